@@ -110,7 +110,21 @@ func (s *Syncer) Err() error {
 	}
 }
 
+// GetFeeEstimatesByCoinType is part of the wallet.NetworkBackend interface.
+// Estimates are cached for the TTL of the syncer's RPC options.
 func (s *Syncer) GetFeeEstimatesByCoinType(ctx context.Context, coinType uint8) (*wallet.FeeEstimates, error) {
+	return s.FeeEstimates(ctx, coinType, false)
+}
+
+// FeeEstimates returns the fee estimates of a coin type, querying dcrd only
+// when cached estimates have expired or refresh is true.  Expired estimates
+// continue to be returned for a short time when dcrd can not be queried,
+// unless refresh is true.
+func (s *Syncer) FeeEstimates(ctx context.Context, coinType uint8, refresh bool) (*wallet.FeeEstimates, error) {
+	return s.feeEstimates.get(ctx, coinType, refresh, s.fetchFeeEstimates)
+}
+
+func (s *Syncer) fetchFeeEstimates(ctx context.Context, coinType uint8) (*wallet.FeeEstimates, error) {
 	estimates, err := s.rpc.GetFeeEstimatesByCoinType(ctx, coinType)
 	if err != nil {
 		return nil, err
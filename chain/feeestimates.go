@@ -0,0 +1,132 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/monetarium/monetarium-wallet/wallet"
+)
+
+const (
+	// DefaultFeeEstimateTTL is the duration fee estimates queried from
+	// dcrd are reused for when RPCOptions does not specify one.
+	DefaultFeeEstimateTTL = 30 * time.Second
+
+	// feeEstimateStaleFactor limits how long expired fee estimates
+	// continue to be returned when dcrd can not be queried for new
+	// estimates, as a multiple of the TTL.  This keeps transaction
+	// authoring working through short disconnects without using
+	// estimates which no longer describe the network.
+	feeEstimateStaleFactor = 4
+)
+
+type cachedFeeEstimates struct {
+	estimates wallet.FeeEstimates
+	fetched   time.Time
+}
+
+// feeEstimateCache caches the fee estimates of each coin type for a TTL.
+type feeEstimateCache struct {
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[uint8]*cachedFeeEstimates
+	mu      sync.Mutex
+}
+
+func newFeeEstimateCache(ttl time.Duration) *feeEstimateCache {
+	if ttl <= 0 {
+		ttl = DefaultFeeEstimateTTL
+	}
+	return &feeEstimateCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[uint8]*cachedFeeEstimates),
+	}
+}
+
+type feeEstimateFetcher func(ctx context.Context, coinType uint8) (*wallet.FeeEstimates, error)
+
+// get returns the cached fee estimates of a coin type, calling fetch to
+// query new estimates when none are cached, the cached estimates have
+// expired, or refresh is true.  If fetch errors without refresh, expired
+// estimates which are not yet stale are returned instead of the error.
+func (c *feeEstimateCache) get(ctx context.Context, coinType uint8, refresh bool,
+	fetch feeEstimateFetcher) (*wallet.FeeEstimates, error) {
+
+	c.mu.Lock()
+	e := c.entries[coinType]
+	if e != nil && !refresh && c.now().Sub(e.fetched) < c.ttl {
+		estimates := e.estimates
+		c.mu.Unlock()
+		return &estimates, nil
+	}
+	c.mu.Unlock()
+
+	estimates, err := fetch(ctx, coinType)
+	if err != nil {
+		c.mu.Lock()
+		e := c.entries[coinType]
+		if e == nil || refresh || c.now().Sub(e.fetched) >= feeEstimateStaleFactor*c.ttl {
+			c.mu.Unlock()
+			return nil, err
+		}
+		stale := e.estimates
+		c.mu.Unlock()
+		log.Debugf("Using cached fee estimates for coin type %d after "+
+			"failed refresh: %v", coinType, err)
+		return &stale, nil
+	}
+
+	c.put(coinType, estimates)
+	return estimates, nil
+}
+
+func (c *feeEstimateCache) put(coinType uint8, estimates *wallet.FeeEstimates) {
+	c.mu.Lock()
+	c.entries[coinType] = &cachedFeeEstimates{
+		estimates: *estimates,
+		fetched:   c.now(),
+	}
+	c.mu.Unlock()
+}
+
+// refreshLoop queries new estimates for every cached coin type each half TTL,
+// so cached estimates are replaced before they expire, until the context is
+// cancelled.  Only coin types the wallet has requested estimates for are
+// refreshed.
+func (c *feeEstimateCache) refreshLoop(ctx context.Context, fetch feeEstimateFetcher) {
+	ticker := time.NewTicker(c.ttl / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		c.mu.Lock()
+		coinTypes := make([]uint8, 0, len(c.entries))
+		for ct := range c.entries {
+			coinTypes = append(coinTypes, ct)
+		}
+		c.mu.Unlock()
+
+		for _, ct := range coinTypes {
+			estimates, err := fetch(ctx, ct)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				log.Debugf("Unable to refresh fee estimates for coin "+
+					"type %d: %v", ct, err)
+				continue
+			}
+			c.put(ct, estimates)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-wallet/wallet"
+)
+
+func TestFeeEstimateCache(t *testing.T) {
+	ctx := context.Background()
+	const ttl = time.Minute
+	c := newFeeEstimateCache(ttl)
+	now := time.Unix(1700000000, 0)
+	c.now = func() time.Time { return now }
+
+	var fetches int
+	var fetchErr error
+	fetch := func(ctx context.Context, coinType uint8) (*wallet.FeeEstimates, error) {
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		fetches++
+		return &wallet.FeeEstimates{
+			CoinType:  coinType,
+			NormalFee: float64(fetches),
+		}, nil
+	}
+	get := func(coinType uint8, refresh bool) (*wallet.FeeEstimates, error) {
+		t.Helper()
+		return c.get(ctx, coinType, refresh, fetch)
+	}
+	wantFee := func(coinType uint8, refresh bool, fee float64) {
+		t.Helper()
+		estimates, err := get(coinType, refresh)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if estimates.CoinType != coinType || estimates.NormalFee != fee {
+			t.Fatalf("coin type %d: got estimates %+v, want normal fee %v",
+				coinType, estimates, fee)
+		}
+	}
+
+	wantFee(0, false, 1)
+	// Cached estimates are reused until they expire.
+	now = now.Add(ttl - time.Second)
+	wantFee(0, false, 1)
+	// Coin types are cached separately.
+	wantFee(1, false, 2)
+	// Forcing a refresh queries new estimates.
+	wantFee(0, true, 3)
+	now = now.Add(ttl)
+	wantFee(0, false, 4)
+
+	// Expired estimates are returned when they can not be refreshed,
+	// unless the refresh is forced or the estimates are stale.
+	fetchErr = errors.New("disconnected")
+	now = now.Add(ttl)
+	wantFee(0, false, 4)
+	if _, err := get(0, true); !errors.Is(err, fetchErr) {
+		t.Errorf("forced refresh: got error %v, want %v", err, fetchErr)
+	}
+	now = now.Add(feeEstimateStaleFactor * ttl)
+	if _, err := get(0, false); !errors.Is(err, fetchErr) {
+		t.Errorf("stale estimates: got error %v, want %v", err, fetchErr)
+	}
+	if _, err := get(2, false); !errors.Is(err, fetchErr) {
+		t.Errorf("uncached coin type: got error %v, want %v", err, fetchErr)
+	}
+
+	// Returned estimates do not alias the cache.
+	fetchErr = nil
+	estimates, err := get(0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	estimates.NormalFee = 0
+	wantFee(0, false, 5)
+}
@@ -0,0 +1,16 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chain
+
+import "github.com/monetarium/monetarium-wallet/internal/metrics"
+
+var (
+	syncedGauge = metrics.NewGauge("monetarium_wallet_chain_synced",
+		"Whether the wallet is synced to the dcrd RPC server.")
+	blockNotifications = metrics.NewCounter("monetarium_wallet_chain_block_notifications_total",
+		"Block connected notifications received from the dcrd RPC server.")
+	relevantTxNotifications = metrics.NewCounter("monetarium_wallet_chain_relevant_tx_notifications_total",
+		"Relevant transaction notifications received from the dcrd RPC server.")
+)
@@ -0,0 +1,136 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+// nodeChainParams is the subset of the getblockchaininfo result describing the
+// consensus parameters dcrd was built with.
+type nodeChainParams struct {
+	Chain       string                      `json:"chain"`
+	Deployments map[string]nodeAgendaParams `json:"deployments"`
+
+	// SKAEmissions describes the emission schedule of each SKA coin type.
+	// It is not reported by nodes predating SKA emissions, and the
+	// schedules are not compared when missing.
+	SKAEmissions []nodeSKAEmission `json:"skaemissions,omitempty"`
+}
+
+type nodeAgendaParams struct {
+	StartTime  uint64 `json:"starttime"`
+	ExpireTime uint64 `json:"expiretime"`
+}
+
+type nodeSKAEmission struct {
+	CoinType       uint8 `json:"cointype"`
+	Active         bool  `json:"active"`
+	EmissionHeight int64 `json:"emissionheight"`
+	EmissionWindow int64 `json:"emissionwindow"`
+}
+
+// chainParamsDiff describes every difference between the wallet's chain
+// parameters and those reported by dcrd.  Agendas only known by one of the
+// two are not differences, as the wallet and dcrd may be different releases
+// for the same network.
+func chainParamsDiff(params *chaincfg.Params, node *nodeChainParams) []string {
+	var diff []string
+	if node.Chain != params.Name {
+		diff = append(diff, fmt.Sprintf("network: wallet %q, dcrd %q",
+			params.Name, node.Chain))
+		// Nothing else can be expected to match.
+		return diff
+	}
+
+	ids := make([]string, 0, len(node.Deployments))
+	for id := range node.Deployments {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	agendas := make(map[string]*chaincfg.ConsensusDeployment)
+	for _, deployments := range params.Deployments {
+		for i := range deployments {
+			agendas[deployments[i].Vote.Id] = &deployments[i]
+		}
+	}
+	for _, id := range ids {
+		d, ok := agendas[id]
+		if !ok {
+			continue
+		}
+		n := node.Deployments[id]
+		if n.StartTime != d.StartTime {
+			diff = append(diff, fmt.Sprintf("agenda %s start time: "+
+				"wallet %d, dcrd %d", id, d.StartTime, n.StartTime))
+		}
+		if n.ExpireTime != d.ExpireTime {
+			diff = append(diff, fmt.Sprintf("agenda %s expire time: "+
+				"wallet %d, dcrd %d", id, d.ExpireTime, n.ExpireTime))
+		}
+	}
+
+	if node.SKAEmissions == nil {
+		return diff
+	}
+	reported := make(map[cointype.CoinType]bool, len(node.SKAEmissions))
+	for _, e := range node.SKAEmissions {
+		ct := cointype.CoinType(e.CoinType)
+		reported[ct] = true
+		cfg, ok := params.SKACoins[ct]
+		if !ok {
+			diff = append(diff, fmt.Sprintf("SKA coin type %d: unknown "+
+				"to wallet", ct))
+			continue
+		}
+		if e.Active != cfg.Active {
+			diff = append(diff, fmt.Sprintf("SKA coin type %d active: "+
+				"wallet %t, dcrd %t", ct, cfg.Active, e.Active))
+		}
+		if e.EmissionHeight != int64(cfg.EmissionHeight) {
+			diff = append(diff, fmt.Sprintf("SKA coin type %d emission "+
+				"height: wallet %d, dcrd %d", ct, cfg.EmissionHeight,
+				e.EmissionHeight))
+		}
+		if e.EmissionWindow != int64(cfg.EmissionWindow) {
+			diff = append(diff, fmt.Sprintf("SKA coin type %d emission "+
+				"window: wallet %d, dcrd %d", ct, cfg.EmissionWindow,
+				e.EmissionWindow))
+		}
+	}
+	var unreported []cointype.CoinType
+	for ct := range params.SKACoins {
+		if !reported[ct] {
+			unreported = append(unreported, ct)
+		}
+	}
+	sort.Slice(unreported, func(i, j int) bool {
+		return unreported[i] < unreported[j]
+	})
+	for _, ct := range unreported {
+		diff = append(diff, fmt.Sprintf("SKA coin type %d: unknown to "+
+			"dcrd", ct))
+	}
+	return diff
+}
+
+// checkChainParams errors if the chain parameters of dcrd do not match the
+// wallet's, describing each difference.  Syncing with a dcrd built for other
+// consensus rules would record blocks and transactions the wallet considers
+// invalid.
+func checkChainParams(params *chaincfg.Params, node *nodeChainParams) error {
+	diff := chainParamsDiff(params, node)
+	if len(diff) == 0 {
+		return nil
+	}
+	return errors.E(errors.Invalid, errors.Errorf("dcrd chain parameters "+
+		"do not match the wallet: %s", strings.Join(diff, "; ")))
+}
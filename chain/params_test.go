@@ -0,0 +1,102 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chain
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+func TestChainParamsDiff(t *testing.T) {
+	params := chaincfg.SimNetParams()
+
+	// matching returns the parameters reported by a dcrd built with the
+	// wallet's parameters.
+	matching := func() *nodeChainParams {
+		node := &nodeChainParams{
+			Chain:        params.Name,
+			Deployments:  make(map[string]nodeAgendaParams),
+			SKAEmissions: []nodeSKAEmission{},
+		}
+		for _, deployments := range params.Deployments {
+			for _, d := range deployments {
+				node.Deployments[d.Vote.Id] = nodeAgendaParams{
+					StartTime:  d.StartTime,
+					ExpireTime: d.ExpireTime,
+				}
+			}
+		}
+		for ct, cfg := range params.SKACoins {
+			node.SKAEmissions = append(node.SKAEmissions, nodeSKAEmission{
+				CoinType:       uint8(ct),
+				Active:         cfg.Active,
+				EmissionHeight: int64(cfg.EmissionHeight),
+				EmissionWindow: int64(cfg.EmissionWindow),
+			})
+		}
+		sort.Slice(node.SKAEmissions, func(i, j int) bool {
+			return node.SKAEmissions[i].CoinType < node.SKAEmissions[j].CoinType
+		})
+		return node
+	}
+
+	if diff := chainParamsDiff(params, matching()); len(diff) != 0 {
+		t.Fatalf("matching parameters: got diff %q", diff)
+	}
+	if err := checkChainParams(params, matching()); err != nil {
+		t.Fatalf("matching parameters: %v", err)
+	}
+
+	node := matching()
+	node.Chain = "testnet3"
+	want := []string{fmt.Sprintf("network: wallet %q, dcrd \"testnet3\"", params.Name)}
+	if diff := chainParamsDiff(params, node); !reflect.DeepEqual(diff, want) {
+		t.Errorf("other network: got diff %q, want %q", diff, want)
+	}
+	if err := checkChainParams(params, node); err == nil {
+		t.Errorf("other network: no error")
+	}
+
+	// Agendas unknown to the wallet and unreported SKA emissions of older
+	// nodes are not differences.
+	node = matching()
+	node.Deployments["unknownagenda"] = nodeAgendaParams{StartTime: 1}
+	node.SKAEmissions = nil
+	if diff := chainParamsDiff(params, node); len(diff) != 0 {
+		t.Errorf("older and newer releases: got diff %q", diff)
+	}
+
+	node = matching()
+	want = nil
+	for id, d := range node.Deployments {
+		d.ExpireTime++
+		node.Deployments[id] = d
+		want = append(want, fmt.Sprintf("agenda %s expire time: wallet %d, "+
+			"dcrd %d", id, d.ExpireTime-1, d.ExpireTime))
+		break
+	}
+	if len(node.SKAEmissions) != 0 {
+		e := &node.SKAEmissions[0]
+		e.EmissionHeight++
+		want = append(want, fmt.Sprintf("SKA coin type %d emission height: "+
+			"wallet %d, dcrd %d", e.CoinType, e.EmissionHeight-1,
+			e.EmissionHeight))
+	}
+	const unknownCoinType = 255
+	if _, ok := params.SKACoins[cointype.CoinType(unknownCoinType)]; !ok {
+		node.SKAEmissions = append(node.SKAEmissions, nodeSKAEmission{
+			CoinType: unknownCoinType,
+		})
+		want = append(want, "SKA coin type 255: unknown to wallet")
+	}
+	if diff := chainParamsDiff(params, node); !reflect.DeepEqual(diff, want) {
+		t.Errorf("mismatched parameters: got diff %q, want %q", diff, want)
+	}
+}
@@ -56,6 +56,8 @@ type Syncer struct {
 
 	cb *Callbacks
 
+	feeEstimates *feeEstimateCache
+
 	done   chan struct{}
 	err    error
 	doneMu sync.Mutex
@@ -73,6 +75,10 @@ type RPCOptions struct {
 	ClientCert  []byte
 	ClientKey   []byte
 	Insecure    bool
+
+	// FeeEstimateTTL is the duration fee estimates queried from dcrd are
+	// reused for.  DefaultFeeEstimateTTL is used when zero.
+	FeeEstimateTTL time.Duration
 }
 
 // NewSyncer creates a Syncer that will sync the wallet using dcrd JSON-RPC.
@@ -83,6 +89,7 @@ func NewSyncer(w *wallet.Wallet, r *RPCOptions) *Syncer {
 		blake256Hasher: blake256.New(),
 		discoverAccts:  !w.Locked(),
 		relevantTxs:    make(map[chainhash.Hash][]*wire.MsgTx),
+		feeEstimates:   newFeeEstimateCache(r.FeeEstimateTTL),
 	}
 }
 
@@ -98,9 +105,10 @@ type Callbacks struct {
 	FetchHeadersProgress         func(lastHeaderHeight int32, lastHeaderTime int64)
 	FetchHeadersFinished         func()
 	DiscoverAddressesStarted     func()
+	DiscoverAddressesProgress    func(completedAccounts, totalAccounts int)
 	DiscoverAddressesFinished    func()
 	RescanStarted                func()
-	RescanProgress               func(rescannedThrough int32)
+	RescanProgress               func(rescannedThrough int32, percent float64)
 	RescanFinished               func()
 }
 
@@ -140,6 +148,7 @@ func (s *Syncer) Synced(ctx context.Context) (bool, int32) {
 // unsynced, updates to synced and notifies the callback, if set.
 func (s *Syncer) synced() {
 	swapped := s.atomicWalletSynced.CompareAndSwap(0, 1)
+	syncedGauge.Set(1)
 	if swapped && s.cb != nil && s.cb.Synced != nil {
 		s.cb.Synced(true)
 	}
@@ -149,6 +158,7 @@ func (s *Syncer) synced() {
 // synced, updates to unsynced and notifies the callback, if set.
 func (s *Syncer) unsynced() {
 	swapped := s.atomicWalletSynced.CompareAndSwap(1, 0)
+	syncedGauge.Set(0)
 	if swapped && s.cb != nil && s.cb.Synced != nil {
 		s.cb.Synced(false)
 	}
@@ -195,6 +205,12 @@ func (s *Syncer) discoverAddressesStart() {
 	}
 }
 
+func (s *Syncer) discoverAddressesProgress(completedAccounts, totalAccounts int) {
+	if s.cb != nil && s.cb.DiscoverAddressesProgress != nil {
+		s.cb.DiscoverAddressesProgress(completedAccounts, totalAccounts)
+	}
+}
+
 func (s *Syncer) discoverAddressesFinished() {
 	if s.cb != nil && s.cb.DiscoverAddressesFinished != nil {
 		s.cb.DiscoverAddressesFinished()
@@ -207,9 +223,9 @@ func (s *Syncer) rescanStart() {
 	}
 }
 
-func (s *Syncer) rescanProgress(rescannedThrough int32) {
+func (s *Syncer) rescanProgress(rescannedThrough int32, percent float64) {
 	if s.cb != nil && s.cb.RescanProgress != nil {
-		s.cb.RescanProgress(rescannedThrough)
+		s.cb.RescanProgress(rescannedThrough, percent)
 	}
 }
 
@@ -367,9 +383,14 @@ func (s *Syncer) getHeaders(ctx context.Context) error {
 		discoverAccts := s.discoverAccts
 		s.mu.Unlock()
 		s.discoverAddressesStart()
-		err = s.wallet.DiscoverActiveAddresses(ctx, s, rescanPoint, discoverAccts, s.wallet.GapLimit())
-		if err != nil {
-			return err
+		discovery := make(chan wallet.DiscoveryProgress, 1)
+		go s.wallet.DiscoverActiveAddressesProgress(ctx, s, rescanPoint,
+			discoverAccts, s.wallet.GapLimit(), discovery)
+		for p := range discovery {
+			if p.Err != nil {
+				return p.Err
+			}
+			s.discoverAddressesProgress(p.Completed, p.Total)
 		}
 		s.discoverAddressesFinished()
 		s.mu.Lock()
@@ -392,7 +413,7 @@ func (s *Syncer) getHeaders(ctx context.Context) error {
 			if p.Err != nil {
 				return p.Err
 			}
-			s.rescanProgress(p.ScannedThrough)
+			s.rescanProgress(p.ScannedThrough, p.Percent)
 		}
 		s.rescanFinished()
 
@@ -439,9 +460,11 @@ func (s *Syncer) getMissingHeaders(ctx context.Context) error {
 	// This must be done one by one because a new block might have
 	// transactions that change the set of active addresses for the wallet,
 	// causing yet another set of transactions to be found in a subsequent
-	// block.
+	// block.  Blocks are instead connected in batches with a single database
+	// update, and a batch ends with each block with relevant transactions,
+	// so that the addresses watched by later rescans are updated.
 	rescanHashes := make([]chainhash.Hash, 1)
-	for _, header := range headers {
+	for i, header := range headers {
 		rescanHashes[0] = header.BlockHash()
 		var relevantTxs []*wire.MsgTx
 		err := s.rpc.Rescan(ctx, rescanHashes, func(block *chainhash.Hash, txs []*wire.MsgTx) error {
@@ -452,10 +475,20 @@ func (s *Syncer) getMissingHeaders(ctx context.Context) error {
 			return err
 		}
 
-		err = s.handleBlockConnected(ctx, header, relevantTxs, true)
+		haveParent, err := s.addBlock(ctx, header, relevantTxs)
 		if err != nil {
 			return err
 		}
+		if !haveParent {
+			return fmt.Errorf("broken assumption: received missing block " +
+				" without its parent in mainchain or sidechain")
+		}
+		if len(relevantTxs) != 0 || i == len(headers)-1 {
+			_, err = s.connectBestChain(ctx)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -608,6 +641,18 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		return errors.E("mismatched networks")
 	}
 
+	// Verify that the server was built with the same consensus parameters,
+	// including agenda activation and SKA emission schedules.
+	var nodeParams nodeChainParams
+	err = s.rpc.Call(ctx, "getblockchaininfo", &nodeParams)
+	if err != nil {
+		return err
+	}
+	err = checkChainParams(params, &nodeParams)
+	if err != nil {
+		return err
+	}
+
 	// Ensure the RPC server has a compatible API version.
 	var api struct {
 		Version semver `json:"dcrdjsonrpcapi"`
@@ -743,11 +788,17 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	walletCtx, walletCtxCancel := context.WithCancel(context.Background())
 	defer walletCtxCancel()
 	g.Go(func() error {
-		// Run wallet background goroutines (currently, this just runs
-		// mixclient).
+		// Run wallet background goroutines (the rebroadcast of
+		// queued transactions, and mixclient when mixing).
 		return s.wallet.Run(walletCtx)
 	})
 
+	// Keep fee estimates of previously queried coin types fresh.
+	g.Go(func() error {
+		s.feeEstimates.refreshLoop(ctx, s.fetchFeeEstimates)
+		return nil
+	})
+
 	// Request notifications for mixing messages.
 	if s.wallet.MixingEnabled() {
 		err = s.rpc.Call(ctx, "notifymixmessages", nil)
@@ -842,23 +893,20 @@ func (s *Syncer) blockConnected(ctx context.Context, params json.RawMessage) err
 	if err != nil {
 		return err
 	}
+	blockNotifications.Inc()
 
-	return s.handleBlockConnected(ctx, header, relevant, false)
+	return s.handleBlockConnected(ctx, header, relevant)
 }
 
-func (s *Syncer) handleBlockConnected(ctx context.Context, header *wire.BlockHeader, relevantTxs []*wire.MsgTx, isProcessingMissingBlock bool) error {
+func (s *Syncer) handleBlockConnected(ctx context.Context, header *wire.BlockHeader, relevantTxs []*wire.MsgTx) error {
 	// Ensure the ancestor is known to be in the main or in a side chain.
 	// If it is not, this means we missed some blocks and should perform a
 	// new round of initial header sync.
-	s.sidechainsMu.Lock()
-	prevInMainChain, _, _ := s.wallet.BlockInMainChain(ctx, &header.PrevBlock)
-	prevInSideChain := s.sidechains.HasSideChainBlock(&header.PrevBlock)
-	s.sidechainsMu.Unlock()
-	if !(prevInMainChain || prevInSideChain) {
-		if isProcessingMissingBlock {
-			return fmt.Errorf("broken assumption: received missing block " +
-				" without its parent in mainchain or sidechain")
-		}
+	haveParent, err := s.addBlock(ctx, header, relevantTxs)
+	if err != nil {
+		return err
+	}
+	if !haveParent {
 		log.Infof("Received header for block %s (height %d) when "+
 			"parent %s not in main or side chain. Re-requesting "+
 			"missing headers.", header.BlockHash(),
@@ -866,16 +914,40 @@ func (s *Syncer) handleBlockConnected(ctx context.Context, header *wire.BlockHea
 		return s.getMissingHeaders(ctx)
 	}
 
+	bestChain, err := s.connectBestChain(ctx)
+	if err != nil {
+		return err
+	}
+	if len(bestChain) == 0 {
+		log.Infof("Observed sidechain or orphan block %v (height %d)",
+			header.BlockHash(), header.Height)
+	}
+	return nil
+}
+
+// addBlock validates a block reported by the server, and adds it to the side
+// chain forest to be connected by connectBestChain.  It returns false without
+// adding the block if its parent is not known to be in the main chain or a
+// side chain.
+func (s *Syncer) addBlock(ctx context.Context, header *wire.BlockHeader, relevantTxs []*wire.MsgTx) (bool, error) {
+	s.sidechainsMu.Lock()
+	prevInMainChain, _, _ := s.wallet.BlockInMainChain(ctx, &header.PrevBlock)
+	prevInSideChain := s.sidechains.HasSideChainBlock(&header.PrevBlock)
+	s.sidechainsMu.Unlock()
+	if !(prevInMainChain || prevInSideChain) {
+		return false, nil
+	}
+
 	blockHash := header.BlockHash()
 	filter, proofIndex, proof, err := s.rpc.CFilterV2(ctx, &blockHash)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	cnet := s.wallet.ChainParams().Net
 	err = validate.CFilterV2HeaderCommitment(cnet, header, filter, proofIndex, proof)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	s.sidechainsMu.Lock()
@@ -885,42 +957,63 @@ func (s *Syncer) handleBlockConnected(ctx context.Context, header *wire.BlockHea
 	if wallet.BadCheckpoint(cnet, &blockHash, int32(header.Height)) {
 		blockNode.BadCheckpoint()
 	}
+
+	// Validate the header chain ending with the connected block rather than
+	// trusting the server to report valid blocks of the best chain.
+	fullsc, err := s.sidechains.FullSideChain([]*wallet.BlockNode{blockNode})
+	if err != nil {
+		return false, err
+	}
+	_, err = s.wallet.ValidateHeaderChainDifficulties(ctx, fullsc, 0)
+	if err != nil {
+		log.Warnf("Rejecting block %v (height %d) reported by %s: %v",
+			&blockHash, header.Height, s.rpc, err)
+		return false, err
+	}
+
 	s.sidechains.AddBlockNode(blockNode)
 	s.relevantTxs[blockHash] = relevantTxs
+	return true, nil
+}
+
+// connectBestChain switches the wallet to the best chain of the blocks added
+// by addBlock, if it has more work than the wallet's main chain.  All blocks
+// of the chain are connected with a single database update.  The connected
+// chain is returned.
+func (s *Syncer) connectBestChain(ctx context.Context) ([]*wallet.BlockNode, error) {
+	s.sidechainsMu.Lock()
+	defer s.sidechainsMu.Unlock()
 
 	bestChain, err := s.wallet.EvaluateBestChain(ctx, &s.sidechains)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if len(bestChain) == 0 {
+		return nil, nil
+	}
+	prevChain, err := s.wallet.ChainSwitch(ctx, &s.sidechains, bestChain, s.relevantTxs)
+	if err != nil {
+		return nil, err
 	}
-	if len(bestChain) != 0 {
-		var prevChain []*wallet.BlockNode
-		prevChain, err = s.wallet.ChainSwitch(ctx, &s.sidechains, bestChain, s.relevantTxs)
-		if err != nil {
-			return err
-		}
 
-		if len(prevChain) != 0 {
-			log.Infof("Reorganize from %v to %v (total %d block(s) reorged)",
-				prevChain[len(prevChain)-1].Hash, bestChain[len(bestChain)-1].Hash, len(prevChain))
-			for _, n := range prevChain {
-				s.sidechains.AddBlockNode(n)
+	if len(prevChain) != 0 {
+		log.Infof("Reorganize from %v to %v (total %d block(s) reorged)",
+			prevChain[len(prevChain)-1].Hash, bestChain[len(bestChain)-1].Hash, len(prevChain))
+		for _, n := range prevChain {
+			s.sidechains.AddBlockNode(n)
 
-				// TODO: should add txs from the removed blocks
-				// to relevantTxs.  Later block connected logs
-				// will be missing the transaction counts if a
-				// reorg switches back to this older chain.
-			}
+			// TODO: should add txs from the removed blocks
+			// to relevantTxs.  Later block connected logs
+			// will be missing the transaction counts if a
+			// reorg switches back to this older chain.
 		}
-		for _, n := range bestChain {
-			log.Infof("Connected block %v, height %d, %d wallet transaction(s)",
-				n.Hash, n.Header.Height, len(s.relevantTxs[*n.Hash]))
-			delete(s.relevantTxs, *n.Hash)
-		}
-	} else {
-		log.Infof("Observed sidechain or orphan block %v (height %d)", &blockHash, header.Height)
 	}
-
-	return nil
+	for _, n := range bestChain {
+		log.Infof("Connected block %v, height %d, %d wallet transaction(s)",
+			n.Hash, n.Header.Height, len(s.relevantTxs[*n.Hash]))
+		delete(s.relevantTxs, *n.Hash)
+	}
+	return bestChain, nil
 }
 
 func (s *Syncer) relevantTxAccepted(ctx context.Context, params json.RawMessage) error {
@@ -928,6 +1021,7 @@ func (s *Syncer) relevantTxAccepted(ctx context.Context, params json.RawMessage)
 	if err != nil {
 		return err
 	}
+	relevantTxNotifications.Inc()
 	if s.wallet.ManualTickets() && stake.IsSStx(tx) {
 		return nil
 	}
@@ -13,18 +13,25 @@ import (
 	"os/user"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"decred.org/cspp/v2/solverrpc"
+	"github.com/monetarium/monetarium-wallet/chain"
 	"github.com/monetarium/monetarium-wallet/errors"
 	"github.com/monetarium/monetarium-wallet/internal/cfgutil"
+	"github.com/monetarium/monetarium-wallet/internal/fiatrate"
 	"github.com/monetarium/monetarium-wallet/internal/loggers"
 	"github.com/monetarium/monetarium-wallet/internal/netparams"
+	"github.com/monetarium/monetarium-wallet/spv"
 	"github.com/monetarium/monetarium-wallet/version"
 	"github.com/monetarium/monetarium-wallet/wallet"
+	"github.com/monetarium/monetarium-wallet/wallet/txauthor"
 	"github.com/monetarium/monetarium-wallet/wallet/txrules"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
 	"github.com/monetarium/monetarium-node/connmgr"
 	"github.com/monetarium/monetarium-node/dcrutil"
 	"github.com/decred/go-socks/socks"
@@ -45,8 +52,11 @@ const (
 	defaultLogDirname              = "logs"
 	defaultLogFilename             = "monetarium-wallet.log"
 	defaultLogSize                 = "10M"
+	defaultLogFormat               = "text"
 	defaultRPCMaxClients           = 10
 	defaultRPCMaxWebsockets        = 25
+	defaultNotificationPolicy      = "disconnect"
+	defaultChangeSplitDistribution = "random"
 	defaultAuthType                = authTypeBasic
 	defaultEnableTicketBuyer       = false
 	defaultEnableVoting            = false
@@ -61,6 +71,10 @@ const (
 	defaultCircuitLimit            = 32
 	defaultMixSplitLimit           = 10
 	defaultVSPMaxFee               = dcrutil.Amount(0.2e8)
+	defaultFeeEstimateTTL          = chain.DefaultFeeEstimateTTL
+	defaultSPVRescanConcurrency    = spv.DefaultRescanConcurrency
+	defaultShutdownTimeout         = 30 * time.Second
+	defaultDBDriver                = "bdb"
 
 	// ticket buyer options
 	defaultBalanceToMaintainAbsolute = 0
@@ -95,10 +109,13 @@ type config struct {
 	DebugLevel         string                  `short:"d" long:"debuglevel" description:"Logging level {trace, debug, info, warn, error, critical}"`
 	LogDir             *cfgutil.ExplicitString `long:"logdir" description:"Directory to log output."`
 	LogSize            string                  `long:"logsize" description:"Maximum size of log file before it is rotated"`
+	LogFormat          string                  `long:"logformat" description:"Format of log records {text, json}"`
 	NoFileLogging      bool                    `long:"nofilelogging" description:"Disable file logging"`
 	Profile            []string                `long:"profile" description:"Enable HTTP profiling this interface/port"`
 	MemProfile         string                  `long:"memprofile" description:"Write mem profile to the specified file"`
 	CPUProfile         string                  `long:"cpuprofile" description:"Write cpu profile to the specified file"`
+	MetricsListeners   []string                `long:"metricslisten" description:"Serve Prometheus metrics at /metrics on this interface/port"`
+	ShutdownTimeout    time.Duration           `long:"shutdowntimeout" description:"Maximum time to wait for in-flight requests and transactions to complete when shutting down"`
 
 	// Wallet options
 	WalletPass              string              `long:"walletpass" default-mask:"-" description:"Public wallet password; required when created with one"`
@@ -112,9 +129,25 @@ type config struct {
 	WatchLast               uint32              `long:"watchlast" description:"Limit watched previous addresses of each HD account branch"`
 	ManualTickets           bool                `long:"manualtickets" description:"Do not discover new tickets through network synchronization"`
 	AllowHighFees           bool                `long:"allowhighfees" description:"Do not perform high fee checks"`
+	AvoidAddressReuse       bool                `long:"avoidaddressreuse" description:"Never wrap around to previously returned addresses when deriving new and change addresses"`
+	AvoidPartialSpends      bool                `long:"avoidpartialspends" description:"Spend every output paying to the address of any selected input together"`
+	ChangeSplit             uint32              `long:"changesplit" description:"Split the change of sent transactions into this many outputs to hide the change amount"`
+	ChangeSplitDistribution string              `long:"changesplitdistribution" description:"Division of change between split change outputs (even or random)"`
 	RelayFee                *cfgutil.AmountFlag `long:"txfee" description:"Transaction fee per kilobyte"`
 	AccountGapLimit         int                 `long:"accountgaplimit" description:"Allowed gap of unused accounts"`
 	DisableCoinTypeUpgrades bool                `long:"disablecointypeupgrades" description:"Never upgrade from legacy to SLIP0044 coin type keys"`
+	DBDriver                string              `long:"dbdriver" description:"Database driver of created wallets (bdb or sqlite); existing wallets are opened with the driver of their database"`
+	UpgradeDryRun           bool                `long:"upgradedryrun" description:"Report pending database upgrades without performing them and exit"`
+	Argon2idTime            uint32              `long:"argon2idtime" description:"Argon2id time cost deriving the private passphrase key; 0 uses the network default"`
+	Argon2idMemory          uint32              `long:"argon2idmemory" description:"Argon2id memory cost (MiB) deriving the private passphrase key; 0 uses the network default"`
+	EnableSKAEmission       bool                `long:"enableskaemission" description:"Allow the emitska JSON-RPC method to sign and publish SKA emission transactions"`
+	AccountKeyExport        bool                `long:"accountkeyexport" description:"Allow the exportaccountxpriv and dumpaccountprivkeys JSON-RPC methods to reveal account private keys"`
+	changeSplitDistribution txauthor.ChangeDistribution
+
+	// Fiat exchange rate options
+	FiatRateSource string `long:"fiatratesource" description:"URL of an HTTP JSON exchange rate source for fiat-denominated sends; {currency} and {cointype} are substituted"`
+	FiatRateField  string `long:"fiatratefield" description:"Field of the exchange rate source JSON response holding the price of one coin"`
+	fiatRates      fiatrate.Source
 
 	// RPC client options
 	RPCConnect       string                  `short:"c" long:"rpcconnect" description:"Network address of dcrd RPC server"`
@@ -126,6 +159,7 @@ type config struct {
 	DcrdClientCert   *cfgutil.ExplicitString `long:"dcrdclientcert" description:"TLS client certificate to present to authenticate RPC connections to dcrd"`
 	DcrdClientKey    *cfgutil.ExplicitString `long:"dcrdclientkey" description:"Key for dcrd RPC client certificate"`
 	DcrdAuthType     string                  `long:"dcrdauthtype" description:"Method for dcrd JSON-RPC client authentication (basic or clientcert)"`
+	FeeEstimateTTL   time.Duration           `long:"feeestimatettl" description:"Duration fee estimates queried from dcrd are reused before querying again"`
 
 	// Proxy and Tor settings
 	Proxy        string `long:"proxy" description:"Establish network connections and DNS lookups through a SOCKS5 proxy (e.g. 127.0.0.1:9050)"`
@@ -138,12 +172,14 @@ type config struct {
 	lookup       func(name string) ([]net.IP, error)
 
 	// Offline mode.
-	Offline bool `long:"offline" description:"Do not sync the wallet"`
+	Offline  bool `long:"offline" description:"Do not sync the wallet"`
+	ReadOnly bool `long:"readonly" description:"Open the wallet database read-only without syncing, and disable RPCs which modify the wallet"`
 
 	// SPV options
-	SPV               bool     `long:"spv" description:"Sync using simplified payment verification"`
-	SPVConnect        []string `long:"spvconnect" description:"SPV sync only with specified peers; disables DNS seeding"`
-	SPVDisableRelayTx bool     `long:"spvdisablerelaytx" description:"Disable receiving mempool transactions when in SPV mode"`
+	SPV                  bool     `long:"spv" description:"Sync using simplified payment verification"`
+	SPVConnect           []string `long:"spvconnect" description:"SPV sync only with specified peers; disables DNS seeding"`
+	SPVDisableRelayTx    bool     `long:"spvdisablerelaytx" description:"Disable receiving mempool transactions when in SPV mode"`
+	SPVRescanConcurrency int      `long:"spvrescanconcurrency" description:"Maximum number of block batches fetched concurrently during SPV rescans"`
 
 	// RPC server options
 	RPCCert                *cfgutil.ExplicitString `long:"rpccert" description:"RPC server TLS certificate"`
@@ -155,8 +191,13 @@ type config struct {
 	LegacyRPCListeners     []string                `long:"rpclisten" description:"Listen for JSON-RPC connections on this interface"`
 	NoGRPC                 bool                    `long:"nogrpc" description:"Disable gRPC server"`
 	NoLegacyRPC            bool                    `long:"nolegacyrpc" description:"Disable JSON-RPC server"`
+	GRPCScopedAuth         bool                    `long:"grpcscopedauth" description:"Require gRPC clients to also present the JSON-RPC username and password or a scoped RPC credential, and limit them to its scopes"`
 	LegacyRPCMaxClients    int64                   `long:"rpcmaxclients" description:"Max JSON-RPC HTTP POST clients"`
 	LegacyRPCMaxWebsockets int64                   `long:"rpcmaxwebsockets" description:"Max JSON-RPC websocket clients"`
+	NotificationBacklog    int                     `long:"notificationbacklog" description:"Max undelivered notifications queued for each notification subscriber (0 blocks until delivered)"`
+	NotificationPolicy     string                  `long:"notificationpolicy" description:"Handling of subscribers reaching the notification backlog (disconnect, dropoldest, or summarize)"`
+	RPCSlowThreshold       time.Duration           `long:"rpcslowthreshold" description:"Log JSON-RPC and gRPC requests taking at least this duration (0 disables)"`
+	notificationPolicy     wallet.NotificationBacklogPolicy
 	Username               string                  `short:"u" long:"username" description:"JSON-RPC username and default dcrd RPC username"`
 	Password               string                  `short:"P" long:"password" default-mask:"-" description:"JSON-RPC password and default dcrd RPC password"`
 	JSONRPCAuthType        string                  `long:"jsonrpcauthtype" description:"Method for JSON-RPC client authentication (basic or clientcert)"`
@@ -187,6 +228,9 @@ type ticketBuyerOptions struct {
 	BalanceToMaintainAbsolute *cfgutil.AmountFlag `long:"balancetomaintainabsolute" description:"Amount of funds to keep in wallet when purchasing tickets"`
 	Limit                     uint                `long:"limit" description:"Buy no more than specified number of tickets per block"`
 	VotingAccount             string              `long:"votingaccount" description:"Account used to derive addresses specifying voting rights"`
+	Compound                  bool                `long:"compound" description:"Purchase tickets with the matured SSFee rewards of accounts opted in to compounding"`
+	FeeRewardFunding          bool                `long:"feerewardfunding" description:"Fund purchased tickets only with mature SSFee reward outputs"`
+	Targets                   bool                `long:"targets" description:"Purchase tickets to maintain the target ticket counts of accounts configured with the setticketbuyerconfig RPC"`
 }
 
 type vspOptions struct {
@@ -349,6 +393,9 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		AppDataDir:              cfgutil.NewExplicitString(defaultAppDataDir),
 		LogDir:                  cfgutil.NewExplicitString(defaultLogDir),
 		LogSize:                 defaultLogSize,
+		LogFormat:               defaultLogFormat,
+		ShutdownTimeout:         defaultShutdownTimeout,
+		DBDriver:                defaultDBDriver,
 		WalletPass:              wallet.InsecurePubPassphrase,
 		CAFile:                  cfgutil.NewExplicitString(""),
 		ClientCAFile:            cfgutil.NewExplicitString(defaultRPCClientCAFile),
@@ -364,6 +411,8 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		TLSCurve:                cfgutil.NewCurveFlag(cfgutil.PreferredCurve),
 		LegacyRPCMaxClients:     defaultRPCMaxClients,
 		LegacyRPCMaxWebsockets:  defaultRPCMaxWebsockets,
+		NotificationPolicy:      defaultNotificationPolicy,
+		ChangeSplitDistribution: defaultChangeSplitDistribution,
 		JSONRPCAuthType:         defaultAuthType,
 		DcrdAuthType:            defaultAuthType,
 		EnableTicketBuyer:       defaultEnableTicketBuyer,
@@ -377,6 +426,8 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		CircuitLimit:            defaultCircuitLimit,
 		MixSplitLimit:           defaultMixSplitLimit,
 		CSPPSolver:              cfgutil.NewExplicitString(solverrpc.SolverProcess),
+		FeeEstimateTTL:          defaultFeeEstimateTTL,
+		SPVRescanConcurrency:    defaultSPVRescanConcurrency,
 
 		// Ticket Buyer Options
 		TBOpts: ticketBuyerOptions{
@@ -541,6 +592,12 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		os.Exit(0)
 	}
 
+	if err := loggers.SetFormat(cfg.LogFormat); err != nil {
+		err := errors.Errorf("loadConfig: %v", err)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
 	// Parse, validate, and set debug log level(s).
 	if err := parseAndSetDebugLevels(cfg.DebugLevel); err != nil {
 		err := errors.Errorf("%s: %v", "loadConfig", err.Error())
@@ -573,6 +630,48 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		return loadConfigError(err)
 	}
 
+	// Mixed ticket purchases cannot restrict their inputs to fee rewards.
+	if cfg.TBOpts.FeeRewardFunding && cfg.EnableTicketBuyer && cfg.MixingEnabled {
+		str := "%s: ticketbuyer.feerewardfunding is incompatible with mixing"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	if cfg.NotificationBacklog < 0 {
+		str := "%s: notificationbacklog cannot be negative: %v"
+		err := errors.Errorf(str, funcName, cfg.NotificationBacklog)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	cfg.notificationPolicy, err = wallet.ParseNotificationBacklogPolicy(cfg.NotificationPolicy)
+	if err != nil {
+		err := errors.Errorf("%s: invalid notificationpolicy: %v", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	cfg.changeSplitDistribution, err = txauthor.ParseChangeDistribution(cfg.ChangeSplitDistribution)
+	if err != nil {
+		err := errors.Errorf("%s: invalid changesplitdistribution: %v", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	if !slices.Contains(walletdb.SupportedDrivers(), cfg.DBDriver) {
+		str := "%s: unsupported dbdriver %q (supported drivers: %s)"
+		err := errors.Errorf(str, funcName, cfg.DBDriver,
+			strings.Join(walletdb.SupportedDrivers(), ", "))
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	if cfg.FeeEstimateTTL <= 0 {
+		str := "%s: feeestimatettl must be positive: %v"
+		err := errors.Errorf(str, funcName, cfg.FeeEstimateTTL)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
 	// Exit if you try to use a simulation wallet with a standard
 	// data directory.
 	if !cfg.AppDataDir.ExplicitlySet() && cfg.CreateTemp {
@@ -600,6 +699,13 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		return loadConfigError(err)
 	}
 
+	if cfg.ReadOnly && (cfg.Create || cfg.CreateTemp || cfg.CreateWatchingOnly) {
+		err := errors.Errorf("The flag --readonly can not be specified " +
+			"when creating a wallet. Use --help for more information.")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
 	dbFileExists, err := cfgutil.FileExists(dbPath)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -848,6 +954,27 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		return loadConfigError(err)
 	}
 
+	// Read-only wallets are never synced, as syncing records blocks and
+	// transactions, and never run services which author transactions.
+	if cfg.ReadOnly {
+		var err error
+		switch {
+		case cfg.SPV:
+			err = errors.E("--readonly and --spv cannot be specified at the same time")
+		case cfg.EnableTicketBuyer || cfg.TBOpts.Compound || cfg.TBOpts.Targets:
+			err = errors.E("--readonly cannot be used with the ticket buyer")
+		case cfg.EnableVoting:
+			err = errors.E("--readonly cannot be used with --enablevoting")
+		case cfg.MixingEnabled || cfg.MixChange:
+			err = errors.E("--readonly cannot be used with mixing")
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		cfg.Offline = true
+	}
+
 	if cfg.SPV && cfg.EnableVoting {
 		err := errors.E("SPV voting is not possible: disable --spv or --enablevoting")
 		fmt.Fprintln(os.Stderr, err)
@@ -858,6 +985,11 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		fmt.Fprintln(os.Stderr, err)
 		return loadConfigError(err)
 	}
+	if cfg.SPVRescanConcurrency < 1 {
+		err := errors.E("--spvrescanconcurrency must be positive")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
 	for i, p := range cfg.SPVConnect {
 		cfg.SPVConnect[i], err = cfgutil.NormalizeAddress(p, activeNet.Params.DefaultPort)
 		if err != nil {
@@ -972,6 +1104,17 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		}
 	}
 
+	if cfg.FiatRateSource != "" {
+		src, err := fiatrate.NewHTTPSource(cfg.FiatRateSource,
+			cfg.FiatRateField, cfg.dial)
+		if err != nil {
+			err := errors.Errorf("invalid fiatratesource: %v", err)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		cfg.fiatRates = src
+	}
+
 	// Expand environment variable and leading ~ for filepaths.
 	cfg.CAFile.Value = cleanAndExpandPath(cfg.CAFile.Value)
 	cfg.RPCCert.Value = cleanAndExpandPath(cfg.RPCCert.Value)
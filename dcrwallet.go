@@ -22,6 +22,7 @@ import (
 	"github.com/monetarium/monetarium-wallet/errors"
 	ldr "github.com/monetarium/monetarium-wallet/internal/loader"
 	"github.com/monetarium/monetarium-wallet/internal/loggers"
+	"github.com/monetarium/monetarium-wallet/internal/metrics"
 	"github.com/monetarium/monetarium-wallet/internal/prompt"
 	"github.com/monetarium/monetarium-wallet/internal/rpc/rpcserver"
 	"github.com/monetarium/monetarium-wallet/p2p"
@@ -42,6 +43,10 @@ var (
 	cfg *config
 )
 
+// vspRetryInterval is the interval between attempts to retry failed VSP fee
+// payments.
+const vspRetryInterval = 10 * time.Minute
+
 func main() {
 	// Create a context that is cancelled when a shutdown request is received
 	// through an interrupt signal or an RPC request.
@@ -85,6 +90,12 @@ func run(ctx context.Context) error {
 	cfg = tcfg
 	defer loggers.CloseLogRotator()
 
+	// Requests and operations in progress when shutdown begins are waited
+	// on by each stage of the shutdown, up to the shutdown timeout in
+	// total.
+	drain := &shutdownDrain{timeout: cfg.ShutdownTimeout}
+	defer drain.release()
+
 	// Show version at startup.
 	log.Infof("Version %s (Go version %s %s/%s)", version.String(), runtime.Version(),
 		runtime.GOOS, runtime.GOARCH)
@@ -170,7 +181,88 @@ func run(ctx context.Context) error {
 		cfg.GapLimit, cfg.WatchLast, cfg.AllowHighFees, cfg.RelayFee.Amount,
 		cfg.VSPOpts.MaxFee.Amount, cfg.AccountGapLimit,
 		cfg.DisableCoinTypeUpgrades, cfg.MixingEnabled, cfg.ManualTickets,
-		cfg.MixSplitLimit, cfg.dial)
+		cfg.MixSplitLimit, cfg.ReadOnly, cfg.DBDriver, cfg.dial)
+
+	// Limit the notifications queued for each notification subscriber.
+	loader.RunAfterLoad(func(w *wallet.Wallet) {
+		w.NtfnServer.SetBacklogLimit(cfg.NotificationBacklog, cfg.notificationPolicy)
+	})
+
+	// Apply the address reuse policy.
+	loader.RunAfterLoad(func(w *wallet.Wallet) {
+		w.SetAvoidAddressReuse(cfg.AvoidAddressReuse)
+		w.SetAvoidPartialSpends(cfg.AvoidPartialSpends)
+	})
+
+	// Split change of sent transactions as configured.
+	loader.RunAfterLoad(func(w *wallet.Wallet) {
+		w.SetChangeSplit(cfg.ChangeSplit, cfg.changeSplitDistribution)
+	})
+
+	// Derive private passphrase keys with the configured Argon2id cost.
+	loader.RunAfterLoad(func(w *wallet.Wallet) {
+		w.SetArgon2idCost(cfg.Argon2idTime, cfg.Argon2idMemory*1024)
+	})
+
+	// Retry failed fee payments of tickets registered with a VSP.
+	if !cfg.ReadOnly {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			go vspRetryLoop(ctx, w)
+		})
+	}
+
+	// Serve metrics to Prometheus if enabled.  Gauges describing the default
+	// wallet are collected before each scrape.
+	if len(cfg.MetricsListeners) > 0 {
+		metrics.RegisterCollector(func(ctx context.Context) {
+			if w, ok := loader.LoadedWallet(); ok {
+				w.CollectMetrics(ctx)
+			}
+		})
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+		for _, listenAddr := range cfg.MetricsListeners {
+			go func() {
+				log.Infof("Starting metrics server on %s", listenAddr)
+				err := http.ListenAndServe(listenAddr, mux)
+				if err != nil {
+					fatalf("Unable to run metrics server: %v", err)
+				}
+			}()
+		}
+	}
+
+	// Named wallets loaded over RPC are configured like the default wallet
+	// and synchronized with the network until they are unloaded.
+	loader.RunAfterNamedLoad(func(wctx context.Context, name string, w *wallet.Wallet) {
+		w.NtfnServer.SetBacklogLimit(cfg.NotificationBacklog, cfg.notificationPolicy)
+		w.SetAvoidAddressReuse(cfg.AvoidAddressReuse)
+		w.SetAvoidPartialSpends(cfg.AvoidPartialSpends)
+		w.SetChangeSplit(cfg.ChangeSplit, cfg.changeSplitDistribution)
+		w.SetArgon2idCost(cfg.Argon2idTime, cfg.Argon2idMemory*1024)
+
+		ctx, cancel := context.WithCancel(ctx)
+		stop := context.AfterFunc(wctx, cancel)
+		nl, _ := loader.Named(name)
+		amgrDir := nl.DbDirPath()
+		go func() {
+			defer cancel()
+			defer stop()
+			log.Infof("Loaded wallet %q", name)
+			if !cfg.ReadOnly {
+				go vspRetryLoop(ctx, w)
+			}
+			switch {
+			case cfg.Offline:
+				w.SetNetworkBackend(wallet.OfflineNetworkBackend{})
+				<-ctx.Done()
+			case cfg.SPV:
+				spvLoop(ctx, w, amgrDir)
+			default:
+				rpcSyncLoop(ctx, w)
+			}
+		}()
+	})
 
 	// Stop any services started by the loader after the shutdown procedure is
 	// initialized and this function returns.
@@ -181,6 +273,13 @@ func run(ctx context.Context) error {
 		if r := recover(); r != nil {
 			panic(r)
 		}
+		if err := loader.Drain(drain.context()); err != nil {
+			log.Warnf("Closing wallets before in-flight operations "+
+				"completed: %v", err)
+		}
+		if err := loader.UnloadNamedWallets(); err != nil {
+			log.Errorf("Failed to close named wallets: %v", err)
+		}
 		err := loader.UnloadWallet()
 		if err != nil && !errors.Is(err, errors.Invalid) {
 			log.Errorf("Failed to close wallet: %v", err)
@@ -202,6 +301,25 @@ func run(ctx context.Context) error {
 			return ctx.Err()
 		}
 
+		// Report the pending database upgrades without performing them
+		// when requested.
+		if cfg.UpgradeDryRun {
+			migrations, err := loader.DryRunUpgrade(ctx, walletPass)
+			zero(walletPass)
+			if err != nil {
+				log.Errorf("Database upgrade dry run failed: %v", err)
+				return err
+			}
+			if len(migrations) == 0 {
+				log.Infof("No database upgrades are pending")
+			}
+			for _, m := range migrations {
+				log.Infof("Pending database upgrade to version %d: %s",
+					m.Version, m.Description)
+			}
+			return nil
+		}
+
 		// Load the wallet.  It must have been created already or this will
 		// return an appropriate error.
 		var w *wallet.Wallet
@@ -287,7 +405,7 @@ func run(ctx context.Context) error {
 			}
 		}
 
-		if cfg.MixChange || cfg.EnableTicketBuyer {
+		if cfg.MixChange || cfg.EnableTicketBuyer || cfg.TBOpts.Compound || cfg.TBOpts.Targets {
 			var err error
 			var lastFlag, lastLookup string
 			lookup := func(flag, name string) (account uint32) {
@@ -347,6 +465,9 @@ func run(ctx context.Context) error {
 				TicketSplitAccount: ticketSplitAccount,
 				ChangeAccount:      changeAccount,
 				VSP:                vspClient,
+				Compound:           cfg.TBOpts.Compound,
+				FeeRewardFunding:   cfg.TBOpts.FeeRewardFunding,
+				Targets:            cfg.TBOpts.Targets,
 			})
 
 			log.Infof("Starting auto transaction creator")
@@ -386,8 +507,16 @@ func run(ctx context.Context) error {
 			rpcserver.StartNetworkService(gRPCServer, w)
 			rpcserver.StartVotingService(gRPCServer, w)
 		})
+		// New requests are refused as soon as shutdown begins, while
+		// those in progress are waited on before the server is stopped.
+		context.AfterFunc(ctx, grpcRequests.Refuse)
 		defer func() {
 			log.Warn("Stopping gRPC server...")
+			err := grpcRequests.Drain(drain.context())
+			if err != nil {
+				log.Warnf("Cancelling %d in-flight gRPC requests: %v",
+					grpcRequests.InFlight(), err)
+			}
 			gRPCServer.Stop()
 			log.Info("gRPC server shutdown")
 		}()
@@ -400,7 +529,10 @@ func run(ctx context.Context) error {
 		}()
 		defer func() {
 			log.Warn("Stopping JSON-RPC server...")
-			jsonRPCServer.Stop()
+			err := jsonRPCServer.Shutdown(drain.context())
+			if err != nil {
+				log.Warnf("Cancelled in-flight JSON-RPC requests: %v", err)
+			}
 			log.Info("JSON-RPC server shutdown")
 		}()
 	}
@@ -429,7 +561,8 @@ func run(ctx context.Context) error {
 			case cfg.Offline:
 				w.SetNetworkBackend(wallet.OfflineNetworkBackend{})
 			case cfg.SPV:
-				spvLoop(ctx, w)
+				amgrDir := filepath.Join(cfg.AppDataDir.Value, w.ChainParams().Name)
+				spvLoop(ctx, w, amgrDir)
 			default:
 				rpcSyncLoop(ctx, w)
 			}
@@ -483,7 +616,7 @@ func startPromptPass(ctx context.Context, w *wallet.Wallet) []byte {
 		fmt.Println("*****************")
 		promptPass = true
 	}
-	if cfg.EnableTicketBuyer {
+	if cfg.EnableTicketBuyer || cfg.TBOpts.Compound || cfg.TBOpts.Targets {
 		promptPass = true
 	}
 
@@ -522,9 +655,34 @@ func startPromptPass(ctx context.Context, w *wallet.Wallet) []byte {
 	}
 }
 
-func spvLoop(ctx context.Context, w *wallet.Wallet) {
+// vspRetryLoop periodically retries the fee payments of tickets which failed
+// to register with a VSP, until the context is cancelled.  The client of the
+// VSP selected with the setvsp RPC is created first so its tickets may be
+// retried.
+func vspRetryLoop(ctx context.Context, w *wallet.Wallet) {
+	_, _, err := w.SelectedVSP(ctx)
+	if err != nil && !errors.Is(err, errors.NotExist) {
+		log.Errorf("Unable to load selected VSP: %v", err)
+	}
+	ticker := time.NewTicker(vspRetryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		err := w.RetryVSPFeePayments(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Errorf("Retrying VSP fee payments failed: %v", err)
+		}
+	}
+}
+
+// spvLoop synchronizes a wallet with the network using SPV until the context
+// is cancelled, recording known peers in the address manager directory.
+func spvLoop(ctx context.Context, w *wallet.Wallet, amgrDir string) {
 	addr := &net.TCPAddr{IP: net.ParseIP("::1"), Port: 0}
-	amgrDir := filepath.Join(cfg.AppDataDir.Value, w.ChainParams().Name)
 	amgr := addrmgr.New(amgrDir)
 	for {
 		lp := p2p.NewLocalPeer(w.ChainParams(), addr, amgr)
@@ -534,6 +692,7 @@ func spvLoop(ctx context.Context, w *wallet.Wallet) {
 		if len(cfg.SPVConnect) > 0 {
 			syncer.SetPersistentPeers(cfg.SPVConnect)
 		}
+		syncer.SetRescanConcurrency(cfg.SPVRescanConcurrency)
 		err := syncer.Run(ctx)
 		if err == nil || done(ctx) {
 			loggers.SyncLog.Infof("SPV synchronization stopped")
@@ -569,6 +728,8 @@ func rpcSyncLoop(ctx context.Context, w *wallet.Wallet) {
 			Dial:        dial,
 			CA:          certs,
 			Insecure:    cfg.DisableClientTLS,
+
+			FeeEstimateTTL: cfg.FeeEstimateTTL,
 		}
 		if len(clientCert) != 0 {
 			rpcOptions.User = ""
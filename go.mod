@@ -14,6 +14,7 @@ require (
 	github.com/jrick/bitset v1.0.0
 	github.com/jrick/logrotate v1.0.0
 	github.com/jrick/wsrpc/v2 v2.3.8
+	github.com/mattn/go-sqlite3 v1.14.28
 	github.com/monetarium/monetarium-node/addrmgr v1.0.11
 	github.com/monetarium/monetarium-node/blockchain v1.0.11
 	github.com/monetarium/monetarium-node/blockchain/stake v1.0.11
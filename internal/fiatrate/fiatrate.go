@@ -0,0 +1,250 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package fiatrate provides exchange rate sources used to convert fiat
+// currency amounts into coin amounts at the time a transaction is created.
+package fiatrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+// Rate describes the price of one whole coin of a coin type in a fiat
+// currency, as reported by a rate source at a point in time.  The price is
+// kept as an exact rational so conversions do not lose precision.
+type Rate struct {
+	CoinType cointype.CoinType
+	Currency string
+	Price    *big.Rat
+	Time     time.Time
+	Source   string
+}
+
+// Source is implemented by exchange rate providers.
+type Source interface {
+	// Rate returns the current price of one coin of coinType denominated
+	// in the fiat currency.
+	Rate(ctx context.Context, coinType cointype.CoinType, currency string) (*Rate, error)
+}
+
+// ParseAmount parses a decimal fiat amount.  The amount must be a plain
+// decimal number greater than zero; fractions, exponents and non-finite
+// values are rejected.
+func ParseAmount(amount string) (*big.Rat, error) {
+	if !isDecimal(amount) {
+		return nil, errors.Errorf("invalid fiat amount %q", amount)
+	}
+	r, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return nil, errors.Errorf("invalid fiat amount %q", amount)
+	}
+	if r.Sign() <= 0 {
+		return nil, errors.Errorf("fiat amount %q is not positive", amount)
+	}
+	return r, nil
+}
+
+// isDecimal returns whether s consists of decimal digits with at most one
+// decimal point.
+func isDecimal(s string) bool {
+	digits, point := 0, false
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			digits++
+		case c == '.' && !point:
+			point = true
+		default:
+			return false
+		}
+	}
+	return digits > 0
+}
+
+// ToAtoms converts a fiat amount into the number of atoms of the rate's coin
+// type, given the number of atoms in one whole coin.  Fractions of an atom are
+// truncated.
+func (r *Rate) ToAtoms(fiatAmount *big.Rat, atomsPerCoin *big.Int) *big.Int {
+	q := new(big.Rat).Mul(fiatAmount, new(big.Rat).SetInt(atomsPerCoin))
+	q.Quo(q, r.Price)
+	return new(big.Int).Quo(q.Num(), q.Denom())
+}
+
+// Label returns an audit description of a conversion of the fiat amounts,
+// keyed by payment address, at this rate.  It is suitable for recording as a
+// transaction label.  Amounts are recorded exactly as provided by the user.
+func (r *Rate) Label(fiatAmounts map[string]string) string {
+	addrs := make([]string, 0, len(fiatAmounts))
+	for addr := range fiatAmounts {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	var b strings.Builder
+	for i, addr := range addrs {
+		if i != 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%s=%s", addr, fiatAmounts[addr])
+	}
+	return fmt.Sprintf("fiat %s %s @ %s %s/%s (source=%s time=%s)",
+		r.Currency, b.String(),
+		FormatPrice(r.Price), r.Currency, r.CoinType,
+		r.Source, r.Time.UTC().Format(time.RFC3339))
+}
+
+// FormatPrice formats a price as a decimal string without trailing zeros.
+// Prices which do not have a terminating decimal expansion are rounded to 18
+// decimal places.
+func FormatPrice(price *big.Rat) string {
+	s := price.FloatString(18)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// HTTPSource queries a rate from an HTTP endpoint returning a JSON object.
+//
+// The URL may contain the placeholders {currency} and {cointype}, which are
+// replaced by the lowercase currency code and the numeric coin type of each
+// request.  The response must be a JSON object containing the price as a
+// number (or numeric string) under the configured field, and may optionally
+// include a "timestamp" field in Unix seconds.  When no timestamp is reported,
+// the time of the request is used.
+type HTTPSource struct {
+	URL   string
+	Field string
+
+	client http.Client
+}
+
+// DefaultField is the JSON field read by an HTTPSource when none is
+// configured.
+const DefaultField = "rate"
+
+// NewHTTPSource returns a rate source that queries urlTemplate.  If dial is
+// non-nil, it is used to create all network connections.
+func NewHTTPSource(urlTemplate, field string, dial func(ctx context.Context, network, addr string) (net.Conn, error)) (*HTTPSource, error) {
+	const op errors.Op = "fiatrate.NewHTTPSource"
+
+	u, err := url.Parse(expandURL(urlTemplate, "usd", 0))
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("unsupported rate source scheme %q", u.Scheme))
+	}
+	if field == "" {
+		field = DefaultField
+	}
+
+	s := &HTTPSource{URL: urlTemplate, Field: field}
+	s.client.Timeout = 30 * time.Second
+	if dial != nil {
+		s.client.Transport = &http.Transport{DialContext: dial}
+	}
+	return s, nil
+}
+
+func expandURL(template, currency string, coinType cointype.CoinType) string {
+	r := strings.NewReplacer(
+		"{currency}", url.PathEscape(strings.ToLower(currency)),
+		"{cointype}", strconv.Itoa(int(coinType)),
+	)
+	return r.Replace(template)
+}
+
+// Rate implements the Source interface.
+func (s *HTTPSource) Rate(ctx context.Context, coinType cointype.CoinType, currency string) (*Rate, error) {
+	const op errors.Op = "fiatrate.Rate"
+
+	currency = strings.ToUpper(currency)
+	u := expandURL(s.URL, currency, coinType)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	req.Header.Set("Accept", "application/json")
+	requested := time.Now()
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.E(op, errors.IO,
+			errors.Errorf("rate source responded %v", resp.Status))
+	}
+
+	var fields map[string]json.RawMessage
+	err = json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&fields)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	n, err := decodeNumber(fields[s.Field])
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding,
+			errors.Errorf("field %q: %v", s.Field, err))
+	}
+	price, ok := new(big.Rat).SetString(n.String())
+	if !ok {
+		return nil, errors.E(op, errors.Encoding,
+			errors.Errorf("field %q: invalid number %q", s.Field, n))
+	}
+	if price.Sign() <= 0 {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("rate source returned non-positive price %v", n))
+	}
+	t := requested
+	if raw, ok := fields["timestamp"]; ok {
+		n, err := decodeNumber(raw)
+		if err != nil {
+			return nil, errors.E(op, errors.Encoding,
+				errors.Errorf("field \"timestamp\": %v", err))
+		}
+		ts, err := n.Float64()
+		if err != nil {
+			return nil, errors.E(op, errors.Encoding,
+				errors.Errorf("field \"timestamp\": %v", err))
+		}
+		t = time.Unix(int64(ts), 0)
+	}
+
+	return &Rate{
+		CoinType: coinType,
+		Currency: currency,
+		Price:    price,
+		Time:     t,
+		Source:   req.URL.Host,
+	}, nil
+}
+
+// decodeNumber decodes a JSON number, or a string containing a number.
+func decodeNumber(raw json.RawMessage) (json.Number, error) {
+	if raw == nil {
+		return "", errors.New("missing")
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err != nil {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return "", errors.New("not a number")
+		}
+		n = json.Number(s)
+	}
+	return n, nil
+}
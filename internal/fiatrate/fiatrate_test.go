@@ -0,0 +1,142 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package fiatrate
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+func TestHTTPSource(t *testing.T) {
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/price/usd/0":
+			w.Write([]byte(`{"rate": 2.5, "timestamp": 1700000000}`))
+		case "/price/eur/1":
+			w.Write([]byte(`{"last": "0.25"}`))
+		default:
+			w.Write([]byte(`{"rate": 0}`))
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	src, err := NewHTTPSource(srv.URL+"/price/{currency}/{cointype}", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := src.Rate(ctx, cointype.CoinTypeVAR, "usd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotPath != "/price/usd/0" {
+		t.Errorf("requested path %q", gotPath)
+	}
+	if r.Price.Cmp(big.NewRat(5, 2)) != 0 || r.Currency != "USD" ||
+		!r.Time.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("unexpected rate %+v", r)
+	}
+	label := r.Label(map[string]string{"b": "10", "a": "0.10"})
+	if !strings.HasPrefix(label, "fiat USD a=0.10, b=10 @ 2.5 USD/") ||
+		!strings.Contains(label, "2023-11-14T22:13:20Z") {
+		t.Errorf("unexpected label %q", label)
+	}
+
+	src.Field = "last"
+	r, err = src.Rate(ctx, 1, "EUR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Price.Cmp(big.NewRat(1, 4)) != 0 || r.CoinType != 1 {
+		t.Errorf("unexpected rate %+v", r)
+	}
+
+	src.Field = DefaultField
+	_, err = src.Rate(ctx, 2, "gbp")
+	if err == nil {
+		t.Errorf("non-positive price did not error")
+	}
+
+	_, err = NewHTTPSource("ftp://example.com/{currency}", "", nil)
+	if err == nil {
+		t.Errorf("unsupported scheme did not error")
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	valid := []string{"1", "0.01", "30.000000000000000001", "5."}
+	for _, s := range valid {
+		if _, err := ParseAmount(s); err != nil {
+			t.Errorf("ParseAmount(%q): %v", s, err)
+		}
+	}
+	invalid := []string{"", "0", "-1", "NaN", "Inf", "+Inf", "abc", "1.2.3",
+		"1e3", "1/3", "0x10", "1e400000000000", "."}
+	for _, s := range invalid {
+		if _, err := ParseAmount(s); err == nil {
+			t.Errorf("ParseAmount(%q) did not error", s)
+		}
+	}
+}
+
+func TestToAtoms(t *testing.T) {
+	ska, _ := new(big.Int).SetString("1000000000000000000", 10)
+	tests := []struct {
+		price        string
+		amount       string
+		atomsPerCoin *big.Int
+		want         string
+	}{
+		// 10 USD at 2.5 USD/VAR is exactly 4 VAR.
+		{"2.5", "10", big.NewInt(1e8), "400000000"},
+		// Fractions of an atom are truncated.
+		{"3", "1", big.NewInt(1e8), "33333333"},
+		// SKA conversions keep all 18 decimal places.
+		{"0.000003", "12345678.123456789123456789", ska,
+			"4115226041152263041152263000000"},
+		{"7", "1", ska, "142857142857142857"},
+	}
+	for i, test := range tests {
+		price, ok := new(big.Rat).SetString(test.price)
+		if !ok {
+			t.Fatalf("test %d: bad price", i)
+		}
+		amount, err := ParseAmount(test.amount)
+		if err != nil {
+			t.Fatalf("test %d: %v", i, err)
+		}
+		r := &Rate{Price: price}
+		got := r.ToAtoms(amount, test.atomsPerCoin)
+		if got.String() != test.want {
+			t.Errorf("test %d: got %v atoms, want %v", i, got, test.want)
+		}
+	}
+}
+
+func TestFormatPrice(t *testing.T) {
+	tests := []struct {
+		price *big.Rat
+		want  string
+	}{
+		{big.NewRat(5, 2), "2.5"},
+		{big.NewRat(100, 1), "100"},
+		{big.NewRat(1, 3), "0.333333333333333333"},
+	}
+	for _, test := range tests {
+		if got := FormatPrice(test.price); got != test.want {
+			t.Errorf("FormatPrice(%v) = %q, want %q", test.price, got, test.want)
+		}
+	}
+}
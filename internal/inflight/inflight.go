@@ -0,0 +1,92 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package inflight tracks the operations in progress which must complete
+// before a service is stopped.
+package inflight
+
+import (
+	"context"
+	"sync"
+)
+
+// Tracker counts the operations in progress.  Once the tracker is draining,
+// new operations begun with TryBegin are refused, and Drain waits for those
+// in progress to end.  The zero value is ready for use.
+type Tracker struct {
+	mu       sync.Mutex
+	n        int
+	draining bool
+	idle     chan struct{} // closed when no operations remain while draining
+}
+
+// TryBegin begins an operation unless the tracker is draining, and returns
+// whether it began.  Every operation which began must be ended with End.
+func (t *Tracker) TryBegin() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.draining {
+		return false
+	}
+	t.n++
+	return true
+}
+
+// Begin begins an operation even when the tracker is draining.  It is used
+// by steps which complete an operation already in progress, and which must
+// not be refused once earlier steps have been performed.
+func (t *Tracker) Begin() {
+	t.mu.Lock()
+	t.n++
+	t.mu.Unlock()
+}
+
+// End ends an operation.
+func (t *Tracker) End() {
+	t.mu.Lock()
+	t.n--
+	if t.n == 0 && t.idle != nil {
+		close(t.idle)
+		t.idle = nil
+	}
+	t.mu.Unlock()
+}
+
+// InFlight returns the number of operations in progress.
+func (t *Tracker) InFlight() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.n
+}
+
+// Refuse refuses operations begun with TryBegin from now on.
+func (t *Tracker) Refuse() {
+	t.mu.Lock()
+	t.draining = true
+	t.mu.Unlock()
+}
+
+// Drain refuses operations begun with TryBegin from now on, and waits for the
+// operations in progress to end.  If ctx is done first, its error is
+// returned.
+func (t *Tracker) Drain(ctx context.Context) error {
+	t.mu.Lock()
+	t.draining = true
+	if t.n == 0 {
+		t.mu.Unlock()
+		return nil
+	}
+	if t.idle == nil {
+		t.idle = make(chan struct{})
+	}
+	idle := t.idle
+	t.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package inflight
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTracker(t *testing.T) {
+	var tr Tracker
+	if !tr.TryBegin() {
+		t.Fatal("operation refused before draining")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	if err := tr.Drain(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("drain with operation in progress returned %v", err)
+	}
+	if tr.TryBegin() {
+		t.Fatal("operation began while draining")
+	}
+
+	// Operations in progress may be completed while draining.
+	tr.Begin()
+	tr.End()
+	if n := tr.InFlight(); n != 1 {
+		t.Fatalf("%d operations in progress, want 1", n)
+	}
+
+	done := make(chan error)
+	go func() { done <- tr.Drain(context.Background()) }()
+	tr.End()
+	if err := <-done; err != nil {
+		t.Fatalf("drain returned %v", err)
+	}
+	if err := tr.Drain(context.Background()); err != nil {
+		t.Fatalf("drain of idle tracker returned %v", err)
+	}
+
+	var refused Tracker
+	refused.Refuse()
+	if refused.TryBegin() {
+		t.Fatal("operation began after it was refused")
+	}
+}
@@ -13,14 +13,19 @@ import (
 
 	"github.com/monetarium/monetarium-wallet/errors"
 	"github.com/monetarium/monetarium-wallet/wallet"
-	_ "github.com/monetarium/monetarium-wallet/wallet/drivers/bdb" // driver loaded during init
+	_ "github.com/monetarium/monetarium-wallet/wallet/drivers/bdb"    // driver loaded during init
+	_ "github.com/monetarium/monetarium-wallet/wallet/drivers/sqlite" // driver loaded during init
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
 	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/dcrutil"
 )
 
 const (
 	walletDbName = "wallet.db"
-	driver       = "bdb"
+
+	// legacyDriver is the driver of imported dcrwallet databases, which
+	// are copied without changing their format.
+	legacyDriver = "bdb"
 )
 
 // Loader implements the creating of new and opening of existing wallets, while
@@ -48,15 +53,31 @@ type Loader struct {
 	relayFee                dcrutil.Amount
 	vspMaxFee               dcrutil.Amount
 	mixSplitLimit           int
+	readOnly                bool
+	dbDriver                string
 	dialer                  wallet.DialFunc
 
+	// Named wallets hosted by the default wallet's loader, and the
+	// functions run each time one is loaded.  A named wallet's loader
+	// records its name and the parent loader, and the context which is
+	// cancelled when its wallet is unloaded.
+	named          map[string]*Loader
+	namedCallbacks []func(context.Context, string, *wallet.Wallet)
+	name           string
+	parent         *Loader
+	cancelLoaded   context.CancelFunc
+
 	mu sync.Mutex
 }
 
-// NewLoader constructs a Loader.
+// NewLoader constructs a Loader.  When readOnly is set, wallets are opened
+// with read-only databases and may not be created or restored.  New wallets
+// are created with the database driver dbDriver, while existing wallets are
+// opened with the driver of their database file.
 func NewLoader(chainParams *chaincfg.Params, dbDirPath string, votingEnabled bool, gapLimit uint32,
 	watchLast uint32, allowHighFees bool, relayFee dcrutil.Amount, vspMaxFee dcrutil.Amount, accountGapLimit int,
-	disableCoinTypeUpgrades bool, mixingEnabled bool, manualTickets bool, mixSplitLimit int, dialer wallet.DialFunc) *Loader {
+	disableCoinTypeUpgrades bool, mixingEnabled bool, manualTickets bool, mixSplitLimit int, readOnly bool,
+	dbDriver string, dialer wallet.DialFunc) *Loader {
 
 	return &Loader{
 		chainParams:             chainParams,
@@ -72,10 +93,16 @@ func NewLoader(chainParams *chaincfg.Params, dbDirPath string, votingEnabled boo
 		relayFee:                relayFee,
 		vspMaxFee:               vspMaxFee,
 		mixSplitLimit:           mixSplitLimit,
+		readOnly:                readOnly,
+		dbDriver:                dbDriver,
 		dialer:                  dialer,
 	}
 }
 
+// errReadOnly is returned when creating or restoring a wallet with a loader
+// which opens wallets read-only.
+var errReadOnly = errors.E(errors.Invalid, "wallets may not be created in read-only mode")
+
 // onLoaded executes each added callback and prevents loader from loading any
 // additional wallets.  Requires mutex to be locked.
 func (l *Loader) onLoaded(w *wallet.Wallet, db wallet.DB) {
@@ -86,6 +113,17 @@ func (l *Loader) onLoaded(w *wallet.Wallet, db wallet.DB) {
 	l.wallet = w
 	l.db = db
 	l.callbacks = nil // not needed anymore
+
+	if l.parent != nil {
+		var ctx context.Context
+		ctx, l.cancelLoaded = context.WithCancel(context.Background())
+		l.parent.mu.Lock()
+		callbacks := l.parent.namedCallbacks
+		l.parent.mu.Unlock()
+		for _, fn := range callbacks {
+			fn(ctx, l.name, w)
+		}
+	}
 }
 
 // RunAfterLoad adds a function to be executed when the loader creates or opens
@@ -104,10 +142,17 @@ func (l *Loader) RunAfterLoad(fn func(*wallet.Wallet)) {
 }
 
 // CreateWatchingOnlyWallet creates a new watch-only wallet using the provided
-// extended public key and public passphrase.
-func (l *Loader) CreateWatchingOnlyWallet(ctx context.Context, extendedPubKey string, pubPass []byte) (w *wallet.Wallet, err error) {
+// extended public key and public passphrase.  Options may be provided to watch
+// additional account extended public keys.
+func (l *Loader) CreateWatchingOnlyWallet(ctx context.Context, extendedPubKey string, pubPass []byte,
+	opts ...wallet.WatchOnlyOption) (w *wallet.Wallet, err error) {
+
 	const op errors.Op = "loader.CreateWatchingOnlyWallet"
 
+	if l.readOnly {
+		return nil, errors.E(op, errReadOnly)
+	}
+
 	defer l.mu.Unlock()
 	l.mu.Lock()
 
@@ -149,18 +194,18 @@ func (l *Loader) CreateWatchingOnlyWallet(ctx context.Context, extendedPubKey st
 		}
 	}()
 
-	// Create the wallet database backed by bolt db.
+	// Create the wallet database with the configured driver.
 	err = os.MkdirAll(l.dbDirPath, 0700)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
-	db, err := wallet.CreateDB(driver, dbPath)
+	db, err := wallet.CreateDB(l.dbDriver, dbPath)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
 
 	// Initialize the watch-only database for the wallet before opening.
-	err = wallet.CreateWatchOnly(ctx, db, extendedPubKey, pubPass, l.chainParams)
+	err = wallet.CreateWatchOnly(ctx, db, extendedPubKey, pubPass, l.chainParams, opts...)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -181,6 +226,7 @@ func (l *Loader) CreateWatchingOnlyWallet(ctx context.Context, extendedPubKey st
 		VSPMaxFee:               l.vspMaxFee,
 		MixSplitLimit:           l.mixSplitLimit,
 		Params:                  l.chainParams,
+		MigrationBackupDir:      l.dbDirPath,
 		Dialer:                  l.dialer,
 	}
 	w, err = wallet.Open(ctx, cfg)
@@ -198,6 +244,10 @@ func (l *Loader) CreateWatchingOnlyWallet(ctx context.Context, extendedPubKey st
 func (l *Loader) CreateNewWallet(ctx context.Context, pubPassphrase, privPassphrase, seed []byte) (w *wallet.Wallet, err error) {
 	const op errors.Op = "loader.CreateNewWallet"
 
+	if l.readOnly {
+		return nil, errors.E(op, errReadOnly)
+	}
+
 	defer l.mu.Unlock()
 	l.mu.Lock()
 
@@ -239,12 +289,12 @@ func (l *Loader) CreateNewWallet(ctx context.Context, pubPassphrase, privPassphr
 		}
 	}()
 
-	// Create the wallet database backed by bolt db.
+	// Create the wallet database with the configured driver.
 	err = os.MkdirAll(l.dbDirPath, 0700)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
-	db, err := wallet.CreateDB(driver, dbPath)
+	db, err := wallet.CreateDB(l.dbDriver, dbPath)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -295,10 +345,14 @@ func (l *Loader) OpenExistingWallet(ctx context.Context, pubPassphrase []byte) (
 		return nil, errors.E(op, errors.Exist, "wallet already opened")
 	}
 
-	// Open the database using the boltdb backend.
+	// Open the database using the driver of the database file.
 	dbPath := filepath.Join(l.dbDirPath, walletDbName)
 	l.mu.Unlock()
-	db, err := wallet.OpenDB(driver, dbPath)
+	var db wallet.DB
+	driver, err := wallet.DBDriver(dbPath)
+	if err == nil {
+		db, err = wallet.OpenDB(driver, dbPath, l.readOnly)
+	}
 	l.mu.Lock()
 
 	if err != nil {
@@ -330,6 +384,7 @@ func (l *Loader) OpenExistingWallet(ctx context.Context, pubPassphrase []byte) (
 		VSPMaxFee:               l.vspMaxFee,
 		MixSplitLimit:           l.mixSplitLimit,
 		Params:                  l.chainParams,
+		ReadOnly:                l.readOnly,
 		Dialer:                  l.dialer,
 	}
 	w, err = wallet.Open(ctx, cfg)
@@ -341,6 +396,105 @@ func (l *Loader) OpenExistingWallet(ctx context.Context, pubPassphrase []byte) (
 	return w, nil
 }
 
+// ReadOnly returns whether wallets are opened read-only.
+func (l *Loader) ReadOnly() bool {
+	return l.readOnly
+}
+
+// RestoreWallet restores the wallet database from an encrypted backup written
+// by wallet.ExportEncryptedBackup and opens the restored wallet with the public
+// passphrase.  No wallet may exist at the loader's database path.  The
+// restored database is kept if it cannot be opened, so opening may be retried.
+func (l *Loader) RestoreWallet(ctx context.Context, backupPath string, passphrase, pubPassphrase []byte) (*wallet.Wallet, error) {
+	const op errors.Op = "loader.RestoreWallet"
+
+	if l.readOnly {
+		return nil, errors.E(op, errReadOnly)
+	}
+
+	l.mu.Lock()
+	if l.wallet != nil {
+		l.mu.Unlock()
+		return nil, errors.E(op, errors.Exist, "wallet already loaded")
+	}
+	dbPath := filepath.Join(l.dbDirPath, walletDbName)
+	err := wallet.RestoreEncryptedBackup(backupPath, dbPath, passphrase)
+	l.mu.Unlock()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	w, err := l.OpenExistingWallet(ctx, pubPassphrase)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return w, nil
+}
+
+// ImportLegacyWallet imports an upstream dcrwallet database, verifies that
+// the imported database records its accounts, transactions, and balance, and
+// opens the imported wallet with the public passphrase.  No wallet may exist
+// at the loader's database path.  The legacy database is not modified.
+func (l *Loader) ImportLegacyWallet(ctx context.Context, legacyPath string,
+	pubPassphrase []byte) (*wallet.Wallet, *wallet.LegacyImport, error) {
+
+	const op errors.Op = "loader.ImportLegacyWallet"
+
+	if l.readOnly {
+		return nil, nil, errors.E(op, errReadOnly)
+	}
+
+	l.mu.Lock()
+	if l.wallet != nil {
+		l.mu.Unlock()
+		return nil, nil, errors.E(op, errors.Exist, "wallet already loaded")
+	}
+	dbPath := filepath.Join(l.dbDirPath, walletDbName)
+	imp, err := wallet.ImportLegacyDB(ctx, legacyDriver, legacyPath, dbPath,
+		pubPassphrase, l.chainParams)
+	l.mu.Unlock()
+	if err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+
+	w, err := l.OpenExistingWallet(ctx, pubPassphrase)
+	if err != nil {
+		return nil, imp, errors.E(op, err)
+	}
+	return w, imp, nil
+}
+
+// DryRunUpgrade reports the database upgrades which will be performed when
+// the wallet is opened, after verifying that each upgrade succeeds without
+// committing any changes to the database.
+func (l *Loader) DryRunUpgrade(ctx context.Context, pubPassphrase []byte) ([]udb.Migration, error) {
+	const op errors.Op = "loader.DryRunUpgrade"
+
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	if l.wallet != nil {
+		return nil, errors.E(op, errors.Exist, "wallet already opened")
+	}
+
+	dbPath := filepath.Join(l.dbDirPath, walletDbName)
+	driver, err := wallet.DBDriver(dbPath)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	db, err := wallet.OpenDB(driver, dbPath)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer db.Close()
+
+	migrations, err := wallet.DryRunUpgrade(ctx, db, pubPassphrase, l.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return migrations, nil
+}
+
 // DbDirPath returns the Loader's database directory path
 func (l *Loader) DbDirPath() string {
 	return l.dbDirPath
@@ -382,6 +536,10 @@ func (l *Loader) UnloadWallet() error {
 		return errors.E(op, errors.Invalid, "wallet is unopened")
 	}
 
+	if l.cancelLoaded != nil {
+		l.cancelLoaded()
+		l.cancelLoaded = nil
+	}
 	err := l.db.Close()
 	if err != nil {
 		return errors.E(op, err)
@@ -392,6 +550,37 @@ func (l *Loader) UnloadWallet() error {
 	return nil
 }
 
+// Drain drains the loaded default and named wallets, refusing new transaction
+// authoring and publishing and waiting for those in progress to complete
+// before the wallets are unloaded.  Draining stops waiting when ctx is done.
+// The wallets remain loaded.
+func (l *Loader) Drain(ctx context.Context) error {
+	const op errors.Op = "loader.Drain"
+
+	l.mu.Lock()
+	loaders := make([]*Loader, 0, len(l.named)+1)
+	loaders = append(loaders, l)
+	for _, nl := range l.named {
+		loaders = append(loaders, nl)
+	}
+	l.mu.Unlock()
+
+	var firstErr error
+	for _, dl := range loaders {
+		w, ok := dl.LoadedWallet()
+		if !ok {
+			continue
+		}
+		if err := w.Drain(ctx); err != nil && firstErr == nil {
+			if dl.name != "" {
+				err = errors.Errorf("wallet %q: %v", dl.name, err)
+			}
+			firstErr = errors.E(op, err)
+		}
+	}
+	return firstErr
+}
+
 // NetworkBackend returns the associated wallet network backend, if any, and a
 // bool describing whether a non-nil network backend was set.
 func (l *Loader) NetworkBackend() (n wallet.NetworkBackend, ok bool) {
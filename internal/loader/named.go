@@ -0,0 +1,190 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loader
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet"
+)
+
+// walletsDirName is the directory, under the default wallet's database
+// directory, which holds the database directory of each named wallet.
+const walletsDirName = "wallets"
+
+// maxWalletNameLen is the maximum length of a named wallet's name.
+const maxWalletNameLen = 64
+
+// ValidWalletName returns whether a name may name a wallet hosted alongside
+// the default wallet.  Names are between 1 and 64 characters of ASCII letters,
+// digits, hyphens, underscores, and periods, and may not begin with a period.
+func ValidWalletName(name string) bool {
+	if len(name) == 0 || len(name) > maxWalletNameLen || name[0] == '.' {
+		return false
+	}
+	for _, c := range []byte(name) {
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		case c == '-', c == '_', c == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// NamedLoader returns the loader of a named wallet hosted in the same process
+// as the default wallet, creating the loader if it does not exist.  The named
+// wallet's database is kept in its own directory under the default wallet's
+// database directory, and the wallet is created, opened, and unloaded with
+// the returned loader independently of the default and other named wallets.
+// Each named wallet is a separate wallet.Wallet, so it has its own passphrase
+// and lock state, and its own NotificationServer.
+//
+// Named loaders may only be created by the default wallet's loader.  Errors
+// with code Invalid are returned for invalid names.
+func (l *Loader) NamedLoader(name string) (*Loader, error) {
+	const op errors.Op = "loader.NamedLoader"
+
+	if l.parent != nil {
+		return nil, errors.E(op, errors.Invalid, "named wallets may not host named wallets")
+	}
+	if !ValidWalletName(name) {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("invalid wallet name %q", name))
+	}
+
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	if nl, ok := l.named[name]; ok {
+		return nl, nil
+	}
+	nl := &Loader{
+		chainParams:             l.chainParams,
+		dbDirPath:               filepath.Join(l.dbDirPath, walletsDirName, name),
+		votingEnabled:           l.votingEnabled,
+		gapLimit:                l.gapLimit,
+		watchLast:               l.watchLast,
+		accountGapLimit:         l.accountGapLimit,
+		disableCoinTypeUpgrades: l.disableCoinTypeUpgrades,
+		mixingEnabled:           l.mixingEnabled,
+		allowHighFees:           l.allowHighFees,
+		manualTickets:           l.manualTickets,
+		relayFee:                l.relayFee,
+		vspMaxFee:               l.vspMaxFee,
+		mixSplitLimit:           l.mixSplitLimit,
+		readOnly:                l.readOnly,
+		dbDriver:                l.dbDriver,
+		dialer:                  l.dialer,
+		name:                    name,
+		parent:                  l,
+	}
+	if l.named == nil {
+		l.named = make(map[string]*Loader)
+	}
+	l.named[name] = nl
+	return nl, nil
+}
+
+// Named returns the loader of a named wallet previously returned by
+// NamedLoader, and whether it exists.
+func (l *Loader) Named(name string) (*Loader, bool) {
+	l.mu.Lock()
+	nl, ok := l.named[name]
+	l.mu.Unlock()
+	return nl, ok
+}
+
+// Name returns the name of a named wallet's loader, or the empty string for
+// the default wallet's loader.
+func (l *Loader) Name() string {
+	return l.name
+}
+
+// RunAfterNamedLoad adds a function to be executed each time a named wallet
+// is created or opened, after any functions added to the named wallet's
+// loader by RunAfterLoad.  The context is cancelled when the wallet is
+// unloaded, and should be used to stop services started for the wallet, such
+// as its network synchronization.  Functions are executed in the order they
+// are added, and must not block.
+func (l *Loader) RunAfterNamedLoad(fn func(ctx context.Context, name string, w *wallet.Wallet)) {
+	l.mu.Lock()
+	l.namedCallbacks = append(l.namedCallbacks, fn)
+	l.mu.Unlock()
+}
+
+// NamedWallets returns the sorted names of the named wallets with databases
+// in the default wallet's database directory, whether or not they are loaded.
+func (l *Loader) NamedWallets() ([]string, error) {
+	const op errors.Op = "loader.NamedWallets"
+
+	entries, err := os.ReadDir(filepath.Join(l.dbDirPath, walletsDirName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() || !ValidWalletName(e.Name()) {
+			continue
+		}
+		dbPath := filepath.Join(l.dbDirPath, walletsDirName, e.Name(), walletDbName)
+		exists, err := fileExists(dbPath)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if exists {
+			names = append(names, e.Name())
+		}
+	}
+	return names, nil
+}
+
+// LoadedNamedWallets returns the sorted names of the loaded named wallets.
+func (l *Loader) LoadedNamedWallets() []string {
+	l.mu.Lock()
+	loaders := make([]*Loader, 0, len(l.named))
+	for _, nl := range l.named {
+		loaders = append(loaders, nl)
+	}
+	l.mu.Unlock()
+
+	var names []string
+	for _, nl := range loaders {
+		if _, ok := nl.LoadedWallet(); ok {
+			names = append(names, nl.name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// UnloadNamedWallets unloads every loaded named wallet.  The first error
+// unloading a wallet is returned after attempting to unload the others.
+func (l *Loader) UnloadNamedWallets() error {
+	const op errors.Op = "loader.UnloadNamedWallets"
+
+	l.mu.Lock()
+	loaders := make([]*Loader, 0, len(l.named))
+	for _, nl := range l.named {
+		loaders = append(loaders, nl)
+	}
+	l.mu.Unlock()
+
+	var firstErr error
+	for _, nl := range loaders {
+		err := nl.UnloadWallet()
+		if err != nil && !errors.Is(err, errors.Invalid) && firstErr == nil {
+			firstErr = errors.E(op, errors.Errorf("wallet %q: %v", nl.name, err))
+		}
+	}
+	return firstErr
+}
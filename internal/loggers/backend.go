@@ -0,0 +1,258 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loggers
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/decred/slog"
+	"github.com/monetarium/monetarium-node/crypto/rand"
+)
+
+// Backend flags, read from the comma separated LOGFLAGS environment variable
+// as by the slog package.
+const (
+	flagLongFile = 1 << iota
+	flagShortFile
+	flagUTC
+	flagNoDateTime
+)
+
+func envFlags() uint32 {
+	var flags uint32
+	for _, f := range strings.Split(os.Getenv("LOGFLAGS"), ",") {
+		switch f {
+		case "longfile":
+			flags |= flagLongFile
+		case "shortfile":
+			flags |= flagShortFile
+		case "UTC":
+			flags |= flagUTC
+		case "nodatetime":
+			flags |= flagNoDateTime
+		}
+	}
+	return flags
+}
+
+// Backend writes the records of subsystem loggers as lines of text formatted
+// like the slog package, or as JSON objects.
+type Backend struct {
+	mu    sync.Mutex
+	w     io.Writer
+	flags uint32
+	json  atomic.Bool
+}
+
+// NewBackend creates a backend writing text records to w.
+func NewBackend(w io.Writer) *Backend {
+	return &Backend{w: w, flags: envFlags()}
+}
+
+// SetJSON sets whether records are written as JSON objects rather than text.
+func (b *Backend) SetJSON(json bool) {
+	b.json.Store(json)
+}
+
+// Logger returns a logger for the subsystem writing to the backend.  The
+// logger uses the info level by default.
+func (b *Backend) Logger(subsystem string) *Logger {
+	l := &Logger{b: b, tag: subsystem, level: new(atomic.Uint32)}
+	l.level.Store(uint32(slog.LevelInfo))
+	return l
+}
+
+// jsonRecord is the JSON encoding of a record.
+type jsonRecord struct {
+	Time      string `json:"time,omitempty"`
+	Level     string `json:"level"`
+	Subsystem string `json:"subsystem"`
+	Request   string `json:"request,omitempty"`
+	Caller    string `json:"caller,omitempty"`
+	Message   string `json:"msg"`
+}
+
+var jsonLevels = [...]string{"trace", "debug", "info", "warn", "error", "critical"}
+
+// write writes a record of the message.  It must only be called by the print
+// and printf methods of a Logger, called by the logging methods of the Logger
+// interface, for the callsite to be recorded.
+func (b *Backend) write(lvl slog.Level, tag, request, msg string) {
+	var t time.Time
+	if b.flags&flagNoDateTime == 0 {
+		t = time.Now()
+		if b.flags&flagUTC != 0 {
+			t = t.UTC()
+		}
+	}
+	var caller string
+	if b.flags&(flagShortFile|flagLongFile) != 0 {
+		file, line := "???", 0
+		if _, f, l, ok := runtime.Caller(3); ok {
+			file, line = f, l
+			if b.flags&flagShortFile != 0 {
+				file = file[strings.LastIndexAny(file, `/\`)+1:]
+			}
+		}
+		caller = file + ":" + strconv.Itoa(line)
+	}
+
+	var buf []byte
+	if b.json.Load() {
+		r := &jsonRecord{
+			Level:     jsonLevels[lvl],
+			Subsystem: tag,
+			Request:   request,
+			Caller:    caller,
+			Message:   msg,
+		}
+		if !t.IsZero() {
+			r.Time = t.Format(time.RFC3339Nano)
+		}
+		// Encoding strings does not error.
+		buf, _ = json.Marshal(r)
+	} else {
+		if !t.IsZero() {
+			buf = t.AppendFormat(buf, "2006-01-02 15:04:05.000 ")
+		}
+		buf = append(buf, '[')
+		buf = append(buf, lvl.String()...)
+		buf = append(buf, "] "...)
+		buf = append(buf, tag...)
+		if caller != "" {
+			buf = append(buf, ' ')
+			buf = append(buf, caller...)
+		}
+		if request != "" {
+			buf = append(buf, " ["...)
+			buf = append(buf, request...)
+			buf = append(buf, ']')
+		}
+		buf = append(buf, ": "...)
+		buf = append(buf, msg...)
+	}
+	buf = append(buf, '\n')
+
+	b.mu.Lock()
+	b.w.Write(buf)
+	b.mu.Unlock()
+}
+
+// Logger is a subsystem logger implementing the slog.Logger interface.
+// Loggers returned by WithContext share the level of their parent.
+type Logger struct {
+	b       *Backend
+	tag     string
+	level   *atomic.Uint32
+	request string
+}
+
+// WithContext returns a logger recording the request ID of the context, if
+// any, with every record.
+func (l *Logger) WithContext(ctx context.Context) slog.Logger {
+	id := RequestID(ctx)
+	if id == "" || id == l.request {
+		return l
+	}
+	c := *l
+	c.request = id
+	return &c
+}
+
+func (l *Logger) print(lvl slog.Level, args []any) {
+	if lvl >= l.Level() {
+		msg := fmt.Sprintln(args...)
+		l.b.write(lvl, l.tag, l.request, msg[:len(msg)-1])
+	}
+}
+
+func (l *Logger) printf(lvl slog.Level, format string, args []any) {
+	if lvl >= l.Level() {
+		l.b.write(lvl, l.tag, l.request, fmt.Sprintf(format, args...))
+	}
+}
+
+// The logging methods each call print or printf, so the callsite is at a fixed
+// depth from Backend.write.
+
+// Trace logs the operands at the trace level.
+func (l *Logger) Trace(args ...any) { l.print(slog.LevelTrace, args) }
+
+// Tracef logs the formatted message at the trace level.
+func (l *Logger) Tracef(format string, args ...any) { l.printf(slog.LevelTrace, format, args) }
+
+// Debug logs the operands at the debug level.
+func (l *Logger) Debug(args ...any) { l.print(slog.LevelDebug, args) }
+
+// Debugf logs the formatted message at the debug level.
+func (l *Logger) Debugf(format string, args ...any) { l.printf(slog.LevelDebug, format, args) }
+
+// Info logs the operands at the info level.
+func (l *Logger) Info(args ...any) { l.print(slog.LevelInfo, args) }
+
+// Infof logs the formatted message at the info level.
+func (l *Logger) Infof(format string, args ...any) { l.printf(slog.LevelInfo, format, args) }
+
+// Warn logs the operands at the warn level.
+func (l *Logger) Warn(args ...any) { l.print(slog.LevelWarn, args) }
+
+// Warnf logs the formatted message at the warn level.
+func (l *Logger) Warnf(format string, args ...any) { l.printf(slog.LevelWarn, format, args) }
+
+// Error logs the operands at the error level.
+func (l *Logger) Error(args ...any) { l.print(slog.LevelError, args) }
+
+// Errorf logs the formatted message at the error level.
+func (l *Logger) Errorf(format string, args ...any) { l.printf(slog.LevelError, format, args) }
+
+// Critical logs the operands at the critical level.
+func (l *Logger) Critical(args ...any) { l.print(slog.LevelCritical, args) }
+
+// Criticalf logs the formatted message at the critical level.
+func (l *Logger) Criticalf(format string, args ...any) { l.printf(slog.LevelCritical, format, args) }
+
+// Level returns the level of the logger.
+func (l *Logger) Level() slog.Level {
+	return slog.Level(l.level.Load())
+}
+
+// SetLevel sets the level of the logger and the loggers derived from it.
+func (l *Logger) SetLevel(level slog.Level) {
+	l.level.Store(uint32(level))
+}
+
+type requestIDKey struct{}
+
+// NewRequestID returns a random ID correlating the records logged while
+// handling a request.
+func NewRequestID() string {
+	var b [8]byte
+	rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// WithRequestID returns a context recording the request ID, which is included
+// in the records of loggers returned by WithContext.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID recorded by the context, or the empty
+// string.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loggers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/decred/slog"
+)
+
+func TestBackend(t *testing.T) {
+	var buf bytes.Buffer
+	b := &Backend{w: &buf, flags: flagNoDateTime | flagShortFile}
+	l := b.Logger("TEST")
+
+	l.Debugf("filtered %d", 1)
+	l.Infof("plain %d", 1)
+	ctx := WithRequestID(context.Background(), "abcd")
+	l.WithContext(ctx).Warn("with", "request")
+	want := "[INF] TEST backend_test.go:23: plain 1\n" +
+		"[WRN] TEST backend_test.go:25 [abcd]: with request\n"
+	if got := buf.String(); got != want {
+		t.Errorf("text records:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Derived loggers share the level of their parent.
+	buf.Reset()
+	b.SetJSON(true)
+	l.SetLevel(slog.LevelDebug)
+	l.WithContext(ctx).Debugf("quoted \"%s\"", "value")
+	var r jsonRecord
+	if err := json.Unmarshal(buf.Bytes(), &r); err != nil {
+		t.Fatal(err)
+	}
+	want2 := jsonRecord{Level: "debug", Subsystem: "TEST", Request: "abcd",
+		Caller: "backend_test.go:36", Message: `quoted "value"`}
+	if r != want2 || !strings.HasSuffix(buf.String(), "}\n") {
+		t.Errorf("JSON record %q, want %+v", buf.String(), want2)
+	}
+}
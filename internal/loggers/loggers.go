@@ -9,7 +9,6 @@ import (
 	"os"
 	"path/filepath"
 
-	"github.com/decred/slog"
 	"github.com/jrick/logrotate/rotator"
 )
 
@@ -37,7 +36,7 @@ var (
 	// backendLog is the logging backend used to create all subsystem loggers.
 	// The backend must not be used before the log rotator has been initialized,
 	// or data races and/or nil pointer dereferences will occur.
-	backendLog = slog.NewBackend(logWriter{})
+	backendLog = NewBackend(logWriter{})
 
 	// logRotator is one of the logging outputs.  It should be closed on
 	// application shutdown.
@@ -57,6 +56,20 @@ var (
 	VspcLog    = backendLog.Logger("VSPC")
 )
 
+// SetFormat sets the format of the records written by all subsystem loggers,
+// which is either "text" or "json".
+func SetFormat(format string) error {
+	switch format {
+	case "text":
+		backendLog.SetJSON(false)
+	case "json":
+		backendLog.SetJSON(true)
+	default:
+		return fmt.Errorf("unknown log format %q", format)
+	}
+	return nil
+}
+
 // InitLogRotator initializes the logging rotater to write logs to logFile and
 // create roll files in the same directory.  logSize is the size in KiB after
 // which a log file will be rotated and compressed.
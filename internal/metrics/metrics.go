@@ -0,0 +1,367 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package metrics implements counters, gauges and histograms exposed to
+// Prometheus in its text exposition format.
+//
+// Metrics are created and registered when a package is initialized, and are
+// cheap to update whether or not they are ever served.  Values which are
+// expensive to maintain, such as balances, are instead set by collectors
+// registered with RegisterCollector, which are called before each scrape.
+package metrics
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// collectTimeout limits the time collectors may take during a scrape.
+const collectTimeout = 10 * time.Second
+
+// DefaultBuckets are the upper bounds, in seconds, of the buckets of latency
+// histograms.
+var DefaultBuckets = []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30}
+
+type metric interface {
+	name() string
+	write(w *bufio.Writer)
+}
+
+var registry struct {
+	mu         sync.Mutex
+	metrics    []metric
+	collectors []func(context.Context)
+}
+
+func register(m metric) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	for _, r := range registry.metrics {
+		if r.name() == m.name() {
+			panic("metrics: duplicate metric " + m.name())
+		}
+	}
+	registry.metrics = append(registry.metrics, m)
+}
+
+// RegisterCollector registers a function called before each scrape to update
+// gauges from the current state of the process.
+func RegisterCollector(f func(ctx context.Context)) {
+	registry.mu.Lock()
+	registry.collectors = append(registry.collectors, f)
+	registry.mu.Unlock()
+}
+
+// float is a float64 updated atomically.
+type float struct {
+	bits atomic.Uint64
+}
+
+func (f *float) load() float64 {
+	return math.Float64frombits(f.bits.Load())
+}
+
+func (f *float) store(v float64) {
+	f.bits.Store(math.Float64bits(v))
+}
+
+func (f *float) add(v float64) {
+	for {
+		old := f.bits.Load()
+		n := math.Float64bits(math.Float64frombits(old) + v)
+		if f.bits.CompareAndSwap(old, n) {
+			return
+		}
+	}
+}
+
+type desc struct {
+	metricName string
+	help       string
+	typ        string
+}
+
+func (d *desc) name() string { return d.metricName }
+
+func (d *desc) writeHeader(w *bufio.Writer) {
+	help := strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(d.help)
+	w.WriteString("# HELP " + d.metricName + " " + help + "\n")
+	w.WriteString("# TYPE " + d.metricName + " " + d.typ + "\n")
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func writeSample(w *bufio.Writer, name, labels string, v float64) {
+	w.WriteString(name)
+	if labels != "" {
+		w.WriteString("{" + labels + "}")
+	}
+	w.WriteString(" " + formatFloat(v) + "\n")
+}
+
+func label(name, value string) string {
+	value = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+	return name + `="` + value + `"`
+}
+
+// Counter is a value which only increases.
+type Counter struct {
+	desc
+	v float
+}
+
+// NewCounter creates and registers a counter.
+func NewCounter(name, help string) *Counter {
+	c := &Counter{desc: desc{name, help, "counter"}}
+	register(c)
+	return c
+}
+
+// Inc increments the counter.
+func (c *Counter) Inc() { c.v.add(1) }
+
+// Add adds a non-negative value to the counter.
+func (c *Counter) Add(v float64) {
+	if v > 0 {
+		c.v.add(v)
+	}
+}
+
+func (c *Counter) write(w *bufio.Writer) {
+	c.writeHeader(w)
+	writeSample(w, c.metricName, "", c.v.load())
+}
+
+// Gauge is a value which may increase and decrease.
+type Gauge struct {
+	desc
+	v float
+}
+
+// NewGauge creates and registers a gauge.
+func NewGauge(name, help string) *Gauge {
+	g := &Gauge{desc: desc{name, help, "gauge"}}
+	register(g)
+	return g
+}
+
+// Set sets the value of the gauge.
+func (g *Gauge) Set(v float64) { g.v.store(v) }
+
+// Add adds a value, which may be negative, to the gauge.
+func (g *Gauge) Add(v float64) { g.v.add(v) }
+
+func (g *Gauge) write(w *bufio.Writer) {
+	g.writeHeader(w)
+	writeSample(w, g.metricName, "", g.v.load())
+}
+
+// vec holds the values of a metric partitioned by the value of a label.
+type vec[T any] struct {
+	desc
+	label string
+	mu    sync.Mutex
+	m     map[string]*T
+}
+
+func (v *vec[T]) with(value string) *T {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	t, ok := v.m[value]
+	if !ok {
+		t = new(T)
+		v.m[value] = t
+	}
+	return t
+}
+
+// each calls f with each label value in sorted order.
+func (v *vec[T]) each(f func(labels string, t *T)) {
+	v.mu.Lock()
+	values := make([]string, 0, len(v.m))
+	for value := range v.m {
+		values = append(values, value)
+	}
+	v.mu.Unlock()
+	slices.Sort(values)
+	for _, value := range values {
+		f(label(v.label, value), v.with(value))
+	}
+}
+
+// CounterVec is a counter partitioned by the value of a label.
+type CounterVec struct {
+	vec[float]
+}
+
+// NewCounterVec creates and registers a counter partitioned by the value of
+// the named label.
+func NewCounterVec(name, help, label string) *CounterVec {
+	c := &CounterVec{vec[float]{desc: desc{name, help, "counter"}, label: label,
+		m: make(map[string]*float)}}
+	register(c)
+	return c
+}
+
+// Inc increments the counter with the label value.
+func (c *CounterVec) Inc(value string) { c.with(value).add(1) }
+
+// Add adds a non-negative value to the counter with the label value.
+func (c *CounterVec) Add(value string, v float64) {
+	if v > 0 {
+		c.with(value).add(v)
+	}
+}
+
+func (c *CounterVec) write(w *bufio.Writer) {
+	c.writeHeader(w)
+	c.each(func(labels string, f *float) {
+		writeSample(w, c.metricName, labels, f.load())
+	})
+}
+
+// GaugeVec is a gauge partitioned by the value of a label.
+type GaugeVec struct {
+	vec[float]
+}
+
+// NewGaugeVec creates and registers a gauge partitioned by the value of the
+// named label.
+func NewGaugeVec(name, help, label string) *GaugeVec {
+	g := &GaugeVec{vec[float]{desc: desc{name, help, "gauge"}, label: label,
+		m: make(map[string]*float)}}
+	register(g)
+	return g
+}
+
+// Set sets the value of the gauge with the label value.
+func (g *GaugeVec) Set(value string, v float64) { g.with(value).store(v) }
+
+func (g *GaugeVec) write(w *bufio.Writer) {
+	g.writeHeader(w)
+	g.each(func(labels string, f *float) {
+		writeSample(w, g.metricName, labels, f.load())
+	})
+}
+
+// histogram counts observations in buckets of their upper bounds.
+type histogram struct {
+	once   sync.Once
+	counts []atomic.Uint64
+	count  atomic.Uint64
+	sum    float
+}
+
+func (h *histogram) observe(buckets []float64, v float64) {
+	h.once.Do(func() { h.counts = make([]atomic.Uint64, len(buckets)) })
+	for i, upper := range buckets {
+		if v <= upper {
+			h.counts[i].Add(1)
+			break
+		}
+	}
+	h.sum.add(v)
+	h.count.Add(1)
+}
+
+func (h *histogram) write(w *bufio.Writer, name, labels string, buckets []float64) {
+	h.once.Do(func() { h.counts = make([]atomic.Uint64, len(buckets)) })
+	if labels != "" {
+		labels += ","
+	}
+	var cumulative uint64
+	for i, upper := range buckets {
+		cumulative += h.counts[i].Load()
+		writeSample(w, name+"_bucket", labels+label("le", formatFloat(upper)),
+			float64(cumulative))
+	}
+	count := h.count.Load()
+	writeSample(w, name+"_bucket", labels+`le="+Inf"`, float64(count))
+	labels = strings.TrimSuffix(labels, ",")
+	writeSample(w, name+"_sum", labels, h.sum.load())
+	writeSample(w, name+"_count", labels, float64(count))
+}
+
+// HistogramVec is a histogram partitioned by the value of a label.
+type HistogramVec struct {
+	vec[histogram]
+	buckets []float64
+}
+
+// NewHistogramVec creates and registers a histogram with buckets of the
+// increasing upper bounds, partitioned by the value of the named label.
+func NewHistogramVec(name, help, label string, buckets []float64) *HistogramVec {
+	h := &HistogramVec{
+		vec: vec[histogram]{desc: desc{name, help, "histogram"}, label: label,
+			m: make(map[string]*histogram)},
+		buckets: buckets,
+	}
+	register(h)
+	return h
+}
+
+// Observe adds an observation to the histogram with the label value.
+func (h *HistogramVec) Observe(value string, v float64) {
+	h.with(value).observe(h.buckets, v)
+}
+
+// ObserveDuration observes the seconds elapsed since start.
+func (h *HistogramVec) ObserveDuration(value string, start time.Time) {
+	h.Observe(value, time.Since(start).Seconds())
+}
+
+func (h *HistogramVec) write(w *bufio.Writer) {
+	h.writeHeader(w)
+	h.each(func(labels string, hist *histogram) {
+		hist.write(w, h.metricName, labels, h.buckets)
+	})
+}
+
+// Collect calls the registered collectors and writes every metric, ordered
+// by name, in the Prometheus text exposition format.
+func Collect(ctx context.Context, w io.Writer) error {
+	registry.mu.Lock()
+	collectors := slices.Clone(registry.collectors)
+	metrics := slices.Clone(registry.metrics)
+	registry.mu.Unlock()
+
+	for _, f := range collectors {
+		f(ctx)
+	}
+	slices.SortFunc(metrics, func(a, b metric) int {
+		return strings.Compare(a.name(), b.name())
+	})
+	bw := bufio.NewWriter(w)
+	for _, m := range metrics {
+		m.write(bw)
+	}
+	return bw.Flush()
+}
+
+// Handler returns an HTTP handler serving the metrics to Prometheus scrapes.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), collectTimeout)
+		defer cancel()
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_ = Collect(ctx, w)
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCollect(t *testing.T) {
+	c := NewCounter("test_events_total", "Events seen.")
+	g := NewGaugeVec("test_balance", "Balance\nper coin.", "cointype")
+	h := NewHistogramVec("test_latency_seconds", "Latency.", "method", []float64{0.1, 1})
+	RegisterCollector(func(ctx context.Context) {
+		g.Set("1", 2.5)
+		g.Set(`a"b`, -1)
+	})
+
+	c.Inc()
+	c.Add(2)
+	c.Add(-5)
+	h.Observe("ping", 0.05)
+	h.Observe("ping", 0.5)
+	h.Observe("ping", 5)
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	got := rec.Body.String()
+	want := `# HELP test_balance Balance\nper coin.
+# TYPE test_balance gauge
+test_balance{cointype="1"} 2.5
+test_balance{cointype="a\"b"} -1
+# HELP test_events_total Events seen.
+# TYPE test_events_total counter
+test_events_total 3
+# HELP test_latency_seconds Latency.
+# TYPE test_latency_seconds histogram
+test_latency_seconds_bucket{method="ping",le="0.1"} 1
+test_latency_seconds_bucket{method="ping",le="1"} 2
+test_latency_seconds_bucket{method="ping",le="+Inf"} 3
+test_latency_seconds_sum{method="ping"} 5.55
+test_latency_seconds_count{method="ping"} 3
+`
+	if got != want {
+		t.Errorf("unexpected exposition:\n%s\nwant:\n%s", got, want)
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("unexpected content type %q", ct)
+	}
+}
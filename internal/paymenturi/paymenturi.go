@@ -0,0 +1,202 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package paymenturi encodes and decodes monetarium: payment request URIs.
+// The URIs follow BIP0021, naming a payment address and optionally an amount,
+// the coin type of the amount, a label, a message, and an expiry time, so
+// invoices for SKA coin types may be encoded alongside VAR invoices.
+package paymenturi
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+// Scheme is the URI scheme of payment requests.
+const Scheme = "monetarium"
+
+// Query parameters of payment request URIs, in the order they are encoded.
+const (
+	paramAmount   = "amount"
+	paramCoinType = "cointype"
+	paramLabel    = "label"
+	paramMessage  = "message"
+	paramExpires  = "expires"
+)
+
+// Request describes a payment request.
+type Request struct {
+	Address string
+
+	// Amount is the requested amount as a positive decimal number of whole
+	// coins of CoinType, or empty when the payer chooses the amount.
+	Amount string
+
+	// CoinType is the coin type to be paid.  The coin type is only encoded
+	// when it is not VAR.
+	CoinType cointype.CoinType
+
+	// Label names the payee, and Message describes the payment.
+	Label   string
+	Message string
+
+	// Expires is the time after which the request should not be paid, or
+	// the zero time when the request does not expire.  It is encoded as a
+	// Unix time in seconds.
+	Expires time.Time
+}
+
+// validAmount returns whether s is a positive plain decimal number.
+func validAmount(s string) bool {
+	intPart, fracPart, hasDot := strings.Cut(s, ".")
+	if intPart == "" && fracPart == "" || hasDot && fracPart == "" {
+		return false
+	}
+	nonZero := false
+	for _, part := range []string{intPart, fracPart} {
+		for _, c := range part {
+			if c < '0' || c > '9' {
+				return false
+			}
+			nonZero = nonZero || c != '0'
+		}
+	}
+	return nonZero
+}
+
+// Decimals returns the number of decimal places of the requested amount.
+func (r *Request) Decimals() int {
+	_, fracPart, _ := strings.Cut(r.Amount, ".")
+	return len(fracPart)
+}
+
+// Expired returns whether the request expired before t.
+func (r *Request) Expired(t time.Time) bool {
+	return !r.Expires.IsZero() && t.After(r.Expires)
+}
+
+// queryEscape escapes a parameter value, encoding spaces as %20 rather than
+// the + accepted by HTML forms, which BIP0021 does not treat as a space.
+func queryEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+// URI returns the payment request URI of the request.  Errors with code
+// errors.Invalid are returned if the request has no address, an invalid
+// amount or coin type, or an expiry time before the Unix epoch.
+func (r *Request) URI() (string, error) {
+	const op errors.Op = "paymenturi.URI"
+
+	switch {
+	case r.Address == "":
+		return "", errors.E(op, errors.Invalid, "payment request has no address")
+	case r.Amount != "" && !validAmount(r.Amount):
+		return "", errors.E(op, errors.Invalid,
+			errors.Errorf("invalid amount %q", r.Amount))
+	case !r.CoinType.IsValid():
+		return "", errors.E(op, errors.Invalid,
+			errors.Errorf("invalid coin type %d", r.CoinType))
+	case !r.Expires.IsZero() && r.Expires.Unix() < 0:
+		return "", errors.E(op, errors.Invalid,
+			"expiry time precedes the Unix epoch")
+	}
+
+	var params []string
+	add := func(name, value string) {
+		params = append(params, name+"="+queryEscape(value))
+	}
+	if r.Amount != "" {
+		add(paramAmount, r.Amount)
+	}
+	if r.CoinType != cointype.CoinTypeVAR {
+		add(paramCoinType, strconv.Itoa(int(r.CoinType)))
+	}
+	if r.Label != "" {
+		add(paramLabel, r.Label)
+	}
+	if r.Message != "" {
+		add(paramMessage, r.Message)
+	}
+	if !r.Expires.IsZero() {
+		add(paramExpires, strconv.FormatInt(r.Expires.Unix(), 10))
+	}
+
+	uri := Scheme + ":" + r.Address
+	if len(params) != 0 {
+		uri += "?" + strings.Join(params, "&")
+	}
+	return uri, nil
+}
+
+// Parse decodes a payment request URI.  Unknown parameters are ignored,
+// unless they are prefixed with "req-", which BIP0021 reserves for parameters
+// that must be understood to pay the request.  Errors with code
+// errors.Encoding are returned for invalid URIs.
+func Parse(uri string) (*Request, error) {
+	const op errors.Op = "paymenturi.Parse"
+	invalid := func(err error) error {
+		return errors.E(op, errors.Encoding, err)
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, invalid(err)
+	}
+	if u.Scheme != Scheme {
+		return nil, invalid(errors.Errorf("URI scheme is not %q", Scheme))
+	}
+	if u.Opaque == "" {
+		return nil, invalid(errors.New("URI has no payment address"))
+	}
+	address, err := url.PathUnescape(u.Opaque)
+	if err != nil {
+		return nil, invalid(err)
+	}
+	query, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return nil, invalid(err)
+	}
+
+	r := &Request{Address: address}
+	for name, values := range query {
+		if len(values) != 1 {
+			return nil, invalid(errors.Errorf("parameter %q is repeated", name))
+		}
+		value := values[0]
+		switch name {
+		case paramAmount:
+			if !validAmount(value) {
+				return nil, invalid(errors.Errorf("invalid amount %q", value))
+			}
+			r.Amount = value
+		case paramCoinType:
+			ct, err := strconv.ParseUint(value, 10, 8)
+			if err != nil || !cointype.CoinType(ct).IsValid() {
+				return nil, invalid(errors.Errorf("invalid coin type %q", value))
+			}
+			r.CoinType = cointype.CoinType(ct)
+		case paramLabel:
+			r.Label = value
+		case paramMessage:
+			r.Message = value
+		case paramExpires:
+			unix, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || unix < 0 {
+				return nil, invalid(errors.Errorf("invalid expiry time %q", value))
+			}
+			r.Expires = time.Unix(unix, 0)
+		default:
+			if strings.HasPrefix(name, "req-") {
+				return nil, invalid(errors.Errorf("required parameter %q "+
+					"is not supported", name))
+			}
+		}
+	}
+	return r, nil
+}
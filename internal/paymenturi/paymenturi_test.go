@@ -0,0 +1,110 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package paymenturi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		req Request
+		uri string
+	}{{
+		req: Request{Address: "SsfPTmZmaXGkXfcNGjftRPmoGGCqtNPCHKx"},
+		uri: "monetarium:SsfPTmZmaXGkXfcNGjftRPmoGGCqtNPCHKx",
+	}, {
+		req: Request{
+			Address: "SsfPTmZmaXGkXfcNGjftRPmoGGCqtNPCHKx",
+			Amount:  "1.5",
+			Label:   "Corner Shop",
+			Message: "Order #42 & tip",
+		},
+		uri: "monetarium:SsfPTmZmaXGkXfcNGjftRPmoGGCqtNPCHKx?amount=1.5&label=Corner%20Shop&message=Order%20%2342%20%26%20tip",
+	}, {
+		req: Request{
+			Address:  "SsfPTmZmaXGkXfcNGjftRPmoGGCqtNPCHKx",
+			Amount:   "0.000000000000000001",
+			CoinType: 1,
+			Expires:  time.Unix(1700000000, 0),
+		},
+		uri: "monetarium:SsfPTmZmaXGkXfcNGjftRPmoGGCqtNPCHKx?amount=0.000000000000000001&cointype=1&expires=1700000000",
+	}}
+	for _, tc := range tests {
+		uri, err := tc.req.URI()
+		if err != nil {
+			t.Fatalf("%+v: %v", tc.req, err)
+		}
+		if uri != tc.uri {
+			t.Errorf("URI:\n%s\nwant:\n%s", uri, tc.uri)
+		}
+		req, err := Parse(uri)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", uri, err)
+		}
+		if *req != tc.req {
+			t.Errorf("Parse(%q) = %+v, want %+v", uri, *req, tc.req)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	req, err := Parse("MONETARIUM:SsfPTmZmaXGkXfcNGjftRPmoGGCqtNPCHKx?amount=.25&label=a+b&unknown=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Amount != ".25" || req.Decimals() != 2 || req.Label != "a b" {
+		t.Errorf("unexpected request %+v", req)
+	}
+	if req.Expired(time.Now()) {
+		t.Errorf("request without expiry time is expired")
+	}
+
+	req, err = Parse("monetarium:SsfPTmZmaXGkXfcNGjftRPmoGGCqtNPCHKx?expires=1000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Expired(time.Unix(1000, 0)) || !req.Expired(time.Unix(1001, 0)) {
+		t.Errorf("request expiring at %v: unexpected expiry", req.Expires)
+	}
+
+	invalid := []string{
+		"bitcoin:SsfPTmZmaXGkXfcNGjftRPmoGGCqtNPCHKx",
+		"monetarium:",
+		"monetarium://SsfPTmZmaXGkXfcNGjftRPmoGGCqtNPCHKx",
+		"monetarium:Ss?amount=0",
+		"monetarium:Ss?amount=-1",
+		"monetarium:Ss?amount=1e8",
+		"monetarium:Ss?amount=1.",
+		"monetarium:Ss?amount=1&amount=2",
+		"monetarium:Ss?cointype=256",
+		"monetarium:Ss?expires=soon",
+		"monetarium:Ss?req-refund=1",
+	}
+	for _, uri := range invalid {
+		_, err := Parse(uri)
+		if !errors.Is(err, errors.Encoding) {
+			t.Errorf("Parse(%q): expected Encoding error, got %v", uri, err)
+		}
+	}
+}
+
+func TestURIInvalid(t *testing.T) {
+	invalid := []Request{
+		{},
+		{Address: "Ss", Amount: "0.0"},
+		{Address: "Ss", Amount: "1,5"},
+		{Address: "Ss", Expires: time.Unix(-1, 0)},
+	}
+	for _, req := range invalid {
+		_, err := req.URI()
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("%+v: expected Invalid error, got %v", req, err)
+		}
+	}
+}
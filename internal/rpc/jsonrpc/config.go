@@ -7,6 +7,9 @@ package jsonrpc
 import (
 	"context"
 	"net"
+
+	"github.com/monetarium/monetarium-wallet/internal/fiatrate"
+	"github.com/monetarium/monetarium-wallet/internal/rpctrace"
 )
 
 // Options contains the required options for running the legacy RPC server.
@@ -23,11 +26,30 @@ type Options struct {
 	MixChangeAccount   string
 	TicketSplitAccount string
 
+	// SKAEmissionEnabled allows the emitska method to sign and publish
+	// SKA emission transactions.
+	SKAEmissionEnabled bool
+
+	// AccountKeyExport allows the exportaccountxpriv and
+	// dumpaccountprivkeys methods to reveal account private keys.
+	AccountKeyExport bool
+
+	// ReadOnly disables methods which modify the wallet, as the wallet
+	// database is opened read-only.
+	ReadOnly bool
+
 	VSPHost   string
 	VSPPubKey string
 	Dial      func(ctx context.Context, network, addr string) (net.Conn, error)
 
+	// FiatRates, if non-nil, converts fiat-denominated send amounts.
+	FiatRates fiatrate.Source
+
 	Loggers Loggers
+
+	// Traces, if non-nil, records handled requests for the getrpcstats
+	// method and marks slow requests to be logged.
+	Traces *rpctrace.Recorder
 }
 
 // Loggers provides access to manage all application subsystem loggers.
@@ -39,4 +61,11 @@ type Loggers interface {
 	// prefixed by the subsystem and an equals sign) to a particular debug
 	// level.
 	SetLevels(levelSpec string) error
+
+	// SetLevel sets the level of the subsystem logger, or of all loggers
+	// when the subsystem is empty.
+	SetLevel(subsystem, level string) error
+
+	// Levels returns the level of each subsystem logger.
+	Levels() map[string]string
 }
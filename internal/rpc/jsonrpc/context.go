@@ -19,3 +19,12 @@ func remoteAddr(ctx context.Context) string {
 	}
 	return v.(string)
 }
+
+func withWalletName(parent context.Context, name string) context.Context {
+	return context.WithValue(parent, contextKey("wallet-name"), name)
+}
+
+func walletName(ctx context.Context) string {
+	v, _ := ctx.Value(contextKey("wallet-name")).(string)
+	return v
+}
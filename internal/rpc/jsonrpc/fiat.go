@@ -0,0 +1,125 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrjson"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-wallet/internal/fiatrate"
+	"github.com/monetarium/monetarium-wallet/rpc/jsonrpc/types"
+	"github.com/monetarium/monetarium-wallet/wallet"
+)
+
+// fiatRate queries the configured rate source for the current price of
+// coinType in the fiat currency.
+func (s *Server) fiatRate(ctx context.Context, coinType cointype.CoinType, currency string) (*fiatrate.Rate, error) {
+	if s.cfg.FiatRates == nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"fiat-denominated amounts require a configured rate source")
+	}
+	if currency == "" {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "empty fiat currency")
+	}
+	rate, err := s.cfg.FiatRates.Rate(ctx, coinType, currency)
+	if err != nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCMisc, "unable to query fiat rate: %v", err)
+	}
+	return rate, nil
+}
+
+// fiatToAtoms converts fiat amounts keyed by payment address into atoms of
+// the rate's coin type.  Amounts are converted exactly, without passing
+// through floating point, and must be finite and positive.
+func fiatToAtoms(rate *fiatrate.Rate, atomsPerCoin *big.Int, amounts map[string]string) (map[string]*big.Int, error) {
+	atoms := make(map[string]*big.Int, len(amounts))
+	for addr, amount := range amounts {
+		fiat, err := fiatrate.ParseAmount(amount)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"invalid amount for %s: %v", addr, err)
+		}
+		amt := rate.ToAtoms(fiat, atomsPerCoin)
+		if amt.Sign() <= 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"amount for %s is less than one atom at the current rate", addr)
+		}
+		atoms[addr] = amt
+	}
+	return atoms, nil
+}
+
+// sendFiat converts the fiat-denominated amounts to coinType at a single
+// current rate, sends them from account, and records the rate, its time and
+// source as a label of the published transaction, following any comment.
+//
+// The returned result always includes the audit label.  Because the
+// transaction has already been published when the label is recorded, a
+// failure to record it is reported in the result rather than as an error, so
+// callers do not retry a send which succeeded.
+func (s *Server) sendFiat(ctx context.Context, w *wallet.Wallet, coinType cointype.CoinType,
+	currency string, amounts map[string]string, account uint32, minConf int32,
+	opts *sendOptions) (any, error) {
+
+	rate, err := s.fiatRate(ctx, coinType, currency)
+	if err != nil {
+		return nil, err
+	}
+	atomsPerCoin := getAtomsPerCoin(w.ChainParams(), coinType)
+	pairsBig, err := fiatToAtoms(rate, atomsPerCoin, amounts)
+	if err != nil {
+		return nil, err
+	}
+
+	var txid string
+	if coinType.IsSKA() {
+		txid, err = s.sendPairsWithCoinTypeBig(ctx, w, pairsBig, account, minConf, coinType, opts)
+	} else {
+		pairs := make(map[string]dcrutil.Amount, len(pairsBig))
+		for addr, amt := range pairsBig {
+			if !amt.IsInt64() || amt.Int64() > int64(cointype.MaxVARAmount) {
+				return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+					"amount for %s exceeds the maximum amount", addr)
+			}
+			pairs[addr] = dcrutil.Amount(amt.Int64())
+		}
+		txid, err = s.sendPairsWithCoinType(ctx, w, pairs, account, minConf, coinType, opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The audit label replaces the label recorded for the transaction, so
+	// it begins with any comment recorded as that label.
+	label := rate.Label(amounts)
+	if opts.label != "" {
+		label = opts.label + "; " + label
+	}
+	res := &types.FiatSendResult{
+		TxID:       txid,
+		Rate:       fiatrate.FormatPrice(rate.Price),
+		Currency:   rate.Currency,
+		RateTime:   rate.Time.Unix(),
+		RateSource: rate.Source,
+		Label:      label,
+	}
+	if opts.feePreference != "" {
+		res.FeePreference = opts.feePreference
+		res.FeeRate = opts.feeRateCoins
+	}
+	hash, err := chainhash.NewHashFromStr(txid)
+	if err == nil {
+		err = w.SetTransactionLabel(ctx, hash, label)
+	}
+	if err != nil {
+		logCtx(ctx).Warnf("Failed to label transaction %v: %v", txid, err)
+		res.LabelError = err.Error()
+	}
+	return res, nil
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrjson"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/internal/fiatrate"
+	"github.com/monetarium/monetarium-wallet/internal/loader"
+	"github.com/monetarium/monetarium-wallet/rpc/jsonrpc/types"
+	_ "github.com/monetarium/monetarium-wallet/wallet/drivers/bdb"
+)
+
+// fixedRates is a fiat rate source reporting a constant price.
+type fixedRates struct {
+	price *big.Rat
+}
+
+func (f fixedRates) Rate(ctx context.Context, coinType cointype.CoinType, currency string) (*fiatrate.Rate, error) {
+	return &fiatrate.Rate{
+		CoinType: coinType,
+		Currency: currency,
+		Price:    f.price,
+		Time:     time.Unix(1700000000, 0),
+		Source:   "fixed",
+	}, nil
+}
+
+// testServer returns a JSON-RPC server with a newly created simnet wallet
+// loaded.
+func testServer(ctx context.Context, t *testing.T, opts Options) *Server {
+	params := chaincfg.SimNetParams()
+	l := loader.NewLoader(params, t.TempDir(), false, 20, 0, false, 1e5, 0, 0,
+		false, false, false, 0, false, "bdb", nil)
+	seed := []byte("test seed for fiat rpc testing..")
+	_, err := l.CreateNewWallet(ctx, []byte("public"), []byte("private"), seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.UnloadWallet() })
+	return &Server{walletLoader: l, cfg: opts}
+}
+
+func TestFiatToAtoms(t *testing.T) {
+	rate := &fiatrate.Rate{Price: big.NewRat(5, 2)}
+	ska := cointype.AtomsPerSKACoin
+
+	atoms, err := fiatToAtoms(rate, big.NewInt(cointype.AtomsPerVAR),
+		map[string]string{"a": "10", "b": "0.25"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if atoms["a"].Cmp(big.NewInt(4e8)) != 0 || atoms["b"].Cmp(big.NewInt(1e7)) != 0 {
+		t.Errorf("unexpected VAR atoms %v", atoms)
+	}
+
+	// SKA amounts are converted without float rounding.
+	atoms, err = fiatToAtoms(rate, ska,
+		map[string]string{"a": "1.000000000000000005"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fiat, _ := new(big.Int).SetString("1000000000000000005", 10)
+	want := new(big.Int).Mul(ska, fiat)
+	want.Mul(want, big.NewInt(2))
+	want.Quo(want, big.NewInt(5e18))
+	if atoms["a"].Cmp(want) != 0 {
+		t.Errorf("converted SKA amount to %v atoms, want %v", atoms["a"], want)
+	}
+
+	invalid := []string{"", "0", "-1", "NaN", "Inf", "-Inf", "0x10", "1/3",
+		"1e3", "0.000000001"}
+	for _, amount := range invalid {
+		_, err := fiatToAtoms(rate, big.NewInt(cointype.AtomsPerVAR),
+			map[string]string{"a": amount})
+		var rpcErr *dcrjson.RPCError
+		if !errors.As(err, &rpcErr) || rpcErr.Code != dcrjson.ErrRPCInvalidParameter {
+			t.Errorf("amount %q: expected invalid parameter error, got %v", amount, err)
+		}
+	}
+}
+
+func TestSendFiatValidation(t *testing.T) {
+	ctx := context.Background()
+	usd := "USD"
+	addr := "SsWKp7wtdTZYabYFYSc9cnxhwFEjA5g4pFc"
+
+	// Fiat amounts are refused without a rate source.
+	s := testServer(ctx, t, Options{})
+	_, err := s.sendToAddress(ctx, &types.SendToAddressCmd{
+		Address:      addr,
+		Amount:       "10",
+		FiatCurrency: &usd,
+	})
+	var rpcErr *dcrjson.RPCError
+	if !errors.As(err, &rpcErr) || rpcErr.Code != dcrjson.ErrRPCInvalidParameter {
+		t.Errorf("send without rate source: expected invalid parameter error, got %v", err)
+	}
+
+	s = testServer(ctx, t, Options{FiatRates: fixedRates{big.NewRat(2, 1)}})
+	for _, amount := range []string{"NaN", "Inf", "0", "-5"} {
+		_, err := s.sendToAddress(ctx, &types.SendToAddressCmd{
+			Address:      addr,
+			Amount:       amount,
+			FiatCurrency: &usd,
+		})
+		if !errors.As(err, &rpcErr) || rpcErr.Code != dcrjson.ErrRPCInvalidParameter {
+			t.Errorf("amount %q: expected invalid parameter error, got %v", amount, err)
+		}
+	}
+	minConf := 1
+	_, err = s.sendMany(ctx, &types.SendManyCmd{
+		FromAccount:  "default",
+		Amounts:      map[string]string{addr: "10", "SsXY": "NaN"},
+		MinConf:      &minConf,
+		FiatCurrency: &usd,
+	})
+	if !errors.As(err, &rpcErr) || rpcErr.Code != dcrjson.ErrRPCInvalidParameter {
+		t.Errorf("sendmany with invalid amount: expected invalid parameter error, got %v", err)
+	}
+}
+
+func TestGetTransactionLabel(t *testing.T) {
+	ctx := context.Background()
+	s := testServer(ctx, t, Options{})
+	w, _ := s.walletLoader.LoadedWallet()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vers, script := addr.PaymentScript()
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, 1e8, nil))
+	out := wire.NewTxOut(1e8-1e5, script)
+	out.Version = vers
+	tx.AddTxOut(out)
+	err = w.AddTransaction(ctx, tx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txHash := tx.TxHash()
+
+	get := func() types.GetTransactionResult {
+		res, err := s.getTransaction(ctx, &types.GetTransactionCmd{Txid: txHash.String()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.(types.GetTransactionResult)
+	}
+	if l := get().Label; l != "" {
+		t.Errorf("unlabeled transaction reported label %q", l)
+	}
+	const label = "fiat USD a=10 @ 2 USD/VAR (source=fixed time=2023-11-14T22:13:20Z)"
+	if err := w.SetTransactionLabel(ctx, &txHash, label); err != nil {
+		t.Fatal(err)
+	}
+	if l := get().Label; l != label {
+		t.Errorf("gettransaction reported label %q, want %q", l, label)
+	}
+}
@@ -17,7 +17,9 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -25,13 +27,21 @@ import (
 	"time"
 
 	"github.com/monetarium/monetarium-wallet/chain"
+	"github.com/monetarium/monetarium-wallet/deployments"
 	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/internal/fiatrate"
+	"github.com/monetarium/monetarium-wallet/internal/paymenturi"
+	"github.com/monetarium/monetarium-wallet/internal/rpctrace"
 	"github.com/monetarium/monetarium-wallet/p2p"
 	"github.com/monetarium/monetarium-wallet/rpc/client/dcrd"
 	"github.com/monetarium/monetarium-wallet/rpc/jsonrpc/types"
 	"github.com/monetarium/monetarium-wallet/spv"
 	"github.com/monetarium/monetarium-wallet/version"
 	"github.com/monetarium/monetarium-wallet/wallet"
+	"github.com/monetarium/monetarium-wallet/wallet/atomicswap"
+	"github.com/monetarium/monetarium-wallet/wallet/contracts"
+	"github.com/monetarium/monetarium-wallet/wallet/ownership"
+	"github.com/monetarium/monetarium-wallet/wallet/psdt"
 	"github.com/monetarium/monetarium-wallet/wallet/txauthor"
 	"github.com/monetarium/monetarium-wallet/wallet/txrules"
 	"github.com/monetarium/monetarium-wallet/wallet/txsizes"
@@ -44,13 +54,11 @@ import (
 	"github.com/monetarium/monetarium-node/crypto/rand"
 	"github.com/monetarium/monetarium-node/dcrec"
 	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
-	"github.com/monetarium/monetarium-node/dcrec/secp256k1/ecdsa"
 	"github.com/monetarium/monetarium-node/dcrjson"
 	"github.com/monetarium/monetarium-node/dcrutil"
 	"github.com/monetarium/monetarium-node/hdkeychain"
 	dcrdtypes "github.com/monetarium/monetarium-node/rpc/jsonrpc/types"
 	"github.com/monetarium/monetarium-node/txscript"
-	"github.com/monetarium/monetarium-node/txscript/sign"
 	"github.com/monetarium/monetarium-node/txscript/stdaddr"
 	"github.com/monetarium/monetarium-node/txscript/stdscript"
 	"github.com/monetarium/monetarium-node/wire"
@@ -242,6 +250,16 @@ func atomsToCoinsBig(atoms *big.Int, atomsPerCoin *big.Int) string {
 	return result
 }
 
+// coinAmount formats an atom amount of a coin type for a JSON-RPC result.
+// VAR amounts are reported as a float64 coin value and SKA amounts as a
+// decimal string preserving full precision.
+func coinAmount(chainParams *chaincfg.Params, ct cointype.CoinType, atoms *big.Int) any {
+	if ct.IsSKA() {
+		return atomsToCoinsBig(atoms, getAtomsPerCoin(chainParams, ct))
+	}
+	return dcrutil.Amount(atoms.Int64()).ToCoin()
+}
+
 // skaAmountToAtomsString returns the SKA amount as a raw atom string (no decimals).
 // Use this when you need the exact atom count for calculations.
 func skaAmountToAtomsString(amount cointype.SKAAmount) string {
@@ -270,20 +288,53 @@ var handlers = map[string]handler{
 	"accountsyncaddressindex":          {fn: (*Server).accountSyncAddressIndex},
 	"accountunlocked":                  {fn: (*Server).accountUnlocked},
 	"addmultisigaddress":               {fn: (*Server).addMultiSigAddress},
+	"addrecurringpayment":              {fn: (*Server).addRecurringPayment},
 	"addtransaction":                   {fn: (*Server).addTransaction},
+	"approvepending":                   {fn: (*Server).approvePending},
+	"archiveaccount":                   {fn: (*Server).archiveAccount},
+	"auditcontract":                    {fn: (*Server).auditContract},
 	"auditreuse":                       {fn: (*Server).auditReuse},
+	"backupwallet":                     {fn: (*Server).backupWallet},
+	"blockaddress":                     {fn: (*Server).blockAddress},
+	"cancelscheduledsend":              {fn: (*Server).cancelScheduledSend},
+	"changeaccounts":                   {fn: (*Server).changeAccounts},
+	"changescripttypes":                {fn: (*Server).changeScriptTypes},
+	"clearinheritance":                 {fn: (*Server).clearInheritance},
+	"combinepsdt":                      {fn: (*Server).combinePSDT},
+	"compactwallet":                    {fn: (*Server).compactWallet},
 	"consolidate":                      {fn: (*Server).consolidate},
+	"counterpartysummary":              {fn: (*Server).counterpartySummary},
+	"createcontract":                   {fn: (*Server).createContract},
 	"createmultisig":                   {fn: (*Server).createMultiSig},
+	"createmultisigaccount":            {fn: (*Server).createMultisigAccount},
+	"createownershipproof":             {fn: (*Server).createOwnershipProof},
+	"createvaultaccount":               {fn: (*Server).createVaultAccount},
 	"createnewaccount":                 {fn: (*Server).createNewAccount},
+	"createinvoice":                    {fn: (*Server).createInvoice},
+	"createpaymenturi":                 {fn: (*Server).createPaymentURI},
+	"createrpccredential":              {fn: (*Server).createRPCCredential},
 	"createauthorizedemission":         {fn: (*Server).createAuthorizedEmission},
 	"createrawtransaction":             {fn: (*Server).createRawTransaction},
+	"exportcounterparties":             {fn: (*Server).exportCounterparties},
+	"exportaccountxpriv":               {fn: (*Server).exportAccountXpriv},
+	"exporthistory":                    {fn: (*Server).exportHistory},
+	"extractsecret":                    {fn: (*Server).extractSecret},
 	"generateemissionkey":              {fn: (*Server).generateEmissionKey},
+	"importcounterparties":             {fn: (*Server).importCounterparties},
 	"importemissionkey":                {fn: (*Server).importEmissionKey},
 	"createsignature":                  {fn: (*Server).createSignature},
+	"createunsignedtransactionfile":    {fn: (*Server).createUnsignedTransactionFile},
+	"createwallet":                     {fn: (*Server).createWallet},
+	"createwatchonlywallet":            {fn: (*Server).createWatchOnlyWallet},
 	"debuglevel":                       {fn: (*Server).debugLevel},
+	"decodepaymenturi":                 {fn: (*Server).decodePaymentURI},
 	"disapprovepercent":                {fn: (*Server).disapprovePercent},
 	"discoverusage":                    {fn: (*Server).discoverUsage},
+	"dumpaccountprivkeys":              {fn: (*Server).dumpAccountPrivKeys},
 	"dumpprivkey":                      {fn: (*Server).dumpPrivKey},
+	"emitska":                          {fn: (*Server).emitSKA},
+	"estimatesendfee":                  {fn: (*Server).estimateSendFee},
+	"finalizepsdt":                     {fn: (*Server).finalizePSDT},
 	"fundrawtransaction":               {fn: (*Server).fundRawTransaction},
 	"getaccount":                       {fn: (*Server).getAccount},
 	"getaccountaddress":                {fn: (*Server).getAccountAddress},
@@ -298,26 +349,44 @@ var handlers = map[string]handler{
 	"getblock":                         {fn: (*Server).getBlock},
 	"getcoinjoinsbyacct":               {fn: (*Server).getcoinjoinsbyacct},
 	"getcurrentnet":                    {fn: (*Server).getCurrentNet},
+	"getfeesummary":                    {fn: (*Server).getFeeSummary},
 	"getinfo":                          {fn: (*Server).getInfo},
+	"getinheritance":                   {fn: (*Server).getInheritance},
+	"getinvoice":                       {fn: (*Server).getInvoice},
+	"getkdfinfo":                       {fn: (*Server).getKDFInfo},
 	"getmasterpubkey":                  {fn: (*Server).getMasterPubkey},
+	"getmaxspendable":                  {fn: (*Server).getMaxSpendable},
+	"getmigrationhistory":              {fn: (*Server).getMigrationHistory},
 	"getmultisigoutinfo":               {fn: (*Server).getMultisigOutInfo},
 	"getnewaddress":                    {fn: (*Server).getNewAddress},
 	"getpeerinfo":                      {fn: (*Server).getPeerInfo},
 	"getrawchangeaddress":              {fn: (*Server).getRawChangeAddress},
 	"getreceivedbyaccount":             {fn: (*Server).getReceivedByAccount},
 	"getreceivedbyaddress":             {fn: (*Server).getReceivedByAddress},
+	"getrescanstatus":                  {fn: (*Server).getRescanStatus},
+	"getrpcstats":                      {fn: (*Server).getRPCStats},
+	"getsendpolicy":                    {fn: (*Server).getSendPolicy},
+	"getskaemissionhistory":            {fn: (*Server).getSKAEmissionHistory},
+	"getskasupply":                     {fn: (*Server).getSKASupply},
 	"getstakeinfo":                     {fn: (*Server).getStakeInfo},
+	"getstakestats":                    {fn: (*Server).getStakeStats},
 	"gettickets":                       {fn: (*Server).getTickets},
 	"gettransaction":                   {fn: (*Server).getTransaction},
+	"gettransactionspage":              {fn: (*Server).getTransactionsPage},
+	"gettxtrace":                       {fn: (*Server).getTxTrace},
 	"gettxout":                         {fn: (*Server).getTxOut},
 	"getunconfirmedbalance":            {fn: (*Server).getUnconfirmedBalance},
 	"getvotechoices":                   {fn: (*Server).getVoteChoices},
 	"getvotefeeconsolidationaddress":   {fn: (*Server).getVoteFeeConsolidationAddress},
+	"getvspticketstatus":               {fn: (*Server).getVSPTicketStatus},
+	"getwalletdbversion":               {fn: (*Server).getWalletDBVersion},
 	"getwalletfee":                     {fn: (*Server).getWalletFee},
+	"getwalletlockstate":               {fn: (*Server).getWalletLockState},
 	"clearvotefeeconsolidationaddress": {fn: (*Server).clearVoteFeeConsolidationAddress},
 	"help":                             {fn: (*Server).help},
 	"getcfilterv2":                     {fn: (*Server).getCFilterV2},
 	"importcfiltersv2":                 {fn: (*Server).importCFiltersV2},
+	"importlegacywallet":               {fn: (*Server).importLegacyWallet},
 	"importprivkey":                    {fn: (*Server).importPrivKey},
 	"importpubkey":                     {fn: (*Server).importPubKey},
 	"importscript":                     {fn: (*Server).importScript},
@@ -326,61 +395,110 @@ var handlers = map[string]handler{
 	"listaddresstransactions":          {fn: (*Server).listAddressTransactions},
 	"listcointypes":                    {fn: (*Server).listCoinTypes},
 	"listalltransactions":              {fn: (*Server).listAllTransactions},
+	"listinvoices":                     {fn: (*Server).listInvoices},
 	"listlockunspent":                  {fn: (*Server).listLockUnspent},
+	"listpendingbroadcasts":            {fn: (*Server).listPendingBroadcasts},
+	"listpendingsends":                 {fn: (*Server).listPendingSends},
 	"listreceivedbyaccount":            {fn: (*Server).listReceivedByAccount},
 	"listreceivedbyaddress":            {fn: (*Server).listReceivedByAddress},
+	"listrecurringpayments":            {fn: (*Server).listRecurringPayments},
+	"listrpccredentials":               {fn: (*Server).listRPCCredentials},
+	"listscheduledsends":               {fn: (*Server).listScheduledSends},
 	"listsinceblock":                   {fn: (*Server).listSinceBlock},
+	"listspendlimits":                  {fn: (*Server).listSpendLimits},
 	"listtransactions":                 {fn: (*Server).listTransactions},
 	"listunspent":                      {fn: (*Server).listUnspent},
+	"listwallets":                      {fn: (*Server).listWallets},
+	"loadwallet":                       {fn: (*Server).loadWallet},
 	"lockaccount":                      {fn: (*Server).lockAccount},
+	"lockstaking":                      {fn: (*Server).lockStaking},
 	"lockunspent":                      {fn: (*Server).lockUnspent},
 	"mixaccount":                       {fn: (*Server).mixAccount},
 	"mixoutput":                        {fn: (*Server).mixOutput},
+	"pauserecurringpayment":            {fn: (*Server).pauseRecurringPayment},
+	"planconsolidation":                {fn: (*Server).planConsolidation},
 	"purchaseticket":                   {fn: (*Server).purchaseTicket},
 	"processunmanagedticket":           {fn: (*Server).processUnmanagedTicket},
+	"recordprice":                      {fn: (*Server).recordPrice},
+	"recoverunspent":                   {fn: (*Server).recoverUnspent},
+	"redeemcontract":                   {fn: (*Server).redeemContract},
 	"redeemmultisigout":                {fn: (*Server).redeemMultiSigOut},
 	"redeemmultisigouts":               {fn: (*Server).redeemMultiSigOuts},
+	"rejectpending":                    {fn: (*Server).rejectPending},
+	"removerecurringpayment":           {fn: (*Server).removeRecurringPayment},
 	"renameaccount":                    {fn: (*Server).renameAccount},
 	"rescanwallet":                     {fn: (*Server).rescanWallet},
+	"restorewallet":                    {fn: (*Server).restoreWallet},
+	"resumerecurringpayment":           {fn: (*Server).resumeRecurringPayment},
+	"revokerpccredential":              {fn: (*Server).revokeRPCCredential},
+	"schedulesend":                     {fn: (*Server).scheduleSend},
 	"sendfrom":                         {fn: (*Server).sendFrom},
 	"sendfromtreasury":                 {fn: (*Server).sendFromTreasury},
+	"sendfromvault":                    {fn: (*Server).sendFromVault},
 	"sendmany":                         {fn: (*Server).sendMany},
 	"sendrawtransaction":               {fn: (*Server).sendRawTransaction},
 	"sendtoaddress":                    {fn: (*Server).sendToAddress},
 	"sendtomultisig":                   {fn: (*Server).sendToMultiSig},
 	"sendtotreasury":                   {fn: (*Server).sendToTreasury},
 	"sendtoburn":                       {fn: (*Server).sendToBurn},
+	"sendtspend":                       {fn: (*Server).sendTSpend},
+	"setaccountgaplimit":               {fn: (*Server).setAccountGapLimit},
 	"setaccountpassphrase":             {fn: (*Server).setAccountPassphrase},
+	"setchangeaccount":                 {fn: (*Server).setChangeAccount},
+	"setchangescripttype":              {fn: (*Server).setChangeScriptType},
 	"setdisapprovepercent":             {fn: (*Server).setDisapprovePercent},
+	"setinheritance":                   {fn: (*Server).setInheritance},
+	"setlabelthreshold":                {fn: (*Server).setLabelThreshold},
+	"setloglevel":                      {fn: (*Server).setLogLevel},
+	"setskasendaccounts":               {fn: (*Server).setSKASendAccounts},
+	"setspendlimit":                    {fn: (*Server).setSpendLimit},
+	"setstakingaccount":                {fn: (*Server).setStakingAccount},
+	"setstakingpassphrase":             {fn: (*Server).setStakingPassphrase},
+	"setticketbuyerconfig":             {fn: (*Server).setTicketBuyerConfig},
+	"setticketcompounding":             {fn: (*Server).setTicketCompounding},
 	"settreasurypolicy":                {fn: (*Server).setTreasuryPolicy},
 	"settspendpolicy":                  {fn: (*Server).setTSpendPolicy},
 	"settxfee":                         {fn: (*Server).setTxFee},
 	"setvotechoice":                    {fn: (*Server).setVoteChoice},
 	"setvotefeeconsolidationaddress":   {fn: (*Server).setVoteFeeConsolidationAddress},
+	"setvsp":                           {fn: (*Server).setVSP},
+	"setwalletbirthday":                {fn: (*Server).setWalletBirthday},
 	"signmessage":                      {fn: (*Server).signMessage},
 	"signrawtransaction":               {fn: (*Server).signRawTransaction},
+	"signrawtransactionoffline":        {fn: (*Server).signRawTransactionOffline},
 	"signrawtransactions":              {fn: (*Server).signRawTransactions},
 	"spendoutputs":                     {fn: (*Server).spendOutputs},
 	"sweepaccount":                     {fn: (*Server).sweepAccount},
 	"syncstatus":                       {fn: (*Server).syncStatus},
+	"tagcounterparty":                  {fn: (*Server).tagCounterparty},
+	"ticketbuyerconfig":                {fn: (*Server).ticketBuyerConfig},
+	"ticketcompounding":                {fn: (*Server).ticketCompounding},
 	"ticketinfo":                       {fn: (*Server).ticketInfo},
 	"treasurypolicy":                   {fn: (*Server).treasuryPolicy},
 	"tspendpolicy":                     {fn: (*Server).tspendPolicy},
+	"unarchiveaccount":                 {fn: (*Server).unarchiveAccount},
+	"unblockaddress":                   {fn: (*Server).unblockAddress},
 	"unlockaccount":                    {fn: (*Server).unlockAccount},
+	"unlockstaking":                    {fn: (*Server).unlockStaking},
+	"unloadwallet":                     {fn: (*Server).unloadWallet},
+	"untagcounterparty":                {fn: (*Server).untagCounterparty},
 	"validateaddress":                  {fn: (*Server).validateAddress},
 	"validatepredcp0005cf":             {fn: (*Server).validatePreDCP0005CF},
 	"verifymessage":                    {fn: (*Server).verifyMessage},
+	"verifyownershipproof":             {fn: (*Server).verifyOwnershipProof},
+	"verifyseed":                       {fn: (*Server).verifySeed},
 	"version":                          {fn: (*Server).version},
+	"walletaudit":                      {fn: (*Server).walletAudit},
 	"walletinfo":                       {fn: (*Server).walletInfo},
 	"walletislocked":                   {fn: (*Server).walletIsLocked},
 	"walletlock":                       {fn: (*Server).walletLock},
 	"walletpassphrase":                 {fn: (*Server).walletPassphrase},
 	"walletpassphrasechange":           {fn: (*Server).walletPassphraseChange},
+	"walletprocesspsdt":                {fn: (*Server).walletProcessPSDT},
 	"walletpubpassphrasechange":        {fn: (*Server).walletPubPassphraseChange},
 
 	// Unimplemented/unsupported RPCs which may be found in other
 	// cryptocurrency wallets.
-	"backupwallet":         {fn: unimplemented, noHelp: true},
 	"getwalletinfo":        {fn: unimplemented, noHelp: true},
 	"importwallet":         {fn: unimplemented, noHelp: true},
 	"listaddressgroupings": {fn: unimplemented, noHelp: true},
@@ -422,7 +540,7 @@ func lazyApplyHandler(s *Server, ctx context.Context, request *dcrjson.Request)
 	if !ok {
 		return func() (any, *dcrjson.RPCError) {
 			// Attempt RPC passthrough if possible
-			n, ok := s.walletLoader.NetworkBackend()
+			n, ok := s.loader(ctx).NetworkBackend()
 			if !ok {
 				return nil, errRPCClientNotConnected
 			}
@@ -437,7 +555,7 @@ func lazyApplyHandler(s *Server, ctx context.Context, request *dcrjson.Request)
 			}
 			err := chainSyncer.RPC().Call(ctx, request.Method, &resp, params...)
 			if ctx.Err() != nil {
-				log.Warnf("Canceled RPC method %v invoked by %v: %v", request.Method, remoteAddr(ctx), err)
+				logCtx(ctx).Warnf("Canceled RPC method %v invoked by %v: %v", request.Method, remoteAddr(ctx), err)
 				return nil, &dcrjson.RPCError{
 					Code:    dcrjson.ErrRPCMisc,
 					Message: ctx.Err().Error(),
@@ -458,7 +576,7 @@ func lazyApplyHandler(s *Server, ctx context.Context, request *dcrjson.Request)
 
 		defer func() {
 			if err := ctx.Err(); err != nil {
-				log.Warnf("Canceled RPC method %v invoked by %v: %v", request.Method, remoteAddr(ctx), err)
+				logCtx(ctx).Warnf("Canceled RPC method %v invoked by %v: %v", request.Method, remoteAddr(ctx), err)
 			}
 		}()
 		resp, err := handlerData.fn(s, ctx, params)
@@ -500,7 +618,7 @@ func makeResponse(id, result any, err error) dcrjson.Response {
 // transactions from the wallet.
 func (s *Server) abandonTransaction(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.AbandonTransactionCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -518,7 +636,7 @@ func (s *Server) abandonTransaction(ctx context.Context, icmd any) (any, error)
 // account and branch.
 func (s *Server) accountAddressIndex(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.AccountAddressIndexCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -552,7 +670,7 @@ func (s *Server) accountAddressIndex(ctx context.Context, icmd any) (any, error)
 // is successful, nothing is returned.
 func (s *Server) accountSyncAddressIndex(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.AccountSyncAddressIndexCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -625,7 +743,7 @@ func (s *Server) addMultiSigAddress(ctx context.Context, icmd any) (any, error)
 		return nil, errNotImportedAccount
 	}
 
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -639,7 +757,7 @@ func (s *Server) addMultiSigAddress(ctx context.Context, icmd any) (any, error)
 		return nil, err
 	}
 
-	err = w.ImportScript(ctx, script)
+	err = w.ImportScript(ctx, script, udb.ImportedAddrAccount)
 	if err != nil && !errors.Is(err, errors.Exist) {
 		return nil, err
 	}
@@ -649,7 +767,7 @@ func (s *Server) addMultiSigAddress(ctx context.Context, icmd any) (any, error)
 
 func (s *Server) addTransaction(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.AddTransactionCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -669,11 +787,52 @@ func (s *Server) addTransaction(ctx context.Context, icmd any) (any, error) {
 	return nil, err
 }
 
+// auditContract handles an auditcontract request by describing the output of
+// a transaction paying to a hash-locked contract, so the counterparty of a
+// swap can verify its terms before funding or redeeming their side.
+func (s *Server) auditContract(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.AuditContractCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	contractTx := new(wire.MsgTx)
+	err := contractTx.Deserialize(hex.NewDecoder(strings.NewReader(cmd.ContractTx)))
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
+	}
+	contract, err := decodeHexStr(cmd.Contract)
+	if err != nil {
+		return nil, err
+	}
+	params := w.ChainParams()
+	audit, err := atomicswap.AuditContract(contractTx, contract, params)
+	if errors.Is(err, errors.Invalid) || errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	_, tipHeight := w.MainChainTip(ctx)
+	return &types.AuditContractResult{
+		Address:         audit.Address.String(),
+		Vout:            audit.OutputIndex,
+		CoinType:        uint8(audit.CoinType),
+		Amount:          atomsToCoinsBig(audit.Amount, getAtomsPerCoin(params, audit.CoinType)),
+		Recipient:       audit.Recipient.String(),
+		Refund:          audit.Refund.String(),
+		SecretHash:      hex.EncodeToString(audit.Terms.SecretHash[:]),
+		LockTime:        audit.Terms.LockTime,
+		LockTimeReached: audit.Terms.LockTimeReached(tipHeight, time.Now()),
+	}, nil
+}
+
 // auditReuse returns an object keying reused addresses to two or more outputs
 // referencing them.
 func (s *Server) auditReuse(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.AuditReuseCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -752,11 +911,84 @@ func (s *Server) auditReuse(ctx context.Context, icmd any) (any, error) {
 	return reuse, nil
 }
 
+// backupWallet handles a backupwallet request by writing an encrypted snapshot
+// of the wallet database to a file.
+func (s *Server) backupWallet(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.BackupWalletCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.ExportEncryptedBackup(ctx, cmd.Destination, []byte(cmd.Passphrase))
+	if errors.Is(err, errors.Invalid) || errors.Is(err, errors.Exist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// parsePSDT parses a base64-encoded PSDT RPC parameter.
+func parsePSDT(s string) (*psdt.Packet, error) {
+	p, err := psdt.ParseBase64(s)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
+	}
+	return p, nil
+}
+
+// finalizePSDTResult finalizes the inputs of a PSDT with enough signatures, and
+// returns the encoded PSDT, whether it is complete, and the hex encoding of the
+// signed transaction when complete and extract is true.
+func finalizePSDTResult(p *psdt.Packet, extract bool) (encoded string, complete bool, txHex string, err error) {
+	complete, err = psdt.Finalize(p)
+	if err != nil {
+		return "", false, "", rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	encoded, err = p.B64Encode()
+	if err != nil {
+		return "", false, "", err
+	}
+	if complete && extract {
+		tx, err := psdt.Extract(p)
+		if err != nil {
+			return "", false, "", err
+		}
+		var b strings.Builder
+		b.Grow(2 * tx.SerializeSize())
+		err = tx.Serialize(hex.NewEncoder(&b))
+		if err != nil {
+			return "", false, "", err
+		}
+		txHex = b.String()
+	}
+	return encoded, complete, txHex, nil
+}
+
+// combinePSDT handles the combinepsdt command, merging the signatures and
+// other data of PSDTs for the same transaction.
+func (s *Server) combinePSDT(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CombinePSDTCmd)
+
+	packets := make([]*psdt.Packet, 0, len(cmd.PSDTs))
+	for _, enc := range cmd.PSDTs {
+		p, err := parsePSDT(enc)
+		if err != nil {
+			return nil, err
+		}
+		packets = append(packets, p)
+	}
+	p, err := psdt.Combine(packets...)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return p.B64Encode()
+}
+
 // consolidate handles a consolidate request by returning attempting to compress
 // as many inputs as given and then returning the txHash and error.
 func (s *Server) consolidate(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.ConsolidateCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -793,19 +1025,237 @@ func (s *Server) consolidate(ctx context.Context, icmd any) (any, error) {
 
 	// TODO In the future this should take the optional account and
 	// only consolidate UTXOs found within that account.
-	txHash, err := w.ConsolidateWithCoinType(ctx, cmd.Inputs, account, changeAddr, ct)
+	txHashes, err := w.ConsolidateWithCoinType(ctx, cmd.Inputs, account, changeAddr, ct)
 	if err != nil {
 		return nil, err
 	}
 
-	return txHash.String(), nil
+	// Large consolidations are split into chained transactions.  Report
+	// the final transaction, which pays the consolidated output.
+	return txHashes[len(txHashes)-1].String(), nil
+}
+
+// planConsolidation handles a planconsolidation request by estimating the
+// transactions and fees of consolidating the account's outputs, without
+// creating any transactions.
+func (s *Server) planConsolidation(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.PlanConsolidationCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if cmd.Inputs < 2 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"inputs must be at least 2")
+	}
+
+	account := uint32(udb.DefaultAccountNum)
+	var err error
+	if cmd.Account != nil {
+		account, err = w.AccountNumber(ctx, *cmd.Account)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+	}
+
+	var coinTypes []cointype.CoinType
+	if cmd.CoinType != nil {
+		coinTypes = append(coinTypes, cointype.CoinType(*cmd.CoinType))
+	}
+
+	plans, err := w.PlanConsolidation(ctx, cmd.Inputs, account, coinTypes...)
+	if err != nil {
+		return nil, err
+	}
+
+	params := w.ChainParams()
+	res := make([]types.PlanConsolidationResult, 0, len(plans))
+	for i := range plans {
+		p := &plans[i]
+		res = append(res, types.PlanConsolidationResult{
+			CoinType:     uint8(p.CoinType),
+			UTXOs:        p.Outputs,
+			Transactions: p.Transactions,
+			Fee:          coinAmount(params, p.CoinType, big.NewInt(int64(p.Fee))),
+			ResultUTXOs:  p.Remaining,
+		})
+	}
+	return res, nil
+}
+
+// counterpartySummary handles a counterpartysummary request by aggregating
+// the wallet's transaction history by tagged counterparty.
+func (s *Server) counterpartySummary(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CounterpartySummaryCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var name string
+	if cmd.Counterparty != nil {
+		name = *cmd.Counterparty
+	}
+	activity, err := w.CounterpartyActivity(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	params := w.ChainParams()
+	res := make([]types.CounterpartySummaryResult, 0, len(activity))
+	for _, a := range activity {
+		res = append(res, types.CounterpartySummaryResult{
+			Counterparty: a.Counterparty,
+			CoinType:     uint8(a.CoinType),
+			Sent:         coinAmount(params, a.CoinType, a.Sent),
+			Received:     coinAmount(params, a.CoinType, a.Received),
+			Transactions: a.Transactions,
+		})
+	}
+	return res, nil
+}
+
+// exportCounterparties handles an exportcounterparties request by returning
+// all tagged addresses grouped by counterparty.
+func (s *Server) exportCounterparties(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	return w.Counterparties(ctx)
+}
+
+// exportHistory handles an exporthistory request by writing the mined
+// transaction history to a file for accounting.
+func (s *Server) exportHistory(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ExportHistoryCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	format, err := wallet.ParseHistoryFormat(*cmd.Format)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	n, err := w.ExportHistory(ctx, cmd.Destination, format)
+	if errors.Is(err, errors.Exist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// extractSecret handles an extractsecret request by returning the secret
+// revealed by a transaction redeeming a contract with the secret hash.
+func (s *Server) extractSecret(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ExtractSecretCmd)
+
+	redeemTx := new(wire.MsgTx)
+	err := redeemTx.Deserialize(hex.NewDecoder(strings.NewReader(cmd.RedeemTx)))
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
+	}
+	var secretHash [32]byte
+	h, err := hex.DecodeString(cmd.SecretHash)
+	if err != nil || len(h) != len(secretHash) {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"secret hash must be %d hex-encoded bytes", len(secretHash))
+	}
+	copy(secretHash[:], h)
+	secret, err := contracts.ExtractSecret(redeemTx, &secretHash)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// importCounterparties handles an importcounterparties request by tagging
+// the addresses of a counterparty tag list.
+func (s *Server) importCounterparties(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportCounterpartiesCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	n, err := w.ImportCounterparties(ctx, cmd.Tags)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return n, nil
+}
+
+// tagCounterparty handles a tagcounterparty request by tagging external
+// addresses with a counterparty name.
+func (s *Server) tagCounterparty(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.TagCounterpartyCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	addrs, err := decodeAddresses(cmd.Addresses, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	err = w.TagCounterparty(ctx, cmd.Counterparty, addrs)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// untagCounterparty handles an untagcounterparty request by removing the
+// counterparty tags of addresses.
+func (s *Server) untagCounterparty(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.UntagCounterpartyCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	addrs, err := decodeAddresses(cmd.Addresses, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	return nil, w.UntagCounterparty(ctx, addrs)
+}
+
+// decodeAddresses decodes each address string of a request parameter.
+func decodeAddresses(addrs []string, params *chaincfg.Params) ([]stdaddr.Address, error) {
+	res := make([]stdaddr.Address, 0, len(addrs))
+	for _, a := range addrs {
+		addr, err := decodeAddress(a, params)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, addr)
+	}
+	return res, nil
 }
 
 // createMultiSig handles an createmultisig request by returning a
 // multisig address for the given inputs.
 func (s *Server) createMultiSig(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.CreateMultisigCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -945,7 +1395,7 @@ func (s *Server) createRawTransaction(ctx context.Context, icmd any) (any, error
 // key of the address is also returned.
 func (s *Server) createSignature(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.CreateSignatureCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -989,197 +1439,282 @@ func (s *Server) createSignature(ctx context.Context, icmd any) (any, error) {
 	}, nil
 }
 
-func (s *Server) debugLevel(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.DebugLevelCmd)
+// createUnsignedTransactionFile authors an unsigned transaction paying the
+// requested amounts from an account, and returns it encoded with the details
+// of its inputs, so it can be signed by signrawtransactionoffline on a wallet
+// without a connection to the network.
+func (s *Server) createUnsignedTransactionFile(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateUnsignedTransactionFileCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-	if cmd.LevelSpec == "show" {
-		return fmt.Sprintf("Supported subsystems %v",
-			s.cfg.Loggers.Subsystems()), nil
+	var coinType cointype.CoinType = cointype.CoinTypeVAR
+	if cmd.CoinType != nil {
+		coinType = cointype.CoinType(*cmd.CoinType)
+		if err := validateCoinType(coinType); err != nil {
+			return nil, err
+		}
 	}
 
-	err := s.cfg.Loggers.SetLevels(cmd.LevelSpec)
+	account, err := w.AccountNumber(ctx, cmd.FromAccount)
 	if err != nil {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-			"invalid debug level %v: %v", cmd.LevelSpec, err)
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
 	}
 
-	return "Done.", nil
-}
-
-// disapprovePercent returns the wallets current disapprove percentage.
-func (s *Server) disapprovePercent(ctx context.Context, _ any) (any, error) {
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
 	}
-	return w.DisapprovePercent(), nil
-}
 
-func (s *Server) discoverUsage(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.DiscoverUsageCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+	atomsPerCoin := getAtomsPerCoin(w.ChainParams(), coinType)
+	var outputs []*wire.TxOut
+	if coinType.IsSKA() {
+		pairs := make(map[string]*big.Int, len(cmd.Amounts))
+		for k, v := range cmd.Amounts {
+			amt, err := coinsToAtomsBig(v, atomsPerCoin)
+			if err != nil {
+				return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "invalid amount for %s: %v", k, err)
+			}
+			pairs[k] = amt
+		}
+		outputs, err = makeOutputsWithCoinTypeBig(pairs, w.ChainParams(), coinType)
+	} else {
+		pairs := make(map[string]dcrutil.Amount, len(cmd.Amounts))
+		for k, v := range cmd.Amounts {
+			amtFloat, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "invalid amount for %s: %v", k, err)
+			}
+			pairs[k] = dcrutil.Amount(coinsToAtoms(amtFloat, atomsPerCoin))
+		}
+		outputs, err = makeOutputsWithCoinType(pairs, w.ChainParams(), coinType)
 	}
-
-	n, ok := s.walletLoader.NetworkBackend()
-	if !ok {
-		return nil, errNoNetwork
+	if err != nil {
+		return nil, err
 	}
 
-	startBlock := w.ChainParams().GenesisHash
-	if cmd.StartBlock != nil {
-		h, err := chainhash.NewHashFromStr(*cmd.StartBlock)
-		if err != nil {
-			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "startblock: %v", err)
+	atx, err := w.NewUnsignedTransaction(ctx, outputs, w.RelayFee(), account,
+		minConf, wallet.OutputSelectionAlgorithmDefault, nil, nil)
+	if err != nil {
+		if errors.Is(err, errors.InsufficientBalance) {
+			return nil, rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
 		}
-		startBlock = *h
+		return nil, err
 	}
-	discoverAccounts := cmd.DiscoverAccounts != nil && *cmd.DiscoverAccounts
-
-	gapLimit := w.GapLimit()
-	if cmd.GapLimit != nil {
-		gapLimit = *cmd.GapLimit
+	file, err := w.CreateUnsignedTransactionFile(ctx, atx)
+	if err != nil {
+		return nil, err
 	}
-
-	err := w.DiscoverActiveAddresses(ctx, n, &startBlock, discoverAccounts, gapLimit)
-	return nil, err
+	return string(file), nil
 }
 
-// dumpPrivKey handles a dumpprivkey request with the private key
-// for a single address, or an appropriate error if the wallet
-// is locked.
-func (s *Server) dumpPrivKey(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.DumpPrivKeyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+// createWatchOnlyWallet handles a createwatchonlywallet request by creating
+// and loading a watching-only wallet.  The default account watches the first
+// extended public key, and every additional key is imported as an account
+// named xpub1, xpub2, and so on.  The wallet records no private keys.
+func (s *Server) createWatchOnlyWallet(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateWatchOnlyWalletCmd)
+	if len(cmd.Xpubs) == 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"at least one extended public key is required")
 	}
 
-	addr, err := decodeAddress(cmd.Address, w.ChainParams())
-	if err != nil {
-		return nil, err
+	pubPassphrase := []byte(wallet.InsecurePubPassphrase)
+	if cmd.PubPassphrase != nil && *cmd.PubPassphrase != "" {
+		pubPassphrase = []byte(*cmd.PubPassphrase)
+	}
+	var opts []wallet.WatchOnlyOption
+	for i, xpub := range cmd.Xpubs[1:] {
+		opts = append(opts, wallet.WithXpubAccount(fmt.Sprintf("xpub%d", i+1), xpub))
 	}
 
-	key, err := w.DumpWIFPrivateKey(ctx, addr)
+	_, err := s.loader(ctx).CreateWatchingOnlyWallet(ctx, cmd.Xpubs[0],
+		pubPassphrase, opts...)
 	if err != nil {
-		if errors.Is(err, errors.Locked) {
-			return nil, errWalletUnlockNeeded
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 		}
 		return nil, err
 	}
-	return key, nil
+	return nil, nil
 }
 
-func (s *Server) fundRawTransaction(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.FundRawTransactionCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
-	}
+func (s *Server) debugLevel(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.DebugLevelCmd)
 
-	var (
-		changeAddress string
-		feeRate       = w.RelayFee()
-		confs         = int32(1)
-	)
-	if cmd.Options != nil {
-		opts := cmd.Options
-		var err error
-		if opts.ChangeAddress != nil {
-			changeAddress = *opts.ChangeAddress
-		}
-		if opts.FeeRate != nil {
-			feeRate, err = dcrutil.NewAmount(*opts.FeeRate)
-			if err != nil {
-				return nil, err
-			}
-		}
-		if opts.ConfTarget != nil {
-			confs = *opts.ConfTarget
-			if confs < 0 {
-				return nil, errors.New("confs must be non-negative")
-			}
-		}
+	if cmd.LevelSpec == "show" {
+		return fmt.Sprintf("Supported subsystems %v",
+			s.cfg.Loggers.Subsystems()), nil
 	}
 
-	tx := new(wire.MsgTx)
-	err := tx.Deserialize(hex.NewDecoder(strings.NewReader(cmd.HexString)))
+	err := s.cfg.Loggers.SetLevels(cmd.LevelSpec)
 	if err != nil {
-		return nil, err
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"invalid debug level %v: %v", cmd.LevelSpec, err)
+	}
+
+	return "Done.", nil
+}
+
+// setLogLevel handles the setloglevel command by setting the level of one or
+// all subsystem loggers and returning the level of every logger.
+func (s *Server) setLogLevel(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetLogLevelCmd)
+
+	var subsystem string
+	if cmd.Subsystem != nil {
+		subsystem = *cmd.Subsystem
 	}
-	// Existing inputs are problematic.  Without information about
-	// how large the input scripts will be once signed, the wallet is
-	// unable to perform correct fee estimation.  If fundrawtransaction
-	// is changed later to work on a PSDT structure that includes this
-	// information, this functionality may be enabled.  For now, prevent
-	// the method from continuing.
-	if len(tx.TxIn) != 0 {
-		return nil, errors.New("transaction must not already have inputs")
+	if err := s.cfg.Loggers.SetLevel(subsystem, cmd.Level); err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if subsystem == "" {
+		logCtx(ctx).Infof("Set log level of all subsystems to %v", cmd.Level)
+	} else {
+		logCtx(ctx).Infof("Set %v log level to %v", subsystem, cmd.Level)
 	}
 
-	accountNum, err := w.AccountNumber(ctx, cmd.FundAccount)
-	if err != nil {
-		return nil, err
+	levels := s.cfg.Loggers.Levels()
+	res := make([]types.LogLevelResult, 0, len(levels))
+	for subsystem, level := range levels {
+		res = append(res, types.LogLevelResult{Subsystem: subsystem, Level: level})
 	}
+	slices.SortFunc(res, func(a, b types.LogLevelResult) int {
+		return strings.Compare(a.Subsystem, b.Subsystem)
+	})
+	return res, nil
+}
 
-	// Because there are no other inputs, a new transaction can be created.
-	var changeSource txauthor.ChangeSource
-	if changeAddress != "" {
-		var err error
-		changeSource, err = makeScriptChangeSource(changeAddress, w.ChainParams())
-		if err != nil {
-			return nil, err
-		}
+// checkPaymentRequest checks that a payment request pays an address of the
+// network, and that its amount has no more decimal places than the coin type.
+func checkPaymentRequest(r *paymenturi.Request, params *chaincfg.Params) error {
+	if _, err := stdaddr.DecodeAddress(r.Address, params); err != nil {
+		return rpcErrorf(dcrjson.ErrRPCInvalidAddressOrKey,
+			"invalid address %q: %v", r.Address, err)
 	}
-	atx, err := w.NewUnsignedTransaction(ctx, tx.TxOut, feeRate, accountNum, confs,
-		wallet.OutputSelectionAlgorithmDefault, changeSource, nil)
-	if err != nil {
-		return nil, err
+	if err := validateCoinType(r.CoinType); err != nil {
+		return err
 	}
-
-	// Include chosen inputs and change output (if any) in decoded
-	// transaction.
-	tx.TxIn = atx.Tx.TxIn
-	if atx.ChangeIndex >= 0 {
-		tx.TxOut = append(tx.TxOut, atx.Tx.TxOut[atx.ChangeIndex])
+	decimals := len(getAtomsPerCoin(params, r.CoinType).String()) - 1
+	if r.Decimals() > decimals {
+		return rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"amount %s has more than %d decimal places", r.Amount, decimals)
 	}
+	return nil
+}
 
-	// Determine the absolute fee of the funded transaction
-	fee := atx.TotalInput
-	for i := range tx.TxOut {
-		fee -= dcrutil.Amount(tx.TxOut[i].GetValue())
+// createPaymentURI handles a createpaymenturi request by encoding a payment
+// request URI.
+func (s *Server) createPaymentURI(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreatePaymentURICmd)
+
+	r := &paymenturi.Request{Address: cmd.Address}
+	if cmd.Amount != nil {
+		r.Amount = *cmd.Amount
+	}
+	if cmd.CoinType != nil {
+		r.CoinType = cointype.CoinType(*cmd.CoinType)
+	}
+	if cmd.Label != nil {
+		r.Label = *cmd.Label
+	}
+	if cmd.Message != nil {
+		r.Message = *cmd.Message
+	}
+	if cmd.Expires != nil {
+		r.Expires = time.Unix(*cmd.Expires, 0)
 	}
+	if err := checkPaymentRequest(r, s.activeNet); err != nil {
+		return nil, err
+	}
+	uri, err := r.URI()
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return uri, nil
+}
 
-	b := new(strings.Builder)
-	b.Grow(2 * tx.SerializeSize())
-	err = tx.Serialize(hex.NewEncoder(b))
+// decodePaymentURI handles a decodepaymenturi request by decoding a payment
+// request URI.
+func (s *Server) decodePaymentURI(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.DecodePaymentURICmd)
+
+	r, err := paymenturi.Parse(cmd.URI)
 	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if err := checkPaymentRequest(r, s.activeNet); err != nil {
 		return nil, err
 	}
-	res := &types.FundRawTransactionResult{
-		Hex: b.String(),
-		Fee: fee.ToCoin(),
+	res := &types.DecodePaymentURIResult{
+		Address:  r.Address,
+		Amount:   r.Amount,
+		CoinType: uint8(r.CoinType),
+		Label:    r.Label,
+		Message:  r.Message,
+		Expired:  r.Expired(time.Now()),
+	}
+	if !r.Expires.IsZero() {
+		res.Expires = r.Expires.Unix()
 	}
 	return res, nil
 }
 
-// getAddressesByAccount handles a getaddressesbyaccount request by returning
-// all addresses for an account, or an error if the requested account does
-// not exist.
-func (s *Server) getAddressesByAccount(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetAddressesByAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+// invoiceResult describes an invoice for a JSON-RPC result.
+func invoiceResult(inv *udb.Invoice, params *chaincfg.Params) types.InvoiceResult {
+	atomsPerCoin := getAtomsPerCoin(params, inv.CoinType)
+	res := types.InvoiceResult{
+		ID:       inv.ID,
+		Address:  inv.Address,
+		CoinType: uint8(inv.CoinType),
+		Amount:   coinAmount(params, inv.CoinType, inv.Amount),
+		Received: coinAmount(params, inv.CoinType, inv.Received()),
+		Label:    inv.Label,
+		Created:  inv.Created.Unix(),
+		Status:   inv.Status.String(),
+		Payments: make([]types.InvoicePaymentResult, 0, len(inv.Payments)),
+	}
+	if !inv.Expires.IsZero() {
+		res.Expires = inv.Expires.Unix()
+	}
+	r := &paymenturi.Request{
+		Address:  inv.Address,
+		Amount:   atomsToCoinsBig(inv.Amount, atomsPerCoin),
+		CoinType: inv.CoinType,
+		Label:    inv.Label,
+		Expires:  inv.Expires,
+	}
+	if uri, err := r.URI(); err == nil {
+		res.URI = uri
+	}
+	for i := range inv.Payments {
+		p := &inv.Payments[i]
+		res.Payments = append(res.Payments, types.InvoicePaymentResult{
+			TxID:   p.OutPoint.Hash.String(),
+			Vout:   p.OutPoint.Index,
+			Tree:   p.OutPoint.Tree,
+			Height: p.Height,
+			Amount: coinAmount(params, inv.CoinType, p.Amount),
+		})
 	}
+	return res
+}
 
-	if cmd.Account == "imported" {
-		addrs, err := w.ImportedAddresses(ctx, cmd.Account)
-		if err != nil {
-			return nil, err
-		}
-		return knownAddressMarshaler(addrs), nil
+// createContract handles a createcontract request by funding a hash-locked
+// contract paying the recipient.  Without a secret hash, the wallet generates
+// the secret of a new swap and the contract is refundable after the initiator
+// lock time; contracts participating in a swap with the secret hash of a
+// counterparty are refundable after the shorter participant lock time.
+func (s *Server) createContract(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateContractCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
 	account, err := w.AccountNumber(ctx, cmd.Account)
@@ -1189,3468 +1724,6241 @@ func (s *Server) getAddressesByAccount(ctx context.Context, icmd any) (any, erro
 		}
 		return nil, err
 	}
-
-	xpub, err := w.AccountXpub(ctx, account)
-	if err != nil {
-		return nil, err
-	}
-	extBranch, err := xpub.Child(0)
+	recipient, err := decodeAddress(cmd.Recipient, w.ChainParams())
 	if err != nil {
 		return nil, err
 	}
-	intBranch, err := xpub.Child(1)
-	if err != nil {
+	coinType := cointype.CoinType(*cmd.CoinType)
+	if err := validateCoinType(coinType); err != nil {
 		return nil, err
 	}
-	endExt, endInt, err := w.BIP0044BranchNextIndexes(ctx, account)
-	if err != nil {
-		return nil, err
+	amount, err := coinsToAtomsBig(cmd.Amount, getAtomsPerCoin(w.ChainParams(), coinType))
+	if err != nil || amount.Sign() <= 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"invalid amount %q", cmd.Amount)
 	}
-	params := w.ChainParams()
-	addrs := make([]string, 0, endExt+endInt)
-	appendAddrs := func(branchKey *hdkeychain.ExtendedKey, n uint32) error {
-		for i := uint32(0); i < n; i++ {
-			child, err := branchKey.Child(i)
-			if errors.Is(err, hdkeychain.ErrInvalidChild) {
-				continue
-			}
-			if err != nil {
-				return err
-			}
-			pkh := dcrutil.Hash160(child.SerializedPubKey())
-			addr, _ := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
-				pkh, params)
-			addrs = append(addrs, addr.String())
+
+	var secret []byte
+	var secretHash [32]byte
+	lockTime := time.Now().Add(atomicswap.ParticipantLockTime).Unix()
+	if cmd.SecretHash != nil {
+		h, err := hex.DecodeString(*cmd.SecretHash)
+		if err != nil || len(h) != len(secretHash) {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"secret hash must be %d hex-encoded bytes", len(secretHash))
 		}
-		return nil
+		copy(secretHash[:], h)
+	} else {
+		generated, h, err := contracts.GenerateSecret()
+		if err != nil {
+			return nil, err
+		}
+		secret, secretHash = generated[:], h
+		lockTime = time.Now().Add(atomicswap.InitiatorLockTime).Unix()
+	}
+	if cmd.LockTime != nil {
+		lockTime = *cmd.LockTime
+	}
+
+	c, err := w.CreateContract(ctx, account, coinType, amount, recipient,
+		&secretHash, lockTime)
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 	}
-	err = appendAddrs(extBranch, endExt)
 	if err != nil {
 		return nil, err
 	}
-	err = appendAddrs(intBranch, endInt)
-	if err != nil {
+	txBuf := new(strings.Builder)
+	if err := c.Tx.Serialize(hex.NewEncoder(txBuf)); err != nil {
 		return nil, err
 	}
-	return addressStringsMarshaler(addrs), nil
+	return &types.CreateContractResult{
+		TxID:       c.Tx.TxHash().String(),
+		Tx:         txBuf.String(),
+		Contract:   hex.EncodeToString(c.Contract),
+		Address:    c.Address.String(),
+		SecretHash: hex.EncodeToString(secretHash[:]),
+		Secret:     hex.EncodeToString(secret),
+		LockTime:   c.Terms.LockTime,
+	}, nil
 }
 
-// getBalance handles a getbalance request by returning the balance for an
-// account (wallet), or an error if the requested account does not
-// exist. Supports optional coin type filtering for dual-coin operations.
-func (s *Server) getBalance(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetBalanceCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// createInvoice handles a createinvoice request by recording an invoice paid
+// to a new address of an account.
+func (s *Server) createInvoice(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateInvoiceCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	minConf := int32(*cmd.MinConf)
-	if minConf < 0 {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "minconf must be non-negative")
+	coinType := cointype.CoinTypeVAR
+	if cmd.CoinType != nil {
+		coinType = cointype.CoinType(*cmd.CoinType)
+	}
+	if err := validateCoinType(coinType); err != nil {
+		return nil, err
+	}
+	atomsPerCoin := getAtomsPerCoin(w.ChainParams(), coinType)
+	decimals := len(atomsPerCoin.String()) - 1
+	if _, frac, _ := strings.Cut(cmd.Amount, "."); len(frac) > decimals {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"amount %s has more than %d decimal places", cmd.Amount, decimals)
+	}
+	amount, err := coinsToAtomsBig(cmd.Amount, atomsPerCoin)
+	if err != nil || amount.Sign() <= 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"invalid amount %q", cmd.Amount)
 	}
 
-	// Validate coin type if specified
-	if cmd.CoinType != nil {
-		coinType := cointype.CoinType(*cmd.CoinType)
-		if err := validateCoinType(coinType); err != nil {
+	account := uint32(udb.DefaultAccountNum)
+	if cmd.Account != nil {
+		account, err = w.AccountNumber(ctx, *cmd.Account)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
 			return nil, err
 		}
 	}
+	var label string
+	if cmd.Label != nil {
+		label = *cmd.Label
+	}
+	var expires time.Time
+	if cmd.Expires != nil {
+		expires = time.Unix(*cmd.Expires, 0)
+	}
 
-	accountName := "*"
-	if cmd.Account != nil {
-		accountName = *cmd.Account
+	inv, err := w.CreateInvoice(ctx, account, coinType, amount, label, expires)
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if err != nil {
+		return nil, err
 	}
+	return invoiceResult(inv, w.ChainParams()), nil
+}
 
-	blockHash, _ := w.MainChainTip(ctx)
-	result := types.GetBalanceResult{
-		BlockHash: blockHash.String(),
+// getInvoice handles a getinvoice request by describing an invoice.
+func (s *Server) getInvoice(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetInvoiceCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	if accountName == "*" {
-		// If coin type is specified, filter by coin type
-		if cmd.CoinType != nil {
-			coinType := cointype.CoinType(*cmd.CoinType)
-			atomsPerCoin := getAtomsPerCoin(w.ChainParams(), coinType)
-			allBalances, err := w.AccountBalances(ctx, minConf)
-			if err != nil {
-				return nil, err
-			}
+	inv, err := w.Invoice(ctx, cmd.ID)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return invoiceResult(inv, w.ChainParams()), nil
+}
 
-			// Filter for specified coin type and convert to result format
-			result.Balances = make([]types.GetAccountBalanceResult, 0, len(allBalances))
+// getKDFInfo handles a getkdfinfo request by describing the key derivation
+// function deriving the master private key from the private passphrase.
+func (s *Server) getKDFInfo(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-			// For SKA coins, use big.Int totals
-			isSKA := coinType.IsSKA()
-			var (
-				totImmatureCoinbase dcrutil.Amount
-				totImmatureStakegen dcrutil.Amount
-				totLocked           dcrutil.Amount
-				totSpendable        dcrutil.Amount
-				totUnconfirmed      dcrutil.Amount
-				totVotingAuthority  dcrutil.Amount
-				cumTot              dcrutil.Amount
-				// SKA big.Int totals
-				skaTotImmatureCoinbase cointype.SKAAmount // For SKA emission (coinbase-like)
-				skaTotSpendable        cointype.SKAAmount
-				skaTotUnconfirmed      cointype.SKAAmount
-				skaCumTot              cointype.SKAAmount
-			)
+	info, err := w.KDFInfo()
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetKDFInfoResult{
+		Algorithm:      info.Algorithm,
+		N:              info.N,
+		R:              info.R,
+		P:              info.P,
+		Time:           info.Time,
+		Memory:         info.Memory,
+		Threads:        uint32(info.Threads),
+		TargetTime:     info.Options.Time,
+		TargetMemory:   info.Options.Memory,
+		UpgradePending: info.UpgradePending,
+	}, nil
+}
 
-			for _, bal := range allBalances {
-				// Check if this account has balances for the requested coin type
-				coinBal, exists := bal.CoinTypeBalances[coinType]
-				if !exists {
-					continue
-				}
-				// For SKA, check SKATotal instead of Total (which may be 0 for large amounts)
-				hasSKABalance := isSKA && (!coinBal.SKATotal.IsZero() || !coinBal.SKAUnconfirmed.IsZero())
-				hasVARBalance := !isSKA && (coinBal.Total > 0 || coinBal.Unconfirmed > 0)
-				if hasSKABalance || hasVARBalance {
-					accountName, err := w.AccountName(ctx, bal.Account)
-					if err != nil {
-						if errors.Is(err, errors.NotExist) {
-							return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
-						}
-						return nil, err
-					}
+// listInvoices handles a listinvoices request by describing every invoice,
+// optionally only those with a status.
+func (s *Server) listInvoices(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ListInvoicesCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-					if isSKA {
-						// Use SKA big.Int fields for SKA coins
-						skaTotImmatureCoinbase = skaTotImmatureCoinbase.Add(coinBal.SKAImmatureCoinbaseRewards)
-						skaTotSpendable = skaTotSpendable.Add(coinBal.SKASpendable)
-						skaTotUnconfirmed = skaTotUnconfirmed.Add(coinBal.SKAUnconfirmed)
-						skaCumTot = skaCumTot.Add(coinBal.SKATotal)
+	var status *udb.InvoiceStatus
+	if cmd.Status != nil {
+		s, err := udb.ParseInvoiceStatus(*cmd.Status)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		status = &s
+	}
+	invoices, err := w.Invoices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.InvoiceResult, 0, len(invoices))
+	for _, inv := range invoices {
+		if status != nil && inv.Status != *status {
+			continue
+		}
+		res = append(res, invoiceResult(inv, w.ChainParams()))
+	}
+	return res, nil
+}
 
-						// Use decimal strings for full precision SKA amounts
-						json := types.GetAccountBalanceResult{
-							AccountName:             accountName,
-							ImmatureCoinbaseRewards: coinBal.SKAImmatureCoinbaseRewards.ToDecimalString(atomsPerCoin),
-							ImmatureStakeGeneration: coinBal.SKAImmatureStakeGeneration.ToDecimalString(atomsPerCoin),
-							LockedByTickets:         "0", // SKA doesn't participate in staking
-							Spendable:               coinBal.SKASpendable.ToDecimalString(atomsPerCoin),
-							Total:                   coinBal.SKATotal.ToDecimalString(atomsPerCoin),
-							Unconfirmed:             coinBal.SKAUnconfirmed.ToDecimalString(atomsPerCoin),
-							VotingAuthority:         "0", // SKA doesn't have voting authority
-						}
-						result.Balances = append(result.Balances, json)
-					} else {
-						// Use native .ToCoin() for VAR
-						totImmatureCoinbase += coinBal.ImmatureCoinbaseRewards
-						totImmatureStakegen += coinBal.ImmatureStakeGeneration
-						totLocked += coinBal.LockedByTickets
-						totSpendable += coinBal.Spendable
-						totUnconfirmed += coinBal.Unconfirmed
-						totVotingAuthority += coinBal.VotingAuthority
-						cumTot += coinBal.Total
+// disapprovePercent returns the wallets current disapprove percentage.
+func (s *Server) disapprovePercent(ctx context.Context, _ any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	return w.DisapprovePercent(), nil
+}
 
-						json := types.GetAccountBalanceResult{
-							AccountName:             accountName,
-							ImmatureCoinbaseRewards: coinBal.ImmatureCoinbaseRewards.ToCoin(),
-							ImmatureStakeGeneration: coinBal.ImmatureStakeGeneration.ToCoin(),
-							LockedByTickets:         coinBal.LockedByTickets.ToCoin(),
-							Spendable:               coinBal.Spendable.ToCoin(),
-							Total:                   coinBal.Total.ToCoin(),
-							Unconfirmed:             coinBal.Unconfirmed.ToCoin(),
-							VotingAuthority:         coinBal.VotingAuthority.ToCoin(),
-						}
-						result.Balances = append(result.Balances, json)
-					}
-				}
+func (s *Server) discoverUsage(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.DiscoverUsageCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	n, ok := s.loader(ctx).NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+
+	startBlock := w.ChainParams().GenesisHash
+	switch {
+	case cmd.StartBlock != nil:
+		h, err := chainhash.NewHashFromStr(*cmd.StartBlock)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "startblock: %v", err)
+		}
+		startBlock = *h
+	case cmd.FullScan == nil || !*cmd.FullScan:
+		birthday, _, err := w.BirthdayBlock(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if birthday != nil {
+			startBlock = *birthday
+		}
+	}
+	discoverAccounts := cmd.DiscoverAccounts != nil && *cmd.DiscoverAccounts
+
+	gapLimit := w.GapLimit()
+	if cmd.GapLimit != nil {
+		gapLimit = *cmd.GapLimit
+	}
+
+	err := w.DiscoverActiveAddresses(ctx, n, &startBlock, discoverAccounts, gapLimit)
+	return nil, err
+}
+
+// dumpPrivKey handles a dumpprivkey request with the private key
+// for a single address, or an appropriate error if the wallet
+// is locked.
+func (s *Server) dumpPrivKey(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.DumpPrivKeyCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := w.DumpWIFPrivateKey(ctx, addr)
+	if err != nil {
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		return nil, err
+	}
+	return key, nil
+}
+
+// exportAccountXpriv handles an exportaccountxpriv request by returning the
+// extended private key of an account, when enabled by the application
+// config.
+func (s *Server) exportAccountXpriv(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ExportAccountXprivCmd)
+	if !s.cfg.AccountKeyExport {
+		return nil, rpcErrorf(dcrjson.ErrRPCMisc,
+			"account key export is disabled; restart with --accountkeyexport")
+	}
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	xpriv, err := w.ExportAccountXpriv(ctx, account, []byte(cmd.Passphrase))
+	if err != nil {
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		return nil, err
+	}
+	defer xpriv.Zero()
+	return xpriv.String(), nil
+}
+
+// dumpAccountPrivKeys handles a dumpaccountprivkeys request by returning the
+// private keys of the addresses of an account, when enabled by the
+// application config.
+func (s *Server) dumpAccountPrivKeys(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.DumpAccountPrivKeysCmd)
+	if !s.cfg.AccountKeyExport {
+		return nil, rpcErrorf(dcrjson.ErrRPCMisc,
+			"account key export is disabled; restart with --accountkeyexport")
+	}
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	keys, err := w.DumpAccountPrivKeys(ctx, account, []byte(cmd.Passphrase))
+	if err != nil {
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		return nil, err
+	}
+	res := make([]types.AccountPrivKeyResult, len(keys))
+	for i := range keys {
+		res[i] = types.AccountPrivKeyResult{
+			Address: keys[i].Address.String(),
+			Branch:  keys[i].Branch,
+			Index:   keys[i].Index,
+			PrivKey: keys[i].WIF,
+		}
+	}
+	return res, nil
+}
+
+// estimateSendFee handles an estimatesendfee request by authoring, without
+// signing or publishing, the transaction sendmany creates for the same
+// parameters, and describing its fee, size, inputs and change.
+func (s *Server) estimateSendFee(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.EstimateSendFeeCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var coinType cointype.CoinType = cointype.CoinTypeVAR
+	if cmd.CoinType != nil {
+		coinType = cointype.CoinType(*cmd.CoinType)
+		if err := validateCoinType(coinType); err != nil {
+			return nil, err
+		}
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.FromAccount)
+	if err != nil {
+		return nil, err
+	}
+	changeAccount := account
+	if s.cfg.MixingEnabled && s.cfg.MixAccount != "" && s.cfg.MixChangeAccount != "" {
+		mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
+		if err != nil {
+			return nil, err
+		}
+		if account == mixAccount {
+			changeAccount, err = w.AccountNumber(ctx, s.cfg.MixChangeAccount)
+			if err != nil {
+				return nil, err
 			}
+		}
+	}
 
-			if isSKA {
-				result.TotalImmatureCoinbaseRewards = skaTotImmatureCoinbase.ToDecimalString(atomsPerCoin)
-				result.TotalImmatureStakeGeneration = "0"
-				result.TotalLockedByTickets = "0"
-				result.TotalSpendable = skaTotSpendable.ToDecimalString(atomsPerCoin)
-				result.TotalUnconfirmed = skaTotUnconfirmed.ToDecimalString(atomsPerCoin)
-				result.TotalVotingAuthority = "0"
-				result.CumulativeTotal = skaCumTot.ToDecimalString(atomsPerCoin)
-			} else {
-				result.TotalImmatureCoinbaseRewards = totImmatureCoinbase.ToCoin()
-				result.TotalImmatureStakeGeneration = totImmatureStakegen.ToCoin()
-				result.TotalLockedByTickets = totLocked.ToCoin()
-				result.TotalSpendable = totSpendable.ToCoin()
-				result.TotalUnconfirmed = totUnconfirmed.ToCoin()
-				result.TotalVotingAuthority = totVotingAuthority.ToCoin()
-				result.CumulativeTotal = cumTot.ToCoin()
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
+	}
+
+	// Amounts are converted to outputs as by sendmany.
+	params := w.ChainParams()
+	atomsPerCoin := getAtomsPerCoin(params, coinType)
+	var outputs []*wire.TxOut
+	if coinType.IsSKA() {
+		pairs := make(map[string]*big.Int, len(cmd.Amounts))
+		for k, v := range cmd.Amounts {
+			amt, err := coinsToAtomsBig(v, atomsPerCoin)
+			if err != nil {
+				return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "invalid amount for %s: %v", k, err)
+			}
+			pairs[k] = amt
+		}
+		outputs, err = makeOutputsWithCoinTypeBig(pairs, params, coinType)
+	} else {
+		pairs := make(map[string]dcrutil.Amount, len(cmd.Amounts))
+		for k, v := range cmd.Amounts {
+			amt, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "invalid amount for %s: %v", k, err)
 			}
+			pairs[k] = dcrutil.Amount(coinsToAtoms(amt, atomsPerCoin))
+		}
+		outputs, err = makeOutputsWithCoinType(pairs, params, coinType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	opts := new(wallet.SendOptions)
+	if cmd.SubtractFeeFrom != nil {
+		opts.SubtractFeeFrom, err = subtractFeeOutputs(outputs,
+			*cmd.SubtractFeeFrom, params)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cmd.FeePreference != nil {
+		opts.FeeRate, err = feeRateForPreference(ctx, w, coinType, *cmd.FeePreference)
+		if err != nil {
+			return nil, err
+		}
+	}
+	est, err := w.EstimateSendFee(ctx, outputs, account, changeAccount, minConf, opts)
+	if err != nil {
+		if errors.Is(err, errors.InsufficientBalance) {
+			return nil, rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+		}
+		return nil, err
+	}
+
+	res := &types.EstimateSendFeeResult{
+		CoinType: uint8(coinType),
+		Fee:      coinAmount(params, coinType, big.NewInt(int64(est.Fee))),
+		FeeRate:  coinAmount(params, coinType, big.NewInt(int64(est.FeeRate))),
+		Size:     est.EstimatedSignedSerializeSize,
+		Inputs:   make([]types.EstimateSendFeeInputResult, 0, len(est.Tx.TxIn)),
+	}
+	for _, in := range est.Tx.TxIn {
+		atoms := big.NewInt(in.ValueIn)
+		if coinType.IsSKA() && in.SKAValueIn != nil {
+			atoms = in.SKAValueIn
+		}
+		prev := &in.PreviousOutPoint
+		res.Inputs = append(res.Inputs, types.EstimateSendFeeInputResult{
+			TxID:   prev.Hash.String(),
+			Vout:   prev.Index,
+			Tree:   prev.Tree,
+			Amount: coinAmount(params, coinType, atoms),
+		})
+	}
+	if est.ChangeIndex >= 0 {
+		change := est.Tx.TxOut[est.ChangeIndex]
+		res.Change = coinAmount(params, coinType, sendOutputAmount(change))
+	}
+	return res, nil
+}
+
+// finalizePSDT handles the finalizepsdt command, creating the signature
+// scripts of PSDT inputs and optionally extracting the signed transaction.
+func (s *Server) finalizePSDT(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.FinalizePSDTCmd)
+
+	p, err := parsePSDT(cmd.PSDT)
+	if err != nil {
+		return nil, err
+	}
+	encoded, complete, txHex, err := finalizePSDTResult(p, *cmd.Extract)
+	if err != nil {
+		return nil, err
+	}
+	return &types.FinalizePSDTResult{
+		PSDT:     encoded,
+		Complete: complete,
+		Hex:      txHex,
+	}, nil
+}
+
+func (s *Server) fundRawTransaction(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.FundRawTransactionCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var (
+		changeAddress string
+		feeRate       dcrutil.Amount // zero uses the coin type's relay fee
+		confs         = int32(1)
+	)
+	if cmd.Options != nil {
+		opts := cmd.Options
+		var err error
+		if opts.ChangeAddress != nil {
+			changeAddress = *opts.ChangeAddress
+		}
+		if opts.FeeRate != nil {
+			feeRate, err = dcrutil.NewAmount(*opts.FeeRate)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if opts.ConfTarget != nil {
+			confs = *opts.ConfTarget
+			if confs < 0 {
+				return nil, errors.New("confs must be non-negative")
+			}
+		}
+	}
+
+	tx := new(wire.MsgTx)
+	err := tx.Deserialize(hex.NewDecoder(strings.NewReader(cmd.HexString)))
+	if err != nil {
+		return nil, err
+	}
+
+	accountNum, err := w.AccountNumber(ctx, cmd.FundAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	var changeSource txauthor.ChangeSource
+	if changeAddress != "" {
+		var err error
+		changeSource, err = makeScriptChangeSource(changeAddress, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Existing inputs must spend wallet outputs, so their signature script
+	// sizes are known for fee estimation.  Additional inputs of the coin
+	// type of the outputs are selected after them.
+	funded, err := w.FundTransaction(ctx, tx, feeRate, accountNum, confs,
+		changeSource)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	tx = funded.Tx
+
+	b := new(strings.Builder)
+	b.Grow(2 * tx.SerializeSize())
+	err = tx.Serialize(hex.NewEncoder(b))
+	if err != nil {
+		return nil, err
+	}
+	res := &types.FundRawTransactionResult{
+		Hex: b.String(),
+		Fee: atomsToCoins(int64(funded.Fee),
+			getAtomsPerCoin(w.ChainParams(), funded.CoinType)),
+	}
+	return res, nil
+}
+
+// getAddressesByAccount handles a getaddressesbyaccount request by returning
+// all addresses for an account, or an error if the requested account does
+// not exist.
+func (s *Server) getAddressesByAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetAddressesByAccountCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if cmd.Account == "imported" {
+		addrs, err := w.ImportedAddresses(ctx, cmd.Account)
+		if err != nil {
+			return nil, err
+		}
+		return knownAddressMarshaler(addrs), nil
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	xpub, err := w.AccountXpub(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	extBranch, err := xpub.Child(0)
+	if err != nil {
+		return nil, err
+	}
+	intBranch, err := xpub.Child(1)
+	if err != nil {
+		return nil, err
+	}
+	endExt, endInt, err := w.BIP0044BranchNextIndexes(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	params := w.ChainParams()
+	addrs := make([]string, 0, endExt+endInt)
+	appendAddrs := func(branchKey *hdkeychain.ExtendedKey, n uint32) error {
+		for i := uint32(0); i < n; i++ {
+			child, err := branchKey.Child(i)
+			if errors.Is(err, hdkeychain.ErrInvalidChild) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			pkh := dcrutil.Hash160(child.SerializedPubKey())
+			addr, _ := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+				pkh, params)
+			addrs = append(addrs, addr.String())
+		}
+		return nil
+	}
+	err = appendAddrs(extBranch, endExt)
+	if err != nil {
+		return nil, err
+	}
+	err = appendAddrs(intBranch, endInt)
+	if err != nil {
+		return nil, err
+	}
+	return addressStringsMarshaler(addrs), nil
+}
+
+// getBalance handles a getbalance request by returning the balance for an
+// account (wallet), or an error if the requested account does not
+// exist. Supports optional coin type filtering for dual-coin operations.
+// Archived accounts are omitted from the per-account balances of the "*"
+// account, but are included in the totals.
+func (s *Server) getBalance(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetBalanceCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	// Serve the request from a single consistent view of the database.
+	ctx, release, err := w.Snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "minconf must be non-negative")
+	}
+
+	// Validate coin type if specified
+	if cmd.CoinType != nil {
+		coinType := cointype.CoinType(*cmd.CoinType)
+		if err := validateCoinType(coinType); err != nil {
+			return nil, err
+		}
+	}
+
+	accountName := "*"
+	if cmd.Account != nil {
+		accountName = *cmd.Account
+	}
+
+	blockHash, _ := w.MainChainTip(ctx)
+	result := types.GetBalanceResult{
+		BlockHash: blockHash.String(),
+	}
+
+	if accountName == "*" {
+		// If coin type is specified, filter by coin type
+		if cmd.CoinType != nil {
+			coinType := cointype.CoinType(*cmd.CoinType)
+			atomsPerCoin := getAtomsPerCoin(w.ChainParams(), coinType)
+			allBalances, err := w.AccountBalances(ctx, minConf)
+			if err != nil {
+				return nil, err
+			}
+
+			// Filter for specified coin type and convert to result format
+			result.Balances = make([]types.GetAccountBalanceResult, 0, len(allBalances))
+
+			// For SKA coins, use big.Int totals
+			isSKA := coinType.IsSKA()
+			var (
+				totImmatureCoinbase dcrutil.Amount
+				totImmatureStakegen dcrutil.Amount
+				totLocked           dcrutil.Amount
+				totSpendable        dcrutil.Amount
+				totUnconfirmed      dcrutil.Amount
+				totVotingAuthority  dcrutil.Amount
+				cumTot              dcrutil.Amount
+				// SKA big.Int totals
+				skaTotImmatureCoinbase cointype.SKAAmount // For SKA emission (coinbase-like)
+				skaTotSpendable        cointype.SKAAmount
+				skaTotUnconfirmed      cointype.SKAAmount
+				skaCumTot              cointype.SKAAmount
+			)
+
+			for _, bal := range allBalances {
+				// Check if this account has balances for the requested coin type
+				coinBal, exists := bal.CoinTypeBalances[coinType]
+				if !exists {
+					continue
+				}
+				// For SKA, check SKATotal instead of Total (which may be 0 for large amounts)
+				hasSKABalance := isSKA && (!coinBal.SKATotal.IsZero() || !coinBal.SKAUnconfirmed.IsZero())
+				hasVARBalance := !isSKA && (coinBal.Total > 0 || coinBal.Unconfirmed > 0)
+				if hasSKABalance || hasVARBalance {
+					accountName, err := w.AccountName(ctx, bal.Account)
+					if err != nil {
+						if errors.Is(err, errors.NotExist) {
+							return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
+						}
+						return nil, err
+					}
+					archived, err := w.AccountArchived(ctx, bal.Account)
+					if err != nil {
+						return nil, err
+					}
+
+					if isSKA {
+						// Use SKA big.Int fields for SKA coins
+						skaTotImmatureCoinbase = skaTotImmatureCoinbase.Add(coinBal.SKAImmatureCoinbaseRewards)
+						skaTotSpendable = skaTotSpendable.Add(coinBal.SKASpendable)
+						skaTotUnconfirmed = skaTotUnconfirmed.Add(coinBal.SKAUnconfirmed)
+						skaCumTot = skaCumTot.Add(coinBal.SKATotal)
+
+						// Use decimal strings for full precision SKA amounts
+						json := types.GetAccountBalanceResult{
+							AccountName:             accountName,
+							ImmatureCoinbaseRewards: coinBal.SKAImmatureCoinbaseRewards.ToDecimalString(atomsPerCoin),
+							ImmatureStakeGeneration: coinBal.SKAImmatureStakeGeneration.ToDecimalString(atomsPerCoin),
+							LockedByTickets:         "0", // SKA doesn't participate in staking
+							Spendable:               coinBal.SKASpendable.ToDecimalString(atomsPerCoin),
+							Total:                   coinBal.SKATotal.ToDecimalString(atomsPerCoin),
+							Unconfirmed:             coinBal.SKAUnconfirmed.ToDecimalString(atomsPerCoin),
+							VotingAuthority:         "0", // SKA doesn't have voting authority
+						}
+						if !archived {
+							result.Balances = append(result.Balances, json)
+						}
+					} else {
+						// Use native .ToCoin() for VAR
+						totImmatureCoinbase += coinBal.ImmatureCoinbaseRewards
+						totImmatureStakegen += coinBal.ImmatureStakeGeneration
+						totLocked += coinBal.LockedByTickets
+						totSpendable += coinBal.Spendable
+						totUnconfirmed += coinBal.Unconfirmed
+						totVotingAuthority += coinBal.VotingAuthority
+						cumTot += coinBal.Total
+
+						json := types.GetAccountBalanceResult{
+							AccountName:             accountName,
+							ImmatureCoinbaseRewards: coinBal.ImmatureCoinbaseRewards.ToCoin(),
+							ImmatureStakeGeneration: coinBal.ImmatureStakeGeneration.ToCoin(),
+							LockedByTickets:         coinBal.LockedByTickets.ToCoin(),
+							Spendable:               coinBal.Spendable.ToCoin(),
+							Total:                   coinBal.Total.ToCoin(),
+							Unconfirmed:             coinBal.Unconfirmed.ToCoin(),
+							VotingAuthority:         coinBal.VotingAuthority.ToCoin(),
+						}
+						if !archived {
+							result.Balances = append(result.Balances, json)
+						}
+					}
+				}
+			}
+
+			if isSKA {
+				result.TotalImmatureCoinbaseRewards = skaTotImmatureCoinbase.ToDecimalString(atomsPerCoin)
+				result.TotalImmatureStakeGeneration = "0"
+				result.TotalLockedByTickets = "0"
+				result.TotalSpendable = skaTotSpendable.ToDecimalString(atomsPerCoin)
+				result.TotalUnconfirmed = skaTotUnconfirmed.ToDecimalString(atomsPerCoin)
+				result.TotalVotingAuthority = "0"
+				result.CumulativeTotal = skaCumTot.ToDecimalString(atomsPerCoin)
+			} else {
+				result.TotalImmatureCoinbaseRewards = totImmatureCoinbase.ToCoin()
+				result.TotalImmatureStakeGeneration = totImmatureStakegen.ToCoin()
+				result.TotalLockedByTickets = totLocked.ToCoin()
+				result.TotalSpendable = totSpendable.ToCoin()
+				result.TotalUnconfirmed = totUnconfirmed.ToCoin()
+				result.TotalVotingAuthority = totVotingAuthority.ToCoin()
+				result.CumulativeTotal = cumTot.ToCoin()
+			}
+
+			return result, nil
+		}
+
+		// Default behavior (backward compatible): use existing AccountBalances for VAR
+		balances, err := w.AccountBalances(ctx, int32(*cmd.MinConf))
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			totImmatureCoinbase dcrutil.Amount
+			totImmatureStakegen dcrutil.Amount
+			totLocked           dcrutil.Amount
+			totSpendable        dcrutil.Amount
+			totUnconfirmed      dcrutil.Amount
+			totVotingAuthority  dcrutil.Amount
+			cumTot              dcrutil.Amount
+		)
+
+		balancesLen := uint32(len(balances))
+		result.Balances = make([]types.GetAccountBalanceResult, 0, balancesLen)
+
+		for _, bal := range balances {
+			accountName, err := w.AccountName(ctx, bal.Account)
+			if err != nil {
+				// Expect account lookup to succeed
+				if errors.Is(err, errors.NotExist) {
+					return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
+				}
+				return nil, err
+			}
+			archived, err := w.AccountArchived(ctx, bal.Account)
+			if err != nil {
+				return nil, err
+			}
+
+			totImmatureCoinbase += bal.ImmatureCoinbaseRewards
+			totImmatureStakegen += bal.ImmatureStakeGeneration
+			totLocked += bal.LockedByTickets
+			totSpendable += bal.Spendable
+			totUnconfirmed += bal.Unconfirmed
+			totVotingAuthority += bal.VotingAuthority
+			cumTot += bal.Total
+
+			json := types.GetAccountBalanceResult{
+				AccountName:             accountName,
+				ImmatureCoinbaseRewards: bal.ImmatureCoinbaseRewards.ToCoin(),
+				ImmatureStakeGeneration: bal.ImmatureStakeGeneration.ToCoin(),
+				LockedByTickets:         bal.LockedByTickets.ToCoin(),
+				Spendable:               bal.Spendable.ToCoin(),
+				Total:                   bal.Total.ToCoin(),
+				Unconfirmed:             bal.Unconfirmed.ToCoin(),
+				VotingAuthority:         bal.VotingAuthority.ToCoin(),
+			}
+
+			// Archived accounts are counted in the totals but are not
+			// listed individually.
+			if !archived {
+				result.Balances = append(result.Balances, json)
+			}
+		}
+
+		result.TotalImmatureCoinbaseRewards = totImmatureCoinbase.ToCoin()
+		result.TotalImmatureStakeGeneration = totImmatureStakegen.ToCoin()
+		result.TotalLockedByTickets = totLocked.ToCoin()
+		result.TotalSpendable = totSpendable.ToCoin()
+		result.TotalUnconfirmed = totUnconfirmed.ToCoin()
+		result.TotalVotingAuthority = totVotingAuthority.ToCoin()
+		result.CumulativeTotal = cumTot.ToCoin()
+	} else {
+		account, err := w.AccountNumber(ctx, accountName)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+
+		// If coin type is specified, use coin-type specific balance for single account
+		if cmd.CoinType != nil {
+			coinType := cointype.CoinType(*cmd.CoinType)
+			atomsPerCoin := getAtomsPerCoin(w.ChainParams(), coinType)
+			coinBal, err := w.AccountBalanceByCoinType(ctx, account, coinType, minConf)
+			if err != nil {
+				return nil, err
+			}
+
+			var json types.GetAccountBalanceResult
+			if coinType.IsSKA() {
+				// Use decimal strings for full precision SKA amounts
+				json = types.GetAccountBalanceResult{
+					AccountName:             accountName,
+					ImmatureCoinbaseRewards: coinBal.SKAImmatureCoinbaseRewards.ToDecimalString(atomsPerCoin),
+					ImmatureStakeGeneration: coinBal.SKAImmatureStakeGeneration.ToDecimalString(atomsPerCoin),
+					LockedByTickets:         "0",
+					Spendable:               coinBal.SKASpendable.ToDecimalString(atomsPerCoin),
+					Total:                   coinBal.SKATotal.ToDecimalString(atomsPerCoin),
+					Unconfirmed:             coinBal.SKAUnconfirmed.ToDecimalString(atomsPerCoin),
+					VotingAuthority:         "0",
+				}
+			} else {
+				// Use native .ToCoin() for VAR
+				json = types.GetAccountBalanceResult{
+					AccountName:             accountName,
+					ImmatureCoinbaseRewards: coinBal.ImmatureCoinbaseRewards.ToCoin(),
+					ImmatureStakeGeneration: coinBal.ImmatureStakeGeneration.ToCoin(),
+					LockedByTickets:         coinBal.LockedByTickets.ToCoin(),
+					Spendable:               coinBal.Spendable.ToCoin(),
+					Total:                   coinBal.Total.ToCoin(),
+					Unconfirmed:             coinBal.Unconfirmed.ToCoin(),
+					VotingAuthority:         coinBal.VotingAuthority.ToCoin(),
+				}
+			}
+			result.Balances = append(result.Balances, json)
+		} else {
+			// Default behavior (backward compatible): use existing AccountBalance for VAR
+			bal, err := w.AccountBalance(ctx, account, minConf)
+			if err != nil {
+				// Expect account lookup to succeed
+				if errors.Is(err, errors.NotExist) {
+					return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
+				}
+				return nil, err
+			}
+			json := types.GetAccountBalanceResult{
+				AccountName:             accountName,
+				ImmatureCoinbaseRewards: bal.ImmatureCoinbaseRewards.ToCoin(),
+				ImmatureStakeGeneration: bal.ImmatureStakeGeneration.ToCoin(),
+				LockedByTickets:         bal.LockedByTickets.ToCoin(),
+				Spendable:               bal.Spendable.ToCoin(),
+				Total:                   bal.Total.ToCoin(),
+				Unconfirmed:             bal.Unconfirmed.ToCoin(),
+				VotingAuthority:         bal.VotingAuthority.ToCoin(),
+			}
+			result.Balances = append(result.Balances, json)
+		}
+	}
+
+	return result, nil
+}
+
+// getBestBlock handles a getbestblock request by returning a JSON object
+// with the height and hash of the most recently processed block.
+func (s *Server) getBestBlock(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	hash, height := w.MainChainTip(ctx)
+	result := &dcrdtypes.GetBestBlockResult{
+		Hash:   hash.String(),
+		Height: int64(height),
+	}
+	return result, nil
+}
+
+// getBestBlockHash handles a getbestblockhash request by returning the hash
+// of the most recently processed block.
+func (s *Server) getBestBlockHash(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	hash, _ := w.MainChainTip(ctx)
+	return hash.String(), nil
+}
+
+// getBlockCount handles a getblockcount request by returning the chain height
+// of the most recently processed block.
+func (s *Server) getBlockCount(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	_, height := w.MainChainTip(ctx)
+	return height, nil
+}
+
+// getBlockHash handles a getblockhash request by returning the main chain hash
+// for a block at some height.
+func (s *Server) getBlockHash(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetBlockHashCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	height := int32(cmd.Index)
+	id := wallet.NewBlockIdentifierFromHeight(height)
+	info, err := w.BlockInfo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return info.Hash.String(), nil
+}
+
+// getBlockHeader implements the getblockheader command.
+func (s *Server) getBlockHeader(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetBlockHeaderCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	// Attempt RPC passthrough if connected to DCRD.
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, err
+	}
+	if chainSyncer, ok := n.(*chain.Syncer); ok {
+		var resp json.RawMessage
+		err := chainSyncer.RPC().Call(ctx, "getblockheader", &resp, cmd.Hash, cmd.Verbose)
+		return resp, err
+	}
+
+	blockHash, err := chainhash.NewHashFromStr(cmd.Hash)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+
+	blockHeader, err := w.BlockHeader(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// When the verbose flag isn't set, simply return the serialized block
+	// header as a hex-encoded string.
+	if cmd.Verbose == nil || !*cmd.Verbose {
+		var headerBuf bytes.Buffer
+		err := blockHeader.Serialize(&headerBuf)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Could not serialize block header: %v", err)
+		}
+		return hex.EncodeToString(headerBuf.Bytes()), nil
+	}
+
+	// The verbose flag is set, so generate the JSON object and return it.
+
+	// Get next block hash unless there are none.
+	var nextHashString string
+	confirmations := int64(-1)
+	mainChainHasBlock, _, err := w.BlockInMainChain(ctx, blockHash)
+	if err != nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Error checking if block is in mainchain: %v", err)
+	}
+	if mainChainHasBlock {
+		blockHeight := int32(blockHeader.Height)
+		_, bestHeight := w.MainChainTip(ctx)
+		if blockHeight < bestHeight {
+			nextBlockID := wallet.NewBlockIdentifierFromHeight(blockHeight + 1)
+			nextBlockInfo, err := w.BlockInfo(ctx, nextBlockID)
+			if err != nil {
+				return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Info not found for next block: %v", err)
+			}
+			nextHashString = nextBlockInfo.Hash.String()
+		}
+		confirmations = int64(confirms(blockHeight, bestHeight))
+	}
+
+	// Calculate past median time. Look at the last 11 blocks, starting
+	// with the requested block, which is consistent with dcrd.
+	iBlkHeader := blockHeader // start with the block header for the requested block
+	timestamps := make([]int64, 0, 11)
+	for i := 0; i < cap(timestamps); i++ {
+		timestamps = append(timestamps, iBlkHeader.Timestamp.Unix())
+		if iBlkHeader.Height == 0 {
+			break
+		}
+		iBlkHeader, err = w.BlockHeader(ctx, &iBlkHeader.PrevBlock)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Info not found for previous block: %v", err)
+		}
+	}
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i] < timestamps[j]
+	})
+	medianTime := timestamps[len(timestamps)/2]
+
+	// Determine the PoW hash.  When the v1 PoW hash differs from the
+	// block hash, this is assumed to be v2 (DCP0011).  More advanced
+	// selection logic will be necessary if the PoW hash changes again in
+	// the future.
+	powHash := blockHeader.PowHashV1()
+	if powHash != *blockHash {
+		powHash = blockHeader.PowHashV2()
+	}
+
+	return &dcrdtypes.GetBlockHeaderVerboseResult{
+		Hash:          blockHash.String(),
+		PowHash:       powHash.String(),
+		Confirmations: confirmations,
+		Version:       blockHeader.Version,
+		MerkleRoot:    blockHeader.MerkleRoot.String(),
+		StakeRoot:     blockHeader.StakeRoot.String(),
+		VoteBits:      blockHeader.VoteBits,
+		FinalState:    hex.EncodeToString(blockHeader.FinalState[:]),
+		Voters:        blockHeader.Voters,
+		FreshStake:    blockHeader.FreshStake,
+		Revocations:   blockHeader.Revocations,
+		PoolSize:      blockHeader.PoolSize,
+		Bits:          strconv.FormatInt(int64(blockHeader.Bits), 16),
+		SBits:         dcrutil.Amount(blockHeader.SBits).ToCoin(),
+		Height:        blockHeader.Height,
+		Size:          blockHeader.Size,
+		Time:          blockHeader.Timestamp.Unix(),
+		MedianTime:    medianTime,
+		Nonce:         blockHeader.Nonce,
+		ExtraData:     hex.EncodeToString(blockHeader.ExtraData[:]),
+		StakeVersion:  blockHeader.StakeVersion,
+		Difficulty:    difficultyRatio(blockHeader.Bits, w.ChainParams()),
+		ChainWork:     "", // unset because wallet is not equipped to easily calculate the cummulative chainwork
+		PreviousHash:  blockHeader.PrevBlock.String(),
+		NextHash:      nextHashString,
+	}, nil
+}
+
+// getBlock implements the getblock command.
+func (s *Server) getBlock(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetBlockCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	// Attempt RPC passthrough if connected to DCRD.
+	if chainSyncer, ok := n.(*chain.Syncer); ok {
+		var resp json.RawMessage
+		err := chainSyncer.RPC().Call(ctx, "getblock", &resp, cmd.Hash, cmd.Verbose, cmd.VerboseTx)
+		return resp, err
+	}
+
+	blockHash, err := chainhash.NewHashFromStr(cmd.Hash)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+
+	blocks, err := n.Blocks(ctx, []*chainhash.Hash{blockHash})
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) == 0 {
+		// Should never happen but protects against a possible panic on
+		// the following code.
+		return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Network returned 0 blocks")
+	}
+
+	blk := blocks[0]
+
+	// When the verbose flag isn't set, simply return the
+	// network-serialized block as a hex-encoded string.
+	if cmd.Verbose == nil || !*cmd.Verbose {
+		b := new(strings.Builder)
+		b.Grow(2 * blk.SerializeSize())
+		err = blk.Serialize(hex.NewEncoder(b))
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Could not serialize block: %v", err)
+		}
+		return b.String(), nil
+	}
+
+	// Get next block hash unless there are none.
+	var nextHashString string
+	blockHeader := &blk.Header
+	confirmations := int64(-1)
+	mainChainHasBlock, _, err := w.BlockInMainChain(ctx, blockHash)
+	if err != nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Error checking if block is in mainchain: %v", err)
+	}
+	if mainChainHasBlock {
+		blockHeight := int32(blockHeader.Height)
+		_, bestHeight := w.MainChainTip(ctx)
+		if blockHeight < bestHeight {
+			nextBlockID := wallet.NewBlockIdentifierFromHeight(blockHeight + 1)
+			nextBlockInfo, err := w.BlockInfo(ctx, nextBlockID)
+			if err != nil {
+				return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Info not found for next block: %v", err)
+			}
+			nextHashString = nextBlockInfo.Hash.String()
+		}
+		confirmations = int64(confirms(blockHeight, bestHeight))
+	}
+
+	// Calculate past median time. Look at the last 11 blocks, starting
+	// with the requested block, which is consistent with dcrd.
+	timestamps := make([]int64, 0, 11)
+	for iBlkHeader := blockHeader; ; {
+		timestamps = append(timestamps, iBlkHeader.Timestamp.Unix())
+		if iBlkHeader.Height == 0 || len(timestamps) == cap(timestamps) {
+			break
+		}
+		iBlkHeader, err = w.BlockHeader(ctx, &iBlkHeader.PrevBlock)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Info not found for previous block: %v", err)
+		}
+	}
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i] < timestamps[j]
+	})
+	medianTime := timestamps[len(timestamps)/2]
+
+	// Determine the PoW hash.  When the v1 PoW hash differs from the
+	// block hash, this is assumed to be v2 (DCP0011).  More advanced
+	// selection logic will be necessary if the PoW hash changes again in
+	// the future.
+	powHash := blockHeader.PowHashV1()
+	if powHash != *blockHash {
+		powHash = blockHeader.PowHashV2()
+	}
+
+	sbitsFloat := float64(blockHeader.SBits) / cointype.AtomsPerVAR
+	blockReply := dcrdtypes.GetBlockVerboseResult{
+		Hash:          cmd.Hash,
+		PoWHash:       powHash.String(),
+		Version:       blockHeader.Version,
+		MerkleRoot:    blockHeader.MerkleRoot.String(),
+		StakeRoot:     blockHeader.StakeRoot.String(),
+		PreviousHash:  blockHeader.PrevBlock.String(),
+		MedianTime:    medianTime,
+		Nonce:         blockHeader.Nonce,
+		VoteBits:      blockHeader.VoteBits,
+		FinalState:    hex.EncodeToString(blockHeader.FinalState[:]),
+		Voters:        blockHeader.Voters,
+		FreshStake:    blockHeader.FreshStake,
+		Revocations:   blockHeader.Revocations,
+		PoolSize:      blockHeader.PoolSize,
+		Time:          blockHeader.Timestamp.Unix(),
+		StakeVersion:  blockHeader.StakeVersion,
+		Confirmations: confirmations,
+		Height:        int64(blockHeader.Height),
+		Size:          int32(blk.Header.Size),
+		Bits:          strconv.FormatInt(int64(blockHeader.Bits), 16),
+		SBits:         sbitsFloat,
+		Difficulty:    difficultyRatio(blockHeader.Bits, w.ChainParams()),
+		ChainWork:     "", // unset because wallet is not equipped to easily calculate the cummulative chainwork
+		ExtraData:     hex.EncodeToString(blockHeader.ExtraData[:]),
+		NextHash:      nextHashString,
+	}
+
+	// The coinbase must be version 3 once the treasury agenda is active.
+	isTreasuryEnabled := blk.Transactions[0].Version >= wire.TxVersionTreasury
+
+	if cmd.VerboseTx == nil || !*cmd.VerboseTx {
+		transactions := blk.Transactions
+		txNames := make([]string, len(transactions))
+		for i, tx := range transactions {
+			txNames[i] = tx.TxHash().String()
+		}
+		blockReply.Tx = txNames
+
+		stransactions := blk.STransactions
+		stxNames := make([]string, len(stransactions))
+		for i, tx := range stransactions {
+			stxNames[i] = tx.TxHash().String()
+		}
+		blockReply.STx = stxNames
+	} else {
+		txns := blk.Transactions
+		rawTxns := make([]dcrdtypes.TxRawResult, len(txns))
+		for i, tx := range txns {
+			rawTxn, err := createTxRawResult(w.ChainParams(), tx, uint32(i), blockHeader, confirmations, isTreasuryEnabled)
+			if err != nil {
+				return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Could not create transaction: %v", err)
+			}
+			rawTxns[i] = *rawTxn
+		}
+		blockReply.RawTx = rawTxns
+
+		stxns := blk.STransactions
+		rawSTxns := make([]dcrdtypes.TxRawResult, len(stxns))
+		for i, tx := range stxns {
+			rawSTxn, err := createTxRawResult(w.ChainParams(), tx, uint32(i), blockHeader, confirmations, isTreasuryEnabled)
+			if err != nil {
+				return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Could not create stake transaction: %v", err)
+			}
+			rawSTxns[i] = *rawSTxn
+		}
+		blockReply.RawSTx = rawSTxns
+	}
+
+	return blockReply, nil
+}
+
+func createTxRawResult(chainParams *chaincfg.Params, mtx *wire.MsgTx, blkIdx uint32, blkHeader *wire.BlockHeader,
+	confirmations int64, isTreasuryEnabled bool) (*dcrdtypes.TxRawResult, error) {
+
+	b := new(strings.Builder)
+	b.Grow(2 * mtx.SerializeSize())
+	err := mtx.Serialize(hex.NewEncoder(b))
+	if err != nil {
+		return nil, err
+	}
+
+	txReply := &dcrdtypes.TxRawResult{
+		Hex:           b.String(),
+		Txid:          mtx.CachedTxHash().String(),
+		Version:       int32(mtx.Version),
+		LockTime:      mtx.LockTime,
+		Expiry:        mtx.Expiry,
+		Vin:           createVinList(mtx, isTreasuryEnabled),
+		Vout:          createVoutList(mtx, chainParams, nil),
+		BlockHash:     blkHeader.BlockHash().String(),
+		BlockHeight:   int64(blkHeader.Height),
+		BlockIndex:    blkIdx,
+		Confirmations: confirmations,
+		Time:          blkHeader.Timestamp.Unix(),
+		Blocktime:     blkHeader.Timestamp.Unix(), // identical to Time in bitcoind too
+	}
+
+	return txReply, nil
+}
+
+// createVinList returns a slice of JSON objects for the inputs of the passed
+// transaction.
+func createVinList(mtx *wire.MsgTx, isTreasuryEnabled bool) []dcrdtypes.Vin {
+	// Treasurybase transactions only have a single txin by definition.
+	//
+	// NOTE: This check MUST come before the coinbase check because a
+	// treasurybase will be identified as a coinbase as well.
+	vinList := make([]dcrdtypes.Vin, len(mtx.TxIn))
+	if isTreasuryEnabled && blockchain.IsTreasuryBase(mtx) {
+		txIn := mtx.TxIn[0]
+		vinEntry := &vinList[0]
+		vinEntry.Treasurybase = true
+		vinEntry.Sequence = txIn.Sequence
+		vinEntry.AmountIn = dcrutil.Amount(txIn.ValueIn).ToCoin()
+		vinEntry.BlockHeight = txIn.BlockHeight
+		vinEntry.BlockIndex = txIn.BlockIndex
+		return vinList
+	}
+
+	// Coinbase transactions only have a single txin by definition.
+	if blockchain.IsCoinBaseTx(mtx, isTreasuryEnabled) {
+		txIn := mtx.TxIn[0]
+		vinEntry := &vinList[0]
+		vinEntry.Coinbase = hex.EncodeToString(txIn.SignatureScript)
+		vinEntry.Sequence = txIn.Sequence
+		vinEntry.AmountIn = dcrutil.Amount(txIn.ValueIn).ToCoin()
+		vinEntry.BlockHeight = txIn.BlockHeight
+		vinEntry.BlockIndex = txIn.BlockIndex
+		return vinList
+	}
+
+	// Treasury spend transactions only have a single txin by definition.
+	if isTreasuryEnabled && stake.IsTSpend(mtx) {
+		txIn := mtx.TxIn[0]
+		vinEntry := &vinList[0]
+		vinEntry.TreasurySpend = hex.EncodeToString(txIn.SignatureScript)
+		vinEntry.Sequence = txIn.Sequence
+		vinEntry.AmountIn = dcrutil.Amount(txIn.ValueIn).ToCoin()
+		vinEntry.BlockHeight = txIn.BlockHeight
+		vinEntry.BlockIndex = txIn.BlockIndex
+		return vinList
+	}
+
+	// Stakebase transactions (votes) have two inputs: a null stake base
+	// followed by an input consuming a ticket's stakesubmission.
+	isSSGen := stake.IsSSGen(mtx)
+
+	for i, txIn := range mtx.TxIn {
+		// Handle only the null input of a stakebase differently.
+		if isSSGen && i == 0 {
+			vinEntry := &vinList[0]
+			vinEntry.Stakebase = hex.EncodeToString(txIn.SignatureScript)
+			vinEntry.Sequence = txIn.Sequence
+			vinEntry.AmountIn = dcrutil.Amount(txIn.ValueIn).ToCoin()
+			vinEntry.BlockHeight = txIn.BlockHeight
+			vinEntry.BlockIndex = txIn.BlockIndex
+			continue
+		}
+
+		// The disassembled string will contain [error] inline
+		// if the script doesn't fully parse, so ignore the
+		// error here.
+		disbuf, _ := txscript.DisasmString(txIn.SignatureScript)
+
+		vinEntry := &vinList[i]
+		vinEntry.Txid = txIn.PreviousOutPoint.Hash.String()
+		vinEntry.Vout = txIn.PreviousOutPoint.Index
+		vinEntry.Tree = txIn.PreviousOutPoint.Tree
+		vinEntry.Sequence = txIn.Sequence
+		vinEntry.AmountIn = dcrutil.Amount(txIn.ValueIn).ToCoin()
+		vinEntry.BlockHeight = txIn.BlockHeight
+		vinEntry.BlockIndex = txIn.BlockIndex
+		vinEntry.ScriptSig = &dcrdtypes.ScriptSig{
+			Asm: disbuf,
+			Hex: hex.EncodeToString(txIn.SignatureScript),
+		}
+	}
+
+	return vinList
+}
+
+// createVoutList returns a slice of JSON objects for the outputs of the passed
+// transaction.
+func createVoutList(mtx *wire.MsgTx, chainParams *chaincfg.Params, filterAddrMap map[string]struct{}) []dcrdtypes.Vout {
+	txType := stake.DetermineTxType(mtx)
+	voutList := make([]dcrdtypes.Vout, 0, len(mtx.TxOut))
+	for i, v := range mtx.TxOut {
+		// The disassembled string will contain [error] inline if the
+		// script doesn't fully parse, so ignore the error here.
+		disbuf, _ := txscript.DisasmString(v.PkScript)
+
+		// Attempt to extract addresses from the public key script.  In
+		// the case of stake submission transactions, the odd outputs
+		// contain a commitment address, so detect that case
+		// accordingly.
+		var addrs []stdaddr.Address
+		var scriptClass string
+		var reqSigs uint16
+		var commitAmt *dcrutil.Amount
+		if txType == stake.TxTypeSStx && (i%2 != 0) {
+			scriptClass = sstxCommitmentString
+			addr, err := stake.AddrFromSStxPkScrCommitment(v.PkScript,
+				chainParams)
+			if err != nil {
+				log.Warnf("failed to decode ticket "+
+					"commitment addr output for tx hash "+
+					"%v, output idx %v", mtx.TxHash(), i)
+			} else {
+				addrs = []stdaddr.Address{addr}
+			}
+			amt, err := stake.AmountFromSStxPkScrCommitment(v.PkScript)
+			if err != nil {
+				log.Warnf("failed to decode ticket "+
+					"commitment amt output for tx hash %v"+
+					", output idx %v", mtx.TxHash(), i)
+			} else {
+				commitAmt = &amt
+			}
+		} else {
+			// Ignore the error here since an error means the script
+			// couldn't parse and there is no additional information
+			// about it anyways.
+			var sc stdscript.ScriptType
+			sc, addrs = stdscript.ExtractAddrs(v.Version, v.PkScript, chainParams)
+			reqSigs = stdscript.DetermineRequiredSigs(v.Version, v.PkScript)
+			scriptClass = sc.String()
+		}
+
+		// Encode the addresses while checking if the address passes the
+		// filter when needed.
+		passesFilter := len(filterAddrMap) == 0
+		encodedAddrs := make([]string, len(addrs))
+		for j, addr := range addrs {
+			encodedAddr := addr.String()
+			encodedAddrs[j] = encodedAddr
+
+			// No need to check the map again if the filter already
+			// passes.
+			if passesFilter {
+				continue
+			}
+			if _, exists := filterAddrMap[encodedAddr]; exists {
+				passesFilter = true
+			}
+		}
+
+		if !passesFilter {
+			continue
+		}
+
+		var vout dcrdtypes.Vout
+		voutSPK := &vout.ScriptPubKey
+		vout.N = uint32(i)
+		vout.Value = dcrutil.Amount(v.Value).ToCoin()
+		vout.Version = v.Version
+		voutSPK.Addresses = encodedAddrs
+		voutSPK.Asm = disbuf
+		voutSPK.Hex = hex.EncodeToString(v.PkScript)
+		voutSPK.Type = scriptClass
+		voutSPK.ReqSigs = int32(reqSigs)
+		if commitAmt != nil {
+			voutSPK.CommitAmt = dcrjson.Float64(commitAmt.ToCoin())
+		}
+
+		voutList = append(voutList, vout)
+	}
+
+	return voutList
+}
+
+// difficultyRatio returns the proof-of-work difficulty as a multiple of the
+// minimum difficulty using the passed bits field from the header of a block.
+func difficultyRatio(bits uint32, params *chaincfg.Params) float64 {
+	// The minimum difficulty is the max possible proof-of-work limit bits
+	// converted back to a number.  Note this is not the same as the proof
+	// of work limit directly because the block difficulty is encoded in a
+	// block with the compact form which loses precision.
+	max := blockchain.CompactToBig(params.PowLimitBits)
+	target := blockchain.CompactToBig(bits)
+
+	difficulty := new(big.Rat).SetFrac(max, target)
+	outString := difficulty.FloatString(8)
+	diff, err := strconv.ParseFloat(outString, 64)
+	if err != nil {
+		log.Errorf("Cannot get difficulty: %v", err)
+		return 0
+	}
+	return diff
+}
+
+// syncStatus handles a syncstatus request.
+func (s *Server) syncStatus(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	walletBestHash, walletBestHeight := w.MainChainTip(ctx)
+	bestBlock, err := w.BlockInfo(ctx, wallet.NewBlockIdentifierFromHash(&walletBestHash))
+	if err != nil {
+		return nil, err
+	}
+	_24HoursAgo := time.Now().UTC().Add(-24 * time.Hour).Unix()
+	walletBestBlockTooOld := bestBlock.Timestamp < _24HoursAgo
+
+	synced, targetHeight := n.Synced(ctx)
+
+	var headersFetchProgress float32
+	blocksToFetch := targetHeight - walletBestHeight
+	if blocksToFetch <= 0 {
+		headersFetchProgress = 1
+	} else {
+		totalHeadersToFetch := targetHeight - w.InitialHeight()
+		headersFetchProgress = 1 - (float32(blocksToFetch) / float32(totalHeadersToFetch))
+	}
+
+	return &types.SyncStatusResult{
+		Synced:               synced,
+		InitialBlockDownload: walletBestBlockTooOld,
+		HeadersFetchProgress: headersFetchProgress,
+	}, nil
+}
+
+// getCurrentNet handles a getcurrentnet request.
+func (s *Server) getCurrentNet(ctx context.Context, icmd any) (any, error) {
+	return s.activeNet.Net, nil
+}
+
+// getInfo handles a getinfo request by returning a structure containing
+// information about the current state of the wallet.
+func (s *Server) getInfo(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	tipHash, tipHeight := w.MainChainTip(ctx)
+	tipHeader, err := w.BlockHeader(ctx, &tipHash)
+	if err != nil {
+		return nil, err
+	}
+
+	balances, err := w.AccountBalances(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+	var spendableBalance dcrutil.Amount
+	for _, balance := range balances {
+		spendableBalance += balance.Spendable
+	}
+
+	info := &types.InfoResult{
+		Version:         version.Integer,
+		ProtocolVersion: int32(p2p.Pver),
+		WalletVersion:   version.Integer,
+		Balance:         spendableBalance.ToCoin(),
+		Blocks:          tipHeight,
+		TimeOffset:      0,
+		Connections:     0,
+		Proxy:           "",
+		Difficulty:      difficultyRatio(tipHeader.Bits, w.ChainParams()),
+		TestNet:         w.ChainParams().Net == wire.TestNet3,
+		KeypoolOldest:   0,
+		KeypoolSize:     0,
+		UnlockedUntil:   0,
+		PaytxFee:        w.RelayFee().ToCoin(),
+		RelayFee:        0,
+		Errors:          "",
+	}
+
+	n, _ := s.loader(ctx).NetworkBackend()
+	if chainSyncer, ok := n.(*chain.Syncer); ok {
+		var consensusInfo dcrdtypes.InfoChainResult
+		err := chainSyncer.RPC().Call(ctx, "getinfo", &consensusInfo)
+		if err != nil {
+			return nil, err
+		}
+		info.Version = consensusInfo.Version
+		info.ProtocolVersion = consensusInfo.ProtocolVersion
+		info.TimeOffset = consensusInfo.TimeOffset
+		info.Connections = consensusInfo.Connections
+		info.Proxy = consensusInfo.Proxy
+		info.RelayFee = consensusInfo.RelayFee
+		info.Errors = consensusInfo.Errors
+	}
+
+	return info, nil
+}
+
+func decodeAddress(s string, params *chaincfg.Params) (stdaddr.Address, error) {
+	// Secp256k1 pubkey as a string, handle differently.
+	if len(s) == 66 || len(s) == 130 {
+		pubKeyBytes, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+		pubKeyAddr, err := stdaddr.NewAddressPubKeyEcdsaSecp256k1V0Raw(
+			pubKeyBytes, params)
+		if err != nil {
+			return nil, err
+		}
+
+		return pubKeyAddr, nil
+	}
+
+	addr, err := stdaddr.DecodeAddress(s, params)
+	if err != nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidAddressOrKey,
+			"invalid address %q: decode failed: %#q", s, err)
+	}
+	return addr, nil
+}
+
+func decodeStakeAddress(s string, params *chaincfg.Params) (stdaddr.StakeAddress, error) {
+	a, err := decodeAddress(s, params)
+	if err != nil {
+		return nil, err
+	}
+	if sa, ok := a.(stdaddr.StakeAddress); ok {
+		return sa, nil
+	}
+	return nil, rpcErrorf(dcrjson.ErrRPCInvalidAddressOrKey,
+		"invalid stake address %q", s)
+}
+
+// getAccount handles a getaccount request by returning the account name
+// associated with a single address.
+func (s *Server) getAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetAccountCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := w.KnownAddress(ctx, addr)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAddressNotInWallet
+		}
+		return nil, err
+	}
+
+	return a.AccountName(), nil
+}
+
+// getAccountAddress handles a getaccountaddress by returning the most
+// recently-created chained address that has not yet been used (does not yet
+// appear in the blockchain, or any tx that has arrived in the dcrd mempool).
+// If the most recently-requested address has been used, a new address (the
+// next chained address in the keypool) is used.  This can fail if the keypool
+// runs out (and will return dcrjson.ErrRPCWalletKeypoolRanOut if that happens).
+func (s *Server) getAccountAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetAccountAddressCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	// Get the current address and persist it to the database so it can be
+	// found when receiving transactions and appears in getaddressesbyaccount.
+	addr, err := w.CurrentAddressAndPersist(ctx, account)
+	if err != nil {
+		// Expect account lookup to succeed
+		if errors.Is(err, errors.NotExist) {
+			return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
+		}
+		return nil, err
+	}
+
+	return addr.String(), nil
+}
+
+// getUnconfirmedBalance handles a getunconfirmedbalance extension request
+// by returning the current unconfirmed balance of an account.
+func (s *Server) getUnconfirmedBalance(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetUnconfirmedBalanceCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	acctName := "default"
+	if cmd.Account != nil {
+		acctName = *cmd.Account
+	}
+	account, err := w.AccountNumber(ctx, acctName)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	bals, err := w.AccountBalance(ctx, account, 1)
+	if err != nil {
+		// Expect account lookup to succeed
+		if errors.Is(err, errors.NotExist) {
+			return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
+		}
+		return nil, err
+	}
+
+	return (bals.Total - bals.Spendable).ToCoin(), nil
+}
+
+// getCFilterV2 implements the getcfilterv2 command.
+func (s *Server) getCFilterV2(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetCFilterV2Cmd)
+	blockHash, err := chainhash.NewHashFromStr(cmd.BlockHash)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	key, filter, err := w.CFilterV2(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetCFilterV2Result{
+		BlockHash: cmd.BlockHash,
+		Filter:    hex.EncodeToString(filter.Bytes()),
+		Key:       hex.EncodeToString(key[:]),
+	}, nil
+}
+
+// importCFiltersV2 handles an importcfiltersv2 request by parsing the provided
+// hex-encoded filters into bytes and importing them into the wallet.
+func (s *Server) importCFiltersV2(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportCFiltersV2Cmd)
+
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	filterData := make([][]byte, len(cmd.Filters))
+	for i, fdhex := range cmd.Filters {
+		var err error
+		filterData[i], err = hex.DecodeString(fdhex)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParams.Code, "filter %d is not a valid hex string", i)
+		}
+	}
+
+	err := w.ImportCFiltersV2(ctx, cmd.StartHeight, filterData)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidRequest.Code, err)
+	}
+
+	return nil, nil
+}
+
+// importAccount returns the account number that imported keys and scripts are
+// attached to.  The reserved imported account is used when no account name is
+// provided.
+func importAccount(ctx context.Context, w *wallet.Wallet, name *string) (uint32, error) {
+	if name == nil || *name == udb.ImportedAddrAccountName {
+		return udb.ImportedAddrAccount, nil
+	}
+	account, err := w.AccountNumber(ctx, *name)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return 0, errAccountNotFound
+		}
+		return 0, err
+	}
+	return account, nil
+}
+
+// importPrivKey handles an importprivkey request by parsing
+// a WIF-encoded private key and adding it to an account.
+func (s *Server) importPrivKey(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportPrivKeyCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	rescan := true
+	if cmd.Rescan != nil {
+		rescan = *cmd.Rescan
+	}
+	scanFrom := int32(0)
+	if cmd.ScanFrom != nil {
+		scanFrom = int32(*cmd.ScanFrom)
+	}
+	n, ok := s.loader(ctx).NetworkBackend()
+	if rescan && !ok {
+		return nil, errNoNetwork
+	}
+
+	// Yes, Label is the account name.
+	account, err := importAccount(ctx, w, cmd.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	wif, err := dcrutil.DecodeWIF(cmd.PrivKey, w.ChainParams().PrivateKeyID)
+	if err != nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidAddressOrKey, "WIF decode failed: %v", err)
+	}
+
+	// Import the private key, handling any errors.
+	_, err = w.ImportPrivateKey(ctx, wif, account)
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.Exist):
+			// Do not return duplicate key errors to the client.
+			return nil, nil
+		case errors.Is(err, errors.Locked):
+			return nil, errWalletUnlockNeeded
+		default:
+			return nil, err
+		}
+	}
+
+	if rescan {
+		// Rescan in the background rather than blocking the rpc request. Use
+		// the server waitgroup to ensure the rescan can return cleanly rather
+		// than being killed mid database transaction.
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			serverCtx := s.httpServer.BaseContext(nil)
+			_ = w.RescanFromHeight(serverCtx, n, scanFrom)
+		}()
+	}
+
+	return nil, nil
+}
+
+// importPubKey handles an importpubkey request by importing a hex-encoded
+// compressed 33-byte secp256k1 public key with sign byte, as well as its
+// derived P2PKH address.  This method may only be used by watching-only
+// wallets and with the special "imported" account.
+func (s *Server) importPubKey(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportPubKeyCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	rescan := true
+	if cmd.Rescan != nil {
+		rescan = *cmd.Rescan
+	}
+	scanFrom := int32(0)
+	if cmd.ScanFrom != nil {
+		scanFrom = int32(*cmd.ScanFrom)
+	}
+	n, ok := s.loader(ctx).NetworkBackend()
+	if rescan && !ok {
+		return nil, errNoNetwork
+	}
+
+	account, err := importAccount(ctx, w, cmd.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := hex.DecodeString(cmd.PubKey)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+
+	_, err = w.ImportPublicKey(ctx, pk, account)
+	if errors.Is(err, errors.Exist) {
+		// Do not return duplicate address errors, and skip any
+		// rescans.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if rescan {
+		// Rescan in the background rather than blocking the rpc request. Use
+		// the server waitgroup to ensure the rescan can return cleanly rather
+		// than being killed mid database transaction.
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			serverCtx := s.httpServer.BaseContext(nil)
+			_ = w.RescanFromHeight(serverCtx, n, scanFrom)
+		}()
+	}
+
+	return nil, nil
+}
+
+// importScript imports a redeem script for a P2SH output.
+func (s *Server) importScript(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportScriptCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	rescan := true
+	if cmd.Rescan != nil {
+		rescan = *cmd.Rescan
+	}
+	scanFrom := int32(0)
+	if cmd.ScanFrom != nil {
+		scanFrom = int32(*cmd.ScanFrom)
+	}
+	n, ok := s.loader(ctx).NetworkBackend()
+	if rescan && !ok {
+		return nil, errNoNetwork
+	}
+
+	account, err := importAccount(ctx, w, cmd.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := hex.DecodeString(cmd.Hex)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+	if len(rs) == 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "empty script")
+	}
+
+	err = w.ImportScript(ctx, rs, account)
+	if errors.Is(err, errors.Exist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if rescan {
+		// Rescan in the background rather than blocking the rpc request. Use
+		// the server waitgroup to ensure the rescan can return cleanly rather
+		// than being killed mid database transaction.
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			serverCtx := s.httpServer.BaseContext(nil)
+			_ = w.RescanFromHeight(serverCtx, n, scanFrom)
+		}()
+	}
+
+	return nil, nil
+}
+
+func (s *Server) importXpub(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportXpubCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	rescan := true
+	if cmd.Rescan != nil {
+		rescan = *cmd.Rescan
+	}
+	n, ok := s.loader(ctx).NetworkBackend()
+	if rescan && !ok {
+		return nil, errNoNetwork
+	}
+
+	xpub, err := hdkeychain.NewKeyFromString(cmd.Xpub, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.ImportXpubAccount(ctx, cmd.Name, xpub)
+	if err != nil {
+		return nil, err
+	}
+	account, err := w.AccountNumber(ctx, cmd.Name)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.GapLimit != nil {
+		err := w.SetAccountGapLimit(ctx, account, *cmd.GapLimit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rescan {
+		// Transactions of the imported account can not precede the
+		// wallet birthday unless the account was created before the
+		// wallet.
+		var scanFrom int32
+		if cmd.ScanFrom != nil {
+			scanFrom = int32(*cmd.ScanFrom)
+		} else {
+			_, scanFrom, err = w.BirthdayBlock(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Discover the account's addresses and rescan in the background
+		// rather than blocking the rpc request. Use the server waitgroup
+		// to ensure the rescan can return cleanly rather than being
+		// killed mid database transaction.
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			serverCtx := s.httpServer.BaseContext(nil)
+			err := w.RescanAccount(serverCtx, n, account, scanFrom)
+			if err != nil {
+				logCtx(ctx).Errorf("Rescan of imported account %q failed: %v",
+					cmd.Name, err)
+			}
+		}()
+	}
+
+	return nil, nil
+}
+
+// createNewAccount handles a createnewaccount request by creating and
+// returning a new account. If the last account has no transaction history
+// as per BIP 0044 a new account cannot be created so an error will be returned.
+func (s *Server) createNewAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateNewAccountCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	// The wildcard * is reserved by the rpc server with the special meaning
+	// of "all accounts", so disallow naming accounts to this string.
+	if cmd.Account == "*" {
+		return nil, errReservedAccountName
+	}
+
+	_, err := w.NextAccount(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.Locked) {
+			return nil, rpcErrorf(dcrjson.ErrRPCWalletUnlockNeeded, "creating new accounts requires an unlocked wallet")
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// createMultisigAccount handles a createmultisigaccount request by creating an
+// account paying to P2SH multisig scripts shared with the cosigners of the
+// extended public keys.
+func (s *Server) createMultisigAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateMultisigAccountCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if cmd.Account == "*" {
+		return nil, errReservedAccountName
+	}
+
+	cosigners := make([]*hdkeychain.ExtendedKey, 0, len(cmd.Xpubs))
+	for _, s := range cmd.Xpubs {
+		xpub, err := hdkeychain.NewKeyFromString(s, w.ChainParams())
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		cosigners = append(cosigners, xpub)
+	}
+
+	_, err := w.CreateMultisigAccount(ctx, cmd.Account, cmd.NRequired, cosigners)
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.Invalid):
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		case errors.Is(err, errors.Locked):
+			return nil, rpcErrorf(dcrjson.ErrRPCWalletUnlockNeeded, "creating new accounts requires an unlocked wallet")
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// createVaultAccount handles a createvaultaccount request by creating an
+// account paying to P2SH scripts which may only be spent by the account keys
+// after a relative lock time, or immediately by an optional recovery key.
+func (s *Server) createVaultAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateVaultAccountCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if cmd.Account == "*" {
+		return nil, errReservedAccountName
+	}
+
+	var recovery *hdkeychain.ExtendedKey
+	if cmd.RecoveryXpub != nil {
+		var err error
+		recovery, err = hdkeychain.NewKeyFromString(*cmd.RecoveryXpub, w.ChainParams())
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+	}
+
+	_, err := w.CreateVaultAccount(ctx, cmd.Account, cmd.Delay, recovery)
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.Invalid):
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		case errors.Is(err, errors.Locked):
+			return nil, rpcErrorf(dcrjson.ErrRPCWalletUnlockNeeded, "creating new accounts requires an unlocked wallet")
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// createAuthorizedEmission handles a createauthorizedemission request by creating a
+// cryptographically signed SKA emission transaction.
+func (s *Server) createAuthorizedEmission(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateAuthorizedEmissionCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	// Unlock wallet for key operations
+	err := w.Unlock(ctx, []byte(cmd.Passphrase), nil)
+	if err != nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCWalletPassphraseIncorrect,
+			"incorrect passphrase: %v", err)
+	}
+
+	// Only one emission is planned per coin type, so the nonce is always 1.
+	const nonce = 1
+	tx, err := w.CreateSKAEmission(ctx, cointype.CoinType(cmd.CoinType),
+		cmd.EmissionKeyName, nonce)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+
+	totalAmount := new(big.Int)
+	for _, out := range tx.TxOut {
+		totalAmount.Add(totalAmount, out.SKAValue)
+	}
+	var txBuf bytes.Buffer
+	txBuf.Grow(tx.SerializeSize())
+	if err := tx.Serialize(&txBuf); err != nil {
+		return nil, err
+	}
+
+	return &types.CreateAuthorizedEmissionResult{
+		Transaction:     hex.EncodeToString(txBuf.Bytes()),
+		TransactionHash: tx.TxHash().String(),
+		Nonce:           nonce,
+		TotalAmount:     totalAmount.String(),
+		CoinType:        cmd.CoinType,
+	}, nil
+}
+
+// emitSKA handles an emitska request by creating the emission transaction of
+// an SKA coin type, signed by an emission key stored by the wallet, and
+// publishing it.  The method is only available when SKA emission is enabled
+// by the application config.
+func (s *Server) emitSKA(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.EmitSKACmd)
+	if !s.cfg.SKAEmissionEnabled {
+		return nil, rpcErrorf(dcrjson.ErrRPCMisc,
+			"SKA emission is disabled; restart with --enableskaemission")
+	}
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	tx, err := w.CreateSKAEmission(ctx, cointype.CoinType(cmd.CoinType),
+		cmd.EmissionKeyName, *cmd.Nonce)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+
+	n, ok := s.loader(ctx).NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+	err = n.PublishTransactions(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.TxHash().String(), nil
+}
+
+// renameAccount handles a renameaccount request by renaming an account.
+// If the account does not exist an appropriate error will be returned.
+func (s *Server) renameAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RenameAccountCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	// The wildcard * is reserved by the rpc server with the special meaning
+	// of "all accounts", so disallow naming accounts to this string.
+	if cmd.NewAccount == "*" {
+		return nil, errReservedAccountName
+	}
+
+	// Check that given account exists
+	account, err := w.AccountNumber(ctx, cmd.OldAccount)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	err = w.RenameAccount(ctx, account, cmd.NewAccount)
+	return nil, err
+}
 
-			return result, nil
+// archiveAccount handles an archiveaccount request by hiding an account from
+// default balance and account listings.
+func (s *Server) archiveAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ArchiveAccountCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
 		}
+		return nil, err
+	}
+	err = w.ArchiveAccount(ctx, account)
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
 
-		// Default behavior (backward compatible): use existing AccountBalances for VAR
-		balances, err := w.AccountBalances(ctx, int32(*cmd.MinConf))
-		if err != nil {
-			return nil, err
+// unarchiveAccount handles an unarchiveaccount request by restoring an
+// archived account to default balance and account listings.
+func (s *Server) unarchiveAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.UnarchiveAccountCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
 		}
+		return nil, err
+	}
+	err = w.UnarchiveAccount(ctx, account)
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
 
-		var (
-			totImmatureCoinbase dcrutil.Amount
-			totImmatureStakegen dcrutil.Amount
-			totLocked           dcrutil.Amount
-			totSpendable        dcrutil.Amount
-			totUnconfirmed      dcrutil.Amount
-			totVotingAuthority  dcrutil.Amount
-			cumTot              dcrutil.Amount
-		)
+// getMigrationHistory returns the database upgrades performed by the wallet.
+func (s *Server) getMigrationHistory(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-		balancesLen := uint32(len(balances))
-		result.Balances = make([]types.GetAccountBalanceResult, 0, balancesLen)
+	history, err := w.MigrationHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.GetMigrationHistoryResult, 0, len(history))
+	for _, m := range history {
+		res = append(res, types.GetMigrationHistoryResult{
+			Version:     m.Version,
+			Description: m.Description,
+			Time:        m.Time.Unix(),
+		})
+	}
+	return res, nil
+}
 
-		for _, bal := range balances {
-			accountName, err := w.AccountName(ctx, bal.Account)
-			if err != nil {
-				// Expect account lookup to succeed
-				if errors.Is(err, errors.NotExist) {
-					return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
-				}
-				return nil, err
-			}
+// getWalletDBVersion returns the schema version of the wallet database and
+// the latest version understood by this software.
+func (s *Server) getWalletDBVersion(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-			totImmatureCoinbase += bal.ImmatureCoinbaseRewards
-			totImmatureStakegen += bal.ImmatureStakeGeneration
-			totLocked += bal.LockedByTickets
-			totSpendable += bal.Spendable
-			totUnconfirmed += bal.Unconfirmed
-			totVotingAuthority += bal.VotingAuthority
-			cumTot += bal.Total
+	v, err := w.SchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetWalletDBVersionResult{
+		Version:           v.Version,
+		CompatibleVersion: v.Compatible,
+		LatestVersion:     udb.DBVersion,
+	}, nil
+}
 
-			json := types.GetAccountBalanceResult{
-				AccountName:             accountName,
-				ImmatureCoinbaseRewards: bal.ImmatureCoinbaseRewards.ToCoin(),
-				ImmatureStakeGeneration: bal.ImmatureStakeGeneration.ToCoin(),
-				LockedByTickets:         bal.LockedByTickets.ToCoin(),
-				Spendable:               bal.Spendable.ToCoin(),
-				Total:                   bal.Total.ToCoin(),
-				Unconfirmed:             bal.Unconfirmed.ToCoin(),
-				VotingAuthority:         bal.VotingAuthority.ToCoin(),
-			}
+// getRescanStatus handles a getrescanstatus request by returning the progress
+// of the active rescan, or of a rescan which was interrupted and will resume
+// the next time the wallet syncs.
+func (s *Server) getRescanStatus(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-			result.Balances = append(result.Balances, json)
-		}
+	status, err := w.RescanStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetRescanStatusResult{
+		Rescanning:  status.Active,
+		StartHeight: status.StartHeight,
+		Height:      status.ScannedThrough,
+		TipHeight:   status.TipHeight,
+		Percent:     status.Percent,
+		ETA:         int64(status.ETA / time.Second),
+	}, nil
+}
 
-		result.TotalImmatureCoinbaseRewards = totImmatureCoinbase.ToCoin()
-		result.TotalImmatureStakeGeneration = totImmatureStakegen.ToCoin()
-		result.TotalLockedByTickets = totLocked.ToCoin()
-		result.TotalSpendable = totSpendable.ToCoin()
-		result.TotalUnconfirmed = totUnconfirmed.ToCoin()
-		result.TotalVotingAuthority = totVotingAuthority.ToCoin()
-		result.CumulativeTotal = cumTot.ToCoin()
+// getRPCStats handles a getrpcstats request by returning statistics of the
+// recorded JSON-RPC and gRPC requests of each method, and the most recent
+// requests.  Requests may be limited to a single method.
+func (s *Server) getRPCStats(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetRPCStatsCmd)
+	if s.cfg.Traces == nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCMisc, "requests are not recorded")
+	}
+	count := 20
+	if cmd.Count != nil {
+		count = *cmd.Count
+	}
+	if count < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative count")
+	}
+
+	records := s.cfg.Traces.Records()
+	if cmd.Method != nil {
+		records = slices.DeleteFunc(records, func(r rpctrace.Record) bool {
+			return r.Method != *cmd.Method
+		})
+	}
+
+	stats := make(map[string]*types.RPCMethodStatsResult)
+	for _, r := range records {
+		st, ok := stats[r.Method]
+		if !ok {
+			st = &types.RPCMethodStatsResult{Method: r.Method}
+			stats[r.Method] = st
+		}
+		st.Count++
+		if r.Error != "" {
+			st.Errors++
+		}
+		if r.Slow {
+			st.Slow++
+		}
+		st.MeanDuration += r.Duration.Seconds()
+		st.MaxDuration = max(st.MaxDuration, r.Duration.Seconds())
+		st.MeanLockWait += r.LockWait.Seconds()
+		st.MeanDBViews += float64(r.DBViews)
+		st.MeanDBUpdates += float64(r.DBUpdates)
+	}
+	res := &types.GetRPCStatsResult{
+		SlowThreshold: s.cfg.Traces.SlowThreshold().Seconds(),
+		Methods:       make([]types.RPCMethodStatsResult, 0, len(stats)),
+		Recent:        make([]types.RPCTraceResult, 0, min(count, len(records))),
+	}
+	for _, st := range stats {
+		n := float64(st.Count)
+		st.MeanDuration /= n
+		st.MeanLockWait /= n
+		st.MeanDBViews /= n
+		st.MeanDBUpdates /= n
+		res.Methods = append(res.Methods, *st)
+	}
+	slices.SortFunc(res.Methods, func(a, b types.RPCMethodStatsResult) int {
+		return strings.Compare(a.Method, b.Method)
+	})
+	for i := len(records) - 1; i >= 0 && len(res.Recent) < count; i-- {
+		r := &records[i]
+		res.Recent = append(res.Recent, types.RPCTraceResult{
+			Method:    r.Method,
+			RequestID: r.RequestID,
+			Time:      r.Start.Unix(),
+			Duration:  r.Duration.Seconds(),
+			LockWait:  r.LockWait.Seconds(),
+			DBViews:   r.DBViews,
+			DBUpdates: r.DBUpdates,
+			Slow:      r.Slow,
+			Error:     r.Error,
+		})
+	}
+	return res, nil
+}
+
+// compactWallet handles a compactwallet request by pruning fully spent
+// transactions buried by at least the prune depth, if specified, and
+// compacting the wallet database.  When status is set, the progress of the
+// active or last compaction is returned instead.
+func (s *Server) compactWallet(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CompactWalletCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var status *wallet.CompactStatus
+	if cmd.Status != nil && *cmd.Status {
+		status = w.CompactStatus()
+		if status == nil {
+			status = new(wallet.CompactStatus)
+		}
 	} else {
-		account, err := w.AccountNumber(ctx, accountName)
+		var pruneDepth int32
+		if cmd.PruneDepth != nil {
+			pruneDepth = *cmd.PruneDepth
+		}
+		var err error
+		status, err = w.CompactDB(ctx, pruneDepth)
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
 		if err != nil {
-			if errors.Is(err, errors.NotExist) {
-				return nil, errAccountNotFound
-			}
 			return nil, err
 		}
-
-		// If coin type is specified, use coin-type specific balance for single account
-		if cmd.CoinType != nil {
-			coinType := cointype.CoinType(*cmd.CoinType)
-			atomsPerCoin := getAtomsPerCoin(w.ChainParams(), coinType)
-			coinBal, err := w.AccountBalanceByCoinType(ctx, account, coinType, minConf)
-			if err != nil {
-				return nil, err
-			}
-
-			var json types.GetAccountBalanceResult
-			if coinType.IsSKA() {
-				// Use decimal strings for full precision SKA amounts
-				json = types.GetAccountBalanceResult{
-					AccountName:             accountName,
-					ImmatureCoinbaseRewards: coinBal.SKAImmatureCoinbaseRewards.ToDecimalString(atomsPerCoin),
-					ImmatureStakeGeneration: coinBal.SKAImmatureStakeGeneration.ToDecimalString(atomsPerCoin),
-					LockedByTickets:         "0",
-					Spendable:               coinBal.SKASpendable.ToDecimalString(atomsPerCoin),
-					Total:                   coinBal.SKATotal.ToDecimalString(atomsPerCoin),
-					Unconfirmed:             coinBal.SKAUnconfirmed.ToDecimalString(atomsPerCoin),
-					VotingAuthority:         "0",
-				}
-			} else {
-				// Use native .ToCoin() for VAR
-				json = types.GetAccountBalanceResult{
-					AccountName:             accountName,
-					ImmatureCoinbaseRewards: coinBal.ImmatureCoinbaseRewards.ToCoin(),
-					ImmatureStakeGeneration: coinBal.ImmatureStakeGeneration.ToCoin(),
-					LockedByTickets:         coinBal.LockedByTickets.ToCoin(),
-					Spendable:               coinBal.Spendable.ToCoin(),
-					Total:                   coinBal.Total.ToCoin(),
-					Unconfirmed:             coinBal.Unconfirmed.ToCoin(),
-					VotingAuthority:         coinBal.VotingAuthority.ToCoin(),
-				}
-			}
-			result.Balances = append(result.Balances, json)
-		} else {
-			// Default behavior (backward compatible): use existing AccountBalance for VAR
-			bal, err := w.AccountBalance(ctx, account, minConf)
-			if err != nil {
-				// Expect account lookup to succeed
-				if errors.Is(err, errors.NotExist) {
-					return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
-				}
-				return nil, err
-			}
-			json := types.GetAccountBalanceResult{
-				AccountName:             accountName,
-				ImmatureCoinbaseRewards: bal.ImmatureCoinbaseRewards.ToCoin(),
-				ImmatureStakeGeneration: bal.ImmatureStakeGeneration.ToCoin(),
-				LockedByTickets:         bal.LockedByTickets.ToCoin(),
-				Spendable:               bal.Spendable.ToCoin(),
-				Total:                   bal.Total.ToCoin(),
-				Unconfirmed:             bal.Unconfirmed.ToCoin(),
-				VotingAuthority:         bal.VotingAuthority.ToCoin(),
-			}
-			result.Balances = append(result.Balances, json)
-		}
 	}
 
-	return result, nil
+	history, err := w.PrunedHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := w.ChainParams()
+	res := &types.CompactWalletResult{
+		Active:             status.Active,
+		Stage:              status.Stage,
+		Percent:            status.Percent,
+		PruneDepth:         status.PruneDepth,
+		PrunedTransactions: status.PrunedTransactions,
+		PrunedHistory:      make([]types.PrunedHistoryResult, 0, len(history)),
+	}
+	for _, h := range history {
+		res.PrunedHistory = append(res.PrunedHistory, types.PrunedHistoryResult{
+			CoinType:     uint8(h.CoinType),
+			Transactions: h.Transactions,
+			FirstHeight:  h.FirstHeight,
+			LastHeight:   h.LastHeight,
+			Received:     coinAmount(params, h.CoinType, h.Received),
+			Sent:         coinAmount(params, h.CoinType, h.Sent),
+		})
+	}
+	return res, nil
 }
 
-// getBestBlock handles a getbestblock request by returning a JSON object
-// with the height and hash of the most recently processed block.
-func (s *Server) getBestBlock(ctx context.Context, icmd any) (any, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+// getMultisigOutInfo displays information about a given multisignature
+// output.
+func (s *Server) getMultisigOutInfo(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetMultisigOutInfoCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
-
-	hash, height := w.MainChainTip(ctx)
-	result := &dcrdtypes.GetBestBlockResult{
-		Hash:   hash.String(),
-		Height: int64(height),
+
+	hash, err := chainhash.NewHashFromStr(cmd.Hash)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+
+	// Multisig outs are always in TxTreeRegular.
+	op := &wire.OutPoint{
+		Hash:  *hash,
+		Index: cmd.Index,
+		Tree:  wire.TxTreeRegular,
+	}
+
+	p2shOutput, err := w.FetchP2SHMultiSigOutput(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the list of pubkeys required to sign.
+	_, pubkeyAddrs := stdscript.ExtractAddrs(scriptVersionAssumed, p2shOutput.RedeemScript, w.ChainParams())
+	pubkeys := make([]string, 0, len(pubkeyAddrs))
+	for _, pka := range pubkeyAddrs {
+		switch pka := pka.(type) {
+		case *stdaddr.AddressPubKeyEcdsaSecp256k1V0:
+			pubkeys = append(pubkeys, hex.EncodeToString(pka.SerializedPubKey()))
+		}
+	}
+
+	result := &types.GetMultisigOutInfoResult{
+		Address:      p2shOutput.P2SHAddress.String(),
+		RedeemScript: hex.EncodeToString(p2shOutput.RedeemScript),
+		M:            p2shOutput.M,
+		N:            p2shOutput.N,
+		Pubkeys:      pubkeys,
+		TxHash:       p2shOutput.OutPoint.Hash.String(),
+		Amount:       p2shOutput.OutputAmount.ToCoin(),
+	}
+	if !p2shOutput.ContainingBlock.None() {
+		result.BlockHeight = uint32(p2shOutput.ContainingBlock.Height)
+		result.BlockHash = p2shOutput.ContainingBlock.Hash.String()
+	}
+	if p2shOutput.Redeemer != nil {
+		result.Spent = true
+		result.SpentBy = p2shOutput.Redeemer.TxHash.String()
+		result.SpentByIndex = p2shOutput.Redeemer.InputIndex
 	}
 	return result, nil
 }
 
-// getBestBlockHash handles a getbestblockhash request by returning the hash
-// of the most recently processed block.
-func (s *Server) getBestBlockHash(ctx context.Context, icmd any) (any, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+// getNewAddress handles a getnewaddress request by returning a new
+// address for an account.  If the account does not exist an appropriate
+// error is returned.
+func (s *Server) getNewAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetNewAddressCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	hash, _ := w.MainChainTip(ctx)
-	return hash.String(), nil
-}
+	var callOpts []wallet.NextAddressCallOption
+	if cmd.GapPolicy != nil {
+		switch *cmd.GapPolicy {
+		case "":
+		case "error":
+			callOpts = append(callOpts, wallet.WithGapPolicyError())
+		case "ignore":
+			callOpts = append(callOpts, wallet.WithGapPolicyIgnore())
+		case "wrap":
+			callOpts = append(callOpts, wallet.WithGapPolicyWrap())
+		default:
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "unknown gap policy %q", *cmd.GapPolicy)
+		}
+	}
 
-// getBlockCount handles a getblockcount request by returning the chain height
-// of the most recently processed block.
-func (s *Server) getBlockCount(ctx context.Context, icmd any) (any, error) {
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+	acctName := "default"
+	if cmd.Account != nil {
+		acctName = *cmd.Account
+	}
+	account, err := w.AccountNumber(ctx, acctName)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
 	}
 
-	_, height := w.MainChainTip(ctx)
-	return height, nil
+	addr, err := w.NewExternalAddress(ctx, account, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return addr.String(), nil
 }
 
-// getBlockHash handles a getblockhash request by returning the main chain hash
-// for a block at some height.
-func (s *Server) getBlockHash(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetBlockHashCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// getRawChangeAddress handles a getrawchangeaddress request by creating
+// and returning a new change address for an account.
+//
+// Note: bitcoind allows specifying the account as an optional parameter,
+// but ignores the parameter.
+func (s *Server) getRawChangeAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetRawChangeAddressCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	height := int32(cmd.Index)
-	id := wallet.NewBlockIdentifierFromHeight(height)
-	info, err := w.BlockInfo(ctx, id)
+	acctName := "default"
+	if cmd.Account != nil {
+		acctName = *cmd.Account
+	}
+	account, err := w.AccountNumber(ctx, acctName)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	addr, err := w.NewChangeAddress(ctx, account)
 	if err != nil {
 		return nil, err
 	}
-	return info.Hash.String(), nil
+
+	// Return the new payment address string.
+	return addr.String(), nil
 }
 
-// getBlockHeader implements the getblockheader command.
-func (s *Server) getBlockHeader(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetBlockHeaderCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// getReceivedByAccount handles a getreceivedbyaccount request by returning
+// the total amount received by addresses of an account.
+func (s *Server) getReceivedByAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetReceivedByAccountCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	// Attempt RPC passthrough if connected to DCRD.
-	n, err := w.NetworkBackend()
+	account, err := w.AccountNumber(ctx, cmd.Account)
 	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
 		return nil, err
 	}
-	if chainSyncer, ok := n.(*chain.Syncer); ok {
-		var resp json.RawMessage
-		err := chainSyncer.RPC().Call(ctx, "getblockheader", &resp, cmd.Hash, cmd.Verbose)
-		return resp, err
-	}
 
-	blockHash, err := chainhash.NewHashFromStr(cmd.Hash)
-	if err != nil {
-		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	// Transactions are not tracked for imported xpub accounts.
+	if account > udb.ImportedAddrAccount {
+		return 0.0, nil
 	}
 
-	blockHeader, err := w.BlockHeader(ctx, blockHash)
+	// TODO: This is more inefficient that it could be, but the entire
+	// algorithm is already dominated by reading every transaction in the
+	// wallet's history.
+	results, err := w.TotalReceivedForAccounts(ctx, int32(*cmd.MinConf))
 	if err != nil {
 		return nil, err
 	}
-
-	// When the verbose flag isn't set, simply return the serialized block
-	// header as a hex-encoded string.
-	if cmd.Verbose == nil || !*cmd.Verbose {
-		var headerBuf bytes.Buffer
-		err := blockHeader.Serialize(&headerBuf)
-		if err != nil {
-			return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Could not serialize block header: %v", err)
-		}
-		return hex.EncodeToString(headerBuf.Bytes()), nil
+	acctIndex := int(account)
+	if account == udb.ImportedAddrAccount {
+		acctIndex = len(results) - 1
 	}
+	return results[acctIndex].TotalReceived.ToCoin(), nil
+}
 
-	// The verbose flag is set, so generate the JSON object and return it.
+// getReceivedByAddress handles a getreceivedbyaddress request by returning
+// the total amount received by a single address.
+func (s *Server) getReceivedByAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetReceivedByAddressCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-	// Get next block hash unless there are none.
-	var nextHashString string
-	confirmations := int64(-1)
-	mainChainHasBlock, _, err := w.BlockInMainChain(ctx, blockHash)
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
 	if err != nil {
-		return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Error checking if block is in mainchain: %v", err)
+		return nil, err
 	}
-	if mainChainHasBlock {
-		blockHeight := int32(blockHeader.Height)
-		_, bestHeight := w.MainChainTip(ctx)
-		if blockHeight < bestHeight {
-			nextBlockID := wallet.NewBlockIdentifierFromHeight(blockHeight + 1)
-			nextBlockInfo, err := w.BlockInfo(ctx, nextBlockID)
-			if err != nil {
-				return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Info not found for next block: %v", err)
-			}
-			nextHashString = nextBlockInfo.Hash.String()
+	// Use coin type filter, defaulting to VAR (0) if not specified
+	// Default to VAR if no coin type specified
+	filterCoinType := cointype.CoinTypeVAR
+	if cmd.CoinType != nil {
+		filterCoinType = cointype.CoinType(*cmd.CoinType)
+		// Validate coin type
+		if !filterCoinType.IsValid() {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"invalid coin type %d: must be between 0 (VAR) and 255 (SKA)", *cmd.CoinType)
 		}
-		confirmations = int64(confirms(blockHeight, bestHeight))
 	}
 
-	// Calculate past median time. Look at the last 11 blocks, starting
-	// with the requested block, which is consistent with dcrd.
-	iBlkHeader := blockHeader // start with the block header for the requested block
-	timestamps := make([]int64, 0, 11)
-	for i := 0; i < cap(timestamps); i++ {
-		timestamps = append(timestamps, iBlkHeader.Timestamp.Unix())
-		if iBlkHeader.Height == 0 {
-			break
-		}
-		iBlkHeader, err = w.BlockHeader(ctx, &iBlkHeader.PrevBlock)
-		if err != nil {
-			return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Info not found for previous block: %v", err)
-		}
-	}
-	sort.Slice(timestamps, func(i, j int) bool {
-		return timestamps[i] < timestamps[j]
-	})
-	medianTime := timestamps[len(timestamps)/2]
+	// Get the correct AtomsPerCoin for this coin type
+	atomsPerCoin := getAtomsPerCoin(w.ChainParams(), filterCoinType)
 
-	// Determine the PoW hash.  When the v1 PoW hash differs from the
-	// block hash, this is assumed to be v2 (DCP0011).  More advanced
-	// selection logic will be necessary if the PoW hash changes again in
-	// the future.
-	powHash := blockHeader.PowHashV1()
-	if powHash != *blockHash {
-		powHash = blockHeader.PowHashV2()
+	total, err := w.TotalReceivedForAddr(ctx, addr, int32(*cmd.MinConf), filterCoinType)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAddressNotInWallet
+		}
+		return nil, err
 	}
 
-	return &dcrdtypes.GetBlockHeaderVerboseResult{
-		Hash:          blockHash.String(),
-		PowHash:       powHash.String(),
-		Confirmations: confirmations,
-		Version:       blockHeader.Version,
-		MerkleRoot:    blockHeader.MerkleRoot.String(),
-		StakeRoot:     blockHeader.StakeRoot.String(),
-		VoteBits:      blockHeader.VoteBits,
-		FinalState:    hex.EncodeToString(blockHeader.FinalState[:]),
-		Voters:        blockHeader.Voters,
-		FreshStake:    blockHeader.FreshStake,
-		Revocations:   blockHeader.Revocations,
-		PoolSize:      blockHeader.PoolSize,
-		Bits:          strconv.FormatInt(int64(blockHeader.Bits), 16),
-		SBits:         dcrutil.Amount(blockHeader.SBits).ToCoin(),
-		Height:        blockHeader.Height,
-		Size:          blockHeader.Size,
-		Time:          blockHeader.Timestamp.Unix(),
-		MedianTime:    medianTime,
-		Nonce:         blockHeader.Nonce,
-		ExtraData:     hex.EncodeToString(blockHeader.ExtraData[:]),
-		StakeVersion:  blockHeader.StakeVersion,
-		Difficulty:    difficultyRatio(blockHeader.Bits, w.ChainParams()),
-		ChainWork:     "", // unset because wallet is not equipped to easily calculate the cummulative chainwork
-		PreviousHash:  blockHeader.PrevBlock.String(),
-		NextHash:      nextHashString,
-	}, nil
+	return atomsToCoins(int64(total), atomsPerCoin), nil
 }
 
-// getBlock implements the getblock command.
-func (s *Server) getBlock(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetBlockCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// getMasterPubkey handles a getmasterpubkey request by returning the wallet
+// master pubkey encoded as a string.
+func (s *Server) getMasterPubkey(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetMasterPubkeyCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
-	n, err := w.NetworkBackend()
+
+	// If no account is passed, we provide the extended public key
+	// for the default account number.
+	account := uint32(udb.DefaultAccountNum)
+	if cmd.Account != nil {
+		var err error
+		account, err = w.AccountNumber(ctx, *cmd.Account)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+	}
+
+	xpub, err := w.AccountXpub(ctx, account)
 	if err != nil {
 		return nil, err
 	}
 
-	// Attempt RPC passthrough if connected to DCRD.
-	if chainSyncer, ok := n.(*chain.Syncer); ok {
-		var resp json.RawMessage
-		err := chainSyncer.RPC().Call(ctx, "getblock", &resp, cmd.Hash, cmd.Verbose, cmd.VerboseTx)
-		return resp, err
-	}
+	logCtx(ctx).Warnf("Attention: Extended public keys must not be shared with or " +
+		"leaked to external parties, such as VSPs, in combination with " +
+		"any account private key; this reveals all private keys of " +
+		"this account")
 
-	blockHash, err := chainhash.NewHashFromStr(cmd.Hash)
-	if err != nil {
-		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	return xpub.String(), nil
+}
+
+// getMaxSpendable handles a getmaxspendable request by returning the largest
+// amount of a coin type an account can send in a single transaction.
+func (s *Server) getMaxSpendable(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetMaxSpendableCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	blocks, err := n.Blocks(ctx, []*chainhash.Hash{blockHash})
-	if err != nil {
+	coinType := cointype.CoinType(*cmd.CoinType)
+	if err := validateCoinType(coinType); err != nil {
 		return nil, err
 	}
-	if len(blocks) == 0 {
-		// Should never happen but protects against a possible panic on
-		// the following code.
-		return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Network returned 0 blocks")
-	}
-
-	blk := blocks[0]
 
-	// When the verbose flag isn't set, simply return the
-	// network-serialized block as a hex-encoded string.
-	if cmd.Verbose == nil || !*cmd.Verbose {
-		b := new(strings.Builder)
-		b.Grow(2 * blk.SerializeSize())
-		err = blk.Serialize(hex.NewEncoder(b))
+	// use provided fee per Kb if specified, or the wallet's fee rate for
+	// the coin type otherwise
+	var feePerKb dcrutil.Amount
+	if cmd.FeePerKb != nil {
+		var err error
+		feePerKb, err = dcrutil.NewAmount(*cmd.FeePerKb)
 		if err != nil {
-			return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Could not serialize block: %v", err)
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 		}
-		return b.String(), nil
 	}
 
-	// Get next block hash unless there are none.
-	var nextHashString string
-	blockHeader := &blk.Header
-	confirmations := int64(-1)
-	mainChainHasBlock, _, err := w.BlockInMainChain(ctx, blockHash)
-	if err != nil {
-		return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Error checking if block is in mainchain: %v", err)
-	}
-	if mainChainHasBlock {
-		blockHeight := int32(blockHeader.Height)
-		_, bestHeight := w.MainChainTip(ctx)
-		if blockHeight < bestHeight {
-			nextBlockID := wallet.NewBlockIdentifierFromHeight(blockHeight + 1)
-			nextBlockInfo, err := w.BlockInfo(ctx, nextBlockID)
-			if err != nil {
-				return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Info not found for next block: %v", err)
-			}
-			nextHashString = nextBlockInfo.Hash.String()
-		}
-		confirmations = int64(confirms(blockHeight, bestHeight))
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
 	}
 
-	// Calculate past median time. Look at the last 11 blocks, starting
-	// with the requested block, which is consistent with dcrd.
-	timestamps := make([]int64, 0, 11)
-	for iBlkHeader := blockHeader; ; {
-		timestamps = append(timestamps, iBlkHeader.Timestamp.Unix())
-		if iBlkHeader.Height == 0 || len(timestamps) == cap(timestamps) {
-			break
-		}
-		iBlkHeader, err = w.BlockHeader(ctx, &iBlkHeader.PrevBlock)
-		if err != nil {
-			return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Info not found for previous block: %v", err)
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
 		}
+		return nil, err
 	}
-	sort.Slice(timestamps, func(i, j int) bool {
-		return timestamps[i] < timestamps[j]
-	})
-	medianTime := timestamps[len(timestamps)/2]
 
-	// Determine the PoW hash.  When the v1 PoW hash differs from the
-	// block hash, this is assumed to be v2 (DCP0011).  More advanced
-	// selection logic will be necessary if the PoW hash changes again in
-	// the future.
-	powHash := blockHeader.PowHashV1()
-	if powHash != *blockHash {
-		powHash = blockHeader.PowHashV2()
+	m, err := w.MaxSpendable(ctx, account, coinType, feePerKb, minConf)
+	if err != nil {
+		return nil, err
 	}
+	params := w.ChainParams()
+	return &types.GetMaxSpendableResult{
+		CoinType: uint8(coinType),
+		Amount:   coinAmount(params, coinType, m.Amount),
+		Fee:      coinAmount(params, coinType, big.NewInt(int64(m.Fee))),
+		Size:     m.Size,
+		Inputs:   m.Inputs,
+		Eligible: m.Eligible,
+	}, nil
+}
 
-	sbitsFloat := float64(blockHeader.SBits) / cointype.AtomsPerVAR
-	blockReply := dcrdtypes.GetBlockVerboseResult{
-		Hash:          cmd.Hash,
-		PoWHash:       powHash.String(),
-		Version:       blockHeader.Version,
-		MerkleRoot:    blockHeader.MerkleRoot.String(),
-		StakeRoot:     blockHeader.StakeRoot.String(),
-		PreviousHash:  blockHeader.PrevBlock.String(),
-		MedianTime:    medianTime,
-		Nonce:         blockHeader.Nonce,
-		VoteBits:      blockHeader.VoteBits,
-		FinalState:    hex.EncodeToString(blockHeader.FinalState[:]),
-		Voters:        blockHeader.Voters,
-		FreshStake:    blockHeader.FreshStake,
-		Revocations:   blockHeader.Revocations,
-		PoolSize:      blockHeader.PoolSize,
-		Time:          blockHeader.Timestamp.Unix(),
-		StakeVersion:  blockHeader.StakeVersion,
-		Confirmations: confirmations,
-		Height:        int64(blockHeader.Height),
-		Size:          int32(blk.Header.Size),
-		Bits:          strconv.FormatInt(int64(blockHeader.Bits), 16),
-		SBits:         sbitsFloat,
-		Difficulty:    difficultyRatio(blockHeader.Bits, w.ChainParams()),
-		ChainWork:     "", // unset because wallet is not equipped to easily calculate the cummulative chainwork
-		ExtraData:     hex.EncodeToString(blockHeader.ExtraData[:]),
-		NextHash:      nextHashString,
+// getPeerInfo responds to the getpeerinfo request.
+// It gets the network backend and views the data on remote peers when in spv mode
+func (s *Server) getPeerInfo(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, err
 	}
 
-	// The coinbase must be version 3 once the treasury agenda is active.
-	isTreasuryEnabled := blk.Transactions[0].Version >= wire.TxVersionTreasury
-
-	if cmd.VerboseTx == nil || !*cmd.VerboseTx {
-		transactions := blk.Transactions
-		txNames := make([]string, len(transactions))
-		for i, tx := range transactions {
-			txNames[i] = tx.TxHash().String()
-		}
-		blockReply.Tx = txNames
-
-		stransactions := blk.STransactions
-		stxNames := make([]string, len(stransactions))
-		for i, tx := range stransactions {
-			stxNames[i] = tx.TxHash().String()
-		}
-		blockReply.STx = stxNames
-	} else {
-		txns := blk.Transactions
-		rawTxns := make([]dcrdtypes.TxRawResult, len(txns))
-		for i, tx := range txns {
-			rawTxn, err := createTxRawResult(w.ChainParams(), tx, uint32(i), blockHeader, confirmations, isTreasuryEnabled)
-			if err != nil {
-				return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Could not create transaction: %v", err)
-			}
-			rawTxns[i] = *rawTxn
-		}
-		blockReply.RawTx = rawTxns
-
-		stxns := blk.STransactions
-		rawSTxns := make([]dcrdtypes.TxRawResult, len(stxns))
-		for i, tx := range stxns {
-			rawSTxn, err := createTxRawResult(w.ChainParams(), tx, uint32(i), blockHeader, confirmations, isTreasuryEnabled)
+	syncer, ok := n.(*spv.Syncer)
+	if !ok {
+		var resp []*types.GetPeerInfoResult
+		if chainSyncer, ok := n.(*chain.Syncer); ok {
+			err := chainSyncer.RPC().Call(ctx, "getpeerinfo", &resp)
 			if err != nil {
-				return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code, "Could not create stake transaction: %v", err)
+				return nil, err
 			}
-			rawSTxns[i] = *rawSTxn
 		}
-		blockReply.RawSTx = rawSTxns
+		return resp, nil
 	}
 
-	return blockReply, nil
-}
-
-func createTxRawResult(chainParams *chaincfg.Params, mtx *wire.MsgTx, blkIdx uint32, blkHeader *wire.BlockHeader,
-	confirmations int64, isTreasuryEnabled bool) (*dcrdtypes.TxRawResult, error) {
-
-	b := new(strings.Builder)
-	b.Grow(2 * mtx.SerializeSize())
-	err := mtx.Serialize(hex.NewEncoder(b))
-	if err != nil {
-		return nil, err
-	}
+	rps := syncer.GetRemotePeers()
+	infos := make([]*types.GetPeerInfoResult, 0, len(rps))
 
-	txReply := &dcrdtypes.TxRawResult{
-		Hex:           b.String(),
-		Txid:          mtx.CachedTxHash().String(),
-		Version:       int32(mtx.Version),
-		LockTime:      mtx.LockTime,
-		Expiry:        mtx.Expiry,
-		Vin:           createVinList(mtx, isTreasuryEnabled),
-		Vout:          createVoutList(mtx, chainParams, nil),
-		BlockHash:     blkHeader.BlockHash().String(),
-		BlockHeight:   int64(blkHeader.Height),
-		BlockIndex:    blkIdx,
-		Confirmations: confirmations,
-		Time:          blkHeader.Timestamp.Unix(),
-		Blocktime:     blkHeader.Timestamp.Unix(), // identical to Time in bitcoind too
+	for _, rp := range rps {
+		info := &types.GetPeerInfoResult{
+			ID:             int32(rp.ID()),
+			Addr:           rp.RemoteAddr().String(),
+			AddrLocal:      rp.LocalAddr().String(),
+			Services:       fmt.Sprintf("%08d", uint64(rp.Services())),
+			Version:        rp.Pver(),
+			SubVer:         rp.UA(),
+			StartingHeight: int64(rp.InitialHeight()),
+			BanScore:       int32(rp.BanScore()),
+		}
+		infos = append(infos, info)
 	}
-
-	return txReply, nil
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos, nil
 }
 
-// createVinList returns a slice of JSON objects for the inputs of the passed
-// transaction.
-func createVinList(mtx *wire.MsgTx, isTreasuryEnabled bool) []dcrdtypes.Vin {
-	// Treasurybase transactions only have a single txin by definition.
-	//
-	// NOTE: This check MUST come before the coinbase check because a
-	// treasurybase will be identified as a coinbase as well.
-	vinList := make([]dcrdtypes.Vin, len(mtx.TxIn))
-	if isTreasuryEnabled && blockchain.IsTreasuryBase(mtx) {
-		txIn := mtx.TxIn[0]
-		vinEntry := &vinList[0]
-		vinEntry.Treasurybase = true
-		vinEntry.Sequence = txIn.Sequence
-		vinEntry.AmountIn = dcrutil.Amount(txIn.ValueIn).ToCoin()
-		vinEntry.BlockHeight = txIn.BlockHeight
-		vinEntry.BlockIndex = txIn.BlockIndex
-		return vinList
+// getStakeInfo gets a large amounts of information about the stake environment
+// and a number of statistics about local staking in the wallet.
+func (s *Server) getStakeInfo(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	// Coinbase transactions only have a single txin by definition.
-	if blockchain.IsCoinBaseTx(mtx, isTreasuryEnabled) {
-		txIn := mtx.TxIn[0]
-		vinEntry := &vinList[0]
-		vinEntry.Coinbase = hex.EncodeToString(txIn.SignatureScript)
-		vinEntry.Sequence = txIn.Sequence
-		vinEntry.AmountIn = dcrutil.Amount(txIn.ValueIn).ToCoin()
-		vinEntry.BlockHeight = txIn.BlockHeight
-		vinEntry.BlockIndex = txIn.BlockIndex
-		return vinList
+	n, _ := s.loader(ctx).NetworkBackend()
+	var sinfo *wallet.StakeInfoData
+	var err error
+	if chainSyncer, ok := n.(*chain.Syncer); ok {
+		sinfo, err = w.StakeInfoPrecise(ctx, chainSyncer.RPC())
+	} else {
+		sinfo, err = w.StakeInfo(ctx)
 	}
-
-	// Treasury spend transactions only have a single txin by definition.
-	if isTreasuryEnabled && stake.IsTSpend(mtx) {
-		txIn := mtx.TxIn[0]
-		vinEntry := &vinList[0]
-		vinEntry.TreasurySpend = hex.EncodeToString(txIn.SignatureScript)
-		vinEntry.Sequence = txIn.Sequence
-		vinEntry.AmountIn = dcrutil.Amount(txIn.ValueIn).ToCoin()
-		vinEntry.BlockHeight = txIn.BlockHeight
-		vinEntry.BlockIndex = txIn.BlockIndex
-		return vinList
+	if err != nil {
+		return nil, err
 	}
 
-	// Stakebase transactions (votes) have two inputs: a null stake base
-	// followed by an input consuming a ticket's stakesubmission.
-	isSSGen := stake.IsSSGen(mtx)
+	var proportionLive, proportionMissed float64
+	if sinfo.PoolSize > 0 {
+		proportionLive = float64(sinfo.Live) / float64(sinfo.PoolSize)
+	}
+	if sinfo.Missed > 0 {
+		proportionMissed = float64(sinfo.Missed) / (float64(sinfo.Voted + sinfo.Missed))
+	}
 
-	for i, txIn := range mtx.TxIn {
-		// Handle only the null input of a stakebase differently.
-		if isSSGen && i == 0 {
-			vinEntry := &vinList[0]
-			vinEntry.Stakebase = hex.EncodeToString(txIn.SignatureScript)
-			vinEntry.Sequence = txIn.Sequence
-			vinEntry.AmountIn = dcrutil.Amount(txIn.ValueIn).ToCoin()
-			vinEntry.BlockHeight = txIn.BlockHeight
-			vinEntry.BlockIndex = txIn.BlockIndex
-			continue
-		}
+	resp := &types.GetStakeInfoResult{
+		BlockHeight:  sinfo.BlockHeight,
+		Difficulty:   sinfo.Sdiff.ToCoin(),
+		TotalSubsidy: sinfo.TotalSubsidy.ToCoin(),
 
-		// The disassembled string will contain [error] inline
-		// if the script doesn't fully parse, so ignore the
-		// error here.
-		disbuf, _ := txscript.DisasmString(txIn.SignatureScript)
+		OwnMempoolTix:  sinfo.OwnMempoolTix,
+		Immature:       sinfo.Immature,
+		Unspent:        sinfo.Unspent,
+		Voted:          sinfo.Voted,
+		Revoked:        sinfo.Revoked,
+		UnspentExpired: sinfo.UnspentExpired,
 
-		vinEntry := &vinList[i]
-		vinEntry.Txid = txIn.PreviousOutPoint.Hash.String()
-		vinEntry.Vout = txIn.PreviousOutPoint.Index
-		vinEntry.Tree = txIn.PreviousOutPoint.Tree
-		vinEntry.Sequence = txIn.Sequence
-		vinEntry.AmountIn = dcrutil.Amount(txIn.ValueIn).ToCoin()
-		vinEntry.BlockHeight = txIn.BlockHeight
-		vinEntry.BlockIndex = txIn.BlockIndex
-		vinEntry.ScriptSig = &dcrdtypes.ScriptSig{
-			Asm: disbuf,
-			Hex: hex.EncodeToString(txIn.SignatureScript),
-		}
+		PoolSize:         sinfo.PoolSize,
+		AllMempoolTix:    sinfo.AllMempoolTix,
+		Live:             sinfo.Live,
+		ProportionLive:   proportionLive,
+		Missed:           sinfo.Missed,
+		ProportionMissed: proportionMissed,
+		Expired:          sinfo.Expired,
 	}
 
-	return vinList
+	return resp, nil
 }
 
-// createVoutList returns a slice of JSON objects for the outputs of the passed
-// transaction.
-func createVoutList(mtx *wire.MsgTx, chainParams *chaincfg.Params, filterAddrMap map[string]struct{}) []dcrdtypes.Vout {
-	txType := stake.DetermineTxType(mtx)
-	voutList := make([]dcrdtypes.Vout, 0, len(mtx.TxOut))
-	for i, v := range mtx.TxOut {
-		// The disassembled string will contain [error] inline if the
-		// script doesn't fully parse, so ignore the error here.
-		disbuf, _ := txscript.DisasmString(v.PkScript)
+// getFeeSummary returns the fees paid by the wallet's mined transactions over
+// the requested window of days, by coin type and by day.
+func (s *Server) getFeeSummary(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetFeeSummaryCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-		// Attempt to extract addresses from the public key script.  In
-		// the case of stake submission transactions, the odd outputs
-		// contain a commitment address, so detect that case
-		// accordingly.
-		var addrs []stdaddr.Address
-		var scriptClass string
-		var reqSigs uint16
-		var commitAmt *dcrutil.Amount
-		if txType == stake.TxTypeSStx && (i%2 != 0) {
-			scriptClass = sstxCommitmentString
-			addr, err := stake.AddrFromSStxPkScrCommitment(v.PkScript,
-				chainParams)
-			if err != nil {
-				log.Warnf("failed to decode ticket "+
-					"commitment addr output for tx hash "+
-					"%v, output idx %v", mtx.TxHash(), i)
-			} else {
-				addrs = []stdaddr.Address{addr}
-			}
-			amt, err := stake.AmountFromSStxPkScrCommitment(v.PkScript)
-			if err != nil {
-				log.Warnf("failed to decode ticket "+
-					"commitment amt output for tx hash %v"+
-					", output idx %v", mtx.TxHash(), i)
-			} else {
-				commitAmt = &amt
-			}
-		} else {
-			// Ignore the error here since an error means the script
-			// couldn't parse and there is no additional information
-			// about it anyways.
-			var sc stdscript.ScriptType
-			sc, addrs = stdscript.ExtractAddrs(v.Version, v.PkScript, chainParams)
-			reqSigs = stdscript.DetermineRequiredSigs(v.Version, v.PkScript)
-			scriptClass = sc.String()
+	if *cmd.Days < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative days")
+	}
+	if cmd.CoinType != nil {
+		if err := validateCoinType(cointype.CoinType(*cmd.CoinType)); err != nil {
+			return nil, err
 		}
+	}
+	summary, err := w.FeeSummary(ctx, *cmd.Days)
+	if err != nil {
+		return nil, err
+	}
 
-		// Encode the addresses while checking if the address passes the
-		// filter when needed.
-		passesFilter := len(filterAddrMap) == 0
-		encodedAddrs := make([]string, len(addrs))
-		for j, addr := range addrs {
-			encodedAddr := addr.String()
-			encodedAddrs[j] = encodedAddr
-
-			// No need to check the map again if the filter already
-			// passes.
-			if passesFilter {
-				continue
-			}
-			if _, exists := filterAddrMap[encodedAddr]; exists {
-				passesFilter = true
-			}
+	params := w.ChainParams()
+	resp := &types.GetFeeSummaryResult{
+		Days:      summary.Days,
+		Since:     summary.Since.Unix(),
+		CoinTypes: make([]types.FeeSummaryCoinType, 0, len(summary.CoinTypes)),
+		Daily:     make([]types.FeeSummaryDailyResult, 0, len(summary.Daily)),
+	}
+	for i := range summary.CoinTypes {
+		c := &summary.CoinTypes[i]
+		if cmd.CoinType != nil && uint8(c.CoinType) != *cmd.CoinType {
+			continue
 		}
-
-		if !passesFilter {
+		resp.CoinTypes = append(resp.CoinTypes, types.FeeSummaryCoinType{
+			CoinType:     uint8(c.CoinType),
+			Transactions: c.Transactions,
+			TotalFees:    coinAmount(params, c.CoinType, c.TotalFees),
+			AverageFee:   coinAmount(params, c.CoinType, c.AverageFee),
+			DailyAverage: coinAmount(params, c.CoinType, c.DailyAverage),
+		})
+	}
+	for i := range summary.Daily {
+		d := &summary.Daily[i]
+		if cmd.CoinType != nil && uint8(d.CoinType) != *cmd.CoinType {
 			continue
 		}
+		date := time.Unix(d.Day*int64(24*time.Hour/time.Second), 0).UTC()
+		resp.Daily = append(resp.Daily, types.FeeSummaryDailyResult{
+			Date:         date.Format(time.DateOnly),
+			CoinType:     uint8(d.CoinType),
+			Transactions: d.Transactions,
+			Fees:         coinAmount(params, d.CoinType, d.Fees),
+		})
+	}
+	return resp, nil
+}
 
-		var vout dcrdtypes.Vout
-		voutSPK := &vout.ScriptPubKey
-		vout.N = uint32(i)
-		vout.Value = dcrutil.Amount(v.Value).ToCoin()
-		vout.Version = v.Version
-		voutSPK.Addresses = encodedAddrs
-		voutSPK.Asm = disbuf
-		voutSPK.Hex = hex.EncodeToString(v.PkScript)
-		voutSPK.Type = scriptClass
-		voutSPK.ReqSigs = int32(reqSigs)
-		if commitAmt != nil {
-			voutSPK.CommitAmt = dcrjson.Float64(commitAmt.ToCoin())
-		}
+// getSKAEmissionHistory returns the SKA emissions observed by the wallet in
+// main chain blocks.
+func (s *Server) getSKAEmissionHistory(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetSKAEmissionHistoryCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-		voutList = append(voutList, vout)
+	var coinType *cointype.CoinType
+	if cmd.CoinType != nil {
+		ct := cointype.CoinType(*cmd.CoinType)
+		if !ct.IsSKA() {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"cointype must be an SKA coin type")
+		}
+		coinType = &ct
+	}
+	emissions, err := w.SKAEmissionHistory(ctx, coinType)
+	if err != nil {
+		return nil, err
 	}
 
-	return voutList
+	params := w.ChainParams()
+	res := make([]types.SKAEmissionResult, 0, len(emissions))
+	for i := range emissions {
+		e := &emissions[i]
+		r := types.SKAEmissionResult{
+			CoinType:      uint8(e.CoinType),
+			Height:        e.Height,
+			TxID:          e.Hash.String(),
+			Amount:        atomsToCoinsBig(e.Amount, getAtomsPerCoin(params, e.CoinType)),
+			EmitterScript: hex.EncodeToString(e.EmitterScript),
+		}
+		if auth, err := wallet.ParseSKAEmissionAuth(e.EmitterScript); err == nil {
+			r.EmissionKey = hex.EncodeToString(auth.EmissionKey.SerializeCompressed())
+			r.Nonce = auth.Nonce
+		}
+		res = append(res, r)
+	}
+	return res, nil
 }
 
-// difficultyRatio returns the proof-of-work difficulty as a multiple of the
-// minimum difficulty using the passed bits field from the header of a block.
-func difficultyRatio(bits uint32, params *chaincfg.Params) float64 {
-	// The minimum difficulty is the max possible proof-of-work limit bits
-	// converted back to a number.  Note this is not the same as the proof
-	// of work limit directly because the block difficulty is encoded in a
-	// block with the compact form which loses precision.
-	max := blockchain.CompactToBig(params.PowLimitBits)
-	target := blockchain.CompactToBig(bits)
+// getSKASupply returns the supply of SKA coin types, counted from the
+// emissions observed by the wallet.
+func (s *Server) getSKASupply(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetSKASupplyCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-	difficulty := new(big.Rat).SetFrac(max, target)
-	outString := difficulty.FloatString(8)
-	diff, err := strconv.ParseFloat(outString, 64)
+	if cmd.CoinType != nil && !cointype.CoinType(*cmd.CoinType).IsSKA() {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"cointype must be an SKA coin type")
+	}
+	supply, err := w.SKASupply(ctx)
 	if err != nil {
-		log.Errorf("Cannot get difficulty: %v", err)
-		return 0
+		return nil, err
 	}
-	return diff
+
+	params := w.ChainParams()
+	res := make([]types.SKASupplyResult, 0, len(supply))
+	for i := range supply {
+		sup := &supply[i]
+		if cmd.CoinType != nil && uint8(sup.CoinType) != *cmd.CoinType {
+			continue
+		}
+		atomsPerCoin := getAtomsPerCoin(params, sup.CoinType)
+		r := types.SKASupplyResult{
+			CoinType:           uint8(sup.CoinType),
+			Emitted:            atomsToCoinsBig(sup.Emitted, atomsPerCoin),
+			Emissions:          sup.Emissions,
+			LastEmissionHeight: sup.LastHeight,
+		}
+		if sup.MaxSupply != nil {
+			remaining := new(big.Int).Sub(sup.MaxSupply, sup.Emitted)
+			if remaining.Sign() < 0 {
+				remaining.SetInt64(0)
+			}
+			r.MaxSupply = atomsToCoinsBig(sup.MaxSupply, atomsPerCoin)
+			r.Remaining = atomsToCoinsBig(remaining, atomsPerCoin)
+		}
+		res = append(res, r)
+	}
+	return res, nil
 }
 
-// syncStatus handles a syncstatus request.
-func (s *Server) syncStatus(ctx context.Context, icmd any) (any, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+// getStakeStats returns statistics of the wallet's tickets and earned stake
+// rewards, computed from wallet data.  Vote statistics cover the requested
+// window of blocks, or the entire chain when the window is zero.
+func (s *Server) getStakeStats(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetStakeStatsCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
-	n, err := w.NetworkBackend()
+
+	window := 0
+	if cmd.Window != nil {
+		window = *cmd.Window
+	}
+	if window < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative window")
+	}
+	stats, err := w.StakeStats(ctx, int32(window))
 	if err != nil {
 		return nil, err
 	}
 
-	walletBestHash, walletBestHeight := w.MainChainTip(ctx)
-	bestBlock, err := w.BlockInfo(ctx, wallet.NewBlockIdentifierFromHash(&walletBestHash))
-	if err != nil {
-		return nil, err
+	resp := &types.GetStakeStatsResult{
+		BlockHeight: stats.Height,
+		Live:        stats.Live,
+		Immature:    stats.Immature,
+		Missed:      stats.Missed,
+		Expired:     stats.Expired,
+		Revoked:     stats.Revoked,
+		FeeRewards:  make([]types.StakeStatsFeeReward, 0, len(stats.FeeRewards)),
+		Window:      stats.Window,
+		Votes:       stats.WindowVotes,
+	}
+	for ct, reward := range stats.FeeRewards {
+		resp.FeeRewards = append(resp.FeeRewards, types.StakeStatsFeeReward{
+			CoinType: uint8(ct),
+			Amount:   coinAmount(w.ChainParams(), ct, reward),
+		})
+	}
+	sort.Slice(resp.FeeRewards, func(i, j int) bool {
+		return resp.FeeRewards[i].CoinType < resp.FeeRewards[j].CoinType
+	})
+	if n := stats.WindowVotes + stats.WindowMissed; n > 0 {
+		resp.VoteSuccessRate = float64(stats.WindowVotes) / float64(n)
+	}
+	if stats.WindowVotes > 0 {
+		resp.AverageVoteReward = (stats.WindowSubsidy /
+			dcrutil.Amount(stats.WindowVotes)).ToCoin()
 	}
-	_24HoursAgo := time.Now().UTC().Add(-24 * time.Hour).Unix()
-	walletBestBlockTooOld := bestBlock.Timestamp < _24HoursAgo
 
-	synced, targetHeight := n.Synced(ctx)
+	return resp, nil
+}
 
-	var headersFetchProgress float32
-	blocksToFetch := targetHeight - walletBestHeight
-	if blocksToFetch <= 0 {
-		headersFetchProgress = 1
-	} else {
-		totalHeadersToFetch := targetHeight - w.InitialHeight()
-		headersFetchProgress = 1 - (float32(blocksToFetch) / float32(totalHeadersToFetch))
+// getTickets handles a gettickets request by returning the hashes of the tickets
+// currently owned by wallet, encoded as strings.
+func (s *Server) getTickets(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetTicketsCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	return &types.SyncStatusResult{
-		Synced:               synced,
-		InitialBlockDownload: walletBestBlockTooOld,
-		HeadersFetchProgress: headersFetchProgress,
-	}, nil
-}
+	n, _ := s.loader(ctx).NetworkBackend()
+	rpc, _ := n.(wallet.LiveTicketQuerier) // nil rpc indicates SPV to LiveTicketHashes
+
+	ticketHashes, err := w.LiveTicketHashes(ctx, rpc, cmd.IncludeImmature)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compose a slice of strings to return.
+	ticketHashStrs := make([]string, 0, len(ticketHashes))
+	for i := range ticketHashes {
+		ticketHashStrs = append(ticketHashStrs, ticketHashes[i].String())
+	}
 
-// getCurrentNet handles a getcurrentnet request.
-func (s *Server) getCurrentNet(ctx context.Context, icmd any) (any, error) {
-	return s.activeNet.Net, nil
+	return &types.GetTicketsResult{Hashes: ticketHashStrs}, nil
 }
 
-// getInfo handles a getinfo request by returning a structure containing
-// information about the current state of the wallet.
-func (s *Server) getInfo(ctx context.Context, icmd any) (any, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+// getTransaction handles a gettransaction request by returning details about
+// a single transaction saved by wallet.
+func (s *Server) getTransaction(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetTransactionCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	tipHash, tipHeight := w.MainChainTip(ctx)
-	tipHeader, err := w.BlockHeader(ctx, &tipHash)
+	txHash, err := chainhash.NewHashFromStr(cmd.Txid)
 	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+
+	// returns nil details when not found
+	txd, err := wallet.UnstableAPI(w).TxDetails(ctx, txHash)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcErrorf(dcrjson.ErrRPCNoTxInfo, "no information for transaction")
+	} else if err != nil {
 		return nil, err
 	}
 
-	balances, err := w.AccountBalances(ctx, 1)
+	_, tipHeight := w.MainChainTip(ctx)
+
+	var b strings.Builder
+	b.Grow(2 * txd.MsgTx.SerializeSize())
+	err = txd.MsgTx.Serialize(hex.NewEncoder(&b))
 	if err != nil {
 		return nil, err
 	}
-	var spendableBalance dcrutil.Amount
-	for _, balance := range balances {
-		spendableBalance += balance.Spendable
+
+	// TODO: Add a "generated" field to this result type.  "generated":true
+	// is only added if the transaction is a coinbase.
+	ret := types.GetTransactionResult{
+		TxID:            cmd.Txid,
+		Hex:             b.String(),
+		Time:            txd.Received.Unix(),
+		TimeReceived:    txd.Received.Unix(),
+		WalletConflicts: []string{}, // Not saved
+		//Generated:     compat.IsEitherCoinBaseTx(&details.MsgTx),
 	}
 
-	info := &types.InfoResult{
-		Version:         version.Integer,
-		ProtocolVersion: int32(p2p.Pver),
-		WalletVersion:   version.Integer,
-		Balance:         spendableBalance.ToCoin(),
-		Blocks:          tipHeight,
-		TimeOffset:      0,
-		Connections:     0,
-		Proxy:           "",
-		Difficulty:      difficultyRatio(tipHeader.Bits, w.ChainParams()),
-		TestNet:         w.ChainParams().Net == wire.TestNet3,
-		KeypoolOldest:   0,
-		KeypoolSize:     0,
-		UnlockedUntil:   0,
-		PaytxFee:        w.RelayFee().ToCoin(),
-		RelayFee:        0,
-		Errors:          "",
+	if txd.Block.Height != -1 {
+		ret.BlockHash = txd.Block.Hash.String()
+		ret.BlockTime = txd.Block.Time.Unix()
+		ret.Confirmations = int64(confirms(txd.Block.Height,
+			tipHeight))
 	}
 
-	n, _ := s.walletLoader.NetworkBackend()
-	if chainSyncer, ok := n.(*chain.Syncer); ok {
-		var consensusInfo dcrdtypes.InfoChainResult
-		err := chainSyncer.RPC().Call(ctx, "getinfo", &consensusInfo)
-		if err != nil {
-			return nil, err
-		}
-		info.Version = consensusInfo.Version
-		info.ProtocolVersion = consensusInfo.ProtocolVersion
-		info.TimeOffset = consensusInfo.TimeOffset
-		info.Connections = consensusInfo.Connections
-		info.Proxy = consensusInfo.Proxy
-		info.RelayFee = consensusInfo.RelayFee
-		info.Errors = consensusInfo.Errors
+	ret.Label, err = w.TransactionLabel(ctx, txHash)
+	if err != nil {
+		return nil, err
 	}
 
-	return info, nil
-}
+	// Determine coin type from transaction outputs
+	var txCoinType cointype.CoinType
+	for _, output := range txd.MsgTx.TxOut {
+		if output.CoinType.IsSKA() {
+			txCoinType = output.CoinType
+			break
+		}
+	}
+	isSKA := txCoinType.IsSKA()
+	atomsPerCoin := getAtomsPerCoin(w.ChainParams(), txCoinType)
 
-func decodeAddress(s string, params *chaincfg.Params) (stdaddr.Address, error) {
-	// Secp256k1 pubkey as a string, handle differently.
-	if len(s) == 66 || len(s) == 130 {
-		pubKeyBytes, err := hex.DecodeString(s)
-		if err != nil {
+	if txd.Block.Height != -1 {
+		price, err := w.PriceAt(ctx, txCoinType, txd.Block.Time)
+		switch {
+		case errors.Is(err, errors.NotExist):
+		case err != nil:
 			return nil, err
+		default:
+			ret.FiatCurrency = price.Currency
+			ret.FiatPrice = fiatrate.FormatPrice(price.Price)
+			ret.FiatPriceTime = price.Time.Unix()
 		}
-		pubKeyAddr, err := stdaddr.NewAddressPubKeyEcdsaSecp256k1V0Raw(
-			pubKeyBytes, params)
-		if err != nil {
-			return nil, err
+	}
+
+	var (
+		debitTotal     dcrutil.Amount
+		creditTotal    dcrutil.Amount
+		skaDebitTotal  cointype.SKAAmount
+		skaCreditTotal cointype.SKAAmount
+		fee            dcrutil.Amount
+		negFeeF64      float64
+	)
+
+	// For SKA transactions, use a direct calculation approach:
+	// - Credits = outputs that belong to the wallet (in txd.Credits)
+	// - Debits = either from database or calculated from inputs
+	// - Net = Credits - Debits (negative for sends, positive for receives)
+	if isSKA {
+		// Calculate credits directly from outputs that are in txd.Credits
+		for _, cred := range txd.Credits {
+			if int(cred.Index) < len(txd.MsgTx.TxOut) {
+				output := txd.MsgTx.TxOut[cred.Index]
+				if output.SKAValue != nil && output.SKAValue.Sign() > 0 {
+					skaCreditTotal = skaCreditTotal.Add(cointype.NewSKAAmount(output.SKAValue))
+				}
+			}
 		}
 
-		return pubKeyAddr, nil
-	}
+		// Calculate debits: sum of all inputs from wallet (previous outputs being spent)
+		// First try from database, then look up previous transactions
+		for _, deb := range txd.Debits {
+			if !deb.SKAAmount.IsZero() {
+				skaDebitTotal = skaDebitTotal.Add(deb.SKAAmount)
+			} else if int(deb.Index) < len(txd.MsgTx.TxIn) {
+				// Look up the previous transaction's output to get the actual SKA amount
+				prevOut := &txd.MsgTx.TxIn[deb.Index].PreviousOutPoint
+				prevHash := prevOut.Hash
+				prevTxs, _, err := w.GetTransactionsByHashes(ctx, []*chainhash.Hash{&prevHash})
+				if err == nil && len(prevTxs) > 0 && prevTxs[0] != nil {
+					if int(prevOut.Index) < len(prevTxs[0].TxOut) {
+						out := prevTxs[0].TxOut[prevOut.Index]
+						if out.SKAValue != nil && out.SKAValue.Sign() > 0 {
+							skaDebitTotal = skaDebitTotal.Add(cointype.NewSKAAmount(out.SKAValue))
+						}
+					}
+				}
+			}
+		}
 
-	addr, err := stdaddr.DecodeAddress(s, params)
-	if err != nil {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidAddressOrKey,
-			"invalid address %q: decode failed: %#q", s, err)
-	}
-	return addr, nil
-}
+		// For SKA, use output-based calculation which is more reliable
+		// especially for unmined transactions where credits may not be populated yet.
+		// For a send: Amount = -(outputs NOT to our wallet)
+		// For a receive: Amount = (outputs TO our wallet that are credits)
 
-func decodeStakeAddress(s string, params *chaincfg.Params) (stdaddr.StakeAddress, error) {
-	a, err := decodeAddress(s, params)
-	if err != nil {
-		return nil, err
-	}
-	if sa, ok := a.(stdaddr.StakeAddress); ok {
-		return sa, nil
-	}
-	return nil, rpcErrorf(dcrjson.ErrRPCInvalidAddressOrKey,
-		"invalid stake address %q", s)
-}
+		// Calculate fee if all inputs are debits and we have debit values
+		var skaFee cointype.SKAAmount
+		if len(txd.Debits) == len(txd.MsgTx.TxIn) && !skaDebitTotal.IsZero() {
+			var skaOutputTotal cointype.SKAAmount
+			for _, output := range txd.MsgTx.TxOut {
+				if output.SKAValue != nil {
+					skaOutputTotal = skaOutputTotal.Add(cointype.NewSKAAmount(output.SKAValue))
+				}
+			}
+			skaFee = skaDebitTotal.Sub(skaOutputTotal)
+			ret.Fee = skaFee.Neg().ToDecimalString(atomsPerCoin)
+		}
 
-// getAccount handles a getaccount request by returning the account name
-// associated with a single address.
-func (s *Server) getAccount(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+		// Amount = credits - debits (same formula as VAR)
+		// For mined transactions: credits are populated, so this gives correct net change
+		// For unmined transactions: credits = 0, so this gives -debits (total spent)
+		skaNet := skaCreditTotal.Sub(skaDebitTotal)
+		ret.Amount = skaNet.ToDecimalString(atomsPerCoin)
+	} else {
+		// VAR transaction handling (unchanged)
+		for _, deb := range txd.Debits {
+			debitTotal += deb.Amount
+		}
+		for _, cred := range txd.Credits {
+			creditTotal += cred.Amount
+		}
+		// Fee can only be determined if every input is a debit.
+		if len(txd.Debits) == len(txd.MsgTx.TxIn) {
+			var outputTotal dcrutil.Amount
+			for _, output := range txd.MsgTx.TxOut {
+				outputTotal += dcrutil.Amount(output.Value)
+			}
+			fee = debitTotal - outputTotal
+			negFeeF64 = (-fee).ToCoin()
+		}
+		ret.Amount = (creditTotal - debitTotal).ToCoin()
+		ret.Fee = negFeeF64
 	}
 
-	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	details, err := w.ListTransactionDetails(ctx, txHash)
 	if err != nil {
 		return nil, err
 	}
-
-	a, err := w.KnownAddress(ctx, addr)
-	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAddressNotInWallet
+	ret.Details = make([]types.GetTransactionDetailsResult, len(details))
+	for i, d := range details {
+		ret.Details[i] = types.GetTransactionDetailsResult{
+			Account:           d.Account,
+			Address:           d.Address,
+			Amount:            d.Amount,
+			Category:          d.Category,
+			InvolvesWatchOnly: d.InvolvesWatchOnly,
+			Fee:               d.Fee,
+			Vout:              d.Vout,
 		}
-		return nil, err
 	}
 
-	return a.AccountName(), nil
+	return ret, nil
 }
 
-// getAccountAddress handles a getaccountaddress by returning the most
-// recently-created chained address that has not yet been used (does not yet
-// appear in the blockchain, or any tx that has arrived in the dcrd mempool).
-// If the most recently-requested address has been used, a new address (the
-// next chained address in the keypool) is used.  This can fail if the keypool
-// runs out (and will return dcrjson.ErrRPCWalletKeypoolRanOut if that happens).
-func (s *Server) getAccountAddress(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetAccountAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// getTxTrace handles a gettxtrace request by returning the lifecycle timeline
+// of a transaction originated by the wallet.
+func (s *Server) getTxTrace(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetTxTraceCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	account, err := w.AccountNumber(ctx, cmd.Account)
+	txHash, err := chainhash.NewHashFromStr(cmd.TxHash)
 	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAccountNotFound
-		}
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+
+	trace, err := w.TxTrace(ctx, txHash)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcErrorf(dcrjson.ErrRPCNoTxInfo, "no trace for transaction")
+	} else if err != nil {
 		return nil, err
 	}
 
-	// Get the current address and persist it to the database so it can be
-	// found when receiving transactions and appears in getaddressesbyaccount.
-	addr, err := w.CurrentAddressAndPersist(ctx, account)
-	if err != nil {
-		// Expect account lookup to succeed
-		if errors.Is(err, errors.NotExist) {
-			return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
+	events := make([]types.TxTraceEventResult, 0, len(trace.Events))
+	for _, e := range trace.Events {
+		r := types.TxTraceEventResult{
+			Stage: e.Stage.String(),
+			Time:  e.Time.Unix(),
 		}
-		return nil, err
+		if e.Height >= 0 {
+			r.Height = e.Height
+		}
+		events = append(events, r)
 	}
-
-	return addr.String(), nil
+	return &types.GetTxTraceResult{
+		TxHash:  txHash.String(),
+		TraceID: trace.ID.String(),
+		Events:  events,
+	}, nil
 }
 
-// getUnconfirmedBalance handles a getunconfirmedbalance extension request
-// by returning the current unconfirmed balance of an account.
-func (s *Server) getUnconfirmedBalance(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetUnconfirmedBalanceCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// getTxOut handles a gettxout request by returning details about an unspent
+// output. In SPV mode, details are only returned for transaction outputs that
+// are relevant to the wallet.
+// To match the behavior in RPC mode, (nil, nil) is returned if the transaction
+// output could not be found (never existed or was pruned) or is spent by another
+// transaction already in the main chain.  Mined transactions that are spent by
+// a mempool transaction are not affected by this.
+func (s *Server) getTxOut(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetTxOutCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	acctName := "default"
-	if cmd.Account != nil {
-		acctName = *cmd.Account
-	}
-	account, err := w.AccountNumber(ctx, acctName)
+	// Attempt RPC passthrough if connected to DCRD.
+	n, err := w.NetworkBackend()
 	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAccountNotFound
-		}
 		return nil, err
 	}
-	bals, err := w.AccountBalance(ctx, account, 1)
-	if err != nil {
-		// Expect account lookup to succeed
-		if errors.Is(err, errors.NotExist) {
-			return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
-		}
-		return nil, err
+	if chainSyncer, ok := n.(*chain.Syncer); ok {
+		var resp json.RawMessage
+		err := chainSyncer.RPC().Call(ctx, "gettxout", &resp, cmd.Txid, cmd.Vout, cmd.Tree, cmd.IncludeMempool)
+		return resp, err
 	}
 
-	return (bals.Total - bals.Spendable).ToCoin(), nil
-}
-
-// getCFilterV2 implements the getcfilterv2 command.
-func (s *Server) getCFilterV2(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetCFilterV2Cmd)
-	blockHash, err := chainhash.NewHashFromStr(cmd.BlockHash)
+	txHash, err := chainhash.NewHashFromStr(cmd.Txid)
 	if err != nil {
 		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
 	}
 
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+	if cmd.Tree != wire.TxTreeRegular && cmd.Tree != wire.TxTreeStake {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "Tx tree must be regular or stake")
 	}
 
-	key, filter, err := w.CFilterV2(ctx, blockHash)
-	if err != nil {
+	// Attempt to read the unspent txout info from wallet.
+	outpoint := wire.OutPoint{Hash: *txHash, Index: cmd.Vout, Tree: cmd.Tree}
+	utxo, err := w.UnspentOutput(ctx, outpoint, *cmd.IncludeMempool)
+	if err != nil && !errors.Is(err, errors.NotExist) {
 		return nil, err
 	}
+	if utxo == nil {
+		return nil, nil // output is spent or does not exist.
+	}
 
-	return &types.GetCFilterV2Result{
-		BlockHash: cmd.BlockHash,
-		Filter:    hex.EncodeToString(filter.Bytes()),
-		Key:       hex.EncodeToString(key[:]),
+	// Disassemble script into single line printable format.  The
+	// disassembled string will contain [error] inline if the script
+	// doesn't fully parse, so ignore the error here.
+	disbuf, _ := txscript.DisasmString(utxo.PkScript)
+
+	// Get further info about the script.  Ignore the error here since an
+	// error means the script couldn't parse and there is no additional
+	// information about it anyways.
+	scriptClass, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, utxo.PkScript, s.activeNet)
+	reqSigs := stdscript.DetermineRequiredSigs(scriptVersionAssumed, utxo.PkScript)
+	addresses := make([]string, len(addrs))
+	for i, addr := range addrs {
+		addresses[i] = addr.String()
+	}
+
+	bestHash, bestHeight := w.MainChainTip(ctx)
+	var confirmations int64
+	if utxo.Block.Height != -1 {
+		confirmations = int64(confirms(utxo.Block.Height, bestHeight))
+	}
+
+	return &dcrdtypes.GetTxOutResult{
+		BestBlock:     bestHash.String(),
+		Confirmations: confirmations,
+		Value:         utxo.Amount.ToCoin(),
+		ScriptPubKey: dcrdtypes.ScriptPubKeyResult{
+			Asm:       disbuf,
+			Hex:       hex.EncodeToString(utxo.PkScript),
+			ReqSigs:   int32(reqSigs),
+			Type:      scriptClass.String(),
+			Addresses: addresses,
+		},
+		Coinbase: utxo.FromCoinBase,
 	}, nil
 }
 
-// importCFiltersV2 handles an importcfiltersv2 request by parsing the provided
-// hex-encoded filters into bytes and importing them into the wallet.
-func (s *Server) importCFiltersV2(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ImportCFiltersV2Cmd)
-
-	w, ok := s.walletLoader.LoadedWallet()
+// getVoteChoices handles a getvotechoices request by returning configured vote
+// preferences for each agenda of the latest supported stake version.
+func (s *Server) getVoteChoices(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetVoteChoicesCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	filterData := make([][]byte, len(cmd.Filters))
-	for i, fdhex := range cmd.Filters {
-		var err error
-		filterData[i], err = hex.DecodeString(fdhex)
+	var ticketHash *chainhash.Hash
+	if cmd.TicketHash != nil {
+		hash, err := chainhash.NewHashFromStr(*cmd.TicketHash)
 		if err != nil {
-			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParams.Code, "filter %d is not a valid hex string", i)
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 		}
+		ticketHash = hash
 	}
 
-	err := w.ImportCFiltersV2(ctx, cmd.StartHeight, filterData)
-	if err != nil {
-		return nil, rpcError(dcrjson.ErrRPCInvalidRequest.Code, err)
+	version, agendas := wallet.CurrentAgendas(w.ChainParams())
+	resp := &types.GetVoteChoicesResult{
+		Version: version,
+		Choices: make([]types.VoteChoice, 0, len(agendas)),
 	}
 
-	return nil, nil
-}
-
-// importPrivKey handles an importprivkey request by parsing
-// a WIF-encoded private key and adding it to an account.
-func (s *Server) importPrivKey(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ImportPrivKeyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+	choices, _, err := w.AgendaChoices(ctx, ticketHash)
+	if err != nil {
+		return nil, err
 	}
 
-	rescan := true
-	if cmd.Rescan != nil {
-		rescan = *cmd.Rescan
-	}
-	scanFrom := int32(0)
-	if cmd.ScanFrom != nil {
-		scanFrom = int32(*cmd.ScanFrom)
-	}
-	n, ok := s.walletLoader.NetworkBackend()
-	if rescan && !ok {
-		return nil, errNoNetwork
+	// Include the deployment status of each agenda when the network backend
+	// is able to report it.  The configured choices are still returned
+	// without a status when the query fails.
+	var deploymentInfo map[string]dcrdtypes.AgendaInfo
+	if n, err := w.NetworkBackend(); err == nil {
+		if querier, ok := n.(deployments.Querier); ok {
+			deploymentInfo, err = querier.Deployments(ctx)
+			if err != nil {
+				logCtx(ctx).Warnf("Unable to query agenda deployment "+
+					"status: %v", err)
+			}
+		}
 	}
 
-	// Ensure that private keys are only imported to the correct account.
-	//
-	// Yes, Label is the account name.
-	if cmd.Label != nil && *cmd.Label != udb.ImportedAddrAccountName {
-		return nil, errNotImportedAccount
+	for _, agenda := range agendas {
+		agendaID := agenda.Vote.Id
+		voteChoice := types.VoteChoice{
+			AgendaID:          agendaID,
+			AgendaDescription: agenda.Vote.Description,
+			AgendaStatus:      deploymentInfo[agendaID].Status,
+			ChoiceID:          choices[agendaID],
+			ChoiceDescription: "", // Set below
+		}
+
+		for _, choice := range agenda.Vote.Choices {
+			if choices[agendaID] == choice.Id {
+				voteChoice.ChoiceDescription = choice.Description
+				break
+			}
+		}
+
+		resp.Choices = append(resp.Choices, voteChoice)
 	}
 
-	wif, err := dcrutil.DecodeWIF(cmd.PrivKey, w.ChainParams().PrivateKeyID)
-	if err != nil {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidAddressOrKey, "WIF decode failed: %v", err)
+	return resp, nil
+}
+
+// getWalletFee returns the currently set tx fee for the requested wallet
+// with source indication (manual, rpc, or static).
+func (s *Server) getWalletFee(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetWalletFeeCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	// Import the private key, handling any errors.
-	_, err = w.ImportPrivateKey(ctx, wif)
-	if err != nil {
-		switch {
-		case errors.Is(err, errors.Exist):
-			// Do not return duplicate key errors to the client.
-			return nil, nil
-		case errors.Is(err, errors.Locked):
-			return nil, errWalletUnlockNeeded
-		default:
-			return nil, err
-		}
+	// Default to VAR (coin type 0) if not specified
+	coinType := cointype.CoinType(0)
+	if cmd.CoinType != nil {
+		coinType = cointype.CoinType(*cmd.CoinType)
 	}
 
-	if rescan {
-		// Rescan in the background rather than blocking the rpc request. Use
-		// the server waitgroup to ensure the rescan can return cleanly rather
-		// than being killed mid database transaction.
-		s.wg.Add(1)
-		go func() {
-			defer s.wg.Done()
-			serverCtx := s.httpServer.BaseContext(nil)
-			_ = w.RescanFromHeight(serverCtx, n, scanFrom)
-		}()
+	// Get effective fee with source indication
+	fee, source, err := w.GetEffectiveFee(ctx, coinType)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
 	}
 
-	return nil, nil
+	return &types.GetWalletFeeResult{
+		Fee:    fee.ToCoin(),
+		Source: source,
+	}, nil
 }
 
-// importPubKey handles an importpubkey request by importing a hex-encoded
-// compressed 33-byte secp256k1 public key with sign byte, as well as its
-// derived P2PKH address.  This method may only be used by watching-only
-// wallets and with the special "imported" account.
-func (s *Server) importPubKey(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ImportPubKeyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// getWalletLockState handles a getwalletlockstate request by returning
+// whether the wallet is unlocked, and when and how an unlocked wallet will be
+// locked.
+func (s *Server) getWalletLockState(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	rescan := true
-	if cmd.Rescan != nil {
-		rescan = *cmd.Rescan
+	state := w.LockState()
+	stakingUnlocked := !w.StakingLocked()
+	if state.Locked {
+		return &types.GetWalletLockStateResult{
+			StakingUnlocked: stakingUnlocked,
+		}, nil
 	}
-	scanFrom := int32(0)
-	if cmd.ScanFrom != nil {
-		scanFrom = int32(*cmd.ScanFrom)
+	res := &types.GetWalletLockStateResult{
+		Unlocked:        true,
+		Mode:            "all",
+		StakingUnlocked: stakingUnlocked,
 	}
-	n, ok := s.walletLoader.NetworkBackend()
-	if rescan && !ok {
-		return nil, errNoNetwork
+	switch {
+	case state.StakingOnly:
+		res.Mode = "staking"
+	case state.SingleOperation:
+		res.Mode = "once"
 	}
+	if !state.Expires.IsZero() {
+		res.Expires = state.Expires.Unix()
+		res.SecondsLeft = int64(time.Until(state.Expires).Round(time.Second) / time.Second)
+	}
+	return res, nil
+}
 
-	if cmd.Label != nil && *cmd.Label != udb.ImportedAddrAccountName {
-		return nil, errNotImportedAccount
+// getVoteFeeConsolidationAddress handles the getvotefeeconsolidationaddress command.
+func (s *Server) getVoteFeeConsolidationAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetVoteFeeConsolidationAddressCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	pk, err := hex.DecodeString(cmd.PubKey)
+	// Call wallet method to get address
+	addr, err := w.GetVoteFeeConsolidationAddress(ctx, cmd.Account)
 	if err != nil {
-		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+		return nil, err
 	}
 
-	_, err = w.ImportPublicKey(ctx, pk)
-	if errors.Is(err, errors.Exist) {
-		// Do not return duplicate address errors, and skip any
-		// rescans.
-		return nil, nil
-	}
+	// Check if this is a custom address or the default
+	hasCustom, err := w.HasCustomConsolidationAddress(ctx, cmd.Account)
 	if err != nil {
 		return nil, err
 	}
 
-	if rescan {
-		// Rescan in the background rather than blocking the rpc request. Use
-		// the server waitgroup to ensure the rescan can return cleanly rather
-		// than being killed mid database transaction.
-		s.wg.Add(1)
-		go func() {
-			defer s.wg.Done()
-			serverCtx := s.httpServer.BaseContext(nil)
-			_ = w.RescanFromHeight(serverCtx, n, scanFrom)
-		}()
+	external, err := w.IsExternalConsolidationAddress(ctx, cmd.Account)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, nil
+	return types.GetVoteFeeConsolidationAddressResult{
+		Account:   cmd.Account,
+		Address:   addr.String(),
+		IsDefault: !hasCustom,
+		External:  external,
+	}, nil
 }
 
-// importScript imports a redeem script for a P2SH output.
-func (s *Server) importScript(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ImportScriptCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// getVSPTicketStatus returns the fee payment status of a ticket registered
+// with a VSP.  When a client for the ticket's VSP has been created, the status
+// reported by the VSP is included as well.
+func (s *Server) getVSPTicketStatus(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetVSPTicketStatusCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	rescan := true
-	if cmd.Rescan != nil {
-		rescan = *cmd.Rescan
+	hash, err := chainhash.NewHashFromStr(cmd.TicketHash)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
 	}
-	scanFrom := int32(0)
-	if cmd.ScanFrom != nil {
-		scanFrom = int32(*cmd.ScanFrom)
+	ticket, err := w.NewVSPTicket(ctx, hash)
+	if err != nil {
+		return nil, err
 	}
-	n, ok := s.walletLoader.NetworkBackend()
-	if rescan && !ok {
-		return nil, errNoNetwork
+	info, err := ticket.VSPTicketInfo(ctx)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"ticket %v is not registered with a VSP", hash)
 	}
-
-	rs, err := hex.DecodeString(cmd.Hex)
 	if err != nil {
-		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+		return nil, err
 	}
-	if len(rs) == 0 {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "empty script")
+
+	res := &types.GetVSPTicketStatusResult{
+		TicketHash:  hash.String(),
+		Host:        info.Host,
+		FeeTxStatus: udb.FeeStatus(info.FeeTxStatus).String(),
+	}
+	if info.FeeHash != (chainhash.Hash{}) {
+		res.FeeTxHash = info.FeeHash.String()
 	}
 
-	err = w.ImportScript(ctx, rs)
-	if errors.Is(err, errors.Exist) {
-		return nil, nil
+	vspClient, err := w.LookupVSP(info.Host)
+	if errors.Is(err, errors.NotExist) {
+		return res, nil
 	}
 	if err != nil {
 		return nil, err
 	}
-
-	if rescan {
-		// Rescan in the background rather than blocking the rpc request. Use
-		// the server waitgroup to ensure the rescan can return cleanly rather
-		// than being killed mid database transaction.
-		s.wg.Add(1)
-		go func() {
-			defer s.wg.Done()
-			serverCtx := s.httpServer.BaseContext(nil)
-			_ = w.RescanFromHeight(serverCtx, n, scanFrom)
-		}()
+	status, err := vspClient.TicketStatus(ctx, ticket)
+	if err != nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCMisc,
+			"unable to query VSP ticket status: %v", err)
 	}
-
-	return nil, nil
+	res.VSPFeeTxStatus = status.FeeTxStatus
+	res.TicketConfirmed = status.TicketConfirmed
+	return res, nil
 }
 
-func (s *Server) importXpub(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ImportXpubCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// setVoteFeeConsolidationAddress handles the setvotefeeconsolidationaddress command.
+func (s *Server) setVoteFeeConsolidationAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetVoteFeeConsolidationAddressCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	xpub, err := hdkeychain.NewKeyFromString(cmd.Xpub, w.ChainParams())
+	// Decode and validate the address
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
 	if err != nil {
 		return nil, err
 	}
 
-	return nil, w.ImportXpubAccount(ctx, cmd.Name, xpub)
-}
-
-// createNewAccount handles a createnewaccount request by creating and
-// returning a new account. If the last account has no transaction history
-// as per BIP 0044 a new account cannot be created so an error will be returned.
-func (s *Server) createNewAccount(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.CreateNewAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
-	}
+	external := cmd.External != nil && *cmd.External
 
-	// The wildcard * is reserved by the rpc server with the special meaning
-	// of "all accounts", so disallow naming accounts to this string.
-	if cmd.Account == "*" {
-		return nil, errReservedAccountName
+	// Call wallet method
+	err = w.SetVoteFeeConsolidationAddress(ctx, cmd.Account, addr, external)
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 	}
-
-	_, err := w.NextAccount(ctx, cmd.Account)
 	if err != nil {
-		if errors.Is(err, errors.Locked) {
-			return nil, rpcErrorf(dcrjson.ErrRPCWalletUnlockNeeded, "creating new accounts requires an unlocked wallet")
-		}
 		return nil, err
 	}
-	return nil, nil
+
+	return "Consolidation address set successfully", nil
 }
 
-// createAuthorizedEmission handles a createauthorizedemission request by creating a
-// cryptographically signed SKA emission transaction.
-func (s *Server) createAuthorizedEmission(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.CreateAuthorizedEmissionCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// clearVoteFeeConsolidationAddress handles the clearvotefeeconsolidationaddress command.
+func (s *Server) clearVoteFeeConsolidationAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ClearVoteFeeConsolidationAddressCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	// Get governance-defined parameters for this coin type
-	chainParams := w.ChainParams()
-
-	// Get SKA coin configuration from governance settings
-	skaConfig := chainParams.SKACoins[cointype.CoinType(cmd.CoinType)]
-	if skaConfig == nil {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-			"coin type %d is not configured in governance settings", cmd.CoinType)
-	}
-
-	if !skaConfig.Active {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-			"coin type %d is not active according to governance settings", cmd.CoinType)
+	// Call wallet method
+	err := w.ClearVoteFeeConsolidationAddress(ctx, cmd.Account)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get governance-defined emission addresses and amounts from chain configuration
-	emissionAddresses := skaConfig.EmissionAddresses
-	emissionAmounts := skaConfig.EmissionAmounts
+	return "Consolidation address cleared (using default)", nil
+}
 
-	// Validate governance configuration
-	if len(emissionAddresses) == 0 {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-			"no emission addresses configured for coin type %d - governance vote required", cmd.CoinType)
+// setVSP selects the VSP that purchased tickets are registered with, paying
+// VSP fees from the fee account.  Tickets whose fee payments failed are
+// retried with the VSP.  An empty host removes the selection.
+func (s *Server) setVSP(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetVSPCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	if len(emissionAddresses) != len(emissionAmounts) {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-			"emission addresses and amounts length mismatch for coin type %d", cmd.CoinType)
+	cfg := &udb.VSPConfig{
+		Host:   cmd.Host,
+		PubKey: cmd.PubKey,
 	}
-
-	// Calculate and validate total amount (using big.Int for SKA precision)
-	totalAmount := new(big.Int)
-	zero := new(big.Int)
-	for _, amount := range emissionAmounts {
-		if amount == nil || amount.Cmp(zero) <= 0 {
+	if cmd.Host != "" {
+		if cmd.PubKey == "" {
 			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-				"invalid emission amount %s for coin type %d", amount, cmd.CoinType)
+				"VSP pubkey must be provided")
 		}
-		totalAmount.Add(totalAmount, amount)
-	}
-
-	if totalAmount.Cmp(skaConfig.MaxSupply) != 0 {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-			"total emission amount %s does not match MaxSupply %s for coin type %d",
-			totalAmount.String(), skaConfig.MaxSupply.String(), cmd.CoinType)
+		account, err := w.AccountNumber(ctx, *cmd.FeeAccount)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		cfg.FeeAccount = account
 	}
 
-	// Unlock wallet for key operations
-	err := w.Unlock(ctx, []byte(cmd.Passphrase), nil)
-	if err != nil {
-		return nil, rpcErrorf(dcrjson.ErrRPCWalletPassphraseIncorrect,
-			"incorrect passphrase: %v", err)
+	_, err := w.SetVSP(ctx, cfg)
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 	}
-
-	// Get emission private key for this coin type from wallet
-	emissionPrivKey, err := getEmissionKeyForCoinType(w, ctx, cointype.CoinType(cmd.CoinType), cmd.EmissionKeyName)
 	if err != nil {
-		return nil, rpcErrorf(dcrjson.ErrRPCWallet,
-			"failed to get emission key: %v", err)
+		return nil, err
 	}
+	return nil, nil
+}
 
-	// Get current block height from wallet
-	_, currentHeight32 := w.MainChainTip(ctx)
-	currentHeight := int64(currentHeight32)
-
-	// Set nonce to 1 - only one emission is planned per coin type
-	// This can be enhanced later to retrieve the current nonce from blockchain
-	// via RPC if multiple emissions per coin type are needed
-	nonce := uint64(1)
+// These generators create the following global variables in this package:
+//
+//   var localeHelpDescs map[string]func() map[string]string
+//   var requestUsages string
+//
+// localeHelpDescs maps from locale strings (e.g. "en_US") to a function that
+// builds a map of help texts for each RPC server method.  This prevents help
+// text maps for every locale map from being rooted and created during init.
+// Instead, the appropriate function is looked up when help text is first needed
+// using the current locale and saved to the global below for further reuse.
+//
+// requestUsages contains single line usages for every supported request,
+// separated by newlines.  It is set during init.  These usages are used for all
+// locales.
+//
+//go:generate go run ../../rpchelp/genrpcserverhelp.go jsonrpc
+//go:generate gofmt -w rpcserverhelp.go
 
-	// Create authorization structure (without signature initially)
-	auth := &chaincfg.SKAEmissionAuth{
-		EmissionKey: emissionPrivKey.PubKey(),
-		Nonce:       nonce,
-		CoinType:    cointype.CoinType(cmd.CoinType),
-		Amount:      totalAmount,
-		Height:      currentHeight,
-		Timestamp:   time.Now().Unix(),
-	}
+var helpDescs map[string]string
+var helpDescsMu sync.Mutex // Help may execute concurrently, so synchronize access.
 
-	// Create the emission transaction first (unsigned)
-	// We need to build the transaction before signing so we can sign the transaction hash
-	tx, err := createUnsignedSKAEmissionTransaction(
-		auth, emissionAddresses, emissionAmounts, w.ChainParams())
-	if err != nil {
-		return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code,
-			"failed to create emission transaction: %v", err)
+// help handles the help request by returning one line usage of all available
+// methods, or full help for a specific method.  The chainClient is optional,
+// and this is simply a helper function for the HelpNoChainRPC and
+// HelpWithChainRPC handlers.
+func (s *Server) help(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.HelpCmd)
+	// TODO: The "help" RPC should use a HTTP POST client when calling down to
+	// dcrd for additional help methods.  This avoids including websocket-only
+	// requests in the help, which are not callable by wallet JSON-RPC clients.
+	var rpc *dcrd.RPC
+	n, _ := s.loader(ctx).NetworkBackend()
+	if chainSyncer, ok := n.(*chain.Syncer); ok {
+		rpc = chainSyncer.RPC()
 	}
-
-	// SECURITY FIX: Sign the transaction hash, not the addresses/amounts
-	// This prevents miner redirect attacks
-	authHash, err := createEmissionAuthHashFromTx(tx, auth, currentHeight, w.ChainParams())
-	if err != nil {
-		return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code,
-			"failed to create authorization hash: %v", err)
+	if cmd.Command == nil || *cmd.Command == "" {
+		// Prepend chain server usage if it is available.
+		usages := requestUsages
+		if rpc != nil {
+			var usage string
+			err := rpc.Call(ctx, "help", &usage)
+			if err != nil {
+				return nil, err
+			}
+			if usage != "" {
+				usages = "Chain server usage:\n\n" + usage + "\n\n" +
+					"Wallet server usage (overrides chain requests):\n\n" +
+					requestUsages
+			}
+		}
+		return usages, nil
 	}
 
-	// Sign the authorization hash that includes the transaction
-	signature := ecdsa.Sign(emissionPrivKey, authHash[:])
-	auth.Signature = signature.Serialize()
+	defer helpDescsMu.Unlock()
+	helpDescsMu.Lock()
 
-	// Now update the transaction with the signed authorization
-	authScript, err := createEmissionAuthScript(auth)
-	if err != nil {
-		return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code,
-			"failed to create authorization script: %v", err)
+	if helpDescs == nil {
+		// TODO: Allow other locales to be set via config or detemine
+		// this from environment variables.  For now, hardcode US
+		// English.
+		helpDescs = localeHelpDescs["en_US"]()
 	}
-	tx.TxIn[0].SignatureScript = authScript
 
-	// Serialize transaction to hex
-	var txBuf bytes.Buffer
-	if err := tx.Serialize(&txBuf); err != nil {
-		return nil, rpcErrorf(dcrjson.ErrRPCInternal.Code,
-			"failed to serialize transaction: %v", err)
+	helpText, ok := helpDescs[*cmd.Command]
+	if ok {
+		return helpText, nil
 	}
 
-	// Calculate transaction hash
-	txHash := tx.TxHash()
-
-	return &types.CreateAuthorizedEmissionResult{
-		Transaction:     hex.EncodeToString(txBuf.Bytes()),
-		TransactionHash: txHash.String(),
-		Nonce:           nonce,
-		TotalAmount:     totalAmount.String(),
-		CoinType:        cmd.CoinType,
-	}, nil
-}
-
-// renameAccount handles a renameaccount request by renaming an account.
-// If the account does not exist an appropriate error will be returned.
-func (s *Server) renameAccount(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.RenameAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+	// Return the chain server's detailed help if possible.
+	var chainHelp string
+	if rpc != nil {
+		err := rpc.Call(ctx, "help", &chainHelp, *cmd.Command)
+		if err != nil {
+			return nil, err
+		}
 	}
+	if chainHelp != "" {
+		return chainHelp, nil
+	}
+	return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "no help for method %q", *cmd.Command)
+}
 
-	// The wildcard * is reserved by the rpc server with the special meaning
-	// of "all accounts", so disallow naming accounts to this string.
-	if cmd.NewAccount == "*" {
-		return nil, errReservedAccountName
+// listAccounts handles a listaccounts request by returning a map of account
+// names to their balances.
+func (s *Server) listAccounts(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ListAccountsCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	// Check that given account exists
-	account, err := w.AccountNumber(ctx, cmd.OldAccount)
+	accountBalances := map[string]float64{}
+	results, err := w.AccountBalances(ctx, int32(*cmd.MinConf))
 	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAccountNotFound
-		}
 		return nil, err
 	}
-	err = w.RenameAccount(ctx, account, cmd.NewAccount)
-	return nil, err
+	for _, result := range results {
+		if !*cmd.IncludeArchived {
+			archived, err := w.AccountArchived(ctx, result.Account)
+			if err != nil {
+				return nil, err
+			}
+			if archived {
+				continue
+			}
+		}
+		accountName, err := w.AccountName(ctx, result.Account)
+		if err != nil {
+			// Expect name lookup to succeed
+			if errors.Is(err, errors.NotExist) {
+				return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
+			}
+			return nil, err
+		}
+		accountBalances[accountName] = result.Spendable.ToCoin()
+	}
+	// Return the map.  This will be marshaled into a JSON object.
+	return accountBalances, nil
 }
 
-// getMultisigOutInfo displays information about a given multisignature
-// output.
-func (s *Server) getMultisigOutInfo(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetMultisigOutInfoCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// listLockUnspent handles a listlockunspent request by returning an slice of
+// all locked outpoints.
+func (s *Server) listLockUnspent(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	hash, err := chainhash.NewHashFromStr(cmd.Hash)
-	if err != nil {
-		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	var account string
+	cmd := icmd.(*types.ListLockUnspentCmd)
+	if cmd.Account != nil {
+		account = *cmd.Account
 	}
+	return w.LockedOutpoints(ctx, account)
+}
 
-	// Multisig outs are always in TxTreeRegular.
-	op := &wire.OutPoint{
-		Hash:  *hash,
-		Index: cmd.Index,
-		Tree:  wire.TxTreeRegular,
+// listReceivedByAccount handles a listreceivedbyaccount request by returning
+// a slice of objects, each one containing:
+//
+//	"account": the receiving account;
+//	"amount": total amount received by the account;
+//	"confirmations": number of confirmations of the most recent transaction.
+//
+// It takes two parameters:
+//
+//	"minconf": minimum number of confirmations to consider a transaction -
+//	           default: one;
+//	"includeempty": whether or not to include addresses that have no transactions -
+//	                default: false.
+func (s *Server) listReceivedByAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ListReceivedByAccountCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	p2shOutput, err := w.FetchP2SHMultiSigOutput(ctx, op)
+	results, err := w.TotalReceivedForAccounts(ctx, int32(*cmd.MinConf))
 	if err != nil {
 		return nil, err
 	}
 
-	// Get the list of pubkeys required to sign.
-	_, pubkeyAddrs := stdscript.ExtractAddrs(scriptVersionAssumed, p2shOutput.RedeemScript, w.ChainParams())
-	pubkeys := make([]string, 0, len(pubkeyAddrs))
-	for _, pka := range pubkeyAddrs {
-		switch pka := pka.(type) {
-		case *stdaddr.AddressPubKeyEcdsaSecp256k1V0:
-			pubkeys = append(pubkeys, hex.EncodeToString(pka.SerializedPubKey()))
-		}
-	}
-
-	result := &types.GetMultisigOutInfoResult{
-		Address:      p2shOutput.P2SHAddress.String(),
-		RedeemScript: hex.EncodeToString(p2shOutput.RedeemScript),
-		M:            p2shOutput.M,
-		N:            p2shOutput.N,
-		Pubkeys:      pubkeys,
-		TxHash:       p2shOutput.OutPoint.Hash.String(),
-		Amount:       p2shOutput.OutputAmount.ToCoin(),
-	}
-	if !p2shOutput.ContainingBlock.None() {
-		result.BlockHeight = uint32(p2shOutput.ContainingBlock.Height)
-		result.BlockHash = p2shOutput.ContainingBlock.Hash.String()
-	}
-	if p2shOutput.Redeemer != nil {
-		result.Spent = true
-		result.SpentBy = p2shOutput.Redeemer.TxHash.String()
-		result.SpentByIndex = p2shOutput.Redeemer.InputIndex
+	jsonResults := make([]types.ListReceivedByAccountResult, 0, len(results))
+	for _, result := range results {
+		jsonResults = append(jsonResults, types.ListReceivedByAccountResult{
+			Account:       result.AccountName,
+			Amount:        result.TotalReceived.ToCoin(),
+			Confirmations: uint64(result.LastConfirmation),
+		})
 	}
-	return result, nil
+	return jsonResults, nil
 }
 
-// getNewAddress handles a getnewaddress request by returning a new
-// address for an account.  If the account does not exist an appropriate
-// error is returned.
-func (s *Server) getNewAddress(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetNewAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// listReceivedByAddress handles a listreceivedbyaddress request by returning
+// a slice of objects, each one containing:
+//
+//	"account": the account of the receiving address;
+//	"address": the receiving address;
+//	"amount": total amount received by the address;
+//	"confirmations": number of confirmations of the most recent transaction.
+//
+// It takes two parameters:
+//
+//	"minconf": minimum number of confirmations to consider a transaction -
+//	           default: one;
+//	"includeempty": whether or not to include addresses that have no transactions -
+//	                default: false.
+func (s *Server) listReceivedByAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ListReceivedByAddressCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	var callOpts []wallet.NextAddressCallOption
-	if cmd.GapPolicy != nil {
-		switch *cmd.GapPolicy {
-		case "":
-		case "error":
-			callOpts = append(callOpts, wallet.WithGapPolicyError())
-		case "ignore":
-			callOpts = append(callOpts, wallet.WithGapPolicyIgnore())
-		case "wrap":
-			callOpts = append(callOpts, wallet.WithGapPolicyWrap())
-		default:
-			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "unknown gap policy %q", *cmd.GapPolicy)
-		}
+	// Intermediate data for each address.
+	type AddrData struct {
+		// Total amount received.
+		amount dcrutil.Amount
+		// Number of confirmations of the last transaction.
+		confirmations int32
+		// Hashes of transactions which include an output paying to the address
+		tx []string
 	}
 
-	acctName := "default"
-	if cmd.Account != nil {
-		acctName = *cmd.Account
-	}
-	account, err := w.AccountNumber(ctx, acctName)
+	_, tipHeight := w.MainChainTip(ctx)
+
+	// Intermediate data for all addresses.
+	allAddrData := make(map[string]AddrData)
+	// Create an AddrData entry for each active address in the account.
+	// Otherwise we'll just get addresses from transactions later.
+	sortedAddrs, err := w.SortedActivePaymentAddresses(ctx)
 	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAccountNotFound
-		}
 		return nil, err
 	}
+	for _, address := range sortedAddrs {
+		// There might be duplicates, just overwrite them.
+		allAddrData[address] = AddrData{}
+	}
 
-	addr, err := w.NewExternalAddress(ctx, account, callOpts...)
+	minConf := *cmd.MinConf
+	var endHeight int32
+	if minConf == 0 {
+		endHeight = -1
+	} else {
+		endHeight = tipHeight - int32(minConf) + 1
+	}
+	err = wallet.UnstableAPI(w).RangeTransactions(ctx, 0, endHeight, func(details []udb.TxDetails) (bool, error) {
+		confirmations := confirms(details[0].Block.Height, tipHeight)
+		for _, tx := range details {
+			for _, cred := range tx.Credits {
+				pkVersion := tx.MsgTx.TxOut[cred.Index].Version
+				pkScript := tx.MsgTx.TxOut[cred.Index].PkScript
+				_, addrs := stdscript.ExtractAddrs(pkVersion, pkScript, w.ChainParams())
+				for _, addr := range addrs {
+					addrStr := addr.String()
+					addrData, ok := allAddrData[addrStr]
+					if ok {
+						addrData.amount += cred.Amount
+						// Always overwrite confirmations with newer ones.
+						addrData.confirmations = confirmations
+					} else {
+						addrData = AddrData{
+							amount:        cred.Amount,
+							confirmations: confirmations,
+						}
+					}
+					addrData.tx = append(addrData.tx, tx.Hash.String())
+					allAddrData[addrStr] = addrData
+				}
+			}
+		}
+		return false, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	return addr.String(), nil
+
+	// Massage address data into output format.
+	numAddresses := len(allAddrData)
+	ret := make([]types.ListReceivedByAddressResult, numAddresses)
+	idx := 0
+	for address, addrData := range allAddrData {
+		ret[idx] = types.ListReceivedByAddressResult{
+			Address:       address,
+			Amount:        addrData.amount.ToCoin(),
+			Confirmations: uint64(addrData.confirmations),
+			TxIDs:         addrData.tx,
+		}
+		idx++
+	}
+	return ret, nil
 }
 
-// getRawChangeAddress handles a getrawchangeaddress request by creating
-// and returning a new change address for an account.
-//
-// Note: bitcoind allows specifying the account as an optional parameter,
-// but ignores the parameter.
-func (s *Server) getRawChangeAddress(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetRawChangeAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// listSinceBlock handles a listsinceblock request by returning an array of maps
+// with details of sent and received wallet transactions since the given block.
+func (s *Server) listSinceBlock(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ListSinceBlockCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	acctName := "default"
-	if cmd.Account != nil {
-		acctName = *cmd.Account
+	targetConf := int32(*cmd.TargetConfirmations)
+	if targetConf < 1 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "target_confirmations must be positive")
 	}
-	account, err := w.AccountNumber(ctx, acctName)
-	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAccountNotFound
+
+	tipHash, tipHeight := w.MainChainTip(ctx)
+	lastBlock := &tipHash
+	if targetConf > 0 {
+		id := wallet.NewBlockIdentifierFromHeight((tipHeight + 1) - targetConf)
+		info, err := w.BlockInfo(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		lastBlock = &info.Hash
+	}
+
+	// TODO: This must begin at the fork point in the main chain, not the height
+	// of this block.
+	var end int32
+	if cmd.BlockHash != nil {
+		hash, err := chainhash.NewHashFromStr(*cmd.BlockHash)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
 		}
-		return nil, err
+		header, err := w.BlockHeader(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		end = int32(header.Height)
 	}
 
-	addr, err := w.NewChangeAddress(ctx, account)
+	txInfoList, err := w.ListSinceBlock(ctx, -1, end, tipHeight)
 	if err != nil {
 		return nil, err
 	}
 
-	// Return the new payment address string.
-	return addr.String(), nil
+	res := &types.ListSinceBlockResult{
+		Transactions: txInfoList,
+		LastBlock:    lastBlock.String(),
+	}
+	return res, nil
 }
 
-// getReceivedByAccount handles a getreceivedbyaccount request by returning
-// the total amount received by addresses of an account.
-func (s *Server) getReceivedByAccount(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetReceivedByAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// listTransactions handles a listtransactions request by returning an
+// array of maps with details of sent and recevied wallet transactions,
+// optionally filtered by coin type, transaction class, and block height.
+func (s *Server) listTransactions(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ListTransactionsCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	account, err := w.AccountNumber(ctx, cmd.Account)
+	// Serve the request from a single consistent view of the database.
+	ctx, release, err := w.Snapshot(ctx)
 	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAccountNotFound
-		}
 		return nil, err
 	}
+	defer release()
 
-	// Transactions are not tracked for imported xpub accounts.
-	if account > udb.ImportedAddrAccount {
-		return 0.0, nil
+	// TODO: ListTransactions does not currently understand the difference
+	// between transactions pertaining to one account from another.  This
+	// will be resolved when wtxmgr is combined with the waddrmgr namespace.
+
+	if cmd.Account != nil && *cmd.Account != "*" {
+		// For now, don't bother trying to continue if the user
+		// specified an account, since this can't be (easily or
+		// efficiently) calculated.
+		return nil,
+			errors.E(`Transactions can not be searched by account. ` +
+				`Use "*" to reference all accounts.`)
 	}
 
-	// TODO: This is more inefficient that it could be, but the entire
-	// algorithm is already dominated by reading every transaction in the
-	// wallet's history.
-	results, err := w.TotalReceivedForAccounts(ctx, int32(*cmd.MinConf))
+	if cmd.CoinType == nil && cmd.TxClass == nil && cmd.StartHeight == nil &&
+		cmd.EndHeight == nil {
+		return w.ListTransactions(ctx, *cmd.From, *cmd.Count)
+	}
+
+	filter, err := txListFilter(cmd.CoinType, cmd.TxClass, cmd.StartHeight,
+		cmd.EndHeight)
 	if err != nil {
 		return nil, err
 	}
-	acctIndex := int(account)
-	if account == udb.ImportedAddrAccount {
-		acctIndex = len(results) - 1
+	return w.ListFilteredTransactions(ctx, *cmd.From, *cmd.Count, filter)
+}
+
+// txListFilter returns the transaction filter described by the optional
+// filtering parameters of the listtransactions and gettransactionspage
+// requests.
+func txListFilter(coinType *uint8, txClass *string, startHeight,
+	endHeight *int32) (*wallet.TxListFilter, error) {
+
+	filter := &wallet.TxListFilter{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
 	}
-	return results[acctIndex].TotalReceived.ToCoin(), nil
+	if coinType != nil {
+		ct := cointype.CoinType(*coinType)
+		if err := validateCoinType(ct); err != nil {
+			return nil, err
+		}
+		filter.CoinType = &ct
+	}
+	if txClass != nil {
+		class, err := wallet.ParseTxClass(*txClass)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		filter.Class = &class
+	}
+	if (startHeight != nil && *startHeight < 0) ||
+		(endHeight != nil && *endHeight < 0) {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"heights must not be negative")
+	}
+	if startHeight != nil && endHeight != nil && *startHeight > *endHeight {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"start height %d is greater than end height %d",
+			*startHeight, *endHeight)
+	}
+	return filter, nil
 }
 
-// getReceivedByAddress handles a getreceivedbyaddress request by returning
-// the total amount received by a single address.
-func (s *Server) getReceivedByAddress(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetReceivedByAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// getTransactionsPage handles a gettransactionspage request by returning a
+// page of wallet transactions and the cursor of the following page.
+func (s *Server) getTransactionsPage(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetTransactionsPageCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if *cmd.Limit <= 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"limit must be positive")
+	}
+	filter, err := txListFilter(cmd.CoinType, cmd.TxClass, cmd.StartHeight,
+		cmd.EndHeight)
 	if err != nil {
 		return nil, err
 	}
-	// Use coin type filter, defaulting to VAR (0) if not specified
-	// Default to VAR if no coin type specified
-	filterCoinType := cointype.CoinTypeVAR
-	if cmd.CoinType != nil {
-		filterCoinType = cointype.CoinType(*cmd.CoinType)
-		// Validate coin type
-		if !filterCoinType.IsValid() {
-			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-				"invalid coin type %d: must be between 0 (VAR) and 255 (SKA)", *cmd.CoinType)
+	page, err := w.GetTransactionsPage(ctx, *cmd.Cursor, *cmd.Limit, filter)
+	if err != nil {
+		if errors.Is(err, errors.Encoding) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 		}
+		return nil, err
 	}
 
-	// Get the correct AtomsPerCoin for this coin type
-	atomsPerCoin := getAtomsPerCoin(w.ChainParams(), filterCoinType)
-
-	total, err := w.TotalReceivedForAddr(ctx, addr, int32(*cmd.MinConf), filterCoinType)
-	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAddressNotInWallet
+	res := &types.GetTransactionsPageResult{
+		Transactions: []types.TransactionsPageTx{},
+		NextCursor:   page.NextCursor,
+	}
+	for _, b := range page.Blocks {
+		var blockHash string
+		var height int32 = -1
+		if b.Header != nil {
+			blockHash = b.Header.BlockHash().String()
+			height = int32(b.Header.Height)
+		}
+		for i := range b.Transactions {
+			tx := &b.Transactions[i]
+			res.Transactions = append(res.Transactions, types.TransactionsPageTx{
+				TxID:        tx.Hash.String(),
+				BlockHash:   blockHash,
+				BlockHeight: height,
+				Time:        tx.Timestamp,
+				TxClass:     txClassName(tx.Type),
+				Hex:         hex.EncodeToString(tx.Transaction),
+			})
 		}
-		return nil, err
 	}
+	return res, nil
+}
 
-	return atomsToCoins(int64(total), atomsPerCoin), nil
+// txClassName returns the transaction class name of a transaction type, as
+// accepted by the txclass filter.
+func txClassName(t wallet.TransactionType) string {
+	switch t {
+	case wallet.TransactionTypeCoinbase:
+		return wallet.TxClassCoinbase.String()
+	case wallet.TransactionTypeTicketPurchase:
+		return wallet.TxClassTicket.String()
+	case wallet.TransactionTypeVote:
+		return wallet.TxClassVote.String()
+	case wallet.TransactionTypeRevocation:
+		return wallet.TxClassRevocation.String()
+	case wallet.TransactionTypeSSFee:
+		return wallet.TxClassSSFee.String()
+	default:
+		return wallet.TxClassRegular.String()
+	}
 }
 
-// getMasterPubkey handles a getmasterpubkey request by returning the wallet
-// master pubkey encoded as a string.
-func (s *Server) getMasterPubkey(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetMasterPubkeyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// listAddressTransactions handles a listaddresstransactions request by
+// returning an array of maps with details of spent and received wallet
+// transactions.  The form of the reply is identical to listtransactions,
+// but the array elements are limited to transaction details which are
+// about the addresess included in the request.
+func (s *Server) listAddressTransactions(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ListAddressTransactionsCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	// If no account is passed, we provide the extended public key
-	// for the default account number.
-	account := uint32(udb.DefaultAccountNum)
-	if cmd.Account != nil {
-		var err error
-		account, err = w.AccountNumber(ctx, *cmd.Account)
+	if cmd.Account != nil && *cmd.Account != "*" {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"listing transactions for addresses may only be done for all accounts")
+	}
+
+	// Decode addresses.
+	hash160Map := make(map[string]struct{})
+	for _, addrStr := range cmd.Addresses {
+		addr, err := decodeAddress(addrStr, w.ChainParams())
 		if err != nil {
-			if errors.Is(err, errors.NotExist) {
-				return nil, errAccountNotFound
-			}
 			return nil, err
 		}
+		hash160er, ok := addr.(stdaddr.Hash160er)
+		if !ok {
+			// Not tracked by the wallet so skip reporting history
+			// of this address.
+			continue
+		}
+		hash160Map[string(hash160er.Hash160()[:])] = struct{}{}
 	}
 
-	xpub, err := w.AccountXpub(ctx, account)
-	if err != nil {
-		return nil, err
+	return w.ListAddressTransactions(ctx, hash160Map)
+}
+
+// listAllTransactions handles a listalltransactions request by returning
+// a map with details of sent and recevied wallet transactions.  This is
+// similar to ListTransactions, except it takes only a single optional
+// argument for the account name and replies with all transactions.
+func (s *Server) listAllTransactions(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ListAllTransactionsCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	log.Warnf("Attention: Extended public keys must not be shared with or " +
-		"leaked to external parties, such as VSPs, in combination with " +
-		"any account private key; this reveals all private keys of " +
-		"this account")
+	if cmd.Account != nil && *cmd.Account != "*" {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"listing all transactions may only be done for all accounts")
+	}
 
-	return xpub.String(), nil
+	return w.ListAllTransactions(ctx)
 }
 
-// getPeerInfo responds to the getpeerinfo request.
-// It gets the network backend and views the data on remote peers when in spv mode
-func (s *Server) getPeerInfo(ctx context.Context, icmd any) (any, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+// listUnspent handles the listunspent command with optional coin type filtering.
+func (s *Server) listUnspent(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ListUnspentCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
-	n, err := w.NetworkBackend()
+
+	// Serve the request from a single consistent view of the database.
+	ctx, release, err := w.Snapshot(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
-	syncer, ok := n.(*spv.Syncer)
-	if !ok {
-		var resp []*types.GetPeerInfoResult
-		if chainSyncer, ok := n.(*chain.Syncer); ok {
-			err := chainSyncer.RPC().Call(ctx, "getpeerinfo", &resp)
-			if err != nil {
-				return nil, err
-			}
+	// Validate coin type if specified
+	if cmd.CoinType != nil {
+		coinType := cointype.CoinType(*cmd.CoinType)
+		if err := validateCoinType(coinType); err != nil {
+			return nil, err
 		}
-		return resp, nil
 	}
 
-	rps := syncer.GetRemotePeers()
-	infos := make([]*types.GetPeerInfoResult, 0, len(rps))
-
-	for _, rp := range rps {
-		info := &types.GetPeerInfoResult{
-			ID:             int32(rp.ID()),
-			Addr:           rp.RemoteAddr().String(),
-			AddrLocal:      rp.LocalAddr().String(),
-			Services:       fmt.Sprintf("%08d", uint64(rp.Services())),
-			Version:        rp.Pver(),
-			SubVer:         rp.UA(),
-			StartingHeight: int64(rp.InitialHeight()),
-			BanScore:       int32(rp.BanScore()),
+	var addresses map[string]struct{}
+	if cmd.Addresses != nil {
+		addresses = make(map[string]struct{})
+		// confirm that all of them are good:
+		for _, as := range *cmd.Addresses {
+			a, err := decodeAddress(as, w.ChainParams())
+			if err != nil {
+				return nil, err
+			}
+			addresses[a.String()] = struct{}{}
 		}
-		infos = append(infos, info)
 	}
-	sort.Slice(infos, func(i, j int) bool {
-		return infos[i].ID < infos[j].ID
-	})
-	return infos, nil
-}
 
-// getStakeInfo gets a large amounts of information about the stake environment
-// and a number of statistics about local staking in the wallet.
-func (s *Server) getStakeInfo(ctx context.Context, icmd any) (any, error) {
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+	var account string
+	if cmd.Account != nil {
+		account = *cmd.Account
 	}
 
-	n, _ := s.walletLoader.NetworkBackend()
-	var sinfo *wallet.StakeInfoData
-	var err error
-	if chainSyncer, ok := n.(*chain.Syncer); ok {
-		sinfo, err = w.StakeInfoPrecise(ctx, chainSyncer.RPC())
-	} else {
-		sinfo, err = w.StakeInfo(ctx)
-	}
+	result, err := w.ListUnspent(ctx, int32(*cmd.MinConf), int32(*cmd.MaxConf), addresses, account)
 	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAddressNotInWallet
+		}
 		return nil, err
 	}
 
-	var proportionLive, proportionMissed float64
-	if sinfo.PoolSize > 0 {
-		proportionLive = float64(sinfo.Live) / float64(sinfo.PoolSize)
-	}
-	if sinfo.Missed > 0 {
-		proportionMissed = float64(sinfo.Missed) / (float64(sinfo.Voted + sinfo.Missed))
-	}
-
-	resp := &types.GetStakeInfoResult{
-		BlockHeight:  sinfo.BlockHeight,
-		Difficulty:   sinfo.Sdiff.ToCoin(),
-		TotalSubsidy: sinfo.TotalSubsidy.ToCoin(),
+	// Filter by coin type if specified
+	if cmd.CoinType != nil {
+		requestedCoinType := *cmd.CoinType
+		filteredResult := make([]*types.ListUnspentResult, 0)
 
-		OwnMempoolTix:  sinfo.OwnMempoolTix,
-		Immature:       sinfo.Immature,
-		Unspent:        sinfo.Unspent,
-		Voted:          sinfo.Voted,
-		Revoked:        sinfo.Revoked,
-		UnspentExpired: sinfo.UnspentExpired,
+		for _, unspent := range result {
+			if unspent.CoinType == requestedCoinType {
+				filteredResult = append(filteredResult, unspent)
+			}
+		}
 
-		PoolSize:         sinfo.PoolSize,
-		AllMempoolTix:    sinfo.AllMempoolTix,
-		Live:             sinfo.Live,
-		ProportionLive:   proportionLive,
-		Missed:           sinfo.Missed,
-		ProportionMissed: proportionMissed,
-		Expired:          sinfo.Expired,
+		return filteredResult, nil
 	}
 
-	return resp, nil
+	return result, nil
 }
 
-// getTickets handles a gettickets request by returning the hashes of the tickets
-// currently owned by wallet, encoded as strings.
-func (s *Server) getTickets(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetTicketsCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// lockUnspent handles the lockunspent command.
+func (s *Server) lockUnspent(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.LockUnspentCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	n, _ := s.walletLoader.NetworkBackend()
-	rpc, _ := n.(wallet.LiveTicketQuerier) // nil rpc indicates SPV to LiveTicketHashes
-
-	ticketHashes, err := w.LiveTicketHashes(ctx, rpc, cmd.IncludeImmature)
-	if err != nil {
-		return nil, err
-	}
-
-	// Compose a slice of strings to return.
-	ticketHashStrs := make([]string, 0, len(ticketHashes))
-	for i := range ticketHashes {
-		ticketHashStrs = append(ticketHashStrs, ticketHashes[i].String())
+	switch {
+	case cmd.Unlock && len(cmd.Transactions) == 0:
+		w.ResetLockedOutpoints()
+	default:
+		for _, input := range cmd.Transactions {
+			txHash, err := chainhash.NewHashFromStr(input.Txid)
+			if err != nil {
+				return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+			}
+			if cmd.Unlock {
+				w.UnlockOutpoint(txHash, input.Vout)
+			} else {
+				w.LockOutpoint(txHash, input.Vout)
+			}
+		}
 	}
-
-	return &types.GetTicketsResult{Hashes: ticketHashStrs}, nil
+	return true, nil
 }
 
-// getTransaction handles a gettransaction request by returning details about
-// a single transaction saved by wallet.
-func (s *Server) getTransaction(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetTransactionCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// purchaseTicket indicates to the wallet that a ticket should be purchased
+// using all currently available funds. If the ticket could not be purchased
+// because there are not enough eligible funds, an error will be returned.
+func (s *Server) purchaseTicket(ctx context.Context, icmd any) (any, error) {
+	// Enforce valid and positive spend limit.
+	cmd := icmd.(*types.PurchaseTicketCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	txHash, err := chainhash.NewHashFromStr(cmd.Txid)
+	n, err := w.NetworkBackend()
 	if err != nil {
-		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
-	}
-
-	// returns nil details when not found
-	txd, err := wallet.UnstableAPI(w).TxDetails(ctx, txHash)
-	if errors.Is(err, errors.NotExist) {
-		return nil, rpcErrorf(dcrjson.ErrRPCNoTxInfo, "no information for transaction")
-	} else if err != nil {
 		return nil, err
 	}
 
-	_, tipHeight := w.MainChainTip(ctx)
+	spendLimit, err := dcrutil.NewAmount(cmd.SpendLimit)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if spendLimit < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative spend limit")
+	}
 
-	var b strings.Builder
-	b.Grow(2 * txd.MsgTx.SerializeSize())
-	err = txd.MsgTx.Serialize(hex.NewEncoder(&b))
+	account, err := w.AccountNumber(ctx, cmd.FromAccount)
 	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
 		return nil, err
 	}
 
-	// TODO: Add a "generated" field to this result type.  "generated":true
-	// is only added if the transaction is a coinbase.
-	ret := types.GetTransactionResult{
-		TxID:            cmd.Txid,
-		Hex:             b.String(),
-		Time:            txd.Received.Unix(),
-		TimeReceived:    txd.Received.Unix(),
-		WalletConflicts: []string{}, // Not saved
-		//Generated:     compat.IsEitherCoinBaseTx(&details.MsgTx),
+	// Override the minimum number of required confirmations if specified
+	// and enforce it is positive.
+	minConf := int32(1)
+	if cmd.MinConf != nil {
+		minConf = int32(*cmd.MinConf)
+		if minConf < 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
+		}
 	}
 
-	if txd.Block.Height != -1 {
-		ret.BlockHash = txd.Block.Hash.String()
-		ret.BlockTime = txd.Block.Time.Unix()
-		ret.Confirmations = int64(confirms(txd.Block.Height,
-			tipHeight))
+	numTickets := 1
+	if cmd.NumTickets != nil {
+		if *cmd.NumTickets > 1 {
+			numTickets = *cmd.NumTickets
+		}
 	}
 
-	// Determine coin type from transaction outputs
-	var txCoinType cointype.CoinType
-	for _, output := range txd.MsgTx.TxOut {
-		if output.CoinType.IsSKA() {
-			txCoinType = output.CoinType
-			break
-		}
+	// Set the expiry if specified.
+	expiry := int32(0)
+	if cmd.Expiry != nil {
+		expiry = int32(*cmd.Expiry)
 	}
-	isSKA := txCoinType.IsSKA()
-	atomsPerCoin := getAtomsPerCoin(w.ChainParams(), txCoinType)
 
-	var (
-		debitTotal     dcrutil.Amount
-		creditTotal    dcrutil.Amount
-		skaDebitTotal  cointype.SKAAmount
-		skaCreditTotal cointype.SKAAmount
-		fee            dcrutil.Amount
-		negFeeF64      float64
-	)
+	dontSignTx := false
+	if cmd.DontSignTx != nil {
+		dontSignTx = *cmd.DontSignTx
+	}
 
-	// For SKA transactions, use a direct calculation approach:
-	// - Credits = outputs that belong to the wallet (in txd.Credits)
-	// - Debits = either from database or calculated from inputs
-	// - Net = Credits - Debits (negative for sends, positive for receives)
-	if isSKA {
-		// Calculate credits directly from outputs that are in txd.Credits
-		for _, cred := range txd.Credits {
-			if int(cred.Index) < len(txd.MsgTx.TxOut) {
-				output := txd.MsgTx.TxOut[cred.Index]
-				if output.SKAValue != nil && output.SKAValue.Sign() > 0 {
-					skaCreditTotal = skaCreditTotal.Add(cointype.NewSKAAmount(output.SKAValue))
-				}
-			}
+	var mixedAccount uint32
+	var mixedAccountBranch uint32
+	var mixedSplitAccount uint32
+	// Use purchasing account as change account by default (overridden below if
+	// mixing is enabled).
+	var changeAccount = account
+
+	if s.cfg.MixingEnabled {
+		mixedAccount, err = w.AccountNumber(ctx, s.cfg.MixAccount)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"Mixing enabled, but error on mixed account: %v", err)
+		}
+		mixedAccountBranch = s.cfg.MixBranch
+		if mixedAccountBranch != 0 && mixedAccountBranch != 1 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"MixedAccountBranch should be 0 or 1.")
 		}
+		mixedSplitAccount, err = w.AccountNumber(ctx, s.cfg.TicketSplitAccount)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"Mixing enabled, but error on mixedSplitAccount: %v", err)
+		}
+		changeAccount, err = w.AccountNumber(ctx, s.cfg.MixChangeAccount)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"Mixing enabled, but error on changeAccount: %v", err)
+		}
+	}
 
-		// Calculate debits: sum of all inputs from wallet (previous outputs being spent)
-		// First try from database, then look up previous transactions
-		for _, deb := range txd.Debits {
-			if !deb.SKAAmount.IsZero() {
-				skaDebitTotal = skaDebitTotal.Add(deb.SKAAmount)
-			} else if int(deb.Index) < len(txd.MsgTx.TxIn) {
-				// Look up the previous transaction's output to get the actual SKA amount
-				prevOut := &txd.MsgTx.TxIn[deb.Index].PreviousOutPoint
-				prevHash := prevOut.Hash
-				prevTxs, _, err := w.GetTransactionsByHashes(ctx, []*chainhash.Hash{&prevHash})
-				if err == nil && len(prevTxs) > 0 && prevTxs[0] != nil {
-					if int(prevOut.Index) < len(prevTxs[0].TxOut) {
-						out := prevTxs[0].TxOut[prevOut.Index]
-						if out.SKAValue != nil && out.SKAValue.Sign() > 0 {
-							skaDebitTotal = skaDebitTotal.Add(cointype.NewSKAAmount(out.SKAValue))
-						}
-					}
-				}
-			}
+	// Prefer the VSP selected with setvsp, which pays fees from its own fee
+	// account, over the VSP set in the options.
+	vspClient, _, err := w.SelectedVSP(ctx)
+	if err != nil && !errors.Is(err, errors.NotExist) {
+		return nil, err
+	}
+	if vspClient == nil && s.cfg.VSPHost != "" {
+		cfg := wallet.VSPClientConfig{
+			URL:    s.cfg.VSPHost,
+			PubKey: s.cfg.VSPPubKey,
+			Policy: &wallet.VSPPolicy{
+				MaxFee:     w.VSPMaxFee(),
+				FeeAcct:    account,
+				ChangeAcct: changeAccount,
+			},
+		}
+		vspClient, err = w.VSP(cfg)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCMisc,
+				"VSP Server instance failed to start: %v", err)
 		}
+	}
 
-		// For SKA, use output-based calculation which is more reliable
-		// especially for unmined transactions where credits may not be populated yet.
-		// For a send: Amount = -(outputs NOT to our wallet)
-		// For a receive: Amount = (outputs TO our wallet that are credits)
+	request := &wallet.PurchaseTicketsRequest{
+		Count:         numTickets,
+		SourceAccount: account,
+		MinConf:       minConf,
+		Expiry:        expiry,
+		DontSignTx:    dontSignTx,
 
-		// Calculate fee if all inputs are debits and we have debit values
-		var skaFee cointype.SKAAmount
-		if len(txd.Debits) == len(txd.MsgTx.TxIn) && !skaDebitTotal.IsZero() {
-			var skaOutputTotal cointype.SKAAmount
-			for _, output := range txd.MsgTx.TxOut {
-				if output.SKAValue != nil {
-					skaOutputTotal = skaOutputTotal.Add(cointype.NewSKAAmount(output.SKAValue))
-				}
-			}
-			skaFee = skaDebitTotal.Sub(skaOutputTotal)
-			ret.Fee = skaFee.Neg().ToDecimalString(atomsPerCoin)
-		}
+		// CSPP
+		Mixing:             s.cfg.MixingEnabled,
+		MixedAccount:       mixedAccount,
+		MixedAccountBranch: mixedAccountBranch,
+		MixedSplitAccount:  mixedSplitAccount,
+		ChangeAccount:      changeAccount,
 
-		// Amount = credits - debits (same formula as VAR)
-		// For mined transactions: credits are populated, so this gives correct net change
-		// For unmined transactions: credits = 0, so this gives -debits (total spent)
-		skaNet := skaCreditTotal.Sub(skaDebitTotal)
-		ret.Amount = skaNet.ToDecimalString(atomsPerCoin)
+		VSPClient: vspClient,
+	}
+	// Use the mixed account as voting account if mixing is enabled,
+	// otherwise use the source account.
+	if s.cfg.MixingEnabled {
+		request.VotingAccount = mixedAccount
 	} else {
-		// VAR transaction handling (unchanged)
-		for _, deb := range txd.Debits {
-			debitTotal += deb.Amount
-		}
-		for _, cred := range txd.Credits {
-			creditTotal += cred.Amount
-		}
-		// Fee can only be determined if every input is a debit.
-		if len(txd.Debits) == len(txd.MsgTx.TxIn) {
-			var outputTotal dcrutil.Amount
-			for _, output := range txd.MsgTx.TxOut {
-				outputTotal += dcrutil.Amount(output.Value)
-			}
-			fee = debitTotal - outputTotal
-			negFeeF64 = (-fee).ToCoin()
-		}
-		ret.Amount = (creditTotal - debitTotal).ToCoin()
-		ret.Fee = negFeeF64
+		request.VotingAccount = account
 	}
 
-	details, err := w.ListTransactionDetails(ctx, txHash)
+	ticketsResponse, err := w.PurchaseTickets(ctx, n, request)
 	if err != nil {
 		return nil, err
 	}
-	ret.Details = make([]types.GetTransactionDetailsResult, len(details))
-	for i, d := range details {
-		ret.Details[i] = types.GetTransactionDetailsResult{
-			Account:           d.Account,
-			Address:           d.Address,
-			Amount:            d.Amount,
-			Category:          d.Category,
-			InvolvesWatchOnly: d.InvolvesWatchOnly,
-			Fee:               d.Fee,
-			Vout:              d.Vout,
+	ticketsTx := ticketsResponse.Tickets
+	splitTx := ticketsResponse.SplitTx
+
+	// If dontSignTx is false, we return the TicketHashes of the published txs.
+	if !dontSignTx {
+		hashes := ticketsResponse.TicketHashes
+		hashStrs := make([]string, len(hashes))
+		for i := range hashes {
+			hashStrs[i] = hashes[i].String()
 		}
-	}
 
-	return ret, nil
-}
+		return hashStrs, err
+	}
 
-// getTxOut handles a gettxout request by returning details about an unspent
-// output. In SPV mode, details are only returned for transaction outputs that
-// are relevant to the wallet.
-// To match the behavior in RPC mode, (nil, nil) is returned if the transaction
-// output could not be found (never existed or was pruned) or is spent by another
-// transaction already in the main chain.  Mined transactions that are spent by
-// a mempool transaction are not affected by this.
-func (s *Server) getTxOut(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetTxOutCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+	// Otherwise we return its unsigned tickets bytes and the splittx, so a
+	// cold wallet can handle it.
+	var stringBuilder strings.Builder
+	unsignedTickets := make([]string, len(ticketsTx))
+	for i, mtx := range ticketsTx {
+		err = mtx.Serialize(hex.NewEncoder(&stringBuilder))
+		if err != nil {
+			return nil, err
+		}
+		unsignedTickets[i] = stringBuilder.String()
+		stringBuilder.Reset()
 	}
 
-	// Attempt RPC passthrough if connected to DCRD.
-	n, err := w.NetworkBackend()
+	err = splitTx.Serialize(hex.NewEncoder(&stringBuilder))
 	if err != nil {
-		return nil, err
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 	}
-	if chainSyncer, ok := n.(*chain.Syncer); ok {
-		var resp json.RawMessage
-		err := chainSyncer.RPC().Call(ctx, "gettxout", &resp, cmd.Txid, cmd.Vout, cmd.Tree, cmd.IncludeMempool)
-		return resp, err
+
+	splitTxString := stringBuilder.String()
+
+	return types.CreateUnsignedTicketResult{
+		UnsignedTickets: unsignedTickets,
+		SplitTx:         splitTxString,
+	}, nil
+}
+
+// processUnmanagedTicket takes a ticket hash as an argument and attempts to
+// start managing it for the vsp client selected with setvsp, or else the set
+// vsp client from the config.
+func (s *Server) processUnmanagedTicket(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ProcessUnmanagedTicketCmd)
+
+	if cmd.TicketHash == "" {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "ticket hash must be provided")
 	}
 
-	txHash, err := chainhash.NewHashFromStr(cmd.Txid)
+	hash, err := chainhash.NewHashFromStr(cmd.TicketHash)
 	if err != nil {
-		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 	}
 
-	if cmd.Tree != wire.TxTreeRegular && cmd.Tree != wire.TxTreeStake {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "Tx tree must be regular or stake")
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	// Attempt to read the unspent txout info from wallet.
-	outpoint := wire.OutPoint{Hash: *txHash, Index: cmd.Vout, Tree: cmd.Tree}
-	utxo, err := w.UnspentOutput(ctx, outpoint, *cmd.IncludeMempool)
-	if err != nil && !errors.Is(err, errors.NotExist) {
-		return nil, err
+	vspClient, _, err := w.SelectedVSP(ctx)
+	if errors.Is(err, errors.NotExist) {
+		vspHost := s.cfg.VSPHost
+		if vspHost == "" {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"no VSP selected with setvsp and vsphost not set in options")
+		}
+		vspClient, err = w.LookupVSP(vspHost)
 	}
-	if utxo == nil {
-		return nil, nil // output is spent or does not exist.
+	if err != nil {
+		return nil, err
 	}
 
-	// Disassemble script into single line printable format.  The
-	// disassembled string will contain [error] inline if the script
-	// doesn't fully parse, so ignore the error here.
-	disbuf, _ := txscript.DisasmString(utxo.PkScript)
-
-	// Get further info about the script.  Ignore the error here since an
-	// error means the script couldn't parse and there is no additional
-	// information about it anyways.
-	scriptClass, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, utxo.PkScript, s.activeNet)
-	reqSigs := stdscript.DetermineRequiredSigs(scriptVersionAssumed, utxo.PkScript)
-	addresses := make([]string, len(addrs))
-	for i, addr := range addrs {
-		addresses[i] = addr.String()
+	ticket, err := w.NewVSPTicket(ctx, hash)
+	if err != nil {
+		return nil, err
 	}
 
-	bestHash, bestHeight := w.MainChainTip(ctx)
-	var confirmations int64
-	if utxo.Block.Height != -1 {
-		confirmations = int64(confirms(utxo.Block.Height, bestHeight))
+	err = vspClient.Process(ctx, ticket, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return &dcrdtypes.GetTxOutResult{
-		BestBlock:     bestHash.String(),
-		Confirmations: confirmations,
-		Value:         utxo.Amount.ToCoin(),
-		ScriptPubKey: dcrdtypes.ScriptPubKeyResult{
-			Asm:       disbuf,
-			Hex:       hex.EncodeToString(utxo.PkScript),
-			ReqSigs:   int32(reqSigs),
-			Type:      scriptClass.String(),
-			Addresses: addresses,
-		},
-		Coinbase: utxo.FromCoinBase,
-	}, nil
+	return nil, nil
+
 }
 
-// getVoteChoices handles a getvotechoices request by returning configured vote
-// preferences for each agenda of the latest supported stake version.
-func (s *Server) getVoteChoices(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetVoteChoicesCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+// makeOutputs creates a slice of transaction outputs from a pair of address
+// strings to amounts.  This is used to create the outputs to include in newly
+// created transactions from a JSON object describing the output destinations
+// and amounts.
+func makeOutputs(pairs map[string]dcrutil.Amount, chainParams *chaincfg.Params) ([]*wire.TxOut, error) {
+	outputs := make([]*wire.TxOut, 0, len(pairs))
+	for addrStr, amt := range pairs {
+		if amt < 0 {
+			return nil, errNeedPositiveAmount
+		}
+		addr, err := decodeAddress(addrStr, chainParams)
+		if err != nil {
+			return nil, err
+		}
+
+		vers, pkScript := addr.PaymentScript()
+
+		outputs = append(outputs, &wire.TxOut{
+			Value:    int64(amt),
+			PkScript: pkScript,
+			Version:  vers,
+		})
 	}
+	return outputs, nil
+}
 
-	var ticketHash *chainhash.Hash
-	if cmd.TicketHash != nil {
-		hash, err := chainhash.NewHashFromStr(*cmd.TicketHash)
+// makeOutputsWithCoinType creates transaction outputs with specified coin type for dual-coin support.
+func makeOutputsWithCoinType(pairs map[string]dcrutil.Amount, chainParams *chaincfg.Params, coinType cointype.CoinType) ([]*wire.TxOut, error) {
+	outputs := make([]*wire.TxOut, 0, len(pairs))
+	for addrStr, amt := range pairs {
+		if amt < 0 {
+			return nil, errNeedPositiveAmount
+		}
+		addr, err := decodeAddress(addrStr, chainParams)
 		if err != nil {
-			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+			return nil, err
 		}
-		ticketHash = hash
-	}
 
-	version, agendas := wallet.CurrentAgendas(w.ChainParams())
-	resp := &types.GetVoteChoicesResult{
-		Version: version,
-		Choices: make([]types.VoteChoice, 0, len(agendas)),
+		vers, pkScript := addr.PaymentScript()
+
+		txOut := &wire.TxOut{
+			PkScript: pkScript,
+			Version:  vers,
+			CoinType: coinType, // Dual-coin support: specify coin type
+		}
+
+		// For SKA, use SKAValue (big.Int); for VAR, use Value (int64)
+		if coinType.IsSKA() {
+			txOut.Value = 0 // SKA uses SKAValue, not Value
+			txOut.SKAValue = big.NewInt(int64(amt))
+		} else {
+			txOut.Value = int64(amt)
+		}
+
+		outputs = append(outputs, txOut)
 	}
+	return outputs, nil
+}
 
-	choices, _, err := w.AgendaChoices(ctx, ticketHash)
-	if err != nil {
-		return nil, err
+// makeOutputsWithCoinTypeBig creates transaction outputs with specified coin type using big.Int amounts.
+// This is essential for SKA transactions where amounts can exceed int64.
+func makeOutputsWithCoinTypeBig(pairs map[string]*big.Int, chainParams *chaincfg.Params, coinType cointype.CoinType) ([]*wire.TxOut, error) {
+	outputs := make([]*wire.TxOut, 0, len(pairs))
+	for addrStr, amt := range pairs {
+		if amt == nil || amt.Sign() < 0 {
+			return nil, errNeedPositiveAmount
+		}
+		addr, err := decodeAddress(addrStr, chainParams)
+		if err != nil {
+			return nil, err
+		}
+
+		vers, pkScript := addr.PaymentScript()
+
+		txOut := &wire.TxOut{
+			PkScript: pkScript,
+			Version:  vers,
+			CoinType: coinType,
+		}
+
+		// For SKA, use SKAValue (big.Int); for VAR, use Value (int64)
+		if coinType.IsSKA() {
+			txOut.Value = 0 // SKA uses SKAValue, not Value
+			txOut.SKAValue = new(big.Int).Set(amt)
+		} else {
+			txOut.Value = amt.Int64()
+		}
+
+		outputs = append(outputs, txOut)
 	}
+	return outputs, nil
+}
 
-	for _, agenda := range agendas {
-		agendaID := agenda.Vote.Id
-		voteChoice := types.VoteChoice{
-			AgendaID:          agendaID,
-			AgendaDescription: agenda.Vote.Description,
-			ChoiceID:          choices[agendaID],
-			ChoiceDescription: "", // Set below
+// subtractFeeOutputs returns the indexes of the outputs paying to each of the
+// addresses in subtractFeeFrom.  Every address must be paid by an output.
+func subtractFeeOutputs(outputs []*wire.TxOut, subtractFeeFrom []string, chainParams *chaincfg.Params) ([]int, error) {
+	idxs := make([]int, 0, len(subtractFeeFrom))
+	for _, addrStr := range subtractFeeFrom {
+		addr, err := decodeAddress(addrStr, chainParams)
+		if err != nil {
+			return nil, err
 		}
-
-		for _, choice := range agenda.Vote.Choices {
-			if choices[agendaID] == choice.Id {
-				voteChoice.ChoiceDescription = choice.Description
+		_, pkScript := addr.PaymentScript()
+		idx := -1
+		for i, out := range outputs {
+			if bytes.Equal(out.PkScript, pkScript) {
+				idx = i
 				break
 			}
 		}
-
-		resp.Choices = append(resp.Choices, voteChoice)
+		if idx == -1 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"subtractfeefrom address %s is not paid by the transaction", addrStr)
+		}
+		idxs = append(idxs, idx)
 	}
+	return idxs, nil
+}
 
-	return resp, nil
+// sendOptions holds the optional parameters shared by the send methods.
+type sendOptions struct {
+	subtractFeeFrom []string
+	lockTimes       wallet.TxLockTimes
+	label           string
+	feePreference   string
+	feeRate         dcrutil.Amount
+	feeRateCoins    any // feeRate in coins of the sent coin type
+	nullData        *wire.TxOut
 }
 
-// getWalletFee returns the currently set tx fee for the requested wallet
-// with source indication (manual, rpc, or static).
-func (s *Server) getWalletFee(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetWalletFeeCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
-	}
+// makeSendOptions returns the send options for the optional comment and lock
+// time parameters of a send method.  The comment is recorded as the label of
+// the transaction.
+func makeSendOptions(subtractFeeFrom []string, comment *string, expiry, expireAfter,
+	lockTime *uint32) *sendOptions {
 
-	// Default to VAR (coin type 0) if not specified
-	coinType := cointype.CoinType(0)
-	if cmd.CoinType != nil {
-		coinType = cointype.CoinType(*cmd.CoinType)
+	opts := &sendOptions{subtractFeeFrom: subtractFeeFrom}
+	if comment != nil {
+		opts.label = *comment
 	}
-
-	// Get effective fee with source indication
-	fee, source, err := w.GetEffectiveFee(ctx, coinType)
-	if err != nil {
-		return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
+	if expiry != nil {
+		opts.lockTimes.Expiry = *expiry
+	}
+	if expireAfter != nil {
+		opts.lockTimes.ExpireAfter = *expireAfter
+	}
+	if lockTime != nil {
+		opts.lockTimes.LockTime = *lockTime
 	}
+	return opts
+}
 
-	return &types.GetWalletFeeResult{
-		Fee:    fee.ToCoin(),
-		Source: source,
-	}, nil
+// feeRateForPreference returns the fee rate estimated by the network backend
+// for coinType at the slow, normal or fast fee preference pref.
+func feeRateForPreference(ctx context.Context, w *wallet.Wallet, coinType cointype.CoinType,
+	pref string) (dcrutil.Amount, error) {
+
+	rate, err := w.FeeRateForPreference(ctx, coinType, pref)
+	if errors.Is(err, errors.Invalid) {
+		return 0, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return rate, err
 }
 
-// getVoteFeeConsolidationAddress handles the getvotefeeconsolidationaddress command.
-func (s *Server) getVoteFeeConsolidationAddress(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetVoteFeeConsolidationAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+// setFeePreference sets the fee rate of a send of coinType to the rate of the
+// fee preference pref, if one is specified.
+func (opts *sendOptions) setFeePreference(ctx context.Context, w *wallet.Wallet,
+	coinType cointype.CoinType, pref *string) error {
+
+	if pref == nil {
+		return nil
+	}
+	rate, err := feeRateForPreference(ctx, w, coinType, *pref)
+	if err != nil {
+		return err
 	}
+	opts.feePreference = *pref
+	opts.feeRate = rate
+	opts.feeRateCoins = coinAmount(w.ChainParams(), coinType, big.NewInt(int64(rate)))
+	return nil
+}
 
-	// Call wallet method to get address
-	addr, err := w.GetVoteFeeConsolidationAddress(ctx, cmd.Account)
+// result returns the result of a send method which published the transaction
+// txid.  Sends at a fee preference echo the preference and its fee rate, while
+// other sends return only the transaction hash.
+func (opts *sendOptions) result(txid string, err error) (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	if opts.feePreference == "" {
+		return txid, nil
+	}
+	return &types.FeePreferenceSendResult{
+		TxID:          txid,
+		FeePreference: opts.feePreference,
+		FeeRate:       opts.feeRateCoins,
+	}, nil
+}
 
-	// Check if this is a custom address or the default
-	hasCustom, err := w.HasCustomConsolidationAddress(ctx, cmd.Account)
+// sendOutputs sends outputs from account, subtracting the fee from the outputs
+// paying the subtractFeeFrom addresses if any are specified.
+func sendOutputs(ctx context.Context, w *wallet.Wallet, outputs []*wire.TxOut,
+	opts *sendOptions, account, changeAccount uint32, minconf int32) (string, error) {
+
+	walletOpts := &wallet.SendOptions{
+		LockTimes: opts.lockTimes,
+		Label:     opts.label,
+		FeeRate:   opts.feeRate,
+	}
+	if len(opts.subtractFeeFrom) != 0 {
+		var err error
+		walletOpts.SubtractFeeFrom, err = subtractFeeOutputs(outputs,
+			opts.subtractFeeFrom, w.ChainParams())
+		if err != nil {
+			return "", err
+		}
+	}
+	if opts.nullData != nil {
+		outputs = append(outputs, opts.nullData)
+	}
+	txSha, err := w.SendOutputsWithOptions(ctx, outputs, account, changeAccount,
+		minconf, walletOpts)
 	if err != nil {
-		return nil, err
+		if errors.Is(err, errors.Locked) {
+			return "", errWalletUnlockNeeded
+		}
+		if errors.Is(err, errors.InsufficientBalance) {
+			return "", rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+		}
+		return "", err
 	}
 
-	return types.GetVoteFeeConsolidationAddressResult{
-		Account:   cmd.Account,
-		Address:   addr.String(),
-		IsDefault: !hasCustom,
-	}, nil
+	return txSha.String(), nil
 }
 
-// setVoteFeeConsolidationAddress handles the setvotefeeconsolidationaddress command.
-func (s *Server) setVoteFeeConsolidationAddress(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.SetVoteFeeConsolidationAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+// sendPairs creates and sends payment transactions.
+// It returns the transaction hash in string format upon success
+// All errors are returned in dcrjson.RPCError format
+func (s *Server) sendPairs(ctx context.Context, w *wallet.Wallet, amounts map[string]dcrutil.Amount, account uint32, minconf int32) (string, error) {
+	changeAccount := account
+	if s.cfg.MixingEnabled && s.cfg.MixAccount != "" && s.cfg.MixChangeAccount != "" {
+		mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
+		if err != nil {
+			return "", err
+		}
+		if account == mixAccount {
+			changeAccount, err = w.AccountNumber(ctx, s.cfg.MixChangeAccount)
+			if err != nil {
+				return "", err
+			}
+		}
 	}
 
-	// Decode and validate the address
-	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	outputs, err := makeOutputs(amounts, w.ChainParams())
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-
-	// Call wallet method
-	err = w.SetVoteFeeConsolidationAddress(ctx, cmd.Account, addr)
+	txSha, err := w.SendOutputs(ctx, outputs, account, changeAccount, minconf)
 	if err != nil {
-		return nil, err
+		if errors.Is(err, errors.Locked) {
+			return "", errWalletUnlockNeeded
+		}
+		if errors.Is(err, errors.InsufficientBalance) {
+			return "", rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+		}
+		return "", err
 	}
 
-	return "Consolidation address set successfully", nil
+	return txSha.String(), nil
 }
 
-// clearVoteFeeConsolidationAddress handles the clearvotefeeconsolidationaddress command.
-func (s *Server) clearVoteFeeConsolidationAddress(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ClearVoteFeeConsolidationAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+// sendPairsWithCoinType creates and sends payment transactions with coin type support.
+// It extends sendPairs to handle dual-coin transactions (VAR and SKA).
+func (s *Server) sendPairsWithCoinType(ctx context.Context, w *wallet.Wallet, amounts map[string]dcrutil.Amount, account uint32, minconf int32, coinType cointype.CoinType, opts *sendOptions) (string, error) {
+	changeAccount := account
+	if s.cfg.MixingEnabled && s.cfg.MixAccount != "" && s.cfg.MixChangeAccount != "" {
+		mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
+		if err != nil {
+			return "", err
+		}
+		if account == mixAccount {
+			changeAccount, err = w.AccountNumber(ctx, s.cfg.MixChangeAccount)
+			if err != nil {
+				return "", err
+			}
+		}
 	}
 
-	// Call wallet method
-	err := w.ClearVoteFeeConsolidationAddress(ctx, cmd.Account)
+	outputs, err := makeOutputsWithCoinType(amounts, w.ChainParams(), coinType)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	return "Consolidation address cleared (using default)", nil
+	// Coin type is embedded in outputs
+	return sendOutputs(ctx, w, outputs, opts, account, changeAccount, minconf)
 }
 
-// These generators create the following global variables in this package:
-//
-//   var localeHelpDescs map[string]func() map[string]string
-//   var requestUsages string
-//
-// localeHelpDescs maps from locale strings (e.g. "en_US") to a function that
-// builds a map of help texts for each RPC server method.  This prevents help
-// text maps for every locale map from being rooted and created during init.
-// Instead, the appropriate function is looked up when help text is first needed
-// using the current locale and saved to the global below for further reuse.
-//
-// requestUsages contains single line usages for every supported request,
-// separated by newlines.  It is set during init.  These usages are used for all
-// locales.
-//
-//go:generate go run ../../rpchelp/genrpcserverhelp.go jsonrpc
-//go:generate gofmt -w rpcserverhelp.go
-
-var helpDescs map[string]string
-var helpDescsMu sync.Mutex // Help may execute concurrently, so synchronize access.
+// sendPairsWithCoinTypeBig creates and sends payment transactions with coin type support using big.Int amounts.
+// This is essential for SKA transactions where amounts can exceed int64.
+func (s *Server) sendPairsWithCoinTypeBig(ctx context.Context, w *wallet.Wallet, amounts map[string]*big.Int, account uint32, minconf int32, coinType cointype.CoinType, opts *sendOptions) (string, error) {
+	changeAccount := account
+	if s.cfg.MixingEnabled && s.cfg.MixAccount != "" && s.cfg.MixChangeAccount != "" {
+		mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
+		if err != nil {
+			return "", err
+		}
+		if account == mixAccount {
+			changeAccount, err = w.AccountNumber(ctx, s.cfg.MixChangeAccount)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
 
-// help handles the help request by returning one line usage of all available
-// methods, or full help for a specific method.  The chainClient is optional,
-// and this is simply a helper function for the HelpNoChainRPC and
-// HelpWithChainRPC handlers.
-func (s *Server) help(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.HelpCmd)
-	// TODO: The "help" RPC should use a HTTP POST client when calling down to
-	// dcrd for additional help methods.  This avoids including websocket-only
-	// requests in the help, which are not callable by wallet JSON-RPC clients.
-	var rpc *dcrd.RPC
-	n, _ := s.walletLoader.NetworkBackend()
-	if chainSyncer, ok := n.(*chain.Syncer); ok {
-		rpc = chainSyncer.RPC()
+	outputs, err := makeOutputsWithCoinTypeBig(amounts, w.ChainParams(), coinType)
+	if err != nil {
+		return "", err
 	}
-	if cmd.Command == nil || *cmd.Command == "" {
-		// Prepend chain server usage if it is available.
-		usages := requestUsages
-		if rpc != nil {
-			var usage string
-			err := rpc.Call(ctx, "help", &usage)
+
+	// Coin type is embedded in outputs
+	return sendOutputs(ctx, w, outputs, opts, account, changeAccount, minconf)
+}
+
+// sendAmountToTreasury creates and sends payment transactions to the treasury.
+// It returns the transaction hash in string format upon success All errors are
+// returned in dcrjson.RPCError format
+func (s *Server) sendAmountToTreasury(ctx context.Context, w *wallet.Wallet, amount dcrutil.Amount, account uint32) (string, error) {
+	changeAccount := account
+	if s.cfg.MixingEnabled {
+		mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
+		if err != nil {
+			return "", err
+		}
+		if account == mixAccount {
+			changeAccount, err = w.AccountNumber(ctx,
+				s.cfg.MixChangeAccount)
 			if err != nil {
-				return nil, err
-			}
-			if usage != "" {
-				usages = "Chain server usage:\n\n" + usage + "\n\n" +
-					"Wallet server usage (overrides chain requests):\n\n" +
-					requestUsages
+				return "", err
 			}
 		}
-		return usages, nil
 	}
 
-	defer helpDescsMu.Unlock()
-	helpDescsMu.Lock()
+	txSha, err := w.CreateTreasuryAdd(ctx, account, amount, changeAccount)
+	if err != nil {
+		if errors.Is(err, errors.Locked) {
+			return "", errWalletUnlockNeeded
+		}
+		if errors.Is(err, errors.InsufficientBalance) {
+			return "", rpcError(dcrjson.ErrRPCWalletInsufficientFunds,
+				err)
+		}
+		return "", err
+	}
+
+	return txSha.String(), nil
+}
+
+// sendOutputsFromTreasury creates and sends payment transactions from the treasury.
+// It returns the transaction hash in string format upon success All errors are
+// returned in dcrjson.RPCError format
+func (s *Server) sendOutputsFromTreasury(ctx context.Context, w *wallet.Wallet, cmd types.SendFromTreasuryCmd) (string, error) {
+	piKey, err := hex.DecodeString(cmd.Key)
+	if err != nil {
+		return "", rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+
+	_, tipHeight := w.MainChainTip(ctx)
 
-	if helpDescs == nil {
-		// TODO: Allow other locales to be set via config or detemine
-		// this from environment variables.  For now, hardcode US
-		// English.
-		helpDescs = localeHelpDescs["en_US"]()
+	// OP_RETURN <8 Bytes ValueIn><24 byte random>. The encoded ValueIn is
+	// added at the end of this function.
+	var payload [32]byte
+	rand.Read(payload[8:])
+	builder := txscript.NewScriptBuilder()
+	builder.AddOp(txscript.OP_RETURN)
+	builder.AddData(payload[:])
+	opretScript, err := builder.Script()
+	if err != nil {
+		return "", rpcErrorf(dcrjson.ErrRPCInternal.Code,
+			"sendOutputsFromTreasury NewScriptBuilder: %v", err)
 	}
+	msgTx := wire.NewMsgTx()
+	msgTx.Version = wire.TxVersionTreasury
+	msgTx.AddTxOut(wire.NewTxOut(0, opretScript))
 
-	helpText, ok := helpDescs[*cmd.Command]
-	if ok {
-		return helpText, nil
-	}
+	// Calculate expiry.
+	msgTx.Expiry = blockchain.CalcTSpendExpiry(int64(tipHeight+1),
+		w.ChainParams().TreasuryVoteInterval,
+		w.ChainParams().TreasuryVoteIntervalMultiplier)
 
-	// Return the chain server's detailed help if possible.
-	var chainHelp string
-	if rpc != nil {
-		err := rpc.Call(ctx, "help", &chainHelp, *cmd.Command)
+	// OP_TGEN and calculate totals.
+	var totalPayout dcrutil.Amount
+	for address, amount := range cmd.Amounts {
+		amt, err := dcrutil.NewAmount(amount)
 		if err != nil {
-			return nil, err
+			return "", rpcError(dcrjson.ErrRPCInvalidParameter, err)
 		}
-	}
-	if chainHelp != "" {
-		return chainHelp, nil
-	}
-	return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "no help for method %q", *cmd.Command)
-}
 
-// listAccounts handles a listaccounts request by returning a map of account
-// names to their balances.
-func (s *Server) listAccounts(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ListAccountsCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
-	}
+		// While looping calculate total amount
+		totalPayout += amt
 
-	accountBalances := map[string]float64{}
-	results, err := w.AccountBalances(ctx, int32(*cmd.MinConf))
-	if err != nil {
-		return nil, err
-	}
-	for _, result := range results {
-		accountName, err := w.AccountName(ctx, result.Account)
+		// Decode address.
+		addr, err := decodeStakeAddress(address, w.ChainParams())
 		if err != nil {
-			// Expect name lookup to succeed
-			if errors.Is(err, errors.NotExist) {
-				return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
-			}
-			return nil, err
+			return "", err
 		}
-		accountBalances[accountName] = result.Spendable.ToCoin()
-	}
-	// Return the map.  This will be marshaled into a JSON object.
-	return accountBalances, nil
-}
 
-// listLockUnspent handles a listlockunspent request by returning an slice of
-// all locked outpoints.
-func (s *Server) listLockUnspent(ctx context.Context, icmd any) (any, error) {
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+		// Create OP_TGEN prefixed script.
+		vers, script := addr.PayFromTreasuryScript()
+
+		// Make sure this is not dust.
+		txOut := &wire.TxOut{
+			Value:    int64(amt),
+			Version:  vers,
+			PkScript: script,
+		}
+		if txrules.IsDustOutput(txOut, w.RelayFee()) {
+			return "", rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"Amount is dust: %v %v", addr, amt)
+		}
+
+		// Add to transaction.
+		msgTx.AddTxOut(txOut)
 	}
 
-	var account string
-	cmd := icmd.(*types.ListLockUnspentCmd)
-	if cmd.Account != nil {
-		account = *cmd.Account
+	// Calculate fee. Inputs are <signature> <compressed key> OP_TSPEND.
+	estimatedFee := txsizes.EstimateSerializeSize([]int{txsizes.TSPENDInputSize},
+		msgTx.TxOut, 0)
+	fee := txrules.FeeForSerializeSize(w.RelayFee(), estimatedFee)
+
+	// Assemble TxIn.
+	msgTx.AddTxIn(&wire.TxIn{
+		// Stakebase transactions have no inputs, so previous outpoint
+		// is zero hash and max index.
+		PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{},
+			wire.MaxPrevOutIndex, wire.TxTreeRegular),
+		Sequence:        wire.MaxTxInSequenceNum,
+		ValueIn:         int64(fee) + int64(totalPayout),
+		BlockHeight:     wire.NullBlockHeight,
+		BlockIndex:      wire.NullBlockIndex,
+		SignatureScript: []byte{}, // Empty for now
+	})
+
+	// Encode total amount in first 8 bytes of TxOut[0] OP_RETURN.
+	binary.LittleEndian.PutUint64(msgTx.TxOut[0].PkScript[2:2+8],
+		uint64(fee)+uint64(totalPayout))
+
+	err = w.SignTSpend(ctx, msgTx, piKey)
+	if err != nil {
+		return "", err
 	}
-	return w.LockedOutpoints(ctx, account)
-}
 
-// listReceivedByAccount handles a listreceivedbyaccount request by returning
-// a slice of objects, each one containing:
-//
-//	"account": the receiving account;
-//	"amount": total amount received by the account;
-//	"confirmations": number of confirmations of the most recent transaction.
-//
-// It takes two parameters:
-//
-//	"minconf": minimum number of confirmations to consider a transaction -
-//	           default: one;
-//	"includeempty": whether or not to include addresses that have no transactions -
-//	                default: false.
-func (s *Server) listReceivedByAccount(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ListReceivedByAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	// Send to dcrd.
+	n, ok := s.loader(ctx).NetworkBackend()
 	if !ok {
-		return nil, errUnloadedWallet
+		return "", errNoNetwork
 	}
-
-	results, err := w.TotalReceivedForAccounts(ctx, int32(*cmd.MinConf))
+	err = n.PublishTransactions(ctx, msgTx)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	jsonResults := make([]types.ListReceivedByAccountResult, 0, len(results))
-	for _, result := range results {
-		jsonResults = append(jsonResults, types.ListReceivedByAccountResult{
-			Account:       result.AccountName,
-			Amount:        result.TotalReceived.ToCoin(),
-			Confirmations: uint64(result.LastConfirmation),
-		})
-	}
-	return jsonResults, nil
+	return msgTx.TxHash().String(), nil
 }
 
-// listReceivedByAddress handles a listreceivedbyaddress request by returning
-// a slice of objects, each one containing:
-//
-//	"account": the account of the receiving address;
-//	"address": the receiving address;
-//	"amount": total amount received by the address;
-//	"confirmations": number of confirmations of the most recent transaction.
-//
-// It takes two parameters:
-//
-//	"minconf": minimum number of confirmations to consider a transaction -
-//	           default: one;
-//	"includeempty": whether or not to include addresses that have no transactions -
-//	                default: false.
-func (s *Server) listReceivedByAddress(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ListReceivedByAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// sendTSpend signs a treasury spend transaction created elsewhere with a
+// Politeia key held by the wallet and publishes it to the network.
+func (s *Server) sendTSpend(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SendTSpendCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	// Intermediate data for each address.
-	type AddrData struct {
-		// Total amount received.
-		amount dcrutil.Amount
-		// Number of confirmations of the last transaction.
-		confirmations int32
-		// Hashes of transactions which include an output paying to the address
-		tx []string
-	}
-
-	_, tipHeight := w.MainChainTip(ctx)
-
-	// Intermediate data for all addresses.
-	allAddrData := make(map[string]AddrData)
-	// Create an AddrData entry for each active address in the account.
-	// Otherwise we'll just get addresses from transactions later.
-	sortedAddrs, err := w.SortedActivePaymentAddresses(ctx)
+	msgTx := wire.NewMsgTx()
+	err := msgTx.Deserialize(hex.NewDecoder(strings.NewReader(cmd.HexTx)))
 	if err != nil {
-		return nil, err
-	}
-	for _, address := range sortedAddrs {
-		// There might be duplicates, just overwrite them.
-		allAddrData[address] = AddrData{}
-	}
-
-	minConf := *cmd.MinConf
-	var endHeight int32
-	if minConf == 0 {
-		endHeight = -1
-	} else {
-		endHeight = tipHeight - int32(minConf) + 1
+		return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
 	}
-	err = wallet.UnstableAPI(w).RangeTransactions(ctx, 0, endHeight, func(details []udb.TxDetails) (bool, error) {
-		confirmations := confirms(details[0].Block.Height, tipHeight)
-		for _, tx := range details {
-			for _, cred := range tx.Credits {
-				pkVersion := tx.MsgTx.TxOut[cred.Index].Version
-				pkScript := tx.MsgTx.TxOut[cred.Index].PkScript
-				_, addrs := stdscript.ExtractAddrs(pkVersion, pkScript, w.ChainParams())
-				for _, addr := range addrs {
-					addrStr := addr.String()
-					addrData, ok := allAddrData[addrStr]
-					if ok {
-						addrData.amount += cred.Amount
-						// Always overwrite confirmations with newer ones.
-						addrData.confirmations = confirmations
-					} else {
-						addrData = AddrData{
-							amount:        cred.Amount,
-							confirmations: confirmations,
-						}
-					}
-					addrData.tx = append(addrData.tx, tx.Hash.String())
-					allAddrData[addrStr] = addrData
-				}
-			}
-		}
-		return false, nil
-	})
+	piKey, err := hex.DecodeString(cmd.PiKey)
 	if err != nil {
-		return nil, err
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
 	}
 
-	// Massage address data into output format.
-	numAddresses := len(allAddrData)
-	ret := make([]types.ListReceivedByAddressResult, numAddresses)
-	idx := 0
-	for address, addrData := range allAddrData {
-		ret[idx] = types.ListReceivedByAddressResult{
-			Address:       address,
-			Amount:        addrData.amount.ToCoin(),
-			Confirmations: uint64(addrData.confirmations),
-			TxIDs:         addrData.tx,
-		}
-		idx++
+	err = w.SignTSpend(ctx, msgTx, piKey)
+	if err != nil {
+		return nil, err
 	}
-	return ret, nil
-}
 
-// listSinceBlock handles a listsinceblock request by returning an array of maps
-// with details of sent and received wallet transactions since the given block.
-func (s *Server) listSinceBlock(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ListSinceBlockCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	n, ok := s.loader(ctx).NetworkBackend()
 	if !ok {
-		return nil, errUnloadedWallet
+		return nil, errNoNetwork
+	}
+	err = n.PublishTransactions(ctx, msgTx)
+	if err != nil {
+		return nil, err
 	}
 
-	targetConf := int32(*cmd.TargetConfirmations)
-	if targetConf < 1 {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "target_confirmations must be positive")
+	return msgTx.TxHash().String(), nil
+}
+
+// treasuryPolicy returns voting policies for treasury spends by a particular
+// key.  If a key is specified, that policy is returned; otherwise the policies
+// for all keys are returned in an array.  If both a key and ticket hash are
+// provided, the per-ticket key policy is returned.
+func (s *Server) treasuryPolicy(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.TreasuryPolicyCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	tipHash, tipHeight := w.MainChainTip(ctx)
-	lastBlock := &tipHash
-	if targetConf > 0 {
-		id := wallet.NewBlockIdentifierFromHeight((tipHeight + 1) - targetConf)
-		info, err := w.BlockInfo(ctx, id)
+	var ticketHash *chainhash.Hash
+	if cmd.Ticket != nil && *cmd.Ticket != "" {
+		var err error
+		ticketHash, err = chainhash.NewHashFromStr(*cmd.Ticket)
 		if err != nil {
-			return nil, err
+			return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
 		}
-
-		lastBlock = &info.Hash
 	}
 
-	// TODO: This must begin at the fork point in the main chain, not the height
-	// of this block.
-	var end int32
-	if cmd.BlockHash != nil {
-		hash, err := chainhash.NewHashFromStr(*cmd.BlockHash)
+	if cmd.Key != nil && *cmd.Key != "" {
+		pikey, err := hex.DecodeString(*cmd.Key)
 		if err != nil {
 			return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
 		}
-		header, err := w.BlockHeader(ctx, hash)
-		if err != nil {
-			return nil, err
+		var policy string
+		switch w.TreasuryKeyPolicy(pikey, ticketHash) {
+		case stake.TreasuryVoteYes:
+			policy = "yes"
+		case stake.TreasuryVoteNo:
+			policy = "no"
+		default:
+			policy = "abstain"
 		}
-		end = int32(header.Height)
-	}
-
-	txInfoList, err := w.ListSinceBlock(ctx, -1, end, tipHeight)
-	if err != nil {
-		return nil, err
+		res := &types.TreasuryPolicyResult{
+			Key:    *cmd.Key,
+			Policy: policy,
+		}
+		if cmd.Ticket != nil {
+			res.Ticket = *cmd.Ticket
+		}
+		return res, nil
 	}
 
-	res := &types.ListSinceBlockResult{
-		Transactions: txInfoList,
-		LastBlock:    lastBlock.String(),
+	policies := w.TreasuryKeyPolicies()
+	res := make([]types.TreasuryPolicyResult, 0, len(policies))
+	for i := range policies {
+		var policy string
+		switch policies[i].Policy {
+		case stake.TreasuryVoteYes:
+			policy = "yes"
+		case stake.TreasuryVoteNo:
+			policy = "no"
+		}
+		r := types.TreasuryPolicyResult{
+			Key:    hex.EncodeToString(policies[i].PiKey),
+			Policy: policy,
+		}
+		if policies[i].Ticket != nil {
+			r.Ticket = policies[i].Ticket.String()
+		}
+		res = append(res, r)
 	}
 	return res, nil
 }
 
-// listTransactions handles a listtransactions request by returning an
-// array of maps with details of sent and recevied wallet transactions.
-func (s *Server) listTransactions(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ListTransactionsCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// setDisapprovePercent sets the wallet's disapprove percentage.
+func (s *Server) setDisapprovePercent(ctx context.Context, icmd any) (any, error) {
+	if s.activeNet.Net == wire.MainNet {
+		return nil, dcrjson.ErrInvalidRequest
+	}
+	cmd := icmd.(*types.SetDisapprovePercentCmd)
+	if cmd.Percent > 100 {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter,
+			errors.New("percent must be from 0 to 100"))
+	}
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
+	w.SetDisapprovePercent(cmd.Percent)
+	return nil, nil
+}
 
-	// TODO: ListTransactions does not currently understand the difference
-	// between transactions pertaining to one account from another.  This
-	// will be resolved when wtxmgr is combined with the waddrmgr namespace.
+// setTicketBuyerConfig sets the target number of unspent tickets the ticket
+// buyer maintains for an account, along with the limits of its purchases.  A
+// zero target stops the ticket buyer from maintaining the account's tickets.
+func (s *Server) setTicketBuyerConfig(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetTicketBuyerConfigCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-	if cmd.Account != nil && *cmd.Account != "*" {
-		// For now, don't bother trying to continue if the user
-		// specified an account, since this can't be (easily or
-		// efficiently) calculated.
-		return nil,
-			errors.E(`Transactions can not be searched by account. ` +
-				`Use "*" to reference all accounts.`)
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
 	}
 
-	return w.ListTransactions(ctx, *cmd.From, *cmd.Count)
+	amount := func(name string, v *float64) (dcrutil.Amount, error) {
+		if v == nil {
+			return 0, nil
+		}
+		amt, err := dcrutil.NewAmount(*v)
+		if err != nil {
+			return 0, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		if amt < 0 {
+			return 0, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative %s", name)
+		}
+		return amt, nil
+	}
+	cfg := &udb.TicketBuyerConfig{
+		Account: account,
+		Target:  cmd.Target,
+	}
+	if cfg.MaxPrice, err = amount("max price", cmd.MaxPrice); err != nil {
+		return nil, err
+	}
+	if cfg.MaxFee, err = amount("max fee", cmd.MaxFee); err != nil {
+		return nil, err
+	}
+	if cfg.Reserve, err = amount("reserve", cmd.Reserve); err != nil {
+		return nil, err
+	}
+	return nil, w.SetTicketBuyerConfig(ctx, cfg)
 }
 
-// listAddressTransactions handles a listaddresstransactions request by
-// returning an array of maps with details of spent and received wallet
-// transactions.  The form of the reply is identical to listtransactions,
-// but the array elements are limited to transaction details which are
-// about the addresess included in the request.
-func (s *Server) listAddressTransactions(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ListAddressTransactionsCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// ticketBuyerConfig returns the ticket buyer configuration and current ticket
+// count of each account whose tickets are maintained by the ticket buyer.
+func (s *Server) ticketBuyerConfig(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	if cmd.Account != nil && *cmd.Account != "*" {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-			"listing transactions for addresses may only be done for all accounts")
+	configs, err := w.TicketBuyerConfigs(ctx)
+	if err != nil {
+		return nil, err
 	}
-
-	// Decode addresses.
-	hash160Map := make(map[string]struct{})
-	for _, addrStr := range cmd.Addresses {
-		addr, err := decodeAddress(addrStr, w.ChainParams())
+	res := make([]types.TicketBuyerConfigResult, 0, len(configs))
+	for _, c := range configs {
+		name, err := w.AccountName(ctx, c.Account)
 		if err != nil {
 			return nil, err
 		}
-		hash160er, ok := addr.(stdaddr.Hash160er)
-		if !ok {
-			// Not tracked by the wallet so skip reporting history
-			// of this address.
-			continue
+		tickets, err := w.AccountTicketCount(ctx, c.Account)
+		if err != nil {
+			return nil, err
 		}
-		hash160Map[string(hash160er.Hash160()[:])] = struct{}{}
+		res = append(res, types.TicketBuyerConfigResult{
+			Account:  name,
+			Target:   c.Target,
+			Tickets:  tickets,
+			MaxPrice: c.MaxPrice.ToCoin(),
+			MaxFee:   c.MaxFee.ToCoin(),
+			Reserve:  c.Reserve.ToCoin(),
+		})
 	}
-
-	return w.ListAddressTransactions(ctx, hash160Map)
+	return res, nil
 }
 
-// listAllTransactions handles a listalltransactions request by returning
-// a map with details of sent and recevied wallet transactions.  This is
-// similar to ListTransactions, except it takes only a single optional
-// argument for the account name and replies with all transactions.
-func (s *Server) listAllTransactions(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ListAllTransactionsCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// setChangeAccount redirects all change of a coin type from transactions
+// spending an account's outputs to a separate change account.
+func (s *Server) setChangeAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetChangeAccountCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	if cmd.Account != nil && *cmd.Account != "*" {
+	coinType := 0
+	if cmd.CoinType != nil {
+		coinType = *cmd.CoinType
+	}
+	if coinType < 0 || coinType > 255 {
 		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-			"listing all transactions may only be done for all accounts")
+			"invalid coin type %d: must be between 0 (VAR) and 255 (SKA)", coinType)
 	}
-
-	return w.ListAllTransactions(ctx)
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	changeAccount, err := w.AccountNumber(ctx, cmd.ChangeAccount)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	err = w.SetChangeAccount(ctx, account, cointype.CoinType(coinType),
+		changeAccount)
+	return nil, err
 }
 
-// listUnspent handles the listunspent command with optional coin type filtering.
-func (s *Server) listUnspent(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ListUnspentCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// changeAccounts returns the change account of each account and coin type
+// whose change is redirected.
+func (s *Server) changeAccounts(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	// Validate coin type if specified
-	if cmd.CoinType != nil {
-		coinType := cointype.CoinType(*cmd.CoinType)
-		if err := validateCoinType(coinType); err != nil {
+	redirects, err := w.ChangeAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.ChangeAccountResult, 0, len(redirects))
+	for _, c := range redirects {
+		name, err := w.AccountName(ctx, c.Account)
+		if err != nil {
 			return nil, err
 		}
-	}
-
-	var addresses map[string]struct{}
-	if cmd.Addresses != nil {
-		addresses = make(map[string]struct{})
-		// confirm that all of them are good:
-		for _, as := range *cmd.Addresses {
-			a, err := decodeAddress(as, w.ChainParams())
-			if err != nil {
-				return nil, err
-			}
-			addresses[a.String()] = struct{}{}
+		changeName, err := w.AccountName(ctx, c.ChangeAccount)
+		if err != nil {
+			return nil, err
 		}
+		res = append(res, types.ChangeAccountResult{
+			Account:       name,
+			CoinType:      uint8(c.CoinType),
+			ChangeAccount: changeName,
+		})
 	}
+	return res, nil
+}
 
-	var account string
-	if cmd.Account != nil {
-		account = *cmd.Account
+// setChangeScriptType sets the output script type of change returned to an
+// account.
+func (s *Server) setChangeScriptType(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetChangeScriptTypeCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	result, err := w.ListUnspent(ctx, int32(*cmd.MinConf), int32(*cmd.MaxConf), addresses, account)
+	scriptType, err := udb.ParseChangeScriptType(cmd.ScriptType)
 	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAddressNotInWallet
-		}
-		return nil, err
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"invalid script type %q: must be p2pkh, schnorr-p2pkh, or p2sh",
+			cmd.ScriptType)
 	}
-
-	// Filter by coin type if specified
-	if cmd.CoinType != nil {
-		requestedCoinType := *cmd.CoinType
-		filteredResult := make([]*types.ListUnspentResult, 0)
-
-		for _, unspent := range result {
-			if unspent.CoinType == requestedCoinType {
-				filteredResult = append(filteredResult, unspent)
-			}
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
 		}
-
-		return filteredResult, nil
+		return nil, err
 	}
-
-	return result, nil
+	err = w.SetChangeScriptType(ctx, account, scriptType)
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
 }
 
-// lockUnspent handles the lockunspent command.
-func (s *Server) lockUnspent(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.LockUnspentCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// changeScriptTypes returns the change script type of each account which does
+// not use P2PKH change.
+func (s *Server) changeScriptTypes(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	switch {
-	case cmd.Unlock && len(cmd.Transactions) == 0:
-		w.ResetLockedOutpoints()
-	default:
-		for _, input := range cmd.Transactions {
-			txHash, err := chainhash.NewHashFromStr(input.Txid)
-			if err != nil {
-				return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
-			}
-			if cmd.Unlock {
-				w.UnlockOutpoint(txHash, input.Vout)
-			} else {
-				w.LockOutpoint(txHash, input.Vout)
-			}
+	scriptTypes, err := w.ChangeScriptTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]uint32, 0, len(scriptTypes))
+	for account := range scriptTypes {
+		accounts = append(accounts, account)
+	}
+	slices.Sort(accounts)
+	res := make([]types.ChangeScriptTypeResult, 0, len(accounts))
+	for _, account := range accounts {
+		name, err := w.AccountName(ctx, account)
+		if err != nil {
+			return nil, err
 		}
+		res = append(res, types.ChangeScriptTypeResult{
+			Account:    name,
+			ScriptType: scriptTypes[account].String(),
+		})
 	}
-	return true, nil
+	return res, nil
 }
 
-// purchaseTicket indicates to the wallet that a ticket should be purchased
-// using all currently available funds. If the ticket could not be purchased
-// because there are not enough eligible funds, an error will be returned.
-func (s *Server) purchaseTicket(ctx context.Context, icmd any) (any, error) {
-	// Enforce valid and positive spend limit.
-	cmd := icmd.(*types.PurchaseTicketCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// setSpendLimit limits the amount of a coin type which an account may send
+// each day, optionally queueing sends exceeding the limit for approval.
+func (s *Server) setSpendLimit(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetSpendLimitCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	n, err := w.NetworkBackend()
-	if err != nil {
-		return nil, err
+	coinType := cointype.CoinTypeVAR
+	if cmd.CoinType != nil {
+		coinType = cointype.CoinType(*cmd.CoinType)
 	}
-
-	spendLimit, err := dcrutil.NewAmount(cmd.SpendLimit)
-	if err != nil {
-		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	if err := validateCoinType(coinType); err != nil {
+		return nil, err
 	}
-	if spendLimit < 0 {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative spend limit")
+	limit, err := coinsToAtomsBig(cmd.Limit, getAtomsPerCoin(w.ChainParams(), coinType))
+	if err != nil || limit.Sign() < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"invalid limit %q", cmd.Limit)
 	}
-
-	account, err := w.AccountNumber(ctx, cmd.FromAccount)
+	account, err := w.AccountNumber(ctx, cmd.Account)
 	if err != nil {
 		if errors.Is(err, errors.NotExist) {
 			return nil, errAccountNotFound
 		}
 		return nil, err
 	}
+	requireApproval := cmd.RequireApproval != nil && *cmd.RequireApproval
+	err = w.SetSpendLimit(ctx, account, coinType, limit, requireApproval)
+	return nil, err
+}
 
-	// Override the minimum number of required confirmations if specified
-	// and enforce it is positive.
-	minConf := int32(1)
-	if cmd.MinConf != nil {
-		minConf = int32(*cmd.MinConf)
-		if minConf < 0 {
-			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
-		}
+// listSpendLimits describes the daily spend limit of each account and coin
+// type whose spending is limited.
+func (s *Server) listSpendLimits(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	numTickets := 1
-	if cmd.NumTickets != nil {
-		if *cmd.NumTickets > 1 {
-			numTickets = *cmd.NumTickets
+	limits, err := w.SpendLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := w.ChainParams()
+	now := time.Now()
+	res := make([]types.SpendLimitResult, 0, len(limits))
+	for i := range limits {
+		l := &limits[i]
+		name, err := w.AccountName(ctx, l.Account)
+		if err != nil {
+			return nil, err
 		}
+		res = append(res, types.SpendLimitResult{
+			Account:         name,
+			CoinType:        uint8(l.CoinType),
+			Limit:           coinAmount(params, l.CoinType, l.Limit),
+			SpentToday:      coinAmount(params, l.CoinType, l.SpentOn(now)),
+			RequireApproval: l.RequireApproval,
+		})
 	}
+	return res, nil
+}
 
-	// Set the expiry if specified.
-	expiry := int32(0)
-	if cmd.Expiry != nil {
-		expiry = int32(*cmd.Expiry)
+// listPendingBroadcasts describes every published transaction queued to be
+// republished until it is mined.
+func (s *Server) listPendingBroadcasts(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	dontSignTx := false
-	if cmd.DontSignTx != nil {
-		dontSignTx = *cmd.DontSignTx
+	queue, err := w.PendingBroadcasts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.PendingBroadcastResult, 0, len(queue))
+	for i := range queue {
+		r := &queue[i]
+		pb := types.PendingBroadcastResult{
+			TxID:        r.Hash.String(),
+			Queued:      r.Queued.Unix(),
+			Attempts:    r.Attempts,
+			NextAttempt: r.NextAttempt.Unix(),
+			LastError:   r.LastError,
+		}
+		if !r.LastAttempt.IsZero() {
+			pb.LastAttempt = r.LastAttempt.Unix()
+		}
+		res = append(res, pb)
 	}
+	return res, nil
+}
 
-	var mixedAccount uint32
-	var mixedAccountBranch uint32
-	var mixedSplitAccount uint32
-	// Use purchasing account as change account by default (overridden below if
-	// mixing is enabled).
-	var changeAccount = account
+// listPendingSends describes every send awaiting approval after exceeding the
+// daily spend limit of its account.
+func (s *Server) listPendingSends(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-	if s.cfg.MixingEnabled {
-		mixedAccount, err = w.AccountNumber(ctx, s.cfg.MixAccount)
+	sends, err := w.PendingSends(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := w.ChainParams()
+	res := make([]types.PendingSendResult, 0, len(sends))
+	for i := range sends {
+		p := &sends[i]
+		name, err := w.AccountName(ctx, p.Account)
 		if err != nil {
-			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-				"Mixing enabled, but error on mixed account: %v", err)
+			return nil, err
 		}
-		mixedAccountBranch = s.cfg.MixBranch
-		if mixedAccountBranch != 0 && mixedAccountBranch != 1 {
-			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-				"MixedAccountBranch should be 0 or 1.")
+		outputs := make([]types.PendingSendOutputResult, 0, len(p.Outputs))
+		for _, out := range p.Outputs {
+			var o types.PendingSendOutputResult
+			_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, params)
+			if len(addrs) == 1 {
+				o.Address = addrs[0].String()
+			}
+			if !p.Sweep {
+				o.Amount = coinAmount(params, out.CoinType, sendOutputAmount(out))
+			}
+			outputs = append(outputs, o)
 		}
-		mixedSplitAccount, err = w.AccountNumber(ctx, s.cfg.TicketSplitAccount)
-		if err != nil {
-			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-				"Mixing enabled, but error on mixedSplitAccount: %v", err)
+		r := types.PendingSendResult{
+			ID:       p.ID,
+			Account:  name,
+			CoinType: uint8(p.CoinType),
+			Outputs:  outputs,
+			Sweep:    p.Sweep,
+			Treasury: p.Treasury,
+			Label:    p.Label,
+			Created:  p.Created.Unix(),
 		}
-		changeAccount, err = w.AccountNumber(ctx, s.cfg.MixChangeAccount)
-		if err != nil {
-			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-				"Mixing enabled, but error on changeAccount: %v", err)
+		if !p.Sweep {
+			r.Amount = coinAmount(params, p.CoinType, p.Amount)
 		}
+		res = append(res, r)
 	}
+	return res, nil
+}
 
-	var vspClient *wallet.VSPClient
-	if s.cfg.VSPHost != "" {
-		cfg := wallet.VSPClientConfig{
-			URL:    s.cfg.VSPHost,
-			PubKey: s.cfg.VSPPubKey,
-			Policy: &wallet.VSPPolicy{
-				MaxFee:     w.VSPMaxFee(),
-				FeeAcct:    account,
-				ChangeAcct: changeAccount,
-			},
-		}
-		vspClient, err = w.VSP(cfg)
-		if err != nil {
-			return nil, rpcErrorf(dcrjson.ErrRPCMisc,
-				"VSP Server instance failed to start: %v", err)
-		}
+// sendOutputAmount returns the value of an output in atoms of its coin type.
+func sendOutputAmount(out *wire.TxOut) *big.Int {
+	if out.CoinType.IsSKA() && out.SKAValue != nil {
+		return out.SKAValue
 	}
+	return big.NewInt(out.Value)
+}
 
-	request := &wallet.PurchaseTicketsRequest{
-		Count:         numTickets,
-		SourceAccount: account,
-		MinConf:       minConf,
-		Expiry:        expiry,
-		DontSignTx:    dontSignTx,
-
-		// CSPP
-		Mixing:             s.cfg.MixingEnabled,
-		MixedAccount:       mixedAccount,
-		MixedAccountBranch: mixedAccountBranch,
-		MixedSplitAccount:  mixedSplitAccount,
-		ChangeAccount:      changeAccount,
-
-		VSPClient: vspClient,
-	}
-	// Use the mixed account as voting account if mixing is enabled,
-	// otherwise use the source account.
-	if s.cfg.MixingEnabled {
-		request.VotingAccount = mixedAccount
-	} else {
-		request.VotingAccount = account
+// approvePending handles an approvepending request by authoring, signing and
+// publishing a send awaiting approval.  The transaction hash is returned.
+func (s *Server) approvePending(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ApprovePendingCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	ticketsResponse, err := w.PurchaseTickets(ctx, n, request)
+	hash, err := w.ApprovePendingSend(ctx, cmd.ID)
 	if err != nil {
-		return nil, err
-	}
-	ticketsTx := ticketsResponse.Tickets
-	splitTx := ticketsResponse.SplitTx
-
-	// If dontSignTx is false, we return the TicketHashes of the published txs.
-	if !dontSignTx {
-		hashes := ticketsResponse.TicketHashes
-		hashStrs := make([]string, len(hashes))
-		for i := range hashes {
-			hashStrs[i] = hashes[i].String()
+		switch {
+		case errors.Is(err, errors.NotExist):
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		case errors.Is(err, errors.Locked):
+			return nil, errWalletUnlockNeeded
+		case errors.Is(err, errors.InsufficientBalance):
+			return nil, rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
 		}
-
-		return hashStrs, err
+		return nil, err
 	}
+	return hash.String(), nil
+}
 
-	// Otherwise we return its unsigned tickets bytes and the splittx, so a
-	// cold wallet can handle it.
-	var stringBuilder strings.Builder
-	unsignedTickets := make([]string, len(ticketsTx))
-	for i, mtx := range ticketsTx {
-		err = mtx.Serialize(hex.NewEncoder(&stringBuilder))
-		if err != nil {
-			return nil, err
-		}
-		unsignedTickets[i] = stringBuilder.String()
-		stringBuilder.Reset()
+// rejectPending handles a rejectpending request by removing a send awaiting
+// approval without sending it.
+func (s *Server) rejectPending(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RejectPendingCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	err = splitTx.Serialize(hex.NewEncoder(&stringBuilder))
-	if err != nil {
+	err := w.RejectPendingSend(ctx, cmd.ID)
+	if errors.Is(err, errors.NotExist) {
 		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 	}
-
-	splitTxString := stringBuilder.String()
-
-	return types.CreateUnsignedTicketResult{
-		UnsignedTickets: unsignedTickets,
-		SplitTx:         splitTxString,
-	}, nil
+	return nil, err
 }
 
-// processUnmanagedTicket takes a ticket hash as an argument and attempts to
-// start managing it for the set vsp client from the config.
-func (s *Server) processUnmanagedTicket(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ProcessUnmanagedTicketCmd)
-
-	if cmd.TicketHash == "" {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "ticket hash must be provided")
+// scheduleSend handles a schedulesend request by queueing a send to be
+// published once a time or block height is reached.
+func (s *Server) scheduleSend(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ScheduleSendCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	hash, err := chainhash.NewHashFromStr(cmd.TicketHash)
+	coinType := cointype.CoinTypeVAR
+	if cmd.CoinType != nil {
+		coinType = cointype.CoinType(*cmd.CoinType)
+		if err := validateCoinType(coinType); err != nil {
+			return nil, err
+		}
+	}
+	account, err := w.AccountNumber(ctx, cmd.FromAccount)
 	if err != nil {
-		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
 	}
-
-	vspHost := s.cfg.VSPHost
-	if vspHost == "" {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "vsphost must be set in options")
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
 	}
 
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+	sched := &wallet.ScheduleOptions{PreSign: *cmd.PreSign}
+	if cmd.NotBefore != nil {
+		sched.NotBefore = time.Unix(*cmd.NotBefore, 0)
 	}
-
-	vspClient, err := w.LookupVSP(vspHost)
+	if cmd.NotBeforeHeight != nil {
+		sched.NotBeforeHeight = *cmd.NotBeforeHeight
+	}
+	opts := makeSendOptions(nil, cmd.Comment, cmd.Expiry, cmd.ExpireAfter, nil)
+	err = opts.setFeePreference(ctx, w, coinType, cmd.FeePreference)
 	if err != nil {
 		return nil, err
 	}
 
-	ticket, err := w.NewVSPTicket(ctx, hash)
+	atomsPerCoin := getAtomsPerCoin(w.ChainParams(), coinType)
+	pairs := make(map[string]*big.Int, len(cmd.Amounts))
+	for k, v := range cmd.Amounts {
+		amt, err := coinsToAtomsBig(v, atomsPerCoin)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "invalid amount for %s: %v", k, err)
+		}
+		if !coinType.IsSKA() && !amt.IsInt64() {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "amount for %s out of range", k)
+		}
+		pairs[k] = amt
+	}
+	outputs, err := makeOutputsWithCoinTypeBig(pairs, w.ChainParams(), coinType)
 	if err != nil {
 		return nil, err
 	}
 
-	err = vspClient.Process(ctx, ticket, nil)
+	walletOpts := &wallet.SendOptions{
+		LockTimes: opts.lockTimes,
+		Label:     opts.label,
+		FeeRate:   opts.feeRate,
+	}
+	sent, err := w.ScheduleSend(ctx, outputs, account, account, minConf, walletOpts, sched)
 	if err != nil {
+		switch {
+		case errors.Is(err, errors.Invalid):
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		case errors.Is(err, errors.Locked):
+			return nil, errWalletUnlockNeeded
+		case errors.Is(err, errors.InsufficientBalance):
+			return nil, rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+		}
 		return nil, err
 	}
-
-	return nil, nil
-
+	return scheduledSendResult(w.ChainParams(), sent, cmd.FromAccount), nil
 }
 
-// makeOutputs creates a slice of transaction outputs from a pair of address
-// strings to amounts.  This is used to create the outputs to include in newly
-// created transactions from a JSON object describing the output destinations
-// and amounts.
-func makeOutputs(pairs map[string]dcrutil.Amount, chainParams *chaincfg.Params) ([]*wire.TxOut, error) {
-	outputs := make([]*wire.TxOut, 0, len(pairs))
-	for addrStr, amt := range pairs {
-		if amt < 0 {
-			return nil, errNeedPositiveAmount
-		}
-		addr, err := decodeAddress(addrStr, chainParams)
-		if err != nil {
-			return nil, err
-		}
-
-		vers, pkScript := addr.PaymentScript()
+// scheduledSendResult returns the result describing a scheduled send from the
+// named account.
+func scheduledSendResult(params *chaincfg.Params, sent *udb.ScheduledSend,
+	accountName string) types.ScheduledSendResult {
 
-		outputs = append(outputs, &wire.TxOut{
-			Value:    int64(amt),
-			PkScript: pkScript,
-			Version:  vers,
-		})
+	p := &sent.Send
+	outputs := make([]types.PendingSendOutputResult, 0, len(p.Outputs))
+	for _, out := range p.Outputs {
+		var o types.PendingSendOutputResult
+		_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, params)
+		if len(addrs) == 1 {
+			o.Address = addrs[0].String()
+		}
+		o.Amount = coinAmount(params, out.CoinType, sendOutputAmount(out))
+		outputs = append(outputs, o)
 	}
-	return outputs, nil
+	r := types.ScheduledSendResult{
+		ID:              sent.ID,
+		Account:         accountName,
+		CoinType:        uint8(p.CoinType),
+		Amount:          coinAmount(params, p.CoinType, p.Amount),
+		Outputs:         outputs,
+		NotBeforeHeight: sent.NotBeforeHeight,
+		Status:          sent.Status.String(),
+		LastError:       sent.LastError,
+		Label:           p.Label,
+		Created:         p.Created.Unix(),
+	}
+	if !sent.NotBefore.IsZero() {
+		r.NotBefore = sent.NotBefore.Unix()
+	}
+	if sent.SignedTx != nil {
+		r.SignedTxID = sent.SignedTx.TxHash().String()
+	}
+	if sent.Status == udb.ScheduledSendSent {
+		r.TxID = sent.TxHash.String()
+	}
+	return r
 }
 
-// makeOutputsWithCoinType creates transaction outputs with specified coin type for dual-coin support.
-func makeOutputsWithCoinType(pairs map[string]dcrutil.Amount, chainParams *chaincfg.Params, coinType cointype.CoinType) ([]*wire.TxOut, error) {
-	outputs := make([]*wire.TxOut, 0, len(pairs))
-	for addrStr, amt := range pairs {
-		if amt < 0 {
-			return nil, errNeedPositiveAmount
-		}
-		addr, err := decodeAddress(addrStr, chainParams)
-		if err != nil {
-			return nil, err
-		}
-
-		vers, pkScript := addr.PaymentScript()
-
-		txOut := &wire.TxOut{
-			PkScript: pkScript,
-			Version:  vers,
-			CoinType: coinType, // Dual-coin support: specify coin type
-		}
-
-		// For SKA, use SKAValue (big.Int); for VAR, use Value (int64)
-		if coinType.IsSKA() {
-			txOut.Value = 0 // SKA uses SKAValue, not Value
-			txOut.SKAValue = big.NewInt(int64(amt))
-		} else {
-			txOut.Value = int64(amt)
-		}
-
-		outputs = append(outputs, txOut)
+// listScheduledSends handles a listscheduledsends request by returning every
+// scheduled send and its status.
+func (s *Server) listScheduledSends(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
-	return outputs, nil
-}
 
-// makeOutputsWithCoinTypeBig creates transaction outputs with specified coin type using big.Int amounts.
-// This is essential for SKA transactions where amounts can exceed int64.
-func makeOutputsWithCoinTypeBig(pairs map[string]*big.Int, chainParams *chaincfg.Params, coinType cointype.CoinType) ([]*wire.TxOut, error) {
-	outputs := make([]*wire.TxOut, 0, len(pairs))
-	for addrStr, amt := range pairs {
-		if amt == nil || amt.Sign() < 0 {
-			return nil, errNeedPositiveAmount
-		}
-		addr, err := decodeAddress(addrStr, chainParams)
+	sends, err := w.ScheduledSends(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.ScheduledSendResult, 0, len(sends))
+	for i := range sends {
+		name, err := w.AccountName(ctx, sends[i].Send.Account)
 		if err != nil {
 			return nil, err
 		}
+		res = append(res, scheduledSendResult(w.ChainParams(), &sends[i], name))
+	}
+	return res, nil
+}
 
-		vers, pkScript := addr.PaymentScript()
-
-		txOut := &wire.TxOut{
-			PkScript: pkScript,
-			Version:  vers,
-			CoinType: coinType,
-		}
+// cancelScheduledSend handles a cancelscheduledsend request by cancelling a
+// scheduled send which has not been triggered.
+func (s *Server) cancelScheduledSend(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CancelScheduledSendCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-		// For SKA, use SKAValue (big.Int); for VAR, use Value (int64)
-		if coinType.IsSKA() {
-			txOut.Value = 0 // SKA uses SKAValue, not Value
-			txOut.SKAValue = new(big.Int).Set(amt)
-		} else {
-			txOut.Value = amt.Int64()
-		}
+	err := w.CancelScheduledSend(ctx, cmd.ID)
+	if errors.Is(err, errors.NotExist) || errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
 
-		outputs = append(outputs, txOut)
+// parseRecurringInterval parses the time interval of a recurring payment,
+// which is a duration or one of the @hourly, @daily and @weekly macros.
+func parseRecurringInterval(s string) (time.Duration, error) {
+	switch s {
+	case "@hourly":
+		return time.Hour, nil
+	case "@daily":
+		return 24 * time.Hour, nil
+	case "@weekly":
+		return 7 * 24 * time.Hour, nil
 	}
-	return outputs, nil
+	return time.ParseDuration(s)
 }
 
-// sendPairs creates and sends payment transactions.
-// It returns the transaction hash in string format upon success
-// All errors are returned in dcrjson.RPCError format
-func (s *Server) sendPairs(ctx context.Context, w *wallet.Wallet, amounts map[string]dcrutil.Amount, account uint32, minconf int32) (string, error) {
-	changeAccount := account
-	if s.cfg.MixingEnabled && s.cfg.MixAccount != "" && s.cfg.MixChangeAccount != "" {
-		mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
-		if err != nil {
-			return "", err
-		}
-		if account == mixAccount {
-			changeAccount, err = w.AccountNumber(ctx, s.cfg.MixChangeAccount)
-			if err != nil {
-				return "", err
-			}
-		}
+// addRecurringPayment handles an addrecurringpayment request by recording a
+// payment sent every interval of blocks or time.
+func (s *Server) addRecurringPayment(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.AddRecurringPaymentCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	outputs, err := makeOutputs(amounts, w.ChainParams())
-	if err != nil {
-		return "", err
+	coinType := cointype.CoinTypeVAR
+	if cmd.CoinType != nil {
+		coinType = cointype.CoinType(*cmd.CoinType)
+		if err := validateCoinType(coinType); err != nil {
+			return nil, err
+		}
 	}
-	txSha, err := w.SendOutputs(ctx, outputs, account, changeAccount, minconf)
+	account, err := w.AccountNumber(ctx, cmd.FromAccount)
 	if err != nil {
-		if errors.Is(err, errors.Locked) {
-			return "", errWalletUnlockNeeded
-		}
-		if errors.Is(err, errors.InsufficientBalance) {
-			return "", rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
 		}
-		return "", err
+		return nil, err
+	}
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
+	}
+	policy, err := udb.ParseRecurringFailurePolicy(*cmd.FailurePolicy)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 	}
 
-	return txSha.String(), nil
-}
-
-// sendPairsWithCoinType creates and sends payment transactions with coin type support.
-// It extends sendPairs to handle dual-coin transactions (VAR and SKA).
-func (s *Server) sendPairsWithCoinType(ctx context.Context, w *wallet.Wallet, amounts map[string]dcrutil.Amount, account uint32, minconf int32, coinType cointype.CoinType) (string, error) {
-	changeAccount := account
-	if s.cfg.MixingEnabled && s.cfg.MixAccount != "" && s.cfg.MixChangeAccount != "" {
-		mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
+	opts := &wallet.RecurringPaymentOptions{FailurePolicy: policy}
+	if cmd.Comment != nil {
+		opts.Label = *cmd.Comment
+	}
+	if cmd.IntervalBlocks != nil {
+		opts.IntervalBlocks = *cmd.IntervalBlocks
+	}
+	if cmd.Interval != nil {
+		opts.Interval, err = parseRecurringInterval(*cmd.Interval)
 		if err != nil {
-			return "", err
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"invalid interval: %v", err)
 		}
-		if account == mixAccount {
-			changeAccount, err = w.AccountNumber(ctx, s.cfg.MixChangeAccount)
-			if err != nil {
-				return "", err
-			}
+	}
+	if cmd.Start != nil {
+		switch {
+		case cmd.Interval != nil:
+			opts.StartTime = time.Unix(*cmd.Start, 0)
+		case *cmd.Start < 0 || *cmd.Start > math.MaxInt32:
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"start height out of range")
+		default:
+			opts.StartHeight = int32(*cmd.Start)
 		}
 	}
 
-	outputs, err := makeOutputsWithCoinType(amounts, w.ChainParams(), coinType)
+	atomsPerCoin := getAtomsPerCoin(w.ChainParams(), coinType)
+	amt, err := coinsToAtomsBig(cmd.Amount, atomsPerCoin)
 	if err != nil {
-		return "", err
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "invalid amount: %v", err)
+	}
+	if !coinType.IsSKA() && !amt.IsInt64() {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "amount out of range")
+	}
+	outputs, err := makeOutputsWithCoinTypeBig(map[string]*big.Int{cmd.Address: amt},
+		w.ChainParams(), coinType)
+	if err != nil {
+		return nil, err
 	}
 
-	// Use existing SendOutputs method (coin type is embedded in outputs)
-	txSha, err := w.SendOutputs(ctx, outputs, account, changeAccount, minconf)
+	p, err := w.AddRecurringPayment(ctx, outputs[0], account, minConf, opts)
 	if err != nil {
-		if errors.Is(err, errors.Locked) {
-			return "", errWalletUnlockNeeded
-		}
-		if errors.Is(err, errors.InsufficientBalance) {
-			return "", rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 		}
-		return "", err
+		return nil, err
 	}
-
-	return txSha.String(), nil
+	return recurringPaymentResult(w.ChainParams(), p, cmd.FromAccount), nil
 }
 
-// sendPairsWithCoinTypeBig creates and sends payment transactions with coin type support using big.Int amounts.
-// This is essential for SKA transactions where amounts can exceed int64.
-func (s *Server) sendPairsWithCoinTypeBig(ctx context.Context, w *wallet.Wallet, amounts map[string]*big.Int, account uint32, minconf int32, coinType cointype.CoinType) (string, error) {
-	changeAccount := account
-	if s.cfg.MixingEnabled && s.cfg.MixAccount != "" && s.cfg.MixChangeAccount != "" {
-		mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
-		if err != nil {
-			return "", err
-		}
-		if account == mixAccount {
-			changeAccount, err = w.AccountNumber(ctx, s.cfg.MixChangeAccount)
-			if err != nil {
-				return "", err
-			}
+// recurringPaymentResult returns the result describing a recurring payment
+// from the named account.
+func recurringPaymentResult(params *chaincfg.Params, p *udb.RecurringPayment,
+	accountName string) types.RecurringPaymentResult {
+
+	send := &p.Send
+	r := types.RecurringPaymentResult{
+		ID:             p.ID,
+		Account:        accountName,
+		CoinType:       uint8(send.CoinType),
+		Amount:         coinAmount(params, send.CoinType, send.Amount),
+		IntervalBlocks: p.IntervalBlocks,
+		Interval:       int64(p.Interval / time.Second),
+		NextHeight:     p.NextHeight,
+		FailurePolicy:  p.FailurePolicy.String(),
+		Paused:         p.Paused,
+		Executions:     p.Executions,
+		LastError:      p.LastError,
+		Label:          send.Label,
+		Created:        send.Created.Unix(),
+	}
+	if len(send.Outputs) == 1 {
+		out := send.Outputs[0]
+		_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, params)
+		if len(addrs) == 1 {
+			r.Address = addrs[0].String()
 		}
 	}
+	if !p.NextTime.IsZero() {
+		r.NextTime = p.NextTime.Unix()
+	}
+	if !p.LastAttempt.IsZero() {
+		r.LastAttempt = p.LastAttempt.Unix()
+	}
+	if p.LastTxHash != (chainhash.Hash{}) {
+		r.LastTxID = p.LastTxHash.String()
+	}
+	return r
+}
 
-	outputs, err := makeOutputsWithCoinTypeBig(amounts, w.ChainParams(), coinType)
-	if err != nil {
-		return "", err
+// listRecurringPayments handles a listrecurringpayments request by returning
+// every recurring payment.
+func (s *Server) listRecurringPayments(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	// Use existing SendOutputs method (coin type is embedded in outputs)
-	txSha, err := w.SendOutputs(ctx, outputs, account, changeAccount, minconf)
+	payments, err := w.RecurringPayments(ctx)
 	if err != nil {
-		if errors.Is(err, errors.Locked) {
-			return "", errWalletUnlockNeeded
-		}
-		if errors.Is(err, errors.InsufficientBalance) {
-			return "", rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+		return nil, err
+	}
+	res := make([]types.RecurringPaymentResult, 0, len(payments))
+	for i := range payments {
+		name, err := w.AccountName(ctx, payments[i].Send.Account)
+		if err != nil {
+			return nil, err
 		}
-		return "", err
+		res = append(res, recurringPaymentResult(w.ChainParams(), &payments[i], name))
 	}
+	return res, nil
+}
 
-	return txSha.String(), nil
+// pauseRecurringPayment handles a pauserecurringpayment request.
+func (s *Server) pauseRecurringPayment(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.PauseRecurringPaymentCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.PauseRecurringPayment(ctx, cmd.ID)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
 }
 
-// sendAmountToTreasury creates and sends payment transactions to the treasury.
-// It returns the transaction hash in string format upon success All errors are
-// returned in dcrjson.RPCError format
-func (s *Server) sendAmountToTreasury(ctx context.Context, w *wallet.Wallet, amount dcrutil.Amount, account uint32, minconf int32) (string, error) {
-	changeAccount := account
-	if s.cfg.MixingEnabled {
-		mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
-		if err != nil {
-			return "", err
-		}
-		if account == mixAccount {
-			changeAccount, err = w.AccountNumber(ctx,
-				s.cfg.MixChangeAccount)
-			if err != nil {
-				return "", err
-			}
-		}
+// resumeRecurringPayment handles a resumerecurringpayment request.
+func (s *Server) resumeRecurringPayment(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ResumeRecurringPaymentCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	outputs := []*wire.TxOut{
-		{
-			Value:    int64(amount),
-			PkScript: []byte{txscript.OP_TADD},
-			Version:  wire.DefaultPkScriptVersion,
-		},
+	err := w.ResumeRecurringPayment(ctx, cmd.ID)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 	}
-	txSha, err := w.SendOutputsToTreasury(ctx, outputs, account,
-		changeAccount, minconf)
-	if err != nil {
-		if errors.Is(err, errors.Locked) {
-			return "", errWalletUnlockNeeded
-		}
-		if errors.Is(err, errors.InsufficientBalance) {
-			return "", rpcError(dcrjson.ErrRPCWalletInsufficientFunds,
-				err)
-		}
-		return "", err
+	return nil, err
+}
+
+// removeRecurringPayment handles a removerecurringpayment request.
+func (s *Server) removeRecurringPayment(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RemoveRecurringPaymentCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	return txSha.String(), nil
+	err := w.RemoveRecurringPayment(ctx, cmd.ID)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
 }
 
-// sendOutputsFromTreasury creates and sends payment transactions from the treasury.
-// It returns the transaction hash in string format upon success All errors are
-// returned in dcrjson.RPCError format
-func (s *Server) sendOutputsFromTreasury(ctx context.Context, w *wallet.Wallet, cmd types.SendFromTreasuryCmd) (string, error) {
-	// Look to see if the we have the private key imported.
-	publicKey, err := decodeAddress(cmd.Key, w.ChainParams())
+// setInheritance handles a setinheritance request by configuring the
+// dead-man's switch of an account.
+func (s *Server) setInheritance(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetInheritanceCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
 	if err != nil {
-		return "", err
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
 	}
-	privKey, zero, err := w.LoadPrivateKey(ctx, publicKey)
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	defer zero()
-
-	_, tipHeight := w.MainChainTip(ctx)
+	if cmd.DelayDays > math.MaxUint32/(24*60*60) {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "delay out of range")
+	}
+	delay := time.Duration(cmd.DelayDays) * 24 * time.Hour
 
-	// OP_RETURN <8 Bytes ValueIn><24 byte random>. The encoded ValueIn is
-	// added at the end of this function.
-	var payload [32]byte
-	rand.Read(payload[8:])
-	builder := txscript.NewScriptBuilder()
-	builder.AddOp(txscript.OP_RETURN)
-	builder.AddData(payload[:])
-	opretScript, err := builder.Script()
+	sw, err := w.SetInheritance(ctx, account, addr, delay)
 	if err != nil {
-		return "", rpcErrorf(dcrjson.ErrRPCInternal.Code,
-			"sendOutputsFromTreasury NewScriptBuilder: %v", err)
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
 	}
-	msgTx := wire.NewMsgTx()
-	msgTx.Version = wire.TxVersionTreasury
-	msgTx.AddTxOut(wire.NewTxOut(0, opretScript))
+	return inheritanceResult(w.ChainParams(), sw, cmd.Account)
+}
 
-	// Calculate expiry.
-	msgTx.Expiry = blockchain.CalcTSpendExpiry(int64(tipHeight+1),
-		w.ChainParams().TreasuryVoteInterval,
-		w.ChainParams().TreasuryVoteIntervalMultiplier)
+// inheritanceResult returns the result describing the dead-man's switch of the
+// named account.
+func inheritanceResult(params *chaincfg.Params, sw *udb.InheritanceSwitch,
+	accountName string) (types.InheritanceResult, error) {
 
-	// OP_TGEN and calculate totals.
-	var totalPayout dcrutil.Amount
-	for address, amount := range cmd.Amounts {
-		amt, err := dcrutil.NewAmount(amount)
+	r := types.InheritanceResult{
+		Account:      accountName,
+		Delay:        int64(sw.Delay / time.Second),
+		LastError:    sw.LastError,
+		Transactions: make([]types.InheritanceTxResult, 0, len(sw.Txs)),
+	}
+	_, addrs := stdscript.ExtractAddrs(sw.ScriptVersion, sw.PkScript, params)
+	if len(addrs) == 1 {
+		r.Address = addrs[0].String()
+	}
+	if !sw.Refreshed.IsZero() {
+		r.Refreshed = sw.Refreshed.Unix()
+	}
+	for _, tx := range sw.Txs {
+		b, err := tx.Bytes()
 		if err != nil {
-			return "", rpcError(dcrjson.ErrRPCInvalidParameter, err)
-		}
+			return r, err
+		}
+		r.LockTime = tx.LockTime
+		out := tx.TxOut[0]
+		r.Transactions = append(r.Transactions, types.InheritanceTxResult{
+			CoinType: uint8(out.CoinType),
+			TxID:     tx.TxHash().String(),
+			Amount:   coinAmount(params, out.CoinType, sendOutputAmount(out)),
+			Hex:      hex.EncodeToString(b),
+		})
+	}
+	return r, nil
+}
 
-		// While looping calculate total amount
-		totalPayout += amt
+// getInheritance handles a getinheritance request by returning the dead-man's
+// switch of every account which has one.
+func (s *Server) getInheritance(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-		// Decode address.
-		addr, err := decodeStakeAddress(address, w.ChainParams())
+	switches, err := w.InheritanceSwitches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.InheritanceResult, 0, len(switches))
+	for i := range switches {
+		name, err := w.AccountName(ctx, switches[i].Account)
 		if err != nil {
-			return "", err
-		}
-
-		// Create OP_TGEN prefixed script.
-		vers, script := addr.PayFromTreasuryScript()
-
-		// Make sure this is not dust.
-		txOut := &wire.TxOut{
-			Value:    int64(amt),
-			Version:  vers,
-			PkScript: script,
+			return nil, err
 		}
-		if txrules.IsDustOutput(txOut, w.RelayFee()) {
-			return "", rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-				"Amount is dust: %v %v", addr, amt)
+		r, err := inheritanceResult(w.ChainParams(), &switches[i], name)
+		if err != nil {
+			return nil, err
 		}
-
-		// Add to transaction.
-		msgTx.AddTxOut(txOut)
+		res = append(res, r)
 	}
+	return res, nil
+}
 
-	// Calculate fee. Inputs are <signature> <compressed key> OP_TSPEND.
-	estimatedFee := txsizes.EstimateSerializeSize([]int{txsizes.TSPENDInputSize},
-		msgTx.TxOut, 0)
-	fee := txrules.FeeForSerializeSize(w.RelayFee(), estimatedFee)
-
-	// Assemble TxIn.
-	msgTx.AddTxIn(&wire.TxIn{
-		// Stakebase transactions have no inputs, so previous outpoint
-		// is zero hash and max index.
-		PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{},
-			wire.MaxPrevOutIndex, wire.TxTreeRegular),
-		Sequence:        wire.MaxTxInSequenceNum,
-		ValueIn:         int64(fee) + int64(totalPayout),
-		BlockHeight:     wire.NullBlockHeight,
-		BlockIndex:      wire.NullBlockIndex,
-		SignatureScript: []byte{}, // Empty for now
-	})
-
-	// Encode total amount in first 8 bytes of TxOut[0] OP_RETURN.
-	binary.LittleEndian.PutUint64(msgTx.TxOut[0].PkScript[2:2+8],
-		uint64(fee)+uint64(totalPayout))
+// clearInheritance handles a clearinheritance request by removing the
+// dead-man's switch of an account.
+func (s *Server) clearInheritance(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ClearInheritanceCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-	// Calculate TSpend signature without SigHashType.
-	privKeyBytes := privKey.Serialize()
-	sigscript, err := sign.TSpendSignatureScript(msgTx, privKeyBytes)
+	account, err := w.AccountNumber(ctx, cmd.Account)
 	if err != nil {
-		return "", err
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	err = w.ClearInheritance(ctx, account)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// blockAddress adds an address to the send policy blocklist.
+func (s *Server) blockAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.BlockAddressCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
-	msgTx.TxIn[0].SignatureScript = sigscript
 
-	_, _, err = stake.CheckTSpend(msgTx)
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	var reason string
+	if cmd.Reason != nil {
+		reason = *cmd.Reason
 	}
+	err = w.BlockAddress(ctx, addr, reason)
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
 
-	// Send to dcrd.
-	n, ok := s.walletLoader.NetworkBackend()
+// unblockAddress removes an address from the send policy blocklist.
+func (s *Server) unblockAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.UnblockAddressCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
-		return "", errNoNetwork
+		return nil, errUnloadedWallet
 	}
-	err = n.PublishTransactions(ctx, msgTx)
+
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	err = w.UnblockAddress(ctx, addr)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
 
-	return msgTx.TxHash().String(), nil
+// setLabelThreshold requires sends of at least an amount of a coin type to be
+// labeled.  A zero threshold removes the requirement.
+func (s *Server) setLabelThreshold(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetLabelThresholdCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	coinType := cointype.CoinTypeVAR
+	if cmd.CoinType != nil {
+		coinType = cointype.CoinType(*cmd.CoinType)
+	}
+	if err := validateCoinType(coinType); err != nil {
+		return nil, err
+	}
+	threshold, err := coinsToAtomsBig(cmd.Threshold, getAtomsPerCoin(w.ChainParams(), coinType))
+	if err != nil || threshold.Sign() < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"invalid threshold %q", cmd.Threshold)
+	}
+	err = w.SetLabelThreshold(ctx, coinType, threshold)
+	return nil, err
 }
 
-// treasuryPolicy returns voting policies for treasury spends by a particular
-// key.  If a key is specified, that policy is returned; otherwise the policies
-// for all keys are returned in an array.  If both a key and ticket hash are
-// provided, the per-ticket key policy is returned.
-func (s *Server) treasuryPolicy(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.TreasuryPolicyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// setSKASendAccounts restricts sends of an SKA coin type to the accounts.  No
+// accounts allows every account to send the coin type.
+func (s *Server) setSKASendAccounts(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetSKASendAccountsCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	var ticketHash *chainhash.Hash
-	if cmd.Ticket != nil && *cmd.Ticket != "" {
-		var err error
-		ticketHash, err = chainhash.NewHashFromStr(*cmd.Ticket)
+	coinType := cointype.CoinType(cmd.CoinType)
+	if err := validateCoinType(coinType); err != nil {
+		return nil, err
+	}
+	if !coinType.IsSKA() {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"coin type %d is not an SKA coin type", coinType)
+	}
+	accounts := make([]uint32, 0, len(cmd.Accounts))
+	for _, name := range cmd.Accounts {
+		account, err := w.AccountNumber(ctx, name)
 		if err != nil {
-			return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
 		}
+		accounts = append(accounts, account)
 	}
+	err := w.SetSKASendAccounts(ctx, coinType, accounts)
+	return nil, err
+}
 
-	if cmd.Key != nil && *cmd.Key != "" {
-		pikey, err := hex.DecodeString(*cmd.Key)
-		if err != nil {
-			return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
-		}
-		var policy string
-		switch w.TreasuryKeyPolicy(pikey, ticketHash) {
-		case stake.TreasuryVoteYes:
-			policy = "yes"
-		case stake.TreasuryVoteNo:
-			policy = "no"
-		default:
-			policy = "abstain"
-		}
-		res := &types.TreasuryPolicyResult{
-			Key:    *cmd.Key,
-			Policy: policy,
-		}
-		if cmd.Ticket != nil {
-			res.Ticket = *cmd.Ticket
-		}
-		return res, nil
+// getSendPolicy describes the policy evaluated before the wallet publishes a
+// send.
+func (s *Server) getSendPolicy(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	policies := w.TreasuryKeyPolicies()
-	res := make([]types.TreasuryPolicyResult, 0, len(policies))
-	for i := range policies {
-		var policy string
-		switch policies[i].Policy {
-		case stake.TreasuryVoteYes:
-			policy = "yes"
-		case stake.TreasuryVoteNo:
-			policy = "no"
-		}
-		r := types.TreasuryPolicyResult{
-			Key:    hex.EncodeToString(policies[i].PiKey),
-			Policy: policy,
+	policy, err := w.SendPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := w.ChainParams()
+	res := &types.SendPolicyResult{
+		Blocklist:       make([]types.BlockedAddressResult, 0, len(policy.Blocklist)),
+		LabelThresholds: make([]types.LabelThresholdResult, 0, len(policy.LabelThresholds)),
+		SKASendAccounts: make([]types.SKASendAccountsResult, 0, len(policy.SKASendAccounts)),
+	}
+	for addr, reason := range policy.Blocklist {
+		res.Blocklist = append(res.Blocklist, types.BlockedAddressResult{
+			Address: addr,
+			Reason:  reason,
+		})
+	}
+	sort.Slice(res.Blocklist, func(i, j int) bool {
+		return res.Blocklist[i].Address < res.Blocklist[j].Address
+	})
+	for ct, threshold := range policy.LabelThresholds {
+		res.LabelThresholds = append(res.LabelThresholds, types.LabelThresholdResult{
+			CoinType:  uint8(ct),
+			Threshold: coinAmount(params, ct, threshold),
+		})
+	}
+	sort.Slice(res.LabelThresholds, func(i, j int) bool {
+		return res.LabelThresholds[i].CoinType < res.LabelThresholds[j].CoinType
+	})
+	for ct, accounts := range policy.SKASendAccounts {
+		r := types.SKASendAccountsResult{
+			CoinType: uint8(ct),
+			Accounts: make([]string, 0, len(accounts)),
 		}
-		if policies[i].Ticket != nil {
-			r.Ticket = policies[i].Ticket.String()
+		for _, account := range accounts {
+			name, err := w.AccountName(ctx, account)
+			if err != nil {
+				return nil, err
+			}
+			r.Accounts = append(r.Accounts, name)
 		}
-		res = append(res, r)
+		res.SKASendAccounts = append(res.SKASendAccounts, r)
 	}
+	sort.Slice(res.SKASendAccounts, func(i, j int) bool {
+		return res.SKASendAccounts[i].CoinType < res.SKASendAccounts[j].CoinType
+	})
 	return res, nil
 }
 
-// setDisapprovePercent sets the wallet's disapprove percentage.
-func (s *Server) setDisapprovePercent(ctx context.Context, icmd any) (any, error) {
-	if s.activeNet.Net == wire.MainNet {
-		return nil, dcrjson.ErrInvalidRequest
+// setTicketCompounding opts an account in to or out of compounding its matured
+// SSFee rewards into tickets purchased by the ticket buyer.
+func (s *Server) setTicketCompounding(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetTicketCompoundingCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
-	cmd := icmd.(*types.SetDisapprovePercentCmd)
-	if cmd.Percent > 100 {
-		return nil, rpcError(dcrjson.ErrRPCInvalidParameter,
-			errors.New("percent must be from 0 to 100"))
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
 	}
-	w, ok := s.walletLoader.LoadedWallet()
+	return nil, w.SetTicketCompounding(ctx, account, cmd.Enable)
+}
+
+// ticketCompounding returns the matured SSFee rewards accrued, and not yet
+// compounded into tickets, by each account which has opted in to compounding.
+func (s *Server) ticketCompounding(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
-	w.SetDisapprovePercent(cmd.Percent)
-	return nil, nil
+
+	states, err := w.TicketCompounding(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.TicketCompoundingResult, 0, len(states))
+	for _, c := range states {
+		name, err := w.AccountName(ctx, c.Account)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, types.TicketCompoundingResult{
+			Account: name,
+			Accrued: c.Accrued.ToCoin(),
+			Height:  c.Height,
+		})
+	}
+	return res, nil
 }
 
 // setTreasuryPolicy saves the voting policy for treasury spends by a particular
@@ -4660,7 +7968,7 @@ func (s *Server) setDisapprovePercent(ctx context.Context, icmd any) (any, error
 // preferences will also be set with the VSP.
 func (s *Server) setTreasuryPolicy(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SetTreasuryPolicyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -4721,7 +8029,7 @@ func (s *Server) setTreasuryPolicy(ctx context.Context, icmd any) (any, error) {
 // the per-ticket tspend policy is returned.
 func (s *Server) tspendPolicy(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.TSpendPolicyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -4791,7 +8099,7 @@ func (s *Server) tspendPolicy(ctx context.Context, icmd any) (any, error) {
 // preferences will also be set with the VSP.
 func (s *Server) setTSpendPolicy(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SetTSpendPolicyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -4847,6 +8155,142 @@ func (s *Server) setTSpendPolicy(ctx context.Context, icmd any) (any, error) {
 	return nil, err
 }
 
+// recordPrice handles a recordprice request by recording the fiat price of a
+// coin type, which is reported for transactions mined at or after its time.
+func (s *Server) recordPrice(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RecordPriceCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	coinType := cointype.CoinType(cmd.CoinType)
+	if err := validateCoinType(coinType); err != nil {
+		return nil, err
+	}
+	price, err := fiatrate.ParseAmount(cmd.Price)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	t := time.Now()
+	if cmd.Time != nil {
+		t = time.Unix(*cmd.Time, 0)
+	}
+	err = w.RecordPrice(ctx, &udb.PriceSnapshot{
+		CoinType: coinType,
+		Time:     t,
+		Currency: strings.ToUpper(cmd.Currency),
+		Price:    price,
+	})
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// recoverUnspent handles a recoverunspent request by rescanning the block
+// chain for unspent outputs paying wallet addresses which are missing from the
+// wallet database, and describing the outputs which were reinserted.
+func (s *Server) recoverUnspent(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RecoverUnspentCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	n, ok := s.loader(ctx).NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+
+	var beginHeight int32
+	switch {
+	case cmd.BeginHeight != nil:
+		beginHeight = int32(*cmd.BeginHeight)
+	case cmd.FullScan == nil || !*cmd.FullScan:
+		_, height, err := w.BirthdayBlock(ctx)
+		if err != nil {
+			return nil, err
+		}
+		beginHeight = height
+	}
+
+	recovered, err := w.RecoverUnspent(ctx, n, beginHeight)
+	if err != nil {
+		return nil, err
+	}
+	params := w.ChainParams()
+	res := make([]types.RecoveredOutputResult, 0, len(recovered))
+	for i := range recovered {
+		out := &recovered[i]
+		acctName, err := w.AccountName(ctx, out.Account)
+		if err != nil {
+			return nil, err
+		}
+		atoms := big.NewInt(int64(out.Amount))
+		if out.CoinType.IsSKA() {
+			atoms = out.SKAAmount.BigInt()
+		}
+		r := types.RecoveredOutputResult{
+			TxID:     out.OutPoint.Hash.String(),
+			Vout:     out.OutPoint.Index,
+			Tree:     out.OutPoint.Tree,
+			Account:  acctName,
+			CoinType: uint8(out.CoinType),
+			Amount:   coinAmount(params, out.CoinType, atoms),
+			Height:   out.Height,
+			TxType:   int(out.TxType),
+			Coinbase: out.FromCoinBase,
+			Mature:   out.Mature,
+			Verified: out.Verified,
+		}
+		if out.Address != nil {
+			r.Address = out.Address.String()
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+// redeemContract handles a redeemcontract request by redeeming a contract
+// paying the wallet with its secret, or refunding a contract funded by the
+// wallet after its lock time when no secret is provided.
+func (s *Server) redeemContract(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RedeemContractCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	contractTx := new(wire.MsgTx)
+	err := contractTx.Deserialize(hex.NewDecoder(strings.NewReader(cmd.ContractTx)))
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
+	}
+	contract, err := decodeHexStr(cmd.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx *wire.MsgTx
+	if cmd.Secret != nil {
+		secret, err := decodeHexStr(*cmd.Secret)
+		if err != nil {
+			return nil, err
+		}
+		tx, err = w.RedeemContract(ctx, contractTx, contract, secret)
+	} else {
+		tx, err = w.RefundContract(ctx, contractTx, contract)
+	}
+	if errors.Is(err, errors.Invalid) || errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tx.TxHash().String(), nil
+}
+
 // redeemMultiSigOut receives a transaction hash/idx and fetches the first output
 // index or indices with known script hashes from the transaction. It then
 // construct a transaction with a single P2PKH paying to a specified address.
@@ -4854,7 +8298,7 @@ func (s *Server) setTSpendPolicy(ctx context.Context, icmd any) (any, error) {
 // the user to export to others to sign.
 func (s *Server) redeemMultiSigOut(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.RedeemMultiSigOutCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -4952,7 +8396,7 @@ func (s *Server) redeemMultiSigOut(ctx context.Context, icmd any) (any, error) {
 // addresses in this wallet.
 func (s *Server) redeemMultiSigOuts(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.RedeemMultiSigOutsCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -4971,52 +8415,310 @@ func (s *Server) redeemMultiSigOuts(ctx context.Context, icmd any) (any, error)
 	if err != nil {
 		return nil, err
 	}
-	max := uint32(0xffffffff)
-	if cmd.Number != nil {
-		max = uint32(*cmd.Number)
-	}
+	max := uint32(0xffffffff)
+	if cmd.Number != nil {
+		max = uint32(*cmd.Number)
+	}
+
+	itr := uint32(0)
+	rmsoResults := make([]types.RedeemMultiSigOutResult, len(msos))
+	for i, mso := range msos {
+		if itr > max {
+			break
+		}
+
+		rmsoRequest := &types.RedeemMultiSigOutCmd{
+			Hash:    mso.OutPoint.Hash.String(),
+			Index:   mso.OutPoint.Index,
+			Tree:    mso.OutPoint.Tree,
+			Address: cmd.ToAddress,
+		}
+		redeemResult, err := s.redeemMultiSigOut(ctx, rmsoRequest)
+		if err != nil {
+			return nil, err
+		}
+		redeemResultTyped := redeemResult.(types.RedeemMultiSigOutResult)
+		rmsoResults[i] = redeemResultTyped
+
+		itr++
+	}
+
+	return types.RedeemMultiSigOutsResult{Results: rmsoResults}, nil
+}
+
+// rescanWallet initiates a rescan of the block chain for wallet data, blocking
+// until the rescan completes or exits with an error.
+func (s *Server) rescanWallet(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RescanWalletCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	n, ok := s.loader(ctx).NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+
+	var beginHeight int32
+	switch {
+	case cmd.BeginHeight != nil:
+		beginHeight = int32(*cmd.BeginHeight)
+	case cmd.FullScan == nil || !*cmd.FullScan:
+		_, height, err := w.BirthdayBlock(ctx)
+		if err != nil {
+			return nil, err
+		}
+		beginHeight = height
+	}
+
+	err := w.RescanFromHeight(ctx, n, beginHeight)
+	return nil, err
+}
+
+// setWalletBirthday handles a setwalletbirthday request by setting the wallet
+// birthday from a block height or time.
+func (s *Server) setWalletBirthday(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetWalletBirthdayCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if cmd.Birthday < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "birthday must not be negative")
+	}
+	bs := new(udb.BirthdayState)
+	if cmd.Timestamp != nil && *cmd.Timestamp {
+		bs.Time = time.Unix(cmd.Birthday, 0)
+		bs.SetFromTime = true
+	} else {
+		if cmd.Birthday > math.MaxUint32 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "birthday height is too large")
+		}
+		bs.Height = uint32(cmd.Birthday)
+		bs.SetFromHeight = true
+	}
+	err := w.SetBirthday(ctx, bs)
+	return nil, err
+}
+
+// restoreWallet handles a restorewallet request by restoring the wallet
+// database from an encrypted backup and opening the restored wallet.  No wallet
+// may be loaded or exist in the wallet directory.
+func (s *Server) restoreWallet(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RestoreWalletCmd)
+
+	pubPassphrase := []byte(wallet.InsecurePubPassphrase)
+	if cmd.PubPassphrase != nil && *cmd.PubPassphrase != "" {
+		pubPassphrase = []byte(*cmd.PubPassphrase)
+	}
+
+	_, err := s.loader(ctx).RestoreWallet(ctx, cmd.Source,
+		[]byte(cmd.Passphrase), pubPassphrase)
+	switch {
+	case errors.Is(err, errors.Passphrase):
+		return nil, rpcError(dcrjson.ErrRPCWalletPassphraseIncorrect, err)
+	case errors.Is(err, errors.Exist), errors.Is(err, errors.Encoding):
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// importLegacyWallet handles an importlegacywallet request by importing an
+// upstream dcrwallet database and opening the imported wallet.  No wallet may
+// be loaded or exist in the wallet directory.
+func (s *Server) importLegacyWallet(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportLegacyWalletCmd)
+
+	pubPassphrase := []byte(wallet.InsecurePubPassphrase)
+	if cmd.PubPassphrase != nil && *cmd.PubPassphrase != "" {
+		pubPassphrase = []byte(*cmd.PubPassphrase)
+	}
+
+	_, imp, err := s.loader(ctx).ImportLegacyWallet(ctx, cmd.Source, pubPassphrase)
+	switch {
+	case errors.Is(err, errors.Passphrase):
+		return nil, rpcError(dcrjson.ErrRPCWalletPassphraseIncorrect, err)
+	case errors.Is(err, errors.Exist), errors.Is(err, errors.NotExist),
+		errors.Is(err, errors.Invalid):
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	case err != nil:
+		return nil, err
+	}
+	return &types.ImportLegacyWalletResult{
+		LegacyVersion:  imp.Legacy.Version,
+		Version:        imp.Imported.Version,
+		LastAccount:    imp.Imported.LastAccount,
+		Transactions:   imp.Imported.Transactions,
+		UnspentOutputs: imp.Imported.UnspentOutputs,
+		Balance:        imp.Imported.Unspent.ToCoin(),
+	}, nil
+}
+
+// createRPCCredential handles a createrpccredential request by recording a
+// credential which authenticates RPC clients with a set of scopes, and
+// returning its generated password.  Credentials are recorded by the default
+// wallet, whichever wallet the request selects.
+func (s *Server) createRPCCredential(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateRPCCredentialCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var scopes udb.RPCScopes
+	for _, name := range cmd.Scopes {
+		scope, err := udb.ParseRPCScope(name)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		scopes |= scope
+	}
+	if scopes == 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"at least one scope is required")
+	}
+
+	password, err := w.CreateRPCCredential(ctx, cmd.Username, scopes)
+	switch {
+	case errors.Is(err, errors.Exist), errors.Is(err, errors.Invalid):
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	case err != nil:
+		return nil, err
+	}
+	return &types.CreateRPCCredentialResult{
+		Username: cmd.Username,
+		Password: password,
+		Scopes:   scopes.Names(),
+	}, nil
+}
+
+// listRPCCredentials handles a listrpccredentials request by returning the
+// usernames and scopes of the RPC credentials recorded by the default wallet.
+func (s *Server) listRPCCredentials(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	creds, err := w.RPCCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.ListRPCCredentialsResult, 0, len(creds))
+	for _, c := range creds {
+		res = append(res, types.ListRPCCredentialsResult{
+			Username: c.Username,
+			Scopes:   c.Scopes.Names(),
+			Created:  c.Created.Unix(),
+		})
+	}
+	return res, nil
+}
+
+// revokeRPCCredential handles a revokerpccredential request by removing an
+// RPC credential recorded by the default wallet.
+func (s *Server) revokeRPCCredential(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RevokeRPCCredentialCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.RevokeRPCCredential(ctx, cmd.Username)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// createWallet handles a createwallet request by creating a new named wallet
+// from a seed.  The wallet is hosted alongside the default wallet and remains
+// loaded after it is created.
+func (s *Server) createWallet(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateWalletCmd)
+
+	nl, err := s.walletLoader.NamedLoader(cmd.Name)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	seed, err := decodeHexStr(cmd.Seed)
+	if err != nil {
+		return nil, err
+	}
+	pubPassphrase := []byte(wallet.InsecurePubPassphrase)
+	if cmd.PubPassphrase != nil && *cmd.PubPassphrase != "" {
+		pubPassphrase = []byte(*cmd.PubPassphrase)
+	}
+
+	_, err = nl.CreateNewWallet(ctx, pubPassphrase, []byte(cmd.Passphrase), seed)
+	switch {
+	case errors.Is(err, errors.Exist), errors.Is(err, errors.Invalid):
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// listWallets handles a listwallets request by returning the named wallets
+// hosted alongside the default wallet and whether each is loaded.
+func (s *Server) listWallets(ctx context.Context, icmd any) (any, error) {
+	names, err := s.walletLoader.NamedWallets()
+	if err != nil {
+		return nil, err
+	}
+	loaded := s.walletLoader.LoadedNamedWallets()
 
-	itr := uint32(0)
-	rmsoResults := make([]types.RedeemMultiSigOutResult, len(msos))
-	for i, mso := range msos {
-		if itr > max {
-			break
-		}
+	res := make([]types.ListWalletsResult, 0, len(names))
+	for _, name := range names {
+		res = append(res, types.ListWalletsResult{
+			Name:   name,
+			Loaded: slices.Contains(loaded, name),
+		})
+	}
+	return res, nil
+}
 
-		rmsoRequest := &types.RedeemMultiSigOutCmd{
-			Hash:    mso.OutPoint.Hash.String(),
-			Index:   mso.OutPoint.Index,
-			Tree:    mso.OutPoint.Tree,
-			Address: cmd.ToAddress,
-		}
-		redeemResult, err := s.redeemMultiSigOut(ctx, rmsoRequest)
-		if err != nil {
-			return nil, err
-		}
-		redeemResultTyped := redeemResult.(types.RedeemMultiSigOutResult)
-		rmsoResults[i] = redeemResultTyped
+// loadWallet handles a loadwallet request by opening an existing named
+// wallet.  Requests may select the wallet by name once it is loaded.
+func (s *Server) loadWallet(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.LoadWalletCmd)
 
-		itr++
+	nl, err := s.walletLoader.NamedLoader(cmd.Name)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	pubPassphrase := []byte(wallet.InsecurePubPassphrase)
+	if cmd.PubPassphrase != nil && *cmd.PubPassphrase != "" {
+		pubPassphrase = []byte(*cmd.PubPassphrase)
 	}
 
-	return types.RedeemMultiSigOutsResult{Results: rmsoResults}, nil
+	_, err = nl.OpenExistingWallet(ctx, pubPassphrase)
+	switch {
+	case errors.Is(err, errors.Passphrase):
+		return nil, rpcError(dcrjson.ErrRPCWalletPassphraseIncorrect, err)
+	case errors.Is(err, errors.Exist), errors.Is(err, errors.NotExist),
+		errors.Is(err, errors.Invalid):
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
 }
 
-// rescanWallet initiates a rescan of the block chain for wallet data, blocking
-// until the rescan completes or exits with an error.
-func (s *Server) rescanWallet(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.RescanWalletCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
-	}
+// unloadWallet handles an unloadwallet request by closing a loaded named
+// wallet.  The default wallet may not be unloaded.
+func (s *Server) unloadWallet(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.UnloadWalletCmd)
 
-	n, ok := s.walletLoader.NetworkBackend()
+	nl, ok := s.walletLoader.Named(cmd.Name)
 	if !ok {
-		return nil, errNoNetwork
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"wallet %q is not loaded", cmd.Name)
+	}
+	err := nl.UnloadWallet()
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"wallet %q is not loaded", cmd.Name)
 	}
-
-	err := w.RescanFromHeight(ctx, n, int32(*cmd.BeginHeight))
 	return nil, err
 }
 
@@ -5100,7 +8802,7 @@ func (a *accountChangeSource) ScriptSize() int {
 // pairs, with any change returned to the specified account.
 func (s *Server) spendOutputs(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SpendOutputsCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -5154,11 +8856,15 @@ func (s *Server) spendOutputs(ctx context.Context, icmd any) (any, error) {
 		account: account,
 	}
 
-	secretsSource, err := w.SecretsSource()
-	if err != nil {
-		return nil, err
+	var secrets txauthor.SecretsSource = w.ExternalSigner()
+	if secrets == nil {
+		secretsSource, err := w.SecretsSource()
+		if err != nil {
+			return nil, err
+		}
+		defer secretsSource.Close()
+		secrets = secretsSource
 	}
-	defer secretsSource.Close()
 
 	atx, err := txauthor.NewUnsignedTransaction(outputs, w.RelayFee(),
 		inputSource, changeSource, params.MaxTxSize)
@@ -5166,7 +8872,7 @@ func (s *Server) spendOutputs(ctx context.Context, icmd any) (any, error) {
 		return nil, err
 	}
 	atx.RandomizeChangePosition()
-	err = atx.AddAllInputScripts(secretsSource)
+	err = atx.AddAllInputScripts(secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -5180,7 +8886,7 @@ func (s *Server) spendOutputs(ctx context.Context, icmd any) (any, error) {
 
 func (s *Server) ticketInfo(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.TicketInfoCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -5247,6 +8953,12 @@ func (s *Server) ticketInfo(ctx context.Context, icmd any) (any, error) {
 			}
 			info.VSPHost = host
 
+			_, err = w.FeeRewardTicket(ctx, t.Ticket.Hash)
+			if err != nil && !errors.Is(err, errors.NotExist) {
+				return false, err
+			}
+			info.FeeRewardFunded = err == nil
+
 			res = append(res, info)
 		}
 		return false, nil
@@ -5266,7 +8978,7 @@ func isNilOrEmpty(s *string) bool {
 // the TxID for the created transaction is returned.
 func (s *Server) sendFrom(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SendFromCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -5280,10 +8992,11 @@ func (s *Server) sendFrom(ctx context.Context, icmd any) (any, error) {
 		}
 	}
 
-	// Transaction comments are not yet supported.  Error instead of
-	// pretending to save them.
-	if !isNilOrEmpty(cmd.Comment) || !isNilOrEmpty(cmd.CommentTo) {
-		return nil, rpcErrorf(dcrjson.ErrRPCUnimplemented, "transaction comments are unsupported")
+	// Comments are recorded as transaction labels.  There is nowhere to
+	// record a comment about the recipient.  Error instead of pretending to
+	// save it.
+	if !isNilOrEmpty(cmd.CommentTo) {
+		return nil, rpcErrorf(dcrjson.ErrRPCUnimplemented, "recipient comments are unsupported")
 	}
 
 	account, err := w.AccountNumber(ctx, cmd.FromAccount)
@@ -5300,6 +9013,19 @@ func (s *Server) sendFrom(ctx context.Context, icmd any) (any, error) {
 		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
 	}
 
+	opts := makeSendOptions(nil, cmd.Comment, cmd.Expiry, cmd.ExpireAfter, cmd.LockTime)
+	err = opts.setFeePreference(ctx, w, coinType, cmd.FeePreference)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fiat-denominated amounts are converted to coins at the current rate.
+	if cmd.FiatCurrency != nil {
+		amounts := map[string]string{cmd.ToAddress: cmd.Amount}
+		return s.sendFiat(ctx, w, coinType, *cmd.FiatCurrency, amounts,
+			account, minConf, opts)
+	}
+
 	// Convert coins to atoms using the correct AtomsPerCoin for this coin type
 	atomsPerCoin := getAtomsPerCoin(w.ChainParams(), coinType)
 
@@ -5312,7 +9038,7 @@ func (s *Server) sendFrom(ctx context.Context, icmd any) (any, error) {
 		pairsBig := map[string]*big.Int{
 			cmd.ToAddress: amtBig,
 		}
-		return s.sendPairsWithCoinTypeBig(ctx, w, pairsBig, account, minConf, coinType)
+		return opts.result(s.sendPairsWithCoinTypeBig(ctx, w, pairsBig, account, minConf, coinType, opts))
 	}
 
 	// For VAR (coinType == 0), parse string to float64 and use standard int64 path
@@ -5324,7 +9050,65 @@ func (s *Server) sendFrom(ctx context.Context, icmd any) (any, error) {
 	pairs := map[string]dcrutil.Amount{
 		cmd.ToAddress: amt,
 	}
-	return s.sendPairsWithCoinType(ctx, w, pairs, account, minConf, coinType)
+	return opts.result(s.sendPairsWithCoinType(ctx, w, pairs, account, minConf, coinType, opts))
+}
+
+// sendFromVault handles a sendfromvault request by spending the outputs of a
+// vault account which have reached the relative lock time of the vault.  The
+// transaction hash is returned.
+func (s *Server) sendFromVault(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SendFromVaultCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	coinType := cointype.CoinTypeVAR
+	if cmd.CoinType != nil {
+		coinType = cointype.CoinType(*cmd.CoinType)
+		if err := validateCoinType(coinType); err != nil {
+			return nil, err
+		}
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	atomsPerCoin := getAtomsPerCoin(w.ChainParams(), coinType)
+	pairs := make(map[string]*big.Int, len(cmd.Amounts))
+	for k, v := range cmd.Amounts {
+		amt, err := coinsToAtomsBig(v, atomsPerCoin)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "invalid amount for %s: %v", k, err)
+		}
+		if !coinType.IsSKA() && !amt.IsInt64() {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "amount for %s out of range", k)
+		}
+		pairs[k] = amt
+	}
+	outputs, err := makeOutputsWithCoinTypeBig(pairs, w.ChainParams(), coinType)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := w.SendFromVault(ctx, account, outputs)
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.Invalid):
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		case errors.Is(err, errors.InsufficientBalance):
+			return nil, rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+		case errors.Is(err, errors.Locked):
+			return nil, errWalletUnlockNeeded
+		}
+		return nil, err
+	}
+	return tx.TxHash().String(), nil
 }
 
 // sendMany handles a sendmany RPC request by creating a new transaction
@@ -5335,7 +9119,7 @@ func (s *Server) sendFrom(ctx context.Context, icmd any) (any, error) {
 // Supports optional coin type for dual-coin operations.
 func (s *Server) sendMany(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SendManyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -5349,12 +9133,6 @@ func (s *Server) sendMany(ctx context.Context, icmd any) (any, error) {
 		}
 	}
 
-	// Transaction comments are not yet supported.  Error instead of
-	// pretending to save them.
-	if !isNilOrEmpty(cmd.Comment) {
-		return nil, rpcErrorf(dcrjson.ErrRPCUnimplemented, "transaction comments are unsupported")
-	}
-
 	account, err := w.AccountNumber(ctx, cmd.FromAccount)
 	if err != nil {
 		return nil, err
@@ -5366,6 +9144,32 @@ func (s *Server) sendMany(ctx context.Context, icmd any) (any, error) {
 		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
 	}
 
+	var subtractFeeFrom []string
+	if cmd.SubtractFeeFrom != nil {
+		subtractFeeFrom = *cmd.SubtractFeeFrom
+	}
+	opts := makeSendOptions(subtractFeeFrom, cmd.Comment, cmd.Expiry, cmd.ExpireAfter, cmd.LockTime)
+	err = opts.setFeePreference(ctx, w, coinType, cmd.FeePreference)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.Data != nil {
+		data, err := hex.DecodeString(*cmd.Data)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCDecodeHexString, "data: %v", err)
+		}
+		opts.nullData, err = wallet.NullDataOutput(data, coinType)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+	}
+
+	// Fiat-denominated amounts are converted to coins at the current rate.
+	if cmd.FiatCurrency != nil {
+		return s.sendFiat(ctx, w, coinType, *cmd.FiatCurrency, cmd.Amounts,
+			account, minConf, opts)
+	}
+
 	// Get the correct AtomsPerCoin for this coin type
 	atomsPerCoin := getAtomsPerCoin(w.ChainParams(), coinType)
 
@@ -5379,7 +9183,7 @@ func (s *Server) sendMany(ctx context.Context, icmd any) (any, error) {
 			}
 			pairsBig[k] = amtBig
 		}
-		return s.sendPairsWithCoinTypeBig(ctx, w, pairsBig, account, minConf, coinType)
+		return opts.result(s.sendPairsWithCoinTypeBig(ctx, w, pairsBig, account, minConf, coinType, opts))
 	}
 
 	// For VAR (coinType == 0), parse string amounts to float64 and use standard int64 path
@@ -5392,7 +9196,7 @@ func (s *Server) sendMany(ctx context.Context, icmd any) (any, error) {
 		amt := dcrutil.Amount(coinsToAtoms(amtFloat, atomsPerCoin))
 		pairs[k] = amt
 	}
-	return s.sendPairsWithCoinType(ctx, w, pairs, account, minConf, coinType)
+	return opts.result(s.sendPairsWithCoinType(ctx, w, pairs, account, minConf, coinType, opts))
 }
 
 // sendToAddress handles a sendtoaddress RPC request by creating a new
@@ -5402,7 +9206,7 @@ func (s *Server) sendMany(ctx context.Context, icmd any) (any, error) {
 // the TxID for the created transaction is returned. Supports optional coin type.
 func (s *Server) sendToAddress(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SendToAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -5416,10 +9220,11 @@ func (s *Server) sendToAddress(ctx context.Context, icmd any) (any, error) {
 		}
 	}
 
-	// Transaction comments are not yet supported.  Error instead of
-	// pretending to save them.
-	if !isNilOrEmpty(cmd.Comment) || !isNilOrEmpty(cmd.CommentTo) {
-		return nil, rpcErrorf(dcrjson.ErrRPCUnimplemented, "transaction comments are unsupported")
+	// Comments are recorded as transaction labels.  There is nowhere to
+	// record a comment about the recipient.  Error instead of pretending to
+	// save it.
+	if !isNilOrEmpty(cmd.CommentTo) {
+		return nil, rpcErrorf(dcrjson.ErrRPCUnimplemented, "recipient comments are unsupported")
 	}
 
 	// Convert coins to atoms using the correct AtomsPerCoin for this coin type
@@ -5430,6 +9235,24 @@ func (s *Server) sendToAddress(ctx context.Context, icmd any) (any, error) {
 		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative amount")
 	}
 
+	var subtractFeeFrom []string
+	if cmd.SubtractFeeFromAmount != nil && *cmd.SubtractFeeFromAmount {
+		subtractFeeFrom = []string{cmd.Address}
+	}
+	opts := makeSendOptions(subtractFeeFrom, cmd.Comment, cmd.Expiry, cmd.ExpireAfter, cmd.LockTime)
+	err := opts.setFeePreference(ctx, w, coinType, cmd.FeePreference)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fiat-denominated amounts are converted to coins at the current rate.
+	if cmd.FiatCurrency != nil {
+		// sendtoaddress always spends from the default account, this matches bitcoind
+		amounts := map[string]string{cmd.Address: cmd.Amount}
+		return s.sendFiat(ctx, w, coinType, *cmd.FiatCurrency, amounts,
+			udb.DefaultAccountNum, 1, opts)
+	}
+
 	// For SKA transactions (coinType > 0), use big.Int to avoid int64 overflow
 	if coinType.IsSKA() {
 		amtBig, err := coinsToAtomsBig(cmd.Amount, atomsPerCoin)
@@ -5440,7 +9263,7 @@ func (s *Server) sendToAddress(ctx context.Context, icmd any) (any, error) {
 			cmd.Address: amtBig,
 		}
 		// sendtoaddress always spends from the default account, this matches bitcoind
-		return s.sendPairsWithCoinTypeBig(ctx, w, pairsBig, udb.DefaultAccountNum, 1, coinType)
+		return opts.result(s.sendPairsWithCoinTypeBig(ctx, w, pairsBig, udb.DefaultAccountNum, 1, coinType, opts))
 	}
 
 	// For VAR (coinType == 0), parse string to float64 and use standard int64 path
@@ -5453,7 +9276,7 @@ func (s *Server) sendToAddress(ctx context.Context, icmd any) (any, error) {
 		cmd.Address: amt,
 	}
 	// sendtoaddress always spends from the default account, this matches bitcoind
-	return s.sendPairsWithCoinType(ctx, w, pairs, udb.DefaultAccountNum, 1, coinType)
+	return opts.result(s.sendPairsWithCoinType(ctx, w, pairs, udb.DefaultAccountNum, 1, coinType, opts))
 }
 
 // sendToMultiSig handles a sendtomultisig RPC request by creating a new
@@ -5469,7 +9292,7 @@ func (s *Server) sendToAddress(ctx context.Context, icmd any) (any, error) {
 // TODO Use with non-default accounts as well
 func (s *Server) sendToMultiSig(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SendToMultiSigCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -5499,7 +9322,7 @@ func (s *Server) sendToMultiSig(ctx context.Context, icmd any) (any, error) {
 		RedeemScript: hex.EncodeToString(script),
 	}
 
-	log.Infof("Successfully sent funds to multisignature output in "+
+	logCtx(ctx).Infof("Successfully sent funds to multisignature output in "+
 		"transaction %v", tx.MsgTx.TxHash().String())
 
 	return result, nil
@@ -5509,7 +9332,7 @@ func (s *Server) sendToMultiSig(ctx context.Context, icmd any) (any, error) {
 // transaction and sending it to the network backend for propagation.
 func (s *Server) sendRawTransaction(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SendRawTransactionCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -5550,7 +9373,7 @@ func (s *Server) sendRawTransaction(ctx context.Context, icmd any) (any, error)
 // for the created transaction is returned.
 func (s *Server) sendToTreasury(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SendToTreasuryCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -5565,15 +9388,22 @@ func (s *Server) sendToTreasury(ctx context.Context, icmd any) (any, error) {
 		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative amount")
 	}
 
-	// sendtotreasury always spends from the default account.
-	return s.sendAmountToTreasury(ctx, w, amt, udb.DefaultAccountNum, 1)
+	account, err := w.AccountNumber(ctx, *cmd.FromAccount)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	return s.sendAmountToTreasury(ctx, w, amt, account)
 }
 
 // transaction spending treasury balance.
 // Upon success, the TxID for the created transaction is returned.
 func (s *Server) sendFromTreasury(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SendFromTreasuryCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -5588,7 +9418,7 @@ func (s *Server) sendFromTreasury(ctx context.Context, icmd any) (any, error) {
 // Upon success, the TxID for the created burn transaction is returned.
 func (s *Server) sendToBurn(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SendToBurnCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -5688,7 +9518,7 @@ func (s *Server) sendToBurn(ctx context.Context, icmd any) (any, error) {
 // setTxFee sets the transaction fee per kilobyte added to transactions.
 func (s *Server) setTxFee(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SetTxFeeCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -5730,7 +9560,7 @@ func (s *Server) setTxFee(ctx context.Context, icmd any) (any, error) {
 // preferences will also be set with the VSP.
 func (s *Server) setVoteChoice(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SetVoteChoiceCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -5818,7 +9648,7 @@ func (s *Server) updateVSPVoteChoices(ctx context.Context, w *wallet.Wallet, tic
 // address
 func (s *Server) signMessage(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SignMessageCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -5846,7 +9676,7 @@ func (s *Server) signMessage(ctx context.Context, icmd any) (any, error) {
 // variant.  It must be checked before all usage.
 func (s *Server) signRawTransaction(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SignRawTransactionCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -5902,18 +9732,10 @@ func (s *Server) signRawTransaction(ctx context.Context, icmd any) (any, error)
 			return nil, err
 		}
 
-		// redeemScript is only actually used iff the user provided
-		// private keys. In which case, it is used to get the scripts
-		// for signing. If the user did not provide keys then we always
-		// get scripts from the wallet.
-		// Empty strings are ok for this one and hex.DecodeString will
-		// DTRT.
-		// Note that redeemScript is NOT only the redeemscript
-		// required to be appended to the end of a P2SH output
-		// spend, but the entire signature script for spending
-		// *any* outpoint with dummy values inserted into it
-		// that can later be replacing by txscript's sign.
-		if cmd.PrivKeys != nil && len(*cmd.PrivKeys) != 0 {
+		// The redeem script is used to sign inputs spending the P2SH
+		// output of the script, taking precedence over any redeem script
+		// known to the wallet.
+		if rti.RedeemScript != "" {
 			redeemScript, err := decodeHexStr(rti.RedeemScript)
 			if err != nil {
 				return nil, err
@@ -5926,11 +9748,20 @@ func (s *Server) signRawTransaction(ctx context.Context, icmd any) (any, error)
 			}
 			scripts[addr.String()] = redeemScript
 		}
-		inputs[wire.OutPoint{
+		outPoint := wire.OutPoint{
 			Hash:  *inputSha,
 			Tree:  rti.Tree,
 			Index: rti.Vout,
-		}] = script
+		}
+		inputs[outPoint] = script
+
+		// Record the provided value of the previous output in the inputs
+		// spending it, which is needed to sign for outputs of other
+		// parties that the wallet and network backend may not know of.
+		err = setRawTxInputValue(tx, outPoint, &rti)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Now we go and look for any inputs that we were not provided by
@@ -5940,7 +9771,7 @@ func (s *Server) signRawTransaction(ctx context.Context, icmd any) (any, error)
 	requested := make(map[wire.OutPoint]*dcrdtypes.GetTxOutResult)
 	var requestedMu sync.Mutex
 	requestedGroup, gctx := errgroup.WithContext(ctx)
-	n, _ := s.walletLoader.NetworkBackend()
+	n, _ := s.loader(ctx).NetworkBackend()
 	if chainSyncer, ok := n.(*chain.Syncer); ok {
 		for i, txIn := range tx.TxIn {
 			// We don't need the first input of a stakebase tx, as it's garbage
@@ -6039,12 +9870,55 @@ func (s *Server) signRawTransaction(ctx context.Context, icmd any) (any, error)
 	// all scripts will run to completion. This is returned as part of the
 	// reply.
 	signErrs, signErr := w.SignTransaction(ctx, tx, hashType, inputs, keys, scripts)
+	return signRawTransactionResult(tx, signErrs, signErr == nil)
+}
+
+// setRawTxInputValue sets the value-in of transaction inputs spending a
+// previous output to the VAR amount or SKA value of a signrawtransaction input.
+func setRawTxInputValue(tx *wire.MsgTx, outPoint wire.OutPoint, rti *types.RawTxInput) error {
+	if rti.Amount == nil && rti.SKAValueIn == nil {
+		return nil
+	}
+	if rti.Amount != nil && rti.SKAValueIn != nil {
+		return rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"input %v: amount and skaValueIn may not both be set", &outPoint)
+	}
+	var valueIn int64
+	var skaValueIn *big.Int
+	if rti.Amount != nil {
+		amount, err := dcrutil.NewAmount(*rti.Amount)
+		if err != nil || amount < 0 {
+			return rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"input %v: invalid amount %v", &outPoint, *rti.Amount)
+		}
+		valueIn = int64(amount)
+	} else {
+		var ok bool
+		skaValueIn, ok = new(big.Int).SetString(*rti.SKAValueIn, 10)
+		if !ok || skaValueIn.Sign() <= 0 {
+			return rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"input %v: invalid skaValueIn %q", &outPoint, *rti.SKAValueIn)
+		}
+	}
+	for _, txIn := range tx.TxIn {
+		if txIn.PreviousOutPoint != outPoint {
+			continue
+		}
+		txIn.ValueIn = valueIn
+		txIn.SKAValueIn = skaValueIn
+	}
+	return nil
+}
 
+// signRawTransactionResult describes a signed transaction and the inputs which
+// could not be signed.  The transaction is only complete if signing succeeded
+// and there are no signature errors.
+func signRawTransactionResult(tx *wire.MsgTx, signErrs []wallet.SignatureError, signed bool) (types.SignRawTransactionResult, error) {
 	var b strings.Builder
 	b.Grow(2 * tx.SerializeSize())
-	err = tx.Serialize(hex.NewEncoder(&b))
+	err := tx.Serialize(hex.NewEncoder(&b))
 	if err != nil {
-		return nil, err
+		return types.SignRawTransactionResult{}, err
 	}
 
 	signErrors := make([]types.SignRawTransactionError, 0, len(signErrs))
@@ -6061,11 +9935,35 @@ func (s *Server) signRawTransaction(ctx context.Context, icmd any) (any, error)
 
 	return types.SignRawTransactionResult{
 		Hex:      b.String(),
-		Complete: len(signErrors) == 0 && signErr == nil,
+		Complete: len(signErrors) == 0 && signed,
 		Errors:   signErrors,
 	}, nil
 }
 
+// signRawTransactionOffline handles the signrawtransactionoffline command,
+// signing a transaction encoded by createunsignedtransactionfile.
+func (s *Server) signRawTransactionOffline(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SignRawTransactionOfflineCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	tx, signErrs, err := w.SignTransactionFile(ctx, []byte(cmd.File))
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.Locked):
+			return nil, errWalletUnlockNeeded
+		case errors.Is(err, errors.Encoding):
+			return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
+		case errors.Is(err, errors.Invalid):
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return signRawTransactionResult(tx, signErrs, true)
+}
+
 // signRawTransactions handles the signrawtransactions command.
 func (s *Server) signRawTransactions(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SignRawTransactionsCmd)
@@ -6093,7 +9991,7 @@ func (s *Server) signRawTransactions(ctx context.Context, icmd any) (any, error)
 	toReturn := make([]types.SignedTransaction, len(cmd.RawTxs))
 
 	if *cmd.Send {
-		n, ok := s.walletLoader.NetworkBackend()
+		n, ok := s.loader(ctx).NetworkBackend()
 		if !ok {
 			return nil, errNoNetwork
 		}
@@ -6183,13 +10081,22 @@ func sumOutputValues(outputs []*wire.TxOut) (totalOutput dcrutil.Amount) {
 // sweepAccount handles the sweepaccount command.
 func (s *Server) sweepAccount(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SweepAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	// use provided fee per Kb if specified
-	feePerKb := w.RelayFee()
+	coinType := cointype.CoinTypeVAR
+	if cmd.CoinType != nil {
+		coinType = cointype.CoinType(*cmd.CoinType)
+		if err := validateCoinType(coinType); err != nil {
+			return nil, err
+		}
+	}
+
+	// use provided fee per Kb if specified, or the wallet's fee rate for
+	// the coin type otherwise
+	var feePerKb dcrutil.Amount
 	if cmd.FeePerKb != nil {
 		var err error
 		feePerKb, err = dcrutil.NewAmount(*cmd.FeePerKb)
@@ -6215,12 +10122,12 @@ func (s *Server) sweepAccount(ctx context.Context, icmd any) (any, error) {
 		return nil, err
 	}
 
-	changeSource, err := makeScriptChangeSource(cmd.DestinationAddress, w.ChainParams())
+	destAddr, err := decodeAddress(cmd.DestinationAddress, w.ChainParams())
 	if err != nil {
-		return nil, err
+		return nil, rpcError(dcrjson.ErrRPCInvalidAddressOrKey, err)
 	}
-	tx, err := w.NewUnsignedTransaction(ctx, nil, feePerKb, account,
-		requiredConfs, wallet.OutputSelectionAlgorithmAll, changeSource, nil)
+	tx, err := w.NewUnsignedSweepTransaction(ctx, account, coinType, destAddr,
+		feePerKb, requiredConfs)
 	if err != nil {
 		if errors.Is(err, errors.InsufficientBalance) {
 			return nil, rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
@@ -6241,6 +10148,15 @@ func (s *Server) sweepAccount(ctx context.Context, icmd any) (any, error) {
 		TotalOutputAmount:         sumOutputValues(tx.Tx.TxOut).ToCoin(),
 		EstimatedSignedSize:       uint32(tx.EstimatedSignedSerializeSize),
 	}
+	if coinType.IsSKA() {
+		// SKA amounts are reported with the precision of the float
+		// result fields.
+		atomsPerCoin := getAtomsPerCoin(w.ChainParams(), coinType)
+		res.TotalPreviousOutputAmount, _ = strconv.ParseFloat(
+			atomsToCoinsBig(tx.SKATotalInput.BigInt(), atomsPerCoin), 64)
+		res.TotalOutputAmount, _ = strconv.ParseFloat(
+			atomsToCoinsBig(tx.Tx.TxOut[0].SKAValue, atomsPerCoin), 64)
+	}
 
 	return res, nil
 }
@@ -6248,7 +10164,7 @@ func (s *Server) sweepAccount(ctx context.Context, icmd any) (any, error) {
 // validateAddress handles the validateaddress command.
 func (s *Server) validateAddress(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.ValidateAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6333,7 +10249,7 @@ func (s *Server) validateAddress(ctx context.Context, icmd any) (any, error) {
 
 // validatePreDCP0005CF handles the validatepredcp0005cf command.
 func (s *Server) validatePreDCP0005CF(ctx context.Context, icmd any) (any, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6347,31 +10263,164 @@ func (s *Server) validatePreDCP0005CF(ctx context.Context, icmd any) (any, error
 func (s *Server) verifyMessage(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.VerifyMessageCmd)
 
-	var valid bool
+	var valid bool
+
+	// Decode address and base64 signature from the request.
+	addr, err := stdaddr.DecodeAddress(cmd.Address, s.activeNet)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := base64.StdEncoding.DecodeString(cmd.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	// Addresses must have an associated secp256k1 private key and must be P2PKH
+	// (P2PK and P2SH is not allowed).
+	switch addr.(type) {
+	case *stdaddr.AddressPubKeyHashEcdsaSecp256k1V0:
+	case *stdaddr.AddressPubKeyHashSchnorrSecp256k1V0:
+	default:
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"address must be secp256k1 pay-to-pubkey-hash")
+	}
+
+	valid, err = wallet.VerifyMessage(cmd.Message, addr, sig, s.activeNet)
+	// Mirror Bitcoin Core behavior, which treats all erorrs as an invalid
+	// signature.
+	return err == nil && valid, nil
+}
+
+// createOwnershipProof handles a createownershipproof request by signing a
+// proof of ownership of wallet outputs committing to a challenge.
+func (s *Server) createOwnershipProof(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateOwnershipProofCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	outpoints := make([]wire.OutPoint, 0, len(cmd.Outputs))
+	for _, input := range cmd.Outputs {
+		txHash, err := chainhash.NewHashFromStr(input.Txid)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+		}
+		outpoints = append(outpoints, wire.OutPoint{
+			Hash:  *txHash,
+			Index: input.Vout,
+			Tree:  input.Tree,
+		})
+	}
+
+	p, err := w.OwnershipProof(ctx, cmd.Challenge, outpoints)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) || errors.Is(err, errors.NotExist) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	b, err := p.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	outputs, totals := ownershipProofOutputs(w.ChainParams(), p)
+	return &types.CreateOwnershipProofResult{
+		Proof:     hex.EncodeToString(b),
+		Challenge: p.Challenge,
+		Outputs:   outputs,
+		Totals:    totals,
+	}, nil
+}
+
+// ownershipProofOutputs returns the results describing the outputs proven by
+// an ownership proof and their total value of each coin type.
+func ownershipProofOutputs(params *chaincfg.Params, p *ownership.Proof) (
+	[]types.OwnershipProofOutputResult, []types.OwnershipProofTotalResult) {
+
+	outputs := make([]types.OwnershipProofOutputResult, len(p.PrevOuts))
+	for i, out := range p.PrevOuts {
+		op := &p.Tx.TxIn[i+1].PreviousOutPoint
+		outputs[i] = types.OwnershipProofOutputResult{
+			TxID:     op.Hash.String(),
+			Vout:     op.Index,
+			Tree:     op.Tree,
+			CoinType: uint8(out.CoinType),
+			Amount:   coinAmount(params, out.CoinType, sendOutputAmount(out)),
+		}
+		_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, params)
+		if len(addrs) == 1 {
+			outputs[i].Address = addrs[0].String()
+		}
+	}
+	totals := p.Totals()
+	coinTypes := make([]cointype.CoinType, 0, len(totals))
+	for ct := range totals {
+		coinTypes = append(coinTypes, ct)
+	}
+	slices.Sort(coinTypes)
+	totalResults := make([]types.OwnershipProofTotalResult, len(coinTypes))
+	for i, ct := range coinTypes {
+		totalResults[i] = types.OwnershipProofTotalResult{
+			CoinType: uint8(ct),
+			Amount:   coinAmount(params, ct, totals[ct]),
+		}
+	}
+	return outputs, totalResults
+}
+
+// verifyOwnershipProof handles a verifyownershipproof request by verifying
+// the signatures of an ownership proof and its commitment to a challenge.  The
+// proven outputs are not checked to be unspent.
+func (s *Server) verifyOwnershipProof(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.VerifyOwnershipProofCmd)
 
-	// Decode address and base64 signature from the request.
-	addr, err := stdaddr.DecodeAddress(cmd.Address, s.activeNet)
+	b, err := hex.DecodeString(cmd.Proof)
 	if err != nil {
-		return nil, err
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
 	}
-	sig, err := base64.StdEncoding.DecodeString(cmd.Signature)
+	p, err := ownership.Parse(b)
 	if err != nil {
-		return nil, err
+		return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
 	}
 
-	// Addresses must have an associated secp256k1 private key and must be P2PKH
-	// (P2PK and P2SH is not allowed).
-	switch addr.(type) {
-	case *stdaddr.AddressPubKeyHashEcdsaSecp256k1V0:
+	outputs, totals := ownershipProofOutputs(s.activeNet, p)
+	res := &types.VerifyOwnershipProofResult{
+		Challenge: p.Challenge,
+		Outputs:   outputs,
+		Totals:    totals,
+	}
+	switch err := p.Verify(); {
+	case p.Challenge != cmd.Challenge:
+		res.Error = "proof is for a different challenge"
+	case err != nil:
+		res.Error = err.Error()
 	default:
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-			"address must be secp256k1 pay-to-pubkey-hash")
+		res.Valid = true
 	}
+	return res, nil
+}
 
-	valid, err = wallet.VerifyMessage(cmd.Message, addr, sig, s.activeNet)
-	// Mirror Bitcoin Core behavior, which treats all erorrs as an invalid
-	// signature.
-	return err == nil && valid, nil
+// verifySeed handles the verifyseed command by checking a mnemonic seed backup
+// against the wallet seed.  Incorrect word positions are zero-based.
+func (s *Server) verifySeed(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.VerifySeedCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	matches, incorrect, err := w.VerifySeedBackup(ctx, strings.Fields(cmd.Mnemonic))
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.VerifySeedResult{
+		Matches:        matches,
+		IncorrectWords: incorrect,
+	}, nil
 }
 
 // version handles the version command by returning the RPC API versions of the
@@ -6380,7 +10429,7 @@ func (s *Server) verifyMessage(ctx context.Context, icmd any) (any, error) {
 // function for the versionWithChainRPC and versionNoChainRPC handlers.
 func (s *Server) version(ctx context.Context, icmd any) (any, error) {
 	resp := make(map[string]dcrdtypes.VersionResult)
-	n, _ := s.walletLoader.NetworkBackend()
+	n, _ := s.loader(ctx).NetworkBackend()
 	if chainSyncer, ok := n.(*chain.Syncer); ok {
 		err := chainSyncer.RPC().Call(ctx, "version", &resp)
 		if err != nil {
@@ -6405,11 +10454,69 @@ func (s *Server) version(ctx context.Context, icmd any) (any, error) {
 	return resp, nil
 }
 
+// walletAudit handles the walletaudit command by describing the derivation
+// path and usage of every derived wallet address.
+func (s *Server) walletAudit(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	audits, err := w.AuditAddresses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	coinType, err := w.CoinType(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := w.ChainParams()
+	accountNames := make(map[uint32]string)
+	res := make([]types.WalletAuditResult, 0, len(audits))
+	for _, a := range audits {
+		name, ok := accountNames[a.Account]
+		if !ok {
+			name, err = w.AccountName(ctx, a.Account)
+			if err != nil {
+				return nil, err
+			}
+			accountNames[a.Account] = name
+		}
+		r := types.WalletAuditResult{
+			Address:        a.Address.String(),
+			Account:        a.Account,
+			AccountName:    name,
+			Branch:         a.Branch,
+			Index:          a.Child,
+			FirstUseHeight: a.FirstUseHeight,
+			LastUseHeight:  a.LastUseHeight,
+			Received:       make([]types.WalletAuditReceived, 0, len(a.Received)),
+			UTXOs:          a.UTXOs,
+		}
+		if a.Account <= udb.MaxAccountNum {
+			r.Path = fmt.Sprintf("m/44'/%d'/%d'/%d/%d", coinType,
+				a.Account, a.Branch, a.Child)
+		}
+		for ct, atoms := range a.Received {
+			r.Received = append(r.Received, types.WalletAuditReceived{
+				CoinType: uint8(ct),
+				Amount:   coinAmount(params, ct, atoms),
+			})
+		}
+		sort.Slice(r.Received, func(i, j int) bool {
+			return r.Received[i].CoinType < r.Received[j].CoinType
+		})
+		res = append(res, r)
+	}
+	return res, nil
+}
+
 // walletInfo gets the current information about the wallet. If the daemon
 // is connected and fails to ping, the function will still return that the
 // daemon is disconnected.
 func (s *Server) walletInfo(ctx context.Context, icmd any) (any, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6425,14 +10532,14 @@ func (s *Server) walletInfo(ctx context.Context, icmd any) (any, error) {
 			return nil, ctx.Err()
 		}
 		if err != nil {
-			log.Warnf("Ping failed on connected daemon client: %v", err)
+			logCtx(ctx).Warnf("Ping failed on connected daemon client: %v", err)
 		} else {
 			connected = true
 		}
 	case nil:
-		log.Warnf("walletInfo - no network backend")
+		logCtx(ctx).Warnf("walletInfo - no network backend")
 	default:
-		log.Errorf("walletInfo - invalid network backend (%T).", n)
+		logCtx(ctx).Errorf("walletInfo - invalid network backend (%T).", n)
 		return nil, &dcrjson.RPCError{
 			Code:    dcrjson.ErrRPCMisc,
 			Message: "invalid network backend",
@@ -6444,9 +10551,9 @@ func (s *Server) walletInfo(ctx context.Context, icmd any) (any, error) {
 		// This is a watching-only wallet, which does not store the active coin
 		// type. Return CoinTypes default value (0), which will be omitted from
 		// the JSON response, and log a debug message.
-		log.Debug("Watching only wallets do not store the coin type keys.")
+		logCtx(ctx).Debug("Watching only wallets do not store the coin type keys.")
 	} else if err != nil {
-		log.Errorf("Failed to retrieve the active coin type: %v", err)
+		logCtx(ctx).Errorf("Failed to retrieve the active coin type: %v", err)
 		coinType = 0
 	}
 
@@ -6456,6 +10563,7 @@ func (s *Server) walletInfo(ctx context.Context, icmd any) (any, error) {
 	var voteVersion uint32
 	_ = binary.Read(bytes.NewBuffer(voteBits.ExtendedBits[0:4]), binary.LittleEndian, &voteVersion)
 	voting := w.VotingEnabled()
+	cacheStats := w.UTXOCacheStats()
 
 	wi := &types.WalletInfoResult{
 		DaemonConnected:  connected,
@@ -6469,11 +10577,14 @@ func (s *Server) walletInfo(ctx context.Context, icmd any) (any, error) {
 		Voting:           voting,
 		VSP:              s.cfg.VSPHost,
 		ManualTickets:    w.ManualTickets(),
+		UTXOCacheHits:    cacheStats.Hits,
+		UTXOCacheMisses:  cacheStats.Misses,
+		UTXOCacheHitRate: cacheStats.HitRate(),
 	}
 
 	birthState, err := w.BirthState(ctx)
 	if err != nil {
-		log.Errorf("Failed to get birth state: %v", err)
+		logCtx(ctx).Errorf("Failed to get birth state: %v", err)
 	} else if birthState != nil &&
 		!(birthState.SetFromTime || birthState.SetFromHeight) {
 		wi.BirthHash = birthState.Hash.String()
@@ -6487,7 +10598,7 @@ func (s *Server) walletInfo(ctx context.Context, icmd any) (any, error) {
 // returning the current lock state (false for unlocked, true for locked)
 // of an account.
 func (s *Server) walletIsLocked(ctx context.Context, icmd any) (any, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6499,7 +10610,7 @@ func (s *Server) walletIsLocked(ctx context.Context, icmd any) (any, error) {
 // wallets, returning an error if any wallet is not encrypted (for example,
 // a watching-only wallet).
 func (s *Server) walletLock(ctx context.Context, icmd any) (any, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6511,20 +10622,34 @@ func (s *Server) walletLock(ctx context.Context, icmd any) (any, error) {
 // walletPassphrase responds to the walletpassphrase request by unlocking the
 // wallet. The decryption key is saved in the wallet until timeout seconds
 // expires, after which the wallet is locked. A timeout of 0 leaves the wallet
-// unlocked indefinitely.
+// unlocked indefinitely. The "once" mode locks the wallet again after the
+// first operation using private keys, and the "staking" mode only provides
+// the keys of imported voting accounts.
 func (s *Server) walletPassphrase(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.WalletPassphraseCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	timeout := time.Second * time.Duration(cmd.Timeout)
-	var unlockAfter <-chan time.Time
-	if timeout != 0 {
-		unlockAfter = time.After(timeout)
+	policy := &wallet.UnlockPolicy{
+		Timeout: time.Second * time.Duration(cmd.Timeout),
+	}
+	mode := "all"
+	if cmd.Mode != nil {
+		mode = *cmd.Mode
+	}
+	switch mode {
+	case "all":
+	case "once":
+		policy.SingleOperation = true
+	case "staking":
+		policy.StakingOnly = true
+	default:
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"unknown unlock mode %q", mode)
 	}
-	err := w.Unlock(ctx, []byte(cmd.Passphrase), unlockAfter)
+	err := w.UnlockWithPolicy(ctx, []byte(cmd.Passphrase), policy)
 	return nil, err
 }
 
@@ -6537,7 +10662,7 @@ func (s *Server) walletPassphrase(ctx context.Context, icmd any) (any, error) {
 // wallets will be immediately locked.
 func (s *Server) walletPassphraseChange(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.WalletPassphraseChangeCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6558,7 +10683,7 @@ func (s *Server) mixOutput(ctx context.Context, icmd any) (any, error) {
 	if !s.cfg.MixingEnabled {
 		return nil, errors.E("Mixing is not configured")
 	}
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6593,7 +10718,7 @@ func (s *Server) mixAccount(ctx context.Context, icmd any) (any, error) {
 	if !s.cfg.MixingEnabled {
 		return nil, errors.E("Mixing is not configured")
 	}
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6638,11 +10763,51 @@ func parseOutpoint(s string) (*wire.OutPoint, error) {
 	return &wire.OutPoint{Hash: *hash, Index: uint32(index)}, nil
 }
 
+// walletProcessPSDT handles the walletprocesspsdt command, adding the wallet's
+// knowledge of the inputs and outputs of a PSDT and, optionally, signatures by
+// wallet keys.
+func (s *Server) walletProcessPSDT(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.WalletProcessPSDTCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	p, err := parsePSDT(cmd.PSDT)
+	if err != nil {
+		return nil, err
+	}
+	err = w.ProcessPSDT(ctx, p, *cmd.Sign)
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.Locked):
+			return nil, errWalletUnlockNeeded
+		case errors.Is(err, errors.Invalid):
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+
+	res := &types.WalletProcessPSDTResult{Complete: p.Complete()}
+	if *cmd.Finalize {
+		res.PSDT, res.Complete, res.Hex, err = finalizePSDTResult(p, true)
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+	res.PSDT, err = p.B64Encode()
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // walletPubPassphraseChange responds to the walletpubpassphrasechange request
 // by modifying the public passphrase of the wallet.
 func (s *Server) walletPubPassphraseChange(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.WalletPubPassphraseChangeCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6655,9 +10820,30 @@ func (s *Server) walletPubPassphraseChange(ctx context.Context, icmd any) (any,
 	return nil, err
 }
 
+func (s *Server) setAccountGapLimit(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetAccountGapLimitCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	err = w.SetAccountGapLimit(ctx, account, cmd.GapLimit)
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
 func (s *Server) setAccountPassphrase(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SetAccountPassphraseCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6675,7 +10861,7 @@ func (s *Server) setAccountPassphrase(ctx context.Context, icmd any) (any, error
 
 func (s *Server) accountUnlocked(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.AccountUnlockedCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6709,7 +10895,7 @@ func (s *Server) accountUnlocked(ctx context.Context, icmd any) (any, error) {
 
 func (s *Server) unlockAccount(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.UnlockAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6727,7 +10913,7 @@ func (s *Server) unlockAccount(ctx context.Context, icmd any) (any, error) {
 
 func (s *Server) lockAccount(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.LockAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6759,6 +10945,73 @@ func (s *Server) lockAccount(ctx context.Context, icmd any) (any, error) {
 	return nil, err
 }
 
+// unlockStaking handles an unlockstaking request by unlocking the staking key
+// domain with the staking passphrase.
+func (s *Server) unlockStaking(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.UnlockStakingCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.UnlockStaking(ctx, []byte(cmd.Passphrase))
+	return nil, err
+}
+
+// lockStaking handles a lockstaking request by locking the staking key domain.
+func (s *Server) lockStaking(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.LockStaking(ctx)
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// setStakingPassphrase handles a setstakingpassphrase request by changing the
+// passphrase protecting the staking key domain.
+func (s *Server) setStakingPassphrase(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetStakingPassphraseCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.SetStakingPassphrase(ctx, []byte(cmd.Passphrase))
+	return nil, err
+}
+
+// setStakingAccount handles a setstakingaccount request by adding an account
+// to, or removing an account from, the staking key domain.
+func (s *Server) setStakingAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetStakingAccountCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	staking := true
+	if cmd.Staking != nil {
+		staking = *cmd.Staking
+	}
+	err = w.SetStakingAccount(ctx, account, staking)
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
 // decodeHexStr decodes the hex encoding of a string, possibly prepending a
 // leading '0' character if there is an odd number of bytes in the hex string.
 // This is to prevent an error for an invalid hex string when using an odd
@@ -6776,7 +11029,7 @@ func decodeHexStr(hexStr string) ([]byte, error) {
 
 func (s *Server) getcoinjoinsbyacct(ctx context.Context, icmd any) (any, error) {
 	_ = icmd.(*types.GetCoinjoinsByAcctCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6809,11 +11062,18 @@ func (s *Server) getcoinjoinsbyacct(ctx context.Context, icmd any) (any, error)
 // for a specific coin type (VAR or SKA) with detailed breakdown.
 func (s *Server) getCoinBalance(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.GetCoinBalanceCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
+	// Serve the request from a single consistent view of the database.
+	ctx, release, err := w.Snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	coinType := cointype.CoinType(cmd.CoinType)
 	minConf := int32(1)
 	if cmd.MinConf != nil {
@@ -6936,7 +11196,7 @@ func (s *Server) getCoinBalance(ctx context.Context, icmd any) (any, error) {
 // that have non-zero balances in the wallet with balance information.
 func (s *Server) listCoinTypes(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.ListCoinTypesCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -6997,263 +11257,11 @@ func (s *Server) listCoinTypes(ctx context.Context, icmd any) (any, error) {
 	return result, nil
 }
 
-// getEmissionKeyForCoinType retrieves a stored emission key by name and validates
-// it matches the governance-approved public key for the specified coin type.
-func getEmissionKeyForCoinType(w *wallet.Wallet, ctx context.Context, coinType cointype.CoinType, keyName string) (*secp256k1.PrivateKey, error) {
-	// Retrieve emission key by name from wallet database
-	privateKey, err := retrieveEmissionKeyByName(w, ctx, keyName)
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve emission key %s: %v", keyName, err)
-	}
-
-	// Validate that this key matches the governance-approved public key for this coin type
-	chainParams := w.ChainParams()
-	authorizedKey := chainParams.GetSKAEmissionKey(coinType)
-	if authorizedKey == nil {
-		return nil, fmt.Errorf("no emission key configured for coin type %d in governance settings", coinType)
-	}
-
-	publicKey := privateKey.PubKey()
-	if !bytes.Equal(publicKey.SerializeCompressed(), authorizedKey.SerializeCompressed()) {
-		return nil, fmt.Errorf("stored key %s does not match governance-approved public key for coin type %d", keyName, coinType)
-	}
-
-	return privateKey, nil
-}
-
-// createEmissionAuthHashFromTx creates the authorization hash for SKA emission signing.
-// SECURITY: This function creates a hash that binds the signature to:
-// - The exact transaction outputs (preventing miner redirect attacks)
-// - The network ID (preventing cross-network replay)
-// - The coin type, nonce, and block height
-func createEmissionAuthHashFromTx(tx *wire.MsgTx, auth *chaincfg.SKAEmissionAuth,
-	_ int64, chainParams *chaincfg.Params) ([32]byte, error) {
-
-	// Compute the transaction hash using no-witness serialization
-	// This ensures the signature binds to the exact outputs
-	txBytes, err := tx.BytesPrefix()
-	if err != nil {
-		return [32]byte{}, fmt.Errorf("failed to serialize transaction: %w", err)
-	}
-	txHash := sha256.Sum256(txBytes)
-
-	// Build the domain-separated signing message
-	// Format: "SKA-EMIT-V2" || netID || coinType || nonce || blockHeight || txHash
-	var msgBuf bytes.Buffer
-
-	// Domain separator to prevent signature reuse in other contexts
-	msgBuf.WriteString("SKA-EMIT-V2")
-
-	// Network ID for replay protection across networks
-	if err := binary.Write(&msgBuf, binary.LittleEndian, uint32(chainParams.Net)); err != nil {
-		return [32]byte{}, fmt.Errorf("failed to write network ID: %w", err)
-	}
-
-	// Coin type
-	msgBuf.WriteByte(byte(auth.CoinType))
-
-	// Nonce for replay protection within network
-	if err := binary.Write(&msgBuf, binary.LittleEndian, auth.Nonce); err != nil {
-		return [32]byte{}, fmt.Errorf("failed to write nonce: %w", err)
-	}
-
-	// Use auth.Height (signed by emitter) instead of current blockHeight
-	// This allows broadcasting to mempool and inclusion at any valid height within window
-	if err := binary.Write(&msgBuf, binary.LittleEndian, uint64(auth.Height)); err != nil {
-		return [32]byte{}, fmt.Errorf("failed to write authorization height: %w", err)
-	}
-
-	// Transaction hash - this binds the signature to exact outputs
-	msgBuf.Write(txHash[:])
-
-	// Create the final message hash
-	return sha256.Sum256(msgBuf.Bytes()), nil
-}
-
-// createUnsignedSKAEmissionTransaction creates an unsigned SKA emission transaction.
-// The transaction will be signed separately after creation to bind the signature
-// to the transaction hash (preventing miner redirect attacks).
-func createUnsignedSKAEmissionTransaction(auth *chaincfg.SKAEmissionAuth,
-	emissionAddresses []string, amounts []*big.Int, chainParams *chaincfg.Params) (*wire.MsgTx, error) {
-
-	// Validate authorization structure
-	if auth == nil {
-		return nil, fmt.Errorf("SKA emission authorization required")
-	}
-
-	if auth.EmissionKey == nil {
-		return nil, fmt.Errorf("SKA emission key required")
-	}
-
-	// Note: Signature is not required here since this creates an unsigned transaction
-	// The signature will be added after the transaction is created
-
-	// Validate coin type
-	if auth.CoinType < 1 || auth.CoinType > 255 {
-		return nil, fmt.Errorf("invalid SKA coin type: %d", auth.CoinType)
-	}
-
-	// Check if emission is authorized for this coin type
-	authorizedKey := chainParams.GetSKAEmissionKey(auth.CoinType)
-	if authorizedKey == nil {
-		return nil, fmt.Errorf("no emission key configured for coin type %d", auth.CoinType)
-	}
-
-	// Verify the provided key matches the authorized key
-	if !bytes.Equal(auth.EmissionKey.SerializeCompressed(),
-		authorizedKey.SerializeCompressed()) {
-		return nil, fmt.Errorf("unauthorized emission key for coin type %d", auth.CoinType)
-	}
-
-	// NOTE: Nonce checking is NOT performed during transaction creation
-	// because wallets cannot reliably know the chain state due to reorgs and lag.
-	// The nonce will be validated during block acceptance in dcrd
-	// which uses the actual blockchain state for proper replay protection.
-
-	// Validate emission amounts
-	if len(emissionAddresses) != len(amounts) {
-		return nil, fmt.Errorf("emission addresses and amounts length mismatch")
-	}
-
-	if len(emissionAddresses) == 0 {
-		return nil, fmt.Errorf("no emission addresses specified")
-	}
-
-	zero := new(big.Int)
-	totalAmount := new(big.Int)
-	for _, amount := range amounts {
-		if amount == nil || amount.Cmp(zero) <= 0 {
-			return nil, fmt.Errorf("invalid emission amount: %s", amount)
-		}
-		totalAmount.Add(totalAmount, amount)
-	}
-
-	// Verify total matches authorization
-	if totalAmount.Cmp(auth.Amount) != 0 {
-		return nil, fmt.Errorf("total emission amount %s does not match authorization %s",
-			totalAmount.String(), auth.Amount.String())
-	}
-
-	// Get the SKA coin config for this coin type and validate emission window
-	skaConfig, exists := chainParams.SKACoins[auth.CoinType]
-	if !exists {
-		return nil, fmt.Errorf("SKA coin type %d not configured", auth.CoinType)
-	}
-
-	// Verify emission height is within the emission window
-	emissionStart := int64(skaConfig.EmissionHeight)
-	emissionEnd := emissionStart + int64(skaConfig.EmissionWindow)
-	if auth.Height < emissionStart || auth.Height > emissionEnd {
-		return nil, fmt.Errorf("emission height %d is outside emission window [%d, %d] for coin type %d",
-			auth.Height, emissionStart, emissionEnd, auth.CoinType)
-	}
-
-	// Note: Signature verification is not done here since we're creating an unsigned transaction.
-	// The signature will be added after the transaction is created.
-
-	// Create the authorized emission transaction with Expiry set to window end
-	// This ensures automatic mempool cleanup if the emission window expires
-	tx := &wire.MsgTx{
-		SerType:  wire.TxSerializeFull,
-		Version:  1,
-		LockTime: 0,
-		Expiry:   uint32(emissionEnd),
-	}
-
-	// Create signature script with authorization data
-	authScript, err := createEmissionAuthScript(auth)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create authorization script: %w", err)
-	}
-
-	// Add null input for emission with full authorization script
-	tx.TxIn = append(tx.TxIn, &wire.TxIn{
-		PreviousOutPoint: wire.OutPoint{
-			Hash:  chainhash.Hash{}, // All zeros
-			Index: 0xffffffff,       // Max value indicates null
-			Tree:  wire.TxTreeRegular,
-		},
-		SignatureScript: authScript,
-		Sequence:        0xffffffff,
-		BlockHeight:     wire.NullBlockHeight,
-		BlockIndex:      wire.NullBlockIndex,
-		ValueIn:         wire.NullValueIn,
-	})
-
-	// Add outputs for each emission address
-	for i, addressStr := range emissionAddresses {
-		addr, err := stdaddr.DecodeAddress(addressStr, chainParams)
-		if err != nil {
-			return nil, fmt.Errorf("invalid emission address %s: %w", addressStr, err)
-		}
-
-		// Create script for the address
-		_, pkScript := addr.PaymentScript()
-
-		// Add SKA output with specific coin type
-		// SKA coins use SKAValue (big.Int) for amounts
-		tx.TxOut = append(tx.TxOut, &wire.TxOut{
-			Value:    0,             // Not used for SKA
-			SKAValue: amounts[i],    // SKA uses big.Int for amounts
-			CoinType: auth.CoinType, // Use authorized coin type
-			Version:  0,
-			PkScript: pkScript,
-		})
-	}
-
-	return tx, nil
-}
-
-// createEmissionAuthScript creates the authorization script for SKA emission input.
-// Format v3 (for big.Int amounts):
-// [SKA_marker:4][auth_version:1][nonce:8][coin_type:1][amount_len:1][amount:N][height:8][pubkey:33][sig_len:1][signature:var]
-func createEmissionAuthScript(auth *chaincfg.SKAEmissionAuth) ([]byte, error) {
-	var script bytes.Buffer
-
-	// Standard SKA emission marker
-	script.Write([]byte{0x01, 0x53, 0x4b, 0x41}) // "SKA" marker
-
-	// Authorization data - version 0x03 for big.Int amounts
-	script.WriteByte(0x03) // Auth version 3
-
-	// Nonce (8 bytes)
-	nonceBytes := make([]byte, 8)
-	binary.LittleEndian.PutUint64(nonceBytes, auth.Nonce)
-	script.Write(nonceBytes)
-
-	// Coin type (1 byte)
-	script.WriteByte(uint8(auth.CoinType))
-
-	// Amount (variable length big.Int)
-	// Write length prefix (1 byte) followed by big-endian bytes
-	amountBytes := auth.Amount.Bytes() // Big-endian representation
-	if len(amountBytes) > 255 {
-		return nil, fmt.Errorf("amount too large: %d bytes (max 255)", len(amountBytes))
-	}
-	script.WriteByte(uint8(len(amountBytes)))
-	script.Write(amountBytes)
-
-	// Height (8 bytes)
-	heightBytes := make([]byte, 8)
-	binary.LittleEndian.PutUint64(heightBytes, uint64(auth.Height))
-	script.Write(heightBytes)
-
-	// Public key (33 bytes compressed)
-	pubKeyBytes := auth.EmissionKey.SerializeCompressed()
-	script.Write(pubKeyBytes)
-
-	// Signature length and signature
-	script.WriteByte(uint8(len(auth.Signature)))
-	script.Write(auth.Signature)
-
-	return script.Bytes(), nil
-}
-
 // generateEmissionKey handles a generateemissionkey request by creating a new private key
 // for SKA emission authorization (primary flow - key exists before governance).
 func (s *Server) generateEmissionKey(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.GenerateEmissionKeyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -7317,7 +11325,7 @@ func (s *Server) generateEmissionKey(ctx context.Context, icmd any) (any, error)
 // used for SKA emission authorization in the wallet database (emergency/recovery only).
 func (s *Server) importEmissionKey(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.ImportEmissionKeyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.loader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -7395,13 +11403,6 @@ func storeGeneratedEmissionKey(w *wallet.Wallet, ctx context.Context, keyName st
 	return w.StoreEmissionKey(ctx, keyName, privateKey)
 }
 
-// retrieveEmissionKeyByName retrieves an emission private key by name from the wallet database.
-// This is coin-type agnostic - the caller must validate the key matches governance settings.
-func retrieveEmissionKeyByName(w *wallet.Wallet, ctx context.Context, keyName string) (*secp256k1.PrivateKey, error) {
-	// Retrieve the emission key using the wallet's public method
-	return w.RetrieveEmissionKey(ctx, keyName)
-}
-
 // encryptPrivateKeyWithPassphrase encrypts a private key with AES-256-GCM using a passphrase.
 // Returns format: "aes256gcm:IV:encrypted_private_key_hex"
 func encryptPrivateKeyWithPassphrase(privateKey *secp256k1.PrivateKey, passphrase string) (string, error) {
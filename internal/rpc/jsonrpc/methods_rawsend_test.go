@@ -0,0 +1,261 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/gcs/blockcf2"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/rpc/jsonrpc/types"
+	"github.com/monetarium/monetarium-wallet/wallet"
+)
+
+// publishBackend is an offline network backend which accepts published
+// transactions.
+type publishBackend struct {
+	wallet.OfflineNetworkBackend
+	published []*wire.MsgTx
+}
+
+func (b *publishBackend) PublishTransactions(ctx context.Context, txs ...*wire.MsgTx) error {
+	b.published = append(b.published, txs...)
+	return nil
+}
+
+func (b *publishBackend) LoadTxFilter(ctx context.Context, reload bool,
+	addrs []stdaddr.Address, outpoints []wire.OutPoint) error {
+	return nil
+}
+
+// rawSendServer returns a server of an unlocked wallet with five mined outputs
+// of ten coins in its default account, and a backend accepting published
+// transactions.
+func rawSendServer(ctx context.Context, t *testing.T) (*Server, *wallet.Wallet, *publishBackend) {
+	s := testServer(ctx, t, Options{})
+	w, _ := s.walletLoader.LoadedWallet()
+	if err := w.Unlock(ctx, []byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vers, script := addr.PaymentScript()
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, 50e8, nil))
+	for i := 0; i < 5; i++ {
+		out := wire.NewTxOut(10e8, script)
+		out.Version = vers
+		tx.AddTxOut(out)
+	}
+
+	tipHash, _ := w.MainChainTip(ctx)
+	h := &wire.BlockHeader{
+		PrevBlock: tipHash,
+		VoteBits:  dcrutil.BlockValid,
+		Height:    1,
+		Timestamp: time.Unix(1700000000, 0),
+	}
+	block := &wire.MsgBlock{Header: *h}
+	block.AddTransaction(tx)
+	f, err := blockcf2.Regular(block, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := h.BlockHash()
+	var forest wallet.SidechainForest
+	_, err = w.ChainSwitch(ctx, &forest, []*wallet.BlockNode{wallet.NewBlockNode(h, &hash, f)},
+		map[chainhash.Hash][]*wire.MsgTx{hash: {tx}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := new(publishBackend)
+	w.SetNetworkBackend(b)
+	return s, w, b
+}
+
+// signRawSend funds and signs a transaction paying atoms to addr from the
+// default account with the fundrawtransaction and signrawtransaction RPCs.
+func signRawSend(ctx context.Context, t *testing.T, s *Server, addr stdaddr.Address,
+	atoms int64) string {
+
+	vers, script := addr.PaymentScript()
+	out := wire.NewTxOut(atoms, script)
+	out.Version = vers
+	tx := wire.NewMsgTx()
+	tx.AddTxOut(out)
+	txBytes, err := tx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := s.fundRawTransaction(ctx, &types.FundRawTransactionCmd{
+		HexString:   hex.EncodeToString(txBytes),
+		FundAccount: "default",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	flags := "ALL"
+	res, err = s.signRawTransaction(ctx, &types.SignRawTransactionCmd{
+		RawTx: res.(*types.FundRawTransactionResult).Hex,
+		Flags: &flags,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := res.(types.SignRawTransactionResult)
+	if !signed.Complete {
+		t.Fatalf("transaction was not completely signed: %v", signed.Errors)
+	}
+	return signed.Hex
+}
+
+// sendRaw publishes a signed transaction with the sendrawtransaction RPC.
+func sendRaw(ctx context.Context, s *Server, signedHex string) error {
+	allowHighFees := false
+	_, err := s.sendRawTransaction(ctx, &types.SendRawTransactionCmd{
+		HexTx:         signedHex,
+		AllowHighFees: &allowHighFees,
+	})
+	return err
+}
+
+func TestRawSendSpendLimit(t *testing.T) {
+	ctx := context.Background()
+	s, w, b := rawSendServer(ctx, t)
+	params := w.ChainParams()
+
+	dest, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spent := func() int64 {
+		limits, err := w.SpendLimits(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return limits[0].SpentOn(time.Now()).Int64()
+	}
+
+	// Signed transactions exceeding the limit are not published.
+	err = w.SetSpendLimit(ctx, 0, cointype.CoinTypeVAR, big.NewInt(3e8), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sendRaw(ctx, s, signRawSend(ctx, t, s, dest, 4e8))
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("raw send exceeding limit: expected Policy error, got %v", err)
+	}
+	if len(b.published) != 0 || spent() != 0 {
+		t.Fatalf("refused raw send was published or counted")
+	}
+
+	// Sends within the limit are counted, excluding change returned to the
+	// account, and publishing them again does not count them twice.
+	signedHex := signRawSend(ctx, t, s, dest, 2e8)
+	if err := sendRaw(ctx, s, signedHex); err != nil {
+		t.Fatal(err)
+	}
+	if err := sendRaw(ctx, s, signedHex); err != nil {
+		t.Fatal(err)
+	}
+	if len(b.published) != 2 || spent() != 2e8 {
+		t.Fatalf("published %d transactions, counted %d atoms, want 2 and 2e8",
+			len(b.published), spent())
+	}
+	err = sendRaw(ctx, s, signRawSend(ctx, t, s, dest, 2e8))
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("raw send exceeding remaining limit: expected Policy error, got %v", err)
+	}
+
+	// Sends exceeding a limit requiring approval are recorded as pending
+	// sends of their outputs.
+	err = w.SetSpendLimit(ctx, 0, cointype.CoinTypeVAR, big.NewInt(3e8), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sendRaw(ctx, s, signRawSend(ctx, t, s, dest, 2e8))
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("raw send awaiting approval: expected Policy error, got %v", err)
+	}
+	pending, err := w.PendingSends(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, destScript := dest.PaymentScript()
+	if len(pending) != 1 || pending[0].Account != 0 || pending[0].Amount.Int64() != 2e8 ||
+		len(pending[0].Outputs) != 1 ||
+		!bytes.Equal(pending[0].Outputs[0].PkScript, destScript) {
+		t.Fatalf("unexpected pending sends %+v", pending)
+	}
+	if len(b.published) != 2 {
+		t.Fatalf("raw send awaiting approval was published")
+	}
+}
+
+func TestRawSendPolicy(t *testing.T) {
+	ctx := context.Background()
+	s, w, b := rawSendServer(ctx, t)
+	params := w.ChainParams()
+
+	dest, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Signed transactions paying blocked addresses are not published.
+	if err := w.BlockAddress(ctx, dest, "sanctioned"); err != nil {
+		t.Fatal(err)
+	}
+	err = sendRaw(ctx, s, signRawSend(ctx, t, s, dest, 2e8))
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("raw send to blocked address: expected Policy error, got %v", err)
+	}
+	if len(b.published) != 0 {
+		t.Fatalf("raw send to blocked address was published")
+	}
+	if err := w.UnblockAddress(ctx, dest); err != nil {
+		t.Fatal(err)
+	}
+
+	// Large sends must be labeled before they are published.
+	err = w.SetLabelThreshold(ctx, cointype.CoinTypeVAR, big.NewInt(1e8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signedHex := signRawSend(ctx, t, s, dest, 2e8)
+	err = sendRaw(ctx, s, signedHex)
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("unlabeled raw send: expected Policy error, got %v", err)
+	}
+	tx := wire.NewMsgTx()
+	if err := tx.Deserialize(hex.NewDecoder(bytes.NewBufferString(signedHex))); err != nil {
+		t.Fatal(err)
+	}
+	txHash := tx.TxHash()
+	if err := w.SetTransactionLabel(ctx, &txHash, "invoice 7"); err != nil {
+		t.Fatal(err)
+	}
+	if err := sendRaw(ctx, s, signedHex); err != nil {
+		t.Fatal(err)
+	}
+	if len(b.published) != 1 {
+		t.Fatalf("published %d transactions, want the labeled send", len(b.published))
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/gcs/blockcf2"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/rpc/jsonrpc/types"
+	"github.com/monetarium/monetarium-wallet/wallet"
+)
+
+// TestSnapshotRPCsDuringBlockConnect ensures the RPCs served from a database
+// snapshot neither fail nor deadlock while blocks are connected, which hold
+// the locked outpoints mutex while updating the database.
+func TestSnapshotRPCsDuringBlockConnect(t *testing.T) {
+	ctx := context.Background()
+	s := testServer(ctx, t, Options{})
+	w, _ := s.walletLoader.LoadedWallet()
+
+	// Record an unmined transaction paying the wallet, with one of its
+	// outputs locked, which is mined by the first connected block.
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vers, script := addr.PaymentScript()
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, 3e8, nil))
+	for i := 0; i < 2; i++ {
+		out := wire.NewTxOut(1e8, script)
+		out.Version = vers
+		tx.AddTxOut(out)
+	}
+	if err := w.AddTransaction(ctx, tx, nil); err != nil {
+		t.Fatal(err)
+	}
+	txHash := tx.TxHash()
+	w.LockOutpoint(&txHash, 1)
+
+	const blocks = 100
+	connected := make(chan error, 1)
+	go func() {
+		var forest wallet.SidechainForest
+		for i := 1; i <= blocks; i++ {
+			tipHash, tipHeight := w.MainChainTip(ctx)
+			h := &wire.BlockHeader{
+				PrevBlock: tipHash,
+				VoteBits:  dcrutil.BlockValid,
+				Height:    uint32(tipHeight + 1),
+				Timestamp: time.Unix(1700000000+int64(i)*300, 0),
+			}
+			var relevantTxs map[chainhash.Hash][]*wire.MsgTx
+			block := &wire.MsgBlock{Header: *h}
+			if i == 1 {
+				block.AddTransaction(tx)
+				relevantTxs = map[chainhash.Hash][]*wire.MsgTx{
+					h.BlockHash(): {tx},
+				}
+			}
+			f, err := blockcf2.Regular(block, nil)
+			if err != nil {
+				connected <- err
+				return
+			}
+			hash := h.BlockHash()
+			n := wallet.NewBlockNode(h, &hash, f)
+			_, err = w.ChainSwitch(ctx, &forest, []*wallet.BlockNode{n}, relevantTxs)
+			if err != nil {
+				connected <- err
+				return
+			}
+		}
+		connected <- nil
+	}()
+
+	all, zero, one, max := "*", 0, 1, 9999999
+	from, count := 0, 10
+	rpcs := []struct {
+		name string
+		call func() (any, error)
+	}{
+		{"getbalance", func() (any, error) {
+			return s.getBalance(ctx, &types.GetBalanceCmd{
+				Account: &all, MinConf: &one,
+			})
+		}},
+		{"listtransactions", func() (any, error) {
+			return s.listTransactions(ctx, &types.ListTransactionsCmd{
+				From: &from, Count: &count,
+			})
+		}},
+		{"listunspent", func() (any, error) {
+			return s.listUnspent(ctx, &types.ListUnspentCmd{
+				MinConf: &zero, MaxConf: &max,
+			})
+		}},
+		{"getcoinbalance", func() (any, error) {
+			return s.getCoinBalance(ctx, &types.GetCoinBalanceCmd{
+				Account: &all, MinConf: &one,
+			})
+		}},
+	}
+
+	timeout := time.After(time.Minute)
+	for done := false; !done; {
+		for _, r := range rpcs {
+			if _, err := r.call(); err != nil {
+				t.Fatalf("%s while connecting blocks: %v", r.name, err)
+			}
+		}
+		select {
+		case err := <-connected:
+			if err != nil {
+				t.Fatal(err)
+			}
+			done = true
+		case <-timeout:
+			t.Fatal("blocks were not connected while RPCs were served")
+		default:
+		}
+	}
+
+	// The mined output is reported with all connected blocks confirming
+	// it, and the locked output is excluded.
+	res, err := s.listUnspent(ctx, &types.ListUnspentCmd{MinConf: &one, MaxConf: &max})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unspent := res.([]*types.ListUnspentResult)
+	if len(unspent) != 1 || unspent[0].Vout != 0 || unspent[0].Confirmations != blocks {
+		t.Fatalf("listunspent reported %d outputs after connecting blocks", len(unspent))
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import (
+	"context"
+	"testing"
+
+	dcrdtypes "github.com/monetarium/monetarium-node/rpc/jsonrpc/types"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/rpc/jsonrpc/types"
+	"github.com/monetarium/monetarium-wallet/wallet"
+)
+
+// deploymentsBackend is an offline network backend reporting agenda
+// deployments, or failing to do so when err is set.
+type deploymentsBackend struct {
+	wallet.OfflineNetworkBackend
+	info map[string]dcrdtypes.AgendaInfo
+	err  error
+}
+
+func (b deploymentsBackend) Deployments(ctx context.Context) (map[string]dcrdtypes.AgendaInfo, error) {
+	return b.info, b.err
+}
+
+func TestGetVoteChoicesAgendaStatus(t *testing.T) {
+	ctx := context.Background()
+	s := testServer(ctx, t, Options{})
+	w, _ := s.walletLoader.LoadedWallet()
+
+	_, err := w.SetAgendaChoices(ctx, nil, map[string]string{"blake3pow": "yes"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getVoteChoices := func() map[string]types.VoteChoice {
+		res, err := s.getVoteChoices(ctx, &types.GetVoteChoicesCmd{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		choices := make(map[string]types.VoteChoice)
+		for _, c := range res.(*types.GetVoteChoicesResult).Choices {
+			choices[c.AgendaID] = c
+		}
+		return choices
+	}
+
+	w.SetNetworkBackend(deploymentsBackend{
+		info: map[string]dcrdtypes.AgendaInfo{
+			"blake3pow": {Status: "active"},
+		},
+	})
+	choices := getVoteChoices()
+	if c := choices["blake3pow"]; c.AgendaStatus != "active" || c.ChoiceID != "yes" {
+		t.Errorf("blake3pow reported status %q choice %q, want active and yes",
+			c.AgendaStatus, c.ChoiceID)
+	}
+
+	// Choices are still reported, without a status, when the backend fails
+	// to report deployments.
+	w.SetNetworkBackend(deploymentsBackend{err: errors.E("deployments unavailable")})
+	choices = getVoteChoices()
+	if len(choices) == 0 {
+		t.Fatal("no vote choices reported")
+	}
+	for id, c := range choices {
+		if c.AgendaStatus != "" {
+			t.Errorf("%s reported status %q without deployment info", id,
+				c.AgendaStatus)
+		}
+	}
+	if c := choices["blake3pow"]; c.ChoiceID != "yes" {
+		t.Errorf("blake3pow reported choice %q, want yes", c.ChoiceID)
+	}
+}
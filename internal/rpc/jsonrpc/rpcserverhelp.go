@@ -9,24 +9,56 @@ func helpDescsEnUS() map[string]string {
 		"accountsyncaddressindex":          "accountsyncaddressindex \"account\" branch index\n\nSynchronize an account branch to some passed address index\n\nArguments:\n1. account (string, required)  String for the account\n2. branch  (numeric, required) Number for the branch (0=external, 1=internal)\n3. index   (numeric, required) The address index to synchronize to\n\nResult:\nNothing\n",
 		"accountunlocked":                  "accountunlocked \"account\"\n\nReport account encryption and locked status\n\nArguments:\n1. account (string, required) Account name\n\nResult:\n{\n \"encrypted\": true|false, (boolean) Whether the account is individually encrypted with a separate passphrase\n \"unlocked\": true|false,  (boolean) If the individually encrypted account is unlocked. Omitted for unencrypted accounts.\n}                         \n",
 		"addmultisigaddress":               "addmultisigaddress nrequired [\"key\",...] (\"account\")\n\nGenerates and imports a multisig address and redeeming script to the 'imported' account.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n3. account   (string, optional)          DEPRECATED -- Unused (all imported addresses belong to the imported account)\n\nResult:\n\"value\" (string) The imported pay-to-script-hash address\n",
+		"addrecurringpayment":              "addrecurringpayment \"fromaccount\" \"address\" \"amount\" (intervalblocks \"interval\" start cointype failurepolicy=\"retry\" minconf=1 \"comment\")\n\nRecord a payment to an address sent every interval of blocks or time.\nEach payment is authored and signed when due, subject to the send policy and daily spend limits, and waits while the wallet is locked. Payments missed while the wallet was not running are skipped.\n\nArguments:\n1.  fromaccount    (string, required)                  Account to pick unspent outputs from\n2.  address        (string, required)                  Address to pay\n3.  amount         (string, required)                  Amount of each payment\n4.  intervalblocks (numeric, optional)                 Number of blocks between payments\n5.  interval       (string, optional)                  Time between payments, as a duration such as 36h or one of @hourly, @daily and @weekly; exactly one of intervalblocks and interval is required\n6.  start          (numeric, optional)                 Optional block height, or Unix time for time intervals, of the first payment; defaults to one interval from now\n7.  cointype       (numeric, optional)                 Optional coin type to send (0=VAR, 1-255=SKA)\n8.  failurepolicy  (string, optional, default=\"retry\") How a failed payment is handled: retry it until it succeeds, skip it, or pause the recurring payment (retry, skip or pause)\n9.  minconf        (numeric, optional, default=1)      Minimum number of block confirmations required before a transaction output is eligible to be spent\n10. comment        (string, optional)                  Optional label recorded for each payment transaction\n\nResult:\n{\n \"id\": n,                  (numeric) The recurring payment ID\n \"account\": \"value\",       (string)  Name of the sending account\n \"address\": \"value\",       (string)  The address paid\n \"cointype\": n,            (numeric) Coin type of the payments\n \"amount\": unknown,        (value)   Amount of each payment\n \"intervalblocks\": n,      (numeric) Number of blocks between payments, unset for time intervals\n \"interval\": n,            (numeric) Seconds between payments, unset for block intervals\n \"nextheight\": n,          (numeric) The main chain height at which the next payment is due, unset for time intervals\n \"nexttime\": n,            (numeric) The Unix time at which the next payment is due, unset for block intervals\n \"failurepolicy\": \"value\", (string)  How a failed payment is handled (retry, skip or pause)\n \"paused\": true|false,     (boolean) Whether payments are paused\n \"executions\": n,          (numeric) The number of payments sent\n \"lastattempt\": n,         (numeric) The Unix time of the last attempted payment, unset if none\n \"lasttxid\": \"value\",      (string)  The transaction hash of the last payment, unset if it was not sent\n \"lasterror\": \"value\",     (string)  The reason the last payment was not sent, unset if it was\n \"label\": \"value\",         (string)  The label recorded for each payment transaction, if any\n \"created\": n,             (numeric) The Unix time the recurring payment was recorded\n}                          \n",
 		"addtransaction":                   "addtransaction \"blockhash\" \"transaction\"\n\nManually record a transaction mined in a main chain block\n\nArguments:\n1. blockhash   (string, required) Hash of block which mines transaction\n2. transaction (string, required) Hex-encoded serialized transaction\n\nResult:\nNothing\n",
+		"approvepending":                   "approvepending id\n\nApprove a send which exceeded the daily spend limit of its account, signing and publishing its transaction. The amount sent is counted against the limit.\n\nArguments:\n1. id (numeric, required) The pending send ID\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"archiveaccount":                   "archiveaccount \"account\"\n\nArchives an account, hiding it from getbalance and listaccounts results. The account's keys, addresses, and transaction history are retained.\n\nArguments:\n1. account (string, required) The account to archive\n\nResult:\nNothing\n",
+		"auditcontract":                    "auditcontract \"contracttx\" \"contract\"\n\nDescribes the output of a transaction paying to a hash-locked contract, so the counterparty of an atomic swap can verify its terms before funding or redeeming their side.\n\nArguments:\n1. contracttx (string, required) The hex-encoded transaction funding the contract\n2. contract   (string, required) The hex-encoded contract redeem script\n\nResult:\n{\n \"address\": \"value\",            (string)  The P2SH address of the contract\n \"vout\": n,                     (numeric) The index of the contract output\n \"cointype\": n,                 (numeric) The coin type of the contract output (0=VAR, 1-255=SKA)\n \"amount\": \"value\",             (string)  The amount locked in the contract (string for precision)\n \"recipient\": \"value\",          (string)  The P2PKH address which may redeem the contract with the secret\n \"refund\": \"value\",             (string)  The P2PKH address which may refund the contract after the lock time\n \"secrethash\": \"value\",         (string)  The hex-encoded secret hash of the contract\n \"locktime\": n,                 (numeric) Block height, or unix time, after which the contract may be refunded\n \"locktimereached\": true|false, (boolean) Whether the contract may be refunded in the next block\n}                               \n",
 		"auditreuse":                       "auditreuse (since)\n\nReports outputs identifying address reuse\n\nArguments:\n1. since (numeric, optional) Only report reusage since some main chain block height\n\nResult:\n{\n \"Array of outpoints referencing the reused address\": Reused address, (object) Object keying reused addresses to arrays of outpoint strings\n ...\n}\n",
-		"consolidate":                      "consolidate inputs (\"account\" \"address\" cointype)\n\nConsolidate n many UTXOs into a single output in the wallet.\n\nArguments:\n1. inputs   (numeric, required) Number of UTXOs to consolidate as inputs\n2. account  (string, optional)  Optional: Account from which unspent outputs are picked. When no address specified, also the account used to obtain an output address.\n3. address  (string, optional)  Optional: Address to pay.  Default is obtained via getnewaddress from the account's address pool.\n4. cointype (numeric, optional) Optional: Coin type to consolidate (0=VAR, 1-255=SKA). Default is VAR (0).\n\nResult:\n\"value\" (string) Transaction hash for the consolidation transaction\n",
+		"backupwallet":                     "backupwallet \"destination\" \"passphrase\"\n\nWrites an encrypted snapshot of the wallet database, including accounts, labels, and transaction history, to a file.\n\nArguments:\n1. destination (string, required) Path of the backup file to create\n2. passphrase  (string, required) Passphrase used to encrypt the backup\n\nResult:\nNothing\n",
+		"blockaddress":                     "blockaddress \"address\" (\"reason\")\n\nAdd an address to the send policy blocklist. Sends paying a blocked address are refused, including transactions signed by the wallet and published with sendrawtransaction.\n\nArguments:\n1. address (string, required) The address to block\n2. reason  (string, optional) Optional reason the address is blocked, included in the error refusing a send\n\nResult:\nNothing\n",
+		"cancelscheduledsend":              "cancelscheduledsend id\n\nCancel a scheduled send which has not been triggered, unlocking the inputs of a presigned transaction\n\nArguments:\n1. id (numeric, required) The scheduled send ID\n\nResult:\nNothing\n",
+		"changeaccounts":                   "changeaccounts\n\nReturns the change account of each account and coin type whose change is redirected\n\nArguments:\nNone\n\nResult:\n[{\n \"account\": \"value\",       (string)  Name of the account whose change is redirected\n \"cointype\": n,            (numeric) Coin type of the redirected change\n \"changeaccount\": \"value\", (string)  Name of the account the change is returned to\n},...]\n",
+		"changescripttypes":                "changescripttypes\n\nReturns the change script type of each account which does not pay P2PKH change\n\nArguments:\nNone\n\nResult:\n[{\n \"account\": \"value\",    (string) Name of the account\n \"scripttype\": \"value\", (string) Script type of change returned to the account (\"schnorr-p2pkh\" or \"p2sh\")\n},...]\n",
+		"clearinheritance":                 "clearinheritance \"account\"\n\nRemove the dead-man's switch of an account. Transactions already handed out remain valid once their lock time is reached unless the outputs they spend are spent first.\n\nArguments:\n1. account (string, required) The account whose dead-man's switch is removed\n\nResult:\nNothing\n",
+		"combinepsdt":                      "combinepsdt [\"psdt\",...]\n\nCombines the signatures and other data of multiple partially signed transactions (PSDTs) for the same unsigned transaction.\n\nArguments:\n1. psdts (array of string, required) The base64-encoded PSDTs to combine\n\nResult:\n\"value\" (string) The base64-encoded combined PSDT\n",
+		"compactwallet":                    "compactwallet (prunedepth=0 status=false)\n\nCompacts the wallet database to reclaim the space of deleted records, optionally first pruning old transactions.\nPruned transactions are fully spent regular transactions which, along with their spenders, are buried by at least the prune depth. They are no longer reported by transaction queries, and are kept only as aggregate history by coin type. Their labels, traces and no longer used price snapshots are removed with them. Writes to the wallet database are blocked while it is compacted.\n\nArguments:\n1. prunedepth (numeric, optional, default=0)     Prune transactions buried by at least this many blocks, which must be at least 4096, or 0 to only compact the database\n2. status     (boolean, optional, default=false) Report the progress of the active or last compaction rather than compacting the database\n\nResult:\n{\n \"active\": true|false,    (boolean)         Whether a compaction is in progress\n \"stage\": \"value\",        (string)          The stage of the compaction: pruning, compacting, or complete\n \"percent\": n.nnn,        (numeric)         The progress of the current stage as a percentage\n \"prunedepth\": n,         (numeric)         The prune depth of the compaction, if transactions were pruned\n \"prunedtransactions\": n, (numeric)         The number of transactions pruned by the compaction\n \"prunedhistory\": [{      (array of object) The aggregate history of all pruned transactions by coin type\n  \"cointype\": n,          (numeric)         The coin type credited or debited by the pruned transactions\n  \"transactions\": n,      (numeric)         The number of pruned transactions crediting or debiting the coin type\n  \"firstheight\": n,       (numeric)         The block height of the oldest pruned transaction\n  \"lastheight\": n,        (numeric)         The block height of the newest pruned transaction\n  \"received\": unknown,    (value)           The total value of the pruned credits\n  \"sent\": unknown,        (value)           The total value of the pruned debits\n },...],                                    \n}                         \n",
+		"consolidate":                      "consolidate inputs (\"account\" \"address\" cointype)\n\nConsolidate n many UTXOs into a single output in the wallet.\n\nArguments:\n1. inputs   (numeric, required) Number of UTXOs to consolidate as inputs\n2. account  (string, optional)  Optional: Account from which unspent outputs are picked. When no address specified, also the account used to obtain an output address.\n3. address  (string, optional)  Optional: Address to pay.  Default is obtained via getnewaddress from the account's address pool.\n4. cointype (numeric, optional) Optional: Coin type to consolidate (0=VAR, 1-255=SKA). Default is VAR (0).\n\nResult:\n\"value\" (string) Transaction hash for the consolidation transaction, or the final transaction when the consolidation is split into chained transactions to remain within the maximum transaction size\n",
+		"counterpartysummary":              "counterpartysummary (\"counterparty\")\n\nAggregates the value exchanged with tagged counterparties by coin type.\n\nArguments:\n1. counterparty (string, optional) Only report activity with this counterparty\n\nResult:\n[{\n \"counterparty\": \"value\", (string)  The counterparty name\n \"cointype\": n,           (numeric) The coin type of the reported amounts (0=VAR, 1-255=SKA)\n \"sent\": unknown,         (value)   Total value of wallet-funded outputs paying the counterparty's addresses\n \"received\": unknown,     (value)   Total value credited to the wallet by transactions spending from the counterparty's addresses and no wallet outputs\n \"transactions\": n,       (numeric) Number of transactions involving the counterparty\n},...]\n",
+		"createcontract":                   "createcontract \"account\" \"recipient\" \"amount\" (cointype=0 \"secrethash\" locktime)\n\nFunds a hash-locked contract paying the recipient, who may redeem it by revealing the secret of the secret hash, or refunding the account after the lock time.\nWithout a secret hash, the wallet generates the secret of a new atomic swap and the contract may be refunded after 48 hours.\nA contract participating in a swap with the secret hash of the initiator may be refunded after 24 hours.\n\nArguments:\n1. account    (string, required)             Account funding the contract and receiving refunds\n2. recipient  (string, required)             P2PKH address of the contract recipient\n3. amount     (string, required)             Amount locked in the contract (string for precision)\n4. cointype   (numeric, optional, default=0) Coin type of the locked amount (0=VAR, 1-255=SKA)\n5. secrethash (string, optional)             Hex-encoded SHA-256 hash of the swap secret chosen by the initiator\n6. locktime   (numeric, optional)            Block height, or unix time, after which the contract may be refunded, overriding the default\n\nResult:\n{\n \"txid\": \"value\",       (string)  The hash of the published contract transaction\n \"tx\": \"value\",         (string)  The hex-encoded contract transaction\n \"contract\": \"value\",   (string)  The hex-encoded contract redeem script\n \"address\": \"value\",    (string)  The P2SH address of the contract\n \"secrethash\": \"value\", (string)  The hex-encoded secret hash of the contract\n \"secret\": \"value\",     (string)  The hex-encoded secret, when generated by the wallet\n \"locktime\": n,         (numeric) Block height, or unix time, after which the contract may be refunded\n}                       \n",
 		"createmultisig":                   "createmultisig nrequired [\"key\",...]\n\nGenerate a multisig address and redeem script.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n\nResult:\n{\n \"address\": \"value\",      (string) The generated pay-to-script-hash address\n \"redeemScript\": \"value\", (string) The script required to redeem outputs paid to the multisig address\n}                         \n",
+		"createmultisigaccount":            "createmultisigaccount \"account\" nrequired [\"xpub\",...]\n\nCreates an account paying to P2SH multisig addresses shared with cosigners.\nThe redeem script of each address requires nrequired signatures from the keys of the account and each cosigner, derived at the address' branch and index.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. account   (string, required)          Name of the new account\n2. nrequired (numeric, required)         The number of signatures required to spend from the account\n3. xpubs     (array of string, required) The account extended public keys of each cosigner\n\nResult:\nNothing\n",
+		"createvaultaccount":               "createvaultaccount \"account\" delay (\"recoveryxpub\")\n\nCreates an account paying to P2SH vault addresses which the account keys may only spend after a relative lock time.\nVault outputs are not selected by other sends, and are spent with sendfromvault once they have delay confirmations.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. account      (string, required)  Name of the new account\n2. delay        (numeric, required) The number of confirmations (1 to 65535) before outputs may be spent by the account keys\n3. recoveryxpub (string, optional)  An extended public key whose child keys, derived at each address' branch and index, may spend outputs without delay\n\nResult:\nNothing\n",
+		"createownershipproof":             "createownershipproof \"challenge\" [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\n\nCreate a proof of ownership of unspent wallet outputs, of any coin type, committing to a challenge chosen by the verifier.\nThe proof is a transaction spending each output, signed with SigHashAll, whose first input commits to the challenge and spends a nonexistent output so that it can never be mined. Only P2PKH outputs may be proven, and the wallet must be unlocked.\n\nArguments:\n1. challenge (string, required)          The challenge, such as a statement and nonce chosen by the auditor\n2. outputs   (array of object, required) The outputs to prove ownership of\n[{\n \"amount\": n.nnn, (numeric) The previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n\nResult:\n{\n \"proof\": \"value\",     (string)          The hex-encoded serialized proof\n \"challenge\": \"value\", (string)          The challenge committed to by the proof\n \"outputs\": [{         (array of object) The proven outputs\n  \"txid\": \"value\",     (string)          The transaction hash of the output\n  \"vout\": n,           (numeric)         The output index\n  \"tree\": n,           (numeric)         The transaction tree of the output\n  \"cointype\": n,       (numeric)         Coin type of the output\n  \"amount\": unknown,   (value)           Value of the output\n  \"address\": \"value\",  (string)          The address paid by the output\n },...],                                 \n \"totals\": [{          (array of object) The total value of the proven outputs of each coin type\n  \"cointype\": n,       (numeric)         The coin type\n  \"amount\": unknown,   (value)           Total value of the outputs of the coin type\n },...],                                 \n}                      \n",
 		"createnewaccount":                 "createnewaccount \"account\"\n\nCreates a new account.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. account (string, required) Name of the new account\n\nResult:\nNothing\n",
+		"createinvoice":                    "createinvoice \"amount\" (cointype=0 account=\"default\" \"label\" expires)\n\nRecords an invoice requesting payment to a new external address of an account.\nWallet outputs paying the address in the coin type are matched with the invoice until it is paid in full or expires.\n\nArguments:\n1. amount   (string, required)                    The invoiced amount as a decimal number of coins of the coin type\n2. cointype (numeric, optional, default=0)        The coin type to be paid (0=VAR, 1-255=SKA)\n3. account  (string, optional, default=\"default\") The account of the payment address\n4. label    (string, optional)                    A label describing the invoice\n5. expires  (numeric, optional)                   The Unix time after which payments are no longer matched with the invoice\n\nResult:\n{\n \"id\": n,             (numeric)         The invoice ID\n \"address\": \"value\",  (string)          The payment address\n \"cointype\": n,       (numeric)         The coin type to be paid (0=VAR, 1-255=SKA)\n \"amount\": unknown,   (value)           The invoiced amount\n \"received\": unknown, (value)           The total of the payments matched with the invoice, including unmined payments\n \"label\": \"value\",    (string)          A label describing the invoice\n \"created\": n,        (numeric)         The Unix time the invoice was created\n \"expires\": n,        (numeric)         The Unix time after which payments are no longer matched with the invoice, unset when the invoice does not expire\n \"status\": \"value\",   (string)          The invoice status (open, paid, or expired)\n \"uri\": \"value\",      (string)          The payment request URI of the invoice\n \"payments\": [{       (array of object) The wallet outputs paying the invoice\n  \"txid\": \"value\",    (string)          The transaction hash of the output\n  \"vout\": n,          (numeric)         The output index\n  \"tree\": n,          (numeric)         The transaction tree of the output\n  \"height\": n,        (numeric)         Height of the block mining the output, or -1 if unmined\n  \"amount\": unknown,  (value)           The output amount\n },...],                                \n}                     \n",
+		"createpaymenturi":                 "createpaymenturi \"address\" (\"amount\" cointype=0 \"label\" \"message\" expires)\n\nEncodes a monetarium: payment request URI following BIP0021.\n\nArguments:\n1. address  (string, required)             The payment address\n2. amount   (string, optional)             The requested amount as a decimal number of coins of the coin type, or unset for the payer to choose the amount\n3. cointype (numeric, optional, default=0) The coin type to be paid (0=VAR, 1-255=SKA)\n4. label    (string, optional)             A label naming the payee\n5. message  (string, optional)             A message describing the payment\n6. expires  (numeric, optional)            The Unix time after which the request should not be paid\n\nResult:\n\"value\" (string) The payment request URI\n",
+		"createrpccredential":              "createrpccredential \"username\" [\"scop\",...]\n\nCreates a credential which authenticates RPC clients with a limited set of scopes, and returns its generated password.\nThe read scope permits methods which only report wallet and network state, send permits deriving addresses and creating, signing, and sending transactions, staking permits purchasing tickets and setting voting preferences, and admin permits every method.\nCredentials are recorded by the default wallet, and only a salted hash of the password is kept, so the password can not be recovered later.\n\nArguments:\n1. username (string, required)          Username of the credential, which may not contain ':'\n2. scopes   (array of string, required) Scopes granted to the credential (read, send, staking, or admin)\n\nResult:\n{\n \"username\": \"value\",     (string)          Username of the credential\n \"password\": \"value\",     (string)          Generated password of the credential\n \"scopes\": [\"value\",...], (array of string) Scopes granted to the credential\n}                         \n",
 		"createauthorizedemission":         "createauthorizedemission cointype \"emissionkeyname\" \"passphrase\"\n\nCreates a cryptographically authorized SKA emission transaction using governance-defined parameters.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. cointype        (numeric, required) SKA coin type to emit (1-255)\n2. emissionkeyname (string, required)  Name of the imported emission private key\n3. passphrase      (string, required)  Wallet passphrase for key access\n\nResult:\n\"value\" (string) Hex-encoded bytes of the signed emission transaction\n",
 		"createrawtransaction":             "createrawtransaction [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\n\nReturns a new transaction spending the provided inputs and sending to the provided addresses.\nThe transaction inputs are not signed in the created transaction.\nThe signrawtransaction RPC command provided by wallet must be used to sign the resulting transaction.\n\nArguments:\n1. inputs (array of object, required) The inputs to the transaction\n[{\n \"amount\": n.nnn, (numeric) The previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n2. amounts (object, required) JSON object with the destination addresses as keys and amounts as values\n{\n \"address\": n.nnn, (object) The destination address as the key and the amount in VAR as the value\n ...\n}\n3. locktime (numeric, optional) Locktime value; a non-zero value will also locktime-activate the inputs\n4. expiry   (numeric, optional) Expiry value; a non-zero value when the transaction expiry\n\nResult:\n\"value\" (string) Hex-encoded bytes of the serialized transaction\n",
 		"createsignature":                  "createsignature \"address\" inputindex hashtype \"previouspkscript\" \"serializedtransaction\"\n\nGenerate a signature for a transaction input script.\n\nArguments:\n1. address               (string, required)  The address of the private key to use to create the signature.\n2. inputindex            (numeric, required) The index of the transaction input to sign.\n3. hashtype              (numeric, required) The signature hash flags to use.\n4. previouspkscript      (string, required)  The hex encoded previous output script or P2SH redeem script.\n5. serializedtransaction (string, required)  The hex encoded transaction to add input signatures to.\n\nResult:\n{\n \"signature\": \"value\", (string) The hex encoded signature.\n \"publickey\": \"value\", (string) The hex encoded serialized compressed pubkey of the address.\n}                      \n",
+		"createunsignedtransactionfile":    "createunsignedtransactionfile \"fromaccount\" {\"address\":\"amount\",...} (minconf=1 cointype)\n\nAuthors an unsigned transaction paying to many addresses and encodes it, with the previous outputs spent by its inputs, to be signed by signrawtransactionoffline.\nThe wallet may be watching-only, and the signing wallet does not require a network connection.\n\nArguments:\n1. fromaccount (string, required) Account to pick unspent outputs from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in Monetarium, (object) JSON object using payment addresses as keys and output amounts valued in Monetarium to send to each address\n ...\n}\n3. minconf  (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n4. cointype (numeric, optional)            Optional coin type to send (0=VAR, 1-255=SKA)\n\nResult:\n\"value\" (string) The JSON-encoded unsigned transaction file\n",
+		"createwallet":                     "createwallet \"name\" \"passphrase\" \"seed\" (\"pubpassphrase\")\n\nCreates and loads a named wallet from a seed, hosted alongside the default wallet.\nRequests select a named wallet with a \"wallet\" member naming it alongside the method and params.\n\nArguments:\n1. name          (string, required) Name of the wallet (letters, digits, '-', '_', and '.', not beginning with '.')\n2. passphrase    (string, required) Private passphrase to encrypt the wallet with\n3. seed          (string, required) Hexadecimal wallet seed\n4. pubpassphrase (string, optional) Public passphrase to encrypt the wallet database with (default: insecure public passphrase)\n\nResult:\nNothing\n",
+		"createwatchonlywallet":            "createwatchonlywallet [\"xpub\",...] (\"pubpassphrase\")\n\nCreates and loads a watching-only wallet which records no private keys.\nThe default account watches the first extended public key, and each additional key is imported as an account named xpub1, xpub2, and so on.\n\nArguments:\n1. xpubs         (array of string, required) Account extended public keys to watch\n2. pubpassphrase (string, optional)          Public passphrase to encrypt the wallet database with (default: insecure public passphrase)\n\nResult:\nNothing\n",
 		"debuglevel":                       "debuglevel \"levelspec\"\n\nDynamically changes the debug logging level.\nThe levelspec can either a debug level or of the form:\n<subsystem>=<level>,<subsystem2>=<level2>,...\nThe valid debug levels are trace, debug, info, warn, error, and critical.\nThe valid subsystems are CMGR, DCRW, GRPC, LODR, MIXC, MIXP, PEER, RPCS, SYNC, TKBY, VSPC, and WLLT.\nFinally the keyword 'show' will return a list of the available subsystems.\n\nArguments:\n1. levelspec (string, required) The debug level(s) to use or the keyword 'show'\n\nResult:\n\"value\" (string) The string 'Done.'\n",
+		"decodepaymenturi":                 "decodepaymenturi \"uri\"\n\nDecodes a monetarium: payment request URI following BIP0021.\nRequests paying an address of another network, or with an amount more precise than the coin type, are rejected.\n\nArguments:\n1. uri (string, required) The payment request URI\n\nResult:\n{\n \"address\": \"value\",    (string)  The payment address\n \"amount\": \"value\",     (string)  The requested amount as a decimal number of coins of the coin type, unset when the payer chooses the amount\n \"cointype\": n,         (numeric) The coin type to be paid (0=VAR, 1-255=SKA)\n \"label\": \"value\",      (string)  A label naming the payee\n \"message\": \"value\",    (string)  A message describing the payment\n \"expires\": n,          (numeric) The Unix time after which the request should not be paid, unset when the request does not expire\n \"expired\": true|false, (boolean) Whether the request has expired\n}                       \n",
 		"disapprovepercent":                "disapprovepercent\n\nReturns the wallet's current block disapprove percent per vote. i.e. 100 means that all votes disapprove the block they are called on. Only used for testing purposes.\n\nArguments:\nNone\n\nResult:\nn (numeric) The disapprove percent. When voting, this percent of votes will randomly disapprove the block they are called on.\n",
-		"discoverusage":                    "discoverusage (\"startblock\" discoveraccounts gaplimit)\n\nPerform address and/or account discovery\n\nArguments:\n1. startblock       (string, optional)  Hash of block to begin discovery from, or null to scan from the genesis block\n2. discoveraccounts (boolean, optional) Perform account discovery in addition to address discovery.  Requires unlocked wallet.\n3. gaplimit         (numeric, optional) Allowed unused address gap.\n\nResult:\nNothing\n",
+		"discoverusage":                    "discoverusage (\"startblock\" discoveraccounts gaplimit fullscan=false)\n\nPerform address and/or account discovery\n\nArguments:\n1. startblock       (string, optional)                 Hash of block to begin discovery from, or null to scan from the wallet birthday block\n2. discoveraccounts (boolean, optional)                Perform account discovery in addition to address discovery.  Requires unlocked wallet.\n3. gaplimit         (numeric, optional)                Allowed unused address gap.\n4. fullscan         (boolean, optional, default=false) Scan from the genesis block, ignoring the wallet birthday, when no start block is provided\n\nResult:\nNothing\n",
+		"dumpaccountprivkeys":              "dumpaccountprivkeys \"account\" \"passphrase\"\n\nReturns the WIF-encoded private keys of every address the wallet has derived on the external and internal branches of an account, for migrating the account to other software.\nRequires the --accountkeyexport option. The account's keys must be unlocked, and the passphrase they were unlocked with must be entered again to confirm the export.\n\nArguments:\n1. account    (string, required) The account to export the private keys of\n2. passphrase (string, required) The wallet private passphrase, or the account passphrase of individually-encrypted accounts\n\nResult:\n[{\n \"address\": \"value\", (string)  The P2PKH address of the key\n \"branch\": n,        (numeric) The account branch of the address (0 for external, 1 for internal)\n \"index\": n,         (numeric) The child index of the address on its branch\n \"privkey\": \"value\", (string)  The WIF-encoded private key\n},...]\n",
 		"dumpprivkey":                      "dumpprivkey \"address\"\n\nReturns the private key in WIF encoding that controls some wallet address.\n\nArguments:\n1. address (string, required) The address to return a private key for\n\nResult:\n\"value\" (string) The WIF-encoded private key\n",
-		"fundrawtransaction":               "fundrawtransaction \"hexstring\" \"fundaccount\" ({\"changeaddress\":changeaddress,\"feerate\":feerate,\"conftarget\":conftarget})\n\nAdds unsigned inputs and change output to a raw transaction\n\nArguments:\n1. hexstring   (string, required) Serialized transaction in hex encoding\n2. fundaccount (string, required) Account of outputs to spend in transaction\n3. options     (object, optional) Object to specify fixed change address, alternative fee rate, and confirmation target\n{\n \"changeaddress\": \"value\", (string)  Provide a change address rather than deriving one from the funding account\n \"feerate\": n.nnn,         (numeric) Alternative fee rate\n \"conf_target\": n,         (numeric) Required confirmations of selected previous outputs\n}                          \n\nResult:\n{\n \"hex\": \"value\", (string)  Funded transaction in hex encoding\n \"fee\": n.nnn,   (numeric) Absolute fee of funded transaction\n}                \n",
+		"emitska":                          "emitska cointype \"emissionkeyname\" (nonce=1)\n\nCreates the governance-defined emission transaction of an SKA coin type, signed by an emission key stored by the wallet, and publishes it.\nThe emission pays no fee and must be created within the emission window of the coin type. Requires an unlocked wallet and the --enableskaemission option.\n\nArguments:\n1. cointype        (numeric, required)            SKA coin type to emit (1-255)\n2. emissionkeyname (string, required)             Name of the stored emission key approved for the coin type\n3. nonce           (numeric, optional, default=1) Nonce of the emission, unique for each emission of the coin type\n\nResult:\n\"value\" (string) The transaction hash of the emission\n",
+		"estimatesendfee":                  "estimatesendfee \"fromaccount\" {\"address\":\"amount\",...} (minconf=1 cointype [\"subtractfeefrom\",...] \"feepreference\")\n\nSelects inputs and decides on change for a send of amounts to addresses, as sendmany would, without signing or publishing the transaction.\nNo change address is derived, and the estimate describes the transaction before any configured change split.\n\nArguments:\n1. fromaccount (string, required) The account to send from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in Monetarium, (object) JSON object using payment addresses as keys and output amounts valued in Monetarium to send to each address\n ...\n}\n3. minconf         (numeric, optional, default=1) The minimum number of block confirmations required before a transaction output is eligible to be spent\n4. cointype        (numeric, optional)            The coin type of the amounts (0=VAR, 1-255=SKA)\n5. subtractfeefrom (array of string, optional)    Addresses of the amounts whose outputs pay the transaction fee, divided evenly between them\n6. feepreference   (string, optional)             Optional fee preference (slow, normal or fast) selecting the fee rate estimated by the network backend for the coin type instead of the wallet's fee rate\n\nResult:\n{\n \"cointype\": n,      (numeric)         The coin type of the transaction\n \"fee\": unknown,     (value)           The transaction fee\n \"feerate\": unknown, (value)           The fee rate per kB used to author the transaction\n \"size\": n,          (numeric)         The estimated serialize size of the signed transaction in bytes\n \"inputs\": [{        (array of object) The outputs selected to be spent by the transaction\n  \"txid\": \"value\",   (string)          The hash of the transaction creating the output\n  \"vout\": n,         (numeric)         The output index\n  \"tree\": n,         (numeric)         The transaction tree of the output\n  \"amount\": unknown, (value)           The output amount\n },...],                               \n \"change\": unknown,  (value)           The amount paid to change, unset when the transaction has no change\n}                    \n",
+		"exportcounterparties":             "exportcounterparties\n\nExports all counterparty address tags.\n\nArguments:\nNone\n\nResult:\n{\n \"Counterparty name\": Array of addresses tagged with the counterparty, (object) Object keying counterparty names to arrays of tagged addresses\n ...\n}\n",
+		"exportaccountxpriv":               "exportaccountxpriv \"account\" \"passphrase\"\n\nReturns the BIP0044 extended private key of an account, for migrating the account to other software. The extended private key reveals every private key of the account.\nRequires the --accountkeyexport option. The account's keys must be unlocked, and the passphrase they were unlocked with must be entered again to confirm the export.\n\nArguments:\n1. account    (string, required) The account to export the extended private key of\n2. passphrase (string, required) The wallet private passphrase, or the account passphrase of individually-encrypted accounts\n\nResult:\n\"value\" (string) The account extended private key\n",
+		"exporthistory":                    "exporthistory \"destination\" (format=\"csv\")\n\nWrites the mined transaction history to a new file for accounting, in increasing block height order.\nEach transaction records its coin type, class, SSFee type (MF or SF), block time, amounts received and sent by the wallet, fee paid, label, and the latest fiat price recorded at or before the block time.\n\nArguments:\n1. destination (string, required)                Path of the file to create\n2. format      (string, optional, default=\"csv\") Format of the file (csv or json)\n\nResult:\nn.nnn (numeric) The number of exported transactions\n",
+		"extractsecret":                    "extractsecret \"redeemtx\" \"secrethash\"\n\nReturns the hex-encoded secret revealed by a transaction redeeming a hash-locked contract with the secret hash.\n\nArguments:\n1. redeemtx   (string, required) The hex-encoded transaction redeeming the contract\n2. secrethash (string, required) The hex-encoded SHA-256 secret hash of the contract\n\nResult:\n\"value\" (string) The hex-encoded secret\n",
+		"finalizepsdt":                     "finalizepsdt \"psdt\" (extract=true)\n\nCreates the signature scripts of PSDT inputs with enough partial signatures.\nWhen every input is finalized and extract is true, the signed transaction is also returned.\n\nArguments:\n1. psdt    (string, required)                The base64-encoded PSDT\n2. extract (boolean, optional, default=true) Return the signed transaction when every input is finalized\n\nResult:\n{\n \"psdt\": \"value\",        (string)  The base64-encoded PSDT\n \"complete\": true|false, (boolean) Whether every input is finalized\n \"hex\": \"value\",         (string)  The signed transaction encoded as a hexadecimal string, when complete and extracted\n}                        \n",
+		"fundrawtransaction":               "fundrawtransaction \"hexstring\" \"fundaccount\" ({\"changeaddress\":changeaddress,\"feerate\":feerate,\"conftarget\":conftarget})\n\nAdds unsigned inputs and change output to a raw transaction.\nExisting inputs must spend wallet outputs and are kept, and additional inputs of the coin type of the outputs are selected to pay the outputs and fee\n\nArguments:\n1. hexstring   (string, required) Serialized transaction in hex encoding\n2. fundaccount (string, required) Account of outputs to spend in transaction\n3. options     (object, optional) Object to specify fixed change address, alternative fee rate, and confirmation target\n{\n \"changeaddress\": \"value\", (string)  Provide a change address rather than deriving one from the funding account\n \"feerate\": n.nnn,         (numeric) Alternative fee rate\n \"conf_target\": n,         (numeric) Required confirmations of selected previous outputs\n}                          \n\nResult:\n{\n \"hex\": \"value\", (string)  Funded transaction in hex encoding\n \"fee\": n.nnn,   (numeric) Absolute fee of funded transaction in coins of its coin type\n}                \n",
 		"generateemissionkey":              "generateemissionkey \"keyname\" \"passphrase\" (cointype)\n\nGenerates a new private key for SKA emission authorization.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. keyname    (string, required)  Unique identifier for this emission key\n2. passphrase (string, required)  Wallet passphrase for key generation\n3. cointype   (numeric, optional) Optional SKA coin type (1-255) for organization\n\nResult:\n\"value\" (string) The public key corresponding to the generated private key\n",
 		"getaccount":                       "getaccount \"address\"\n\nLookup the account name that some wallet address belongs to.\n\nArguments:\n1. address (string, required) The address to query the account for\n\nResult:\n\"value\" (string) The name of the account that 'address' belongs to\n",
 		"getaccountaddress":                "getaccountaddress \"account\"\n\nDEPRECATED -- Returns the most recent external payment address for an account that has not been seen publicly.\nA new address is generated for the account if the most recently generated address has been seen on the blockchain or in mempool.\n\nArguments:\n1. account (string, required) The account of the returned address\n\nResult:\n\"value\" (string) The unused address for 'account'\n",
 		"getaddressesbyaccount":            "getaddressesbyaccount \"account\"\n\nDEPRECATED -- Returns all addresses strings controlled by a single account.\n\nArguments:\n1. account (string, required) Account name to fetch addresses for\n\nResult:\n[\"value\",...] (array of string) All addresses controlled by 'account'\n",
-		"getbalance":                       "getbalance (\"account\" minconf=1 cointype)\n\nCalculates and returns the balance of all accounts.\n\nArguments:\n1. account  (string, optional)             The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")\n2. minconf  (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n3. cointype (numeric, optional)            Optional coin type to filter by (0=VAR, 1-255=SKA)\n\nResult:\n{\n \"balances\": [{                           (array of object) Balances for all accounts.\n  \"accountname\": \"value\",                 (string)          Name of account.\n  \"immaturecoinbaserewards\": unknown,     (value)           Immature Coinbase reward coins.\n  \"immaturestakegeneration\": unknown,     (value)           Number of immature stake coins.\n  \"lockedbytickets\": unknown,             (value)           Coins locked by tickets.\n  \"spendable\": unknown,                   (value)           Spendable number of coins.\n  \"total\": unknown,                       (value)           Total amount of coins.\n  \"unconfirmed\": unknown,                 (value)           Unconfirmed number of coins.\n  \"votingauthority\": unknown,             (value)           Coins for voting authority.\n },...],                                                    \n \"blockhash\": \"value\",                    (string)          Block hash.\n \"totalimmaturecoinbaserewards\": unknown, (value)           Total number of immature coinbase reward coins.\n \"totalimmaturestakegeneration\": unknown, (value)           Total number of immature stake coins.\n \"totallockedbytickets\": unknown,         (value)           Total number of coins locked by tickets.\n \"totalspendable\": unknown,               (value)           Total number of spendable number of coins.\n \"cumulativetotal\": unknown,              (value)           Total number of coins.\n \"totalunconfirmed\": unknown,             (value)           Total number of unconfirmed coins.\n \"totalvotingauthority\": unknown,         (value)           Total number of coins for voting authority.\n}                                         \n",
+		"getbalance":                       "getbalance (\"account\" minconf=1 cointype)\n\nCalculates and returns the balance of all accounts.\n\nArguments:\n1. account  (string, optional)             The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")\n2. minconf  (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n3. cointype (numeric, optional)            Optional coin type to filter by (0=VAR, 1-255=SKA)\n\nResult:\n{\n \"balances\": [{                           (array of object) Balances for all unarchived accounts. Archived accounts are included in the totals.\n  \"accountname\": \"value\",                 (string)          Name of account.\n  \"immaturecoinbaserewards\": unknown,     (value)           Immature Coinbase reward coins.\n  \"immaturestakegeneration\": unknown,     (value)           Number of immature stake coins.\n  \"lockedbytickets\": unknown,             (value)           Coins locked by tickets.\n  \"spendable\": unknown,                   (value)           Spendable number of coins.\n  \"total\": unknown,                       (value)           Total amount of coins.\n  \"unconfirmed\": unknown,                 (value)           Unconfirmed number of coins.\n  \"votingauthority\": unknown,             (value)           Coins for voting authority.\n },...],                                                    \n \"blockhash\": \"value\",                    (string)          Block hash.\n \"totalimmaturecoinbaserewards\": unknown, (value)           Total number of immature coinbase reward coins.\n \"totalimmaturestakegeneration\": unknown, (value)           Total number of immature stake coins.\n \"totallockedbytickets\": unknown,         (value)           Total number of coins locked by tickets.\n \"totalspendable\": unknown,               (value)           Total number of spendable number of coins.\n \"cumulativetotal\": unknown,              (value)           Total number of coins.\n \"totalunconfirmed\": unknown,             (value)           Total number of unconfirmed coins.\n \"totalvotingauthority\": unknown,         (value)           Total number of coins for voting authority.\n}                                         \n",
 		"getcoinbalance":                   "getcoinbalance cointype (\"account\" minconf=1)\n\nReturns the balance for a specific coin type (VAR or SKA) with detailed breakdown.\n\nArguments:\n1. cointype (numeric, required)            Coin type to query balance for (0=VAR, 1-255=SKA)\n2. account  (string, optional)             Account name to query balance for, or \"*\" for all accounts (default=\"*\")\n3. minconf  (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"cointype\": n,                           (numeric)         The coin type for which the balance is reported\n \"blockhash\": \"value\",                    (string)          Block hash of the tip block\n \"totalimmaturecoinbaserewards\": unknown, (value)           Total immature coinbase reward coins\n \"totalimmaturestakegeneration\": unknown, (value)           Total immature stake generation coins\n \"totallockedbytickets\": unknown,         (value)           Total coins locked by tickets\n \"totalspendable\": unknown,               (value)           Total spendable balance for the coin type\n \"totalunconfirmed\": unknown,             (value)           Total unconfirmed balance\n \"totalvotingauthority\": unknown,         (value)           Total coins for voting authority\n \"cumulativetotal\": unknown,              (value)           Total balance including immature and locked coins\n \"balances\": [{                           (array of object) Per-account balance breakdown\n  \"accountname\": \"value\",                 (string)          Name of the account\n  \"cointype\": n,                          (numeric)         Coin type for this account balance (0=VAR, 1-255=SKA)\n  \"immaturecoinbaserewards\": unknown,     (value)           Immature coinbase reward coins\n  \"immaturestakegeneration\": unknown,     (value)           Immature stake generation coins\n  \"lockedbytickets\": unknown,             (value)           Coins locked by tickets\n  \"spendable\": unknown,                   (value)           Spendable balance for this account and coin type\n  \"total\": unknown,                       (value)           Total balance for this account and coin type\n  \"unconfirmed\": unknown,                 (value)           Unconfirmed balance\n  \"votingauthority\": unknown,             (value)           Coins for voting authority\n },...],                                                    \n}                                         \n",
 		"getbestblock":                     "getbestblock\n\nReturns the hash and height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n{\n \"hash\": \"value\", (string)  The hash of the block\n \"height\": n,     (numeric) The blockchain height of the block\n}                 \n",
 		"getbestblockhash":                 "getbestblockhash\n\nReturns the hash of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n\"value\" (string) The hash of the most recent synced-to block\n",
@@ -36,85 +68,154 @@ func helpDescsEnUS() map[string]string {
 		"getblock":                         "getblock \"hash\" (verbose=true verbosetx=false)\n\nReturns information about a block given its hash.\n\nArguments:\n1. hash      (string, required)                 The hash of the block\n2. verbose   (boolean, optional, default=true)  Specifies the block is returned as a JSON object instead of hex-encoded string\n3. verbosetx (boolean, optional, default=false) Specifies that each transaction is returned as a JSON object and only applies if the verbose flag is true (dcrd extension)\n\nResult:\n{\n \"hash\": \"value\",               (string)          The hash of the block (same as provided)\n \"powhash\": \"value\",            (string)          The Proof-of-Work hash of the block (same as hash prior to DCP0011 activation)\n \"confirmations\": n,            (numeric)         The number of confirmations\n \"size\": n,                     (numeric)         The size of the block\n \"height\": n,                   (numeric)         The height of the block in the block chain\n \"version\": n,                  (numeric)         The block version\n \"merkleroot\": \"value\",         (string)          Root hash of the merkle tree\n \"stakeroot\": \"value\",          (string)          The block's sstx hashes the were included\n \"tx\": [\"value\",...],           (array of string) The transaction hashes (only when verbosetx=false)\n \"rawtx\": [{                    (array of object) The transactions as JSON objects (only when verbosetx=true)\n  \"hex\": \"value\",               (string)          Hex-encoded transaction\n  \"txid\": \"value\",              (string)          The hash of the transaction\n  \"version\": n,                 (numeric)         The transaction version\n  \"locktime\": n,                (numeric)         The transaction lock time\n  \"expiry\": n,                  (numeric)         The transacion expiry\n  \"vin\": [{                     (array of object) The transaction inputs as JSON objects\n   \"coinbase\": \"value\",         (string)          The hex-encoded bytes of the signature script (coinbase txns only)\n   \"stakebase\": \"value\",        (string)          The hex-encoded bytes of the signature script (vote txns only)\n   \"treasurybase\": true|false,  (boolean)         Whether or not the input is a treasury base (treasurybase txns only)\n   \"treasuryspend\": \"value\",    (string)          The hex-encoded bytes of the signature script (treasury spend txns only)\n   \"txid\": \"value\",             (string)          The hash of the origin transaction (non-coinbase txns only)\n   \"vout\": n,                   (numeric)         The index of the output being redeemed from the origin transaction (non-coinbase txns only)\n   \"tree\": n,                   (numeric)         The tree of the transaction\n   \"sequence\": n,               (numeric)         The script sequence number\n   \"amountin\": n.nnn,           (numeric)         The amount in\n   \"skaamountin\": \"value\",      (string)          The SKA amount in (string for precision with large values)\n   \"blockheight\": n,            (numeric)         The block height of the origin transaction\n   \"blockindex\": n,             (numeric)         The block idx of the origin transaction\n   \"scriptSig\": {               (object)          The signature script used to redeem the origin transaction as a JSON object (non-coinbase txns only)\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n   },                                             \n  },...],                                         \n  \"vout\": [{                    (array of object) The transaction outputs as JSON objects\n   \"value\": n.nnn,              (numeric)         The amount in VAR\n   \"skavalue\": \"value\",         (string)          The amount in SKA (string for precision with large values)\n   \"n\": n,                      (numeric)         The index of this transaction output\n   \"version\": n,                (numeric)         The version of the public key script\n   \"cointype\": n,               (numeric)         The coin type (0=VAR, 1-255=SKA)\n   \"scriptPubKey\": {            (object)          The public key script used to pay coins as a JSON object\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n    \"reqSigs\": n,               (numeric)         The number of required signatures\n    \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n    \"addresses\": [\"value\",...], (array of string) The Monetarium addresses associated with this script\n    \"commitamt\": n.nnn,         (numeric)         The ticket commitment value if the script is for a staking commitment\n    \"version\": n,               (numeric)         The script version\n   },                                             \n  },...],                                         \n  \"blockhash\": \"value\",         (string)          The hash of the block that contains the transaction\n  \"blockheight\": n,             (numeric)         The height of the block that contains the transaction\n  \"blockindex\": n,              (numeric)         The index within the array of transactions contained by the block\n  \"confirmations\": n,           (numeric)         Number of confirmations of the block\n  \"time\": n,                    (numeric)         Transaction time in seconds since 1 Jan 1970 GMT\n  \"blocktime\": n,               (numeric)         Block time in seconds since the 1 Jan 1970 GMT\n },...],                                          \n \"stx\": [\"value\",...],          (array of string) The block's sstx hashes the were included\n \"rawstx\": [{                   (array of object) The block's raw sstx hashes the were included\n  \"hex\": \"value\",               (string)          Hex-encoded transaction\n  \"txid\": \"value\",              (string)          The hash of the transaction\n  \"version\": n,                 (numeric)         The transaction version\n  \"locktime\": n,                (numeric)         The transaction lock time\n  \"expiry\": n,                  (numeric)         The transacion expiry\n  \"vin\": [{                     (array of object) The transaction inputs as JSON objects\n   \"coinbase\": \"value\",         (string)          The hex-encoded bytes of the signature script (coinbase txns only)\n   \"stakebase\": \"value\",        (string)          The hex-encoded bytes of the signature script (vote txns only)\n   \"treasurybase\": true|false,  (boolean)         Whether or not the input is a treasury base (treasurybase txns only)\n   \"treasuryspend\": \"value\",    (string)          The hex-encoded bytes of the signature script (treasury spend txns only)\n   \"txid\": \"value\",             (string)          The hash of the origin transaction (non-coinbase txns only)\n   \"vout\": n,                   (numeric)         The index of the output being redeemed from the origin transaction (non-coinbase txns only)\n   \"tree\": n,                   (numeric)         The tree of the transaction\n   \"sequence\": n,               (numeric)         The script sequence number\n   \"amountin\": n.nnn,           (numeric)         The amount in\n   \"skaamountin\": \"value\",      (string)          The SKA amount in (string for precision with large values)\n   \"blockheight\": n,            (numeric)         The block height of the origin transaction\n   \"blockindex\": n,             (numeric)         The block idx of the origin transaction\n   \"scriptSig\": {               (object)          The signature script used to redeem the origin transaction as a JSON object (non-coinbase txns only)\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n   },                                             \n  },...],                                         \n  \"vout\": [{                    (array of object) The transaction outputs as JSON objects\n   \"value\": n.nnn,              (numeric)         The amount in VAR\n   \"skavalue\": \"value\",         (string)          The amount in SKA (string for precision with large values)\n   \"n\": n,                      (numeric)         The index of this transaction output\n   \"version\": n,                (numeric)         The version of the public key script\n   \"cointype\": n,               (numeric)         The coin type (0=VAR, 1-255=SKA)\n   \"scriptPubKey\": {            (object)          The public key script used to pay coins as a JSON object\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n    \"reqSigs\": n,               (numeric)         The number of required signatures\n    \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n    \"addresses\": [\"value\",...], (array of string) The Monetarium addresses associated with this script\n    \"commitamt\": n.nnn,         (numeric)         The ticket commitment value if the script is for a staking commitment\n    \"version\": n,               (numeric)         The script version\n   },                                             \n  },...],                                         \n  \"blockhash\": \"value\",         (string)          The hash of the block that contains the transaction\n  \"blockheight\": n,             (numeric)         The height of the block that contains the transaction\n  \"blockindex\": n,              (numeric)         The index within the array of transactions contained by the block\n  \"confirmations\": n,           (numeric)         Number of confirmations of the block\n  \"time\": n,                    (numeric)         Transaction time in seconds since 1 Jan 1970 GMT\n  \"blocktime\": n,               (numeric)         Block time in seconds since the 1 Jan 1970 GMT\n },...],                                          \n \"time\": n,                     (numeric)         The block time in seconds since 1 Jan 1970 GMT\n \"mediantime\": n,               (numeric)         The median block time over the last 11 blocks\n \"nonce\": n,                    (numeric)         The block nonce\n \"votebits\": n,                 (numeric)         The block's voting results\n \"finalstate\": \"value\",         (string)          The block's finalstate\n \"voters\": n,                   (numeric)         The number votes in the block\n \"freshstake\": n,               (numeric)         The number of new tickets in the block\n \"revocations\": n,              (numeric)         The number of revocations in the block\n \"poolsize\": n,                 (numeric)         The size of the live ticket pool\n \"bits\": \"value\",               (string)          The bits which represent the block difficulty\n \"sbits\": n.nnn,                (numeric)         The stake difficulty of the block\n \"extradata\": \"value\",          (string)          Extra data field for the requested block\n \"stakeversion\": n,             (numeric)         Stake Version of the block\n \"difficulty\": n.nnn,           (numeric)         The proof-of-work difficulty as a multiple of the minimum difficulty\n \"chainwork\": \"value\",          (string)          The total number of hashes expected to produce the chain up to the block in hex\n \"previousblockhash\": \"value\",  (string)          The hash of the previous block\n \"nextblockhash\": \"value\",      (string)          The hash of the next block (only if there is one)\n}                               \n",
 		"getcoinjoinsbyacct":               "getcoinjoinsbyacct\n\nGet coinjoin outputs by account.\n\nArguments:\nNone\n\nResult:\n{\n \"Accounts name\": Coinjoin outputs sum., (object) Return a map of account's name and its coinjoin outputs sum.\n ...\n}\n",
 		"getcurrentnet":                    "getcurrentnet\n\nGet Monetarium network the wallet is connected to.\n\nArguments:\nNone\n\nResult:\nn (numeric) The network identifier\n",
+		"getfeesummary":                    "getfeesummary (days=30 cointype)\n\nReturns the fees paid by the wallet's mined transactions over a window of days ending today, by coin type and by day.\nFees are recorded as transactions spending only wallet outputs are mined, and days begin at midnight UTC by block timestamp.\n\nArguments:\n1. days     (numeric, optional, default=30) Number of days, including today, to summarize, or 0 for every recorded fee\n2. cointype (numeric, optional)             Optional coin type to limit the summary to (0=VAR, 1-255=SKA)\n\nResult:\n{\n \"days\": n,                (numeric)         Number of days summarized\n \"since\": n,               (numeric)         Unix time of the start of the first summarized day\n \"cointypes\": [{           (array of object) Fees paid over the window, by coin type\n  \"cointype\": n,           (numeric)         The coin type of the fees\n  \"transactions\": n,       (numeric)         Number of transactions paying fees\n  \"totalfees\": unknown,    (value)           Total fees paid\n  \"averagefee\": unknown,   (value)           Average fee paid per transaction\n  \"dailyaverage\": unknown, (value)           Average fees paid per day of the window\n },...],                                     \n \"daily\": [{               (array of object) Fees paid on each day of the window with fees, by coin type\n  \"date\": \"value\",         (string)          The UTC date (YYYY-MM-DD)\n  \"cointype\": n,           (numeric)         The coin type of the fees\n  \"transactions\": n,       (numeric)         Number of transactions paying fees\n  \"fees\": unknown,         (value)           Total fees paid on the day\n },...],                                     \n}                          \n",
 		"getinfo":                          "getinfo\n\nReturns a JSON object containing various state info.\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,          (numeric) The version of the server\n \"protocolversion\": n,  (numeric) The latest supported protocol version\n \"walletversion\": n,    (numeric) The version of the address manager database\n \"balance\": n.nnn,      (numeric) The balance of all accounts calculated with one block confirmation\n \"blocks\": n,           (numeric) The number of blocks processed\n \"timeoffset\": n,       (numeric) The time offset\n \"connections\": n,      (numeric) The number of connected peers\n \"proxy\": \"value\",      (string)  The proxy used by the server\n \"difficulty\": n.nnn,   (numeric) The current target difficulty\n \"testnet\": true|false, (boolean) Whether or not server is using testnet\n \"keypoololdest\": n,    (numeric) Unset\n \"keypoolsize\": n,      (numeric) Unset\n \"unlocked_until\": n,   (numeric) Unset\n \"paytxfee\": n.nnn,     (numeric) The fee per kB of the serialized tx size used each time more fee is required for an authored transaction\n \"relayfee\": n.nnn,     (numeric) The minimum relay fee for non-free transactions in VAR/KB\n \"errors\": \"value\",     (string)  Any current errors\n}                       \n",
+		"getinheritance":                   "getinheritance\n\nReturns the dead-man's switch of every account which has one\n\nArguments:\nNone\n\nResult:\n[{\n \"account\": \"value\",   (string)          Name of the account whose funds are swept\n \"address\": \"value\",   (string)          The recovery address receiving the funds\n \"delay\": n,           (numeric)         Seconds of inactivity after which the transactions become valid\n \"refreshed\": n,       (numeric)         The Unix time the transactions were last created, unset if never\n \"locktime\": n,        (numeric)         The Unix time lock time of the transactions, unset if there are none\n \"lasterror\": \"value\", (string)          The reason the transactions could not be re-created when the wallet was last used, unset if they were\n \"transactions\": [{    (array of object) The pre-signed sweep transaction of each coin type with spendable funds\n  \"cointype\": n,       (numeric)         Coin type swept by the transaction\n  \"txid\": \"value\",     (string)          The transaction hash\n  \"amount\": unknown,   (value)           Amount paid to the recovery address\n  \"hex\": \"value\",      (string)          The serialized signed transaction\n },...],                                 \n},...]\n",
+		"getinvoice":                       "getinvoice id\n\nDescribes an invoice created by createinvoice.\n\nArguments:\n1. id (numeric, required) The invoice ID\n\nResult:\n{\n \"id\": n,             (numeric)         The invoice ID\n \"address\": \"value\",  (string)          The payment address\n \"cointype\": n,       (numeric)         The coin type to be paid (0=VAR, 1-255=SKA)\n \"amount\": unknown,   (value)           The invoiced amount\n \"received\": unknown, (value)           The total of the payments matched with the invoice, including unmined payments\n \"label\": \"value\",    (string)          A label describing the invoice\n \"created\": n,        (numeric)         The Unix time the invoice was created\n \"expires\": n,        (numeric)         The Unix time after which payments are no longer matched with the invoice, unset when the invoice does not expire\n \"status\": \"value\",   (string)          The invoice status (open, paid, or expired)\n \"uri\": \"value\",      (string)          The payment request URI of the invoice\n \"payments\": [{       (array of object) The wallet outputs paying the invoice\n  \"txid\": \"value\",    (string)          The transaction hash of the output\n  \"vout\": n,          (numeric)         The output index\n  \"tree\": n,          (numeric)         The transaction tree of the output\n  \"height\": n,        (numeric)         Height of the block mining the output, or -1 if unmined\n  \"amount\": unknown,  (value)           The output amount\n },...],                                \n}                     \n",
+		"getkdfinfo":                       "getkdfinfo\n\nDescribes the key derivation function deriving the key which protects the wallet's private keys from the private passphrase.\nKeys derived with scrypt, or with a lower Argon2id cost than configured, are upgraded to the configured Argon2id cost the next time the wallet is unlocked.\n\nArguments:\nNone\n\nResult:\n{\n \"algorithm\": \"value\",         (string)  The key derivation function: scrypt or argon2id\n \"n\": n,                       (numeric) The scrypt CPU/memory cost parameter, if the algorithm is scrypt\n \"r\": n,                       (numeric) The scrypt block size parameter, if the algorithm is scrypt\n \"p\": n,                       (numeric) The scrypt parallelization parameter, if the algorithm is scrypt\n \"time\": n,                    (numeric) The Argon2id time cost, if the algorithm is argon2id\n \"memory\": n,                  (numeric) The Argon2id memory cost in KiB, if the algorithm is argon2id\n \"threads\": n,                 (numeric) The Argon2id parallelism, if the algorithm is argon2id\n \"targettime\": n,              (numeric) The configured Argon2id time cost\n \"targetmemory\": n,            (numeric) The configured Argon2id memory cost in KiB\n \"upgradepending\": true|false, (boolean) Whether the key will be derived with the configured Argon2id cost after the next unlock\n}                              \n",
 		"getmasterpubkey":                  "getmasterpubkey (\"account\")\n\nRequests the master pubkey from the wallet.\n\nArguments:\n1. account (string, optional) The account to get the master pubkey for\n\nResult:\n\"value\" (string) The master pubkey for the wallet\n",
+		"getmaxspendable":                  "getmaxspendable \"account\" (cointype=0 minconf=1 feeperkb)\n\nReturns the largest amount of a coin type an account can send in a single transaction.\nEligible outputs are spent in decreasing order of value while each pays for the fee of its input and the transaction remains within the maximum transaction size.\n\nArguments:\n1. account  (string, required)             The account to spend from\n2. cointype (numeric, optional, default=0) Coin type to spend (0=VAR, 1-255=SKA)\n3. minconf  (numeric, optional, default=1) Minimum number of block confirmations required before an output is spent\n4. feeperkb (numeric, optional)            The fee rate per kB, the wallet's fee rate for the coin type when unset\n\nResult:\n{\n \"cointype\": n,     (numeric) The coin type of the transaction\n \"amount\": unknown, (value)   The largest amount which can be sent, zero when no non-dust output can be paid\n \"fee\": unknown,    (value)   The fee of the transaction sending the amount\n \"size\": n,         (numeric) The estimated serialize size of the signed transaction in bytes\n \"inputs\": n,       (numeric) The number of outputs spent by the transaction\n \"eligible\": n,     (numeric) The number of outputs eligible to be spent, including those too small to pay for their own input\n}                   \n",
+		"getmigrationhistory":              "getmigrationhistory\n\nReturns the database upgrades performed by the wallet since the migration history was created\n\nArguments:\nNone\n\nResult:\n[{\n \"version\": n,           (numeric) Database version the upgrade migrated to\n \"description\": \"value\", (string)  Description of the changes made by the upgrade\n \"time\": n,              (numeric) Unix time the upgrade was performed\n},...]\n",
 		"getmultisigoutinfo":               "getmultisigoutinfo \"hash\" index\n\nReturns information about a multisignature output.\n\nArguments:\n1. hash  (string, required)  Input hash to check.\n2. index (numeric, required) Index of input.\n\nResult:\n{\n \"address\": \"value\",       (string)          Script address.\n \"redeemscript\": \"value\",  (string)          Hex of the redeeming script.\n \"m\": n,                   (numeric)         m (in m-of-n)\n \"n\": n,                   (numeric)         n (in m-of-n)\n \"pubkeys\": [\"value\",...], (array of string) Associated pubkeys.\n \"txhash\": \"value\",        (string)          txhash\n \"blockheight\": n,         (numeric)         Height of the containing block.\n \"blockhash\": \"value\",     (string)          Hash of the containing block.\n \"spent\": true|false,      (boolean)         If it has been spent.\n \"spentby\": \"value\",       (string)          Hash of spending tx.\n \"spentbyindex\": n,        (numeric)         Index of spending tx.\n \"amount\": n.nnn,          (numeric)         Amount of coins contained.\n}                          \n",
 		"getnewaddress":                    "getnewaddress (\"account\" \"gappolicy\")\n\nGenerates and returns a new payment address.\n\nArguments:\n1. account   (string, optional) Account name the new address will belong to (default=\"default\")\n2. gappolicy (string, optional) String defining the policy to use when the BIP0044 gap limit would be violated, may be \"error\", \"ignore\", or \"wrap\"\n\nResult:\n\"value\" (string) The payment address\n",
 		"getpeerinfo":                      "getpeerinfo\n\nReturns data on remote peers when in spv mode.\n\nArguments:\nNone\n\nResult:\n{\n \"id\": n,              (numeric) A unique node ID\n \"addr\": \"value\",      (string)  The remote IP address and port of the peer\n \"addrlocal\": \"value\", (string)  The local IP address and port of the peer\n \"services\": \"value\",  (string)  Services bitmask which represents the services supported by the peer\n \"version\": n,         (numeric) The protocol version of the peer\n \"subver\": \"value\",    (string)  The user agent of the peer\n \"startingheight\": n,  (numeric) The latest block height the peer knew about when the connection was established\n \"banscore\": n,        (numeric) The ban score\n}                      \n",
 		"getrawchangeaddress":              "getrawchangeaddress (\"account\")\n\nGenerates and returns a new internal payment address for use as a change address in raw transactions.\n\nArguments:\n1. account (string, optional) Account name the new internal address will belong to (default=\"default\")\n\nResult:\n\"value\" (string) The internal payment address\n",
 		"getreceivedbyaccount":             "getreceivedbyaccount \"account\" (minconf=1)\n\nReturns the total amount received by addresses of some account, including spent outputs.\n\nArguments:\n1. account (string, required)             Account name to query total received amount for\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in Monetarium\n",
 		"getreceivedbyaddress":             "getreceivedbyaddress \"address\" (minconf=1 cointype=0)\n\nReturns the total amount received by a single address, including spent outputs.\n\nArguments:\n1. address  (string, required)             Payment address which received outputs to include in total\n2. minconf  (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n3. cointype (numeric, optional, default=0) Coin type to filter results (0=VAR, 1-255=SKA coin types)\n\nResult:\nn.nnn (numeric) The total received amount valued in Monetarium\n",
+		"getrescanstatus":                  "getrescanstatus\n\nReturns the progress of the active rescan, or of an interrupted rescan which resumes from its last rescanned block the next time the wallet syncs.\n\nArguments:\nNone\n\nResult:\n{\n \"rescanning\": true|false, (boolean) Whether a rescan is currently being performed\n \"startheight\": n,         (numeric) Height of the first block of the rescan\n \"height\": n,              (numeric) Height of the last block for which all transactions have been rescanned\n \"tipheight\": n,           (numeric) Height of the main chain tip block\n \"percent\": n.nnn,         (numeric) Percentage of blocks from the start height through the tip which have been rescanned\n \"eta\": n,                 (numeric) Estimated seconds remaining until the active rescan completes, or 0 when unknown\n}                          \n",
+		"getrpcstats":                      "getrpcstats (count=20 \"method\")\n\nReturns statistics of the most recent JSON-RPC and gRPC requests of each method, including the time waited on wallet locks and the database transactions performed, and the most recent requests.\n\nArguments:\n1. count  (numeric, optional, default=20) Maximum number of the most recent requests to return\n2. method (string, optional)              Only return requests of this method\n\nResult:\n{\n \"slowthreshold\": n.nnn,  (numeric)         Duration in seconds at and above which requests are logged as slow, or 0 if slow requests are not logged\n \"methods\": [{            (array of object) Statistics of the recorded requests of each method\n  \"method\": \"value\",      (string)          The JSON-RPC method or full gRPC method name\n  \"count\": n,             (numeric)         Number of recorded requests\n  \"errors\": n,            (numeric)         Number of requests which errored\n  \"slow\": n,              (numeric)         Number of slow requests\n  \"meanduration\": n.nnn,  (numeric)         Mean duration of the requests in seconds\n  \"maxduration\": n.nnn,   (numeric)         Maximum duration of the requests in seconds\n  \"meanlockwait\": n.nnn,  (numeric)         Mean seconds requests waited to acquire wallet locks\n  \"meandbviews\": n.nnn,   (numeric)         Mean number of database read transactions\n  \"meandbupdates\": n.nnn, (numeric)         Mean number of database read/write transactions\n },...],                                    \n \"recent\": [{             (array of object) The most recent requests, most recent first\n  \"method\": \"value\",      (string)          The JSON-RPC method or full gRPC method name\n  \"requestid\": \"value\",   (string)          ID of the request recorded in the log\n  \"time\": n,              (numeric)         Unix time the request was started\n  \"duration\": n.nnn,      (numeric)         Duration of the request in seconds\n  \"lockwait\": n.nnn,      (numeric)         Seconds waited to acquire wallet locks\n  \"dbviews\": n,           (numeric)         Number of database read transactions\n  \"dbupdates\": n,         (numeric)         Number of database read/write transactions\n  \"slow\": true|false,     (boolean)         Whether the request was slow\n  \"error\": \"value\",       (string)          Error returned by the request, if any\n },...],                                    \n}                         \n",
+		"getsendpolicy":                    "getsendpolicy\n\nReturns the policy evaluated before the wallet publishes a send\n\nArguments:\nNone\n\nResult:\n{\n \"blocklist\": [{             (array of object) Addresses which sends may not pay\n  \"address\": \"value\",        (string)          The blocked address\n  \"reason\": \"value\",         (string)          The reason the address is blocked, if any\n },...],                                       \n \"labelthresholds\": [{       (array of object) Amounts of each coin type at and above which sends must be labeled\n  \"cointype\": n,             (numeric)         Coin type of the threshold\n  \"threshold\": unknown,      (value)           Amount at and above which sends must be labeled\n },...],                                       \n \"skasendaccounts\": [{       (array of object) Accounts which may send each restricted SKA coin type\n  \"cointype\": n,             (numeric)         The SKA coin type\n  \"accounts\": [\"value\",...], (array of string) Names of the only accounts which may send the coin type\n },...],                                       \n}                            \n",
+		"getskaemissionhistory":            "getskaemissionhistory (cointype)\n\nReturns the SKA emission transactions observed by the wallet in main chain blocks, in increasing block height order.\nOnly emissions relevant to the wallet, such as those paying wallet addresses, are observed.\n\nArguments:\n1. cointype (numeric, optional) Optional SKA coin type to limit the history to (1-255)\n\nResult:\n[{\n \"cointype\": n,            (numeric) The SKA coin type emitted\n \"height\": n,              (numeric) Height of the block mining the emission\n \"txid\": \"value\",          (string)  The hash of the emission transaction\n \"amount\": \"value\",        (string)  Total amount emitted\n \"emissionkey\": \"value\",   (string)  Hex-encoded emission public key authorizing the emission\n \"nonce\": n,               (numeric) Nonce of the emission authorization\n \"emitterscript\": \"value\", (string)  Hex-encoded authorization script of the emission input\n},...]\n",
+		"getskasupply":                     "getskasupply (cointype)\n\nReturns the supply of each SKA coin type configured by the network or with observed emissions, counted from the emissions observed by the wallet.\n\nArguments:\n1. cointype (numeric, optional) Optional SKA coin type to limit the result to (1-255)\n\nResult:\n[{\n \"cointype\": n,           (numeric) The SKA coin type\n \"maxsupply\": \"value\",    (string)  Maximum supply set by the network governance parameters\n \"emitted\": \"value\",      (string)  Total amount of the observed emissions\n \"remaining\": \"value\",    (string)  Maximum supply not yet observed as emitted\n \"emissions\": n,          (numeric) Number of observed emissions\n \"lastemissionheight\": n, (numeric) Block height of the latest observed emission, or -1 when none were observed\n},...]\n",
 		"getstakeinfo":                     "getstakeinfo\n\nReturns statistics about staking from the wallet.\n\nArguments:\nNone\n\nResult:\n{\n \"blockheight\": n,          (numeric) Current block height for stake info.\n \"difficulty\": n.nnn,       (numeric) Current stake difficulty.\n \"totalsubsidy\": n.nnn,     (numeric) Total amount of coins earned by proof-of-stake voting\n \"ownmempooltix\": n,        (numeric) Number of tickets submitted by this wallet currently in mempool\n \"immature\": n,             (numeric) Number of tickets from this wallet that are in the blockchain but which are not yet mature\n \"unspent\": n,              (numeric) Number of unspent tickets\n \"voted\": n,                (numeric) Number of votes cast by this wallet\n \"revoked\": n,              (numeric) Number of missed tickets that were missed and then revoked\n \"unspentexpired\": n,       (numeric) Number of unspent tickets which are past expiry\n \"poolsize\": n,             (numeric) Number of live tickets in the ticket pool.\n \"allmempooltix\": n,        (numeric) Number of tickets currently in the mempool\n \"live\": n,                 (numeric) Number of mature, active tickets owned by this wallet\n \"proportionlive\": n.nnn,   (numeric) (Live / PoolSize)\n \"missed\": n,               (numeric) Number of missed tickets (failure to vote, not including expired)\n \"proportionmissed\": n.nnn, (numeric) (Missed / (Missed + Voted))\n \"expired\": n,              (numeric) Number of tickets that have expired\n}                           \n",
+		"getstakestats":                    "getstakestats (window=0)\n\nReturns statistics of the wallet's tickets and earned stake rewards.\nVotes, missed and expired tickets, and rewards are counted from stake transactions recorded as they are mined, and include only transactions mined after the wallet database was upgraded to record them unless the wallet is rescanned.\n\nArguments:\n1. window (numeric, optional, default=0) Number of most recent blocks to compute vote statistics over, or 0 for the entire chain\n\nResult:\n{\n \"blockheight\": n,           (numeric)         Height of the main chain tip block\n \"live\": n,                  (numeric)         Number of mature, unexpired tickets owned by this wallet\n \"immature\": n,              (numeric)         Number of tickets owned by this wallet which are not yet mature\n \"missed\": n,                (numeric)         Number of tickets which missed their vote and were revoked\n \"expired\": n,               (numeric)         Number of tickets which expired and were revoked\n \"revoked\": n,               (numeric)         Number of revoked tickets\n \"feerewards\": [{            (array of object) SSFee rewards earned by the wallet, by coin type\n  \"cointype\": n,             (numeric)         Coin type of the reward\n  \"amount\": unknown,         (value)           Total reward earned in the coin type\n },...],                                       \n \"window\": n,                (numeric)         Number of blocks the vote statistics cover, or 0 for the entire chain\n \"votes\": n,                 (numeric)         Number of votes cast by the wallet within the window\n \"votesuccessrate\": n.nnn,   (numeric)         Votes / (Votes + missed votes) within the window, or 0 when no tickets were called\n \"averagevotereward\": n.nnn, (numeric)         Average stakebase subsidy earned per vote within the window\n}                            \n",
 		"gettickets":                       "gettickets includeimmature\n\nReturning the hashes of the tickets currently owned by wallet.\n\nArguments:\n1. includeimmature (boolean, required) If true include immature tickets in the results.\n\nResult:\n{\n \"hashes\": [\"value\",...], (array of string) Hashes of the tickets owned by the wallet encoded as strings\n}                         \n",
-		"gettransaction":                   "gettransaction \"txid\" (includewatchonly=false)\n\nReturns a JSON object with details regarding a transaction relevant to this wallet.\n\nArguments:\n1. txid             (string, required)                 Hash of the transaction to query\n2. includewatchonly (boolean, optional, default=false) Also consider transactions involving watched addresses\n\nResult:\n{\n \"amount\": unknown,                (value)           The total amount this transaction credits to the wallet, valued in Monetarium\n \"fee\": unknown,                   (value)           The total input value minus the total output value, or 0 if 'txid' is not a sent transaction\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"txid\": \"value\",                  (string)          The transaction hash\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"details\": [{                     (array of object) Additional details for each recorded wallet credit and debit\n  \"account\": \"value\",              (string)          DEPRECATED -- Unset\n  \"address\": \"value\",              (string)          The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input\n  \"amount\": unknown,               (value)           The amount of a received output\n  \"category\": \"value\",             (string)          The kind of detail: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs\n  \"involveswatchonly\": true|false, (boolean)         Unset\n  \"fee\": unknown,                  (value)           The included fee for a sent transaction\n  \"vout\": n,                       (numeric)         The transaction output index\n },...],                                             \n \"hex\": \"value\",                   (string)          The transaction encoded as a hexadecimal string\n \"type\": \"value\",                  (string)          The type of transaction (regular, ticket, vote, or revocation)\n \"ticketstatus\": \"value\",          (string)          Status of ticket (if transaction is a ticket)\n}                                  \n",
+		"gettransaction":                   "gettransaction \"txid\" (includewatchonly=false)\n\nReturns a JSON object with details regarding a transaction relevant to this wallet.\n\nArguments:\n1. txid             (string, required)                 Hash of the transaction to query\n2. includewatchonly (boolean, optional, default=false) Also consider transactions involving watched addresses\n\nResult:\n{\n \"amount\": unknown,                (value)           The total amount this transaction credits to the wallet, valued in Monetarium\n \"fee\": unknown,                   (value)           The total input value minus the total output value, or 0 if 'txid' is not a sent transaction\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"txid\": \"value\",                  (string)          The transaction hash\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"details\": [{                     (array of object) Additional details for each recorded wallet credit and debit\n  \"account\": \"value\",              (string)          DEPRECATED -- Unset\n  \"address\": \"value\",              (string)          The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input\n  \"amount\": unknown,               (value)           The amount of a received output\n  \"category\": \"value\",             (string)          The kind of detail: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs\n  \"involveswatchonly\": true|false, (boolean)         Unset\n  \"fee\": unknown,                  (value)           The included fee for a sent transaction\n  \"vout\": n,                       (numeric)         The transaction output index\n },...],                                             \n \"hex\": \"value\",                   (string)          The transaction encoded as a hexadecimal string\n \"type\": \"value\",                  (string)          The type of transaction (regular, ticket, vote, or revocation)\n \"ticketstatus\": \"value\",          (string)          Status of ticket (if transaction is a ticket)\n \"label\": \"value\",                 (string)          Label recorded for the transaction, if any\n \"fiatcurrency\": \"value\",          (string)          Currency of the recorded price of the transaction's coin type at its block time, if any\n \"fiatprice\": \"value\",             (string)          Latest price of one coin recorded at or before the block time, if any\n \"fiatpricetime\": n,               (numeric)         The Unix time the price was recorded for, if any\n}                                  \n",
+		"gettransactionspage":              "gettransactionspage (cursor=\"\" limit=100 cointype \"txclass\" startheight endheight)\n\nReturns a page of wallet transactions and the cursor of the following page.\nUnmined transactions are returned first, followed by mined transactions in descending block height order and increasing hash order within a block.\n\nArguments:\n1. cursor      (string, optional, default=\"\")   Cursor returned by a previous request, or the empty string for the first page\n2. limit       (numeric, optional, default=100) Maximum number of transactions to return\n3. cointype    (numeric, optional)              Only return transactions of this coin type (0=VAR, 1-255=SKA), the coin type of their first SKA output or VAR\n4. txclass     (string, optional)               Only return transactions of this class (regular, coinbase, ticket, vote, revocation, or ssfee)\n5. startheight (numeric, optional)              Only return transactions mined at or above this block height\n6. endheight   (numeric, optional)              Only return transactions mined at or below this block height, excluding unmined transactions\n\nResult:\n{\n \"transactions\": [{     (array of object) Transactions of the page\n  \"txid\": \"value\",      (string)          The transaction hash\n  \"blockhash\": \"value\", (string)          The hash of the block this transaction is mined in, unset if unmined\n  \"blockheight\": n,     (numeric)         The height of the block this transaction is mined in, or -1 if unmined\n  \"time\": n,            (numeric)         The earliest Unix time this transaction was known to exist\n  \"txclass\": \"value\",   (string)          The class of the transaction (regular, coinbase, ticket, vote, revocation, or ssfee)\n  \"hex\": \"value\",       (string)          The transaction encoded as a hexadecimal string\n },...],                                  \n \"nextcursor\": \"value\", (string)          Cursor of the following page, unset when the page holds the last transaction\n}                       \n",
+		"gettxtrace":                       "gettxtrace \"txhash\"\n\nReturns the lifecycle timeline of a transaction originated by the wallet, from construction through signing, publishing, mempool acceptance, confirmation, and maturity.\nEvery traced transaction is assigned a trace ID which is included in wallet logs and transaction notifications.\n\nArguments:\n1. txhash (string, required) Hash of the transaction\n\nResult:\n{\n \"txhash\": \"value\",  (string)          Hash of the traced transaction\n \"traceid\": \"value\", (string)          Trace ID of the transaction\n \"events\": [{        (array of object) Lifecycle events of the transaction in the order they occurred\n  \"stage\": \"value\",  (string)          Lifecycle stage (constructed, signed, published, mempool, confirmed, or mature)\n  \"time\": n,         (numeric)         Unix time the stage was reached\n  \"height\": n,       (numeric)         Block height of confirmation or maturity\n },...],                               \n}                    \n",
 		"gettxout":                         "gettxout \"txid\" vout tree (includemempool=true)\n\nReturns information about an unspent transaction output.\n\nArguments:\n1. txid           (string, required)                The hash of the transaction\n2. vout           (numeric, required)               The index of the output\n3. tree           (numeric, required)               The tree of the transaction\n4. includemempool (boolean, optional, default=true) Include the mempool when true\n\nResult:\n{\n \"bestblock\": \"value\",        (string)          The block hash that contains the transaction output\n \"confirmations\": n,          (numeric)         The number of confirmations\n \"value\": n.nnn,              (numeric)         The transaction amount in VAR\n \"scriptPubKey\": {            (object)          The public key script used to pay coins as a JSON object\n  \"asm\": \"value\",             (string)          Disassembly of the script\n  \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n  \"reqSigs\": n,               (numeric)         The number of required signatures\n  \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n  \"addresses\": [\"value\",...], (array of string) The Monetarium addresses associated with this script\n  \"commitamt\": n.nnn,         (numeric)         The ticket commitment value if the script is for a staking commitment\n  \"version\": n,               (numeric)         The script version\n },                                             \n \"coinbase\": true|false,      (boolean)         Whether or not the transaction is a coinbase\n}                             \n",
 		"getunconfirmedbalance":            "getunconfirmedbalance (\"account\")\n\nCalculates the unspent output value of all unmined transaction outputs for an account.\n\nArguments:\n1. account (string, optional) The account to query the unconfirmed balance for (default=\"default\")\n\nResult:\nn.nnn (numeric) Total amount of all unmined unspent outputs of the account valued in Monetarium.\n",
-		"getvotechoices":                   "getvotechoices (\"tickethash\")\n\nRetrieve the currently configured default vote choices for the latest supported stake agendas\n\nArguments:\n1. tickethash (string, optional) The hash of the ticket to return vote choices for. If the ticket has no choices set, the default vote choices are returned\n\nResult:\n{\n \"version\": n,                  (numeric)         The latest stake version supported by the software and the version of the included agendas\n \"choices\": [{                  (array of object) The currently configured agenda vote choices, including abstaining votes\n  \"agendaid\": \"value\",          (string)          The ID for the agenda the choice concerns\n  \"agendadescription\": \"value\", (string)          A description of the agenda the choice concerns\n  \"choiceid\": \"value\",          (string)          The ID of the current choice for this agenda\n  \"choicedescription\": \"value\", (string)          A description of the current choice for this agenda\n },...],                                          \n}                               \n",
-		"getvotefeeconsolidationaddress":   "getvotefeeconsolidationaddress \"account\"\n\nGet the consolidation address for vote fee (SSFee) payments for a specific account.\nReturns the custom address if set, or the default first external address (index 0) otherwise.\n\nArguments:\n1. account (string, required) The account name or number\n\nResult:\n{\n \"account\": \"value\",      (string)  The account name\n \"address\": \"value\",      (string)  The consolidation address\n \"isdefault\": true|false, (boolean) True if using the default address (first external), false if custom address is set\n}                         \n",
+		"getvotechoices":                   "getvotechoices (\"tickethash\")\n\nRetrieve the currently configured default vote choices for the latest supported stake agendas\n\nArguments:\n1. tickethash (string, optional) The hash of the ticket to return vote choices for. If the ticket has no choices set, the default vote choices are returned\n\nResult:\n{\n \"version\": n,                  (numeric)         The latest stake version supported by the software and the version of the included agendas\n \"choices\": [{                  (array of object) The currently configured agenda vote choices, including abstaining votes\n  \"agendaid\": \"value\",          (string)          The ID for the agenda the choice concerns\n  \"agendadescription\": \"value\", (string)          A description of the agenda the choice concerns\n  \"agendastatus\": \"value\",      (string)          Deployment status of the agenda (defined, started, lockedin, active, or failed), if reported by the consensus RPC server\n  \"choiceid\": \"value\",          (string)          The ID of the current choice for this agenda\n  \"choicedescription\": \"value\", (string)          A description of the current choice for this agenda\n },...],                                          \n}                               \n",
+		"getvotefeeconsolidationaddress":   "getvotefeeconsolidationaddress \"account\"\n\nGet the consolidation address for vote fee (SSFee) payments for a specific account.\nReturns the custom address if set, or the default first external address (index 0) otherwise.\n\nArguments:\n1. account (string, required) The account name or number\n\nResult:\n{\n \"account\": \"value\",      (string)  The account name\n \"address\": \"value\",      (string)  The consolidation address\n \"isdefault\": true|false, (boolean) True if using the default address (first external), false if custom address is set\n \"external\": true|false,  (boolean) True if the custom address is not controlled by the account, or was set before its ownership was verified\n}                         \n",
+		"getvspticketstatus":               "getvspticketstatus \"tickethash\"\n\nReturns the status of a ticket's fee payment to the VSP it is registered with. The status reported by the VSP is included when the VSP was selected with setvsp, or set with --vsp.url.\n\nArguments:\n1. tickethash (string, required) Hash of the ticket\n\nResult:\n{\n \"tickethash\": \"value\",         (string)  Hash of the ticket\n \"host\": \"value\",               (string)  Host of the VSP the ticket is registered with\n \"feetxhash\": \"value\",          (string)  Hash of the fee transaction, if one has been created\n \"feetxstatus\": \"value\",        (string)  Fee payment status tracked by the wallet (started/paid/errored/confirmed)\n \"vspfeetxstatus\": \"value\",     (string)  Fee transaction status reported by the VSP\n \"ticketconfirmed\": true|false, (boolean) Whether the VSP reports the ticket as confirmed\n}                               \n",
+		"getwalletdbversion":               "getwalletdbversion\n\nReturns the schema version of the wallet database.\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,           (numeric) The database version recorded by the wallet\n \"compatibleversion\": n, (numeric) The oldest database version understood by software which may open the database\n \"latestversion\": n,     (numeric) The latest database version understood by this software\n}                        \n",
 		"getwalletfee":                     "getwalletfee (cointype=0)\n\nGet currently set transaction fee for the wallet\n\nArguments:\n1. cointype (numeric, optional, default=0) Coin type to get fee for (0=VAR, 1-255=SKA coin types)\n\nResult:\nn.nnn (numeric) Current tx fee (in VAR)\n",
+		"getwalletlockstate":               "getwalletlockstate\n\nReturns whether the wallet is unlocked, and when and how an unlocked wallet will be locked again.\n\nArguments:\nNone\n\nResult:\n{\n \"unlocked\": true|false,        (boolean) Whether the wallet is unlocked\n \"mode\": \"value\",               (string)  The unlock mode of an unlocked wallet: all, once, or staking\n \"expires\": n,                  (numeric) The Unix time the wallet will be locked due to the unlock timeout, if any\n \"secondsleft\": n,              (numeric) The number of seconds until the wallet is locked due to the unlock timeout, if any\n \"stakingunlocked\": true|false, (boolean) Whether the staking keys are unlocked, independently of the wallet\n}                               \n",
 		"clearvotefeeconsolidationaddress": "clearvotefeeconsolidationaddress \"account\"\n\nClear the custom consolidation address for vote fee (SSFee) payments, reverting to the default first external address (index 0).\n\nArguments:\n1. account (string, required) The account name or number\n\nResult:\nNothing\n",
 		"getcfilterv2":                     "getcfilterv2 \"blockhash\"\n\nReturns the version 2 block filter for the given block along with the key required to query it for matches against committed scripts.\n\nArguments:\n1. blockhash (string, required) The block hash of the filter to retrieve\n\nResult:\n{\n \"blockhash\": \"value\", (string) The block hash for which the filter includes data\n \"filter\": \"value\",    (string) Hex-encoded bytes of the serialized filter\n \"key\": \"value\",       (string) The key required to query the filter for matches against committed scripts\n}                      \n",
 		"help":                             "help (\"command\")\n\nReturns a list of all commands or help for a specified command.\n\nArguments:\n1. command (string, optional) The command to retrieve help for\n\nResult (no command provided):\n\"value\" (string) List of commands\n\nResult (command specified):\n\"value\" (string) Help for specified command\n",
 		"importcfiltersv2":                 "importcfiltersv2 startheight [\"filter\",...]\n\nImports a list of v2 cfilters into the wallet. Does not perform validation on the filters\n\nArguments:\n1. startheight (numeric, required)         The starting block height for this list of cfilters\n2. filters     (array of string, required) The list of hex-encoded cfilters\n\nResult:\nNothing\n",
+		"importcounterparties":             "importcounterparties {\"counterparty\":[\"address\",...],...}\n\nImports counterparty address tags, such as those returned by exportcounterparties.\n\nArguments:\n1. tags (object, required) Counterparty address tags\n{\n \"Counterparty name\": Array of addresses to tag with the counterparty, (object) Object keying counterparty names to arrays of external addresses\n ...\n}\n\nResult:\nn.nnn (numeric) The number of tagged addresses\n",
 		"importemissionkey":                "importemissionkey \"keyname\" \"privatekey\" \"passphrase\" (cointype)\n\nImports a private key for SKA emission authorization (emergency/recovery only).\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. keyname    (string, required)  Unique identifier for this emission key\n2. privatekey (string, required)  Hex-encoded secp256k1 private key or encrypted format\n3. passphrase (string, required)  Wallet passphrase for key encryption\n4. cointype   (numeric, optional) Optional SKA coin type (1-255) for organization\n\nResult:\n\"value\" (string) The public key corresponding to the imported private key\n",
-		"importprivkey":                    "importprivkey \"privkey\" (\"label\" rescan=true scanfrom)\n\nImports a WIF-encoded private key to the 'imported' account.\n\nArguments:\n1. privkey  (string, required)                The WIF-encoded private key\n2. label    (string, optional)                Unused (must be unset or 'imported')\n3. rescan   (boolean, optional, default=true) Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n4. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
-		"importpubkey":                     "importpubkey \"pubkey\" (\"label\" rescan=true scanfrom)\n\nImports a compressed (33-byte) secp256k1 public key and the derived P2PKH address to the imported account.\n\nArguments:\n1. pubkey   (string, required)                The hex-encoded 33-byte compressed public key\n2. label    (string, optional)                Unused (must be unset or 'imported')\n3. rescan   (boolean, optional, default=true) Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n4. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
-		"importscript":                     "importscript \"hex\" (rescan=true scanfrom)\n\nImport a redeem script.\n\nArguments:\n1. hex      (string, required)                Hex encoded script to import\n2. rescan   (boolean, optional, default=true) Rescans the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n3. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
-		"importxpub":                       "importxpub \"name\" \"xpub\"\n\nImport a HD extended public key as a new account.\n\nArguments:\n1. name (string, required) Name of new account\n2. xpub (string, required) Extended public key\n\nResult:\nNothing\n",
-		"listaccounts":                     "listaccounts (minconf=1)\n\nDEPRECATED -- Returns a JSON object of all accounts and their balances.\n\nArguments:\n1. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"The account name\": The account balance valued in Monetarium, (object) JSON object with account names as keys and Monetarium amounts as values\n ...\n}\n",
-		"listaddresstransactions":          "listaddresstransactions [\"address\",...] (\"account\")\n\nReturns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to filter transaction results by\n2. account   (string, optional)          Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": unknown,                (value)           The value of the transaction output valued in Monetarium\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": unknown,                   (value)           The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"listalltransactions":              "listalltransactions (\"account\")\n\nReturns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\n\nArguments:\n1. account (string, optional) Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": unknown,                (value)           The value of the transaction output valued in Monetarium\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": unknown,                   (value)           The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
+		"importlegacywallet":               "importlegacywallet \"source\" (\"pubpassphrase\")\n\nImports a wallet database of upstream dcrwallet and opens the imported wallet. No wallet may be loaded or exist.\nThe legacy database is copied and never modified. The copy is upgraded, recording every output as a VAR output, and is only kept once its accounts, transactions, and balance are verified to match the legacy database.\n\nArguments:\n1. source        (string, required) Path of the dcrwallet wallet.db file\n2. pubpassphrase (string, optional) Public passphrase of the legacy wallet (default insecure public passphrase)\n\nResult:\n{\n \"legacyversion\": n,  (numeric) The database version of the legacy wallet\n \"version\": n,        (numeric) The database version of the imported wallet\n \"lastaccount\": n,    (numeric) The last BIP0044 account number of the wallet\n \"transactions\": n,   (numeric) The number of mined and unmined transactions recorded by the wallet\n \"unspentoutputs\": n, (numeric) The number of unspent outputs recorded by the wallet\n \"balance\": n.nnn,    (numeric) The total value of the unspent outputs, in VAR\n}                     \n",
+		"importprivkey":                    "importprivkey \"privkey\" (\"label\" rescan=true scanfrom)\n\nImports a WIF-encoded private key to the 'imported' account or another account.\n\nArguments:\n1. privkey  (string, required)                The WIF-encoded private key\n2. label    (string, optional)                Account the key is imported to (default='imported')\n3. rescan   (boolean, optional, default=true) Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n4. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
+		"importpubkey":                     "importpubkey \"pubkey\" (\"label\" rescan=true scanfrom)\n\nImports a compressed (33-byte) secp256k1 public key and the derived P2PKH address to the imported account or another account.\n\nArguments:\n1. pubkey   (string, required)                The hex-encoded 33-byte compressed public key\n2. label    (string, optional)                Account the key is imported to (default='imported')\n3. rescan   (boolean, optional, default=true) Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n4. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
+		"importscript":                     "importscript \"hex\" (rescan=true scanfrom \"account\")\n\nImport a redeem script.\n\nArguments:\n1. hex      (string, required)                Hex encoded script to import\n2. rescan   (boolean, optional, default=true) Rescans the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n3. scanfrom (numeric, optional)               Block number for where to start rescan from\n4. account  (string, optional)                Account the script is imported to (default='imported')\n\nResult:\nNothing\n",
+		"importxpub":                       "importxpub \"name\" \"xpub\" (gaplimit rescan=true scanfrom)\n\nImport a HD extended public key as a new watching-only account. Unless disabled, addresses of the account used since the scan height are discovered and blocks rescanned in the background.\n\nArguments:\n1. name     (string, required)                Name of new account\n2. xpub     (string, required)                Extended public key\n3. gaplimit (numeric, optional)               Allowed gap of unused addresses on each branch of the account, or null to use the wallet's gap limit\n4. rescan   (boolean, optional, default=true) Discover used addresses of the account and rescan blocks for its transactions\n5. scanfrom (numeric, optional)               Block height to begin the rescan from, or null to begin from the wallet birthday block\n\nResult:\nNothing\n",
+		"listaccounts":                     "listaccounts (minconf=1 includearchived=false)\n\nDEPRECATED -- Returns a JSON object of all accounts and their balances.\n\nArguments:\n1. minconf         (numeric, optional, default=1)     Minimum number of block confirmations required before an unspent output's value is included in the balance\n2. includearchived (boolean, optional, default=false) Include archived accounts in the result\n\nResult:\n{\n \"The account name\": The account balance valued in Monetarium, (object) JSON object with account names as keys and Monetarium amounts as values\n ...\n}\n",
+		"listaddresstransactions":          "listaddresstransactions [\"address\",...] (\"account\")\n\nReturns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to filter transaction results by\n2. account   (string, optional)          Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": unknown,                (value)           The value of the transaction output valued in Monetarium\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": unknown,                   (value)           The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n \"counterparty\": \"value\",          (string)          The counterparty tagged for the paid address (sends) or funding inputs (receives)\n},...]\n",
+		"listalltransactions":              "listalltransactions (\"account\")\n\nReturns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\n\nArguments:\n1. account (string, optional) Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": unknown,                (value)           The value of the transaction output valued in Monetarium\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": unknown,                   (value)           The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n \"counterparty\": \"value\",          (string)          The counterparty tagged for the paid address (sends) or funding inputs (receives)\n},...]\n",
 		"listcointypes":                    "listcointypes (minconf=1)\n\nReturns a JSON array of objects representing coin types with non-zero balances in the wallet.\n\nArguments:\n1. minconf (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is considered for balance calculation\n\nResult:\n{\n \"cointypes\": [{      (array of object) Array of coin type information objects\n  \"cointype\": n,      (numeric)         The coin type number (0=VAR, 1-255=SKA)\n  \"name\": \"value\",    (string)          Human-readable name of the coin type\n  \"balance\": unknown, (value)           Total balance for this coin type\n },...],                                \n}                     \n",
+		"listinvoices":                     "listinvoices (\"status\")\n\nDescribes every invoice created by createinvoice, ordered by ID.\n\nArguments:\n1. status (string, optional) If set, only describes invoices with the status (open, paid, or expired)\n\nResult:\n[{\n \"id\": n,             (numeric)         The invoice ID\n \"address\": \"value\",  (string)          The payment address\n \"cointype\": n,       (numeric)         The coin type to be paid (0=VAR, 1-255=SKA)\n \"amount\": unknown,   (value)           The invoiced amount\n \"received\": unknown, (value)           The total of the payments matched with the invoice, including unmined payments\n \"label\": \"value\",    (string)          A label describing the invoice\n \"created\": n,        (numeric)         The Unix time the invoice was created\n \"expires\": n,        (numeric)         The Unix time after which payments are no longer matched with the invoice, unset when the invoice does not expire\n \"status\": \"value\",   (string)          The invoice status (open, paid, or expired)\n \"uri\": \"value\",      (string)          The payment request URI of the invoice\n \"payments\": [{       (array of object) The wallet outputs paying the invoice\n  \"txid\": \"value\",    (string)          The transaction hash of the output\n  \"vout\": n,          (numeric)         The output index\n  \"tree\": n,          (numeric)         The transaction tree of the output\n  \"height\": n,        (numeric)         Height of the block mining the output, or -1 if unmined\n  \"amount\": unknown,  (value)           The output amount\n },...],                                \n},...]\n",
 		"listlockunspent":                  "listlockunspent (\"account\")\n\nReturns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.\n\nArguments:\n1. account (string, optional) If set, only returns outpoints from this account that are marked as locked\n\nResult:\n[{\n \"amount\": n.nnn, (numeric) The previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n",
+		"listpendingbroadcasts":            "listpendingbroadcasts\n\nReturns every published transaction queued to be republished, with exponential backoff, until it is mined\n\nArguments:\nNone\n\nResult:\n[{\n \"txid\": \"value\",      (string)  The transaction hash\n \"queued\": n,          (numeric) The Unix time the transaction was queued after it was first published\n \"attempts\": n,        (numeric) The number of times the transaction has been republished\n \"lastattempt\": n,     (numeric) The Unix time the transaction was last republished, unset if never\n \"nextattempt\": n,     (numeric) The Unix time the transaction is next republished\n \"lasterror\": \"value\", (string)  The error of the last attempt to republish the transaction, unset if it succeeded\n},...]\n",
+		"listpendingsends":                 "listpendingsends\n\nReturns every send awaiting approval after exceeding the daily spend limit of its account, ordered by ID\n\nArguments:\nNone\n\nResult:\n[{\n \"id\": n,                (numeric)         The pending send ID\n \"account\": \"value\",     (string)          Name of the sending account\n \"cointype\": n,          (numeric)         Coin type of the send\n \"amount\": unknown,      (value)           Amount counted against the daily spend limit, unset for sweeps\n \"outputs\": [{           (array of object) The outputs paid by the send\n  \"address\": \"value\",    (string)          The address paid by the output, unset for outputs not paying a single address\n  \"amount\": unknown,     (value)           The output amount, unset for sweeps\n },...],                                   \n \"sweep\": true|false,    (boolean)         Whether the send sweeps every eligible output of the account to the only output\n \"treasury\": true|false, (boolean)         Whether the send adds the outputs to the treasury\n \"label\": \"value\",       (string)          The label recorded for the transaction once sent, if any\n \"created\": n,           (numeric)         The Unix time the send was recorded\n},...]\n",
 		"listreceivedbyaccount":            "listreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing all accounts and the total amount received by each account.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\", (string)  The name of the account\n \"amount\": n.nnn,    (numeric) Total amount received by payment addresses of the account valued in Monetarium\n \"confirmations\": n, (numeric) Number of block confirmations of the most recent transaction relevant to the account\n},...]\n",
 		"listreceivedbyaddress":            "listreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing wallet payment addresses and their total received amounts.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",              (string)          DEPRECATED -- Unset\n \"address\": \"value\",              (string)          The payment address\n \"amount\": n.nnn,                 (numeric)         Total amount received by the payment address valued in Monetarium\n \"confirmations\": n,              (numeric)         Number of block confirmations of the most recent transaction relevant to the address\n \"txids\": [\"value\",...],          (array of string) Transaction hashes of all transactions involving this address\n \"involvesWatchonly\": true|false, (boolean)         Unset\n},...]\n",
-		"listsinceblock":                   "listsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\n\nReturns a JSON array of objects listing details of all wallet transactions after some block.\n\nArguments:\n1. blockhash           (string, optional)                 Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\n2. targetconfirmations (numeric, optional, default=1)     Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\n3. includewatchonly    (boolean, optional, default=false) Unused\n\nResult:\n{\n \"transactions\": [{                 (array of object) JSON array of objects containing verbose details of the each transaction\n  \"account\": \"value\",               (string)          DEPRECATED -- Unset\n  \"address\": \"value\",               (string)          Payment address for a transaction output\n  \"amount\": unknown,                (value)           The value of the transaction output valued in Monetarium\n  \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n  \"blockindex\": n,                  (numeric)         Unset\n  \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n  \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n  \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n  \"fee\": unknown,                   (value)           The total input value minus the total output value for sent transactions\n  \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n  \"involveswatchonly\": true|false,  (boolean)         Unset\n  \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n  \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n  \"txid\": \"value\",                  (string)          The hash of the transaction\n  \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n  \"vout\": n,                        (numeric)         The transaction output index\n  \"walletconflicts\": [\"value\",...], (array of string) Unset\n  \"comment\": \"value\",               (string)          Unset\n  \"otheraccount\": \"value\",          (string)          Unset\n },...],                                              \n \"lastblock\": \"value\",              (string)          Hash of the latest-synced block to be used in later calls to listsinceblock\n}                                   \n",
-		"listtransactions":                 "listtransactions (\"account\" count=10 from=0 includewatchonly=false)\n\nReturns a JSON array of objects containing verbose details for wallet transactions.\n\nArguments:\n1. account          (string, optional)                 DEPRECATED -- Unused (must be unset or \"*\")\n2. count            (numeric, optional, default=10)    Maximum number of transactions to create results from\n3. from             (numeric, optional, default=0)     Number of transactions to skip before results are created\n4. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": unknown,                (value)           The value of the transaction output valued in Monetarium\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": unknown,                   (value)           The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
+		"listrecurringpayments":            "listrecurringpayments\n\nReturns every recurring payment, ordered by ID\n\nArguments:\nNone\n\nResult:\n[{\n \"id\": n,                  (numeric) The recurring payment ID\n \"account\": \"value\",       (string)  Name of the sending account\n \"address\": \"value\",       (string)  The address paid\n \"cointype\": n,            (numeric) Coin type of the payments\n \"amount\": unknown,        (value)   Amount of each payment\n \"intervalblocks\": n,      (numeric) Number of blocks between payments, unset for time intervals\n \"interval\": n,            (numeric) Seconds between payments, unset for block intervals\n \"nextheight\": n,          (numeric) The main chain height at which the next payment is due, unset for time intervals\n \"nexttime\": n,            (numeric) The Unix time at which the next payment is due, unset for block intervals\n \"failurepolicy\": \"value\", (string)  How a failed payment is handled (retry, skip or pause)\n \"paused\": true|false,     (boolean) Whether payments are paused\n \"executions\": n,          (numeric) The number of payments sent\n \"lastattempt\": n,         (numeric) The Unix time of the last attempted payment, unset if none\n \"lasttxid\": \"value\",      (string)  The transaction hash of the last payment, unset if it was not sent\n \"lasterror\": \"value\",     (string)  The reason the last payment was not sent, unset if it was\n \"label\": \"value\",         (string)  The label recorded for each payment transaction, if any\n \"created\": n,             (numeric) The Unix time the recurring payment was recorded\n},...]\n",
+		"listrpccredentials":               "listrpccredentials\n\nReturns the usernames and scopes of the RPC credentials recorded by the default wallet.\n\nArguments:\nNone\n\nResult:\n[{\n \"username\": \"value\",     (string)          Username of the credential\n \"scopes\": [\"value\",...], (array of string) Scopes granted to the credential\n \"created\": n,            (numeric)         Unix time the credential was created\n},...]\n",
+		"listscheduledsends":               "listscheduledsends\n\nReturns every scheduled send and its status, ordered by ID\n\nArguments:\nNone\n\nResult:\n[{\n \"id\": n,               (numeric)         The scheduled send ID\n \"account\": \"value\",    (string)          Name of the sending account\n \"cointype\": n,         (numeric)         Coin type of the send\n \"amount\": unknown,     (value)           Total amount of the outputs\n \"outputs\": [{          (array of object) The outputs paid by the send\n  \"address\": \"value\",   (string)          The address paid by the output, unset for outputs not paying a single address\n  \"amount\": unknown,    (value)           The output amount, unset for sweeps\n },...],                                  \n \"notbefore\": n,        (numeric)         The Unix time before which the send is not published, unset if none\n \"notbeforeheight\": n,  (numeric)         The main chain height which must be reached before the send is published, unset if none\n \"signedtxid\": \"value\", (string)          The hash of the presigned transaction, unset if the send is signed once triggered\n \"status\": \"value\",     (string)          The send status (waiting, sent, failed or cancelled)\n \"txid\": \"value\",       (string)          The hash of the published transaction, set once sent\n \"lasterror\": \"value\",  (string)          The reason the send could not be published, set once failed\n \"label\": \"value\",      (string)          The label recorded for the transaction once sent, if any\n \"created\": n,          (numeric)         The Unix time the send was scheduled\n},...]\n",
+		"listsinceblock":                   "listsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\n\nReturns a JSON array of objects listing details of all wallet transactions after some block.\n\nArguments:\n1. blockhash           (string, optional)                 Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\n2. targetconfirmations (numeric, optional, default=1)     Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\n3. includewatchonly    (boolean, optional, default=false) Unused\n\nResult:\n{\n \"transactions\": [{                 (array of object) JSON array of objects containing verbose details of the each transaction\n  \"account\": \"value\",               (string)          DEPRECATED -- Unset\n  \"address\": \"value\",               (string)          Payment address for a transaction output\n  \"amount\": unknown,                (value)           The value of the transaction output valued in Monetarium\n  \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n  \"blockindex\": n,                  (numeric)         Unset\n  \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n  \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n  \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n  \"fee\": unknown,                   (value)           The total input value minus the total output value for sent transactions\n  \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n  \"involveswatchonly\": true|false,  (boolean)         Unset\n  \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n  \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n  \"txid\": \"value\",                  (string)          The hash of the transaction\n  \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n  \"vout\": n,                        (numeric)         The transaction output index\n  \"walletconflicts\": [\"value\",...], (array of string) Unset\n  \"comment\": \"value\",               (string)          Unset\n  \"otheraccount\": \"value\",          (string)          Unset\n  \"counterparty\": \"value\",          (string)          The counterparty tagged for the paid address (sends) or funding inputs (receives)\n },...],                                              \n \"lastblock\": \"value\",              (string)          Hash of the latest-synced block to be used in later calls to listsinceblock\n}                                   \n",
+		"listspendlimits":                  "listspendlimits\n\nReturns the daily spend limit of each account and coin type whose spending is limited\n\nArguments:\nNone\n\nResult:\n[{\n \"account\": \"value\",            (string)  Name of the account whose spending is limited\n \"cointype\": n,                 (numeric) Coin type of the limit\n \"limit\": unknown,              (value)   Maximum amount sent each day\n \"spenttoday\": unknown,         (value)   Amount sent since midnight UTC\n \"requireapproval\": true|false, (boolean) Whether sends exceeding the limit await approval instead of being refused\n},...]\n",
+		"listtransactions":                 "listtransactions (\"account\" count=10 from=0 includewatchonly=false cointype \"txclass\" startheight endheight)\n\nReturns a JSON array of objects containing verbose details for wallet transactions.\n\nArguments:\n1. account          (string, optional)                 DEPRECATED -- Unused (must be unset or \"*\")\n2. count            (numeric, optional, default=10)    Maximum number of transactions to create results from\n3. from             (numeric, optional, default=0)     Number of transactions to skip before results are created\n4. includewatchonly (boolean, optional, default=false) Unused\n5. cointype         (numeric, optional)                Only list transactions of this coin type (0=VAR, 1-255=SKA), the coin type of their first SKA output or VAR\n6. txclass          (string, optional)                 Only list transactions of this class (regular, coinbase, ticket, vote, revocation, or ssfee)\n7. startheight      (numeric, optional)                Only list transactions mined at or above this block height\n8. endheight        (numeric, optional)                Only list transactions mined at or below this block height, excluding unmined transactions\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": unknown,                (value)           The value of the transaction output valued in Monetarium\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": unknown,                   (value)           The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n \"counterparty\": \"value\",          (string)          The counterparty tagged for the paid address (sends) or funding inputs (receives)\n},...]\n",
 		"listunspent":                      "listunspent (minconf=1 maxconf=9999999 [\"address\",...] \"account\" cointype)\n\nReturns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.\n\nArguments:\n1. minconf   (numeric, optional, default=1)       Minimum number of block confirmations required before a transaction output is considered\n2. maxconf   (numeric, optional, default=9999999) Maximum number of block confirmations required before a transaction output is excluded\n3. addresses (array of string, optional)          If set, limits the returned details to unspent outputs received by any of these payment addresses\n4. account   (string, optional)                   If set, only return unspent outputs from this account\n5. cointype  (numeric, optional)                  Optional coin type to filter by (0=VAR, 1-255=SKA)\n\nResult:\n{\n \"txid\": \"value\",         (string)  The transaction hash of the referenced output\n \"vout\": n,               (numeric) The output index of the referenced output\n \"tree\": n,               (numeric) The tree the transaction comes from\n \"txtype\": n,             (numeric) The type of the transaction\n \"address\": \"value\",      (string)  The payment address that received the output\n \"account\": \"value\",      (string)  The account associated with the receiving payment address\n \"scriptPubKey\": \"value\", (string)  The output script encoded as a hexadecimal string\n \"redeemScript\": \"value\", (string)  The redeemScript if scriptPubKey is P2SH\n \"amount\": unknown,       (value)   The amount of the output valued in Monetarium\n \"confirmations\": n,      (numeric) The number of block confirmations of the transaction\n \"spendable\": true|false, (boolean) Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\n \"cointype\": n,           (numeric) The coin type of the unspent output (0=VAR, 1-255=SKA)\n}                         \n",
+		"listwallets":                      "listwallets\n\nReturns the named wallets hosted alongside the default wallet and whether each is loaded.\n\nArguments:\nNone\n\nResult:\n[{\n \"name\": \"value\",      (string)  The name of the wallet\n \"loaded\": true|false, (boolean) Whether the wallet is loaded\n},...]\n",
+		"loadwallet":                       "loadwallet \"name\" (\"pubpassphrase\")\n\nOpens an existing named wallet so requests may select it.\n\nArguments:\n1. name          (string, required) Name of the wallet\n2. pubpassphrase (string, optional) Public passphrase of the wallet (default: insecure public passphrase)\n\nResult:\nNothing\n",
 		"lockaccount":                      "lockaccount \"account\"\n\nLock an individually-encrypted account\n\nArguments:\n1. account (string, required) Account to lock\n\nResult:\nNothing\n",
+		"lockstaking":                      "lockstaking\n\nLock the staking keys, which vote and revoke tickets independently of the wallet passphrase. Staking keys without a staking passphrase are always unlocked and can not be locked.\n\nArguments:\nNone\n\nResult:\nNothing\n",
 		"lockunspent":                      "lockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\n\nLocks or unlocks an unspent output.\nLocked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\nLocked outputs are volatile and are not saved across wallet restarts.\nIf unlock is true and no transaction outputs are specified, all locked outputs are marked unlocked.\n\nArguments:\n1. unlock       (boolean, required)         True to unlock outputs, false to lock\n2. transactions (array of object, required) Transaction outputs to lock or unlock\n[{\n \"amount\": n.nnn, (numeric) The previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
 		"mixaccount":                       "mixaccount\n\nMix all outputs of an account.\n\nArguments:\nNone\n\nResult:\nNothing\n",
 		"mixoutput":                        "mixoutput \"outpoint\"\n\nMix a specific output.\n\nArguments:\n1. outpoint (string, required) Outpoint (in form \"txhash:index\") to mix\n\nResult:\nNothing\n",
 		"processunmanagedticket":           "processunmanagedticket \"tickethash\"\n\nProcesses tickets for vsp client based on ticket hash.\n\nArguments:\n1. tickethash (string, required) The ticket hash of ticket to be processed by the vsp client.\n\nResult:\nNothing\n",
+		"pauserecurringpayment":            "pauserecurringpayment id\n\nPause a recurring payment, which is not sent until resumed\n\nArguments:\n1. id (numeric, required) The recurring payment ID\n\nResult:\nNothing\n",
+		"planconsolidation":                "planconsolidation inputs (\"account\" cointype)\n\nEstimates the transactions, fees, and resulting unspent outputs of consolidating all eligible outputs of an account with consolidate, without creating any transactions.\n\nArguments:\n1. inputs   (numeric, required) Maximum number of UTXOs consolidated by each consolidation, as with the inputs of consolidate\n2. account  (string, optional)  Optional: Account from which unspent outputs are picked. Default is the default account.\n3. cointype (numeric, optional) Optional: Coin type to plan (0=VAR, 1-255=SKA). Default plans every active coin type.\n\nResult:\n[{\n \"cointype\": n,     (numeric) Coin type of the consolidated outputs\n \"utxos\": n,        (numeric) Number of unspent outputs eligible for consolidation\n \"transactions\": n, (numeric) Number of consolidation transactions required\n \"fee\": unknown,    (value)   Total fee of all consolidation transactions (float for VAR, string for SKA)\n \"resultutxos\": n,  (numeric) Number of eligible unspent outputs remaining after consolidation\n},...]\n",
 		"purchaseticket":                   "purchaseticket \"fromaccount\" spendlimit (minconf=1 numtickets=1 expiry \"comment\" dontsigntx)\n\nPurchase ticket using available funds.\n\nArguments:\n1. fromaccount (string, required)             The account to use for purchase (default=\"default\")\n2. spendlimit  (numeric, required)            Limit on the amount to spend on ticket\n3. minconf     (numeric, optional, default=1) Minimum number of block confirmations required\n4. numtickets  (numeric, optional, default=1) The number of tickets to purchase\n5. expiry      (numeric, optional)            Height at which the purchase tickets expire\n6. comment     (string, optional)             Unused\n7. dontsigntx  (boolean, optional)            Return unsigned split and ticket transactions instead of signing and publishing\n\nResult:\n\"value\" (string) Hash of the resulting ticket\n",
+		"recordprice":                      "recordprice cointype \"currency\" \"price\" (time)\n\nRecords the fiat price of one coin of a coin type, which is reported by gettransaction and exporthistory for transactions mined at or after the price time until a later price is recorded.\nA price recorded for the coin type at the same time is replaced.\n\nArguments:\n1. cointype (numeric, required) The coin type (0=VAR, 1-255=SKA)\n2. currency (string, required)  The fiat currency code of the price\n3. price    (string, required)  The decimal price of one coin in the currency\n4. time     (numeric, optional) The Unix time of the price, or the current time if unset\n\nResult:\nNothing\n",
+		"recoverunspent":                   "recoverunspent (beginheight fullscan=false)\n\nRescans the block chain for unspent outputs paying wallet addresses which are missing from the wallet database, such as after restoring a partial backup, and reinserts them with their coin type and maturity.\nRecovered outputs are checked against the UTXO set of the node when the wallet is not running in SPV mode.\n\nArguments:\n1. beginheight (numeric, optional)                The height of the first block to scan, or null to begin from the wallet birthday block\n2. fullscan    (boolean, optional, default=false) Scan from the genesis block, ignoring the wallet birthday, when no begin height is provided\n\nResult:\n[{\n \"txid\": \"value\",        (string)  The hash of the transaction creating the output\n \"vout\": n,              (numeric) The output index\n \"tree\": n,              (numeric) The transaction tree of the output\n \"address\": \"value\",     (string)  The address paid by the output, unset for nonstandard scripts\n \"account\": \"value\",     (string)  Name of the account of the address\n \"cointype\": n,          (numeric) Coin type of the output\n \"amount\": unknown,      (value)   The output amount\n \"height\": n,            (numeric) The height of the block mining the transaction\n \"txtype\": n,            (numeric) The stake transaction type of the transaction\n \"coinbase\": true|false, (boolean) Whether the output was created by a coinbase transaction\n \"mature\": true|false,   (boolean) Whether the output has reached the maturity required to be spent\n \"verified\": true|false, (boolean) Whether the node reported the output is unspent, always false in SPV mode\n},...]\n",
+		"redeemcontract":                   "redeemcontract \"contracttx\" \"contract\" (\"secret\")\n\nRedeems a hash-locked contract paying the wallet with its secret, or refunds a contract funded by the wallet after its lock time when no secret is provided, and publishes the transaction.\n\nArguments:\n1. contracttx (string, required) The hex-encoded transaction funding the contract\n2. contract   (string, required) The hex-encoded contract redeem script\n3. secret     (string, optional) The hex-encoded secret redeeming the contract\n\nResult:\n\"value\" (string) The hash of the published redeem or refund transaction\n",
 		"redeemmultisigout":                "redeemmultisigout \"hash\" index tree (\"address\")\n\nTakes the input and constructs a P2PKH paying to the specified address.\n\nArguments:\n1. hash    (string, required)  Hash of the input transaction\n2. index   (numeric, required) Idx of the input transaction\n3. tree    (numeric, required) Tree the transaction is on.\n4. address (string, optional)  Address to pay to.\n\nResult:\n{\n \"hex\": \"value\",         (string)          Resulting hash.\n \"complete\": true|false, (boolean)         Shows if opperation was completed.\n \"errors\": [{            (array of object) Any errors generated.\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
 		"redeemmultisigouts":               "redeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\n\nTakes a hash, looks up all unspent outpoints and generates list artially signed transactions spending to either an address specified or internal addresses\n\nArguments:\n1. fromscraddress (string, required)  Input script hash address.\n2. toaddress      (string, optional)  Address to look for (if not internal addresses).\n3. number         (numeric, optional) Number of outpoints found.\n\nResult:\n{\n \"hex\": \"value\",         (string)          Resulting hash.\n \"complete\": true|false, (boolean)         Shows if opperation was completed.\n \"errors\": [{            (array of object) Any errors generated.\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
+		"rejectpending":                    "rejectpending id\n\nRemove a send awaiting approval without sending it\n\nArguments:\n1. id (numeric, required) The pending send ID\n\nResult:\nNothing\n",
+		"removerecurringpayment":           "removerecurringpayment id\n\nRemove a recurring payment\n\nArguments:\n1. id (numeric, required) The recurring payment ID\n\nResult:\nNothing\n",
 		"renameaccount":                    "renameaccount \"oldaccount\" \"newaccount\"\n\nRenames an account.\n\nArguments:\n1. oldaccount (string, required) The old account name to rename\n2. newaccount (string, required) The new name for the account\n\nResult:\nNothing\n",
-		"rescanwallet":                     "rescanwallet (beginheight=0)\n\nRescan the block chain for wallet data, blocking until the rescan completes or exits with an error\n\nArguments:\n1. beginheight (numeric, optional, default=0) The height of the first block to begin the rescan from\n\nResult:\nNothing\n",
-		"sendfrom":                         "sendfrom \"fromaccount\" \"toaddress\" \"amount\" (minconf=1 \"comment\" \"commentto\" cointype)\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)             Account to pick unspent outputs from\n2. toaddress   (string, required)             Address to pay\n3. amount      (string, required)             Amount to send to the payment address valued in Monetarium\n4. minconf     (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n5. comment     (string, optional)             Unused\n6. commentto   (string, optional)             Unused\n7. cointype    (numeric, optional)            Optional coin type to send (0=VAR, 1-255=SKA)\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendfromtreasury":                 "sendfromtreasury \"key\" amounts\n\nSend from treasury balance to multiple recipients.\n\nArguments:\n1. key     (string, required) Politeia public key\n2. amounts (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in Monetarium, (object) JSON object using payment addresses as keys and output amounts valued in Monetarium to send to each address\n ...\n}\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendmany":                         "sendmany \"fromaccount\" {\"address\":\"amount\",...} (minconf=1 \"comment\" cointype)\n\nAuthors, signs, and sends a transaction that outputs to many payment addresses.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required) Account to pick unspent outputs from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in Monetarium, (object) JSON object using payment addresses as keys and output amounts valued in Monetarium to send to each address\n ...\n}\n3. minconf  (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n4. comment  (string, optional)             Unused\n5. cointype (numeric, optional)            Optional coin type to send (0=VAR, 1-255=SKA)\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"rescanwallet":                     "rescanwallet (beginheight fullscan=false)\n\nRescan the block chain for wallet data, blocking until the rescan completes or exits with an error\n\nArguments:\n1. beginheight (numeric, optional)                The height of the first block to begin the rescan from, or null to begin from the wallet birthday block\n2. fullscan    (boolean, optional, default=false) Rescan from the genesis block, ignoring the wallet birthday, when no begin height is provided\n\nResult:\nNothing\n",
+		"restorewallet":                    "restorewallet \"source\" \"passphrase\" (\"pubpassphrase\")\n\nRestores the wallet database from an encrypted backup written by backupwallet and opens the restored wallet. No wallet may be loaded or exist.\n\nArguments:\n1. source        (string, required) Path of the backup file\n2. passphrase    (string, required) Passphrase used to encrypt the backup\n3. pubpassphrase (string, optional) Public passphrase of the restored wallet (default insecure public passphrase)\n\nResult:\nNothing\n",
+		"resumerecurringpayment":           "resumerecurringpayment id\n\nResume a paused recurring payment. Payments which became due while paused are skipped.\n\nArguments:\n1. id (numeric, required) The recurring payment ID\n\nResult:\nNothing\n",
+		"revokerpccredential":              "revokerpccredential \"username\"\n\nRemoves an RPC credential recorded by the default wallet.  Connections already authenticated with the credential are not closed.\n\nArguments:\n1. username (string, required) Username of the credential\n\nResult:\nNothing\n",
+		"schedulesend":                     "schedulesend \"fromaccount\" {\"address\":\"amount\",...} (notbefore notbeforeheight presign=false minconf=1 cointype \"comment\" expiry expireafter \"feepreference\")\n\nQueue a send to be published once a time and block height are reached.\nUnless presigned, the transaction is authored and signed once triggered, subject to the send policy and daily spend limits, and the send waits while the wallet is locked.\n\nArguments:\n1. fromaccount (string, required) Account to pick unspent outputs from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address, (object) JSON object using payment addresses as keys and output amounts as strings to send to each address\n ...\n}\n3.  notbefore       (numeric, optional)                Optional Unix time before which the send is not published\n4.  notbeforeheight (numeric, optional)                Optional main chain height which must be reached before the send is published; at least one of notbefore and notbeforeheight is required\n5.  presign         (boolean, optional, default=false) Sign the transaction now so that it may be published while the wallet is locked; requires expiry or expireafter, and its inputs are locked until the send is triggered or cancelled\n6.  minconf         (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction output is eligible to be spent\n7.  cointype        (numeric, optional)                Optional coin type to send (0=VAR, 1-255=SKA)\n8.  comment         (string, optional)                 Optional label recorded for the transaction\n9.  expiry          (numeric, optional)                Optional block height at which the transaction expires\n10. expireafter     (numeric, optional)                Optional number of blocks in which the transaction may be mined before it expires; may not be combined with expiry\n11. feepreference   (string, optional)                 Optional fee preference (slow, normal or fast) selecting the fee rate estimated by the network backend when the send is scheduled instead of the wallet's fee rate\n\nResult:\n{\n \"id\": n,               (numeric)         The scheduled send ID\n \"account\": \"value\",    (string)          Name of the sending account\n \"cointype\": n,         (numeric)         Coin type of the send\n \"amount\": unknown,     (value)           Total amount of the outputs\n \"outputs\": [{          (array of object) The outputs paid by the send\n  \"address\": \"value\",   (string)          The address paid by the output, unset for outputs not paying a single address\n  \"amount\": unknown,    (value)           The output amount, unset for sweeps\n },...],                                  \n \"notbefore\": n,        (numeric)         The Unix time before which the send is not published, unset if none\n \"notbeforeheight\": n,  (numeric)         The main chain height which must be reached before the send is published, unset if none\n \"signedtxid\": \"value\", (string)          The hash of the presigned transaction, unset if the send is signed once triggered\n \"status\": \"value\",     (string)          The send status (waiting, sent, failed or cancelled)\n \"txid\": \"value\",       (string)          The hash of the published transaction, set once sent\n \"lasterror\": \"value\",  (string)          The reason the send could not be published, set once failed\n \"label\": \"value\",      (string)          The label recorded for the transaction once sent, if any\n \"created\": n,          (numeric)         The Unix time the send was scheduled\n}                       \n",
+		"sendfrom":                         "sendfrom \"fromaccount\" \"toaddress\" \"amount\" (minconf=1 \"comment\" \"commentto\" cointype \"fiatcurrency\" expiry expireafter locktime \"feepreference\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1.  fromaccount   (string, required)             Account to pick unspent outputs from\n2.  toaddress     (string, required)             Address to pay\n3.  amount        (string, required)             Amount to send to the payment address valued in Monetarium\n4.  minconf       (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n5.  comment       (string, optional)             Optional label recorded for the transaction\n6.  commentto     (string, optional)             Unused\n7.  cointype      (numeric, optional)            Optional coin type to send (0=VAR, 1-255=SKA)\n8.  fiatcurrency  (string, optional)             Optional fiat currency code (e.g. USD); when set, amounts are fiat values converted to coins using the configured rate source\n9.  expiry        (numeric, optional)            Optional block height at which the transaction expires; must be above the next block height\n10. expireafter   (numeric, optional)            Optional number of blocks in which the transaction may be mined before it expires; may not be combined with expiry\n11. locktime      (numeric, optional)            Optional block height, or unix time when at least 500000000, before which the transaction may not be mined; may not be after the current tip\n12. feepreference (string, optional)             Optional fee preference (slow, normal or fast) selecting the fee rate estimated by the network backend for the coin type instead of the wallet's fee rate\n\nResult (neither fiatcurrency nor feepreference specified):\n\"value\" (string) The transaction hash of the sent transaction\n\nResult (fiatcurrency specified):\n{\n \"txid\": \"value\",          (string)  The transaction hash of the sent transaction\n \"rate\": \"value\",          (string)  Price of one coin in the fiat currency used for the conversion\n \"currency\": \"value\",      (string)  The fiat currency code\n \"ratetime\": n,            (numeric) Unix time at which the rate was reported\n \"ratesource\": \"value\",    (string)  The source that reported the rate\n \"label\": \"value\",         (string)  The audit label describing the conversion, as recorded for the transaction\n \"labelerror\": \"value\",    (string)  Error recording the label, if any; the transaction was still sent\n \"feepreference\": \"value\", (string)  The fee preference, if specified\n \"feerate\": unknown,       (value)   The fee rate per kB of the fee preference, if specified\n}                          \n\nResult (feepreference specified without fiatcurrency):\n{\n \"txid\": \"value\",          (string) The transaction hash of the sent transaction\n \"feepreference\": \"value\", (string) The fee preference\n \"feerate\": unknown,       (value)  The fee rate per kB estimated by the network backend for the fee preference and paid by the transaction\n}                          \n",
+		"sendfromtreasury":                 "sendfromtreasury \"key\" amounts\n\nSend from treasury balance to multiple recipients.\n\nArguments:\n1. key     (string, required) Hex-encoded Politeia public key held by the wallet\n2. amounts (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in Monetarium, (object) JSON object using payment addresses as keys and output amounts valued in Monetarium to send to each address\n ...\n}\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendfromvault":                    "sendfromvault \"account\" {\"address\":\"amount\",...} (cointype)\n\nSpends the outputs of a vault account which have reached the relative lock time of the vault.\nChange is returned to a new address of the vault and is locked again.\nSends are subject to the send policy and the daily spend limit of the vault account, and sends exceeding a limit requiring approval are recorded as pending sends.\n\nArguments:\n1. account (string, required) Name of the vault account\n2. amounts (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address, (object) JSON object using payment addresses as keys and output amounts as strings to send to each address\n ...\n}\n3. cointype (numeric, optional) The coin type of the outputs (0 for VAR, 1-255 for SKA)\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendmany":                         "sendmany \"fromaccount\" {\"address\":\"amount\",...} (minconf=1 \"comment\" cointype \"fiatcurrency\" [\"subtractfeefrom\",...] expiry expireafter locktime \"feepreference\" \"data\")\n\nAuthors, signs, and sends a transaction that outputs to many payment addresses.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required) Account to pick unspent outputs from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in Monetarium, (object) JSON object using payment addresses as keys and output amounts valued in Monetarium to send to each address\n ...\n}\n3.  minconf         (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n4.  comment         (string, optional)             Optional label recorded for the transaction\n5.  cointype        (numeric, optional)            Optional coin type to send (0=VAR, 1-255=SKA)\n6.  fiatcurrency    (string, optional)             Optional fiat currency code (e.g. USD); when set, amounts are fiat values converted to coins using the configured rate source\n7.  subtractfeefrom (array of string, optional)    Optional payment addresses whose output amounts pay the transaction fee, divided evenly between them\n8.  expiry          (numeric, optional)            Optional block height at which the transaction expires; must be above the next block height\n9.  expireafter     (numeric, optional)            Optional number of blocks in which the transaction may be mined before it expires; may not be combined with expiry\n10. locktime        (numeric, optional)            Optional block height, or unix time when at least 500000000, before which the transaction may not be mined; may not be after the current tip\n11. feepreference   (string, optional)             Optional fee preference (slow, normal or fast) selecting the fee rate estimated by the network backend for the coin type instead of the wallet's fee rate\n12. data            (string, optional)             Optional hex-encoded data of up to 256 bytes carried by an additional zero value OP_RETURN output, whose size is paid for by the transaction fee. Data matching the SSFee marker format is rejected\n\nResult (neither fiatcurrency nor feepreference specified):\n\"value\" (string) The transaction hash of the sent transaction\n\nResult (fiatcurrency specified):\n{\n \"txid\": \"value\",          (string)  The transaction hash of the sent transaction\n \"rate\": \"value\",          (string)  Price of one coin in the fiat currency used for the conversion\n \"currency\": \"value\",      (string)  The fiat currency code\n \"ratetime\": n,            (numeric) Unix time at which the rate was reported\n \"ratesource\": \"value\",    (string)  The source that reported the rate\n \"label\": \"value\",         (string)  The audit label describing the conversion, as recorded for the transaction\n \"labelerror\": \"value\",    (string)  Error recording the label, if any; the transaction was still sent\n \"feepreference\": \"value\", (string)  The fee preference, if specified\n \"feerate\": unknown,       (value)   The fee rate per kB of the fee preference, if specified\n}                          \n\nResult (feepreference specified without fiatcurrency):\n{\n \"txid\": \"value\",          (string) The transaction hash of the sent transaction\n \"feepreference\": \"value\", (string) The fee preference\n \"feerate\": unknown,       (value)  The fee rate per kB estimated by the network backend for the fee preference and paid by the transaction\n}                          \n",
 		"sendrawtransaction":               "sendrawtransaction \"hextx\" (allowhighfees=false)\n\nSubmits the serialized, hex-encoded transaction to the local peer and relays it to the network.\n\nArguments:\n1. hextx         (string, required)                 Serialized, hex-encoded signed transaction\n2. allowhighfees (boolean, optional, default=false) Whether or not to allow insanely high fees\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendtoaddress":                    "sendtoaddress \"address\" \"amount\" (\"comment\" \"commentto\" cointype)\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. address   (string, required)  Address to pay\n2. amount    (string, required)  Amount to send to the payment address valued in Monetarium\n3. comment   (string, optional)  Unused\n4. commentto (string, optional)  Unused\n5. cointype  (numeric, optional) Optional coin type to send (0=VAR, 1-255=SKA)\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendtoaddress":                    "sendtoaddress \"address\" \"amount\" (\"comment\" \"commentto\" cointype \"fiatcurrency\" subtractfeefromamount expiry expireafter locktime \"feepreference\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1.  address               (string, required)  Address to pay\n2.  amount                (string, required)  Amount to send to the payment address valued in Monetarium\n3.  comment               (string, optional)  Optional label recorded for the transaction\n4.  commentto             (string, optional)  Unused\n5.  cointype              (numeric, optional) Optional coin type to send (0=VAR, 1-255=SKA)\n6.  fiatcurrency          (string, optional)  Optional fiat currency code (e.g. USD); when set, amounts are fiat values converted to coins using the configured rate source\n7.  subtractfeefromamount (boolean, optional) Subtract the transaction fee from the amount, so the payment address receives less than amount\n8.  expiry                (numeric, optional) Optional block height at which the transaction expires; must be above the next block height\n9.  expireafter           (numeric, optional) Optional number of blocks in which the transaction may be mined before it expires; may not be combined with expiry\n10. locktime              (numeric, optional) Optional block height, or unix time when at least 500000000, before which the transaction may not be mined; may not be after the current tip\n11. feepreference         (string, optional)  Optional fee preference (slow, normal or fast) selecting the fee rate estimated by the network backend for the coin type instead of the wallet's fee rate\n\nResult (neither fiatcurrency nor feepreference specified):\n\"value\" (string) The transaction hash of the sent transaction\n\nResult (fiatcurrency specified):\n{\n \"txid\": \"value\",          (string)  The transaction hash of the sent transaction\n \"rate\": \"value\",          (string)  Price of one coin in the fiat currency used for the conversion\n \"currency\": \"value\",      (string)  The fiat currency code\n \"ratetime\": n,            (numeric) Unix time at which the rate was reported\n \"ratesource\": \"value\",    (string)  The source that reported the rate\n \"label\": \"value\",         (string)  The audit label describing the conversion, as recorded for the transaction\n \"labelerror\": \"value\",    (string)  Error recording the label, if any; the transaction was still sent\n \"feepreference\": \"value\", (string)  The fee preference, if specified\n \"feerate\": unknown,       (value)   The fee rate per kB of the fee preference, if specified\n}                          \n\nResult (feepreference specified without fiatcurrency):\n{\n \"txid\": \"value\",          (string) The transaction hash of the sent transaction\n \"feepreference\": \"value\", (string) The fee preference\n \"feerate\": unknown,       (value)  The fee rate per kB estimated by the network backend for the fee preference and paid by the transaction\n}                          \n",
 		"sendtomultisig":                   "sendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a multisig address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)             Unused\n2. amount      (numeric, required)            Amount to send to the payment address valued in Monetarium\n3. pubkeys     (array of string, required)    Pubkey to send to.\n4. nrequired   (numeric, optional, default=1) The number of signatures required to redeem outputs paid to this address\n5. minconf     (numeric, optional, default=1) Minimum number of block confirmations required\n6. comment     (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendtotreasury":                   "sendtotreasury amount\n\nSend Monetarium to treasury\n\nArguments:\n1. amount (numeric, required) Amount to send to treasury\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendtotreasury":                   "sendtotreasury amount (fromaccount=\"default\")\n\nSend Monetarium to treasury with a treasury add transaction. Change is returned as stake change.\n\nArguments:\n1. amount      (numeric, required)                   Amount to send to treasury\n2. fromaccount (string, optional, default=\"default\") Account to send from\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
 		"sendtoburn":                       "sendtoburn \"amount\" cointype \"passphrase\" (\"comment\")\n\n⚠️  WARNING: IRREVERSIBLE OPERATION ⚠️\nPermanently burns (destroys) SKA coins making them unspendable forever.\nThis action cannot be undone. Burned coins are permanently removed from circulation.\nOnly SKA coin types (1-255) can be burned.\n\nArguments:\n1. amount     (string, required)  Amount of SKA coins to burn (in coin units, e.g., 100.5)\n2. cointype   (numeric, required) SKA coin type to burn (must be 1-255, VAR cannot be burned)\n3. passphrase (string, required)  Wallet passphrase required for authorization\n4. comment    (string, optional)  Optional comment for user records (not stored on blockchain)\n\nResult:\n\"value\" (string) The transaction hash of the burn transaction\n",
+		"sendtspend":                       "sendtspend \"hextx\" \"pikey\"\n\nSign a treasury spend transaction with a Politeia key held by the wallet and publish it. The wallet's vote policy for the treasury spend is set to yes.\n\nArguments:\n1. hextx (string, required) Serialized, hex-encoded treasury spend transaction with all outputs, expiry and input value set\n2. pikey (string, required) Hex-encoded Politeia public key to sign with\n\nResult:\n\"value\" (string) The transaction hash of the sent treasury spend\n",
+		"setaccountgaplimit":               "setaccountgaplimit \"account\" gaplimit\n\nSets the unused address gap limit of an account, overriding the wallet's gap limit. Address discovery searches the account using this gap limit.\n\nArguments:\n1. account  (string, required)  Account to modify\n2. gaplimit (numeric, required) Allowed gap of unused addresses on each account branch, or zero to use the wallet's gap limit\n\nResult:\nNothing\n",
 		"setaccountpassphrase":             "setaccountpassphrase \"account\" \"passphrase\"\n\nIndividually encrypt or change per-account passphrase\n\nArguments:\n1. account    (string, required) Account to modify\n2. passphrase (string, required) New passphrase to use.\nIf this is the empty string, the account passphrase is removed and the account becomes encrypted by the global wallet passhprase.\n\nResult:\nNothing\n",
+		"setchangeaccount":                 "setchangeaccount \"account\" \"changeaccount\" (cointype=0)\n\nRedirect all change of a coin type from transactions spending the outputs of an account to a separate change account, so that funds of the two accounts, such as mixed and unmixed funds, never share an account. Setting the change account to the account itself removes the redirection.\n\nArguments:\n1. account       (string, required)             Account whose change is redirected\n2. changeaccount (string, required)             Account to return the change to\n3. cointype      (numeric, optional, default=0) Coin type of the redirected change (0=VAR, 1-255=SKA)\n\nResult:\nNothing\n",
+		"setchangescripttype":              "setchangescripttype \"account\" \"scripttype\"\n\nSet the output script of change returned to an account. Change pays the next internal address key of the account with a P2PKH (the default), Schnorr P2PKH, or P2SH script, where P2SH change pays a P2PK redeem script of the key. Fees are estimated using the size of the selected script. The change of multisig accounts always pays their P2SH multisig scripts and can not be changed.\n\nArguments:\n1. account    (string, required) Account whose change script type is set\n2. scripttype (string, required) Change script type (\"p2pkh\", \"schnorr-p2pkh\", or \"p2sh\")\n\nResult:\nNothing\n",
 		"setdisapprovepercent":             "setdisapprovepercent percent\n\nSets the wallet's block disapprove percent per vote. The wallet will randomly disapprove blocks with this percent of votes. Only used for testing purposes and will fail on mainnet.\n\nArguments:\n1. percent (numeric, required) The percent of votes to disapprove blocks. i.e. 100 means that all votes disapprove the block they are called on. Must be between zero and one hundred.\n\nResult:\nNothing\n",
+		"setinheritance":                   "setinheritance \"account\" \"address\" delaydays\n\nConfigure the dead-man's switch of an account.\nThe wallet maintains pre-signed transactions, one for each coin type, sweeping the account's funds to the recovery address with a lock time delaydays days in the future. The transactions are re-created with a new lock time whenever the wallet is unlocked or sends a transaction, so they only become valid if the wallet is unused for the delay. The wallet never publishes the transactions; they are returned by getinheritance to be handed to the recipient. Transactions are only created while the wallet is unlocked.\n\nArguments:\n1. account   (string, required)  The account whose funds are swept\n2. address   (string, required)  The recovery address receiving the funds\n3. delaydays (numeric, required) Number of days of inactivity, at least 1, after which the transactions become valid\n\nResult:\n{\n \"account\": \"value\",   (string)          Name of the account whose funds are swept\n \"address\": \"value\",   (string)          The recovery address receiving the funds\n \"delay\": n,           (numeric)         Seconds of inactivity after which the transactions become valid\n \"refreshed\": n,       (numeric)         The Unix time the transactions were last created, unset if never\n \"locktime\": n,        (numeric)         The Unix time lock time of the transactions, unset if there are none\n \"lasterror\": \"value\", (string)          The reason the transactions could not be re-created when the wallet was last used, unset if they were\n \"transactions\": [{    (array of object) The pre-signed sweep transaction of each coin type with spendable funds\n  \"cointype\": n,       (numeric)         Coin type swept by the transaction\n  \"txid\": \"value\",     (string)          The transaction hash\n  \"amount\": unknown,   (value)           Amount paid to the recovery address\n  \"hex\": \"value\",      (string)          The serialized signed transaction\n },...],                                 \n}                      \n",
+		"setlabelthreshold":                "setlabelthreshold \"threshold\" (cointype=0)\n\nRequire sends of at least an amount of a coin type to be labeled with a comment. Unlabeled sends are refused, including transactions published with sendrawtransaction. A zero threshold removes the requirement.\n\nArguments:\n1. threshold (string, required)             Amount at and above which sends must be labeled, as a coin amount string\n2. cointype  (numeric, optional, default=0) Coin type of the threshold (0=VAR, 1-255=SKA)\n\nResult:\nNothing\n",
+		"setloglevel":                      "setloglevel \"level\" (\"subsystem\")\n\nSet the log level of a subsystem, or of every subsystem, and return the level of each subsystem.\nThe valid levels are trace, debug, info, warn, error, critical, and off.\nThe valid subsystems are CMGR, DCRW, GRPC, LODR, MIXC, MIXP, PEER, RPCS, SYNC, TKBY, VSPC, and WLLT.\n\nArguments:\n1. level     (string, required) The log level\n2. subsystem (string, optional) The subsystem to set the level of (default: every subsystem)\n\nResult:\n[{\n \"subsystem\": \"value\", (string) The subsystem\n \"level\": \"value\",     (string) The log level of the subsystem\n},...]\n",
+		"setskasendaccounts":               "setskasendaccounts cointype [\"account\",...]\n\nRestrict sends of an SKA coin type to the accounts. Sends from other accounts are refused. An empty array allows every account to send the coin type.\n\nArguments:\n1. cointype (numeric, required)         The SKA coin type (1-255)\n2. accounts (array of string, required) Names of the only accounts which may send the coin type\n\nResult:\nNothing\n",
+		"setspendlimit":                    "setspendlimit \"account\" \"limit\" (cointype=0 requireapproval=false)\n\nLimit the amount of a coin type which an account may send each day, with days beginning at midnight UTC. Sends exceeding the limit are refused, or when approval is required, recorded as pending sends which are only signed and published once approved with approvepending. Transactions published with sendrawtransaction count the outputs not paying the sending account, and approving them authors a new transaction paying the same outputs. A zero limit removes the limit.\n\nArguments:\n1. account         (string, required)                 Account whose spending is limited\n2. limit           (string, required)                 Maximum amount of the coin type sent each day, as a coin amount string\n3. cointype        (numeric, optional, default=0)     Coin type of the limit (0=VAR, 1-255=SKA)\n4. requireapproval (boolean, optional, default=false) Record sends exceeding the limit as pending sends awaiting approval instead of refusing them\n\nResult:\nNothing\n",
+		"setstakingaccount":                "setstakingaccount \"account\" (staking=true)\n\nMove an account into or out of the staking key domain, whose private keys are encrypted by the staking passphrase instead of the wallet passphrase.\nTickets voting with addresses of an account in the domain are voted and revoked while the staking keys are unlocked, even when the wallet is locked.\nThe wallet and the staking keys must be unlocked, and accounts with a unique passphrase can not be moved.\n\nArguments:\n1. account (string, required)                The account to move\n2. staking (boolean, optional, default=true) Whether the account is added to (true) or removed from (false) the staking key domain\n\nResult:\nNothing\n",
+		"setstakingpassphrase":             "setstakingpassphrase \"passphrase\"\n\nSet the passphrase protecting the staking keys, which must be unlocked. An empty passphrase leaves the staking keys unlocked whenever the wallet is opened.\n\nArguments:\n1. passphrase (string, required) The new staking passphrase\n\nResult:\nNothing\n",
+		"setticketbuyerconfig":             "setticketbuyerconfig \"account\" target (maxprice maxfee reserve)\n\nSet the number of unspent tickets the ticket buyer maintains for an account, purchasing tickets with the account's outputs (requires --ticketbuyer.targets). The configuration is saved in the wallet database.\n\nArguments:\n1. account  (string, required)  Account to purchase tickets with\n2. target   (numeric, required) Number of unspent and unexpired tickets to maintain, or 0 to stop maintaining the account's tickets\n3. maxprice (numeric, optional) Maximum ticket price to purchase tickets at, or 0 for no limit\n4. maxfee   (numeric, optional) Maximum relay fee per kB to purchase tickets at, or 0 for no limit\n5. reserve  (numeric, optional) Spendable balance of the account which is never used to purchase tickets\n\nResult:\nNothing\n",
+		"setticketcompounding":             "setticketcompounding \"account\" enable\n\nOpt an account in to or out of compounding its matured SSFee VAR rewards into tickets purchased by the ticket buyer (requires --ticketbuyer.compound). Opting out discards accrued rewards.\n\nArguments:\n1. account (string, required)  Account to compound the rewards of\n2. enable  (boolean, required) True to compound the account's rewards, false to stop\n\nResult:\nNothing\n",
 		"settreasurypolicy":                "settreasurypolicy \"key\" \"policy\" (\"ticket\")\n\nSet a voting policy for treasury spends by a particular key\n\nArguments:\n1. key    (string, required) Treasury key to set policy for\n2. policy (string, required) Voting policy for a treasury key (invalid/abstain, yes, or no)\n3. ticket (string, optional) Ticket hash to set a per-ticket treasury key policy\n\nResult:\nNothing\n",
 		"settspendpolicy":                  "settspendpolicy \"hash\" \"policy\" (\"ticket\")\n\nSet a voting policy for a treasury spend transaction\n\nArguments:\n1. hash   (string, required) Hash of treasury spend transaction to set policy for\n2. policy (string, required) Voting policy for a tspend transaction (invalid/abstain, yes, or no)\n3. ticket (string, optional) Ticket hash to set a per-ticket tspend approval policy\n\nResult:\nNothing\n",
 		"settxfee":                         "settxfee amount (cointype=0)\n\nModify the fee per kB of the serialized tx size used each time more fee is required for an authored transaction.\n\nArguments:\n1. amount   (numeric, required)            The new fee per kB of the serialized tx size valued in Monetarium\n2. cointype (numeric, optional, default=0) Coin type to set fee for (0=VAR, 1-255=SKA coin types)\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
 		"setvotechoice":                    "setvotechoice \"agendaid\" \"choiceid\" (\"tickethash\")\n\nSets choices for defined agendas in the latest stake version supported by this software\n\nArguments:\n1. agendaid   (string, required) The ID for the agenda to modify\n2. choiceid   (string, required) The ID for the choice to choose\n3. tickethash (string, optional) The hash of the ticket to set choices for\n\nResult:\nNothing\n",
-		"setvotefeeconsolidationaddress":   "setvotefeeconsolidationaddress \"account\" \"address\"\n\nSet a custom consolidation address for vote fee (SSFee) payments for a specific account.\nThis overrides the default first external address (index 0).\n\nArguments:\n1. account (string, required) The account name or number\n2. address (string, required) The consolidation address to use for SSFee payments\n\nResult:\nNothing\n",
-		"signmessage":                      "signmessage \"address\" \"message\"\n\nSigns a message using the private key of a payment address.\n\nArguments:\n1. address (string, required) Payment address of private key used to sign the message with\n2. message (string, required) Message to sign\n\nResult:\n\"value\" (string) The signed message encoded as a base64 string\n",
-		"signrawtransaction":               "signrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\n\nSigns transaction inputs using private keys from this wallet and request.\nThe valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.\n\nArguments:\n1. rawtx    (string, required)                Unsigned or partially unsigned transaction to sign encoded as a hexadecimal string\n2. inputs   (array of object, optional)       Additional data regarding inputs that this wallet may not be tracking\n3. privkeys (array of string, optional)       Additional WIF-encoded private keys to use when creating signatures\n4. flags    (string, optional, default=\"ALL\") Sighash flags\n\nResult:\n{\n \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n \"complete\": true|false, (boolean)         Whether all input signatures have been created\n \"errors\": [{            (array of object) Script verification errors (if exists)\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
+		"setvotefeeconsolidationaddress":   "setvotefeeconsolidationaddress \"account\" \"address\" (external=false)\n\nSet a custom consolidation address for vote fee (SSFee) payments for a specific account.\nThis overrides the default first external address (index 0).\n\nArguments:\n1. account  (string, required)                 The account name or number\n2. address  (string, required)                 The consolidation address to use for SSFee payments\n3. external (boolean, optional, default=false) Allow an address which is not derived from the account, directing SSFee payments to an address the account does not control\n\nResult:\nNothing\n",
+		"setvsp":                           "setvsp \"host\" \"pubkey\" (feeaccount=\"default\")\n\nSelect the VSP to register tickets purchased by the purchaseticket RPC with, paying VSP fees from the fee account. Failed fee payments of tickets registered with the VSP are periodically retried. The selection is saved in the wallet database and takes precedence over --vsp.url.\n\nArguments:\n1. host       (string, required)                    URL of the VSP, or an empty string to remove the selection\n2. pubkey     (string, required)                    Base64 encoded public key of the VSP\n3. feeaccount (string, optional, default=\"default\") Account to pay VSP fees from\n\nResult:\nNothing\n",
+		"setwalletbirthday":                "setwalletbirthday birthday (timestamp=false)\n\nSets the wallet birthday, the block before which the wallet has no transactions. Rescans and address discovery begin from the birthday block. When the birthday precedes the blocks already processed, as for wallets restored from older seeds, addresses are discovered and blocks rescanned from the birthday the next time the wallet syncs.\n\nArguments:\n1. birthday  (numeric, required)                Height of the birthday block, or a UNIX timestamp when timestamp is true\n2. timestamp (boolean, optional, default=false) Interpret the birthday as a UNIX timestamp\n\nResult:\nNothing\n",
+		"signmessage":                      "signmessage \"address\" \"message\"\n\nSigns a message using the private key of a payment address.\nMessages are signed with a compact ECDSA signature, or for Schnorr addresses, a Schnorr signature followed by the compressed public key.\n\nArguments:\n1. address (string, required) Payment address of private key used to sign the message with\n2. message (string, required) Message to sign\n\nResult:\n\"value\" (string) The signed message encoded as a base64 string\n",
+		"signrawtransaction":               "signrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\",\"amount\":amount,\"skavaluein\":skavaluein},...] [\"privkey\",...] flags=\"ALL\")\n\nSigns transaction inputs using private keys from this wallet and request.\nThe valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.\n\nArguments:\n1. rawtx    (string, required)                Unsigned or partially unsigned transaction to sign encoded as a hexadecimal string\n2. inputs   (array of object, optional)       Additional data regarding inputs that this wallet may not be tracking: previous output scripts, P2SH redeem scripts, and the amount in coins of VAR or value in atoms of SKA previous outputs\n3. privkeys (array of string, optional)       Additional WIF-encoded private keys to use when creating signatures, in preference to keys of the wallet\n4. flags    (string, optional, default=\"ALL\") Sighash flags\n\nResult:\n{\n \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n \"complete\": true|false, (boolean)         Whether all input signatures have been created\n \"errors\": [{            (array of object) Script verification errors (if exists)\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
+		"signrawtransactionoffline":        "signrawtransactionoffline \"file\"\n\nSigns the inputs of a transaction created by createunsignedtransactionfile using private keys from this wallet.\nThe wallet does not need to know of the previous transactions, and derives the keys of account addresses from the paths recorded in the file.\n\nArguments:\n1. file (string, required) The JSON-encoded unsigned transaction file\n\nResult:\n{\n \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n \"complete\": true|false, (boolean)         Whether all input signatures have been created\n \"errors\": [{            (array of object) Script verification errors (if exists)\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
 		"signrawtransactions":              "signrawtransactions [\"rawtx\",...] (send=true)\n\nSigns transaction inputs using private keys from this wallet and request for a list of transactions.\n\n\nArguments:\n1. rawtxs (array of string, required)       A list of transactions to sign (and optionally send).\n2. send   (boolean, optional, default=true) Set true to send the transactions after signing.\n\nResult:\n{\n \"results\": [{             (array of object) Returned values from the signrawtransactions command.\n  \"signingresult\": {       (object)          Success or failure of signing.\n   \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n   \"complete\": true|false, (boolean)         Whether all input signatures have been created\n   \"errors\": [{            (array of object) Script verification errors (if exists)\n    \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n    \"vout\": n,             (numeric)         The output index of the referenced previous output\n    \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n    \"sequence\": n,         (numeric)         Script sequence number\n    \"error\": \"value\",      (string)          Verification or signing error related to the input\n   },...],                                   \n  },                                         \n  \"sent\": true|false,      (boolean)         Tells if the transaction was sent.\n  \"txhash\": \"value\",       (string)          The hash of the signed tx.\n },...],                                     \n}                          \n",
 		"spendoutputs":                     "spendoutputs \"account\" [\"previousoutpoint\",...] [{\"address\":\"value\",\"amount\":n.nnn},...]\n\nCreate, sign, and publish a transaction spending the specified wallet outputs, and paying an array of address/amount pairs.\nOutputs must belong to the specified account, and change (if needed) is returned to an internal address of the same account.\n\nArguments:\n1. account           (string, required)          Account of specified previous outpoints, and account used to return change\n2. previousoutpoints (array of string, required) Array of outpoints in string encoding (\"hash:index\")\n3. outputs           (array of object, required) Array of JSON objects, each specifying an address string and amount\n[{\n \"address\": \"value\", (string)  Address to pay\n \"amount\": n.nnn,    (numeric) Amount to pay the address\n},...]\n\nResult:\n\"value\" (string) The published transaction hash\n",
-		"sweepaccount":                     "sweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\n\nMoves as much value as possible in a transaction from an account.\n\n\nArguments:\n1. sourceaccount         (string, required)  The account to be swept.\n2. destinationaddress    (string, required)  The destination address to pay to.\n3. requiredconfirmations (numeric, optional) The minimum utxo confirmation requirement (optional).\n4. feeperkb              (numeric, optional) The minimum relay fee policy (optional).\n\nResult:\n{\n \"unsignedtransaction\": \"value\",     (string)  The hex encoded string of the unsigned transaction.\n \"totalpreviousoutputamount\": n.nnn, (numeric) The total transaction input amount.\n \"totaloutputamount\": n.nnn,         (numeric) The total transaction output amount.\n \"estimatedsignedsize\": n,           (numeric) The estimated size of the transaction when signed.\n}                                    \n",
+		"sweepaccount":                     "sweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb cointype)\n\nMoves as much value as possible in a transaction from an account.\nEvery eligible output of the coin type is spent to the destination address without a change output, and the fee is subtracted from the output value.\n\n\nArguments:\n1. sourceaccount         (string, required)  The account to be swept.\n2. destinationaddress    (string, required)  The destination address to pay to.\n3. requiredconfirmations (numeric, optional) The minimum utxo confirmation requirement (optional).\n4. feeperkb              (numeric, optional) The minimum relay fee policy (optional).\n5. cointype              (numeric, optional) Coin type to sweep (0=VAR, 1-255=SKA). Default is VAR (0).\n\nResult:\n{\n \"unsignedtransaction\": \"value\",     (string)  The hex encoded string of the unsigned transaction.\n \"totalpreviousoutputamount\": n.nnn, (numeric) The total transaction input amount.\n \"totaloutputamount\": n.nnn,         (numeric) The total transaction output amount.\n \"estimatedsignedsize\": n,           (numeric) The estimated size of the transaction when signed.\n}                                    \n",
 		"syncstatus":                       "syncstatus\n\nReturns information about this wallet's synchronization to the network.\n\nArguments:\nNone\n\nResult:\n{\n \"synced\": true|false,               (boolean) Whether or not the wallet is fully caught up to the network.\n \"initialblockdownload\": true|false, (boolean) Best guess of whether this wallet is in the initial block download mode used to catch up the blockchain when it is far behind.\n \"headersfetchprogress\": n.nnn,      (numeric) Estimated progress of the headers fetching stage of the current sync process.\n}                                    \n",
-		"ticketinfo":                       "ticketinfo (startheight=0)\n\nReturns details of each wallet ticket transaction\n\nArguments:\n1. startheight (numeric, optional, default=0) Specify the starting block height to scan from\n\nResult:\n[{\n \"hash\": \"value\",               (string)          Transaction hash of the ticket\n \"cost\": n.nnn,                 (numeric)         Amount paid to purchase the ticket; this may be greater than the ticket price at time of purchase\n \"votingaddress\": \"value\",      (string)          Address of 0th output, which describes the requirements to spend the ticket\n \"status\": \"value\",             (string)          Description of ticket status (unknown, unmined, immature, mature, live, voted, missed, expired, unspent, revoked)\n \"blockhash\": \"value\",          (string)          Hash of block ticket is mined in\n \"blockheight\": n,              (numeric)         Height of block ticket is mined in\n \"vote\": \"value\",               (string)          Transaction hash of vote which spends the ticket\n \"revocation\": \"value\",         (string)          Transaction hash of revocation which spends the ticket\n \"choices\": [{                  (array of object) Vote preferences set for the ticket\n  \"agendaid\": \"value\",          (string)          The ID for the agenda the choice concerns\n  \"agendadescription\": \"value\", (string)          A description of the agenda the choice concerns\n  \"choiceid\": \"value\",          (string)          The ID of the current choice for this agenda\n  \"choicedescription\": \"value\", (string)          A description of the current choice for this agenda\n },...],                                          \n \"vsphost\": \"value\",            (string)          VSP Host associated with the ticket (if any)\n},...]\n",
+		"tagcounterparty":                  "tagcounterparty \"counterparty\" [\"address\",...]\n\nTags external addresses as belonging to a named counterparty, such as an exchange or pool.\n\nArguments:\n1. counterparty (string, required)          The counterparty name\n2. addresses    (array of string, required) External addresses to tag\n\nResult:\nNothing\n",
+		"ticketbuyerconfig":                "ticketbuyerconfig\n\nReturns the ticket buyer configuration of each account whose unspent tickets are maintained by the ticket buyer\n\nArguments:\nNone\n\nResult:\n[{\n \"account\": \"value\", (string)  Name of the account\n \"target\": n,        (numeric) Number of unspent and unexpired tickets to maintain\n \"tickets\": n,       (numeric) Current number of unspent and unexpired tickets purchased by the account\n \"maxprice\": n.nnn,  (numeric) Maximum ticket price to purchase tickets at (0 for no limit)\n \"maxfee\": n.nnn,    (numeric) Maximum relay fee per kB to purchase tickets at (0 for no limit)\n \"reserve\": n.nnn,   (numeric) Spendable balance of the account which is never used to purchase tickets\n},...]\n",
+		"ticketcompounding":                "ticketcompounding\n\nReturns the matured SSFee VAR rewards accrued, and not yet compounded into tickets, by each account opted in to compounding\n\nArguments:\nNone\n\nResult:\n[{\n \"account\": \"value\", (string)  Name of the account\n \"accrued\": n.nnn,   (numeric) Matured rewards not yet spent purchasing tickets (in VAR)\n \"height\": n,        (numeric) Main chain height through which matured rewards have been accrued\n},...]\n",
+		"ticketinfo":                       "ticketinfo (startheight=0)\n\nReturns details of each wallet ticket transaction\n\nArguments:\n1. startheight (numeric, optional, default=0) Specify the starting block height to scan from\n\nResult:\n[{\n \"hash\": \"value\",               (string)          Transaction hash of the ticket\n \"cost\": n.nnn,                 (numeric)         Amount paid to purchase the ticket; this may be greater than the ticket price at time of purchase\n \"votingaddress\": \"value\",      (string)          Address of 0th output, which describes the requirements to spend the ticket\n \"status\": \"value\",             (string)          Description of ticket status (unknown, unmined, immature, mature, live, voted, missed, expired, unspent, revoked)\n \"blockhash\": \"value\",          (string)          Hash of block ticket is mined in\n \"blockheight\": n,              (numeric)         Height of block ticket is mined in\n \"vote\": \"value\",               (string)          Transaction hash of vote which spends the ticket\n \"revocation\": \"value\",         (string)          Transaction hash of revocation which spends the ticket\n \"choices\": [{                  (array of object) Vote preferences set for the ticket\n  \"agendaid\": \"value\",          (string)          The ID for the agenda the choice concerns\n  \"agendadescription\": \"value\", (string)          A description of the agenda the choice concerns\n  \"agendastatus\": \"value\",      (string)          Deployment status of the agenda (defined, started, lockedin, active, or failed), if reported by the consensus RPC server\n  \"choiceid\": \"value\",          (string)          The ID of the current choice for this agenda\n  \"choicedescription\": \"value\", (string)          A description of the current choice for this agenda\n },...],                                          \n \"vsphost\": \"value\",            (string)          VSP Host associated with the ticket (if any)\n \"feerewardfunded\": true|false, (boolean)         Whether the ticket was funded only by SSFee reward outputs\n},...]\n",
 		"treasurypolicy":                   "treasurypolicy (\"key\" \"ticket\")\n\nReturn voting policies for treasury spend transactions by key\n\nArguments:\n1. key    (string, optional) Return the policy for a particular key\n2. ticket (string, optional) Return policies used by a specific ticket hash\n\nResult (no key provided):\n[{\n \"key\": \"value\",    (string) Treasury key associated with a policy\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket treasury key approval policy\n},...]\n\nResult (key specified):\n{\n \"key\": \"value\",    (string) Treasury key associated with a policy\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket treasury key approval policy\n}                   \n",
 		"tspendpolicy":                     "tspendpolicy (\"hash\" \"ticket\")\n\nReturn voting policies for treasury spend transactions\n\nArguments:\n1. hash   (string, optional) Return the policy for a particular tspend hash\n2. ticket (string, optional) Return policies used by a specific ticket hash\n\nResult (no tspend hash provided):\n[{\n \"hash\": \"value\",   (string) Treasury spend transaction hash\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket tspend approval policy\n},...]\n\nResult (tspend hash specified):\n{\n \"hash\": \"value\",   (string) Treasury spend transaction hash\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket tspend approval policy\n}                   \n",
+		"unarchiveaccount":                 "unarchiveaccount \"account\"\n\nUnarchives an account previously archived with archiveaccount.\n\nArguments:\n1. account (string, required) The account to unarchive\n\nResult:\nNothing\n",
+		"unblockaddress":                   "unblockaddress \"address\"\n\nRemove an address from the send policy blocklist\n\nArguments:\n1. address (string, required) The blocked address\n\nResult:\nNothing\n",
 		"unlockaccount":                    "unlockaccount \"account\" \"passphrase\"\n\nUnlock an individually-encrypted account\n\nArguments:\n1. account    (string, required) Account to unlock\n2. passphrase (string, required) Account passphrase\n\nResult:\nNothing\n",
+		"unlockstaking":                    "unlockstaking \"passphrase\"\n\nUnlock the staking keys, which vote and revoke tickets without unlocking the wallet's spending keys.\n\nArguments:\n1. passphrase (string, required) The staking passphrase\n\nResult:\nNothing\n",
+		"unloadwallet":                     "unloadwallet \"name\"\n\nCloses a loaded named wallet.  The default wallet may not be unloaded.\n\nArguments:\n1. name (string, required) Name of the wallet\n\nResult:\nNothing\n",
+		"untagcounterparty":                "untagcounterparty [\"address\",...]\n\nRemoves the counterparty tags of addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to untag\n\nResult:\nNothing\n",
 		"validateaddress":                  "validateaddress \"address\"\n\nVerify that an address is valid.\nExtra details are returned if the address is controlled by this wallet.\nThe following fields are valid only when the address is controlled by this wallet (ismine=true): pubkey, account, addresses, hex, and sigsrequired.\nThe following fields are only valid when address has an associated public key: pubkey, iscompressed.\nThe following fields are only valid when address is a pay-to-script-hash address: addresses, hex, and script.\nIf the address is a multisig address controlled by this wallet, the multisig fields will be left unset if the wallet is locked since the redeem script cannot be decrypted.\n\nArguments:\n1. address (string, required) Address to validate\n\nResult:\n{\n \"isvalid\": true|false,      (boolean)         Whether or not the address is valid\n \"address\": \"value\",         (string)          The payment address (only when isvalid is true)\n \"ismine\": true|false,       (boolean)         Whether this address is controlled by the wallet (only when isvalid is true)\n \"iswatchonly\": true|false,  (boolean)         Unset\n \"isscript\": true|false,     (boolean)         Whether the payment address is a pay-to-script-hash address (only when isvalid is true)\n \"pubkeyaddr\": \"value\",      (string)          The pubkey for this payment address (only when isvalid is true)\n \"pubkey\": \"value\",          (string)          The associated public key of the payment address, if any (only when isvalid is true)\n \"iscompressed\": true|false, (boolean)         Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)\n \"account\": \"value\",         (string)          The account this payment address belongs to (only when isvalid is true)\n \"addresses\": [\"value\",...], (array of string) All associated payment addresses of the script if address is a multisig address (only when isvalid is true)\n \"hex\": \"value\",             (string)          The redeem script \n \"script\": \"value\",          (string)          The class of redeem script for a multisig address\n \"sigsrequired\": n,          (numeric)         The number of required signatures to redeem outputs to the multisig address\n \"accountn\": n,              (numeric)         The account number. This number plus 2 ^ 31 is the HD account the address was derived from. Not available for imported accounts. Only present for BIP0044 derived addresses.\n \"branch\": n,                (numeric)         The HD branch. Only present for BIP0044 derived addresses.\n \"index\": n,                 (numeric)         The HD index. Only present for BIP0044 derived addresses.\n}                            \n",
 		"validatepredcp0005cf":             "validatepredcp0005cf\n\nValidate whether all stored cfilters from before DCP0005 activation are correct according to the expected hardcoded hash\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the cfilters are valid\n",
-		"verifymessage":                    "verifymessage \"address\" \"signature\" \"message\"\n\nVerify a message was signed with the associated private key of some address.\n\nArguments:\n1. address   (string, required) Address used to sign message\n2. signature (string, required) The signature to verify\n3. message   (string, required) The message to verify\n\nResult:\ntrue|false (boolean) Whether the message was signed with the private key of 'address'\n",
+		"verifymessage":                    "verifymessage \"address\" \"signature\" \"message\"\n\nVerify a message was signed with the associated private key of some address.\nThe address must be an ECDSA or Schnorr pay-to-pubkey-hash address.\n\nArguments:\n1. address   (string, required) Address used to sign message\n2. signature (string, required) The signature to verify\n3. message   (string, required) The message to verify\n\nResult:\ntrue|false (boolean) Whether the message was signed with the private key of 'address'\n",
+		"verifyownershipproof":             "verifyownershipproof \"proof\" \"challenge\"\n\nVerify a proof of ownership created by createownershipproof.\nThe proof is valid when it commits to the challenge and every input is signed by the key of the output it spends. Signatures do not commit to the values and scripts of the outputs, so the verifier must also confirm with a full node that each output exists as described and is unspent.\n\nArguments:\n1. proof     (string, required) The hex-encoded serialized proof\n2. challenge (string, required) The challenge the proof must commit to\n\nResult:\n{\n \"valid\": true|false,  (boolean)         Whether the proof is valid for the challenge\n \"error\": \"value\",     (string)          The reason the proof is invalid, unset if it is valid\n \"challenge\": \"value\", (string)          The challenge committed to by the proof\n \"outputs\": [{         (array of object) The outputs claimed by the proof\n  \"txid\": \"value\",     (string)          The transaction hash of the output\n  \"vout\": n,           (numeric)         The output index\n  \"tree\": n,           (numeric)         The transaction tree of the output\n  \"cointype\": n,       (numeric)         Coin type of the output\n  \"amount\": unknown,   (value)           Value of the output\n  \"address\": \"value\",  (string)          The address paid by the output\n },...],                                 \n \"totals\": [{          (array of object) The total value of the claimed outputs of each coin type\n  \"cointype\": n,       (numeric)         The coin type\n  \"amount\": unknown,   (value)           Total value of the outputs of the coin type\n },...],                                 \n}                      \n",
+		"verifyseed":                       "verifyseed \"mnemonic\"\n\nVerify that a mnemonic seed backup encodes the wallet seed without revealing the seed.\n\nArguments:\n1. mnemonic (string, required) The space-separated mnemonic seed words\n\nResult:\n{\n \"matches\": true|false,     (boolean)          Whether the mnemonic encodes the wallet seed\n \"incorrectwords\": [n,...], (array of numeric) Zero-based positions of words known to be incorrect (a single incorrect word is always located, several may not be)\n}                           \n",
 		"version":                          "version\n\nReturns application and API versions (semver) keyed by their names\n\nArguments:\nNone\n\nResult:\n{\n \"Program or API name\": Object containing the semantic version, (object) Version objects keyed by the program or API name\n ...\n}\n",
-		"walletinfo":                       "walletinfo\n\nReturns global information about the wallet\n\nArguments:\nNone\n\nResult:\n{\n \"daemonconnected\": true|false, (boolean) Whether or not the wallet is currently connected to the daemon RPC\n \"spv\": true|false,             (boolean) Whether or not wallet is syncing in SPV mode\n \"unlocked\": true|false,        (boolean) Whether or not the wallet is unlocked\n \"cointype\": n,                 (numeric) Active coin type. Not available for watching-only wallets.\n \"txfee\": n.nnn,                (numeric) Transaction fee per kB of the serialized tx size in coins\n \"votebits\": n,                 (numeric) Vote bits setting\n \"votebitsextended\": \"value\",   (string)  Extended vote bits setting\n \"voteversion\": n,              (numeric) Version of votes that will be generated\n \"voting\": true|false,          (boolean) Whether or not the wallet is currently voting tickets\n \"vsp\": \"value\",                (string)  VSP URL used when purchasing tickets\n \"manualtickets\": true|false,   (boolean) Whether or not the wallet is only accepting tickets manually\n \"birthhash\": \"value\",          (string)  The wallet birth hash.\n \"birthheight\": n,              (numeric) The wallet birth height.\n}                               \n",
+		"walletaudit":                      "walletaudit\n\nDescribes the derivation path and usage of every derived wallet address, up to the last returned or used address of each account branch.\nUsage is read from the outputs recorded by the wallet rather than by rescanning the chain.\n\nArguments:\nNone\n\nResult:\n[{\n \"address\": \"value\",     (string)          The derived address\n \"account\": n,           (numeric)         The account number of the address\n \"accountname\": \"value\", (string)          The account name of the address\n \"branch\": n,            (numeric)         The account branch of the address (0 for external, 1 for internal)\n \"index\": n,             (numeric)         The child index of the address on the branch\n \"path\": \"value\",        (string)          The BIP0044 derivation path of the address, unset for addresses of imported xpub accounts\n \"firstuseheight\": n,    (numeric)         Height of the first block with an output paying the address, or -1 if unused\n \"lastuseheight\": n,     (numeric)         Height of the last block with an output paying the address, or -1 if unused\n \"received\": [{          (array of object) Total received by the address, including spent and unmined outputs, by coin type\n  \"cointype\": n,         (numeric)         Coin type of the received outputs\n  \"amount\": unknown,     (value)           Total received in the coin type\n },...],                                   \n \"utxos\": n,             (numeric)         Number of unspent outputs paying the address\n},...]\n",
+		"walletinfo":                       "walletinfo\n\nReturns global information about the wallet\n\nArguments:\nNone\n\nResult:\n{\n \"daemonconnected\": true|false, (boolean) Whether or not the wallet is currently connected to the daemon RPC\n \"spv\": true|false,             (boolean) Whether or not wallet is syncing in SPV mode\n \"unlocked\": true|false,        (boolean) Whether or not the wallet is unlocked\n \"cointype\": n,                 (numeric) Active coin type. Not available for watching-only wallets.\n \"txfee\": n.nnn,                (numeric) Transaction fee per kB of the serialized tx size in coins\n \"votebits\": n,                 (numeric) Vote bits setting\n \"votebitsextended\": \"value\",   (string)  Extended vote bits setting\n \"voteversion\": n,              (numeric) Version of votes that will be generated\n \"voting\": true|false,          (boolean) Whether or not the wallet is currently voting tickets\n \"vsp\": \"value\",                (string)  VSP URL used when purchasing tickets\n \"manualtickets\": true|false,   (boolean) Whether or not the wallet is only accepting tickets manually\n \"birthhash\": \"value\",          (string)  The wallet birth hash.\n \"birthheight\": n,              (numeric) The wallet birth height.\n \"utxocachehits\": n,            (numeric) Number of input selections served by the cache of unspent outputs\n \"utxocachemisses\": n,          (numeric) Number of input selections which read unspent outputs from the database\n \"utxocachehitrate\": n.nnn,     (numeric) Fraction of input selections served by the cache of unspent outputs\n}                               \n",
 		"walletislocked":                   "walletislocked\n\nReturns whether or not the wallet is locked.\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the wallet is locked\n",
 		"walletlock":                       "walletlock\n\nLock the wallet.\n\nArguments:\nNone\n\nResult:\nNothing\n",
-		"walletpassphrase":                 "walletpassphrase \"passphrase\" timeout\n\nUnlock the wallet.\n\nArguments:\n1. passphrase (string, required)  The wallet passphrase\n2. timeout    (numeric, required) The number of seconds to wait before the wallet automatically locks. 0 leaves the wallet unlocked indefinitely.\n\nResult:\nNothing\n",
+		"walletpassphrase":                 "walletpassphrase \"passphrase\" timeout (mode=\"all\")\n\nUnlock the wallet.\n\nArguments:\n1. passphrase (string, required)                The wallet passphrase\n2. timeout    (numeric, required)               The number of seconds to wait before the wallet automatically locks. 0 leaves the wallet unlocked indefinitely.\n3. mode       (string, optional, default=\"all\") Which operations may use the unlocked wallet: all, once (locks again after the first operation using private keys), or staking (only the keys of imported voting accounts)\n\nResult:\nNothing\n",
 		"walletpassphrasechange":           "walletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\n\nChange the wallet passphrase.\n\nArguments:\n1. oldpassphrase (string, required) The old wallet passphrase\n2. newpassphrase (string, required) The new wallet passphrase\n\nResult:\nNothing\n",
+		"walletprocesspsdt":                "walletprocesspsdt \"psdt\" (sign=true finalize=true)\n\nAdds the previous outputs, redeem scripts, and BIP0032 derivations known to the wallet to a PSDT, and optionally signs the inputs which can be signed by wallet keys.\nSigning requires the wallet to be unlocked.\n\nArguments:\n1. psdt     (string, required)                The base64-encoded PSDT\n2. sign     (boolean, optional, default=true) Sign inputs with wallet keys\n3. finalize (boolean, optional, default=true) Create the signature scripts of inputs with enough signatures\n\nResult:\n{\n \"psdt\": \"value\",        (string)  The base64-encoded PSDT\n \"complete\": true|false, (boolean) Whether every input is finalized\n \"hex\": \"value\",         (string)  The signed transaction encoded as a hexadecimal string, when complete\n}                        \n",
 		"walletpubpassphrasechange":        "walletpubpassphrasechange \"oldpassphrase\" \"newpassphrase\"\n\nChange the wallet's public passphrase.\n\nArguments:\n1. oldpassphrase (string, required) The old wallet passphrase\n2. newpassphrase (string, required) The new wallet passphrase\n\nResult:\nNothing\n",
 	}
 }
@@ -123,4 +224,4 @@ var localeHelpDescs = map[string]func() map[string]string{
 	"en_US": helpDescsEnUS,
 }
 
-var requestUsages = "abandontransaction \"hash\"\naccountaddressindex \"account\" branch\naccountsyncaddressindex \"account\" branch index\naccountunlocked \"account\"\naddmultisigaddress nrequired [\"key\",...] (\"account\")\naddtransaction \"blockhash\" \"transaction\"\nauditreuse (since)\nconsolidate inputs (\"account\" \"address\" cointype)\ncreatemultisig nrequired [\"key\",...]\ncreatenewaccount \"account\"\ncreateauthorizedemission cointype \"emissionkeyname\" \"passphrase\"\ncreaterawtransaction [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\ncreatesignature \"address\" inputindex hashtype \"previouspkscript\" \"serializedtransaction\"\ndebuglevel \"levelspec\"\ndisapprovepercent\ndiscoverusage (\"startblock\" discoveraccounts gaplimit)\ndumpprivkey \"address\"\nfundrawtransaction \"hexstring\" \"fundaccount\" ({\"changeaddress\":changeaddress,\"feerate\":feerate,\"conftarget\":conftarget})\ngenerateemissionkey \"keyname\" \"passphrase\" (cointype)\ngetaccount \"address\"\ngetaccountaddress \"account\"\ngetaddressesbyaccount \"account\"\ngetbalance (\"account\" minconf=1 cointype)\ngetcoinbalance cointype (\"account\" minconf=1)\ngetbestblock\ngetbestblockhash\ngetblockcount\ngetblockhash index\ngetblockheader \"hash\" (verbose=true)\ngetblock \"hash\" (verbose=true verbosetx=false)\ngetcoinjoinsbyacct\ngetcurrentnet\ngetinfo\ngetmasterpubkey (\"account\")\ngetmultisigoutinfo \"hash\" index\ngetnewaddress (\"account\" \"gappolicy\")\ngetpeerinfo\ngetrawchangeaddress (\"account\")\ngetreceivedbyaccount \"account\" (minconf=1)\ngetreceivedbyaddress \"address\" (minconf=1 cointype=0)\ngetstakeinfo\ngettickets includeimmature\ngettransaction \"txid\" (includewatchonly=false)\ngettxout \"txid\" vout tree (includemempool=true)\ngetunconfirmedbalance (\"account\")\ngetvotechoices (\"tickethash\")\ngetvotefeeconsolidationaddress \"account\"\ngetwalletfee (cointype=0)\nclearvotefeeconsolidationaddress \"account\"\ngetcfilterv2 \"blockhash\"\nhelp (\"command\")\nimportcfiltersv2 startheight [\"filter\",...]\nimportemissionkey \"keyname\" \"privatekey\" \"passphrase\" (cointype)\nimportprivkey \"privkey\" (\"label\" rescan=true scanfrom)\nimportpubkey \"pubkey\" (\"label\" rescan=true scanfrom)\nimportscript \"hex\" (rescan=true scanfrom)\nimportxpub \"name\" \"xpub\"\nlistaccounts (minconf=1)\nlistaddresstransactions [\"address\",...] (\"account\")\nlistalltransactions (\"account\")\nlistcointypes (minconf=1)\nlistlockunspent (\"account\")\nlistreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\nlistreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\nlistsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\nlisttransactions (\"account\" count=10 from=0 includewatchonly=false)\nlistunspent (minconf=1 maxconf=9999999 [\"address\",...] \"account\" cointype)\nlockaccount \"account\"\nlockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\nmixaccount\nmixoutput \"outpoint\"\nprocessunmanagedticket \"tickethash\"\npurchaseticket \"fromaccount\" spendlimit (minconf=1 numtickets=1 expiry \"comment\" dontsigntx)\nredeemmultisigout \"hash\" index tree (\"address\")\nredeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\nrenameaccount \"oldaccount\" \"newaccount\"\nrescanwallet (beginheight=0)\nsendfrom \"fromaccount\" \"toaddress\" \"amount\" (minconf=1 \"comment\" \"commentto\" cointype)\nsendfromtreasury \"key\" amounts\nsendmany \"fromaccount\" {\"address\":\"amount\",...} (minconf=1 \"comment\" cointype)\nsendrawtransaction \"hextx\" (allowhighfees=false)\nsendtoaddress \"address\" \"amount\" (\"comment\" \"commentto\" cointype)\nsendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\nsendtotreasury amount\nsendtoburn \"amount\" cointype \"passphrase\" (\"comment\")\nsetaccountpassphrase \"account\" \"passphrase\"\nsetdisapprovepercent percent\nsettreasurypolicy \"key\" \"policy\" (\"ticket\")\nsettspendpolicy \"hash\" \"policy\" (\"ticket\")\nsettxfee amount (cointype=0)\nsetvotechoice \"agendaid\" \"choiceid\" (\"tickethash\")\nsetvotefeeconsolidationaddress \"account\" \"address\"\nsignmessage \"address\" \"message\"\nsignrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\nsignrawtransactions [\"rawtx\",...] (send=true)\nspendoutputs \"account\" [\"previousoutpoint\",...] [{\"address\":\"value\",\"amount\":n.nnn},...]\nsweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\nsyncstatus\nticketinfo (startheight=0)\ntreasurypolicy (\"key\" \"ticket\")\ntspendpolicy (\"hash\" \"ticket\")\nunlockaccount \"account\" \"passphrase\"\nvalidateaddress \"address\"\nvalidatepredcp0005cf\nverifymessage \"address\" \"signature\" \"message\"\nversion\nwalletinfo\nwalletislocked\nwalletlock\nwalletpassphrase \"passphrase\" timeout\nwalletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\nwalletpubpassphrasechange \"oldpassphrase\" \"newpassphrase\""
+var requestUsages = "abandontransaction \"hash\"\naccountaddressindex \"account\" branch\naccountsyncaddressindex \"account\" branch index\naccountunlocked \"account\"\naddmultisigaddress nrequired [\"key\",...] (\"account\")\naddrecurringpayment \"fromaccount\" \"address\" \"amount\" (intervalblocks \"interval\" start cointype failurepolicy=\"retry\" minconf=1 \"comment\")\naddtransaction \"blockhash\" \"transaction\"\napprovepending id\narchiveaccount \"account\"\nauditcontract \"contracttx\" \"contract\"\nauditreuse (since)\nbackupwallet \"destination\" \"passphrase\"\nblockaddress \"address\" (\"reason\")\ncancelscheduledsend id\nchangeaccounts\nchangescripttypes\nclearinheritance \"account\"\ncombinepsdt [\"psdt\",...]\ncompactwallet (prunedepth=0 status=false)\nconsolidate inputs (\"account\" \"address\" cointype)\ncounterpartysummary (\"counterparty\")\ncreatecontract \"account\" \"recipient\" \"amount\" (cointype=0 \"secrethash\" locktime)\ncreatemultisig nrequired [\"key\",...]\ncreatemultisigaccount \"account\" nrequired [\"xpub\",...]\ncreatevaultaccount \"account\" delay (\"recoveryxpub\")\ncreateownershipproof \"challenge\" [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\ncreatenewaccount \"account\"\ncreateinvoice \"amount\" (cointype=0 account=\"default\" \"label\" expires)\ncreatepaymenturi \"address\" (\"amount\" cointype=0 \"label\" \"message\" expires)\ncreaterpccredential \"username\" [\"scop\",...]\ncreateauthorizedemission cointype \"emissionkeyname\" \"passphrase\"\ncreaterawtransaction [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\ncreatesignature \"address\" inputindex hashtype \"previouspkscript\" \"serializedtransaction\"\ncreateunsignedtransactionfile \"fromaccount\" {\"address\":\"amount\",...} (minconf=1 cointype)\ncreatewallet \"name\" \"passphrase\" \"seed\" (\"pubpassphrase\")\ncreatewatchonlywallet [\"xpub\",...] (\"pubpassphrase\")\ndebuglevel \"levelspec\"\ndecodepaymenturi \"uri\"\ndisapprovepercent\ndiscoverusage (\"startblock\" discoveraccounts gaplimit fullscan=false)\ndumpaccountprivkeys \"account\" \"passphrase\"\ndumpprivkey \"address\"\nemitska cointype \"emissionkeyname\" (nonce=1)\nestimatesendfee \"fromaccount\" {\"address\":\"amount\",...} (minconf=1 cointype [\"subtractfeefrom\",...] \"feepreference\")\nexportcounterparties\nexportaccountxpriv \"account\" \"passphrase\"\nexporthistory \"destination\" (format=\"csv\")\nextractsecret \"redeemtx\" \"secrethash\"\nfinalizepsdt \"psdt\" (extract=true)\nfundrawtransaction \"hexstring\" \"fundaccount\" ({\"changeaddress\":changeaddress,\"feerate\":feerate,\"conftarget\":conftarget})\ngenerateemissionkey \"keyname\" \"passphrase\" (cointype)\ngetaccount \"address\"\ngetaccountaddress \"account\"\ngetaddressesbyaccount \"account\"\ngetbalance (\"account\" minconf=1 cointype)\ngetcoinbalance cointype (\"account\" minconf=1)\ngetbestblock\ngetbestblockhash\ngetblockcount\ngetblockhash index\ngetblockheader \"hash\" (verbose=true)\ngetblock \"hash\" (verbose=true verbosetx=false)\ngetcoinjoinsbyacct\ngetcurrentnet\ngetfeesummary (days=30 cointype)\ngetinfo\ngetinheritance\ngetinvoice id\ngetkdfinfo\ngetmasterpubkey (\"account\")\ngetmaxspendable \"account\" (cointype=0 minconf=1 feeperkb)\ngetmigrationhistory\ngetmultisigoutinfo \"hash\" index\ngetnewaddress (\"account\" \"gappolicy\")\ngetpeerinfo\ngetrawchangeaddress (\"account\")\ngetreceivedbyaccount \"account\" (minconf=1)\ngetreceivedbyaddress \"address\" (minconf=1 cointype=0)\ngetrescanstatus\ngetrpcstats (count=20 \"method\")\ngetsendpolicy\ngetskaemissionhistory (cointype)\ngetskasupply (cointype)\ngetstakeinfo\ngetstakestats (window=0)\ngettickets includeimmature\ngettransaction \"txid\" (includewatchonly=false)\ngettransactionspage (cursor=\"\" limit=100 cointype \"txclass\" startheight endheight)\ngettxtrace \"txhash\"\ngettxout \"txid\" vout tree (includemempool=true)\ngetunconfirmedbalance (\"account\")\ngetvotechoices (\"tickethash\")\ngetvotefeeconsolidationaddress \"account\"\ngetvspticketstatus \"tickethash\"\ngetwalletdbversion\ngetwalletfee (cointype=0)\ngetwalletlockstate\nclearvotefeeconsolidationaddress \"account\"\ngetcfilterv2 \"blockhash\"\nhelp (\"command\")\nimportcfiltersv2 startheight [\"filter\",...]\nimportcounterparties {\"counterparty\":[\"address\",...],...}\nimportemissionkey \"keyname\" \"privatekey\" \"passphrase\" (cointype)\nimportlegacywallet \"source\" (\"pubpassphrase\")\nimportprivkey \"privkey\" (\"label\" rescan=true scanfrom)\nimportpubkey \"pubkey\" (\"label\" rescan=true scanfrom)\nimportscript \"hex\" (rescan=true scanfrom \"account\")\nimportxpub \"name\" \"xpub\" (gaplimit rescan=true scanfrom)\nlistaccounts (minconf=1 includearchived=false)\nlistaddresstransactions [\"address\",...] (\"account\")\nlistalltransactions (\"account\")\nlistcointypes (minconf=1)\nlistinvoices (\"status\")\nlistlockunspent (\"account\")\nlistpendingbroadcasts\nlistpendingsends\nlistreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\nlistreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\nlistrecurringpayments\nlistrpccredentials\nlistscheduledsends\nlistsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\nlistspendlimits\nlisttransactions (\"account\" count=10 from=0 includewatchonly=false cointype \"txclass\" startheight endheight)\nlistunspent (minconf=1 maxconf=9999999 [\"address\",...] \"account\" cointype)\nlistwallets\nloadwallet \"name\" (\"pubpassphrase\")\nlockaccount \"account\"\nlockstaking\nlockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\nmixaccount\nmixoutput \"outpoint\"\nprocessunmanagedticket \"tickethash\"\npauserecurringpayment id\nplanconsolidation inputs (\"account\" cointype)\npurchaseticket \"fromaccount\" spendlimit (minconf=1 numtickets=1 expiry \"comment\" dontsigntx)\nrecordprice cointype \"currency\" \"price\" (time)\nrecoverunspent (beginheight fullscan=false)\nredeemcontract \"contracttx\" \"contract\" (\"secret\")\nredeemmultisigout \"hash\" index tree (\"address\")\nredeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\nrejectpending id\nremoverecurringpayment id\nrenameaccount \"oldaccount\" \"newaccount\"\nrescanwallet (beginheight fullscan=false)\nrestorewallet \"source\" \"passphrase\" (\"pubpassphrase\")\nresumerecurringpayment id\nrevokerpccredential \"username\"\nschedulesend \"fromaccount\" {\"address\":\"amount\",...} (notbefore notbeforeheight presign=false minconf=1 cointype \"comment\" expiry expireafter \"feepreference\")\nsendfrom \"fromaccount\" \"toaddress\" \"amount\" (minconf=1 \"comment\" \"commentto\" cointype \"fiatcurrency\" expiry expireafter locktime \"feepreference\")\nsendfromtreasury \"key\" amounts\nsendfromvault \"account\" {\"address\":\"amount\",...} (cointype)\nsendmany \"fromaccount\" {\"address\":\"amount\",...} (minconf=1 \"comment\" cointype \"fiatcurrency\" [\"subtractfeefrom\",...] expiry expireafter locktime \"feepreference\" \"data\")\nsendrawtransaction \"hextx\" (allowhighfees=false)\nsendtoaddress \"address\" \"amount\" (\"comment\" \"commentto\" cointype \"fiatcurrency\" subtractfeefromamount expiry expireafter locktime \"feepreference\")\nsendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\nsendtotreasury amount (fromaccount=\"default\")\nsendtoburn \"amount\" cointype \"passphrase\" (\"comment\")\nsendtspend \"hextx\" \"pikey\"\nsetaccountgaplimit \"account\" gaplimit\nsetaccountpassphrase \"account\" \"passphrase\"\nsetchangeaccount \"account\" \"changeaccount\" (cointype=0)\nsetchangescripttype \"account\" \"scripttype\"\nsetdisapprovepercent percent\nsetinheritance \"account\" \"address\" delaydays\nsetlabelthreshold \"threshold\" (cointype=0)\nsetloglevel \"level\" (\"subsystem\")\nsetskasendaccounts cointype [\"account\",...]\nsetspendlimit \"account\" \"limit\" (cointype=0 requireapproval=false)\nsetstakingaccount \"account\" (staking=true)\nsetstakingpassphrase \"passphrase\"\nsetticketbuyerconfig \"account\" target (maxprice maxfee reserve)\nsetticketcompounding \"account\" enable\nsettreasurypolicy \"key\" \"policy\" (\"ticket\")\nsettspendpolicy \"hash\" \"policy\" (\"ticket\")\nsettxfee amount (cointype=0)\nsetvotechoice \"agendaid\" \"choiceid\" (\"tickethash\")\nsetvotefeeconsolidationaddress \"account\" \"address\" (external=false)\nsetvsp \"host\" \"pubkey\" (feeaccount=\"default\")\nsetwalletbirthday birthday (timestamp=false)\nsignmessage \"address\" \"message\"\nsignrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\",\"amount\":amount,\"skavaluein\":skavaluein},...] [\"privkey\",...] flags=\"ALL\")\nsignrawtransactionoffline \"file\"\nsignrawtransactions [\"rawtx\",...] (send=true)\nspendoutputs \"account\" [\"previousoutpoint\",...] [{\"address\":\"value\",\"amount\":n.nnn},...]\nsweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb cointype)\nsyncstatus\ntagcounterparty \"counterparty\" [\"address\",...]\nticketbuyerconfig\nticketcompounding\nticketinfo (startheight=0)\ntreasurypolicy (\"key\" \"ticket\")\ntspendpolicy (\"hash\" \"ticket\")\nunarchiveaccount \"account\"\nunblockaddress \"address\"\nunlockaccount \"account\" \"passphrase\"\nunlockstaking \"passphrase\"\nunloadwallet \"name\"\nuntagcounterparty [\"address\",...]\nvalidateaddress \"address\"\nvalidatepredcp0005cf\nverifymessage \"address\" \"signature\" \"message\"\nverifyownershipproof \"proof\" \"challenge\"\nverifyseed \"mnemonic\"\nversion\nwalletaudit\nwalletinfo\nwalletislocked\nwalletlock\nwalletpassphrase \"passphrase\" timeout (mode=\"all\")\nwalletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\nwalletprocesspsdt \"psdt\" (sign=true finalize=true)\nwalletpubpassphrasechange \"oldpassphrase\" \"newpassphrase\""
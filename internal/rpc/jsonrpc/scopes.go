@@ -0,0 +1,221 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import (
+	"github.com/monetarium/monetarium-node/dcrjson"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+)
+
+// methodScopes maps methods to the RPC scope a client must hold to invoke
+// them.  Methods which are not listed require the admin scope.
+var methodScopes = map[string]udb.RPCScopes{
+	// Methods which only report wallet and network state.
+	"accountaddressindex":            udb.RPCScopeRead,
+	"accountunlocked":                udb.RPCScopeRead,
+	"auditcontract":                  udb.RPCScopeRead,
+	"auditreuse":                     udb.RPCScopeRead,
+	"changeaccounts":                 udb.RPCScopeRead,
+	"changescripttypes":              udb.RPCScopeRead,
+	"counterpartysummary":            udb.RPCScopeRead,
+	"createmultisig":                 udb.RPCScopeRead,
+	"decodepaymenturi":               udb.RPCScopeRead,
+	"disapprovepercent":              udb.RPCScopeRead,
+	"estimatesendfee":                udb.RPCScopeRead,
+	"extractsecret":                  udb.RPCScopeRead,
+	"getaccount":                     udb.RPCScopeRead,
+	"getaddressesbyaccount":          udb.RPCScopeRead,
+	"getbalance":                     udb.RPCScopeRead,
+	"getbestblock":                   udb.RPCScopeRead,
+	"getbestblockhash":               udb.RPCScopeRead,
+	"getblock":                       udb.RPCScopeRead,
+	"getblockcount":                  udb.RPCScopeRead,
+	"getblockhash":                   udb.RPCScopeRead,
+	"getblockheader":                 udb.RPCScopeRead,
+	"getcfilterv2":                   udb.RPCScopeRead,
+	"getcoinbalance":                 udb.RPCScopeRead,
+	"getcoinjoinsbyacct":             udb.RPCScopeRead,
+	"getcurrentnet":                  udb.RPCScopeRead,
+	"getfeesummary":                  udb.RPCScopeRead,
+	"getinfo":                        udb.RPCScopeRead,
+	"getinvoice":                     udb.RPCScopeRead,
+	"getkdfinfo":                     udb.RPCScopeRead,
+	"getmasterpubkey":                udb.RPCScopeRead,
+	"getmaxspendable":                udb.RPCScopeRead,
+	"getmigrationhistory":            udb.RPCScopeRead,
+	"getmultisigoutinfo":             udb.RPCScopeRead,
+	"getpeerinfo":                    udb.RPCScopeRead,
+	"getreceivedbyaccount":           udb.RPCScopeRead,
+	"getreceivedbyaddress":           udb.RPCScopeRead,
+	"getrescanstatus":                udb.RPCScopeRead,
+	"getsendpolicy":                  udb.RPCScopeRead,
+	"getskaemissionhistory":          udb.RPCScopeRead,
+	"getskasupply":                   udb.RPCScopeRead,
+	"getstakeinfo":                   udb.RPCScopeRead,
+	"getstakestats":                  udb.RPCScopeRead,
+	"gettickets":                     udb.RPCScopeRead,
+	"gettransaction":                 udb.RPCScopeRead,
+	"gettransactionspage":            udb.RPCScopeRead,
+	"gettxout":                       udb.RPCScopeRead,
+	"gettxtrace":                     udb.RPCScopeRead,
+	"getunconfirmedbalance":          udb.RPCScopeRead,
+	"getvotechoices":                 udb.RPCScopeRead,
+	"getvotefeeconsolidationaddress": udb.RPCScopeRead,
+	"getvspticketstatus":             udb.RPCScopeRead,
+	"getwalletdbversion":             udb.RPCScopeRead,
+	"getwalletfee":                   udb.RPCScopeRead,
+	"getwalletlockstate":             udb.RPCScopeRead,
+	"help":                           udb.RPCScopeRead,
+	"listaccounts":                   udb.RPCScopeRead,
+	"listaddresstransactions":        udb.RPCScopeRead,
+	"listalltransactions":            udb.RPCScopeRead,
+	"listcointypes":                  udb.RPCScopeRead,
+	"listinvoices":                   udb.RPCScopeRead,
+	"listlockunspent":                udb.RPCScopeRead,
+	"listpendingbroadcasts":          udb.RPCScopeRead,
+	"listpendingsends":               udb.RPCScopeRead,
+	"listscheduledsends":             udb.RPCScopeRead,
+	"listreceivedbyaccount":          udb.RPCScopeRead,
+	"listreceivedbyaddress":          udb.RPCScopeRead,
+	"listrecurringpayments":          udb.RPCScopeRead,
+	"listsinceblock":                 udb.RPCScopeRead,
+	"listspendlimits":                udb.RPCScopeRead,
+	"listtransactions":               udb.RPCScopeRead,
+	"listunspent":                    udb.RPCScopeRead,
+	"listwallets":                    udb.RPCScopeRead,
+	"notifyaccountconfirmations":     udb.RPCScopeRead,
+	"notifycointypebalance":          udb.RPCScopeRead,
+	"notifyinvoices":                 udb.RPCScopeRead,
+	"notifyrecurringpayments":        udb.RPCScopeRead,
+	"notifytxconfirmations":          udb.RPCScopeRead,
+	"notifytxconflicts":              udb.RPCScopeRead,
+	"planconsolidation":              udb.RPCScopeRead,
+	"syncstatus":                     udb.RPCScopeRead,
+	"ticketbuyerconfig":              udb.RPCScopeRead,
+	"ticketcompounding":              udb.RPCScopeRead,
+	"ticketinfo":                     udb.RPCScopeRead,
+	"treasurypolicy":                 udb.RPCScopeRead,
+	"tspendpolicy":                   udb.RPCScopeRead,
+	"validateaddress":                udb.RPCScopeRead,
+	"validatepredcp0005cf":           udb.RPCScopeRead,
+	"verifymessage":                  udb.RPCScopeRead,
+	"verifyownershipproof":           udb.RPCScopeRead,
+	"version":                        udb.RPCScopeRead,
+	"walletaudit":                    udb.RPCScopeRead,
+	"walletinfo":                     udb.RPCScopeRead,
+	"walletislocked":                 udb.RPCScopeRead,
+
+	// Methods which derive receiving addresses and create, sign, and
+	// publish transactions.
+	"abandontransaction":            udb.RPCScopeSend,
+	"addtransaction":                udb.RPCScopeSend,
+	"combinepsdt":                   udb.RPCScopeSend,
+	"consolidate":                   udb.RPCScopeSend,
+	"createinvoice":                 udb.RPCScopeSend,
+	"createpaymenturi":              udb.RPCScopeSend,
+	"createrawtransaction":          udb.RPCScopeSend,
+	"createsignature":               udb.RPCScopeSend,
+	"createunsignedtransactionfile": udb.RPCScopeSend,
+	"finalizepsdt":                  udb.RPCScopeSend,
+	"fundrawtransaction":            udb.RPCScopeSend,
+	"getaccountaddress":             udb.RPCScopeSend,
+	"getnewaddress":                 udb.RPCScopeSend,
+	"getrawchangeaddress":           udb.RPCScopeSend,
+	"lockunspent":                   udb.RPCScopeSend,
+	"mixaccount":                    udb.RPCScopeSend,
+	"mixoutput":                     udb.RPCScopeSend,
+	"redeemmultisigout":             udb.RPCScopeSend,
+	"redeemmultisigouts":            udb.RPCScopeSend,
+	"sendfrom":                      udb.RPCScopeSend,
+	"sendmany":                      udb.RPCScopeSend,
+	"sendrawtransaction":            udb.RPCScopeSend,
+	"sendtoaddress":                 udb.RPCScopeSend,
+	"sendtoburn":                    udb.RPCScopeSend,
+	"sendtomultisig":                udb.RPCScopeSend,
+	"settxfee":                      udb.RPCScopeSend,
+	"signrawtransaction":            udb.RPCScopeSend,
+	"signrawtransactionoffline":     udb.RPCScopeSend,
+	"signrawtransactions":           udb.RPCScopeSend,
+	"spendoutputs":                  udb.RPCScopeSend,
+	"sweepaccount":                  udb.RPCScopeSend,
+	"walletprocesspsdt":             udb.RPCScopeSend,
+
+	// Methods which purchase tickets and manage voting preferences.
+	"clearvotefeeconsolidationaddress": udb.RPCScopeStaking,
+	"processunmanagedticket":           udb.RPCScopeStaking,
+	"purchaseticket":                   udb.RPCScopeStaking,
+	"sendtotreasury":                   udb.RPCScopeStaking,
+	"setdisapprovepercent":             udb.RPCScopeStaking,
+	"setticketbuyerconfig":             udb.RPCScopeStaking,
+	"setticketcompounding":             udb.RPCScopeStaking,
+	"settreasurypolicy":                udb.RPCScopeStaking,
+	"settspendpolicy":                  udb.RPCScopeStaking,
+	"setvotechoice":                    udb.RPCScopeStaking,
+	"setvotefeeconsolidationaddress":   udb.RPCScopeStaking,
+	"setvsp":                           udb.RPCScopeStaking,
+}
+
+// readOnlyMethods lists the methods requiring the admin scope which do not
+// modify the wallet, and which remain enabled when the wallet is opened
+// read-only.  Every method requiring the read scope is also enabled.
+var readOnlyMethods = map[string]struct{}{
+	"backupwallet":         {},
+	"createownershipproof": {},
+	"debuglevel":           {},
+	"dumpaccountprivkeys":  {},
+	"dumpprivkey":          {},
+	"dumpwallet":           {},
+	"exportaccountxpriv":   {},
+	"exportcounterparties": {},
+	"exporthistory":        {},
+	"getinheritance":       {},
+	"getrpcstats":          {},
+	"getwalletinfo":        {},
+	"listaddressgroupings": {},
+	"listrpccredentials":   {},
+	"loadwallet":           {},
+	"lockaccount":          {},
+	"setloglevel":          {},
+	"signmessage":          {},
+	"stop":                 {},
+	"unloadwallet":         {},
+	"unlockaccount":        {},
+	"verifyseed":           {},
+	"walletlock":           {},
+	"walletpassphrase":     {},
+}
+
+// requiredScope returns the RPC scope a client must hold to invoke a method.
+func requiredScope(method string) udb.RPCScopes {
+	if scope, ok := methodScopes[method]; ok {
+		return scope
+	}
+	return udb.RPCScopeAdmin
+}
+
+// authorize returns an error if a client authenticated with a set of scopes
+// may not invoke a method.
+func authorize(scopes udb.RPCScopes, method string) *dcrjson.RPCError {
+	required := requiredScope(method)
+	if scopes.Allows(required) {
+		return nil
+	}
+	return rpcErrorf(dcrjson.ErrRPCMisc,
+		"RPC credentials lack the %s scope required by method %s",
+		required, method)
+}
+
+// authorizeReadOnly returns an error if a method may modify the wallet, and
+// is disabled because the wallet was opened read-only.
+func authorizeReadOnly(method string) *dcrjson.RPCError {
+	if requiredScope(method) == udb.RPCScopeRead {
+		return nil
+	}
+	if _, ok := readOnlyMethods[method]; ok {
+		return nil
+	}
+	return rpcErrorf(dcrjson.ErrRPCMisc,
+		"method %s is disabled when the wallet is opened read-only", method)
+}
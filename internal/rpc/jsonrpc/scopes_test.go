@@ -0,0 +1,90 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+)
+
+// websocketMethods are the methods handled by the websocket client loop
+// rather than the handlers map.
+var websocketMethods = map[string]struct{}{
+	"notifyaccountconfirmations": {},
+	"notifycointypebalance":      {},
+	"notifyinvoices":             {},
+	"notifyrecurringpayments":    {},
+	"notifytxconfirmations":      {},
+	"notifytxconflicts":          {},
+}
+
+func TestMethodScopes(t *testing.T) {
+	for method := range methodScopes {
+		_, isHandler := handlers[method]
+		_, isWebsocket := websocketMethods[method]
+		if !isHandler && !isWebsocket {
+			t.Errorf("scope is assigned to unknown method %q", method)
+		}
+	}
+
+	tests := []struct {
+		scopes udb.RPCScopes
+		method string
+		allows bool
+	}{
+		{udb.RPCScopeRead, "getbalance", true},
+		{udb.RPCScopeRead, "notifytxconflicts", true},
+		{udb.RPCScopeRead, "sendtoaddress", false},
+		{udb.RPCScopeRead, "purchaseticket", false},
+		{udb.RPCScopeRead, "dumpprivkey", false},
+		{udb.RPCScopeRead, "stop", false},
+		{udb.RPCScopeRead | udb.RPCScopeSend, "sendtoaddress", true},
+		{udb.RPCScopeSend, "createrpccredential", false},
+		{udb.RPCScopeStaking, "purchaseticket", true},
+		{udb.RPCScopeStaking, "walletpassphrase", false},
+		{udb.RPCScopeAdmin, "walletpassphrase", true},
+		{udb.RPCScopeAdmin, "stop", true},
+	}
+	for _, test := range tests {
+		err := authorize(test.scopes, test.method)
+		if (err == nil) != test.allows {
+			t.Errorf("scopes %v invoking %s: allowed=%v, want %v",
+				test.scopes, test.method, err == nil, test.allows)
+		}
+	}
+}
+
+func TestAuthorizeReadOnly(t *testing.T) {
+	for method := range readOnlyMethods {
+		if _, ok := handlers[method]; !ok && method != "stop" {
+			t.Errorf("unknown method %q is enabled in read-only mode", method)
+		}
+	}
+
+	tests := []struct {
+		method string
+		allows bool
+	}{
+		{"getbalance", true},
+		{"listtransactions", true},
+		{"dumpprivkey", true},
+		{"walletpassphrase", true},
+		{"stop", true},
+		{"sendtoaddress", false},
+		{"consolidate", false},
+		{"importprivkey", false},
+		{"setvotechoice", false},
+		{"purchaseticket", false},
+		{"getnewaddress", false},
+	}
+	for _, test := range tests {
+		err := authorizeReadOnly(test.method)
+		if (err == nil) != test.allows {
+			t.Errorf("invoking %s read-only: allowed=%v, want %v",
+				test.method, err == nil, test.allows)
+		}
+	}
+}
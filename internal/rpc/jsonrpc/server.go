@@ -21,8 +21,15 @@ import (
 
 	"github.com/monetarium/monetarium-wallet/errors"
 	"github.com/monetarium/monetarium-wallet/internal/loader"
+	"github.com/monetarium/monetarium-wallet/internal/loggers"
+	"github.com/monetarium/monetarium-wallet/internal/metrics"
+	"github.com/monetarium/monetarium-wallet/internal/rpctrace"
 	"github.com/monetarium/monetarium-wallet/rpc/jsonrpc/types"
+	"github.com/monetarium/monetarium-wallet/wallet"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
 	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/dcrjson"
 	dcrdtypes "github.com/monetarium/monetarium-node/rpc/jsonrpc/types"
 	"github.com/gorilla/websocket"
@@ -31,6 +38,7 @@ import (
 type websocketClient struct {
 	conn          *websocket.Conn
 	authenticated bool
+	scopes        udb.RPCScopes
 	allRequests   chan []byte
 	responses     chan []byte
 	cancel        func()
@@ -38,10 +46,11 @@ type websocketClient struct {
 	wg            sync.WaitGroup
 }
 
-func newWebsocketClient(c *websocket.Conn, cancel func(), authenticated bool) *websocketClient {
+func newWebsocketClient(c *websocket.Conn, cancel func(), scopes udb.RPCScopes) *websocketClient {
 	return &websocketClient{
 		conn:          c,
-		authenticated: authenticated,
+		authenticated: scopes != 0,
+		scopes:        scopes,
 		allRequests:   make(chan []byte),
 		responses:     make(chan []byte),
 		cancel:        cancel,
@@ -73,6 +82,10 @@ type Server struct {
 	quit    chan struct{}
 	quitMtx sync.Mutex
 
+	// cancel cancels the contexts of the requests still being handled
+	// when the server is stopped.
+	cancel context.CancelFunc
+
 	requestShutdownChan chan struct{}
 
 	activeNet *chaincfg.Params
@@ -90,9 +103,11 @@ func jsonAuthFail(w http.ResponseWriter) {
 }
 
 // NewServer creates a new server for serving JSON-RPC client connections,
-// both HTTP POST and websocket.
+// both HTTP POST and websocket.  Requests are handled with contexts derived
+// from ctx, which are also cancelled once the server is stopped.
 func NewServer(ctx context.Context, opts *Options, activeNet *chaincfg.Params, walletLoader *loader.Loader, listeners []net.Listener) *Server {
 	serveMux := http.NewServeMux()
+	ctx, cancel := context.WithCancel(ctx)
 	const rpcAuthTimeoutSeconds = 10
 	server := &Server{
 		httpServer: http.Server{
@@ -119,6 +134,7 @@ func NewServer(ctx context.Context, opts *Options, activeNet *chaincfg.Params, w
 			CheckOrigin: func(r *http.Request) bool { return true },
 		},
 		quit:                make(chan struct{}),
+		cancel:              cancel,
 		requestShutdownChan: make(chan struct{}, 1),
 		activeNet:           activeNet,
 	}
@@ -133,29 +149,33 @@ func NewServer(ctx context.Context, opts *Options, activeNet *chaincfg.Params, w
 			w.Header().Set("Content-Type", "application/json")
 			r.Close = true
 
-			if err := server.checkAuthHeader(r); err != nil {
-				log.Warnf("Failed authentication attempt from client %s",
+			scopes, err := server.checkAuthHeader(r)
+			if err != nil {
+				logCtx(ctx).Warnf("Failed authentication attempt from client %s",
 					r.RemoteAddr)
 				jsonAuthFail(w)
 				return
 			}
-			server.wg.Add(1)
+			if !server.track(1) {
+				http.Error(w, "503 Server is shutting down.",
+					http.StatusServiceUnavailable)
+				return
+			}
 			defer server.wg.Done()
-			server.postClientRPC(w, r)
+			server.postClientRPC(w, r, scopes)
 		}))
 
 	serveMux.Handle("/ws", throttledFn(opts.MaxWebsocketClients,
 		func(w http.ResponseWriter, r *http.Request) {
-			authenticated := false
-			switch server.checkAuthHeader(r) {
-			case nil:
-				authenticated = true
-			case errNoAuth:
-				// nothing
+			scopes, err := server.checkAuthHeader(r)
+			switch err {
+			case nil, errNoAuth:
+				// Clients which did not authenticate with the
+				// header may use the authenticate method.
 			default:
 				// If auth was supplied but incorrect, rather than simply
 				// being missing, immediately terminate the connection.
-				log.Warnf("Failed authentication attempt from client %s",
+				logCtx(ctx).Warnf("Failed authentication attempt from client %s",
 					r.RemoteAddr)
 				jsonAuthFail(w)
 				return
@@ -163,13 +183,13 @@ func NewServer(ctx context.Context, opts *Options, activeNet *chaincfg.Params, w
 
 			conn, err := server.upgrader.Upgrade(w, r, nil)
 			if err != nil {
-				log.Warnf("Cannot websocket upgrade client %s: %v",
+				logCtx(ctx).Warnf("Cannot websocket upgrade client %s: %v",
 					r.RemoteAddr, err)
 				return
 			}
 			ctx := withRemoteAddr(r.Context(), r.RemoteAddr)
 			ctx, cancel := context.WithCancel(ctx)
-			wsc := newWebsocketClient(conn, cancel, authenticated)
+			wsc := newWebsocketClient(conn, cancel, scopes)
 			server.websocketClientRPC(ctx, wsc)
 		}))
 
@@ -212,14 +232,36 @@ func (s *Server) serve(lis net.Listener) {
 	}()
 }
 
+// track adds n goroutines serving clients to the server's wait group, unless
+// the server is stopping.  It returns whether the goroutines were added.
+func (s *Server) track(n int) bool {
+	s.quitMtx.Lock()
+	defer s.quitMtx.Unlock()
+	select {
+	case <-s.quit:
+		return false
+	default:
+	}
+	s.wg.Add(n)
+	return true
+}
+
 // Stop gracefully shuts down the rpc server by stopping and disconnecting all
 // clients.  This blocks until shutdown completes.
 func (s *Server) Stop() {
+	s.Shutdown(context.Background())
+}
+
+// Shutdown stops the server from accepting connections and requests, and waits
+// for the requests being handled to complete.  If ctx is done first, the
+// contexts of the remaining requests are cancelled, and the context's error is
+// returned after their handlers return.
+func (s *Server) Shutdown(ctx context.Context) error {
 	s.quitMtx.Lock()
 	select {
 	case <-s.quit:
 		s.quitMtx.Unlock()
-		return
+		return nil
 	default:
 	}
 
@@ -236,10 +278,29 @@ func (s *Server) Stop() {
 	close(s.quit)
 	s.quitMtx.Unlock()
 
-	// Wait for all remaining goroutines to exit.
-	s.wg.Wait()
+	// Wait for all remaining goroutines to exit, cancelling the requests
+	// still being handled if ctx is done first.
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	var err error
+	select {
+	case <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+		s.cancel()
+		<-done
+	}
+	s.cancel()
+	return err
 }
 
+// requestDuration observes the time taken to handle JSON-RPC requests.
+var requestDuration = metrics.NewHistogramVec("monetarium_wallet_rpc_request_duration_seconds",
+	"Time taken to handle JSON-RPC requests, by method.", "method", metrics.DefaultBuckets)
+
 // handlerClosure creates a closure function for handling requests of the given
 // method.  This may be a request that is handled directly by dcrwallet, or
 // a chain server request that is handled by passing the request down to dcrd.
@@ -248,8 +309,84 @@ func (s *Server) Stop() {
 // method.  Each of these must be checked beforehand (the method is already
 // known) and handled accordingly.
 func (s *Server) handlerClosure(ctx context.Context, request *dcrjson.Request) lazyHandler {
-	log.Debugf("RPC method %q invoked by %v", request.Method, remoteAddr(ctx))
-	return lazyApplyHandler(s, ctx, request)
+	// Records logged while handling the request, including those of the
+	// wallet and its database, are correlated by a request ID.
+	requestID := loggers.NewRequestID()
+	ctx = loggers.WithRequestID(ctx, requestID)
+	logCtx(ctx).Debugf("RPC method %q invoked by %v", request.Method, remoteAddr(ctx))
+
+	// The wallet and its database add to the trace of the request as it is
+	// handled.
+	trace := rpctrace.New(request.Method, requestID)
+	ctx = rpctrace.WithTrace(ctx, trace)
+	f := lazyApplyHandler(s, ctx, request)
+
+	// Requests passed through to dcrd are not partitioned by method, so
+	// clients can not create unbounded numbers of metrics.
+	method := request.Method
+	if _, ok := handlers[method]; !ok {
+		method = "passthrough"
+	}
+	return func() (any, *dcrjson.RPCError) {
+		trace.Start = time.Now()
+		res, jsonErr := f()
+		requestDuration.ObserveDuration(method, trace.Start)
+		s.finishTrace(ctx, trace, jsonErr)
+		return res, jsonErr
+	}
+}
+
+// finishTrace records the handled request and logs it when slow.
+func (s *Server) finishTrace(ctx context.Context, trace *rpctrace.Trace, jsonErr *dcrjson.RPCError) {
+	if s.cfg.Traces == nil {
+		return
+	}
+	var errMsg string
+	if jsonErr != nil {
+		errMsg = jsonErr.Message
+	}
+	rec := s.cfg.Traces.Finish(trace, errMsg)
+	if rec.Slow {
+		logCtx(ctx).Warnf("Slow RPC method %q took %v (waited %v on wallet "+
+			"locks, %d database views, %d database updates)", rec.Method,
+			rec.Duration, rec.LockWait, rec.DBViews, rec.DBUpdates)
+	}
+}
+
+// walletSelector decodes the optional "wallet" member of a JSON-RPC request
+// object, which names the wallet handling the request.
+type walletSelector struct {
+	Wallet string `json:"wallet"`
+}
+
+// selectWallet returns a context recording the named wallet selected by a
+// request.  Requests which do not select a wallet are handled by the default
+// wallet.  Requests selecting a named wallet which is not loaded fail as if
+// the default wallet were not loaded, except those which create or restore
+// the wallet.
+func (s *Server) selectWallet(ctx context.Context, reqBytes []byte) (context.Context, *dcrjson.RPCError) {
+	var sel walletSelector
+	if err := json.Unmarshal(reqBytes, &sel); err != nil {
+		return ctx, rpcErrorf(dcrjson.ErrRPCInvalidRequest.Code,
+			"wallet selector must be a string")
+	}
+	if sel.Wallet == "" {
+		return ctx, nil
+	}
+	if _, err := s.walletLoader.NamedLoader(sel.Wallet); err != nil {
+		return ctx, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return withWalletName(ctx, sel.Wallet), nil
+}
+
+// loader returns the loader of the wallet selected by a request.
+func (s *Server) loader(ctx context.Context) *loader.Loader {
+	if name := walletName(ctx); name != "" {
+		if l, ok := s.walletLoader.Named(name); ok {
+			return l
+		}
+	}
+	return s.walletLoader
 }
 
 // errNoAuth represents an error where authentication could not succeed
@@ -257,24 +394,43 @@ func (s *Server) handlerClosure(ctx context.Context, request *dcrjson.Request) l
 var errNoAuth = errors.E("missing Authorization header")
 
 // checkAuthHeader checks any HTTP Basic authentication supplied by a client
-// in the HTTP request r.
+// in the HTTP request r, and returns the RPC scopes of the client.  Clients
+// authenticating with the configured username and password, or with a client
+// certificate when basic authentication is disabled, hold the admin scope.
+// Other clients authenticate with the scoped RPC credentials recorded by the
+// default wallet.
 //
 // The authentication comparison is time constant.
-func (s *Server) checkAuthHeader(r *http.Request) error {
+func (s *Server) checkAuthHeader(r *http.Request) (udb.RPCScopes, error) {
 	if s.authsha == nil {
-		return nil
+		return udb.RPCScopeAdmin, nil
 	}
 	authhdr := r.Header["Authorization"]
 	if len(authhdr) == 0 {
-		return errNoAuth
+		return 0, errNoAuth
 	}
 
 	authsha := sha256.Sum256([]byte(authhdr[0]))
 	cmp := subtle.ConstantTimeCompare(authsha[:], s.authsha[:])
-	if cmp != 1 {
-		return errors.New("invalid Authorization header")
+	if cmp == 1 {
+		return udb.RPCScopeAdmin, nil
 	}
-	return nil
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return 0, errors.New("invalid Authorization header")
+	}
+	return s.scopedAuth(r.Context(), username, password)
+}
+
+// scopedAuth returns the RPC scopes of a scoped RPC credential recorded by
+// the default wallet.  Scoped credentials may not be used before the default
+// wallet is loaded.
+func (s *Server) scopedAuth(ctx context.Context, username, password string) (udb.RPCScopes, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return 0, errors.New("invalid RPC credentials")
+	}
+	return w.AuthenticateRPC(ctx, username, password)
 }
 
 // throttledFn wraps an http.HandlerFunc with throttling of concurrent active
@@ -313,27 +469,32 @@ func idPointer(id any) (p *any) {
 	return
 }
 
-// invalidAuth checks whether a websocket request is a valid (parsable)
+// authenticate checks whether a websocket request is a valid (parsable)
 // authenticate request and checks the supplied username and passphrase
-// against the server auth.
-func (s *Server) invalidAuth(req *dcrjson.Request) bool {
+// against the server auth and the scoped RPC credentials.  The RPC scopes of
+// the client are returned if the credentials are valid.
+func (s *Server) authenticate(ctx context.Context, req *dcrjson.Request) (udb.RPCScopes, bool) {
 	cmd, err := dcrjson.ParseParams(types.Method(req.Method), req.Params)
 	if err != nil {
-		return false
+		return 0, false
 	}
 	authCmd, ok := cmd.(*dcrdtypes.AuthenticateCmd)
 	if !ok {
-		return false
+		return 0, false
 	}
 	// Authenticate commands are invalid when no basic auth is used
 	if s.authsha == nil {
-		return true
+		return 0, false
 	}
 	// Check credentials.
 	login := authCmd.Username + ":" + authCmd.Passphrase
 	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
 	authSha := sha256.Sum256([]byte(auth))
-	return subtle.ConstantTimeCompare(authSha[:], s.authsha[:]) != 1
+	if subtle.ConstantTimeCompare(authSha[:], s.authsha[:]) == 1 {
+		return udb.RPCScopeAdmin, true
+	}
+	scopes, err := s.scopedAuth(ctx, authCmd.Username, authCmd.Passphrase)
+	return scopes, err == nil
 }
 
 func (s *Server) websocketClientRead(ctx context.Context, wsc *websocketClient) {
@@ -341,7 +502,7 @@ func (s *Server) websocketClientRead(ctx context.Context, wsc *websocketClient)
 		_, request, err := wsc.conn.ReadMessage()
 		if err != nil {
 			if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
-				log.Warnf("Websocket receive failed from client %s: %v",
+				logCtx(ctx).Warnf("Websocket receive failed from client %s: %v",
 					remoteAddr(ctx), err)
 			}
 			close(wsc.allRequests)
@@ -370,7 +531,7 @@ out:
 			var req dcrjson.Request
 			err := json.Unmarshal(reqBytes, &req)
 			if err != nil {
-				log.Warnf("Failed unmarshal of JSON-RPC request object "+
+				logCtx(ctx).Warnf("Failed unmarshal of JSON-RPC request object "+
 					"from client %s", remoteAddr(ctx))
 				if !wsc.authenticated {
 					// Disconnect immediately.
@@ -393,19 +554,21 @@ out:
 			}
 
 			if req.Method == "authenticate" {
-				log.Debugf("RPC method authenticate invoked by %s",
+				logCtx(ctx).Debugf("RPC method authenticate invoked by %s",
 					remoteAddr(ctx))
-				switch {
-				case wsc.authenticated:
-					log.Warnf("Multiple authentication attempts from %s",
+				if wsc.authenticated {
+					logCtx(ctx).Warnf("Multiple authentication attempts from %s",
 						remoteAddr(ctx))
 					break out
-				case s.invalidAuth(&req):
-					log.Warnf("Failed authentication attempt from %s",
+				}
+				scopes, ok := s.authenticate(ctx, &req)
+				if !ok {
+					logCtx(ctx).Warnf("Failed authentication attempt from %s",
 						remoteAddr(ctx))
 					break out
 				}
 				wsc.authenticated = true
+				wsc.scopes = scopes
 				resp := makeResponse(req.ID, nil, nil)
 				// Expected to never fail.
 				mresp, err := json.Marshal(resp)
@@ -424,9 +587,29 @@ out:
 				break out
 			}
 
+			ctx, jsonErr := s.selectWallet(ctx, reqBytes)
+			if jsonErr == nil {
+				jsonErr = authorize(wsc.scopes, req.Method)
+			}
+			if jsonErr == nil && s.cfg.ReadOnly {
+				jsonErr = authorizeReadOnly(req.Method)
+			}
+			if jsonErr != nil {
+				mresp, err := dcrjson.MarshalResponse(req.Jsonrpc, req.ID, nil, jsonErr)
+				// Expected to never fail.
+				if err != nil {
+					panic(err)
+				}
+				err = wsc.send(mresp)
+				if err != nil {
+					break out
+				}
+				continue
+			}
+
 			switch req.Method {
 			case "stop":
-				log.Debugf("RPC method stop invoked by %s", remoteAddr(ctx))
+				logCtx(ctx).Debugf("RPC method stop invoked by %s", remoteAddr(ctx))
 				resp := makeResponse(req.ID,
 					"dcrwallet stopping.", nil)
 				mresp, err := json.Marshal(resp)
@@ -441,6 +624,81 @@ out:
 				s.requestProcessShutdown()
 				break out
 
+			case "notifycointypebalance":
+				var jsonErr *dcrjson.RPCError
+				if err := s.notifyCoinTypeBalance(ctx, wsc, &req); err != nil {
+					jsonErr = convertError(err)
+				}
+				mresp, err := dcrjson.MarshalResponse(req.Jsonrpc, req.ID, nil, jsonErr)
+				// Expected to never fail.
+				if err != nil {
+					panic(err)
+				}
+				err = wsc.send(mresp)
+				if err != nil {
+					break out
+				}
+
+			case "notifytxconflicts":
+				var jsonErr *dcrjson.RPCError
+				if err := s.notifyTxConflicts(ctx, wsc); err != nil {
+					jsonErr = convertError(err)
+				}
+				mresp, err := dcrjson.MarshalResponse(req.Jsonrpc, req.ID, nil, jsonErr)
+				// Expected to never fail.
+				if err != nil {
+					panic(err)
+				}
+				err = wsc.send(mresp)
+				if err != nil {
+					break out
+				}
+
+			case "notifyinvoices":
+				var jsonErr *dcrjson.RPCError
+				if err := s.notifyInvoices(ctx, wsc); err != nil {
+					jsonErr = convertError(err)
+				}
+				mresp, err := dcrjson.MarshalResponse(req.Jsonrpc, req.ID, nil, jsonErr)
+				// Expected to never fail.
+				if err != nil {
+					panic(err)
+				}
+				err = wsc.send(mresp)
+				if err != nil {
+					break out
+				}
+
+			case "notifyrecurringpayments":
+				var jsonErr *dcrjson.RPCError
+				if err := s.notifyRecurringPayments(ctx, wsc); err != nil {
+					jsonErr = convertError(err)
+				}
+				mresp, err := dcrjson.MarshalResponse(req.Jsonrpc, req.ID, nil, jsonErr)
+				// Expected to never fail.
+				if err != nil {
+					panic(err)
+				}
+				err = wsc.send(mresp)
+				if err != nil {
+					break out
+				}
+
+			case "notifytxconfirmations", "notifyaccountconfirmations":
+				var jsonErr *dcrjson.RPCError
+				if err := s.notifyConfirmations(ctx, wsc, &req); err != nil {
+					jsonErr = convertError(err)
+				}
+				mresp, err := dcrjson.MarshalResponse(req.Jsonrpc, req.ID, nil, jsonErr)
+				// Expected to never fail.
+				if err != nil {
+					panic(err)
+				}
+				err = wsc.send(mresp)
+				if err != nil {
+					break out
+				}
+
 			default:
 				req := req // Copy for the closure
 				ctx, task := trace.NewTask(ctx, req.Method)
@@ -452,7 +710,7 @@ out:
 					resp, jsonErr := f()
 					mresp, err := dcrjson.MarshalResponse(req.Jsonrpc, req.ID, resp, jsonErr)
 					if err != nil {
-						log.Errorf("Unable to marshal response to client %s: %v",
+						logCtx(ctx).Errorf("Unable to marshal response to client %s: %v",
 							remoteAddr(ctx), err)
 					} else {
 						_ = wsc.send(mresp)
@@ -484,13 +742,13 @@ out:
 			}
 			err := wsc.conn.SetWriteDeadline(time.Now().Add(deadline))
 			if err != nil {
-				log.Warnf("Cannot set write deadline on "+
+				logCtx(ctx).Warnf("Cannot set write deadline on "+
 					"client %s: %v", remoteAddr(ctx), err)
 			}
 			err = wsc.conn.WriteMessage(websocket.TextMessage,
 				response)
 			if err != nil {
-				log.Warnf("Failed websocket send to client "+
+				logCtx(ctx).Warnf("Failed websocket send to client "+
 					"%s: %v", remoteAddr(ctx), err)
 				break out
 			}
@@ -500,18 +758,25 @@ out:
 		}
 	}
 	close(wsc.quit)
-	log.Infof("Disconnected websocket client %s", remoteAddr(ctx))
+	logCtx(ctx).Infof("Disconnected websocket client %s", remoteAddr(ctx))
 }
 
 // websocketClientRPC starts the goroutines to serve JSON-RPC requests over a
 // websocket connection for a single client.
 func (s *Server) websocketClientRPC(ctx context.Context, wsc *websocketClient) {
-	log.Infof("New websocket client %s", remoteAddr(ctx))
+	logCtx(ctx).Infof("New websocket client %s", remoteAddr(ctx))
 
 	// Clear the read deadline set before the websocket hijacked
 	// the connection.
 	if err := wsc.conn.SetReadDeadline(time.Time{}); err != nil {
-		log.Warnf("Cannot remove read deadline: %v", err)
+		logCtx(ctx).Warnf("Cannot remove read deadline: %v", err)
+	}
+
+	// Clients connecting while the server is stopping are disconnected.
+	if !s.track(2) {
+		wsc.cancel()
+		wsc.conn.Close()
+		return
 	}
 
 	// WebsocketClientRead is intentionally not run with the waitgroup
@@ -520,19 +785,317 @@ func (s *Server) websocketClientRPC(ctx context.Context, wsc *websocketClient) {
 	// websocket connection if the client is still connected.
 	go s.websocketClientRead(ctx, wsc)
 
-	s.wg.Add(2)
 	go s.websocketClientRespond(ctx, wsc)
 	go s.websocketClientSend(ctx, wsc)
 
 	<-wsc.quit
 }
 
+// notifyCoinTypeBalance registers a websocket client for cointypebalance
+// notifications, which are sent until the client disconnects.
+func (s *Server) notifyCoinTypeBalance(ctx context.Context, wsc *websocketClient, req *dcrjson.Request) error {
+	params, err := dcrjson.ParseParams(types.Method(req.Method), req.Params)
+	if err != nil {
+		return dcrjson.ErrRPCInvalidRequest
+	}
+	cmd := params.(*types.NotifyCoinTypeBalanceCmd)
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return errUnloadedWallet
+	}
+	coinType := cointype.CoinType(cmd.CoinType)
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return rpcErrorf(dcrjson.ErrRPCInvalidParameter, "minconf must be non-negative")
+	}
+	n, err := w.NtfnServer.CoinTypeBalanceNotifications(ctx, coinType, minConf)
+	if err != nil {
+		return err
+	}
+	atomsPerCoin := getAtomsPerCoin(w.ChainParams(), coinType)
+
+	go func() {
+		defer n.Done()
+		for {
+			var v *wallet.CoinTypeBalanceNotification
+			var ok bool
+			select {
+			case v, ok = <-n.C:
+			case <-wsc.quit:
+				return
+			}
+			if !ok {
+				// The client was disconnected for exceeding the
+				// notification backlog limit.
+				logCtx(ctx).Warnf("Disconnecting websocket client %s: "+
+					"notification backlog limit exceeded", remoteAddr(ctx))
+				wsc.conn.Close()
+				return
+			}
+			balances := make([]types.CoinTypeSpendableBalance, 0, len(v.Balances))
+			for _, b := range v.Balances {
+				name, err := w.AccountName(ctx, b.Account)
+				if err != nil {
+					logCtx(ctx).Errorf("Cannot look up account %d name: %v",
+						b.Account, err)
+				}
+				var spendable any
+				if coinType.IsSKA() {
+					spendable = atomsToCoinsBig(b.Spendable, atomsPerCoin)
+				} else {
+					spendable = atomsToCoins(b.Spendable.Int64(), atomsPerCoin)
+				}
+				balances = append(balances, types.CoinTypeSpendableBalance{
+					Account:     b.Account,
+					AccountName: name,
+					Spendable:   spendable,
+				})
+			}
+			ntfn := types.NewCoinTypeBalanceNtfn(uint8(v.CoinType), balances)
+			mntfn, err := dcrjson.MarshalCmd("1.0", nil, ntfn)
+			if err != nil {
+				logCtx(ctx).Errorf("Cannot marshal cointypebalance notification: %v", err)
+				continue
+			}
+			if wsc.send(mntfn) != nil {
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// notifyTxConflicts registers a websocket client for txconflict
+// notifications, which are sent until the client disconnects.
+func (s *Server) notifyTxConflicts(ctx context.Context, wsc *websocketClient) error {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return errUnloadedWallet
+	}
+	n := w.NtfnServer.TxConflictNotifications()
+
+	go func() {
+		defer n.Done()
+		for {
+			var v *wallet.TxConflictNotification
+			var ok bool
+			select {
+			case v, ok = <-n.C:
+			case <-wsc.quit:
+				return
+			}
+			if !ok {
+				// The client was disconnected for exceeding the
+				// notification backlog limit.
+				logCtx(ctx).Warnf("Disconnecting websocket client %s: "+
+					"notification backlog limit exceeded", remoteAddr(ctx))
+				wsc.conn.Close()
+				return
+			}
+			conflicts := make([]string, len(v.Conflicts))
+			for i := range v.Conflicts {
+				conflicts[i] = v.Conflicts[i].String()
+			}
+			ntfn := types.NewTxConflictNtfn(v.TxHash.String(), conflicts, v.Mined)
+			mntfn, err := dcrjson.MarshalCmd("1.0", nil, ntfn)
+			if err != nil {
+				logCtx(ctx).Errorf("Cannot marshal txconflict notification: %v", err)
+				continue
+			}
+			if wsc.send(mntfn) != nil {
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// notifyInvoices registers a websocket client for invoice notifications,
+// which are sent until the client disconnects.
+func (s *Server) notifyInvoices(ctx context.Context, wsc *websocketClient) error {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return errUnloadedWallet
+	}
+	n := w.NtfnServer.InvoiceNotifications()
+
+	go func() {
+		defer n.Done()
+		for {
+			var v *wallet.InvoiceNotification
+			var ok bool
+			select {
+			case v, ok = <-n.C:
+			case <-wsc.quit:
+				return
+			}
+			if !ok {
+				// The client was disconnected for exceeding the
+				// notification backlog limit.
+				logCtx(ctx).Warnf("Disconnecting websocket client %s: "+
+					"notification backlog limit exceeded", remoteAddr(ctx))
+				wsc.conn.Close()
+				return
+			}
+			ntfn := types.NewInvoiceNtfn(invoiceResult(v.Invoice, w.ChainParams()))
+			mntfn, err := dcrjson.MarshalCmd("1.0", nil, ntfn)
+			if err != nil {
+				logCtx(ctx).Errorf("Cannot marshal invoice notification: %v", err)
+				continue
+			}
+			if wsc.send(mntfn) != nil {
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// notifyRecurringPayments registers a websocket client for recurringpayment
+// notifications, which are sent until the client disconnects.
+func (s *Server) notifyRecurringPayments(ctx context.Context, wsc *websocketClient) error {
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return errUnloadedWallet
+	}
+	n := w.NtfnServer.RecurringPaymentNotifications()
+
+	go func() {
+		defer n.Done()
+		for {
+			var v *wallet.RecurringPaymentNotification
+			var ok bool
+			select {
+			case v, ok = <-n.C:
+			case <-wsc.quit:
+				return
+			}
+			if !ok {
+				// The client was disconnected for exceeding the
+				// notification backlog limit.
+				logCtx(ctx).Warnf("Disconnecting websocket client %s: "+
+					"notification backlog limit exceeded", remoteAddr(ctx))
+				wsc.conn.Close()
+				return
+			}
+			name, err := w.AccountName(ctx, v.Payment.Send.Account)
+			if err != nil {
+				logCtx(ctx).Errorf("Cannot look up account %d name: %v",
+					v.Payment.Send.Account, err)
+			}
+			ntfn := types.NewRecurringPaymentNtfn(recurringPaymentResult(
+				w.ChainParams(), v.Payment, name))
+			mntfn, err := dcrjson.MarshalCmd("1.0", nil, ntfn)
+			if err != nil {
+				logCtx(ctx).Errorf("Cannot marshal recurringpayment notification: %v", err)
+				continue
+			}
+			if wsc.send(mntfn) != nil {
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// notifyConfirmations registers a watch of a transaction or account for
+// confirmationthreshold notifications.  A transaction watch sends a single
+// notification, while an account watch sends notifications until the client
+// disconnects.
+func (s *Server) notifyConfirmations(ctx context.Context, wsc *websocketClient, req *dcrjson.Request) error {
+	params, err := dcrjson.ParseParams(types.Method(req.Method), req.Params)
+	if err != nil {
+		return dcrjson.ErrRPCInvalidRequest
+	}
+	w, ok := s.loader(ctx).LoadedWallet()
+	if !ok {
+		return errUnloadedWallet
+	}
+
+	n := w.NtfnServer.ConfirmationThresholdNotifications()
+	var accountName string
+	var txWatch bool
+	switch cmd := params.(type) {
+	case *types.NotifyTxConfirmationsCmd:
+		txHash, err := chainhash.NewHashFromStr(cmd.TxHash)
+		if err != nil {
+			n.Done()
+			return rpcError(dcrjson.ErrRPCDecodeHexString, err)
+		}
+		_, err = n.WatchTransaction(ctx, txHash, cmd.Confirmations)
+		if err != nil {
+			n.Done()
+			if errors.Is(err, errors.NotExist) {
+				return rpcError(dcrjson.ErrRPCNoTxInfo, err)
+			}
+			return rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		txWatch = true
+	case *types.NotifyAccountConfirmationsCmd:
+		account, err := w.AccountNumber(ctx, cmd.Account)
+		if err != nil {
+			n.Done()
+			if errors.Is(err, errors.NotExist) {
+				return errAccountNotFound
+			}
+			return err
+		}
+		_, err = n.WatchAccount(ctx, account, cmd.Confirmations)
+		if err != nil {
+			n.Done()
+			return rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		accountName = cmd.Account
+	}
+
+	go func() {
+		defer n.Done()
+		for {
+			var v *wallet.ConfirmationThresholdNotification
+			var ok bool
+			select {
+			case v, ok = <-n.C:
+			case <-wsc.quit:
+				return
+			}
+			if !ok {
+				// The client was disconnected for exceeding the
+				// notification backlog limit.
+				logCtx(ctx).Warnf("Disconnecting websocket client %s: "+
+					"notification backlog limit exceeded", remoteAddr(ctx))
+				wsc.conn.Close()
+				return
+			}
+			var blockHash string
+			if v.BlockHash != nil {
+				blockHash = v.BlockHash.String()
+			}
+			ntfn := types.NewConfirmationThresholdNtfn(v.TxHash.String(),
+				accountName, v.Confirmations, blockHash, v.BlockHeight)
+			mntfn, err := dcrjson.MarshalCmd("1.0", nil, ntfn)
+			if err != nil {
+				logCtx(ctx).Errorf("Cannot marshal confirmationthreshold notification: %v", err)
+				continue
+			}
+			if wsc.send(mntfn) != nil {
+				return
+			}
+			if txWatch {
+				// The transaction watch is complete.
+				return
+			}
+		}
+	}()
+	return nil
+}
+
 // maxRequestSize specifies the maximum number of bytes in the request body
 // that may be read from a client.  This is currently limited to 4MB.
 const maxRequestSize = 1024 * 1024 * 4
 
-// postClientRPC processes and replies to a JSON-RPC client request.
-func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
+// postClientRPC processes and replies to a JSON-RPC client request from a
+// client authenticated with a set of RPC scopes.
+func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request, scopes udb.RPCScopes) {
 	ctx := withRemoteAddr(r.Context(), r.RemoteAddr)
 
 	body := http.MaxBytesReader(w, r.Body, maxRequestSize)
@@ -574,15 +1137,22 @@ func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
 	// Create the response and error from the request.  Two special cases
 	// are handled for the authenticate and stop request methods.
 	var res any
-	var jsonErr *dcrjson.RPCError
 	var stop bool
-	switch req.Method {
-	case "authenticate":
+	ctx, jsonErr := s.selectWallet(ctx, rpcRequest)
+	if jsonErr == nil && req.Method != "authenticate" {
+		jsonErr = authorize(scopes, req.Method)
+	}
+	if jsonErr == nil && req.Method != "authenticate" && s.cfg.ReadOnly {
+		jsonErr = authorizeReadOnly(req.Method)
+	}
+	switch {
+	case req.Method == "authenticate":
 		log.Warnf("Invalid RPC method authenticate invoked by HTTP POST client %s",
 			r.RemoteAddr)
 		// Drop it.
 		return
-	case "stop":
+	case jsonErr != nil:
+	case req.Method == "stop":
 		log.Debugf("RPC method stop invoked by %s", r.RemoteAddr)
 		stop = true
 		res = "dcrwallet stopping"
@@ -0,0 +1,113 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcserver
+
+import (
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+)
+
+// methodScopes maps the full names of gRPC methods to the RPC scope a client
+// must hold to invoke them.  Methods which are not listed require the admin
+// scope.
+var methodScopes = map[string]udb.RPCScopes{
+	// Methods which only report wallet and network state.
+	"/walletrpc.VersionService/Version":                           udb.RPCScopeRead,
+	"/walletrpc.WalletService/Ping":                               udb.RPCScopeRead,
+	"/walletrpc.WalletService/Network":                            udb.RPCScopeRead,
+	"/walletrpc.WalletService/CoinType":                           udb.RPCScopeRead,
+	"/walletrpc.WalletService/AccountNumber":                      udb.RPCScopeRead,
+	"/walletrpc.WalletService/Accounts":                           udb.RPCScopeRead,
+	"/walletrpc.WalletService/Address":                            udb.RPCScopeRead,
+	"/walletrpc.WalletService/Balance":                            udb.RPCScopeRead,
+	"/walletrpc.WalletService/GetAccountExtendedPubKey":           udb.RPCScopeRead,
+	"/walletrpc.WalletService/GetTransaction":                     udb.RPCScopeRead,
+	"/walletrpc.WalletService/GetTransactions":                    udb.RPCScopeRead,
+	"/walletrpc.WalletService/GetTicket":                          udb.RPCScopeRead,
+	"/walletrpc.WalletService/GetTickets":                         udb.RPCScopeRead,
+	"/walletrpc.WalletService/TicketPrice":                        udb.RPCScopeRead,
+	"/walletrpc.WalletService/StakeInfo":                          udb.RPCScopeRead,
+	"/walletrpc.WalletService/BlockInfo":                          udb.RPCScopeRead,
+	"/walletrpc.WalletService/BestBlock":                          udb.RPCScopeRead,
+	"/walletrpc.WalletService/Spender":                            udb.RPCScopeRead,
+	"/walletrpc.WalletService/GetCFilters":                        udb.RPCScopeRead,
+	"/walletrpc.WalletService/GetPeerInfo":                        udb.RPCScopeRead,
+	"/walletrpc.WalletService/BirthBlock":                         udb.RPCScopeRead,
+	"/walletrpc.WalletService/TransactionNotifications":           udb.RPCScopeRead,
+	"/walletrpc.WalletService/AccountNotifications":               udb.RPCScopeRead,
+	"/walletrpc.WalletService/ConfirmationNotifications":          udb.RPCScopeRead,
+	"/walletrpc.WalletService/CoinTypeBalanceNotifications":       udb.RPCScopeRead,
+	"/walletrpc.WalletService/ConfirmationThresholdNotifications": udb.RPCScopeRead,
+	"/walletrpc.WalletService/StakeEvents":                        udb.RPCScopeRead,
+	"/walletrpc.WalletService/UnspentOutputs":                     udb.RPCScopeRead,
+	"/walletrpc.WalletService/ValidateAddress":                    udb.RPCScopeRead,
+	"/walletrpc.WalletService/CommittedTickets":                   udb.RPCScopeRead,
+	"/walletrpc.WalletService/GetCoinjoinOutputspByAcct":          udb.RPCScopeRead,
+	"/walletrpc.WalletService/AccountUnlocked":                    udb.RPCScopeRead,
+	"/walletrpc.WalletService/GetVSPTicketsByFeeStatus":           udb.RPCScopeRead,
+	"/walletrpc.WalletService/GetTrackedVSPTickets":               udb.RPCScopeRead,
+	"/walletrpc.WalletLoaderService/WalletExists":                 udb.RPCScopeRead,
+	"/walletrpc.AgendaService/Agendas":                            udb.RPCScopeRead,
+	"/walletrpc.VotingService/VoteChoices":                        udb.RPCScopeRead,
+	"/walletrpc.VotingService/TSpendPolicies":                     udb.RPCScopeRead,
+	"/walletrpc.VotingService/TreasuryPolicies":                   udb.RPCScopeRead,
+	"/walletrpc.MessageVerificationService/VerifyMessage":         udb.RPCScopeRead,
+	"/walletrpc.NetworkService/GetRawBlock":                       udb.RPCScopeRead,
+	"/walletrpc.DecodeMessageService/DecodeRawTransaction":        udb.RPCScopeRead,
+
+	// Methods which derive receiving addresses and create, sign, and
+	// publish transactions.
+	"/walletrpc.WalletService/NextAddress":                udb.RPCScopeSend,
+	"/walletrpc.WalletService/FundTransaction":            udb.RPCScopeSend,
+	"/walletrpc.WalletService/ConstructTransaction":       udb.RPCScopeSend,
+	"/walletrpc.WalletService/SignTransaction":            udb.RPCScopeSend,
+	"/walletrpc.WalletService/SignTransactions":           udb.RPCScopeSend,
+	"/walletrpc.WalletService/CreateSignature":            udb.RPCScopeSend,
+	"/walletrpc.WalletService/PublishTransaction":         udb.RPCScopeSend,
+	"/walletrpc.WalletService/PublishUnminedTransactions": udb.RPCScopeSend,
+	"/walletrpc.WalletService/SweepAccount":               udb.RPCScopeSend,
+	"/walletrpc.WalletService/AbandonTransaction":         udb.RPCScopeSend,
+
+	// Methods which purchase tickets and manage voting preferences.
+	"/walletrpc.WalletService/PurchaseTickets":         udb.RPCScopeStaking,
+	"/walletrpc.WalletService/SyncVSPFailedTickets":    udb.RPCScopeStaking,
+	"/walletrpc.WalletService/ProcessManagedTickets":   udb.RPCScopeStaking,
+	"/walletrpc.WalletService/ProcessUnmanagedTickets": udb.RPCScopeStaking,
+	"/walletrpc.WalletService/SetVspdVoteChoices":      udb.RPCScopeStaking,
+	"/walletrpc.TicketBuyerService/RunTicketBuyer":     udb.RPCScopeStaking,
+	"/walletrpc.VotingService/SetVoteChoices":          udb.RPCScopeStaking,
+	"/walletrpc.VotingService/SetTSpendPolicy":         udb.RPCScopeStaking,
+	"/walletrpc.VotingService/SetTreasuryPolicy":       udb.RPCScopeStaking,
+}
+
+// RequiredScope returns the RPC scope a client must hold to invoke a gRPC
+// method, named by its full /package.service/method name.
+func RequiredScope(fullMethod string) udb.RPCScopes {
+	if scope, ok := methodScopes[fullMethod]; ok {
+		return scope
+	}
+	return udb.RPCScopeAdmin
+}
+
+// readOnlyMethods lists the gRPC methods requiring the admin scope which do
+// not modify the wallet, and which remain enabled when the wallet is opened
+// read-only.
+var readOnlyMethods = map[string]struct{}{
+	"/walletrpc.WalletLoaderService/OpenWallet":  {},
+	"/walletrpc.WalletLoaderService/CloseWallet": {},
+	"/walletrpc.WalletService/SignMessage":       {},
+	"/walletrpc.WalletService/SignMessages":      {},
+}
+
+// ReadOnlyAllowed returns whether a gRPC method, named by its full
+// /package.service/method name, may be invoked when the wallet is opened
+// read-only.  Methods requiring the read scope, and admin methods which do
+// not modify the wallet, are allowed.
+func ReadOnlyAllowed(fullMethod string) bool {
+	if RequiredScope(fullMethod) == udb.RPCScopeRead {
+		return true
+	}
+	_, ok := readOnlyMethods[fullMethod]
+	return ok
+}
@@ -21,6 +21,8 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"io"
+	"math"
 	"net"
 	"sort"
 	"sync/atomic"
@@ -62,9 +64,9 @@ import (
 
 // Public API version constants
 const (
-	semverString = "9.0.0"
+	semverString = "9.2.0"
 	semverMajor  = 9
-	semverMinor  = 0
+	semverMinor  = 2
 	semverPatch  = 0
 )
 
@@ -645,13 +647,6 @@ func (s *walletServer) ImportPrivateKey(ctx context.Context, req *pb.ImportPriva
 		}
 	}
 
-	// At the moment, only the special-cased import account can be used to
-	// import keys.
-	if req.Account != udb.ImportedAddrAccount {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"Only the imported account accepts private key imports")
-	}
-
 	if req.ScanFrom < 0 {
 		return nil, status.Errorf(codes.InvalidArgument,
 			"Attempted to scan from a negative block height")
@@ -667,7 +662,7 @@ func (s *walletServer) ImportPrivateKey(ctx context.Context, req *pb.ImportPriva
 		return nil, err
 	}
 
-	_, err = s.wallet.ImportPrivateKey(ctx, wif)
+	_, err = s.wallet.ImportPrivateKey(ctx, wif, req.Account)
 	if err != nil {
 		return nil, translateError(err)
 	}
@@ -743,7 +738,7 @@ func (s *walletServer) ImportScript(ctx context.Context,
 		return nil, err
 	}
 
-	err = s.wallet.ImportScript(ctx, req.Script)
+	err = s.wallet.ImportScript(ctx, req.Script, udb.ImportedAddrAccount)
 	if err != nil && !errors.Is(err, errors.Exist) {
 		return nil, translateError(err)
 	}
@@ -1178,12 +1173,13 @@ func (s *walletServer) ConstructTransaction(ctx context.Context, req *pb.Constru
 
 	chainParams := s.wallet.ChainParams()
 
-	if len(req.NonChangeOutputs) == 0 && req.ChangeDestination == nil {
+	if len(req.NonChangeOutputs) == 0 && req.ChangeDestination == nil &&
+		len(req.NullData) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument,
-			"non_change_outputs and change_destination may not both be empty or null")
+			"non_change_outputs, change_destination and null_data may not all be empty or null")
 	}
 
-	outputs := make([]*wire.TxOut, 0, len(req.NonChangeOutputs))
+	outputs := make([]*wire.TxOut, 0, len(req.NonChangeOutputs)+1)
 	for _, o := range req.NonChangeOutputs {
 		script, version, err := decodeDestination(o.Destination, chainParams)
 		if err != nil {
@@ -1196,6 +1192,13 @@ func (s *walletServer) ConstructTransaction(ctx context.Context, req *pb.Constru
 		}
 		outputs = append(outputs, output)
 	}
+	if len(req.NullData) != 0 {
+		output, err := wallet.NullDataOutput(req.NullData, cointype.CoinTypeVAR)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "null_data: %v", err)
+		}
+		outputs = append(outputs, output)
+	}
 
 	var algo wallet.OutputSelectionAlgorithm
 	switch req.OutputSelectionAlgorithm {
@@ -1375,6 +1378,10 @@ func decodeBlockRange(req blockRangeReq) (*wallet.BlockIdentifier, *wallet.Block
 func (s *walletServer) GetTransactions(req *pb.GetTransactionsRequest,
 	server pb.WalletService_GetTransactionsServer) error {
 
+	if req.Paginate {
+		return s.getTransactionsPage(req, server)
+	}
+
 	startBlock, endBlock, err := decodeBlockRange(req)
 	if err != nil {
 		return err
@@ -1434,6 +1441,64 @@ func (s *walletServer) GetTransactions(req *pb.GetTransactionsRequest,
 	return nil
 }
 
+// getTransactionsPage streams a single page of transactions, one response per
+// block, for a paginated GetTransactions request.  The block range may only be
+// specified by non-negative heights.
+func (s *walletServer) getTransactionsPage(req *pb.GetTransactionsRequest,
+	server pb.WalletService_GetTransactionsServer) error {
+
+	if req.StartingBlockHash != nil || req.EndingBlockHash != nil {
+		return status.Errorf(codes.InvalidArgument,
+			"block hashes may not be specified for paginated requests")
+	}
+	if req.StartingBlockHeight < 0 || req.EndingBlockHeight < 0 {
+		return status.Errorf(codes.InvalidArgument,
+			"block heights of paginated requests may not be negative")
+	}
+	if req.PageLimit <= 0 {
+		return status.Errorf(codes.InvalidArgument,
+			"page limit must be positive")
+	}
+	filter := new(wallet.TxListFilter)
+	if req.StartingBlockHeight != 0 {
+		filter.StartHeight = &req.StartingBlockHeight
+	}
+	if req.EndingBlockHeight != 0 {
+		filter.EndHeight = &req.EndingBlockHeight
+	}
+
+	ctx := server.Context()
+	page, err := s.wallet.GetTransactionsPage(ctx, req.PageCursor,
+		int(req.PageLimit), filter)
+	if err != nil {
+		return translateError(err)
+	}
+
+	resps := make([]*pb.GetTransactionsResponse, 0, len(page.Blocks)+1)
+	for i := range page.Blocks {
+		block := &page.Blocks[i]
+		if block.Header != nil {
+			resps = append(resps, &pb.GetTransactionsResponse{
+				MinedTransactions: marshalBlock(block),
+			})
+		} else {
+			resps = append(resps, &pb.GetTransactionsResponse{
+				UnminedTransactions: marshalTransactionDetailsSlice(block.Transactions),
+			})
+		}
+	}
+	if len(resps) == 0 {
+		resps = append(resps, new(pb.GetTransactionsResponse))
+	}
+	resps[len(resps)-1].NextPageCursor = page.NextCursor
+	for _, resp := range resps {
+		if err := server.Send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *walletServer) GetTicket(ctx context.Context, req *pb.GetTicketRequest) (*pb.GetTicketsResponse, error) {
 	ticketHash, err := chainhash.NewHash(req.TicketHash)
 	if err != nil {
@@ -1999,6 +2064,8 @@ func (s *walletServer) signMessage(ctx context.Context, address, message string)
 	switch addr.(type) {
 	case *stdaddr.AddressPubKeyEcdsaSecp256k1V0:
 	case *stdaddr.AddressPubKeyHashEcdsaSecp256k1V0:
+	case *stdaddr.AddressPubKeySchnorrSecp256k1V0:
+	case *stdaddr.AddressPubKeyHashSchnorrSecp256k1V0:
 	default:
 		return nil, status.Error(codes.InvalidArgument,
 			"address must be secp256k1 P2PK or P2PKH")
@@ -2391,7 +2458,11 @@ func (s *walletServer) TransactionNotifications(req *pb.TransactionNotifications
 	ctxDone := svr.Context().Done()
 	for {
 		select {
-		case v := <-n.C:
+		case v, ok := <-n.C:
+			if !ok {
+				return status.Error(codes.ResourceExhausted,
+					"notification backlog limit exceeded")
+			}
 			resp := pb.TransactionNotificationsResponse{
 				AttachedBlocks:           marshalBlocks(v.AttachedBlocks),
 				DetachedBlocks:           marshalHeaderHashes(v.DetachedBlocks),
@@ -2419,7 +2490,11 @@ func (s *walletServer) AccountNotifications(req *pb.AccountNotificationsRequest,
 	ctxDone := svr.Context().Done()
 	for {
 		select {
-		case v := <-n.C:
+		case v, ok := <-n.C:
+			if !ok {
+				return status.Error(codes.ResourceExhausted,
+					"notification backlog limit exceeded")
+			}
 			resp := pb.AccountNotificationsResponse{
 				AccountNumber:    v.AccountNumber,
 				AccountName:      v.AccountName,
@@ -2438,6 +2513,54 @@ func (s *walletServer) AccountNotifications(req *pb.AccountNotificationsRequest,
 	}
 }
 
+func (s *walletServer) CoinTypeBalanceNotifications(req *pb.CoinTypeBalanceNotificationsRequest,
+	svr pb.WalletService_CoinTypeBalanceNotificationsServer) error {
+
+	if req.CoinType > math.MaxUint8 {
+		return status.Errorf(codes.InvalidArgument, "coin type %d out of range", req.CoinType)
+	}
+	if req.RequiredConfirmations < 0 {
+		return status.Errorf(codes.InvalidArgument, "negative required confirmations")
+	}
+
+	n, err := s.wallet.NtfnServer.CoinTypeBalanceNotifications(svr.Context(),
+		cointype.CoinType(req.CoinType), req.RequiredConfirmations)
+	if err != nil {
+		return translateError(err)
+	}
+	defer n.Done()
+
+	ctxDone := svr.Context().Done()
+	for {
+		select {
+		case v, ok := <-n.C:
+			if !ok {
+				return status.Error(codes.ResourceExhausted,
+					"notification backlog limit exceeded")
+			}
+			balances := make([]*pb.CoinTypeBalanceNotificationsResponse_AccountSpendable,
+				0, len(v.Balances))
+			for _, b := range v.Balances {
+				balances = append(balances, &pb.CoinTypeBalanceNotificationsResponse_AccountSpendable{
+					AccountNumber: b.Account,
+					Spendable:     b.Spendable.String(),
+				})
+			}
+			resp := pb.CoinTypeBalanceNotificationsResponse{
+				CoinType: uint32(v.CoinType),
+				Balances: balances,
+			}
+			err := svr.Send(&resp)
+			if err != nil {
+				return translateError(err)
+			}
+
+		case <-ctxDone:
+			return nil
+		}
+	}
+}
+
 func (s *walletServer) ConfirmationNotifications(svr pb.WalletService_ConfirmationNotificationsServer) error {
 	c := s.wallet.NtfnServer.ConfirmationNotifications(svr.Context())
 	errOut := make(chan error, 2)
@@ -2505,6 +2628,125 @@ func (s *walletServer) ConfirmationNotifications(svr pb.WalletService_Confirmati
 	}
 }
 
+func (s *walletServer) ConfirmationThresholdNotifications(svr pb.WalletService_ConfirmationThresholdNotificationsServer) error {
+	n := s.wallet.NtfnServer.ConfirmationThresholdNotifications()
+	defer n.Done()
+
+	errOut := make(chan error, 1)
+	go func() {
+		for {
+			req, err := svr.Recv()
+			if errors.Is(err, io.EOF) {
+				// Watches remain active after the client stops
+				// sending requests.
+				return
+			}
+			if err != nil {
+				errOut <- err
+				return
+			}
+			if req.Confirmations < 1 {
+				errOut <- status.Errorf(codes.InvalidArgument, "confirmations must be positive")
+				return
+			}
+			if len(req.TxHash) != 0 {
+				txHash, err := chainhash.NewHash(req.TxHash)
+				if err != nil {
+					errOut <- status.Errorf(codes.InvalidArgument, "tx_hash: %v", err)
+					return
+				}
+				_, err = n.WatchTransaction(svr.Context(), txHash, req.Confirmations)
+				if err != nil {
+					errOut <- translateError(err)
+					return
+				}
+				continue
+			}
+			_, err = n.WatchAccount(svr.Context(), req.Account, req.Confirmations)
+			if err != nil {
+				errOut <- translateError(err)
+				return
+			}
+		}
+	}()
+
+	ctxDone := svr.Context().Done()
+	for {
+		select {
+		case v, ok := <-n.C:
+			if !ok {
+				return status.Error(codes.ResourceExhausted,
+					"notification backlog limit exceeded")
+			}
+			var blockHash []byte
+			if v.BlockHash != nil {
+				blockHash = v.BlockHash[:]
+			}
+			resp := pb.ConfirmationThresholdNotificationsResponse{
+				WatchId:       v.WatchID,
+				TxHash:        v.TxHash[:],
+				Account:       v.Account,
+				Confirmations: v.Confirmations,
+				BlockHash:     blockHash,
+				BlockHeight:   v.BlockHeight,
+			}
+			err := svr.Send(&resp)
+			if err != nil {
+				return translateError(err)
+			}
+
+		case err := <-errOut:
+			if _, ok := status.FromError(err); ok {
+				return err
+			}
+			return translateError(err)
+
+		case <-ctxDone:
+			return nil
+		}
+	}
+}
+
+func (s *walletServer) StakeEvents(req *pb.StakeEventsRequest, svr pb.WalletService_StakeEventsServer) error {
+	n := s.wallet.NtfnServer.StakeEventNotifications()
+	defer n.Done()
+
+	ctxDone := svr.Context().Done()
+	for {
+		select {
+		case v, ok := <-n.C:
+			if !ok {
+				return status.Error(codes.ResourceExhausted,
+					"notification backlog limit exceeded")
+			}
+			amounts := make([]*pb.StakeEventsResponse_CoinTypeAmount, 0, len(v.Amounts))
+			for ct, amount := range v.Amounts {
+				amounts = append(amounts, &pb.StakeEventsResponse_CoinTypeAmount{
+					CoinType: uint32(ct),
+					Amount:   amount.String(),
+				})
+			}
+			sort.Slice(amounts, func(i, j int) bool {
+				return amounts[i].CoinType < amounts[j].CoinType
+			})
+			resp := pb.StakeEventsResponse{
+				Type:        pb.StakeEventsResponse_EventType(v.Type),
+				TxHash:      v.TxHash[:],
+				BlockHeight: v.Height,
+				Expired:     v.Expired,
+				Amounts:     amounts,
+			}
+			err := svr.Send(&resp)
+			if err != nil {
+				return translateError(err)
+			}
+
+		case <-ctxDone:
+			return nil
+		}
+	}
+}
+
 // StartWalletLoaderService starts the WalletLoaderService.
 func StartWalletLoaderService(server *grpc.Server, loader *loader.Loader, activeNet *netparams.Params) {
 	loaderService.loader = loader
@@ -2948,7 +3190,7 @@ func (s *loaderServer) RpcSync(req *pb.RpcSyncRequest, svr pb.WalletLoaderServic
 			}
 			_ = svr.Send(resp)
 		},
-		RescanProgress: func(rescannedThrough int32) {
+		RescanProgress: func(rescannedThrough int32, _ float64) {
 			resp := &pb.RpcSyncResponse{
 				NotificationType: pb.SyncNotificationType_RESCAN_PROGRESS,
 				RescanProgress: &pb.RescanProgressNotification{
@@ -3104,7 +3346,7 @@ func (s *loaderServer) SpvSync(req *pb.SpvSyncRequest, svr pb.WalletLoaderServic
 			}
 			_ = svr.Send(resp)
 		},
-		RescanProgress: func(rescannedThrough int32) {
+		RescanProgress: func(rescannedThrough int32, _ float64) {
 			resp := &pb.SpvSyncResponse{
 				NotificationType: pb.SyncNotificationType_RESCAN_PROGRESS,
 				RescanProgress: &pb.RescanProgressNotification{
@@ -3523,6 +3765,7 @@ func (s *messageVerificationServer) VerifyMessage(ctx context.Context, req *pb.V
 	// (P2PK and P2SH is not allowed).
 	switch addr.(type) {
 	case *stdaddr.AddressPubKeyHashEcdsaSecp256k1V0:
+	case *stdaddr.AddressPubKeyHashSchnorrSecp256k1V0:
 	default:
 		return nil, status.Error(codes.InvalidArgument,
 			"address must be secp256k1 pay-to-pubkey-hash")
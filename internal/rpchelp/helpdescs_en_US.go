@@ -44,6 +44,26 @@ var helpDescsEnUS = map[string]string{
 	"addtransaction-blockhash":   "Hash of block which mines transaction",
 	"addtransaction-transaction": "Hex-encoded serialized transaction",
 
+	// ArchiveAccountCmd help.
+	"archiveaccount--synopsis": "Archives an account, hiding it from getbalance and listaccounts results. The account's keys, addresses, and transaction history are retained.",
+	"archiveaccount-account":   "The account to archive",
+
+	// AuditContractCmd help.
+	"auditcontract--synopsis":  "Describes the output of a transaction paying to a hash-locked contract, so the counterparty of an atomic swap can verify its terms before funding or redeeming their side.",
+	"auditcontract-contracttx": "The hex-encoded transaction funding the contract",
+	"auditcontract-contract":   "The hex-encoded contract redeem script",
+
+	// AuditContractResult help.
+	"auditcontractresult-address":         "The P2SH address of the contract",
+	"auditcontractresult-vout":            "The index of the contract output",
+	"auditcontractresult-cointype":        "The coin type of the contract output (0=VAR, 1-255=SKA)",
+	"auditcontractresult-amount":          "The amount locked in the contract (string for precision)",
+	"auditcontractresult-recipient":       "The P2PKH address which may redeem the contract with the secret",
+	"auditcontractresult-refund":          "The P2PKH address which may refund the contract after the lock time",
+	"auditcontractresult-secrethash":      "The hex-encoded secret hash of the contract",
+	"auditcontractresult-locktime":        "Block height, or unix time, after which the contract may be refunded",
+	"auditcontractresult-locktimereached": "Whether the contract may be refunded in the next block",
+
 	// AuditReuseCmd help.
 	"auditreuse--synopsis":       "Reports outputs identifying address reuse",
 	"auditreuse-since":           "Only report reusage since some main chain block height",
@@ -51,13 +71,122 @@ var helpDescsEnUS = map[string]string{
 	"auditreuse--result0--value": "Reused address",
 	"auditreuse--result0--key":   "Array of outpoints referencing the reused address",
 
+	// BackupWalletCmd help.
+	"backupwallet--synopsis":   "Writes an encrypted snapshot of the wallet database, including accounts, labels, and transaction history, to a file.",
+	"backupwallet-destination": "Path of the backup file to create",
+	"backupwallet-passphrase":  "Passphrase used to encrypt the backup",
+
+	// CombinePSDTCmd help.
+	"combinepsdt--synopsis": "Combines the signatures and other data of multiple partially signed transactions (PSDTs) for the same unsigned transaction.",
+	"combinepsdt-psdts":     "The base64-encoded PSDTs to combine",
+	"combinepsdt--result0":  "The base64-encoded combined PSDT",
+
+	// CompactWalletCmd help.
+	"compactwallet--synopsis": "Compacts the wallet database to reclaim the space of deleted records, optionally first pruning old transactions.\n" +
+		"Pruned transactions are fully spent regular transactions which, along with their spenders, are buried by at least the prune depth. " +
+		"They are no longer reported by transaction queries, and are kept only as aggregate history by coin type. " +
+		"Their labels, traces and no longer used price snapshots are removed with them. " +
+		"Writes to the wallet database are blocked while it is compacted.",
+	"compactwallet-prunedepth": "Prune transactions buried by at least this many blocks, which must be at least 4096, or 0 to only compact the database",
+	"compactwallet-status":     "Report the progress of the active or last compaction rather than compacting the database",
+
+	// CompactWalletResult help.
+	"compactwalletresult-active":             "Whether a compaction is in progress",
+	"compactwalletresult-stage":              "The stage of the compaction: pruning, compacting, or complete",
+	"compactwalletresult-percent":            "The progress of the current stage as a percentage",
+	"compactwalletresult-prunedepth":         "The prune depth of the compaction, if transactions were pruned",
+	"compactwalletresult-prunedtransactions": "The number of transactions pruned by the compaction",
+	"compactwalletresult-prunedhistory":      "The aggregate history of all pruned transactions by coin type",
+
+	// PrunedHistoryResult help.
+	"prunedhistoryresult-cointype":     "The coin type credited or debited by the pruned transactions",
+	"prunedhistoryresult-transactions": "The number of pruned transactions crediting or debiting the coin type",
+	"prunedhistoryresult-firstheight":  "The block height of the oldest pruned transaction",
+	"prunedhistoryresult-lastheight":   "The block height of the newest pruned transaction",
+	"prunedhistoryresult-received":     "The total value of the pruned credits",
+	"prunedhistoryresult-sent":         "The total value of the pruned debits",
+
 	// ConsolidateCmd help.
 	"consolidate--synopsis": "Consolidate n many UTXOs into a single output in the wallet.",
 	"consolidate-inputs":    "Number of UTXOs to consolidate as inputs",
 	"consolidate-account":   "Optional: Account from which unspent outputs are picked. When no address specified, also the account used to obtain an output address.",
 	"consolidate-address":   "Optional: Address to pay.  Default is obtained via getnewaddress from the account's address pool.",
 	"consolidate-cointype":  "Optional: Coin type to consolidate (0=VAR, 1-255=SKA). Default is VAR (0).",
-	"consolidate--result0":  "Transaction hash for the consolidation transaction",
+	"consolidate--result0":  "Transaction hash for the consolidation transaction, or the final transaction when the consolidation is split into chained transactions to remain within the maximum transaction size",
+
+	// CounterpartySummaryCmd help.
+	"counterpartysummary--synopsis":    "Aggregates the value exchanged with tagged counterparties by coin type.",
+	"counterpartysummary-counterparty": "Only report activity with this counterparty",
+
+	// CounterpartySummaryResult help.
+	"counterpartysummaryresult-counterparty": "The counterparty name",
+	"counterpartysummaryresult-cointype":     "The coin type of the reported amounts (0=VAR, 1-255=SKA)",
+	"counterpartysummaryresult-sent":         "Total value of wallet-funded outputs paying the counterparty's addresses",
+	"counterpartysummaryresult-received":     "Total value credited to the wallet by transactions spending from the counterparty's addresses and no wallet outputs",
+	"counterpartysummaryresult-transactions": "Number of transactions involving the counterparty",
+
+	// ExportCounterpartiesCmd help.
+	"exportcounterparties--synopsis":       "Exports all counterparty address tags.",
+	"exportcounterparties--result0--desc":  "Object keying counterparty names to arrays of tagged addresses",
+	"exportcounterparties--result0--key":   "Counterparty name",
+	"exportcounterparties--result0--value": "Array of addresses tagged with the counterparty",
+
+	// ExportAccountXprivCmd help.
+	"exportaccountxpriv--synopsis": "Returns the BIP0044 extended private key of an account, for migrating the account to other software. The extended private key reveals every private key of the account.\n" +
+		"Requires the --accountkeyexport option. The account's keys must be unlocked, and the passphrase they were unlocked with must be entered again to confirm the export.",
+	"exportaccountxpriv-account":    "The account to export the extended private key of",
+	"exportaccountxpriv-passphrase": "The wallet private passphrase, or the account passphrase of individually-encrypted accounts",
+	"exportaccountxpriv--result0":   "The account extended private key",
+
+	// ExportHistoryCmd help.
+	"exporthistory--synopsis": "Writes the mined transaction history to a new file for accounting, in increasing block height order.\n" +
+		"Each transaction records its coin type, class, SSFee type (MF or SF), block time, amounts received and sent by the wallet, fee paid, label, and the latest fiat price recorded at or before the block time.",
+	"exporthistory-destination": "Path of the file to create",
+	"exporthistory-format":      "Format of the file (csv or json)",
+	"exporthistory--result0":    "The number of exported transactions",
+
+	// ExtractSecretCmd help.
+	"extractsecret--synopsis":  "Returns the hex-encoded secret revealed by a transaction redeeming a hash-locked contract with the secret hash.",
+	"extractsecret-redeemtx":   "The hex-encoded transaction redeeming the contract",
+	"extractsecret-secrethash": "The hex-encoded SHA-256 secret hash of the contract",
+	"extractsecret--result0":   "The hex-encoded secret",
+
+	// ImportCounterpartiesCmd help.
+	"importcounterparties--synopsis":   "Imports counterparty address tags, such as those returned by exportcounterparties.",
+	"importcounterparties-tags":        "Counterparty address tags",
+	"importcounterparties-tags--desc":  "Object keying counterparty names to arrays of external addresses",
+	"importcounterparties-tags--key":   "Counterparty name",
+	"importcounterparties-tags--value": "Array of addresses to tag with the counterparty",
+	"importcounterparties--result0":    "The number of tagged addresses",
+
+	// TagCounterpartyCmd help.
+	"tagcounterparty--synopsis":    "Tags external addresses as belonging to a named counterparty, such as an exchange or pool.",
+	"tagcounterparty-counterparty": "The counterparty name",
+	"tagcounterparty-addresses":    "External addresses to tag",
+
+	// UntagCounterpartyCmd help.
+	"untagcounterparty--synopsis": "Removes the counterparty tags of addresses.",
+	"untagcounterparty-addresses": "Addresses to untag",
+
+	// CreateContractCmd help.
+	"createcontract--synopsis": "Funds a hash-locked contract paying the recipient, who may redeem it by revealing the secret of the secret hash, or refunding the account after the lock time.\n" +
+		"Without a secret hash, the wallet generates the secret of a new atomic swap and the contract may be refunded after 48 hours.\n" +
+		"A contract participating in a swap with the secret hash of the initiator may be refunded after 24 hours.",
+	"createcontract-account":    "Account funding the contract and receiving refunds",
+	"createcontract-recipient":  "P2PKH address of the contract recipient",
+	"createcontract-amount":     "Amount locked in the contract (string for precision)",
+	"createcontract-cointype":   "Coin type of the locked amount (0=VAR, 1-255=SKA)",
+	"createcontract-secrethash": "Hex-encoded SHA-256 hash of the swap secret chosen by the initiator",
+	"createcontract-locktime":   "Block height, or unix time, after which the contract may be refunded, overriding the default",
+
+	// CreateContractResult help.
+	"createcontractresult-txid":       "The hash of the published contract transaction",
+	"createcontractresult-tx":         "The hex-encoded contract transaction",
+	"createcontractresult-contract":   "The hex-encoded contract redeem script",
+	"createcontractresult-address":    "The P2SH address of the contract",
+	"createcontractresult-secrethash": "The hex-encoded secret hash of the contract",
+	"createcontractresult-secret":     "The hex-encoded secret, when generated by the wallet",
+	"createcontractresult-locktime":   "Block height, or unix time, after which the contract may be refunded",
 
 	// CreateMultisigCmd help.
 	"createmultisig--synopsis": "Generate a multisig address and redeem script.",
@@ -72,11 +201,66 @@ var helpDescsEnUS = map[string]string{
 	"createsignatureresult-signature": "The hex encoded signature.",
 	"createsignatureresult-publickey": "The hex encoded serialized compressed pubkey of the address.",
 
+	// CreateMultisigAccountCmd help.
+	"createmultisigaccount--synopsis": "Creates an account paying to P2SH multisig addresses shared with cosigners.\n" +
+		"The redeem script of each address requires nrequired signatures from the keys of the account and each cosigner, derived at the address' branch and index.\n" +
+		"The wallet must be unlocked for this request to succeed.",
+	"createmultisigaccount-account":   "Name of the new account",
+	"createmultisigaccount-nrequired": "The number of signatures required to spend from the account",
+	"createmultisigaccount-xpubs":     "The account extended public keys of each cosigner",
+
+	// CreateVaultAccountCmd help.
+	"createvaultaccount--synopsis": "Creates an account paying to P2SH vault addresses which the account keys may only spend after a relative lock time.\n" +
+		"Vault outputs are not selected by other sends, and are spent with sendfromvault once they have delay confirmations.\n" +
+		"The wallet must be unlocked for this request to succeed.",
+	"createvaultaccount-account":      "Name of the new account",
+	"createvaultaccount-delay":        "The number of confirmations (1 to 65535) before outputs may be spent by the account keys",
+	"createvaultaccount-recoveryxpub": "An extended public key whose child keys, derived at each address' branch and index, may spend outputs without delay",
+
 	// CreateNewAccountCmd help.
 	"createnewaccount--synopsis": "Creates a new account.\n" +
 		"The wallet must be unlocked for this request to succeed.",
 	"createnewaccount-account": "Name of the new account",
 
+	// CreateInvoiceCmd help.
+	"createinvoice--synopsis": "Records an invoice requesting payment to a new external address of an account.\n" +
+		"Wallet outputs paying the address in the coin type are matched with the invoice until it is paid in full or expires.",
+	"createinvoice-amount":   "The invoiced amount as a decimal number of coins of the coin type",
+	"createinvoice-cointype": "The coin type to be paid (0=VAR, 1-255=SKA)",
+	"createinvoice-account":  "The account of the payment address",
+	"createinvoice-label":    "A label describing the invoice",
+	"createinvoice-expires":  "The Unix time after which payments are no longer matched with the invoice",
+
+	// InvoiceResult help.
+	"invoiceresult-id":       "The invoice ID",
+	"invoiceresult-address":  "The payment address",
+	"invoiceresult-cointype": "The coin type to be paid (0=VAR, 1-255=SKA)",
+	"invoiceresult-amount":   "The invoiced amount",
+	"invoiceresult-received": "The total of the payments matched with the invoice, including unmined payments",
+	"invoiceresult-label":    "A label describing the invoice",
+	"invoiceresult-created":  "The Unix time the invoice was created",
+	"invoiceresult-expires":  "The Unix time after which payments are no longer matched with the invoice, unset when the invoice does not expire",
+	"invoiceresult-status":   "The invoice status (open, paid, or expired)",
+	"invoiceresult-uri":      "The payment request URI of the invoice",
+	"invoiceresult-payments": "The wallet outputs paying the invoice",
+
+	// InvoicePaymentResult help.
+	"invoicepaymentresult-txid":   "The transaction hash of the output",
+	"invoicepaymentresult-vout":   "The output index",
+	"invoicepaymentresult-tree":   "The transaction tree of the output",
+	"invoicepaymentresult-height": "Height of the block mining the output, or -1 if unmined",
+	"invoicepaymentresult-amount": "The output amount",
+
+	// CreatePaymentURICmd help.
+	"createpaymenturi--synopsis": "Encodes a monetarium: payment request URI following BIP0021.",
+	"createpaymenturi-address":   "The payment address",
+	"createpaymenturi-amount":    "The requested amount as a decimal number of coins of the coin type, or unset for the payer to choose the amount",
+	"createpaymenturi-cointype":  "The coin type to be paid (0=VAR, 1-255=SKA)",
+	"createpaymenturi-label":     "A label naming the payee",
+	"createpaymenturi-message":   "A message describing the payment",
+	"createpaymenturi-expires":   "The Unix time after which the request should not be paid",
+	"createpaymenturi--result0":  "The payment request URI",
+
 	// CreateAuthorizedEmissionCmd help.
 	"createauthorizedemission--synopsis": "Creates a cryptographically authorized SKA emission transaction using governance-defined parameters.\n" +
 		"The wallet must be unlocked for this request to succeed.",
@@ -106,6 +290,44 @@ var helpDescsEnUS = map[string]string{
 	"createsignature-hashtype":              "The signature hash flags to use.",
 	"createsignature-previouspkscript":      "The hex encoded previous output script or P2SH redeem script.",
 
+	// CreateUnsignedTransactionFileCmd help.
+	"createunsignedtransactionfile--synopsis": "Authors an unsigned transaction paying to many addresses and encodes it, with the previous outputs spent by its inputs, to be signed by signrawtransactionoffline.\n" +
+		"The wallet may be watching-only, and the signing wallet does not require a network connection.",
+	"createunsignedtransactionfile-fromaccount":    "Account to pick unspent outputs from",
+	"createunsignedtransactionfile-amounts":        "Pairs of payment addresses and the output amount to pay each",
+	"createunsignedtransactionfile-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in Monetarium to send to each address",
+	"createunsignedtransactionfile-amounts--key":   "Address to pay",
+	"createunsignedtransactionfile-amounts--value": "Amount to send to the payment address valued in Monetarium",
+	"createunsignedtransactionfile-minconf":        "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"createunsignedtransactionfile-cointype":       "Optional coin type to send (0=VAR, 1-255=SKA)",
+	"createunsignedtransactionfile--result0":       "The JSON-encoded unsigned transaction file",
+
+	// CreateRPCCredentialCmd help.
+	"createrpccredential--synopsis": "Creates a credential which authenticates RPC clients with a limited set of scopes, and returns its generated password.\n" +
+		"The read scope permits methods which only report wallet and network state, send permits deriving addresses and creating, signing, and sending transactions, staking permits purchasing tickets and setting voting preferences, and admin permits every method.\n" +
+		"Credentials are recorded by the default wallet, and only a salted hash of the password is kept, so the password can not be recovered later.",
+	"createrpccredential-username": "Username of the credential, which may not contain ':'",
+	"createrpccredential-scopes":   "Scopes granted to the credential (read, send, staking, or admin)",
+
+	// CreateRPCCredentialResult help.
+	"createrpccredentialresult-username": "Username of the credential",
+	"createrpccredentialresult-password": "Generated password of the credential",
+	"createrpccredentialresult-scopes":   "Scopes granted to the credential",
+
+	// CreateWalletCmd help.
+	"createwallet--synopsis": "Creates and loads a named wallet from a seed, hosted alongside the default wallet.\n" +
+		"Requests select a named wallet with a \"wallet\" member naming it alongside the method and params.",
+	"createwallet-name":          "Name of the wallet (letters, digits, '-', '_', and '.', not beginning with '.')",
+	"createwallet-passphrase":    "Private passphrase to encrypt the wallet with",
+	"createwallet-seed":          "Hexadecimal wallet seed",
+	"createwallet-pubpassphrase": "Public passphrase to encrypt the wallet database with (default: insecure public passphrase)",
+
+	// CreateWatchOnlyWalletCmd help.
+	"createwatchonlywallet--synopsis": "Creates and loads a watching-only wallet which records no private keys.\n" +
+		"The default account watches the first extended public key, and each additional key is imported as an account named xpub1, xpub2, and so on.",
+	"createwatchonlywallet-xpubs":         "Account extended public keys to watch",
+	"createwatchonlywallet-pubpassphrase": "Public passphrase to encrypt the wallet database with (default: insecure public passphrase)",
+
 	// DebugLevelCmd help.
 	"debuglevel--synopsis": "Dynamically changes the debug logging level.\n" +
 		"The levelspec can either a debug level or of the form:\n" +
@@ -119,23 +341,98 @@ var helpDescsEnUS = map[string]string{
 	"debuglevel--result0":    "The string 'Done.'",
 	"debuglevel--result1":    "The list of subsystems",
 
+	// DecodePaymentURICmd help.
+	"decodepaymenturi--synopsis": "Decodes a monetarium: payment request URI following BIP0021.\n" +
+		"Requests paying an address of another network, or with an amount more precise than the coin type, are rejected.",
+	"decodepaymenturi-uri": "The payment request URI",
+
+	// DecodePaymentURIResult help.
+	"decodepaymenturiresult-address":  "The payment address",
+	"decodepaymenturiresult-amount":   "The requested amount as a decimal number of coins of the coin type, unset when the payer chooses the amount",
+	"decodepaymenturiresult-cointype": "The coin type to be paid (0=VAR, 1-255=SKA)",
+	"decodepaymenturiresult-label":    "A label naming the payee",
+	"decodepaymenturiresult-message":  "A message describing the payment",
+	"decodepaymenturiresult-expires":  "The Unix time after which the request should not be paid, unset when the request does not expire",
+	"decodepaymenturiresult-expired":  "Whether the request has expired",
+
 	// DisapprovePercentCmd help.
 	"disapprovepercent--synopsis": "Returns the wallet's current block disapprove percent per vote. i.e. 100 means that all votes disapprove the block they are called on. Only used for testing purposes.",
 	"disapprovepercent--result0":  "The disapprove percent. When voting, this percent of votes will randomly disapprove the block they are called on.",
 
 	// DiscoverUsageCmd help.
 	"discoverusage--synopsis":        "Perform address and/or account discovery",
-	"discoverusage-startblock":       "Hash of block to begin discovery from, or null to scan from the genesis block",
+	"discoverusage-startblock":       "Hash of block to begin discovery from, or null to scan from the wallet birthday block",
 	"discoverusage-discoveraccounts": "Perform account discovery in addition to address discovery.  Requires unlocked wallet.",
 	"discoverusage-gaplimit":         "Allowed unused address gap.",
+	"discoverusage-fullscan":         "Scan from the genesis block, ignoring the wallet birthday, when no start block is provided",
+
+	// DumpAccountPrivKeysCmd help.
+	"dumpaccountprivkeys--synopsis": "Returns the WIF-encoded private keys of every address the wallet has derived on the external and internal branches of an account, for migrating the account to other software.\n" +
+		"Requires the --accountkeyexport option. The account's keys must be unlocked, and the passphrase they were unlocked with must be entered again to confirm the export.",
+	"dumpaccountprivkeys-account":    "The account to export the private keys of",
+	"dumpaccountprivkeys-passphrase": "The wallet private passphrase, or the account passphrase of individually-encrypted accounts",
+	"dumpaccountprivkeys--result0":   "The address private keys",
+
+	// AccountPrivKeyResult help.
+	"accountprivkeyresult-address": "The P2PKH address of the key",
+	"accountprivkeyresult-branch":  "The account branch of the address (0 for external, 1 for internal)",
+	"accountprivkeyresult-index":   "The child index of the address on its branch",
+	"accountprivkeyresult-privkey": "The WIF-encoded private key",
 
 	// DumpPrivKeyCmd help.
 	"dumpprivkey--synopsis": "Returns the private key in WIF encoding that controls some wallet address.",
 	"dumpprivkey-address":   "The address to return a private key for",
 	"dumpprivkey--result0":  "The WIF-encoded private key",
 
+	// EmitSKACmd help.
+	"emitska--synopsis": "Creates the governance-defined emission transaction of an SKA coin type, signed by an emission key stored by the wallet, and publishes it.\n" +
+		"The emission pays no fee and must be created within the emission window of the coin type. Requires an unlocked wallet and the --enableskaemission option.",
+	"emitska-cointype":        "SKA coin type to emit (1-255)",
+	"emitska-emissionkeyname": "Name of the stored emission key approved for the coin type",
+	"emitska-nonce":           "Nonce of the emission, unique for each emission of the coin type",
+	"emitska--result0":        "The transaction hash of the emission",
+
+	// EstimateSendFeeCmd help.
+	"estimatesendfee--synopsis": "Selects inputs and decides on change for a send of amounts to addresses, as sendmany would, without signing or publishing the transaction.\n" +
+		"No change address is derived, and the estimate describes the transaction before any configured change split.",
+	"estimatesendfee-fromaccount":     "The account to send from",
+	"estimatesendfee-amounts":         "Pairs of payment addresses and the output amount to pay each",
+	"estimatesendfee-amounts--desc":   "JSON object using payment addresses as keys and output amounts valued in Monetarium to send to each address",
+	"estimatesendfee-amounts--key":    "Address to pay",
+	"estimatesendfee-amounts--value":  "Amount to send to the payment address valued in Monetarium",
+	"estimatesendfee-minconf":         "The minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"estimatesendfee-cointype":        "The coin type of the amounts (0=VAR, 1-255=SKA)",
+	"estimatesendfee-subtractfeefrom": "Addresses of the amounts whose outputs pay the transaction fee, divided evenly between them",
+	"estimatesendfee-feepreference":   "Optional fee preference (slow, normal or fast) selecting the fee rate estimated by the network backend for the coin type instead of the wallet's fee rate",
+
+	// EstimateSendFeeResult help.
+	"estimatesendfeeresult-cointype": "The coin type of the transaction",
+	"estimatesendfeeresult-fee":      "The transaction fee",
+	"estimatesendfeeresult-feerate":  "The fee rate per kB used to author the transaction",
+	"estimatesendfeeresult-size":     "The estimated serialize size of the signed transaction in bytes",
+	"estimatesendfeeresult-inputs":   "The outputs selected to be spent by the transaction",
+	"estimatesendfeeresult-change":   "The amount paid to change, unset when the transaction has no change",
+
+	// EstimateSendFeeInputResult help.
+	"estimatesendfeeinputresult-txid":   "The hash of the transaction creating the output",
+	"estimatesendfeeinputresult-vout":   "The output index",
+	"estimatesendfeeinputresult-tree":   "The transaction tree of the output",
+	"estimatesendfeeinputresult-amount": "The output amount",
+
+	// FinalizePSDTCmd help.
+	"finalizepsdt--synopsis": "Creates the signature scripts of PSDT inputs with enough partial signatures.\n" +
+		"When every input is finalized and extract is true, the signed transaction is also returned.",
+	"finalizepsdt-psdt":    "The base64-encoded PSDT",
+	"finalizepsdt-extract": "Return the signed transaction when every input is finalized",
+
+	// FinalizePSDTResult help.
+	"finalizepsdtresult-psdt":     "The base64-encoded PSDT",
+	"finalizepsdtresult-complete": "Whether every input is finalized",
+	"finalizepsdtresult-hex":      "The signed transaction encoded as a hexadecimal string, when complete and extracted",
+
 	// FundRawTransactionCmd help.
-	"fundrawtransaction--synopsis":            "Adds unsigned inputs and change output to a raw transaction",
+	"fundrawtransaction--synopsis": "Adds unsigned inputs and change output to a raw transaction.\n" +
+		"Existing inputs must spend wallet outputs and are kept, and additional inputs of the coin type of the outputs are selected to pay the outputs and fee",
 	"fundrawtransaction-hexstring":            "Serialized transaction in hex encoding",
 	"fundrawtransaction-fundaccount":          "Account of outputs to spend in transaction",
 	"fundrawtransaction-options":              "Object to specify fixed change address, alternative fee rate, and confirmation target",
@@ -143,7 +440,7 @@ var helpDescsEnUS = map[string]string{
 	"fundrawtransactionoptions-feerate":       "Alternative fee rate",
 	"fundrawtransactionoptions-changeaddress": "Provide a change address rather than deriving one from the funding account",
 	"fundrawtransactionresult-hex":            "Funded transaction in hex encoding",
-	"fundrawtransactionresult-fee":            "Absolute fee of funded transaction",
+	"fundrawtransactionresult-fee":            "Absolute fee of funded transaction in coins of its coin type",
 
 	// GenerateEmissionKeyCmd help.
 	"generateemissionkey--synopsis": "Generates a new private key for SKA emission authorization.\n" +
@@ -175,7 +472,7 @@ var helpDescsEnUS = map[string]string{
 	"getbalance-account":   "The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")",
 	"getbalance-cointype":  "Optional coin type to filter by (0=VAR, 1-255=SKA)",
 
-	"getbalanceresult-balances":                       "Balances for all accounts.",
+	"getbalanceresult-balances":                       "Balances for all unarchived accounts. Archived accounts are included in the totals.",
 	"getaccountbalanceresult-accountname":             "Name of account.",
 	"getaccountbalanceresult-immaturecoinbaserewards": "Immature Coinbase reward coins.",
 	"getaccountbalanceresult-immaturestakegeneration": "Number of immature stake coins.",
@@ -401,11 +698,54 @@ var helpDescsEnUS = map[string]string{
 	// GetInfoCmd help.
 	"getinfo--synopsis": "Returns a JSON object containing various state info.",
 
+	// GetInvoiceCmd help.
+	"getinvoice--synopsis": "Describes an invoice created by createinvoice.",
+	"getinvoice-id":        "The invoice ID",
+
+	// GetKDFInfoCmd help.
+	"getkdfinfo--synopsis": "Describes the key derivation function deriving the key which protects the wallet's private keys from the private passphrase.\n" +
+		"Keys derived with scrypt, or with a lower Argon2id cost than configured, are upgraded to the configured Argon2id cost the next time the wallet is unlocked.",
+	"getkdfinforesult-algorithm":      "The key derivation function: scrypt or argon2id",
+	"getkdfinforesult-n":              "The scrypt CPU/memory cost parameter, if the algorithm is scrypt",
+	"getkdfinforesult-r":              "The scrypt block size parameter, if the algorithm is scrypt",
+	"getkdfinforesult-p":              "The scrypt parallelization parameter, if the algorithm is scrypt",
+	"getkdfinforesult-time":           "The Argon2id time cost, if the algorithm is argon2id",
+	"getkdfinforesult-memory":         "The Argon2id memory cost in KiB, if the algorithm is argon2id",
+	"getkdfinforesult-threads":        "The Argon2id parallelism, if the algorithm is argon2id",
+	"getkdfinforesult-targettime":     "The configured Argon2id time cost",
+	"getkdfinforesult-targetmemory":   "The configured Argon2id memory cost in KiB",
+	"getkdfinforesult-upgradepending": "Whether the key will be derived with the configured Argon2id cost after the next unlock",
+
 	// GetMasterPubkey help.
 	"getmasterpubkey--synopsis": "Requests the master pubkey from the wallet.",
 	"getmasterpubkey-account":   "The account to get the master pubkey for",
 	"getmasterpubkey--result0":  "The master pubkey for the wallet",
 
+	// GetMaxSpendableCmd help.
+	"getmaxspendable--synopsis": "Returns the largest amount of a coin type an account can send in a single transaction.\n" +
+		"Eligible outputs are spent in decreasing order of value while each pays for the fee of its input and the transaction remains within the maximum transaction size.",
+	"getmaxspendable-account":  "The account to spend from",
+	"getmaxspendable-cointype": "Coin type to spend (0=VAR, 1-255=SKA)",
+	"getmaxspendable-minconf":  "Minimum number of block confirmations required before an output is spent",
+	"getmaxspendable-feeperkb": "The fee rate per kB, the wallet's fee rate for the coin type when unset",
+
+	// GetMaxSpendableResult help.
+	"getmaxspendableresult-cointype": "The coin type of the transaction",
+	"getmaxspendableresult-amount":   "The largest amount which can be sent, zero when no non-dust output can be paid",
+	"getmaxspendableresult-fee":      "The fee of the transaction sending the amount",
+	"getmaxspendableresult-size":     "The estimated serialize size of the signed transaction in bytes",
+	"getmaxspendableresult-inputs":   "The number of outputs spent by the transaction",
+	"getmaxspendableresult-eligible": "The number of outputs eligible to be spent, including those too small to pay for their own input",
+
+	// GetMigrationHistoryCmd help.
+	"getmigrationhistory--synopsis": "Returns the database upgrades performed by the wallet since the migration history was created",
+	"getmigrationhistory--result0":  "Array of objects describing each database upgrade",
+
+	// GetMigrationHistoryResult help.
+	"getmigrationhistoryresult-version":     "Database version the upgrade migrated to",
+	"getmigrationhistoryresult-description": "Description of the changes made by the upgrade",
+	"getmigrationhistoryresult-time":        "Unix time the upgrade was performed",
+
 	// GetMultisigOutInfo help.
 	"getmultisigoutinfo--synopsis": "Returns information about a multisignature output.",
 	"getmultisigoutinfo-index":     "Index of input.",
@@ -461,6 +801,50 @@ var helpDescsEnUS = map[string]string{
 	"getreceivedbyaddress-cointype":  "Coin type to filter results (0=VAR, 1-255=SKA coin types)",
 	"getreceivedbyaddress--result0":  "The total received amount valued in Monetarium",
 
+	// GetRescanStatusCmd help.
+	"getrescanstatus--synopsis": "Returns the progress of the active rescan, or of an interrupted rescan which resumes from its last rescanned block the next time the wallet syncs.",
+
+	// GetRescanStatusResult help.
+	"getrescanstatusresult-rescanning":  "Whether a rescan is currently being performed",
+	"getrescanstatusresult-startheight": "Height of the first block of the rescan",
+	"getrescanstatusresult-height":      "Height of the last block for which all transactions have been rescanned",
+	"getrescanstatusresult-tipheight":   "Height of the main chain tip block",
+	"getrescanstatusresult-percent":     "Percentage of blocks from the start height through the tip which have been rescanned",
+	"getrescanstatusresult-eta":         "Estimated seconds remaining until the active rescan completes, or 0 when unknown",
+
+	// GetRPCStatsCmd help.
+	"getrpcstats--synopsis": "Returns statistics of the most recent JSON-RPC and gRPC requests of each method, including the time waited on wallet locks and the database transactions performed, and the most recent requests.",
+	"getrpcstats-count":     "Maximum number of the most recent requests to return",
+	"getrpcstats-method":    "Only return requests of this method",
+	"getrpcstats--result0":  "Request statistics",
+
+	// GetRPCStatsResult help.
+	"getrpcstatsresult-slowthreshold": "Duration in seconds at and above which requests are logged as slow, or 0 if slow requests are not logged",
+	"getrpcstatsresult-methods":       "Statistics of the recorded requests of each method",
+	"getrpcstatsresult-recent":        "The most recent requests, most recent first",
+
+	// RPCMethodStatsResult help.
+	"rpcmethodstatsresult-method":        "The JSON-RPC method or full gRPC method name",
+	"rpcmethodstatsresult-count":         "Number of recorded requests",
+	"rpcmethodstatsresult-errors":        "Number of requests which errored",
+	"rpcmethodstatsresult-slow":          "Number of slow requests",
+	"rpcmethodstatsresult-meanduration":  "Mean duration of the requests in seconds",
+	"rpcmethodstatsresult-maxduration":   "Maximum duration of the requests in seconds",
+	"rpcmethodstatsresult-meanlockwait":  "Mean seconds requests waited to acquire wallet locks",
+	"rpcmethodstatsresult-meandbviews":   "Mean number of database read transactions",
+	"rpcmethodstatsresult-meandbupdates": "Mean number of database read/write transactions",
+
+	// RPCTraceResult help.
+	"rpctraceresult-method":    "The JSON-RPC method or full gRPC method name",
+	"rpctraceresult-requestid": "ID of the request recorded in the log",
+	"rpctraceresult-time":      "Unix time the request was started",
+	"rpctraceresult-duration":  "Duration of the request in seconds",
+	"rpctraceresult-lockwait":  "Seconds waited to acquire wallet locks",
+	"rpctraceresult-dbviews":   "Number of database read transactions",
+	"rpctraceresult-dbupdates": "Number of database read/write transactions",
+	"rpctraceresult-slow":      "Whether the request was slow",
+	"rpctraceresult-error":     "Error returned by the request, if any",
+
 	// GetStakeInfo help.
 	"getstakeinfo--synopsis": "Returns statistics about staking from the wallet.",
 
@@ -482,6 +866,79 @@ var helpDescsEnUS = map[string]string{
 	"getstakeinforesult-unspent":          "Number of unspent tickets",
 	"getstakeinforesult-unspentexpired":   "Number of unspent tickets which are past expiry",
 
+	// GetFeeSummaryCmd help.
+	"getfeesummary--synopsis": "Returns the fees paid by the wallet's mined transactions over a window of days ending today, by coin type and by day.\n" +
+		"Fees are recorded as transactions spending only wallet outputs are mined, and days begin at midnight UTC by block timestamp.",
+	"getfeesummary-days":     "Number of days, including today, to summarize, or 0 for every recorded fee",
+	"getfeesummary-cointype": "Optional coin type to limit the summary to (0=VAR, 1-255=SKA)",
+
+	// GetFeeSummaryResult help.
+	"getfeesummaryresult-days":      "Number of days summarized",
+	"getfeesummaryresult-since":     "Unix time of the start of the first summarized day",
+	"getfeesummaryresult-cointypes": "Fees paid over the window, by coin type",
+	"getfeesummaryresult-daily":     "Fees paid on each day of the window with fees, by coin type",
+
+	// FeeSummaryCoinType help.
+	"feesummarycointype-cointype":     "The coin type of the fees",
+	"feesummarycointype-transactions": "Number of transactions paying fees",
+	"feesummarycointype-totalfees":    "Total fees paid",
+	"feesummarycointype-averagefee":   "Average fee paid per transaction",
+	"feesummarycointype-dailyaverage": "Average fees paid per day of the window",
+
+	// FeeSummaryDailyResult help.
+	"feesummarydailyresult-date":         "The UTC date (YYYY-MM-DD)",
+	"feesummarydailyresult-cointype":     "The coin type of the fees",
+	"feesummarydailyresult-transactions": "Number of transactions paying fees",
+	"feesummarydailyresult-fees":         "Total fees paid on the day",
+
+	// GetSKAEmissionHistoryCmd help.
+	"getskaemissionhistory--synopsis": "Returns the SKA emission transactions observed by the wallet in main chain blocks, in increasing block height order.\n" +
+		"Only emissions relevant to the wallet, such as those paying wallet addresses, are observed.",
+	"getskaemissionhistory-cointype": "Optional SKA coin type to limit the history to (1-255)",
+
+	// SKAEmissionResult help.
+	"skaemissionresult-cointype":      "The SKA coin type emitted",
+	"skaemissionresult-height":        "Height of the block mining the emission",
+	"skaemissionresult-txid":          "The hash of the emission transaction",
+	"skaemissionresult-amount":        "Total amount emitted",
+	"skaemissionresult-emissionkey":   "Hex-encoded emission public key authorizing the emission",
+	"skaemissionresult-nonce":         "Nonce of the emission authorization",
+	"skaemissionresult-emitterscript": "Hex-encoded authorization script of the emission input",
+
+	// GetSKASupplyCmd help.
+	"getskasupply--synopsis": "Returns the supply of each SKA coin type configured by the network or with observed emissions, counted from the emissions observed by the wallet.",
+	"getskasupply-cointype":  "Optional SKA coin type to limit the result to (1-255)",
+
+	// SKASupplyResult help.
+	"skasupplyresult-cointype":           "The SKA coin type",
+	"skasupplyresult-maxsupply":          "Maximum supply set by the network governance parameters",
+	"skasupplyresult-emitted":            "Total amount of the observed emissions",
+	"skasupplyresult-remaining":          "Maximum supply not yet observed as emitted",
+	"skasupplyresult-emissions":          "Number of observed emissions",
+	"skasupplyresult-lastemissionheight": "Block height of the latest observed emission, or -1 when none were observed",
+
+	// GetStakeStats help.
+	"getstakestats--synopsis": "Returns statistics of the wallet's tickets and earned stake rewards.\n" +
+		"Votes, missed and expired tickets, and rewards are counted from stake transactions recorded as they are mined, and include only transactions mined after the wallet database was upgraded to record them unless the wallet is rescanned.",
+	"getstakestats-window": "Number of most recent blocks to compute vote statistics over, or 0 for the entire chain",
+
+	// GetStakeStatsResult help.
+	"getstakestatsresult-blockheight":       "Height of the main chain tip block",
+	"getstakestatsresult-live":              "Number of mature, unexpired tickets owned by this wallet",
+	"getstakestatsresult-immature":          "Number of tickets owned by this wallet which are not yet mature",
+	"getstakestatsresult-missed":            "Number of tickets which missed their vote and were revoked",
+	"getstakestatsresult-expired":           "Number of tickets which expired and were revoked",
+	"getstakestatsresult-revoked":           "Number of revoked tickets",
+	"getstakestatsresult-feerewards":        "SSFee rewards earned by the wallet, by coin type",
+	"getstakestatsresult-window":            "Number of blocks the vote statistics cover, or 0 for the entire chain",
+	"getstakestatsresult-votes":             "Number of votes cast by the wallet within the window",
+	"getstakestatsresult-votesuccessrate":   "Votes / (Votes + missed votes) within the window, or 0 when no tickets were called",
+	"getstakestatsresult-averagevotereward": "Average stakebase subsidy earned per vote within the window",
+
+	// StakeStatsFeeReward help.
+	"stakestatsfeereward-cointype": "Coin type of the reward",
+	"stakestatsfeereward-amount":   "Total reward earned in the coin type",
+
 	// GetTicketMaxPrice help.
 	"getticketmaxprice--synopsis": "Returns the max price the wallet will pay for a ticket.",
 	"getticketmaxprice--result0":  "Max price wallet will spend on a ticket.",
@@ -522,6 +979,48 @@ var helpDescsEnUS = map[string]string{
 	"gettransactionresult-hex":             "The transaction encoded as a hexadecimal string",
 	"gettransactionresult-type":            "The type of transaction (regular, ticket, vote, or revocation)",
 	"gettransactionresult-ticketstatus":    "Status of ticket (if transaction is a ticket)",
+	"gettransactionresult-label":           "Label recorded for the transaction, if any",
+	"gettransactionresult-fiatcurrency":    "Currency of the recorded price of the transaction's coin type at its block time, if any",
+	"gettransactionresult-fiatprice":       "Latest price of one coin recorded at or before the block time, if any",
+	"gettransactionresult-fiatpricetime":   "The Unix time the price was recorded for, if any",
+
+	// GetTransactionsPageCmd help.
+	"gettransactionspage--synopsis": "Returns a page of wallet transactions and the cursor of the following page.\n" +
+		"Unmined transactions are returned first, followed by mined transactions in descending block height order and increasing hash order within a block.",
+	"gettransactionspage-cursor":      "Cursor returned by a previous request, or the empty string for the first page",
+	"gettransactionspage-limit":       "Maximum number of transactions to return",
+	"gettransactionspage-cointype":    "Only return transactions of this coin type (0=VAR, 1-255=SKA), the coin type of their first SKA output or VAR",
+	"gettransactionspage-txclass":     "Only return transactions of this class (regular, coinbase, ticket, vote, revocation, or ssfee)",
+	"gettransactionspage-startheight": "Only return transactions mined at or above this block height",
+	"gettransactionspage-endheight":   "Only return transactions mined at or below this block height, excluding unmined transactions",
+
+	// GetTransactionsPageResult help.
+	"gettransactionspageresult-transactions": "Transactions of the page",
+	"gettransactionspageresult-nextcursor":   "Cursor of the following page, unset when the page holds the last transaction",
+
+	// TransactionsPageTx help.
+	"transactionspagetx-txid":        "The transaction hash",
+	"transactionspagetx-blockhash":   "The hash of the block this transaction is mined in, unset if unmined",
+	"transactionspagetx-blockheight": "The height of the block this transaction is mined in, or -1 if unmined",
+	"transactionspagetx-time":        "The earliest Unix time this transaction was known to exist",
+	"transactionspagetx-txclass":     "The class of the transaction (regular, coinbase, ticket, vote, revocation, or ssfee)",
+	"transactionspagetx-hex":         "The transaction encoded as a hexadecimal string",
+
+	// GetTxTraceCmd help.
+	"gettxtrace--synopsis": "Returns the lifecycle timeline of a transaction originated by the wallet, from construction through signing, publishing, mempool acceptance, confirmation, and maturity.\n" +
+		"Every traced transaction is assigned a trace ID which is included in wallet logs and transaction notifications.",
+	"gettxtrace-txhash":   "Hash of the transaction",
+	"gettxtrace--result0": "The transaction trace",
+
+	// GetTxTraceResult help.
+	"gettxtraceresult-txhash":  "Hash of the traced transaction",
+	"gettxtraceresult-traceid": "Trace ID of the transaction",
+	"gettxtraceresult-events":  "Lifecycle events of the transaction in the order they occurred",
+
+	// TxTraceEventResult help.
+	"txtraceeventresult-stage":  "Lifecycle stage (constructed, signed, published, mempool, confirmed, or mature)",
+	"txtraceeventresult-time":   "Unix time the stage was reached",
+	"txtraceeventresult-height": "Block height of confirmation or maturity",
 
 	// GetUnconfirmedBalanceCmd help.
 	"getunconfirmedbalance--synopsis": "Calculates the unspent output value of all unmined transaction outputs for an account.",
@@ -546,12 +1045,42 @@ var helpDescsEnUS = map[string]string{
 	"getvotefeeconsolidationaddressresult-account":   "The account name",
 	"getvotefeeconsolidationaddressresult-address":   "The consolidation address",
 	"getvotefeeconsolidationaddressresult-isdefault": "True if using the default address (first external), false if custom address is set",
+	"getvotefeeconsolidationaddressresult-external":  "True if the custom address is not controlled by the account, or was set before its ownership was verified",
+
+	// GetVSPTicketStatusCmd help.
+	"getvspticketstatus--synopsis":  "Returns the status of a ticket's fee payment to the VSP it is registered with. The status reported by the VSP is included when the VSP was selected with setvsp, or set with --vsp.url.",
+	"getvspticketstatus-tickethash": "Hash of the ticket",
+	"getvspticketstatus--result0":   "The ticket's VSP fee payment status",
+
+	// GetVSPTicketStatusResult help.
+	"getvspticketstatusresult-tickethash":      "Hash of the ticket",
+	"getvspticketstatusresult-host":            "Host of the VSP the ticket is registered with",
+	"getvspticketstatusresult-feetxhash":       "Hash of the fee transaction, if one has been created",
+	"getvspticketstatusresult-feetxstatus":     "Fee payment status tracked by the wallet (started/paid/errored/confirmed)",
+	"getvspticketstatusresult-vspfeetxstatus":  "Fee transaction status reported by the VSP",
+	"getvspticketstatusresult-ticketconfirmed": "Whether the VSP reports the ticket as confirmed",
+
+	// GetWalletDBVersionCmd help.
+	"getwalletdbversion--synopsis": "Returns the schema version of the wallet database.",
+
+	// GetWalletDBVersionResult help.
+	"getwalletdbversionresult-version":           "The database version recorded by the wallet",
+	"getwalletdbversionresult-compatibleversion": "The oldest database version understood by software which may open the database",
+	"getwalletdbversionresult-latestversion":     "The latest database version understood by this software",
 
 	// GetWalletFeeCmd help.
 	"getwalletfee--synopsis": "Get currently set transaction fee for the wallet",
 	"getwalletfee-cointype":  "Coin type to get fee for (0=VAR, 1-255=SKA coin types)",
 	"getwalletfee--result0":  "Current tx fee (in VAR)",
 
+	// GetWalletLockStateCmd help.
+	"getwalletlockstate--synopsis":             "Returns whether the wallet is unlocked, and when and how an unlocked wallet will be locked again.",
+	"getwalletlockstateresult-unlocked":        "Whether the wallet is unlocked",
+	"getwalletlockstateresult-mode":            "The unlock mode of an unlocked wallet: all, once, or staking",
+	"getwalletlockstateresult-expires":         "The Unix time the wallet will be locked due to the unlock timeout, if any",
+	"getwalletlockstateresult-secondsleft":     "The number of seconds until the wallet is locked due to the unlock timeout, if any",
+	"getwalletlockstateresult-stakingunlocked": "Whether the staking keys are unlocked, independently of the wallet",
+
 	// HelpCmd help.
 	"help--synopsis":   "Returns a list of all commands or help for a specified command.",
 	"help-command":     "The command to retrieve help for",
@@ -588,17 +1117,32 @@ var helpDescsEnUS = map[string]string{
 	"importemissionkey-cointype":   "Optional SKA coin type (1-255) for organization",
 	"importemissionkey--result0":   "The public key corresponding to the imported private key",
 
+	// ImportLegacyWalletCmd help.
+	"importlegacywallet--synopsis": "Imports a wallet database of upstream dcrwallet and opens the imported wallet. No wallet may be loaded or exist.\n" +
+		"The legacy database is copied and never modified. The copy is upgraded, recording every output as a VAR output, and is only kept once its accounts, transactions, and balance are verified to match the legacy database.",
+	"importlegacywallet-source":        "Path of the dcrwallet wallet.db file",
+	"importlegacywallet-pubpassphrase": "Public passphrase of the legacy wallet (default insecure public passphrase)",
+	"importlegacywallet--result0":      "The verified summary of the imported database",
+
+	// ImportLegacyWalletResult help.
+	"importlegacywalletresult-legacyversion":  "The database version of the legacy wallet",
+	"importlegacywalletresult-version":        "The database version of the imported wallet",
+	"importlegacywalletresult-lastaccount":    "The last BIP0044 account number of the wallet",
+	"importlegacywalletresult-transactions":   "The number of mined and unmined transactions recorded by the wallet",
+	"importlegacywalletresult-unspentoutputs": "The number of unspent outputs recorded by the wallet",
+	"importlegacywalletresult-balance":        "The total value of the unspent outputs, in VAR",
+
 	// ImportPrivKeyCmd help.
-	"importprivkey--synopsis": "Imports a WIF-encoded private key to the 'imported' account.",
+	"importprivkey--synopsis": "Imports a WIF-encoded private key to the 'imported' account or another account.",
 	"importprivkey-privkey":   "The WIF-encoded private key",
-	"importprivkey-label":     "Unused (must be unset or 'imported')",
+	"importprivkey-label":     "Account the key is imported to (default='imported')",
 	"importprivkey-rescan":    "Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key",
 	"importprivkey-scanfrom":  "Block number for where to start rescan from",
 
 	// ImportPubKeyCmd help.
-	"importpubkey--synopsis": "Imports a compressed (33-byte) secp256k1 public key and the derived P2PKH address to the imported account.",
+	"importpubkey--synopsis": "Imports a compressed (33-byte) secp256k1 public key and the derived P2PKH address to the imported account or another account.",
 	"importpubkey-pubkey":    "The hex-encoded 33-byte compressed public key",
-	"importpubkey-label":     "Unused (must be unset or 'imported')",
+	"importpubkey-label":     "Account the key is imported to (default='imported')",
 	"importpubkey-rescan":    "Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key",
 	"importpubkey-scanfrom":  "Block number for where to start rescan from",
 
@@ -607,11 +1151,15 @@ var helpDescsEnUS = map[string]string{
 	"importscript-hex":       "Hex encoded script to import",
 	"importscript-rescan":    "Rescans the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key",
 	"importscript-scanfrom":  "Block number for where to start rescan from",
+	"importscript-account":   "Account the script is imported to (default='imported')",
 
 	// ImportXpub help.
-	"importxpub--synopsis": "Import a HD extended public key as a new account.",
+	"importxpub--synopsis": "Import a HD extended public key as a new watching-only account. Unless disabled, addresses of the account used since the scan height are discovered and blocks rescanned in the background.",
 	"importxpub-name":      "Name of new account",
 	"importxpub-xpub":      "Extended public key",
+	"importxpub-gaplimit":  "Allowed gap of unused addresses on each branch of the account, or null to use the wallet's gap limit",
+	"importxpub-rescan":    "Discover used addresses of the account and rescan blocks for its transactions",
+	"importxpub-scanfrom":  "Block height to begin the rescan from, or null to begin from the wallet birthday block",
 
 	// InfoResult help.
 	"inforesult-version":         "The version of the server",
@@ -634,6 +1182,7 @@ var helpDescsEnUS = map[string]string{
 	// ListAccountsCmd help.
 	"listaccounts--synopsis":       "DEPRECATED -- Returns a JSON object of all accounts and their balances.",
 	"listaccounts-minconf":         "Minimum number of block confirmations required before an unspent output's value is included in the balance",
+	"listaccounts-includearchived": "Include archived accounts in the result",
 	"listaccounts--result0--desc":  "JSON object with account names as keys and Monetarium amounts as values",
 	"listaccounts--result0--key":   "The account name",
 	"listaccounts--result0--value": "The account balance valued in Monetarium",
@@ -647,6 +1196,11 @@ var helpDescsEnUS = map[string]string{
 	"listalltransactions--synopsis": "Returns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.",
 	"listalltransactions-account":   "Unused (must be unset or \"*\")",
 
+	// ListInvoicesCmd help.
+	"listinvoices--synopsis": "Describes every invoice created by createinvoice, ordered by ID.",
+	"listinvoices-status":    "If set, only describes invoices with the status (open, paid, or expired)",
+	"listinvoices--result0":  "Invoices ordered by ID",
+
 	// ListLockUnspentCmd help.
 	"listlockunspent--synopsis": "Returns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.",
 	"listlockunspent-account":   "If set, only returns outpoints from this account that are marked as locked",
@@ -676,6 +1230,14 @@ var helpDescsEnUS = map[string]string{
 	"listreceivedbyaddressresult-txids":             "Transaction hashes of all transactions involving this address",
 	"listreceivedbyaddressresult-involvesWatchonly": "Unset",
 
+	// ListRPCCredentialsCmd help.
+	"listrpccredentials--synopsis": "Returns the usernames and scopes of the RPC credentials recorded by the default wallet.",
+
+	// ListRPCCredentialsResult help.
+	"listrpccredentialsresult-username": "Username of the credential",
+	"listrpccredentialsresult-scopes":   "Scopes granted to the credential",
+	"listrpccredentialsresult-created":  "Unix time the credential was created",
+
 	// ListSinceBlockCmd help.
 	"listsinceblock--synopsis":           "Returns a JSON array of objects listing details of all wallet transactions after some block.",
 	"listsinceblock-blockhash":           "Hash of the parent block of the first block to consider transactions from, or unset to list all transactions",
@@ -696,6 +1258,10 @@ var helpDescsEnUS = map[string]string{
 	"listtransactions-count":            "Maximum number of transactions to create results from",
 	"listtransactions-from":             "Number of transactions to skip before results are created",
 	"listtransactions-includewatchonly": "Unused",
+	"listtransactions-cointype":         "Only list transactions of this coin type (0=VAR, 1-255=SKA), the coin type of their first SKA output or VAR",
+	"listtransactions-txclass":          "Only list transactions of this class (regular, coinbase, ticket, vote, revocation, or ssfee)",
+	"listtransactions-startheight":      "Only list transactions mined at or above this block height",
+	"listtransactions-endheight":        "Only list transactions mined at or below this block height, excluding unmined transactions",
 
 	// ListTransactionsResult help.
 	"listtransactionsresult-account":           "DEPRECATED -- Unset",
@@ -716,6 +1282,7 @@ var helpDescsEnUS = map[string]string{
 	"listtransactionsresult-involveswatchonly": "Unset",
 	"listtransactionsresult-comment":           "Unset",
 	"listtransactionsresult-otheraccount":      "Unset",
+	"listtransactionsresult-counterparty":      "The counterparty tagged for the paid address (sends) or funding inputs (receives)",
 	"listtransactionsresult-txtype":            "The type of tx (regular tx, stake tx)",
 
 	// ListUnspentCmd help.
@@ -726,6 +1293,18 @@ var helpDescsEnUS = map[string]string{
 	"listunspent-account":   "If set, only return unspent outputs from this account",
 	"listunspent-cointype":  "Optional coin type to filter by (0=VAR, 1-255=SKA)",
 
+	// ListWalletsCmd help.
+	"listwallets--synopsis": "Returns the named wallets hosted alongside the default wallet and whether each is loaded.",
+
+	// ListWalletsResult help.
+	"listwalletsresult-name":   "The name of the wallet",
+	"listwalletsresult-loaded": "Whether the wallet is loaded",
+
+	// LoadWalletCmd help.
+	"loadwallet--synopsis":     "Opens an existing named wallet so requests may select it.",
+	"loadwallet-name":          "Name of the wallet",
+	"loadwallet-pubpassphrase": "Public passphrase of the wallet (default: insecure public passphrase)",
+
 	// ListUnspentResult help.
 	"listunspentresult-txid":          "The transaction hash of the referenced output",
 	"listunspentresult-vout":          "The output index of the referenced output",
@@ -756,6 +1335,9 @@ var helpDescsEnUS = map[string]string{
 	"lockaccount--synopsis": "Lock an individually-encrypted account",
 	"lockaccount-account":   "Account to lock",
 
+	// LockStakingCmd help.
+	"lockstaking--synopsis": "Lock the staking keys, which vote and revoke tickets independently of the wallet passphrase. Staking keys without a staking passphrase are always unlocked and can not be locked.",
+
 	// LockUnspentCmd help.
 	"lockunspent--synopsis": "Locks or unlocks an unspent output.\n" +
 		"Locked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\n" +
@@ -773,6 +1355,19 @@ var helpDescsEnUS = map[string]string{
 	"mixoutput--synopsis": "Mix a specific output.",
 	"mixoutput-outpoint":  `Outpoint (in form "txhash:index") to mix`,
 
+	// PlanConsolidationCmd help.
+	"planconsolidation--synopsis": "Estimates the transactions, fees, and resulting unspent outputs of consolidating all eligible outputs of an account with consolidate, without creating any transactions.",
+	"planconsolidation-inputs":    "Maximum number of UTXOs consolidated by each consolidation, as with the inputs of consolidate",
+	"planconsolidation-account":   "Optional: Account from which unspent outputs are picked. Default is the default account.",
+	"planconsolidation-cointype":  "Optional: Coin type to plan (0=VAR, 1-255=SKA). Default plans every active coin type.",
+
+	// PlanConsolidationResult help.
+	"planconsolidationresult-cointype":     "Coin type of the consolidated outputs",
+	"planconsolidationresult-utxos":        "Number of unspent outputs eligible for consolidation",
+	"planconsolidationresult-transactions": "Number of consolidation transactions required",
+	"planconsolidationresult-fee":          "Total fee of all consolidation transactions (float for VAR, string for SKA)",
+	"planconsolidationresult-resultutxos":  "Number of eligible unspent outputs remaining after consolidation",
+
 	// PurchaseTicketCmd help.
 	"purchaseticket--synopsis":          "Purchase ticket using available funds.",
 	"purchaseticket--result0":           "Hash of the resulting ticket",
@@ -789,6 +1384,42 @@ var helpDescsEnUS = map[string]string{
 	"processunmanagedticket--synopsis":  "Processes tickets for vsp client based on ticket hash.",
 	"processunmanagedticket-tickethash": "The ticket hash of ticket to be processed by the vsp client.",
 
+	// RecordPriceCmd help.
+	"recordprice--synopsis": "Records the fiat price of one coin of a coin type, which is reported by gettransaction and exporthistory for transactions mined at or after the price time until a later price is recorded.\n" +
+		"A price recorded for the coin type at the same time is replaced.",
+	"recordprice-cointype": "The coin type (0=VAR, 1-255=SKA)",
+	"recordprice-currency": "The fiat currency code of the price",
+	"recordprice-price":    "The decimal price of one coin in the currency",
+	"recordprice-time":     "The Unix time of the price, or the current time if unset",
+
+	// RecoverUnspentCmd help.
+	"recoverunspent--synopsis": "Rescans the block chain for unspent outputs paying wallet addresses which are missing from the wallet database, such as after restoring a partial backup, and reinserts them with their coin type and maturity.\n" +
+		"Recovered outputs are checked against the UTXO set of the node when the wallet is not running in SPV mode.",
+	"recoverunspent-beginheight": "The height of the first block to scan, or null to begin from the wallet birthday block",
+	"recoverunspent-fullscan":    "Scan from the genesis block, ignoring the wallet birthday, when no begin height is provided",
+	"recoverunspent--result0":    "Array of objects describing each recovered output, in block order",
+
+	// RecoveredOutputResult help.
+	"recoveredoutputresult-txid":     "The hash of the transaction creating the output",
+	"recoveredoutputresult-vout":     "The output index",
+	"recoveredoutputresult-tree":     "The transaction tree of the output",
+	"recoveredoutputresult-address":  "The address paid by the output, unset for nonstandard scripts",
+	"recoveredoutputresult-account":  "Name of the account of the address",
+	"recoveredoutputresult-cointype": "Coin type of the output",
+	"recoveredoutputresult-amount":   "The output amount",
+	"recoveredoutputresult-height":   "The height of the block mining the transaction",
+	"recoveredoutputresult-txtype":   "The stake transaction type of the transaction",
+	"recoveredoutputresult-coinbase": "Whether the output was created by a coinbase transaction",
+	"recoveredoutputresult-mature":   "Whether the output has reached the maturity required to be spent",
+	"recoveredoutputresult-verified": "Whether the node reported the output is unspent, always false in SPV mode",
+
+	// RedeemContractCmd help.
+	"redeemcontract--synopsis":  "Redeems a hash-locked contract paying the wallet with its secret, or refunds a contract funded by the wallet after its lock time when no secret is provided, and publishes the transaction.",
+	"redeemcontract-contracttx": "The hex-encoded transaction funding the contract",
+	"redeemcontract-contract":   "The hex-encoded contract redeem script",
+	"redeemcontract-secret":     "The hex-encoded secret redeeming the contract",
+	"redeemcontract--result0":   "The hash of the published redeem or refund transaction",
+
 	// RedeemMultiSigout help.
 	"redeemmultisigout--synopsis": "Takes the input and constructs a P2PKH paying to the specified address.",
 	"redeemmultisigout-address":   "Address to pay to.",
@@ -813,41 +1444,98 @@ var helpDescsEnUS = map[string]string{
 
 	// RescanWallet help.
 	"rescanwallet--synopsis":   "Rescan the block chain for wallet data, blocking until the rescan completes or exits with an error",
-	"rescanwallet-beginheight": "The height of the first block to begin the rescan from",
+	"rescanwallet-beginheight": "The height of the first block to begin the rescan from, or null to begin from the wallet birthday block",
+	"rescanwallet-fullscan":    "Rescan from the genesis block, ignoring the wallet birthday, when no begin height is provided",
+
+	// RestoreWalletCmd help.
+	"restorewallet--synopsis":     "Restores the wallet database from an encrypted backup written by backupwallet and opens the restored wallet. No wallet may be loaded or exist.",
+	"restorewallet-source":        "Path of the backup file",
+	"restorewallet-passphrase":    "Passphrase used to encrypt the backup",
+	"restorewallet-pubpassphrase": "Public passphrase of the restored wallet (default insecure public passphrase)",
+
+	// FiatSendResult help.
+	"fiatsendresult-txid":          "The transaction hash of the sent transaction",
+	"fiatsendresult-rate":          "Price of one coin in the fiat currency used for the conversion",
+	"fiatsendresult-currency":      "The fiat currency code",
+	"fiatsendresult-ratetime":      "Unix time at which the rate was reported",
+	"fiatsendresult-ratesource":    "The source that reported the rate",
+	"fiatsendresult-label":         "The audit label describing the conversion, as recorded for the transaction",
+	"fiatsendresult-labelerror":    "Error recording the label, if any; the transaction was still sent",
+	"fiatsendresult-feepreference": "The fee preference, if specified",
+	"fiatsendresult-feerate":       "The fee rate per kB of the fee preference, if specified",
+
+	// FeePreferenceSendResult help.
+	"feepreferencesendresult-txid":          "The transaction hash of the sent transaction",
+	"feepreferencesendresult-feepreference": "The fee preference",
+	"feepreferencesendresult-feerate":       "The fee rate per kB estimated by the network backend for the fee preference and paid by the transaction",
+
+	// RevokeRPCCredentialCmd help.
+	"revokerpccredential--synopsis": "Removes an RPC credential recorded by the default wallet.  Connections already authenticated with the credential are not closed.",
+	"revokerpccredential-username":  "Username of the credential",
 
 	// SendFromCmd help.
 	"sendfrom--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a payment address.\n" +
 		"A change output is automatically included to send extra output value back to the original account.",
-	"sendfrom-fromaccount": "Account to pick unspent outputs from",
-	"sendfrom-toaddress":   "Address to pay",
-	"sendfrom-amount":      "Amount to send to the payment address valued in Monetarium",
-	"sendfrom-minconf":     "Minimum number of block confirmations required before a transaction output is eligible to be spent",
-	"sendfrom-comment":     "Unused",
-	"sendfrom-commentto":   "Unused",
-	"sendfrom-cointype":    "Optional coin type to send (0=VAR, 1-255=SKA)",
-	"sendfrom--result0":    "The transaction hash of the sent transaction",
+	"sendfrom-fromaccount":   "Account to pick unspent outputs from",
+	"sendfrom-toaddress":     "Address to pay",
+	"sendfrom-amount":        "Amount to send to the payment address valued in Monetarium",
+	"sendfrom-minconf":       "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"sendfrom-comment":       "Optional label recorded for the transaction",
+	"sendfrom-commentto":     "Unused",
+	"sendfrom-cointype":      "Optional coin type to send (0=VAR, 1-255=SKA)",
+	"sendfrom-fiatcurrency":  "Optional fiat currency code (e.g. USD); when set, amounts are fiat values converted to coins using the configured rate source",
+	"sendfrom-expiry":        "Optional block height at which the transaction expires; must be above the next block height",
+	"sendfrom-expireafter":   "Optional number of blocks in which the transaction may be mined before it expires; may not be combined with expiry",
+	"sendfrom-locktime":      "Optional block height, or unix time when at least 500000000, before which the transaction may not be mined; may not be after the current tip",
+	"sendfrom-feepreference": "Optional fee preference (slow, normal or fast) selecting the fee rate estimated by the network backend for the coin type instead of the wallet's fee rate",
+	"sendfrom--condition0":   "neither fiatcurrency nor feepreference specified",
+	"sendfrom--condition1":   "fiatcurrency specified",
+	"sendfrom--condition2":   "feepreference specified without fiatcurrency",
+	"sendfrom--result0":      "The transaction hash of the sent transaction",
 
 	// SendFromTreasuryCmd help.
 	"sendfromtreasury--synopsis":      "Send from treasury balance to multiple recipients.",
-	"sendfromtreasury-key":            "Politeia public key",
+	"sendfromtreasury-key":            "Hex-encoded Politeia public key held by the wallet",
 	"sendfromtreasury-amounts":        "Pairs of payment addresses and the output amount to pay each",
 	"sendfromtreasury-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in Monetarium to send to each address",
 	"sendfromtreasury-amounts--key":   "Address to pay",
 	"sendfromtreasury-amounts--value": "Amount to send to the payment address valued in Monetarium",
 	"sendfromtreasury--result0":       "The transaction hash of the sent transaction",
 
+	// SendFromVaultCmd help.
+	"sendfromvault--synopsis": "Spends the outputs of a vault account which have reached the relative lock time of the vault.\n" +
+		"Change is returned to a new address of the vault and is locked again.\n" +
+		"Sends are subject to the send policy and the daily spend limit of the vault account, and sends exceeding a limit requiring approval are recorded as pending sends.",
+	"sendfromvault-account":        "Name of the vault account",
+	"sendfromvault-amounts":        "Pairs of payment addresses and the output amount to pay each",
+	"sendfromvault-amounts--desc":  "JSON object using payment addresses as keys and output amounts as strings to send to each address",
+	"sendfromvault-amounts--key":   "Address to pay",
+	"sendfromvault-amounts--value": "Amount to send to the payment address",
+	"sendfromvault-cointype":       "The coin type of the outputs (0 for VAR, 1-255 for SKA)",
+	"sendfromvault--result0":       "The transaction hash of the sent transaction",
+
 	// SendManyCmd help.
 	"sendmany--synopsis": "Authors, signs, and sends a transaction that outputs to many payment addresses.\n" +
 		"A change output is automatically included to send extra output value back to the original account.",
-	"sendmany-fromaccount":    "Account to pick unspent outputs from",
-	"sendmany-amounts":        "Pairs of payment addresses and the output amount to pay each",
-	"sendmany-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in Monetarium to send to each address",
-	"sendmany-amounts--key":   "Address to pay",
-	"sendmany-amounts--value": "Amount to send to the payment address valued in Monetarium",
-	"sendmany-minconf":        "Minimum number of block confirmations required before a transaction output is eligible to be spent",
-	"sendmany-comment":        "Unused",
-	"sendmany-cointype":       "Optional coin type to send (0=VAR, 1-255=SKA)",
-	"sendmany--result0":       "The transaction hash of the sent transaction",
+	"sendmany-fromaccount":     "Account to pick unspent outputs from",
+	"sendmany-amounts":         "Pairs of payment addresses and the output amount to pay each",
+	"sendmany-amounts--desc":   "JSON object using payment addresses as keys and output amounts valued in Monetarium to send to each address",
+	"sendmany-amounts--key":    "Address to pay",
+	"sendmany-amounts--value":  "Amount to send to the payment address valued in Monetarium",
+	"sendmany-minconf":         "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"sendmany-comment":         "Optional label recorded for the transaction",
+	"sendmany-cointype":        "Optional coin type to send (0=VAR, 1-255=SKA)",
+	"sendmany-fiatcurrency":    "Optional fiat currency code (e.g. USD); when set, amounts are fiat values converted to coins using the configured rate source",
+	"sendmany-subtractfeefrom": "Optional payment addresses whose output amounts pay the transaction fee, divided evenly between them",
+	"sendmany-expiry":          "Optional block height at which the transaction expires; must be above the next block height",
+	"sendmany-expireafter":     "Optional number of blocks in which the transaction may be mined before it expires; may not be combined with expiry",
+	"sendmany-locktime":        "Optional block height, or unix time when at least 500000000, before which the transaction may not be mined; may not be after the current tip",
+	"sendmany-feepreference":   "Optional fee preference (slow, normal or fast) selecting the fee rate estimated by the network backend for the coin type instead of the wallet's fee rate",
+	"sendmany-data":            "Optional hex-encoded data of up to 256 bytes carried by an additional zero value OP_RETURN output, whose size is paid for by the transaction fee. Data matching the SSFee marker format is rejected",
+	"sendmany--condition0":     "neither fiatcurrency nor feepreference specified",
+	"sendmany--condition1":     "fiatcurrency specified",
+	"sendmany--condition2":     "feepreference specified without fiatcurrency",
+	"sendmany--result0":        "The transaction hash of the sent transaction",
 
 	// SendRawTransactionCmd help.
 	"sendrawtransaction--synopsis":     "Submits the serialized, hex-encoded transaction to the local peer and relays it to the network.",
@@ -859,12 +1547,21 @@ var helpDescsEnUS = map[string]string{
 	"sendtoaddress--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a payment address.\n" +
 		"Unlike sendfrom, outputs are always chosen from the default account.\n" +
 		"A change output is automatically included to send extra output value back to the original account.",
-	"sendtoaddress-address":   "Address to pay",
-	"sendtoaddress-amount":    "Amount to send to the payment address valued in Monetarium",
-	"sendtoaddress-comment":   "Unused",
-	"sendtoaddress-commentto": "Unused",
-	"sendtoaddress-cointype":  "Optional coin type to send (0=VAR, 1-255=SKA)",
-	"sendtoaddress--result0":  "The transaction hash of the sent transaction",
+	"sendtoaddress-address":               "Address to pay",
+	"sendtoaddress-amount":                "Amount to send to the payment address valued in Monetarium",
+	"sendtoaddress-comment":               "Optional label recorded for the transaction",
+	"sendtoaddress-commentto":             "Unused",
+	"sendtoaddress-cointype":              "Optional coin type to send (0=VAR, 1-255=SKA)",
+	"sendtoaddress-fiatcurrency":          "Optional fiat currency code (e.g. USD); when set, amounts are fiat values converted to coins using the configured rate source",
+	"sendtoaddress-subtractfeefromamount": "Subtract the transaction fee from the amount, so the payment address receives less than amount",
+	"sendtoaddress-expiry":                "Optional block height at which the transaction expires; must be above the next block height",
+	"sendtoaddress-expireafter":           "Optional number of blocks in which the transaction may be mined before it expires; may not be combined with expiry",
+	"sendtoaddress-locktime":              "Optional block height, or unix time when at least 500000000, before which the transaction may not be mined; may not be after the current tip",
+	"sendtoaddress-feepreference":         "Optional fee preference (slow, normal or fast) selecting the fee rate estimated by the network backend for the coin type instead of the wallet's fee rate",
+	"sendtoaddress--condition0":           "neither fiatcurrency nor feepreference specified",
+	"sendtoaddress--condition1":           "fiatcurrency specified",
+	"sendtoaddress--condition2":           "feepreference specified without fiatcurrency",
+	"sendtoaddress--result0":              "The transaction hash of the sent transaction",
 
 	// SendToMultisigCmd help.
 	"sendtomultisig--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a multisig address.\n" +
@@ -879,9 +1576,10 @@ var helpDescsEnUS = map[string]string{
 	"sendtomultisig--result0":    "The transaction hash of the sent transaction",
 
 	// SendToTreasuryCmd help.
-	"sendtotreasury--synopsis": "Send Monetarium to treasury",
-	"sendtotreasury-amount":    "Amount to send to treasury",
-	"sendtotreasury--result0":  "The transaction hash of the sent transaction",
+	"sendtotreasury--synopsis":   "Send Monetarium to treasury with a treasury add transaction. Change is returned as stake change.",
+	"sendtotreasury-amount":      "Amount to send to treasury",
+	"sendtotreasury-fromaccount": "Account to send from",
+	"sendtotreasury--result0":    "The transaction hash of the sent transaction",
 
 	// SendToBurnCmd help.
 	"sendtoburn--synopsis": "⚠️  WARNING: IRREVERSIBLE OPERATION ⚠️\n" +
@@ -894,6 +1592,17 @@ var helpDescsEnUS = map[string]string{
 	"sendtoburn-passphrase": "Wallet passphrase required for authorization",
 	"sendtoburn--result0":   "The transaction hash of the burn transaction",
 
+	// SendTSpendCmd help.
+	"sendtspend--synopsis": "Sign a treasury spend transaction with a Politeia key held by the wallet and publish it. The wallet's vote policy for the treasury spend is set to yes.",
+	"sendtspend-hextx":     "Serialized, hex-encoded treasury spend transaction with all outputs, expiry and input value set",
+	"sendtspend-pikey":     "Hex-encoded Politeia public key to sign with",
+	"sendtspend--result0":  "The transaction hash of the sent treasury spend",
+
+	// SetAccountGapLimitCmd help.
+	"setaccountgaplimit--synopsis": "Sets the unused address gap limit of an account, overriding the wallet's gap limit. Address discovery searches the account using this gap limit.",
+	"setaccountgaplimit-account":   "Account to modify",
+	"setaccountgaplimit-gaplimit":  "Allowed gap of unused addresses on each account branch, or zero to use the wallet's gap limit",
+
 	// SetAccountPassphraseCmd help.
 	"setaccountpassphrase--synopsis": "Individually encrypt or change per-account passphrase",
 	"setaccountpassphrase-account":   "Account to modify",
@@ -909,6 +1618,41 @@ var helpDescsEnUS = map[string]string{
 	"setdisapprovepercent--synopsis": "Sets the wallet's block disapprove percent per vote. The wallet will randomly disapprove blocks with this percent of votes. Only used for testing purposes and will fail on mainnet.",
 	"setdisapprovepercent-percent":   "The percent of votes to disapprove blocks. i.e. 100 means that all votes disapprove the block they are called on. Must be between zero and one hundred.",
 
+	// SetStakingAccountCmd help.
+	"setstakingaccount--synopsis": "Move an account into or out of the staking key domain, whose private keys are encrypted by the staking passphrase instead of the wallet passphrase.\n" +
+		"Tickets voting with addresses of an account in the domain are voted and revoked while the staking keys are unlocked, even when the wallet is locked.\n" +
+		"The wallet and the staking keys must be unlocked, and accounts with a unique passphrase can not be moved.",
+	"setstakingaccount-account": "The account to move",
+	"setstakingaccount-staking": "Whether the account is added to (true) or removed from (false) the staking key domain",
+
+	// SetStakingPassphraseCmd help.
+	"setstakingpassphrase--synopsis":  "Set the passphrase protecting the staking keys, which must be unlocked. An empty passphrase leaves the staking keys unlocked whenever the wallet is opened.",
+	"setstakingpassphrase-passphrase": "The new staking passphrase",
+
+	// SetTicketBuyerConfigCmd help.
+	"setticketbuyerconfig--synopsis": "Set the number of unspent tickets the ticket buyer maintains for an account, purchasing tickets with the account's outputs (requires --ticketbuyer.targets). The configuration is saved in the wallet database.",
+	"setticketbuyerconfig-account":   "Account to purchase tickets with",
+	"setticketbuyerconfig-target":    "Number of unspent and unexpired tickets to maintain, or 0 to stop maintaining the account's tickets",
+	"setticketbuyerconfig-maxprice":  "Maximum ticket price to purchase tickets at, or 0 for no limit",
+	"setticketbuyerconfig-maxfee":    "Maximum relay fee per kB to purchase tickets at, or 0 for no limit",
+	"setticketbuyerconfig-reserve":   "Spendable balance of the account which is never used to purchase tickets",
+
+	// SetChangeAccountCmd help.
+	"setchangeaccount--synopsis":     "Redirect all change of a coin type from transactions spending the outputs of an account to a separate change account, so that funds of the two accounts, such as mixed and unmixed funds, never share an account. Setting the change account to the account itself removes the redirection.",
+	"setchangeaccount-account":       "Account whose change is redirected",
+	"setchangeaccount-changeaccount": "Account to return the change to",
+	"setchangeaccount-cointype":      "Coin type of the redirected change (0=VAR, 1-255=SKA)",
+
+	// SetChangeScriptTypeCmd help.
+	"setchangescripttype--synopsis":  "Set the output script of change returned to an account. Change pays the next internal address key of the account with a P2PKH (the default), Schnorr P2PKH, or P2SH script, where P2SH change pays a P2PK redeem script of the key. Fees are estimated using the size of the selected script. The change of multisig accounts always pays their P2SH multisig scripts and can not be changed.",
+	"setchangescripttype-account":    "Account whose change script type is set",
+	"setchangescripttype-scripttype": "Change script type (\"p2pkh\", \"schnorr-p2pkh\", or \"p2sh\")",
+
+	// SetTicketCompoundingCmd help.
+	"setticketcompounding--synopsis": "Opt an account in to or out of compounding its matured SSFee VAR rewards into tickets purchased by the ticket buyer (requires --ticketbuyer.compound). Opting out discards accrued rewards.",
+	"setticketcompounding-account":   "Account to compound the rewards of",
+	"setticketcompounding-enable":    "True to compound the account's rewards, false to stop",
+
 	// SetGenerate help
 	"setgenerate--synopsis":    "Enable or disable stake mining",
 	"setgenerate-generate":     "True to enable stake mining, false to disable.",
@@ -953,22 +1697,40 @@ var helpDescsEnUS = map[string]string{
 		"This overrides the default first external address (index 0).",
 	"setvotefeeconsolidationaddress-account":  "The account name or number",
 	"setvotefeeconsolidationaddress-address":  "The consolidation address to use for SSFee payments",
+	"setvotefeeconsolidationaddress-external": "Allow an address which is not derived from the account, directing SSFee payments to an address the account does not control",
 	"setvotefeeconsolidationaddress--result0": "Success message confirming the consolidation address was set",
 
+	// SetVSPCmd help.
+	"setvsp--synopsis":  "Select the VSP to register tickets purchased by the purchaseticket RPC with, paying VSP fees from the fee account. Failed fee payments of tickets registered with the VSP are periodically retried. The selection is saved in the wallet database and takes precedence over --vsp.url.",
+	"setvsp-host":       "URL of the VSP, or an empty string to remove the selection",
+	"setvsp-pubkey":     "Base64 encoded public key of the VSP",
+	"setvsp-feeaccount": "Account to pay VSP fees from",
+
+	// SetWalletBirthdayCmd help.
+	"setwalletbirthday--synopsis": "Sets the wallet birthday, the block before which the wallet has no transactions. Rescans and address discovery begin from the birthday block. When the birthday precedes the blocks already processed, as for wallets restored from older seeds, addresses are discovered and blocks rescanned from the birthday the next time the wallet syncs.",
+	"setwalletbirthday-birthday":  "Height of the birthday block, or a UNIX timestamp when timestamp is true",
+	"setwalletbirthday-timestamp": "Interpret the birthday as a UNIX timestamp",
+
 	// SignMessageCmd help.
-	"signmessage--synopsis": "Signs a message using the private key of a payment address.",
-	"signmessage-address":   "Payment address of private key used to sign the message with",
-	"signmessage-message":   "Message to sign",
-	"signmessage--result0":  "The signed message encoded as a base64 string",
+	"signmessage--synopsis": "Signs a message using the private key of a payment address.\n" +
+		"Messages are signed with a compact ECDSA signature, or for Schnorr addresses, a Schnorr signature followed by the compressed public key.",
+	"signmessage-address":  "Payment address of private key used to sign the message with",
+	"signmessage-message":  "Message to sign",
+	"signmessage--result0": "The signed message encoded as a base64 string",
 
 	// SignRawTransactionCmd help.
 	"signrawtransaction--synopsis": "Signs transaction inputs using private keys from this wallet and request.\n" +
 		"The valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.",
 	"signrawtransaction-rawtx":    "Unsigned or partially unsigned transaction to sign encoded as a hexadecimal string",
-	"signrawtransaction-inputs":   "Additional data regarding inputs that this wallet may not be tracking",
-	"signrawtransaction-privkeys": "Additional WIF-encoded private keys to use when creating signatures",
+	"signrawtransaction-inputs":   "Additional data regarding inputs that this wallet may not be tracking: previous output scripts, P2SH redeem scripts, and the amount in coins of VAR or value in atoms of SKA previous outputs",
+	"signrawtransaction-privkeys": "Additional WIF-encoded private keys to use when creating signatures, in preference to keys of the wallet",
 	"signrawtransaction-flags":    "Sighash flags",
 
+	// SignRawTransactionOfflineCmd help.
+	"signrawtransactionoffline--synopsis": "Signs the inputs of a transaction created by createunsignedtransactionfile using private keys from this wallet.\n" +
+		"The wallet does not need to know of the previous transactions, and derives the keys of account addresses from the paths recorded in the file.",
+	"signrawtransactionoffline-file": "The JSON-encoded unsigned transaction file",
+
 	// SignRawTransactionError help.
 	"signrawtransactionerror-error":     "Verification or signing error related to the input",
 	"signrawtransactionerror-sequence":  "Script sequence number",
@@ -1003,11 +1765,13 @@ var helpDescsEnUS = map[string]string{
 	"addressamountpair-amount":       "Amount to pay the address",
 
 	// SweepAccount help.
-	"sweepaccount--synopsis":             "Moves as much value as possible in a transaction from an account.\n",
+	"sweepaccount--synopsis": "Moves as much value as possible in a transaction from an account.\n" +
+		"Every eligible output of the coin type is spent to the destination address without a change output, and the fee is subtracted from the output value.\n",
 	"sweepaccount-sourceaccount":         "The account to be swept.",
 	"sweepaccount-destinationaddress":    "The destination address to pay to.",
 	"sweepaccount-requiredconfirmations": "The minimum utxo confirmation requirement (optional).",
 	"sweepaccount-feeperkb":              "The minimum relay fee policy (optional).",
+	"sweepaccount-cointype":              "Coin type to sweep (0=VAR, 1-255=SKA). Default is VAR (0).",
 
 	// SweepAccountResult help.
 	"sweepaccountresult-unsignedtransaction":       "The hex encoded string of the unsigned transaction.",
@@ -1015,20 +1779,337 @@ var helpDescsEnUS = map[string]string{
 	"sweepaccountresult-totaloutputamount":         "The total transaction output amount.",
 	"sweepaccountresult-estimatedsignedsize":       "The estimated size of the transaction when signed.",
 
+	// TicketBuyerConfigCmd help.
+	"ticketbuyerconfig--synopsis": "Returns the ticket buyer configuration of each account whose unspent tickets are maintained by the ticket buyer",
+	"ticketbuyerconfig--result0":  "Array of objects describing each configured account",
+
+	// TicketBuyerConfigResult help.
+	"ticketbuyerconfigresult-account":  "Name of the account",
+	"ticketbuyerconfigresult-target":   "Number of unspent and unexpired tickets to maintain",
+	"ticketbuyerconfigresult-tickets":  "Current number of unspent and unexpired tickets purchased by the account",
+	"ticketbuyerconfigresult-maxprice": "Maximum ticket price to purchase tickets at (0 for no limit)",
+	"ticketbuyerconfigresult-maxfee":   "Maximum relay fee per kB to purchase tickets at (0 for no limit)",
+	"ticketbuyerconfigresult-reserve":  "Spendable balance of the account which is never used to purchase tickets",
+
+	// ChangeAccountsCmd help.
+	"changeaccounts--synopsis": "Returns the change account of each account and coin type whose change is redirected",
+	"changeaccounts--result0":  "Array of objects describing each change redirection",
+
+	// ChangeAccountResult help.
+	"changeaccountresult-account":       "Name of the account whose change is redirected",
+	"changeaccountresult-cointype":      "Coin type of the redirected change",
+	"changeaccountresult-changeaccount": "Name of the account the change is returned to",
+
+	// ChangeScriptTypesCmd help.
+	"changescripttypes--synopsis": "Returns the change script type of each account which does not pay P2PKH change",
+	"changescripttypes--result0":  "Array of objects describing the change script type of each account",
+
+	// ChangeScriptTypeResult help.
+	"changescripttyperesult-account":    "Name of the account",
+	"changescripttyperesult-scripttype": "Script type of change returned to the account (\"schnorr-p2pkh\" or \"p2sh\")",
+
+	// SetSpendLimitCmd help.
+	"setspendlimit--synopsis":       "Limit the amount of a coin type which an account may send each day, with days beginning at midnight UTC. Sends exceeding the limit are refused, or when approval is required, recorded as pending sends which are only signed and published once approved with approvepending. Transactions published with sendrawtransaction count the outputs not paying the sending account, and approving them authors a new transaction paying the same outputs. A zero limit removes the limit.",
+	"setspendlimit-account":         "Account whose spending is limited",
+	"setspendlimit-limit":           "Maximum amount of the coin type sent each day, as a coin amount string",
+	"setspendlimit-cointype":        "Coin type of the limit (0=VAR, 1-255=SKA)",
+	"setspendlimit-requireapproval": "Record sends exceeding the limit as pending sends awaiting approval instead of refusing them",
+
+	// ListSpendLimitsCmd help.
+	"listspendlimits--synopsis": "Returns the daily spend limit of each account and coin type whose spending is limited",
+	"listspendlimits--result0":  "Array of objects describing each spend limit",
+
+	// SpendLimitResult help.
+	"spendlimitresult-account":         "Name of the account whose spending is limited",
+	"spendlimitresult-cointype":        "Coin type of the limit",
+	"spendlimitresult-limit":           "Maximum amount sent each day",
+	"spendlimitresult-spenttoday":      "Amount sent since midnight UTC",
+	"spendlimitresult-requireapproval": "Whether sends exceeding the limit await approval instead of being refused",
+
+	// ListPendingBroadcastsCmd help.
+	"listpendingbroadcasts--synopsis": "Returns every published transaction queued to be republished, with exponential backoff, until it is mined",
+	"listpendingbroadcasts--result0":  "Array of objects describing each queued transaction",
+
+	// PendingBroadcastResult help.
+	"pendingbroadcastresult-txid":        "The transaction hash",
+	"pendingbroadcastresult-queued":      "The Unix time the transaction was queued after it was first published",
+	"pendingbroadcastresult-attempts":    "The number of times the transaction has been republished",
+	"pendingbroadcastresult-lastattempt": "The Unix time the transaction was last republished, unset if never",
+	"pendingbroadcastresult-nextattempt": "The Unix time the transaction is next republished",
+	"pendingbroadcastresult-lasterror":   "The error of the last attempt to republish the transaction, unset if it succeeded",
+
+	// ListPendingSendsCmd help.
+	"listpendingsends--synopsis": "Returns every send awaiting approval after exceeding the daily spend limit of its account, ordered by ID",
+	"listpendingsends--result0":  "Array of objects describing each pending send",
+
+	// PendingSendResult help.
+	"pendingsendresult-id":       "The pending send ID",
+	"pendingsendresult-account":  "Name of the sending account",
+	"pendingsendresult-cointype": "Coin type of the send",
+	"pendingsendresult-amount":   "Amount counted against the daily spend limit, unset for sweeps",
+	"pendingsendresult-outputs":  "The outputs paid by the send",
+	"pendingsendresult-sweep":    "Whether the send sweeps every eligible output of the account to the only output",
+	"pendingsendresult-treasury": "Whether the send adds the outputs to the treasury",
+	"pendingsendresult-label":    "The label recorded for the transaction once sent, if any",
+	"pendingsendresult-created":  "The Unix time the send was recorded",
+
+	// PendingSendOutputResult help.
+	"pendingsendoutputresult-address": "The address paid by the output, unset for outputs not paying a single address",
+	"pendingsendoutputresult-amount":  "The output amount, unset for sweeps",
+
+	// ApprovePendingCmd help.
+	"approvepending--synopsis": "Approve a send which exceeded the daily spend limit of its account, signing and publishing its transaction. The amount sent is counted against the limit.",
+	"approvepending-id":        "The pending send ID",
+	"approvepending--result0":  "The transaction hash of the sent transaction",
+
+	// RejectPendingCmd help.
+	"rejectpending--synopsis": "Remove a send awaiting approval without sending it",
+	"rejectpending-id":        "The pending send ID",
+
+	// ScheduleSendCmd help.
+	"schedulesend--synopsis": "Queue a send to be published once a time and block height are reached.\n" +
+		"Unless presigned, the transaction is authored and signed once triggered, subject to the send policy and daily spend limits, and the send waits while the wallet is locked.",
+	"schedulesend-fromaccount":     "Account to pick unspent outputs from",
+	"schedulesend-amounts":         "Pairs of payment addresses and the output amount to pay each",
+	"schedulesend-amounts--desc":   "JSON object using payment addresses as keys and output amounts as strings to send to each address",
+	"schedulesend-amounts--key":    "Address to pay",
+	"schedulesend-amounts--value":  "Amount to send to the payment address",
+	"schedulesend-notbefore":       "Optional Unix time before which the send is not published",
+	"schedulesend-notbeforeheight": "Optional main chain height which must be reached before the send is published; at least one of notbefore and notbeforeheight is required",
+	"schedulesend-presign":         "Sign the transaction now so that it may be published while the wallet is locked; requires expiry or expireafter, and its inputs are locked until the send is triggered or cancelled",
+	"schedulesend-minconf":         "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"schedulesend-cointype":        "Optional coin type to send (0=VAR, 1-255=SKA)",
+	"schedulesend-comment":         "Optional label recorded for the transaction",
+	"schedulesend-expiry":          "Optional block height at which the transaction expires",
+	"schedulesend-expireafter":     "Optional number of blocks in which the transaction may be mined before it expires; may not be combined with expiry",
+	"schedulesend-feepreference":   "Optional fee preference (slow, normal or fast) selecting the fee rate estimated by the network backend when the send is scheduled instead of the wallet's fee rate",
+	"schedulesend--result0":        "Object describing the scheduled send",
+
+	// ListScheduledSendsCmd help.
+	"listscheduledsends--synopsis": "Returns every scheduled send and its status, ordered by ID",
+	"listscheduledsends--result0":  "Array of objects describing each scheduled send",
+
+	// ScheduledSendResult help.
+	"scheduledsendresult-id":              "The scheduled send ID",
+	"scheduledsendresult-account":         "Name of the sending account",
+	"scheduledsendresult-cointype":        "Coin type of the send",
+	"scheduledsendresult-amount":          "Total amount of the outputs",
+	"scheduledsendresult-outputs":         "The outputs paid by the send",
+	"scheduledsendresult-notbefore":       "The Unix time before which the send is not published, unset if none",
+	"scheduledsendresult-notbeforeheight": "The main chain height which must be reached before the send is published, unset if none",
+	"scheduledsendresult-signedtxid":      "The hash of the presigned transaction, unset if the send is signed once triggered",
+	"scheduledsendresult-status":          "The send status (waiting, sent, failed or cancelled)",
+	"scheduledsendresult-txid":            "The hash of the published transaction, set once sent",
+	"scheduledsendresult-lasterror":       "The reason the send could not be published, set once failed",
+	"scheduledsendresult-label":           "The label recorded for the transaction once sent, if any",
+	"scheduledsendresult-created":         "The Unix time the send was scheduled",
+
+	// CancelScheduledSendCmd help.
+	"cancelscheduledsend--synopsis": "Cancel a scheduled send which has not been triggered, unlocking the inputs of a presigned transaction",
+	"cancelscheduledsend-id":        "The scheduled send ID",
+
+	// AddRecurringPaymentCmd help.
+	"addrecurringpayment--synopsis": "Record a payment to an address sent every interval of blocks or time.\n" +
+		"Each payment is authored and signed when due, subject to the send policy and daily spend limits, and waits while the wallet is locked. " +
+		"Payments missed while the wallet was not running are skipped.",
+	"addrecurringpayment-fromaccount":    "Account to pick unspent outputs from",
+	"addrecurringpayment-address":        "Address to pay",
+	"addrecurringpayment-amount":         "Amount of each payment",
+	"addrecurringpayment-intervalblocks": "Number of blocks between payments",
+	"addrecurringpayment-interval":       "Time between payments, as a duration such as 36h or one of @hourly, @daily and @weekly; exactly one of intervalblocks and interval is required",
+	"addrecurringpayment-start":          "Optional block height, or Unix time for time intervals, of the first payment; defaults to one interval from now",
+	"addrecurringpayment-cointype":       "Optional coin type to send (0=VAR, 1-255=SKA)",
+	"addrecurringpayment-failurepolicy":  "How a failed payment is handled: retry it until it succeeds, skip it, or pause the recurring payment (retry, skip or pause)",
+	"addrecurringpayment-minconf":        "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"addrecurringpayment-comment":        "Optional label recorded for each payment transaction",
+	"addrecurringpayment--result0":       "Object describing the recurring payment",
+
+	// ListRecurringPaymentsCmd help.
+	"listrecurringpayments--synopsis": "Returns every recurring payment, ordered by ID",
+	"listrecurringpayments--result0":  "Array of objects describing each recurring payment",
+
+	// RecurringPaymentResult help.
+	"recurringpaymentresult-id":             "The recurring payment ID",
+	"recurringpaymentresult-account":        "Name of the sending account",
+	"recurringpaymentresult-address":        "The address paid",
+	"recurringpaymentresult-cointype":       "Coin type of the payments",
+	"recurringpaymentresult-amount":         "Amount of each payment",
+	"recurringpaymentresult-intervalblocks": "Number of blocks between payments, unset for time intervals",
+	"recurringpaymentresult-interval":       "Seconds between payments, unset for block intervals",
+	"recurringpaymentresult-nextheight":     "The main chain height at which the next payment is due, unset for time intervals",
+	"recurringpaymentresult-nexttime":       "The Unix time at which the next payment is due, unset for block intervals",
+	"recurringpaymentresult-failurepolicy":  "How a failed payment is handled (retry, skip or pause)",
+	"recurringpaymentresult-paused":         "Whether payments are paused",
+	"recurringpaymentresult-executions":     "The number of payments sent",
+	"recurringpaymentresult-lastattempt":    "The Unix time of the last attempted payment, unset if none",
+	"recurringpaymentresult-lasttxid":       "The transaction hash of the last payment, unset if it was not sent",
+	"recurringpaymentresult-lasterror":      "The reason the last payment was not sent, unset if it was",
+	"recurringpaymentresult-label":          "The label recorded for each payment transaction, if any",
+	"recurringpaymentresult-created":        "The Unix time the recurring payment was recorded",
+
+	// PauseRecurringPaymentCmd help.
+	"pauserecurringpayment--synopsis": "Pause a recurring payment, which is not sent until resumed",
+	"pauserecurringpayment-id":        "The recurring payment ID",
+
+	// ResumeRecurringPaymentCmd help.
+	"resumerecurringpayment--synopsis": "Resume a paused recurring payment. Payments which became due while paused are skipped.",
+	"resumerecurringpayment-id":        "The recurring payment ID",
+
+	// RemoveRecurringPaymentCmd help.
+	"removerecurringpayment--synopsis": "Remove a recurring payment",
+	"removerecurringpayment-id":        "The recurring payment ID",
+
+	// SetInheritanceCmd help.
+	"setinheritance--synopsis": "Configure the dead-man's switch of an account.\n" +
+		"The wallet maintains pre-signed transactions, one for each coin type, sweeping the account's funds to the recovery address with a lock time delaydays days in the future. " +
+		"The transactions are re-created with a new lock time whenever the wallet is unlocked or sends a transaction, so they only become valid if the wallet is unused for the delay. " +
+		"The wallet never publishes the transactions; they are returned by getinheritance to be handed to the recipient. " +
+		"Transactions are only created while the wallet is unlocked.",
+	"setinheritance-account":   "The account whose funds are swept",
+	"setinheritance-address":   "The recovery address receiving the funds",
+	"setinheritance-delaydays": "Number of days of inactivity, at least 1, after which the transactions become valid",
+	"setinheritance--result0":  "Object describing the dead-man's switch",
+
+	// GetInheritanceCmd help.
+	"getinheritance--synopsis": "Returns the dead-man's switch of every account which has one",
+	"getinheritance--result0":  "Array of objects describing each dead-man's switch",
+
+	// InheritanceResult help.
+	"inheritanceresult-account":      "Name of the account whose funds are swept",
+	"inheritanceresult-address":      "The recovery address receiving the funds",
+	"inheritanceresult-delay":        "Seconds of inactivity after which the transactions become valid",
+	"inheritanceresult-refreshed":    "The Unix time the transactions were last created, unset if never",
+	"inheritanceresult-locktime":     "The Unix time lock time of the transactions, unset if there are none",
+	"inheritanceresult-lasterror":    "The reason the transactions could not be re-created when the wallet was last used, unset if they were",
+	"inheritanceresult-transactions": "The pre-signed sweep transaction of each coin type with spendable funds",
+
+	// InheritanceTxResult help.
+	"inheritancetxresult-cointype": "Coin type swept by the transaction",
+	"inheritancetxresult-txid":     "The transaction hash",
+	"inheritancetxresult-amount":   "Amount paid to the recovery address",
+	"inheritancetxresult-hex":      "The serialized signed transaction",
+
+	// ClearInheritanceCmd help.
+	"clearinheritance--synopsis": "Remove the dead-man's switch of an account. Transactions already handed out remain valid once their lock time is reached unless the outputs they spend are spent first.",
+	"clearinheritance-account":   "The account whose dead-man's switch is removed",
+
+	// CreateOwnershipProofCmd help.
+	"createownershipproof--synopsis": "Create a proof of ownership of unspent wallet outputs, of any coin type, committing to a challenge chosen by the verifier.\n" +
+		"The proof is a transaction spending each output, signed with SigHashAll, whose first input commits to the challenge and spends a nonexistent output so that it can never be mined. " +
+		"Only P2PKH outputs may be proven, and the wallet must be unlocked.",
+	"createownershipproof-challenge": "The challenge, such as a statement and nonce chosen by the auditor",
+	"createownershipproof-outputs":   "The outputs to prove ownership of",
+	"createownershipproof--result0":  "The proof and the outputs it proves",
+
+	// CreateOwnershipProofResult help.
+	"createownershipproofresult-proof":     "The hex-encoded serialized proof",
+	"createownershipproofresult-challenge": "The challenge committed to by the proof",
+	"createownershipproofresult-outputs":   "The proven outputs",
+	"createownershipproofresult-totals":    "The total value of the proven outputs of each coin type",
+
+	// VerifyOwnershipProofCmd help.
+	"verifyownershipproof--synopsis": "Verify a proof of ownership created by createownershipproof.\n" +
+		"The proof is valid when it commits to the challenge and every input is signed by the key of the output it spends. " +
+		"Signatures do not commit to the values and scripts of the outputs, so the verifier must also confirm with a full node that each output exists as described and is unspent.",
+	"verifyownershipproof-proof":     "The hex-encoded serialized proof",
+	"verifyownershipproof-challenge": "The challenge the proof must commit to",
+	"verifyownershipproof--result0":  "Whether the proof is valid, and the outputs it claims",
+
+	// VerifyOwnershipProofResult help.
+	"verifyownershipproofresult-valid":     "Whether the proof is valid for the challenge",
+	"verifyownershipproofresult-error":     "The reason the proof is invalid, unset if it is valid",
+	"verifyownershipproofresult-challenge": "The challenge committed to by the proof",
+	"verifyownershipproofresult-outputs":   "The outputs claimed by the proof",
+	"verifyownershipproofresult-totals":    "The total value of the claimed outputs of each coin type",
+
+	// OwnershipProofOutputResult help.
+	"ownershipproofoutputresult-txid":     "The transaction hash of the output",
+	"ownershipproofoutputresult-vout":     "The output index",
+	"ownershipproofoutputresult-tree":     "The transaction tree of the output",
+	"ownershipproofoutputresult-cointype": "Coin type of the output",
+	"ownershipproofoutputresult-amount":   "Value of the output",
+	"ownershipproofoutputresult-address":  "The address paid by the output",
+
+	// OwnershipProofTotalResult help.
+	"ownershipprooftotalresult-cointype": "The coin type",
+	"ownershipprooftotalresult-amount":   "Total value of the outputs of the coin type",
+
+	// BlockAddressCmd help.
+	"blockaddress--synopsis": "Add an address to the send policy blocklist. Sends paying a blocked address are refused, including transactions signed by the wallet and published with sendrawtransaction.",
+	"blockaddress-address":   "The address to block",
+	"blockaddress-reason":    "Optional reason the address is blocked, included in the error refusing a send",
+
+	// UnblockAddressCmd help.
+	"unblockaddress--synopsis": "Remove an address from the send policy blocklist",
+	"unblockaddress-address":   "The blocked address",
+
+	// SetLabelThresholdCmd help.
+	"setlabelthreshold--synopsis": "Require sends of at least an amount of a coin type to be labeled with a comment. Unlabeled sends are refused, including transactions published with sendrawtransaction. A zero threshold removes the requirement.",
+	"setlabelthreshold-threshold": "Amount at and above which sends must be labeled, as a coin amount string",
+	"setlabelthreshold-cointype":  "Coin type of the threshold (0=VAR, 1-255=SKA)",
+
+	// SetLogLevelCmd help.
+	"setloglevel--synopsis": "Set the log level of a subsystem, or of every subsystem, and return the level of each subsystem.\n" +
+		"The valid levels are trace, debug, info, warn, error, critical, and off.\n" +
+		"The valid subsystems are CMGR, DCRW, GRPC, LODR, MIXC, MIXP, PEER, RPCS, SYNC, TKBY, VSPC, and WLLT.",
+	"setloglevel-level":     "The log level",
+	"setloglevel-subsystem": "The subsystem to set the level of (default: every subsystem)",
+	"setloglevel--result0":  "The level of each subsystem",
+
+	// LogLevelResult help.
+	"loglevelresult-subsystem": "The subsystem",
+	"loglevelresult-level":     "The log level of the subsystem",
+
+	// SetSKASendAccountsCmd help.
+	"setskasendaccounts--synopsis": "Restrict sends of an SKA coin type to the accounts. Sends from other accounts are refused. An empty array allows every account to send the coin type.",
+	"setskasendaccounts-cointype":  "The SKA coin type (1-255)",
+	"setskasendaccounts-accounts":  "Names of the only accounts which may send the coin type",
+
+	// GetSendPolicyCmd help.
+	"getsendpolicy--synopsis": "Returns the policy evaluated before the wallet publishes a send",
+	"getsendpolicy--result0":  "The send policy",
+
+	// SendPolicyResult help.
+	"sendpolicyresult-blocklist":       "Addresses which sends may not pay",
+	"sendpolicyresult-labelthresholds": "Amounts of each coin type at and above which sends must be labeled",
+	"sendpolicyresult-skasendaccounts": "Accounts which may send each restricted SKA coin type",
+
+	// BlockedAddressResult help.
+	"blockedaddressresult-address": "The blocked address",
+	"blockedaddressresult-reason":  "The reason the address is blocked, if any",
+
+	// LabelThresholdResult help.
+	"labelthresholdresult-cointype":  "Coin type of the threshold",
+	"labelthresholdresult-threshold": "Amount at and above which sends must be labeled",
+
+	// SKASendAccountsResult help.
+	"skasendaccountsresult-cointype": "The SKA coin type",
+	"skasendaccountsresult-accounts": "Names of the only accounts which may send the coin type",
+
+	// TicketCompoundingCmd help.
+	"ticketcompounding--synopsis": "Returns the matured SSFee VAR rewards accrued, and not yet compounded into tickets, by each account opted in to compounding",
+	"ticketcompounding--result0":  "Array of objects describing each compounding account",
+
+	// TicketCompoundingResult help.
+	"ticketcompoundingresult-account": "Name of the account",
+	"ticketcompoundingresult-accrued": "Matured rewards not yet spent purchasing tickets (in VAR)",
+	"ticketcompoundingresult-height":  "Main chain height through which matured rewards have been accrued",
+
 	// TicketInfoCmd help.
-	"ticketinfo--synopsis":           "Returns details of each wallet ticket transaction",
-	"ticketinfo-startheight":         "Specify the starting block height to scan from",
-	"ticketinfo--result0":            "Array of objects describing each ticket",
-	"ticketinforesult-hash":          "Transaction hash of the ticket",
-	"ticketinforesult-cost":          "Amount paid to purchase the ticket; this may be greater than the ticket price at time of purchase",
-	"ticketinforesult-votingaddress": "Address of 0th output, which describes the requirements to spend the ticket",
-	"ticketinforesult-status":        "Description of ticket status (unknown, unmined, immature, mature, live, voted, missed, expired, unspent, revoked)",
-	"ticketinforesult-blockhash":     "Hash of block ticket is mined in",
-	"ticketinforesult-blockheight":   "Height of block ticket is mined in",
-	"ticketinforesult-vote":          "Transaction hash of vote which spends the ticket",
-	"ticketinforesult-revocation":    "Transaction hash of revocation which spends the ticket",
-	"ticketinforesult-choices":       "Vote preferences set for the ticket",
-	"ticketinforesult-vsphost":       "VSP Host associated with the ticket (if any)",
+	"ticketinfo--synopsis":             "Returns details of each wallet ticket transaction",
+	"ticketinfo-startheight":           "Specify the starting block height to scan from",
+	"ticketinfo--result0":              "Array of objects describing each ticket",
+	"ticketinforesult-hash":            "Transaction hash of the ticket",
+	"ticketinforesult-cost":            "Amount paid to purchase the ticket; this may be greater than the ticket price at time of purchase",
+	"ticketinforesult-votingaddress":   "Address of 0th output, which describes the requirements to spend the ticket",
+	"ticketinforesult-status":          "Description of ticket status (unknown, unmined, immature, mature, live, voted, missed, expired, unspent, revoked)",
+	"ticketinforesult-blockhash":       "Hash of block ticket is mined in",
+	"ticketinforesult-blockheight":     "Height of block ticket is mined in",
+	"ticketinforesult-vote":            "Transaction hash of vote which spends the ticket",
+	"ticketinforesult-revocation":      "Transaction hash of revocation which spends the ticket",
+	"ticketinforesult-choices":         "Vote preferences set for the ticket",
+	"ticketinforesult-vsphost":         "VSP Host associated with the ticket (if any)",
+	"ticketinforesult-feerewardfunded": "Whether the ticket was funded only by SSFee reward outputs",
 
 	// TransactionInput help.
 	"transactioninput-amount": "The previous output amount",
@@ -1062,11 +2143,23 @@ var helpDescsEnUS = map[string]string{
 	"tspendpolicyresult-policy": "Voting policy description (abstain, yes, or no)",
 	"tspendpolicyresult-ticket": "Ticket hash of a per-ticket tspend approval policy",
 
+	// UnarchiveAccountCmd help.
+	"unarchiveaccount--synopsis": "Unarchives an account previously archived with archiveaccount.",
+	"unarchiveaccount-account":   "The account to unarchive",
+
 	// UnlockAccountCmd help.
 	"unlockaccount--synopsis":  "Unlock an individually-encrypted account",
 	"unlockaccount-account":    "Account to unlock",
 	"unlockaccount-passphrase": "Account passphrase",
 
+	// UnlockStakingCmd help.
+	"unlockstaking--synopsis":  "Unlock the staking keys, which vote and revoke tickets without unlocking the wallet's spending keys.",
+	"unlockstaking-passphrase": "The staking passphrase",
+
+	// UnloadWalletCmd help.
+	"unloadwallet--synopsis": "Closes a loaded named wallet.  The default wallet may not be unloaded.",
+	"unloadwallet-name":      "Name of the wallet",
+
 	// ValidateAddressCmd help.
 	"validateaddress--synopsis": "Verify that an address is valid.\n" +
 		"Extra details are returned if the address is controlled by this wallet.\n" +
@@ -1100,12 +2193,21 @@ var helpDescsEnUS = map[string]string{
 	"validatepredcp0005cf--result0":  "Whether the cfilters are valid",
 
 	// VerifyMessageCmd help.
-	"verifymessage--synopsis": "Verify a message was signed with the associated private key of some address.",
+	"verifymessage--synopsis": "Verify a message was signed with the associated private key of some address.\n" +
+		"The address must be an ECDSA or Schnorr pay-to-pubkey-hash address.",
 	"verifymessage-address":   "Address used to sign message",
 	"verifymessage-signature": "The signature to verify",
 	"verifymessage-message":   "The message to verify",
 	"verifymessage--result0":  "Whether the message was signed with the private key of 'address'",
 
+	// VerifySeedCmd help.
+	"verifyseed--synopsis": "Verify that a mnemonic seed backup encodes the wallet seed without revealing the seed.",
+	"verifyseed-mnemonic":  "The space-separated mnemonic seed words",
+
+	// VerifySeedResult help.
+	"verifyseedresult-matches":        "Whether the mnemonic encodes the wallet seed",
+	"verifyseedresult-incorrectwords": "Zero-based positions of words known to be incorrect (a single incorrect word is always located, several may not be)",
+
 	// Version help
 	"version--synopsis":       "Returns application and API versions (semver) keyed by their names",
 	"version--result0--desc":  "Version objects keyed by the program or API name",
@@ -1115,9 +2217,31 @@ var helpDescsEnUS = map[string]string{
 	// VoteChoice help.
 	"votechoice-agendaid":          "The ID for the agenda the choice concerns",
 	"votechoice-agendadescription": "A description of the agenda the choice concerns",
+	"votechoice-agendastatus":      "Deployment status of the agenda (defined, started, lockedin, active, or failed), if reported by the consensus RPC server",
 	"votechoice-choiceid":          "The ID of the current choice for this agenda",
 	"votechoice-choicedescription": "A description of the current choice for this agenda",
 
+	// WalletAuditCmd help.
+	"walletaudit--synopsis": "Describes the derivation path and usage of every derived wallet address, up to the last returned or used address of each account branch.\n" +
+		"Usage is read from the outputs recorded by the wallet rather than by rescanning the chain.",
+	"walletaudit--result0": "Derived addresses ordered by account, branch, and index",
+
+	// WalletAuditResult help.
+	"walletauditresult-address":        "The derived address",
+	"walletauditresult-account":        "The account number of the address",
+	"walletauditresult-accountname":    "The account name of the address",
+	"walletauditresult-branch":         "The account branch of the address (0 for external, 1 for internal)",
+	"walletauditresult-index":          "The child index of the address on the branch",
+	"walletauditresult-path":           "The BIP0044 derivation path of the address, unset for addresses of imported xpub accounts",
+	"walletauditresult-firstuseheight": "Height of the first block with an output paying the address, or -1 if unused",
+	"walletauditresult-lastuseheight":  "Height of the last block with an output paying the address, or -1 if unused",
+	"walletauditresult-received":       "Total received by the address, including spent and unmined outputs, by coin type",
+	"walletauditresult-utxos":          "Number of unspent outputs paying the address",
+
+	// WalletAuditReceived help.
+	"walletauditreceived-cointype": "Coin type of the received outputs",
+	"walletauditreceived-amount":   "Total received in the coin type",
+
 	// WalletInfoCmd help.
 	"walletinfo--synopsis":              "Returns global information about the wallet",
 	"walletinforesult-daemonconnected":  "Whether or not the wallet is currently connected to the daemon RPC",
@@ -1133,6 +2257,9 @@ var helpDescsEnUS = map[string]string{
 	"walletinforesult-manualtickets":    "Whether or not the wallet is only accepting tickets manually",
 	"walletinforesult-birthhash":        "The wallet birth hash.",
 	"walletinforesult-birthheight":      "The wallet birth height.",
+	"walletinforesult-utxocachehits":    "Number of input selections served by the cache of unspent outputs",
+	"walletinforesult-utxocachemisses":  "Number of input selections which read unspent outputs from the database",
+	"walletinforesult-utxocachehitrate": "Fraction of input selections served by the cache of unspent outputs",
 
 	// WalletIsLockedCmd help.
 	"walletislocked--synopsis": "Returns whether or not the wallet is locked.",
@@ -1146,10 +2273,23 @@ var helpDescsEnUS = map[string]string{
 	"walletpassphrasechange-oldpassphrase": "The old wallet passphrase",
 	"walletpassphrasechange-newpassphrase": "The new wallet passphrase",
 
+	// WalletProcessPSDTCmd help.
+	"walletprocesspsdt--synopsis": "Adds the previous outputs, redeem scripts, and BIP0032 derivations known to the wallet to a PSDT, and optionally signs the inputs which can be signed by wallet keys.\n" +
+		"Signing requires the wallet to be unlocked.",
+	"walletprocesspsdt-psdt":     "The base64-encoded PSDT",
+	"walletprocesspsdt-sign":     "Sign inputs with wallet keys",
+	"walletprocesspsdt-finalize": "Create the signature scripts of inputs with enough signatures",
+
+	// WalletProcessPSDTResult help.
+	"walletprocesspsdtresult-psdt":     "The base64-encoded PSDT",
+	"walletprocesspsdtresult-complete": "Whether every input is finalized",
+	"walletprocesspsdtresult-hex":      "The signed transaction encoded as a hexadecimal string, when complete",
+
 	// WalletPassphraseCmd help.
 	"walletpassphrase--synopsis":  "Unlock the wallet.",
 	"walletpassphrase-passphrase": "The wallet passphrase",
 	"walletpassphrase-timeout":    "The number of seconds to wait before the wallet automatically locks. 0 leaves the wallet unlocked indefinitely.",
+	"walletpassphrase-mode":       "Which operations may use the unlocked wallet: all, once (locks again after the first operation using private keys), or staking (only the keys of imported voting accounts)",
 
 	// WalletPubPassPhraseChangeCmd help
 	"walletpubpassphrasechange--synopsis":     "Change the wallet's public passphrase.",
@@ -8,8 +8,8 @@
 package rpchelp
 
 import (
-	"github.com/monetarium/monetarium-wallet/rpc/jsonrpc/types"
 	dcrdtypes "github.com/monetarium/monetarium-node/rpc/jsonrpc/types"
+	"github.com/monetarium/monetarium-wallet/rpc/jsonrpc/types"
 )
 
 // Common return types.
@@ -19,6 +19,7 @@ var (
 	returnsString      = []any{(*string)(nil)}
 	returnsStringArray = []any{(*[]string)(nil)}
 	returnsLTRArray    = []any{(*[]types.ListTransactionsResult)(nil)}
+	returnsSend        = []any{(*string)(nil), (*types.FiatSendResult)(nil), (*types.FeePreferenceSendResult)(nil)}
 )
 
 // Methods contains all methods and result types that help is generated for,
@@ -32,18 +33,50 @@ var Methods = []struct {
 	{"accountsyncaddressindex", nil},
 	{"accountunlocked", []any{(*types.AccountUnlockedResult)(nil)}},
 	{"addmultisigaddress", returnsString},
+	{"addrecurringpayment", []any{(*types.RecurringPaymentResult)(nil)}},
 	{"addtransaction", nil},
+	{"approvepending", returnsString},
+	{"archiveaccount", nil},
+	{"auditcontract", []any{(*types.AuditContractResult)(nil)}},
 	{"auditreuse", []any{(*map[string][]string)(nil)}},
+	{"backupwallet", nil},
+	{"blockaddress", nil},
+	{"cancelscheduledsend", nil},
+	{"changeaccounts", []any{(*[]types.ChangeAccountResult)(nil)}},
+	{"changescripttypes", []any{(*[]types.ChangeScriptTypeResult)(nil)}},
+	{"clearinheritance", nil},
+	{"combinepsdt", returnsString},
+	{"compactwallet", []any{(*types.CompactWalletResult)(nil)}},
 	{"consolidate", returnsString},
+	{"counterpartysummary", []any{(*[]types.CounterpartySummaryResult)(nil)}},
+	{"createcontract", []any{(*types.CreateContractResult)(nil)}},
 	{"createmultisig", []any{(*types.CreateMultiSigResult)(nil)}},
+	{"createmultisigaccount", nil},
+	{"createvaultaccount", nil},
+	{"createownershipproof", []any{(*types.CreateOwnershipProofResult)(nil)}},
 	{"createnewaccount", nil},
+	{"createinvoice", []any{(*types.InvoiceResult)(nil)}},
+	{"createpaymenturi", returnsString},
+	{"createrpccredential", []any{(*types.CreateRPCCredentialResult)(nil)}},
 	{"createauthorizedemission", returnsString},
 	{"createrawtransaction", returnsString},
 	{"createsignature", []any{(*types.CreateSignatureResult)(nil)}},
+	{"createunsignedtransactionfile", returnsString},
+	{"createwallet", nil},
+	{"createwatchonlywallet", nil},
 	{"debuglevel", returnsString},
+	{"decodepaymenturi", []any{(*types.DecodePaymentURIResult)(nil)}},
 	{"disapprovepercent", []any{(*uint32)(nil)}},
 	{"discoverusage", nil},
+	{"dumpaccountprivkeys", []any{(*[]types.AccountPrivKeyResult)(nil)}},
 	{"dumpprivkey", returnsString},
+	{"emitska", returnsString},
+	{"estimatesendfee", []any{(*types.EstimateSendFeeResult)(nil)}},
+	{"exportcounterparties", []any{(*map[string][]string)(nil)}},
+	{"exportaccountxpriv", returnsString},
+	{"exporthistory", returnsNumber},
+	{"extractsecret", returnsString},
+	{"finalizepsdt", []any{(*types.FinalizePSDTResult)(nil)}},
 	{"fundrawtransaction", []any{(*types.FundRawTransactionResult)(nil)}},
 	{"generateemissionkey", returnsString},
 	{"getaccount", returnsString},
@@ -59,27 +92,46 @@ var Methods = []struct {
 	{"getblock", []any{(*dcrdtypes.GetBlockVerboseResult)(nil)}},
 	{"getcoinjoinsbyacct", []any{(*map[string]uint32)(nil)}},
 	{"getcurrentnet", []any{(*uint32)(nil)}},
+	{"getfeesummary", []any{(*types.GetFeeSummaryResult)(nil)}},
 	{"getinfo", []any{(*types.InfoWalletResult)(nil)}},
+	{"getinheritance", []any{(*[]types.InheritanceResult)(nil)}},
+	{"getinvoice", []any{(*types.InvoiceResult)(nil)}},
+	{"getkdfinfo", []any{(*types.GetKDFInfoResult)(nil)}},
 	{"getmasterpubkey", []any{(*string)(nil)}},
+	{"getmaxspendable", []any{(*types.GetMaxSpendableResult)(nil)}},
+	{"getmigrationhistory", []any{(*[]types.GetMigrationHistoryResult)(nil)}},
 	{"getmultisigoutinfo", []any{(*types.GetMultisigOutInfoResult)(nil)}},
 	{"getnewaddress", returnsString},
 	{"getpeerinfo", []any{(*types.GetPeerInfoResult)(nil)}},
 	{"getrawchangeaddress", returnsString},
 	{"getreceivedbyaccount", returnsNumber},
 	{"getreceivedbyaddress", returnsNumber},
+	{"getrescanstatus", []any{(*types.GetRescanStatusResult)(nil)}},
+	{"getrpcstats", []any{(*types.GetRPCStatsResult)(nil)}},
+	{"getsendpolicy", []any{(*types.SendPolicyResult)(nil)}},
+	{"getskaemissionhistory", []any{(*[]types.SKAEmissionResult)(nil)}},
+	{"getskasupply", []any{(*[]types.SKASupplyResult)(nil)}},
 	{"getstakeinfo", []any{(*types.GetStakeInfoResult)(nil)}},
+	{"getstakestats", []any{(*types.GetStakeStatsResult)(nil)}},
 	{"gettickets", []any{(*types.GetTicketsResult)(nil)}},
 	{"gettransaction", []any{(*types.GetTransactionResult)(nil)}},
+	{"gettransactionspage", []any{(*types.GetTransactionsPageResult)(nil)}},
+	{"gettxtrace", []any{(*types.GetTxTraceResult)(nil)}},
 	{"gettxout", []any{(*dcrdtypes.GetTxOutResult)(nil)}},
 	{"getunconfirmedbalance", returnsNumber},
 	{"getvotechoices", []any{(*types.GetVoteChoicesResult)(nil)}},
 	{"getvotefeeconsolidationaddress", []any{(*types.GetVoteFeeConsolidationAddressResult)(nil)}},
+	{"getvspticketstatus", []any{(*types.GetVSPTicketStatusResult)(nil)}},
+	{"getwalletdbversion", []any{(*types.GetWalletDBVersionResult)(nil)}},
 	{"getwalletfee", returnsNumber},
+	{"getwalletlockstate", []any{(*types.GetWalletLockStateResult)(nil)}},
 	{"clearvotefeeconsolidationaddress", nil},
 	{"getcfilterv2", []any{(*types.GetCFilterV2Result)(nil)}},
 	{"help", append(returnsString, returnsString[0])},
 	{"importcfiltersv2", nil},
+	{"importcounterparties", returnsNumber},
 	{"importemissionkey", returnsString},
+	{"importlegacywallet", []any{(*types.ImportLegacyWalletResult)(nil)}},
 	{"importprivkey", nil},
 	{"importpubkey", nil},
 	{"importscript", nil},
@@ -88,56 +140,106 @@ var Methods = []struct {
 	{"listaddresstransactions", returnsLTRArray},
 	{"listalltransactions", returnsLTRArray},
 	{"listcointypes", []any{(*types.ListCoinTypesResult)(nil)}},
+	{"listinvoices", []any{(*[]types.InvoiceResult)(nil)}},
 	{"listlockunspent", []any{(*[]dcrdtypes.TransactionInput)(nil)}},
+	{"listpendingbroadcasts", []any{(*[]types.PendingBroadcastResult)(nil)}},
+	{"listpendingsends", []any{(*[]types.PendingSendResult)(nil)}},
 	{"listreceivedbyaccount", []any{(*[]types.ListReceivedByAccountResult)(nil)}},
 	{"listreceivedbyaddress", []any{(*[]types.ListReceivedByAddressResult)(nil)}},
+	{"listrecurringpayments", []any{(*[]types.RecurringPaymentResult)(nil)}},
+	{"listrpccredentials", []any{(*[]types.ListRPCCredentialsResult)(nil)}},
+	{"listscheduledsends", []any{(*[]types.ScheduledSendResult)(nil)}},
 	{"listsinceblock", []any{(*types.ListSinceBlockResult)(nil)}},
+	{"listspendlimits", []any{(*[]types.SpendLimitResult)(nil)}},
 	{"listtransactions", returnsLTRArray},
 	{"listunspent", []any{(*types.ListUnspentResult)(nil)}},
+	{"listwallets", []any{(*[]types.ListWalletsResult)(nil)}},
+	{"loadwallet", nil},
 	{"lockaccount", nil},
+	{"lockstaking", nil},
 	{"lockunspent", returnsBool},
 	{"mixaccount", nil},
 	{"mixoutput", nil},
 	{"processunmanagedticket", nil},
+	{"pauserecurringpayment", nil},
+	{"planconsolidation", []any{(*[]types.PlanConsolidationResult)(nil)}},
 	{"purchaseticket", returnsString},
+	{"recordprice", nil},
+	{"recoverunspent", []any{(*[]types.RecoveredOutputResult)(nil)}},
+	{"redeemcontract", returnsString},
 	{"redeemmultisigout", []any{(*types.RedeemMultiSigOutResult)(nil)}},
 	{"redeemmultisigouts", []any{(*types.RedeemMultiSigOutResult)(nil)}},
+	{"rejectpending", nil},
+	{"removerecurringpayment", nil},
 	{"renameaccount", nil},
 	{"rescanwallet", nil},
-	{"sendfrom", returnsString},
+	{"restorewallet", nil},
+	{"resumerecurringpayment", nil},
+	{"revokerpccredential", nil},
+	{"schedulesend", []any{(*types.ScheduledSendResult)(nil)}},
+	{"sendfrom", returnsSend},
 	{"sendfromtreasury", returnsString},
-	{"sendmany", returnsString},
+	{"sendfromvault", returnsString},
+	{"sendmany", returnsSend},
 	{"sendrawtransaction", returnsString},
-	{"sendtoaddress", returnsString},
+	{"sendtoaddress", returnsSend},
 	{"sendtomultisig", returnsString},
 	{"sendtotreasury", returnsString},
 	{"sendtoburn", returnsString},
+	{"sendtspend", returnsString},
+	{"setaccountgaplimit", nil},
 	{"setaccountpassphrase", nil},
+	{"setchangeaccount", nil},
+	{"setchangescripttype", nil},
 	{"setdisapprovepercent", nil},
+	{"setinheritance", []any{(*types.InheritanceResult)(nil)}},
+	{"setlabelthreshold", nil},
+	{"setloglevel", []any{(*[]types.LogLevelResult)(nil)}},
+	{"setskasendaccounts", nil},
+	{"setspendlimit", nil},
+	{"setstakingaccount", nil},
+	{"setstakingpassphrase", nil},
+	{"setticketbuyerconfig", nil},
+	{"setticketcompounding", nil},
 	{"settreasurypolicy", nil},
 	{"settspendpolicy", nil},
 	{"settxfee", returnsBool},
 	{"setvotechoice", nil},
 	{"setvotefeeconsolidationaddress", nil},
+	{"setvsp", nil},
+	{"setwalletbirthday", nil},
 	{"signmessage", returnsString},
 	{"signrawtransaction", []any{(*types.SignRawTransactionResult)(nil)}},
+	{"signrawtransactionoffline", []any{(*types.SignRawTransactionResult)(nil)}},
 	{"signrawtransactions", []any{(*types.SignRawTransactionsResult)(nil)}},
 	{"spendoutputs", returnsString},
 	{"sweepaccount", []any{(*types.SweepAccountResult)(nil)}},
 	{"syncstatus", []any{(*types.SyncStatusResult)(nil)}},
+	{"tagcounterparty", nil},
+	{"ticketbuyerconfig", []any{(*[]types.TicketBuyerConfigResult)(nil)}},
+	{"ticketcompounding", []any{(*[]types.TicketCompoundingResult)(nil)}},
 	{"ticketinfo", []any{(*[]types.TicketInfoResult)(nil)}},
 	{"treasurypolicy", []any{(*[]types.TreasuryPolicyResult)(nil), (*types.TreasuryPolicyResult)(nil)}},
 	{"tspendpolicy", []any{(*[]types.TSpendPolicyResult)(nil), (*types.TSpendPolicyResult)(nil)}},
+	{"unarchiveaccount", nil},
+	{"unblockaddress", nil},
 	{"unlockaccount", nil},
+	{"unlockstaking", nil},
+	{"unloadwallet", nil},
+	{"untagcounterparty", nil},
 	{"validateaddress", []any{(*types.ValidateAddressWalletResult)(nil)}},
 	{"validatepredcp0005cf", returnsBool},
 	{"verifymessage", returnsBool},
+	{"verifyownershipproof", []any{(*types.VerifyOwnershipProofResult)(nil)}},
+	{"verifyseed", []any{(*types.VerifySeedResult)(nil)}},
 	{"version", []any{(*map[string]dcrdtypes.VersionResult)(nil)}},
+	{"walletaudit", []any{(*[]types.WalletAuditResult)(nil)}},
 	{"walletinfo", []any{(*types.WalletInfoResult)(nil)}},
 	{"walletislocked", returnsBool},
 	{"walletlock", nil},
 	{"walletpassphrase", nil},
 	{"walletpassphrasechange", nil},
+	{"walletprocesspsdt", []any{(*types.WalletProcessPSDTResult)(nil)}},
 	{"walletpubpassphrasechange", nil},
 }
 
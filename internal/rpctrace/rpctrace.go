@@ -0,0 +1,146 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package rpctrace records the duration of RPC requests, the time they waited
+// on wallet locks, and the database transactions they performed.
+//
+// A Trace is carried by the context of a request, and is updated by the
+// wallet and its database as the request is handled.  Finished traces are
+// kept by a Recorder in a ring buffer of the most recent requests.
+package rpctrace
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Trace records the work performed by a request.  The methods of a nil Trace
+// do nothing.
+type Trace struct {
+	Method    string
+	RequestID string
+	Start     time.Time
+
+	lockWait  atomic.Int64
+	dbViews   atomic.Int32
+	dbUpdates atomic.Int32
+}
+
+// New returns a trace of a request starting now.
+func New(method, requestID string) *Trace {
+	return &Trace{Method: method, RequestID: requestID, Start: time.Now()}
+}
+
+type traceKey struct{}
+
+// WithTrace returns a context carrying the trace.
+func WithTrace(ctx context.Context, t *Trace) context.Context {
+	return context.WithValue(ctx, traceKey{}, t)
+}
+
+// FromContext returns the trace carried by the context, or nil.
+func FromContext(ctx context.Context) *Trace {
+	t, _ := ctx.Value(traceKey{}).(*Trace)
+	return t
+}
+
+// AddLockWait adds to the time the request waited to acquire wallet locks.
+func (t *Trace) AddLockWait(d time.Duration) {
+	if t != nil {
+		t.lockWait.Add(int64(d))
+	}
+}
+
+// AddDBView counts a database read transaction.
+func (t *Trace) AddDBView() {
+	if t != nil {
+		t.dbViews.Add(1)
+	}
+}
+
+// AddDBUpdate counts a database read/write transaction.
+func (t *Trace) AddDBUpdate() {
+	if t != nil {
+		t.dbUpdates.Add(1)
+	}
+}
+
+// Record describes a finished request.
+type Record struct {
+	Method    string
+	RequestID string
+	Start     time.Time
+	Duration  time.Duration
+	LockWait  time.Duration
+	DBViews   int
+	DBUpdates int
+	Error     string
+	Slow      bool
+}
+
+// Recorder keeps the records of the most recent requests.
+type Recorder struct {
+	mu      sync.Mutex
+	records []Record
+	next    int
+	full    bool
+	slow    time.Duration
+}
+
+// NewRecorder returns a recorder keeping the size most recent records.
+// Requests taking at least the slow threshold are marked slow, unless the
+// threshold is zero.
+func NewRecorder(size int, slow time.Duration) *Recorder {
+	return &Recorder{records: make([]Record, size), slow: slow}
+}
+
+// SlowThreshold returns the duration at and above which requests are slow,
+// or zero when requests are never slow.
+func (r *Recorder) SlowThreshold() time.Duration {
+	return r.slow
+}
+
+// Finish records the finished request traced by t, which failed with the
+// error message if it is not empty.
+func (r *Recorder) Finish(t *Trace, errMsg string) Record {
+	d := time.Since(t.Start)
+	rec := Record{
+		Method:    t.Method,
+		RequestID: t.RequestID,
+		Start:     t.Start,
+		Duration:  d,
+		LockWait:  time.Duration(t.lockWait.Load()),
+		DBViews:   int(t.dbViews.Load()),
+		DBUpdates: int(t.dbUpdates.Load()),
+		Error:     errMsg,
+		Slow:      r.slow != 0 && d >= r.slow,
+	}
+	if len(r.records) == 0 {
+		return rec
+	}
+	r.mu.Lock()
+	r.records[r.next] = rec
+	r.next++
+	if r.next == len(r.records) {
+		r.next = 0
+		r.full = true
+	}
+	r.mu.Unlock()
+	return rec
+}
+
+// Records returns the kept records, ordered from the oldest to the most
+// recent request.
+func (r *Recorder) Records() []Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.full {
+		return append([]Record(nil), r.records[:r.next]...)
+	}
+	records := make([]Record, 0, len(r.records))
+	records = append(records, r.records[r.next:]...)
+	return append(records, r.records[:r.next]...)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpctrace
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRecorder(t *testing.T) {
+	r := NewRecorder(2, time.Hour)
+	for _, method := range []string{"a", "b", "c"} {
+		tr := New(method, "")
+		ctx := WithTrace(context.Background(), tr)
+		FromContext(ctx).AddDBView()
+		FromContext(ctx).AddDBUpdate()
+		FromContext(ctx).AddLockWait(time.Second)
+		rec := r.Finish(tr, "")
+		if rec.DBViews != 1 || rec.DBUpdates != 1 || rec.LockWait != time.Second || rec.Slow {
+			t.Errorf("unexpected record %+v", rec)
+		}
+	}
+	records := r.Records()
+	if len(records) != 2 || records[0].Method != "b" || records[1].Method != "c" {
+		t.Errorf("ring buffer kept %+v, want records of b and c", records)
+	}
+
+	// Traces are optional.
+	FromContext(context.Background()).AddDBView()
+
+	r = NewRecorder(1, time.Nanosecond)
+	tr := New("slow", "")
+	tr.Start = tr.Start.Add(-time.Second)
+	if rec := r.Finish(tr, "failed"); !rec.Slow || rec.Error != "failed" {
+		t.Errorf("unexpected slow record %+v", rec)
+	}
+}
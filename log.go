@@ -72,6 +72,25 @@ func setLogLevel(subsystemID string, logLevel string) {
 	logger.SetLevel(level)
 }
 
+// logLevelName returns the name of a log level accepted by setLogLevel.
+func logLevelName(level slog.Level) string {
+	switch level {
+	case slog.LevelTrace:
+		return "trace"
+	case slog.LevelDebug:
+		return "debug"
+	case slog.LevelInfo:
+		return "info"
+	case slog.LevelWarn:
+		return "warn"
+	case slog.LevelError:
+		return "error"
+	case slog.LevelCritical:
+		return "critical"
+	}
+	return "off"
+}
+
 // setLogLevels sets the log level for all subsystem loggers to the passed
 // level.  It also dynamically creates the subsystem loggers as needed, so it
 // can be used to initialize the logging system.
@@ -31,6 +31,24 @@ func ByteToMnemonic(b byte, index int) string {
 	return wordList[bb]
 }
 
+// MnemonicToByte returns the byte encoded by word when found at index.  This is
+// the inverse of ByteToMnemonic.
+func MnemonicToByte(word string, index int) (byte, error) {
+	const op errors.Op = "pgpwordlist.MnemonicToByte"
+
+	b, ok := wordIndexes[strings.ToLower(word)]
+	if !ok {
+		err := errors.Errorf("word %v is not in the PGP word list", word)
+		return 0, errors.E(op, errors.Encoding, err)
+	}
+	if int(b%2) != index%2 {
+		err := errors.Errorf("word %v is not valid at position %v, "+
+			"check for missing words", word, index)
+		return 0, errors.E(op, errors.Encoding, err)
+	}
+	return byte(b / 2), nil
+}
+
 // DecodeMnemonics returns the decoded value that is encoded by words.  Any
 // words that are whitespace are empty are skipped.
 func DecodeMnemonics(words []string) ([]byte, error) {
@@ -43,17 +61,11 @@ func DecodeMnemonics(words []string) ([]byte, error) {
 		if w == "" {
 			continue
 		}
-		b, ok := wordIndexes[strings.ToLower(w)]
-		if !ok {
-			err := errors.Errorf("word %v is not in the PGP word list", w)
-			return nil, errors.E(op, errors.Encoding, err)
-		}
-		if int(b%2) != idx%2 {
-			err := errors.Errorf("word %v is not valid at position %v, "+
-				"check for missing words", w, idx)
-			return nil, errors.E(op, errors.Encoding, err)
+		b, err := MnemonicToByte(w, idx)
+		if err != nil {
+			return nil, errors.E(op, err)
 		}
-		decoded[idx] = byte(b / 2)
+		decoded[idx] = b
 		idx++
 	}
 	return decoded[:idx], nil
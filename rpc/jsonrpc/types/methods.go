@@ -77,6 +77,40 @@ func NewAddMultisigAddressCmd(nRequired int, keys []string, account *string) *Ad
 	}
 }
 
+// AddRecurringPaymentCmd defines the addrecurringpayment JSON-RPC command.
+type AddRecurringPaymentCmd struct {
+	FromAccount string `json:"fromaccount"`
+	Address     string `json:"address"`
+	Amount      string `json:"amount"`
+
+	// Exactly one of IntervalBlocks and Interval must be set.  Interval is
+	// a duration such as "36h", or one of @hourly, @daily and @weekly.
+	IntervalBlocks *int32  `json:"intervalblocks,omitempty"`
+	Interval       *string `json:"interval,omitempty"`
+
+	// Start is the height or Unix time of the first payment, matching the
+	// interval.
+	Start         *int64  `json:"start,omitempty"`
+	CoinType      *uint8  `json:"cointype,omitempty"`
+	FailurePolicy *string `json:"failurepolicy" jsonrpcdefault:"\"retry\""`
+	MinConf       *int    `json:"minconf" jsonrpcdefault:"1"`
+	Comment       *string `json:"comment,omitempty"`
+}
+
+// NewAddRecurringPaymentCmd returns a new instance which can be used to issue
+// an addrecurringpayment JSON-RPC command.
+func NewAddRecurringPaymentCmd(fromAccount, address, amount string,
+	intervalBlocks *int32, interval *string) *AddRecurringPaymentCmd {
+
+	return &AddRecurringPaymentCmd{
+		FromAccount:    fromAccount,
+		Address:        address,
+		Amount:         amount,
+		IntervalBlocks: intervalBlocks,
+		Interval:       interval,
+	}
+}
+
 // AddTransactionCmd manually adds a single mined transaction to the wallet,
 // which may be useful to add a transaction which was mined before a private
 // key was imported.
@@ -87,6 +121,34 @@ type AddTransactionCmd struct {
 	Transaction string `json:"transaction"`
 }
 
+// ApprovePendingCmd defines the approvepending JSON-RPC command.
+type ApprovePendingCmd struct {
+	ID uint32
+}
+
+// NewApprovePendingCmd returns a new instance which can be used to issue an
+// approvepending JSON-RPC command.
+func NewApprovePendingCmd(id uint32) *ApprovePendingCmd {
+	return &ApprovePendingCmd{
+		ID: id,
+	}
+}
+
+// AuditContractCmd defines the auditcontract JSON-RPC command.
+type AuditContractCmd struct {
+	ContractTx string
+	Contract   string
+}
+
+// NewAuditContractCmd returns a new instance which can be used to issue an
+// auditcontract JSON-RPC command.
+func NewAuditContractCmd(contractTx, contract string) *AuditContractCmd {
+	return &AuditContractCmd{
+		ContractTx: contractTx,
+		Contract:   contract,
+	}
+}
+
 // AuditReuseCmd defines the auditreuse JSON-RPC command.
 //
 // This method returns an object keying reused addresses to two or more outputs
@@ -96,6 +158,49 @@ type AuditReuseCmd struct {
 	Since *int32 `json:"since"`
 }
 
+// BackupWalletCmd defines the backupwallet JSON-RPC command.
+type BackupWalletCmd struct {
+	Destination string
+	Passphrase  string
+}
+
+// NewBackupWalletCmd returns a new instance which can be used to issue a
+// backupwallet JSON-RPC command.
+func NewBackupWalletCmd(destination, passphrase string) *BackupWalletCmd {
+	return &BackupWalletCmd{
+		Destination: destination,
+		Passphrase:  passphrase,
+	}
+}
+
+// CombinePSDTCmd defines the combinepsdt JSON-RPC command.
+type CombinePSDTCmd struct {
+	PSDTs []string
+}
+
+// NewCombinePSDTCmd returns a new instance which can be used to issue a
+// combinepsdt JSON-RPC command.
+func NewCombinePSDTCmd(psdts []string) *CombinePSDTCmd {
+	return &CombinePSDTCmd{
+		PSDTs: psdts,
+	}
+}
+
+// CompactWalletCmd defines the compactwallet JSON-RPC command.
+type CompactWalletCmd struct {
+	PruneDepth *int32 `jsonrpcdefault:"0"`
+	Status     *bool  `jsonrpcdefault:"false"`
+}
+
+// NewCompactWalletCmd returns a new instance which can be used to issue a
+// compactwallet JSON-RPC command.
+func NewCompactWalletCmd(pruneDepth *int32, status *bool) *CompactWalletCmd {
+	return &CompactWalletCmd{
+		PruneDepth: pruneDepth,
+		Status:     status,
+	}
+}
+
 // ConsolidateCmd is a type handling custom marshaling and
 // unmarshaling of consolidate JSON wallet extension
 // commands.
@@ -116,6 +221,102 @@ func NewConsolidateCmdWithCoinType(inputs int, acct *string, addr *string, coinT
 	return &ConsolidateCmd{Inputs: inputs, Account: acct, Address: addr, CoinType: coinType}
 }
 
+// CounterpartySummaryCmd defines the counterpartysummary JSON-RPC command.
+type CounterpartySummaryCmd struct {
+	Counterparty *string
+}
+
+// NewCounterpartySummaryCmd returns a new instance which can be used to issue
+// a counterpartysummary JSON-RPC command.
+func NewCounterpartySummaryCmd(counterparty *string) *CounterpartySummaryCmd {
+	return &CounterpartySummaryCmd{Counterparty: counterparty}
+}
+
+// ExportCounterpartiesCmd defines the exportcounterparties JSON-RPC command.
+type ExportCounterpartiesCmd struct{}
+
+// ExportAccountXprivCmd defines the exportaccountxpriv JSON-RPC command.
+type ExportAccountXprivCmd struct {
+	Account    string
+	Passphrase string
+}
+
+// NewExportAccountXprivCmd returns a new instance which can be used to issue
+// an exportaccountxpriv JSON-RPC command.
+func NewExportAccountXprivCmd(account, passphrase string) *ExportAccountXprivCmd {
+	return &ExportAccountXprivCmd{
+		Account:    account,
+		Passphrase: passphrase,
+	}
+}
+
+// ExportHistoryCmd defines the exporthistory JSON-RPC command.
+type ExportHistoryCmd struct {
+	Destination string
+	Format      *string `jsonrpcdefault:"\"csv\""`
+}
+
+// NewExportHistoryCmd returns a new instance which can be used to issue an
+// exporthistory JSON-RPC command.
+func NewExportHistoryCmd(destination string, format *string) *ExportHistoryCmd {
+	return &ExportHistoryCmd{
+		Destination: destination,
+		Format:      format,
+	}
+}
+
+// ExtractSecretCmd defines the extractsecret JSON-RPC command.
+type ExtractSecretCmd struct {
+	RedeemTx   string
+	SecretHash string
+}
+
+// NewExtractSecretCmd returns a new instance which can be used to issue an
+// extractsecret JSON-RPC command.
+func NewExtractSecretCmd(redeemTx, secretHash string) *ExtractSecretCmd {
+	return &ExtractSecretCmd{
+		RedeemTx:   redeemTx,
+		SecretHash: secretHash,
+	}
+}
+
+// ImportCounterpartiesCmd defines the importcounterparties JSON-RPC command.
+type ImportCounterpartiesCmd struct {
+	Tags map[string][]string `jsonrpcusage:"{\"counterparty\":[\"address\",...],...}"`
+}
+
+// NewImportCounterpartiesCmd returns a new instance which can be used to
+// issue an importcounterparties JSON-RPC command.
+func NewImportCounterpartiesCmd(tags map[string][]string) *ImportCounterpartiesCmd {
+	return &ImportCounterpartiesCmd{Tags: tags}
+}
+
+// TagCounterpartyCmd defines the tagcounterparty JSON-RPC command.
+type TagCounterpartyCmd struct {
+	Counterparty string
+	Addresses    []string
+}
+
+// NewTagCounterpartyCmd returns a new instance which can be used to issue a
+// tagcounterparty JSON-RPC command.
+func NewTagCounterpartyCmd(counterparty string, addresses []string) *TagCounterpartyCmd {
+	return &TagCounterpartyCmd{
+		Counterparty: counterparty,
+		Addresses:    addresses,
+	}
+}
+
+// UntagCounterpartyCmd defines the untagcounterparty JSON-RPC command.
+type UntagCounterpartyCmd struct {
+	Addresses []string
+}
+
+// NewUntagCounterpartyCmd returns a new instance which can be used to issue
+// an untagcounterparty JSON-RPC command.
+func NewUntagCounterpartyCmd(addresses []string) *UntagCounterpartyCmd {
+	return &UntagCounterpartyCmd{Addresses: addresses}
+}
+
 // CreateMultisigCmd defines the createmultisig JSON-RPC command.
 type CreateMultisigCmd struct {
 	NRequired int
@@ -140,6 +341,57 @@ type CreateSignatureCmd struct {
 	SerializedTransaction string
 }
 
+// CreateMultisigAccountCmd defines the createmultisigaccount JSON-RPC command.
+type CreateMultisigAccountCmd struct {
+	Account   string
+	NRequired int
+	Xpubs     []string
+}
+
+// NewCreateMultisigAccountCmd returns a new instance which can be used to
+// issue a createmultisigaccount JSON-RPC command.
+func NewCreateMultisigAccountCmd(account string, nRequired int, xpubs []string) *CreateMultisigAccountCmd {
+	return &CreateMultisigAccountCmd{
+		Account:   account,
+		NRequired: nRequired,
+		Xpubs:     xpubs,
+	}
+}
+
+// CreateVaultAccountCmd defines the createvaultaccount JSON-RPC command.
+type CreateVaultAccountCmd struct {
+	Account      string
+	Delay        uint32
+	RecoveryXpub *string
+}
+
+// NewCreateVaultAccountCmd returns a new instance which can be used to issue a
+// createvaultaccount JSON-RPC command.
+func NewCreateVaultAccountCmd(account string, delay uint32, recoveryXpub *string) *CreateVaultAccountCmd {
+	return &CreateVaultAccountCmd{
+		Account:      account,
+		Delay:        delay,
+		RecoveryXpub: recoveryXpub,
+	}
+}
+
+// CreateOwnershipProofCmd defines the createownershipproof JSON-RPC command.
+type CreateOwnershipProofCmd struct {
+	Challenge string
+	Outputs   []dcrdtypes.TransactionInput
+}
+
+// NewCreateOwnershipProofCmd returns a new instance which can be used to issue
+// a createownershipproof JSON-RPC command.
+func NewCreateOwnershipProofCmd(challenge string,
+	outputs []dcrdtypes.TransactionInput) *CreateOwnershipProofCmd {
+
+	return &CreateOwnershipProofCmd{
+		Challenge: challenge,
+		Outputs:   outputs,
+	}
+}
+
 // CreateNewAccountCmd defines the createnewaccount JSON-RPC command.
 type CreateNewAccountCmd struct {
 	Account string
@@ -153,6 +405,131 @@ func NewCreateNewAccountCmd(account string) *CreateNewAccountCmd {
 	}
 }
 
+// CreateContractCmd defines the createcontract JSON-RPC command.
+type CreateContractCmd struct {
+	Account    string
+	Recipient  string
+	Amount     string
+	CoinType   *uint8 `jsonrpcdefault:"0"`
+	SecretHash *string
+	LockTime   *int64
+}
+
+// NewCreateContractCmd returns a new instance which can be used to issue a
+// createcontract JSON-RPC command.
+func NewCreateContractCmd(account, recipient, amount string, coinType *uint8,
+	secretHash *string, lockTime *int64) *CreateContractCmd {
+
+	return &CreateContractCmd{
+		Account:    account,
+		Recipient:  recipient,
+		Amount:     amount,
+		CoinType:   coinType,
+		SecretHash: secretHash,
+		LockTime:   lockTime,
+	}
+}
+
+// CreateInvoiceCmd defines the createinvoice JSON-RPC command.
+type CreateInvoiceCmd struct {
+	Amount   string
+	CoinType *uint8  `jsonrpcdefault:"0"`
+	Account  *string `jsonrpcdefault:"\"default\""`
+	Label    *string
+	Expires  *int64
+}
+
+// NewCreateInvoiceCmd returns a new instance which can be used to issue a
+// createinvoice JSON-RPC command.
+func NewCreateInvoiceCmd(amount string, coinType *uint8, account, label *string,
+	expires *int64) *CreateInvoiceCmd {
+
+	return &CreateInvoiceCmd{
+		Amount:   amount,
+		CoinType: coinType,
+		Account:  account,
+		Label:    label,
+		Expires:  expires,
+	}
+}
+
+// CreatePaymentURICmd defines the createpaymenturi JSON-RPC command.
+type CreatePaymentURICmd struct {
+	Address  string
+	Amount   *string
+	CoinType *uint8 `jsonrpcdefault:"0"`
+	Label    *string
+	Message  *string
+	Expires  *int64
+}
+
+// NewCreatePaymentURICmd returns a new instance which can be used to issue a
+// createpaymenturi JSON-RPC command.
+func NewCreatePaymentURICmd(address string, amount *string, coinType *uint8,
+	label, message *string, expires *int64) *CreatePaymentURICmd {
+
+	return &CreatePaymentURICmd{
+		Address:  address,
+		Amount:   amount,
+		CoinType: coinType,
+		Label:    label,
+		Message:  message,
+		Expires:  expires,
+	}
+}
+
+// CreateWatchOnlyWalletCmd defines the createwatchonlywallet JSON-RPC command.
+type CreateWatchOnlyWalletCmd struct {
+	Xpubs         []string
+	PubPassphrase *string
+}
+
+// NewCreateWatchOnlyWalletCmd returns a new instance which can be used to
+// issue a createwatchonlywallet JSON-RPC command.
+func NewCreateWatchOnlyWalletCmd(xpubs []string, pubPassphrase *string) *CreateWatchOnlyWalletCmd {
+	return &CreateWatchOnlyWalletCmd{
+		Xpubs:         xpubs,
+		PubPassphrase: pubPassphrase,
+	}
+}
+
+// CreateRPCCredentialCmd defines the createrpccredential JSON-RPC command.
+type CreateRPCCredentialCmd struct {
+	Username string
+	Scopes   []string
+}
+
+// NewCreateRPCCredentialCmd returns a new instance which can be used to issue
+// a createrpccredential JSON-RPC command.
+func NewCreateRPCCredentialCmd(username string, scopes []string) *CreateRPCCredentialCmd {
+	return &CreateRPCCredentialCmd{
+		Username: username,
+		Scopes:   scopes,
+	}
+}
+
+// CreateWalletCmd defines the createwallet JSON-RPC command.
+type CreateWalletCmd struct {
+	Name          string
+	Passphrase    string
+	Seed          string
+	PubPassphrase *string
+}
+
+// NewCreateWalletCmd returns a new instance which can be used to issue a
+// createwallet JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewCreateWalletCmd(name, passphrase, seed string, pubPassphrase *string) *CreateWalletCmd {
+	return &CreateWalletCmd{
+		Name:          name,
+		Passphrase:    passphrase,
+		Seed:          seed,
+		PubPassphrase: pubPassphrase,
+	}
+}
+
 // CreateAuthorizedEmissionCmd describes the command and parameters for creating
 // a cryptographically authorized SKA emission transaction with governance-defined parameters.
 type CreateAuthorizedEmissionCmd struct {
@@ -173,6 +550,24 @@ func NewCreateAuthorizedEmissionCmd(coinType uint8, emissionKeyName, passphrase
 	}
 }
 
+// EmitSKACmd defines the emitska JSON-RPC command, which creates and
+// publishes the governance-defined emission of an SKA coin type.
+type EmitSKACmd struct {
+	CoinType        uint8   `json:"cointype"`
+	EmissionKeyName string  `json:"emissionkeyname"`
+	Nonce           *uint64 `json:"nonce" jsonrpcdefault:"1"`
+}
+
+// NewEmitSKACmd returns a new instance which can be used to issue an emitska
+// JSON-RPC command.
+func NewEmitSKACmd(coinType uint8, emissionKeyName string, nonce *uint64) *EmitSKACmd {
+	return &EmitSKACmd{
+		CoinType:        coinType,
+		EmissionKeyName: emissionKeyName,
+		Nonce:           nonce,
+	}
+}
+
 // GenerateEmissionKeyCmd defines the generateemissionkey JSON-RPC command for
 // generating new private keys for SKA emission authorization (primary flow).
 type GenerateEmissionKeyCmd struct {
@@ -230,6 +625,27 @@ func NewImportEmissionKeyCmdNoCoinType(keyName, privateKey, passphrase string) *
 	}
 }
 
+// CreateUnsignedTransactionFileCmd defines the createunsignedtransactionfile
+// JSON-RPC command.
+type CreateUnsignedTransactionFileCmd struct {
+	FromAccount string
+	Amounts     map[string]string `jsonrpcusage:"{\"address\":\"amount\",...}"` // Amounts as strings (preserves precision for SKA)
+	MinConf     *int              `jsonrpcdefault:"1"`
+	CoinType    *uint8
+}
+
+// NewCreateUnsignedTransactionFileCmd returns a new instance which can be used
+// to issue a createunsignedtransactionfile JSON-RPC command.
+func NewCreateUnsignedTransactionFileCmd(fromAccount string, amounts map[string]string,
+	minConf *int, coinType *uint8) *CreateUnsignedTransactionFileCmd {
+	return &CreateUnsignedTransactionFileCmd{
+		FromAccount: fromAccount,
+		Amounts:     amounts,
+		MinConf:     minConf,
+		CoinType:    coinType,
+	}
+}
+
 // CreateVotingAccountCmd is a type for handling custom marshaling and
 // unmarshalling of createvotingaccount JSON-RPC command.
 type CreateVotingAccountCmd struct {
@@ -243,6 +659,21 @@ func NewCreateVotingAccountCmd(name, pubKey string, childIndex *uint32) *CreateV
 	return &CreateVotingAccountCmd{name, pubKey, childIndex}
 }
 
+// DumpAccountPrivKeysCmd defines the dumpaccountprivkeys JSON-RPC command.
+type DumpAccountPrivKeysCmd struct {
+	Account    string
+	Passphrase string
+}
+
+// NewDumpAccountPrivKeysCmd returns a new instance which can be used to issue
+// a dumpaccountprivkeys JSON-RPC command.
+func NewDumpAccountPrivKeysCmd(account, passphrase string) *DumpAccountPrivKeysCmd {
+	return &DumpAccountPrivKeysCmd{
+		Account:    account,
+		Passphrase: passphrase,
+	}
+}
+
 // DumpPrivKeyCmd defines the dumpprivkey JSON-RPC command.
 type DumpPrivKeyCmd struct {
 	Address string
@@ -256,6 +687,36 @@ func NewDumpPrivKeyCmd(address string) *DumpPrivKeyCmd {
 	}
 }
 
+// EstimateSendFeeCmd defines the estimatesendfee JSON-RPC command.
+type EstimateSendFeeCmd struct {
+	FromAccount string            `json:"fromaccount"`
+	Amounts     map[string]string `json:"amounts" jsonrpcusage:"{\"address\":\"amount\",...}"`
+	MinConf     *int              `json:"minconf" jsonrpcdefault:"1"`
+	CoinType    *uint8            `json:"cointype,omitempty"`
+
+	// SubtractFeeFrom lists addresses of Amounts whose outputs pay the
+	// transaction fee, divided evenly between them.
+	SubtractFeeFrom *[]string `json:"subtractfeefrom,omitempty"`
+
+	// FeePreference, when set, estimates the fee at the slow, normal or
+	// fast fee rate estimated by the network backend for the coin type.
+	FeePreference *string `json:"feepreference,omitempty"`
+}
+
+// NewEstimateSendFeeCmd returns a new instance which can be used to issue an
+// estimatesendfee JSON-RPC command.
+func NewEstimateSendFeeCmd(fromAccount string, amounts map[string]string,
+	minConf *int, coinType *uint8, subtractFeeFrom *[]string) *EstimateSendFeeCmd {
+
+	return &EstimateSendFeeCmd{
+		FromAccount:     fromAccount,
+		Amounts:         amounts,
+		MinConf:         minConf,
+		CoinType:        coinType,
+		SubtractFeeFrom: subtractFeeFrom,
+	}
+}
+
 // FundRawTransactionOptions represents the optional inputs to fund
 // a raw transaction.
 type FundRawTransactionOptions struct {
@@ -264,6 +725,21 @@ type FundRawTransactionOptions struct {
 	ConfTarget    *int32   `json:"conf_target"`
 }
 
+// FinalizePSDTCmd defines the finalizepsdt JSON-RPC command.
+type FinalizePSDTCmd struct {
+	PSDT    string
+	Extract *bool `jsonrpcdefault:"true"`
+}
+
+// NewFinalizePSDTCmd returns a new instance which can be used to issue a
+// finalizepsdt JSON-RPC command.
+func NewFinalizePSDTCmd(psdt string, extract *bool) *FinalizePSDTCmd {
+	return &FinalizePSDTCmd{
+		PSDT:    psdt,
+		Extract: extract,
+	}
+}
+
 // FundRawTransactionCmd is a type handling custom marshaling and
 // unmarshaling of fundrawtransaction JSON wallet extension commands.
 type FundRawTransactionCmd struct {
@@ -349,6 +825,37 @@ func NewGetBalanceCmdWithCoinType(account *string, minConf *int, coinType *uint8
 	}
 }
 
+// GetInheritanceCmd defines the getinheritance JSON-RPC command.
+type GetInheritanceCmd struct{}
+
+// NewGetInheritanceCmd returns a new instance which can be used to issue a
+// getinheritance JSON-RPC command.
+func NewGetInheritanceCmd() *GetInheritanceCmd {
+	return &GetInheritanceCmd{}
+}
+
+// GetInvoiceCmd defines the getinvoice JSON-RPC command.
+type GetInvoiceCmd struct {
+	ID uint32
+}
+
+// NewGetInvoiceCmd returns a new instance which can be used to issue a
+// getinvoice JSON-RPC command.
+func NewGetInvoiceCmd(id uint32) *GetInvoiceCmd {
+	return &GetInvoiceCmd{
+		ID: id,
+	}
+}
+
+// GetKDFInfoCmd defines the getkdfinfo JSON-RPC command.
+type GetKDFInfoCmd struct{}
+
+// NewGetKDFInfoCmd returns a new instance which can be used to issue a
+// getkdfinfo JSON-RPC command.
+func NewGetKDFInfoCmd() *GetKDFInfoCmd {
+	return &GetKDFInfoCmd{}
+}
+
 // GetMasterPubkeyCmd is a type handling custom marshaling and unmarshaling of
 // getmasterpubkey JSON wallet extension commands.
 type GetMasterPubkeyCmd struct {
@@ -360,6 +867,68 @@ func NewGetMasterPubkeyCmd(acct *string) *GetMasterPubkeyCmd {
 	return &GetMasterPubkeyCmd{Account: acct}
 }
 
+// GetFeeSummaryCmd defines the getfeesummary JSON-RPC command.
+type GetFeeSummaryCmd struct {
+	Days     *int   `json:"days" jsonrpcdefault:"30"`
+	CoinType *uint8 `json:"cointype,omitempty"`
+}
+
+// NewGetFeeSummaryCmd returns a new instance which can be used to issue a
+// getfeesummary JSON-RPC command.
+func NewGetFeeSummaryCmd(days *int, coinType *uint8) *GetFeeSummaryCmd {
+	return &GetFeeSummaryCmd{
+		Days:     days,
+		CoinType: coinType,
+	}
+}
+
+// GetSKAEmissionHistoryCmd defines the getskaemissionhistory JSON-RPC
+// command.
+type GetSKAEmissionHistoryCmd struct {
+	CoinType *uint8 `json:"cointype,omitempty"`
+}
+
+// NewGetSKAEmissionHistoryCmd returns a new instance which can be used to
+// issue a getskaemissionhistory JSON-RPC command.
+func NewGetSKAEmissionHistoryCmd(coinType *uint8) *GetSKAEmissionHistoryCmd {
+	return &GetSKAEmissionHistoryCmd{CoinType: coinType}
+}
+
+// GetSKASupplyCmd defines the getskasupply JSON-RPC command.
+type GetSKASupplyCmd struct {
+	CoinType *uint8 `json:"cointype,omitempty"`
+}
+
+// NewGetSKASupplyCmd returns a new instance which can be used to issue a
+// getskasupply JSON-RPC command.
+func NewGetSKASupplyCmd(coinType *uint8) *GetSKASupplyCmd {
+	return &GetSKASupplyCmd{CoinType: coinType}
+}
+
+// GetMaxSpendableCmd defines the getmaxspendable JSON-RPC command.
+type GetMaxSpendableCmd struct {
+	Account  string
+	CoinType *uint8 `jsonrpcdefault:"0"`
+	MinConf  *int   `jsonrpcdefault:"1"`
+	FeePerKb *float64
+}
+
+// NewGetMaxSpendableCmd returns a new instance which can be used to issue a
+// getmaxspendable JSON-RPC command.
+func NewGetMaxSpendableCmd(account string, coinType *uint8, minConf *int,
+	feePerKb *float64) *GetMaxSpendableCmd {
+
+	return &GetMaxSpendableCmd{
+		Account:  account,
+		CoinType: coinType,
+		MinConf:  minConf,
+		FeePerKb: feePerKb,
+	}
+}
+
+// GetMigrationHistoryCmd defines the getmigrationhistory JSON-RPC command.
+type GetMigrationHistoryCmd struct{}
+
 // GetMultisigOutInfoCmd is a type handling custom marshaling and
 // unmarshaling of getmultisigoutinfo JSON websocket extension
 // commands.
@@ -454,6 +1023,27 @@ func NewGetReceivedByAddressCmdWithCoinType(address string, minConf *int, coinTy
 	}
 }
 
+// GetRescanStatusCmd defines the getrescanstatus JSON-RPC command.
+type GetRescanStatusCmd struct{}
+
+// GetRPCStatsCmd defines the getrpcstats JSON-RPC command.
+type GetRPCStatsCmd struct {
+	Count  *int `jsonrpcdefault:"20"`
+	Method *string
+}
+
+// NewGetRPCStatsCmd returns a new instance which can be used to issue a
+// getrpcstats JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetRPCStatsCmd(count *int, method *string) *GetRPCStatsCmd {
+	return &GetRPCStatsCmd{
+		Count:  count,
+		Method: method,
+	}
+}
+
 // GetStakeInfoCmd is a type handling custom marshaling and
 // unmarshaling of getstakeinfo JSON wallet extension commands.
 type GetStakeInfoCmd struct {
@@ -464,6 +1054,19 @@ func NewGetStakeInfoCmd() *GetStakeInfoCmd {
 	return &GetStakeInfoCmd{}
 }
 
+// GetStakeStatsCmd defines the getstakestats JSON-RPC command.
+type GetStakeStatsCmd struct {
+	Window *int `jsonrpcdefault:"0"`
+}
+
+// NewGetStakeStatsCmd returns a new instance which can be used to issue a
+// getstakestats JSON-RPC command.
+func NewGetStakeStatsCmd(window *int) *GetStakeStatsCmd {
+	return &GetStakeStatsCmd{
+		Window: window,
+	}
+}
+
 // GetTicketsCmd is a type handling custom marshaling and
 // unmarshaling of gettickets JSON wallet extension
 // commands.
@@ -495,6 +1098,41 @@ func NewGetTransactionCmd(txHash string, includeWatchOnly *bool) *GetTransaction
 	}
 }
 
+// GetTransactionsPageCmd defines the gettransactionspage JSON-RPC command.
+type GetTransactionsPageCmd struct {
+	Cursor      *string `jsonrpcdefault:"\"\""`
+	Limit       *int    `jsonrpcdefault:"100"`
+	CoinType    *uint8
+	TxClass     *string
+	StartHeight *int32
+	EndHeight   *int32
+}
+
+// NewGetTransactionsPageCmd returns a new instance which can be used to issue
+// a gettransactionspage JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetTransactionsPageCmd(cursor *string, limit *int) *GetTransactionsPageCmd {
+	return &GetTransactionsPageCmd{
+		Cursor: cursor,
+		Limit:  limit,
+	}
+}
+
+// GetTxTraceCmd defines the gettxtrace JSON-RPC command.
+type GetTxTraceCmd struct {
+	TxHash string
+}
+
+// NewGetTxTraceCmd returns a new instance which can be used to issue a
+// gettxtrace JSON-RPC command.
+func NewGetTxTraceCmd(txHash string) *GetTxTraceCmd {
+	return &GetTxTraceCmd{
+		TxHash: txHash,
+	}
+}
+
 // GetUnconfirmedBalanceCmd defines the getunconfirmedbalance JSON-RPC command.
 type GetUnconfirmedBalanceCmd struct {
 	Account *string
@@ -557,6 +1195,19 @@ func NewGetVoteChoicesCmd(tickethash *string) *GetVoteChoicesCmd {
 	}
 }
 
+// GetVSPTicketStatusCmd defines the getvspticketstatus JSON-RPC command.
+type GetVSPTicketStatusCmd struct {
+	TicketHash string
+}
+
+// NewGetVSPTicketStatusCmd returns a new instance which can be used to issue a
+// getvspticketstatus JSON-RPC command.
+func NewGetVSPTicketStatusCmd(ticketHash string) *GetVSPTicketStatusCmd {
+	return &GetVSPTicketStatusCmd{
+		TicketHash: ticketHash,
+	}
+}
+
 // GetWalletFeeCmd defines the getwalletfee JSON-RPC command.
 type GetWalletFeeCmd struct {
 	CoinType *int `jsonrpcdefault:"0"`
@@ -576,12 +1227,30 @@ func NewGetWalletFeeCmdWithCoinType(coinType int) *GetWalletFeeCmd {
 	}
 }
 
-// GetVoteFeeConsolidationAddressCmd defines the getvotefeeconsolidationaddress JSON-RPC command.
-type GetVoteFeeConsolidationAddressCmd struct {
-	Account string
+// GetWalletDBVersionCmd defines the getwalletdbversion JSON-RPC command.
+type GetWalletDBVersionCmd struct{}
+
+// NewGetWalletDBVersionCmd returns a new instance which can be used to issue
+// a getwalletdbversion JSON-RPC command.
+func NewGetWalletDBVersionCmd() *GetWalletDBVersionCmd {
+	return &GetWalletDBVersionCmd{}
 }
 
-// NewGetVoteFeeConsolidationAddressCmd returns a new instance which can be used to issue a
+// GetWalletLockStateCmd defines the getwalletlockstate JSON-RPC command.
+type GetWalletLockStateCmd struct{}
+
+// NewGetWalletLockStateCmd returns a new instance which can be used to issue
+// a getwalletlockstate JSON-RPC command.
+func NewGetWalletLockStateCmd() *GetWalletLockStateCmd {
+	return &GetWalletLockStateCmd{}
+}
+
+// GetVoteFeeConsolidationAddressCmd defines the getvotefeeconsolidationaddress JSON-RPC command.
+type GetVoteFeeConsolidationAddressCmd struct {
+	Account string
+}
+
+// NewGetVoteFeeConsolidationAddressCmd returns a new instance which can be used to issue a
 // getvotefeeconsolidationaddress JSON-RPC command.
 func NewGetVoteFeeConsolidationAddressCmd(account string) *GetVoteFeeConsolidationAddressCmd {
 	return &GetVoteFeeConsolidationAddressCmd{
@@ -591,8 +1260,9 @@ func NewGetVoteFeeConsolidationAddressCmd(account string) *GetVoteFeeConsolidati
 
 // SetVoteFeeConsolidationAddressCmd defines the setvotefeeconsolidationaddress JSON-RPC command.
 type SetVoteFeeConsolidationAddressCmd struct {
-	Account string
-	Address string
+	Account  string
+	Address  string
+	External *bool `jsonrpcdefault:"false"`
 }
 
 // NewSetVoteFeeConsolidationAddressCmd returns a new instance which can be used to issue a
@@ -604,6 +1274,28 @@ func NewSetVoteFeeConsolidationAddressCmd(account string, address string) *SetVo
 	}
 }
 
+// CancelScheduledSendCmd defines the cancelscheduledsend JSON-RPC command.
+type CancelScheduledSendCmd struct {
+	ID uint32
+}
+
+// NewCancelScheduledSendCmd returns a new instance which can be used to issue
+// a cancelscheduledsend JSON-RPC command.
+func NewCancelScheduledSendCmd(id uint32) *CancelScheduledSendCmd {
+	return &CancelScheduledSendCmd{ID: id}
+}
+
+// ClearInheritanceCmd defines the clearinheritance JSON-RPC command.
+type ClearInheritanceCmd struct {
+	Account string
+}
+
+// NewClearInheritanceCmd returns a new instance which can be used to issue a
+// clearinheritance JSON-RPC command.
+func NewClearInheritanceCmd(account string) *ClearInheritanceCmd {
+	return &ClearInheritanceCmd{Account: account}
+}
+
 // ClearVoteFeeConsolidationAddressCmd defines the clearvotefeeconsolidationaddress JSON-RPC command.
 type ClearVoteFeeConsolidationAddressCmd struct {
 	Account string
@@ -617,6 +1309,24 @@ func NewClearVoteFeeConsolidationAddressCmd(account string) *ClearVoteFeeConsoli
 	}
 }
 
+// ImportLegacyWalletCmd defines the importlegacywallet JSON-RPC command.
+type ImportLegacyWalletCmd struct {
+	Source        string
+	PubPassphrase *string
+}
+
+// NewImportLegacyWalletCmd returns a new instance which can be used to issue
+// an importlegacywallet JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewImportLegacyWalletCmd(source string, pubPassphrase *string) *ImportLegacyWalletCmd {
+	return &ImportLegacyWalletCmd{
+		Source:        source,
+		PubPassphrase: pubPassphrase,
+	}
+}
+
 // ImportPrivKeyCmd defines the importprivkey JSON-RPC command.
 type ImportPrivKeyCmd struct {
 	PrivKey  string
@@ -667,23 +1377,28 @@ type ImportScriptCmd struct {
 	Hex      string
 	Rescan   *bool `jsonrpcdefault:"true"`
 	ScanFrom *int
+	Account  *string
 }
 
 // NewImportScriptCmd creates a new GetImportScriptCmd.
-func NewImportScriptCmd(hex string, rescan *bool, scanFrom *int) *ImportScriptCmd {
-	return &ImportScriptCmd{hex, rescan, scanFrom}
+func NewImportScriptCmd(hex string, rescan *bool, scanFrom *int, account *string) *ImportScriptCmd {
+	return &ImportScriptCmd{hex, rescan, scanFrom, account}
 }
 
 // ImportXpubCmd is a type for handling custom marshaling and unmarshaling of
 // importxpub JSON-RPC commands.
 type ImportXpubCmd struct {
-	Name string `json:"name"`
-	Xpub string `json:"xpub"`
+	Name     string  `json:"name"`
+	Xpub     string  `json:"xpub"`
+	GapLimit *uint32 `json:"gaplimit"`
+	Rescan   *bool   `json:"rescan" jsonrpcdefault:"true"`
+	ScanFrom *int    `json:"scanfrom"`
 }
 
 // ListAccountsCmd defines the listaccounts JSON-RPC command.
 type ListAccountsCmd struct {
-	MinConf *int `jsonrpcdefault:"1"`
+	MinConf         *int  `jsonrpcdefault:"1"`
+	IncludeArchived *bool `jsonrpcdefault:"false"`
 }
 
 // NewListAccountsCmd returns a new instance which can be used to issue a
@@ -697,6 +1412,73 @@ func NewListAccountsCmd(minConf *int) *ListAccountsCmd {
 	}
 }
 
+// ListInvoicesCmd defines the listinvoices JSON-RPC command.
+type ListInvoicesCmd struct {
+	Status *string
+}
+
+// NewListInvoicesCmd returns a new instance which can be used to issue a
+// listinvoices JSON-RPC command.
+func NewListInvoicesCmd(status *string) *ListInvoicesCmd {
+	return &ListInvoicesCmd{
+		Status: status,
+	}
+}
+
+// ListPendingBroadcastsCmd defines the listpendingbroadcasts JSON-RPC command.
+type ListPendingBroadcastsCmd struct{}
+
+// NewListPendingBroadcastsCmd returns a new instance which can be used to
+// issue a listpendingbroadcasts JSON-RPC command.
+func NewListPendingBroadcastsCmd() *ListPendingBroadcastsCmd {
+	return &ListPendingBroadcastsCmd{}
+}
+
+// ListPendingSendsCmd defines the listpendingsends JSON-RPC command.
+type ListPendingSendsCmd struct{}
+
+// NewListPendingSendsCmd returns a new instance which can be used to issue a
+// listpendingsends JSON-RPC command.
+func NewListPendingSendsCmd() *ListPendingSendsCmd {
+	return &ListPendingSendsCmd{}
+}
+
+// ListSpendLimitsCmd defines the listspendlimits JSON-RPC command.
+type ListSpendLimitsCmd struct{}
+
+// NewListSpendLimitsCmd returns a new instance which can be used to issue a
+// listspendlimits JSON-RPC command.
+func NewListSpendLimitsCmd() *ListSpendLimitsCmd {
+	return &ListSpendLimitsCmd{}
+}
+
+// ListWalletsCmd defines the listwallets JSON-RPC command.
+type ListWalletsCmd struct{}
+
+// NewListWalletsCmd returns a new instance which can be used to issue a
+// listwallets JSON-RPC command.
+func NewListWalletsCmd() *ListWalletsCmd {
+	return &ListWalletsCmd{}
+}
+
+// LoadWalletCmd defines the loadwallet JSON-RPC command.
+type LoadWalletCmd struct {
+	Name          string
+	PubPassphrase *string
+}
+
+// NewLoadWalletCmd returns a new instance which can be used to issue a
+// loadwallet JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewLoadWalletCmd(name string, pubPassphrase *string) *LoadWalletCmd {
+	return &LoadWalletCmd{
+		Name:          name,
+		PubPassphrase: pubPassphrase,
+	}
+}
+
 // ListLockUnspentCmd defines the listlockunspent JSON-RPC command.
 type ListLockUnspentCmd struct {
 	Account *string
@@ -783,6 +1565,34 @@ func NewListAllTransactionsCmd(account *string) *ListAllTransactionsCmd {
 	}
 }
 
+// ListRPCCredentialsCmd defines the listrpccredentials JSON-RPC command.
+type ListRPCCredentialsCmd struct{}
+
+// NewListRPCCredentialsCmd returns a new instance which can be used to issue
+// a listrpccredentials JSON-RPC command.
+func NewListRPCCredentialsCmd() *ListRPCCredentialsCmd {
+	return &ListRPCCredentialsCmd{}
+}
+
+// ListRecurringPaymentsCmd defines the listrecurringpayments JSON-RPC
+// command.
+type ListRecurringPaymentsCmd struct{}
+
+// NewListRecurringPaymentsCmd returns a new instance which can be used to
+// issue a listrecurringpayments JSON-RPC command.
+func NewListRecurringPaymentsCmd() *ListRecurringPaymentsCmd {
+	return &ListRecurringPaymentsCmd{}
+}
+
+// ListScheduledSendsCmd defines the listscheduledsends JSON-RPC command.
+type ListScheduledSendsCmd struct{}
+
+// NewListScheduledSendsCmd returns a new instance which can be used to issue a
+// listscheduledsends JSON-RPC command.
+func NewListScheduledSendsCmd() *ListScheduledSendsCmd {
+	return &ListScheduledSendsCmd{}
+}
+
 // ListSinceBlockCmd defines the listsinceblock JSON-RPC command.
 type ListSinceBlockCmd struct {
 	BlockHash           *string
@@ -809,6 +1619,10 @@ type ListTransactionsCmd struct {
 	Count            *int  `jsonrpcdefault:"10"`
 	From             *int  `jsonrpcdefault:"0"`
 	IncludeWatchOnly *bool `jsonrpcdefault:"false"`
+	CoinType         *uint8
+	TxClass          *string
+	StartHeight      *int32
+	EndHeight        *int32
 }
 
 // NewListTransactionsCmd returns a new instance which can be used to issue a
@@ -873,6 +1687,25 @@ func NewLockUnspentCmd(unlock bool, transactions []dcrdtypes.TransactionInput) *
 	}
 }
 
+// PlanConsolidationCmd defines the planconsolidation JSON-RPC command.
+type PlanConsolidationCmd struct {
+	Inputs   int `json:"inputs"`
+	Account  *string
+	CoinType *uint8 `json:"cointype,omitempty"` // Optional: plan a single coin type (0=VAR, 1-255=SKA)
+}
+
+// PauseRecurringPaymentCmd defines the pauserecurringpayment JSON-RPC
+// command.
+type PauseRecurringPaymentCmd struct {
+	ID uint32
+}
+
+// NewPauseRecurringPaymentCmd returns a new instance which can be used to
+// issue a pauserecurringpayment JSON-RPC command.
+func NewPauseRecurringPaymentCmd(id uint32) *PauseRecurringPaymentCmd {
+	return &PauseRecurringPaymentCmd{ID: id}
+}
+
 // PurchaseTicketCmd is a type handling custom marshaling and
 // unmarshaling of purchaseticket JSON RPC commands.
 type PurchaseTicketCmd struct {
@@ -905,6 +1738,59 @@ type CreateUnsignedTicketResult struct {
 	SplitTx         string   `json:"splittx"`
 }
 
+// RecordPriceCmd defines the recordprice JSON-RPC command.
+type RecordPriceCmd struct {
+	CoinType uint8
+	Currency string
+	Price    string
+	Time     *int64
+}
+
+// NewRecordPriceCmd returns a new instance which can be used to issue a
+// recordprice JSON-RPC command.
+func NewRecordPriceCmd(coinType uint8, currency, price string, t *int64) *RecordPriceCmd {
+	return &RecordPriceCmd{
+		CoinType: coinType,
+		Currency: currency,
+		Price:    price,
+		Time:     t,
+	}
+}
+
+// RecoverUnspentCmd defines the recoverunspent JSON-RPC command.  When
+// BeginHeight is nil, the scan begins at the wallet birthday block unless
+// FullScan is true.
+type RecoverUnspentCmd struct {
+	BeginHeight *int
+	FullScan    *bool `jsonrpcdefault:"false"`
+}
+
+// NewRecoverUnspentCmd returns a new instance which can be used to issue a
+// recoverunspent JSON-RPC command.
+func NewRecoverUnspentCmd(beginHeight *int, fullScan *bool) *RecoverUnspentCmd {
+	return &RecoverUnspentCmd{
+		BeginHeight: beginHeight,
+		FullScan:    fullScan,
+	}
+}
+
+// RedeemContractCmd defines the redeemcontract JSON-RPC command.
+type RedeemContractCmd struct {
+	ContractTx string
+	Contract   string
+	Secret     *string
+}
+
+// NewRedeemContractCmd returns a new instance which can be used to issue a
+// redeemcontract JSON-RPC command.
+func NewRedeemContractCmd(contractTx, contract string, secret *string) *RedeemContractCmd {
+	return &RedeemContractCmd{
+		ContractTx: contractTx,
+		Contract:   contract,
+		Secret:     secret,
+	}
+}
+
 // RedeemMultiSigOutCmd is a type handling custom marshaling and
 // unmarshaling of redeemmultisigout JSON RPC commands.
 type RedeemMultiSigOutCmd struct {
@@ -943,6 +1829,18 @@ func NewRedeemMultiSigOutsCmd(from string, to *string,
 	}
 }
 
+// RemoveRecurringPaymentCmd defines the removerecurringpayment JSON-RPC
+// command.
+type RemoveRecurringPaymentCmd struct {
+	ID uint32
+}
+
+// NewRemoveRecurringPaymentCmd returns a new instance which can be used to
+// issue a removerecurringpayment JSON-RPC command.
+func NewRemoveRecurringPaymentCmd(id uint32) *RemoveRecurringPaymentCmd {
+	return &RemoveRecurringPaymentCmd{ID: id}
+}
+
 // RenameAccountCmd defines the renameaccount JSON-RPC command.
 type RenameAccountCmd struct {
 	OldAccount string
@@ -958,9 +1856,143 @@ func NewRenameAccountCmd(oldAccount, newAccount string) *RenameAccountCmd {
 	}
 }
 
+// ArchiveAccountCmd defines the archiveaccount JSON-RPC command.
+type ArchiveAccountCmd struct {
+	Account string
+}
+
+// NewArchiveAccountCmd returns a new instance which can be used to issue an
+// archiveaccount JSON-RPC command.
+func NewArchiveAccountCmd(account string) *ArchiveAccountCmd {
+	return &ArchiveAccountCmd{
+		Account: account,
+	}
+}
+
+// UnloadWalletCmd defines the unloadwallet JSON-RPC command.
+type UnloadWalletCmd struct {
+	Name string
+}
+
+// NewUnloadWalletCmd returns a new instance which can be used to issue an
+// unloadwallet JSON-RPC command.
+func NewUnloadWalletCmd(name string) *UnloadWalletCmd {
+	return &UnloadWalletCmd{
+		Name: name,
+	}
+}
+
+// UnarchiveAccountCmd defines the unarchiveaccount JSON-RPC command.
+type UnarchiveAccountCmd struct {
+	Account string
+}
+
+// NewUnarchiveAccountCmd returns a new instance which can be used to issue an
+// unarchiveaccount JSON-RPC command.
+func NewUnarchiveAccountCmd(account string) *UnarchiveAccountCmd {
+	return &UnarchiveAccountCmd{
+		Account: account,
+	}
+}
+
 // RescanWalletCmd describes the rescanwallet JSON-RPC request and parameters.
+// When BeginHeight is nil, the rescan begins at the wallet birthday block
+// unless FullScan is true.
 type RescanWalletCmd struct {
-	BeginHeight *int `jsonrpcdefault:"0"`
+	BeginHeight *int
+	FullScan    *bool `jsonrpcdefault:"false"`
+}
+
+// ResumeRecurringPaymentCmd defines the resumerecurringpayment JSON-RPC
+// command.
+type ResumeRecurringPaymentCmd struct {
+	ID uint32
+}
+
+// NewResumeRecurringPaymentCmd returns a new instance which can be used to
+// issue a resumerecurringpayment JSON-RPC command.
+func NewResumeRecurringPaymentCmd(id uint32) *ResumeRecurringPaymentCmd {
+	return &ResumeRecurringPaymentCmd{ID: id}
+}
+
+// RestoreWalletCmd defines the restorewallet JSON-RPC command.
+type RestoreWalletCmd struct {
+	Source        string
+	Passphrase    string
+	PubPassphrase *string
+}
+
+// NewRestoreWalletCmd returns a new instance which can be used to issue a
+// restorewallet JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewRestoreWalletCmd(source, passphrase string, pubPassphrase *string) *RestoreWalletCmd {
+	return &RestoreWalletCmd{
+		Source:        source,
+		Passphrase:    passphrase,
+		PubPassphrase: pubPassphrase,
+	}
+}
+
+// RejectPendingCmd defines the rejectpending JSON-RPC command.
+type RejectPendingCmd struct {
+	ID uint32
+}
+
+// NewRejectPendingCmd returns a new instance which can be used to issue a
+// rejectpending JSON-RPC command.
+func NewRejectPendingCmd(id uint32) *RejectPendingCmd {
+	return &RejectPendingCmd{
+		ID: id,
+	}
+}
+
+// RevokeRPCCredentialCmd defines the revokerpccredential JSON-RPC command.
+type RevokeRPCCredentialCmd struct {
+	Username string
+}
+
+// NewRevokeRPCCredentialCmd returns a new instance which can be used to issue
+// a revokerpccredential JSON-RPC command.
+func NewRevokeRPCCredentialCmd(username string) *RevokeRPCCredentialCmd {
+	return &RevokeRPCCredentialCmd{
+		Username: username,
+	}
+}
+
+// ScheduleSendCmd defines the schedulesend JSON-RPC command.
+type ScheduleSendCmd struct {
+	FromAccount     string            `json:"fromaccount"`
+	Amounts         map[string]string `json:"amounts" jsonrpcusage:"{\"address\":\"amount\",...}"`
+	NotBefore       *int64            `json:"notbefore,omitempty"`
+	NotBeforeHeight *int32            `json:"notbeforeheight,omitempty"`
+	PreSign         *bool             `json:"presign" jsonrpcdefault:"false"`
+	MinConf         *int              `json:"minconf" jsonrpcdefault:"1"`
+	CoinType        *uint8            `json:"cointype,omitempty"`
+	Comment         *string           `json:"comment,omitempty"`
+
+	// Expiry and ExpireAfter set the expiry height of the transaction.
+	// ExpireAfter is counted from the tip when the transaction is signed.
+	Expiry      *uint32 `json:"expiry,omitempty"`
+	ExpireAfter *uint32 `json:"expireafter,omitempty"`
+
+	// FeePreference, when set, pays the slow, normal or fast fee rate
+	// estimated by the network backend for the coin type.
+	FeePreference *string `json:"feepreference,omitempty"`
+}
+
+// NewScheduleSendCmd returns a new instance which can be used to issue a
+// schedulesend JSON-RPC command.
+func NewScheduleSendCmd(fromAccount string, amounts map[string]string, notBefore *int64,
+	notBeforeHeight *int32) *ScheduleSendCmd {
+
+	return &ScheduleSendCmd{
+		FromAccount:     fromAccount,
+		Amounts:         amounts,
+		NotBefore:       notBefore,
+		NotBeforeHeight: notBeforeHeight,
+	}
 }
 
 // SendFromCmd defines the sendfrom JSON-RPC command.
@@ -972,6 +2004,20 @@ type SendFromCmd struct {
 	Comment     *string
 	CommentTo   *string
 	CoinType    *uint8 `json:"cointype,omitempty"` // Optional: specify coin type (0=VAR, 1-255=SKA)
+
+	// FiatCurrency, when set, denominates Amount in this fiat currency.
+	FiatCurrency *string `json:"fiatcurrency,omitempty"`
+
+	// Expiry and LockTime set the expiry height and lock time of the
+	// transaction.  ExpireAfter instead sets an expiry height allowing the
+	// transaction to be mined only in this number of next blocks.
+	Expiry      *uint32 `json:"expiry,omitempty"`
+	ExpireAfter *uint32 `json:"expireafter,omitempty"`
+	LockTime    *uint32 `json:"locktime,omitempty"`
+
+	// FeePreference, when set, pays the slow, normal or fast fee rate
+	// estimated by the network backend for the coin type.
+	FeePreference *string `json:"feepreference,omitempty"`
 }
 
 // NewSendFromCmd returns a new instance which can be used to issue a sendfrom
@@ -1003,6 +2049,23 @@ func NewSendFromCmdWithCoinType(fromAccount, toAddress, amount string, minConf *
 	}
 }
 
+// SendFromVaultCmd defines the sendfromvault JSON-RPC command.
+type SendFromVaultCmd struct {
+	Account  string            `json:"account"`
+	Amounts  map[string]string `json:"amounts" jsonrpcusage:"{\"address\":\"amount\",...}"`
+	CoinType *uint8            `json:"cointype,omitempty"`
+}
+
+// NewSendFromVaultCmd returns a new instance which can be used to issue a
+// sendfromvault JSON-RPC command.
+func NewSendFromVaultCmd(account string, amounts map[string]string, coinType *uint8) *SendFromVaultCmd {
+	return &SendFromVaultCmd{
+		Account:  account,
+		Amounts:  amounts,
+		CoinType: coinType,
+	}
+}
+
 // SendManyCmd defines the sendmany JSON-RPC command.
 type SendManyCmd struct {
 	FromAccount string            `json:"fromaccount"`
@@ -1010,6 +2073,28 @@ type SendManyCmd struct {
 	MinConf     *int              `json:"minconf" jsonrpcdefault:"1"`
 	Comment     *string           `json:"comment,omitempty"`
 	CoinType    *uint8            `json:"cointype,omitempty"` // Optional: specify coin type (0=VAR, 1-255=SKA)
+
+	// FiatCurrency, when set, denominates Amounts in this fiat currency.
+	FiatCurrency *string `json:"fiatcurrency,omitempty"`
+
+	// SubtractFeeFrom lists addresses of Amounts whose outputs pay the
+	// transaction fee, divided evenly between them.
+	SubtractFeeFrom *[]string `json:"subtractfeefrom,omitempty"`
+
+	// Expiry and LockTime set the expiry height and lock time of the
+	// transaction.  ExpireAfter instead sets an expiry height allowing the
+	// transaction to be mined only in this number of next blocks.
+	Expiry      *uint32 `json:"expiry,omitempty"`
+	ExpireAfter *uint32 `json:"expireafter,omitempty"`
+	LockTime    *uint32 `json:"locktime,omitempty"`
+
+	// FeePreference, when set, pays the slow, normal or fast fee rate
+	// estimated by the network backend for the coin type.
+	FeePreference *string `json:"feepreference,omitempty"`
+
+	// Data, when set, is hex-encoded data carried by an additional null
+	// data (OP_RETURN) output of the transaction.
+	Data *string `json:"data,omitempty"`
 }
 
 // NewSendManyCmd returns a new instance which can be used to issue a sendmany
@@ -1044,6 +2129,24 @@ type SendToAddressCmd struct {
 	Comment   *string `json:"comment,omitempty"`
 	CommentTo *string `json:"commentto,omitempty"`
 	CoinType  *uint8  `json:"cointype,omitempty"` // Optional: specify coin type (0=VAR, 1-255=SKA)
+
+	// FiatCurrency, when set, denominates Amount in this fiat currency.
+	FiatCurrency *string `json:"fiatcurrency,omitempty"`
+
+	// SubtractFeeFromAmount, when true, subtracts the transaction fee from
+	// Amount so the recipient bears the fee.
+	SubtractFeeFromAmount *bool `json:"subtractfeefromamount,omitempty"`
+
+	// Expiry and LockTime set the expiry height and lock time of the
+	// transaction.  ExpireAfter instead sets an expiry height allowing the
+	// transaction to be mined only in this number of next blocks.
+	Expiry      *uint32 `json:"expiry,omitempty"`
+	ExpireAfter *uint32 `json:"expireafter,omitempty"`
+	LockTime    *uint32 `json:"locktime,omitempty"`
+
+	// FeePreference, when set, pays the slow, normal or fast fee rate
+	// estimated by the network backend for the coin type.
+	FeePreference *string `json:"feepreference,omitempty"`
 }
 
 // NewSendToAddressCmd returns a new instance which can be used to issue a
@@ -1097,7 +2200,8 @@ func NewSendToMultiSigCmd(fromaccount string, amount float64, pubkeys []string,
 
 // SendToTreasuryCmd defines the sendtotreasury JSON-RPC command.
 type SendToTreasuryCmd struct {
-	Amount float64
+	Amount      float64
+	FromAccount *string `jsonrpcdefault:"\"default\""`
 }
 
 // NewSendToTreasuryCmd returns a new instance which can be used to issue a
@@ -1123,6 +2227,21 @@ func NewSendFromTreasuryCmd(pubkey string, amounts map[string]float64) *SendFrom
 	}
 }
 
+// SendTSpendCmd defines the sendtspend JSON-RPC command.
+type SendTSpendCmd struct {
+	HexTx string
+	PiKey string
+}
+
+// NewSendTSpendCmd returns a new instance which can be used to issue a
+// sendtspend JSON-RPC command.
+func NewSendTSpendCmd(hexTx, piKey string) *SendTSpendCmd {
+	return &SendTSpendCmd{
+		HexTx: hexTx,
+		PiKey: piKey,
+	}
+}
+
 // SendToBurnCmd defines the sendtoburn JSON-RPC command for permanently
 // burning SKA coins.
 type SendToBurnCmd struct {
@@ -1155,6 +2274,230 @@ type SetDisapprovePercentCmd struct {
 	Percent uint32
 }
 
+// SetTicketBuyerConfigCmd defines the parameters for the setticketbuyerconfig
+// JSON-RPC command.
+type SetTicketBuyerConfigCmd struct {
+	Account  string
+	Target   uint32
+	MaxPrice *float64
+	MaxFee   *float64
+	Reserve  *float64
+}
+
+// NewSetTicketBuyerConfigCmd returns a new instance which can be used to issue
+// a setticketbuyerconfig JSON-RPC command.
+func NewSetTicketBuyerConfigCmd(account string, target uint32, maxPrice, maxFee,
+	reserve *float64) *SetTicketBuyerConfigCmd {
+	return &SetTicketBuyerConfigCmd{
+		Account:  account,
+		Target:   target,
+		MaxPrice: maxPrice,
+		MaxFee:   maxFee,
+		Reserve:  reserve,
+	}
+}
+
+// SetChangeAccountCmd defines the parameters for the setchangeaccount JSON-RPC
+// command.
+type SetChangeAccountCmd struct {
+	Account       string
+	ChangeAccount string
+	CoinType      *int `jsonrpcdefault:"0"`
+}
+
+// NewSetChangeAccountCmd returns a new instance which can be used to issue a
+// setchangeaccount JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSetChangeAccountCmd(account, changeAccount string, coinType *int) *SetChangeAccountCmd {
+	return &SetChangeAccountCmd{
+		Account:       account,
+		ChangeAccount: changeAccount,
+		CoinType:      coinType,
+	}
+}
+
+// SetChangeScriptTypeCmd defines the parameters for the setchangescripttype
+// JSON-RPC command.
+type SetChangeScriptTypeCmd struct {
+	Account    string
+	ScriptType string
+}
+
+// NewSetChangeScriptTypeCmd returns a new instance which can be used to issue
+// a setchangescripttype JSON-RPC command.
+func NewSetChangeScriptTypeCmd(account, scriptType string) *SetChangeScriptTypeCmd {
+	return &SetChangeScriptTypeCmd{
+		Account:    account,
+		ScriptType: scriptType,
+	}
+}
+
+// SetSpendLimitCmd defines the parameters for the setspendlimit JSON-RPC
+// command.
+type SetSpendLimitCmd struct {
+	Account         string
+	Limit           string // Coin amount as string (preserves precision for SKA)
+	CoinType        *uint8 `jsonrpcdefault:"0"`
+	RequireApproval *bool  `jsonrpcdefault:"false"`
+}
+
+// NewSetSpendLimitCmd returns a new instance which can be used to issue a
+// setspendlimit JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSetSpendLimitCmd(account, limit string, coinType *uint8,
+	requireApproval *bool) *SetSpendLimitCmd {
+
+	return &SetSpendLimitCmd{
+		Account:         account,
+		Limit:           limit,
+		CoinType:        coinType,
+		RequireApproval: requireApproval,
+	}
+}
+
+// BlockAddressCmd defines the blockaddress JSON-RPC command.
+type BlockAddressCmd struct {
+	Address string
+	Reason  *string
+}
+
+// NewBlockAddressCmd returns a new instance which can be used to issue a
+// blockaddress JSON-RPC command.
+func NewBlockAddressCmd(address string, reason *string) *BlockAddressCmd {
+	return &BlockAddressCmd{
+		Address: address,
+		Reason:  reason,
+	}
+}
+
+// UnblockAddressCmd defines the unblockaddress JSON-RPC command.
+type UnblockAddressCmd struct {
+	Address string
+}
+
+// NewUnblockAddressCmd returns a new instance which can be used to issue an
+// unblockaddress JSON-RPC command.
+func NewUnblockAddressCmd(address string) *UnblockAddressCmd {
+	return &UnblockAddressCmd{
+		Address: address,
+	}
+}
+
+// SetInheritanceCmd defines the setinheritance JSON-RPC command.
+type SetInheritanceCmd struct {
+	Account   string
+	Address   string
+	DelayDays uint32
+}
+
+// NewSetInheritanceCmd returns a new instance which can be used to issue a
+// setinheritance JSON-RPC command.
+func NewSetInheritanceCmd(account, address string, delayDays uint32) *SetInheritanceCmd {
+	return &SetInheritanceCmd{
+		Account:   account,
+		Address:   address,
+		DelayDays: delayDays,
+	}
+}
+
+// SetLabelThresholdCmd defines the parameters for the setlabelthreshold
+// JSON-RPC command.
+type SetLabelThresholdCmd struct {
+	Threshold string // Coin amount as string (preserves precision for SKA)
+	CoinType  *uint8 `jsonrpcdefault:"0"`
+}
+
+// NewSetLabelThresholdCmd returns a new instance which can be used to issue a
+// setlabelthreshold JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSetLabelThresholdCmd(threshold string, coinType *uint8) *SetLabelThresholdCmd {
+	return &SetLabelThresholdCmd{
+		Threshold: threshold,
+		CoinType:  coinType,
+	}
+}
+
+// SetLogLevelCmd defines the setloglevel JSON-RPC command.
+type SetLogLevelCmd struct {
+	Level     string
+	Subsystem *string
+}
+
+// NewSetLogLevelCmd returns a new instance which can be used to issue a
+// setloglevel JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSetLogLevelCmd(level string, subsystem *string) *SetLogLevelCmd {
+	return &SetLogLevelCmd{
+		Level:     level,
+		Subsystem: subsystem,
+	}
+}
+
+// SetSKASendAccountsCmd defines the parameters for the setskasendaccounts
+// JSON-RPC command.
+type SetSKASendAccountsCmd struct {
+	CoinType uint8
+	Accounts []string
+}
+
+// NewSetSKASendAccountsCmd returns a new instance which can be used to issue a
+// setskasendaccounts JSON-RPC command.
+func NewSetSKASendAccountsCmd(coinType uint8, accounts []string) *SetSKASendAccountsCmd {
+	return &SetSKASendAccountsCmd{
+		CoinType: coinType,
+		Accounts: accounts,
+	}
+}
+
+// GetSendPolicyCmd defines the getsendpolicy JSON-RPC command.
+type GetSendPolicyCmd struct{}
+
+// NewGetSendPolicyCmd returns a new instance which can be used to issue a
+// getsendpolicy JSON-RPC command.
+func NewGetSendPolicyCmd() *GetSendPolicyCmd {
+	return &GetSendPolicyCmd{}
+}
+
+// ChangeAccountsCmd defines the parameters for the changeaccounts JSON-RPC
+// command.
+type ChangeAccountsCmd struct{}
+
+// ChangeScriptTypesCmd defines the parameters for the changescripttypes
+// JSON-RPC command.
+type ChangeScriptTypesCmd struct{}
+
+// SetTicketCompoundingCmd defines the parameters for the setticketcompounding
+// JSON-RPC command.
+type SetTicketCompoundingCmd struct {
+	Account string
+	Enable  bool
+}
+
+// NewSetTicketCompoundingCmd returns a new instance which can be used to issue
+// a setticketcompounding JSON-RPC command.
+func NewSetTicketCompoundingCmd(account string, enable bool) *SetTicketCompoundingCmd {
+	return &SetTicketCompoundingCmd{
+		Account: account,
+		Enable:  enable,
+	}
+}
+
+// TicketBuyerConfigCmd defines the parameters for the ticketbuyerconfig
+// JSON-RPC command.
+type TicketBuyerConfigCmd struct{}
+
+// TicketCompoundingCmd defines the parameters for the ticketcompounding
+// JSON-RPC command.
+type TicketCompoundingCmd struct{}
+
 // TreasuryPolicyCmd defines the parameters for the treasurypolicy JSON-RPC
 // command.
 type TreasuryPolicyCmd struct {
@@ -1241,6 +2584,23 @@ func NewSetVoteChoiceCmd(agendaID, choiceID string, tickethash *string) *SetVote
 	return &SetVoteChoiceCmd{AgendaID: agendaID, ChoiceID: choiceID, TicketHash: tickethash}
 }
 
+// SetVSPCmd defines the setvsp JSON-RPC command.
+type SetVSPCmd struct {
+	Host       string
+	PubKey     string
+	FeeAccount *string `jsonrpcdefault:"\"default\""`
+}
+
+// NewSetVSPCmd returns a new instance which can be used to issue a setvsp
+// JSON-RPC command.
+func NewSetVSPCmd(host, pubKey string, feeAccount *string) *SetVSPCmd {
+	return &SetVSPCmd{
+		Host:       host,
+		PubKey:     pubKey,
+		FeeAccount: feeAccount,
+	}
+}
+
 // SignMessageCmd defines the signmessage JSON-RPC command.
 type SignMessageCmd struct {
 	Address string
@@ -1257,13 +2617,17 @@ func NewSignMessageCmd(address, message string) *SignMessageCmd {
 }
 
 // RawTxInput models the data needed for raw transaction input that is used in
-// the SignRawTransactionCmd struct.  Contains Decred additions.
+// the SignRawTransactionCmd struct.  Contains Decred additions.  The optional
+// Amount, in coins, or SKAValueIn, in atoms, replace the value-in of the
+// transaction input spending a VAR or SKA previous output.
 type RawTxInput struct {
-	Txid         string `json:"txid"`
-	Vout         uint32 `json:"vout"`
-	Tree         int8   `json:"tree"`
-	ScriptPubKey string `json:"scriptPubKey"`
-	RedeemScript string `json:"redeemScript"`
+	Txid         string   `json:"txid"`
+	Vout         uint32   `json:"vout"`
+	Tree         int8     `json:"tree"`
+	ScriptPubKey string   `json:"scriptPubKey"`
+	RedeemScript string   `json:"redeemScript"`
+	Amount       *float64 `json:"amount,omitempty"`
+	SKAValueIn   *string  `json:"skaValueIn,omitempty"`
 }
 
 // SignRawTransactionCmd defines the signrawtransaction JSON-RPC command.
@@ -1304,12 +2668,27 @@ func NewSignRawTransactionsCmd(hexEncodedTxs []string,
 	}
 }
 
+// SignRawTransactionOfflineCmd defines the signrawtransactionoffline JSON-RPC
+// command.
+type SignRawTransactionOfflineCmd struct {
+	File string
+}
+
+// NewSignRawTransactionOfflineCmd returns a new instance which can be used to
+// issue a signrawtransactionoffline JSON-RPC command.
+func NewSignRawTransactionOfflineCmd(file string) *SignRawTransactionOfflineCmd {
+	return &SignRawTransactionOfflineCmd{
+		File: file,
+	}
+}
+
 // SweepAccountCmd defines the sweep account JSON-RPC command.
 type SweepAccountCmd struct {
 	SourceAccount         string
 	DestinationAddress    string
 	RequiredConfirmations *uint32
 	FeePerKb              *float64
+	CoinType              *uint8 `json:"cointype,omitempty"`
 }
 
 // NewSweepAccountCmd returns a new instance which can be used to issue a JSON-RPC SweepAccountCmd command.
@@ -1322,9 +2701,32 @@ func NewSweepAccountCmd(sourceAccount string, destinationAddress string, require
 	}
 }
 
+// NewSweepAccountCmdWithCoinType returns a new instance which can be used to
+// issue a JSON-RPC SweepAccountCmd command sweeping outputs of a coin type.
+func NewSweepAccountCmdWithCoinType(sourceAccount string, destinationAddress string,
+	requiredConfs *uint32, feePerKb *float64, coinType *uint8) *SweepAccountCmd {
+
+	return &SweepAccountCmd{
+		SourceAccount:         sourceAccount,
+		DestinationAddress:    destinationAddress,
+		RequiredConfirmations: requiredConfs,
+		FeePerKb:              feePerKb,
+		CoinType:              coinType,
+	}
+}
+
 // SyncStatusCmd defines the syncstatus JSON-RPC command.
 type SyncStatusCmd struct{}
 
+// WalletAuditCmd defines the walletaudit JSON-RPC command.
+type WalletAuditCmd struct{}
+
+// NewWalletAuditCmd returns a new instance which can be used to issue a
+// walletaudit JSON-RPC command.
+func NewWalletAuditCmd() *WalletAuditCmd {
+	return &WalletAuditCmd{}
+}
+
 // WalletInfoCmd defines the walletinfo JSON-RPC command.
 type WalletInfoCmd struct {
 }
@@ -1353,18 +2755,37 @@ func NewWalletLockCmd() *WalletLockCmd {
 	return &WalletLockCmd{}
 }
 
+// WalletProcessPSDTCmd defines the walletprocesspsdt JSON-RPC command.
+type WalletProcessPSDTCmd struct {
+	PSDT     string
+	Sign     *bool `jsonrpcdefault:"true"`
+	Finalize *bool `jsonrpcdefault:"true"`
+}
+
+// NewWalletProcessPSDTCmd returns a new instance which can be used to issue a
+// walletprocesspsdt JSON-RPC command.
+func NewWalletProcessPSDTCmd(psdt string, sign, finalize *bool) *WalletProcessPSDTCmd {
+	return &WalletProcessPSDTCmd{
+		PSDT:     psdt,
+		Sign:     sign,
+		Finalize: finalize,
+	}
+}
+
 // WalletPassphraseCmd defines the walletpassphrase JSON-RPC command.
 type WalletPassphraseCmd struct {
 	Passphrase string
 	Timeout    int64
+	Mode       *string `jsonrpcdefault:"\"all\""`
 }
 
 // NewWalletPassphraseCmd returns a new instance which can be used to issue a
 // walletpassphrase JSON-RPC command.
-func NewWalletPassphraseCmd(passphrase string, timeout int64) *WalletPassphraseCmd {
+func NewWalletPassphraseCmd(passphrase string, timeout int64, mode *string) *WalletPassphraseCmd {
 	return &WalletPassphraseCmd{
 		Passphrase: passphrase,
 		Timeout:    timeout,
+		Mode:       mode,
 	}
 }
 
@@ -1391,16 +2812,58 @@ type MixOutputCmd struct {
 	Outpoint string `json:"outpoint"`
 }
 
+// DecodePaymentURICmd defines the decodepaymenturi JSON-RPC command.
+type DecodePaymentURICmd struct {
+	URI string
+}
+
+// NewDecodePaymentURICmd returns a new instance which can be used to issue a
+// decodepaymenturi JSON-RPC command.
+func NewDecodePaymentURICmd(uri string) *DecodePaymentURICmd {
+	return &DecodePaymentURICmd{
+		URI: uri,
+	}
+}
+
 // DiscoverUsageCmd defines the discoverusage JSON-RPC command.
 type DiscoverUsageCmd struct {
 	StartBlock       *string `json:"startblock"`
 	DiscoverAccounts *bool   `json:"discoveraccounts"`
 	GapLimit         *uint32 `json:"gaplimit"`
+	FullScan         *bool   `json:"fullscan" jsonrpcdefault:"false"`
 }
 
 // ValidatePreDCP0005CFCmd defines the validatepredcp0005cf JSON-RPC command.
 type ValidatePreDCP0005CFCmd struct{}
 
+// VerifySeedCmd defines the verifyseed JSON-RPC command.
+type VerifySeedCmd struct {
+	Mnemonic string `json:"mnemonic"`
+}
+
+// NewVerifySeedCmd returns a new instance which can be used to issue a
+// verifyseed JSON-RPC command.
+func NewVerifySeedCmd(mnemonic string) *VerifySeedCmd {
+	return &VerifySeedCmd{
+		Mnemonic: mnemonic,
+	}
+}
+
+// VerifyOwnershipProofCmd defines the verifyownershipproof JSON-RPC command.
+type VerifyOwnershipProofCmd struct {
+	Proof     string
+	Challenge string
+}
+
+// NewVerifyOwnershipProofCmd returns a new instance which can be used to issue
+// a verifyownershipproof JSON-RPC command.
+func NewVerifyOwnershipProofCmd(proof, challenge string) *VerifyOwnershipProofCmd {
+	return &VerifyOwnershipProofCmd{
+		Proof:     proof,
+		Challenge: challenge,
+	}
+}
+
 // ImportCfiltersV2Cmd defines the importcfiltersv2 JSON-RPC command.
 type ImportCFiltersV2Cmd struct {
 	StartHeight int32    `json:"startheight"`
@@ -1418,6 +2881,39 @@ type WalletPubPassphraseChangeCmd struct {
 	NewPassphrase string
 }
 
+// SetAccountGapLimitCmd defines the setaccountgaplimit JSON-RPC command
+// arguments.
+type SetAccountGapLimitCmd struct {
+	Account  string
+	GapLimit uint32
+}
+
+// NewSetAccountGapLimitCmd returns a new instance which can be used to issue a
+// setaccountgaplimit JSON-RPC command.
+func NewSetAccountGapLimitCmd(account string, gapLimit uint32) *SetAccountGapLimitCmd {
+	return &SetAccountGapLimitCmd{
+		Account:  account,
+		GapLimit: gapLimit,
+	}
+}
+
+// SetWalletBirthdayCmd defines the setwalletbirthday JSON-RPC command
+// arguments.  Birthday is a block height, or a UNIX timestamp when Timestamp
+// is true.
+type SetWalletBirthdayCmd struct {
+	Birthday  int64
+	Timestamp *bool `jsonrpcdefault:"false"`
+}
+
+// NewSetWalletBirthdayCmd returns a new instance which can be used to issue a
+// setwalletbirthday JSON-RPC command.
+func NewSetWalletBirthdayCmd(birthday int64, timestamp *bool) *SetWalletBirthdayCmd {
+	return &SetWalletBirthdayCmd{
+		Birthday:  birthday,
+		Timestamp: timestamp,
+	}
+}
+
 // SetAccountPassphraseCmd defines the setaccountpassphrase JSON-RPC command
 // arguments.
 type SetAccountPassphraseCmd struct {
@@ -1441,6 +2937,143 @@ type AccountUnlockedCmd struct {
 	Account string
 }
 
+// UnlockStakingCmd defines the unlockstaking JSON-RPC command arguments.
+type UnlockStakingCmd struct {
+	Passphrase string
+}
+
+// NewUnlockStakingCmd returns a new instance which can be used to issue an
+// unlockstaking JSON-RPC command.
+func NewUnlockStakingCmd(passphrase string) *UnlockStakingCmd {
+	return &UnlockStakingCmd{Passphrase: passphrase}
+}
+
+// LockStakingCmd defines the lockstaking JSON-RPC command.
+type LockStakingCmd struct{}
+
+// NewLockStakingCmd returns a new instance which can be used to issue a
+// lockstaking JSON-RPC command.
+func NewLockStakingCmd() *LockStakingCmd {
+	return &LockStakingCmd{}
+}
+
+// SetStakingPassphraseCmd defines the setstakingpassphrase JSON-RPC command
+// arguments.
+type SetStakingPassphraseCmd struct {
+	Passphrase string
+}
+
+// NewSetStakingPassphraseCmd returns a new instance which can be used to
+// issue a setstakingpassphrase JSON-RPC command.
+func NewSetStakingPassphraseCmd(passphrase string) *SetStakingPassphraseCmd {
+	return &SetStakingPassphraseCmd{Passphrase: passphrase}
+}
+
+// SetStakingAccountCmd defines the setstakingaccount JSON-RPC command
+// arguments.
+type SetStakingAccountCmd struct {
+	Account string
+	Staking *bool `jsonrpcdefault:"true"`
+}
+
+// NewSetStakingAccountCmd returns a new instance which can be used to issue a
+// setstakingaccount JSON-RPC command.
+func NewSetStakingAccountCmd(account string, staking *bool) *SetStakingAccountCmd {
+	return &SetStakingAccountCmd{
+		Account: account,
+		Staking: staking,
+	}
+}
+
+// NotifyCoinTypeBalanceCmd defines the websocket-only notifycointypebalance
+// JSON-RPC command arguments.  Once registered, cointypebalance notifications
+// are sent to the client whenever the spendable balance of the coin type
+// changes in any account.
+type NotifyCoinTypeBalanceCmd struct {
+	CoinType uint8 `json:"cointype"`
+	MinConf  *int  `json:"minconf,omitempty" jsonrpcdefault:"1"`
+}
+
+// NewNotifyCoinTypeBalanceCmd returns a new instance which can be used to
+// issue a notifycointypebalance JSON-RPC command.
+func NewNotifyCoinTypeBalanceCmd(coinType uint8, minConf *int) *NotifyCoinTypeBalanceCmd {
+	return &NotifyCoinTypeBalanceCmd{
+		CoinType: coinType,
+		MinConf:  minConf,
+	}
+}
+
+// NotifyTxConflictsCmd defines the websocket-only notifytxconflicts JSON-RPC
+// command.  Once registered, txconflict notifications are sent to the client
+// whenever a transaction double spends unmined wallet transactions.
+type NotifyTxConflictsCmd struct{}
+
+// NewNotifyTxConflictsCmd returns a new instance which can be used to issue a
+// notifytxconflicts JSON-RPC command.
+func NewNotifyTxConflictsCmd() *NotifyTxConflictsCmd {
+	return &NotifyTxConflictsCmd{}
+}
+
+// NotifyInvoicesCmd defines the websocket-only notifyinvoices JSON-RPC
+// command.  Once registered, invoice notifications are sent to the client
+// whenever an invoice is paid or expires.
+type NotifyInvoicesCmd struct{}
+
+// NewNotifyInvoicesCmd returns a new instance which can be used to issue a
+// notifyinvoices JSON-RPC command.
+func NewNotifyInvoicesCmd() *NotifyInvoicesCmd {
+	return &NotifyInvoicesCmd{}
+}
+
+// NotifyRecurringPaymentsCmd defines the websocket-only
+// notifyrecurringpayments JSON-RPC command.  Once registered,
+// recurringpayment notifications are sent to the client after each attempted
+// payment of a recurring payment.
+type NotifyRecurringPaymentsCmd struct{}
+
+// NewNotifyRecurringPaymentsCmd returns a new instance which can be used to
+// issue a notifyrecurringpayments JSON-RPC command.
+func NewNotifyRecurringPaymentsCmd() *NotifyRecurringPaymentsCmd {
+	return &NotifyRecurringPaymentsCmd{}
+}
+
+// NotifyTxConfirmationsCmd defines the websocket-only notifytxconfirmations
+// JSON-RPC command arguments.  Once registered, a confirmationthreshold
+// notification is sent to the client when the transaction reaches the number
+// of confirmations or is removed from the wallet.
+type NotifyTxConfirmationsCmd struct {
+	TxHash        string `json:"txhash"`
+	Confirmations int32  `json:"confirmations"`
+}
+
+// NewNotifyTxConfirmationsCmd returns a new instance which can be used to
+// issue a notifytxconfirmations JSON-RPC command.
+func NewNotifyTxConfirmationsCmd(txHash string, confirmations int32) *NotifyTxConfirmationsCmd {
+	return &NotifyTxConfirmationsCmd{
+		TxHash:        txHash,
+		Confirmations: confirmations,
+	}
+}
+
+// NotifyAccountConfirmationsCmd defines the websocket-only
+// notifyaccountconfirmations JSON-RPC command arguments.  Once registered,
+// confirmationthreshold notifications are sent to the client whenever a
+// transaction receiving outputs to the account reaches the number of
+// confirmations.
+type NotifyAccountConfirmationsCmd struct {
+	Account       string `json:"account"`
+	Confirmations int32  `json:"confirmations"`
+}
+
+// NewNotifyAccountConfirmationsCmd returns a new instance which can be used
+// to issue a notifyaccountconfirmations JSON-RPC command.
+func NewNotifyAccountConfirmationsCmd(account string, confirmations int32) *NotifyAccountConfirmationsCmd {
+	return &NotifyAccountConfirmationsCmd{
+		Account:       account,
+		Confirmations: confirmations,
+	}
+}
+
 // ProcessUnmanagedTicket defines the processunmanagedticket JSON-RPC command arguments.
 type ProcessUnmanagedTicketCmd struct {
 	TicketHash string
@@ -1476,19 +3109,51 @@ func init() {
 		{"accountsyncaddressindex", (*AccountSyncAddressIndexCmd)(nil)},
 		{"accountunlocked", (*AccountUnlockedCmd)(nil)},
 		{"addmultisigaddress", (*AddMultisigAddressCmd)(nil)},
+		{"addrecurringpayment", (*AddRecurringPaymentCmd)(nil)},
 		{"addtransaction", (*AddTransactionCmd)(nil)},
+		{"approvepending", (*ApprovePendingCmd)(nil)},
+		{"archiveaccount", (*ArchiveAccountCmd)(nil)},
+		{"auditcontract", (*AuditContractCmd)(nil)},
 		{"auditreuse", (*AuditReuseCmd)(nil)},
+		{"backupwallet", (*BackupWalletCmd)(nil)},
+		{"blockaddress", (*BlockAddressCmd)(nil)},
+		{"cancelscheduledsend", (*CancelScheduledSendCmd)(nil)},
+		{"changeaccounts", (*ChangeAccountsCmd)(nil)},
+		{"changescripttypes", (*ChangeScriptTypesCmd)(nil)},
+		{"combinepsdt", (*CombinePSDTCmd)(nil)},
+		{"compactwallet", (*CompactWalletCmd)(nil)},
 		{"consolidate", (*ConsolidateCmd)(nil)},
+		{"counterpartysummary", (*CounterpartySummaryCmd)(nil)},
+		{"createcontract", (*CreateContractCmd)(nil)},
 		{"createmultisig", (*CreateMultisigCmd)(nil)},
+		{"createmultisigaccount", (*CreateMultisigAccountCmd)(nil)},
+		{"createvaultaccount", (*CreateVaultAccountCmd)(nil)},
+		{"createownershipproof", (*CreateOwnershipProofCmd)(nil)},
 		{"createnewaccount", (*CreateNewAccountCmd)(nil)},
+		{"createinvoice", (*CreateInvoiceCmd)(nil)},
+		{"createpaymenturi", (*CreatePaymentURICmd)(nil)},
+		{"createrpccredential", (*CreateRPCCredentialCmd)(nil)},
 		{"createauthorizedemission", (*CreateAuthorizedEmissionCmd)(nil)},
 		{"createsignature", (*CreateSignatureCmd)(nil)},
+		{"createunsignedtransactionfile", (*CreateUnsignedTransactionFileCmd)(nil)},
+		{"createwallet", (*CreateWalletCmd)(nil)},
+		{"createwatchonlywallet", (*CreateWatchOnlyWalletCmd)(nil)},
+		{"exportcounterparties", (*ExportCounterpartiesCmd)(nil)},
+		{"exportaccountxpriv", (*ExportAccountXprivCmd)(nil)},
+		{"exporthistory", (*ExportHistoryCmd)(nil)},
+		{"extractsecret", (*ExtractSecretCmd)(nil)},
 		{"generateemissionkey", (*GenerateEmissionKeyCmd)(nil)},
+		{"importcounterparties", (*ImportCounterpartiesCmd)(nil)},
 		{"importemissionkey", (*ImportEmissionKeyCmd)(nil)},
 		{"createvotingaccount", (*CreateVotingAccountCmd)(nil)},
+		{"decodepaymenturi", (*DecodePaymentURICmd)(nil)},
 		{"disapprovepercent", (*DisapprovePercentCmd)(nil)},
 		{"discoverusage", (*DiscoverUsageCmd)(nil)},
+		{"dumpaccountprivkeys", (*DumpAccountPrivKeysCmd)(nil)},
 		{"dumpprivkey", (*DumpPrivKeyCmd)(nil)},
+		{"emitska", (*EmitSKACmd)(nil)},
+		{"estimatesendfee", (*EstimateSendFeeCmd)(nil)},
+		{"finalizepsdt", (*FinalizePSDTCmd)(nil)},
 		{"fundrawtransaction", (*FundRawTransactionCmd)(nil)},
 		{"getaccount", (*GetAccountCmd)(nil)},
 		{"getaccountaddress", (*GetAccountAddressCmd)(nil)},
@@ -1496,21 +3161,40 @@ func init() {
 		{"getbalance", (*GetBalanceCmd)(nil)},
 		{"getcoinbalance", (*GetCoinBalanceCmd)(nil)},
 		{"getcoinjoinsbyacct", (*GetCoinjoinsByAcctCmd)(nil)},
+		{"getfeesummary", (*GetFeeSummaryCmd)(nil)},
+		{"getinheritance", (*GetInheritanceCmd)(nil)},
+		{"getinvoice", (*GetInvoiceCmd)(nil)},
+		{"getkdfinfo", (*GetKDFInfoCmd)(nil)},
 		{"getmasterpubkey", (*GetMasterPubkeyCmd)(nil)},
+		{"getmaxspendable", (*GetMaxSpendableCmd)(nil)},
+		{"getmigrationhistory", (*GetMigrationHistoryCmd)(nil)},
 		{"getmultisigoutinfo", (*GetMultisigOutInfoCmd)(nil)},
 		{"getnewaddress", (*GetNewAddressCmd)(nil)},
 		{"getrawchangeaddress", (*GetRawChangeAddressCmd)(nil)},
 		{"getreceivedbyaccount", (*GetReceivedByAccountCmd)(nil)},
 		{"getreceivedbyaddress", (*GetReceivedByAddressCmd)(nil)},
+		{"getrescanstatus", (*GetRescanStatusCmd)(nil)},
+		{"getrpcstats", (*GetRPCStatsCmd)(nil)},
+		{"getsendpolicy", (*GetSendPolicyCmd)(nil)},
+		{"getskaemissionhistory", (*GetSKAEmissionHistoryCmd)(nil)},
+		{"getskasupply", (*GetSKASupplyCmd)(nil)},
 		{"getstakeinfo", (*GetStakeInfoCmd)(nil)},
+		{"getstakestats", (*GetStakeStatsCmd)(nil)},
 		{"gettickets", (*GetTicketsCmd)(nil)},
 		{"gettransaction", (*GetTransactionCmd)(nil)},
+		{"gettransactionspage", (*GetTransactionsPageCmd)(nil)},
+		{"gettxtrace", (*GetTxTraceCmd)(nil)},
 		{"getunconfirmedbalance", (*GetUnconfirmedBalanceCmd)(nil)},
 		{"getvotechoices", (*GetVoteChoicesCmd)(nil)},
 		{"getvotefeeconsolidationaddress", (*GetVoteFeeConsolidationAddressCmd)(nil)},
+		{"getvspticketstatus", (*GetVSPTicketStatusCmd)(nil)},
+		{"getwalletdbversion", (*GetWalletDBVersionCmd)(nil)},
 		{"getwalletfee", (*GetWalletFeeCmd)(nil)},
+		{"getwalletlockstate", (*GetWalletLockStateCmd)(nil)},
+		{"clearinheritance", (*ClearInheritanceCmd)(nil)},
 		{"clearvotefeeconsolidationaddress", (*ClearVoteFeeConsolidationAddressCmd)(nil)},
 		{"importcfiltersv2", (*ImportCFiltersV2Cmd)(nil)},
+		{"importlegacywallet", (*ImportLegacyWalletCmd)(nil)},
 		{"importprivkey", (*ImportPrivKeyCmd)(nil)},
 		{"importpubkey", (*ImportPubKeyCmd)(nil)},
 		{"importscript", (*ImportScriptCmd)(nil)},
@@ -1519,52 +3203,102 @@ func init() {
 		{"listaddresstransactions", (*ListAddressTransactionsCmd)(nil)},
 		{"listcointypes", (*ListCoinTypesCmd)(nil)},
 		{"listalltransactions", (*ListAllTransactionsCmd)(nil)},
+		{"listinvoices", (*ListInvoicesCmd)(nil)},
 		{"listlockunspent", (*ListLockUnspentCmd)(nil)},
+		{"listpendingbroadcasts", (*ListPendingBroadcastsCmd)(nil)},
+		{"listpendingsends", (*ListPendingSendsCmd)(nil)},
 		{"listreceivedbyaccount", (*ListReceivedByAccountCmd)(nil)},
 		{"listreceivedbyaddress", (*ListReceivedByAddressCmd)(nil)},
+		{"listrecurringpayments", (*ListRecurringPaymentsCmd)(nil)},
+		{"listrpccredentials", (*ListRPCCredentialsCmd)(nil)},
+		{"listscheduledsends", (*ListScheduledSendsCmd)(nil)},
 		{"listsinceblock", (*ListSinceBlockCmd)(nil)},
+		{"listspendlimits", (*ListSpendLimitsCmd)(nil)},
 		{"listtransactions", (*ListTransactionsCmd)(nil)},
 		{"listunspent", (*ListUnspentCmd)(nil)},
+		{"listwallets", (*ListWalletsCmd)(nil)},
+		{"loadwallet", (*LoadWalletCmd)(nil)},
 		{"lockaccount", (*LockAccountCmd)(nil)},
+		{"lockstaking", (*LockStakingCmd)(nil)},
 		{"lockunspent", (*LockUnspentCmd)(nil)},
 		{"mixaccount", (*MixAccountCmd)(nil)},
 		{"mixoutput", (*MixOutputCmd)(nil)},
+		{"pauserecurringpayment", (*PauseRecurringPaymentCmd)(nil)},
+		{"planconsolidation", (*PlanConsolidationCmd)(nil)},
 		{"purchaseticket", (*PurchaseTicketCmd)(nil)},
 		{"processunmanagedticket", (*ProcessUnmanagedTicketCmd)(nil)},
+		{"recordprice", (*RecordPriceCmd)(nil)},
+		{"recoverunspent", (*RecoverUnspentCmd)(nil)},
+		{"redeemcontract", (*RedeemContractCmd)(nil)},
 		{"redeemmultisigout", (*RedeemMultiSigOutCmd)(nil)},
 		{"redeemmultisigouts", (*RedeemMultiSigOutsCmd)(nil)},
+		{"rejectpending", (*RejectPendingCmd)(nil)},
+		{"removerecurringpayment", (*RemoveRecurringPaymentCmd)(nil)},
 		{"renameaccount", (*RenameAccountCmd)(nil)},
 		{"rescanwallet", (*RescanWalletCmd)(nil)},
+		{"restorewallet", (*RestoreWalletCmd)(nil)},
+		{"resumerecurringpayment", (*ResumeRecurringPaymentCmd)(nil)},
+		{"revokerpccredential", (*RevokeRPCCredentialCmd)(nil)},
+		{"schedulesend", (*ScheduleSendCmd)(nil)},
 		{"sendfrom", (*SendFromCmd)(nil)},
 		{"sendfromtreasury", (*SendFromTreasuryCmd)(nil)},
+		{"sendfromvault", (*SendFromVaultCmd)(nil)},
 		{"sendmany", (*SendManyCmd)(nil)},
 		{"sendtoaddress", (*SendToAddressCmd)(nil)},
 		{"sendtomultisig", (*SendToMultiSigCmd)(nil)},
 		{"sendtotreasury", (*SendToTreasuryCmd)(nil)},
 		{"sendtoburn", (*SendToBurnCmd)(nil)},
+		{"sendtspend", (*SendTSpendCmd)(nil)},
+		{"setaccountgaplimit", (*SetAccountGapLimitCmd)(nil)},
 		{"setaccountpassphrase", (*SetAccountPassphraseCmd)(nil)},
+		{"setchangeaccount", (*SetChangeAccountCmd)(nil)},
+		{"setchangescripttype", (*SetChangeScriptTypeCmd)(nil)},
 		{"setdisapprovepercent", (*SetDisapprovePercentCmd)(nil)},
+		{"setinheritance", (*SetInheritanceCmd)(nil)},
+		{"setlabelthreshold", (*SetLabelThresholdCmd)(nil)},
+		{"setloglevel", (*SetLogLevelCmd)(nil)},
+		{"setskasendaccounts", (*SetSKASendAccountsCmd)(nil)},
+		{"setspendlimit", (*SetSpendLimitCmd)(nil)},
+		{"setstakingaccount", (*SetStakingAccountCmd)(nil)},
+		{"setstakingpassphrase", (*SetStakingPassphraseCmd)(nil)},
+		{"setticketbuyerconfig", (*SetTicketBuyerConfigCmd)(nil)},
+		{"setticketcompounding", (*SetTicketCompoundingCmd)(nil)},
 		{"settreasurypolicy", (*SetTreasuryPolicyCmd)(nil)},
 		{"settspendpolicy", (*SetTSpendPolicyCmd)(nil)},
 		{"settxfee", (*SetTxFeeCmd)(nil)},
 		{"setvotechoice", (*SetVoteChoiceCmd)(nil)},
 		{"setvotefeeconsolidationaddress", (*SetVoteFeeConsolidationAddressCmd)(nil)},
+		{"setvsp", (*SetVSPCmd)(nil)},
+		{"setwalletbirthday", (*SetWalletBirthdayCmd)(nil)},
 		{"signmessage", (*SignMessageCmd)(nil)},
 		{"signrawtransaction", (*SignRawTransactionCmd)(nil)},
+		{"signrawtransactionoffline", (*SignRawTransactionOfflineCmd)(nil)},
 		{"signrawtransactions", (*SignRawTransactionsCmd)(nil)},
 		{"spendoutputs", (*SpendOutputsCmd)(nil)},
 		{"sweepaccount", (*SweepAccountCmd)(nil)},
 		{"syncstatus", (*SyncStatusCmd)(nil)},
+		{"tagcounterparty", (*TagCounterpartyCmd)(nil)},
+		{"ticketbuyerconfig", (*TicketBuyerConfigCmd)(nil)},
+		{"ticketcompounding", (*TicketCompoundingCmd)(nil)},
 		{"ticketinfo", (*TicketInfoCmd)(nil)},
 		{"treasurypolicy", (*TreasuryPolicyCmd)(nil)},
 		{"tspendpolicy", (*TSpendPolicyCmd)(nil)},
+		{"unarchiveaccount", (*UnarchiveAccountCmd)(nil)},
+		{"unblockaddress", (*UnblockAddressCmd)(nil)},
 		{"unlockaccount", (*UnlockAccountCmd)(nil)},
+		{"unlockstaking", (*UnlockStakingCmd)(nil)},
+		{"unloadwallet", (*UnloadWalletCmd)(nil)},
+		{"untagcounterparty", (*UntagCounterpartyCmd)(nil)},
 		{"validatepredcp0005cf", (*ValidatePreDCP0005CFCmd)(nil)},
+		{"verifyownershipproof", (*VerifyOwnershipProofCmd)(nil)},
+		{"verifyseed", (*VerifySeedCmd)(nil)},
+		{"walletaudit", (*WalletAuditCmd)(nil)},
 		{"walletinfo", (*WalletInfoCmd)(nil)},
 		{"walletislocked", (*WalletIsLockedCmd)(nil)},
 		{"walletlock", (*WalletLockCmd)(nil)},
 		{"walletpassphrase", (*WalletPassphraseCmd)(nil)},
 		{"walletpassphrasechange", (*WalletPassphraseChangeCmd)(nil)},
+		{"walletprocesspsdt", (*WalletProcessPSDTCmd)(nil)},
 		{"walletpubpassphrasechange", (*WalletPubPassphraseChangeCmd)(nil)},
 	}
 	for i := range register {
@@ -1599,6 +3333,12 @@ func init() {
 	// Websocket-specific methods implemented by dcrwallet
 	register = []registeredMethod{
 		{"authenticate", (*AuthenticateCmd)(nil)},
+		{"notifycointypebalance", (*NotifyCoinTypeBalanceCmd)(nil)},
+		{"notifytxconflicts", (*NotifyTxConflictsCmd)(nil)},
+		{"notifyinvoices", (*NotifyInvoicesCmd)(nil)},
+		{"notifyrecurringpayments", (*NotifyRecurringPaymentsCmd)(nil)},
+		{"notifytxconfirmations", (*NotifyTxConfirmationsCmd)(nil)},
+		{"notifyaccountconfirmations", (*NotifyAccountConfirmationsCmd)(nil)},
 	}
 	for i := range register {
 		dcrjson.MustRegister(Method(register[i].method), register[i].cmd,
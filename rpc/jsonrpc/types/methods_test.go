@@ -64,922 +64,2396 @@ func TestWalletSvrCmds(t *testing.T) {
 			},
 		},
 		{
-			name: "createmultisig",
+			name: "archiveaccount",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("createmultisig"), 2, []string{"031234", "035678"})
+				return dcrjson.NewCmd(Method("archiveaccount"), "acct")
 			},
 			staticCmd: func() any {
-				keys := []string{"031234", "035678"}
-				return NewCreateMultisigCmd(2, keys)
+				return NewArchiveAccountCmd("acct")
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"createmultisig","params":[2,["031234","035678"]],"id":1}`,
-			unmarshalled: &CreateMultisigCmd{
-				NRequired: 2,
-				Keys:      []string{"031234", "035678"},
+			marshalled: `{"jsonrpc":"1.0","method":"archiveaccount","params":["acct"],"id":1}`,
+			unmarshalled: &ArchiveAccountCmd{
+				Account: "acct",
 			},
 		},
 		{
-			name: "createnewaccount",
+			name: "backupwallet",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("createnewaccount"), "acct")
+				return dcrjson.NewCmd(Method("backupwallet"), "wallet.bak", "pass")
 			},
 			staticCmd: func() any {
-				return NewCreateNewAccountCmd("acct")
+				return NewBackupWalletCmd("wallet.bak", "pass")
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"createnewaccount","params":["acct"],"id":1}`,
-			unmarshalled: &CreateNewAccountCmd{
-				Account: "acct",
+			marshalled: `{"jsonrpc":"1.0","method":"backupwallet","params":["wallet.bak","pass"],"id":1}`,
+			unmarshalled: &BackupWalletCmd{
+				Destination: "wallet.bak",
+				Passphrase:  "pass",
 			},
 		},
 		{
-			name: "dumpprivkey",
+			name: "combinepsdt",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("dumpprivkey"), "1Address")
+				return dcrjson.NewCmd(Method("combinepsdt"), []string{"cHNkdP8=", "cHNkdP8="})
 			},
 			staticCmd: func() any {
-				return NewDumpPrivKeyCmd("1Address")
+				return NewCombinePSDTCmd([]string{"cHNkdP8=", "cHNkdP8="})
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"dumpprivkey","params":["1Address"],"id":1}`,
-			unmarshalled: &DumpPrivKeyCmd{
-				Address: "1Address",
+			marshalled: `{"jsonrpc":"1.0","method":"combinepsdt","params":[["cHNkdP8=","cHNkdP8="]],"id":1}`,
+			unmarshalled: &CombinePSDTCmd{
+				PSDTs: []string{"cHNkdP8=", "cHNkdP8="},
 			},
 		},
 		{
-			name: "getaccount",
+			name: "compactwallet",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("getaccount"), "1Address")
+				return dcrjson.NewCmd(Method("compactwallet"))
 			},
 			staticCmd: func() any {
-				return NewGetAccountCmd("1Address")
+				return NewCompactWalletCmd(nil, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getaccount","params":["1Address"],"id":1}`,
-			unmarshalled: &GetAccountCmd{
-				Address: "1Address",
+			marshalled: `{"jsonrpc":"1.0","method":"compactwallet","params":[],"id":1}`,
+			unmarshalled: &CompactWalletCmd{
+				PruneDepth: dcrjson.Int32(0),
+				Status:     dcrjson.Bool(false),
 			},
 		},
 		{
-			name: "getaccountaddress",
+			name: "compactwallet optional",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("getaccountaddress"), "acct")
+				return dcrjson.NewCmd(Method("compactwallet"), 8192, true)
 			},
 			staticCmd: func() any {
-				return NewGetAccountAddressCmd("acct")
+				return NewCompactWalletCmd(dcrjson.Int32(8192), dcrjson.Bool(true))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getaccountaddress","params":["acct"],"id":1}`,
-			unmarshalled: &GetAccountAddressCmd{
-				Account: "acct",
+			marshalled: `{"jsonrpc":"1.0","method":"compactwallet","params":[8192,true],"id":1}`,
+			unmarshalled: &CompactWalletCmd{
+				PruneDepth: dcrjson.Int32(8192),
+				Status:     dcrjson.Bool(true),
 			},
 		},
 		{
-			name: "getaddressesbyaccount",
+			name: "createmultisig",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("getaddressesbyaccount"), "acct")
+				return dcrjson.NewCmd(Method("createmultisig"), 2, []string{"031234", "035678"})
 			},
 			staticCmd: func() any {
-				return NewGetAddressesByAccountCmd("acct")
+				keys := []string{"031234", "035678"}
+				return NewCreateMultisigCmd(2, keys)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getaddressesbyaccount","params":["acct"],"id":1}`,
-			unmarshalled: &GetAddressesByAccountCmd{
-				Account: "acct",
+			marshalled: `{"jsonrpc":"1.0","method":"createmultisig","params":[2,["031234","035678"]],"id":1}`,
+			unmarshalled: &CreateMultisigCmd{
+				NRequired: 2,
+				Keys:      []string{"031234", "035678"},
 			},
 		},
 		{
-			name: "getbalance",
+			name: "createmultisigaccount",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("getbalance"))
+				return dcrjson.NewCmd(Method("createmultisigaccount"), "acct", 2, []string{"tpubA", "tpubB"})
 			},
 			staticCmd: func() any {
-				return NewGetBalanceCmd(nil, nil)
+				xpubs := []string{"tpubA", "tpubB"}
+				return NewCreateMultisigAccountCmd("acct", 2, xpubs)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getbalance","params":[],"id":1}`,
-			unmarshalled: &GetBalanceCmd{
-				Account: nil,
-				MinConf: dcrjson.Int(1),
+			marshalled: `{"jsonrpc":"1.0","method":"createmultisigaccount","params":["acct",2,["tpubA","tpubB"]],"id":1}`,
+			unmarshalled: &CreateMultisigAccountCmd{
+				Account:   "acct",
+				NRequired: 2,
+				Xpubs:     []string{"tpubA", "tpubB"},
 			},
 		},
 		{
-			name: "getbalance optional1",
+			name: "createvaultaccount",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("getbalance"), "acct")
+				return dcrjson.NewCmd(Method("createvaultaccount"), "acct", 144, "tpubA")
 			},
 			staticCmd: func() any {
-				return NewGetBalanceCmd(dcrjson.String("acct"), nil)
+				return NewCreateVaultAccountCmd("acct", 144, dcrjson.String("tpubA"))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getbalance","params":["acct"],"id":1}`,
-			unmarshalled: &GetBalanceCmd{
-				Account: dcrjson.String("acct"),
-				MinConf: dcrjson.Int(1),
+			marshalled: `{"jsonrpc":"1.0","method":"createvaultaccount","params":["acct",144,"tpubA"],"id":1}`,
+			unmarshalled: &CreateVaultAccountCmd{
+				Account:      "acct",
+				Delay:        144,
+				RecoveryXpub: dcrjson.String("tpubA"),
 			},
 		},
 		{
-			name: "getbalance optional2",
+			name: "createnewaccount",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("getbalance"), "acct", 6)
+				return dcrjson.NewCmd(Method("createnewaccount"), "acct")
 			},
 			staticCmd: func() any {
-				return NewGetBalanceCmd(dcrjson.String("acct"), dcrjson.Int(6))
+				return NewCreateNewAccountCmd("acct")
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getbalance","params":["acct",6],"id":1}`,
-			unmarshalled: &GetBalanceCmd{
-				Account: dcrjson.String("acct"),
-				MinConf: dcrjson.Int(6),
+			marshalled: `{"jsonrpc":"1.0","method":"createnewaccount","params":["acct"],"id":1}`,
+			unmarshalled: &CreateNewAccountCmd{
+				Account: "acct",
 			},
 		},
 		{
-			name: "getnewaddress",
+			name: "createinvoice",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("getnewaddress"))
+				return dcrjson.NewCmd(Method("createinvoice"), "1.5")
 			},
 			staticCmd: func() any {
-				return NewGetNewAddressCmd(nil, nil)
+				return NewCreateInvoiceCmd("1.5", nil, nil, nil, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getnewaddress","params":[],"id":1}`,
-			unmarshalled: &GetNewAddressCmd{
-				Account:   nil,
-				GapPolicy: nil,
+			marshalled: `{"jsonrpc":"1.0","method":"createinvoice","params":["1.5"],"id":1}`,
+			unmarshalled: &CreateInvoiceCmd{
+				Amount:   "1.5",
+				CoinType: func() *uint8 { ct := uint8(0); return &ct }(),
+				Account:  dcrjson.String("default"),
 			},
 		},
 		{
-			name: "getnewaddress optional",
+			name: "createinvoice optional",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("getnewaddress"), "acct", "ignore")
+				return dcrjson.NewCmd(Method("createinvoice"), "1.5", 1, "shop",
+					"order", 1700000000)
 			},
 			staticCmd: func() any {
-				return NewGetNewAddressCmd(dcrjson.String("acct"), dcrjson.String("ignore"))
+				return NewCreateInvoiceCmd("1.5",
+					func() *uint8 { ct := uint8(1); return &ct }(),
+					dcrjson.String("shop"), dcrjson.String("order"),
+					dcrjson.Int64(1700000000))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getnewaddress","params":["acct","ignore"],"id":1}`,
-			unmarshalled: &GetNewAddressCmd{
-				Account:   dcrjson.String("acct"),
-				GapPolicy: dcrjson.String("ignore"),
+			marshalled: `{"jsonrpc":"1.0","method":"createinvoice","params":["1.5",1,"shop","order",1700000000],"id":1}`,
+			unmarshalled: &CreateInvoiceCmd{
+				Amount:   "1.5",
+				CoinType: func() *uint8 { ct := uint8(1); return &ct }(),
+				Account:  dcrjson.String("shop"),
+				Label:    dcrjson.String("order"),
+				Expires:  dcrjson.Int64(1700000000),
 			},
 		},
 		{
-			name: "getrawchangeaddress",
+			name: "createpaymenturi",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("getrawchangeaddress"))
+				return dcrjson.NewCmd(Method("createpaymenturi"), "Ss")
 			},
 			staticCmd: func() any {
-				return NewGetRawChangeAddressCmd(nil)
+				return NewCreatePaymentURICmd("Ss", nil, nil, nil, nil, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getrawchangeaddress","params":[],"id":1}`,
-			unmarshalled: &GetRawChangeAddressCmd{
-				Account: nil,
+			marshalled: `{"jsonrpc":"1.0","method":"createpaymenturi","params":["Ss"],"id":1}`,
+			unmarshalled: &CreatePaymentURICmd{
+				Address:  "Ss",
+				CoinType: func() *uint8 { ct := uint8(0); return &ct }(),
 			},
 		},
 		{
-			name: "getrawchangeaddress optional",
+			name: "createpaymenturi optional",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("getrawchangeaddress"), "acct")
+				return dcrjson.NewCmd(Method("createpaymenturi"), "Ss", "1.5", 1,
+					"shop", "order", 1700000000)
 			},
 			staticCmd: func() any {
-				return NewGetRawChangeAddressCmd(dcrjson.String("acct"))
+				return NewCreatePaymentURICmd("Ss", dcrjson.String("1.5"),
+					func() *uint8 { ct := uint8(1); return &ct }(),
+					dcrjson.String("shop"), dcrjson.String("order"),
+					dcrjson.Int64(1700000000))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getrawchangeaddress","params":["acct"],"id":1}`,
-			unmarshalled: &GetRawChangeAddressCmd{
-				Account: dcrjson.String("acct"),
+			marshalled: `{"jsonrpc":"1.0","method":"createpaymenturi","params":["Ss","1.5",1,"shop","order",1700000000],"id":1}`,
+			unmarshalled: &CreatePaymentURICmd{
+				Address:  "Ss",
+				Amount:   dcrjson.String("1.5"),
+				CoinType: func() *uint8 { ct := uint8(1); return &ct }(),
+				Label:    dcrjson.String("shop"),
+				Message:  dcrjson.String("order"),
+				Expires:  dcrjson.Int64(1700000000),
 			},
 		},
 		{
-			name: "getreceivedbyaccount",
+			name: "createunsignedtransactionfile",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("getreceivedbyaccount"), "acct")
+				return dcrjson.NewCmd(Method("createunsignedtransactionfile"), "from", `{"1Address":"0.5"}`)
 			},
 			staticCmd: func() any {
-				return NewGetReceivedByAccountCmd("acct", nil)
+				amounts := map[string]string{"1Address": "0.5"}
+				return NewCreateUnsignedTransactionFileCmd("from", amounts, nil, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getreceivedbyaccount","params":["acct"],"id":1}`,
-			unmarshalled: &GetReceivedByAccountCmd{
-				Account: "acct",
-				MinConf: dcrjson.Int(1),
+			marshalled: `{"jsonrpc":"1.0","method":"createunsignedtransactionfile","params":["from",{"1Address":"0.5"}],"id":1}`,
+			unmarshalled: &CreateUnsignedTransactionFileCmd{
+				FromAccount: "from",
+				Amounts:     map[string]string{"1Address": "0.5"},
+				MinConf:     dcrjson.Int(1),
 			},
 		},
 		{
-			name: "getreceivedbyaccount optional",
+			name: "createunsignedtransactionfile optional",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("getreceivedbyaccount"), "acct", 6)
+				return dcrjson.NewCmd(Method("createunsignedtransactionfile"), "from", `{"1Address":"0.5"}`, 6, 1)
 			},
 			staticCmd: func() any {
-				return NewGetReceivedByAccountCmd("acct", dcrjson.Int(6))
+				amounts := map[string]string{"1Address": "0.5"}
+				coinType := uint8(1)
+				return NewCreateUnsignedTransactionFileCmd("from", amounts, dcrjson.Int(6), &coinType)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getreceivedbyaccount","params":["acct",6],"id":1}`,
-			unmarshalled: &GetReceivedByAccountCmd{
-				Account: "acct",
-				MinConf: dcrjson.Int(6),
+			marshalled: `{"jsonrpc":"1.0","method":"createunsignedtransactionfile","params":["from",{"1Address":"0.5"},6,1],"id":1}`,
+			unmarshalled: &CreateUnsignedTransactionFileCmd{
+				FromAccount: "from",
+				Amounts:     map[string]string{"1Address": "0.5"},
+				MinConf:     dcrjson.Int(6),
+				CoinType:    func() *uint8 { ct := uint8(1); return &ct }(),
 			},
 		},
 		{
-			name: "getreceivedbyaddress",
+			name: "createwatchonlywallet",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("getreceivedbyaddress"), "1Address")
+				return dcrjson.NewCmd(Method("createwatchonlywallet"), []string{"xpub1", "xpub2"}, "public")
 			},
 			staticCmd: func() any {
-				return NewGetReceivedByAddressCmd("1Address", nil)
+				return NewCreateWatchOnlyWalletCmd([]string{"xpub1", "xpub2"}, dcrjson.String("public"))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getreceivedbyaddress","params":["1Address"],"id":1}`,
-			unmarshalled: &GetReceivedByAddressCmd{
-				Address:  "1Address",
-				MinConf:  dcrjson.Int(1),
-				CoinType: dcrjson.Int(0), // Default CoinType is 0 (VAR)
+			marshalled: `{"jsonrpc":"1.0","method":"createwatchonlywallet","params":[["xpub1","xpub2"],"public"],"id":1}`,
+			unmarshalled: &CreateWatchOnlyWalletCmd{
+				Xpubs:         []string{"xpub1", "xpub2"},
+				PubPassphrase: dcrjson.String("public"),
 			},
 		},
 		{
-			name: "getreceivedbyaddress optional",
+			name: "decodepaymenturi",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("getreceivedbyaddress"), "1Address", 6)
+				return dcrjson.NewCmd(Method("decodepaymenturi"), "monetarium:Ss")
 			},
 			staticCmd: func() any {
-				return NewGetReceivedByAddressCmd("1Address", dcrjson.Int(6))
+				return NewDecodePaymentURICmd("monetarium:Ss")
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getreceivedbyaddress","params":["1Address",6],"id":1}`,
-			unmarshalled: &GetReceivedByAddressCmd{
-				Address:  "1Address",
-				MinConf:  dcrjson.Int(6),
-				CoinType: dcrjson.Int(0), // Default CoinType is 0 (VAR)
+			marshalled: `{"jsonrpc":"1.0","method":"decodepaymenturi","params":["monetarium:Ss"],"id":1}`,
+			unmarshalled: &DecodePaymentURICmd{
+				URI: "monetarium:Ss",
 			},
 		},
 		{
-			name: "gettransaction",
+			name: "dumpaccountprivkeys",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("gettransaction"), "123")
+				return dcrjson.NewCmd(Method("dumpaccountprivkeys"), "default", "pass")
 			},
 			staticCmd: func() any {
-				return NewGetTransactionCmd("123", nil)
+				return NewDumpAccountPrivKeysCmd("default", "pass")
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"gettransaction","params":["123"],"id":1}`,
-			unmarshalled: &GetTransactionCmd{
-				Txid:             "123",
-				IncludeWatchOnly: dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"dumpaccountprivkeys","params":["default","pass"],"id":1}`,
+			unmarshalled: &DumpAccountPrivKeysCmd{
+				Account:    "default",
+				Passphrase: "pass",
 			},
 		},
 		{
-			name: "gettransaction optional",
+			name: "dumpprivkey",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("gettransaction"), "123", true)
+				return dcrjson.NewCmd(Method("dumpprivkey"), "1Address")
 			},
 			staticCmd: func() any {
-				return NewGetTransactionCmd("123", dcrjson.Bool(true))
+				return NewDumpPrivKeyCmd("1Address")
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"gettransaction","params":["123",true],"id":1}`,
-			unmarshalled: &GetTransactionCmd{
-				Txid:             "123",
-				IncludeWatchOnly: dcrjson.Bool(true),
+			marshalled: `{"jsonrpc":"1.0","method":"dumpprivkey","params":["1Address"],"id":1}`,
+			unmarshalled: &DumpPrivKeyCmd{
+				Address: "1Address",
 			},
 		},
 		{
-			name: "importprivkey",
+			name: "emitska",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("importprivkey"), "abc")
+				return dcrjson.NewCmd(Method("emitska"), 1, "key")
 			},
 			staticCmd: func() any {
-				return NewImportPrivKeyCmd("abc", nil, nil, nil)
+				return NewEmitSKACmd(1, "key", nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"importprivkey","params":["abc"],"id":1}`,
-			unmarshalled: &ImportPrivKeyCmd{
-				PrivKey: "abc",
-				Label:   nil,
-				Rescan:  dcrjson.Bool(true),
+			marshalled: `{"jsonrpc":"1.0","method":"emitska","params":[1,"key"],"id":1}`,
+			unmarshalled: &EmitSKACmd{
+				CoinType:        1,
+				EmissionKeyName: "key",
+				Nonce:           dcrjson.Uint64(1),
 			},
 		},
 		{
-			name: "importprivkey optional1",
+			name: "emitska optional",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("importprivkey"), "abc", "label")
+				return dcrjson.NewCmd(Method("emitska"), 1, "key", 2)
 			},
 			staticCmd: func() any {
-				return NewImportPrivKeyCmd("abc", dcrjson.String("label"), nil, nil)
+				return NewEmitSKACmd(1, "key", dcrjson.Uint64(2))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"importprivkey","params":["abc","label"],"id":1}`,
-			unmarshalled: &ImportPrivKeyCmd{
-				PrivKey: "abc",
-				Label:   dcrjson.String("label"),
-				Rescan:  dcrjson.Bool(true),
+			marshalled: `{"jsonrpc":"1.0","method":"emitska","params":[1,"key",2],"id":1}`,
+			unmarshalled: &EmitSKACmd{
+				CoinType:        1,
+				EmissionKeyName: "key",
+				Nonce:           dcrjson.Uint64(2),
 			},
 		},
 		{
-			name: "importprivkey optional2",
+			name: "estimatesendfee",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("importprivkey"), "abc", "label", false)
+				return dcrjson.NewCmd(Method("estimatesendfee"), "from", `{"1Address":"0.5"}`)
 			},
 			staticCmd: func() any {
-				return NewImportPrivKeyCmd("abc", dcrjson.String("label"), dcrjson.Bool(false), nil)
+				amounts := map[string]string{"1Address": "0.5"}
+				return NewEstimateSendFeeCmd("from", amounts, nil, nil, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"importprivkey","params":["abc","label",false],"id":1}`,
-			unmarshalled: &ImportPrivKeyCmd{
-				PrivKey: "abc",
-				Label:   dcrjson.String("label"),
-				Rescan:  dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"estimatesendfee","params":["from",{"1Address":"0.5"}],"id":1}`,
+			unmarshalled: &EstimateSendFeeCmd{
+				FromAccount: "from",
+				Amounts:     map[string]string{"1Address": "0.5"},
+				MinConf:     dcrjson.Int(1),
 			},
 		},
 		{
-			name: "importprivkey optional3",
+			name: "estimatesendfee optional",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("importprivkey"), "abc", "label", false, 12345)
+				return dcrjson.NewCmd(Method("estimatesendfee"), "from", `{"1Address":"0.5"}`, 6,
+					1, `["1Address"]`)
 			},
 			staticCmd: func() any {
-				return NewImportPrivKeyCmd("abc", dcrjson.String("label"), dcrjson.Bool(false), dcrjson.Int(12345))
+				amounts := map[string]string{"1Address": "0.5"}
+				return NewEstimateSendFeeCmd("from", amounts, dcrjson.Int(6),
+					uint8Ptr(1), &[]string{"1Address"})
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"importprivkey","params":["abc","label",false,12345],"id":1}`,
-			unmarshalled: &ImportPrivKeyCmd{
-				PrivKey:  "abc",
-				Label:    dcrjson.String("label"),
-				Rescan:   dcrjson.Bool(false),
-				ScanFrom: dcrjson.Int(12345),
+			marshalled: `{"jsonrpc":"1.0","method":"estimatesendfee","params":["from",{"1Address":"0.5"},6,1,["1Address"]],"id":1}`,
+			unmarshalled: &EstimateSendFeeCmd{
+				FromAccount:     "from",
+				Amounts:         map[string]string{"1Address": "0.5"},
+				MinConf:         dcrjson.Int(6),
+				CoinType:        uint8Ptr(1),
+				SubtractFeeFrom: &[]string{"1Address"},
 			},
 		},
 		{
-			name: "importpubkey",
+			name: "exporthistory",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("importpubkey"), "abc", "label", false, 12345)
+				return dcrjson.NewCmd(Method("exporthistory"), "history.csv")
 			},
 			staticCmd: func() any {
-				return NewImportPubKeyCmd("abc", dcrjson.String("label"), dcrjson.Bool(false), dcrjson.Int(12345))
+				return NewExportHistoryCmd("history.csv", nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"importpubkey","params":["abc","label",false,12345],"id":1}`,
-			unmarshalled: &ImportPubKeyCmd{
-				PubKey:   "abc",
-				Label:    dcrjson.String("label"),
-				Rescan:   dcrjson.Bool(false),
-				ScanFrom: dcrjson.Int(12345),
+			marshalled: `{"jsonrpc":"1.0","method":"exporthistory","params":["history.csv"],"id":1}`,
+			unmarshalled: &ExportHistoryCmd{
+				Destination: "history.csv",
+				Format:      dcrjson.String("csv"),
 			},
 		},
 		{
-			name: "listaccounts",
+			name: "exporthistory optional",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listaccounts"))
+				return dcrjson.NewCmd(Method("exporthistory"), "history.json", "json")
 			},
 			staticCmd: func() any {
-				return NewListAccountsCmd(nil)
+				return NewExportHistoryCmd("history.json", dcrjson.String("json"))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listaccounts","params":[],"id":1}`,
-			unmarshalled: &ListAccountsCmd{
-				MinConf: dcrjson.Int(1),
+			marshalled: `{"jsonrpc":"1.0","method":"exporthistory","params":["history.json","json"],"id":1}`,
+			unmarshalled: &ExportHistoryCmd{
+				Destination: "history.json",
+				Format:      dcrjson.String("json"),
 			},
 		},
 		{
-			name: "listaccounts optional",
+			name: "finalizepsdt",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listaccounts"), 6)
+				return dcrjson.NewCmd(Method("finalizepsdt"), "cHNkdP8=")
 			},
 			staticCmd: func() any {
-				return NewListAccountsCmd(dcrjson.Int(6))
+				return NewFinalizePSDTCmd("cHNkdP8=", nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listaccounts","params":[6],"id":1}`,
-			unmarshalled: &ListAccountsCmd{
-				MinConf: dcrjson.Int(6),
+			marshalled: `{"jsonrpc":"1.0","method":"finalizepsdt","params":["cHNkdP8="],"id":1}`,
+			unmarshalled: &FinalizePSDTCmd{
+				PSDT:    "cHNkdP8=",
+				Extract: dcrjson.Bool(true),
 			},
 		},
 		{
-			name: "listlockunspent",
+			name: "getaccount",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listlockunspent"))
+				return dcrjson.NewCmd(Method("getaccount"), "1Address")
 			},
 			staticCmd: func() any {
-				return NewListLockUnspentCmd()
+				return NewGetAccountCmd("1Address")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaccount","params":["1Address"],"id":1}`,
+			unmarshalled: &GetAccountCmd{
+				Address: "1Address",
 			},
-			marshalled:   `{"jsonrpc":"1.0","method":"listlockunspent","params":[],"id":1}`,
-			unmarshalled: &ListLockUnspentCmd{},
 		},
 		{
-			name: "listreceivedbyaccount",
+			name: "getaccountaddress",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listreceivedbyaccount"))
+				return dcrjson.NewCmd(Method("getaccountaddress"), "acct")
 			},
 			staticCmd: func() any {
-				return NewListReceivedByAccountCmd(nil, nil, nil)
+				return NewGetAccountAddressCmd("acct")
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaccount","params":[],"id":1}`,
-			unmarshalled: &ListReceivedByAccountCmd{
-				MinConf:          dcrjson.Int(1),
-				IncludeEmpty:     dcrjson.Bool(false),
-				IncludeWatchOnly: dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"getaccountaddress","params":["acct"],"id":1}`,
+			unmarshalled: &GetAccountAddressCmd{
+				Account: "acct",
 			},
 		},
 		{
-			name: "listreceivedbyaccount optional1",
+			name: "getaddressesbyaccount",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listreceivedbyaccount"), 6)
+				return dcrjson.NewCmd(Method("getaddressesbyaccount"), "acct")
 			},
 			staticCmd: func() any {
-				return NewListReceivedByAccountCmd(dcrjson.Int(6), nil, nil)
+				return NewGetAddressesByAccountCmd("acct")
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaccount","params":[6],"id":1}`,
-			unmarshalled: &ListReceivedByAccountCmd{
-				MinConf:          dcrjson.Int(6),
-				IncludeEmpty:     dcrjson.Bool(false),
-				IncludeWatchOnly: dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"getaddressesbyaccount","params":["acct"],"id":1}`,
+			unmarshalled: &GetAddressesByAccountCmd{
+				Account: "acct",
+			},
+		},
+		{
+			name: "getbalance",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getbalance"))
+			},
+			staticCmd: func() any {
+				return NewGetBalanceCmd(nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getbalance","params":[],"id":1}`,
+			unmarshalled: &GetBalanceCmd{
+				Account: nil,
+				MinConf: dcrjson.Int(1),
+			},
+		},
+		{
+			name: "getbalance optional1",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getbalance"), "acct")
+			},
+			staticCmd: func() any {
+				return NewGetBalanceCmd(dcrjson.String("acct"), nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getbalance","params":["acct"],"id":1}`,
+			unmarshalled: &GetBalanceCmd{
+				Account: dcrjson.String("acct"),
+				MinConf: dcrjson.Int(1),
+			},
+		},
+		{
+			name: "getbalance optional2",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getbalance"), "acct", 6)
+			},
+			staticCmd: func() any {
+				return NewGetBalanceCmd(dcrjson.String("acct"), dcrjson.Int(6))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getbalance","params":["acct",6],"id":1}`,
+			unmarshalled: &GetBalanceCmd{
+				Account: dcrjson.String("acct"),
+				MinConf: dcrjson.Int(6),
+			},
+		},
+		{
+			name: "getinvoice",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getinvoice"), 3)
+			},
+			staticCmd: func() any {
+				return NewGetInvoiceCmd(3)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getinvoice","params":[3],"id":1}`,
+			unmarshalled: &GetInvoiceCmd{
+				ID: 3,
+			},
+		},
+		{
+			name: "getkdfinfo",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getkdfinfo"))
+			},
+			staticCmd: func() any {
+				return NewGetKDFInfoCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getkdfinfo","params":[],"id":1}`,
+			unmarshalled: &GetKDFInfoCmd{},
+		},
+		{
+			name: "createcontract",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("createcontract"), "default", "Ssaddr", "1.5")
+			},
+			staticCmd: func() any {
+				return NewCreateContractCmd("default", "Ssaddr", "1.5", nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"createcontract","params":["default","Ssaddr","1.5"],"id":1}`,
+			unmarshalled: &CreateContractCmd{
+				Account:   "default",
+				Recipient: "Ssaddr",
+				Amount:    "1.5",
+				CoinType:  uint8Ptr(0),
+			},
+		},
+		{
+			name: "createcontract optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("createcontract"), "default", "Ssaddr", "1.5", 1, "00ff", 1000)
+			},
+			staticCmd: func() any {
+				return NewCreateContractCmd("default", "Ssaddr", "1.5", uint8Ptr(1),
+					dcrjson.String("00ff"), dcrjson.Int64(1000))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"createcontract","params":["default","Ssaddr","1.5",1,"00ff",1000],"id":1}`,
+			unmarshalled: &CreateContractCmd{
+				Account:    "default",
+				Recipient:  "Ssaddr",
+				Amount:     "1.5",
+				CoinType:   uint8Ptr(1),
+				SecretHash: dcrjson.String("00ff"),
+				LockTime:   dcrjson.Int64(1000),
+			},
+		},
+		{
+			name: "redeemcontract",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("redeemcontract"), "01", "02", "03")
+			},
+			staticCmd: func() any {
+				return NewRedeemContractCmd("01", "02", dcrjson.String("03"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"redeemcontract","params":["01","02","03"],"id":1}`,
+			unmarshalled: &RedeemContractCmd{
+				ContractTx: "01",
+				Contract:   "02",
+				Secret:     dcrjson.String("03"),
+			},
+		},
+		{
+			name: "auditcontract",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("auditcontract"), "01", "02")
+			},
+			staticCmd: func() any {
+				return NewAuditContractCmd("01", "02")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"auditcontract","params":["01","02"],"id":1}`,
+			unmarshalled: &AuditContractCmd{
+				ContractTx: "01",
+				Contract:   "02",
+			},
+		},
+		{
+			name: "extractsecret",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("extractsecret"), "01", "00ff")
+			},
+			staticCmd: func() any {
+				return NewExtractSecretCmd("01", "00ff")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"extractsecret","params":["01","00ff"],"id":1}`,
+			unmarshalled: &ExtractSecretCmd{
+				RedeemTx:   "01",
+				SecretHash: "00ff",
+			},
+		},
+		{
+			name: "getfeesummary",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getfeesummary"))
+			},
+			staticCmd: func() any {
+				return NewGetFeeSummaryCmd(nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getfeesummary","params":[],"id":1}`,
+			unmarshalled: &GetFeeSummaryCmd{
+				Days: dcrjson.Int(30),
+			},
+		},
+		{
+			name: "getfeesummary optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getfeesummary"), 7, 1)
+			},
+			staticCmd: func() any {
+				return NewGetFeeSummaryCmd(dcrjson.Int(7), uint8Ptr(1))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getfeesummary","params":[7,1],"id":1}`,
+			unmarshalled: &GetFeeSummaryCmd{
+				Days:     dcrjson.Int(7),
+				CoinType: uint8Ptr(1),
+			},
+		},
+		{
+			name: "getskaemissionhistory",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getskaemissionhistory"))
+			},
+			staticCmd: func() any {
+				return NewGetSKAEmissionHistoryCmd(nil)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getskaemissionhistory","params":[],"id":1}`,
+			unmarshalled: &GetSKAEmissionHistoryCmd{},
+		},
+		{
+			name: "getskasupply optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getskasupply"), 2)
+			},
+			staticCmd: func() any {
+				return NewGetSKASupplyCmd(uint8Ptr(2))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getskasupply","params":[2],"id":1}`,
+			unmarshalled: &GetSKASupplyCmd{
+				CoinType: uint8Ptr(2),
+			},
+		},
+		{
+			name: "getmaxspendable",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getmaxspendable"), "acct")
+			},
+			staticCmd: func() any {
+				return NewGetMaxSpendableCmd("acct", nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmaxspendable","params":["acct"],"id":1}`,
+			unmarshalled: &GetMaxSpendableCmd{
+				Account:  "acct",
+				CoinType: uint8Ptr(0),
+				MinConf:  dcrjson.Int(1),
+			},
+		},
+		{
+			name: "getmaxspendable optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getmaxspendable"), "acct", 1, 6, 0.05)
+			},
+			staticCmd: func() any {
+				return NewGetMaxSpendableCmd("acct", uint8Ptr(1), dcrjson.Int(6),
+					func(i float64) *float64 { return &i }(0.05))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmaxspendable","params":["acct",1,6,0.05],"id":1}`,
+			unmarshalled: &GetMaxSpendableCmd{
+				Account:  "acct",
+				CoinType: uint8Ptr(1),
+				MinConf:  dcrjson.Int(6),
+				FeePerKb: func(i float64) *float64 { return &i }(0.05),
+			},
+		},
+		{
+			name: "getnewaddress",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getnewaddress"))
+			},
+			staticCmd: func() any {
+				return NewGetNewAddressCmd(nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getnewaddress","params":[],"id":1}`,
+			unmarshalled: &GetNewAddressCmd{
+				Account:   nil,
+				GapPolicy: nil,
+			},
+		},
+		{
+			name: "getnewaddress optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getnewaddress"), "acct", "ignore")
+			},
+			staticCmd: func() any {
+				return NewGetNewAddressCmd(dcrjson.String("acct"), dcrjson.String("ignore"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getnewaddress","params":["acct","ignore"],"id":1}`,
+			unmarshalled: &GetNewAddressCmd{
+				Account:   dcrjson.String("acct"),
+				GapPolicy: dcrjson.String("ignore"),
+			},
+		},
+		{
+			name: "getrawchangeaddress",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getrawchangeaddress"))
+			},
+			staticCmd: func() any {
+				return NewGetRawChangeAddressCmd(nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getrawchangeaddress","params":[],"id":1}`,
+			unmarshalled: &GetRawChangeAddressCmd{
+				Account: nil,
+			},
+		},
+		{
+			name: "getrawchangeaddress optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getrawchangeaddress"), "acct")
+			},
+			staticCmd: func() any {
+				return NewGetRawChangeAddressCmd(dcrjson.String("acct"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getrawchangeaddress","params":["acct"],"id":1}`,
+			unmarshalled: &GetRawChangeAddressCmd{
+				Account: dcrjson.String("acct"),
+			},
+		},
+		{
+			name: "getreceivedbyaccount",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getreceivedbyaccount"), "acct")
+			},
+			staticCmd: func() any {
+				return NewGetReceivedByAccountCmd("acct", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getreceivedbyaccount","params":["acct"],"id":1}`,
+			unmarshalled: &GetReceivedByAccountCmd{
+				Account: "acct",
+				MinConf: dcrjson.Int(1),
+			},
+		},
+		{
+			name: "getreceivedbyaccount optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getreceivedbyaccount"), "acct", 6)
+			},
+			staticCmd: func() any {
+				return NewGetReceivedByAccountCmd("acct", dcrjson.Int(6))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getreceivedbyaccount","params":["acct",6],"id":1}`,
+			unmarshalled: &GetReceivedByAccountCmd{
+				Account: "acct",
+				MinConf: dcrjson.Int(6),
+			},
+		},
+		{
+			name: "getreceivedbyaddress",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getreceivedbyaddress"), "1Address")
+			},
+			staticCmd: func() any {
+				return NewGetReceivedByAddressCmd("1Address", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getreceivedbyaddress","params":["1Address"],"id":1}`,
+			unmarshalled: &GetReceivedByAddressCmd{
+				Address:  "1Address",
+				MinConf:  dcrjson.Int(1),
+				CoinType: dcrjson.Int(0), // Default CoinType is 0 (VAR)
+			},
+		},
+		{
+			name: "getreceivedbyaddress optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getreceivedbyaddress"), "1Address", 6)
+			},
+			staticCmd: func() any {
+				return NewGetReceivedByAddressCmd("1Address", dcrjson.Int(6))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getreceivedbyaddress","params":["1Address",6],"id":1}`,
+			unmarshalled: &GetReceivedByAddressCmd{
+				Address:  "1Address",
+				MinConf:  dcrjson.Int(6),
+				CoinType: dcrjson.Int(0), // Default CoinType is 0 (VAR)
+			},
+		},
+		{
+			name: "getstakestats",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getstakestats"))
+			},
+			staticCmd: func() any {
+				return NewGetStakeStatsCmd(nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getstakestats","params":[],"id":1}`,
+			unmarshalled: &GetStakeStatsCmd{
+				Window: dcrjson.Int(0),
+			},
+		},
+		{
+			name: "getstakestats optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getstakestats"), 8640)
+			},
+			staticCmd: func() any {
+				return NewGetStakeStatsCmd(dcrjson.Int(8640))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getstakestats","params":[8640],"id":1}`,
+			unmarshalled: &GetStakeStatsCmd{
+				Window: dcrjson.Int(8640),
+			},
+		},
+		{
+			name: "gettransaction",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("gettransaction"), "123")
+			},
+			staticCmd: func() any {
+				return NewGetTransactionCmd("123", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gettransaction","params":["123"],"id":1}`,
+			unmarshalled: &GetTransactionCmd{
+				Txid:             "123",
+				IncludeWatchOnly: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "gettransaction optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("gettransaction"), "123", true)
+			},
+			staticCmd: func() any {
+				return NewGetTransactionCmd("123", dcrjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gettransaction","params":["123",true],"id":1}`,
+			unmarshalled: &GetTransactionCmd{
+				Txid:             "123",
+				IncludeWatchOnly: dcrjson.Bool(true),
+			},
+		},
+		{
+			name: "gettransactionspage",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("gettransactionspage"))
+			},
+			staticCmd: func() any {
+				return NewGetTransactionsPageCmd(nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gettransactionspage","params":[],"id":1}`,
+			unmarshalled: &GetTransactionsPageCmd{
+				Cursor: dcrjson.String(""),
+				Limit:  dcrjson.Int(100),
+			},
+		},
+		{
+			name: "gettransactionspage optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("gettransactionspage"), "100:abc", 50,
+					1, "regular", 10, 20)
+			},
+			staticCmd: func() any {
+				cmd := NewGetTransactionsPageCmd(dcrjson.String("100:abc"),
+					dcrjson.Int(50))
+				ct := uint8(1)
+				cmd.CoinType = &ct
+				cmd.TxClass = dcrjson.String("regular")
+				cmd.StartHeight = dcrjson.Int32(10)
+				cmd.EndHeight = dcrjson.Int32(20)
+				return cmd
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gettransactionspage","params":["100:abc",50,1,"regular",10,20],"id":1}`,
+			unmarshalled: &GetTransactionsPageCmd{
+				Cursor:      dcrjson.String("100:abc"),
+				Limit:       dcrjson.Int(50),
+				CoinType:    func() *uint8 { ct := uint8(1); return &ct }(),
+				TxClass:     dcrjson.String("regular"),
+				StartHeight: dcrjson.Int32(10),
+				EndHeight:   dcrjson.Int32(20),
+			},
+		},
+		{
+			name: "gettxtrace",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("gettxtrace"), "123")
+			},
+			staticCmd: func() any {
+				return NewGetTxTraceCmd("123")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gettxtrace","params":["123"],"id":1}`,
+			unmarshalled: &GetTxTraceCmd{
+				TxHash: "123",
+			},
+		},
+		{
+			name: "getvspticketstatus",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getvspticketstatus"), "123")
+			},
+			staticCmd: func() any {
+				return NewGetVSPTicketStatusCmd("123")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getvspticketstatus","params":["123"],"id":1}`,
+			unmarshalled: &GetVSPTicketStatusCmd{
+				TicketHash: "123",
+			},
+		},
+		{
+			name: "getwalletdbversion",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getwalletdbversion"))
+			},
+			staticCmd: func() any {
+				return NewGetWalletDBVersionCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getwalletdbversion","params":[],"id":1}`,
+			unmarshalled: &GetWalletDBVersionCmd{},
+		},
+		{
+			name: "getwalletlockstate",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getwalletlockstate"))
+			},
+			staticCmd: func() any {
+				return NewGetWalletLockStateCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getwalletlockstate","params":[],"id":1}`,
+			unmarshalled: &GetWalletLockStateCmd{},
+		},
+		{
+			name: "importprivkey",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("importprivkey"), "abc")
+			},
+			staticCmd: func() any {
+				return NewImportPrivKeyCmd("abc", nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importprivkey","params":["abc"],"id":1}`,
+			unmarshalled: &ImportPrivKeyCmd{
+				PrivKey: "abc",
+				Label:   nil,
+				Rescan:  dcrjson.Bool(true),
+			},
+		},
+		{
+			name: "importprivkey optional1",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("importprivkey"), "abc", "label")
+			},
+			staticCmd: func() any {
+				return NewImportPrivKeyCmd("abc", dcrjson.String("label"), nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importprivkey","params":["abc","label"],"id":1}`,
+			unmarshalled: &ImportPrivKeyCmd{
+				PrivKey: "abc",
+				Label:   dcrjson.String("label"),
+				Rescan:  dcrjson.Bool(true),
+			},
+		},
+		{
+			name: "importprivkey optional2",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("importprivkey"), "abc", "label", false)
+			},
+			staticCmd: func() any {
+				return NewImportPrivKeyCmd("abc", dcrjson.String("label"), dcrjson.Bool(false), nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importprivkey","params":["abc","label",false],"id":1}`,
+			unmarshalled: &ImportPrivKeyCmd{
+				PrivKey: "abc",
+				Label:   dcrjson.String("label"),
+				Rescan:  dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "importprivkey optional3",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("importprivkey"), "abc", "label", false, 12345)
+			},
+			staticCmd: func() any {
+				return NewImportPrivKeyCmd("abc", dcrjson.String("label"), dcrjson.Bool(false), dcrjson.Int(12345))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importprivkey","params":["abc","label",false,12345],"id":1}`,
+			unmarshalled: &ImportPrivKeyCmd{
+				PrivKey:  "abc",
+				Label:    dcrjson.String("label"),
+				Rescan:   dcrjson.Bool(false),
+				ScanFrom: dcrjson.Int(12345),
+			},
+		},
+		{
+			name: "importpubkey",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("importpubkey"), "abc", "label", false, 12345)
+			},
+			staticCmd: func() any {
+				return NewImportPubKeyCmd("abc", dcrjson.String("label"), dcrjson.Bool(false), dcrjson.Int(12345))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importpubkey","params":["abc","label",false,12345],"id":1}`,
+			unmarshalled: &ImportPubKeyCmd{
+				PubKey:   "abc",
+				Label:    dcrjson.String("label"),
+				Rescan:   dcrjson.Bool(false),
+				ScanFrom: dcrjson.Int(12345),
+			},
+		},
+		{
+			name: "importscript",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("importscript"), "abc")
+			},
+			staticCmd: func() any {
+				return NewImportScriptCmd("abc", nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importscript","params":["abc"],"id":1}`,
+			unmarshalled: &ImportScriptCmd{
+				Hex:    "abc",
+				Rescan: dcrjson.Bool(true),
+			},
+		},
+		{
+			name: "importscript optional3",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("importscript"), "abc", false, 12345, "acct")
+			},
+			staticCmd: func() any {
+				return NewImportScriptCmd("abc", dcrjson.Bool(false), dcrjson.Int(12345), dcrjson.String("acct"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importscript","params":["abc",false,12345,"acct"],"id":1}`,
+			unmarshalled: &ImportScriptCmd{
+				Hex:      "abc",
+				Rescan:   dcrjson.Bool(false),
+				ScanFrom: dcrjson.Int(12345),
+				Account:  dcrjson.String("acct"),
+			},
+		},
+		{
+			name: "listaccounts",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listaccounts"))
+			},
+			staticCmd: func() any {
+				return NewListAccountsCmd(nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listaccounts","params":[],"id":1}`,
+			unmarshalled: &ListAccountsCmd{
+				MinConf:         dcrjson.Int(1),
+				IncludeArchived: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listaccounts optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listaccounts"), 6)
+			},
+			staticCmd: func() any {
+				return NewListAccountsCmd(dcrjson.Int(6))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listaccounts","params":[6],"id":1}`,
+			unmarshalled: &ListAccountsCmd{
+				MinConf:         dcrjson.Int(6),
+				IncludeArchived: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listaccounts includearchived",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listaccounts"), 6, true)
+			},
+			staticCmd: func() any {
+				cmd := NewListAccountsCmd(dcrjson.Int(6))
+				cmd.IncludeArchived = dcrjson.Bool(true)
+				return cmd
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listaccounts","params":[6,true],"id":1}`,
+			unmarshalled: &ListAccountsCmd{
+				MinConf:         dcrjson.Int(6),
+				IncludeArchived: dcrjson.Bool(true),
+			},
+		},
+		{
+			name: "listinvoices",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listinvoices"))
+			},
+			staticCmd: func() any {
+				return NewListInvoicesCmd(nil)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"listinvoices","params":[],"id":1}`,
+			unmarshalled: &ListInvoicesCmd{},
+		},
+		{
+			name: "listinvoices optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listinvoices"), "paid")
+			},
+			staticCmd: func() any {
+				return NewListInvoicesCmd(dcrjson.String("paid"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listinvoices","params":["paid"],"id":1}`,
+			unmarshalled: &ListInvoicesCmd{
+				Status: dcrjson.String("paid"),
+			},
+		},
+		{
+			name: "listlockunspent",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listlockunspent"))
+			},
+			staticCmd: func() any {
+				return NewListLockUnspentCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"listlockunspent","params":[],"id":1}`,
+			unmarshalled: &ListLockUnspentCmd{},
+		},
+		{
+			name: "listreceivedbyaccount",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listreceivedbyaccount"))
+			},
+			staticCmd: func() any {
+				return NewListReceivedByAccountCmd(nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaccount","params":[],"id":1}`,
+			unmarshalled: &ListReceivedByAccountCmd{
+				MinConf:          dcrjson.Int(1),
+				IncludeEmpty:     dcrjson.Bool(false),
+				IncludeWatchOnly: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listreceivedbyaccount optional1",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listreceivedbyaccount"), 6)
+			},
+			staticCmd: func() any {
+				return NewListReceivedByAccountCmd(dcrjson.Int(6), nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaccount","params":[6],"id":1}`,
+			unmarshalled: &ListReceivedByAccountCmd{
+				MinConf:          dcrjson.Int(6),
+				IncludeEmpty:     dcrjson.Bool(false),
+				IncludeWatchOnly: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listreceivedbyaccount optional2",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listreceivedbyaccount"), 6, true)
+			},
+			staticCmd: func() any {
+				return NewListReceivedByAccountCmd(dcrjson.Int(6), dcrjson.Bool(true), nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaccount","params":[6,true],"id":1}`,
+			unmarshalled: &ListReceivedByAccountCmd{
+				MinConf:          dcrjson.Int(6),
+				IncludeEmpty:     dcrjson.Bool(true),
+				IncludeWatchOnly: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listreceivedbyaccount optional3",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listreceivedbyaccount"), 6, true, false)
+			},
+			staticCmd: func() any {
+				return NewListReceivedByAccountCmd(dcrjson.Int(6), dcrjson.Bool(true), dcrjson.Bool(false))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaccount","params":[6,true,false],"id":1}`,
+			unmarshalled: &ListReceivedByAccountCmd{
+				MinConf:          dcrjson.Int(6),
+				IncludeEmpty:     dcrjson.Bool(true),
+				IncludeWatchOnly: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listreceivedbyaddress",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listreceivedbyaddress"))
+			},
+			staticCmd: func() any {
+				return NewListReceivedByAddressCmd(nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaddress","params":[],"id":1}`,
+			unmarshalled: &ListReceivedByAddressCmd{
+				MinConf:          dcrjson.Int(1),
+				IncludeEmpty:     dcrjson.Bool(false),
+				IncludeWatchOnly: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listreceivedbyaddress optional1",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listreceivedbyaddress"), 6)
+			},
+			staticCmd: func() any {
+				return NewListReceivedByAddressCmd(dcrjson.Int(6), nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaddress","params":[6],"id":1}`,
+			unmarshalled: &ListReceivedByAddressCmd{
+				MinConf:          dcrjson.Int(6),
+				IncludeEmpty:     dcrjson.Bool(false),
+				IncludeWatchOnly: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listreceivedbyaddress optional2",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listreceivedbyaddress"), 6, true)
+			},
+			staticCmd: func() any {
+				return NewListReceivedByAddressCmd(dcrjson.Int(6), dcrjson.Bool(true), nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaddress","params":[6,true],"id":1}`,
+			unmarshalled: &ListReceivedByAddressCmd{
+				MinConf:          dcrjson.Int(6),
+				IncludeEmpty:     dcrjson.Bool(true),
+				IncludeWatchOnly: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listreceivedbyaddress optional3",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listreceivedbyaddress"), 6, true, false)
+			},
+			staticCmd: func() any {
+				return NewListReceivedByAddressCmd(dcrjson.Int(6), dcrjson.Bool(true), dcrjson.Bool(false))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaddress","params":[6,true,false],"id":1}`,
+			unmarshalled: &ListReceivedByAddressCmd{
+				MinConf:          dcrjson.Int(6),
+				IncludeEmpty:     dcrjson.Bool(true),
+				IncludeWatchOnly: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listsinceblock",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listsinceblock"))
+			},
+			staticCmd: func() any {
+				return NewListSinceBlockCmd(nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listsinceblock","params":[],"id":1}`,
+			unmarshalled: &ListSinceBlockCmd{
+				BlockHash:           nil,
+				TargetConfirmations: dcrjson.Int(1),
+				IncludeWatchOnly:    dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listsinceblock optional1",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listsinceblock"), "123")
+			},
+			staticCmd: func() any {
+				return NewListSinceBlockCmd(dcrjson.String("123"), nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listsinceblock","params":["123"],"id":1}`,
+			unmarshalled: &ListSinceBlockCmd{
+				BlockHash:           dcrjson.String("123"),
+				TargetConfirmations: dcrjson.Int(1),
+				IncludeWatchOnly:    dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listsinceblock optional2",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listsinceblock"), "123", 6)
+			},
+			staticCmd: func() any {
+				return NewListSinceBlockCmd(dcrjson.String("123"), dcrjson.Int(6), nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listsinceblock","params":["123",6],"id":1}`,
+			unmarshalled: &ListSinceBlockCmd{
+				BlockHash:           dcrjson.String("123"),
+				TargetConfirmations: dcrjson.Int(6),
+				IncludeWatchOnly:    dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listsinceblock optional3",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listsinceblock"), "123", 6, true)
+			},
+			staticCmd: func() any {
+				return NewListSinceBlockCmd(dcrjson.String("123"), dcrjson.Int(6), dcrjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listsinceblock","params":["123",6,true],"id":1}`,
+			unmarshalled: &ListSinceBlockCmd{
+				BlockHash:           dcrjson.String("123"),
+				TargetConfirmations: dcrjson.Int(6),
+				IncludeWatchOnly:    dcrjson.Bool(true),
+			},
+		},
+		{
+			name: "listtransactions",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listtransactions"))
+			},
+			staticCmd: func() any {
+				return NewListTransactionsCmd(nil, nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listtransactions","params":[],"id":1}`,
+			unmarshalled: &ListTransactionsCmd{
+				Account:          nil,
+				Count:            dcrjson.Int(10),
+				From:             dcrjson.Int(0),
+				IncludeWatchOnly: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listtransactions optional1",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listtransactions"), "acct")
+			},
+			staticCmd: func() any {
+				return NewListTransactionsCmd(dcrjson.String("acct"), nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listtransactions","params":["acct"],"id":1}`,
+			unmarshalled: &ListTransactionsCmd{
+				Account:          dcrjson.String("acct"),
+				Count:            dcrjson.Int(10),
+				From:             dcrjson.Int(0),
+				IncludeWatchOnly: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listtransactions optional2",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listtransactions"), "acct", 20)
+			},
+			staticCmd: func() any {
+				return NewListTransactionsCmd(dcrjson.String("acct"), dcrjson.Int(20), nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listtransactions","params":["acct",20],"id":1}`,
+			unmarshalled: &ListTransactionsCmd{
+				Account:          dcrjson.String("acct"),
+				Count:            dcrjson.Int(20),
+				From:             dcrjson.Int(0),
+				IncludeWatchOnly: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listtransactions optional3",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listtransactions"), "acct", 20, 1)
+			},
+			staticCmd: func() any {
+				return NewListTransactionsCmd(dcrjson.String("acct"), dcrjson.Int(20),
+					dcrjson.Int(1), nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listtransactions","params":["acct",20,1],"id":1}`,
+			unmarshalled: &ListTransactionsCmd{
+				Account:          dcrjson.String("acct"),
+				Count:            dcrjson.Int(20),
+				From:             dcrjson.Int(1),
+				IncludeWatchOnly: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "listtransactions optional4",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listtransactions"), "acct", 20, 1, true)
+			},
+			staticCmd: func() any {
+				return NewListTransactionsCmd(dcrjson.String("acct"), dcrjson.Int(20),
+					dcrjson.Int(1), dcrjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listtransactions","params":["acct",20,1,true],"id":1}`,
+			unmarshalled: &ListTransactionsCmd{
+				Account:          dcrjson.String("acct"),
+				Count:            dcrjson.Int(20),
+				From:             dcrjson.Int(1),
+				IncludeWatchOnly: dcrjson.Bool(true),
+			},
+		},
+		{
+			name: "listtransactions filters",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listtransactions"), "*", 20, 1, false,
+					1, "ticket", 100, 200)
+			},
+			staticCmd: func() any {
+				cmd := NewListTransactionsCmd(dcrjson.String("*"), dcrjson.Int(20),
+					dcrjson.Int(1), dcrjson.Bool(false))
+				ct := uint8(1)
+				cmd.CoinType = &ct
+				cmd.TxClass = dcrjson.String("ticket")
+				cmd.StartHeight = dcrjson.Int32(100)
+				cmd.EndHeight = dcrjson.Int32(200)
+				return cmd
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listtransactions","params":["*",20,1,false,1,"ticket",100,200],"id":1}`,
+			unmarshalled: &ListTransactionsCmd{
+				Account:          dcrjson.String("*"),
+				Count:            dcrjson.Int(20),
+				From:             dcrjson.Int(1),
+				IncludeWatchOnly: dcrjson.Bool(false),
+				CoinType:         func() *uint8 { ct := uint8(1); return &ct }(),
+				TxClass:          dcrjson.String("ticket"),
+				StartHeight:      dcrjson.Int32(100),
+				EndHeight:        dcrjson.Int32(200),
+			},
+		},
+		{
+			name: "listunspent",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listunspent"))
+			},
+			staticCmd: func() any {
+				return NewListUnspentCmd(nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listunspent","params":[],"id":1}`,
+			unmarshalled: &ListUnspentCmd{
+				MinConf:   dcrjson.Int(1),
+				MaxConf:   dcrjson.Int(9999999),
+				Addresses: nil,
+			},
+		},
+		{
+			name: "listunspent optional1",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listunspent"), 6)
+			},
+			staticCmd: func() any {
+				return NewListUnspentCmd(dcrjson.Int(6), nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listunspent","params":[6],"id":1}`,
+			unmarshalled: &ListUnspentCmd{
+				MinConf:   dcrjson.Int(6),
+				MaxConf:   dcrjson.Int(9999999),
+				Addresses: nil,
+			},
+		},
+		{
+			name: "listunspent optional2",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listunspent"), 6, 100)
+			},
+			staticCmd: func() any {
+				return NewListUnspentCmd(dcrjson.Int(6), dcrjson.Int(100), nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listunspent","params":[6,100],"id":1}`,
+			unmarshalled: &ListUnspentCmd{
+				MinConf:   dcrjson.Int(6),
+				MaxConf:   dcrjson.Int(100),
+				Addresses: nil,
+			},
+		},
+		{
+			name: "listunspent optional3",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listunspent"), 6, 100, []string{"1Address", "1Address2"})
+			},
+			staticCmd: func() any {
+				return NewListUnspentCmd(dcrjson.Int(6), dcrjson.Int(100),
+					&[]string{"1Address", "1Address2"})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listunspent","params":[6,100,["1Address","1Address2"]],"id":1}`,
+			unmarshalled: &ListUnspentCmd{
+				MinConf:   dcrjson.Int(6),
+				MaxConf:   dcrjson.Int(100),
+				Addresses: &[]string{"1Address", "1Address2"},
+			},
+		},
+		{
+			name: "lockstaking",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("lockstaking"))
+			},
+			staticCmd: func() any {
+				return NewLockStakingCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"lockstaking","params":[],"id":1}`,
+			unmarshalled: &LockStakingCmd{},
+		},
+		{
+			name: "lockunspent",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("lockunspent"), true, `[{"txid":"123","vout":1}]`)
+			},
+			staticCmd: func() any {
+				txInputs := []dcrdtypes.TransactionInput{
+					{Txid: "123", Vout: 1},
+				}
+				return NewLockUnspentCmd(true, txInputs)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"lockunspent","params":[true,[{"txid":"123","vout":1,"tree":0}]],"id":1}`,
+			unmarshalled: &LockUnspentCmd{
+				Unlock: true,
+				Transactions: []dcrdtypes.TransactionInput{
+					{Txid: "123", Vout: 1},
+				},
+			},
+		},
+		{
+			name: "recordprice",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("recordprice"), 1, "USD", "0.25")
+			},
+			staticCmd: func() any {
+				return NewRecordPriceCmd(1, "USD", "0.25", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"recordprice","params":[1,"USD","0.25"],"id":1}`,
+			unmarshalled: &RecordPriceCmd{
+				CoinType: 1,
+				Currency: "USD",
+				Price:    "0.25",
+			},
+		},
+		{
+			name: "recordprice optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("recordprice"), 0, "EUR", "12.5", 1700000000)
+			},
+			staticCmd: func() any {
+				return NewRecordPriceCmd(0, "EUR", "12.5", dcrjson.Int64(1700000000))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"recordprice","params":[0,"EUR","12.5",1700000000],"id":1}`,
+			unmarshalled: &RecordPriceCmd{
+				CoinType: 0,
+				Currency: "EUR",
+				Price:    "12.5",
+				Time:     dcrjson.Int64(1700000000),
+			},
+		},
+		{
+			name: "recoverunspent",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("recoverunspent"))
+			},
+			staticCmd: func() any {
+				return NewRecoverUnspentCmd(nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"recoverunspent","params":[],"id":1}`,
+			unmarshalled: &RecoverUnspentCmd{
+				FullScan: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "recoverunspent optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("recoverunspent"), 1000, true)
+			},
+			staticCmd: func() any {
+				return NewRecoverUnspentCmd(dcrjson.Int(1000), dcrjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"recoverunspent","params":[1000,true],"id":1}`,
+			unmarshalled: &RecoverUnspentCmd{
+				BeginHeight: dcrjson.Int(1000),
+				FullScan:    dcrjson.Bool(true),
+			},
+		},
+		{
+			name: "renameaccount",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("renameaccount"), "oldacct", "newacct")
+			},
+			staticCmd: func() any {
+				return NewRenameAccountCmd("oldacct", "newacct")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"renameaccount","params":["oldacct","newacct"],"id":1}`,
+			unmarshalled: &RenameAccountCmd{
+				OldAccount: "oldacct",
+				NewAccount: "newacct",
+			},
+		},
+		{
+			name: "createrpccredential",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("createrpccredential"), "dashboard", []string{"read"})
+			},
+			staticCmd: func() any {
+				return NewCreateRPCCredentialCmd("dashboard", []string{"read"})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"createrpccredential","params":["dashboard",["read"]],"id":1}`,
+			unmarshalled: &CreateRPCCredentialCmd{
+				Username: "dashboard",
+				Scopes:   []string{"read"},
+			},
+		},
+		{
+			name: "listrpccredentials",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listrpccredentials"))
+			},
+			staticCmd: func() any {
+				return NewListRPCCredentialsCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"listrpccredentials","params":[],"id":1}`,
+			unmarshalled: &ListRPCCredentialsCmd{},
+		},
+		{
+			name: "revokerpccredential",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("revokerpccredential"), "dashboard")
+			},
+			staticCmd: func() any {
+				return NewRevokeRPCCredentialCmd("dashboard")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"revokerpccredential","params":["dashboard"],"id":1}`,
+			unmarshalled: &RevokeRPCCredentialCmd{
+				Username: "dashboard",
+			},
+		},
+		{
+			name: "createwallet",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("createwallet"), "alt", "pass", "00ff")
+			},
+			staticCmd: func() any {
+				return NewCreateWalletCmd("alt", "pass", "00ff", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"createwallet","params":["alt","pass","00ff"],"id":1}`,
+			unmarshalled: &CreateWalletCmd{
+				Name:       "alt",
+				Passphrase: "pass",
+				Seed:       "00ff",
+			},
+		},
+		{
+			name: "listwallets",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("listwallets"))
+			},
+			staticCmd: func() any {
+				return NewListWalletsCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"listwallets","params":[],"id":1}`,
+			unmarshalled: &ListWalletsCmd{},
+		},
+		{
+			name: "loadwallet optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("loadwallet"), "alt", "public")
+			},
+			staticCmd: func() any {
+				return NewLoadWalletCmd("alt", dcrjson.String("public"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"loadwallet","params":["alt","public"],"id":1}`,
+			unmarshalled: &LoadWalletCmd{
+				Name:          "alt",
+				PubPassphrase: dcrjson.String("public"),
+			},
+		},
+		{
+			name: "unloadwallet",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("unloadwallet"), "alt")
+			},
+			staticCmd: func() any {
+				return NewUnloadWalletCmd("alt")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"unloadwallet","params":["alt"],"id":1}`,
+			unmarshalled: &UnloadWalletCmd{
+				Name: "alt",
+			},
+		},
+		{
+			name: "importlegacywallet",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("importlegacywallet"), "wallet.db", "public")
+			},
+			staticCmd: func() any {
+				return NewImportLegacyWalletCmd("wallet.db", dcrjson.String("public"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importlegacywallet","params":["wallet.db","public"],"id":1}`,
+			unmarshalled: &ImportLegacyWalletCmd{
+				Source:        "wallet.db",
+				PubPassphrase: dcrjson.String("public"),
+			},
+		},
+		{
+			name: "restorewallet",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("restorewallet"), "wallet.bak", "pass")
+			},
+			staticCmd: func() any {
+				return NewRestoreWalletCmd("wallet.bak", "pass", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"restorewallet","params":["wallet.bak","pass"],"id":1}`,
+			unmarshalled: &RestoreWalletCmd{
+				Source:     "wallet.bak",
+				Passphrase: "pass",
+			},
+		},
+		{
+			name: "restorewallet optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("restorewallet"), "wallet.bak", "pass", "public")
+			},
+			staticCmd: func() any {
+				return NewRestoreWalletCmd("wallet.bak", "pass", dcrjson.String("public"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"restorewallet","params":["wallet.bak","pass","public"],"id":1}`,
+			unmarshalled: &RestoreWalletCmd{
+				Source:        "wallet.bak",
+				Passphrase:    "pass",
+				PubPassphrase: dcrjson.String("public"),
+			},
+		},
+		{
+			name: "sendfrom",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("sendfrom"), "from", "1Address", "0.5")
+			},
+			staticCmd: func() any {
+				return NewSendFromCmd("from", "1Address", "0.5", nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendfrom","params":["from","1Address","0.5"],"id":1}`,
+			unmarshalled: &SendFromCmd{
+				FromAccount: "from",
+				ToAddress:   "1Address",
+				Amount:      "0.5",
+				MinConf:     dcrjson.Int(1),
+				Comment:     nil,
+				CommentTo:   nil,
+			},
+		},
+		{
+			name: "sendfrom optional1",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("sendfrom"), "from", "1Address", "0.5", 6)
+			},
+			staticCmd: func() any {
+				return NewSendFromCmd("from", "1Address", "0.5", dcrjson.Int(6), nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendfrom","params":["from","1Address","0.5",6],"id":1}`,
+			unmarshalled: &SendFromCmd{
+				FromAccount: "from",
+				ToAddress:   "1Address",
+				Amount:      "0.5",
+				MinConf:     dcrjson.Int(6),
+				Comment:     nil,
+				CommentTo:   nil,
+			},
+		},
+		{
+			name: "sendfrom optional2",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("sendfrom"), "from", "1Address", "0.5", 6, "comment")
+			},
+			staticCmd: func() any {
+				return NewSendFromCmd("from", "1Address", "0.5", dcrjson.Int(6),
+					dcrjson.String("comment"), nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendfrom","params":["from","1Address","0.5",6,"comment"],"id":1}`,
+			unmarshalled: &SendFromCmd{
+				FromAccount: "from",
+				ToAddress:   "1Address",
+				Amount:      "0.5",
+				MinConf:     dcrjson.Int(6),
+				Comment:     dcrjson.String("comment"),
+				CommentTo:   nil,
+			},
+		},
+		{
+			name: "sendfrom optional3",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("sendfrom"), "from", "1Address", "0.5", 6, "comment", "commentto")
+			},
+			staticCmd: func() any {
+				return NewSendFromCmd("from", "1Address", "0.5", dcrjson.Int(6),
+					dcrjson.String("comment"), dcrjson.String("commentto"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendfrom","params":["from","1Address","0.5",6,"comment","commentto"],"id":1}`,
+			unmarshalled: &SendFromCmd{
+				FromAccount: "from",
+				ToAddress:   "1Address",
+				Amount:      "0.5",
+				MinConf:     dcrjson.Int(6),
+				Comment:     dcrjson.String("comment"),
+				CommentTo:   dcrjson.String("commentto"),
+			},
+		},
+		{
+			name: "sendfrom locktimes",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("sendfrom"), "from", "1Address", "0.5", 6,
+					"comment", "commentto", 1, "USD", 1000, 10, 900)
+			},
+			staticCmd: func() any {
+				return &SendFromCmd{
+					FromAccount:  "from",
+					ToAddress:    "1Address",
+					Amount:       "0.5",
+					MinConf:      dcrjson.Int(6),
+					Comment:      dcrjson.String("comment"),
+					CommentTo:    dcrjson.String("commentto"),
+					CoinType:     uint8Ptr(1),
+					FiatCurrency: dcrjson.String("USD"),
+					Expiry:       dcrjson.Uint32(1000),
+					ExpireAfter:  dcrjson.Uint32(10),
+					LockTime:     dcrjson.Uint32(900),
+				}
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendfrom","params":["from","1Address","0.5",6,"comment","commentto",1,"USD",1000,10,900],"id":1}`,
+			unmarshalled: &SendFromCmd{
+				FromAccount:  "from",
+				ToAddress:    "1Address",
+				Amount:       "0.5",
+				MinConf:      dcrjson.Int(6),
+				Comment:      dcrjson.String("comment"),
+				CommentTo:    dcrjson.String("commentto"),
+				CoinType:     uint8Ptr(1),
+				FiatCurrency: dcrjson.String("USD"),
+				Expiry:       dcrjson.Uint32(1000),
+				ExpireAfter:  dcrjson.Uint32(10),
+				LockTime:     dcrjson.Uint32(900),
+			},
+		},
+		{
+			name: "sendmany",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("sendmany"), "from", `{"1Address":"0.5"}`)
+			},
+			staticCmd: func() any {
+				amounts := map[string]string{"1Address": "0.5"}
+				return NewSendManyCmd("from", amounts, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendmany","params":["from",{"1Address":"0.5"}],"id":1}`,
+			unmarshalled: &SendManyCmd{
+				FromAccount: "from",
+				Amounts:     map[string]string{"1Address": "0.5"},
+				MinConf:     dcrjson.Int(1),
+				Comment:     nil,
 			},
 		},
 		{
-			name: "listreceivedbyaccount optional2",
+			name: "sendmany optional1",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listreceivedbyaccount"), 6, true)
+				return dcrjson.NewCmd(Method("sendmany"), "from", `{"1Address":"0.5"}`, 6)
 			},
 			staticCmd: func() any {
-				return NewListReceivedByAccountCmd(dcrjson.Int(6), dcrjson.Bool(true), nil)
+				amounts := map[string]string{"1Address": "0.5"}
+				return NewSendManyCmd("from", amounts, dcrjson.Int(6), nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaccount","params":[6,true],"id":1}`,
-			unmarshalled: &ListReceivedByAccountCmd{
-				MinConf:          dcrjson.Int(6),
-				IncludeEmpty:     dcrjson.Bool(true),
-				IncludeWatchOnly: dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"sendmany","params":["from",{"1Address":"0.5"},6],"id":1}`,
+			unmarshalled: &SendManyCmd{
+				FromAccount: "from",
+				Amounts:     map[string]string{"1Address": "0.5"},
+				MinConf:     dcrjson.Int(6),
+				Comment:     nil,
 			},
 		},
 		{
-			name: "listreceivedbyaccount optional3",
+			name: "sendmany optional2",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listreceivedbyaccount"), 6, true, false)
+				return dcrjson.NewCmd(Method("sendmany"), "from", `{"1Address":"0.5"}`, 6, "comment")
 			},
 			staticCmd: func() any {
-				return NewListReceivedByAccountCmd(dcrjson.Int(6), dcrjson.Bool(true), dcrjson.Bool(false))
+				amounts := map[string]string{"1Address": "0.5"}
+				return NewSendManyCmd("from", amounts, dcrjson.Int(6), dcrjson.String("comment"))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaccount","params":[6,true,false],"id":1}`,
-			unmarshalled: &ListReceivedByAccountCmd{
-				MinConf:          dcrjson.Int(6),
-				IncludeEmpty:     dcrjson.Bool(true),
-				IncludeWatchOnly: dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"sendmany","params":["from",{"1Address":"0.5"},6,"comment"],"id":1}`,
+			unmarshalled: &SendManyCmd{
+				FromAccount: "from",
+				Amounts:     map[string]string{"1Address": "0.5"},
+				MinConf:     dcrjson.Int(6),
+				Comment:     dcrjson.String("comment"),
 			},
 		},
 		{
-			name: "listreceivedbyaddress",
+			name: "sendmany subtractfeefrom",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listreceivedbyaddress"))
+				return dcrjson.NewCmd(Method("sendmany"), "from", `{"1Address":"0.5"}`, 6,
+					"comment", 1, "USD", `["1Address"]`)
 			},
 			staticCmd: func() any {
-				return NewListReceivedByAddressCmd(nil, nil, nil)
+				return &SendManyCmd{
+					FromAccount:     "from",
+					Amounts:         map[string]string{"1Address": "0.5"},
+					MinConf:         dcrjson.Int(6),
+					Comment:         dcrjson.String("comment"),
+					CoinType:        uint8Ptr(1),
+					FiatCurrency:    dcrjson.String("USD"),
+					SubtractFeeFrom: &[]string{"1Address"},
+				}
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaddress","params":[],"id":1}`,
-			unmarshalled: &ListReceivedByAddressCmd{
-				MinConf:          dcrjson.Int(1),
-				IncludeEmpty:     dcrjson.Bool(false),
-				IncludeWatchOnly: dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"sendmany","params":["from",{"1Address":"0.5"},6,"comment",1,"USD",["1Address"]],"id":1}`,
+			unmarshalled: &SendManyCmd{
+				FromAccount:     "from",
+				Amounts:         map[string]string{"1Address": "0.5"},
+				MinConf:         dcrjson.Int(6),
+				Comment:         dcrjson.String("comment"),
+				CoinType:        uint8Ptr(1),
+				FiatCurrency:    dcrjson.String("USD"),
+				SubtractFeeFrom: &[]string{"1Address"},
+			},
+		},
+		{
+			name: "sendmany feepreference",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("sendmany"), "from", `{"1Address":"0.5"}`, 6,
+					"comment", 0, "USD", `["1Address"]`, 100, 0, 0, "fast")
+			},
+			staticCmd: func() any {
+				return &SendManyCmd{
+					FromAccount:     "from",
+					Amounts:         map[string]string{"1Address": "0.5"},
+					MinConf:         dcrjson.Int(6),
+					Comment:         dcrjson.String("comment"),
+					CoinType:        uint8Ptr(0),
+					FiatCurrency:    dcrjson.String("USD"),
+					SubtractFeeFrom: &[]string{"1Address"},
+					Expiry:          dcrjson.Uint32(100),
+					ExpireAfter:     dcrjson.Uint32(0),
+					LockTime:        dcrjson.Uint32(0),
+					FeePreference:   dcrjson.String("fast"),
+				}
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendmany","params":["from",{"1Address":"0.5"},6,"comment",0,"USD",["1Address"],100,0,0,"fast"],"id":1}`,
+			unmarshalled: &SendManyCmd{
+				FromAccount:     "from",
+				Amounts:         map[string]string{"1Address": "0.5"},
+				MinConf:         dcrjson.Int(6),
+				Comment:         dcrjson.String("comment"),
+				CoinType:        uint8Ptr(0),
+				FiatCurrency:    dcrjson.String("USD"),
+				SubtractFeeFrom: &[]string{"1Address"},
+				Expiry:          dcrjson.Uint32(100),
+				ExpireAfter:     dcrjson.Uint32(0),
+				LockTime:        dcrjson.Uint32(0),
+				FeePreference:   dcrjson.String("fast"),
+			},
+		},
+		{
+			name: "sendmany data",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("sendmany"), "from", `{"1Address":"0.5"}`, 6,
+					"comment", 0, "USD", `["1Address"]`, 100, 0, 0, "fast", "68656c6c6f")
+			},
+			staticCmd: func() any {
+				return &SendManyCmd{
+					FromAccount:     "from",
+					Amounts:         map[string]string{"1Address": "0.5"},
+					MinConf:         dcrjson.Int(6),
+					Comment:         dcrjson.String("comment"),
+					CoinType:        uint8Ptr(0),
+					FiatCurrency:    dcrjson.String("USD"),
+					SubtractFeeFrom: &[]string{"1Address"},
+					Expiry:          dcrjson.Uint32(100),
+					ExpireAfter:     dcrjson.Uint32(0),
+					LockTime:        dcrjson.Uint32(0),
+					FeePreference:   dcrjson.String("fast"),
+					Data:            dcrjson.String("68656c6c6f"),
+				}
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendmany","params":["from",{"1Address":"0.5"},6,"comment",0,"USD",["1Address"],100,0,0,"fast","68656c6c6f"],"id":1}`,
+			unmarshalled: &SendManyCmd{
+				FromAccount:     "from",
+				Amounts:         map[string]string{"1Address": "0.5"},
+				MinConf:         dcrjson.Int(6),
+				Comment:         dcrjson.String("comment"),
+				CoinType:        uint8Ptr(0),
+				FiatCurrency:    dcrjson.String("USD"),
+				SubtractFeeFrom: &[]string{"1Address"},
+				Expiry:          dcrjson.Uint32(100),
+				ExpireAfter:     dcrjson.Uint32(0),
+				LockTime:        dcrjson.Uint32(0),
+				FeePreference:   dcrjson.String("fast"),
+				Data:            dcrjson.String("68656c6c6f"),
 			},
 		},
 		{
-			name: "listreceivedbyaddress optional1",
+			name: "sendtoaddress",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listreceivedbyaddress"), 6)
+				return dcrjson.NewCmd(Method("sendtoaddress"), "1Address", "0.5")
 			},
 			staticCmd: func() any {
-				return NewListReceivedByAddressCmd(dcrjson.Int(6), nil, nil)
+				return NewSendToAddressCmd("1Address", "0.5", nil, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaddress","params":[6],"id":1}`,
-			unmarshalled: &ListReceivedByAddressCmd{
-				MinConf:          dcrjson.Int(6),
-				IncludeEmpty:     dcrjson.Bool(false),
-				IncludeWatchOnly: dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"sendtoaddress","params":["1Address","0.5"],"id":1}`,
+			unmarshalled: &SendToAddressCmd{
+				Address:   "1Address",
+				Amount:    "0.5",
+				Comment:   nil,
+				CommentTo: nil,
 			},
 		},
 		{
-			name: "listreceivedbyaddress optional2",
+			name: "sendtoaddress optional1",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listreceivedbyaddress"), 6, true)
+				return dcrjson.NewCmd(Method("sendtoaddress"), "1Address", "0.5", "comment", "commentto")
 			},
 			staticCmd: func() any {
-				return NewListReceivedByAddressCmd(dcrjson.Int(6), dcrjson.Bool(true), nil)
+				return NewSendToAddressCmd("1Address", "0.5", dcrjson.String("comment"),
+					dcrjson.String("commentto"))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaddress","params":[6,true],"id":1}`,
-			unmarshalled: &ListReceivedByAddressCmd{
-				MinConf:          dcrjson.Int(6),
-				IncludeEmpty:     dcrjson.Bool(true),
-				IncludeWatchOnly: dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"sendtoaddress","params":["1Address","0.5","comment","commentto"],"id":1}`,
+			unmarshalled: &SendToAddressCmd{
+				Address:   "1Address",
+				Amount:    "0.5",
+				Comment:   dcrjson.String("comment"),
+				CommentTo: dcrjson.String("commentto"),
 			},
 		},
 		{
-			name: "listreceivedbyaddress optional3",
+			name: "sendtoaddress subtractfeefromamount",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listreceivedbyaddress"), 6, true, false)
+				return dcrjson.NewCmd(Method("sendtoaddress"), "1Address", "0.5", "comment",
+					"commentto", 1, "USD", true)
 			},
 			staticCmd: func() any {
-				return NewListReceivedByAddressCmd(dcrjson.Int(6), dcrjson.Bool(true), dcrjson.Bool(false))
+				return &SendToAddressCmd{
+					Address:               "1Address",
+					Amount:                "0.5",
+					Comment:               dcrjson.String("comment"),
+					CommentTo:             dcrjson.String("commentto"),
+					CoinType:              uint8Ptr(1),
+					FiatCurrency:          dcrjson.String("USD"),
+					SubtractFeeFromAmount: dcrjson.Bool(true),
+				}
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listreceivedbyaddress","params":[6,true,false],"id":1}`,
-			unmarshalled: &ListReceivedByAddressCmd{
-				MinConf:          dcrjson.Int(6),
-				IncludeEmpty:     dcrjson.Bool(true),
-				IncludeWatchOnly: dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"sendtoaddress","params":["1Address","0.5","comment","commentto",1,"USD",true],"id":1}`,
+			unmarshalled: &SendToAddressCmd{
+				Address:               "1Address",
+				Amount:                "0.5",
+				Comment:               dcrjson.String("comment"),
+				CommentTo:             dcrjson.String("commentto"),
+				CoinType:              uint8Ptr(1),
+				FiatCurrency:          dcrjson.String("USD"),
+				SubtractFeeFromAmount: dcrjson.Bool(true),
 			},
 		},
 		{
-			name: "listsinceblock",
+			name: "sendfromtreasury",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listsinceblock"))
+				return dcrjson.NewCmd(Method("sendfromtreasury"), "key", `{"1Address":0.5}`)
 			},
 			staticCmd: func() any {
-				return NewListSinceBlockCmd(nil, nil, nil)
+				var debits = map[string]float64{"1Address": 0.5}
+				return NewSendFromTreasuryCmd("key", debits)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listsinceblock","params":[],"id":1}`,
-			unmarshalled: &ListSinceBlockCmd{
-				BlockHash:           nil,
-				TargetConfirmations: dcrjson.Int(1),
-				IncludeWatchOnly:    dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"sendfromtreasury","params":["key",{"1Address":0.5}],"id":1}`,
+			unmarshalled: &SendFromTreasuryCmd{
+				Key:     "key",
+				Amounts: map[string]float64{"1Address": 0.5},
 			},
 		},
 		{
-			name: "listsinceblock optional1",
+			name: "sendfromvault",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listsinceblock"), "123")
+				return dcrjson.NewCmd(Method("sendfromvault"), "vault", `{"1Address":"0.5"}`, 1)
 			},
 			staticCmd: func() any {
-				return NewListSinceBlockCmd(dcrjson.String("123"), nil, nil)
+				amounts := map[string]string{"1Address": "0.5"}
+				return NewSendFromVaultCmd("vault", amounts, uint8Ptr(1))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listsinceblock","params":["123"],"id":1}`,
-			unmarshalled: &ListSinceBlockCmd{
-				BlockHash:           dcrjson.String("123"),
-				TargetConfirmations: dcrjson.Int(1),
-				IncludeWatchOnly:    dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"sendfromvault","params":["vault",{"1Address":"0.5"},1],"id":1}`,
+			unmarshalled: &SendFromVaultCmd{
+				Account:  "vault",
+				Amounts:  map[string]string{"1Address": "0.5"},
+				CoinType: uint8Ptr(1),
 			},
 		},
 		{
-			name: "listsinceblock optional2",
+			name: "schedulesend",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listsinceblock"), "123", 6)
+				return dcrjson.NewCmd(Method("schedulesend"), "acct", `{"1Address":"0.5"}`, 1700000000)
 			},
 			staticCmd: func() any {
-				return NewListSinceBlockCmd(dcrjson.String("123"), dcrjson.Int(6), nil)
+				amounts := map[string]string{"1Address": "0.5"}
+				return NewScheduleSendCmd("acct", amounts, dcrjson.Int64(1700000000), nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listsinceblock","params":["123",6],"id":1}`,
-			unmarshalled: &ListSinceBlockCmd{
-				BlockHash:           dcrjson.String("123"),
-				TargetConfirmations: dcrjson.Int(6),
-				IncludeWatchOnly:    dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"schedulesend","params":["acct",{"1Address":"0.5"},1700000000],"id":1}`,
+			unmarshalled: &ScheduleSendCmd{
+				FromAccount: "acct",
+				Amounts:     map[string]string{"1Address": "0.5"},
+				NotBefore:   dcrjson.Int64(1700000000),
+				PreSign:     dcrjson.Bool(false),
+				MinConf:     dcrjson.Int(1),
 			},
 		},
 		{
-			name: "listsinceblock optional3",
+			name: "cancelscheduledsend",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listsinceblock"), "123", 6, true)
+				return dcrjson.NewCmd(Method("cancelscheduledsend"), 3)
 			},
 			staticCmd: func() any {
-				return NewListSinceBlockCmd(dcrjson.String("123"), dcrjson.Int(6), dcrjson.Bool(true))
+				return NewCancelScheduledSendCmd(3)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listsinceblock","params":["123",6,true],"id":1}`,
-			unmarshalled: &ListSinceBlockCmd{
-				BlockHash:           dcrjson.String("123"),
-				TargetConfirmations: dcrjson.Int(6),
-				IncludeWatchOnly:    dcrjson.Bool(true),
+			marshalled: `{"jsonrpc":"1.0","method":"cancelscheduledsend","params":[3],"id":1}`,
+			unmarshalled: &CancelScheduledSendCmd{
+				ID: 3,
 			},
 		},
 		{
-			name: "listtransactions",
+			name: "setinheritance",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listtransactions"))
+				return dcrjson.NewCmd(Method("setinheritance"), "default", "1Address", 180)
 			},
 			staticCmd: func() any {
-				return NewListTransactionsCmd(nil, nil, nil, nil)
+				return NewSetInheritanceCmd("default", "1Address", 180)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listtransactions","params":[],"id":1}`,
-			unmarshalled: &ListTransactionsCmd{
-				Account:          nil,
-				Count:            dcrjson.Int(10),
-				From:             dcrjson.Int(0),
-				IncludeWatchOnly: dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"setinheritance","params":["default","1Address",180],"id":1}`,
+			unmarshalled: &SetInheritanceCmd{
+				Account:   "default",
+				Address:   "1Address",
+				DelayDays: 180,
 			},
 		},
 		{
-			name: "listtransactions optional1",
+			name: "verifyownershipproof",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listtransactions"), "acct")
+				return dcrjson.NewCmd(Method("verifyownershipproof"), "6f776e70ff", "audit")
 			},
 			staticCmd: func() any {
-				return NewListTransactionsCmd(dcrjson.String("acct"), nil, nil, nil)
+				return NewVerifyOwnershipProofCmd("6f776e70ff", "audit")
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listtransactions","params":["acct"],"id":1}`,
-			unmarshalled: &ListTransactionsCmd{
-				Account:          dcrjson.String("acct"),
-				Count:            dcrjson.Int(10),
-				From:             dcrjson.Int(0),
-				IncludeWatchOnly: dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"verifyownershipproof","params":["6f776e70ff","audit"],"id":1}`,
+			unmarshalled: &VerifyOwnershipProofCmd{
+				Proof:     "6f776e70ff",
+				Challenge: "audit",
 			},
 		},
 		{
-			name: "listtransactions optional2",
+			name: "getrpcstats",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listtransactions"), "acct", 20)
+				return dcrjson.NewCmd(Method("getrpcstats"), 5, "getbalance")
 			},
 			staticCmd: func() any {
-				return NewListTransactionsCmd(dcrjson.String("acct"), dcrjson.Int(20), nil, nil)
+				count, method := 5, "getbalance"
+				return NewGetRPCStatsCmd(&count, &method)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listtransactions","params":["acct",20],"id":1}`,
-			unmarshalled: &ListTransactionsCmd{
-				Account:          dcrjson.String("acct"),
-				Count:            dcrjson.Int(20),
-				From:             dcrjson.Int(0),
-				IncludeWatchOnly: dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"getrpcstats","params":[5,"getbalance"],"id":1}`,
+			unmarshalled: &GetRPCStatsCmd{
+				Count:  func() *int { i := 5; return &i }(),
+				Method: func() *string { s := "getbalance"; return &s }(),
 			},
 		},
 		{
-			name: "listtransactions optional3",
+			name: "sendtspend",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listtransactions"), "acct", 20, 1)
+				return dcrjson.NewCmd(Method("sendtspend"), "hextx", "pikey")
 			},
 			staticCmd: func() any {
-				return NewListTransactionsCmd(dcrjson.String("acct"), dcrjson.Int(20),
-					dcrjson.Int(1), nil)
+				return NewSendTSpendCmd("hextx", "pikey")
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listtransactions","params":["acct",20,1],"id":1}`,
-			unmarshalled: &ListTransactionsCmd{
-				Account:          dcrjson.String("acct"),
-				Count:            dcrjson.Int(20),
-				From:             dcrjson.Int(1),
-				IncludeWatchOnly: dcrjson.Bool(false),
+			marshalled: `{"jsonrpc":"1.0","method":"sendtspend","params":["hextx","pikey"],"id":1}`,
+			unmarshalled: &SendTSpendCmd{
+				HexTx: "hextx",
+				PiKey: "pikey",
 			},
 		},
 		{
-			name: "listtransactions optional4",
+			name: "sendtotreasury",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listtransactions"), "acct", 20, 1, true)
+				return dcrjson.NewCmd(Method("sendtotreasury"), 0.5)
 			},
 			staticCmd: func() any {
-				return NewListTransactionsCmd(dcrjson.String("acct"), dcrjson.Int(20),
-					dcrjson.Int(1), dcrjson.Bool(true))
+				return NewSendToTreasuryCmd(0.5, nil, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listtransactions","params":["acct",20,1,true],"id":1}`,
-			unmarshalled: &ListTransactionsCmd{
-				Account:          dcrjson.String("acct"),
-				Count:            dcrjson.Int(20),
-				From:             dcrjson.Int(1),
-				IncludeWatchOnly: dcrjson.Bool(true),
+			marshalled: `{"jsonrpc":"1.0","method":"sendtotreasury","params":[0.5],"id":1}`,
+			unmarshalled: &SendToTreasuryCmd{
+				Amount:      0.5,
+				FromAccount: dcrjson.String("default"),
 			},
 		},
 		{
-			name: "listunspent",
+			name: "sendtotreasury optional",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listunspent"))
+				return dcrjson.NewCmd(Method("sendtotreasury"), 0.5, "donations")
 			},
 			staticCmd: func() any {
-				return NewListUnspentCmd(nil, nil, nil)
+				return &SendToTreasuryCmd{
+					Amount:      0.5,
+					FromAccount: dcrjson.String("donations"),
+				}
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listunspent","params":[],"id":1}`,
-			unmarshalled: &ListUnspentCmd{
-				MinConf:   dcrjson.Int(1),
-				MaxConf:   dcrjson.Int(9999999),
-				Addresses: nil,
+			marshalled: `{"jsonrpc":"1.0","method":"sendtotreasury","params":[0.5,"donations"],"id":1}`,
+			unmarshalled: &SendToTreasuryCmd{
+				Amount:      0.5,
+				FromAccount: dcrjson.String("donations"),
 			},
 		},
 		{
-			name: "listunspent optional1",
+			name: "setaccountgaplimit",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listunspent"), 6)
+				return dcrjson.NewCmd(Method("setaccountgaplimit"), "deposits", 1000)
 			},
 			staticCmd: func() any {
-				return NewListUnspentCmd(dcrjson.Int(6), nil, nil)
+				return NewSetAccountGapLimitCmd("deposits", 1000)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listunspent","params":[6],"id":1}`,
-			unmarshalled: &ListUnspentCmd{
-				MinConf:   dcrjson.Int(6),
-				MaxConf:   dcrjson.Int(9999999),
-				Addresses: nil,
+			marshalled: `{"jsonrpc":"1.0","method":"setaccountgaplimit","params":["deposits",1000],"id":1}`,
+			unmarshalled: &SetAccountGapLimitCmd{
+				Account:  "deposits",
+				GapLimit: 1000,
 			},
 		},
 		{
-			name: "listunspent optional2",
+			name: "setchangeaccount",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listunspent"), 6, 100)
+				return dcrjson.NewCmd(Method("setchangeaccount"), "mixed", "unmixed")
 			},
 			staticCmd: func() any {
-				return NewListUnspentCmd(dcrjson.Int(6), dcrjson.Int(100), nil)
+				return NewSetChangeAccountCmd("mixed", "unmixed", nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listunspent","params":[6,100],"id":1}`,
-			unmarshalled: &ListUnspentCmd{
-				MinConf:   dcrjson.Int(6),
-				MaxConf:   dcrjson.Int(100),
-				Addresses: nil,
+			marshalled: `{"jsonrpc":"1.0","method":"setchangeaccount","params":["mixed","unmixed"],"id":1}`,
+			unmarshalled: &SetChangeAccountCmd{
+				Account:       "mixed",
+				ChangeAccount: "unmixed",
+				CoinType:      dcrjson.Int(0),
 			},
 		},
 		{
-			name: "listunspent optional3",
+			name: "setchangeaccount optional",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("listunspent"), 6, 100, []string{"1Address", "1Address2"})
+				return dcrjson.NewCmd(Method("setchangeaccount"), "mixed", "unmixed", 1)
 			},
 			staticCmd: func() any {
-				return NewListUnspentCmd(dcrjson.Int(6), dcrjson.Int(100),
-					&[]string{"1Address", "1Address2"})
+				return NewSetChangeAccountCmd("mixed", "unmixed", dcrjson.Int(1))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"listunspent","params":[6,100,["1Address","1Address2"]],"id":1}`,
-			unmarshalled: &ListUnspentCmd{
-				MinConf:   dcrjson.Int(6),
-				MaxConf:   dcrjson.Int(100),
-				Addresses: &[]string{"1Address", "1Address2"},
+			marshalled: `{"jsonrpc":"1.0","method":"setchangeaccount","params":["mixed","unmixed",1],"id":1}`,
+			unmarshalled: &SetChangeAccountCmd{
+				Account:       "mixed",
+				ChangeAccount: "unmixed",
+				CoinType:      dcrjson.Int(1),
 			},
 		},
 		{
-			name: "lockunspent",
+			name: "setchangescripttype",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("lockunspent"), true, `[{"txid":"123","vout":1}]`)
+				return dcrjson.NewCmd(Method("setchangescripttype"), "default", "p2sh")
 			},
 			staticCmd: func() any {
-				txInputs := []dcrdtypes.TransactionInput{
-					{Txid: "123", Vout: 1},
-				}
-				return NewLockUnspentCmd(true, txInputs)
+				return NewSetChangeScriptTypeCmd("default", "p2sh")
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"lockunspent","params":[true,[{"txid":"123","vout":1,"tree":0}]],"id":1}`,
-			unmarshalled: &LockUnspentCmd{
-				Unlock: true,
-				Transactions: []dcrdtypes.TransactionInput{
-					{Txid: "123", Vout: 1},
-				},
+			marshalled: `{"jsonrpc":"1.0","method":"setchangescripttype","params":["default","p2sh"],"id":1}`,
+			unmarshalled: &SetChangeScriptTypeCmd{
+				Account:    "default",
+				ScriptType: "p2sh",
 			},
 		},
 		{
-			name: "renameaccount",
+			name: "setspendlimit",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("renameaccount"), "oldacct", "newacct")
+				return dcrjson.NewCmd(Method("setspendlimit"), "default", "10")
 			},
 			staticCmd: func() any {
-				return NewRenameAccountCmd("oldacct", "newacct")
+				return NewSetSpendLimitCmd("default", "10", nil, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"renameaccount","params":["oldacct","newacct"],"id":1}`,
-			unmarshalled: &RenameAccountCmd{
-				OldAccount: "oldacct",
-				NewAccount: "newacct",
+			marshalled: `{"jsonrpc":"1.0","method":"setspendlimit","params":["default","10"],"id":1}`,
+			unmarshalled: &SetSpendLimitCmd{
+				Account:         "default",
+				Limit:           "10",
+				CoinType:        func() *uint8 { ct := uint8(0); return &ct }(),
+				RequireApproval: dcrjson.Bool(false),
 			},
 		},
 		{
-			name: "sendfrom",
+			name: "setspendlimit optional",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("sendfrom"), "from", "1Address", "0.5")
+				return dcrjson.NewCmd(Method("setspendlimit"), "default", "10", 1, true)
 			},
 			staticCmd: func() any {
-				return NewSendFromCmd("from", "1Address", "0.5", nil, nil, nil)
+				ct := uint8(1)
+				return NewSetSpendLimitCmd("default", "10", &ct, dcrjson.Bool(true))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"sendfrom","params":["from","1Address","0.5"],"id":1}`,
-			unmarshalled: &SendFromCmd{
-				FromAccount: "from",
-				ToAddress:   "1Address",
-				Amount:      "0.5",
-				MinConf:     dcrjson.Int(1),
-				Comment:     nil,
-				CommentTo:   nil,
+			marshalled: `{"jsonrpc":"1.0","method":"setspendlimit","params":["default","10",1,true],"id":1}`,
+			unmarshalled: &SetSpendLimitCmd{
+				Account:         "default",
+				Limit:           "10",
+				CoinType:        func() *uint8 { ct := uint8(1); return &ct }(),
+				RequireApproval: dcrjson.Bool(true),
 			},
 		},
 		{
-			name: "sendfrom optional1",
+			name: "addrecurringpayment",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("sendfrom"), "from", "1Address", "0.5", 6)
+				return dcrjson.NewCmd(Method("addrecurringpayment"), "acct", "1Address", "0.5", 144)
 			},
 			staticCmd: func() any {
-				return NewSendFromCmd("from", "1Address", "0.5", dcrjson.Int(6), nil, nil)
+				return NewAddRecurringPaymentCmd("acct", "1Address", "0.5", dcrjson.Int32(144), nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"sendfrom","params":["from","1Address","0.5",6],"id":1}`,
-			unmarshalled: &SendFromCmd{
-				FromAccount: "from",
-				ToAddress:   "1Address",
-				Amount:      "0.5",
-				MinConf:     dcrjson.Int(6),
-				Comment:     nil,
-				CommentTo:   nil,
+			marshalled: `{"jsonrpc":"1.0","method":"addrecurringpayment","params":["acct","1Address","0.5",144],"id":1}`,
+			unmarshalled: &AddRecurringPaymentCmd{
+				FromAccount:    "acct",
+				Address:        "1Address",
+				Amount:         "0.5",
+				IntervalBlocks: dcrjson.Int32(144),
+				FailurePolicy:  dcrjson.String("retry"),
+				MinConf:        dcrjson.Int(1),
 			},
 		},
 		{
-			name: "sendfrom optional2",
+			name: "pauserecurringpayment",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("sendfrom"), "from", "1Address", "0.5", 6, "comment")
+				return dcrjson.NewCmd(Method("pauserecurringpayment"), 2)
 			},
 			staticCmd: func() any {
-				return NewSendFromCmd("from", "1Address", "0.5", dcrjson.Int(6),
-					dcrjson.String("comment"), nil)
+				return NewPauseRecurringPaymentCmd(2)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"sendfrom","params":["from","1Address","0.5",6,"comment"],"id":1}`,
-			unmarshalled: &SendFromCmd{
-				FromAccount: "from",
-				ToAddress:   "1Address",
-				Amount:      "0.5",
-				MinConf:     dcrjson.Int(6),
-				Comment:     dcrjson.String("comment"),
-				CommentTo:   nil,
+			marshalled: `{"jsonrpc":"1.0","method":"pauserecurringpayment","params":[2],"id":1}`,
+			unmarshalled: &PauseRecurringPaymentCmd{
+				ID: 2,
 			},
 		},
 		{
-			name: "sendfrom optional3",
+			name: "approvepending",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("sendfrom"), "from", "1Address", "0.5", 6, "comment", "commentto")
+				return dcrjson.NewCmd(Method("approvepending"), 3)
 			},
 			staticCmd: func() any {
-				return NewSendFromCmd("from", "1Address", "0.5", dcrjson.Int(6),
-					dcrjson.String("comment"), dcrjson.String("commentto"))
-			},
-			marshalled: `{"jsonrpc":"1.0","method":"sendfrom","params":["from","1Address","0.5",6,"comment","commentto"],"id":1}`,
-			unmarshalled: &SendFromCmd{
-				FromAccount: "from",
-				ToAddress:   "1Address",
-				Amount:      "0.5",
-				MinConf:     dcrjson.Int(6),
-				Comment:     dcrjson.String("comment"),
-				CommentTo:   dcrjson.String("commentto"),
+				return NewApprovePendingCmd(3)
 			},
+			marshalled:   `{"jsonrpc":"1.0","method":"approvepending","params":[3],"id":1}`,
+			unmarshalled: &ApprovePendingCmd{ID: 3},
 		},
 		{
-			name: "sendmany",
+			name: "blockaddress",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("sendmany"), "from", `{"1Address":"0.5"}`)
+				return dcrjson.NewCmd(Method("blockaddress"), "1Address", "sanctioned")
 			},
 			staticCmd: func() any {
-				amounts := map[string]string{"1Address": "0.5"}
-				return NewSendManyCmd("from", amounts, nil, nil)
+				return NewBlockAddressCmd("1Address", dcrjson.String("sanctioned"))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"sendmany","params":["from",{"1Address":"0.5"}],"id":1}`,
-			unmarshalled: &SendManyCmd{
-				FromAccount: "from",
-				Amounts:     map[string]string{"1Address": "0.5"},
-				MinConf:     dcrjson.Int(1),
-				Comment:     nil,
+			marshalled: `{"jsonrpc":"1.0","method":"blockaddress","params":["1Address","sanctioned"],"id":1}`,
+			unmarshalled: &BlockAddressCmd{
+				Address: "1Address",
+				Reason:  dcrjson.String("sanctioned"),
 			},
 		},
 		{
-			name: "sendmany optional1",
+			name: "setlabelthreshold",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("sendmany"), "from", `{"1Address":"0.5"}`, 6)
+				return dcrjson.NewCmd(Method("setlabelthreshold"), "100")
 			},
 			staticCmd: func() any {
-				amounts := map[string]string{"1Address": "0.5"}
-				return NewSendManyCmd("from", amounts, dcrjson.Int(6), nil)
+				return NewSetLabelThresholdCmd("100", nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"sendmany","params":["from",{"1Address":"0.5"},6],"id":1}`,
-			unmarshalled: &SendManyCmd{
-				FromAccount: "from",
-				Amounts:     map[string]string{"1Address": "0.5"},
-				MinConf:     dcrjson.Int(6),
-				Comment:     nil,
+			marshalled: `{"jsonrpc":"1.0","method":"setlabelthreshold","params":["100"],"id":1}`,
+			unmarshalled: &SetLabelThresholdCmd{
+				Threshold: "100",
+				CoinType:  func() *uint8 { ct := uint8(0); return &ct }(),
 			},
 		},
 		{
-			name: "sendmany optional2",
+			name: "setloglevel",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("sendmany"), "from", `{"1Address":"0.5"}`, 6, "comment")
+				return dcrjson.NewCmd(Method("setloglevel"), "debug", "WLLT")
 			},
 			staticCmd: func() any {
-				amounts := map[string]string{"1Address": "0.5"}
-				return NewSendManyCmd("from", amounts, dcrjson.Int(6), dcrjson.String("comment"))
+				subsystem := "WLLT"
+				return NewSetLogLevelCmd("debug", &subsystem)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"sendmany","params":["from",{"1Address":"0.5"},6,"comment"],"id":1}`,
-			unmarshalled: &SendManyCmd{
-				FromAccount: "from",
-				Amounts:     map[string]string{"1Address": "0.5"},
-				MinConf:     dcrjson.Int(6),
-				Comment:     dcrjson.String("comment"),
+			marshalled: `{"jsonrpc":"1.0","method":"setloglevel","params":["debug","WLLT"],"id":1}`,
+			unmarshalled: &SetLogLevelCmd{
+				Level:     "debug",
+				Subsystem: func() *string { s := "WLLT"; return &s }(),
 			},
 		},
 		{
-			name: "sendtoaddress",
+			name: "setskasendaccounts",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("sendtoaddress"), "1Address", "0.5")
+				return dcrjson.NewCmd(Method("setskasendaccounts"), 1, []string{"treasury"})
 			},
 			staticCmd: func() any {
-				return NewSendToAddressCmd("1Address", "0.5", nil, nil)
+				return NewSetSKASendAccountsCmd(1, []string{"treasury"})
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"sendtoaddress","params":["1Address","0.5"],"id":1}`,
-			unmarshalled: &SendToAddressCmd{
-				Address:   "1Address",
-				Amount:    "0.5",
-				Comment:   nil,
-				CommentTo: nil,
+			marshalled: `{"jsonrpc":"1.0","method":"setskasendaccounts","params":[1,["treasury"]],"id":1}`,
+			unmarshalled: &SetSKASendAccountsCmd{
+				CoinType: 1,
+				Accounts: []string{"treasury"},
 			},
 		},
 		{
-			name: "sendtoaddress optional1",
+			name: "setstakingaccount",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("sendtoaddress"), "1Address", "0.5", "comment", "commentto")
+				return dcrjson.NewCmd(Method("setstakingaccount"), "voting")
 			},
 			staticCmd: func() any {
-				return NewSendToAddressCmd("1Address", "0.5", dcrjson.String("comment"),
-					dcrjson.String("commentto"))
+				return NewSetStakingAccountCmd("voting", nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"sendtoaddress","params":["1Address","0.5","comment","commentto"],"id":1}`,
-			unmarshalled: &SendToAddressCmd{
-				Address:   "1Address",
-				Amount:    "0.5",
-				Comment:   dcrjson.String("comment"),
-				CommentTo: dcrjson.String("commentto"),
+			marshalled: `{"jsonrpc":"1.0","method":"setstakingaccount","params":["voting"],"id":1}`,
+			unmarshalled: &SetStakingAccountCmd{
+				Account: "voting",
+				Staking: dcrjson.Bool(true),
 			},
 		},
 		{
-			name: "sendfromtreasury",
+			name: "setstakingaccount optional",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("sendfromtreasury"), "key", `{"1Address":0.5}`)
+				return dcrjson.NewCmd(Method("setstakingaccount"), "voting", false)
 			},
 			staticCmd: func() any {
-				var debits = map[string]float64{"1Address": 0.5}
-				return NewSendFromTreasuryCmd("key", debits)
+				return NewSetStakingAccountCmd("voting", dcrjson.Bool(false))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"sendfromtreasury","params":["key",{"1Address":0.5}],"id":1}`,
-			unmarshalled: &SendFromTreasuryCmd{
-				Key:     "key",
-				Amounts: map[string]float64{"1Address": 0.5},
+			marshalled: `{"jsonrpc":"1.0","method":"setstakingaccount","params":["voting",false],"id":1}`,
+			unmarshalled: &SetStakingAccountCmd{
+				Account: "voting",
+				Staking: dcrjson.Bool(false),
 			},
 		},
 		{
-			name: "sendtotreasury",
+			name: "setstakingpassphrase",
 			newCmd: func() (any, error) {
-				return dcrjson.NewCmd(Method("sendtotreasury"), 0.5)
+				return dcrjson.NewCmd(Method("setstakingpassphrase"), "pass")
 			},
 			staticCmd: func() any {
-				return NewSendToTreasuryCmd(0.5, nil, nil)
-			},
-			marshalled: `{"jsonrpc":"1.0","method":"sendtotreasury","params":[0.5],"id":1}`,
-			unmarshalled: &SendToTreasuryCmd{
-				Amount: 0.5,
+				return NewSetStakingPassphraseCmd("pass")
 			},
+			marshalled:   `{"jsonrpc":"1.0","method":"setstakingpassphrase","params":["pass"],"id":1}`,
+			unmarshalled: &SetStakingPassphraseCmd{Passphrase: "pass"},
 		},
 		{
 			name: "settspendpolicy",
@@ -1011,6 +2485,54 @@ func TestWalletSvrCmds(t *testing.T) {
 				Ticket: dcrjson.String("ticket"),
 			},
 		},
+		{
+			name: "setticketbuyerconfig",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("setticketbuyerconfig"), "default", 5)
+			},
+			staticCmd: func() any {
+				return NewSetTicketBuyerConfigCmd("default", 5, nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"setticketbuyerconfig","params":["default",5],"id":1}`,
+			unmarshalled: &SetTicketBuyerConfigCmd{
+				Account: "default",
+				Target:  5,
+			},
+		},
+		{
+			name: "setticketbuyerconfig optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("setticketbuyerconfig"), "default", 5, 200.0, 0.001, 10.0)
+			},
+			staticCmd: func() any {
+				return NewSetTicketBuyerConfigCmd("default", 5,
+					func(i float64) *float64 { return &i }(200),
+					func(i float64) *float64 { return &i }(0.001),
+					func(i float64) *float64 { return &i }(10))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"setticketbuyerconfig","params":["default",5,200,0.001,10],"id":1}`,
+			unmarshalled: &SetTicketBuyerConfigCmd{
+				Account:  "default",
+				Target:   5,
+				MaxPrice: func(i float64) *float64 { return &i }(200),
+				MaxFee:   func(i float64) *float64 { return &i }(0.001),
+				Reserve:  func(i float64) *float64 { return &i }(10),
+			},
+		},
+		{
+			name: "setticketcompounding",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("setticketcompounding"), "default", true)
+			},
+			staticCmd: func() any {
+				return NewSetTicketCompoundingCmd("default", true)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"setticketcompounding","params":["default",true],"id":1}`,
+			unmarshalled: &SetTicketCompoundingCmd{
+				Account: "default",
+				Enable:  true,
+			},
+		},
 		{
 			name: "settreasurypolicy",
 			newCmd: func() (any, error) {
@@ -1055,6 +2577,50 @@ func TestWalletSvrCmds(t *testing.T) {
 				CoinType: dcrjson.Int(0), // Default CoinType is 0 (VAR)
 			},
 		},
+		{
+			name: "setvsp",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("setvsp"), "https://vsp.example.com", "pubkey")
+			},
+			staticCmd: func() any {
+				return NewSetVSPCmd("https://vsp.example.com", "pubkey", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"setvsp","params":["https://vsp.example.com","pubkey"],"id":1}`,
+			unmarshalled: &SetVSPCmd{
+				Host:       "https://vsp.example.com",
+				PubKey:     "pubkey",
+				FeeAccount: dcrjson.String("default"),
+			},
+		},
+		{
+			name: "setvsp optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("setvsp"), "https://vsp.example.com", "pubkey", "fees")
+			},
+			staticCmd: func() any {
+				return NewSetVSPCmd("https://vsp.example.com", "pubkey", dcrjson.String("fees"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"setvsp","params":["https://vsp.example.com","pubkey","fees"],"id":1}`,
+			unmarshalled: &SetVSPCmd{
+				Host:       "https://vsp.example.com",
+				PubKey:     "pubkey",
+				FeeAccount: dcrjson.String("fees"),
+			},
+		},
+		{
+			name: "setwalletbirthday",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("setwalletbirthday"), 1700000000, true)
+			},
+			staticCmd: func() any {
+				return NewSetWalletBirthdayCmd(1700000000, dcrjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"setwalletbirthday","params":[1700000000,true],"id":1}`,
+			unmarshalled: &SetWalletBirthdayCmd{
+				Birthday:  1700000000,
+				Timestamp: dcrjson.Bool(true),
+			},
+		},
 		{
 			name: "signmessage",
 			newCmd: func() (any, error) {
@@ -1154,6 +2720,51 @@ func TestWalletSvrCmds(t *testing.T) {
 				Flags:    dcrjson.String("ALL"),
 			},
 		},
+		{
+			name: "signrawtransaction optional4",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("signrawtransaction"), "001122", `[{"txid":"123","vout":1,"tree":0,"scriptPubKey":"00","redeemScript":"","skaValueIn":"100000000000000000000"}]`, `["abc"]`)
+			},
+			staticCmd: func() any {
+				txInputs := []RawTxInput{
+					{
+						Txid:         "123",
+						Vout:         1,
+						ScriptPubKey: "00",
+						SKAValueIn:   dcrjson.String("100000000000000000000"),
+					},
+				}
+				privKeys := []string{"abc"}
+				return NewSignRawTransactionCmd("001122", &txInputs, &privKeys, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"signrawtransaction","params":["001122",[{"txid":"123","vout":1,"tree":0,"scriptPubKey":"00","redeemScript":"","skaValueIn":"100000000000000000000"}],["abc"]],"id":1}`,
+			unmarshalled: &SignRawTransactionCmd{
+				RawTx: "001122",
+				Inputs: &[]RawTxInput{
+					{
+						Txid:         "123",
+						Vout:         1,
+						ScriptPubKey: "00",
+						SKAValueIn:   dcrjson.String("100000000000000000000"),
+					},
+				},
+				PrivKeys: &[]string{"abc"},
+				Flags:    dcrjson.String("ALL"),
+			},
+		},
+		{
+			name: "signrawtransactionoffline",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("signrawtransactionoffline"), `{"version":1}`)
+			},
+			staticCmd: func() any {
+				return NewSignRawTransactionOfflineCmd(`{"version":1}`)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"signrawtransactionoffline","params":["{\"version\":1}"],"id":1}`,
+			unmarshalled: &SignRawTransactionOfflineCmd{
+				File: `{"version":1}`,
+			},
+		},
 		{
 			name: "sweepaccount - optionals provided",
 			newCmd: func() (any, error) {
@@ -1172,6 +2783,26 @@ func TestWalletSvrCmds(t *testing.T) {
 				FeePerKb:              func(i float64) *float64 { return &i }(0.05),
 			},
 		},
+		{
+			name: "sweepaccount - cointype provided",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("sweepaccount"), "default", "DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu", 6, 0.05, 1)
+			},
+			staticCmd: func() any {
+				return NewSweepAccountCmdWithCoinType("default", "DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu",
+					func(i uint32) *uint32 { return &i }(6),
+					func(i float64) *float64 { return &i }(0.05),
+					func(i uint8) *uint8 { return &i }(1))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sweepaccount","params":["default","DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu",6,0.05,1],"id":1}`,
+			unmarshalled: &SweepAccountCmd{
+				SourceAccount:         "default",
+				DestinationAddress:    "DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu",
+				RequiredConfirmations: func(i uint32) *uint32 { return &i }(6),
+				FeePerKb:              func(i float64) *float64 { return &i }(0.05),
+				CoinType:              func(i uint8) *uint8 { return &i }(1),
+			},
+		},
 		{
 			name: "sweepaccount - optionals omitted",
 			newCmd: func() (any, error) {
@@ -1186,6 +2817,54 @@ func TestWalletSvrCmds(t *testing.T) {
 				DestinationAddress: "DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu",
 			},
 		},
+		{
+			name: "unarchiveaccount",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("unarchiveaccount"), "acct")
+			},
+			staticCmd: func() any {
+				return NewUnarchiveAccountCmd("acct")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"unarchiveaccount","params":["acct"],"id":1}`,
+			unmarshalled: &UnarchiveAccountCmd{
+				Account: "acct",
+			},
+		},
+		{
+			name: "unlockstaking",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("unlockstaking"), "pass")
+			},
+			staticCmd: func() any {
+				return NewUnlockStakingCmd("pass")
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"unlockstaking","params":["pass"],"id":1}`,
+			unmarshalled: &UnlockStakingCmd{Passphrase: "pass"},
+		},
+		{
+			name: "verifyseed",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("verifyseed"), "aardvark adroitness")
+			},
+			staticCmd: func() any {
+				return NewVerifySeedCmd("aardvark adroitness")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"verifyseed","params":["aardvark adroitness"],"id":1}`,
+			unmarshalled: &VerifySeedCmd{
+				Mnemonic: "aardvark adroitness",
+			},
+		},
+		{
+			name: "walletaudit",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("walletaudit"))
+			},
+			staticCmd: func() any {
+				return NewWalletAuditCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"walletaudit","params":[],"id":1}`,
+			unmarshalled: &WalletAuditCmd{},
+		},
 		{
 			name: "walletlock",
 			newCmd: func() (any, error) {
@@ -1203,12 +2882,28 @@ func TestWalletSvrCmds(t *testing.T) {
 				return dcrjson.NewCmd(Method("walletpassphrase"), "pass", 60)
 			},
 			staticCmd: func() any {
-				return NewWalletPassphraseCmd("pass", 60)
+				return NewWalletPassphraseCmd("pass", 60, nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"walletpassphrase","params":["pass",60],"id":1}`,
 			unmarshalled: &WalletPassphraseCmd{
 				Passphrase: "pass",
 				Timeout:    60,
+				Mode:       dcrjson.String("all"),
+			},
+		},
+		{
+			name: "walletpassphrase optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("walletpassphrase"), "pass", 0, "once")
+			},
+			staticCmd: func() any {
+				return NewWalletPassphraseCmd("pass", 0, dcrjson.String("once"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"walletpassphrase","params":["pass",0,"once"],"id":1}`,
+			unmarshalled: &WalletPassphraseCmd{
+				Passphrase: "pass",
+				Timeout:    0,
+				Mode:       dcrjson.String("once"),
 			},
 		},
 		{
@@ -1225,6 +2920,36 @@ func TestWalletSvrCmds(t *testing.T) {
 				NewPassphrase: "new",
 			},
 		},
+		{
+			name: "walletprocesspsdt",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("walletprocesspsdt"), "cHNkdP8=")
+			},
+			staticCmd: func() any {
+				return NewWalletProcessPSDTCmd("cHNkdP8=", nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"walletprocesspsdt","params":["cHNkdP8="],"id":1}`,
+			unmarshalled: &WalletProcessPSDTCmd{
+				PSDT:     "cHNkdP8=",
+				Sign:     dcrjson.Bool(true),
+				Finalize: dcrjson.Bool(true),
+			},
+		},
+		{
+			name: "walletprocesspsdt optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("walletprocesspsdt"), "cHNkdP8=", false, false)
+			},
+			staticCmd: func() any {
+				return NewWalletProcessPSDTCmd("cHNkdP8=", dcrjson.Bool(false), dcrjson.Bool(false))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"walletprocesspsdt","params":["cHNkdP8=",false,false],"id":1}`,
+			unmarshalled: &WalletProcessPSDTCmd{
+				PSDT:     "cHNkdP8=",
+				Sign:     dcrjson.Bool(false),
+				Finalize: dcrjson.Bool(false),
+			},
+		},
 		{
 			name: "walletpubpassphrasechange",
 			newCmd: func() (any, error) {
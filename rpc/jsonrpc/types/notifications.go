@@ -0,0 +1,145 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package types
+
+import "github.com/monetarium/monetarium-node/dcrjson"
+
+// CoinTypeBalanceNtfnMethod is the method of the notification sent to
+// websocket clients registered with notifycointypebalance.
+const CoinTypeBalanceNtfnMethod Method = "cointypebalance"
+
+// CoinTypeBalanceNtfn defines the cointypebalance JSON-RPC notification.  It
+// reports the new spendable balance of each account whose spendable balance
+// of the coin type changed.
+type CoinTypeBalanceNtfn struct {
+	CoinType uint8
+	Balances []CoinTypeSpendableBalance
+}
+
+// CoinTypeSpendableBalance describes the spendable balance of a coin type in
+// an account.  Spendable is a float64 for VAR and a decimal string for SKA
+// coins, preserving full precision.
+type CoinTypeSpendableBalance struct {
+	Account     uint32      `json:"account"`
+	AccountName string      `json:"accountname"`
+	Spendable   interface{} `json:"spendable"`
+}
+
+// NewCoinTypeBalanceNtfn returns a new instance which can be used to issue a
+// cointypebalance JSON-RPC notification.
+func NewCoinTypeBalanceNtfn(coinType uint8, balances []CoinTypeSpendableBalance) *CoinTypeBalanceNtfn {
+	return &CoinTypeBalanceNtfn{
+		CoinType: coinType,
+		Balances: balances,
+	}
+}
+
+// TxConflictNtfnMethod is the method of the notification sent to websocket
+// clients registered with notifytxconflicts.
+const TxConflictNtfnMethod Method = "txconflict"
+
+// TxConflictNtfn defines the txconflict JSON-RPC notification.  It reports a
+// transaction which double spends unmined wallet transactions.  When Mined is
+// true, the conflicting wallet transactions were removed.  Otherwise, the
+// transaction is a competing unmined spend and the wallet transactions remain
+// unmined.
+type TxConflictNtfn struct {
+	TxHash    string
+	Conflicts []string
+	Mined     bool
+}
+
+// NewTxConflictNtfn returns a new instance which can be used to issue a
+// txconflict JSON-RPC notification.
+func NewTxConflictNtfn(txHash string, conflicts []string, mined bool) *TxConflictNtfn {
+	return &TxConflictNtfn{
+		TxHash:    txHash,
+		Conflicts: conflicts,
+		Mined:     mined,
+	}
+}
+
+// InvoiceNtfnMethod is the method of the notification sent to websocket
+// clients registered with notifyinvoices.
+const InvoiceNtfnMethod Method = "invoice"
+
+// InvoiceNtfn defines the invoice JSON-RPC notification.  It reports an
+// invoice which was paid or expired, as described by the invoice status.
+type InvoiceNtfn struct {
+	Invoice InvoiceResult
+}
+
+// NewInvoiceNtfn returns a new instance which can be used to issue an invoice
+// JSON-RPC notification.
+func NewInvoiceNtfn(invoice InvoiceResult) *InvoiceNtfn {
+	return &InvoiceNtfn{
+		Invoice: invoice,
+	}
+}
+
+// RecurringPaymentNtfnMethod is the method of the notification sent to
+// websocket clients registered with notifyrecurringpayments.
+const RecurringPaymentNtfnMethod Method = "recurringpayment"
+
+// RecurringPaymentNtfn defines the recurringpayment JSON-RPC notification.  It
+// reports an attempted payment of a recurring payment, whose outcome is
+// described by the last transaction and error of the recurring payment.
+type RecurringPaymentNtfn struct {
+	Payment RecurringPaymentResult
+}
+
+// NewRecurringPaymentNtfn returns a new instance which can be used to issue a
+// recurringpayment JSON-RPC notification.
+func NewRecurringPaymentNtfn(payment RecurringPaymentResult) *RecurringPaymentNtfn {
+	return &RecurringPaymentNtfn{
+		Payment: payment,
+	}
+}
+
+// ConfirmationThresholdNtfnMethod is the method of the notification sent to
+// websocket clients registered with notifytxconfirmations or
+// notifyaccountconfirmations.
+const ConfirmationThresholdNtfnMethod Method = "confirmationthreshold"
+
+// ConfirmationThresholdNtfn defines the confirmationthreshold JSON-RPC
+// notification.  It reports a transaction reaching the confirmations of a
+// watch.  Account is the watched account of notifyaccountconfirmations
+// watches, and empty for transaction watches.  Confirmations is -1, with an
+// empty block hash and block height -1, when a watched transaction was
+// removed from the wallet.
+type ConfirmationThresholdNtfn struct {
+	TxHash        string
+	Account       string
+	Confirmations int32
+	BlockHash     string
+	BlockHeight   int32
+}
+
+// NewConfirmationThresholdNtfn returns a new instance which can be used to
+// issue a confirmationthreshold JSON-RPC notification.
+func NewConfirmationThresholdNtfn(txHash, account string, confirmations int32,
+	blockHash string, blockHeight int32) *ConfirmationThresholdNtfn {
+
+	return &ConfirmationThresholdNtfn{
+		TxHash:        txHash,
+		Account:       account,
+		Confirmations: confirmations,
+		BlockHash:     blockHash,
+		BlockHeight:   blockHeight,
+	}
+}
+
+func init() {
+	dcrjson.MustRegister(CoinTypeBalanceNtfnMethod, (*CoinTypeBalanceNtfn)(nil),
+		dcrjson.UFWebsocketOnly|dcrjson.UFNotification)
+	dcrjson.MustRegister(TxConflictNtfnMethod, (*TxConflictNtfn)(nil),
+		dcrjson.UFWebsocketOnly|dcrjson.UFNotification)
+	dcrjson.MustRegister(InvoiceNtfnMethod, (*InvoiceNtfn)(nil),
+		dcrjson.UFWebsocketOnly|dcrjson.UFNotification)
+	dcrjson.MustRegister(RecurringPaymentNtfnMethod, (*RecurringPaymentNtfn)(nil),
+		dcrjson.UFWebsocketOnly|dcrjson.UFNotification)
+	dcrjson.MustRegister(ConfirmationThresholdNtfnMethod, (*ConfirmationThresholdNtfn)(nil),
+		dcrjson.UFWebsocketOnly|dcrjson.UFNotification)
+}
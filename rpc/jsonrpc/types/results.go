@@ -5,6 +5,15 @@
 
 package types
 
+// FinalizePSDTResult models the data returned from the finalizepsdt command.
+// Hex is the signed transaction, and is only set when the PSDT is complete and
+// the transaction was extracted.
+type FinalizePSDTResult struct {
+	PSDT     string `json:"psdt"`
+	Complete bool   `json:"complete"`
+	Hex      string `json:"hex,omitempty"`
+}
+
 // FundRawTransactionResult models the data from the fundrawtransaction command.
 type FundRawTransactionResult struct {
 	Hex string  `json:"hex"`
@@ -39,6 +48,25 @@ type GetBalanceResult struct {
 	TotalVotingAuthority         interface{}               `json:"totalvotingauthority,omitempty"`
 }
 
+// GetMaxSpendableResult models the data returned from the getmaxspendable
+// command.
+type GetMaxSpendableResult struct {
+	CoinType uint8       `json:"cointype"`
+	Amount   interface{} `json:"amount"`
+	Fee      interface{} `json:"fee"`
+	Size     int         `json:"size"`
+	Inputs   int         `json:"inputs"`
+	Eligible int         `json:"eligible"`
+}
+
+// GetMigrationHistoryResult models objects returned by the getmigrationhistory
+// command.
+type GetMigrationHistoryResult struct {
+	Version     uint32 `json:"version"`
+	Description string `json:"description"`
+	Time        int64  `json:"time"`
+}
+
 // GetMultisigOutInfoResult models the data returned from the getmultisigoutinfo
 // command.
 type GetMultisigOutInfoResult struct {
@@ -56,6 +84,54 @@ type GetMultisigOutInfoResult struct {
 	Amount       float64  `json:"amount"`
 }
 
+// CompactWalletResult models the data returned from the compactwallet
+// command.
+type CompactWalletResult struct {
+	Active             bool                  `json:"active"`
+	Stage              string                `json:"stage,omitempty"`
+	Percent            float64               `json:"percent"`
+	PruneDepth         int32                 `json:"prunedepth,omitempty"`
+	PrunedTransactions int                   `json:"prunedtransactions"`
+	PrunedHistory      []PrunedHistoryResult `json:"prunedhistory"`
+}
+
+// PrunedHistoryResult models the aggregate history of the pruned
+// transactions of a coin type returned by the compactwallet command.
+type PrunedHistoryResult struct {
+	CoinType     uint8       `json:"cointype"`
+	Transactions uint32      `json:"transactions"`
+	FirstHeight  int32       `json:"firstheight"`
+	LastHeight   int32       `json:"lastheight"`
+	Received     interface{} `json:"received"`
+	Sent         interface{} `json:"sent"`
+}
+
+// AuditContractResult models the data returned from the auditcontract
+// command.
+type AuditContractResult struct {
+	Address         string `json:"address"`
+	Vout            uint32 `json:"vout"`
+	CoinType        uint8  `json:"cointype"`
+	Amount          string `json:"amount"`
+	Recipient       string `json:"recipient"`
+	Refund          string `json:"refund"`
+	SecretHash      string `json:"secrethash"`
+	LockTime        int64  `json:"locktime"`
+	LockTimeReached bool   `json:"locktimereached"`
+}
+
+// CreateContractResult models the data returned from the createcontract
+// command.  Secret is only set when the wallet generated the secret.
+type CreateContractResult struct {
+	TxID       string `json:"txid"`
+	Tx         string `json:"tx"`
+	Contract   string `json:"contract"`
+	Address    string `json:"address"`
+	SecretHash string `json:"secrethash"`
+	Secret     string `json:"secret,omitempty"`
+	LockTime   int64  `json:"locktime"`
+}
+
 // CreateMultiSigResult models the data returned from the createmultisig
 // command.
 type CreateMultiSigResult struct {
@@ -63,6 +139,16 @@ type CreateMultiSigResult struct {
 	RedeemScript string `json:"redeemScript"`
 }
 
+// CounterpartySummaryResult models the data returned from the
+// counterpartysummary command.
+type CounterpartySummaryResult struct {
+	Counterparty string      `json:"counterparty"`
+	CoinType     uint8       `json:"cointype"`
+	Sent         interface{} `json:"sent"`
+	Received     interface{} `json:"received"`
+	Transactions int         `json:"transactions"`
+}
+
 // CreateSignatureResult models the data returned from the createsignature
 // command.
 type CreateSignatureResult struct {
@@ -70,6 +156,45 @@ type CreateSignatureResult struct {
 	PublicKey string `json:"publickey"`
 }
 
+// DecodePaymentURIResult models the data returned from the decodepaymenturi
+// command.
+type DecodePaymentURIResult struct {
+	Address  string `json:"address"`
+	Amount   string `json:"amount,omitempty"`
+	CoinType uint8  `json:"cointype"`
+	Label    string `json:"label,omitempty"`
+	Message  string `json:"message,omitempty"`
+	Expires  int64  `json:"expires,omitempty"`
+	Expired  bool   `json:"expired"`
+}
+
+// InvoiceResult models an invoice of the data returned by the createinvoice,
+// getinvoice, and listinvoices commands, and of invoice notifications.
+// Amounts are a float64 for VAR and a string for SKA (full precision).
+type InvoiceResult struct {
+	ID       uint32                 `json:"id"`
+	Address  string                 `json:"address"`
+	CoinType uint8                  `json:"cointype"`
+	Amount   interface{}            `json:"amount"`
+	Received interface{}            `json:"received"`
+	Label    string                 `json:"label,omitempty"`
+	Created  int64                  `json:"created"`
+	Expires  int64                  `json:"expires,omitempty"`
+	Status   string                 `json:"status"`
+	URI      string                 `json:"uri"`
+	Payments []InvoicePaymentResult `json:"payments"`
+}
+
+// InvoicePaymentResult describes a wallet output paying an invoice.  Height
+// is -1 for unmined outputs.
+type InvoicePaymentResult struct {
+	TxID   string      `json:"txid"`
+	Vout   uint32      `json:"vout"`
+	Tree   int8        `json:"tree"`
+	Height int32       `json:"height"`
+	Amount interface{} `json:"amount"`
+}
+
 // CreateAuthorizedEmissionResult models the data returned from the createauthorizedemission
 // command.
 type CreateAuthorizedEmissionResult struct {
@@ -103,6 +228,38 @@ type GetWalletFeeResult struct {
 	Source string  `json:"source"` // Source of the fee: "manual", "rpc", or "static"
 }
 
+// GetWalletDBVersionResult models the data returned from the
+// getwalletdbversion command.
+type GetWalletDBVersionResult struct {
+	Version           uint32 `json:"version"`
+	CompatibleVersion uint32 `json:"compatibleversion"`
+	LatestVersion     uint32 `json:"latestversion"`
+}
+
+// GetWalletLockStateResult models the data returned from the
+// getwalletlockstate command.
+type GetWalletLockStateResult struct {
+	Unlocked        bool   `json:"unlocked"`
+	Mode            string `json:"mode,omitempty"`
+	Expires         int64  `json:"expires,omitempty"`
+	SecondsLeft     int64  `json:"secondsleft,omitempty"`
+	StakingUnlocked bool   `json:"stakingunlocked"`
+}
+
+// GetKDFInfoResult models the data returned from the getkdfinfo command.
+type GetKDFInfoResult struct {
+	Algorithm      string `json:"algorithm"`
+	N              int    `json:"n,omitempty"`
+	R              int    `json:"r,omitempty"`
+	P              int    `json:"p,omitempty"`
+	Time           uint32 `json:"time,omitempty"`
+	Memory         uint32 `json:"memory,omitempty"`
+	Threads        uint32 `json:"threads,omitempty"`
+	TargetTime     uint32 `json:"targettime"`
+	TargetMemory   uint32 `json:"targetmemory"`
+	UpgradePending bool   `json:"upgradepending"`
+}
+
 // GetPeerInfoResult models the data returned from the getpeerinfo command.
 type GetPeerInfoResult struct {
 	ID             int32  `json:"id"`
@@ -115,6 +272,52 @@ type GetPeerInfoResult struct {
 	BanScore       int32  `json:"banscore"`
 }
 
+// GetRescanStatusResult models the data returned by the getrescanstatus
+// command.
+type GetRescanStatusResult struct {
+	Rescanning  bool    `json:"rescanning"`
+	StartHeight int32   `json:"startheight"`
+	Height      int32   `json:"height"`
+	TipHeight   int32   `json:"tipheight"`
+	Percent     float64 `json:"percent"`
+	ETA         int64   `json:"eta"`
+}
+
+// RPCMethodStatsResult models the statistics of the recorded requests of a
+// method returned by the getrpcstats command.  Durations are in seconds.
+type RPCMethodStatsResult struct {
+	Method        string  `json:"method"`
+	Count         int     `json:"count"`
+	Errors        int     `json:"errors"`
+	Slow          int     `json:"slow"`
+	MeanDuration  float64 `json:"meanduration"`
+	MaxDuration   float64 `json:"maxduration"`
+	MeanLockWait  float64 `json:"meanlockwait"`
+	MeanDBViews   float64 `json:"meandbviews"`
+	MeanDBUpdates float64 `json:"meandbupdates"`
+}
+
+// RPCTraceResult models a recorded request returned by the getrpcstats
+// command.  Durations are in seconds.
+type RPCTraceResult struct {
+	Method    string  `json:"method"`
+	RequestID string  `json:"requestid"`
+	Time      int64   `json:"time"`
+	Duration  float64 `json:"duration"`
+	LockWait  float64 `json:"lockwait"`
+	DBViews   int     `json:"dbviews"`
+	DBUpdates int     `json:"dbupdates"`
+	Slow      bool    `json:"slow"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// GetRPCStatsResult models the data returned by the getrpcstats command.
+type GetRPCStatsResult struct {
+	SlowThreshold float64                `json:"slowthreshold"`
+	Methods       []RPCMethodStatsResult `json:"methods"`
+	Recent        []RPCTraceResult       `json:"recent"`
+}
+
 // GetStakeInfoResult models the data returned from the getstakeinfo
 // command.
 type GetStakeInfoResult struct {
@@ -139,6 +342,104 @@ type GetStakeInfoResult struct {
 	Expired          uint32  `json:"expired,omitempty"`
 }
 
+// GetFeeSummaryResult models the data returned from the getfeesummary
+// command.
+type GetFeeSummaryResult struct {
+	Days      int                     `json:"days"`
+	Since     int64                   `json:"since"`
+	CoinTypes []FeeSummaryCoinType    `json:"cointypes"`
+	Daily     []FeeSummaryDailyResult `json:"daily"`
+}
+
+// FeeSummaryCoinType describes the fees of a coin type paid over the window
+// of a getfeesummary result.  Amounts are a float64 for VAR and a string for
+// SKA (full precision).
+type FeeSummaryCoinType struct {
+	CoinType     uint8       `json:"cointype"`
+	Transactions uint32      `json:"transactions"`
+	TotalFees    interface{} `json:"totalfees"`
+	AverageFee   interface{} `json:"averagefee"`
+	DailyAverage interface{} `json:"dailyaverage"`
+}
+
+// FeeSummaryDailyResult describes the fees of a coin type paid on a day of
+// the window of a getfeesummary result.
+type FeeSummaryDailyResult struct {
+	Date         string      `json:"date"`
+	CoinType     uint8       `json:"cointype"`
+	Transactions uint32      `json:"transactions"`
+	Fees         interface{} `json:"fees"`
+}
+
+// SKAEmissionResult describes an SKA emission observed by the wallet in a
+// getskaemissionhistory result.  The emission key and nonce are omitted when
+// the emitter script is not a valid emission authorization.
+type SKAEmissionResult struct {
+	CoinType      uint8  `json:"cointype"`
+	Height        int32  `json:"height"`
+	TxID          string `json:"txid"`
+	Amount        string `json:"amount"`
+	EmissionKey   string `json:"emissionkey,omitempty"`
+	Nonce         uint64 `json:"nonce,omitempty"`
+	EmitterScript string `json:"emitterscript"`
+}
+
+// SKASupplyResult describes the supply of an SKA coin type in a getskasupply
+// result.  MaxSupply and Remaining are omitted for coin types not configured
+// by the network parameters.
+type SKASupplyResult struct {
+	CoinType           uint8  `json:"cointype"`
+	MaxSupply          string `json:"maxsupply,omitempty"`
+	Emitted            string `json:"emitted"`
+	Remaining          string `json:"remaining,omitempty"`
+	Emissions          uint32 `json:"emissions"`
+	LastEmissionHeight int32  `json:"lastemissionheight"`
+}
+
+// GetStakeStatsResult models the data returned from the getstakestats
+// command.
+type GetStakeStatsResult struct {
+	BlockHeight int32 `json:"blockheight"`
+
+	Live     uint32 `json:"live"`
+	Immature uint32 `json:"immature"`
+	Missed   uint32 `json:"missed"`
+	Expired  uint32 `json:"expired"`
+	Revoked  uint32 `json:"revoked"`
+
+	FeeRewards []StakeStatsFeeReward `json:"feerewards"`
+
+	Window            int32   `json:"window"`
+	Votes             uint32  `json:"votes"`
+	VoteSuccessRate   float64 `json:"votesuccessrate"`
+	AverageVoteReward float64 `json:"averagevotereward"`
+}
+
+// GetTransactionsPageResult models the data returned from the
+// gettransactionspage command.
+type GetTransactionsPageResult struct {
+	Transactions []TransactionsPageTx `json:"transactions"`
+	NextCursor   string               `json:"nextcursor,omitempty"`
+}
+
+// TransactionsPageTx describes a transaction of a gettransactionspage result.
+type TransactionsPageTx struct {
+	TxID        string `json:"txid"`
+	BlockHash   string `json:"blockhash,omitempty"`
+	BlockHeight int32  `json:"blockheight"`
+	Time        int64  `json:"time"`
+	TxClass     string `json:"txclass"`
+	Hex         string `json:"hex"`
+}
+
+// StakeStatsFeeReward describes the total SSFee rewards of a coin type earned
+// by the wallet.  Amount is a float64 for VAR and a string for SKA (full
+// precision).
+type StakeStatsFeeReward struct {
+	CoinType uint8       `json:"cointype"`
+	Amount   interface{} `json:"amount"`
+}
+
 // GetTicketsResult models the data returned from the gettickets
 // command.
 type GetTicketsResult struct {
@@ -178,6 +479,24 @@ type GetTransactionResult struct {
 	Hex             string                        `json:"hex"`
 	Type            string                        `json:"type"`
 	TicketStatus    string                        `json:"ticketstatus,omitempty"`
+	Label           string                        `json:"label,omitempty"`
+	FiatCurrency    string                        `json:"fiatcurrency,omitempty"`
+	FiatPrice       string                        `json:"fiatprice,omitempty"`
+	FiatPriceTime   int64                         `json:"fiatpricetime,omitempty"`
+}
+
+// GetTxTraceResult models the data returned by the gettxtrace command.
+type GetTxTraceResult struct {
+	TxHash  string               `json:"txhash"`
+	TraceID string               `json:"traceid"`
+	Events  []TxTraceEventResult `json:"events"`
+}
+
+// TxTraceEventResult models a lifecycle event of a traced transaction.
+type TxTraceEventResult struct {
+	Stage  string `json:"stage"`
+	Time   int64  `json:"time"`
+	Height int32  `json:"height,omitempty"`
 }
 
 // GetCFilterV2Result models the data returned from the getcfilterv2 command.
@@ -191,6 +510,7 @@ type GetCFilterV2Result struct {
 type VoteChoice struct {
 	AgendaID          string `json:"agendaid"`
 	AgendaDescription string `json:"agendadescription,omitempty"`
+	AgendaStatus      string `json:"agendastatus,omitempty"`
 	ChoiceID          string `json:"choiceid"`
 	ChoiceDescription string `json:"choicedescription,omitempty"`
 }
@@ -207,6 +527,18 @@ type GetVoteFeeConsolidationAddressResult struct {
 	Account   string `json:"account"`
 	Address   string `json:"address"`
 	IsDefault bool   `json:"isdefault"` // True if using auto-default (first external address)
+	External  bool   `json:"external"`  // True if the address is not controlled by the account
+}
+
+// GetVSPTicketStatusResult models the data returned from the
+// getvspticketstatus command.
+type GetVSPTicketStatusResult struct {
+	TicketHash      string `json:"tickethash"`
+	Host            string `json:"host"`
+	FeeTxHash       string `json:"feetxhash,omitempty"`
+	FeeTxStatus     string `json:"feetxstatus"`
+	VSPFeeTxStatus  string `json:"vspfeetxstatus,omitempty"`
+	TicketConfirmed bool   `json:"ticketconfirmed,omitempty"`
 }
 
 // SyncStatusResult models the data returned by the syncstatus command.
@@ -280,6 +612,7 @@ type ListTransactionsResult struct {
 	WalletConflicts   []string                `json:"walletconflicts"`
 	Comment           string                  `json:"comment,omitempty"`
 	OtherAccount      string                  `json:"otheraccount,omitempty"`
+	Counterparty      string                  `json:"counterparty,omitempty"`
 }
 
 // ListReceivedByAccountResult models the data from the listreceivedbyaccount
@@ -325,6 +658,33 @@ type ListUnspentResult struct {
 	CoinType      uint8       `json:"cointype"` // Dual-coin support: coin type (0=VAR, 1-255=SKA)
 }
 
+// PlanConsolidationResult models the data returned for each coin type by the
+// planconsolidation command.
+// Fee uses interface{} to support both VAR (float64) and SKA (string with full precision).
+type PlanConsolidationResult struct {
+	CoinType     uint8       `json:"cointype"`
+	UTXOs        int         `json:"utxos"`
+	Transactions int         `json:"transactions"`
+	Fee          interface{} `json:"fee"`
+	ResultUTXOs  int         `json:"resultutxos"`
+}
+
+// RecoveredOutputResult models objects returned by the recoverunspent command.
+type RecoveredOutputResult struct {
+	TxID     string      `json:"txid"`
+	Vout     uint32      `json:"vout"`
+	Tree     int8        `json:"tree"`
+	Address  string      `json:"address,omitempty"`
+	Account  string      `json:"account"`
+	CoinType uint8       `json:"cointype"`
+	Amount   interface{} `json:"amount"`
+	Height   int32       `json:"height"`
+	TxType   int         `json:"txtype"`
+	Coinbase bool        `json:"coinbase,omitempty"`
+	Mature   bool        `json:"mature"`
+	Verified bool        `json:"verified"`
+}
+
 // RedeemMultiSigOutResult models the data returned from the redeemmultisigout
 // command.
 type RedeemMultiSigOutResult struct {
@@ -339,6 +699,50 @@ type RedeemMultiSigOutsResult struct {
 	Results []RedeemMultiSigOutResult `json:"results"`
 }
 
+// EstimateSendFeeResult models the data returned from the estimatesendfee
+// command.
+type EstimateSendFeeResult struct {
+	CoinType uint8                        `json:"cointype"`
+	Fee      interface{}                  `json:"fee"`
+	FeeRate  interface{}                  `json:"feerate"`
+	Size     int                          `json:"size"`
+	Inputs   []EstimateSendFeeInputResult `json:"inputs"`
+	Change   interface{}                  `json:"change,omitempty"`
+}
+
+// EstimateSendFeeInputResult describes an output selected to be spent by an
+// estimated send.
+type EstimateSendFeeInputResult struct {
+	TxID   string      `json:"txid"`
+	Vout   uint32      `json:"vout"`
+	Tree   int8        `json:"tree"`
+	Amount interface{} `json:"amount"`
+}
+
+// FiatSendResult models the data returned from the sendfrom, sendmany and
+// sendtoaddress commands when amounts are denominated in a fiat currency.
+type FiatSendResult struct {
+	TxID       string `json:"txid"`
+	Rate       string `json:"rate"`
+	Currency   string `json:"currency"`
+	RateTime   int64  `json:"ratetime"`
+	RateSource string `json:"ratesource"`
+	Label      string `json:"label"`
+	LabelError string `json:"labelerror,omitempty"`
+
+	// FeePreference and FeeRate are set for sends at a fee preference.
+	FeePreference string      `json:"feepreference,omitempty"`
+	FeeRate       interface{} `json:"feerate,omitempty"`
+}
+
+// FeePreferenceSendResult models the data returned from the sendfrom,
+// sendmany and sendtoaddress commands when a fee preference is specified.
+type FeePreferenceSendResult struct {
+	TxID          string      `json:"txid"`
+	FeePreference string      `json:"feepreference"`
+	FeeRate       interface{} `json:"feerate"`
+}
+
 // SendToMultiSigResult models the data returned from the sendtomultisig
 // command.
 type SendToMultiSigResult struct {
@@ -390,16 +794,179 @@ type SweepAccountResult struct {
 
 // TicketInfoResult models the data returned from the ticketinfo command.
 type TicketInfoResult struct {
-	Hash          string       `json:"hash"`
-	Cost          float64      `json:"cost"`
-	VotingAddress string       `json:"votingaddress"`
-	Status        string       `json:"status"`
-	BlockHash     string       `json:"blockhash,omitempty"`
-	BlockHeight   int32        `json:"blockheight"`
-	Vote          string       `json:"vote,omitempty"`
-	Revocation    string       `json:"revocation,omitempty"`
-	Choices       []VoteChoice `json:"choices,omitempty"`
-	VSPHost       string       `json:"vsphost,omitempty"`
+	Hash            string       `json:"hash"`
+	Cost            float64      `json:"cost"`
+	VotingAddress   string       `json:"votingaddress"`
+	Status          string       `json:"status"`
+	BlockHash       string       `json:"blockhash,omitempty"`
+	BlockHeight     int32        `json:"blockheight"`
+	Vote            string       `json:"vote,omitempty"`
+	Revocation      string       `json:"revocation,omitempty"`
+	Choices         []VoteChoice `json:"choices,omitempty"`
+	VSPHost         string       `json:"vsphost,omitempty"`
+	FeeRewardFunded bool         `json:"feerewardfunded,omitempty"`
+}
+
+// TicketBuyerConfigResult models objects returned by the ticketbuyerconfig
+// command.
+type TicketBuyerConfigResult struct {
+	Account  string  `json:"account"`
+	Target   uint32  `json:"target"`
+	Tickets  int     `json:"tickets"`
+	MaxPrice float64 `json:"maxprice"`
+	MaxFee   float64 `json:"maxfee"`
+	Reserve  float64 `json:"reserve"`
+}
+
+// ChangeAccountResult models objects returned by the changeaccounts command.
+type ChangeAccountResult struct {
+	Account       string `json:"account"`
+	CoinType      uint8  `json:"cointype"`
+	ChangeAccount string `json:"changeaccount"`
+}
+
+// ChangeScriptTypeResult models objects returned by the changescripttypes
+// command.
+type ChangeScriptTypeResult struct {
+	Account    string `json:"account"`
+	ScriptType string `json:"scripttype"`
+}
+
+// SpendLimitResult models objects returned by the listspendlimits command.
+type SpendLimitResult struct {
+	Account         string      `json:"account"`
+	CoinType        uint8       `json:"cointype"`
+	Limit           interface{} `json:"limit"`
+	SpentToday      interface{} `json:"spenttoday"`
+	RequireApproval bool        `json:"requireapproval"`
+}
+
+// PendingBroadcastResult models objects returned by the listpendingbroadcasts
+// command.
+type PendingBroadcastResult struct {
+	TxID        string `json:"txid"`
+	Queued      int64  `json:"queued"`
+	Attempts    uint32 `json:"attempts"`
+	LastAttempt int64  `json:"lastattempt,omitempty"`
+	NextAttempt int64  `json:"nextattempt"`
+	LastError   string `json:"lasterror,omitempty"`
+}
+
+// PendingSendResult models objects returned by the listpendingsends command.
+type PendingSendResult struct {
+	ID       uint32                    `json:"id"`
+	Account  string                    `json:"account"`
+	CoinType uint8                     `json:"cointype"`
+	Amount   interface{}               `json:"amount"`
+	Outputs  []PendingSendOutputResult `json:"outputs"`
+	Sweep    bool                      `json:"sweep,omitempty"`
+	Treasury bool                      `json:"treasury,omitempty"`
+	Label    string                    `json:"label,omitempty"`
+	Created  int64                     `json:"created"`
+}
+
+// PendingSendOutputResult describes an output of a pending send.  The amount
+// of a sweep output is the spendable balance of the account when the sweep is
+// approved, and is omitted.
+type PendingSendOutputResult struct {
+	Address string      `json:"address,omitempty"`
+	Amount  interface{} `json:"amount,omitempty"`
+}
+
+// InheritanceResult models objects returned by the setinheritance and
+// getinheritance commands.
+type InheritanceResult struct {
+	Account      string                `json:"account"`
+	Address      string                `json:"address"`
+	Delay        int64                 `json:"delay"`
+	Refreshed    int64                 `json:"refreshed,omitempty"`
+	LockTime     uint32                `json:"locktime,omitempty"`
+	LastError    string                `json:"lasterror,omitempty"`
+	Transactions []InheritanceTxResult `json:"transactions"`
+}
+
+// InheritanceTxResult describes a pre-signed transaction of a dead-man's
+// switch.
+type InheritanceTxResult struct {
+	CoinType uint8       `json:"cointype"`
+	TxID     string      `json:"txid"`
+	Amount   interface{} `json:"amount"`
+	Hex      string      `json:"hex"`
+}
+
+// RecurringPaymentResult models objects returned by the addrecurringpayment
+// and listrecurringpayments commands and recurringpayment notifications.
+type RecurringPaymentResult struct {
+	ID             uint32      `json:"id"`
+	Account        string      `json:"account"`
+	Address        string      `json:"address"`
+	CoinType       uint8       `json:"cointype"`
+	Amount         interface{} `json:"amount"`
+	IntervalBlocks int32       `json:"intervalblocks,omitempty"`
+	Interval       int64       `json:"interval,omitempty"`
+	NextHeight     int32       `json:"nextheight,omitempty"`
+	NextTime       int64       `json:"nexttime,omitempty"`
+	FailurePolicy  string      `json:"failurepolicy"`
+	Paused         bool        `json:"paused"`
+	Executions     uint32      `json:"executions"`
+	LastAttempt    int64       `json:"lastattempt,omitempty"`
+	LastTxID       string      `json:"lasttxid,omitempty"`
+	LastError      string      `json:"lasterror,omitempty"`
+	Label          string      `json:"label,omitempty"`
+	Created        int64       `json:"created"`
+}
+
+// ScheduledSendResult models objects returned by the schedulesend and
+// listscheduledsends commands.
+type ScheduledSendResult struct {
+	ID              uint32                    `json:"id"`
+	Account         string                    `json:"account"`
+	CoinType        uint8                     `json:"cointype"`
+	Amount          interface{}               `json:"amount"`
+	Outputs         []PendingSendOutputResult `json:"outputs"`
+	NotBefore       int64                     `json:"notbefore,omitempty"`
+	NotBeforeHeight int32                     `json:"notbeforeheight,omitempty"`
+	SignedTxID      string                    `json:"signedtxid,omitempty"`
+	Status          string                    `json:"status"`
+	TxID            string                    `json:"txid,omitempty"`
+	LastError       string                    `json:"lasterror,omitempty"`
+	Label           string                    `json:"label,omitempty"`
+	Created         int64                     `json:"created"`
+}
+
+// SendPolicyResult models the data returned from the getsendpolicy command.
+type SendPolicyResult struct {
+	Blocklist       []BlockedAddressResult  `json:"blocklist"`
+	LabelThresholds []LabelThresholdResult  `json:"labelthresholds"`
+	SKASendAccounts []SKASendAccountsResult `json:"skasendaccounts"`
+}
+
+// BlockedAddressResult describes an address on the send policy blocklist.
+type BlockedAddressResult struct {
+	Address string `json:"address"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// LabelThresholdResult describes the amount of a coin type at and above which
+// sends must be labeled.
+type LabelThresholdResult struct {
+	CoinType  uint8       `json:"cointype"`
+	Threshold interface{} `json:"threshold"`
+}
+
+// SKASendAccountsResult describes the only accounts which may send an SKA
+// coin type.
+type SKASendAccountsResult struct {
+	CoinType uint8    `json:"cointype"`
+	Accounts []string `json:"accounts"`
+}
+
+// TicketCompoundingResult models objects returned by the ticketcompounding
+// command.
+type TicketCompoundingResult struct {
+	Account string  `json:"account"`
+	Accrued float64 `json:"accrued"`
+	Height  int32   `json:"height"`
 }
 
 // TreasuryPolicyResult models objects returned by the treasurypolicy command.
@@ -416,6 +983,15 @@ type TSpendPolicyResult struct {
 	Ticket string `json:"ticket,omitempty"`
 }
 
+// AccountPrivKeyResult models the private key of an account address returned
+// by the dumpaccountprivkeys command.
+type AccountPrivKeyResult struct {
+	Address string `json:"address"`
+	Branch  uint32 `json:"branch"`
+	Index   uint32 `json:"index"`
+	PrivKey string `json:"privkey"`
+}
+
 // ValidateAddressResult models the data returned by the wallet server
 // validateaddress command.
 type ValidateAddressResult struct {
@@ -440,6 +1016,84 @@ type ValidateAddressResult struct {
 // ValidateAddressWalletResult aliases ValidateAddressResult.
 type ValidateAddressWalletResult = ValidateAddressResult
 
+// OwnershipProofOutputResult describes an output proven by an ownership
+// proof.
+type OwnershipProofOutputResult struct {
+	TxID     string      `json:"txid"`
+	Vout     uint32      `json:"vout"`
+	Tree     int8        `json:"tree"`
+	CoinType uint8       `json:"cointype"`
+	Amount   interface{} `json:"amount"`
+	Address  string      `json:"address"`
+}
+
+// OwnershipProofTotalResult is the total value of the proven outputs of a
+// coin type.
+type OwnershipProofTotalResult struct {
+	CoinType uint8       `json:"cointype"`
+	Amount   interface{} `json:"amount"`
+}
+
+// CreateOwnershipProofResult models the data returned by the
+// createownershipproof command.
+type CreateOwnershipProofResult struct {
+	Proof     string                       `json:"proof"`
+	Challenge string                       `json:"challenge"`
+	Outputs   []OwnershipProofOutputResult `json:"outputs"`
+	Totals    []OwnershipProofTotalResult  `json:"totals"`
+}
+
+// VerifyOwnershipProofResult models the data returned by the
+// verifyownershipproof command.  The outputs and totals are those claimed by
+// the proof, and are set even when it is invalid.
+type VerifyOwnershipProofResult struct {
+	Valid     bool                         `json:"valid"`
+	Error     string                       `json:"error,omitempty"`
+	Challenge string                       `json:"challenge"`
+	Outputs   []OwnershipProofOutputResult `json:"outputs"`
+	Totals    []OwnershipProofTotalResult  `json:"totals"`
+}
+
+// ImportLegacyWalletResult models the data returned by the
+// importlegacywallet command.  The counts and balance were verified to be
+// equal in the legacy and imported databases.
+type ImportLegacyWalletResult struct {
+	LegacyVersion  uint32  `json:"legacyversion"`
+	Version        uint32  `json:"version"`
+	LastAccount    uint32  `json:"lastaccount"`
+	Transactions   int     `json:"transactions"`
+	UnspentOutputs int     `json:"unspentoutputs"`
+	Balance        float64 `json:"balance"`
+}
+
+// VerifySeedResult models the data returned by the verifyseed command.
+type VerifySeedResult struct {
+	Matches        bool  `json:"matches"`
+	IncorrectWords []int `json:"incorrectwords,omitempty"`
+}
+
+// WalletAuditResult models an address of the data returned by the walletaudit
+// command.  Path is only set for addresses of BIP0044 accounts.
+type WalletAuditResult struct {
+	Address        string                `json:"address"`
+	Account        uint32                `json:"account"`
+	AccountName    string                `json:"accountname"`
+	Branch         uint32                `json:"branch"`
+	Index          uint32                `json:"index"`
+	Path           string                `json:"path,omitempty"`
+	FirstUseHeight int32                 `json:"firstuseheight"`
+	LastUseHeight  int32                 `json:"lastuseheight"`
+	Received       []WalletAuditReceived `json:"received"`
+	UTXOs          int                   `json:"utxos"`
+}
+
+// WalletAuditReceived describes the total received by an address in a coin
+// type.  Amount is a float64 for VAR and a string for SKA (full precision).
+type WalletAuditReceived struct {
+	CoinType uint8       `json:"cointype"`
+	Amount   interface{} `json:"amount"`
+}
+
 // WalletInfoResult models the data returned from the walletinfo command.
 type WalletInfoResult struct {
 	DaemonConnected  bool    `json:"daemonconnected"`
@@ -455,6 +1109,18 @@ type WalletInfoResult struct {
 	ManualTickets    bool    `json:"manualtickets"`
 	BirthHash        string  `json:"birthhash"`
 	BirthHeight      uint32  `json:"birthheight"`
+	UTXOCacheHits    uint64  `json:"utxocachehits"`
+	UTXOCacheMisses  uint64  `json:"utxocachemisses"`
+	UTXOCacheHitRate float64 `json:"utxocachehitrate"`
+}
+
+// WalletProcessPSDTResult models the data returned from the walletprocesspsdt
+// command.  Hex is the signed transaction, and is only set when the PSDT was
+// finalized and is complete.
+type WalletProcessPSDTResult struct {
+	PSDT     string `json:"psdt"`
+	Complete bool   `json:"complete"`
+	Hex      string `json:"hex,omitempty"`
 }
 
 // AccountUnlockedResult models the data returned by the accountunlocked
@@ -494,6 +1160,35 @@ type GetCoinAccountBalanceResult struct {
 	VotingAuthority         interface{} `json:"votingauthority"`         // Voting authority
 }
 
+// CreateRPCCredentialResult models the data returned from the
+// createrpccredential command.
+type CreateRPCCredentialResult struct {
+	Username string   `json:"username"`
+	Password string   `json:"password"`
+	Scopes   []string `json:"scopes"`
+}
+
+// ListRPCCredentialsResult models the data returned from the
+// listrpccredentials command.
+type ListRPCCredentialsResult struct {
+	Username string   `json:"username"`
+	Scopes   []string `json:"scopes"`
+	Created  int64    `json:"created"`
+}
+
+// ListWalletsResult models the data returned from the listwallets command.
+type ListWalletsResult struct {
+	Name   string `json:"name"`
+	Loaded bool   `json:"loaded"`
+}
+
+// LogLevelResult models the level of a subsystem logger returned by the
+// setloglevel command.
+type LogLevelResult struct {
+	Subsystem string `json:"subsystem"`
+	Level     string `json:"level"`
+}
+
 // ListCoinTypesResult models the data returned from the listcointypes command.
 // This lists all coin types that have non-zero balances in the wallet.
 type ListCoinTypesResult struct {
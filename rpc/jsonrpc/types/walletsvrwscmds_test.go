@@ -111,6 +111,95 @@ func TestWalletSvrWsCmds(t *testing.T) {
 				Account: dcrjson.String("acct"),
 			},
 		},
+		{
+			name: "notifycointypebalance",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("notifycointypebalance"), 1)
+			},
+			staticCmd: func() any {
+				return NewNotifyCoinTypeBalanceCmd(1, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"notifycointypebalance","params":[1],"id":1}`,
+			unmarshalled: &NotifyCoinTypeBalanceCmd{
+				CoinType: 1,
+				MinConf:  dcrjson.Int(1),
+			},
+		},
+		{
+			name: "notifycointypebalance optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("notifycointypebalance"), 1, 0)
+			},
+			staticCmd: func() any {
+				return NewNotifyCoinTypeBalanceCmd(1, dcrjson.Int(0))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"notifycointypebalance","params":[1,0],"id":1}`,
+			unmarshalled: &NotifyCoinTypeBalanceCmd{
+				CoinType: 1,
+				MinConf:  dcrjson.Int(0),
+			},
+		},
+		{
+			name: "notifytxconflicts",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("notifytxconflicts"))
+			},
+			staticCmd: func() any {
+				return NewNotifyTxConflictsCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"notifytxconflicts","params":[],"id":1}`,
+			unmarshalled: &NotifyTxConflictsCmd{},
+		},
+		{
+			name: "notifyinvoices",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("notifyinvoices"))
+			},
+			staticCmd: func() any {
+				return NewNotifyInvoicesCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"notifyinvoices","params":[],"id":1}`,
+			unmarshalled: &NotifyInvoicesCmd{},
+		},
+		{
+			name: "notifyrecurringpayments",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("notifyrecurringpayments"))
+			},
+			staticCmd: func() any {
+				return NewNotifyRecurringPaymentsCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"notifyrecurringpayments","params":[],"id":1}`,
+			unmarshalled: &NotifyRecurringPaymentsCmd{},
+		},
+		{
+			name: "notifytxconfirmations",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("notifytxconfirmations"), "123", 6)
+			},
+			staticCmd: func() any {
+				return NewNotifyTxConfirmationsCmd("123", 6)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"notifytxconfirmations","params":["123",6],"id":1}`,
+			unmarshalled: &NotifyTxConfirmationsCmd{
+				TxHash:        "123",
+				Confirmations: 6,
+			},
+		},
+		{
+			name: "notifyaccountconfirmations",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("notifyaccountconfirmations"), "default", 2)
+			},
+			staticCmd: func() any {
+				return NewNotifyAccountConfirmationsCmd("default", 2)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"notifyaccountconfirmations","params":["default",2],"id":1}`,
+			unmarshalled: &NotifyAccountConfirmationsCmd{
+				Account:       "default",
+				Confirmations: 2,
+			},
+		},
 		{
 			name: "walletislocked",
 			newCmd: func() (any, error) {
@@ -121,71 +121,74 @@ var VersionService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	WalletService_Ping_FullMethodName                        = "/walletrpc.WalletService/Ping"
-	WalletService_Network_FullMethodName                     = "/walletrpc.WalletService/Network"
-	WalletService_CoinType_FullMethodName                    = "/walletrpc.WalletService/CoinType"
-	WalletService_AccountNumber_FullMethodName               = "/walletrpc.WalletService/AccountNumber"
-	WalletService_Accounts_FullMethodName                    = "/walletrpc.WalletService/Accounts"
-	WalletService_Address_FullMethodName                     = "/walletrpc.WalletService/Address"
-	WalletService_Balance_FullMethodName                     = "/walletrpc.WalletService/Balance"
-	WalletService_GetAccountExtendedPubKey_FullMethodName    = "/walletrpc.WalletService/GetAccountExtendedPubKey"
-	WalletService_GetAccountExtendedPrivKey_FullMethodName   = "/walletrpc.WalletService/GetAccountExtendedPrivKey"
-	WalletService_GetTransaction_FullMethodName              = "/walletrpc.WalletService/GetTransaction"
-	WalletService_GetTransactions_FullMethodName             = "/walletrpc.WalletService/GetTransactions"
-	WalletService_GetTicket_FullMethodName                   = "/walletrpc.WalletService/GetTicket"
-	WalletService_GetTickets_FullMethodName                  = "/walletrpc.WalletService/GetTickets"
-	WalletService_TicketPrice_FullMethodName                 = "/walletrpc.WalletService/TicketPrice"
-	WalletService_StakeInfo_FullMethodName                   = "/walletrpc.WalletService/StakeInfo"
-	WalletService_BlockInfo_FullMethodName                   = "/walletrpc.WalletService/BlockInfo"
-	WalletService_BestBlock_FullMethodName                   = "/walletrpc.WalletService/BestBlock"
-	WalletService_Spender_FullMethodName                     = "/walletrpc.WalletService/Spender"
-	WalletService_GetCFilters_FullMethodName                 = "/walletrpc.WalletService/GetCFilters"
-	WalletService_GetPeerInfo_FullMethodName                 = "/walletrpc.WalletService/GetPeerInfo"
-	WalletService_DumpPrivateKey_FullMethodName              = "/walletrpc.WalletService/DumpPrivateKey"
-	WalletService_BirthBlock_FullMethodName                  = "/walletrpc.WalletService/BirthBlock"
-	WalletService_TransactionNotifications_FullMethodName    = "/walletrpc.WalletService/TransactionNotifications"
-	WalletService_AccountNotifications_FullMethodName        = "/walletrpc.WalletService/AccountNotifications"
-	WalletService_ConfirmationNotifications_FullMethodName   = "/walletrpc.WalletService/ConfirmationNotifications"
-	WalletService_ChangePassphrase_FullMethodName            = "/walletrpc.WalletService/ChangePassphrase"
-	WalletService_RenameAccount_FullMethodName               = "/walletrpc.WalletService/RenameAccount"
-	WalletService_Rescan_FullMethodName                      = "/walletrpc.WalletService/Rescan"
-	WalletService_NextAccount_FullMethodName                 = "/walletrpc.WalletService/NextAccount"
-	WalletService_NextAddress_FullMethodName                 = "/walletrpc.WalletService/NextAddress"
-	WalletService_ImportPrivateKey_FullMethodName            = "/walletrpc.WalletService/ImportPrivateKey"
-	WalletService_ImportExtendedPublicKey_FullMethodName     = "/walletrpc.WalletService/ImportExtendedPublicKey"
-	WalletService_ImportVotingAccountFromSeed_FullMethodName = "/walletrpc.WalletService/ImportVotingAccountFromSeed"
-	WalletService_ImportScript_FullMethodName                = "/walletrpc.WalletService/ImportScript"
-	WalletService_FundTransaction_FullMethodName             = "/walletrpc.WalletService/FundTransaction"
-	WalletService_UnspentOutputs_FullMethodName              = "/walletrpc.WalletService/UnspentOutputs"
-	WalletService_ConstructTransaction_FullMethodName        = "/walletrpc.WalletService/ConstructTransaction"
-	WalletService_SignTransaction_FullMethodName             = "/walletrpc.WalletService/SignTransaction"
-	WalletService_SignTransactions_FullMethodName            = "/walletrpc.WalletService/SignTransactions"
-	WalletService_CreateSignature_FullMethodName             = "/walletrpc.WalletService/CreateSignature"
-	WalletService_PublishTransaction_FullMethodName          = "/walletrpc.WalletService/PublishTransaction"
-	WalletService_PublishUnminedTransactions_FullMethodName  = "/walletrpc.WalletService/PublishUnminedTransactions"
-	WalletService_PurchaseTickets_FullMethodName             = "/walletrpc.WalletService/PurchaseTickets"
-	WalletService_LoadActiveDataFilters_FullMethodName       = "/walletrpc.WalletService/LoadActiveDataFilters"
-	WalletService_SignMessage_FullMethodName                 = "/walletrpc.WalletService/SignMessage"
-	WalletService_SignMessages_FullMethodName                = "/walletrpc.WalletService/SignMessages"
-	WalletService_ValidateAddress_FullMethodName             = "/walletrpc.WalletService/ValidateAddress"
-	WalletService_CommittedTickets_FullMethodName            = "/walletrpc.WalletService/CommittedTickets"
-	WalletService_SweepAccount_FullMethodName                = "/walletrpc.WalletService/SweepAccount"
-	WalletService_AbandonTransaction_FullMethodName          = "/walletrpc.WalletService/AbandonTransaction"
-	WalletService_SignHashes_FullMethodName                  = "/walletrpc.WalletService/SignHashes"
-	WalletService_GetCoinjoinOutputspByAcct_FullMethodName   = "/walletrpc.WalletService/GetCoinjoinOutputspByAcct"
-	WalletService_SetAccountPassphrase_FullMethodName        = "/walletrpc.WalletService/SetAccountPassphrase"
-	WalletService_UnlockAccount_FullMethodName               = "/walletrpc.WalletService/UnlockAccount"
-	WalletService_LockAccount_FullMethodName                 = "/walletrpc.WalletService/LockAccount"
-	WalletService_UnlockWallet_FullMethodName                = "/walletrpc.WalletService/UnlockWallet"
-	WalletService_LockWallet_FullMethodName                  = "/walletrpc.WalletService/LockWallet"
-	WalletService_AccountUnlocked_FullMethodName             = "/walletrpc.WalletService/AccountUnlocked"
-	WalletService_SyncVSPFailedTickets_FullMethodName        = "/walletrpc.WalletService/SyncVSPFailedTickets"
-	WalletService_GetVSPTicketsByFeeStatus_FullMethodName    = "/walletrpc.WalletService/GetVSPTicketsByFeeStatus"
-	WalletService_ProcessManagedTickets_FullMethodName       = "/walletrpc.WalletService/ProcessManagedTickets"
-	WalletService_ProcessUnmanagedTickets_FullMethodName     = "/walletrpc.WalletService/ProcessUnmanagedTickets"
-	WalletService_SetVspdVoteChoices_FullMethodName          = "/walletrpc.WalletService/SetVspdVoteChoices"
-	WalletService_GetTrackedVSPTickets_FullMethodName        = "/walletrpc.WalletService/GetTrackedVSPTickets"
-	WalletService_DiscoverUsage_FullMethodName               = "/walletrpc.WalletService/DiscoverUsage"
+	WalletService_Ping_FullMethodName                               = "/walletrpc.WalletService/Ping"
+	WalletService_Network_FullMethodName                            = "/walletrpc.WalletService/Network"
+	WalletService_CoinType_FullMethodName                           = "/walletrpc.WalletService/CoinType"
+	WalletService_AccountNumber_FullMethodName                      = "/walletrpc.WalletService/AccountNumber"
+	WalletService_Accounts_FullMethodName                           = "/walletrpc.WalletService/Accounts"
+	WalletService_Address_FullMethodName                            = "/walletrpc.WalletService/Address"
+	WalletService_Balance_FullMethodName                            = "/walletrpc.WalletService/Balance"
+	WalletService_GetAccountExtendedPubKey_FullMethodName           = "/walletrpc.WalletService/GetAccountExtendedPubKey"
+	WalletService_GetAccountExtendedPrivKey_FullMethodName          = "/walletrpc.WalletService/GetAccountExtendedPrivKey"
+	WalletService_GetTransaction_FullMethodName                     = "/walletrpc.WalletService/GetTransaction"
+	WalletService_GetTransactions_FullMethodName                    = "/walletrpc.WalletService/GetTransactions"
+	WalletService_GetTicket_FullMethodName                          = "/walletrpc.WalletService/GetTicket"
+	WalletService_GetTickets_FullMethodName                         = "/walletrpc.WalletService/GetTickets"
+	WalletService_TicketPrice_FullMethodName                        = "/walletrpc.WalletService/TicketPrice"
+	WalletService_StakeInfo_FullMethodName                          = "/walletrpc.WalletService/StakeInfo"
+	WalletService_BlockInfo_FullMethodName                          = "/walletrpc.WalletService/BlockInfo"
+	WalletService_BestBlock_FullMethodName                          = "/walletrpc.WalletService/BestBlock"
+	WalletService_Spender_FullMethodName                            = "/walletrpc.WalletService/Spender"
+	WalletService_GetCFilters_FullMethodName                        = "/walletrpc.WalletService/GetCFilters"
+	WalletService_GetPeerInfo_FullMethodName                        = "/walletrpc.WalletService/GetPeerInfo"
+	WalletService_DumpPrivateKey_FullMethodName                     = "/walletrpc.WalletService/DumpPrivateKey"
+	WalletService_BirthBlock_FullMethodName                         = "/walletrpc.WalletService/BirthBlock"
+	WalletService_TransactionNotifications_FullMethodName           = "/walletrpc.WalletService/TransactionNotifications"
+	WalletService_AccountNotifications_FullMethodName               = "/walletrpc.WalletService/AccountNotifications"
+	WalletService_ConfirmationNotifications_FullMethodName          = "/walletrpc.WalletService/ConfirmationNotifications"
+	WalletService_CoinTypeBalanceNotifications_FullMethodName       = "/walletrpc.WalletService/CoinTypeBalanceNotifications"
+	WalletService_ConfirmationThresholdNotifications_FullMethodName = "/walletrpc.WalletService/ConfirmationThresholdNotifications"
+	WalletService_StakeEvents_FullMethodName                        = "/walletrpc.WalletService/StakeEvents"
+	WalletService_ChangePassphrase_FullMethodName                   = "/walletrpc.WalletService/ChangePassphrase"
+	WalletService_RenameAccount_FullMethodName                      = "/walletrpc.WalletService/RenameAccount"
+	WalletService_Rescan_FullMethodName                             = "/walletrpc.WalletService/Rescan"
+	WalletService_NextAccount_FullMethodName                        = "/walletrpc.WalletService/NextAccount"
+	WalletService_NextAddress_FullMethodName                        = "/walletrpc.WalletService/NextAddress"
+	WalletService_ImportPrivateKey_FullMethodName                   = "/walletrpc.WalletService/ImportPrivateKey"
+	WalletService_ImportExtendedPublicKey_FullMethodName            = "/walletrpc.WalletService/ImportExtendedPublicKey"
+	WalletService_ImportVotingAccountFromSeed_FullMethodName        = "/walletrpc.WalletService/ImportVotingAccountFromSeed"
+	WalletService_ImportScript_FullMethodName                       = "/walletrpc.WalletService/ImportScript"
+	WalletService_FundTransaction_FullMethodName                    = "/walletrpc.WalletService/FundTransaction"
+	WalletService_UnspentOutputs_FullMethodName                     = "/walletrpc.WalletService/UnspentOutputs"
+	WalletService_ConstructTransaction_FullMethodName               = "/walletrpc.WalletService/ConstructTransaction"
+	WalletService_SignTransaction_FullMethodName                    = "/walletrpc.WalletService/SignTransaction"
+	WalletService_SignTransactions_FullMethodName                   = "/walletrpc.WalletService/SignTransactions"
+	WalletService_CreateSignature_FullMethodName                    = "/walletrpc.WalletService/CreateSignature"
+	WalletService_PublishTransaction_FullMethodName                 = "/walletrpc.WalletService/PublishTransaction"
+	WalletService_PublishUnminedTransactions_FullMethodName         = "/walletrpc.WalletService/PublishUnminedTransactions"
+	WalletService_PurchaseTickets_FullMethodName                    = "/walletrpc.WalletService/PurchaseTickets"
+	WalletService_LoadActiveDataFilters_FullMethodName              = "/walletrpc.WalletService/LoadActiveDataFilters"
+	WalletService_SignMessage_FullMethodName                        = "/walletrpc.WalletService/SignMessage"
+	WalletService_SignMessages_FullMethodName                       = "/walletrpc.WalletService/SignMessages"
+	WalletService_ValidateAddress_FullMethodName                    = "/walletrpc.WalletService/ValidateAddress"
+	WalletService_CommittedTickets_FullMethodName                   = "/walletrpc.WalletService/CommittedTickets"
+	WalletService_SweepAccount_FullMethodName                       = "/walletrpc.WalletService/SweepAccount"
+	WalletService_AbandonTransaction_FullMethodName                 = "/walletrpc.WalletService/AbandonTransaction"
+	WalletService_SignHashes_FullMethodName                         = "/walletrpc.WalletService/SignHashes"
+	WalletService_GetCoinjoinOutputspByAcct_FullMethodName          = "/walletrpc.WalletService/GetCoinjoinOutputspByAcct"
+	WalletService_SetAccountPassphrase_FullMethodName               = "/walletrpc.WalletService/SetAccountPassphrase"
+	WalletService_UnlockAccount_FullMethodName                      = "/walletrpc.WalletService/UnlockAccount"
+	WalletService_LockAccount_FullMethodName                        = "/walletrpc.WalletService/LockAccount"
+	WalletService_UnlockWallet_FullMethodName                       = "/walletrpc.WalletService/UnlockWallet"
+	WalletService_LockWallet_FullMethodName                         = "/walletrpc.WalletService/LockWallet"
+	WalletService_AccountUnlocked_FullMethodName                    = "/walletrpc.WalletService/AccountUnlocked"
+	WalletService_SyncVSPFailedTickets_FullMethodName               = "/walletrpc.WalletService/SyncVSPFailedTickets"
+	WalletService_GetVSPTicketsByFeeStatus_FullMethodName           = "/walletrpc.WalletService/GetVSPTicketsByFeeStatus"
+	WalletService_ProcessManagedTickets_FullMethodName              = "/walletrpc.WalletService/ProcessManagedTickets"
+	WalletService_ProcessUnmanagedTickets_FullMethodName            = "/walletrpc.WalletService/ProcessUnmanagedTickets"
+	WalletService_SetVspdVoteChoices_FullMethodName                 = "/walletrpc.WalletService/SetVspdVoteChoices"
+	WalletService_GetTrackedVSPTickets_FullMethodName               = "/walletrpc.WalletService/GetTrackedVSPTickets"
+	WalletService_DiscoverUsage_FullMethodName                      = "/walletrpc.WalletService/DiscoverUsage"
 )
 
 // WalletServiceClient is the client API for WalletService service.
@@ -219,6 +222,9 @@ type WalletServiceClient interface {
 	TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TransactionNotificationsResponse], error)
 	AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AccountNotificationsResponse], error)
 	ConfirmationNotifications(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConfirmationNotificationsRequest, ConfirmationNotificationsResponse], error)
+	CoinTypeBalanceNotifications(ctx context.Context, in *CoinTypeBalanceNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CoinTypeBalanceNotificationsResponse], error)
+	ConfirmationThresholdNotifications(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConfirmationThresholdNotificationsRequest, ConfirmationThresholdNotificationsResponse], error)
+	StakeEvents(ctx context.Context, in *StakeEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StakeEventsResponse], error)
 	// Control
 	ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error)
 	RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error)
@@ -568,6 +574,57 @@ func (c *walletServiceClient) ConfirmationNotifications(ctx context.Context, opt
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type WalletService_ConfirmationNotificationsClient = grpc.BidiStreamingClient[ConfirmationNotificationsRequest, ConfirmationNotificationsResponse]
 
+func (c *walletServiceClient) CoinTypeBalanceNotifications(ctx context.Context, in *CoinTypeBalanceNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CoinTypeBalanceNotificationsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[6], WalletService_CoinTypeBalanceNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CoinTypeBalanceNotificationsRequest, CoinTypeBalanceNotificationsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletService_CoinTypeBalanceNotificationsClient = grpc.ServerStreamingClient[CoinTypeBalanceNotificationsResponse]
+
+func (c *walletServiceClient) ConfirmationThresholdNotifications(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConfirmationThresholdNotificationsRequest, ConfirmationThresholdNotificationsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[7], WalletService_ConfirmationThresholdNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ConfirmationThresholdNotificationsRequest, ConfirmationThresholdNotificationsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletService_ConfirmationThresholdNotificationsClient = grpc.BidiStreamingClient[ConfirmationThresholdNotificationsRequest, ConfirmationThresholdNotificationsResponse]
+
+func (c *walletServiceClient) StakeEvents(ctx context.Context, in *StakeEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StakeEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[8], WalletService_StakeEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StakeEventsRequest, StakeEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletService_StakeEventsClient = grpc.ServerStreamingClient[StakeEventsResponse]
+
 func (c *walletServiceClient) ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ChangePassphraseResponse)
@@ -590,7 +647,7 @@ func (c *walletServiceClient) RenameAccount(ctx context.Context, in *RenameAccou
 
 func (c *walletServiceClient) Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RescanResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[6], WalletService_Rescan_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[9], WalletService_Rescan_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -679,7 +736,7 @@ func (c *walletServiceClient) FundTransaction(ctx context.Context, in *FundTrans
 
 func (c *walletServiceClient) UnspentOutputs(ctx context.Context, in *UnspentOutputsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UnspentOutputResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[7], WalletService_UnspentOutputs_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[10], WalletService_UnspentOutputs_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1017,6 +1074,9 @@ type WalletServiceServer interface {
 	TransactionNotifications(*TransactionNotificationsRequest, grpc.ServerStreamingServer[TransactionNotificationsResponse]) error
 	AccountNotifications(*AccountNotificationsRequest, grpc.ServerStreamingServer[AccountNotificationsResponse]) error
 	ConfirmationNotifications(grpc.BidiStreamingServer[ConfirmationNotificationsRequest, ConfirmationNotificationsResponse]) error
+	CoinTypeBalanceNotifications(*CoinTypeBalanceNotificationsRequest, grpc.ServerStreamingServer[CoinTypeBalanceNotificationsResponse]) error
+	ConfirmationThresholdNotifications(grpc.BidiStreamingServer[ConfirmationThresholdNotificationsRequest, ConfirmationThresholdNotificationsResponse]) error
+	StakeEvents(*StakeEventsRequest, grpc.ServerStreamingServer[StakeEventsResponse]) error
 	// Control
 	ChangePassphrase(context.Context, *ChangePassphraseRequest) (*ChangePassphraseResponse, error)
 	RenameAccount(context.Context, *RenameAccountRequest) (*RenameAccountResponse, error)
@@ -1143,6 +1203,15 @@ func (UnimplementedWalletServiceServer) AccountNotifications(*AccountNotificatio
 func (UnimplementedWalletServiceServer) ConfirmationNotifications(grpc.BidiStreamingServer[ConfirmationNotificationsRequest, ConfirmationNotificationsResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method ConfirmationNotifications not implemented")
 }
+func (UnimplementedWalletServiceServer) CoinTypeBalanceNotifications(*CoinTypeBalanceNotificationsRequest, grpc.ServerStreamingServer[CoinTypeBalanceNotificationsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method CoinTypeBalanceNotifications not implemented")
+}
+func (UnimplementedWalletServiceServer) ConfirmationThresholdNotifications(grpc.BidiStreamingServer[ConfirmationThresholdNotificationsRequest, ConfirmationThresholdNotificationsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ConfirmationThresholdNotifications not implemented")
+}
+func (UnimplementedWalletServiceServer) StakeEvents(*StakeEventsRequest, grpc.ServerStreamingServer[StakeEventsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StakeEvents not implemented")
+}
 func (UnimplementedWalletServiceServer) ChangePassphrase(context.Context, *ChangePassphraseRequest) (*ChangePassphraseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePassphrase not implemented")
 }
@@ -1688,6 +1757,35 @@ func _WalletService_ConfirmationNotifications_Handler(srv interface{}, stream gr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type WalletService_ConfirmationNotificationsServer = grpc.BidiStreamingServer[ConfirmationNotificationsRequest, ConfirmationNotificationsResponse]
 
+func _WalletService_CoinTypeBalanceNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CoinTypeBalanceNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).CoinTypeBalanceNotifications(m, &grpc.GenericServerStream[CoinTypeBalanceNotificationsRequest, CoinTypeBalanceNotificationsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletService_CoinTypeBalanceNotificationsServer = grpc.ServerStreamingServer[CoinTypeBalanceNotificationsResponse]
+
+func _WalletService_ConfirmationThresholdNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WalletServiceServer).ConfirmationThresholdNotifications(&grpc.GenericServerStream[ConfirmationThresholdNotificationsRequest, ConfirmationThresholdNotificationsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletService_ConfirmationThresholdNotificationsServer = grpc.BidiStreamingServer[ConfirmationThresholdNotificationsRequest, ConfirmationThresholdNotificationsResponse]
+
+func _WalletService_StakeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StakeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).StakeEvents(m, &grpc.GenericServerStream[StakeEventsRequest, StakeEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletService_StakeEventsServer = grpc.ServerStreamingServer[StakeEventsResponse]
+
 func _WalletService_ChangePassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChangePassphraseRequest)
 	if err := dec(in); err != nil {
@@ -2662,6 +2760,22 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "CoinTypeBalanceNotifications",
+			Handler:       _WalletService_CoinTypeBalanceNotifications_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ConfirmationThresholdNotifications",
+			Handler:       _WalletService_ConfirmationThresholdNotifications_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StakeEvents",
+			Handler:       _WalletService_StakeEvents_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Rescan",
 			Handler:       _WalletService_Rescan_Handler,
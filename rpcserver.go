@@ -8,9 +8,12 @@ package main
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/subtle"
 	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/base64"
 	"encoding/pem"
 	"fmt"
 	"math/big"
@@ -24,15 +27,20 @@ import (
 
 	"github.com/monetarium/monetarium-wallet/errors"
 	"github.com/monetarium/monetarium-wallet/internal/cfgutil"
+	"github.com/monetarium/monetarium-wallet/internal/inflight"
 	"github.com/monetarium/monetarium-wallet/internal/loader"
 	"github.com/monetarium/monetarium-wallet/internal/loggers"
+	"github.com/monetarium/monetarium-wallet/internal/metrics"
 	"github.com/monetarium/monetarium-wallet/internal/rpc/jsonrpc"
 	"github.com/monetarium/monetarium-wallet/internal/rpc/rpcserver"
+	"github.com/monetarium/monetarium-wallet/internal/rpctrace"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
 	"github.com/monetarium/monetarium-node/crypto/rand"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
@@ -245,7 +253,43 @@ func (rpcLoggers) SetLevels(levelSpec string) error {
 	return parseAndSetDebugLevels(levelSpec)
 }
 
+func (rpcLoggers) SetLevel(subsystem, level string) error {
+	if !validLogLevel(level) {
+		return errors.Errorf("invalid log level %q", level)
+	}
+	if subsystem == "" {
+		setLogLevels(level)
+		return nil
+	}
+	if _, ok := subsystemLoggers[subsystem]; !ok {
+		return errors.Errorf("unknown subsystem %q", subsystem)
+	}
+	setLogLevel(subsystem, level)
+	return nil
+}
+
+func (rpcLoggers) Levels() map[string]string {
+	levels := make(map[string]string, len(subsystemLoggers))
+	for name, logger := range subsystemLoggers {
+		levels[name] = logLevelName(logger.Level())
+	}
+	return levels
+}
+
+// rpcTraceRecords is the number of the most recent requests recorded for the
+// getrpcstats method.
+const rpcTraceRecords = 1000
+
+// rpcTraces records the JSON-RPC and gRPC requests handled by the servers.
+var rpcTraces = rpctrace.NewRecorder(0, 0)
+
+// grpcRequests counts the unary gRPC requests being handled, which are waited
+// on during shutdown before the gRPC server is stopped.
+var grpcRequests inflight.Tracker
+
 func startRPCServers(ctx context.Context, walletLoader *loader.Loader) (*grpc.Server, *jsonrpc.Server, error) {
+	rpcTraces = rpctrace.NewRecorder(rpcTraceRecords, cfg.RPCSlowThreshold)
+
 	var jsonrpcAddrNotifier jsonrpcListenerEventServer
 	var grpcAddrNotifier grpcListenerEventServer
 	if cfg.RPCListenerEvents {
@@ -325,11 +369,23 @@ func startRPCServers(ctx context.Context, walletLoader *loader.Loader) (*grpc.Se
 				err := errors.New("failed to create listeners for RPC server")
 				return nil, nil, err
 			}
-			server = grpc.NewServer(
+			opts := []grpc.ServerOption{
 				grpc.Creds(credentials.NewTLS(tlsConfig)),
-				grpc.StreamInterceptor(interceptStreaming),
-				grpc.UnaryInterceptor(interceptUnary),
-			)
+				grpc.ChainStreamInterceptor(interceptStreaming),
+				grpc.ChainUnaryInterceptor(interceptUnary),
+			}
+			if cfg.GRPCScopedAuth {
+				a := newGRPCAuthorizer(walletLoader, cfg.Username, cfg.Password)
+				opts = append(opts,
+					grpc.ChainStreamInterceptor(a.interceptStreaming),
+					grpc.ChainUnaryInterceptor(a.interceptUnary))
+			}
+			if cfg.ReadOnly {
+				opts = append(opts,
+					grpc.ChainStreamInterceptor(interceptReadOnlyStreaming),
+					grpc.ChainUnaryInterceptor(interceptReadOnlyUnary))
+			}
+			server = grpc.NewServer(opts...)
 			rpcserver.RegisterServices(server)
 			rpcserver.StartWalletLoaderService(server, walletLoader, activeNet)
 			rpcserver.StartTicketBuyerService(server, walletLoader)
@@ -380,10 +436,19 @@ func startRPCServers(ctx context.Context, walletLoader *loader.Loader) (*grpc.Se
 			VSPHost:             cfg.VSPOpts.URL,
 			VSPPubKey:           cfg.VSPOpts.PubKey,
 			TicketSplitAccount:  cfg.TicketSplitAccount,
+			SKAEmissionEnabled:  cfg.EnableSKAEmission,
+			AccountKeyExport:    cfg.AccountKeyExport,
+			ReadOnly:            cfg.ReadOnly,
 			Dial:                cfg.dial,
+			FiatRates:           cfg.fiatRates,
 			Loggers:             rpcLoggers{},
+			Traces:              rpcTraces,
 		}
-		jsonrpcServer = jsonrpc.NewServer(ctx, &opts, activeNet.Params, walletLoader, listeners)
+		// Requests being handled when shutdown begins are given time
+		// to complete, and are only cancelled once the server is
+		// stopped.
+		jsonrpcServer = jsonrpc.NewServer(context.WithoutCancel(ctx), &opts,
+			activeNet.Params, walletLoader, listeners)
 		for _, lis := range listeners {
 			jsonrpcAddrNotifier.notify(lis.Addr().String())
 		}
@@ -432,24 +497,156 @@ func interceptStreaming(srv any, ss grpc.ServerStream, info *grpc.StreamServerIn
 	return err
 }
 
+// grpcRequestDuration observes the time taken to handle unary gRPC requests.
+var grpcRequestDuration = metrics.NewHistogramVec("monetarium_wallet_grpc_request_duration_seconds",
+	"Time taken to handle unary gRPC requests, by method.", "method", metrics.DefaultBuckets)
+
 func interceptUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	ctx = loggers.WithRequestID(ctx, loggers.NewRequestID())
+	log := loggers.GrpcLog.WithContext(ctx)
 	p, ok := peer.FromContext(ctx)
 	if ok {
-		loggers.GrpcLog.Debugf("Unary method %s invoked by %s", info.FullMethod,
+		log.Debugf("Unary method %s invoked by %s", info.FullMethod,
 			p.Addr.String())
 	}
 	err = rpcserver.ServiceReady(serviceName(info.FullMethod))
 	if err != nil {
 		return nil, err
 	}
-	resp, err = handler(ctx, req)
+	if !grpcRequests.TryBegin() {
+		return nil, status.Error(codes.Unavailable, "wallet is shutting down")
+	}
+	defer grpcRequests.End()
+	trace := rpctrace.New(info.FullMethod, loggers.RequestID(ctx))
+	resp, err = handler(rpctrace.WithTrace(ctx, trace), req)
+	grpcRequestDuration.ObserveDuration(info.FullMethod, trace.Start)
+	var errMsg string
+	if err != nil {
+		errMsg = err.Error()
+	}
+	if rec := rpcTraces.Finish(trace, errMsg); rec.Slow {
+		log.Warnf("Slow unary method %s took %v (waited %v on wallet "+
+			"locks, %d database views, %d database updates)",
+			info.FullMethod, rec.Duration, rec.LockWait, rec.DBViews,
+			rec.DBUpdates)
+	}
 	if err != nil && ok {
-		loggers.GrpcLog.Errorf("Unary method %s invoked by %s errored: %v",
+		log.Errorf("Unary method %s invoked by %s errored: %v",
 			info.FullMethod, p.Addr.String(), err)
 	}
 	return resp, err
 }
 
+// grpcAuthorizer requires gRPC clients to present HTTP Basic credentials in
+// the authorization metadata of each call, and limits clients to the methods
+// permitted by the RPC scopes of their credentials.  Clients presenting the
+// JSON-RPC username and password hold the admin scope, while other clients
+// authenticate with the scoped RPC credentials recorded by the default
+// wallet.
+type grpcAuthorizer struct {
+	loader  *loader.Loader
+	authsha *[sha256.Size]byte // nil when no username and password is set
+}
+
+func newGRPCAuthorizer(l *loader.Loader, username, password string) *grpcAuthorizer {
+	a := &grpcAuthorizer{loader: l}
+	if username != "" && password != "" {
+		login := username + ":" + password
+		h := sha256.Sum256([]byte("Basic " + base64.StdEncoding.EncodeToString([]byte(login))))
+		a.authsha = &h
+	}
+	return a
+}
+
+// scopes returns the RPC scopes of the credentials in an authorization
+// metadata value.  The comparison with the JSON-RPC credentials is time
+// constant.
+func (a *grpcAuthorizer) scopes(ctx context.Context, auth string) (udb.RPCScopes, error) {
+	if a.authsha != nil {
+		h := sha256.Sum256([]byte(auth))
+		if subtle.ConstantTimeCompare(h[:], a.authsha[:]) == 1 {
+			return udb.RPCScopeAdmin, nil
+		}
+	}
+	encoded, ok := strings.CutPrefix(auth, "Basic ")
+	if !ok {
+		return 0, errors.New("invalid authorization")
+	}
+	login, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, errors.New("invalid authorization")
+	}
+	username, password, ok := strings.Cut(string(login), ":")
+	if !ok {
+		return 0, errors.New("invalid authorization")
+	}
+	w, ok := a.loader.LoadedWallet()
+	if !ok {
+		return 0, errors.New("invalid authorization")
+	}
+	return w.AuthenticateRPC(ctx, username, password)
+}
+
+// authorize returns a gRPC status error if the client invoking a method is
+// not authenticated, or does not hold the scope required by the method.
+func (a *grpcAuthorizer) authorize(ctx context.Context, fullMethod string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	auth := md.Get("authorization")
+	if len(auth) == 0 {
+		return status.Error(codes.Unauthenticated, "missing RPC credentials")
+	}
+	scopes, err := a.scopes(ctx, auth[0])
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "invalid RPC credentials")
+	}
+	required := rpcserver.RequiredScope(fullMethod)
+	if !scopes.Allows(required) {
+		return status.Errorf(codes.PermissionDenied, "RPC credentials lack "+
+			"the %s scope required by method %s", required, fullMethod)
+	}
+	return nil
+}
+
+func (a *grpcAuthorizer) interceptStreaming(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := a.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (a *grpcAuthorizer) interceptUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// authorizeReadOnly returns a gRPC status error if a method may modify the
+// wallet, and is disabled because the wallet was opened read-only.
+func authorizeReadOnly(fullMethod string) error {
+	if !rpcserver.ReadOnlyAllowed(fullMethod) {
+		return status.Errorf(codes.PermissionDenied, "method %s is "+
+			"disabled when the wallet is opened read-only", fullMethod)
+	}
+	return nil
+}
+
+func interceptReadOnlyStreaming(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := authorizeReadOnly(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func interceptReadOnlyUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := authorizeReadOnly(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
 type listenFunc func(net string, laddr string) (net.Listener, error)
 
 // makeListeners splits the normalized listen addresses into IPv4 and IPv6
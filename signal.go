@@ -8,6 +8,8 @@ import (
 	"context"
 	"os"
 	"os/signal"
+	"sync"
+	"time"
 )
 
 // shutdownRequestChannel is used to initiate shutdown from one of the
@@ -69,3 +71,30 @@ func shutdownListener() {
 		log.Info("Shutdown signaled.  Already shutting down...")
 	}
 }
+
+// shutdownDrain bounds the time spent waiting for in-flight requests and
+// operations to complete once shutdown begins.  Every stage of the shutdown
+// shares a single deadline, which is set when its context is first used.
+type shutdownDrain struct {
+	timeout time.Duration
+	once    sync.Once
+	ctx     context.Context
+	cancel  func()
+}
+
+// context returns the context which is done once the shutdown timeout has
+// elapsed since the first call.
+func (d *shutdownDrain) context() context.Context {
+	d.once.Do(func() {
+		d.ctx, d.cancel = context.WithTimeout(context.Background(), d.timeout)
+	})
+	return d.ctx
+}
+
+// release releases the resources of the context, if it was created.
+func (d *shutdownDrain) release() {
+	d.once.Do(func() {})
+	if d.cancel != nil {
+		d.cancel()
+	}
+}
@@ -7,8 +7,6 @@ package spv
 import (
 	"context"
 	"fmt"
-	"runtime"
-	"sync"
 	"time"
 
 	"github.com/monetarium/monetarium-wallet/errors"
@@ -16,8 +14,10 @@ import (
 	"github.com/monetarium/monetarium-wallet/validate"
 	"github.com/monetarium/monetarium-wallet/wallet"
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/dcrutil"
 	"github.com/monetarium/monetarium-node/gcs"
+	"github.com/monetarium/monetarium-node/gcs/blockcf2"
 	"github.com/monetarium/monetarium-node/mixing"
 	"github.com/monetarium/monetarium-node/txscript/stdaddr"
 	"github.com/monetarium/monetarium-node/wire"
@@ -487,128 +487,171 @@ func (s *Syncer) PublishMixMessages(ctx context.Context, msgs ...mixing.Message)
 	return nil
 }
 
+// DefaultRescanConcurrency is the default maximum number of block batches
+// fetched concurrently during a rescan.
+const DefaultRescanConcurrency = 4
+
+// rescanBatchSize is the number of blocks checked against their cfilters and
+// fetched together by a single rescan worker.
+const rescanBatchSize = 100
+
+// rescanBatch is a contiguous range of blocks to rescan.  Blocks with
+// cfilters matching the wallet's data filters are fetched by a worker, and
+// done is closed after blocks or err is set.
+type rescanBatch struct {
+	hashes []chainhash.Hash
+	blocks []*wire.MsgBlock // nil for blocks without filter matches
+	err    error
+	done   chan struct{}
+}
+
 // Rescan implements the Rescan method of the wallet.NetworkBackend interface.
+//
+// Batches of blocks are checked against their cfilters and fetched from peers
+// concurrently, while the blocks are rescanned and matching transactions are
+// saved in order.  At most rescanConcurrency batches of fetched blocks are held
+// in memory at any time.
 func (s *Syncer) Rescan(ctx context.Context, blockHashes []chainhash.Hash, save func(*chainhash.Hash, []*wire.MsgTx) error) error {
 	const op errors.Op = "spv.Rescan"
 
-	cfilters := make([]*gcs.FilterV2, 0, len(blockHashes))
-	cfilterKeys := make([][gcs.KeySize]byte, 0, len(blockHashes))
-	for i := 0; i < len(blockHashes); i++ {
-		k, f, err := s.wallet.CFilterV2(ctx, &blockHashes[i])
-		if err != nil {
-			return err
-		}
-		cfilters = append(cfilters, f)
-		cfilterKeys = append(cfilterKeys, k)
-	}
-
-	blockMatches := make([]*wire.MsgBlock, len(blockHashes)) // Block assigned to slice once fetched
+	// Workers are stopped by canceling the context when returning early.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
 	// Read current filter data.
 	s.filterMu.Lock()
 	filterData := s.filterData
 	s.filterMu.Unlock()
 
-	var fmatches []*chainhash.Hash
-	var fmatchidx []int
-	var fmatchMu sync.Mutex
-
-	// Spawn ncpu workers to check filter matches
-	ncpu := runtime.NumCPU()
-	c := make(chan int, ncpu)
-	var wg sync.WaitGroup
-	wg.Add(ncpu)
-	for i := 0; i < ncpu; i++ {
-		go func() {
-			for i := range c {
-				blockHash := &blockHashes[i]
-				key := cfilterKeys[i]
-				f := cfilters[i]
-				if f.MatchAny(key, filterData) {
-					fmatchMu.Lock()
-					fmatches = append(fmatches, blockHash)
-					fmatchidx = append(fmatchidx, i)
-					fmatchMu.Unlock()
-				}
-			}
-			wg.Done()
-		}()
+	batches := make([]*rescanBatch, 0, (len(blockHashes)+rescanBatchSize-1)/rescanBatchSize)
+	for i := 0; i < len(blockHashes); i += rescanBatchSize {
+		end := min(i+rescanBatchSize, len(blockHashes))
+		batches = append(batches, &rescanBatch{
+			hashes: blockHashes[i:end],
+			done:   make(chan struct{}),
+		})
 	}
-	for i := 0; i < len(blockHashes); i++ {
-		if blockMatches[i] != nil {
-			// Already fetched this block
-			continue
-		}
-		c <- i
-	}
-	close(c)
-	wg.Wait()
 
-	if len(fmatches) != 0 {
-	PickPeer:
-		for {
-			if err := ctx.Err(); err != nil {
-				return err
+	// Start a worker for each batch once a batch held by a previous worker
+	// has been rescanned.
+	sem := make(chan struct{}, max(s.rescanConcurrency, 1))
+	go func() {
+		for _, b := range batches {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
 			}
-			rp, err := s.waitForRemote(ctx, pickAny, true)
-			if err != nil {
-				return err
+			go func() {
+				b.blocks, b.err = s.fetchRescanBatch(ctx, op, b.hashes, filterData)
+				close(b.done)
+			}()
+		}
+	}()
+
+	for _, b := range batches {
+		select {
+		case <-b.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if b.err != nil {
+			return b.err
+		}
+
+		for i, block := range b.blocks {
+			if block == nil {
+				// No filter match, skip block
+				continue
 			}
 
-			blocks, err := rp.Blocks(ctx, fmatches)
-			if err != nil {
-				continue PickPeer
+			if err := ctx.Err(); err != nil {
+				return err
 			}
 
-			for j, b := range blocks {
-				// Validate fetched blocks before rescanning transactions.  PoW
-				// and PoS difficulties have already been validated since the
-				// header is saved by the wallet, and modifications to these in
-				// the downloaded block would result in a different block hash
-				// and failure to fetch the block.
-				//
-				// Block filters were also validated
-				// against the header (assuming dcp0005
-				// was activated).
-				err = validate.MerkleRoots(b)
+			matchedTxs := s.rescanBlock(block)
+			if len(matchedTxs) != 0 {
+				err := save(&b.hashes[i], matchedTxs)
 				if err != nil {
-					err = validate.DCP0005MerkleRoot(b)
+					return err
 				}
-				if err != nil {
-					err := errors.E(op, err)
-					rp.Disconnect(err)
-					rp = nil
-					continue PickPeer
-				}
-
-				i := fmatchidx[j]
-				blockMatches[i] = b
 			}
-			break
 		}
+
+		// Release the fetched blocks and allow the next batch to be
+		// fetched.
+		b.blocks = nil
+		<-sem
 	}
 
-	for i := 0; i < len(blockMatches); i++ {
-		b := blockMatches[i]
-		if b == nil {
-			// No filter match, skip block
-			continue
+	return nil
+}
+
+// fetchRescanBatch checks the cfilters of each block against the filter data
+// and fetches every matching block from a peer.  The returned slice has the
+// same length as blockHashes, with nil blocks for cfilters which did not
+// match.
+func (s *Syncer) fetchRescanBatch(ctx context.Context, op errors.Op,
+	blockHashes []chainhash.Hash, filterData blockcf2.Entries) ([]*wire.MsgBlock, error) {
+
+	var fmatches []*chainhash.Hash
+	var fmatchidx []int
+	for i := range blockHashes {
+		k, f, err := s.wallet.CFilterV2(ctx, &blockHashes[i])
+		if err != nil {
+			return nil, err
+		}
+		if f.MatchAny(k, filterData) {
+			fmatches = append(fmatches, &blockHashes[i])
+			fmatchidx = append(fmatchidx, i)
 		}
+	}
 
+	blockMatches := make([]*wire.MsgBlock, len(blockHashes)) // Block assigned to slice once fetched
+	if len(fmatches) == 0 {
+		return blockMatches, nil
+	}
+
+PickPeer:
+	for {
 		if err := ctx.Err(); err != nil {
-			return err
+			return nil, err
+		}
+		rp, err := s.waitForRemote(ctx, pickAny, true)
+		if err != nil {
+			return nil, err
 		}
 
-		matchedTxs := s.rescanBlock(b)
-		if len(matchedTxs) != 0 {
-			err := save(&blockHashes[i], matchedTxs)
+		blocks, err := rp.Blocks(ctx, fmatches)
+		if err != nil {
+			continue PickPeer
+		}
+
+		for j, b := range blocks {
+			// Validate fetched blocks before rescanning transactions.  PoW
+			// and PoS difficulties have already been validated since the
+			// header is saved by the wallet, and modifications to these in
+			// the downloaded block would result in a different block hash
+			// and failure to fetch the block.
+			//
+			// Block filters were also validated
+			// against the header (assuming dcp0005
+			// was activated).
+			err = validate.MerkleRoots(b)
 			if err != nil {
-				return err
+				err = validate.DCP0005MerkleRoot(b)
 			}
+			if err != nil {
+				err := errors.E(op, err)
+				rp.Disconnect(err)
+				continue PickPeer
+			}
+
+			i := fmatchidx[j]
+			blockMatches[i] = b
 		}
+		return blockMatches, nil
 	}
-
-	return nil
 }
 
 // StakeDifficulty implements the StakeDifficulty method of the
@@ -643,8 +686,22 @@ func (s *Syncer) Err() error {
 	}
 }
 
+// GetFeeEstimatesByCoinType implements the GetFeeEstimatesByCoinType method of
+// the wallet.NetworkBackend interface.
+//
+// This implementation of the method will always error as fee estimates are
+// not queryable over wire protocol.  As with an RPC syncer whose node does not
+// provide estimates, the wallet falls back to its configured static fee for
+// the coin type.
 func (s *Syncer) GetFeeEstimatesByCoinType(ctx context.Context, coinType uint8) (*wallet.FeeEstimates, error) {
-	// SPV mode doesn't have direct access to dcrd RPC for fee estimates
-	// Return error indicating this functionality requires full node connection
-	return nil, errors.E(errors.Invalid, "fee estimates not available in SPV mode")
+	const op errors.Op = "spv.GetFeeEstimatesByCoinType"
+
+	ct := cointype.CoinType(coinType)
+	if ct != cointype.CoinTypeVAR {
+		if _, ok := s.wallet.ChainParams().SKACoins[ct]; !ok {
+			return nil, errors.E(op, errors.Invalid,
+				errors.Errorf("unknown coin type %d", coinType))
+		}
+	}
+	return nil, errors.E(op, errors.Invalid, "fee estimates are not queryable over wire protocol")
 }
@@ -0,0 +1,216 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package spv
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/internal/loader"
+	_ "github.com/monetarium/monetarium-wallet/wallet/drivers/bdb"
+)
+
+// testSyncer returns a syncer of a newly created simnet wallet, and an
+// address of the wallet which is watched by the rescan filter.
+func testSyncer(ctx context.Context, t *testing.T) (*Syncer, stdaddr.Address) {
+	params := chaincfg.SimNetParams()
+	l := loader.NewLoader(params, t.TempDir(), false, 20, 0, false, 1e5, 0, 0,
+		false, false, false, 0, false, "bdb", nil)
+	seed := []byte("test seed for spv rescan testing")
+	w, err := l.CreateNewWallet(ctx, []byte("public"), []byte("private"), seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.UnloadWallet() })
+
+	// Addresses are watched as the stdaddr types the wallet loads into the
+	// transaction filter.
+	a, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := stdaddr.DecodeAddress(a.String(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSyncer(w, nil)
+	err = s.LoadTxFilter(ctx, true, []stdaddr.Address{addr}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, addr
+}
+
+// testSSFeeTx returns a fee distribution paying value of a coin type to
+// pkScript.  The distribution augments the consolidated output prev when it is
+// not nil, and otherwise spends the null generating input.
+func testSSFeeTx(ct cointype.CoinType, value int64, pkScript []byte, prev *wire.OutPoint) *wire.MsgTx {
+	tx := wire.NewMsgTx()
+	tx.Version = 3
+	in := &wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+	}
+	if prev != nil {
+		in.PreviousOutPoint = *prev
+	}
+	tx.AddTxIn(in)
+	out := &wire.TxOut{CoinType: ct, PkScript: pkScript}
+	if ct.IsSKA() {
+		out.SKAValue = big.NewInt(value)
+	} else {
+		out.Value = value
+	}
+	tx.AddTxOut(out)
+	tx.AddTxOut(&wire.TxOut{
+		CoinType: ct,
+		PkScript: []byte{txscript.OP_RETURN, txscript.OP_DATA_6, 'S', 'F', 0, 0, 0, 0},
+	})
+	return tx
+}
+
+// payToAddrScript returns the output script paying to addr.
+func payToAddrScript(addr stdaddr.Address) []byte {
+	_, script := addr.PaymentScript()
+	return script
+}
+
+func matchedHashes(matches []*wire.MsgTx) map[chainhash.Hash]bool {
+	m := make(map[chainhash.Hash]bool)
+	for _, tx := range matches {
+		m[tx.TxHash()] = true
+	}
+	return m
+}
+
+func TestRescanSKA(t *testing.T) {
+	ctx := context.Background()
+	s, addr := testSyncer(ctx, t)
+	const ska1 cointype.CoinType = 1
+
+	other := make([]byte, 25)
+	coinbase := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex}}},
+		TxOut: []*wire.TxOut{{Value: 1e8, PkScript: other}},
+	}
+	pay := &wire.MsgTx{
+		TxIn: []*wire.TxIn{{PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}}}},
+		TxOut: []*wire.TxOut{
+			{SKAValue: big.NewInt(7e8), CoinType: ska1, PkScript: other},
+			{SKAValue: big.NewInt(5e8), CoinType: ska1, PkScript: payToAddrScript(addr)},
+		},
+	}
+	payOut := wire.OutPoint{Hash: pay.TxHash(), Index: 1, Tree: wire.TxTreeRegular}
+	spend := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{PreviousOutPoint: payOut}},
+		TxOut: []*wire.TxOut{{SKAValue: big.NewInt(4e8), CoinType: ska1, PkScript: other}},
+	}
+	unrelated := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{2}}}},
+		TxOut: []*wire.TxOut{{SKAValue: big.NewInt(1e8), CoinType: ska1, PkScript: other}},
+	}
+	block := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{coinbase, pay, spend, unrelated},
+	}
+
+	// SKA outputs paying to watched addresses are matched and their
+	// outpoints are watched, so a spend later in the same block is matched
+	// as well.
+	matches := matchedHashes(s.rescanBlock(block))
+	if len(matches) != 2 || !matches[pay.TxHash()] || !matches[spend.TxHash()] {
+		t.Errorf("matched %d transactions, want the SKA payment and its spend",
+			len(matches))
+	}
+	if !s.rescanFilter.ExistsUnspentOutPoint(&payOut) {
+		t.Errorf("SKA output %v is not watched", &payOut)
+	}
+	other0 := wire.OutPoint{Hash: pay.TxHash(), Index: 0}
+	if s.rescanFilter.ExistsUnspentOutPoint(&other0) {
+		t.Errorf("unrelated SKA output %v is watched", &other0)
+	}
+}
+
+func TestRescanSSFee(t *testing.T) {
+	ctx := context.Background()
+	s, addr := testSyncer(ctx, t)
+	const ska1 cointype.CoinType = 1
+
+	pkScript := payToAddrScript(addr)
+	reward := testSSFeeTx(cointype.CoinTypeVAR, 1e6, pkScript, nil)
+	skaReward := testSSFeeTx(ska1, 2e6, pkScript, nil)
+	unrelated := testSSFeeTx(cointype.CoinTypeVAR, 1e6, make([]byte, 25), nil)
+	for _, tx := range []*wire.MsgTx{reward, skaReward, unrelated} {
+		if !stake.IsSSFee(tx) {
+			t.Fatalf("test transaction %v is not a fee distribution", tx.TxHash())
+		}
+	}
+	block := &wire.MsgBlock{
+		STransactions: []*wire.MsgTx{reward, skaReward, unrelated},
+	}
+
+	// Fee distributions paying VAR or SKA rewards to watched addresses are
+	// matched, and the rewards are watched as stake tree outputs.
+	matches := matchedHashes(s.rescanBlock(block))
+	if len(matches) != 2 || !matches[reward.TxHash()] || !matches[skaReward.TxHash()] {
+		t.Fatalf("matched %d transactions, want the VAR and SKA rewards",
+			len(matches))
+	}
+	rewardOut := wire.OutPoint{Hash: reward.TxHash(), Tree: wire.TxTreeStake}
+	skaRewardOut := wire.OutPoint{Hash: skaReward.TxHash(), Tree: wire.TxTreeStake}
+	for _, op := range []*wire.OutPoint{&rewardOut, &skaRewardOut} {
+		if !s.rescanFilter.ExistsUnspentOutPoint(op) {
+			t.Errorf("fee distribution reward %v is not watched", op)
+		}
+	}
+
+	// A later fee distribution augmenting the consolidated reward is matched
+	// by its spend of the watched outpoint, even when it pays elsewhere.
+	augmented := testSSFeeTx(cointype.CoinTypeVAR, 3e6, make([]byte, 25), &rewardOut)
+	block = &wire.MsgBlock{STransactions: []*wire.MsgTx{augmented}}
+	matches = matchedHashes(s.rescanBlock(block))
+	if len(matches) != 1 || !matches[augmented.TxHash()] {
+		t.Errorf("augmented fee distribution spending a watched reward " +
+			"was not matched")
+	}
+}
+
+func TestGetFeeEstimatesByCoinType(t *testing.T) {
+	ctx := context.Background()
+	s, _ := testSyncer(ctx, t)
+
+	// Estimates are not queryable over the wire protocol, and unknown coin
+	// types are rejected.
+	_, err := s.GetFeeEstimatesByCoinType(ctx, uint8(cointype.CoinTypeVAR))
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("VAR estimates: expected Invalid, got %v", err)
+	}
+	const unknown = 200
+	if _, ok := s.wallet.ChainParams().SKACoins[unknown]; ok {
+		t.Fatalf("coin type %d is configured", unknown)
+	}
+	_, err = s.GetFeeEstimatesByCoinType(ctx, unknown)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("unknown coin type estimates: expected Invalid, got %v", err)
+	}
+
+	// The wallet falls back to its static fee, as it does when the node of
+	// an RPC syncer can not estimate fees.
+	s.wallet.SetNetworkBackend(s)
+	fee, source, err := s.wallet.GetEffectiveFee(ctx, cointype.CoinTypeVAR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source != "static" || fee != 1e5 {
+		t.Errorf("effective fee %v from %q, want the static fee 1e5", fee, source)
+	}
+}
@@ -64,6 +64,10 @@ type Syncer struct {
 
 	persistentPeers []string
 
+	// rescanConcurrency is the maximum number of block batches fetched
+	// concurrently during a rescan.
+	rescanConcurrency int
+
 	connectingRemotes map[string]struct{}
 	remotes           map[string]*p2p.RemotePeer
 	remoteAvailable   chan struct{}
@@ -120,9 +124,10 @@ type Notifications struct {
 	FetchHeadersProgress         func(lastHeaderHeight int32, lastHeaderTime int64)
 	FetchHeadersFinished         func()
 	DiscoverAddressesStarted     func()
+	DiscoverAddressesProgress    func(completedAccounts, totalAccounts int)
 	DiscoverAddressesFinished    func()
 	RescanStarted                func()
-	RescanProgress               func(rescannedThrough int32)
+	RescanProgress               func(rescannedThrough int32, percent float64)
 	RescanFinished               func()
 
 	// MempoolTxs is called whenever new relevant unmined transactions are
@@ -144,6 +149,7 @@ func NewSyncer(w *wallet.Wallet, lp *p2p.LocalPeer) *Syncer {
 	return &Syncer{
 		wallet:            w,
 		discoverAccounts:  !w.Locked(),
+		rescanConcurrency: DefaultRescanConcurrency,
 		connectingRemotes: make(map[string]struct{}),
 		remotes:           make(map[string]*p2p.RemotePeer),
 		rescanFilter:      wallet.NewRescanFilter(nil, nil),
@@ -162,6 +168,18 @@ func (s *Syncer) SetPersistentPeers(peers []string) {
 	s.persistentPeers = peers
 }
 
+// SetRescanConcurrency sets the maximum number of batches of blocks which are
+// fetched concurrently during a rescan.  Higher values can reduce the time to
+// rescan large block ranges at the cost of holding more fetched blocks in
+// memory.  Values less than one are treated as one.  This has an effect only if
+// called before the syncer is run.
+func (s *Syncer) SetRescanConcurrency(n int) {
+	if n < 1 {
+		n = 1
+	}
+	s.rescanConcurrency = n
+}
+
 // SetNotifications sets the possible various callbacks that are used
 // to notify interested parties to the syncing progress.
 func (s *Syncer) SetNotifications(ntfns *Notifications) {
@@ -282,6 +300,12 @@ func (s *Syncer) discoverAddressesStart() {
 	}
 }
 
+func (s *Syncer) discoverAddressesProgress(completedAccounts, totalAccounts int) {
+	if s.notifications != nil && s.notifications.DiscoverAddressesProgress != nil {
+		s.notifications.DiscoverAddressesProgress(completedAccounts, totalAccounts)
+	}
+}
+
 func (s *Syncer) discoverAddressesFinished() {
 	if s.notifications != nil && s.notifications.DiscoverAddressesFinished != nil {
 		s.notifications.DiscoverAddressesFinished()
@@ -294,9 +318,9 @@ func (s *Syncer) rescanStart() {
 	}
 }
 
-func (s *Syncer) rescanProgress(rescannedThrough int32) {
+func (s *Syncer) rescanProgress(rescannedThrough int32, percent float64) {
 	if s.notifications != nil && s.notifications.RescanProgress != nil {
-		s.notifications.RescanProgress(rescannedThrough)
+		s.notifications.RescanProgress(rescannedThrough, percent)
 	}
 }
 
@@ -454,8 +478,8 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	})
 
 	g.Go(func() error {
-		// Run wallet background goroutines (currently, this just runs
-		// mixclient).
+		// Run wallet background goroutines (the rebroadcast of
+		// queued transactions, and mixclient when mixing).
 		err := s.wallet.Run(walletCtx)
 		if err != nil {
 			return err
@@ -1872,9 +1896,15 @@ func (s *Syncer) initialSyncRescan(ctx context.Context) error {
 	log.Debugf("Starting address discovery (discoverAccounts=%v, gapLimit=%d, rescanPoint=%v)",
 		s.discoverAccounts, gapLimit, rescanPoint)
 	s.discoverAddressesStart()
-	err = s.wallet.DiscoverActiveAddresses(ctx, s, rescanPoint, s.discoverAccounts, gapLimit)
-	if err != nil {
-		return err
+	discovery := make(chan wallet.DiscoveryProgress, 1)
+	go s.wallet.DiscoverActiveAddressesProgress(ctx, s, rescanPoint,
+		s.discoverAccounts, gapLimit, discovery)
+	for p := range discovery {
+		if p.Err != nil {
+			return p.Err
+		}
+		log.Debugf("Discovered addresses of %d/%d account(s)", p.Completed, p.Total)
+		s.discoverAddressesProgress(p.Completed, p.Total)
 	}
 	s.discoverAddressesFinished()
 
@@ -1898,7 +1928,7 @@ func (s *Syncer) initialSyncRescan(ctx context.Context) error {
 		if p.Err != nil {
 			return p.Err
 		}
-		s.rescanProgress(p.ScannedThrough)
+		s.rescanProgress(p.ScannedThrough, p.Percent)
 	}
 	s.rescanFinished()
 
@@ -43,6 +43,18 @@ type Config struct {
 
 	// VSP client
 	VSP *wallet.VSPClient
+
+	// Purchase tickets with the matured SSFee rewards accrued by accounts
+	// which have opted in to compounding
+	Compound bool
+
+	// Fund purchased tickets only with mature SSFee reward outputs, leaving
+	// other outputs untouched
+	FeeRewardFunding bool
+
+	// Purchase tickets to maintain the target ticket counts of accounts
+	// configured with the wallet's ticket buyer configs
+	Targets bool
 }
 
 // TB is an automated ticket buyer, buying as many tickets as possible given an
@@ -53,6 +65,14 @@ type TB struct {
 
 	cfg Config
 	mu  sync.Mutex
+
+	// compoundMu serializes purchases with accrued rewards, preventing
+	// the same rewards from being spent by purchases for different blocks.
+	compoundMu sync.Mutex
+
+	// targetsMu serializes purchases maintaining target ticket counts,
+	// preventing purchases for different blocks from exceeding a target.
+	targetsMu sync.Mutex
 }
 
 // New returns a new TB to buy tickets from a wallet.
@@ -92,7 +112,14 @@ func (tb *TB) Run(ctx context.Context, passphrase []byte) error {
 				return err
 			}
 			return ctx.Err()
-		case n := <-c.C:
+		case n, ok := <-c.C:
+			if !ok {
+				// The client was disconnected for exceeding the
+				// notification backlog limit; resubscribe.
+				log.Warnf("Missed main chain tip notifications; resubscribing")
+				c = tb.wallet.NtfnServer.MainTipChangedNotifications()
+				continue
+			}
 			if len(n.AttachedBlocks) == 0 {
 				continue
 			}
@@ -165,8 +192,8 @@ func (tb *TB) Run(ctx context.Context, passphrase []byte) error {
 
 			cancelCtx, cancel := context.WithCancel(ctx)
 			cancels = append(cancels, cancel)
-			buyTickets := func() {
-				err := tb.buy(cancelCtx, passphrase, tipHeader, expiry, &cfg)
+			purchase := func(f func(context.Context, []byte, *wire.BlockHeader, int32, *Config) error) {
+				err := f(cancelCtx, passphrase, tipHeader, expiry, &cfg)
 				if err != nil {
 					switch {
 					// silence these errors
@@ -185,7 +212,13 @@ func (tb *TB) Run(ctx context.Context, passphrase []byte) error {
 				}
 			}
 			for i := 0; cfg.BuyTickets && i < multiple; i++ {
-				go buyTickets()
+				go purchase(tb.buy)
+			}
+			if cfg.Compound {
+				go purchase(tb.compound)
+			}
+			if cfg.Targets {
+				go purchase(tb.maintainTargets)
 			}
 			go func() {
 				err := tb.mixChange(ctx, &cfg)
@@ -295,7 +328,8 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *wire.BlockHeader,
 		MixedSplitAccount:  splitAccount,
 		ChangeAccount:      changeAccount,
 
-		VSPClient: tb.cfg.VSP,
+		VSPClient:        tb.cfg.VSP,
+		FeeRewardFunding: tb.cfg.FeeRewardFunding,
 	}
 
 	tix, err := w.PurchaseTickets(ctx, n, purchaseTicketReq)
@@ -307,6 +341,256 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *wire.BlockHeader,
 	return err
 }
 
+// compound purchases tickets with the matured SSFee rewards accrued by each
+// account which has opted in to compounding.  Tickets are only purchased once
+// an account's accrued rewards cover the ticket price plus fees, and the value
+// of purchased tickets is deducted from the account's accrued rewards.
+func (tb *TB) compound(ctx context.Context, passphrase []byte, tip *wire.BlockHeader, expiry int32,
+	cfg *Config) error {
+	ctx, task := trace.NewTask(ctx, "ticketbuyer.compound")
+	defer task.End()
+
+	tb.mu.Lock()
+	compound := tb.cfg.Compound
+	tb.mu.Unlock()
+	if !compound {
+		return nil
+	}
+
+	tb.compoundMu.Lock()
+	defer tb.compoundMu.Unlock()
+
+	w := tb.wallet
+
+	// Unable to publish any transactions if the network backend is unset.
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := wallet.WrapNetworkBackendContext(n, ctx)
+	defer cancel()
+
+	if len(passphrase) > 0 {
+		// Ensure wallet is unlocked with the current passphrase.  If the passphase
+		// is changed, the Run exits and TB must be restarted with the new
+		// passphrase.
+		err = w.Unlock(ctx, passphrase, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	states, err := w.AccrueTicketCompounding(ctx)
+	if err != nil {
+		return err
+	}
+	if len(states) == 0 {
+		return nil
+	}
+
+	sdiff, err := w.NextStakeDifficultyAfterHeader(ctx, tip)
+	if err != nil {
+		return err
+	}
+	cost := w.TicketCompoundingCost(sdiff)
+
+	for i := range states {
+		c := &states[i]
+
+		// Determine how many tickets the accrued rewards purchase
+		buy := int(c.Accrued / cost)
+		if buy == 0 {
+			log.Debugf("Skipping compounding for account %d: accrued rewards %v "+
+				"below ticket cost %v", c.Account, c.Accrued, cost)
+			continue
+		}
+		max := int(w.ChainParams().MaxFreshStakePerBlock)
+		if buy > max {
+			buy = max
+		}
+		if cfg.Limit > 0 && buy > cfg.Limit {
+			buy = cfg.Limit
+		}
+
+		// Tickets of the configured purchasing account keep its voting
+		// account, while other accounts vote with their own addresses.
+		votingAccount := c.Account
+		if c.Account == cfg.Account {
+			votingAccount = cfg.VotingAccount
+		}
+
+		purchaseTicketReq := &wallet.PurchaseTicketsRequest{
+			Count:         buy,
+			SourceAccount: c.Account,
+			VotingAccount: votingAccount,
+			MinConf:       minconf,
+			Expiry:        expiry,
+
+			VSPClient:        cfg.VSP,
+			FeeRewardFunding: cfg.FeeRewardFunding,
+		}
+
+		tix, err := w.PurchaseTickets(ctx, n, purchaseTicketReq)
+		if tix != nil && len(tix.TicketHashes) != 0 {
+			for _, hash := range tix.TicketHashes {
+				log.Infof("Compounded rewards of account %d into ticket %v "+
+					"at stake difficulty %v", c.Account, hash, sdiff)
+			}
+			spent := cost * dcrutil.Amount(len(tix.TicketHashes))
+			serr := w.SpendTicketCompounding(ctx, c.Account, sdiff, spent,
+				tix.TicketHashes)
+			if err == nil {
+				err = serr
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maintainTargets purchases tickets for each account with a ticket buyer config
+// until the account's unspent and unexpired tickets reach its target.  No
+// tickets are purchased for an account while the ticket price or relay fee
+// exceed its limits, and purchases never reduce the account's spendable
+// balance below its reserve.
+func (tb *TB) maintainTargets(ctx context.Context, passphrase []byte, tip *wire.BlockHeader, expiry int32,
+	cfg *Config) error {
+	ctx, task := trace.NewTask(ctx, "ticketbuyer.maintainTargets")
+	defer task.End()
+
+	tb.mu.Lock()
+	targets := tb.cfg.Targets
+	tb.mu.Unlock()
+	if !targets {
+		return nil
+	}
+
+	tb.targetsMu.Lock()
+	defer tb.targetsMu.Unlock()
+
+	w := tb.wallet
+
+	// Unable to publish any transactions if the network backend is unset.
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := wallet.WrapNetworkBackendContext(n, ctx)
+	defer cancel()
+
+	if len(passphrase) > 0 {
+		// Ensure wallet is unlocked with the current passphrase.  If the passphase
+		// is changed, the Run exits and TB must be restarted with the new
+		// passphrase.
+		err = w.Unlock(ctx, passphrase, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	configs, err := w.TicketBuyerConfigs(ctx)
+	if err != nil {
+		return err
+	}
+	if len(configs) == 0 {
+		return nil
+	}
+
+	sdiff, err := w.NextStakeDifficultyAfterHeader(ctx, tip)
+	if err != nil {
+		return err
+	}
+	relayFee := w.RelayFee()
+
+	for i := range configs {
+		c := &configs[i]
+
+		if c.MaxPrice != 0 && sdiff > c.MaxPrice {
+			log.Debugf("Skipping purchase for account %d: stake difficulty %v "+
+				"above max price %v", c.Account, sdiff, c.MaxPrice)
+			continue
+		}
+		if c.MaxFee != 0 && relayFee > c.MaxFee {
+			log.Debugf("Skipping purchase for account %d: relay fee %v "+
+				"above max fee %v", c.Account, relayFee, c.MaxFee)
+			continue
+		}
+
+		count, err := w.AccountTicketCount(ctx, c.Account)
+		if err != nil {
+			return err
+		}
+		if count >= int(c.Target) {
+			continue
+		}
+		buy := int(c.Target) - count
+
+		// Determine how many tickets the balance above the reserve
+		// purchases
+		bal, err := w.AccountBalance(ctx, c.Account, minconf)
+		if err != nil {
+			return err
+		}
+		if bal.Spendable <= c.Reserve {
+			log.Debugf("Skipping purchase for account %d: low available "+
+				"balance", c.Account)
+			continue
+		}
+		if affordable := int((bal.Spendable - c.Reserve) / sdiff); buy > affordable {
+			buy = affordable
+		}
+		if buy == 0 {
+			log.Debugf("Skipping purchase for account %d: low available "+
+				"balance", c.Account)
+			continue
+		}
+		max := int(w.ChainParams().MaxFreshStakePerBlock)
+		if buy > max {
+			buy = max
+		}
+		if cfg.Limit > 0 && buy > cfg.Limit {
+			buy = cfg.Limit
+		}
+
+		// Tickets of the configured purchasing account keep its voting
+		// account, while other accounts vote with their own addresses.
+		votingAccount := c.Account
+		if c.Account == cfg.Account {
+			votingAccount = cfg.VotingAccount
+		}
+
+		purchaseTicketReq := &wallet.PurchaseTicketsRequest{
+			Count:         buy,
+			SourceAccount: c.Account,
+			VotingAccount: votingAccount,
+			MinConf:       minconf,
+			Expiry:        expiry,
+
+			VSPClient:        cfg.VSP,
+			FeeRewardFunding: cfg.FeeRewardFunding,
+		}
+
+		tix, err := w.PurchaseTickets(ctx, n, purchaseTicketReq)
+		if tix != nil {
+			for _, hash := range tix.TicketHashes {
+				log.Infof("Purchased ticket %v for account %d at stake "+
+					"difficulty %v (target %d)", hash, c.Account, sdiff,
+					c.Target)
+			}
+		}
+		if errors.Is(err, errors.InsufficientBalance) {
+			log.Debugf("Skipping purchase for account %d: %v", c.Account, err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // AccessConfig runs f with the current config passed as a parameter.  The
 // config is protected by a mutex and this function is safe for concurrent
 // access to read or modify the config.  It is unsafe to leak a pointer to the
@@ -0,0 +1,170 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-node/dcrec"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/hdkeychain"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// AccountPrivKey is the private key of an address of a BIP0044 account.
+type AccountPrivKey struct {
+	Branch  uint32
+	Index   uint32
+	Address stdaddr.Address
+	WIF     string
+}
+
+// confirmAccountPassphrase checks a passphrase re-entered to confirm the
+// export of an account's private keys.  The keys must already be unlocked,
+// and the passphrase must be the one they were unlocked with: the account
+// passphrase of individually-encrypted accounts, and the wallet's private
+// passphrase otherwise.
+func (w *Wallet) confirmAccountPassphrase(dbtx walletdb.ReadTx, account uint32,
+	passphrase []byte) error {
+
+	encrypted, unlocked := w.manager.AccountHasPassphrase(dbtx, account)
+	if !encrypted {
+		return w.manager.UnlockedWithPassphrase(passphrase)
+	}
+	if !unlocked {
+		return errors.E(errors.Locked, "account is locked")
+	}
+	// The account is unlocked, so this only compares the passphrase.
+	return w.manager.UnlockAccount(dbtx, account, passphrase)
+}
+
+// accountXpriv returns an account's extended private key after confirming
+// the passphrase.
+func (w *Wallet) accountXpriv(dbtx walletdb.ReadTx, account uint32,
+	passphrase []byte) (*hdkeychain.ExtendedKey, error) {
+
+	if account == udb.ImportedAddrAccount {
+		return nil, errors.E(errors.Invalid, "imported account has no "+
+			"extended private key")
+	}
+	if err := w.confirmAccountPassphrase(dbtx, account, passphrase); err != nil {
+		return nil, err
+	}
+	return w.manager.AccountExtendedPrivKey(dbtx, account)
+}
+
+// ExportAccountXpriv returns a BIP0044 account's extended private key, for
+// migrating the account to other software.  The account's keys must be
+// unlocked, and passphrase must confirm the passphrase they were unlocked
+// with.
+func (w *Wallet) ExportAccountXpriv(ctx context.Context, account uint32,
+	passphrase []byte) (*hdkeychain.ExtendedKey, error) {
+
+	const op errors.Op = "wallet.ExportAccountXpriv"
+
+	var xpriv *hdkeychain.ExtendedKey
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		xpriv, err = w.accountXpriv(dbtx, account, passphrase)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	logCtx(ctx).Warnf("Exported the extended private key of account %d", account)
+	return xpriv, nil
+}
+
+// DumpAccountPrivKeys returns the WIF-encoded private keys of each address of
+// a BIP0044 account's external and internal branches which the wallet has
+// derived, for migrating the account to other software.  The account's keys
+// must be unlocked, and passphrase must confirm the passphrase they were
+// unlocked with.
+func (w *Wallet) DumpAccountPrivKeys(ctx context.Context, account uint32,
+	passphrase []byte) ([]AccountPrivKey, error) {
+
+	const op errors.Op = "wallet.DumpAccountPrivKeys"
+
+	endExt, endInt, err := w.BIP0044BranchNextIndexes(ctx, account)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	var keys []AccountPrivKey
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		xpriv, err := w.accountXpriv(dbtx, account, passphrase)
+		if err != nil {
+			return err
+		}
+		defer xpriv.Zero()
+
+		keys = make([]AccountPrivKey, 0, endExt+endInt)
+		for _, b := range []struct{ branch, end uint32 }{
+			{udb.ExternalBranch, endExt},
+			{udb.InternalBranch, endInt},
+		} {
+			branchKey, err := xpriv.Child(b.branch)
+			if err != nil {
+				return err
+			}
+			for i := uint32(0); i < b.end; i++ {
+				key, err := w.accountPrivKey(branchKey, b.branch, i)
+				if errors.Is(err, hdkeychain.ErrInvalidChild) {
+					continue
+				}
+				if err != nil {
+					branchKey.Zero()
+					return err
+				}
+				keys = append(keys, *key)
+			}
+			branchKey.Zero()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	logCtx(ctx).Warnf("Exported %d private keys of account %d", len(keys),
+		account)
+	return keys, nil
+}
+
+// accountPrivKey derives the P2PKH address and WIF-encoded private key of a
+// child of an account branch.
+func (w *Wallet) accountPrivKey(branchKey *hdkeychain.ExtendedKey,
+	branch, index uint32) (*AccountPrivKey, error) {
+
+	child, err := branchKey.Child(index)
+	if err != nil {
+		return nil, err
+	}
+	defer child.Zero()
+	priv, err := child.SerializedPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	defer zero(priv)
+	pkh := dcrutil.Hash160(child.SerializedPubKey())
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(pkh,
+		w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	wif, err := dcrutil.NewWIF(priv, w.chainParams.PrivateKeyID,
+		dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountPrivKey{
+		Branch:  branch,
+		Index:   index,
+		Address: addr,
+		WIF:     wif.String(),
+	}, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+)
+
+func TestAccountKeyExport(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, defaultAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := w.ExportAccountXpriv(ctx, defaultAccount, testPrivPass); !errors.Is(err, errors.Locked) {
+		t.Errorf("locked wallet: expected Locked error, got %v", err)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	wrongPass := []byte("wrong")
+	if _, err := w.ExportAccountXpriv(ctx, defaultAccount, wrongPass); !errors.Is(err, errors.Passphrase) {
+		t.Errorf("wrong passphrase: expected Passphrase error, got %v", err)
+	}
+	if _, err := w.DumpAccountPrivKeys(ctx, defaultAccount, wrongPass); !errors.Is(err, errors.Passphrase) {
+		t.Errorf("wrong passphrase: expected Passphrase error, got %v", err)
+	}
+	if _, err := w.ExportAccountXpriv(ctx, udb.ImportedAddrAccount, testPrivPass); !errors.Is(err, errors.Invalid) {
+		t.Errorf("imported account: expected Invalid error, got %v", err)
+	}
+
+	xpriv, err := w.ExportAccountXpriv(ctx, defaultAccount, testPrivPass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := w.AccountXpub(ctx, defaultAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !xpriv.IsPrivate() || xpriv.Neuter().String() != xpub.String() {
+		t.Errorf("exported xpriv does not match the account xpub")
+	}
+
+	keys, err := w.DumpAccountPrivKeys(ctx, defaultAccount, testPrivPass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	endExt, endInt, err := w.BIP0044BranchNextIndexes(ctx, defaultAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != int(endExt+endInt) {
+		t.Fatalf("dumped %d keys, want %d", len(keys), endExt+endInt)
+	}
+	var found bool
+	for _, k := range keys {
+		wif, err := dcrutil.DecodeWIF(k.WIF, w.ChainParams().PrivateKeyID)
+		if err != nil {
+			t.Fatalf("invalid WIF of address %v: %v", k.Address, err)
+		}
+		pkh := dcrutil.Hash160(wif.PubKey())
+		if !bytes.Equal(pkh, k.Address.(stdaddr.Hash160er).Hash160()[:]) {
+			t.Errorf("WIF does not match address %v", k.Address)
+		}
+		if k.Address.String() == addr.String() {
+			found = k.Branch == udb.ExternalBranch
+		}
+	}
+	if !found {
+		t.Errorf("returned address %v was not dumped", addr)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/hdkeychain"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// AddressAudit describes the derivation path and usage of a derived wallet
+// address.
+type AddressAudit struct {
+	Address stdaddr.Address
+	Account uint32
+	Branch  uint32
+	Child   uint32
+
+	// FirstUseHeight and LastUseHeight are the lowest and highest block
+	// heights of mined outputs paying the address, or -1 if no output paying
+	// the address is mined.
+	FirstUseHeight int32
+	LastUseHeight  int32
+
+	// Received is the total of every output paying the address, in atoms of
+	// each coin type, including spent and unmined outputs.
+	Received map[cointype.CoinType]*big.Int
+
+	// UTXOs is the number of unspent outputs paying the address.
+	UTXOs int
+}
+
+// addOutput records an output paying the address.
+func (a *AddressAudit) addOutput(out *udb.ReceivedOutput) {
+	if out.Height >= 0 {
+		if a.FirstUseHeight == -1 || out.Height < a.FirstUseHeight {
+			a.FirstUseHeight = out.Height
+		}
+		if out.Height > a.LastUseHeight {
+			a.LastUseHeight = out.Height
+		}
+	}
+	received := a.Received[out.CoinType]
+	if received == nil {
+		received = new(big.Int)
+		a.Received[out.CoinType] = received
+	}
+	if out.CoinType.IsSKA() {
+		received.Add(received, out.SKAAmount.BigInt())
+	} else {
+		received.Add(received, big.NewInt(int64(out.Amount)))
+	}
+	if !out.Spent {
+		a.UTXOs++
+	}
+}
+
+// auditChildCount returns the number of children of an account branch which
+// have been derived, which is every child up to the last returned or used
+// child, or none when neither is recorded.
+func auditChildCount(lastUsed, lastReturned uint32) uint32 {
+	last := lastReturned
+	if lastUsed != ^uint32(0) && (last == ^uint32(0) || lastUsed > last) {
+		last = lastUsed
+	}
+	return last + 1
+}
+
+// AuditAddresses describes every derived address of the wallet's BIP0044 and
+// imported xpub accounts, up to the last returned or used child of each
+// branch, ordered by account, branch, and child.  Address usage is read from
+// the transaction store's credit indexes rather than by rescanning the chain,
+// so only outputs recorded by the wallet are reported.
+func (w *Wallet) AuditAddresses(ctx context.Context) ([]*AddressAudit, error) {
+	const op errors.Op = "wallet.AuditAddresses"
+
+	var audits []*AddressAudit
+	byAddr := make(map[string]*AddressAudit)
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		err := w.manager.ForEachAccount(addrmgrNs, func(account uint32) error {
+			if account == udb.ImportedAddrAccount {
+				return nil
+			}
+			props, err := w.manager.AccountProperties(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+			xpub, err := w.manager.AccountExtendedPubKey(dbtx, account)
+			if err != nil {
+				return err
+			}
+			extKey, intKey, err := deriveBranches(xpub)
+			if err != nil {
+				return err
+			}
+			branches := []struct {
+				branch uint32
+				key    *hdkeychain.ExtendedKey
+				count  uint32
+			}{
+				{udb.ExternalBranch, extKey, auditChildCount(
+					props.LastUsedExternalIndex, props.LastReturnedExternalIndex)},
+				{udb.InternalBranch, intKey, auditChildCount(
+					props.LastUsedInternalIndex, props.LastReturnedInternalIndex)},
+			}
+			for _, b := range branches {
+				for child := uint32(0); child < b.count; child++ {
+					addr, err := deriveChildAddress(b.key, child, w.chainParams)
+					if errors.Is(err, hdkeychain.ErrInvalidChild) {
+						continue
+					}
+					if err != nil {
+						return err
+					}
+					a := &AddressAudit{
+						Address:        addr,
+						Account:        account,
+						Branch:         b.branch,
+						Child:          child,
+						FirstUseHeight: -1,
+						LastUseHeight:  -1,
+						Received:       make(map[cointype.CoinType]*big.Int),
+					}
+					audits = append(audits, a)
+					byAddr[addr.String()] = a
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return w.txStore.ForEachReceivedOutput(dbtx, func(out *udb.ReceivedOutput) error {
+			_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed,
+				out.PkScript, w.chainParams)
+			for _, addr := range addrs {
+				if a, ok := byAddr[addr.String()]; ok {
+					a.addOutput(out)
+				}
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return audits, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+)
+
+func TestAuditChildCount(t *testing.T) {
+	t.Parallel()
+
+	const none = ^uint32(0)
+	tests := []struct {
+		lastUsed, lastReturned uint32
+		want                   uint32
+	}{
+		{none, none, 0},
+		{none, 4, 5},
+		{2, none, 3},
+		{2, 4, 5},
+		{9, 4, 10},
+	}
+	for _, tc := range tests {
+		got := auditChildCount(tc.lastUsed, tc.lastReturned)
+		if got != tc.want {
+			t.Errorf("auditChildCount(%d, %d) = %d, want %d", tc.lastUsed,
+				tc.lastReturned, got, tc.want)
+		}
+	}
+}
+
+func TestAddressAuditAddOutput(t *testing.T) {
+	t.Parallel()
+
+	a := &AddressAudit{
+		FirstUseHeight: -1,
+		LastUseHeight:  -1,
+		Received:       make(map[cointype.CoinType]*big.Int),
+	}
+	outputs := []*udb.ReceivedOutput{
+		{Height: 20, CoinType: cointype.CoinTypeVAR, Amount: 300, Spent: true},
+		{Height: 10, CoinType: cointype.CoinTypeVAR, Amount: 200},
+		{Height: -1, CoinType: cointype.CoinTypeVAR, Amount: 100},
+	}
+	for _, out := range outputs {
+		a.addOutput(out)
+	}
+	if a.FirstUseHeight != 10 || a.LastUseHeight != 20 {
+		t.Errorf("use heights %d-%d, want 10-20", a.FirstUseHeight,
+			a.LastUseHeight)
+	}
+	if got := a.Received[cointype.CoinTypeVAR]; got == nil || got.Int64() != 600 {
+		t.Errorf("received %v, want 600", got)
+	}
+	if a.UTXOs != 2 {
+		t.Errorf("UTXOs = %d, want 2", a.UTXOs)
+	}
+
+	// Unmined outputs are counted without recording a use height.
+	a = &AddressAudit{
+		FirstUseHeight: -1,
+		LastUseHeight:  -1,
+		Received:       make(map[cointype.CoinType]*big.Int),
+	}
+	a.addOutput(outputs[2])
+	if a.FirstUseHeight != -1 || a.LastUseHeight != -1 || a.UTXOs != 1 {
+		t.Errorf("unmined output: heights %d-%d, %d UTXOs", a.FirstUseHeight,
+			a.LastUseHeight, a.UTXOs)
+	}
+}
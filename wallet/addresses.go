@@ -383,6 +383,10 @@ func (x *xpubAddress) payRevokeCommitment() (script []byte, version uint16) {
 // DefaultGapLimit is the default unused address gap limit defined by BIP0044.
 const DefaultGapLimit = uint32(20)
 
+// maxAddressGapLimit is the largest unused address gap limit which may be set
+// for an account.
+const maxAddressGapLimit = 1 << 16
+
 // DefaultAccountGapLimit is the default number of accounts that can be
 // created in a row without using any of them
 const DefaultAccountGapLimit = 10
@@ -450,12 +454,28 @@ type addressBuffer struct {
 	// to children beyond the last returned child recorded in the database.
 	cursor      uint32
 	lastWatched uint32
+	// derived holds addresses of future children derived ahead of time by
+	// PreDeriveAddresses, keyed by child index.  Children are removed as
+	// they are returned.
+	derived map[uint32]*stdaddr.AddressPubKeyHashEcdsaSecp256k1V0
 }
 
 type bip0044AccountData struct {
 	xpub        *hdkeychain.ExtendedKey
 	albExternal addressBuffer
 	albInternal addressBuffer
+	gapLimit    uint32
+	multisig    *multisigParams // nil unless a multisig account
+	vault       *vaultParams    // nil unless a vault account
+}
+
+// addressGapLimit returns the unused address gap limit of an account, which is
+// the wallet's gap limit unless a gap limit has been set for the account.
+func (w *Wallet) addressGapLimit(props *udb.AccountProperties) uint32 {
+	if props.GapLimit != 0 {
+		return props.GapLimit
+	}
+	return w.gapLimit
 }
 
 // persistReturnedChildFunc is the function used by nextAddress to update the
@@ -500,7 +520,19 @@ func (w *Wallet) persistReturnedChild(ctx context.Context, maybeDBTX walletdb.Re
 		if err != nil {
 			return err
 		}
-		return w.manager.MarkReturnedChildIndex(maybeDBTX, account, branch, child)
+		err = w.manager.MarkReturnedChildIndex(maybeDBTX, account, branch, child)
+		if err != nil {
+			return err
+		}
+		err = w.importMultisigScript(maybeDBTX, account, branch, child)
+		if err != nil {
+			return err
+		}
+		err = w.importVaultScript(maybeDBTX, account, branch, child)
+		if err != nil {
+			return err
+		}
+		return w.importChangeScript(maybeDBTX, account, branch, child)
 	}
 }
 
@@ -538,6 +570,11 @@ func (w *Wallet) nextAddress(ctx context.Context, op errors.Op, persist persistR
 	for _, c := range callOpts {
 		c(&opts)
 	}
+	// Wrapping around returns previously returned addresses, which the
+	// address reuse policy forbids.
+	if opts.policy == gapPolicyWrap && w.AvoidAddressReuse() {
+		opts.policy = gapPolicyIgnore
+	}
 
 	defer w.addressBuffersMu.Unlock()
 	w.addressBuffersMu.Lock()
@@ -560,7 +597,7 @@ func (w *Wallet) nextAddress(ctx context.Context, op errors.Op, persist persistR
 		if ctx.Err() != nil {
 			return nil, ctx.Err()
 		}
-		if alb.cursor >= w.gapLimit {
+		if alb.cursor >= ad.gapLimit {
 			switch opts.policy {
 			case gapPolicyError:
 				return nil, errors.E(op, errors.Policy,
@@ -570,9 +607,10 @@ func (w *Wallet) nextAddress(ctx context.Context, op errors.Op, persist persistR
 				// Addresses beyond the last used child + gap limit are not
 				// already watched, so this must be done now if the wallet is
 				// connected to a consensus RPC server.  Watch addresses in
-				// batches of the gap limit at a time to avoid introducing many
-				// RPCs from repeated new address calls.
-				if alb.cursor%w.gapLimit != 0 {
+				// batches of the gap limit at a time, queued with other new
+				// addresses, to avoid introducing many RPCs from repeated new
+				// address calls.
+				if alb.cursor%ad.gapLimit != 0 {
 					break
 				}
 				n, err := w.NetworkBackend()
@@ -580,14 +618,11 @@ func (w *Wallet) nextAddress(ctx context.Context, op errors.Op, persist persistR
 					break
 				}
 				addrs, err := deriveChildAddresses(alb.branchXpub,
-					alb.lastUsed+1+alb.cursor, w.gapLimit, w.chainParams)
+					alb.lastUsed+1+alb.cursor, ad.gapLimit, w.chainParams)
 				if err != nil {
 					return nil, errors.E(op, err)
 				}
-				err = n.LoadTxFilter(ctx, false, addrs, nil)
-				if err != nil {
-					return nil, err
-				}
+				w.queueTxFilterAddrs(n, addrs)
 
 			case gapPolicyWrap:
 				alb.cursor = 0
@@ -599,24 +634,46 @@ func (w *Wallet) nextAddress(ctx context.Context, op errors.Op, persist persistR
 			return nil, errors.E(op, errors.Errorf("account %d branch %d exhausted",
 				account, branch))
 		}
-		child, err := alb.branchXpub.Child(childIndex)
-		if errors.Is(err, hdkeychain.ErrInvalidChild) {
-			alb.cursor++
-			continue
-		}
-		if err != nil {
-			return nil, errors.E(op, err)
-		}
-		apkh, err := compat.HD2Address(child, w.chainParams)
-		if err != nil {
-			return nil, errors.E(op, err)
+		apkh, ok := alb.derived[childIndex]
+		if !ok {
+			child, err := alb.branchXpub.Child(childIndex)
+			if errors.Is(err, hdkeychain.ErrInvalidChild) {
+				alb.cursor++
+				continue
+			}
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			apkh, err = compat.HD2Address(child, w.chainParams)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
 		}
 		// Write the returned child index to the database.
-		err = persist(account, branch, childIndex)
+		err := persist(account, branch, childIndex)
 		if err != nil {
 			return nil, errors.E(op, err)
 		}
+		delete(alb.derived, childIndex)
 		alb.cursor++
+		if ad.multisig != nil {
+			addr, err := w.multisigAddress(ad, accountName, account, branch, childIndex)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			logCtx(ctx).Infof("Returning multisig address (account=%v branch=%v child=%v)",
+				account, branch, childIndex)
+			return addr, nil
+		}
+		if ad.vault != nil {
+			addr, err := w.vaultAddress(ad, accountName, account, branch, childIndex)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			logCtx(ctx).Infof("Returning vault address (account=%v branch=%v child=%v)",
+				account, branch, childIndex)
+			return addr, nil
+		}
 		addr := &xpubAddress{
 			AddressPubKeyHashEcdsaSecp256k1V0: apkh,
 			xpub:                              ad.xpub,
@@ -625,7 +682,7 @@ func (w *Wallet) nextAddress(ctx context.Context, op errors.Op, persist persistR
 			branch:                            branch,
 			child:                             childIndex,
 		}
-		log.Infof("Returning address (account=%v branch=%v child=%v)", account, branch, childIndex)
+		logCtx(ctx).Infof("Returning address (account=%v branch=%v child=%v)", account, branch, childIndex)
 		return addr, nil
 	}
 }
@@ -687,7 +744,7 @@ func (w *Wallet) AddressAtIdx(ctx context.Context, account, branch,
 		branch:                            branch,
 		child:                             childIdx,
 	}
-	log.Infof("Returning address (account=%v branch=%v child=%v)", account, branch, childIdx)
+	logCtx(ctx).Infof("Returning address (account=%v branch=%v child=%v)", account, branch, childIdx)
 	return addr, nil
 }
 
@@ -715,7 +772,7 @@ func (w *Wallet) markUsedAddress(op errors.Op, dbtx walletdb.ReadWriteTx, addr u
 		branch = udb.InternalBranch
 	}
 	err = w.manager.SyncAccountToAddrIndex(ns, account,
-		min(hdkeychain.HardenedKeyStart-1, lastUsed+w.gapLimit),
+		min(hdkeychain.HardenedKeyStart-1, lastUsed+w.addressGapLimit(props)),
 		branch)
 	if err != nil {
 		return errors.E(op, err)
@@ -817,6 +874,68 @@ func (w *Wallet) BIP0044BranchNextIndexes(ctx context.Context, account uint32) (
 	return extChild, intChild, nil
 }
 
+// SetAccountGapLimit sets the unused address gap limit of an account,
+// overriding the wallet's gap limit.  Accounts with heavy address churn may use
+// a larger gap limit to return and watch more unused addresses, and address
+// discovery searches the account using its gap limit.  A zero gap limit
+// reverts the account to the wallet's gap limit.
+func (w *Wallet) SetAccountGapLimit(ctx context.Context, account, gapLimit uint32) error {
+	const op errors.Op = "wallet.SetAccountGapLimit"
+	if gapLimit > maxAddressGapLimit {
+		return errors.E(op, errors.Invalid, errors.Errorf("gap limit %d "+
+			"exceeds the maximum %d", gapLimit, maxAddressGapLimit))
+	}
+
+	defer w.addressBuffersMu.Unlock()
+	w.addressBuffersMu.Lock()
+
+	ad, ok := w.addressBuffers[account]
+	if !ok {
+		return errors.E(op, errors.NotExist, errors.Errorf("account %v", account))
+	}
+	newGapLimit := gapLimit
+	if newGapLimit == 0 {
+		newGapLimit = w.gapLimit
+	}
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		err := w.manager.SetAccountGapLimit(ns, account, gapLimit)
+		if err != nil {
+			return err
+		}
+		err = w.manager.SyncAccountToAddrIndex(ns, account,
+			min(hdkeychain.HardenedKeyStart-1, ad.albExternal.lastUsed+newGapLimit),
+			udb.ExternalBranch)
+		if err != nil {
+			return err
+		}
+		return w.manager.SyncAccountToAddrIndex(ns, account,
+			min(hdkeychain.HardenedKeyStart-1, ad.albInternal.lastUsed+newGapLimit),
+			udb.InternalBranch)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	oldGapLimit := ad.gapLimit
+	ad.gapLimit = newGapLimit
+
+	// Watch the additional addresses within a larger gap limit.
+	n, err := w.NetworkBackend()
+	if err != nil || newGapLimit <= oldGapLimit {
+		return nil
+	}
+	for _, alb := range []*addressBuffer{&ad.albExternal, &ad.albInternal} {
+		addrs, err := deriveChildAddresses(alb.branchXpub,
+			alb.lastUsed+1+oldGapLimit, newGapLimit-oldGapLimit, w.chainParams)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		w.queueTxFilterAddrs(n, addrs)
+	}
+	return nil
+}
+
 // SyncLastReturnedAddress advances the last returned child address for a
 // BIP00044 account branch.  The next returned address for the branch will be
 // child+1.
@@ -826,6 +945,7 @@ func (w *Wallet) SyncLastReturnedAddress(ctx context.Context, account, branch, c
 	var (
 		branchXpub *hdkeychain.ExtendedKey
 		lastUsed   uint32
+		gapLimit   uint32
 	)
 	err := func() error {
 		defer w.addressBuffersMu.Unlock()
@@ -847,6 +967,7 @@ func (w *Wallet) SyncLastReturnedAddress(ctx context.Context, account, branch, c
 
 		branchXpub = alb.branchXpub
 		lastUsed = alb.lastUsed
+		gapLimit = acctData.gapLimit
 		if lastUsed != ^uint32(0) && child > lastUsed {
 			alb.cursor = child - lastUsed
 		}
@@ -869,23 +990,139 @@ func (w *Wallet) SyncLastReturnedAddress(ctx context.Context, account, branch, c
 	}
 
 	if n, err := w.NetworkBackend(); err == nil {
-		lastWatched := lastUsed + w.gapLimit
+		lastWatched := lastUsed + gapLimit
 		if child <= lastWatched {
 			// No need to derive anything more.
 			return nil
 		}
 		additionalAddrs := child - lastWatched
-		addrs, err := deriveChildAddresses(branchXpub, lastUsed+1+w.gapLimit,
+		addrs, err := deriveChildAddresses(branchXpub, lastUsed+1+gapLimit,
 			additionalAddrs, w.chainParams)
 		if err != nil {
 			return errors.E(op, err)
 		}
-		err = n.LoadTxFilter(ctx, false, addrs, nil)
+		w.queueTxFilterAddrs(n, addrs)
+	}
+
+	return nil
+}
+
+// maxPreDerivedAddresses is the largest number of addresses which may be
+// derived ahead of time by a single PreDeriveAddresses call.
+const maxPreDerivedAddresses = 1 << 16
+
+// PreDeriveAddresses derives the next count addresses of a BIP0044 account
+// branch ahead of time, so they may later be returned by the NextAddress family
+// of methods without deriving them or updating the transaction filter of the
+// network backend.  Records of the addresses are saved to the database, and
+// addresses beyond the account's watched addresses are loaded into the
+// transaction filter of the network backend, if any, before returning.
+//
+// Pre-deriving addresses does not change the gap limit policy of returning
+// them.  Callers returning more unused addresses than the gap limit must still
+// use the WithGapPolicyIgnore call option.
+func (w *Wallet) PreDeriveAddresses(ctx context.Context, account, branch, count uint32) error {
+	const op errors.Op = "wallet.PreDeriveAddresses"
+	if count > maxPreDerivedAddresses {
+		return errors.E(op, errors.Invalid, errors.Errorf("cannot pre-derive "+
+			"more than %d addresses", maxPreDerivedAddresses))
+	}
+	if count == 0 {
+		return nil
+	}
+
+	var (
+		last  uint32
+		watch []stdaddr.Address
+	)
+	err := func() error {
+		defer w.addressBuffersMu.Unlock()
+		w.addressBuffersMu.Lock()
+
+		ad, ok := w.addressBuffers[account]
+		if !ok {
+			return errors.E(op, errors.NotExist, errors.Errorf("account %v", account))
+		}
+		if ad.multisig != nil {
+			return errors.E(op, errors.Invalid, "addresses of multisig "+
+				"accounts can not be pre-derived")
+		}
+		if ad.vault != nil {
+			return errors.E(op, errors.Invalid, "addresses of vault "+
+				"accounts can not be pre-derived")
+		}
+		var alb *addressBuffer
+		switch branch {
+		case udb.ExternalBranch:
+			alb = &ad.albExternal
+		case udb.InternalBranch:
+			alb = &ad.albInternal
+		default:
+			return errors.E(op, errors.Invalid, "branch must be external (0) or internal (1)")
+		}
+
+		next := alb.lastUsed + 1 + alb.cursor
+		if uint64(next)+uint64(count) > hdkeychain.HardenedKeyStart {
+			return errors.E(op, errors.Errorf("account %d branch %d exhausted",
+				account, branch))
+		}
+		last = next + count - 1
+
+		// Forget addresses of children which can no longer be returned.
+		for child := range alb.derived {
+			if child < next {
+				delete(alb.derived, child)
+			}
+		}
+		if alb.derived == nil {
+			alb.derived = make(map[uint32]*stdaddr.AddressPubKeyHashEcdsaSecp256k1V0)
+		}
+
+		// Addresses up to the gap limit past the last used child are
+		// already watched.
+		lastWatched := alb.lastUsed + ad.gapLimit
+		for child := next; child <= last; child++ {
+			apkh, ok := alb.derived[child]
+			if !ok {
+				key, err := alb.branchXpub.Child(child)
+				if errors.Is(err, hdkeychain.ErrInvalidChild) {
+					continue
+				}
+				if err != nil {
+					return errors.E(op, err)
+				}
+				apkh, err = compat.HD2Address(key, w.chainParams)
+				if err != nil {
+					return errors.E(op, err)
+				}
+				alb.derived[child] = apkh
+			}
+			if child > lastWatched {
+				watch = append(watch, apkh)
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		return err
+	}
+
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.manager.SyncAccountToAddrIndex(ns, account, last, branch)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	if n, err := w.NetworkBackend(); err == nil && len(watch) != 0 {
+		err := n.LoadTxFilter(ctx, false, watch, nil)
 		if err != nil {
 			return errors.E(op, err)
 		}
 	}
-
+	logCtx(ctx).Infof("Pre-derived %d addresses (account=%v branch=%v children=%v-%v)",
+		count, account, branch, last-count+1, last)
 	return nil
 }
 
@@ -919,12 +1156,16 @@ func (w *Wallet) ImportedAddresses(ctx context.Context, account string) (_ []Kno
 	return addrs, err
 }
 
+// p2PKHChangeSource is the change source of transactions paying change to the
+// next internal address of an account.  Change pays the P2PKH script of the
+// address unless scriptType selects another script paying the same key.
 type p2PKHChangeSource struct {
-	persist   persistReturnedChildFunc
-	account   uint32
-	wallet    *Wallet
-	ctx       context.Context
-	gapPolicy gapPolicy
+	persist    persistReturnedChildFunc
+	account    uint32
+	wallet     *Wallet
+	ctx        context.Context
+	gapPolicy  gapPolicy
+	scriptType udb.ChangeScriptType
 }
 
 func (src *p2PKHChangeSource) Script() ([]byte, uint16, error) {
@@ -934,12 +1175,63 @@ func (src *p2PKHChangeSource) Script() ([]byte, uint16, error) {
 	if err != nil {
 		return nil, 0, err
 	}
+	changeAddress, err = src.wallet.changeScriptAddress(changeAddress, src.scriptType)
+	if err != nil {
+		return nil, 0, err
+	}
 	vers, pkScript := changeAddress.PaymentScript()
 	return pkScript, vers, nil
 }
 
 func (src *p2PKHChangeSource) ScriptSize() int {
-	return txsizes.P2PKHPkScriptSize
+	return changeScriptSize(src.scriptType)
+}
+
+// dryRunChangeSource is the change source of transactions which are only
+// estimated.  Change pays a script of the size of scriptType with a zero hash,
+// so no change address is derived from the change account.
+type dryRunChangeSource struct {
+	treasury   bool
+	scriptType udb.ChangeScriptType
+	params     *chaincfg.Params
+}
+
+func (src *dryRunChangeSource) Script() ([]byte, uint16, error) {
+	if !src.treasury && src.scriptType != udb.ChangeScriptP2PKH {
+		var zero [20]byte
+		var addr stdaddr.Address
+		var err error
+		switch src.scriptType {
+		case udb.ChangeScriptSchnorrP2PKH:
+			addr, err = stdaddr.NewAddressPubKeyHashSchnorrSecp256k1V0(zero[:],
+				src.params)
+		default:
+			addr, err = stdaddr.NewAddressScriptHashV0FromHash(zero[:],
+				src.params)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		vers, script := addr.PaymentScript()
+		return script, vers, nil
+	}
+
+	script := make([]byte, 0, txsizes.P2PKHPkTreasruryScriptSize)
+	if src.treasury {
+		script = append(script, txscript.OP_SSTXCHANGE)
+	}
+	script = append(script, txscript.OP_DUP, txscript.OP_HASH160,
+		txscript.OP_DATA_20)
+	script = append(script, make([]byte, 20)...)
+	script = append(script, txscript.OP_EQUALVERIFY, txscript.OP_CHECKSIG)
+	return script, 0, nil
+}
+
+func (src *dryRunChangeSource) ScriptSize() int {
+	if src.treasury {
+		return txsizes.P2PKHPkTreasruryScriptSize
+	}
+	return changeScriptSize(src.scriptType)
 }
 
 // p2PKHTreasuryChangeSource is the change source that shall be used when there
@@ -10,8 +10,11 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
 	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
 )
 
 // expectedAddr is used to house the expected return values from a managed
@@ -307,3 +310,105 @@ func useAddress(child uint32) func(ctx context.Context, t *testing.T, w *Wallet)
 		watchFutureAddresses(ctx, t, w)
 	}
 }
+
+func TestPreDeriveAddresses(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	w.SetNetworkBackend(mockNetwork{})
+
+	err := w.PreDeriveAddresses(ctx, 0, 0, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w.addressBuffersMu.Lock()
+	alb := &w.addressBuffers[0].albExternal
+	derived := len(alb.derived)
+	xbranch := alb.branchXpub
+	w.addressBuffersMu.Unlock()
+	if derived != 30 {
+		t.Fatalf("pre-derived %d addresses, want 30", derived)
+	}
+
+	// Records of pre-derived addresses beyond the gap limit are saved.
+	addr29, err := deriveChildAddress(xbranch, 29, basicWalletConfig.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrBucketKey)
+		_, err := w.manager.Address(ns, addr29)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("pre-derived address is not recorded: %v", err)
+	}
+
+	// Returned addresses are the pre-derived addresses, which are removed
+	// once returned.
+	addr0, err := deriveChildAddress(xbranch, 0, basicWalletConfig.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.String() != addr0.String() {
+		t.Fatalf("returned address %v, want %v", next, addr0)
+	}
+	w.addressBuffersMu.Lock()
+	_, ok := alb.derived[0]
+	derived = len(alb.derived)
+	w.addressBuffersMu.Unlock()
+	if ok || derived != 29 {
+		t.Fatalf("returned address remains pre-derived (%d addresses)", derived)
+	}
+
+	if err := w.PreDeriveAddresses(ctx, 0, 2, 1); err == nil {
+		t.Fatal("pre-deriving addresses of an invalid branch did not error")
+	}
+	if err := w.PreDeriveAddresses(ctx, 0, 0, maxPreDerivedAddresses+1); err == nil {
+		t.Fatal("pre-deriving too many addresses did not error")
+	}
+}
+
+func TestDryRunChangeSource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		treasury   bool
+		scriptType udb.ChangeScriptType
+		class      stdscript.ScriptType
+	}{
+		{false, udb.ChangeScriptP2PKH, stdscript.STPubKeyHashEcdsaSecp256k1},
+		{false, udb.ChangeScriptSchnorrP2PKH, stdscript.STPubKeyHashSchnorrSecp256k1},
+		{false, udb.ChangeScriptP2SH, stdscript.STScriptHash},
+		{true, udb.ChangeScriptP2PKH, stdscript.STStakeChangePubKeyHash},
+		{true, udb.ChangeScriptP2SH, stdscript.STStakeChangePubKeyHash},
+	}
+	for _, test := range tests {
+		src := &dryRunChangeSource{
+			treasury:   test.treasury,
+			scriptType: test.scriptType,
+			params:     chaincfg.SimNetParams(),
+		}
+		script, vers, err := src.Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(script) != src.ScriptSize() {
+			t.Errorf("treasury=%v %v: script size %d does not match "+
+				"ScriptSize %d", test.treasury, test.scriptType,
+				len(script), src.ScriptSize())
+		}
+		if class := stdscript.DetermineScriptType(vers, script); class != test.class {
+			t.Errorf("treasury=%v %v: got script type %v, want %v",
+				test.treasury, test.scriptType, class, test.class)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package atomicswap
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/sign"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/contracts"
+)
+
+// Lock times of the contracts of each side of a swap, relative to the time
+// the contract is created.
+const (
+	InitiatorLockTime   = 48 * time.Hour
+	ParticipantLockTime = 24 * time.Hour
+)
+
+// verifyFlags are the script flags required to execute contract scripts.
+const verifyFlags = txscript.ScriptVerifyCheckLockTimeVerify |
+	txscript.ScriptVerifySHA256
+
+func newContract(recipient, refund stdaddr.Address, secretHash *[32]byte,
+	lockTime time.Time) (*contracts.Contract, error) {
+
+	recipientHash, ok := contracts.PubKeyHash(recipient)
+	if !ok {
+		return nil, errors.E(errors.Invalid, errors.Errorf("recipient %v "+
+			"is not a P2PKH address", recipient))
+	}
+	refundHash, ok := contracts.PubKeyHash(refund)
+	if !ok {
+		return nil, errors.E(errors.Invalid, errors.Errorf("refund address %v "+
+			"is not a P2PKH address", refund))
+	}
+	return &contracts.Contract{
+		SecretHash:       *secretHash,
+		RecipientHash160: *recipientHash,
+		RefundHash160:    *refundHash,
+		LockTime:         lockTime.Unix(),
+	}, nil
+}
+
+// Initiate returns the terms of a contract initiating a swap, paying
+// recipient with a new secret, or refund after InitiatorLockTime has passed
+// since now.  The secret must be kept private until the participant's
+// contract is redeemed.
+func Initiate(recipient, refund stdaddr.Address, now time.Time) (c *contracts.Contract,
+	secret [32]byte, err error) {
+
+	const op errors.Op = "atomicswap.Initiate"
+
+	secret, secretHash, err := contracts.GenerateSecret()
+	if err != nil {
+		return nil, secret, errors.E(op, err)
+	}
+	c, err = newContract(recipient, refund, &secretHash, now.Add(InitiatorLockTime))
+	if err != nil {
+		return nil, [32]byte{}, errors.E(op, err)
+	}
+	return c, secret, nil
+}
+
+// Participate returns the terms of a contract participating in a swap with
+// the secret hash of the initiator's contract, paying recipient, or refund
+// after ParticipantLockTime has passed since now.
+func Participate(recipient, refund stdaddr.Address, secretHash *[32]byte,
+	now time.Time) (*contracts.Contract, error) {
+
+	const op errors.Op = "atomicswap.Participate"
+
+	c, err := newContract(recipient, refund, secretHash, now.Add(ParticipantLockTime))
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return c, nil
+}
+
+// ContractOutput returns the transaction output locking amount atoms of
+// coinType in the contract redeem script.
+func ContractOutput(contract []byte, coinType cointype.CoinType, amount *big.Int) (*wire.TxOut, error) {
+	const op errors.Op = "atomicswap.ContractOutput"
+
+	switch {
+	case !coinType.IsValid():
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("invalid coin type %d", coinType))
+	case amount == nil || amount.Sign() <= 0:
+		return nil, errors.E(op, errors.Invalid, "contract amount must be positive")
+	case !coinType.IsSKA() && !amount.IsInt64():
+		return nil, errors.E(op, errors.Invalid, "contract amount out of range")
+	}
+	if _, err := contracts.ParseContract(contract); err != nil {
+		return nil, errors.E(op, err)
+	}
+	out := &wire.TxOut{CoinType: coinType, PkScript: contracts.PkScript(contract)}
+	if coinType.IsSKA() {
+		out.SKAValue = new(big.Int).Set(amount)
+	} else {
+		out.Value = amount.Int64()
+	}
+	return out, nil
+}
+
+// Audit describes a contract output of a transaction, as checked by the
+// counterparty of a swap before funding or redeeming their side.
+type Audit struct {
+	Terms       contracts.Contract
+	Address     stdaddr.Address
+	Recipient   stdaddr.Address
+	Refund      stdaddr.Address
+	OutputIndex uint32
+	CoinType    cointype.CoinType
+	Amount      *big.Int
+}
+
+// AuditContract returns the terms and value of the output of contractTx
+// paying to the contract redeem script.  An error with code NotExist is
+// returned when the transaction does not pay to the contract.
+func AuditContract(contractTx *wire.MsgTx, contract []byte,
+	params stdaddr.AddressParamsV0) (*Audit, error) {
+
+	const op errors.Op = "atomicswap.AuditContract"
+
+	terms, err := contracts.ParseContract(contract)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	idx, err := contracts.FindContractOutput(contractTx, contract)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	addr, err := contracts.Address(contract, params)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	recipient, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		terms.RecipientHash160[:], params)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	refund, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		terms.RefundHash160[:], params)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	out := contractTx.TxOut[idx]
+	amount := big.NewInt(out.Value)
+	if out.CoinType.IsSKA() {
+		amount = new(big.Int)
+		if out.SKAValue != nil {
+			amount.Set(out.SKAValue)
+		}
+	}
+	return &Audit{
+		Terms:       *terms,
+		Address:     addr,
+		Recipient:   recipient,
+		Refund:      refund,
+		OutputIndex: idx,
+		CoinType:    out.CoinType,
+		Amount:      amount,
+	}, nil
+}
+
+// Redeem returns the transaction redeeming the contract output of contractTx
+// with the secret, signed by key of the contract recipient and paying the
+// contract value less the fee at feePerKb to pkScript.
+func Redeem(contractTx *wire.MsgTx, contract, secret []byte, key *secp256k1.PrivateKey,
+	pkScriptVersion uint16, pkScript []byte, feePerKb dcrutil.Amount) (*wire.MsgTx, error) {
+
+	const op errors.Op = "atomicswap.Redeem"
+
+	terms, err := contracts.ParseContract(contract)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(secret) != contracts.SecretSize || sha256.Sum256(secret) != terms.SecretHash {
+		return nil, errors.E(op, errors.Invalid, "secret does not match the contract secret hash")
+	}
+	tx, err := contracts.NewRedeemTx(contractTx, contract, pkScriptVersion, pkScript, feePerKb)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	err = signContractInput(tx, contractTx, contract, &terms.RecipientHash160, key, secret)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return tx, nil
+}
+
+// Refund returns the transaction refunding the contract output of contractTx,
+// signed by key of the contract refund address and paying the contract value
+// less the fee at feePerKb to pkScript.  The transaction may not be mined
+// before the lock time of the contract.
+func Refund(contractTx *wire.MsgTx, contract []byte, key *secp256k1.PrivateKey,
+	pkScriptVersion uint16, pkScript []byte, feePerKb dcrutil.Amount) (*wire.MsgTx, error) {
+
+	const op errors.Op = "atomicswap.Refund"
+
+	terms, err := contracts.ParseContract(contract)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	tx, err := contracts.NewRefundTx(contractTx, contract, pkScriptVersion, pkScript, feePerKb)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	err = signContractInput(tx, contractTx, contract, &terms.RefundHash160, key, nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return tx, nil
+}
+
+// signContractInput signs the contract input of a redeem transaction when
+// secret is set, or a refund transaction otherwise, with the key of pkh, and
+// executes the signed script.
+func signContractInput(tx, contractTx *wire.MsgTx, contract []byte, pkh *[20]byte,
+	key *secp256k1.PrivateKey, secret []byte) error {
+
+	pubKey := key.PubKey().SerializeCompressed()
+	if [20]byte(stdaddr.Hash160(pubKey)) != *pkh {
+		return errors.E(errors.Invalid, "key does not match the contract")
+	}
+	sig, err := sign.RawTxInSignature(tx, 0, contract, txscript.SigHashAll,
+		key.Serialize(), dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		return err
+	}
+	if secret != nil {
+		tx.TxIn[0].SignatureScript, err = contracts.RedeemSigScript(contract, sig, pubKey, secret)
+	} else {
+		tx.TxIn[0].SignatureScript, err = contracts.RefundSigScript(contract, sig, pubKey)
+	}
+	if err != nil {
+		return err
+	}
+
+	prevOut := contractTx.TxOut[tx.TxIn[0].PreviousOutPoint.Index]
+	vm, err := txscript.NewEngine(prevOut.PkScript, tx, 0, verifyFlags,
+		prevOut.Version, nil)
+	if err == nil {
+		err = vm.Execute()
+	}
+	if err != nil {
+		return errors.E(errors.ScriptFailure, err)
+	}
+	return nil
+}
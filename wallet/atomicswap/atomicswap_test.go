@@ -0,0 +1,162 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package atomicswap
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/contracts"
+	"github.com/monetarium/monetarium-wallet/wallet/txsizes"
+)
+
+func TestSwap(t *testing.T) {
+	t.Parallel()
+
+	params := chaincfg.SimNetParams()
+	keyAddr := func(key *secp256k1.PrivateKey) stdaddr.Address {
+		pkh := stdaddr.Hash160(key.PubKey().SerializeCompressed())
+		addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(pkh, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addr
+	}
+	initiatorKey := secp256k1.PrivKeyFromBytes([]byte{1})
+	participantKey := secp256k1.PrivKeyFromBytes([]byte{2})
+	initiatorAddr := keyAddr(initiatorKey)
+	participantAddr := keyAddr(participantKey)
+	now := time.Unix(1.7e9, 0)
+
+	p2sh, err := stdaddr.NewAddressScriptHashV0([]byte{1}, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := Initiate(p2sh, initiatorAddr, now); !errors.Is(err, errors.Invalid) {
+		t.Errorf("P2SH recipient: expected Invalid error, got %v", err)
+	}
+
+	// The initiator's contract pays the participant, who audits it and
+	// creates a contract with the same secret hash paying the initiator.
+	initiation, secret, err := Initiate(participantAddr, initiatorAddr, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if initiation.LockTime != now.Add(InitiatorLockTime).Unix() ||
+		initiation.SecretHash != sha256.Sum256(secret[:]) {
+		t.Fatalf("unexpected initiator contract %+v", initiation)
+	}
+	initiatorContract, err := initiation.Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(initiatorContract) > txsizes.AtomicSwapContractSize {
+		t.Errorf("contract size %d exceeds the estimate", len(initiatorContract))
+	}
+	if _, err := ContractOutput(initiatorContract, 0, big.NewInt(0)); !errors.Is(err, errors.Invalid) {
+		t.Errorf("zero amount: expected Invalid error, got %v", err)
+	}
+	out, err := ContractOutput(initiatorContract, 0, big.NewInt(5e8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	initiatorTx := &wire.MsgTx{TxOut: []*wire.TxOut{
+		wire.NewTxOut(1e8, []byte{txscript.OP_TRUE}),
+		out,
+	}}
+
+	audit, err := AuditContract(initiatorTx, initiatorContract, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if audit.OutputIndex != 1 || audit.CoinType != 0 ||
+		audit.Amount.Cmp(big.NewInt(5e8)) != 0 || audit.Terms != *initiation ||
+		audit.Recipient.String() != participantAddr.String() ||
+		audit.Refund.String() != initiatorAddr.String() {
+		t.Errorf("unexpected audit %+v", audit)
+	}
+	_, err = AuditContract(&wire.MsgTx{TxOut: initiatorTx.TxOut[:1]}, initiatorContract, params)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("missing contract output: expected NotExist error, got %v", err)
+	}
+
+	participation, err := Participate(initiatorAddr, participantAddr,
+		&audit.Terms.SecretHash, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if participation.LockTime != now.Add(ParticipantLockTime).Unix() {
+		t.Errorf("participant lock time %d", participation.LockTime)
+	}
+	participantContract, err := participation.Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err = ContractOutput(participantContract, 1, big.NewInt(7e8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	participantTx := &wire.MsgTx{TxOut: []*wire.TxOut{out}}
+
+	// The initiator redeems the participant's contract, revealing the
+	// secret used by the participant to redeem the initiator's contract.
+	payScript := []byte{txscript.OP_TRUE}
+	const feePerKb = 1e4
+	_, err = Redeem(participantTx, participantContract, secret[:], participantKey,
+		0, payScript, feePerKb)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("redeem with refund key: expected Invalid error, got %v", err)
+	}
+	_, err = Redeem(participantTx, participantContract, make([]byte, contracts.SecretSize),
+		initiatorKey, 0, payScript, feePerKb)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("redeem with wrong secret: expected Invalid error, got %v", err)
+	}
+	redeemTx, err := Redeem(participantTx, participantContract, secret[:], initiatorKey,
+		0, payScript, feePerKb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(redeemTx.TxIn[0].SignatureScript); n > txsizes.RedeemAtomicSwapSigScriptSize {
+		t.Errorf("redeem signature script size %d exceeds the estimate", n)
+	}
+	revealed, err := contracts.ExtractSecret(redeemTx, &audit.Terms.SecretHash)
+	if err != nil || !bytes.Equal(revealed, secret[:]) {
+		t.Fatalf("extracted secret %x, %v", revealed, err)
+	}
+	if _, err := Redeem(initiatorTx, initiatorContract, revealed, participantKey,
+		0, payScript, feePerKb); err != nil {
+		t.Errorf("participant redeem: %v", err)
+	}
+
+	// Either contract may instead be refunded by the key that funded it.
+	if _, err := Refund(initiatorTx, initiatorContract, participantKey,
+		0, payScript, feePerKb); !errors.Is(err, errors.Invalid) {
+		t.Errorf("refund with recipient key: expected Invalid error, got %v", err)
+	}
+	refundTx, err := Refund(initiatorTx, initiatorContract, initiatorKey, 0, payScript, feePerKb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if int64(refundTx.LockTime) != initiation.LockTime {
+		t.Errorf("refund lock time %d", refundTx.LockTime)
+	}
+	if n := len(refundTx.TxIn[0].SignatureScript); n > txsizes.RefundAtomicSwapSigScriptSize {
+		t.Errorf("refund signature script size %d exceeds the estimate", n)
+	}
+	if initiation.LockTimeReached(0, now.Add(InitiatorLockTime-time.Second)) ||
+		!initiation.LockTimeReached(0, now.Add(InitiatorLockTime)) {
+		t.Errorf("unexpected lock time reached around %d", initiation.LockTime)
+	}
+}
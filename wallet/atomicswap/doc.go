@@ -0,0 +1,36 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package atomicswap implements the steps of an atomic swap with another chain
+using the standard hash time-locked contracts of package contracts.
+
+The contract script is shared by chains deriving from Bitcoin script, so a
+Monetarium contract may be swapped for a contract with the same secret hash on
+another chain:
+
+ 1. The initiator chooses a secret and funds a contract paying the participant
+    with the output returned by ContractOutput for the terms of Initiate,
+    refundable after InitiatorLockTime.
+ 2. The participant audits the initiator's contract, then funds a contract on
+    the other chain paying the initiator with the same secret hash, refundable
+    after ParticipantLockTime, using the terms of Participate when that chain
+    is Monetarium.
+ 3. The initiator audits the participant's contract and redeems it, revealing
+    the secret on chain.
+ 4. The participant extracts the secret from the initiator's redeem
+    transaction and redeems the initiator's contract.
+
+Either party recovers their coins with Refund if the swap is not completed
+before the lock time of their contract.  The participant's lock time is half
+of the initiator's so that the participant always has time to redeem after the
+secret is revealed.
+
+Redeem and refund transactions are built by Redeem and Refund and signed with
+keys held by the caller, so the swap may be completed by tools without access
+to the wallet.  Their fees are estimated with the worst case signature script
+sizes txsizes.RedeemAtomicSwapSigScriptSize and
+txsizes.RefundAtomicSwapSigScriptSize.
+*/
+package atomicswap
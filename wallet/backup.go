@@ -0,0 +1,244 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/monetarium/monetarium-node/crypto/rand"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/kdf"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// Encrypted backups begin with a header of the backup magic, the format
+// version, and the marshaled Argon2id parameters used to derive the encryption
+// key from the backup passphrase.  The header is followed by a nonce and the
+// XChaCha20-Poly1305 sealed copy of the wallet database, authenticated with
+// the header as additional data.
+var backupMagic = [8]byte{'m', 'o', 'n', 'w', 'b', 'a', 'k', 0}
+
+const (
+	backupVersion   = 1
+	backupHeaderLen = len(backupMagic) + 1 + kdf.MarshaledLen
+)
+
+// ExportEncryptedBackup writes a portable snapshot of the wallet database,
+// encrypted with a key derived from passphrase, to path.  The snapshot
+// includes every account, label, consolidation address, and transaction
+// recorded by the wallet, and is copied inside a single database read
+// transaction so it is always consistent.  The backup is written to a
+// temporary file which is renamed to path after it is complete.  Errors with
+// code errors.Exist are returned if a file already exists at path.
+//
+// Backups are restored with RestoreEncryptedBackup.
+func (w *Wallet) ExportEncryptedBackup(ctx context.Context, path string, passphrase []byte) error {
+	const op errors.Op = "wallet.ExportEncryptedBackup"
+	if len(passphrase) == 0 {
+		return errors.E(op, errors.Invalid, "backup passphrase is required")
+	}
+	if _, err := os.Stat(path); err == nil {
+		return errors.E(op, errors.Exist, errors.Errorf("%q already exists", path))
+	}
+
+	var db bytes.Buffer
+	err := w.db.Copy(&db)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	defer zero(db.Bytes())
+
+	kdfp, err := kdf.NewArgon2idParams(rand.Reader())
+	if err != nil {
+		return errors.E(op, err)
+	}
+	params, err := kdfp.MarshalBinary()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	header := make([]byte, 0, backupHeaderLen)
+	header = append(header, backupMagic[:]...)
+	header = append(header, backupVersion)
+	header = append(header, params...)
+
+	key := kdf.DeriveKey(passphrase, kdfp, chacha20poly1305.KeySize)
+	defer zero(key)
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	sealed := make([]byte, backupHeaderLen+aead.NonceSize(),
+		backupHeaderLen+aead.NonceSize()+db.Len()+aead.Overhead())
+	copy(sealed, header)
+	nonce := sealed[backupHeaderLen:]
+	rand.Read(nonce)
+	sealed = aead.Seal(sealed, nonce, db.Bytes(), header)
+
+	err = writeFileAtomic(path, sealed)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	logCtx(ctx).Infof("Exported encrypted wallet backup to %s", path)
+	return nil
+}
+
+// RestoreEncryptedBackup decrypts a backup written by ExportEncryptedBackup
+// and writes the restored wallet database to dbPath, which must not exist.
+// Errors with code errors.Passphrase are returned for incorrect passphrases or
+// corrupted backups.
+func RestoreEncryptedBackup(backupPath, dbPath string, passphrase []byte) error {
+	const op errors.Op = "wallet.RestoreEncryptedBackup"
+	if _, err := os.Stat(dbPath); err == nil {
+		return errors.E(op, errors.Exist, errors.Errorf("%q already exists", dbPath))
+	}
+
+	sealed, err := os.ReadFile(backupPath)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	if len(sealed) < backupHeaderLen ||
+		!bytes.Equal(sealed[:len(backupMagic)], backupMagic[:]) {
+		return errors.E(op, errors.Encoding, "not an encrypted wallet backup")
+	}
+	if v := sealed[len(backupMagic)]; v != backupVersion {
+		return errors.E(op, errors.Encoding, errors.Errorf("unknown backup version %d", v))
+	}
+	header := sealed[:backupHeaderLen]
+	kdfp := new(kdf.Argon2idParams)
+	err = kdfp.UnmarshalBinary(header[len(backupMagic)+1:])
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+
+	key := kdf.DeriveKey(passphrase, kdfp, chacha20poly1305.KeySize)
+	defer zero(key)
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	sealed = sealed[backupHeaderLen:]
+	if len(sealed) < aead.NonceSize()+aead.Overhead() {
+		return errors.E(op, errors.Encoding, "truncated wallet backup")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	db, err := aead.Open(nil, nonce, ciphertext, header)
+	if err != nil {
+		return errors.E(op, errors.Passphrase, "incorrect passphrase or corrupted backup")
+	}
+	defer zero(db)
+
+	err = os.MkdirAll(filepath.Dir(dbPath), 0700)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	err = writeFileAtomic(dbPath, db)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	log.Infof("Restored wallet database %s from backup %s", dbPath, backupPath)
+	return nil
+}
+
+// StreamBackup streams the changes made to the wallet database after the
+// changelog sequence number sinceSeq, allowing external tools to maintain
+// replicas of the wallet database without copying the whole database.  A
+// replica is created from a copy of the database, such as a restored
+// encrypted backup, and is current through the sequence number returned by
+// udb.ChangelogSeq for the copy.  Changes are applied to the replica with
+// udb.ApplyChangelog.
+//
+// The changes recorded after sinceSeq are passed to f, followed by each batch
+// of changes as they are committed, until the context is cancelled or f
+// errors.  The replica is consistent after applying each batch.  Errors with
+// code errors.NotExist are returned if changes after sinceSeq have been
+// pruned from the changelog, and the replica must be recreated.
+func (w *Wallet) StreamBackup(ctx context.Context, sinceSeq uint64,
+	f func(changes []udb.ChangelogEntry) error) error {
+
+	const op errors.Op = "wallet.StreamBackup"
+	for {
+		changed := w.changelog.Changed()
+		var changes []udb.ChangelogEntry
+		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			changes, err = udb.ChangelogEntries(dbtx, sinceSeq)
+			return err
+		})
+		if err != nil {
+			return errors.E(op, err)
+		}
+		if len(changes) != 0 {
+			err = f(changes)
+			if err != nil {
+				return err
+			}
+			sinceSeq = changes[len(changes)-1].Seq
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// CompactBackupChangelog removes changelog entries which are superseded by
+// later changes, and prunes all changes through pruneThrough.  pruneThrough
+// should be the lowest changelog sequence number applied by every replica
+// streamed with StreamBackup, or zero to only remove superseded changes.
+func (w *Wallet) CompactBackupChangelog(ctx context.Context, pruneThrough uint64) error {
+	const op errors.Op = "wallet.CompactBackupChangelog"
+	var removed int
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		removed, err = udb.CompactChangelog(dbtx, pruneThrough)
+		return err
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if removed != 0 {
+		logCtx(ctx).Debugf("Removed %d entries from the backup changelog", removed)
+	}
+	return nil
+}
+
+// writeFileAtomic writes data to a temporary file in the directory of path and
+// renames it to path after it is synced, so an interrupted write never leaves
+// a partial file at path.
+func writeFileAtomic(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	err = os.Rename(f.Name(), path)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
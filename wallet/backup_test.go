@@ -0,0 +1,60 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+func TestEncryptedBackup(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	dir := t.TempDir()
+	backup := filepath.Join(dir, "wallet.bak")
+	passphrase := []byte("backup passphrase")
+
+	err := w.ExportEncryptedBackup(ctx, backup, nil)
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("export without passphrase: expected Invalid, got %v", err)
+	}
+	err = w.ExportEncryptedBackup(ctx, backup, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.ExportEncryptedBackup(ctx, backup, passphrase)
+	if !errors.Is(err, errors.Exist) {
+		t.Fatalf("export over existing file: expected Exist, got %v", err)
+	}
+
+	dbPath := filepath.Join(dir, "restored", "wallet.db")
+	err = RestoreEncryptedBackup(backup, dbPath, []byte("wrong passphrase"))
+	if !errors.Is(err, errors.Passphrase) {
+		t.Fatalf("restore with wrong passphrase: expected Passphrase, got %v", err)
+	}
+	if _, err := os.Stat(dbPath); !os.IsNotExist(err) {
+		t.Fatalf("database written after failed restore: %v", err)
+	}
+	err = RestoreEncryptedBackup(backup, dbPath, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi, err := os.Stat(dbPath); err != nil || fi.Size() == 0 {
+		t.Fatalf("restored database missing or empty: %v", err)
+	}
+	err = RestoreEncryptedBackup(backup, dbPath, passphrase)
+	if !errors.Is(err, errors.Exist) {
+		t.Fatalf("restore over existing database: expected Exist, got %v", err)
+	}
+}
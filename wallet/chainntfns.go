@@ -50,6 +50,7 @@ func (w *Wallet) extendMainChain(ctx context.Context, op errors.Op, dbtx walletd
 
 	// Notify interested clients of the connected block.
 	w.NtfnServer.notifyAttachedBlock(n.Header, blockHash)
+	blocksProcessed.Inc()
 
 	blockMeta, err := w.txStore.GetBlockMetaForHash(txmgrNs, blockHash)
 	if err != nil {
@@ -107,7 +108,7 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 	newWork := chain[len(chain)-1].workSum
 	oldWork := new(big.Int)
 
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 
 	var watchOutPoints []wire.OutPoint
 	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
@@ -119,6 +120,10 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 			return nil
 		}
 
+		// Connected and disconnected blocks change the stake validity
+		// of outputs and remove unmined transactions.
+		w.utxoCache.invalidate()
+
 		if sideChainForkHeight <= tipHeight {
 			chainTipChanges.DetachedBlocks = make([]*chainhash.Hash, tipHeight-sideChainForkHeight+1)
 			prevChain = make([]*BlockNode, tipHeight-sideChainForkHeight+1)
@@ -164,7 +169,7 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 
 		for _, n := range chain {
 			if voteVersion(w.chainParams) < n.Header.StakeVersion {
-				log.Warnf("Old vote version detected (v%v), please update your "+
+				logCtx(ctx).Warnf("Old vote version detected (v%v), please update your "+
 					"wallet to the latest version.", voteVersion(w.chainParams))
 			}
 
@@ -196,7 +201,7 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 				if err := w.txStore.UpdateProcessedTxsBlockMarker(dbtx, &bh); err != nil {
 					return err
 				}
-				log.Infof("Set wallet birthday to block %d (%v).",
+				logCtx(ctx).Infof("Set wallet birthday to block %d (%v).",
 					height, bh)
 			}
 			// NOTE: A birthday block or time set past the main tip
@@ -218,7 +223,7 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 			}
 			if !(rHeader.Height+1 < chain[0].Header.Height) {
 				marker := chain[len(chain)-1].Hash
-				log.Debugf("Updating processed txs block marker to %v", marker)
+				logCtx(ctx).Debugf("Updating processed txs block marker to %v", marker)
 				err := w.txStore.UpdateProcessedTxsBlockMarker(dbtx, marker)
 				if err != nil {
 					return err
@@ -234,14 +239,16 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 		tip := chain[len(chain)-1]
 		hashes, err := w.txStore.PruneUnmined(dbtx, tip.Header.SBits)
 		if err != nil {
-			log.Errorf("Failed to prune unmined transactions when "+
+			logCtx(ctx).Errorf("Failed to prune unmined transactions when "+
 				"connecting block height %v: %v", tip.Header.Height, err)
 		}
 
 		for _, hash := range hashes {
 			w.NtfnServer.notifyRemovedTransaction(*hash)
 		}
-		return nil
+
+		// Expire open invoices which expired before the new tip block.
+		return w.updateInvoices(dbtx, nil, tip.Header.Timestamp)
 	})
 	w.lockedOutpointMu.Unlock()
 	if err != nil {
@@ -257,6 +264,8 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 			}
 		}
 		w.recentlyPublishedMu.Unlock()
+
+		w.recordBlockPrices(ctx, chain, relevantTxs)
 	}
 
 	if n, err := w.NetworkBackend(); err == nil {
@@ -268,7 +277,7 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 		if len(watchOutPoints) > 0 {
 			err = n.LoadTxFilter(ctx, false, nil, watchOutPoints)
 			if err != nil {
-				log.Errorf("Failed to watch outpoints: %v", err)
+				logCtx(ctx).Errorf("Failed to watch outpoints: %v", err)
 			}
 		}
 	}
@@ -305,11 +314,11 @@ func (w *Wallet) AddTransaction(ctx context.Context, tx *wire.MsgTx, blockHash *
 	// Prevent recording unmined tspends since they need to go through
 	// voting for potentially a long time.
 	if isTreasurySpend(tx) && blockHash == nil {
-		log.Debugf("Ignoring unmined TSPend %s", tx.TxHash())
+		logCtx(ctx).Debugf("Ignoring unmined TSPend %s", tx.TxHash())
 		return nil
 	}
 
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 	var watchOutPoints []wire.OutPoint
 	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
 		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
@@ -325,7 +334,7 @@ func (w *Wallet) AddTransaction(ctx context.Context, tx *wire.MsgTx, blockHash *
 			votedBlock, _ := stake.SSGenBlockVotedOn(&rec.MsgTx)
 			tipBlock, _ := w.txStore.MainChainTip(dbtx)
 			if votedBlock != tipBlock {
-				log.Debugf("Rejected unmined orphan vote %v which votes on block %v",
+				logCtx(ctx).Debugf("Rejected unmined orphan vote %v which votes on block %v",
 					&rec.Hash, &votedBlock)
 				return nil
 			}
@@ -351,7 +360,13 @@ func (w *Wallet) AddTransaction(ctx context.Context, tx *wire.MsgTx, blockHash *
 		}
 
 		watchOutPoints, err = w.processTransactionRecord(ctx, dbtx, rec, header, meta)
-		return err
+		if err != nil {
+			return err
+		}
+		if blockHash == nil {
+			return w.traceTx(dbtx, &rec.Hash, udb.TxTraceMempool, -1)
+		}
+		return nil
 	})
 	w.lockedOutpointMu.Unlock()
 	if err != nil {
@@ -365,7 +380,7 @@ func (w *Wallet) AddTransaction(ctx context.Context, tx *wire.MsgTx, blockHash *
 		if len(watchOutPoints) > 0 {
 			err = n.LoadTxFilter(ctx, false, nil, watchOutPoints)
 			if err != nil {
-				log.Errorf("Failed to watch outpoints: %v", err)
+				logCtx(ctx).Errorf("Failed to watch outpoints: %v", err)
 			}
 		}
 	}
@@ -380,23 +395,63 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 	addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
 	txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
 
+	// Recording the transaction may add or spend unspent outputs.
+	w.utxoCache.invalidate()
+
 	// At the moment all notified transactions are assumed to actually be
 	// relevant.  This assumption will not hold true when SPV support is
 	// added, but until then, simply insert the transaction because there
 	// should either be one or more relevant inputs or outputs.
+	var conflicts []chainhash.Hash
 	if header == nil {
 		err = w.txStore.InsertMemPoolTx(dbtx, rec)
 		if errors.Is(err, errors.Exist) {
-			log.Warnf("Refusing to add unmined transaction %v since same "+
+			logCtx(ctx).Warnf("Refusing to add unmined transaction %v since same "+
 				"transaction already exists mined", &rec.Hash)
 			return nil, nil
 		}
+		if errors.Is(err, errors.DoubleSpend) {
+			// Competing unmined spends are not recorded, and the
+			// wallet's transactions remain unmined until either is
+			// mined.
+			conflicts, err = w.txStore.UnminedConflicts(dbtx, &rec.MsgTx)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			logCtx(ctx).Warnf("Unmined transaction %v double spends unmined "+
+				"wallet transactions %v", &rec.Hash, conflicts)
+			w.NtfnServer.notifyTxConflict(&TxConflictNotification{
+				TxHash:    rec.Hash,
+				Conflicts: conflicts,
+			})
+			return nil, nil
+		}
 	} else {
-		err = w.txStore.InsertMinedTx(dbtx, rec, &blockMeta.Hash)
+		// Unmined transactions conflicting with the mined transaction
+		// are removed when it is inserted.
+		conflicts, err = w.txStore.UnminedConflicts(dbtx, &rec.MsgTx)
+		if err == nil {
+			err = w.txStore.InsertMinedTx(dbtx, rec, &blockMeta.Hash)
+		}
+		if err == nil {
+			err = w.traceTx(dbtx, &rec.Hash, udb.TxTraceConfirmed, blockMeta.Height)
+		}
+		for i := 0; err == nil && i < len(conflicts); i++ {
+			err = w.traceTx(dbtx, &conflicts[i], udb.TxTraceConflicted, blockMeta.Height)
+		}
 	}
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+	if header != nil && len(conflicts) != 0 {
+		logCtx(ctx).Infof("Removed unmined transactions %v double spent by mined "+
+			"transaction %v", conflicts, &rec.Hash)
+		w.NtfnServer.notifyTxConflict(&TxConflictNotification{
+			TxHash:    rec.Hash,
+			Conflicts: conflicts,
+			Mined:     true,
+		})
+	}
 
 	// Skip unlocking outpoints if the transaction is a vote or revocation as the lock
 	// is not held.
@@ -434,7 +489,7 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 				if err != nil {
 					return nil, err
 				}
-				log.Debugf("Marked address %v used", addr)
+				logCtx(ctx).Debugf("Marked address %v used", addr)
 			}
 
 			// Add the script to the script databases.
@@ -447,11 +502,7 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 				case err != nil:
 					return nil, errors.E(op, err)
 				case n != nil:
-					addrs := []stdaddr.Address{addr.Address()}
-					err := n.LoadTxFilter(ctx, false, addrs, nil)
-					if err != nil {
-						return nil, errors.E(op, err)
-					}
+					w.queueTxFilterAddrs(n, []stdaddr.Address{addr.Address()})
 				}
 			}
 
@@ -478,6 +529,11 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 	// Check every output to determine whether it is controlled by a
 	// wallet key.  If so, mark the output as a credit and mark
 	// outpoints to watch.
+	height, creditTime := int32(-1), rec.Received
+	if header != nil {
+		height, creditTime = blockMeta.Height, header.Timestamp
+	}
+	var invoiceCredits []invoiceCredit
 	for i, output := range rec.MsgTx.TxOut {
 		class, addrs := stdscript.ExtractAddrs(output.Version, output.PkScript, w.chainParams)
 		if class == stdscript.STNonStandard {
@@ -497,7 +553,7 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 			addr, err := stake.AddrFromSStxPkScrCommitment(output.PkScript,
 				w.chainParams)
 			if err != nil {
-				log.Warnf("failed to decode ticket commitment script of %s:%d",
+				logCtx(ctx).Warnf("failed to decode ticket commitment script of %s:%d",
 					rec.Hash, i)
 				continue
 			}
@@ -507,14 +563,14 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 			// The only case of outputs with 0 value that we need to handle are
 			// ticket commitments and SKA outputs (which use SKAValue instead of Value).
 			// All other outputs can be ignored.
-			log.Debugf("Skipping output %s:%d - Value=0, CoinType=%v, IsSKA=%v, SKAValue=%v",
+			logCtx(ctx).Debugf("Skipping output %s:%d - Value=0, CoinType=%v, IsSKA=%v, SKAValue=%v",
 				rec.Hash, i, output.CoinType, output.CoinType.IsSKA(), output.SKAValue)
 			continue
 		}
 
 		// Debug: Log SKA outputs being processed
 		if output.CoinType.IsSKA() {
-			log.Debugf("Processing SKA output %s:%d - CoinType=%v, SKAValue=%v, addrs=%v",
+			logCtx(ctx).Debugf("Processing SKA output %s:%d - CoinType=%v, SKAValue=%v, addrs=%v",
 				rec.Hash, i, output.CoinType, output.SKAValue, addrs)
 		}
 
@@ -529,7 +585,7 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 			// be propagated.
 			if errors.Is(err, errors.NotExist) {
 				if output.CoinType.IsSKA() {
-					log.Debugf("SKA output %s:%d - address %v NOT found in wallet", rec.Hash, i, addr)
+					logCtx(ctx).Debugf("SKA output %s:%d - address %v NOT found in wallet", rec.Hash, i, addr)
 				}
 				continue
 			}
@@ -539,7 +595,7 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 
 			// Debug: Log when we're about to add a credit for SKA
 			if output.CoinType.IsSKA() {
-				log.Debugf("Adding SKA credit: tx=%s, output=%d, cointype=%v, SKAValue=%v, account=%v",
+				logCtx(ctx).Debugf("Adding SKA credit: tx=%s, output=%d, cointype=%v, SKAValue=%v, account=%v",
 					rec.Hash, i, output.CoinType, output.SKAValue, ma.Account())
 			}
 
@@ -549,6 +605,8 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 			} else {
 				err = w.txStore.AddCredit(dbtx, rec, blockMeta,
 					uint32(i), ma.Internal(), ma.Account())
+				invoiceCredits = append(invoiceCredits, newInvoiceCredit(rec,
+					uint32(i), tree, addr.String(), height))
 			}
 			if err != nil {
 				return nil, errors.E(op, err)
@@ -561,7 +619,7 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 				outpoint.Index = uint32(i)
 				watchOutPoints = append(watchOutPoints, outpoint)
 			}
-			log.Debugf("Marked address %v used", addr)
+			logCtx(ctx).Debugf("Marked address %v used", addr)
 		}
 
 		// Handle P2SH addresses that are multisignature scripts
@@ -571,7 +629,7 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 			for _, addr := range addrs {
 				expandedScript, err = w.manager.RedeemScript(addrmgrNs, addr)
 				if err != nil {
-					log.Debugf("failed to find redeemscript for "+
+					logCtx(ctx).Debugf("failed to find redeemscript for "+
 						"address %v in address manager: %v",
 						addr, err)
 					continue
@@ -596,17 +654,43 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 						// This will throw if there are multiple private keys
 						// for this multisignature output owned by the wallet,
 						// so it's routed to debug.
-						log.Debugf("unable to add multisignature output: %v", err)
+						logCtx(ctx).Debugf("unable to add multisignature output: %v", err)
 					}
 				}
 			}
 		}
 	}
 
+	// Match credits paying wallet addresses with open invoices.
+	if len(invoiceCredits) != 0 {
+		err = w.updateInvoices(dbtx, invoiceCredits, creditTime)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
 	if (rec.TxType == stake.TxTypeSSGen) || (rec.TxType == stake.TxTypeSSRtx) {
 		err = w.txStore.RedeemTicketCommitments(txmgrNs, rec, blockMeta)
 		if err != nil {
-			log.Errorf("Error redeeming ticket commitments: %v", err)
+			logCtx(ctx).Errorf("Error redeeming ticket commitments: %v", err)
+		}
+	}
+
+	// Record ticket outcomes and earned SSFee rewards for stake statistics,
+	// the fees paid by the wallet for fee statistics, and observed SKA
+	// emissions for supply tracking.
+	if header != nil {
+		err = w.recordStakeStat(dbtx, rec, blockMeta)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		err = w.recordFeeStat(dbtx, rec, blockMeta)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		err = w.recordSKAEmission(dbtx, rec, blockMeta)
+		if err != nil {
+			return nil, errors.E(op, err)
 		}
 	}
 
@@ -617,14 +701,14 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 	if header == nil {
 		details, err := w.txStore.UniqueTxDetails(txmgrNs, &rec.Hash, nil)
 		if err != nil {
-			log.Errorf("Cannot query transaction details for notifiation: %v", err)
+			logCtx(ctx).Errorf("Cannot query transaction details for notifiation: %v", err)
 		} else {
 			w.NtfnServer.notifyUnminedTransaction(dbtx, details)
 		}
 	} else {
 		details, err := w.txStore.UniqueTxDetails(txmgrNs, &rec.Hash, &blockMeta.Block)
 		if err != nil {
-			log.Errorf("Cannot query transaction details for notifiation: %v", err)
+			logCtx(ctx).Errorf("Cannot query transaction details for notifiation: %v", err)
 		} else {
 			w.NtfnServer.notifyMinedTransaction(dbtx, details, blockMeta)
 		}
@@ -707,7 +791,7 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 		for i, ticketHash := range ticketHashes {
 			ticketPurchase, err := w.txStore.Tx(txmgrNs, ticketHash)
 			if err != nil {
-				log.Errorf("Failed to read ticket purchase transaction for "+
+				logCtx(ctx).Errorf("Failed to read ticket purchase transaction for "+
 					"owned winning ticket %v: %v", ticketHash, err)
 				continue
 			}
@@ -735,7 +819,7 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 				ticketAddr, err = stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(ticketHash160s[0], w.chainParams)
 			}
 			if err != nil {
-				log.Errorf("Failed to decode ticket commitment address for "+
+				logCtx(ctx).Errorf("Failed to decode ticket commitment address for "+
 					"%v: %v", ticketHash, err)
 				continue
 			}
@@ -743,12 +827,12 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 			// Look up account for this address
 			accountNumber, err := w.manager.AddrAccount(addrmgrNs, ticketAddr)
 			if err != nil {
-				log.Errorf("Failed to find account for ticket %v: %v", ticketHash, err)
+				logCtx(ctx).Errorf("Failed to find account for ticket %v: %v", ticketHash, err)
 				continue
 			}
 			accountName, err := w.manager.AccountName(addrmgrNs, accountNumber)
 			if err != nil {
-				log.Errorf("Failed to get account name for ticket %v: %v", ticketHash, err)
+				logCtx(ctx).Errorf("Failed to get account name for ticket %v: %v", ticketHash, err)
 				continue
 			}
 
@@ -757,7 +841,7 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 			var consolidationHash160 []byte
 			customHash160, err := udb.GetAccountConsolidationAddr(dbtx, accountName)
 			if err != nil {
-				log.Errorf("Failed to get consolidation address for account %s: %v",
+				logCtx(ctx).Errorf("Failed to get consolidation address for account %s: %v",
 					accountName, err)
 				continue
 			}
@@ -767,7 +851,7 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 				// Use auto-default: first external address (index 0)
 				consolidationHash160, err = w.getFirstExternalAddressHash160(dbtx, accountName)
 				if err != nil {
-					log.Errorf("Failed to get default consolidation address for account %s: %v",
+					logCtx(ctx).Errorf("Failed to get default consolidation address for account %s: %v",
 						accountName, err)
 					continue
 				}
@@ -784,9 +868,9 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 			dp := w.DisapprovePercent()
 			if dp > 0 {
 				if w.chainParams.Net == wire.MainNet {
-					log.Warnf("block disapprove percent set on mainnet")
+					logCtx(ctx).Warnf("block disapprove percent set on mainnet")
 				} else if int64(dp) > rand.Int64N(100) {
-					log.Infof("Disapproving block %v voted with ticket %v",
+					logCtx(ctx).Infof("Disapproving block %v voted with ticket %v",
 						blockHash, ticketHash)
 					// Set the BlockValid bit to zero,
 					// disapproving the block.
@@ -808,7 +892,7 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 				w.chainParams, dcp0010Active, dcp0012Active,
 				consolidationHash160) // Pass consolidation address for SSFee batching
 			if err != nil {
-				log.Errorf("Failed to create vote transaction for ticket "+
+				logCtx(ctx).Errorf("Failed to create vote transaction for ticket "+
 					"hash %v: %v", ticketHash, err)
 				continue
 			}
@@ -845,7 +929,7 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 				tspendVoteScript, err := b.Script()
 				if err != nil {
 					// Log error and continue.
-					log.Errorf("Failed to create treasury "+
+					logCtx(ctx).Errorf("Failed to create treasury "+
 						"vote for ticket hash %v: %v",
 						ticketHash, err)
 				} else {
@@ -858,7 +942,7 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 			// Sign vote and sumit.
 			err = w.signVote(addrmgrNs, ticketPurchase, vote)
 			if err != nil {
-				log.Errorf("Failed to sign vote for ticket hash %v: %v",
+				logCtx(ctx).Errorf("Failed to sign vote for ticket hash %v: %v",
 					ticketHash, err)
 				continue
 			}
@@ -870,7 +954,7 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 		return nil
 	})
 	if err != nil {
-		log.Errorf("View failed: %v", errors.E(op, err))
+		logCtx(ctx).Errorf("View failed: %v", errors.E(op, err))
 	}
 
 	// Remove nil votes without preserving order.
@@ -887,7 +971,7 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 	for i := range votes {
 		rec, err := udb.NewTxRecordFromMsgTx(votes[i], time.Now())
 		if err != nil {
-			log.Errorf("Failed to create transaction record: %v", err)
+			logCtx(ctx).Errorf("Failed to create transaction record: %v", err)
 			continue
 		}
 		voteRecords = append(voteRecords, rec)
@@ -896,7 +980,7 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 	for i := range voteRecords {
 		w.recentlyPublished[voteRecords[i].Hash] = struct{}{}
 
-		log.Infof("Voting on block %v (height %v) using ticket %v "+
+		logCtx(ctx).Infof("Voting on block %v (height %v) using ticket %v "+
 			"(vote hash: %v bits: %v)", blockHash, blockHeight,
 			ticketHashes[i], &voteRecords[i].Hash, usedVoteBits[i].Bits)
 	}
@@ -905,13 +989,13 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 	// Publish before recording votes in database to slightly reduce latency.
 	err = n.PublishTransactions(ctx, votes...)
 	if err != nil {
-		log.Errorf("Failed to send one or more votes: %v", err)
+		logCtx(ctx).Errorf("Failed to send one or more votes: %v", err)
 	}
 
 	if len(watchOutPoints) > 0 {
 		err := n.LoadTxFilter(ctx, false, nil, watchOutPoints)
 		if err != nil {
-			log.Errorf("Failed to watch outpoints: %v", err)
+			logCtx(ctx).Errorf("Failed to watch outpoints: %v", err)
 		}
 	}
 
@@ -925,6 +1009,11 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 		}
 		return nil
 	})
+	// Unspent outputs may have been cached by concurrent readers before
+	// the votes were committed.
+	w.lockOutpoints(ctx)
+	w.utxoCache.invalidate()
+	w.lockedOutpointMu.Unlock()
 	if err != nil {
 		return err
 	}
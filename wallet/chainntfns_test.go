@@ -0,0 +1,197 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/gcs/blockcf2"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// commitCountingDB counts the read-write transactions committed to the
+// wrapped database.
+type commitCountingDB struct {
+	walletdb.DB
+	commits atomic.Int32
+}
+
+type commitCountingTx struct {
+	walletdb.ReadWriteTx
+	db *commitCountingDB
+}
+
+func (db *commitCountingDB) BeginReadWriteTx() (walletdb.ReadWriteTx, error) {
+	tx, err := db.DB.BeginReadWriteTx()
+	if err != nil {
+		return nil, err
+	}
+	return commitCountingTx{tx, db}, nil
+}
+
+func (tx commitCountingTx) Commit() error {
+	tx.db.commits.Add(1)
+	return tx.ReadWriteTx.Commit()
+}
+
+type fixedPriceSource struct{}
+
+func (fixedPriceSource) Price(ctx context.Context, coinType cointype.CoinType,
+	currency string, t time.Time) (*big.Rat, error) {
+	return big.NewRat(3, 2), nil
+}
+
+func TestChainSwitchBatchedCommit(t *testing.T) {
+	ctx := context.Background()
+
+	f, err := os.CreateTemp(t.TempDir(), "dcrwallet.testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	bdb, err := walletdb.Create("bdb", f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bdb.Close()
+	db := &commitCountingDB{DB: bdb}
+	cfg := basicWalletConfig
+	err = Create(ctx, opaqueDB{db}, []byte(InsecurePubPassphrase), testPrivPass,
+		nil, cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.DB = opaqueDB{db}
+	w, err := Open(ctx, &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.SetPriceSource(fixedPriceSource{}, "usd")
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vers, script := addr.PaymentScript()
+
+	// Build a chain of blocks on the genesis block, where the second and
+	// fourth blocks mine transactions paying the wallet.
+	const blocks = 5
+	mined := make(map[chainhash.Hash]int32)
+	relevantTxs := make(map[chainhash.Hash][]*wire.MsgTx)
+	chain := make([]*BlockNode, 0, blocks)
+	prevHash, _ := w.MainChainTip(ctx)
+	for i := int32(1); i <= blocks; i++ {
+		h := &wire.BlockHeader{
+			PrevBlock: prevHash,
+			VoteBits:  dcrutil.BlockValid,
+			Height:    uint32(i),
+			Timestamp: time.Unix(1700000000+int64(i)*300, 0),
+		}
+		block := &wire.MsgBlock{Header: *h}
+		hash := h.BlockHash()
+		if i == 2 || i == 4 {
+			tx := wire.NewMsgTx()
+			tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{byte(i)}},
+				1e8, nil))
+			out := wire.NewTxOut(1e8, script)
+			out.Version = vers
+			tx.AddTxOut(out)
+			block.AddTransaction(tx)
+			relevantTxs[hash] = []*wire.MsgTx{tx}
+			mined[tx.TxHash()] = i
+		}
+		filter, err := blockcf2.Regular(block, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		chain = append(chain, NewBlockNode(h, &hash, filter))
+		prevHash = hash
+	}
+
+	// All blocks are connected with a single database update.
+	commits := db.commits.Load()
+	var forest SidechainForest
+	_, err = w.ChainSwitch(ctx, &forest, chain, relevantTxs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := db.commits.Load() - commits; n != 1 {
+		t.Errorf("connecting %d blocks committed %d database updates, want 1",
+			blocks, n)
+	}
+
+	// The prices at the times of both blocks mining wallet transactions are
+	// recorded in the background with another single update.
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		_, err := w.PriceAt(ctx, cointype.CoinTypeVAR, chain[3].Header.Timestamp)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, errors.NotExist) {
+			t.Fatal(err)
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("prices of connected blocks were not recorded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := db.commits.Load() - commits; n != 2 {
+		t.Errorf("connecting blocks and recording prices committed %d "+
+			"database updates, want 2", n)
+	}
+	for _, i := range []int{1, 3} {
+		p, err := w.PriceAt(ctx, cointype.CoinTypeVAR, chain[i].Header.Timestamp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !p.Time.Equal(chain[i].Header.Timestamp) || p.Price.Cmp(big.NewRat(3, 2)) != 0 {
+			t.Errorf("block %d price %v at %v, want 3/2 at %v", i+1,
+				p.Price, p.Time, chain[i].Header.Timestamp)
+		}
+	}
+
+	// The store holds the new tip and the transactions mined in their blocks.
+	tipHash, tipHeight := w.MainChainTip(ctx)
+	if tipHash != *chain[blocks-1].Hash || tipHeight != blocks {
+		t.Errorf("main chain tip %v at height %d, want %v at height %d",
+			&tipHash, tipHeight, chain[blocks-1].Hash, blocks)
+	}
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for txHash, height := range mined {
+			details, err := w.txStore.TxDetails(ns, &txHash)
+			if err != nil {
+				return err
+			}
+			if details.Block.Height != height {
+				t.Errorf("transaction %v mined at height %d, want %d",
+					&txHash, details.Block.Height, height)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bal, err := w.AccountBalance(ctx, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bal.Spendable != 2e8 {
+		t.Errorf("spendable balance %v, want 2 coins", bal.Spendable)
+	}
+}
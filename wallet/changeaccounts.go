@@ -0,0 +1,86 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// SetChangeAccount redirects all change of a coin type from transactions
+// spending the outputs of account to changeAccount, so that funds of the two
+// accounts, such as mixed and unmixed funds, never share an account.  Setting
+// changeAccount to account removes the redirection.
+func (w *Wallet) SetChangeAccount(ctx context.Context, account uint32,
+	ct cointype.CoinType, changeAccount uint32) error {
+
+	const op errors.Op = "wallet.SetChangeAccount"
+
+	if changeAccount == udb.ImportedAddrAccount {
+		return errors.E(op, errors.Invalid,
+			"change can not be redirected to the imported account")
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := w.manager.AccountName(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+		if changeAccount == account {
+			return udb.DeleteChangeAccount(dbtx, account, ct)
+		}
+		_, err = w.manager.AccountName(addrmgrNs, changeAccount)
+		if err != nil {
+			return err
+		}
+		return udb.PutChangeAccount(dbtx, &udb.ChangeAccount{
+			Account:       account,
+			CoinType:      ct,
+			ChangeAccount: changeAccount,
+		})
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// ChangeAccounts returns every change redirection set with SetChangeAccount,
+// in increasing source account and coin type order.
+func (w *Wallet) ChangeAccounts(ctx context.Context) ([]udb.ChangeAccount, error) {
+	const op errors.Op = "wallet.ChangeAccounts"
+
+	var redirects []udb.ChangeAccount
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachChangeAccount(dbtx, func(c *udb.ChangeAccount) error {
+			redirects = append(redirects, *c)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return redirects, nil
+}
+
+// changeAccountFor returns the account which change of a coin type from
+// transactions spending outputs of account must be returned to.  This is
+// changeAccount unless the change of account is redirected.
+func (w *Wallet) changeAccountFor(dbtx walletdb.ReadTx, account uint32,
+	ct cointype.CoinType, changeAccount uint32) (uint32, error) {
+
+	redirect, err := udb.ChangeAccountFor(dbtx, account, ct)
+	switch {
+	case errors.Is(err, errors.NotExist):
+		return changeAccount, nil
+	case err != nil:
+		return 0, err
+	}
+	return redirect, nil
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/txsizes"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// SetChangeScriptType sets the output script of change returned to account.
+// Change is paid to the next internal branch key of the account using either
+// a P2PKH (the default), Schnorr P2PKH, or P2SH script, where P2SH change pays
+// a P2PK redeem script of the key.  The change of multisig and vault accounts
+// always pays their P2SH scripts and can not be changed.
+func (w *Wallet) SetChangeScriptType(ctx context.Context, account uint32, t udb.ChangeScriptType) error {
+	const op errors.Op = "wallet.SetChangeScriptType"
+
+	if account == udb.ImportedAddrAccount {
+		return errors.E(op, errors.Invalid,
+			"change of the imported account is returned to the default account")
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := w.manager.AccountName(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+		_, _, err = w.manager.AccountMultisig(addrmgrNs, account)
+		switch {
+		case err == nil:
+			return errors.E(errors.Invalid,
+				"multisig accounts always pay P2SH multisig change")
+		case !errors.Is(err, errors.NotExist):
+			return err
+		}
+		_, _, err = w.manager.AccountVault(addrmgrNs, account)
+		switch {
+		case err == nil:
+			return errors.E(errors.Invalid,
+				"vault accounts always pay P2SH vault change")
+		case !errors.Is(err, errors.NotExist):
+			return err
+		}
+		return udb.PutChangeScriptType(dbtx, account, t)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// ChangeScriptTypes returns the change script type of every account which
+// does not use P2PKH change.
+func (w *Wallet) ChangeScriptTypes(ctx context.Context) (map[uint32]udb.ChangeScriptType, error) {
+	const op errors.Op = "wallet.ChangeScriptTypes"
+
+	types := make(map[uint32]udb.ChangeScriptType)
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachChangeScriptType(dbtx, func(account uint32, t udb.ChangeScriptType) error {
+			types[account] = t
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return types, nil
+}
+
+// changeScriptType returns the script type of change returned to account.
+func (w *Wallet) changeScriptType(dbtx walletdb.ReadTx, account uint32) (udb.ChangeScriptType, error) {
+	// Change of the imported account is returned to the default account.
+	if account == udb.ImportedAddrAccount {
+		account = udb.DefaultAccountNum
+	}
+	return udb.ChangeScriptTypeFor(dbtx, account)
+}
+
+// changeScriptSize returns the size of change output scripts of a type.
+func changeScriptSize(t udb.ChangeScriptType) int {
+	switch t {
+	case udb.ChangeScriptSchnorrP2PKH:
+		return txsizes.P2PKHSchnorrPkScriptSize
+	case udb.ChangeScriptP2SH:
+		return txsizes.P2SHPkScriptSize
+	default:
+		return txsizes.P2PKHPkScriptSize
+	}
+}
+
+// changeRedeemScript returns the P2PK redeem script of P2SH change paying
+// pubKey.
+func changeRedeemScript(pubKey []byte, params *chaincfg.Params) ([]byte, error) {
+	addr, err := stdaddr.NewAddressPubKeyEcdsaSecp256k1V0Raw(pubKey, params)
+	if err != nil {
+		return nil, err
+	}
+	_, script := addr.PaymentScript()
+	return script, nil
+}
+
+// changeScriptAddress returns the address of a change script type paying the
+// key of a P2PKH change address.
+func (w *Wallet) changeScriptAddress(addr stdaddr.Address, t udb.ChangeScriptType) (stdaddr.Address, error) {
+	var changeAddr stdaddr.Address
+	switch t {
+	case udb.ChangeScriptP2PKH:
+		return addr, nil
+
+	case udb.ChangeScriptSchnorrP2PKH:
+		pkh, ok := addr.(stdaddr.Hash160er)
+		if !ok {
+			return nil, errors.E(errors.Bug, errors.Errorf("change address %v "+
+				"has no public key hash", addr))
+		}
+		var err error
+		changeAddr, err = stdaddr.NewAddressPubKeyHashSchnorrSecp256k1V0(
+			pkh.Hash160()[:], w.chainParams)
+		if err != nil {
+			return nil, err
+		}
+
+	case udb.ChangeScriptP2SH:
+		a, ok := addr.(BIP0044Address)
+		if !ok {
+			return nil, errors.E(errors.Bug, errors.Errorf("change address %v "+
+				"has no public key", addr))
+		}
+		script, err := changeRedeemScript(a.PubKey(), w.chainParams)
+		if err != nil {
+			return nil, err
+		}
+		changeAddr, err = stdaddr.NewAddressScriptHashV0(script, w.chainParams)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, errors.E(errors.Bug, errors.Errorf("unknown change "+
+			"script type %v", t))
+	}
+
+	// Outputs paying the change address are only matched by the network
+	// backend once the address is watched.
+	if n, err := w.NetworkBackend(); err == nil {
+		w.queueTxFilterAddrs(n, []stdaddr.Address{changeAddr})
+	}
+	return changeAddr, nil
+}
+
+// importChangeScript records the redeem script of P2SH change returned to an
+// account at an internal branch child index, so outputs paying the change are
+// credited to the account and can be spent.
+func (w *Wallet) importChangeScript(dbtx walletdb.ReadWriteTx, account, branch, child uint32) error {
+	if branch != udb.InternalBranch {
+		return nil
+	}
+	t, err := udb.ChangeScriptTypeFor(dbtx, account)
+	if err != nil || t != udb.ChangeScriptP2SH {
+		return err
+	}
+	xpub, err := w.manager.AccountExtendedPubKey(dbtx, account)
+	if err != nil {
+		return err
+	}
+	branchKey, err := xpub.Child(branch)
+	if err != nil {
+		return err
+	}
+	childKey, err := branchKey.Child(child)
+	if err != nil {
+		return err
+	}
+	script, err := changeRedeemScript(childKey.SerializedPubKey(), w.chainParams)
+	if err != nil {
+		return err
+	}
+	ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+	a, err := w.manager.ImportScript(ns, script)
+	if errors.Is(err, errors.Exist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return w.attachImportedAddress(ns, a.Address(), account)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestChangeScriptTypes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	err := w.SetChangeScriptType(ctx, udb.ImportedAddrAccount, udb.ChangeScriptP2SH)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("imported account: expected Invalid error, got %v", err)
+	}
+
+	tests := []struct {
+		scriptType udb.ChangeScriptType
+		class      stdscript.ScriptType
+	}{
+		{udb.ChangeScriptP2PKH, stdscript.STPubKeyHashEcdsaSecp256k1},
+		{udb.ChangeScriptSchnorrP2PKH, stdscript.STPubKeyHashSchnorrSecp256k1},
+		{udb.ChangeScriptP2SH, stdscript.STScriptHash},
+	}
+	for _, test := range tests {
+		err := w.SetChangeScriptType(ctx, 0, test.scriptType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var src *p2PKHChangeSource
+		err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			scriptType, err := w.changeScriptType(dbtx, 0)
+			src = &p2PKHChangeSource{
+				persist:    w.persistReturnedChild(ctx, nil),
+				account:    0,
+				wallet:     w,
+				ctx:        ctx,
+				scriptType: scriptType,
+			}
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		script, vers, err := src.Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(script) != src.ScriptSize() {
+			t.Errorf("%v: script size %d does not match ScriptSize %d",
+				test.scriptType, len(script), src.ScriptSize())
+		}
+		if class := stdscript.DetermineScriptType(vers, script); class != test.class {
+			t.Errorf("%v: got script type %v, want %v", test.scriptType,
+				class, test.class)
+		}
+
+		// Change must be credited to the account.
+		_, addrs := stdscript.ExtractAddrs(vers, script, w.chainParams)
+		if len(addrs) != 1 {
+			t.Fatalf("%v: change script has %d addresses", test.scriptType, len(addrs))
+		}
+		var account uint32
+		err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+			var err error
+			account, err = w.manager.AddrAccount(addrmgrNs, addrs[0])
+			return err
+		})
+		if err != nil {
+			t.Fatalf("%v: change address %v is not known: %v",
+				test.scriptType, addrs[0], err)
+		}
+		if account != 0 {
+			t.Errorf("%v: change address %v credits account %d",
+				test.scriptType, addrs[0], account)
+		}
+	}
+
+	types, err := w.ChangeScriptTypes(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(types) != 1 || types[0] != udb.ChangeScriptP2SH {
+		t.Errorf("unexpected change script types %v", types)
+	}
+}
@@ -45,6 +45,7 @@ func BadCheckpoint(network wire.CurrencyNet, hash *chainhash.Hash, height int32)
 
 func (w *Wallet) rollbackInvalidCheckpoints(dbtx walletdb.ReadWriteTx) error {
 	txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+	w.utxoCache.invalidate()
 	var checkpoints []int32
 	if w.chainParams.Net == wire.TestNet3 {
 		checkpoints = []int32{962928}
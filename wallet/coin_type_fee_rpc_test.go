@@ -288,3 +288,38 @@ func TestCoinTypeFeeIntegrationScenarios(t *testing.T) {
 			ska1Fee, ska2Fee, ska255Fee)
 	})
 }
+
+func TestFeeRateForPreference(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+
+	tests := []struct {
+		pref string
+		rate dcrutil.Amount
+	}{
+		// The slow estimate of the mock network is below its minimum
+		// relay fee.
+		{FeePreferenceSlow, 10000},
+		{FeePreferenceNormal, 10000},
+		{FeePreferenceFast, 20000},
+	}
+	for _, test := range tests {
+		rate, err := w.FeeRateForPreference(ctx, cointype.CoinType(1), test.pref)
+		if err != nil {
+			t.Fatalf("%s: %v", test.pref, err)
+		}
+		if rate != test.rate {
+			t.Errorf("%s: got fee rate %v, want %v", test.pref, rate, test.rate)
+		}
+	}
+
+	_, err := w.FeeRateForPreference(ctx, cointype.CoinTypeVAR, "urgent")
+	if err == nil {
+		t.Error("unknown fee preference was accepted")
+	}
+}
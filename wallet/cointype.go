@@ -50,10 +50,15 @@ func (w *Wallet) UpgradeToSLIP0044CoinType(ctx context.Context) error {
 	}
 
 	w.addressBuffersMu.Lock()
+	gapLimit := w.gapLimit
+	if ad, ok := w.addressBuffers[0]; ok {
+		gapLimit = ad.gapLimit
+	}
 	w.addressBuffers[0] = &bip0044AccountData{
 		xpub:        acctXpub,
 		albExternal: addressBuffer{branchXpub: extBranchXpub, lastUsed: ^uint32(0)},
 		albInternal: addressBuffer{branchXpub: intBranchXpub, lastUsed: ^uint32(0)},
+		gapLimit:    gapLimit,
 	}
 	w.addressBuffersMu.Unlock()
 
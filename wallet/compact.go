@@ -0,0 +1,163 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// Stages of a database compaction described by CompactStatus.
+const (
+	CompactStagePruning    = "pruning"
+	CompactStageCompacting = "compacting"
+	CompactStageComplete   = "complete"
+)
+
+// CompactStatus describes the progress of a database compaction.  Percent is
+// the progress of the current stage.
+type CompactStatus struct {
+	Active             bool
+	Stage              string
+	Percent            float64
+	PruneDepth         int32
+	PrunedTransactions int
+}
+
+// CompactStatus returns the progress of the active database compaction, or
+// the outcome of the last compaction performed by this process.  Nil is
+// returned if the database has not been compacted.
+func (w *Wallet) CompactStatus() *CompactStatus {
+	w.compactStatusMu.Lock()
+	defer w.compactStatusMu.Unlock()
+
+	if w.compactStatus == nil {
+		return nil
+	}
+	status := *w.compactStatus
+	return &status
+}
+
+// updateCompactStatus modifies the status of the active compaction.
+func (w *Wallet) updateCompactStatus(f func(status *CompactStatus)) {
+	w.compactStatusMu.Lock()
+	f(w.compactStatus)
+	w.compactStatusMu.Unlock()
+}
+
+// CompactDB rewrites the wallet database to reclaim the space of deleted
+// records.  If pruneDepth is non-zero, fully spent regular transactions
+// buried, along with their spenders, by at least pruneDepth blocks are first
+// pruned from the transaction store, keeping only their aggregate history.
+// Their labels and traces are removed with them.  Writes to the database
+// block until the compaction completes.  An error with code Invalid is
+// returned if a compaction is already active.
+func (w *Wallet) CompactDB(ctx context.Context, pruneDepth int32) (*CompactStatus, error) {
+	const op errors.Op = "wallet.CompactDB"
+
+	compacter, ok := w.db.(walletdb.Compacter)
+	if !ok {
+		return nil, errors.E(op, errors.Invalid, "database does not support compaction")
+	}
+	if pruneDepth != 0 && pruneDepth < udb.MinPruneDepth {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("prune "+
+			"depth must be at least %d blocks", udb.MinPruneDepth))
+	}
+
+	w.compactStatusMu.Lock()
+	if w.compactStatus != nil && w.compactStatus.Active {
+		w.compactStatusMu.Unlock()
+		return nil, errors.E(op, errors.Invalid, "database compaction is already active")
+	}
+	w.compactStatus = &CompactStatus{
+		Active:     true,
+		Stage:      CompactStageCompacting,
+		PruneDepth: pruneDepth,
+	}
+	w.compactStatusMu.Unlock()
+	defer w.updateCompactStatus(func(status *CompactStatus) {
+		status.Active = false
+	})
+
+	if pruneDepth != 0 {
+		w.updateCompactStatus(func(status *CompactStatus) {
+			status.Stage = CompactStagePruning
+		})
+		var pruned int
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			var err error
+			pruned, err = w.txStore.PruneTransactions(dbtx, pruneDepth,
+				func(pruned, total int) {
+					w.updateCompactStatus(func(status *CompactStatus) {
+						status.Percent = percent(pruned, total)
+					})
+				})
+			return err
+		})
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		logCtx(ctx).Infof("Pruned %d transactions buried by at least %d blocks",
+			pruned, pruneDepth)
+		w.updateCompactStatus(func(status *CompactStatus) {
+			status.Stage = CompactStageCompacting
+			status.Percent = 0
+			status.PrunedTransactions = pruned
+		})
+	}
+
+	logCtx(ctx).Infof("Compacting the wallet database")
+	var lastLogged int
+	err := compacter.Compact(func(copied, total int) {
+		p := percent(copied, total)
+		w.updateCompactStatus(func(status *CompactStatus) {
+			status.Percent = p
+		})
+		if int(p)/10 > lastLogged {
+			lastLogged = int(p) / 10
+			logCtx(ctx).Infof("Compacted %d%% of the wallet database", lastLogged*10)
+		}
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	logCtx(ctx).Infof("Compacted the wallet database")
+
+	w.updateCompactStatus(func(status *CompactStatus) {
+		status.Active = false
+		status.Stage = CompactStageComplete
+		status.Percent = 100
+	})
+	return w.CompactStatus(), nil
+}
+
+// percent returns n as a percentage of total.
+func percent(n, total int) float64 {
+	if total == 0 {
+		return 100
+	}
+	return float64(n) * 100 / float64(total)
+}
+
+// PrunedHistory returns the aggregate history of the transactions pruned from
+// the wallet, by coin type.
+func (w *Wallet) PrunedHistory(ctx context.Context) ([]*udb.PrunedHistory, error) {
+	const op errors.Op = "wallet.PrunedHistory"
+
+	var history []*udb.PrunedHistory
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachPrunedHistory(dbtx, func(h *udb.PrunedHistory) error {
+			history = append(history, h)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return history, nil
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/txrules"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// SetTicketCompounding opts an account in to, or out of, compounding its
+// SSFee VAR rewards into additional tickets.  Only rewards which mature after
+// the account opts in are accrued.  Opting in an account which is already
+// compounding keeps its accrued rewards, while opting out discards them.
+func (w *Wallet) SetTicketCompounding(ctx context.Context, account uint32, enable bool) error {
+	const op errors.Op = "wallet.SetTicketCompounding"
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := w.manager.AccountName(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+		if !enable {
+			return udb.DeleteTicketCompounding(dbtx, account)
+		}
+
+		_, err = udb.TicketCompoundingForAccount(dbtx, account)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, errors.NotExist) {
+			return err
+		}
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+		return udb.PutTicketCompounding(dbtx, &udb.TicketCompounding{
+			Account: account,
+			Height:  tipHeight,
+		})
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// TicketCompounding returns the ticket reward compounding state of every
+// account which has opted in to compounding, in increasing account order.
+func (w *Wallet) TicketCompounding(ctx context.Context) ([]udb.TicketCompounding, error) {
+	const op errors.Op = "wallet.TicketCompounding"
+
+	var states []udb.TicketCompounding
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachTicketCompounding(dbtx, func(c *udb.TicketCompounding) error {
+			states = append(states, *c)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return states, nil
+}
+
+// AccrueTicketCompounding adds the SSFee VAR rewards which matured since the
+// last accrual to the accrued rewards of every compounding account, and
+// returns the updated compounding states.  A notification is sent for each
+// account which accrued rewards.
+//
+// A reward is attributed to an account when the fee distribution credits an
+// output of the account.  The value of any outputs of the account spent by an
+// augmented fee distribution to consolidate earlier rewards is subtracted,
+// since those rewards have already been accrued.
+func (w *Wallet) AccrueTicketCompounding(ctx context.Context) ([]udb.TicketCompounding, error) {
+	const op errors.Op = "wallet.AccrueTicketCompounding"
+
+	var states []udb.TicketCompounding
+	var ntfns []*TicketCompoundingNotification
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+
+		var opted []*udb.TicketCompounding
+		err := udb.ForEachTicketCompounding(dbtx, func(c *udb.TicketCompounding) error {
+			opted = append(opted, c)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, c := range opted {
+			// Nothing matures until the tip passes the last accrual,
+			// including after a reorg to a shorter chain.
+			if c.Height < tipHeight {
+				matured, err := w.maturedFeeRewards(ctx, dbtx, c.Account,
+					c.Height, tipHeight)
+				if err != nil {
+					return err
+				}
+				c.Accrued += matured
+				c.Height = tipHeight
+				err = udb.PutTicketCompounding(dbtx, c)
+				if err != nil {
+					return err
+				}
+				if matured != 0 {
+					ntfns = append(ntfns, &TicketCompoundingNotification{
+						Account: c.Account,
+						Height:  tipHeight,
+						Matured: matured,
+						Accrued: c.Accrued,
+					})
+				}
+			}
+			states = append(states, *c)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	for _, n := range ntfns {
+		w.NtfnServer.notifyTicketCompounding(n)
+	}
+	return states, nil
+}
+
+// maturedFeeRewards returns the value of the SSFee VAR rewards of an account
+// which matured after the main chain height from, through height to.
+func (w *Wallet) maturedFeeRewards(ctx context.Context, dbtx walletdb.ReadTx,
+	account uint32, from, to int32) (dcrutil.Amount, error) {
+
+	// Fee distributions mature with the same maturity as coinbases,
+	// becoming spendable when the chain height reaches their mined height
+	// plus the coinbase maturity.
+	maturity := int32(w.chainParams.CoinbaseMaturity)
+	begin, end := from-maturity+1, to-maturity
+	if begin < 0 {
+		begin = 0
+	}
+	if end < begin {
+		return 0, nil
+	}
+
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	var total dcrutil.Amount
+	rangeFn := func(details []udb.TxDetails) (bool, error) {
+		for i := range details {
+			d := &details[i]
+			if d.TxType != stake.TxTypeSSFee {
+				continue
+			}
+			var reward dcrutil.Amount
+			for _, c := range d.Credits {
+				if c.CoinType != cointype.CoinTypeVAR {
+					continue
+				}
+				acct, _, _, _, _ := lookupOutputChain(dbtx, w, d, c)
+				if acct == account {
+					reward += c.Amount
+				}
+			}
+			for _, deb := range d.Debits {
+				if deb.CoinType != cointype.CoinTypeVAR {
+					continue
+				}
+				if lookupInputAccount(dbtx, w, d, deb) == account {
+					reward -= deb.Amount
+				}
+			}
+			if reward > 0 {
+				total += reward
+			}
+		}
+		return false, nil
+	}
+	err := w.txStore.RangeTransactions(ctx, txmgrNs, begin, end, rangeFn)
+	return total, err
+}
+
+// TicketCompoundingCost returns the accrued rewards consumed by compounding
+// them into a single ticket purchased at ticketPrice.  This is the ticket
+// price plus the relay fee of a solo ticket; the fee of the split
+// transaction funding the ticket is paid by the account and is not counted.
+func (w *Wallet) TicketCompoundingCost(ticketPrice dcrutil.Amount) dcrutil.Amount {
+	return ticketPrice + txrules.FeeForSerializeSize(w.RelayFee(), soloTicketSize())
+}
+
+// SpendTicketCompounding deducts the value of tickets purchased with an
+// account's accrued rewards and notifies clients of the purchase.  Spent is
+// limited to the account's accrued rewards.
+func (w *Wallet) SpendTicketCompounding(ctx context.Context, account uint32,
+	ticketPrice, spent dcrutil.Amount, ticketHashes []*chainhash.Hash) error {
+
+	const op errors.Op = "wallet.SpendTicketCompounding"
+
+	var n *TicketCompoundingNotification
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		c, err := udb.TicketCompoundingForAccount(dbtx, account)
+		if err != nil {
+			return err
+		}
+		if spent > c.Accrued {
+			spent = c.Accrued
+		}
+		c.Accrued -= spent
+		err = udb.PutTicketCompounding(dbtx, c)
+		if err != nil {
+			return err
+		}
+		n = &TicketCompoundingNotification{
+			Account:      account,
+			Height:       c.Height,
+			TicketHashes: ticketHashes,
+			TicketPrice:  ticketPrice,
+			Spent:        spent,
+			Accrued:      c.Accrued,
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	w.NtfnServer.notifyTicketCompounding(n)
+	return nil
+}
+
+// FeeRewardTicket returns the funding record of a ticket purchased with SSFee
+// reward outputs.  An error with kind NotExist is returned for tickets which
+// were not funded by fee rewards.
+func (w *Wallet) FeeRewardTicket(ctx context.Context, ticketHash *chainhash.Hash) (*udb.FeeRewardTicket, error) {
+	const op errors.Op = "wallet.FeeRewardTicket"
+
+	var t *udb.FeeRewardTicket
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		t, err = udb.FeeRewardTicketForHash(dbtx, ticketHash)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return t, nil
+}
+
+// FeeRewardTickets returns the funding records of all tickets purchased with
+// SSFee reward outputs.
+func (w *Wallet) FeeRewardTickets(ctx context.Context) ([]udb.FeeRewardTicket, error) {
+	const op errors.Op = "wallet.FeeRewardTickets"
+
+	var tickets []udb.FeeRewardTicket
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachFeeRewardTicket(dbtx, func(t *udb.FeeRewardTicket) error {
+			tickets = append(tickets, *t)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return tickets, nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestTicketCompounding(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	states := func() []udb.TicketCompounding {
+		t.Helper()
+		states, err := w.TicketCompounding(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return states
+	}
+
+	err := w.SetTicketCompounding(ctx, 100, true)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("opting in missing account: expected NotExist error, got %v", err)
+	}
+	if err := w.SetTicketCompounding(ctx, defaultAccount, true); err != nil {
+		t.Fatal(err)
+	}
+	_, tipHeight := w.MainChainTip(ctx)
+	want := []udb.TicketCompounding{{Account: defaultAccount, Height: tipHeight}}
+	if got := states(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("states %+v, want %+v", got, want)
+	}
+
+	// Nothing is accrued before the tip advances.
+	got, err := w.AccrueTicketCompounding(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("accrued states %+v, want %+v", got, want)
+	}
+
+	// Opting in again keeps accrued rewards.
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutTicketCompounding(dbtx, &udb.TicketCompounding{
+			Account: defaultAccount,
+			Accrued: 5e8,
+			Height:  tipHeight,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.SetTicketCompounding(ctx, defaultAccount, true); err != nil {
+		t.Fatal(err)
+	}
+	want[0].Accrued = 5e8
+	if got := states(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("states %+v, want %+v", got, want)
+	}
+
+	n := w.NtfnServer.TicketCompoundingNotifications()
+	defer n.Done()
+	// spend deducts the value of purchased tickets, returning the
+	// notification of the purchase.
+	spend := func(spent dcrutil.Amount) *TicketCompoundingNotification {
+		t.Helper()
+		hashes := []*chainhash.Hash{{1}}
+		errs := make(chan error, 1)
+		go func() {
+			errs <- w.SpendTicketCompounding(ctx, defaultAccount, 2e8, spent, hashes)
+		}()
+		var ntfn *TicketCompoundingNotification
+		select {
+		case ntfn = <-n.C:
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for notification")
+		}
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(ntfn.TicketHashes, hashes) || ntfn.TicketPrice != 2e8 {
+			t.Errorf("notification %+v does not describe the purchase", ntfn)
+		}
+		return ntfn
+	}
+	if ntfn := spend(2e8); ntfn.Spent != 2e8 || ntfn.Accrued != 3e8 {
+		t.Errorf("spent %v leaving %v, want 2 VAR leaving 3 VAR", ntfn.Spent, ntfn.Accrued)
+	}
+	// Spending more than was accrued consumes only the accrued rewards.
+	if ntfn := spend(4e8); ntfn.Spent != 3e8 || ntfn.Accrued != 0 {
+		t.Errorf("spent %v leaving %v, want 3 VAR leaving 0 VAR", ntfn.Spent, ntfn.Accrued)
+	}
+
+	if err := w.SetTicketCompounding(ctx, defaultAccount, false); err != nil {
+		t.Fatal(err)
+	}
+	if got := states(); len(got) != 0 {
+		t.Fatalf("states %+v after opting out", got)
+	}
+	err = w.SpendTicketCompounding(ctx, defaultAccount, 2e8, 1, nil)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("spend of account not compounding: expected NotExist error, got %v", err)
+	}
+}
+
+func TestTicketCompoundingCost(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	const price = 1e8
+	cost := w.TicketCompoundingCost(price)
+	if cost <= price {
+		t.Errorf("cost %v does not include the ticket fee", cost)
+	}
+}
@@ -0,0 +1,337 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// ConfirmationThresholdNotification reports a transaction reaching the
+// confirmation threshold of a watch.  Notifications of account watches
+// describe a transaction receiving outputs to an external address of the
+// watched account, and include the account.  A transaction watch reports
+// Confirmations -1, with a nil block hash and block height -1, when the
+// watched transaction is removed from the wallet before reaching the
+// threshold.
+type ConfirmationThresholdNotification struct {
+	WatchID       uint32
+	TxHash        chainhash.Hash
+	Account       uint32 // Only set by account watches
+	Confirmations int32
+	BlockHash     *chainhash.Hash
+	BlockHeight   int32
+}
+
+// confThresholdWatch is a transaction or account watch of a
+// ConfirmationThresholdNotificationsClient.
+type confThresholdWatch struct {
+	txHash  *chainhash.Hash // nil for account watches
+	account uint32
+	confs   int32
+
+	// height is the greatest block height whose transactions have reached
+	// the threshold of an account watch and were notified.
+	height int32
+}
+
+// advance updates an account watch for a main chain tip change, where
+// forkHeight is the height of the first attached block.  Notified blocks
+// which were detached are rewound, so their transactions are notified again
+// if they reach the threshold in the new chain.  It returns the inclusive
+// range of block heights whose transactions reached the threshold, which is
+// empty when begin > end.
+func (w *confThresholdWatch) advance(forkHeight, tipHeight int32) (begin, end int32) {
+	if forkHeight <= w.height {
+		w.height = forkHeight - 1
+	}
+	begin = max(w.height+1, 0)
+	end = tipHeight - w.confs + 1
+	if end >= begin {
+		w.height = end
+	}
+	return begin, end
+}
+
+// ConfirmationThresholdNotificationsClient receives notifications over the
+// channel C when watched transactions, or transactions received by watched
+// accounts, reach a number of confirmations.  Confirmations are tracked across
+// reorganizations: a transaction whose block is detached before it reaches
+// the threshold is notified once it reaches the threshold in the new main
+// chain.
+type ConfirmationThresholdNotificationsClient struct {
+	C      chan *ConfirmationThresholdNotification
+	server *NotificationServer
+
+	// Watches are protected by the server mutex.
+	watches map[uint32]*confThresholdWatch
+	lastID  uint32
+}
+
+// ConfirmationThresholdNotifications returns a client for receiving
+// ConfirmationThresholdNotifications over a channel.  The channel buffers the
+// server's backlog limit.  When finished, the client's Done method should be
+// called to disassociate the client from the server.
+func (s *NotificationServer) ConfirmationThresholdNotifications() *ConfirmationThresholdNotificationsClient {
+	s.mu.Lock()
+	c := &ConfirmationThresholdNotificationsClient{
+		C:       newClientChan[*ConfirmationThresholdNotification](s),
+		server:  s,
+		watches: make(map[uint32]*confThresholdWatch),
+	}
+	s.confThresholdClients = append(s.confThresholdClients, c)
+	s.mu.Unlock()
+	return c
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *ConfirmationThresholdNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		if s.removeConfThresholdClient(c) {
+			close(c.C)
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// removeConfThresholdClient removes a client from the server, returning false
+// if it was previously removed.  The server mutex must be held.
+func (s *NotificationServer) removeConfThresholdClient(c *ConfirmationThresholdNotificationsClient) bool {
+	clients := s.confThresholdClients
+	for i, sc := range clients {
+		if sc == c {
+			clients[i] = clients[len(clients)-1]
+			clients[len(clients)-1] = nil
+			s.confThresholdClients = clients[:len(clients)-1]
+			return true
+		}
+	}
+	return false
+}
+
+// WatchTransaction watches a wallet transaction until it reaches confs
+// confirmations or is removed from the wallet, returning the ID of the watch.
+// A transaction which already has the confirmations is notified immediately.
+// Errors with code NotExist are returned for transactions unknown to the
+// wallet.
+func (c *ConfirmationThresholdNotificationsClient) WatchTransaction(ctx context.Context,
+	txHash *chainhash.Hash, confs int32) (uint32, error) {
+	const op errors.Op = "wallet.WatchTransaction"
+
+	if confs < 1 {
+		return 0, errors.E(op, errors.Invalid,
+			"confirmation threshold must be positive")
+	}
+	w := &confThresholdWatch{
+		txHash: txHash,
+		confs:  confs,
+	}
+	s := c.server
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var n *ConfirmationThresholdNotification
+	err := walletdb.View(ctx, s.wallet.db, func(dbtx walletdb.ReadTx) error {
+		_, tipHeight := s.wallet.txStore.MainChainTip(dbtx)
+		var err error
+		n, err = s.wallet.txConfirmationThreshold(dbtx, w, tipHeight)
+		return err
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	if n != nil && n.Confirmations == -1 {
+		return 0, errors.E(op, errors.NotExist,
+			errors.Errorf("transaction %v is unknown to the wallet", txHash))
+	}
+	return c.addWatch(w, n), nil
+}
+
+// WatchAccount watches for transactions receiving outputs to external
+// addresses of an account, notifying each once it reaches confs
+// confirmations, and returns the ID of the watch.  Transactions which reached
+// the confirmations before the watch was added are not notified.
+func (c *ConfirmationThresholdNotificationsClient) WatchAccount(ctx context.Context,
+	account uint32, confs int32) (uint32, error) {
+	const op errors.Op = "wallet.WatchAccount"
+
+	if confs < 1 {
+		return 0, errors.E(op, errors.Invalid,
+			"confirmation threshold must be positive")
+	}
+	w := &confThresholdWatch{
+		account: account,
+		confs:   confs,
+	}
+	s := c.server
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err := walletdb.View(ctx, s.wallet.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := s.wallet.manager.AccountName(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+		_, tipHeight := s.wallet.txStore.MainChainTip(dbtx)
+		w.height = tipHeight - confs + 1
+		return nil
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return c.addWatch(w, nil), nil
+}
+
+// addWatch assigns the next watch ID, and records the watch unless the
+// notification n completes it.  The server mutex must be held.
+func (c *ConfirmationThresholdNotificationsClient) addWatch(w *confThresholdWatch,
+	n *ConfirmationThresholdNotification) uint32 {
+
+	c.lastID++
+	id := c.lastID
+	if n == nil {
+		c.watches[id] = w
+		return id
+	}
+	n.WatchID = id
+	if !sendNotification(c.server, c.C, n, nil) {
+		c.server.removeConfThresholdClient(c)
+	}
+	return id
+}
+
+// Unwatch removes the watch with an ID.  Watches of transactions are removed
+// automatically after they are notified.
+func (c *ConfirmationThresholdNotificationsClient) Unwatch(id uint32) {
+	c.server.mu.Lock()
+	delete(c.watches, id)
+	c.server.mu.Unlock()
+}
+
+// txConfirmationThreshold returns the notification of a transaction watch, or
+// nil if the watched transaction has not reached the threshold.
+func (w *Wallet) txConfirmationThreshold(dbtx walletdb.ReadTx, watch *confThresholdWatch,
+	tipHeight int32) (*ConfirmationThresholdNotification, error) {
+
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	details, err := w.txStore.TxDetails(txmgrNs, watch.txHash)
+	if errors.Is(err, errors.NotExist) {
+		return &ConfirmationThresholdNotification{
+			TxHash:        *watch.txHash,
+			Confirmations: -1,
+			BlockHeight:   -1,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	height := details.Height()
+	confs := confirms(height, tipHeight)
+	if confs < watch.confs {
+		return nil, nil
+	}
+	blockHash := details.Block.Hash
+	return &ConfirmationThresholdNotification{
+		TxHash:        *watch.txHash,
+		Confirmations: confs,
+		BlockHash:     &blockHash,
+		BlockHeight:   height,
+	}, nil
+}
+
+// accountConfirmationThreshold returns the notifications of transactions
+// received by the account of an account watch in blocks over the height range
+// [begin,end].
+func (w *Wallet) accountConfirmationThreshold(ctx context.Context, dbtx walletdb.ReadTx,
+	watch *confThresholdWatch, begin, end, tipHeight int32) ([]*ConfirmationThresholdNotification, error) {
+
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	var ntfns []*ConfirmationThresholdNotification
+	err := w.txStore.RangeTransactions(ctx, txmgrNs, begin, end, func(details []udb.TxDetails) (bool, error) {
+		for i := range details {
+			d := &details[i]
+			for _, cred := range d.Credits {
+				account, internal, _, _, _ := lookupOutputChain(dbtx, w, d, cred)
+				if account != watch.account || internal {
+					continue
+				}
+				blockHash := d.Block.Hash
+				ntfns = append(ntfns, &ConfirmationThresholdNotification{
+					TxHash:        d.Hash,
+					Account:       watch.account,
+					Confirmations: confirms(d.Height(), tipHeight),
+					BlockHash:     &blockHash,
+					BlockHeight:   d.Height(),
+				})
+				break
+			}
+		}
+		return false, nil
+	})
+	return ntfns, err
+}
+
+// process notifies the transactions of the client's watches which reached
+// their thresholds after a main chain tip change, removing completed
+// transaction watches.  It returns false if the client was disconnected.  The
+// server mutex must be held.
+func (c *ConfirmationThresholdNotificationsClient) process(n *MainTipChangedNotification) bool {
+	if len(c.watches) == 0 {
+		return true
+	}
+	w := c.server.wallet
+	forkHeight := n.NewHeight - int32(len(n.AttachedBlocks)) + 1
+	var ntfns []*ConfirmationThresholdNotification
+	ctx := context.Background()
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		for id, watch := range c.watches {
+			if watch.txHash != nil {
+				ntfn, err := w.txConfirmationThreshold(dbtx, watch, n.NewHeight)
+				if err != nil {
+					return err
+				}
+				if ntfn != nil {
+					ntfn.WatchID = id
+					ntfns = append(ntfns, ntfn)
+					delete(c.watches, id)
+				}
+				continue
+			}
+			begin, end := watch.advance(forkHeight, n.NewHeight)
+			if begin > end {
+				continue
+			}
+			r, err := w.accountConfirmationThreshold(ctx, dbtx, watch, begin, end, n.NewHeight)
+			if err != nil {
+				return err
+			}
+			for _, ntfn := range r {
+				ntfn.WatchID = id
+			}
+			ntfns = append(ntfns, r...)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Cannot process confirmation threshold watches: %v", err)
+	}
+	for _, ntfn := range ntfns {
+		if !sendNotification(c.server, c.C, ntfn, nil) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+)
+
+func TestConfThresholdWatchAdvance(t *testing.T) {
+	t.Parallel()
+
+	// The watch is added at tip height 10 with a threshold of 3
+	// confirmations, so transactions through height 8 are not notified.
+	w := &confThresholdWatch{confs: 3, height: 8}
+	tests := []struct {
+		name                  string
+		forkHeight, tipHeight int32
+		begin, end            int32
+	}{
+		{"extend", 11, 11, 9, 9},
+		{"extend multiple", 12, 14, 10, 12},
+		{"reorg above notified", 14, 15, 13, 13},
+		// Blocks 12 and 13 were replaced, so their transactions are
+		// notified again once they have 3 confirmations.
+		{"reorg notified", 12, 14, 12, 12},
+		{"reorg to shorter chain", 11, 12, 11, 10},
+		{"extend after shorter chain", 13, 13, 11, 11},
+	}
+	for _, tc := range tests {
+		begin, end := w.advance(tc.forkHeight, tc.tipHeight)
+		if begin != tc.begin || end != tc.end {
+			t.Errorf("%s: range [%d,%d], want [%d,%d]", tc.name,
+				begin, end, tc.begin, tc.end)
+		}
+	}
+
+	// Ranges never include negative heights, which would range over
+	// unmined transactions.
+	w = &confThresholdWatch{confs: 6, height: -5}
+	if begin, end := w.advance(1, 1); begin != 0 || end != -4 {
+		t.Errorf("new chain: range [%d,%d], want empty range from 0", begin, end)
+	}
+}
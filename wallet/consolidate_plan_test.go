@@ -0,0 +1,107 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/wallet/txrules"
+	"github.com/monetarium/monetarium-wallet/wallet/txsizes"
+)
+
+func TestPlanConsolidation(t *testing.T) {
+	const feeRate = dcrutil.Amount(1e4)
+
+	inputs := func(n int, value int64) []Input {
+		in := make([]Input, n)
+		for i := range in {
+			in[i].PrevOut.Value = value
+		}
+		return in
+	}
+	txSize := func(n int) int {
+		scriptSizes := make([]int, n)
+		for i := range scriptSizes {
+			scriptSizes[i] = txsizes.RedeemP2PKHSigScriptSize
+		}
+		txOuts := []*wire.TxOut{{PkScript: make([]byte, txsizes.P2PKHPkScriptSize)}}
+		return txsizes.EstimateSerializeSize(scriptSizes, txOuts, 0)
+	}
+	fee := func(n int) dcrutil.Amount {
+		return txrules.FeeForSerializeSize(feeRate, txSize(n))
+	}
+
+	tests := []struct {
+		name         string
+		eligible     []Input
+		maxInputs    int
+		maxTxSize    int
+		transactions int
+		fee          dcrutil.Amount
+		remaining    int
+	}{{
+		name:         "single transaction",
+		eligible:     inputs(5, 1e8),
+		maxInputs:    10,
+		maxTxSize:    maxStandardTxSize,
+		transactions: 1,
+		fee:          fee(5),
+		remaining:    1,
+	}, {
+		name:         "limited by inputs",
+		eligible:     inputs(5, 1e8),
+		maxInputs:    2,
+		maxTxSize:    maxStandardTxSize,
+		transactions: 2,
+		fee:          2 * fee(2),
+		remaining:    3,
+	}, {
+		name:         "limited by size",
+		eligible:     inputs(7, 1e8),
+		maxInputs:    10,
+		maxTxSize:    txSize(3),
+		transactions: 3,
+		fee:          3 * fee(3),
+		remaining:    1,
+	}, {
+		name:         "dust",
+		eligible:     inputs(4, 1),
+		maxInputs:    10,
+		maxTxSize:    maxStandardTxSize,
+		transactions: 0,
+		fee:          0,
+		remaining:    4,
+	}, {
+		name:         "too few outputs",
+		eligible:     inputs(1, 1e8),
+		maxInputs:    10,
+		maxTxSize:    maxStandardTxSize,
+		transactions: 0,
+		fee:          0,
+		remaining:    1,
+	}}
+	for _, tc := range tests {
+		plan := planConsolidation(tc.eligible, tc.maxInputs,
+			cointype.CoinTypeVAR, feeRate, tc.maxTxSize)
+		if plan.Outputs != len(tc.eligible) {
+			t.Errorf("%s: outputs %d, want %d", tc.name, plan.Outputs,
+				len(tc.eligible))
+		}
+		if plan.Transactions != tc.transactions {
+			t.Errorf("%s: transactions %d, want %d", tc.name,
+				plan.Transactions, tc.transactions)
+		}
+		if plan.Fee != tc.fee {
+			t.Errorf("%s: fee %v, want %v", tc.name, plan.Fee, tc.fee)
+		}
+		if plan.Remaining != tc.remaining {
+			t.Errorf("%s: remaining %d, want %d", tc.name, plan.Remaining,
+				tc.remaining)
+		}
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"crypto/sha256"
+	"math/big"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/atomicswap"
+	"github.com/monetarium/monetarium-wallet/wallet/contracts"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+)
+
+// ContractOutput describes a hash-locked contract funded by the wallet.  Tx
+// is the published transaction paying to the P2SH Address of the Contract
+// redeem script.
+type ContractOutput struct {
+	Contract []byte
+	Address  stdaddr.Address
+	Tx       *wire.MsgTx
+	Terms    contracts.Contract
+}
+
+// CreateContract funds a contract locking amount atoms of coinType from
+// account, redeemable by recipient with the secret of secretHash, or
+// refundable to a new internal address of account once lockTime is reached.
+// The recipient must be a P2PKH address.  The funding transaction is
+// published and counted against the daily spend limit of the account.
+func (w *Wallet) CreateContract(ctx context.Context, account uint32, coinType cointype.CoinType,
+	amount *big.Int, recipient stdaddr.Address, secretHash *[32]byte, lockTime int64) (*ContractOutput, error) {
+
+	const op errors.Op = "wallet.CreateContract"
+
+	if !coinType.IsValid() {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("invalid coin type %d", coinType))
+	}
+	recipientHash, ok := contracts.PubKeyHash(recipient)
+	if !ok {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("recipient %v "+
+			"is not a P2PKH address", recipient))
+	}
+	refund, err := w.NewInternalAddress(ctx, account, WithGapPolicyWrap())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	refundHash, ok := contracts.PubKeyHash(refund)
+	if !ok {
+		return nil, errors.E(op, errors.Bug, "refund address is not P2PKH")
+	}
+
+	terms := contracts.Contract{
+		SecretHash:       *secretHash,
+		RecipientHash160: *recipientHash,
+		RefundHash160:    *refundHash,
+		LockTime:         lockTime,
+	}
+	contract, err := terms.Script()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	addr, err := contracts.Address(contract, w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	out, err := atomicswap.ContractOutput(contract, coinType, amount)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	hash, err := w.SendOutputs(ctx, []*wire.TxOut{out}, account, account, 1)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	txs, _, err := w.GetTransactionsByHashes(ctx, []*chainhash.Hash{hash})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(txs) != 1 {
+		return nil, errors.E(op, errors.Bug, errors.Errorf("published "+
+			"contract transaction %v is not recorded", hash))
+	}
+
+	return &ContractOutput{
+		Contract: contract,
+		Address:  addr,
+		Tx:       txs[0],
+		Terms:    terms,
+	}, nil
+}
+
+// SwapSide describes the coins locked in a contract by one side of an atomic
+// swap between two accounts of the wallet.
+type SwapSide struct {
+	Account  uint32
+	CoinType cointype.CoinType
+	Amount   *big.Int
+}
+
+// Swap describes an atomic swap between two accounts of the wallet: the
+// contract of the initiator paying the participant, and the contract of the
+// participant paying the initiator, both locked by the secret hash of Secret.
+type Swap struct {
+	Secret      [32]byte
+	SecretHash  [32]byte
+	Initiator   *ContractOutput
+	Participant *ContractOutput
+}
+
+// CreateSwap creates the pair of linked contracts swapping the coins of two
+// accounts of different coin types.  The initiator contract pays a new
+// address of the participant account and may be refunded after lockTime,
+// while the participant contract pays a new address of the initiator account
+// and may be refunded after half of lockTime, so the initiator must redeem
+// first, revealing the secret.  Should funding the participant contract
+// fail, the initiator contract has already been published and must be
+// refunded after its lock time.
+func (w *Wallet) CreateSwap(ctx context.Context, initiator, participant SwapSide,
+	lockTime time.Duration) (*Swap, error) {
+
+	const op errors.Op = "wallet.CreateSwap"
+
+	if initiator.CoinType == participant.CoinType {
+		return nil, errors.E(op, errors.Invalid, "swap sides must lock different coin types")
+	}
+	if lockTime < 2*time.Hour {
+		return nil, errors.E(op, errors.Invalid, "swap lock time must be at least two hours")
+	}
+	initiatorAddr, err := w.NewExternalAddress(ctx, initiator.Account, WithGapPolicyWrap())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	participantAddr, err := w.NewExternalAddress(ctx, participant.Account, WithGapPolicyWrap())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	secret, secretHash, err := contracts.GenerateSecret()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	now := time.Now()
+	s := &Swap{Secret: secret, SecretHash: secretHash}
+	s.Initiator, err = w.CreateContract(ctx, initiator.Account, initiator.CoinType,
+		initiator.Amount, participantAddr, &secretHash, now.Add(lockTime).Unix())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	s.Participant, err = w.CreateContract(ctx, participant.Account, participant.CoinType,
+		participant.Amount, initiatorAddr, &secretHash, now.Add(lockTime/2).Unix())
+	if err != nil {
+		logCtx(ctx).Errorf("Initiator contract %v was published but the participant "+
+			"contract was not, and must be refunded after its lock time",
+			s.Initiator.Tx.TxHash())
+		return nil, errors.E(op, err)
+	}
+	return s, nil
+}
+
+// RedeemContract redeems the contract output of contractTx with the secret
+// of the contract, paying it to the wallet account of the contract recipient.
+// The published redeem transaction is returned.
+func (w *Wallet) RedeemContract(ctx context.Context, contractTx *wire.MsgTx,
+	contract, secret []byte) (*wire.MsgTx, error) {
+
+	const op errors.Op = "wallet.RedeemContract"
+
+	if len(secret) != contracts.SecretSize {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("secret "+
+			"must be %d bytes", contracts.SecretSize))
+	}
+	tx, err := w.spendContract(ctx, op, contractTx, contract, secret)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// RefundContract refunds the contract output of contractTx after the lock
+// time of the contract, paying it to the wallet account of the refund
+// address.  The published refund transaction is returned.
+func (w *Wallet) RefundContract(ctx context.Context, contractTx *wire.MsgTx,
+	contract []byte) (*wire.MsgTx, error) {
+
+	const op errors.Op = "wallet.RefundContract"
+
+	tx, err := w.spendContract(ctx, op, contractTx, contract, nil)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// spendContract redeems a contract with the secret, or refunds it when the
+// secret is nil.
+func (w *Wallet) spendContract(ctx context.Context, op errors.Op, contractTx *wire.MsgTx,
+	contract, secret []byte) (*wire.MsgTx, error) {
+
+	terms, err := contracts.ParseContract(contract)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	idx, err := contracts.FindContractOutput(contractTx, contract)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	coinType := contractTx.TxOut[idx].CoinType
+
+	refund := secret == nil
+	if !refund && sha256.Sum256(secret) != terms.SecretHash {
+		return nil, errors.E(op, errors.Invalid, "secret does not match the contract secret hash")
+	}
+	pkh := terms.RecipientHash160[:]
+	if refund {
+		_, tipHeight := w.MainChainTip(ctx)
+		if !terms.LockTimeReached(tipHeight, time.Now()) {
+			return nil, errors.E(op, errors.Invalid, "contract lock time has not been reached")
+		}
+		pkh = terms.RefundHash160[:]
+	}
+	keyAddr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(pkh, w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	ka, err := w.KnownAddress(ctx, keyAddr)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	account, err := w.AccountNumber(ctx, ka.AccountName())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	var payTo stdaddr.Address = keyAddr
+	if account != udb.ImportedAddrAccount {
+		payTo, err = w.NewInternalAddress(ctx, account, WithGapPolicyWrap())
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	vers, pkScript := payTo.PaymentScript()
+
+	key, zero, err := w.LoadPrivateKey(ctx, keyAddr)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer zero()
+	feeRate := w.RelayFeeForCoinType(ctx, coinType)
+	var tx *wire.MsgTx
+	if refund {
+		tx, err = atomicswap.Refund(contractTx, contract, key, vers, pkScript, feeRate)
+	} else {
+		tx, err = atomicswap.Redeem(contractTx, contract, secret, key, vers, pkScript, feeRate)
+	}
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	err = validateMsgTx(op, tx, [][]byte{contracts.PkScript(contract)})
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if _, err := w.PublishTransaction(ctx, tx, n); err != nil {
+		return nil, errors.E(op, err)
+	}
+	return tx, nil
+}
@@ -0,0 +1,280 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package contracts
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"time"
+
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/txrules"
+	"github.com/monetarium/monetarium-wallet/wallet/txsizes"
+)
+
+// SecretSize is the size of contract secrets.
+const SecretSize = 32
+
+// Contract describes the terms of a hash-locked contract.  LockTime is the
+// block height, or the unix time when at least txscript.LockTimeThreshold,
+// after which the contract may be refunded.
+type Contract struct {
+	SecretHash       [32]byte
+	RecipientHash160 [20]byte
+	RefundHash160    [20]byte
+	LockTime         int64
+}
+
+// GenerateSecret returns a random contract secret and its secret hash.
+func GenerateSecret() (secret, secretHash [32]byte, err error) {
+	if _, err := rand.Read(secret[:]); err != nil {
+		return secret, secretHash, errors.E(errors.IO, err)
+	}
+	return secret, sha256.Sum256(secret[:]), nil
+}
+
+// PubKeyHash returns the hash160 of a P2PKH address using ECDSA secp256k1
+// keys, the only addresses contracts may pay to.
+func PubKeyHash(addr stdaddr.Address) (*[20]byte, bool) {
+	_, script := addr.PaymentScript()
+	if stdscript.DetermineScriptType(0, script) != stdscript.STPubKeyHashEcdsaSecp256k1 {
+		return nil, false
+	}
+	h, ok := addr.(stdaddr.Hash160er)
+	if !ok {
+		return nil, false
+	}
+	return h.Hash160(), true
+}
+
+// LockTimeReached returns whether a refund of the contract may be mined in
+// the block following the main chain tip at tipHeight, approximating the
+// median time of the chain by now for lock times expressed as unix times.
+func (c *Contract) LockTimeReached(tipHeight int32, now time.Time) bool {
+	if c.LockTime >= txscript.LockTimeThreshold {
+		return now.Unix() >= c.LockTime
+	}
+	return int64(tipHeight) >= c.LockTime
+}
+
+// Script returns the redeem script of the contract.
+func (c *Contract) Script() ([]byte, error) {
+	const op errors.Op = "contracts.Script"
+
+	if c.LockTime <= 0 || c.LockTime > int64(^uint32(0)) {
+		return nil, errors.E(op, errors.Invalid, "lock time out of range")
+	}
+	b := txscript.NewScriptBuilder()
+	b.AddOp(txscript.OP_IF)
+	b.AddOp(txscript.OP_SIZE)
+	b.AddInt64(SecretSize)
+	b.AddOp(txscript.OP_EQUALVERIFY)
+	b.AddOp(txscript.OP_SHA256)
+	b.AddData(c.SecretHash[:])
+	b.AddOp(txscript.OP_EQUALVERIFY)
+	b.AddOp(txscript.OP_DUP)
+	b.AddOp(txscript.OP_HASH160)
+	b.AddData(c.RecipientHash160[:])
+	b.AddOp(txscript.OP_ELSE)
+	b.AddInt64(c.LockTime)
+	b.AddOp(txscript.OP_CHECKLOCKTIMEVERIFY)
+	b.AddOp(txscript.OP_DROP)
+	b.AddOp(txscript.OP_DUP)
+	b.AddOp(txscript.OP_HASH160)
+	b.AddData(c.RefundHash160[:])
+	b.AddOp(txscript.OP_ENDIF)
+	b.AddOp(txscript.OP_EQUALVERIFY)
+	b.AddOp(txscript.OP_CHECKSIG)
+	script, err := b.Script()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return script, nil
+}
+
+// ParseContract returns the terms of a contract redeem script.  Scripts
+// which are not contracts with secrets of SecretSize bytes are rejected.
+func ParseContract(script []byte) (*Contract, error) {
+	const op errors.Op = "contracts.ParseContract"
+
+	pushes := stdscript.ExtractAtomicSwapDataPushesV0(script)
+	if pushes == nil {
+		return nil, errors.E(op, errors.Invalid, "script is not a contract")
+	}
+	if pushes.SecretSize != SecretSize {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("contract "+
+			"secret size %d is not %d", pushes.SecretSize, SecretSize))
+	}
+	return &Contract{
+		SecretHash:       pushes.SecretHash,
+		RecipientHash160: pushes.RecipientHash160,
+		RefundHash160:    pushes.RefundHash160,
+		LockTime:         pushes.LockTime,
+	}, nil
+}
+
+// PkScript returns the P2SH output script paying to the contract redeem
+// script.
+func PkScript(contract []byte) []byte {
+	script := make([]byte, 0, 23)
+	script = append(script, txscript.OP_HASH160, txscript.OP_DATA_20)
+	script = append(script, stdaddr.Hash160(contract)...)
+	return append(script, txscript.OP_EQUAL)
+}
+
+// Address returns the P2SH address of the contract redeem script.
+func Address(contract []byte, params stdaddr.AddressParamsV0) (stdaddr.Address, error) {
+	return stdaddr.NewAddressScriptHashV0(contract, params)
+}
+
+// RedeemSigScript returns the signature script redeeming a contract with the
+// secret, signed by the key of the recipient.
+func RedeemSigScript(contract, sig, pubKey, secret []byte) ([]byte, error) {
+	b := txscript.NewScriptBuilder()
+	b.AddData(sig)
+	b.AddData(pubKey)
+	b.AddData(secret)
+	b.AddInt64(1)
+	b.AddData(contract)
+	return b.Script()
+}
+
+// RefundSigScript returns the signature script refunding a contract after its
+// lock time, signed by the key of the refund address.
+func RefundSigScript(contract, sig, pubKey []byte) ([]byte, error) {
+	b := txscript.NewScriptBuilder()
+	b.AddData(sig)
+	b.AddData(pubKey)
+	b.AddInt64(0)
+	b.AddData(contract)
+	return b.Script()
+}
+
+// FindContractOutput returns the index of the output of tx paying to the
+// contract redeem script.
+func FindContractOutput(tx *wire.MsgTx, contract []byte) (uint32, error) {
+	const op errors.Op = "contracts.FindContractOutput"
+
+	pkScript := PkScript(contract)
+	for i, out := range tx.TxOut {
+		if out.Version == 0 && bytes.Equal(out.PkScript, pkScript) {
+			return uint32(i), nil
+		}
+	}
+	return 0, errors.E(op, errors.NotExist, "transaction does not pay to the contract")
+}
+
+// NewRedeemTx returns the unsigned transaction redeeming the contract output
+// of contractTx with the secret, paying the contract value less the fee to
+// pkScript.  The fee is paid at feePerKb in atoms of the contract's coin type
+// for the signed size of the transaction.  The signature script must be
+// added with RedeemSigScript after signing the input.
+func NewRedeemTx(contractTx *wire.MsgTx, contract []byte, pkScriptVersion uint16,
+	pkScript []byte, feePerKb dcrutil.Amount) (*wire.MsgTx, error) {
+
+	const op errors.Op = "contracts.NewRedeemTx"
+
+	tx, err := newSpendTx(contractTx, contract, pkScriptVersion, pkScript,
+		feePerKb, txsizes.RedeemAtomicSwapSigScriptSize, false)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return tx, nil
+}
+
+// NewRefundTx returns the unsigned transaction refunding the contract output
+// of contractTx once the contract lock time is reached, paying the contract
+// value less the fee to pkScript.  The transaction lock time is set to the
+// lock time of the contract.  The signature script must be added with
+// RefundSigScript after signing the input.
+func NewRefundTx(contractTx *wire.MsgTx, contract []byte, pkScriptVersion uint16,
+	pkScript []byte, feePerKb dcrutil.Amount) (*wire.MsgTx, error) {
+
+	const op errors.Op = "contracts.NewRefundTx"
+
+	tx, err := newSpendTx(contractTx, contract, pkScriptVersion, pkScript,
+		feePerKb, txsizes.RefundAtomicSwapSigScriptSize, true)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return tx, nil
+}
+
+func newSpendTx(contractTx *wire.MsgTx, contract []byte, pkScriptVersion uint16,
+	pkScript []byte, feePerKb dcrutil.Amount, sigScriptSize int, refund bool) (*wire.MsgTx, error) {
+
+	c, err := ParseContract(contract)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := FindContractOutput(contractTx, contract)
+	if err != nil {
+		return nil, err
+	}
+	prev := contractTx.TxOut[idx]
+
+	contractHash := contractTx.TxHash()
+	tx := wire.NewMsgTx()
+	txIn := wire.NewTxIn(wire.NewOutPoint(&contractHash, idx, wire.TxTreeRegular),
+		prev.Value, make([]byte, sigScriptSize))
+	out := &wire.TxOut{
+		CoinType: prev.CoinType,
+		Version:  pkScriptVersion,
+		PkScript: pkScript,
+	}
+	if prev.CoinType.IsSKA() {
+		txIn.SKAValueIn = new(big.Int).Set(prev.SKAValue)
+		out.SKAValue = new(big.Int).Set(prev.SKAValue)
+	} else {
+		out.Value = prev.Value
+	}
+	if refund {
+		tx.LockTime = uint32(c.LockTime)
+		txIn.Sequence = 0
+	}
+	tx.AddTxIn(txIn)
+	tx.AddTxOut(out)
+
+	// The signature script is sized for the worst case signature while
+	// estimating the fee, and removed for signing.
+	fee := txrules.FeeForSerializeSize(feePerKb, tx.SerializeSize())
+	txIn.SignatureScript = nil
+	if prev.CoinType.IsSKA() {
+		out.SKAValue.Sub(out.SKAValue, big.NewInt(int64(fee)))
+		if out.SKAValue.Sign() <= 0 {
+			return nil, errors.E(errors.Invalid, "contract value does not pay the fee")
+		}
+	} else {
+		out.Value -= int64(fee)
+		if txrules.IsDustOutput(out, feePerKb) {
+			return nil, errors.E(errors.Invalid, "contract value does not pay the fee")
+		}
+	}
+	return tx, nil
+}
+
+// ExtractSecret returns the secret revealed by a transaction redeeming the
+// contract with the secret hash.
+func ExtractSecret(redeemTx *wire.MsgTx, secretHash *[32]byte) ([]byte, error) {
+	const op errors.Op = "contracts.ExtractSecret"
+
+	for _, in := range redeemTx.TxIn {
+		tok := txscript.MakeScriptTokenizer(0, in.SignatureScript)
+		for tok.Next() {
+			push := tok.Data()
+			if len(push) == SecretSize && sha256.Sum256(push) == *secretHash {
+				return bytes.Clone(push), nil
+			}
+		}
+	}
+	return nil, errors.E(op, errors.NotExist, "transaction does not reveal the secret")
+}
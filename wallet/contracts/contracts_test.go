@@ -0,0 +1,142 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package contracts
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/dcrec"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/sign"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+func TestContractSpends(t *testing.T) {
+	t.Parallel()
+
+	recipientKey := secp256k1.PrivKeyFromBytes([]byte{1})
+	refundKey := secp256k1.PrivKeyFromBytes([]byte{2})
+	secret, secretHash, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &Contract{SecretHash: secretHash, LockTime: 1000}
+	copy(c.RecipientHash160[:], stdaddr.Hash160(recipientKey.PubKey().SerializeCompressed()))
+	copy(c.RefundHash160[:], stdaddr.Hash160(refundKey.PubKey().SerializeCompressed()))
+	contract, err := c.Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := ParseContract(contract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *parsed != *c {
+		t.Fatalf("parsed contract %+v, want %+v", parsed, c)
+	}
+	if _, err := ParseContract(contract[1:]); !errors.Is(err, errors.Invalid) {
+		t.Errorf("truncated contract: expected Invalid error, got %v", err)
+	}
+
+	payScript := []byte{txscript.OP_TRUE}
+	contractTxs := map[string]*wire.MsgTx{
+		"VAR": {TxOut: []*wire.TxOut{
+			wire.NewTxOut(1e8, payScript),
+			wire.NewTxOut(5e8, PkScript(contract)),
+		}},
+		"SKA": {TxOut: []*wire.TxOut{
+			wire.NewTxOutSKA(big.NewInt(5e8), 1, PkScript(contract)),
+		}},
+	}
+	spend := func(tx *wire.MsgTx, key *secp256k1.PrivateKey, secret []byte) error {
+		sig, err := sign.RawTxInSignature(tx, 0, contract, txscript.SigHashAll,
+			key.Serialize(), dcrec.STEcdsaSecp256k1)
+		if err != nil {
+			return err
+		}
+		pubKey := key.PubKey().SerializeCompressed()
+		var sigScript []byte
+		if secret != nil {
+			sigScript, err = RedeemSigScript(contract, sig, pubKey, secret)
+		} else {
+			sigScript, err = RefundSigScript(contract, sig, pubKey)
+		}
+		if err != nil {
+			return err
+		}
+		tx.TxIn[0].SignatureScript = sigScript
+		vm, err := txscript.NewEngine(PkScript(contract), tx, 0,
+			txscript.ScriptVerifyCheckLockTimeVerify|txscript.ScriptVerifySHA256, 0, nil)
+		if err != nil {
+			return err
+		}
+		return vm.Execute()
+	}
+	for name, contractTx := range contractTxs {
+		const feePerKb = 1e4
+		redeemTx, err := NewRedeemTx(contractTx, contract, 0, payScript, feePerKb)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		out := redeemTx.TxOut[0]
+		in := redeemTx.TxIn[0]
+		if in.PreviousOutPoint.Hash != contractTx.TxHash() ||
+			int(in.PreviousOutPoint.Index) != len(contractTx.TxOut)-1 {
+			t.Errorf("%s: redeem spends %v", name, &in.PreviousOutPoint)
+		}
+		value := out.Value
+		if out.CoinType.IsSKA() {
+			value = out.SKAValue.Int64()
+		}
+		if out.CoinType != contractTx.TxOut[len(contractTx.TxOut)-1].CoinType ||
+			value >= 5e8 || value < 5e8-feePerKb {
+			t.Errorf("%s: unexpected redeem output %+v", name, out)
+		}
+		if err := spend(redeemTx, refundKey, secret[:]); err == nil {
+			t.Errorf("%s: redeemed by refund key", name)
+		}
+		if err := spend(redeemTx, recipientKey, make([]byte, SecretSize)); err == nil {
+			t.Errorf("%s: redeemed with wrong secret", name)
+		}
+		if err := spend(redeemTx, recipientKey, secret[:]); err != nil {
+			t.Errorf("%s: redeem: %v", name, err)
+		}
+		revealed, err := ExtractSecret(redeemTx, &secretHash)
+		if err != nil || !bytes.Equal(revealed, secret[:]) {
+			t.Errorf("%s: extracted secret %x, %v", name, revealed, err)
+		}
+
+		refundTx, err := NewRefundTx(contractTx, contract, 0, payScript, feePerKb)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if refundTx.LockTime != 1000 {
+			t.Errorf("%s: refund lock time %d", name, refundTx.LockTime)
+		}
+		if err := spend(refundTx, refundKey, nil); err != nil {
+			t.Errorf("%s: refund: %v", name, err)
+		}
+		refundTx.LockTime = 999
+		if err := spend(refundTx, refundKey, nil); err == nil {
+			t.Errorf("%s: refunded before lock time", name)
+		}
+	}
+
+	if _, err := NewRedeemTx(contractTxs["VAR"], contract, 0, payScript, 1e12); !errors.Is(err, errors.Invalid) {
+		t.Errorf("excessive fee: expected Invalid error, got %v", err)
+	}
+	other := &wire.MsgTx{TxOut: []*wire.TxOut{wire.NewTxOut(1e8, payScript)}}
+	if _, err := NewRedeemTx(other, contract, 0, payScript, 1e4); !errors.Is(err, errors.NotExist) {
+		t.Errorf("missing contract output: expected NotExist error, got %v", err)
+	}
+	if _, err := ExtractSecret(other, &[32]byte{}); !errors.Is(err, errors.NotExist) {
+		t.Errorf("no secret: expected NotExist error, got %v", err)
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package contracts implements hash-locked contracts for swapping coin types
+atomically.
+
+A contract is a P2SH redeem script paying to a recipient who reveals the
+secret preimage of a SHA-256 secret hash, or, once its lock time is reached,
+refunding the party who funded it.  It has the standard atomic swap form
+recognized by stdscript.ExtractAtomicSwapDataPushesV0:
+
+	IF
+	  SIZE <secret size> EQUALVERIFY
+	  SHA256 <secret hash> EQUALVERIFY
+	  DUP HASH160 <recipient hash160>
+	ELSE
+	  <lock time> CHECKLOCKTIMEVERIFY DROP
+	  DUP HASH160 <refund hash160>
+	ENDIF
+	EQUALVERIFY CHECKSIG
+
+Two contracts locked by the same secret hash swap the coins they hold: the
+initiator, who chose the secret, funds a contract paying the participant, and
+the participant funds a contract of another coin type paying the initiator
+with an earlier lock time.  Redeeming the participant's contract reveals the
+secret on chain, allowing the participant to redeem the initiator's contract
+in turn, while either party may recover their coins with a refund if the swap
+is not completed before the lock time of their contract.
+
+Contract outputs hold a single coin type, and the redeem and refund
+transactions built by this package pay the fee in the coin type of the
+contract.
+*/
+package contracts
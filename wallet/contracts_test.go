@@ -0,0 +1,101 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/contracts"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+)
+
+func TestContracts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	recipient, err := w.NewExternalAddress(ctx, udb.DefaultAccountNum, WithGapPolicyWrap())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2sh, err := stdaddr.NewAddressScriptHashV0([]byte{1}, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, secretHash, err := contracts.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = w.CreateContract(ctx, 0, 0, big.NewInt(0), recipient, &secretHash, 1000)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("zero amount: expected Invalid error, got %v", err)
+	}
+	_, err = w.CreateContract(ctx, 0, 0, big.NewInt(1e8), p2sh, &secretHash, 1000)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("P2SH recipient: expected Invalid error, got %v", err)
+	}
+	side := SwapSide{Account: 0, CoinType: 0, Amount: big.NewInt(1e8)}
+	_, err = w.CreateSwap(ctx, side, side, 48*time.Hour)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("same coin types: expected Invalid error, got %v", err)
+	}
+	skaSide := SwapSide{Account: 0, CoinType: 1, Amount: big.NewInt(1e8)}
+	_, err = w.CreateSwap(ctx, side, skaSide, time.Hour)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("short lock time: expected Invalid error, got %v", err)
+	}
+
+	// A contract paying the wallet, funded by another party, can be
+	// redeemed with the secret but not refunded.
+	terms := contracts.Contract{SecretHash: secretHash, LockTime: 1 << 20}
+	copy(terms.RecipientHash160[:], recipient.(stdaddr.Hash160er).Hash160()[:])
+	contract, err := terms.Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	contractTx := wire.NewMsgTx()
+	contractTx.AddTxOut(wire.NewTxOutSKA(big.NewInt(5e8), 1, contracts.PkScript(contract)))
+
+	_, err = w.RedeemContract(ctx, contractTx, contract, secret[:16])
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("short secret: expected Invalid error, got %v", err)
+	}
+	_, err = w.RedeemContract(ctx, contractTx, contract, make([]byte, contracts.SecretSize))
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("wrong secret: expected Invalid error, got %v", err)
+	}
+	_, err = w.RefundContract(ctx, contractTx, contract)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("refund before lock time: expected Invalid error, got %v", err)
+	}
+	redeemTx, err := w.RedeemContract(ctx, contractTx, contract, secret[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := redeemTx.TxOut[0]
+	if out.CoinType != 1 || out.SKAValue.Cmp(big.NewInt(5e8)) >= 0 {
+		t.Errorf("unexpected redeem output %+v", out)
+	}
+	revealed, err := contracts.ExtractSecret(redeemTx, &secretHash)
+	if err != nil || !bytes.Equal(revealed, secret[:]) {
+		t.Errorf("redeem reveals secret %x, %v", revealed, err)
+	}
+}
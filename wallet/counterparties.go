@@ -0,0 +1,294 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// TagCounterparty tags external addresses as belonging to a named
+// counterparty, such as an exchange or mining pool.  Addresses previously
+// tagged with another counterparty are retagged.  Addresses owned by the
+// wallet can not be tagged.
+func (w *Wallet) TagCounterparty(ctx context.Context, name string, addrs []stdaddr.Address) error {
+	const op errors.Op = "wallet.TagCounterparty"
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return w.tagCounterparty(dbtx, name, addrs)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// tagCounterparty records the counterparty tags of addrs in dbtx, refusing
+// any address owned by the wallet.
+func (w *Wallet) tagCounterparty(dbtx walletdb.ReadWriteTx, name string, addrs []stdaddr.Address) error {
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	for _, a := range addrs {
+		_, err := w.manager.Address(addrmgrNs, a)
+		if err == nil {
+			return errors.E(errors.Invalid,
+				errors.Errorf("address %v is owned by the wallet", a))
+		}
+		if !errors.Is(err, errors.NotExist) {
+			return err
+		}
+		err = udb.PutCounterpartyAddr(dbtx, a.String(), name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UntagCounterparty removes the counterparty tags of addresses.
+func (w *Wallet) UntagCounterparty(ctx context.Context, addrs []stdaddr.Address) error {
+	const op errors.Op = "wallet.UntagCounterparty"
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		for _, a := range addrs {
+			err := udb.DeleteCounterpartyAddr(dbtx, a.String())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// Counterparties returns every tagged address grouped by counterparty name.
+// The result is suitable for exporting and later passing to
+// ImportCounterparties.
+func (w *Wallet) Counterparties(ctx context.Context) (map[string][]string, error) {
+	const op errors.Op = "wallet.Counterparties"
+
+	tags := make(map[string][]string)
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachCounterpartyAddr(dbtx, func(addr, name string) error {
+			tags[name] = append(tags[name], addr)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	for _, addrs := range tags {
+		sort.Strings(addrs)
+	}
+	return tags, nil
+}
+
+// ImportCounterparties tags the addresses of an exported tag list, keyed by
+// counterparty name.  The import is atomic: either every address is tagged or,
+// if any address is invalid or owned by the wallet, none are.  It returns the
+// number of addresses tagged.
+func (w *Wallet) ImportCounterparties(ctx context.Context, tags map[string][]string) (int, error) {
+	const op errors.Op = "wallet.ImportCounterparties"
+
+	decoded := make(map[string][]stdaddr.Address, len(tags))
+	n := 0
+	for name, addrs := range tags {
+		for _, s := range addrs {
+			a, err := stdaddr.DecodeAddress(s, w.chainParams)
+			if err != nil {
+				return 0, errors.E(op, errors.Invalid, err)
+			}
+			decoded[name] = append(decoded[name], a)
+			n++
+		}
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		for name, addrs := range decoded {
+			err := w.tagCounterparty(dbtx, name, addrs)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return n, nil
+}
+
+// CounterpartyActivity summarizes the value exchanged with a counterparty in
+// a single coin type.  Sent is the value of outputs paying a tagged address
+// in transactions funded by the wallet.  Received is the value credited to
+// the wallet by transactions spending inputs from a tagged address and none
+// of the wallet's own outputs; transactions which the wallet helped fund,
+// such as coinjoins, are not counted as received.
+type CounterpartyActivity struct {
+	Counterparty string
+	CoinType     cointype.CoinType
+	Sent         *big.Int
+	Received     *big.Int
+	Transactions int
+}
+
+// CounterpartyActivity aggregates the wallet's transaction history by tagged
+// counterparty.  If name is non-empty, only activity with that counterparty
+// is reported.
+func (w *Wallet) CounterpartyActivity(ctx context.Context, name string) ([]*CounterpartyActivity, error) {
+	const op errors.Op = "wallet.CounterpartyActivity"
+
+	type key struct {
+		name     string
+		coinType cointype.CoinType
+	}
+	activity := make(map[key]*CounterpartyActivity)
+	get := func(name string, ct cointype.CoinType) *CounterpartyActivity {
+		k := key{name, ct}
+		a, ok := activity[k]
+		if !ok {
+			a = &CounterpartyActivity{
+				Counterparty: name,
+				CoinType:     ct,
+				Sent:         new(big.Int),
+				Received:     new(big.Int),
+			}
+			activity[k] = a
+		}
+		return a
+	}
+
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		lookup := func(a stdaddr.Address) string {
+			cp := udb.CounterpartyForAddr(dbtx, a.String())
+			if name != "" && cp != name {
+				return ""
+			}
+			return cp
+		}
+
+		rangeFn := func(details []udb.TxDetails) (bool, error) {
+			for i := range details {
+				d := &details[i]
+				touched := make(map[*CounterpartyActivity]struct{})
+
+				if len(d.Debits) != 0 {
+					credits := make(map[uint32]struct{}, len(d.Credits))
+					for _, c := range d.Credits {
+						credits[c.Index] = struct{}{}
+					}
+					for j, out := range d.MsgTx.TxOut {
+						if _, ok := credits[uint32(j)]; ok {
+							continue
+						}
+						_, addrs := stdscript.ExtractAddrs(out.Version,
+							out.PkScript, w.chainParams)
+						if len(addrs) != 1 {
+							continue
+						}
+						cp := lookup(addrs[0])
+						if cp == "" {
+							continue
+						}
+						a := get(cp, out.CoinType)
+						a.Sent.Add(a.Sent, outputValue(out))
+						touched[a] = struct{}{}
+					}
+				}
+
+				var funder string
+				if len(d.Debits) == 0 {
+					for _, in := range d.MsgTx.TxIn {
+						addr := inputAddress(in, w.chainParams)
+						if addr == nil {
+							continue
+						}
+						if funder = lookup(addr); funder != "" {
+							break
+						}
+					}
+				}
+				if funder != "" {
+					for _, c := range d.Credits {
+						if c.Change {
+							continue
+						}
+						a := get(funder, c.CoinType)
+						if c.CoinType.IsSKA() {
+							a.Received.Add(a.Received, c.SKAAmount.BigInt())
+						} else {
+							a.Received.Add(a.Received, big.NewInt(int64(c.Amount)))
+						}
+						touched[a] = struct{}{}
+					}
+				}
+
+				for a := range touched {
+					a.Transactions++
+				}
+			}
+			return false, nil
+		}
+		return w.txStore.RangeTransactions(ctx, txmgrNs, 0, -1, rangeFn)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	res := make([]*CounterpartyActivity, 0, len(activity))
+	for _, a := range activity {
+		res = append(res, a)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Counterparty != res[j].Counterparty {
+			return res[i].Counterparty < res[j].Counterparty
+		}
+		return res[i].CoinType < res[j].CoinType
+	})
+	return res, nil
+}
+
+// outputValue returns the value of an output in atoms of its coin type.
+func outputValue(out *wire.TxOut) *big.Int {
+	if out.CoinType.IsSKA() && out.SKAValue != nil {
+		return new(big.Int).Set(out.SKAValue)
+	}
+	return big.NewInt(out.Value)
+}
+
+// inputAddress returns the P2PKH address which an input's signature script
+// redeems, determined from the public key pushed by the script.  Nil is
+// returned for inputs of any other form.
+func inputAddress(in *wire.TxIn, params *chaincfg.Params) stdaddr.Address {
+	var pubKey []byte
+	tokenizer := txscript.MakeScriptTokenizer(scriptVersionAssumed, in.SignatureScript)
+	for tokenizer.Next() {
+		pubKey = tokenizer.Data()
+	}
+	if tokenizer.Err() != nil || len(pubKey) != secp256k1.PubKeyBytesLenCompressed {
+		return nil
+	}
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		dcrutil.Hash160(pubKey), params)
+	if err != nil {
+		return nil
+	}
+	return addr
+}
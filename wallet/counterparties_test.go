@@ -0,0 +1,291 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+func TestCounterpartyTags(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	external := func(b byte) stdaddr.Address {
+		hash := make([]byte, 20)
+		hash[0] = b
+		a, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(hash, cfg.Params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return a
+	}
+	exchange := []stdaddr.Address{external(1), external(2)}
+	pool := []stdaddr.Address{external(3)}
+
+	if err := w.TagCounterparty(ctx, "exchange", exchange); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.TagCounterparty(ctx, "pool", pool); err != nil {
+		t.Fatal(err)
+	}
+
+	owned, err := w.NewExternalAddress(ctx, defaultAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.TagCounterparty(ctx, "exchange", []stdaddr.Address{owned})
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("tagging a wallet address: expected Invalid error, got %v", err)
+	}
+
+	tags, err := w.Counterparties(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]string{
+		"exchange": {exchange[0].String(), exchange[1].String()},
+		"pool":     {pool[0].String()},
+	}
+	if !reflect.DeepEqual(tags, want) {
+		t.Fatalf("exported tags %v, want %v", tags, want)
+	}
+
+	// Untagging and reimporting the export restores the same tags.
+	if err := w.UntagCounterparty(ctx, append(exchange, pool...)); err != nil {
+		t.Fatal(err)
+	}
+	tags, err = w.Counterparties(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 0 {
+		t.Fatalf("tags remain after untagging: %v", tags)
+	}
+	n, err := w.ImportCounterparties(ctx, want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Fatalf("imported %d tags, want 3", n)
+	}
+	tags, err = w.Counterparties(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tags, want) {
+		t.Fatalf("imported tags %v, want %v", tags, want)
+	}
+
+	_, err = w.ImportCounterparties(ctx, map[string][]string{"bad": {"notanaddress"}})
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("importing invalid address: expected Invalid error, got %v", err)
+	}
+}
+
+func TestCounterpartyImportAtomic(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	hash := make([]byte, 20)
+	hash[0] = 1
+	external, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(hash, cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	owned, err := w.NewExternalAddress(ctx, defaultAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An owned address anywhere in the import aborts the whole import.
+	_, err = w.ImportCounterparties(ctx, map[string][]string{
+		"exchange": {external.String()},
+		"pool":     {owned.String()},
+	})
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("importing owned address: expected Invalid error, got %v", err)
+	}
+	tags, err := w.Counterparties(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 0 {
+		t.Fatalf("failed import recorded tags %v", tags)
+	}
+}
+
+// counterpartyKey returns a public key and the P2PKH address it redeems.
+func counterpartyKey(t *testing.T, b byte, w *Wallet) ([]byte, stdaddr.Address) {
+	t.Helper()
+	priv := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{b}, 32))
+	pubKey := priv.PubKey().SerializeCompressed()
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		dcrutil.Hash160(pubKey), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pubKey, addr
+}
+
+// redeemInput returns an input spending prevOut with a P2PKH signature
+// script pushing pubKey.
+func redeemInput(t *testing.T, prevOut *wire.OutPoint, value int64, pubKey []byte) *wire.TxIn {
+	t.Helper()
+	sigScript, err := txscript.NewScriptBuilder().
+		AddData(make([]byte, 71)).
+		AddData(pubKey).
+		Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return wire.NewTxIn(prevOut, value, sigScript)
+}
+
+// payTo returns an output paying value atoms of coinType to addr.
+func payTo(addr stdaddr.Address, coinType cointype.CoinType, value *big.Int) *wire.TxOut {
+	vers, script := addr.PaymentScript()
+	out := &wire.TxOut{Version: vers, PkScript: script, CoinType: coinType}
+	if coinType.IsSKA() {
+		out.SKAValue = value
+	} else {
+		out.Value = value.Int64()
+	}
+	return out
+}
+
+func TestCounterpartyActivity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	exchangeKey, exchange := counterpartyKey(t, 1, w)
+	_, pool := counterpartyKey(t, 2, w)
+	if err := w.TagCounterparty(ctx, "exchange", []stdaddr.Address{exchange}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.TagCounterparty(ctx, "pool", []stdaddr.Address{pool}); err != nil {
+		t.Fatal(err)
+	}
+	recv, err := w.NewExternalAddress(ctx, defaultAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	change, err := w.NewInternalAddress(ctx, defaultAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	add := func(tx *wire.MsgTx) chainhash.Hash {
+		t.Helper()
+		if err := w.AddTransaction(ctx, tx, nil); err != nil {
+			t.Fatal(err)
+		}
+		return tx.TxHash()
+	}
+
+	// The exchange pays the wallet VAR and an SKA amount exceeding the
+	// range of int64.  The P2PKH input identifies the exchange.
+	skaValue, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	received := wire.NewMsgTx()
+	received.AddTxIn(redeemInput(t, &wire.OutPoint{Hash: chainhash.Hash{1}}, 3e8, exchangeKey))
+	received.AddTxOut(payTo(recv, cointype.CoinTypeVAR, big.NewInt(2e8)))
+	received.AddTxOut(payTo(recv, 1, skaValue))
+	receivedHash := add(received)
+
+	// The wallet pays the pool from the received VAR and keeps change.
+	sent := wire.NewMsgTx()
+	sent.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&receivedHash, 0, wire.TxTreeRegular), 2e8, nil))
+	sent.AddTxOut(payTo(pool, cointype.CoinTypeVAR, big.NewInt(5e7)))
+	sent.AddTxOut(payTo(change, cointype.CoinTypeVAR, big.NewInt(149990000)))
+	sentHash := add(sent)
+
+	// A transaction spending both wallet and exchange inputs, as in a
+	// coinjoin, is not received from the exchange.
+	joined := wire.NewMsgTx()
+	joined.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&sentHash, 1, wire.TxTreeRegular), 149990000, nil))
+	joined.AddTxIn(redeemInput(t, &wire.OutPoint{Hash: chainhash.Hash{2}}, 1e8, exchangeKey))
+	joined.AddTxOut(payTo(recv, cointype.CoinTypeVAR, big.NewInt(249980000)))
+	joinedHash := add(joined)
+
+	activity, err := w.CounterpartyActivity(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type summary struct {
+		name           string
+		coinType       cointype.CoinType
+		sent, received string
+		txs            int
+	}
+	want := []summary{
+		{"exchange", cointype.CoinTypeVAR, "0", "200000000", 1},
+		{"exchange", 1, "0", skaValue.String(), 1},
+		{"pool", cointype.CoinTypeVAR, "50000000", "0", 1},
+	}
+	var got []summary
+	for _, a := range activity {
+		got = append(got, summary{a.Counterparty, a.CoinType,
+			a.Sent.String(), a.Received.String(), a.Transactions})
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("activity %+v, want %+v", got, want)
+	}
+
+	activity, err = w.CounterpartyActivity(ctx, "pool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(activity) != 1 || activity[0].Counterparty != "pool" {
+		t.Fatalf("activity filtered by name reported %+v", activity)
+	}
+
+	// Listed transactions report the same counterparties.
+	txs, err := w.ListTransactions(ctx, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCP := map[chainhash.Hash]map[string]string{
+		receivedHash: {"receive": "exchange"},
+		sentHash:     {"send": "pool"},
+		joinedHash:   {"receive": ""},
+	}
+	for _, tx := range txs {
+		hash, err := chainhash.NewHashFromStr(tx.TxID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cp, ok := wantCP[*hash][tx.Category]
+		if !ok {
+			continue
+		}
+		if tx.Counterparty != cp {
+			t.Errorf("%v %s: counterparty %q, want %q", hash,
+				tx.Category, tx.Counterparty, cp)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"math"
 	"sort"
 	"time"
 
@@ -54,6 +55,7 @@ const (
 		txscript.ScriptVerifyCleanStack |
 		txscript.ScriptVerifyCheckLockTimeVerify |
 		txscript.ScriptVerifyCheckSequenceVerify |
+		txscript.ScriptVerifySHA256 |
 		txscript.ScriptVerifyTreasury
 )
 
@@ -94,8 +96,15 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 
 	const op errors.Op = "wallet.NewUnsignedTransaction"
 
+	for _, out := range outputs {
+		if stake.IsSSFeeMarkerScript(out.PkScript) {
+			return nil, errors.E(op, errors.Invalid,
+				"transaction output is an SSFee marker")
+		}
+	}
+
 	defer w.lockedOutpointMu.Unlock()
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 
 	var authoredTx *txauthor.AuthoredTx
 	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
@@ -127,15 +136,30 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 			}
 			inputSourceObj := w.txStore.MakeInputSourceWithCoinType(dbtx, account,
 				minConf, tipHeight, ignoreInput, txCoinType)
+			if w.AvoidPartialSpends() {
+				all := w.txStore.MakeInputSourceWithCoinType(dbtx, account,
+					minConf, tipHeight, ignoreInput, txCoinType)
+				inputSourceObj = udb.GroupInputsByAddress(inputSourceObj, all)
+			}
 			inputSource = inputSourceObj.SelectInputs
 		}
 
 		if changeSource == nil {
+			changeAccount, err := w.changeAccountFor(dbtx, account,
+				txCoinType, account)
+			if err != nil {
+				return err
+			}
+			scriptType, err := w.changeScriptType(dbtx, changeAccount)
+			if err != nil {
+				return err
+			}
 			changeSource = &p2PKHChangeSource{
-				persist: w.deferPersistReturnedChild(ctx, &changeSourceUpdates),
-				account: account,
-				wallet:  w,
-				ctx:     context.Background(),
+				persist:    w.deferPersistReturnedChild(ctx, &changeSourceUpdates),
+				account:    changeAccount,
+				wallet:     w,
+				ctx:        context.Background(),
+				scriptType: scriptType,
 			}
 		}
 
@@ -206,6 +230,268 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 	return authoredTx, nil
 }
 
+// FundedTransaction describes a transaction funded by FundTransaction.  The fee
+// is in atoms of the transaction's coin type.
+type FundedTransaction struct {
+	*txauthor.AuthoredTx
+	CoinType cointype.CoinType
+	Fee      dcrutil.Amount
+}
+
+// FundTransaction funds a partially-built transaction by selecting additional
+// unspent outputs of an account to pay its outputs and fee, and adding change
+// when it is not dust.  The coin type of the transaction is detected from its
+// outputs, and every output, and every input already spent by the transaction,
+// must be of this coin type.  Existing inputs must spend wallet outputs, and
+// are spent first by the funded transaction.  The version, lock time and
+// expiry of the transaction are preserved, while tx itself is not modified.
+//
+// A zero relayFeePerKb uses the wallet's relay fee for the coin type.  The
+// changeSource is optional; when nil, change is paid to an internal address
+// of the account's change account.
+func (w *Wallet) FundTransaction(ctx context.Context, tx *wire.MsgTx,
+	relayFeePerKb dcrutil.Amount, account uint32, minConf int32,
+	changeSource txauthor.ChangeSource) (*FundedTransaction, error) {
+
+	const op errors.Op = "wallet.FundTransaction"
+
+	if len(tx.TxOut) == 0 {
+		return nil, errors.E(op, errors.Invalid, "transaction has no outputs")
+	}
+	coinType := tx.TxOut[0].CoinType
+	for i, out := range tx.TxOut {
+		if out.CoinType != coinType {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("output %d "+
+				"coin type %d does not match coin type %d", i, out.CoinType, coinType))
+		}
+	}
+
+	defer w.lockedOutpointMu.Unlock()
+	w.lockOutpoints(ctx)
+
+	var funded *FundedTransaction
+	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		if account != udb.ImportedAddrAccount {
+			lastAcct, err := w.manager.LastAccount(addrmgrNs)
+			if err != nil {
+				return err
+			}
+			if account > lastAcct {
+				return errors.E(errors.NotExist, "missing account")
+			}
+		}
+
+		// Describe the existing inputs from the wallet outputs they
+		// spend, so their value and signature script sizes are counted
+		// when selecting additional inputs.
+		existing := &txauthor.InputDetail{}
+		spent := make(map[outpoint]struct{}, len(tx.TxIn))
+		for i, txIn := range tx.TxIn {
+			prevOut := txIn.PreviousOutPoint
+			credit, err := w.txStore.UnspentOutput(txmgrNs, prevOut, true)
+			if errors.Is(err, errors.NotExist) {
+				return errors.E(errors.Invalid, errors.Errorf("input %d "+
+					"does not spend an unspent wallet output", i))
+			}
+			if err != nil {
+				return err
+			}
+			if credit.CoinType != coinType {
+				return errors.E(errors.Invalid, errors.Errorf("input %d "+
+					"coin type %d does not match coin type %d", i,
+					credit.CoinType, coinType))
+			}
+			scriptClass := stdscript.DetermineScriptType(scriptVersionAssumed,
+				credit.PkScript)
+			scriptSubClass, _ := txrules.StakeSubScriptType(scriptClass)
+			scriptSize := txsizes.RedeemSigScriptSize(scriptSubClass)
+			if scriptSize == 0 {
+				return errors.E(errors.Invalid, errors.Errorf("input %d "+
+					"spends an output with unsupported script type %v",
+					i, scriptClass))
+			}
+
+			in := *txIn
+			if coinType.IsSKA() {
+				in.ValueIn = 0
+				in.SKAValueIn = credit.SKAAmount.BigInt()
+				existing.SKAAmount = existing.SKAAmount.Add(credit.SKAAmount)
+			} else {
+				in.ValueIn = int64(credit.Amount)
+				existing.Amount += credit.Amount
+			}
+			existing.Inputs = append(existing.Inputs, &in)
+			existing.Scripts = append(existing.Scripts, credit.PkScript)
+			existing.RedeemScriptSizes = append(existing.RedeemScriptSizes, scriptSize)
+			spent[outpoint{prevOut.Hash, prevOut.Index}] = struct{}{}
+		}
+
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+		ignoreInput := func(op *wire.OutPoint) bool {
+			k := outpoint{op.Hash, op.Index}
+			if _, ok := spent[k]; ok {
+				return true
+			}
+			_, ok := w.lockedOutpoints[k]
+			return ok
+		}
+		inputSource := w.txStore.MakeInputSourceWithCoinType(dbtx, account,
+			minConf, tipHeight, ignoreInput, coinType)
+		if w.AvoidPartialSpends() {
+			all := w.txStore.MakeInputSourceWithCoinType(dbtx, account,
+				minConf, tipHeight, ignoreInput, coinType)
+			inputSource = udb.GroupInputsByAddress(inputSource, all)
+		}
+
+		if changeSource == nil {
+			changeAccount, err := w.changeAccountFor(dbtx, account,
+				coinType, account)
+			if err != nil {
+				return err
+			}
+			scriptType, err := w.changeScriptType(dbtx, changeAccount)
+			if err != nil {
+				return err
+			}
+			changeSource = &p2PKHChangeSource{
+				persist:    w.deferPersistReturnedChild(ctx, &changeSourceUpdates),
+				account:    changeAccount,
+				wallet:     w,
+				ctx:        context.Background(),
+				scriptType: scriptType,
+			}
+		}
+
+		if relayFeePerKb == 0 {
+			relayFeePerKb = w.RelayFeeForCoinType(ctx, coinType)
+		}
+		atx, err := txauthor.NewUnsignedTransactionWithInputs(tx.TxOut, existing,
+			relayFeePerKb, inputSource.SelectInputs, changeSource,
+			w.chainParams.MaxTxSize)
+		if err != nil {
+			return err
+		}
+		if atx.ChangeIndex >= 0 {
+			atx.Tx.TxOut[atx.ChangeIndex].CoinType = coinType
+		}
+		atx.Tx.Version = tx.Version
+		atx.Tx.LockTime = tx.LockTime
+		atx.Tx.Expiry = tx.Expiry
+
+		funded = &FundedTransaction{
+			AuthoredTx: atx,
+			CoinType:   coinType,
+			Fee:        authoredTxFee(atx, coinType),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(changeSourceUpdates) != 0 {
+		err := walletdb.Update(ctx, w.db, func(tx walletdb.ReadWriteTx) error {
+			for _, up := range changeSourceUpdates {
+				err := up(tx)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	return funded, nil
+}
+
+// authoredTxFee returns the fee of an authored transaction of a coin type, which
+// is the input value not paid to outputs.
+func authoredTxFee(atx *txauthor.AuthoredTx, coinType cointype.CoinType) dcrutil.Amount {
+	if coinType.IsSKA() {
+		paid := cointype.Zero()
+		for _, out := range atx.Tx.TxOut {
+			if out.SKAValue != nil {
+				paid = paid.Add(cointype.NewSKAAmount(out.SKAValue))
+			}
+		}
+		return dcrutil.Amount(atx.SKATotalInput.Sub(paid).BigInt().Int64())
+	}
+	fee := atx.TotalInput
+	for _, out := range atx.Tx.TxOut {
+		fee -= dcrutil.Amount(out.Value)
+	}
+	return fee
+}
+
+// SendFeeEstimate describes an unsigned transaction authored by
+// EstimateSendFee.  The fee is in atoms of the transaction's coin type, and
+// FeeRate is the fee rate per kB used to author the transaction.
+type SendFeeEstimate struct {
+	*txauthor.AuthoredTx
+	CoinType cointype.CoinType
+	Fee      dcrutil.Amount
+	FeeRate  dcrutil.Amount
+}
+
+// EstimateSendFee authors the transaction that SendOutputsWithOptions would
+// create for the outputs, selecting inputs and deciding on change in the same
+// way, but never signs, records or publishes it.  No change address is
+// derived; change pays a placeholder script of the same size as the script of
+// a change address.  The change output is neither split, as configured by
+// SetChangeSplit, nor moved to a random position, so estimates of the same
+// send from the same outputs are identical.  Any lock times of opts are
+// validated, while its label is ignored.
+func (w *Wallet) EstimateSendFee(ctx context.Context, outputs []*wire.TxOut,
+	account, changeAccount uint32, minconf int32, opts *SendOptions) (*SendFeeEstimate, error) {
+
+	const op errors.Op = "wallet.EstimateSendFee"
+
+	if len(outputs) == 0 {
+		return nil, errors.E(op, errors.Invalid, "no outputs")
+	}
+	coinType := txrules.GetCoinTypeFromOutputs(outputs)
+	feeRate := w.RelayFeeForCoinType(ctx, coinType)
+	if opts != nil && opts.FeeRate != 0 {
+		feeRate = opts.FeeRate
+	}
+	for _, output := range outputs {
+		err := txrules.CheckOutput(output, feeRate)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	a := &authorTx{
+		outputs:       outputs,
+		account:       account,
+		changeAccount: changeAccount,
+		minconf:       minconf,
+		txFee:         feeRate,
+		dontSignTx:    true,
+		dryRun:        true,
+		callerFeeRate: true,
+	}
+	if opts != nil {
+		a.subtractFeeFrom = opts.SubtractFeeFrom
+		a.lockTimes = opts.LockTimes
+	}
+	err := w.authorTx(ctx, op, a)
+	if err != nil {
+		return nil, err
+	}
+	return &SendFeeEstimate{
+		AuthoredTx: a.atx,
+		CoinType:   coinType,
+		Fee:        authoredTxFee(a.atx, coinType),
+		FeeRate:    feeRate,
+	}, nil
+}
+
 // secretSource is an implementation of txauthor.SecretSource for the wallet's
 // address manager.
 type secretSource struct {
@@ -295,6 +581,8 @@ type CreatedTx struct {
 // insertIntoTxMgr inserts a newly created transaction into the tx store
 // as unconfirmed.
 func (w *Wallet) insertIntoTxMgr(dbtx walletdb.ReadWriteTx, msgTx *wire.MsgTx) (*udb.TxRecord, error) {
+	w.utxoCache.invalidate()
+
 	// Create transaction record and insert into the db.
 	rec, err := udb.NewTxRecordFromMsgTx(msgTx, time.Now())
 	if err != nil {
@@ -352,6 +640,8 @@ func (w *Wallet) insertCreditsIntoTxMgr(op errors.Op, dbtx walletdb.ReadWriteTx,
 // insertMultisigOutIntoTxMgr inserts a multisignature output into the
 // transaction store database.
 func (w *Wallet) insertMultisigOutIntoTxMgr(dbtx walletdb.ReadWriteTx, msgTx *wire.MsgTx, index uint32) error {
+	w.utxoCache.invalidate()
+
 	// Create transaction record and insert into the db.
 	rec, err := udb.NewTxRecordFromMsgTx(msgTx, time.Now())
 	if err != nil {
@@ -378,6 +668,10 @@ func (w *Wallet) checkHighFees(totalInput dcrutil.Amount, tx *wire.MsgTx) error
 func (w *Wallet) publishAndWatch(ctx context.Context, op errors.Op, n NetworkBackend, tx *wire.MsgTx,
 	watch []wire.OutPoint) error {
 
+	// Recorded transactions are published even while draining.
+	w.ops.Begin()
+	defer w.ops.End()
+
 	if n == nil {
 		var err error
 		n, err = w.NetworkBackend()
@@ -386,31 +680,87 @@ func (w *Wallet) publishAndWatch(ctx context.Context, op errors.Op, n NetworkBac
 		}
 	}
 
+	hash := tx.TxHash()
 	err := n.PublishTransactions(ctx, tx)
 	if err != nil {
-		hash := tx.TxHash()
-		log.Errorf("Abandoning transaction %v which failed to publish", &hash)
+		logCtx(ctx).Errorf("Abandoning transaction %v which failed to publish", &hash)
 		if err := w.AbandonTransaction(ctx, &hash); err != nil {
-			log.Errorf("Cannot abandon %v: %v", &hash, err)
+			logCtx(ctx).Errorf("Cannot abandon %v: %v", &hash, err)
 		}
 		return errors.E(op, err)
 	}
+	w.tracePublished(ctx, &hash)
+	w.queueRebroadcast(ctx, &hash)
+	w.noteActivity()
 
 	// Watch for future relevant transactions.
 	_, err = w.watchHDAddrs(ctx, false, n)
 	if err != nil {
-		log.Errorf("Failed to watch for future address usage after publishing "+
+		logCtx(ctx).Errorf("Failed to watch for future address usage after publishing "+
 			"transaction: %v", err)
 	}
 	if len(watch) > 0 {
 		err := n.LoadTxFilter(ctx, false, nil, watch)
 		if err != nil {
-			log.Errorf("Failed to watch outpoints: %v", err)
+			logCtx(ctx).Errorf("Failed to watch outpoints: %v", err)
 		}
 	}
 	return nil
 }
 
+// TxLockTimes specifies the expiry and lock time of authored transactions.
+// The zero value creates transactions which never expire and are final.
+type TxLockTimes struct {
+	// Expiry is the block height at which the transaction expires and may
+	// no longer be mined.  It must be above the next block height.
+	Expiry uint32
+
+	// ExpireAfter, when non-zero, sets the expiry so the transaction may
+	// only be mined in the next ExpireAfter blocks.  It may not be combined
+	// with Expiry.
+	ExpireAfter uint32
+
+	// LockTime is the block height, or the unix time when at least
+	// txscript.LockTimeThreshold, which must be reached before the
+	// transaction may be mined.  Because transactions are published when
+	// they are created, it may not be after the current tip.
+	LockTime uint32
+}
+
+// resolve returns the expiry height and lock time of a transaction created
+// when the main chain tip is at tipHeight.
+func (l *TxLockTimes) resolve(tipHeight int32, now time.Time) (expiry, lockTime uint32, err error) {
+	nextHeight := uint32(tipHeight) + 1
+	switch {
+	case l.Expiry != 0 && l.ExpireAfter != 0:
+		return 0, 0, errors.E(errors.Invalid, "expiry and expire-after are mutually exclusive")
+	case l.ExpireAfter != 0:
+		if l.ExpireAfter > math.MaxUint32-nextHeight {
+			return 0, 0, errors.E(errors.Invalid, "expire-after out of range")
+		}
+		expiry = nextHeight + l.ExpireAfter
+	case l.Expiry != 0:
+		if l.Expiry <= nextHeight {
+			return 0, 0, errors.E(errors.Invalid, "expiry height must be above next block height")
+		}
+		expiry = l.Expiry
+	}
+
+	lockTime = l.LockTime
+	if lockTime < txscript.LockTimeThreshold {
+		if lockTime > uint32(tipHeight) {
+			return 0, 0, errors.E(errors.Invalid, errors.Errorf("lock time "+
+				"height %d is after the current tip height %d", lockTime, tipHeight))
+		}
+		if expiry != 0 && lockTime >= expiry {
+			return 0, 0, errors.E(errors.Invalid, "lock time height must be below the expiry height")
+		}
+	} else if int64(lockTime) > now.Unix() {
+		return 0, 0, errors.E(errors.Invalid, "lock time is in the future")
+	}
+	return expiry, lockTime, nil
+}
+
 type authorTx struct {
 	outputs            []*wire.TxOut
 	account            uint32
@@ -420,10 +770,20 @@ type authorTx struct {
 	txFee              dcrutil.Amount
 	dontSignTx         bool
 	isTreasury         bool
+	sweep              bool  // spend every input to the only output, without change
+	subtractFeeFrom    []int // indexes of outputs paying the fee
+	lockTimes          TxLockTimes
+	feeRewardsOnly     bool // only spend SSFee reward outputs
+	splitChange        bool // split change as set by SetChangeSplit
+	label              string
+	dryRun             bool // pay change to a placeholder script
+	callerFeeRate      bool // pay txFee rather than the wallet's fee rate
+	futureLockTime     bool // allow a lock time after the tip for unpublished transactions
 
 	atx                 *txauthor.AuthoredTx
 	changeSourceUpdates []func(walletdb.ReadWriteTx) error
 	watch               []wire.OutPoint
+	trace               *udb.TxTrace
 }
 
 // authorTx creates a (typically signed) transaction which includes each output
@@ -433,6 +793,11 @@ type authorTx struct {
 // wallet's current relay fee.  The wallet must be unlocked to create the
 // transaction.
 func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error {
+	if err := w.beginOp(op); err != nil {
+		return err
+	}
+	defer w.ops.End()
+
 	var unlockOutpoints []*wire.OutPoint
 	defer func() {
 		for _, op := range unlockOutpoints {
@@ -444,30 +809,87 @@ func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error
 		_, ok := w.lockedOutpoints[outpoint{op.Hash, op.Index}]
 		return ok
 	}
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 
 	var atx *txauthor.AuthoredTx
 	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
+	trace := newTxTrace()
+	externalSigner := w.ExternalSigner()
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 
 		// Create the unsigned transaction.
 		_, tipHeight := w.txStore.MainChainTip(dbtx)
 
+		lockTimes := a.lockTimes
+		if a.futureLockTime {
+			lockTimes.LockTime = 0
+		}
+		expiry, lockTime, err := lockTimes.resolve(tipHeight, time.Now())
+		if err != nil {
+			return err
+		}
+		if a.futureLockTime {
+			lockTime = a.lockTimes.LockTime
+		}
+
 		// Determine coin type from outputs for coin-type-aware UTXO selection
 		var inputSource udb.InputSource
 		if len(a.outputs) > 0 {
 			txCoinType := a.outputs[0].CoinType
+			ignoreInput := ignoreInput
+			if a.feeRewardsOnly {
+				rewards, err := w.txStore.UnspentSSFeeOutPoints(dbtx, txCoinType)
+				if err != nil {
+					return err
+				}
+				rewardSet := make(map[outpoint]struct{}, len(rewards))
+				for i := range rewards {
+					rewardSet[outpoint{rewards[i].Hash, rewards[i].Index}] = struct{}{}
+				}
+				locked := ignoreInput
+				ignoreInput = func(op *wire.OutPoint) bool {
+					_, ok := rewardSet[outpoint{op.Hash, op.Index}]
+					return !ok || locked(op)
+				}
+			}
 			inputSource = w.txStore.MakeInputSourceWithCoinType(dbtx, a.account,
 				a.minconf, tipHeight, ignoreInput, txCoinType)
+			if w.AvoidPartialSpends() && !a.sweep {
+				all := w.txStore.MakeInputSourceWithCoinType(dbtx, a.account,
+					a.minconf, tipHeight, ignoreInput, txCoinType)
+				inputSource = udb.GroupInputsByAddress(inputSource, all)
+			}
+		}
+
+		// Change is returned to the account it is redirected to, if any,
+		// so that it never mixes with the funds of the source account.
+		changeCoinType := cointype.CoinTypeVAR
+		if len(a.outputs) > 0 {
+			changeCoinType = a.outputs[0].CoinType
+		}
+		changeAccount, err := w.changeAccountFor(dbtx, a.account,
+			changeCoinType, a.changeAccount)
+		if err != nil {
+			return err
+		}
+		scriptType, err := w.changeScriptType(dbtx, changeAccount)
+		if err != nil {
+			return err
 		}
 
 		var changeSource txauthor.ChangeSource
-		if a.isTreasury {
+		if a.dryRun {
+			changeSource = &dryRunChangeSource{
+				treasury:   a.isTreasury,
+				scriptType: scriptType,
+				params:     w.chainParams,
+			}
+		} else if a.isTreasury {
 			changeSource = &p2PKHTreasuryChangeSource{
 				persist: w.deferPersistReturnedChild(ctx,
 					&changeSourceUpdates),
-				account: a.changeAccount,
+				account: changeAccount,
 				wallet:  w,
 				ctx:     ctx,
 			}
@@ -475,32 +897,59 @@ func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error
 			changeSource = &p2PKHChangeSource{
 				persist: w.deferPersistReturnedChild(ctx,
 					&changeSourceUpdates),
-				account:   a.changeAccount,
-				wallet:    w,
-				ctx:       ctx,
-				gapPolicy: gapPolicyWrap,
+				account:    changeAccount,
+				wallet:     w,
+				ctx:        ctx,
+				gapPolicy:  gapPolicyWrap,
+				scriptType: scriptType,
 			}
 		}
 
-		// Calculate relay fee based on transaction coin type
+		// Calculate relay fee based on transaction coin type.  Sweeps
+		// and sends at a fee rate chosen by the caller use that rate.
 		actualTxFee := a.txFee
-		if len(a.outputs) > 0 {
+		if len(a.outputs) > 0 && !a.sweep && !a.callerFeeRate {
 			actualTxFee = w.RelayFeeForCoinType(ctx, a.outputs[0].CoinType)
 		}
 
-		var err error
-		atx, err = txauthor.NewUnsignedTransaction(a.outputs, actualTxFee,
-			inputSource.SelectInputs, changeSource,
-			w.chainParams.MaxTxSize)
+		if a.sweep {
+			atx, err = txauthor.NewUnsignedSweepTransaction(a.outputs[0],
+				actualTxFee, inputSource.SelectInputs,
+				w.chainParams.MaxTxSize)
+		} else if len(a.subtractFeeFrom) != 0 {
+			atx, err = txauthor.NewUnsignedTransactionSubtractFee(a.outputs,
+				a.subtractFeeFrom, actualTxFee, inputSource.SelectInputs,
+				changeSource, w.chainParams.MaxTxSize)
+		} else {
+			atx, err = txauthor.NewUnsignedTransaction(a.outputs, actualTxFee,
+				inputSource.SelectInputs, changeSource,
+				w.chainParams.MaxTxSize)
+		}
 		if err != nil {
 			return err
 		}
+		if expiry != 0 || lockTime != 0 {
+			atx.SetLockTimes(expiry, lockTime)
+		}
+		trace.Events = append(trace.Events,
+			txTraceEvent(udb.TxTraceConstructed, -1))
 		for _, in := range atx.Tx.TxIn {
 			prev := &in.PreviousOutPoint
 			w.lockedOutpoints[outpoint{prev.Hash, prev.Index}] = struct{}{}
 			unlockOutpoints = append(unlockOutpoints, prev)
 		}
 
+		// Split change into multiple outputs when configured.  The
+		// additional outputs are inserted at random positions.
+		if count, dist := w.ChangeSplit(); count > 1 && a.splitChange &&
+			!a.isTreasury {
+			err = atx.SplitChange(int(count), dist, actualTxFee,
+				changeSource, w.chainParams.MaxTxSize)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Randomize change position, if change exists, before signing.
 		// This doesn't affect the serialize size, so the change amount
 		// will still be valid.
@@ -529,7 +978,7 @@ func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error
 			atx.Tx.Version = wire.TxVersionTreasury
 		}
 
-		if !a.dontSignTx {
+		if !a.dontSignTx && externalSigner == nil {
 			// Sign the transaction.
 			secrets := &secretSource{Manager: w.manager, addrmgrNs: addrmgrNs}
 			err = atx.AddAllInputScripts(secrets)
@@ -543,11 +992,20 @@ func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error
 		return errors.E(op, err)
 	}
 
+	// External signers, which may wait for the user to confirm the
+	// transaction, sign outside of the database transaction.
+	if !a.dontSignTx && externalSigner != nil {
+		err = atx.AddAllInputScripts(externalSigner)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+
 	// Warn when spending UTXOs controlled by imported keys created change for
 	// the default account.
 	if atx.ChangeIndex >= 0 && a.account == udb.ImportedAddrAccount {
 		changeAmount := dcrutil.Amount(atx.Tx.TxOut[atx.ChangeIndex].GetValue())
-		log.Warnf("Spend from imported account produced change: moving"+
+		logCtx(ctx).Warnf("Spend from imported account produced change: moving"+
 			" %v from imported account into default account.", changeAmount)
 	}
 
@@ -562,6 +1020,8 @@ func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error
 		if err != nil {
 			return errors.E(op, err)
 		}
+		trace.Events = append(trace.Events,
+			txTraceEvent(udb.TxTraceSigned, -1))
 	}
 
 	// Validate all outputs have the same coin type
@@ -577,6 +1037,7 @@ func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error
 
 	a.atx = atx
 	a.changeSourceUpdates = changeSourceUpdates
+	a.trace = trace
 	return nil
 }
 
@@ -587,12 +1048,16 @@ func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error
 // subscribed to new tx notifications will also be notified of the new
 // transaction.
 func (w *Wallet) recordAuthoredTx(ctx context.Context, op errors.Op, a *authorTx) error {
+	// Authored transactions are recorded even while draining.
+	w.ops.Begin()
+	defer w.ops.End()
+
 	rec, err := udb.NewTxRecordFromMsgTx(a.atx.Tx, time.Now())
 	if err != nil {
 		return errors.E(op, err)
 	}
 
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 	defer w.lockedOutpointMu.Unlock()
 
 	// To avoid a race between publishing a transaction and potentially opening
@@ -607,6 +1072,22 @@ func (w *Wallet) recordAuthoredTx(ctx context.Context, op errors.Op, a *authorTx
 			}
 		}
 
+		// Record the trace before the transaction so that notifications
+		// of the new transaction include its trace ID.
+		if a.trace != nil {
+			err := udb.PutTxTrace(dbtx, &rec.Hash, a.trace)
+			if err != nil {
+				return err
+			}
+		}
+
+		if a.label != "" {
+			err := udb.PutTxLabel(dbtx, &rec.Hash, a.label)
+			if err != nil {
+				return err
+			}
+		}
+
 		// TODO: this can be improved by not using the same codepath as notified
 		// relevant transactions, since this does a lot of extra work.
 		var err error
@@ -616,6 +1097,9 @@ func (w *Wallet) recordAuthoredTx(ctx context.Context, op errors.Op, a *authorTx
 	if err != nil {
 		return errors.E(op, err)
 	}
+	if a.trace != nil {
+		logCtx(ctx).Infof("Recorded transaction %v with trace ID %v", &rec.Hash, a.trace.ID)
+	}
 
 	a.watch = watch
 	return nil
@@ -627,7 +1111,7 @@ func (w *Wallet) txToMultisig(ctx context.Context, op errors.Op, account uint32,
 	nRequired int8, minconf int32, coinType cointype.CoinType) (*CreatedTx, stdaddr.Address, []byte, error) {
 
 	defer w.lockedOutpointMu.Unlock()
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 
 	var created *CreatedTx
 	var addr stdaddr.Address
@@ -739,6 +1223,22 @@ func (w *Wallet) txToMultisigInternal(ctx context.Context, op errors.Op, dbtx wa
 	}
 	vers, p2shScript := scAddr.PaymentScript()
 
+	changeAccount, err := w.changeAccountFor(dbtx, account, coinType, account)
+	if err != nil {
+		return txToMultisigError(errors.E(op, err))
+	}
+	scriptType, err := w.changeScriptType(dbtx, changeAccount)
+	if err != nil {
+		return txToMultisigError(errors.E(op, err))
+	}
+	changeSource := p2PKHChangeSource{
+		persist:    w.persistReturnedChild(ctx, dbtx),
+		account:    changeAccount,
+		wallet:     w,
+		ctx:        ctx,
+		scriptType: scriptType,
+	}
+
 	// Handle VAR and SKA separately to avoid int64 overflow
 	var feeSize int
 	if coinType.IsSKA() {
@@ -759,7 +1259,7 @@ func (w *Wallet) txToMultisigInternal(ctx context.Context, op errors.Op, dbtx wa
 		skaFeeEst := cointype.SKAAmountFromInt64(int64(feeEstForTx))
 		changeSize := 0
 		if totalSKAInput.Cmp(skaAmount.Add(skaFeeEst)) > 0 {
-			changeSize = txsizes.P2PKHPkScriptSize
+			changeSize = changeSource.ScriptSize()
 		}
 		feeSize = txsizes.EstimateSerializeSizeSKA(scriptSizes, msgtx.TxOut, changeSize)
 		feeEst := txrules.FeeForSerializeSize(w.RelayFeeForCoinType(ctx, coinType), feeSize)
@@ -773,13 +1273,6 @@ func (w *Wallet) txToMultisigInternal(ctx context.Context, op errors.Op, dbtx wa
 
 		// Add change if needed
 		if totalSKAInput.Cmp(required) > 0 {
-			changeSource := p2PKHChangeSource{
-				persist: w.persistReturnedChild(ctx, dbtx),
-				account: account,
-				wallet:  w,
-				ctx:     ctx,
-			}
-
 			pkScript, vers, err := changeSource.Script()
 			if err != nil {
 				return txToMultisigError(err)
@@ -806,7 +1299,7 @@ func (w *Wallet) txToMultisigInternal(ctx context.Context, op errors.Op, dbtx wa
 		// Add change if we need it.
 		changeSize := 0
 		if totalInput > amount+feeEstForTx {
-			changeSize = txsizes.P2PKHPkScriptSize
+			changeSize = changeSource.ScriptSize()
 		}
 		feeSize = txsizes.EstimateSerializeSize(scriptSizes, msgtx.TxOut, changeSize)
 		feeEst := txrules.FeeForSerializeSize(w.RelayFeeForCoinType(ctx, coinType), feeSize)
@@ -815,13 +1308,6 @@ func (w *Wallet) txToMultisigInternal(ctx context.Context, op errors.Op, dbtx wa
 			return txToMultisigError(errors.E(op, errors.InsufficientBalance))
 		}
 		if totalInput > amount+feeEst {
-			changeSource := p2PKHChangeSource{
-				persist: w.persistReturnedChild(ctx, dbtx),
-				account: account,
-				wallet:  w,
-				ctx:     ctx,
-			}
-
 			pkScript, vers, err := changeSource.Script()
 			if err != nil {
 				return txToMultisigError(err)
@@ -907,25 +1393,29 @@ func creditScripts(credits []Input) [][]byte {
 }
 
 // compressWallet compresses all the utxos in a wallet into a single change
-// address. For use when it becomes dusty.
-func (w *Wallet) compressWallet(ctx context.Context, op errors.Op, maxNumIns int, account uint32, changeAddr stdaddr.Address, coinType cointype.CoinType) (*chainhash.Hash, error) {
+// address. For use when it becomes dusty.  Consolidations which would exceed
+// the maximum transaction size are split into multiple chained transactions,
+// each spending the output of the previous one, and the hashes of all
+// transactions are returned in the order they are spent.
+func (w *Wallet) compressWallet(ctx context.Context, op errors.Op, maxNumIns int, account uint32, changeAddr stdaddr.Address, coinType cointype.CoinType) ([]*chainhash.Hash, error) {
 	defer w.lockedOutpointMu.Unlock()
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 
-	var hash *chainhash.Hash
+	var hashes []*chainhash.Hash
 	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
 		var err error
-		hash, err = w.compressWalletInternal(ctx, op, dbtx, maxNumIns, account, changeAddr, coinType)
+		hashes, err = w.compressWalletInternal(ctx, op, dbtx, maxNumIns, account, changeAddr, coinType)
 		return err
 	})
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
-	return hash, nil
+	consolidationRuns.Inc(coinType.String())
+	return hashes, nil
 }
 
 func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx walletdb.ReadWriteTx, maxNumIns int, account uint32,
-	changeAddr stdaddr.Address, coinType cointype.CoinType) (*chainhash.Hash, error) {
+	changeAddr stdaddr.Address, coinType cointype.CoinType) ([]*chainhash.Hash, error) {
 
 	addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
 
@@ -957,16 +1447,107 @@ func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx
 		}
 	}()
 
+	const accountName = "" // not used, so can be faked.
+	newChangeAddr := func() (stdaddr.Address, error) {
+		return w.newChangeAddress(ctx, op, w.persistReturnedChild(ctx, dbtx),
+			accountName, account, gapPolicyIgnore)
+	}
+
 	// Check if output address is default, and generate a new address if needed
 	if changeAddr == nil {
-		const accountName = "" // not used, so can be faked.
-		changeAddr, err = w.newChangeAddress(ctx, op, w.persistReturnedChild(ctx, dbtx),
-			accountName, account, gapPolicyIgnore)
+		changeAddr, err = newChangeAddr()
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	maximumTxSize := w.chainParams.MaxTxSize
+	if w.chainParams.Net == wire.MainNet {
+		maximumTxSize = maxStandardTxSize
+	}
+	feeRate := w.RelayFeeForCoinType(ctx, coinType)
+
+	if maxNumIns < 1 {
+		return nil, errors.E(op, errors.Invalid, "no inputs to consolidate")
+	}
+	unspent := eligible[:min(maxNumIns, len(eligible))]
+	var txs []*wire.MsgTx
+	for len(unspent) > 0 {
+		// Every transaction after the first spends the output of the
+		// previous transaction in the chain.
+		var forSigning []Input
+		if len(txs) != 0 {
+			prev := txs[len(txs)-1]
+			forSigning = append(forSigning, Input{
+				OutPoint: wire.OutPoint{Hash: prev.TxHash(), Index: 0, Tree: wire.TxTreeRegular},
+				PrevOut:  *prev.TxOut[0],
+				CoinType: coinType,
+			})
+		}
+
+		// Add as many inputs as allowed by the maximum transaction size.
+		txOuts := []*wire.TxOut{{
+			PkScript: make([]byte, txsizes.P2PKHPkScriptSize),
+			CoinType: coinType,
+		}}
+		for len(unspent) > 0 {
+			sz := estimateConsolidationSize(len(forSigning)+1, txOuts, coinType)
+			if len(forSigning) >= 2 && sz > maximumTxSize {
+				break
+			}
+			forSigning = append(forSigning, unspent[0])
+			unspent = unspent[1:]
+		}
+
+		// Only the final transaction pays the requested address.
+		// Outputs of the other transactions must be spendable by the
+		// wallet.
+		outputAddr := changeAddr
+		if len(unspent) > 0 {
+			outputAddr, err = newChangeAddr()
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+		}
+
+		msgtx, err := w.consolidationTx(op, addrmgrNs, forSigning, outputAddr,
+			coinType, feeRate)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, msgtx)
+	}
+
+	err = n.PublishTransactions(ctx, txs...)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// Insert the transactions and credits into the transaction manager.
+	hashes := make([]*chainhash.Hash, 0, len(txs))
+	for _, msgtx := range txs {
+		rec, err := w.insertIntoTxMgr(dbtx, msgtx)
 		if err != nil {
 			return nil, errors.E(op, err)
 		}
+		err = w.insertCreditsIntoTxMgr(op, dbtx, msgtx, rec)
+		if err != nil {
+			return nil, err
+		}
+
+		txHash := msgtx.TxHash()
+		logCtx(ctx).Infof("Successfully consolidated funds in transaction %v", &txHash)
+		hashes = append(hashes, &txHash)
 	}
-	vers, pkScript := changeAddr.PaymentScript()
+
+	return hashes, nil
+}
+
+// consolidationTx creates and signs a transaction spending all inputs to a
+// single output paying address, less the fee.
+func (w *Wallet) consolidationTx(op errors.Op, addrmgrNs walletdb.ReadBucket, inputs []Input,
+	address stdaddr.Address, coinType cointype.CoinType, feeRate dcrutil.Amount) (*wire.MsgTx, error) {
+
+	vers, pkScript := address.PaymentScript()
 	msgtx := wire.NewMsgTx()
 	msgtx.AddTxOut(&wire.TxOut{
 		Value:    0,
@@ -974,27 +1555,12 @@ func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx
 		Version:  vers,
 		CoinType: coinType,
 	})
-	maximumTxSize := w.chainParams.MaxTxSize
-	if w.chainParams.Net == wire.MainNet {
-		maximumTxSize = maxStandardTxSize
-	}
 
-	// Add the txins using all the eligible outputs.
+	// Add the txins using all the inputs.
 	// Track VAR and SKA totals separately to avoid int64 overflow for SKA
 	totalAddedVAR := dcrutil.Amount(0)
 	totalAddedSKA := cointype.Zero()
-	scriptSizes := make([]int, 0, maxNumIns)
-	forSigning := make([]Input, 0, maxNumIns)
-	count := 0
-	for _, e := range eligible {
-		if count >= maxNumIns {
-			break
-		}
-		// Add the size of a wire.OutPoint
-		if msgtx.SerializeSize() > maximumTxSize {
-			break
-		}
-
+	for _, e := range inputs {
 		txIn := wire.NewTxIn(&e.OutPoint, e.PrevOut.Value, nil)
 		// Set SKAValueIn for SKA inputs (needed for V13 wire format)
 		if e.PrevOut.CoinType.IsSKA() && e.PrevOut.SKAValue != nil {
@@ -1004,20 +1570,11 @@ func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx
 			totalAddedVAR += dcrutil.Amount(e.PrevOut.Value)
 		}
 		msgtx.AddTxIn(txIn)
-		forSigning = append(forSigning, e)
-		scriptSizes = append(scriptSizes, txsizes.RedeemP2PKHSigScriptSize)
-		count++
 	}
 
-	// Get an initial fee estimate based on the number of selected inputs
-	// and added outputs, with no change.
-	feeRate := w.RelayFeeForCoinType(ctx, coinType)
-	var szEst int
-	if coinType.IsSKA() {
-		szEst = txsizes.EstimateSerializeSizeSKA(scriptSizes, msgtx.TxOut, 0)
-	} else {
-		szEst = txsizes.EstimateSerializeSize(scriptSizes, msgtx.TxOut, 0)
-	}
+	// Get a fee estimate based on the number of inputs and the single
+	// output, with no change.
+	szEst := estimateConsolidationSize(len(inputs), msgtx.TxOut, coinType)
 	feeEst := txrules.FeeForSerializeSize(feeRate, szEst)
 
 	// Set output value based on coin type
@@ -1038,11 +1595,11 @@ func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx
 		}
 	}
 
-	err = w.signP2PKHMsgTx(msgtx, forSigning, addrmgrNs)
+	err := w.signP2PKHMsgTx(msgtx, inputs, addrmgrNs)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
-	err = validateMsgTx(op, msgtx, creditScripts(forSigning))
+	err = validateMsgTx(op, msgtx, creditScripts(inputs))
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -1055,25 +1612,157 @@ func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx
 		}
 	}
 
-	err = n.PublishTransactions(ctx, msgtx)
-	if err != nil {
-		return nil, errors.E(op, err)
+	return msgtx, nil
+}
+
+// estimateConsolidationSize estimates the serialize size of a consolidation
+// transaction redeeming n P2PKH inputs.
+func estimateConsolidationSize(n int, txOuts []*wire.TxOut, coinType cointype.CoinType) int {
+	scriptSizes := make([]int, n)
+	for i := range scriptSizes {
+		scriptSizes[i] = txsizes.RedeemP2PKHSigScriptSize
+	}
+	if coinType.IsSKA() {
+		return txsizes.EstimateSerializeSizeSKA(scriptSizes, txOuts, 0)
+	}
+	return txsizes.EstimateSerializeSize(scriptSizes, txOuts, 0)
+}
+
+// ConsolidationPlan describes the transactions required to consolidate the
+// eligible outputs of a coin type.
+type ConsolidationPlan struct {
+	CoinType     cointype.CoinType
+	Outputs      int            // Eligible outputs before consolidation
+	Transactions int            // Consolidation transactions required
+	Fee          dcrutil.Amount // Total fee, in atoms of the coin type
+	Remaining    int            // Outputs remaining after consolidation
+}
+
+// PlanConsolidation estimates the transactions required to consolidate all
+// eligible outputs of an account when each consolidation spends at most inputs
+// outputs, as with Consolidate.  No transactions are created or published.
+// Fees are estimated from the serialize size of each transaction at the relay
+// fee of its coin type.  Plans are returned for every active coin type when no
+// coin types are specified.
+func (w *Wallet) PlanConsolidation(ctx context.Context, inputs int, account uint32,
+	coinTypes ...cointype.CoinType) ([]ConsolidationPlan, error) {
+
+	const op errors.Op = "wallet.PlanConsolidation"
+
+	if inputs < 2 {
+		return nil, errors.E(op, errors.Invalid,
+			"consolidation requires at least two inputs")
+	}
+	if len(coinTypes) == 0 {
+		coinTypes = w.getActiveCoinTypes()
 	}
 
-	// Insert the transaction and credits into the transaction manager.
-	rec, err := w.insertIntoTxMgr(dbtx, msgtx)
+	eligible := make([][]Input, len(coinTypes))
+	w.lockOutpoints(ctx)
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+		for i, ct := range coinTypes {
+			var err error
+			eligible[i], err = w.findEligibleOutputs(dbtx, account, 1, tipHeight, ct)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	w.lockedOutpointMu.Unlock()
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
-	err = w.insertCreditsIntoTxMgr(op, dbtx, msgtx, rec)
-	if err != nil {
-		return nil, err
+
+	maximumTxSize := w.chainParams.MaxTxSize
+	if w.chainParams.Net == wire.MainNet {
+		maximumTxSize = maxStandardTxSize
+	}
+
+	plans := make([]ConsolidationPlan, len(coinTypes))
+	for i, ct := range coinTypes {
+		feeRate := w.RelayFeeForCoinType(ctx, ct)
+		plans[i] = planConsolidation(eligible[i], inputs, ct, feeRate, maximumTxSize)
+	}
+	return plans, nil
+}
+
+// planConsolidation plans consolidations of eligible outputs, in the order
+// they are spent by compressWallet, which each spend at most maxInputs outputs
+// to a single P2PKH output.  As with compressWallet, consolidations exceeding
+// maxTxSize are split into chained transactions.  Outputs of consolidations
+// unable to pay their fees remain unconsolidated.
+func planConsolidation(eligible []Input, maxInputs int, coinType cointype.CoinType,
+	feeRate dcrutil.Amount, maxTxSize int) ConsolidationPlan {
+
+	plan := ConsolidationPlan{
+		CoinType: coinType,
+		Outputs:  len(eligible),
 	}
 
-	txHash := msgtx.TxHash()
-	log.Infof("Successfully consolidated funds in transaction %v", &txHash)
+	txOuts := []*wire.TxOut{{
+		PkScript: make([]byte, txsizes.P2PKHPkScriptSize),
+		CoinType: coinType,
+	}}
+	for len(eligible) > 0 {
+		batch := eligible[:min(maxInputs, len(eligible))]
+		eligible = eligible[len(batch):]
+		if len(batch) < 2 {
+			plan.Remaining += len(batch)
+			continue
+		}
 
-	return &txHash, nil
+		// Every transaction after the first also spends the output of
+		// the previous transaction.
+		var txs int
+		var fee dcrutil.Amount
+		for unspent, chained := len(batch), 0; unspent > 0; chained = 1 {
+			n := chained + 1
+			unspent--
+			for unspent > 0 && (n < 2 ||
+				estimateConsolidationSize(n+1, txOuts, coinType) <= maxTxSize) {
+				n++
+				unspent--
+			}
+			txs++
+			fee += txrules.FeeForSerializeSize(feeRate,
+				estimateConsolidationSize(n, txOuts, coinType))
+		}
+		if !consolidationPaysFee(batch, fee, feeRate, coinType) {
+			plan.Remaining += len(batch)
+			continue
+		}
+		plan.Transactions += txs
+		plan.Fee += fee
+		plan.Remaining++
+	}
+
+	return plan
+}
+
+// consolidationPaysFee returns whether consolidating the inputs results in an
+// output, after paying fee, that compressWallet would create.
+func consolidationPaysFee(inputs []Input, fee, feeRate dcrutil.Amount,
+	coinType cointype.CoinType) bool {
+
+	if coinType.IsSKA() {
+		total := cointype.Zero()
+		for i := range inputs {
+			if inputs[i].PrevOut.SKAValue != nil {
+				total = total.Add(cointype.NewSKAAmount(inputs[i].PrevOut.SKAValue))
+			}
+		}
+		out := total.Sub(cointype.SKAAmountFromInt64(int64(fee)))
+		return !out.IsNegative() && !out.IsZero()
+	}
+
+	var total dcrutil.Amount
+	for i := range inputs {
+		total += dcrutil.Amount(inputs[i].PrevOut.Value)
+	}
+	out := total - fee
+	return out > 0 && !txrules.IsDustAmount(out, txsizes.P2PKHPkScriptSize, feeRate)
 }
 
 // makeTicket creates a ticket from a split transaction output.
@@ -1153,6 +1842,25 @@ func makeTicket(params *chaincfg.Params, input *Input, addrVote stdaddr.StakeAdd
 
 var p2pkhSizedScript = make([]byte, 25)
 
+// soloTicketSize returns the estimated worst case serialize size of a solo
+// ticket purchase.
+func soloTicketSize() int {
+	const stakeSubmissionPkScriptSize = txsizes.P2PKHPkScriptSize + 1
+
+	// A solo ticket has:
+	//   - a single input redeeming a P2PKH for the worst case size
+	//   - a P2PKH or P2SH stake submission output
+	//   - a ticket commitment output
+	//   - an OP_SSTXCHANGE tagged P2PKH or P2SH change output
+	//
+	//   NB: The wallet currently only supports P2PKH change addresses.
+	//   The network supports both P2PKH and P2SH change addresses however.
+	inSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
+	outSizes := []int{stakeSubmissionPkScriptSize,
+		txsizes.TicketCommitmentScriptSize, txsizes.P2PKHPkScriptSize + 1}
+	return txsizes.EstimateSerializeSizeFromScriptSizes(inSizes, outSizes, 0)
+}
+
 func (w *Wallet) mixedSplit(ctx context.Context, req *PurchaseTicketsRequest, neededPerTicket dcrutil.Amount) (tx *wire.MsgTx, outIndexes []int, err error) {
 	// Use txauthor to perform input selection and change amount
 	// calculations for the unmixed portions of the coinjoin.
@@ -1181,7 +1889,7 @@ func (w *Wallet) mixedSplit(ctx context.Context, req *PurchaseTicketsRequest, ne
 	var unlockOutpoints []*wire.OutPoint
 	defer func() {
 		if len(unlockOutpoints) != 0 {
-			w.lockedOutpointMu.Lock()
+			w.lockOutpoints(ctx)
 			for _, op := range unlockOutpoints {
 				delete(w.lockedOutpoints, outpoint{op.Hash, op.Index})
 			}
@@ -1193,20 +1901,27 @@ func (w *Wallet) mixedSplit(ctx context.Context, req *PurchaseTicketsRequest, ne
 		return ok
 	}
 
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 	var atx *txauthor.AuthoredTx
 	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		_, tipHeight := w.txStore.MainChainTip(dbtx)
 		inputSource := w.txStore.MakeInputSourceWithCoinType(dbtx, req.SourceAccount,
 			req.MinConf, tipHeight, ignoreInput, ticketCoinType)
+		changeAccount, err := w.changeAccountFor(dbtx, req.SourceAccount,
+			ticketCoinType, req.ChangeAccount)
+		if err != nil {
+			return err
+		}
+		// Change of the split transaction is an output of the coinjoin,
+		// and always pays P2PKH so it is not distinguished from the
+		// change of other peers.
 		changeSource := &p2PKHChangeSource{
 			persist:   w.deferPersistReturnedChild(ctx, &changeSourceUpdates),
-			account:   req.ChangeAccount,
+			account:   changeAccount,
 			wallet:    w,
 			ctx:       ctx,
 			gapPolicy: gapPolicyIgnore,
 		}
-		var err error
 		atx, err = txauthor.NewUnsignedTransaction(mixOut, relayFee,
 			inputSource.SelectInputs, changeSource,
 			w.chainParams.MaxTxSize)
@@ -1225,7 +1940,7 @@ func (w *Wallet) mixedSplit(ctx context.Context, req *PurchaseTicketsRequest, ne
 		return
 	}
 	for _, in := range atx.Tx.TxIn {
-		log.Infof("selected input %v (%v) for ticket purchase split transaction",
+		logCtx(ctx).Infof("selected input %v (%v) for ticket purchase split transaction",
 			in.PreviousOutPoint, dcrutil.Amount(in.ValueIn))
 	}
 
@@ -1253,7 +1968,7 @@ func (w *Wallet) mixedSplit(ctx context.Context, req *PurchaseTicketsRequest, ne
 	}
 	splitTx := cj.Tx()
 	splitTxHash := splitTx.TxHash()
-	log.Infof("Completed CoinShuffle++ mix of ticket split transaction %v", &splitTxHash)
+	logCtx(ctx).Infof("Completed CoinShuffle++ mix of ticket split transaction %v", &splitTxHash)
 	return splitTx, cj.MixedIndices(), nil
 }
 
@@ -1297,6 +2012,7 @@ func (w *Wallet) individualSplit(ctx context.Context, req *PurchaseTicketsReques
 		txFee:              w.RelayFeeForCoinType(ctx, ticketCoinType),
 		dontSignTx:         req.DontSignTx,
 		isTreasury:         false,
+		feeRewardsOnly:     req.FeeRewardFunding,
 	}
 	err = w.authorTx(ctx, op, a)
 	if err != nil {
@@ -1339,6 +2055,12 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 	if req.Expiry < 0 {
 		return nil, errors.E(op, errors.Invalid, "negative expiry")
 	}
+	// Mixed split transactions spend outputs selected by the mixing
+	// protocol and cannot be restricted to fee rewards.
+	if req.FeeRewardFunding && req.Mixing {
+		return nil, errors.E(op, errors.Invalid,
+			"fee reward funding is incompatible with mixing")
+	}
 
 	// Perform a sanity check on expiry.
 	var tipHeight int32
@@ -1383,29 +2105,13 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 		return nil, err
 	}
 
-	const stakeSubmissionPkScriptSize = txsizes.P2PKHPkScriptSize + 1
-
 	// Make sure that we have enough funds. Calculate different
 	// ticket required amounts depending on whether or not a
 	// pool output is needed. If the ticket fee increment is
 	// unset in the request, use the global ticket fee increment.
 	var neededPerTicket dcrutil.Amount
-	var estSize int
 	ticketRelayFee := w.RelayFee()
-
-	// A solo ticket has:
-	//   - a single input redeeming a P2PKH for the worst case size
-	//   - a P2PKH or P2SH stake submission output
-	//   - a ticket commitment output
-	//   - an OP_SSTXCHANGE tagged P2PKH or P2SH change output
-	//
-	//   NB: The wallet currently only supports P2PKH change addresses.
-	//   The network supports both P2PKH and P2SH change addresses however.
-	inSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
-	outSizes := []int{stakeSubmissionPkScriptSize,
-		txsizes.TicketCommitmentScriptSize, txsizes.P2PKHPkScriptSize + 1}
-	estSize = txsizes.EstimateSerializeSizeFromScriptSizes(inSizes,
-		outSizes, 0)
+	estSize := soloTicketSize()
 
 	ticketFee := txrules.FeeForSerializeSize(ticketRelayFee, estSize)
 	neededPerTicket = ticketFee + ticketPrice
@@ -1421,13 +2127,13 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 		ctx := context.TODO()
 		_, err := w.watchHDAddrs(ctx, false, n)
 		if err != nil {
-			log.Errorf("Failed to watch for future addresses after ticket "+
+			logCtx(ctx).Errorf("Failed to watch for future addresses after ticket "+
 				"purchases: %v", err)
 		}
 		if len(watchOutPoints) > 0 {
 			err := n.LoadTxFilter(ctx, false, nil, watchOutPoints)
 			if err != nil {
-				log.Errorf("Failed to watch outpoints: %v", err)
+				logCtx(ctx).Errorf("Failed to watch outpoints: %v", err)
 			}
 		}
 	}()
@@ -1474,7 +2180,7 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 			if unlockCredits {
 				for _, credit := range vspFeeCredits {
 					for _, c := range credit {
-						log.Debugf("unlocked unneeded credit for vsp fee tx: %v",
+						logCtx(ctx).Debugf("unlocked unneeded credit for vsp fee tx: %v",
 							c.OutPoint.String())
 						w.UnlockOutpoint(&c.OutPoint.Hash, c.OutPoint.Index)
 					}
@@ -1498,7 +2204,7 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 					break
 				}
 				if err != nil {
-					log.Errorf("ReserveOutputsForAmount failed: %v", err)
+					logCtx(ctx).Errorf("ReserveOutputsForAmount failed: %v", err)
 					return nil, err
 				}
 				vspFeeCredits = append(vspFeeCredits, credits)
@@ -1516,14 +2222,14 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 				break
 			}
 			if err != nil {
-				log.Errorf("ReserveOutputsForAmount failed: %v", err)
+				logCtx(ctx).Errorf("ReserveOutputsForAmount failed: %v", err)
 				return nil, err
 			}
 			ticketCredits = append(ticketCredits, credits)
 		}
 		for _, credits := range ticketCredits {
 			for _, c := range credits {
-				log.Debugf("unlocked credit for ticket tx: %v",
+				logCtx(ctx).Debugf("unlocked credit for ticket tx: %v",
 					c.OutPoint.String())
 				w.UnlockOutpoint(&c.OutPoint.Hash, c.OutPoint.Index)
 			}
@@ -1589,10 +2295,10 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 				return nil, errVSPFeeRequiresUTXOSplit
 			}
 		}
-		log.Infof("Reserved credits for %d tickets: total fee: %v", req.Count, fee)
+		logCtx(ctx).Infof("Reserved credits for %d tickets: total fee: %v", req.Count, fee)
 		for _, credit := range vspFeeCredits {
 			for _, c := range credit {
-				log.Debugf("%s reserved for vsp fee transaction", c.OutPoint.String())
+				logCtx(ctx).Debugf("%s reserved for vsp fee transaction", c.OutPoint.String())
 			}
 		}
 	}
@@ -1630,7 +2336,7 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 		if err != nil {
 			return nil, err
 		}
-		w.lockedOutpointMu.Lock()
+		w.lockOutpoints(ctx)
 		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
 			watch, err := w.processTransactionRecord(ctx, dbtx, rec, nil, nil)
 			watchOutPoints = append(watchOutPoints, watch...)
@@ -1676,7 +2382,7 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 		// input.
 		var eop *Input
 		outpoint.Index = uint32(index)
-		log.Infof("Split output is %v", &outpoint)
+		logCtx(ctx).Infof("Split output is %v", &outpoint)
 		txOut := splitTx.TxOut[index]
 		eop = &Input{
 			OutPoint: outpoint,
@@ -1703,7 +2409,7 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 			return nil, err
 		}
 
-		w.lockedOutpointMu.Lock()
+		w.lockOutpoints(ctx)
 		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
 			// Generate the ticket msgTx and sign it if DontSignTx is false.
 			ticket, err := makeTicket(w.chainParams, eop, addrVote,
@@ -1753,6 +2459,17 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 				return err
 			}
 
+			if req.FeeRewardFunding {
+				err = udb.PutFeeRewardTicket(dbtx, &udb.FeeRewardTicket{
+					Hash:    rec.Hash,
+					Account: req.SourceAccount,
+					Value:   dcrutil.Amount(eop.PrevOut.Value),
+				})
+				if err != nil {
+					return err
+				}
+			}
+
 			w.recentlyPublishedMu.Lock()
 			w.recentlyPublished[rec.Hash] = struct{}{}
 			w.recentlyPublishedMu.Unlock()
@@ -1793,7 +2510,7 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 		if err != nil {
 			return purchaseTicketsResponse, errors.E(op, err)
 		}
-		log.Infof("Published ticket purchase %v", ticket.TxHash())
+		logCtx(ctx).Infof("Published ticket purchase %v", ticket.TxHash())
 
 		// Pay VSP fee when configured to do so.
 		if req.VSPClient == nil {
@@ -1840,7 +2557,7 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 // account.  It is the responsibility of the caller to unlock the outpoints.
 func (w *Wallet) ReserveOutputsForAmount(ctx context.Context, account uint32, amount dcrutil.Amount, minconf int32, coinType cointype.CoinType) ([]Input, error) {
 	defer w.lockedOutpointMu.Unlock()
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 
 	var outputs []Input
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
@@ -1871,7 +2588,7 @@ func (w *Wallet) ReserveOutputsForAmount(ctx context.Context, account uint32, am
 
 func (w *Wallet) reserveOutputs(ctx context.Context, account uint32, minconf int32, coinType cointype.CoinType) ([]Input, error) {
 	defer w.lockedOutpointMu.Unlock()
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 
 	var outputs []Input
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
@@ -1900,71 +2617,107 @@ func (w *Wallet) reserveOutputs(ctx context.Context, account uint32, minconf int
 // This can't be optimized to use the random selection because it must read all
 // outputs.  Prefer to use findEligibleOutputsAmount with various filter options
 // instead.
+//
+// The unspent outputs of the account are cached.  This must be called with
+// w.lockedOutpointMu held, and must not be called with a database transaction
+// which has already modified the transaction store.
 func (w *Wallet) findEligibleOutputs(dbtx walletdb.ReadTx, account uint32, minconf int32,
 	currentHeight int32, coinType cointype.CoinType) ([]Input, error) {
 
-	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
-
-	unspent, err := w.txStore.UnspentOutputs(dbtx, coinType)
+	key := utxoCacheKey{account: account, coinType: coinType}
+	unspent, err := w.utxoCache.load(key, func() ([]cachedOutput, error) {
+		return w.accountUnspentOutputs(dbtx, account, coinType)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: Eventually all of these filters (except perhaps output locking)
-	// should be handled by the call to UnspentOutputs (or similar).
-	// Because one of these filters requires matching the output script to
-	// the desired account, this change depends on making wtxmgr a waddrmgr
-	// dependency and requesting unspent outputs for a single account.
 	eligible := make([]Input, 0, len(unspent))
 	for i := range unspent {
-		output := unspent[i]
+		output := &unspent[i]
+		op := &output.input.OutPoint
 
 		// Locked unspent outputs are skipped.
-		if _, locked := w.lockedOutpoints[outpoint{output.Hash, output.Index}]; locked {
+		if _, locked := w.lockedOutpoints[outpoint{op.Hash, op.Index}]; locked {
 			continue
 		}
 
 		// Only include this output if it meets the required number of
 		// confirmations.  Coinbase transactions must have reached
 		// maturity before their outputs may be spent.
-		if !confirmed(minconf, output.Height, currentHeight) {
-			continue
-		}
-
-		// Filter out unspendable outputs, that is, remove those that
-		// (at this time) are not P2PKH outputs.  Other inputs must be
-		// manually included in transactions and sent (for example,
-		// using createrawtransaction, signrawtransaction, and
-		// sendrawtransaction).
-		class, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, output.PkScript, w.chainParams)
-		if len(addrs) != 1 {
+		if !confirmed(minconf, output.height, currentHeight) {
 			continue
 		}
 
 		// Make sure everything we're trying to spend is actually mature.
-		switch class {
+		switch output.class {
 		case stdscript.STStakeGenPubKeyHash, stdscript.STStakeGenScriptHash:
-			if !coinbaseMatured(w.chainParams, output.Height, currentHeight) {
+			if !coinbaseMatured(w.chainParams, output.height, currentHeight) {
 				continue
 			}
 		case stdscript.STStakeRevocationPubKeyHash, stdscript.STStakeRevocationScriptHash:
-			if !coinbaseMatured(w.chainParams, output.Height, currentHeight) {
+			if !coinbaseMatured(w.chainParams, output.height, currentHeight) {
 				continue
 			}
 		case stdscript.STTreasuryAdd, stdscript.STTreasuryGenPubKeyHash, stdscript.STTreasuryGenScriptHash:
-			if !coinbaseMatured(w.chainParams, output.Height, currentHeight) {
+			if !coinbaseMatured(w.chainParams, output.height, currentHeight) {
 				continue
 			}
 		case stdscript.STStakeChangePubKeyHash, stdscript.STStakeChangeScriptHash:
-			if !ticketChangeMatured(w.chainParams, output.Height, currentHeight) {
+			if !ticketChangeMatured(w.chainParams, output.height, currentHeight) {
 				continue
 			}
 		case stdscript.STPubKeyHashEcdsaSecp256k1:
-			if output.FromCoinBase {
-				if !coinbaseMatured(w.chainParams, output.Height, currentHeight) {
+			if output.fromCoinBase {
+				if !coinbaseMatured(w.chainParams, output.height, currentHeight) {
 					continue
 				}
 			}
+		}
+
+		eligible = append(eligible, output.input)
+	}
+
+	return eligible, nil
+}
+
+// accountUnspentOutputs reads the unspent outputs of an account and coin type
+// which may be selected as transaction inputs once they are confirmed and
+// matured.
+func (w *Wallet) accountUnspentOutputs(dbtx walletdb.ReadTx, account uint32,
+	coinType cointype.CoinType) ([]cachedOutput, error) {
+
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+
+	unspent, err := w.txStore.UnspentOutputs(dbtx, coinType)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: Eventually all of these filters (except perhaps output locking)
+	// should be handled by the call to UnspentOutputs (or similar).
+	// Because one of these filters requires matching the output script to
+	// the desired account, this change depends on making wtxmgr a waddrmgr
+	// dependency and requesting unspent outputs for a single account.
+	outputs := make([]cachedOutput, 0, len(unspent))
+	for i := range unspent {
+		output := unspent[i]
+
+		// Filter out unspendable outputs, that is, remove those that
+		// (at this time) are not P2PKH outputs.  Other inputs must be
+		// manually included in transactions and sent (for example,
+		// using createrawtransaction, signrawtransaction, and
+		// sendrawtransaction).
+		class, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, output.PkScript, w.chainParams)
+		if len(addrs) != 1 {
+			continue
+		}
+		switch class {
+		case stdscript.STStakeGenPubKeyHash, stdscript.STStakeGenScriptHash,
+			stdscript.STStakeRevocationPubKeyHash, stdscript.STStakeRevocationScriptHash,
+			stdscript.STTreasuryAdd, stdscript.STTreasuryGenPubKeyHash, stdscript.STTreasuryGenScriptHash,
+			stdscript.STStakeChangePubKeyHash, stdscript.STStakeChangeScriptHash,
+			stdscript.STPubKeyHashEcdsaSecp256k1:
 		default:
 			continue
 		}
@@ -1990,13 +2743,18 @@ func (w *Wallet) findEligibleOutputs(dbtx walletdb.ReadTx, account uint32, minco
 			PkScript: output.PkScript,
 			CoinType: output.CoinType,
 		}
-		eligible = append(eligible, Input{
-			OutPoint: output.OutPoint,
-			PrevOut:  *txOut,
+		outputs = append(outputs, cachedOutput{
+			input: Input{
+				OutPoint: output.OutPoint,
+				PrevOut:  *txOut,
+			},
+			class:        class,
+			height:       output.Height,
+			fromCoinBase: output.FromCoinBase,
 		})
 	}
 
-	return eligible, nil
+	return outputs, nil
 }
 
 // findEligibleOutputsAmount uses wtxmgr to find a number of unspent outputs
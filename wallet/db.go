@@ -5,7 +5,9 @@
 package wallet
 
 import (
+	"bytes"
 	"io"
+	"os"
 
 	"github.com/monetarium/monetarium-wallet/errors"
 	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
@@ -49,3 +51,27 @@ func CreateDB(driver string, args ...any) (DB, error) {
 	}
 	return opaqueDB{db}, nil
 }
+
+// sqliteHeader is the header string beginning every SQLite database file.
+var sqliteHeader = []byte("SQLite format 3\x00")
+
+// DBDriver returns the name of the driver which opens the existing database
+// file at path.  SQLite databases are recognized by their file header, and all
+// other files are assumed to be bbolt databases.
+func DBDriver(path string) (string, error) {
+	const op errors.Op = "wallet.DBDriver"
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return "", errors.E(op, errors.NotExist, "missing database file")
+	}
+	if err != nil {
+		return "", errors.E(op, errors.IO, err)
+	}
+	defer f.Close()
+	header := make([]byte, len(sqliteHeader))
+	_, err = io.ReadFull(f, header)
+	if err == nil && bytes.Equal(header, sqliteHeader) {
+		return "sqlite", nil
+	}
+	return "bdb", nil
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+func TestDBDriver(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name     string
+		contents []byte
+		driver   string
+	}{
+		{"sqlite", append(sqliteHeader[:len(sqliteHeader):len(sqliteHeader)], 0x10, 0x00), "sqlite"},
+		{"bolt", []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0xed, 0xda, 0x0c, 0xed}, "bdb"},
+		{"short", []byte("SQLite"), "bdb"},
+		{"empty", nil, "bdb"},
+	}
+	for _, test := range tests {
+		path := filepath.Join(dir, test.name)
+		if err := os.WriteFile(path, test.contents, 0600); err != nil {
+			t.Fatal(err)
+		}
+		driver, err := DBDriver(path)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if driver != test.driver {
+			t.Errorf("%s: driver %q, want %q", test.name, driver, test.driver)
+		}
+	}
+
+	_, err := DBDriver(filepath.Join(dir, "noexist"))
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("missing file: expected NotExist, got %v", err)
+	}
+}
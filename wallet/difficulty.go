@@ -831,9 +831,10 @@ func (w *Wallet) NextStakeDifficultyAfterHeader(ctx context.Context, h *wire.Blo
 }
 
 // ValidateHeaderChainDifficulties validates the PoW and PoS difficulties of all
-// blocks in chain[idx:].  The parent of chain[0] must be recorded as wallet
-// main chain block.  If a consensus violation is caught, a subslice of chain
-// beginning with the invalid block is returned.
+// blocks in chain[idx:], and that each header extends the previous block.  The
+// parent of chain[0] must be recorded as wallet main chain block.  If a
+// consensus violation is caught, a subslice of chain beginning with the invalid
+// block is returned.
 func (w *Wallet) ValidateHeaderChainDifficulties(ctx context.Context, chain []*BlockNode, idx int) ([]*BlockNode, error) {
 	var invalid []*BlockNode
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
@@ -870,6 +871,27 @@ func (w *Wallet) validateHeaderChainDifficulties(dbtx walletdb.ReadTx, chain []*
 			}
 		}
 
+		// Validate the header extends its parent.  A backend reporting
+		// blocks of a different branch, or skipping blocks, is caught
+		// here before trusting the reported chain.  The parent of
+		// chain[0] is the header recorded by the wallet, and its hash is
+		// recomputed rather than trusting the lookup key.
+		if parent != nil {
+			var parentHash *chainhash.Hash
+			if idx == 0 {
+				storedHash := parent.BlockHash()
+				parentHash = &storedHash
+			} else {
+				parentHash = chain[idx-1].Hash
+			}
+			if h.PrevBlock != *parentHash || h.Height != parent.Height+1 {
+				err := errors.Errorf("%v (height %d) does not extend "+
+					"block %v (height %d)", hash, h.Height,
+					parentHash, parent.Height)
+				return chain[idx:], errors.E(op, errors.Consensus, err)
+			}
+		}
+
 		// Validate advertised and performed work
 		err := w.checkDifficultyPositional(dbtx, h, parent, chain)
 		if err != nil {
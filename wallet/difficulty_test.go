@@ -0,0 +1,94 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+func TestValidateHeaderChainLinks(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	node := func(prev *chainhash.Hash, height uint32) *BlockNode {
+		h := &wire.BlockHeader{PrevBlock: *prev, Height: height}
+		hash := h.BlockHash()
+		return &BlockNode{Header: h, Hash: &hash}
+	}
+	genesis := &cfg.Params.GenesisHash
+	b1 := node(genesis, 1)
+
+	tests := []struct {
+		name string
+		next *BlockNode
+	}{
+		{"other branch", node(&chainhash.Hash{1}, 2)},
+		{"skipped height", node(b1.Hash, 3)},
+		{"repeated height", node(b1.Hash, 1)},
+	}
+	for _, tc := range tests {
+		chain := []*BlockNode{b1, tc.next}
+		invalid, err := w.ValidateHeaderChainDifficulties(ctx, chain, 1)
+		if !errors.Is(err, errors.Consensus) {
+			t.Errorf("%s: expected Consensus error, got %v", tc.name, err)
+			continue
+		}
+		if len(invalid) != 1 || invalid[0] != tc.next {
+			t.Errorf("%s: wrong invalid blocks %v", tc.name, invalid)
+		}
+	}
+}
+
+func TestValidateHeaderChainStoredParent(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	genesis := &cfg.Params.GenesisHash
+
+	// Headers connecting to the recorded genesis block must be at the next
+	// height and use the difficulty required after the stored header.
+	tests := []struct {
+		name   string
+		header *wire.BlockHeader
+	}{
+		{"bad parent height", &wire.BlockHeader{PrevBlock: *genesis, Height: 2}},
+		{"bad difficulty", &wire.BlockHeader{PrevBlock: *genesis, Height: 1, Bits: 1}},
+	}
+	for _, tc := range tests {
+		hash := tc.header.BlockHash()
+		n := &BlockNode{Header: tc.header, Hash: &hash}
+		invalid, err := w.ValidateHeaderChainDifficulties(ctx, []*BlockNode{n}, 0)
+		if !errors.Is(err, errors.Consensus) {
+			t.Errorf("%s: expected Consensus error, got %v", tc.name, err)
+			continue
+		}
+		if len(invalid) != 1 || invalid[0] != n {
+			t.Errorf("%s: wrong invalid blocks %v", tc.name, invalid)
+		}
+	}
+
+	// A header whose parent is not recorded by the wallet is never
+	// validated.
+	h := &wire.BlockHeader{PrevBlock: chainhash.Hash{1}, Height: 1}
+	hash := h.BlockHash()
+	n := &BlockNode{Header: h, Hash: &hash}
+	_, err := w.ValidateHeaderChainDifficulties(ctx, []*BlockNode{n}, 0)
+	if err == nil {
+		t.Errorf("unknown parent: header was validated")
+	}
+}
@@ -107,6 +107,8 @@ func cacheMissingCommitments(ctx context.Context, n NetworkBackend, cache blockC
 
 type accountUsage struct {
 	account        uint32
+	gapLimit       uint32
+	segments       uint32
 	extkey, intkey *hd.ExtendedKey
 	extLastUsed    uint32
 	intLastUsed    uint32
@@ -121,18 +123,17 @@ type scriptPath struct {
 
 type addrFinder struct {
 	w           *Wallet
-	gaplimit    uint32
-	segments    uint32
 	usage       []accountUsage
 	commitments blockCommitmentCache
 	mu          sync.RWMutex
 }
 
+// newAddrFinder returns an address finder for all accounts of the wallet.
+// Accounts are searched using their own gap limit if one has been set, and
+// gapLimit otherwise.
 func newAddrFinder(ctx context.Context, w *Wallet, gapLimit uint32) (*addrFinder, error) {
 	a := &addrFinder{
 		w:           w,
-		gaplimit:    gapLimit,
-		segments:    hd.HardenedKeyStart / gapLimit,
 		commitments: make(blockCommitmentCache),
 	}
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
@@ -159,23 +160,30 @@ func newAddrFinder(ctx context.Context, w *Wallet, gapLimit uint32) (*addrFinder
 			if err != nil {
 				return err
 			}
+			addrGapLimit := gapLimit
+			if props.GapLimit != 0 {
+				addrGapLimit = props.GapLimit
+			}
+			segments := hd.HardenedKeyStart / addrGapLimit
 			var extlo, intlo uint32
 			if props.LastUsedExternalIndex != ^uint32(0) {
-				extlo = props.LastUsedExternalIndex / a.gaplimit
+				extlo = props.LastUsedExternalIndex / addrGapLimit
 			}
 			if props.LastUsedInternalIndex != ^uint32(0) {
-				intlo = props.LastUsedInternalIndex / a.gaplimit
+				intlo = props.LastUsedInternalIndex / addrGapLimit
 			}
 			a.usage = append(a.usage, accountUsage{
 				account:     acct,
+				gapLimit:    addrGapLimit,
+				segments:    segments,
 				extkey:      extkey,
 				intkey:      intkey,
 				extLastUsed: props.LastUsedExternalIndex,
 				intLastUsed: props.LastUsedInternalIndex,
 				extlo:       extlo,
-				exthi:       a.segments - 1,
+				exthi:       segments - 1,
 				intlo:       intlo,
-				inthi:       a.segments - 1,
+				inthi:       segments - 1,
 			})
 			return nil
 		}
@@ -220,13 +228,14 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, n NetworkB
 		// Map address scripts to their HD path.
 		var data [][]byte
 		scrPaths := make(map[string]scriptPath)
-		addBranch := func(branchPub *hd.ExtendedKey, usageIndex int, acct, branch, lo, hi uint32) error {
-			if lo > hi || hi >= a.segments { // Terminating condition
+		addBranch := func(branchPub *hd.ExtendedKey, usageIndex int, branch, lo, hi uint32) error {
+			u := &a.usage[usageIndex]
+			if lo > hi || hi >= u.segments { // Terminating condition
 				return nil
 			}
 			mid := (hi + lo) / 2
-			begin := mid * a.gaplimit
-			addrs, err := deriveChildAddresses(branchPub, begin, a.gaplimit, a.w.chainParams)
+			begin := mid * u.gapLimit
+			addrs, err := deriveChildAddresses(branchPub, begin, u.gapLimit, a.w.chainParams)
 			if err != nil {
 				return err
 			}
@@ -235,20 +244,20 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, n NetworkB
 				data = append(data, scr)
 				scrPaths[string(scr)] = scriptPath{
 					usageIndex: usageIndex,
-					account:    acct,
+					account:    u.account,
 					branch:     branch,
-					index:      mid*a.gaplimit + uint32(i),
+					index:      begin + uint32(i),
 				}
 			}
 			return nil
 		}
 		for i := range a.usage {
 			u := &a.usage[i]
-			err = addBranch(u.extkey, i, u.account, 0, u.extlo, u.exthi)
+			err = addBranch(u.extkey, i, 0, u.extlo, u.exthi)
 			if err != nil {
 				return err
 			}
-			err = addBranch(u.intkey, i, u.account, 1, u.intlo, u.inthi)
+			err = addBranch(u.intkey, i, 1, u.intlo, u.inthi)
 			if err != nil {
 				return err
 			}
@@ -277,7 +286,7 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, n NetworkB
 					// Found address script in this block.  Look up address path
 					// and record usage.
 					path := scrPaths[string(scr)]
-					log.Debugf("Found match for script %x path %v in block %v", scr, path, &hash)
+					logCtx(ctx).Debugf("Found match for script %x path %v in block %v", scr, path, &hash)
 					u := &a.usage[path.usageIndex]
 					a.mu.Lock()
 					switch path.branch {
@@ -304,8 +313,8 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, n NetworkB
 				mid := (u.exthi + u.extlo) / 2
 				// When the last used index is in this segment's index half open
 				// range [begin,end) then an address was found in this segment.
-				begin := mid * a.gaplimit
-				end := begin + a.gaplimit
+				begin := mid * u.gapLimit
+				end := begin + u.gapLimit
 				if u.extLastUsed >= begin && u.extLastUsed < end {
 					u.extlo = mid + 1
 				} else {
@@ -314,8 +323,8 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, n NetworkB
 			}
 			if u.intlo <= u.inthi {
 				mid := (u.inthi + u.intlo) / 2
-				begin := mid * a.gaplimit
-				end := begin + a.gaplimit
+				begin := mid * u.gapLimit
+				end := begin + u.gapLimit
 				if u.intLastUsed >= begin && u.intLastUsed < end {
 					u.intlo = mid + 1
 				} else {
@@ -512,7 +521,7 @@ func (w *Wallet) findLastUsedAccount(ctx context.Context, n NetworkBackend, bloc
 				// matching address in the block.  Look up the account of the
 				// script and increase the last used account when necessary.
 				acct := addrScriptAccts[string(script)]
-				log.Debugf("Found match for script %x account %v in block %v",
+				logCtx(ctx).Debugf("Found match for script %x account %v in block %v",
 					script, acct, b)
 				if lastUsed < acct {
 					lastUsed = acct
@@ -666,10 +675,10 @@ func (f *existsAddrIndexFinder) branchUsed(ctx context.Context, branchXpub *hd.E
 // findLastUsedAddress returns the child index of the last used child address
 // derived from a branch key.  If no addresses are found, ^uint32(0) is
 // returned.
-func (f *existsAddrIndexFinder) findLastUsedAddress(ctx context.Context, xpub *hd.ExtendedKey) (uint32, error) {
+func (f *existsAddrIndexFinder) findLastUsedAddress(ctx context.Context, xpub *hd.ExtendedKey, gapLimit uint32) (uint32, error) {
 	var (
 		lastUsed        = ^uint32(0)
-		scanLen         = f.gapLimit
+		scanLen         = gapLimit
 		segments        = hd.HardenedKeyStart / scanLen
 		lo, hi   uint32 = 0, segments - 1
 	)
@@ -701,7 +710,7 @@ Bsearch:
 
 func (f *existsAddrIndexFinder) find(ctx context.Context, finder *addrFinder) error {
 	var g errgroup.Group
-	lastUsed := func(acct, branch uint32, index *uint32) error {
+	lastUsed := func(acct, branch, gapLimit uint32, index *uint32) error {
 		var k *hd.ExtendedKey
 		err := walletdb.View(ctx, f.wallet.db, func(tx walletdb.ReadTx) error {
 			var err error
@@ -711,7 +720,7 @@ func (f *existsAddrIndexFinder) find(ctx context.Context, finder *addrFinder) er
 		if err != nil {
 			return err
 		}
-		lastUsed, err := f.findLastUsedAddress(ctx, k)
+		lastUsed, err := f.findLastUsedAddress(ctx, k, gapLimit)
 		if err != nil {
 			return err
 		}
@@ -720,13 +729,109 @@ func (f *existsAddrIndexFinder) find(ctx context.Context, finder *addrFinder) er
 	}
 	for i := range finder.usage {
 		u := &finder.usage[i]
-		acct := u.account
-		g.Go(func() error { return lastUsed(acct, 0, &u.extLastUsed) })
-		g.Go(func() error { return lastUsed(acct, 1, &u.intLastUsed) })
+		acct, gapLimit := u.account, u.gapLimit
+		g.Go(func() error { return lastUsed(acct, 0, gapLimit, &u.extLastUsed) })
+		g.Go(func() error { return lastUsed(acct, 1, gapLimit, &u.intLastUsed) })
 	}
 	return g.Wait()
 }
 
+// saveAccountUsage records the addresses of an account discovered to be used,
+// plus additional future addresses that may be used by other wallets sharing
+// the same seed, and updates the account's address buffers.  Addresses are
+// recorded beginning after the last used addresses described by from.
+func (w *Wallet) saveAccountUsage(ctx context.Context, u, from *accountUsage) error {
+	acct := u.account
+
+	var err error
+	const N = 256
+	max := u.extLastUsed + u.gapLimit
+	for j := from.extLastUsed; ; j += N {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		to := min(j+N, max)
+		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+			return w.manager.SyncAccountToAddrIndex(ns, acct, to, 0)
+		})
+		if err != nil {
+			return err
+		}
+		if to == max {
+			break
+		}
+	}
+
+	max = u.intLastUsed + u.gapLimit
+	for j := from.intLastUsed; ; j += N {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		to := min(j+N, max)
+		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+			return w.manager.SyncAccountToAddrIndex(ns, acct, to, 1)
+		})
+		if err != nil {
+			return err
+		}
+		if to == max {
+			break
+		}
+	}
+
+	// To avoid deadlocks lock mutex before grabbing DB transaction, this is
+	// what we do in other places.
+	w.addressBuffersMu.Lock()
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		if u.extLastUsed < hd.HardenedKeyStart {
+			err = w.manager.MarkUsedChildIndex(dbtx, acct, 0, u.extLastUsed)
+			if err != nil {
+				return err
+			}
+		}
+		if u.intLastUsed < hd.HardenedKeyStart {
+			err = w.manager.MarkUsedChildIndex(dbtx, acct, 1, u.intLastUsed)
+			if err != nil {
+				return err
+			}
+		}
+
+		props, err := w.manager.AccountProperties(ns, acct)
+		if err != nil {
+			return err
+		}
+
+		// Update last used index and cursor for this account's address
+		// buffers.  The cursor must not be reset backwards to avoid the
+		// possibility of address reuse.
+		acctData := w.addressBuffers[acct]
+		extern := &acctData.albExternal
+		if props.LastUsedExternalIndex+1 > extern.lastUsed+1 {
+			extern.cursor += extern.lastUsed - props.LastUsedExternalIndex
+			if extern.cursor > ^uint32(0)>>1 {
+				extern.cursor = 0
+			}
+			extern.lastUsed = props.LastUsedExternalIndex
+		}
+		intern := &acctData.albInternal
+		if props.LastUsedInternalIndex+1 > intern.lastUsed+1 {
+			intern.cursor += intern.lastUsed - props.LastUsedInternalIndex
+			if intern.cursor > ^uint32(0)>>1 {
+				intern.cursor = 0
+			}
+			intern.lastUsed = props.LastUsedInternalIndex
+		}
+		return nil
+	})
+	w.addressBuffersMu.Unlock()
+	return err
+}
+
 // DiscoverActiveAddresses searches for future wallet address usage in all
 // blocks starting from startBlock.  If discoverAccts is true, used accounts
 // will be discovered as well.  This feature requires the wallet to be unlocked
@@ -736,7 +841,47 @@ func (f *existsAddrIndexFinder) find(ctx context.Context, finder *addrFinder) er
 // usage is observed and coin type upgrades are not disabled, the wallet will be
 // upgraded to the SLIP0044 coin type and the address discovery will occur
 // again.
+//
+// Discovery progress is checkpointed in the database, and a discovery from the
+// same start block which was interrupted before completing resumes without
+// repeating account discovery or searching accounts whose addresses were
+// already recorded.
 func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend, startBlock *chainhash.Hash, discoverAccts bool, gapLimit uint32) error {
+	return w.discoverActiveAddresses(ctx, n, startBlock, discoverAccts, gapLimit, nil)
+}
+
+// DiscoveryProgress records the progress of address discovery and any errors
+// during discovery.  AccountsDiscovered is true once used accounts have been
+// discovered.  Account is the last account for which discovered addresses were
+// recorded, and Completed and Total count the accounts whose addresses have
+// been recorded and the accounts being searched.
+type DiscoveryProgress struct {
+	Err                error
+	AccountsDiscovered bool
+	Account            uint32
+	Completed          int
+	Total              int
+}
+
+// DiscoverActiveAddressesProgress performs address discovery like
+// DiscoverActiveAddresses, sending progress notifications and any errors to
+// the channel p.  This allows discovery to be performed in the background while
+// the caller reports its progress.  This function blocks until discovery
+// completes or ends in an error.  p is closed before returning.
+func (w *Wallet) DiscoverActiveAddressesProgress(ctx context.Context, n NetworkBackend,
+	startBlock *chainhash.Hash, discoverAccts bool, gapLimit uint32, p chan<- DiscoveryProgress) {
+
+	defer close(p)
+
+	err := w.discoverActiveAddresses(ctx, n, startBlock, discoverAccts, gapLimit, p)
+	if err != nil {
+		p <- DiscoveryProgress{Err: err}
+	}
+}
+
+func (w *Wallet) discoverActiveAddresses(ctx context.Context, n NetworkBackend,
+	startBlock *chainhash.Hash, discoverAccts bool, gapLimit uint32, p chan<- DiscoveryProgress) error {
+
 	const op errors.Op = "wallet.DiscoverActiveAddresses"
 	_, slip0044CoinType := udb.CoinTypes(w.chainParams)
 	var activeCoinType uint32
@@ -752,13 +897,34 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend,
 		}
 		coinTypeKnown = true
 		isSLIP0044CoinType = activeCoinType == slip0044CoinType
-		log.Debugf("DiscoverActiveAddresses: activeCoinType=%d", activeCoinType)
+		logCtx(ctx).Debugf("DiscoverActiveAddresses: activeCoinType=%d", activeCoinType)
 		return nil
 	})
 	if err != nil {
 		return errors.E(op, err)
 	}
 
+	// Resume an interrupted discovery from the same start block, skipping
+	// the completed work recorded by its checkpoint.
+	checkpoint := &udb.DiscoveryCheckpoint{StartBlock: *startBlock}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		c := udb.LastDiscoveryCheckpoint(dbtx)
+		if c != nil && c.StartBlock == *startBlock {
+			checkpoint = c
+		}
+		if !discoverAccts {
+			checkpoint.AccountsDiscovered = true
+		}
+		return udb.SetDiscoveryCheckpoint(dbtx, checkpoint)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if discoverAccts && checkpoint.AccountsDiscovered {
+		logCtx(ctx).Infof("Resuming address discovery; used accounts were previously discovered")
+		discoverAccts = false
+	}
+
 	// Map block hashes to a set of output scripts from the block.  This map is
 	// queried to avoid fetching the same block multiple times, and blocks are
 	// reduced to a set of committed scripts as that is the only thing being
@@ -769,7 +935,7 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend,
 	// index is. This scan should only ever be performed if we're restoring our
 	// wallet from seed.
 	if discoverAccts {
-		log.Infof("Discovering used accounts")
+		logCtx(ctx).Infof("Discovering used accounts")
 		var coinTypePrivKey *hd.ExtendedKey
 		defer func() {
 			if coinTypePrivKey != nil {
@@ -836,11 +1002,20 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend,
 						xpub:        xpub,
 						albExternal: addressBuffer{branchXpub: extKey},
 						albInternal: addressBuffer{branchXpub: intKey},
+						gapLimit:    w.gapLimit,
 					}
 				}
 			}
 			w.addressBuffersMu.Unlock()
 		}
+
+		checkpoint.AccountsDiscovered = true
+		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			return udb.SetDiscoveryCheckpoint(dbtx, checkpoint)
+		})
+		if err != nil {
+			return errors.E(op, err)
+		}
 	}
 
 	// Discover address usage within known accounts
@@ -849,7 +1024,28 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend,
 	if err != nil {
 		return errors.E(op, err)
 	}
-	log.Infof("Discovering used addresses for %d account(s)", len(finder.usage))
+	if len(checkpoint.Accounts) != 0 {
+		completed := make(map[uint32]struct{}, len(checkpoint.Accounts))
+		for _, acct := range checkpoint.Accounts {
+			completed[acct] = struct{}{}
+		}
+		remaining := finder.usage[:0]
+		for _, u := range finder.usage {
+			if _, ok := completed[u.account]; !ok {
+				remaining = append(remaining, u)
+			}
+		}
+		finder.usage = remaining
+	}
+	total := len(checkpoint.Accounts) + len(finder.usage)
+	if p != nil {
+		p <- DiscoveryProgress{
+			AccountsDiscovered: true,
+			Completed:          len(checkpoint.Accounts),
+			Total:              total,
+		}
+	}
+	logCtx(ctx).Infof("Discovering used addresses for %d account(s)", len(finder.usage))
 	lastUsed := append([]accountUsage(nil), finder.usage...)
 	rpc, ok := n.(usedAddressesQuerier)
 	if ok {
@@ -863,113 +1059,50 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend,
 	}
 	for i := range finder.usage {
 		u := &finder.usage[i]
-		log.Infof("Account %d next child indexes: external:%d internal:%d",
+		logCtx(ctx).Infof("Account %d next child indexes: external:%d internal:%d",
 			u.account, u.extLastUsed+1, u.intLastUsed+1)
 	}
 
 	// Save discovered addresses for each account plus additional future
 	// addresses that may be used by other wallets sharing the same seed.
 	// Multiple updates are used to allow cancellation.
-	log.Infof("Updating DB with discovered addresses...")
+	logCtx(ctx).Infof("Updating DB with discovered addresses...")
 	for i := range finder.usage {
 		u := &finder.usage[i]
 		acct := u.account
 
-		const N = 256
-		max := u.extLastUsed + gapLimit
-		for j := lastUsed[i].extLastUsed; ; j += N {
-			if ctx.Err() != nil {
-				return ctx.Err()
-			}
-
-			to := min(j+N, max)
-			err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
-				ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
-				return w.manager.SyncAccountToAddrIndex(ns, acct, to, 0)
-			})
-			if err != nil {
-				return errors.E(op, err)
-			}
-			if to == max {
-				break
-			}
-		}
-
-		max = u.intLastUsed + gapLimit
-		for j := lastUsed[i].intLastUsed; ; j += N {
-			if ctx.Err() != nil {
-				return ctx.Err()
-			}
-
-			to := min(j+N, max)
-			err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
-				ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
-				return w.manager.SyncAccountToAddrIndex(ns, acct, to, 1)
-			})
-			if err != nil {
-				return errors.E(op, err)
-			}
-			if to == max {
-				break
-			}
+		err := w.saveAccountUsage(ctx, u, &lastUsed[i])
+		if err != nil {
+			return errors.E(op, err)
 		}
 
-		// To avoid deadlocks lock mutex before grabbing DB transaction, this is
-		// what we do in other places.
-		w.addressBuffersMu.Lock()
+		checkpoint.Accounts = append(checkpoint.Accounts, acct)
 		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
-			ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
-			if u.extLastUsed < hd.HardenedKeyStart {
-				err = w.manager.MarkUsedChildIndex(dbtx, acct, 0, u.extLastUsed)
-				if err != nil {
-					return err
-				}
-			}
-			if u.intLastUsed < hd.HardenedKeyStart {
-				err = w.manager.MarkUsedChildIndex(dbtx, acct, 1, u.intLastUsed)
-				if err != nil {
-					return err
-				}
-			}
-
-			props, err := w.manager.AccountProperties(ns, acct)
-			if err != nil {
-				return err
-			}
-
-			// Update last used index and cursor for this account's address
-			// buffers.  The cursor must not be reset backwards to avoid the
-			// possibility of address reuse.
-			acctData := w.addressBuffers[acct]
-			extern := &acctData.albExternal
-			if props.LastUsedExternalIndex+1 > extern.lastUsed+1 {
-				extern.cursor += extern.lastUsed - props.LastUsedExternalIndex
-				if extern.cursor > ^uint32(0)>>1 {
-					extern.cursor = 0
-				}
-				extern.lastUsed = props.LastUsedExternalIndex
-			}
-			intern := &acctData.albInternal
-			if props.LastUsedInternalIndex+1 > intern.lastUsed+1 {
-				intern.cursor += intern.lastUsed - props.LastUsedInternalIndex
-				if intern.cursor > ^uint32(0)>>1 {
-					intern.cursor = 0
-				}
-				intern.lastUsed = props.LastUsedInternalIndex
-			}
-			return nil
+			return udb.SetDiscoveryCheckpoint(dbtx, checkpoint)
 		})
-		w.addressBuffersMu.Unlock()
 		if err != nil {
 			return errors.E(op, err)
 		}
+		if p != nil {
+			p <- DiscoveryProgress{
+				AccountsDiscovered: true,
+				Account:            acct,
+				Completed:          len(checkpoint.Accounts),
+				Total:              total,
+			}
+		}
+	}
+
+	err = walletdb.Update(ctx, w.db, udb.DeleteDiscoveryCheckpoint)
+	if err != nil {
+		return errors.E(op, err)
 	}
 
 	// If the wallet does not know the current coin type (e.g. it is a watching
 	// only wallet created from an account master pubkey) or when the wallet
 	// uses the SLIP0044 coin type, there is nothing more to do.
 	if !coinTypeKnown || isSLIP0044CoinType {
-		log.Infof("Finished address discovery")
+		logCtx(ctx).Infof("Finished address discovery")
 		return nil
 	}
 
@@ -979,25 +1112,90 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend,
 		len(finder.usage) != 1 ||
 		finder.usage[0].extLastUsed != ^uint32(0) ||
 		finder.usage[0].intLastUsed != ^uint32(0)) {
-		log.Infof("Finished address discovery")
-		log.Warnf("Wallet contains addresses derived for the legacy BIP0044 " +
+		logCtx(ctx).Infof("Finished address discovery")
+		logCtx(ctx).Warnf("Wallet contains addresses derived for the legacy BIP0044 " +
 			"coin type and seed restores may not work with some other wallet " +
 			"software")
 		return nil
 	}
 
 	// Upgrade the coin type.
-	log.Infof("Upgrading wallet from legacy coin type %d to SLIP0044 coin type %d",
+	logCtx(ctx).Infof("Upgrading wallet from legacy coin type %d to SLIP0044 coin type %d",
 		activeCoinType, slip0044CoinType)
 	err = w.UpgradeToSLIP0044CoinType(ctx)
 	if err != nil {
-		log.Errorf("Coin type upgrade failed: %v", err)
-		log.Warnf("Continuing with legacy BIP0044 coin type -- seed restores " +
+		logCtx(ctx).Errorf("Coin type upgrade failed: %v", err)
+		logCtx(ctx).Warnf("Continuing with legacy BIP0044 coin type -- seed restores " +
 			"may not work with some other wallet software")
 		return nil
 	}
-	log.Infof("Upgraded coin type.")
+	logCtx(ctx).Infof("Upgraded coin type.")
 
 	// Perform address discovery a second time using the upgraded coin type.
-	return w.DiscoverActiveAddresses(ctx, n, startBlock, discoverAccts, gapLimit)
+	return w.discoverActiveAddresses(ctx, n, startBlock, discoverAccts, gapLimit, p)
+}
+
+// RescanAccount discovers the addresses of a single account which were used in
+// main chain blocks beginning at startHeight, and rescans these blocks for
+// transactions.  Other accounts are not searched for address usage, allowing an
+// account imported from an extended public key to be scanned without repeating
+// address discovery for the entire wallet.
+func (w *Wallet) RescanAccount(ctx context.Context, n NetworkBackend, account uint32, startHeight int32) error {
+	const op errors.Op = "wallet.RescanAccount"
+
+	var startHash chainhash.Hash
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		startHash, err = w.txStore.GetMainChainBlockHashForHeight(ns, startHeight)
+		return err
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	gapLimit := w.GapLimit()
+	finder, err := newAddrFinder(ctx, w, gapLimit)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	var usage []accountUsage
+	for _, u := range finder.usage {
+		if u.account == account {
+			usage = append(usage, u)
+		}
+	}
+	if len(usage) == 0 {
+		return errors.E(op, errors.NotExist, errors.Errorf("account %d", account))
+	}
+	finder.usage = usage
+	from := usage[0]
+
+	logCtx(ctx).Infof("Discovering used addresses for account %d", account)
+	if rpc, ok := n.(usedAddressesQuerier); ok {
+		f := existsAddrIndexFinder{w, rpc, gapLimit}
+		err = f.find(ctx, finder)
+	} else {
+		err = finder.find(ctx, &startHash, n)
+	}
+	if err != nil {
+		return errors.E(op, err)
+	}
+	u := &finder.usage[0]
+	logCtx(ctx).Infof("Account %d next child indexes: external:%d internal:%d",
+		u.account, u.extLastUsed+1, u.intLastUsed+1)
+	err = w.saveAccountUsage(ctx, u, &from)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	err = w.LoadActiveDataFilters(ctx, n, false)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	err = w.rescan(ctx, n, &startHash, startHeight, nil)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
 }
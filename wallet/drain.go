@@ -0,0 +1,65 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+// errDraining is returned when transaction authoring or publishing is refused
+// because the wallet is draining before it is closed.
+var errDraining = errors.E(errors.Invalid, "wallet is shutting down")
+
+// beginOp begins a transaction authoring or publishing operation, which must
+// be ended with w.ops.End.  Operations are refused once the wallet is
+// draining.
+func (w *Wallet) beginOp(op errors.Op) error {
+	if !w.ops.TryBegin() {
+		return errors.E(op, errDraining)
+	}
+	return nil
+}
+
+// Drain refuses new transaction authoring and publishing, and waits for the
+// operations in progress, and any database write they began, to complete so
+// that the wallet may be closed without leaving partially recorded
+// transactions.  If ctx is done first, Drain returns early with an error
+// reporting the operations which did not complete.  Drain may be called more
+// than once.
+func (w *Wallet) Drain(ctx context.Context) error {
+	const op errors.Op = "wallet.Drain"
+
+	if err := w.ops.Drain(ctx); err != nil {
+		return errors.E(op, errors.Errorf("%d operations in progress: %v",
+			w.ops.InFlight(), err))
+	}
+
+	// Background writes of the wallet are not tracked as operations.
+	// Beginning a write transaction waits for any in progress to commit.
+	// A read-only database is never written.
+	if w.readOnly {
+		return nil
+	}
+	errc := make(chan error, 1)
+	go func() {
+		tx, err := w.db.BeginReadWriteTx()
+		if err == nil {
+			err = tx.Rollback()
+		}
+		errc <- err
+	}()
+	select {
+	case err := <-errc:
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return nil
+	case <-ctx.Done():
+		return errors.E(op, errors.Errorf("database write in progress: %v",
+			ctx.Err()))
+	}
+}
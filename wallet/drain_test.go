@@ -0,0 +1,43 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+func TestDrain(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	// Draining waits for operations in progress.
+	if err := w.beginOp("test"); err != nil {
+		t.Fatal(err)
+	}
+	timeout, cancel := context.WithTimeout(ctx, time.Millisecond)
+	defer cancel()
+	if err := w.Drain(timeout); err == nil {
+		t.Fatal("drain completed with an operation in progress")
+	}
+	w.ops.End()
+	if err := w.Drain(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// New transactions are refused once draining.
+	_, err := w.PublishTransaction(ctx, wire.NewMsgTx(), mockNetwork{})
+	if !errors.Is(err, errDraining) {
+		t.Fatalf("publishing while draining returned %v", err)
+	}
+}
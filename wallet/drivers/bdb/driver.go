@@ -11,6 +11,8 @@
 //	if err != nil { /* handle error */ }
 //	db, err = wallet.OpenDB("bdb", filename)
 //	if err != nil { /* handle error */ }
+//	db, err = wallet.OpenDB("bdb", filename, true) // read-only
+//	if err != nil { /* handle error */ }
 package bdb
 
 import _ "github.com/monetarium/monetarium-wallet/wallet/internal/bdb" // Register bdb driver during init
@@ -0,0 +1,21 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package sqlite registers the sqlite driver at init time.  Importing sqlite
+// allows the wallet.OpenDB and wallet.CreateDB functions to be called with the
+// following arguments:
+//
+//	var filename string
+//	db, err := wallet.CreateDB("sqlite", filename)
+//	if err != nil { /* handle error */ }
+//	db, err = wallet.OpenDB("sqlite", filename)
+//	if err != nil { /* handle error */ }
+//	db, err = wallet.OpenDB("sqlite", filename, true) // read-only
+//	if err != nil { /* handle error */ }
+//
+// The driver requires cgo.  Binaries built without it register a driver which
+// fails to create or open any database with an error saying so.
+package sqlite
+
+import _ "github.com/monetarium/monetarium-wallet/wallet/internal/sqlitedb" // Register sqlite driver during init
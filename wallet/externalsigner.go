@@ -0,0 +1,87 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/hardware"
+	"github.com/monetarium/monetarium-wallet/wallet/txauthor"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+)
+
+// SetExternalSigner sets a source, such as a hardware.Source, to sign
+// transactions authored by the wallet instead of the wallet's private keys.
+// This allows watching-only wallets to create signed transactions.  Setting a
+// nil source restores signing with wallet private keys.
+func (w *Wallet) SetExternalSigner(s txauthor.SecretsSource) {
+	w.externalSignerMu.Lock()
+	w.externalSigner = s
+	w.externalSignerMu.Unlock()
+}
+
+// ExternalSigner returns the source set by SetExternalSigner, or nil if
+// transactions are signed with wallet private keys.
+func (w *Wallet) ExternalSigner() txauthor.SecretsSource {
+	w.externalSignerMu.Lock()
+	s := w.externalSigner
+	w.externalSignerMu.Unlock()
+	return s
+}
+
+// KeyPaths looks up the BIP0032 derivation paths of wallet keys for an
+// external signer.
+type KeyPaths struct {
+	ctx    context.Context
+	wallet *Wallet
+}
+
+var _ hardware.KeyPaths = (*KeyPaths)(nil)
+
+// KeyPaths returns a KeyPaths which looks up derivation paths using ctx.
+func (w *Wallet) KeyPaths(ctx context.Context) *KeyPaths {
+	return &KeyPaths{ctx: ctx, wallet: w}
+}
+
+// KeyPath returns the derivation path, beginning at the master key, of the key
+// of a BIP0044 account address.  Watching-only wallets use the SLIP0044 coin
+// type of the network.  Errors with code errors.NotExist describe addresses
+// which do not belong to a BIP0044 account of the wallet.
+func (p *KeyPaths) KeyPath(addr stdaddr.Address) ([]uint32, error) {
+	const op errors.Op = "wallet.KeyPath"
+
+	w := p.wallet
+	var path []uint32
+	err := walletdb.View(p.ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		ma, err := w.manager.Address(addrmgrNs, addr)
+		if err != nil {
+			return err
+		}
+		pka, ok := ma.(udb.ManagedPubKeyAddress)
+		if !ok {
+			return errors.E(errors.NotExist, "not a public key address")
+		}
+		coinType, err := w.manager.CoinType(dbtx)
+		if errors.Is(err, errors.WatchingOnly) {
+			coinType, err = w.chainParams.SLIP0044CoinType, nil
+		}
+		if err != nil {
+			return err
+		}
+		path = bip32Path(pka, &coinType)
+		if path == nil {
+			return errors.E(errors.NotExist, "address of imported key")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return path, nil
+}
@@ -372,6 +372,11 @@ func (c *VSPClient) status(ctx context.Context, ticket *VSPTicket) (*types.Ticke
 	return resp, nil
 }
 
+// TicketStatus queries the VSP for the status of a ticket registered with it.
+func (c *VSPClient) TicketStatus(ctx context.Context, ticket *VSPTicket) (*types.TicketStatusResponse, error) {
+	return c.status(ctx, ticket)
+}
+
 func (c *VSPClient) setVoteChoices(ctx context.Context, ticket *VSPTicket,
 	choices map[string]string, tspendPolicy map[string]string, treasuryPolicy map[string]string) error {
 
@@ -0,0 +1,155 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// recordFeeStat records the fee paid by a mined transaction spending only
+// wallet outputs.  The fee is paid in the coin type of the spent outputs.
+// Transactions spending outputs the wallet does not control, whose fee is
+// unknown, and those paying no fee, are ignored.
+func (w *Wallet) recordFeeStat(dbtx walletdb.ReadWriteTx, rec *udb.TxRecord,
+	blockMeta *udb.BlockMeta) error {
+
+	if len(rec.MsgTx.TxIn) == 0 {
+		return nil
+	}
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	details, err := w.txStore.UniqueTxDetails(txmgrNs, &rec.Hash, &blockMeta.Block)
+	if err != nil || details == nil {
+		return err
+	}
+	if len(details.Debits) != len(rec.MsgTx.TxIn) {
+		return nil
+	}
+
+	ct := details.Debits[0].CoinType
+	fee := new(big.Int)
+	for _, d := range details.Debits {
+		switch {
+		case d.CoinType != ct:
+		case ct.IsSKA():
+			fee.Add(fee, d.SKAAmount.BigInt())
+		default:
+			fee.Add(fee, big.NewInt(int64(d.Amount)))
+		}
+	}
+	for _, out := range rec.MsgTx.TxOut {
+		switch {
+		case out.CoinType != ct:
+		case ct.IsSKA() && out.SKAValue != nil:
+			fee.Sub(fee, out.SKAValue)
+		default:
+			fee.Sub(fee, big.NewInt(out.Value))
+		}
+	}
+	if fee.Sign() <= 0 {
+		return nil
+	}
+
+	return udb.PutFeeStat(dbtx, &udb.FeeStat{
+		Height:   blockMeta.Height,
+		Hash:     rec.Hash,
+		Time:     blockMeta.Time,
+		CoinType: ct,
+		Fee:      fee,
+	})
+}
+
+// FeeSummary describes the fees paid by the wallet's mined transactions over
+// a window of days ending today, counted from the fees recorded as the
+// transactions were mined.  Days begin at midnight UTC and are assigned by
+// block timestamp.
+type FeeSummary struct {
+	Days      int
+	Since     time.Time
+	CoinTypes []FeeSummaryCoinType
+
+	// Daily lists the fees paid on each day with fees in the window.
+	Daily []udb.FeeDay
+}
+
+// FeeSummaryCoinType describes the fees of a coin type paid over the window
+// of a FeeSummary, in atoms of the coin type.  AverageFee is the mean fee of
+// a transaction and DailyAverage the mean fees of a day of the window,
+// rounded down.
+type FeeSummaryCoinType struct {
+	CoinType     cointype.CoinType
+	Transactions uint32
+	TotalFees    *big.Int
+	AverageFee   *big.Int
+	DailyAverage *big.Int
+}
+
+// FeeSummary returns the fees paid by the wallet over the last days days,
+// including today, by coin type.  A zero days summarizes every recorded fee,
+// over a window beginning on the first day with fees.
+func (w *Wallet) FeeSummary(ctx context.Context, days int) (*FeeSummary, error) {
+	const op errors.Op = "wallet.FeeSummary"
+
+	if days < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative days")
+	}
+	const day = 24 * time.Hour
+	today := time.Now().UTC().Truncate(day)
+	var since time.Time
+	if days != 0 {
+		since = today.Add(-time.Duration(days-1) * day)
+	}
+
+	var feeDays []*udb.FeeDay
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		feeDays, err = udb.FeeDays(dbtx, since)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	res := &FeeSummary{
+		Days:  days,
+		Since: since,
+		Daily: make([]udb.FeeDay, 0, len(feeDays)),
+	}
+	if days == 0 {
+		res.Since = today
+		if len(feeDays) != 0 {
+			res.Since = time.Unix(feeDays[0].Day*int64(day/time.Second), 0).UTC()
+		}
+		res.Days = int(today.Sub(res.Since)/day) + 1
+	}
+	byCoinType := make(map[cointype.CoinType]*FeeSummaryCoinType)
+	var coinTypes []cointype.CoinType
+	for _, d := range feeDays {
+		res.Daily = append(res.Daily, *d)
+		s := byCoinType[d.CoinType]
+		if s == nil {
+			s = &FeeSummaryCoinType{CoinType: d.CoinType, TotalFees: new(big.Int)}
+			byCoinType[d.CoinType] = s
+			coinTypes = append(coinTypes, d.CoinType)
+		}
+		s.Transactions += d.Transactions
+		s.TotalFees.Add(s.TotalFees, d.Fees)
+	}
+	slices.Sort(coinTypes)
+	for _, ct := range coinTypes {
+		s := byCoinType[ct]
+		s.AverageFee = new(big.Int).Quo(s.TotalFees, big.NewInt(int64(s.Transactions)))
+		s.DailyAverage = new(big.Int).Quo(s.TotalFees, big.NewInt(int64(res.Days)))
+		res.CoinTypes = append(res.CoinTypes, *s)
+	}
+	return res, nil
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package hardware implements transaction signing by external signing devices,
+// such as hardware wallets, which hold private keys that are never revealed to
+// the wallet.
+//
+// A Source is a txauthor.SecretsSource which sends each transaction to a device
+// together with the derivation path of the key signing every input and the
+// coin type and amount of every output, so the device may display the outputs
+// for the user to confirm before signing.  Devices are reached through a
+// Transport, allowing any connection (USB, a bridge process, or an emulator)
+// to be used.
+package hardware
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/txauthor"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/dcrec"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// Transport exchanges messages with a signing device.  Exchange sends a
+// JSON-encoded request and blocks until the device responds, which may include
+// waiting for the user to confirm the request on the device.
+type Transport interface {
+	Exchange(request []byte) (response []byte, err error)
+}
+
+// KeyPaths looks up the BIP0032 derivation paths, beginning at the master
+// key, of the keys of wallet addresses.  Errors with code errors.NotExist
+// describe addresses which do not belong to the wallet.
+type KeyPaths interface {
+	KeyPath(addr stdaddr.Address) ([]uint32, error)
+}
+
+// Source is a txauthor.SecretsSource which delegates signing to a device.  Only
+// inputs redeeming P2PKH outputs (including stake-tagged outputs) can be
+// signed.
+type Source struct {
+	params    *chaincfg.Params
+	transport Transport
+	paths     KeyPaths
+}
+
+var _ txauthor.InputSigner = (*Source)(nil)
+
+// NewSource returns a Source which signs with the device reached through t.
+// The derivation paths of input keys and change outputs are looked up using
+// paths.
+func NewSource(params *chaincfg.Params, t Transport, paths KeyPaths) *Source {
+	return &Source{
+		params:    params,
+		transport: t,
+		paths:     paths,
+	}
+}
+
+// ChainParams returns the chain parameters.
+func (s *Source) ChainParams() *chaincfg.Params {
+	return s.params
+}
+
+// GetKey always errors, as private keys are never revealed by the device.
+func (s *Source) GetKey(addr stdaddr.Address) ([]byte, dcrec.SignatureType, bool, error) {
+	return nil, 0, false, errors.E(errors.Invalid, "private keys are held "+
+		"by the signing device")
+}
+
+// GetScript always errors, as P2SH outputs can not be signed by the device.
+func (s *Source) GetScript(addr stdaddr.Address) ([]byte, error) {
+	return nil, errors.E(errors.NotExist, "redeem scripts are not supported "+
+		"by the signing device")
+}
+
+// inputAddress returns the P2PKH address of a previous output script.
+func (s *Source) inputAddress(prevScript []byte) (*stdaddr.AddressPubKeyHashEcdsaSecp256k1V0, error) {
+	_, addrs := stdscript.ExtractAddrs(0, prevScript, s.params)
+	if len(addrs) == 1 {
+		if addr, ok := addrs[0].(*stdaddr.AddressPubKeyHashEcdsaSecp256k1V0); ok {
+			return addr, nil
+		}
+	}
+	return nil, errors.E(errors.Invalid, "signing device only signs P2PKH "+
+		"inputs")
+}
+
+// SignInputs sends the transaction to the device to sign every input, and sets
+// the signature scripts from the returned signatures.
+func (s *Source) SignInputs(tx *wire.MsgTx, prevPkScripts [][]byte) error {
+	const op errors.Op = "hardware.SignInputs"
+
+	if len(prevPkScripts) != len(tx.TxIn) {
+		return errors.E(op, errors.Invalid, "previous script count does "+
+			"not match transaction inputs")
+	}
+
+	var rawTx bytes.Buffer
+	rawTx.Grow(tx.SerializeSize())
+	err := tx.Serialize(&rawTx)
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	req := &signTxRequest{
+		Version:     protocolVersion,
+		Type:        "signtx",
+		Network:     s.params.Name,
+		Transaction: hex.EncodeToString(rawTx.Bytes()),
+		Inputs:      make([]signTxInput, len(tx.TxIn)),
+		Outputs:     make([]signTxOutput, len(tx.TxOut)),
+	}
+
+	addrs := make([]*stdaddr.AddressPubKeyHashEcdsaSecp256k1V0, len(tx.TxIn))
+	for i, txIn := range tx.TxIn {
+		addr, err := s.inputAddress(prevPkScripts[i])
+		if err != nil {
+			return errors.E(op, errors.Errorf("input %d: %v", i, err))
+		}
+		path, err := s.paths.KeyPath(addr)
+		if err != nil {
+			return errors.E(op, errors.Errorf("input %d: %v", i, err))
+		}
+		addrs[i] = addr
+		in := &req.Inputs[i]
+		in.Path = path
+		in.PrevScript = hex.EncodeToString(prevPkScripts[i])
+		in.Value = txIn.ValueIn
+		if txIn.SKAValueIn != nil {
+			in.SKAValue = txIn.SKAValueIn.String()
+		}
+	}
+
+	for i, txOut := range tx.TxOut {
+		out := &req.Outputs[i]
+		out.CoinType = uint8(txOut.CoinType)
+		out.Value = txOut.Value
+		if txOut.CoinType.IsSKA() && txOut.SKAValue != nil {
+			out.SKAValue = txOut.SKAValue.String()
+		}
+		_, outAddrs := stdscript.ExtractAddrs(txOut.Version, txOut.PkScript,
+			s.params)
+		if len(outAddrs) != 1 {
+			continue
+		}
+		out.Address = outAddrs[0].String()
+		path, err := s.paths.KeyPath(outAddrs[0])
+		switch {
+		case errors.Is(err, errors.NotExist):
+		case err != nil:
+			return errors.E(op, errors.Errorf("output %d: %v", i, err))
+		case isChangePath(path):
+			out.ChangePath = path
+		}
+	}
+
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	respJSON, err := s.transport.Exchange(reqJSON)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	resp := new(signTxResponse)
+	err = json.Unmarshal(respJSON, resp)
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	if resp.Error != "" {
+		return errors.E(op, errors.Errorf("signing device: %s", resp.Error))
+	}
+	if len(resp.Signatures) != len(tx.TxIn) {
+		return errors.E(op, errors.Encoding, errors.Errorf("signing device "+
+			"returned %d signatures for %d inputs", len(resp.Signatures),
+			len(tx.TxIn)))
+	}
+
+	// Every signature must be created by the key of the address being
+	// redeemed before any signature script is modified.
+	scripts := make([][]byte, len(tx.TxIn))
+	for i, rs := range resp.Signatures {
+		sig, err := hex.DecodeString(rs.Signature)
+		if err != nil {
+			return errors.E(op, errors.Encoding, err)
+		}
+		pubKey, err := hex.DecodeString(rs.PubKey)
+		if err != nil {
+			return errors.E(op, errors.Encoding, err)
+		}
+		if !bytes.Equal(dcrutil.Hash160(pubKey), addrs[i].Hash160()[:]) {
+			return errors.E(op, errors.Invalid, errors.Errorf("signing "+
+				"device returned the wrong public key for input %d", i))
+		}
+		scripts[i], err = txscript.NewScriptBuilder().AddData(sig).
+			AddData(pubKey).Script()
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+	for i, script := range scripts {
+		tx.TxIn[i].SignatureScript = script
+	}
+	return nil
+}
+
+// isChangePath returns whether a BIP0044 path derives an internal branch key.
+func isChangePath(path []uint32) bool {
+	return len(path) >= 2 && path[len(path)-2] == 1
+}
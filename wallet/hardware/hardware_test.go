@@ -0,0 +1,141 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package hardware
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// testDevice records the last request and responds with resp.
+type testDevice struct {
+	req  *signTxRequest
+	resp *signTxResponse
+}
+
+func (d *testDevice) Exchange(request []byte) ([]byte, error) {
+	d.req = new(signTxRequest)
+	err := json.Unmarshal(request, d.req)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(d.resp)
+}
+
+// testPaths maps addresses to derivation paths.
+type testPaths map[string][]uint32
+
+func (p testPaths) KeyPath(addr stdaddr.Address) ([]uint32, error) {
+	path, ok := p[addr.String()]
+	if !ok {
+		return nil, errors.E(errors.NotExist, "unknown address")
+	}
+	return path, nil
+}
+
+func TestSignInputs(t *testing.T) {
+	t.Parallel()
+
+	params := chaincfg.SimNetParams()
+	pubKey := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{1}, 32)).
+		PubKey().SerializeCompressed()
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		dcrutil.Hash160(pubKey), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	change, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		make([]byte, 20), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const hardened = 1 << 31
+	inputPath := []uint32{44 + hardened, 1 + hardened, hardened, 0, 3}
+	changePath := []uint32{44 + hardened, 1 + hardened, hardened, 1, 5}
+	paths := testPaths{
+		addr.String():   inputPath,
+		change.String(): changePath,
+	}
+
+	skaValue, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	tx := wire.NewMsgTx()
+	skaIn := wire.NewTxIn(&wire.OutPoint{Index: 0}, 0, nil)
+	skaIn.SKAValueIn = skaValue
+	tx.AddTxIn(skaIn)
+	_, prevScript := addr.PaymentScript()
+	_, changeScript := change.PaymentScript()
+	payment := wire.NewTxOut(0, prevScript)
+	payment.CoinType = cointype.CoinType(1)
+	payment.SKAValue = skaValue
+	tx.AddTxOut(payment)
+	tx.AddTxOut(wire.NewTxOut(0, changeScript))
+
+	sig := []byte{0x30, 1, 2, byte(txscript.SigHashAll)}
+	device := &testDevice{resp: &signTxResponse{
+		Signatures: []inputSignature{{
+			Signature: hex.EncodeToString(sig),
+			PubKey:    hex.EncodeToString(pubKey),
+		}},
+	}}
+	s := NewSource(params, device, paths)
+	err = s.SignInputs(tx, [][]byte{prevScript})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := device.req
+	if len(req.Inputs) != 1 || !slices.Equal(req.Inputs[0].Path, inputPath) {
+		t.Errorf("input path was not reported: %+v", req.Inputs)
+	}
+	if req.Inputs[0].SKAValue != skaValue.String() {
+		t.Errorf("input SKA value %q, want %q", req.Inputs[0].SKAValue, skaValue)
+	}
+	out := req.Outputs[0]
+	if out.CoinType != 1 || out.SKAValue != skaValue.String() ||
+		out.Address != addr.String() || out.ChangePath != nil {
+		t.Errorf("unexpected payment output %+v", out)
+	}
+	if !slices.Equal(req.Outputs[1].ChangePath, changePath) {
+		t.Errorf("change output was not reported: %+v", req.Outputs[1])
+	}
+	want, err := txscript.NewScriptBuilder().AddData(sig).AddData(pubKey).Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tx.TxIn[0].SignatureScript, want) {
+		t.Errorf("signature script %x, want %x", tx.TxIn[0].SignatureScript, want)
+	}
+
+	// Signatures by a key other than the one of the redeemed address are
+	// rejected without modifying the transaction.
+	tx.TxIn[0].SignatureScript = nil
+	device.resp.Signatures[0].PubKey = hex.EncodeToString(make([]byte, 33))
+	err = s.SignInputs(tx, [][]byte{prevScript})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("wrong public key: expected Invalid error, got %v", err)
+	}
+	if tx.TxIn[0].SignatureScript != nil {
+		t.Error("signature script was set after an invalid signature")
+	}
+
+	// Device errors, such as rejection by the user, are returned.
+	device.resp = &signTxResponse{Error: "rejected by user"}
+	if err := s.SignInputs(tx, [][]byte{prevScript}); err == nil {
+		t.Error("device rejection did not error")
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package hardware
+
+// protocolVersion is the version of the messages exchanged with devices.
+const protocolVersion = 1
+
+// signTxRequest is the JSON message requesting a device to sign every input of
+// a transaction.  The device must display each output which is not change
+// before signing.  SKA values are encoded as decimal atoms.
+type signTxRequest struct {
+	Version     uint32         `json:"version"`
+	Type        string         `json:"type"`
+	Network     string         `json:"network"`
+	Transaction string         `json:"transaction"`
+	Inputs      []signTxInput  `json:"inputs"`
+	Outputs     []signTxOutput `json:"outputs"`
+}
+
+// signTxInput describes the previous output spent by an input and the
+// derivation path of the key which must sign it.  SKAValue is set for inputs
+// spending SKA outputs.
+type signTxInput struct {
+	Path       []uint32 `json:"path"`
+	PrevScript string   `json:"prevscript"`
+	Value      int64    `json:"value"`
+	SKAValue   string   `json:"skavalue,omitempty"`
+}
+
+// signTxOutput describes an output to display.  ChangePath is the derivation
+// path of the key of outputs paying change back to the signer, which are not
+// displayed.
+type signTxOutput struct {
+	Address    string   `json:"address,omitempty"`
+	CoinType   uint8    `json:"cointype"`
+	Value      int64    `json:"value"`
+	SKAValue   string   `json:"skavalue,omitempty"`
+	ChangePath []uint32 `json:"changepath,omitempty"`
+}
+
+// signTxResponse is the JSON message returned by a device, with a signature for
+// each input of the request, or an error describing why the device did not
+// sign, such as the user rejecting the transaction.
+type signTxResponse struct {
+	Signatures []inputSignature `json:"signatures"`
+	Error      string           `json:"error,omitempty"`
+}
+
+// inputSignature is the signature of an input, including the trailing
+// signature hash type byte, and the serialized compressed public key which
+// created it.
+type inputSignature struct {
+	Signature string `json:"signature"`
+	PubKey    string `json:"pubkey"`
+}
@@ -0,0 +1,366 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/internal/fiatrate"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// HistoryFormat is the file format of an exported transaction history.
+type HistoryFormat int
+
+// History export formats.
+const (
+	// HistoryCSV writes a header row naming the columns followed by one
+	// row per transaction.
+	HistoryCSV HistoryFormat = iota
+
+	// HistoryJSON writes a JSON array of one object per transaction.
+	HistoryJSON
+)
+
+var historyFormatNames = [...]string{
+	HistoryCSV:  "csv",
+	HistoryJSON: "json",
+}
+
+// String returns the name of the history format.
+func (f HistoryFormat) String() string {
+	if f < 0 || int(f) >= len(historyFormatNames) {
+		return "unknown"
+	}
+	return historyFormatNames[f]
+}
+
+// ParseHistoryFormat returns the history format with a name returned by
+// HistoryFormat.String.
+func ParseHistoryFormat(name string) (HistoryFormat, error) {
+	for f, n := range historyFormatNames {
+		if n == name {
+			return HistoryFormat(f), nil
+		}
+	}
+	return 0, errors.E(errors.Invalid, errors.Errorf("unknown history "+
+		"format %q", name))
+}
+
+// HistoryRecord describes a mined wallet transaction for accounting.  Amounts
+// are decimal strings in whole coins of the coin type of the transaction, so
+// SKA amounts keep their full precision.
+type HistoryRecord struct {
+	TxID        string `json:"txid"`
+	BlockHeight int32  `json:"blockheight"`
+	BlockHash   string `json:"blockhash"`
+	BlockTime   string `json:"blocktime"` // RFC 3339, UTC
+	TxClass     string `json:"txclass"`
+	SSFeeType   string `json:"ssfeetype"` // MF, SF, or empty
+	CoinType    uint8  `json:"cointype"`
+
+	// Received is the total of all wallet outputs, including change, and
+	// Sent is the total of all spent wallet outputs.
+	Received string `json:"received"`
+	Sent     string `json:"sent"`
+
+	// Amount is the change of the wallet balance, Received - Sent.
+	Amount string `json:"amount"`
+
+	// Fee is the fee paid by the wallet, or empty when the transaction
+	// spends outputs not controlled by the wallet.
+	Fee string `json:"fee"`
+
+	// Label is the transaction label, which records the fiat amounts and
+	// exchange rate of payments sent with a fiat currency.
+	Label string `json:"label"`
+
+	// FiatCurrency, FiatPrice, and FiatPriceTime describe the latest price
+	// of one coin recorded at or before the block time, and FiatAmount
+	// values Amount at this price.  They are empty when no price is
+	// recorded.
+	FiatCurrency  string `json:"fiatcurrency"`
+	FiatPrice     string `json:"fiatprice"`
+	FiatPriceTime string `json:"fiatpricetime"` // RFC 3339, UTC
+	FiatAmount    string `json:"fiatamount"`
+}
+
+// historyColumns names the CSV columns of the fields of a HistoryRecord.
+var historyColumns = []string{
+	"txid", "blockheight", "blockhash", "blocktime", "txclass", "ssfeetype",
+	"cointype", "received", "sent", "amount", "fee", "label", "fiatcurrency",
+	"fiatprice", "fiatpricetime", "fiatamount",
+}
+
+func (r *HistoryRecord) csvRow() []string {
+	return []string{
+		r.TxID,
+		strconv.Itoa(int(r.BlockHeight)),
+		r.BlockHash,
+		r.BlockTime,
+		r.TxClass,
+		r.SSFeeType,
+		strconv.Itoa(int(r.CoinType)),
+		r.Received,
+		r.Sent,
+		r.Amount,
+		r.Fee,
+		r.Label,
+		r.FiatCurrency,
+		r.FiatPrice,
+		r.FiatPriceTime,
+		r.FiatAmount,
+	}
+}
+
+// atomsPerCoin returns the number of atoms in one coin of a coin type.
+func atomsPerCoin(ct cointype.CoinType, params *chaincfg.Params) *big.Int {
+	if !ct.IsSKA() {
+		return big.NewInt(cointype.AtomsPerVAR)
+	}
+	if config, ok := params.SKACoins[ct]; ok && config.AtomsPerCoin != nil {
+		return config.AtomsPerCoin
+	}
+	return cointype.AtomsPerSKACoin
+}
+
+// formatCoins formats an amount of atoms as a decimal number of coins with
+// every decimal place of the coin type.
+func formatCoins(atoms, perCoin *big.Int) string {
+	decimals := len(perCoin.String()) - 1
+	return new(big.Rat).SetFrac(atoms, perCoin).FloatString(decimals)
+}
+
+// makeHistoryRecord describes the mined transaction details for accounting.
+func makeHistoryRecord(dbtx walletdb.ReadTx, details *udb.TxDetails,
+	params *chaincfg.Params) (*HistoryRecord, error) {
+
+	ct := txCoinType(&details.MsgTx)
+	perCoin := atomsPerCoin(ct, params)
+
+	received, sent := new(big.Int), new(big.Int)
+	for i := range details.Credits {
+		c := &details.Credits[i]
+		switch {
+		case c.CoinType != ct:
+		case ct.IsSKA():
+			received.Add(received, c.SKAAmount.BigInt())
+		default:
+			received.Add(received, big.NewInt(int64(c.Amount)))
+		}
+	}
+	for i := range details.Debits {
+		d := &details.Debits[i]
+		switch {
+		case d.CoinType != ct:
+		case ct.IsSKA():
+			sent.Add(sent, d.SKAAmount.BigInt())
+		default:
+			sent.Add(sent, big.NewInt(int64(d.Amount)))
+		}
+	}
+
+	// The fee can only be determined if every input is a debit.
+	var fee string
+	if len(details.Debits) == len(details.MsgTx.TxIn) {
+		outputs := new(big.Int)
+		for _, out := range details.MsgTx.TxOut {
+			if ct.IsSKA() && out.SKAValue != nil {
+				outputs.Add(outputs, out.SKAValue)
+			} else if !ct.IsSKA() {
+				outputs.Add(outputs, big.NewInt(out.Value))
+			}
+		}
+		fee = formatCoins(new(big.Int).Sub(sent, outputs), perCoin)
+	}
+
+	amount := new(big.Int).Sub(received, sent)
+	r := &HistoryRecord{
+		TxID:        details.Hash.String(),
+		BlockHeight: details.Block.Height,
+		BlockHash:   details.Block.Hash.String(),
+		BlockTime:   details.Block.Time.UTC().Format(time.RFC3339),
+		TxClass:     txClass(&details.TxRecord).String(),
+		SSFeeType:   udb.SSFeeType(&details.MsgTx),
+		CoinType:    uint8(ct),
+		Received:    formatCoins(received, perCoin),
+		Sent:        formatCoins(sent, perCoin),
+		Amount:      formatCoins(amount, perCoin),
+		Fee:         fee,
+		Label:       udb.TxLabel(dbtx, &details.Hash),
+	}
+	price, err := udb.PriceSnapshotAt(dbtx, ct, details.Block.Time)
+	switch {
+	case errors.Is(err, errors.NotExist):
+	case err != nil:
+		return nil, err
+	default:
+		fiat := new(big.Rat).SetFrac(amount, perCoin)
+		fiat.Mul(fiat, price.Price)
+		r.FiatCurrency = price.Currency
+		r.FiatPrice = fiatrate.FormatPrice(price.Price)
+		r.FiatPriceTime = price.Time.UTC().Format(time.RFC3339)
+		r.FiatAmount = fiatrate.FormatPrice(fiat)
+	}
+	return r, nil
+}
+
+// historyWriter encodes history records in a format.
+type historyWriter struct {
+	format HistoryFormat
+	buf    *bufio.Writer
+	csv    *csv.Writer
+	n      int
+}
+
+func newHistoryWriter(w io.Writer, format HistoryFormat) (*historyWriter, error) {
+	hw := &historyWriter{format: format, buf: bufio.NewWriter(w)}
+	switch format {
+	case HistoryCSV:
+		hw.csv = csv.NewWriter(hw.buf)
+		return hw, hw.csv.Write(historyColumns)
+	case HistoryJSON:
+		_, err := hw.buf.WriteString("[")
+		return hw, err
+	default:
+		return nil, errors.E(errors.Invalid, errors.Errorf("unknown history "+
+			"format %v", format))
+	}
+}
+
+func (hw *historyWriter) write(r *HistoryRecord) error {
+	hw.n++
+	if hw.csv != nil {
+		return hw.csv.Write(r.csvRow())
+	}
+	if hw.n > 1 {
+		if _, err := hw.buf.WriteString(","); err != nil {
+			return err
+		}
+	}
+	if _, err := hw.buf.WriteString("\n"); err != nil {
+		return err
+	}
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	_, err = hw.buf.Write(b)
+	return err
+}
+
+// flush writes all buffered records.
+func (hw *historyWriter) flush() error {
+	if hw.csv != nil {
+		hw.csv.Flush()
+		if err := hw.csv.Error(); err != nil {
+			return err
+		}
+	}
+	return hw.buf.Flush()
+}
+
+// close ends the encoding and flushes all buffered records.
+func (hw *historyWriter) close() error {
+	if hw.format == HistoryJSON {
+		if _, err := hw.buf.WriteString("\n]\n"); err != nil {
+			return err
+		}
+	}
+	return hw.flush()
+}
+
+// WriteHistory writes every mined wallet transaction to out in the format,
+// in increasing block height order, and returns the number of transactions
+// written.  Records are written as the transactions of each block are read,
+// so the history is never held in memory.
+func (w *Wallet) WriteHistory(ctx context.Context, out io.Writer,
+	format HistoryFormat) (int, error) {
+
+	const op errors.Op = "wallet.WriteHistory"
+
+	hw, err := newHistoryWriter(out, format)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	_, tipHeight := w.MainChainTip(ctx)
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		rangeFn := func(details []udb.TxDetails) (bool, error) {
+			for i := range details {
+				r, err := makeHistoryRecord(dbtx, &details[i], w.chainParams)
+				if err != nil {
+					return false, err
+				}
+				if err := hw.write(r); err != nil {
+					return false, errors.E(errors.IO, err)
+				}
+			}
+			if err := hw.flush(); err != nil {
+				return false, errors.E(errors.IO, err)
+			}
+			return false, nil
+		}
+		return w.txStore.RangeTransactions(ctx, txmgrNs, 0, tipHeight, rangeFn)
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	if err := hw.close(); err != nil {
+		return 0, errors.E(op, errors.IO, err)
+	}
+	return hw.n, nil
+}
+
+// ExportHistory writes the transaction history, as written by WriteHistory,
+// to a new file at path and returns the number of exported transactions.  The
+// history is written to a temporary file which is renamed to path after it is
+// complete.  Errors with code errors.Exist are returned if a file already
+// exists at path.
+func (w *Wallet) ExportHistory(ctx context.Context, path string,
+	format HistoryFormat) (int, error) {
+
+	const op errors.Op = "wallet.ExportHistory"
+	if _, err := os.Stat(path); err == nil {
+		return 0, errors.E(op, errors.Exist, errors.Errorf("%q already exists", path))
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return 0, errors.E(op, errors.IO, err)
+	}
+	defer os.Remove(f.Name())
+	n, err := w.WriteHistory(ctx, f, format)
+	if err != nil {
+		f.Close()
+		return 0, errors.E(op, err)
+	}
+	err = f.Sync()
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return 0, errors.E(op, errors.IO, err)
+	}
+	err = os.Rename(f.Name(), path)
+	if err != nil {
+		return 0, errors.E(op, errors.IO, err)
+	}
+	logCtx(ctx).Infof("Exported %d transactions to %s", n, path)
+	return n, nil
+}
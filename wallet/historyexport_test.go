@@ -0,0 +1,138 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+func TestParseHistoryFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, f := range []HistoryFormat{HistoryCSV, HistoryJSON} {
+		got, err := ParseHistoryFormat(f.String())
+		if err != nil {
+			t.Fatalf("%v: %v", f, err)
+		}
+		if got != f {
+			t.Errorf("parsed %q as %v", f.String(), got)
+		}
+	}
+	if _, err := ParseHistoryFormat("xml"); !errors.Is(err, errors.Invalid) {
+		t.Errorf("unknown format: got error %v, want Invalid", err)
+	}
+}
+
+func TestFormatCoins(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		atoms   int64
+		perCoin int64
+		want    string
+	}{
+		{150000000, 1e8, "1.50000000"},
+		{-1, 1e8, "-0.00000001"},
+		{0, 1e8, "0.00000000"},
+		{1, 1e18, "0.000000000000000001"},
+	}
+	for _, tc := range tests {
+		got := formatCoins(big.NewInt(tc.atoms), big.NewInt(tc.perCoin))
+		if got != tc.want {
+			t.Errorf("formatCoins(%d, %d) = %q, want %q", tc.atoms,
+				tc.perCoin, got, tc.want)
+		}
+	}
+}
+
+func TestHistoryWriter(t *testing.T) {
+	t.Parallel()
+
+	records := []*HistoryRecord{{
+		TxID:          "aa",
+		BlockHeight:   10,
+		BlockHash:     "bb",
+		BlockTime:     "2024-01-02T03:04:05Z",
+		TxClass:       "regular",
+		CoinType:      0,
+		Received:      "1.00000000",
+		Sent:          "2.00000000",
+		Amount:        "-1.00000000",
+		Fee:           "0.00010000",
+		Label:         "fiat USD a=1, b=2",
+		FiatCurrency:  "USD",
+		FiatPrice:     "2.5",
+		FiatPriceTime: "2024-01-02T00:00:00Z",
+		FiatAmount:    "-2.5",
+	}, {
+		TxID:        "cc",
+		BlockHeight: 11,
+		BlockHash:   "dd",
+		BlockTime:   "2024-01-02T03:09:05Z",
+		TxClass:     "ssfee",
+		SSFeeType:   "SF",
+		CoinType:    1,
+		Received:    "0.5",
+		Sent:        "0",
+		Amount:      "0.5",
+	}}
+
+	var buf bytes.Buffer
+	hw, err := newHistoryWriter(&buf, HistoryCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range records {
+		if err := hw.write(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := hw.close(); err != nil {
+		t.Fatal(err)
+	}
+	wantCSV := "txid,blockheight,blockhash,blocktime,txclass,ssfeetype,cointype,received,sent,amount,fee,label,fiatcurrency,fiatprice,fiatpricetime,fiatamount\n" +
+		"aa,10,bb,2024-01-02T03:04:05Z,regular,,0,1.00000000,2.00000000,-1.00000000,0.00010000,\"fiat USD a=1, b=2\",USD,2.5,2024-01-02T00:00:00Z,-2.5\n" +
+		"cc,11,dd,2024-01-02T03:09:05Z,ssfee,SF,1,0.5,0,0.5,,,,,,\n"
+	if buf.String() != wantCSV {
+		t.Errorf("CSV export:\n%s\nwant:\n%s", buf.String(), wantCSV)
+	}
+
+	for _, n := range []int{0, len(records)} {
+		buf.Reset()
+		hw, err = newHistoryWriter(&buf, HistoryJSON)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range records[:n] {
+			if err := hw.write(r); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := hw.close(); err != nil {
+			t.Fatal(err)
+		}
+		var decoded []HistoryRecord
+		if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+			t.Fatalf("JSON export of %d records: %v", n, err)
+		}
+		if len(decoded) != n {
+			t.Fatalf("JSON export decoded %d records, want %d", len(decoded), n)
+		}
+		for i := range decoded {
+			if decoded[i] != *records[i] {
+				t.Errorf("JSON record %d: %+v, want %+v", i, decoded[i], *records[i])
+			}
+		}
+	}
+
+	if _, err := newHistoryWriter(&buf, HistoryFormat(2)); !errors.Is(err, errors.Invalid) {
+		t.Errorf("unknown format: got error %v, want Invalid", err)
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// minInheritanceDelay is the shortest delay of a dead-man's switch.
+const minInheritanceDelay = 24 * time.Hour
+
+// SetInheritance configures the dead-man's switch of an account.  The wallet
+// maintains pre-signed transactions, one for each coin type, which sweep every
+// spendable output of the account to the recovery address and may not be mined
+// until delay after they were created.  The transactions are re-created with a
+// new lock time whenever the wallet is unlocked or sends a transaction, so they
+// only become valid if the wallet is unused for the delay.  The transactions are
+// never published by the wallet, and are returned by InheritanceSwitches to be
+// handed to the recipient.
+//
+// The transactions are created immediately if the wallet is unlocked, and
+// otherwise once it is unlocked.
+func (w *Wallet) SetInheritance(ctx context.Context, account uint32,
+	recovery stdaddr.Address, delay time.Duration) (*udb.InheritanceSwitch, error) {
+
+	const op errors.Op = "wallet.SetInheritance"
+
+	delay = delay.Truncate(time.Second)
+	if delay < minInheritanceDelay {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("delay is "+
+			"shorter than %v", minInheritanceDelay))
+	}
+	vers, script := recovery.PaymentScript()
+	s := &udb.InheritanceSwitch{
+		Account:       account,
+		ScriptVersion: vers,
+		PkScript:      script,
+		Delay:         delay,
+	}
+
+	w.inheritanceMu.Lock()
+	defer w.inheritanceMu.Unlock()
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := w.manager.AccountName(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+		_, _, err = w.manager.AccountMultisig(addrmgrNs, account)
+		switch {
+		case err == nil:
+			return errors.E(errors.Invalid, "multisig accounts may not "+
+				"have a dead-man's switch")
+		case !errors.Is(err, errors.NotExist):
+			return err
+		}
+		_, _, err = w.manager.AccountVault(addrmgrNs, account)
+		switch {
+		case err == nil:
+			return errors.E(errors.Invalid, "vault accounts may not "+
+				"have a dead-man's switch")
+		case !errors.Is(err, errors.NotExist):
+			return err
+		}
+		return udb.PutInheritanceSwitch(dbtx, s)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	logCtx(ctx).Infof("Configured dead-man's switch of account %d with a delay of %v",
+		account, delay)
+
+	if err := w.refreshInheritanceSwitch(ctx, s, time.Now()); err != nil {
+		return nil, errors.E(op, err)
+	}
+	return s, nil
+}
+
+// ClearInheritance removes the dead-man's switch of an account.  Transactions
+// which were already created remain valid once their lock time is reached
+// unless the account's outputs they spend are spent first.
+func (w *Wallet) ClearInheritance(ctx context.Context, account uint32) error {
+	const op errors.Op = "wallet.ClearInheritance"
+
+	w.inheritanceMu.Lock()
+	defer w.inheritanceMu.Unlock()
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeleteInheritanceSwitch(dbtx, account)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	logCtx(ctx).Infof("Removed dead-man's switch of account %d", account)
+	return nil
+}
+
+// InheritanceSwitches returns the dead-man's switch of every account which has
+// one, in increasing account order.
+func (w *Wallet) InheritanceSwitches(ctx context.Context) ([]udb.InheritanceSwitch, error) {
+	const op errors.Op = "wallet.InheritanceSwitches"
+
+	var switches []udb.InheritanceSwitch
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachInheritanceSwitch(dbtx, func(s *udb.InheritanceSwitch) error {
+			switches = append(switches, *s)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return switches, nil
+}
+
+// noteActivity records that the wallet was used, which refreshes the
+// transactions of every dead-man's switch.
+func (w *Wallet) noteActivity() {
+	select {
+	case w.inheritanceActivity <- struct{}{}:
+	default:
+	}
+}
+
+// refreshInheritance re-creates the transactions of every dead-man's switch.
+func (w *Wallet) refreshInheritance(ctx context.Context, now time.Time) error {
+	const op errors.Op = "wallet.refreshInheritance"
+
+	w.inheritanceMu.Lock()
+	defer w.inheritanceMu.Unlock()
+
+	switches, err := w.InheritanceSwitches(ctx)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	for i := range switches {
+		err := w.refreshInheritanceSwitch(ctx, &switches[i], now)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+	return nil
+}
+
+// refreshInheritanceSwitch re-creates the transactions of a dead-man's switch
+// with a lock time delay after now.  The previous transactions are kept, and
+// the reason is recorded, if the transactions can not be created.  The
+// inheritance mutex must be held.
+func (w *Wallet) refreshInheritanceSwitch(ctx context.Context, s *udb.InheritanceSwitch,
+	now time.Time) error {
+
+	txs, err := w.inheritanceTxs(ctx, s, now)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err != nil {
+		logCtx(ctx).Warnf("Failed to refresh dead-man's switch of account %d: %v",
+			s.Account, err)
+		s.LastError = err.Error()
+	} else {
+		s.Txs = txs
+		s.Refreshed = time.Unix(now.Unix(), 0)
+		s.LastError = ""
+	}
+	return walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		// The switch may have been removed while the transactions
+		// were created.
+		_, err := udb.InheritanceSwitchFor(dbtx, s.Account)
+		if err != nil {
+			return err
+		}
+		return udb.PutInheritanceSwitch(dbtx, s)
+	})
+}
+
+// inheritanceTxs creates and signs a transaction for each coin type sweeping
+// the spendable outputs of the account of s to its recovery script, with a lock
+// time delay after now.  Unmined outputs are included so that change of
+// transactions sent before the refresh is swept.
+func (w *Wallet) inheritanceTxs(ctx context.Context, s *udb.InheritanceSwitch,
+	now time.Time) ([]*wire.MsgTx, error) {
+
+	const op errors.Op = "wallet.inheritanceTxs"
+
+	lockTime := now.Add(s.Delay).Unix()
+	if lockTime > math.MaxUint32 {
+		return nil, errors.E(op, errors.Invalid, "lock time out of range")
+	}
+	// VAR is always swept, since ListCoinTypes only reports VAR when the
+	// account has mined outputs.
+	coinTypes, err := w.ListCoinTypes(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(coinTypes, cointype.CoinTypeVAR) {
+		coinTypes = append([]cointype.CoinType{cointype.CoinTypeVAR}, coinTypes...)
+	}
+	var txs []*wire.MsgTx
+	for _, coinType := range coinTypes {
+		a := &authorTx{
+			outputs: []*wire.TxOut{{
+				CoinType: coinType,
+				Version:  s.ScriptVersion,
+				PkScript: s.PkScript,
+			}},
+			account:        s.Account,
+			changeAccount:  s.Account,
+			txFee:          w.RelayFeeForCoinType(ctx, coinType),
+			sweep:          true,
+			futureLockTime: true,
+			lockTimes:      TxLockTimes{LockTime: uint32(lockTime)},
+		}
+		err := w.authorTx(ctx, op, a)
+		if errors.Is(err, errors.InsufficientBalance) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, a.atx.Tx)
+	}
+	return txs, nil
+}
+
+// inheritanceLoop refreshes the transactions of every dead-man's switch when
+// the wallet is used, until the context is cancelled.
+func (w *Wallet) inheritanceLoop(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.inheritanceActivity:
+		}
+
+		err := w.refreshInheritance(ctx, time.Now())
+		if err != nil && ctx.Err() == nil {
+			logCtx(ctx).Errorf("Failed to refresh dead-man's switches: %v", err)
+		}
+	}
+}
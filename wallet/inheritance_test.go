@@ -0,0 +1,122 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+)
+
+func TestInheritance(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	recv, err := w.NewExternalAddress(ctx, defaultAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, 3e8, nil))
+	funding.AddTxOut(payTo(recv, cointype.CoinTypeVAR, big.NewInt(2e8)))
+	if err := w.AddTransaction(ctx, funding, nil); err != nil {
+		t.Fatal(err)
+	}
+	recovery, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, recoveryScript := recovery.PaymentScript()
+
+	_, err = w.SetInheritance(ctx, defaultAccount, recovery, time.Hour)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("short delay: expected Invalid error, got %v", err)
+	}
+
+	// The switch of a locked wallet is recorded without transactions.
+	const delay = 30 * 24 * time.Hour
+	s, err := w.SetInheritance(ctx, defaultAccount, recovery, delay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Txs) != 0 || s.LastError == "" {
+		t.Errorf("switch of locked wallet has %d transactions, error %q",
+			len(s.Txs), s.LastError)
+	}
+
+	// Unlocking the wallet signals activity refreshing the transactions.
+	select {
+	case <-w.inheritanceActivity:
+	default:
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-w.inheritanceActivity:
+	default:
+		t.Fatal("unlocking the wallet did not signal activity")
+	}
+	now := time.Now()
+	if err := w.refreshInheritance(ctx, now); err != nil {
+		t.Fatal(err)
+	}
+	current := func() *udb.InheritanceSwitch {
+		t.Helper()
+		switches, err := w.InheritanceSwitches(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(switches) != 1 {
+			t.Fatalf("%d switches, want 1", len(switches))
+		}
+		return &switches[0]
+	}
+	s1 := current()
+	if len(s1.Txs) != 1 || s1.LastError != "" || s1.Refreshed.Unix() != now.Unix() {
+		t.Fatalf("unexpected refreshed switch %+v", s1)
+	}
+	tx := s1.Txs[0]
+	switch {
+	case int64(tx.LockTime) != now.Add(delay).Unix():
+		t.Errorf("lock time %d, want %d", tx.LockTime, now.Add(delay).Unix())
+	case len(tx.TxIn) != 1 || tx.TxIn[0].PreviousOutPoint.Hash != funding.TxHash():
+		t.Errorf("sweep does not spend the funding output")
+	case tx.TxIn[0].Sequence == wire.MaxTxInSequenceNum:
+		t.Errorf("final input sequence does not enforce the lock time")
+	case len(tx.TxOut) != 1 || !bytes.Equal(tx.TxOut[0].PkScript, recoveryScript):
+		t.Errorf("sweep does not pay only the recovery address")
+	}
+
+	// A failed refresh keeps the previous transactions.
+	w.Lock()
+	if err := w.refreshInheritance(ctx, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if s2 := current(); s2.LastError == "" || len(s2.Txs) != 1 ||
+		s2.Txs[0].TxHash() != tx.TxHash() {
+		t.Errorf("unexpected switch after failed refresh %+v", s2)
+	}
+
+	if err := w.ClearInheritance(ctx, defaultAccount); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.ClearInheritance(ctx, defaultAccount); !errors.Is(err, errors.NotExist) {
+		t.Errorf("clearing removed switch: expected NotExist error, got %v", err)
+	}
+}
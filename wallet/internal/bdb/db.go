@@ -8,6 +8,7 @@ package bdb
 import (
 	"io"
 	"os"
+	"sync"
 
 	"github.com/monetarium/monetarium-wallet/errors"
 	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
@@ -23,7 +24,7 @@ func convertErr(err error) error {
 	switch err {
 	case bolt.ErrInvalid: // Invalid database file, not invalid operation
 		kind = errors.IO
-	case bolt.ErrDatabaseNotOpen, bolt.ErrTxNotWritable, bolt.ErrTxClosed:
+	case bolt.ErrDatabaseNotOpen, bolt.ErrDatabaseReadOnly, bolt.ErrTxNotWritable, bolt.ErrTxClosed:
 		kind = errors.Invalid
 	case bolt.ErrBucketNameRequired, bolt.ErrKeyRequired, bolt.ErrKeyTooLarge, bolt.ErrValueTooLarge, bolt.ErrIncompatibleValue:
 		kind = errors.Invalid
@@ -40,6 +41,18 @@ func convertErr(err error) error {
 // provides a root bucket against which all read and writes occur.
 type transaction struct {
 	boltTx *bolt.Tx
+	db     *db
+	done   bool
+}
+
+// end releases the transaction's hold on the database, allowing the database
+// to be compacted once all transactions have ended.
+func (tx *transaction) end() {
+	if tx.done {
+		return
+	}
+	tx.done = true
+	tx.db.endTx(tx.boltTx.Writable())
 }
 
 func (tx *transaction) ReadBucket(key []byte) walletdb.ReadBucket {
@@ -75,6 +88,7 @@ func (tx *transaction) DeleteTopLevelBucket(key []byte) error {
 //
 // This function is part of the walletdb.Tx interface implementation.
 func (tx *transaction) Commit() error {
+	defer tx.end()
 	return convertErr(tx.boltTx.Commit())
 }
 
@@ -83,6 +97,7 @@ func (tx *transaction) Commit() error {
 //
 // This function is part of the walletdb.Tx interface implementation.
 func (tx *transaction) Rollback() error {
+	defer tx.end()
 	return convertErr(tx.boltTx.Rollback())
 }
 
@@ -264,17 +279,62 @@ func (c *cursor) Close() {}
 // db represents a collection of namespaces which are persisted and implements
 // the walletdb.Db interface.  All database access is performed through
 // transactions which are obtained through the specific Namespace.
-type db bolt.DB
+//
+// The bolt database is replaced when the database is compacted.  Writers
+// are excluded for the duration of the compaction, and the replacement
+// waits for all open transactions to end.
+type db struct {
+	writeMu sync.Mutex // held by writers and compaction
 
-// Enforce db implements the walletdb.Db interface.
+	mu       sync.Mutex
+	cond     sync.Cond // signaled when transactions end
+	bolt     *bolt.DB
+	open     int  // open transactions
+	swapping bool // bolt database is being replaced
+}
+
+// Enforce db implements the walletdb.Db and walletdb.Compacter interfaces.
 var _ walletdb.DB = (*db)(nil)
+var _ walletdb.Compacter = (*db)(nil)
+
+func newDB(boltDB *bolt.DB) *db {
+	db := &db{bolt: boltDB}
+	db.cond.L = &db.mu
+	return db
+}
 
 func (db *db) beginTx(writable bool) (*transaction, error) {
-	boltTx, err := (*bolt.DB)(db).Begin(writable)
+	if writable {
+		db.writeMu.Lock()
+	}
+	db.mu.Lock()
+	for db.swapping {
+		db.cond.Wait()
+	}
+	boltTx, err := db.bolt.Begin(writable)
 	if err != nil {
+		db.mu.Unlock()
+		if writable {
+			db.writeMu.Unlock()
+		}
 		return nil, convertErr(err)
 	}
-	return &transaction{boltTx: boltTx}, nil
+	db.open++
+	db.mu.Unlock()
+	return &transaction{boltTx: boltTx, db: db}, nil
+}
+
+// endTx records that a transaction begun by beginTx has ended.
+func (db *db) endTx(writable bool) {
+	db.mu.Lock()
+	db.open--
+	if db.open == 0 {
+		db.cond.Broadcast()
+	}
+	db.mu.Unlock()
+	if writable {
+		db.writeMu.Unlock()
+	}
 }
 
 func (db *db) BeginReadTx() (walletdb.ReadTx, error) {
@@ -290,16 +350,186 @@ func (db *db) BeginReadWriteTx() (walletdb.ReadWriteTx, error) {
 //
 // This function is part of the walletdb.Db interface implementation.
 func (db *db) Copy(w io.Writer) error {
-	return convertErr((*bolt.DB)(db).View(func(tx *bolt.Tx) error {
-		return tx.Copy(w)
-	}))
+	tx, err := db.beginTx(false)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	_, err = tx.boltTx.WriteTo(w)
+	return convertErr(err)
 }
 
 // Close cleanly shuts down the database and syncs all data.
 //
 // This function is part of the walletdb.Db interface implementation.
 func (db *db) Close() error {
-	return convertErr((*bolt.DB)(db).Close())
+	db.writeMu.Lock()
+	defer db.writeMu.Unlock()
+
+	db.mu.Lock()
+	boltDB := db.bolt
+	db.mu.Unlock()
+	return convertErr(boltDB.Close())
+}
+
+// compactTxSize is the approximate number of bytes of keys and values copied
+// by each transaction writing the compacted database.
+const compactTxSize = 16 * 1024 * 1024
+
+// Compact rewrites the database to a new file without the free pages left
+// behind by deleted records, and replaces the database file with it.  Read
+// transactions may continue while the database is being copied, but writers
+// are blocked until the compaction completes.  Progress, if non-nil, is
+// called with the number of keys copied and the total number of keys.
+//
+// Transactions must not be held open while beginning a read-write
+// transaction, or compaction may deadlock.
+//
+// This function is part of the walletdb.Compacter interface implementation.
+func (db *db) Compact(progress func(copied, total int)) error {
+	db.writeMu.Lock()
+	defer db.writeMu.Unlock()
+
+	db.mu.Lock()
+	src := db.bolt
+	db.mu.Unlock()
+
+	if src.IsReadOnly() {
+		return errors.E(errors.Invalid, "database is opened read-only")
+	}
+
+	dbPath := src.Path()
+	compactPath := dbPath + ".compact"
+	if err := os.Remove(compactPath); err != nil && !os.IsNotExist(err) {
+		return errors.E(errors.IO, err)
+	}
+	dst, err := bolt.Open(compactPath, 0600, nil)
+	if err != nil {
+		return convertErr(err)
+	}
+	err = compact(dst, src, progress)
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(compactPath)
+		return convertErr(err)
+	}
+
+	// Wait for all open transactions to end before replacing the database
+	// file.  New transactions wait for the replacement to complete.
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.swapping = true
+	defer func() {
+		db.swapping = false
+		db.cond.Broadcast()
+	}()
+	for db.open > 0 {
+		db.cond.Wait()
+	}
+
+	if err := src.Close(); err != nil {
+		os.Remove(compactPath)
+		return convertErr(err)
+	}
+	renameErr := os.Rename(compactPath, dbPath)
+	if renameErr != nil {
+		os.Remove(compactPath)
+	}
+	boltDB, err := bolt.Open(dbPath, 0600, nil)
+	if err != nil {
+		return convertErr(err)
+	}
+	db.bolt = boltDB
+	if renameErr != nil {
+		return errors.E(errors.IO, renameErr)
+	}
+	return nil
+}
+
+// compact copies every bucket and key of the src database to the dst
+// database, filling each page for the smallest file size.
+func compact(dst, src *bolt.DB, progress func(copied, total int)) error {
+	return src.View(func(srcTx *bolt.Tx) error {
+		var total, copied, size int
+		err := srcTx.ForEach(func(_ []byte, b *bolt.Bucket) error {
+			total += b.Stats().KeyN
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if progress != nil {
+			progress(0, total)
+		}
+
+		dstTx, err := dst.Begin(true)
+		if err != nil {
+			return err
+		}
+		defer func() { dstTx.Rollback() }()
+
+		// copyBucket recursively copies the keys and nested buckets of
+		// s to the bucket described by path, committing and reopening
+		// the destination transaction when enough data has been
+		// written.
+		var copyBucket func(path [][]byte, s *bolt.Bucket) error
+		copyBucket = func(path [][]byte, s *bolt.Bucket) error {
+			return s.ForEach(func(k, v []byte) error {
+				if size >= compactTxSize {
+					if err := dstTx.Commit(); err != nil {
+						return err
+					}
+					dstTx, err = dst.Begin(true)
+					if err != nil {
+						return err
+					}
+					size = 0
+				}
+				d := dstTx.Bucket(path[0])
+				for _, key := range path[1:] {
+					d = d.Bucket(key)
+				}
+				d.FillPercent = 1.0
+
+				size += len(k) + len(v)
+				copied++
+				if progress != nil && copied%1000 == 0 {
+					progress(min(copied, total), total)
+				}
+				if v != nil {
+					return d.Put(k, v)
+				}
+				nested := s.Bucket(k)
+				nd, err := d.CreateBucket(k)
+				if err != nil {
+					return err
+				}
+				if err := nd.SetSequence(nested.Sequence()); err != nil {
+					return err
+				}
+				return copyBucket(append(path[:len(path):len(path)], k), nested)
+			})
+		}
+		err = srcTx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			d, err := dstTx.CreateBucket(name)
+			if err != nil {
+				return err
+			}
+			if err := d.SetSequence(b.Sequence()); err != nil {
+				return err
+			}
+			return copyBucket([][]byte{name}, b)
+		})
+		if err != nil {
+			return err
+		}
+		if progress != nil {
+			progress(total, total)
+		}
+		return dstTx.Commit()
+	})
 }
 
 // filesExists reports whether the named file or directory exists.
@@ -312,12 +542,20 @@ func fileExists(name string) bool {
 	return true
 }
 
-// openDB opens the database at the provided path.
-func openDB(dbPath string, create bool) (walletdb.DB, error) {
+// openDB opens the database at the provided path.  Read-only databases may
+// be opened by several processes at once, and error on any write.
+func openDB(dbPath string, create, readOnly bool) (walletdb.DB, error) {
 	if !create && !fileExists(dbPath) {
 		return nil, errors.E(errors.NotExist, "missing database file")
 	}
 
-	boltDB, err := bolt.Open(dbPath, 0600, nil)
-	return (*db)(boltDB), convertErr(err)
+	var opts *bolt.Options
+	if readOnly {
+		opts = &bolt.Options{ReadOnly: true}
+	}
+	boltDB, err := bolt.Open(dbPath, 0600, opts)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+	return newDB(boltDB), nil
 }
@@ -10,14 +10,20 @@ datastore.
 # Usage
 
 This package is only a driver to the walletdb package and provides the database
-type of "bdb".  The only parameter the Open and Create functions take is the
-database path as a string:
+type of "bdb".  The only parameter the Create function takes is the database
+path as a string.  Open also takes the database path, optionally followed by a
+bool which opens the database read-only when true:
 
 	db, err := walletdb.Open("bdb", "path/to/database.db")
 	if err != nil {
 		// Handle error
 	}
 
+	db, err := walletdb.Open("bdb", "path/to/database.db", true)
+	if err != nil {
+		// Handle error
+	}
+
 	db, err := walletdb.Create("bdb", "path/to/database.db")
 	if err != nil {
 		// Handle error
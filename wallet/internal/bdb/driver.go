@@ -33,14 +33,25 @@ func parseArgs(funcName string, args ...any) (string, error) {
 }
 
 // openDBDriver is the callback provided during driver registration that opens
-// an existing database for use.
+// an existing database for use.  The database path may be followed by a bool
+// which opens the database read-only when true.
 func openDBDriver(args ...any) (walletdb.DB, error) {
+	var readOnly bool
+	if len(args) == 2 {
+		var ok bool
+		readOnly, ok = args[1].(bool)
+		if !ok {
+			return nil, errors.Errorf("second argument to %s.Open is "+
+				"invalid -- expected read-only bool", dbType)
+		}
+		args = args[:1]
+	}
 	dbPath, err := parseArgs("Open", args...)
 	if err != nil {
 		return nil, err
 	}
 
-	return openDB(dbPath, false)
+	return openDB(dbPath, false, readOnly)
 }
 
 // createDBDriver is the callback provided during driver registration that
@@ -51,7 +62,7 @@ func createDBDriver(args ...any) (walletdb.DB, error) {
 		return nil, err
 	}
 
-	return openDB(dbPath, true)
+	return openDB(dbPath, true, false)
 }
 
 func init() {
@@ -9,7 +9,9 @@ package bdb_test
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/monetarium/monetarium-wallet/errors"
@@ -157,3 +159,165 @@ func TestPersistence(t *testing.T) {
 		t.Fatalf("%v", err)
 	}
 }
+
+// TestCompact ensures that compacting a database preserves its values and
+// reclaims the space of deleted values while it remains open.
+func TestCompact(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "compacttest.db")
+	db, err := walletdb.Create(dbType, dbPath)
+	if err != nil {
+		t.Fatalf("Failed to create test database (%s) %v", dbType, err)
+	}
+	defer db.Close()
+
+	bucketKey := []byte("bucket")
+	nestedKey := []byte("nested")
+	value := bytes.Repeat([]byte{0xff}, 1024)
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket(bucketKey)
+		if err != nil {
+			return err
+		}
+		nested, err := b.CreateBucket(nestedKey)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < 4096; i++ {
+			k := []byte(fmt.Sprintf("key%04d", i))
+			if err := b.Put(k, value); err != nil {
+				return err
+			}
+			if err := nested.Put(k, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		b := tx.ReadWriteBucket(bucketKey)
+		for i := 1; i < 4096; i++ {
+			k := []byte(fmt.Sprintf("key%04d", i))
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fi, err := os.Stat(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sizeBefore := fi.Size()
+
+	// Hold a read transaction open while compacting to ensure the database
+	// is only replaced after it ends.
+	rtx, err := db.BeginReadTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	var copied, total int
+	go func() {
+		done <- db.(walletdb.Compacter).Compact(func(c, t int) {
+			copied, total = c, t
+		})
+	}()
+	if v := rtx.ReadBucket(bucketKey).Get([]byte("key0000")); !bytes.Equal(v, value) {
+		t.Errorf("read transaction value changed during compaction")
+	}
+	if err := rtx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("Compact: unexpected error: %v", err)
+	}
+	if copied != total || total == 0 {
+		t.Errorf("Compact progress: copied %d of %d keys", copied, total)
+	}
+
+	fi, err = os.Stat(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Size() >= sizeBefore {
+		t.Errorf("Compact did not shrink database: %d bytes, was %d",
+			fi.Size(), sizeBefore)
+	}
+
+	// Ensure the remaining values exist and the compacted database is
+	// writable.
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		b := tx.ReadWriteBucket(bucketKey)
+		if v := b.Get([]byte("key0000")); !bytes.Equal(v, value) {
+			return errors.Errorf("missing value after compaction")
+		}
+		if v := b.Get([]byte("key0001")); v != nil {
+			return errors.Errorf("deleted value exists after compaction")
+		}
+		if n := b.NestedReadBucket(nestedKey).KeyN(); n != 4096 {
+			return errors.Errorf("nested bucket has %d keys after "+
+				"compaction, want 4096", n)
+		}
+		return b.Put([]byte("key0001"), value)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReadOnly ensures a database opened read-only may be read but not
+// written or compacted.
+func TestReadOnly(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "readonly.db")
+	db, err := walletdb.Create(dbType, dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := []byte("ns1")
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		_, err := tx.CreateTopLevelBucket(key)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	db, err = walletdb.Open(dbType, dbPath, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	err = walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+		if tx.ReadBucket(key) == nil {
+			return errors.Errorf("missing bucket")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		return nil
+	})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("Update of read-only database: unexpected error: %v", err)
+	}
+	err = db.(walletdb.Compacter).Compact(nil)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("Compact of read-only database: unexpected error: %v", err)
+	}
+
+	if _, err := walletdb.Open(dbType, dbPath, "true"); err == nil {
+		t.Errorf("Open: expected error for invalid read-only argument")
+	}
+}
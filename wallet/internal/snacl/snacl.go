@@ -11,6 +11,7 @@ import (
 	"runtime/debug"
 
 	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/kdf"
 	"github.com/monetarium/monetarium-node/crypto/rand"
 	"golang.org/x/crypto/nacl/secretbox"
 	"golang.org/x/crypto/scrypt"
@@ -77,12 +78,17 @@ func GenerateCryptoKey() (*CryptoKey, error) {
 }
 
 // Parameters are not secret and can be stored in plain text.
+//
+// Keys are derived using scrypt with the salt and N, R, and P parameters,
+// unless Argon2id parameters are set, in which case the Argon2id KDF and its
+// own salt are used instead.
 type Parameters struct {
-	Salt   [KeySize]byte
-	Digest [sha256.Size]byte
-	N      int
-	R      int
-	P      int
+	Salt     [KeySize]byte
+	Digest   [sha256.Size]byte
+	N        int
+	R        int
+	P        int
+	Argon2id *kdf.Argon2idParams
 }
 
 // SecretKey houses a crypto key and the parameters needed to derive it from a
@@ -100,6 +106,13 @@ func zero(b []byte) {
 
 // deriveKey fills out the Key field.
 func (sk *SecretKey) deriveKey(op errors.Op, password *[]byte) error {
+	if sk.Parameters.Argon2id != nil {
+		key := kdf.DeriveKey(*password, sk.Parameters.Argon2id, KeySize)
+		copy(sk.Key[:], key)
+		zero(key)
+		return nil
+	}
+
 	key, err := scrypt.Key(*password, sk.Parameters.Salt[:],
 		sk.Parameters.N,
 		sk.Parameters.R,
@@ -122,11 +135,24 @@ func (sk *SecretKey) deriveKey(op errors.Op, password *[]byte) error {
 	return nil
 }
 
+// argon2idMarshalledLen is the length of marshalled parameters describing a
+// key derived with Argon2id.
+const argon2idMarshalledLen = sha256.Size + kdf.MarshaledLen
+
 // Marshal returns the Parameters field marshalled into a format suitable for
 // storage.  This result of this can be stored in clear text.
 func (sk *SecretKey) Marshal() []byte {
 	params := &sk.Parameters
 
+	// Parameters of Argon2id keys are marshalled as follows:
+	//   <digest><argon2id params>
+	if params.Argon2id != nil {
+		kdfParams, _ := params.Argon2id.MarshalBinary()
+		marshalled := make([]byte, 0, argon2idMarshalledLen)
+		marshalled = append(marshalled, params.Digest[:]...)
+		return append(marshalled, kdfParams...)
+	}
+
 	// The marshalled format for the params is as follows:
 	//   <salt><digest><N><R><P>
 	//
@@ -155,6 +181,17 @@ func (sk *SecretKey) Unmarshal(marshalled []byte) error {
 		sk.Key = (*CryptoKey)(&[KeySize]byte{})
 	}
 
+	if len(marshalled) == argon2idMarshalledLen {
+		params := &sk.Parameters
+		copy(params.Digest[:], marshalled[:sha256.Size])
+		params.Argon2id = new(kdf.Argon2idParams)
+		err := params.Argon2id.UnmarshalBinary(marshalled[sha256.Size:])
+		if err != nil {
+			return errors.E(op, errors.Encoding, err)
+		}
+		return nil
+	}
+
 	// The marshalled format for the params is as follows:
 	//   <salt><digest><N><R><P>
 	//
@@ -245,3 +282,24 @@ func NewSecretKey(password *[]byte, N, r, p int) (*SecretKey, error) {
 
 	return &sk, nil
 }
+
+// NewArgon2idSecretKey returns a SecretKey structure derived from the password
+// using the Argon2id KDF with the passed parameters.
+func NewArgon2idSecretKey(password *[]byte, params *kdf.Argon2idParams) (*SecretKey, error) {
+	const op errors.Op = "snacl.NewArgon2idSecretKey"
+	sk := SecretKey{
+		Key: (*CryptoKey)(&[KeySize]byte{}),
+	}
+	sk.Parameters.Argon2id = params
+
+	// derive key
+	err := sk.deriveKey(op, password)
+	if err != nil {
+		return nil, err
+	}
+
+	// store digest
+	sk.Parameters.Digest = sha256.Sum256(sk.Key[:])
+
+	return &sk, nil
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/kdf"
 )
 
 var (
@@ -116,3 +117,32 @@ func TestDeriveKeyInvalid(t *testing.T) {
 		t.Errorf("unexpected DeriveKey key failure: %v", err)
 	}
 }
+
+func TestArgon2idSecretKey(t *testing.T) {
+	kdfp := &kdf.Argon2idParams{Time: 1, Memory: 64, Threads: 1}
+	sk, err := NewArgon2idSecretKey(&password, kdfp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := sk.Marshal()
+
+	var sk2 SecretKey
+	if err := sk2.Unmarshal(params); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if sk2.Parameters.Argon2id == nil || *sk2.Parameters.Argon2id != *kdfp {
+		t.Fatalf("unmarshaled Argon2id params %v, want %v",
+			sk2.Parameters.Argon2id, kdfp)
+	}
+	if err := sk2.DeriveKey(&password); err != nil {
+		t.Fatalf("unexpected DeriveKey error: %v", err)
+	}
+	if !bytes.Equal(sk2.Key[:], sk.Key[:]) {
+		t.Errorf("keys not equal")
+	}
+
+	p := []byte("wrong password")
+	if err := sk2.DeriveKey(&p); !errors.Is(err, errors.Passphrase) {
+		t.Errorf("wrong password didn't fail")
+	}
+}
@@ -0,0 +1,906 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package sqlitedb
+
+import (
+	"database/sql"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// schema creates the tables of a new database.  See the package documentation
+// for a description of the schema.
+const schema = `
+CREATE TABLE buckets (
+	id INTEGER PRIMARY KEY
+);
+CREATE TABLE kv (
+	bucket INTEGER NOT NULL,
+	key    BLOB NOT NULL,
+	value  BLOB,
+	child  INTEGER,
+	PRIMARY KEY (bucket, key)
+) WITHOUT ROWID;
+`
+
+// rootBucketID is the ID of the bucket holding the top-level buckets.
+const rootBucketID = 0
+
+// forEachPageSize is the number of keys read by each query of ForEach.  Keys
+// are read in pages so that the callback may modify the bucket, and so that
+// iterating over large buckets does not read every key into memory.
+const forEachPageSize = 256
+
+// Statements prepared by each database and used by its transactions.
+const (
+	stmtSnapshot = iota
+	stmtGet
+	stmtPut
+	stmtDelete
+	stmtNewBucket
+	stmtPutBucket
+	stmtDeleteBuckets
+	stmtDeleteBucketKeys
+	stmtDeleteKey
+	stmtFirst
+	stmtLast
+	stmtNext
+	stmtPrev
+	stmtSeek
+	stmtPage
+	stmtCount
+	numStmts
+)
+
+// subBuckets selects the ID of a bucket and of all of its nested buckets.
+const subBuckets = `WITH RECURSIVE sub(id) AS (
+	VALUES (?1)
+	UNION ALL
+	SELECT kv.child FROM kv JOIN sub ON kv.bucket = sub.id WHERE kv.child IS NOT NULL
+) `
+
+var queries = [numStmts]string{
+	stmtSnapshot: `SELECT count(*) FROM (SELECT 1 FROM buckets LIMIT 1)`,
+	stmtGet:      `SELECT value, child FROM kv WHERE bucket = ?1 AND key = ?2`,
+	stmtPut: `INSERT INTO kv (bucket, key, value) VALUES (?1, ?2, ?3)
+		ON CONFLICT (bucket, key) DO UPDATE SET value = excluded.value
+		WHERE child IS NULL`,
+	stmtDelete:           `DELETE FROM kv WHERE bucket = ?1 AND key = ?2 AND child IS NULL`,
+	stmtNewBucket:        `INSERT INTO buckets DEFAULT VALUES`,
+	stmtPutBucket:        `INSERT INTO kv (bucket, key, child) VALUES (?1, ?2, ?3)`,
+	stmtDeleteBuckets:    subBuckets + `DELETE FROM buckets WHERE id IN (SELECT id FROM sub)`,
+	stmtDeleteBucketKeys: subBuckets + `DELETE FROM kv WHERE bucket IN (SELECT id FROM sub)`,
+	stmtDeleteKey:        `DELETE FROM kv WHERE bucket = ?1 AND key = ?2`,
+	stmtFirst:            `SELECT key, value, child FROM kv WHERE bucket = ?1 ORDER BY key LIMIT 1`,
+	stmtLast:             `SELECT key, value, child FROM kv WHERE bucket = ?1 ORDER BY key DESC LIMIT 1`,
+	stmtNext:             `SELECT key, value, child FROM kv WHERE bucket = ?1 AND key > ?2 ORDER BY key LIMIT 1`,
+	stmtPrev:             `SELECT key, value, child FROM kv WHERE bucket = ?1 AND key < ?2 ORDER BY key DESC LIMIT 1`,
+	stmtSeek:             `SELECT key, value, child FROM kv WHERE bucket = ?1 AND key >= ?2 ORDER BY key LIMIT 1`,
+	stmtPage:             `SELECT key, value, child FROM kv WHERE bucket = ?1 AND key > ?2 ORDER BY key LIMIT ?3`,
+	stmtCount:            `SELECT count(*) FROM kv WHERE bucket = ?1`,
+}
+
+// convertErr wraps a driver-specific error with an error code.
+func convertErr(err error) error {
+	if err == nil {
+		return nil
+	}
+	var kind errors.Kind
+	var sqliteErr sqlite3.Error
+	switch {
+	case errors.Is(err, sql.ErrTxDone), errors.Is(err, sql.ErrConnDone):
+		kind = errors.Invalid
+	case errors.As(err, &sqliteErr):
+		switch sqliteErr.Code {
+		case sqlite3.ErrNotADB, sqlite3.ErrCorrupt, sqlite3.ErrIoErr,
+			sqlite3.ErrFull, sqlite3.ErrCantOpen, sqlite3.ErrBusy:
+			kind = errors.IO
+		case sqlite3.ErrReadonly:
+			kind = errors.Invalid
+		}
+	}
+	return errors.E(kind, err)
+}
+
+// transaction represents a database transaction.  It can either be read-only
+// or read-write and implements the walletdb Tx interfaces.
+//
+// Operations which can not return errors, such as Get and cursor movement,
+// record the first error they encounter, which is returned by Commit.
+type transaction struct {
+	db       *db
+	sqlTx    *sql.Tx
+	writable bool
+	stmts    [numStmts]*sql.Stmt
+	err      error
+	done     bool
+}
+
+// stmt returns the transaction-specific instance of a prepared statement.
+func (tx *transaction) stmt(i int) *sql.Stmt {
+	if tx.stmts[i] == nil {
+		tx.stmts[i] = tx.sqlTx.Stmt(tx.db.stmts[i])
+	}
+	return tx.stmts[i]
+}
+
+// recordErr records the error of an operation which can not return it.
+func (tx *transaction) recordErr(err error) {
+	if tx.err == nil {
+		tx.err = convertErr(err)
+	}
+}
+
+// checkWritable errors if the transaction may not modify the database.
+func (tx *transaction) checkWritable() error {
+	switch {
+	case tx.done:
+		return errors.E(errors.Invalid, "transaction is closed")
+	case !tx.writable:
+		return errors.E(errors.Invalid, "transaction is not writable")
+	}
+	return nil
+}
+
+// end releases the transaction's hold on the database.
+func (tx *transaction) end() {
+	tx.done = true
+	tx.db.endTx(tx.writable)
+}
+
+// get returns the value of a key, or the ID of the nested bucket it records,
+// and whether the key exists.
+func (tx *transaction) get(bucketID int64, key []byte) (value []byte, child sql.NullInt64, ok bool, err error) {
+	err = tx.stmt(stmtGet).QueryRow(bucketID, key).Scan(&value, &child)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, child, false, nil
+	}
+	if err != nil {
+		return nil, child, false, convertErr(err)
+	}
+	return value, child, true, nil
+}
+
+// nestedBucket returns the nested bucket recorded by a key, or nil if the key
+// does not record a bucket.
+func (tx *transaction) nestedBucket(bucketID int64, key []byte) *bucket {
+	_, child, _, err := tx.get(bucketID, key)
+	if err != nil {
+		tx.recordErr(err)
+		return nil
+	}
+	if !child.Valid {
+		return nil
+	}
+	return &bucket{tx: tx, id: child.Int64}
+}
+
+// createBucket creates a nested bucket.  When existOK is set, an existing
+// bucket is returned instead of erroring with code Exist.
+func (tx *transaction) createBucket(bucketID int64, key []byte, existOK bool) (*bucket, error) {
+	if err := tx.checkWritable(); err != nil {
+		return nil, err
+	}
+	if len(key) == 0 {
+		return nil, errors.E(errors.Invalid, "bucket name required")
+	}
+	_, child, ok, err := tx.get(bucketID, key)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case child.Valid && existOK:
+		return &bucket{tx: tx, id: child.Int64}, nil
+	case child.Valid:
+		return nil, errors.E(errors.Exist, "bucket already exists")
+	case ok:
+		return nil, errors.E(errors.Invalid, "key records a value, not a bucket")
+	}
+
+	res, err := tx.stmt(stmtNewBucket).Exec()
+	if err != nil {
+		return nil, convertErr(err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, convertErr(err)
+	}
+	_, err = tx.stmt(stmtPutBucket).Exec(bucketID, key, id)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+	return &bucket{tx: tx, id: id}, nil
+}
+
+// deleteBucket deletes a nested bucket and every key and bucket nested in it.
+func (tx *transaction) deleteBucket(bucketID int64, key []byte) error {
+	if err := tx.checkWritable(); err != nil {
+		return err
+	}
+	if len(key) == 0 {
+		return errors.E(errors.Invalid, "bucket name required")
+	}
+	_, child, ok, err := tx.get(bucketID, key)
+	if err != nil {
+		return err
+	}
+	switch {
+	case !ok:
+		return errors.E(errors.NotExist, "bucket not found")
+	case !child.Valid:
+		return errors.E(errors.Invalid, "key records a value, not a bucket")
+	}
+
+	for _, i := range []int{stmtDeleteBuckets, stmtDeleteBucketKeys} {
+		if _, err := tx.stmt(i).Exec(child.Int64); err != nil {
+			return convertErr(err)
+		}
+	}
+	_, err = tx.stmt(stmtDeleteKey).Exec(bucketID, key)
+	return convertErr(err)
+}
+
+func (tx *transaction) ReadBucket(key []byte) walletdb.ReadBucket {
+	return tx.ReadWriteBucket(key)
+}
+
+func (tx *transaction) ReadWriteBucket(key []byte) walletdb.ReadWriteBucket {
+	b := tx.nestedBucket(rootBucketID, key)
+	// Don't return a non-nil interface to a nil pointer.
+	if b == nil {
+		return nil
+	}
+	return b
+}
+
+func (tx *transaction) CreateTopLevelBucket(key []byte) (walletdb.ReadWriteBucket, error) {
+	b, err := tx.createBucket(rootBucketID, key, false)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (tx *transaction) DeleteTopLevelBucket(key []byte) error {
+	return tx.deleteBucket(rootBucketID, key)
+}
+
+// Commit commits all changes that have been made through the root bucket and
+// all of its sub-buckets to persistent storage.  If any operation of the
+// transaction failed without returning its error, the transaction is rolled
+// back and the error is returned.
+//
+// This function is part of the walletdb.Tx interface implementation.
+func (tx *transaction) Commit() error {
+	if tx.done {
+		return errors.E(errors.Invalid, "transaction is closed")
+	}
+	defer tx.end()
+	if tx.err != nil {
+		_ = tx.sqlTx.Rollback()
+		return tx.err
+	}
+	return convertErr(tx.sqlTx.Commit())
+}
+
+// Rollback undoes all changes that have been made to the root bucket and all of
+// its sub-buckets.
+//
+// This function is part of the walletdb.Tx interface implementation.
+func (tx *transaction) Rollback() error {
+	if tx.done {
+		return errors.E(errors.Invalid, "transaction is closed")
+	}
+	defer tx.end()
+	return convertErr(tx.sqlTx.Rollback())
+}
+
+// bucket is an internal type used to represent a collection of key/value pairs
+// and implements the walletdb Bucket interfaces.
+type bucket struct {
+	tx *transaction
+	id int64
+}
+
+// Enforce bucket implements the walletdb Bucket interfaces.
+var _ walletdb.ReadWriteBucket = (*bucket)(nil)
+
+// NestedReadWriteBucket retrieves a nested bucket with the given key.  Returns
+// nil if the bucket does not exist.
+//
+// This function is part of the walletdb.ReadWriteBucket interface implementation.
+func (b *bucket) NestedReadWriteBucket(key []byte) walletdb.ReadWriteBucket {
+	nested := b.tx.nestedBucket(b.id, key)
+	// Don't return a non-nil interface to a nil pointer.
+	if nested == nil {
+		return nil
+	}
+	return nested
+}
+
+func (b *bucket) NestedReadBucket(key []byte) walletdb.ReadBucket {
+	return b.NestedReadWriteBucket(key)
+}
+
+// CreateBucket creates and returns a new nested bucket with the given key.
+// Errors with code Exist if the bucket already exists, and Invalid if the key
+// is empty or records a value.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) CreateBucket(key []byte) (walletdb.ReadWriteBucket, error) {
+	nested, err := b.tx.createBucket(b.id, key, false)
+	if err != nil {
+		return nil, err
+	}
+	return nested, nil
+}
+
+// CreateBucketIfNotExists creates and returns a new nested bucket with the
+// given key if it does not already exist.  Errors with code Invalid if the key
+// is empty or records a value.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) CreateBucketIfNotExists(key []byte) (walletdb.ReadWriteBucket, error) {
+	nested, err := b.tx.createBucket(b.id, key, true)
+	if err != nil {
+		return nil, err
+	}
+	return nested, nil
+}
+
+// DeleteNestedBucket removes a nested bucket with the given key.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) DeleteNestedBucket(key []byte) error {
+	return b.tx.deleteBucket(b.id, key)
+}
+
+// record is a key of a bucket read by a query.
+type record struct {
+	key   []byte
+	value []byte
+	child sql.NullInt64
+}
+
+// query returns the records selected by a statement.
+func (b *bucket) query(stmt int, args ...any) ([]record, error) {
+	rows, err := b.tx.stmt(stmt).Query(args...)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+	defer rows.Close()
+	var records []record
+	for rows.Next() {
+		var r record
+		if err := rows.Scan(&r.key, &r.value, &r.child); err != nil {
+			return nil, convertErr(err)
+		}
+		records = append(records, r)
+	}
+	return records, convertErr(rows.Err())
+}
+
+// ForEach invokes the passed function with every key/value pair in the bucket.
+// This includes nested buckets, in which case the value is nil, but it does not
+// include the key/value pairs within those nested buckets.
+//
+// The function may modify the bucket.  Keys added after the key passed to the
+// function may or may not be visited.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) ForEach(fn func(k, v []byte) error) error {
+	after := []byte{}
+	for {
+		page, err := b.query(stmtPage, b.id, after, forEachPageSize)
+		if err != nil {
+			return err
+		}
+		for _, r := range page {
+			if err := fn(r.key, r.value); err != nil {
+				return err
+			}
+		}
+		if len(page) < forEachPageSize {
+			return nil
+		}
+		after = page[len(page)-1].key
+	}
+}
+
+// Put saves the specified key/value pair to the bucket.  Keys that do not
+// already exist are added and keys that already exist are overwritten.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) Put(key, value []byte) error {
+	if err := b.tx.checkWritable(); err != nil {
+		return err
+	}
+	if len(key) == 0 {
+		return errors.E(errors.Invalid, "key required")
+	}
+	if value == nil {
+		value = []byte{}
+	}
+	res, err := b.tx.stmt(stmtPut).Exec(b.id, key, value)
+	if err != nil {
+		return convertErr(err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return convertErr(err)
+	}
+	if n == 0 {
+		return errors.E(errors.Invalid, "key records a bucket, not a value")
+	}
+	return nil
+}
+
+// Get returns the value for the given key.  Returns nil if the key does
+// not exist in this bucket (or nested buckets).
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) Get(key []byte) []byte {
+	value, _, _, err := b.tx.get(b.id, key)
+	if err != nil {
+		b.tx.recordErr(err)
+		return nil
+	}
+	return value
+}
+
+// Delete removes the specified key from the bucket.  Deleting a key that does
+// not exist does not return an error.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) Delete(key []byte) error {
+	if err := b.tx.checkWritable(); err != nil {
+		return err
+	}
+	res, err := b.tx.stmt(stmtDelete).Exec(b.id, key)
+	if err != nil {
+		return convertErr(err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return convertErr(err)
+	}
+	if n != 0 {
+		return nil
+	}
+	_, child, _, err := b.tx.get(b.id, key)
+	if err != nil {
+		return err
+	}
+	if child.Valid {
+		return errors.E(errors.Invalid, "key records a bucket, not a value")
+	}
+	return nil
+}
+
+// KeyN returns the number of keys and value pairs inside a bucket, including
+// the keys of nested buckets, but not the keys within those nested buckets.
+//
+// This function is part of the walletdb.ReadBucket interface implementation.
+func (b *bucket) KeyN() int {
+	var n int
+	err := b.tx.stmt(stmtCount).QueryRow(b.id).Scan(&n)
+	if err != nil {
+		b.tx.recordErr(err)
+		return 0
+	}
+	return n
+}
+
+func (b *bucket) ReadCursor() walletdb.ReadCursor {
+	return b.ReadWriteCursor()
+}
+
+// ReadWriteCursor returns a new cursor, allowing for iteration over the bucket's
+// key/value pairs and nested buckets in forward or backward order.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) ReadWriteCursor() walletdb.ReadWriteCursor {
+	return &cursor{bucket: b}
+}
+
+// cursor represents a cursor over key/value pairs and nested buckets of a
+// bucket.
+//
+// Each movement of the cursor queries the key after or before the current
+// key, so unlike bbolt cursors, cursors remain valid when the bucket is
+// modified.
+type cursor struct {
+	bucket  *bucket
+	key     []byte // nil until positioned
+	pastEnd bool   // positioned after the last key by Seek
+}
+
+// move positions the cursor at the key selected by a statement and returns
+// the pair.  The cursor is not moved when no key is selected.
+func (c *cursor) move(stmt int, args ...any) (key, value []byte) {
+	records, err := c.bucket.query(stmt, args...)
+	if err != nil {
+		c.bucket.tx.recordErr(err)
+		return nil, nil
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	c.key = records[0].key
+	c.pastEnd = false
+	return records[0].key, records[0].value
+}
+
+// Delete removes the current key/value pair the cursor is at without
+// invalidating the cursor.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Delete() error {
+	if c.key == nil {
+		return errors.E(errors.Invalid, "cursor is not positioned")
+	}
+	return c.bucket.Delete(c.key)
+}
+
+// First positions the cursor at the first key/value pair and returns the pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) First() (key, value []byte) {
+	return c.move(stmtFirst, c.bucket.id)
+}
+
+// Last positions the cursor at the last key/value pair and returns the pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Last() (key, value []byte) {
+	return c.move(stmtLast, c.bucket.id)
+}
+
+// Next moves the cursor one key/value pair forward and returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Next() (key, value []byte) {
+	if c.key == nil {
+		return nil, nil
+	}
+	return c.move(stmtNext, c.bucket.id, c.key)
+}
+
+// Prev moves the cursor one key/value pair backward and returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Prev() (key, value []byte) {
+	// Like bbolt, a seek past the last key leaves the cursor after the end,
+	// where moving backward selects the last key.
+	if c.pastEnd {
+		return c.Last()
+	}
+	if c.key == nil {
+		return nil, nil
+	}
+	return c.move(stmtPrev, c.bucket.id, c.key)
+}
+
+// Seek positions the cursor at the passed seek key. If the key does not exist,
+// the cursor is moved to the next key after seek. Returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Seek(seek []byte) (key, value []byte) {
+	if seek == nil {
+		seek = []byte{}
+	}
+	key, value = c.move(stmtSeek, c.bucket.id, seek)
+	if key == nil {
+		c.key = nil
+		c.pastEnd = true
+	}
+	return key, value
+}
+
+// Closes the cursor
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Close() {}
+
+// db represents a collection of namespaces which are persisted and implements
+// the walletdb.Db interface.  All database access is performed through
+// transactions.
+//
+// The database uses SQLite's write-ahead log, which allows read transactions
+// to continue while a write transaction is open.  Writers are serialized by
+// the database, and the log is only truncated by compaction once all
+// transactions have ended.
+type db struct {
+	writeMu sync.Mutex // held by writers and compaction
+
+	mu            sync.Mutex
+	cond          sync.Cond // signaled when transactions end
+	sqlDB         *sql.DB
+	stmts         [numStmts]*sql.Stmt
+	path          string
+	readOnly      bool
+	open          int  // open transactions
+	checkpointing bool // write-ahead log is being truncated
+	closed        bool
+}
+
+// Enforce db implements the walletdb.Db and walletdb.Compacter interfaces.
+var _ walletdb.DB = (*db)(nil)
+var _ walletdb.Compacter = (*db)(nil)
+
+// enter records the start of a transaction, waiting for any checkpoint to
+// complete.
+func (db *db) enter() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for db.checkpointing {
+		db.cond.Wait()
+	}
+	if db.closed {
+		return errors.E(errors.Invalid, "database is closed")
+	}
+	db.open++
+	return nil
+}
+
+// exit records the end of a transaction begun by enter.
+func (db *db) exit() {
+	db.mu.Lock()
+	db.open--
+	if db.open == 0 {
+		db.cond.Broadcast()
+	}
+	db.mu.Unlock()
+}
+
+func (db *db) beginTx(writable bool) (*transaction, error) {
+	if writable {
+		if db.readOnly {
+			return nil, errors.E(errors.Invalid, "database is opened read-only")
+		}
+		db.writeMu.Lock()
+	}
+	if err := db.enter(); err != nil {
+		if writable {
+			db.writeMu.Unlock()
+		}
+		return nil, err
+	}
+	tx := &transaction{db: db, writable: writable}
+	var err error
+	tx.sqlTx, err = db.sqlDB.Begin()
+	if err == nil && !writable {
+		// SQLite begins reading a snapshot at the first read of a
+		// transaction.  Read now, so the transaction observes the
+		// database as of its beginning.
+		var n int
+		err = tx.stmt(stmtSnapshot).QueryRow().Scan(&n)
+		if err != nil {
+			_ = tx.sqlTx.Rollback()
+		}
+	}
+	if err != nil {
+		db.endTx(writable)
+		return nil, convertErr(err)
+	}
+	return tx, nil
+}
+
+// endTx records that a transaction begun by beginTx has ended.
+func (db *db) endTx(writable bool) {
+	db.exit()
+	if writable {
+		db.writeMu.Unlock()
+	}
+}
+
+func (db *db) BeginReadTx() (walletdb.ReadTx, error) {
+	return db.beginTx(false)
+}
+
+func (db *db) BeginReadWriteTx() (walletdb.ReadWriteTx, error) {
+	return db.beginTx(true)
+}
+
+// Copy writes a copy of the database to the provided writer.  The copy is
+// written to a temporary file in the database directory by a single read
+// transaction, and then copied to the writer.
+//
+// This function is part of the walletdb.Db interface implementation.
+func (db *db) Copy(w io.Writer) error {
+	f, err := os.CreateTemp(filepath.Dir(db.path), filepath.Base(db.path)+".copy")
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	copyPath := f.Name()
+	defer os.Remove(copyPath)
+	f.Close()
+
+	if err := db.enter(); err != nil {
+		return err
+	}
+	_, err = db.sqlDB.Exec(`VACUUM INTO ?`, copyPath)
+	db.exit()
+	if err != nil {
+		return convertErr(err)
+	}
+
+	f, err = os.Open(copyPath)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	defer f.Close()
+	if _, err := io.Copy(w, f); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// Close cleanly shuts down the database and syncs all data.
+//
+// This function is part of the walletdb.Db interface implementation.
+func (db *db) Close() error {
+	db.writeMu.Lock()
+	defer db.writeMu.Unlock()
+
+	db.mu.Lock()
+	if db.closed {
+		db.mu.Unlock()
+		return nil
+	}
+	db.closed = true
+	db.mu.Unlock()
+
+	for _, stmt := range db.stmts {
+		stmt.Close()
+	}
+	return convertErr(db.sqlDB.Close())
+}
+
+// Compact rebuilds the database without the free pages left behind by
+// deleted records, and truncates the write-ahead log.  Read transactions may
+// continue while the database is rebuilt, but writers are blocked until the
+// compaction completes.  Progress, if non-nil, is called with the number of
+// keys copied and the total number of keys.
+//
+// Transactions must not be held open while beginning a read-write
+// transaction, or compaction may deadlock.
+//
+// This function is part of the walletdb.Compacter interface implementation.
+func (db *db) Compact(progress func(copied, total int)) error {
+	if db.readOnly {
+		return errors.E(errors.Invalid, "database is opened read-only")
+	}
+
+	db.writeMu.Lock()
+	defer db.writeMu.Unlock()
+
+	if err := db.enter(); err != nil {
+		return err
+	}
+	var total int
+	err := db.sqlDB.QueryRow(`SELECT count(*) FROM kv`).Scan(&total)
+	if err == nil && progress != nil {
+		progress(0, total)
+	}
+	if err == nil {
+		_, err = db.sqlDB.Exec(`VACUUM`)
+	}
+	db.exit()
+	if err != nil {
+		return convertErr(err)
+	}
+
+	// Wait for all open transactions to end before truncating the log,
+	// which would otherwise be kept for their snapshots.  New transactions
+	// wait for the checkpoint to complete.
+	db.mu.Lock()
+	db.checkpointing = true
+	for db.open > 0 {
+		db.cond.Wait()
+	}
+	db.mu.Unlock()
+	_, err = db.sqlDB.Exec(`PRAGMA wal_checkpoint(TRUNCATE)`)
+	db.mu.Lock()
+	db.checkpointing = false
+	db.cond.Broadcast()
+	db.mu.Unlock()
+	if err != nil {
+		return convertErr(err)
+	}
+
+	if progress != nil {
+		progress(total, total)
+	}
+	return nil
+}
+
+// filesExists reports whether the named file or directory exists.
+func fileExists(name string) bool {
+	if _, err := os.Stat(name); err != nil {
+		if os.IsNotExist(err) {
+			return false
+		}
+	}
+	return true
+}
+
+// dsn returns the data source name of the database at dbPath.  The path is
+// written as a URI filename, which allows the database to be opened
+// read-only.
+func dsn(dbPath string, readOnly bool) string {
+	p := filepath.ToSlash(dbPath)
+	if filepath.VolumeName(dbPath) != "" {
+		p = "/" + p
+	}
+	p = strings.NewReplacer("%", "%25", "?", "%3f", "#", "%23").Replace(p)
+	opts := "_busy_timeout=10000&_cache_size=-32768"
+	if readOnly {
+		opts += "&mode=ro"
+	} else {
+		opts += "&_journal_mode=WAL&_synchronous=FULL"
+	}
+	return "file:" + p + "?" + opts
+}
+
+// openDB opens the database at the provided path.  Read-only databases may
+// be opened by several processes at once, and error on any write.
+func openDB(dbPath string, create, readOnly bool) (walletdb.DB, error) {
+	if !create && !fileExists(dbPath) {
+		return nil, errors.E(errors.NotExist, "missing database file")
+	}
+
+	sqlDB, err := sql.Open("sqlite3", dsn(dbPath, readOnly))
+	if err != nil {
+		return nil, convertErr(err)
+	}
+	db := &db{sqlDB: sqlDB, path: dbPath, readOnly: readOnly}
+	db.cond.L = &db.mu
+	err = db.init(create)
+	if err != nil {
+		sqlDB.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// init creates the tables of a new database, or checks that an existing
+// database is a wallet database, and prepares the statements of the database.
+func (db *db) init(create bool) error {
+	var tables int
+	err := db.sqlDB.QueryRow(`SELECT count(*) FROM sqlite_master
+		WHERE type = 'table' AND name IN ('buckets', 'kv')`).Scan(&tables)
+	if err != nil {
+		return convertErr(err)
+	}
+	switch {
+	case tables == 0 && create:
+		if _, err := db.sqlDB.Exec(schema); err != nil {
+			return convertErr(err)
+		}
+	case tables != 2:
+		return errors.E(errors.IO, "not a wallet database")
+	}
+
+	for i, query := range queries {
+		db.stmts[i], err = db.sqlDB.Prepare(query)
+		if err != nil {
+			for _, stmt := range db.stmts[:i] {
+				stmt.Close()
+			}
+			return convertErr(err)
+		}
+	}
+	return nil
+}
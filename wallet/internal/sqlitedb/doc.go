@@ -0,0 +1,46 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package sqlitedb implements an instance of walletdb that uses SQLite for the
+backing datastore.  SQLite keeps a page cache of each table and index instead
+of memory mapping the whole database, and only reads the pages of the keys
+which are accessed, which performs better than bbolt for wallets with millions
+of transaction records.
+
+The driver requires cgo.  Binaries built without it register a driver which
+fails to create or open any database with an error saying so.
+
+# Usage
+
+This package is only a driver to the walletdb package and provides the database
+type of "sqlite".  The only parameter the Create function takes is the database
+path as a string.  Open also takes the database path, optionally followed by a
+bool which opens the database read-only when true:
+
+	db, err := walletdb.Open("sqlite", "path/to/database.db")
+	if err != nil {
+		// Handle error
+	}
+
+	db, err := walletdb.Open("sqlite", "path/to/database.db", true)
+	if err != nil {
+		// Handle error
+	}
+
+	db, err := walletdb.Create("sqlite", "path/to/database.db")
+	if err != nil {
+		// Handle error
+	}
+
+# Schema
+
+Every bucket is identified by a row of the buckets table, and each key of a
+bucket is a row of the kv table, keyed by the bucket ID and the key.  Keys of
+nested buckets record the ID of the nested bucket and have no value.  The
+top-level buckets are the nested buckets of the root bucket, which has the ID
+0.  Keys are BLOBs, which SQLite orders byte-wise, so cursors and ForEach
+visit keys in the order of bbolt.
+*/
+package sqlitedb
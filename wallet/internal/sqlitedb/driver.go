@@ -0,0 +1,80 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package sqlitedb
+
+import (
+	"fmt"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+const (
+	dbType = "sqlite"
+)
+
+// parseArgs parses the arguments from the walletdb Open/Create methods.
+func parseArgs(funcName string, args ...any) (string, error) {
+	if len(args) != 1 {
+		return "", errors.Errorf("invalid arguments to %s.%s -- "+
+			"expected database path", dbType, funcName)
+	}
+
+	dbPath, ok := args[0].(string)
+	if !ok {
+		return "", errors.Errorf("first argument to %s.%s is invalid -- "+
+			"expected database path string", dbType, funcName)
+	}
+
+	return dbPath, nil
+}
+
+// openDBDriver is the callback provided during driver registration that opens
+// an existing database for use.  The database path may be followed by a bool
+// which opens the database read-only when true.
+func openDBDriver(args ...any) (walletdb.DB, error) {
+	var readOnly bool
+	if len(args) == 2 {
+		var ok bool
+		readOnly, ok = args[1].(bool)
+		if !ok {
+			return nil, errors.Errorf("second argument to %s.Open is "+
+				"invalid -- expected read-only bool", dbType)
+		}
+		args = args[:1]
+	}
+	dbPath, err := parseArgs("Open", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return openDB(dbPath, false, readOnly)
+}
+
+// createDBDriver is the callback provided during driver registration that
+// creates, initializes, and opens a database for use.
+func createDBDriver(args ...any) (walletdb.DB, error) {
+	dbPath, err := parseArgs("Create", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return openDB(dbPath, true, false)
+}
+
+func init() {
+	// Register the driver.
+	driver := walletdb.Driver{
+		DbType: dbType,
+		Create: createDBDriver,
+		Open:   openDBDriver,
+	}
+	if err := walletdb.RegisterDriver(driver); err != nil {
+		panic(fmt.Sprintf("Failed to register database driver '%s': %v",
+			dbType, err))
+	}
+}
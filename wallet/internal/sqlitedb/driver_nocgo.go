@@ -0,0 +1,42 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !cgo
+
+package sqlitedb
+
+import (
+	"fmt"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+const (
+	dbType = "sqlite"
+)
+
+// errNoCgo is returned when creating or opening any database, since the SQLite
+// library is only linked into binaries built with cgo.
+var errNoCgo = errors.E(errors.Invalid, "the sqlite database driver requires "+
+	"cgo, and this binary was built without it (CGO_ENABLED=0)")
+
+func unavailableDriver(args ...any) (walletdb.DB, error) {
+	return nil, errNoCgo
+}
+
+func init() {
+	// Register a driver rejecting every database, so that creating or
+	// opening an SQLite wallet reports why it is not supported instead of
+	// an unknown driver.
+	driver := walletdb.Driver{
+		DbType: dbType,
+		Create: unavailableDriver,
+		Open:   unavailableDriver,
+	}
+	if err := walletdb.RegisterDriver(driver); err != nil {
+		panic(fmt.Sprintf("Failed to register database driver '%s': %v",
+			dbType, err))
+	}
+}
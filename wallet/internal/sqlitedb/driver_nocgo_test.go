@@ -0,0 +1,31 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !cgo
+
+package sqlitedb_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	_ "github.com/monetarium/monetarium-wallet/wallet/internal/sqlitedb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// TestNoCgo ensures binaries built without cgo reject SQLite databases with an
+// error naming the missing cgo support.
+func TestNoCgo(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "db")
+	_, err := walletdb.Create("sqlite", dbPath)
+	if !errors.Is(err, errors.Invalid) || !strings.Contains(err.Error(), "cgo") {
+		t.Errorf("Create: unexpected error %v", err)
+	}
+	_, err = walletdb.Open("sqlite", dbPath)
+	if !errors.Is(err, errors.Invalid) || !strings.Contains(err.Error(), "cgo") {
+		t.Errorf("Open: unexpected error %v", err)
+	}
+}
@@ -0,0 +1,530 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package sqlitedb_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	_ "github.com/monetarium/monetarium-wallet/wallet/internal/bdb"
+	_ "github.com/monetarium/monetarium-wallet/wallet/internal/sqlitedb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// dbType is the database type name for this driver.
+const dbType = "sqlite"
+
+// TestCreateOpenFail ensures that errors related to creating and opening a
+// database are handled properly.
+func TestCreateOpenFail(t *testing.T) {
+	dir := t.TempDir()
+
+	// Ensure that attempting to open a database that doesn't exist returns
+	// the expected error.
+	if _, err := walletdb.Open(dbType, filepath.Join(dir, "noexist.db")); !errors.Is(err, errors.NotExist) {
+		t.Errorf("Open: unexpected error: %v", err)
+	}
+
+	// Ensure that attempting to open a database with the wrong number of
+	// parameters returns the expected error.
+	wantErr := errors.Errorf("invalid arguments to %s.Open -- expected "+
+		"database path", dbType)
+	if _, err := walletdb.Open(dbType, 1, 2, 3); err.Error() != wantErr.Error() {
+		t.Errorf("Open: did not receive expected error - got %v, "+
+			"want %v", err, wantErr)
+	}
+	wantErr = errors.Errorf("first argument to %s.Create is invalid -- "+
+		"expected database path string", dbType)
+	if _, err := walletdb.Create(dbType, 1); err.Error() != wantErr.Error() {
+		t.Errorf("Create: did not receive expected error - got %v, "+
+			"want %v", err, wantErr)
+	}
+
+	// Ensure a bbolt database is not opened as a SQLite database.
+	boltPath := filepath.Join(dir, "bolt.db")
+	boltDB, err := walletdb.Create("bdb", boltPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	boltDB.Close()
+	if _, err := walletdb.Open(dbType, boltPath); !errors.Is(err, errors.IO) {
+		t.Errorf("Open of bbolt database: unexpected error: %v", err)
+	}
+
+	// Ensure operations against a closed database return the expected
+	// error.
+	db, err := walletdb.Create(dbType, filepath.Join(dir, "createfail.db"))
+	if err != nil {
+		t.Fatalf("Create: unexpected error: %v", err)
+	}
+	db.Close()
+	if _, err := db.BeginReadTx(); !errors.Is(err, errors.Invalid) {
+		t.Errorf("BeginReadTx: unexpected error: %v", err)
+	}
+}
+
+// TestPersistence ensures that values and nested buckets stored are still
+// valid after closing and reopening the database.
+func TestPersistence(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "persistencetest.db")
+	db, err := walletdb.Create(dbType, dbPath)
+	if err != nil {
+		t.Fatalf("Failed to create test database (%s) %v", dbType, err)
+	}
+
+	storeValues := map[string]string{
+		"ns1key1": "foo1",
+		"ns1key2": "foo2",
+		"ns1key3": "",
+	}
+	ns1Key := []byte("ns1")
+	nestedKey := []byte("nested")
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		ns1Bkt, err := tx.CreateTopLevelBucket(ns1Key)
+		if err != nil {
+			return err
+		}
+		nested, err := ns1Bkt.CreateBucket(nestedKey)
+		if err != nil {
+			return err
+		}
+		for k, v := range storeValues {
+			if err := ns1Bkt.Put([]byte(k), []byte(v)); err != nil {
+				return err
+			}
+			if err := nested.Put([]byte(k), []byte(v)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ns1 Update: unexpected error: %v", err)
+	}
+
+	// Close and reopen the database to ensure the values persist.
+	db.Close()
+	db, err = walletdb.Open(dbType, dbPath)
+	if err != nil {
+		t.Fatalf("Failed to open test database (%s) %v", dbType, err)
+	}
+	defer db.Close()
+
+	err = walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+		ns1Bkt := tx.ReadBucket(ns1Key)
+		for _, b := range []walletdb.ReadBucket{ns1Bkt, ns1Bkt.NestedReadBucket(nestedKey)} {
+			for k, v := range storeValues {
+				val := b.Get([]byte(k))
+				if val == nil || !bytes.Equal([]byte(v), val) {
+					return errors.Errorf("Get: key '%s' does not "+
+						"match expected value - got %q, want %q",
+						k, val, v)
+				}
+			}
+		}
+		if n := ns1Bkt.KeyN(); n != len(storeValues)+1 {
+			return errors.Errorf("KeyN: got %d, want %d", n, len(storeValues)+1)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestCursor ensures cursors and ForEach visit keys in byte-wise order,
+// report nested buckets with nil values, and remain valid when the bucket is
+// modified.
+func TestCursor(t *testing.T) {
+	ctx := context.Background()
+	db, err := walletdb.Create(dbType, filepath.Join(t.TempDir(), "cursor.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	keys := [][]byte{{0x00}, {0x00, 0x00}, {0x01}, {0x7f, 0xff}, {0x80}, {0xff}}
+	bucketKey := []byte("bucket")
+	nestedKey := []byte{0x80, 0x00}
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket(bucketKey)
+		if err != nil {
+			return err
+		}
+		// Insert in reverse order to ensure keys are sorted.
+		for i := len(keys) - 1; i >= 0; i-- {
+			if err := b.Put(keys[i], keys[i]); err != nil {
+				return err
+			}
+		}
+		nested, err := b.CreateBucket(nestedKey)
+		if err != nil {
+			return err
+		}
+		if err := nested.Put([]byte("k"), []byte("v")); err != nil {
+			return err
+		}
+		if err := b.Put(nestedKey, nil); !errors.Is(err, errors.Invalid) {
+			return errors.Errorf("Put over nested bucket: unexpected error: %v", err)
+		}
+		if err := b.Delete(nestedKey); !errors.Is(err, errors.Invalid) {
+			return errors.Errorf("Delete of nested bucket: unexpected error: %v", err)
+		}
+		if _, err := b.CreateBucket(keys[0]); !errors.Is(err, errors.Invalid) {
+			return errors.Errorf("CreateBucket over value: unexpected error: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]byte{{0x00}, {0x00, 0x00}, {0x01}, {0x7f, 0xff}, {0x80}, nestedKey, {0xff}}
+	err = walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+		b := tx.ReadBucket(bucketKey)
+		var got [][]byte
+		err := b.ForEach(func(k, v []byte) error {
+			if bytes.Equal(k, nestedKey) != (v == nil) {
+				return errors.Errorf("ForEach: key %x has value %x", k, v)
+			}
+			got = append(got, k)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			return errors.Errorf("ForEach: visited %x, want %x", got, want)
+		}
+
+		c := b.ReadCursor()
+		defer c.Close()
+		got = got[:0]
+		for k, _ := c.Last(); k != nil; k, _ = c.Prev() {
+			got = append([][]byte{k}, got...)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			return errors.Errorf("cursor: visited %x, want %x", got, want)
+		}
+		if k, _ := c.Seek([]byte{0x7f}); !bytes.Equal(k, []byte{0x7f, 0xff}) {
+			return errors.Errorf("Seek: positioned at %x", k)
+		}
+		if k, _ := c.Next(); !bytes.Equal(k, []byte{0x80}) {
+			return errors.Errorf("Next after Seek: positioned at %x", k)
+		}
+		// As with bbolt, a seek past the last key positions the cursor
+		// after the end, and Prev returns the last key.
+		if k, _ := c.Seek([]byte{0xff, 0x00}); k != nil {
+			return errors.Errorf("Seek past end: positioned at %x", k)
+		}
+		if k, _ := c.Prev(); !bytes.Equal(k, []byte{0xff}) {
+			return errors.Errorf("Prev after Seek past end: positioned at %x", k)
+		}
+		// Next at the last key leaves the cursor at the last key.
+		if k, _ := c.Next(); k != nil {
+			return errors.Errorf("Next at end: positioned at %x", k)
+		}
+		if k, _ := c.Prev(); !bytes.Equal(k, nestedKey) {
+			return errors.Errorf("Prev after Next at end: positioned at %x", k)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Delete every value with a cursor, and delete the nested bucket.
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		b := tx.ReadWriteBucket(bucketKey)
+		c := b.ReadWriteCursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if v == nil {
+				continue
+			}
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+		c.Close()
+		if n := b.KeyN(); n != 1 {
+			return errors.Errorf("KeyN after cursor deletion: got %d, want 1", n)
+		}
+		return b.DeleteNestedBucket(nestedKey)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+		if !walletdb.BucketIsEmpty(tx.ReadBucket(bucketKey)) {
+			return errors.Errorf("bucket is not empty")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestSnapshot ensures read transactions observe the database as of their
+// beginning while it is modified.
+func TestSnapshot(t *testing.T) {
+	ctx := context.Background()
+	db, err := walletdb.Create(dbType, filepath.Join(t.TempDir(), "snapshot.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	key := []byte("key")
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket([]byte("bucket"))
+		if err != nil {
+			return err
+		}
+		return b.Put(key, []byte("old"))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rtx, err := db.BeginReadTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rtx.Rollback()
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		return tx.ReadWriteBucket([]byte("bucket")).Put(key, []byte("new"))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := rtx.ReadBucket([]byte("bucket")).Get(key); string(v) != "old" {
+		t.Errorf("read transaction observed value %q written after it began", v)
+	}
+}
+
+// TestCompact ensures that compacting a database preserves its values and
+// reclaims the space of deleted values while it remains open.
+func TestCompact(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "compacttest.db")
+	db, err := walletdb.Create(dbType, dbPath)
+	if err != nil {
+		t.Fatalf("Failed to create test database (%s) %v", dbType, err)
+	}
+	defer db.Close()
+
+	bucketKey := []byte("bucket")
+	nestedKey := []byte("nested")
+	value := bytes.Repeat([]byte{0xff}, 1024)
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket(bucketKey)
+		if err != nil {
+			return err
+		}
+		nested, err := b.CreateBucket(nestedKey)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < 4096; i++ {
+			k := []byte(fmt.Sprintf("key%04d", i))
+			if err := b.Put(k, value); err != nil {
+				return err
+			}
+			if err := nested.Put(k, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		b := tx.ReadWriteBucket(bucketKey)
+		for i := 1; i < 4096; i++ {
+			k := []byte(fmt.Sprintf("key%04d", i))
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	size := func() int64 {
+		t.Helper()
+		var size int64
+		for _, suffix := range []string{"", "-wal"} {
+			fi, err := os.Stat(dbPath + suffix)
+			if err != nil && !os.IsNotExist(err) {
+				t.Fatal(err)
+			}
+			if err == nil {
+				size += fi.Size()
+			}
+		}
+		return size
+	}
+	sizeBefore := size()
+
+	// Hold a read transaction open while compacting to ensure the
+	// write-ahead log is only truncated after it ends.
+	rtx, err := db.BeginReadTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	var copied, total int
+	go func() {
+		done <- db.(walletdb.Compacter).Compact(func(c, t int) {
+			copied, total = c, t
+		})
+	}()
+	if v := rtx.ReadBucket(bucketKey).Get([]byte("key0000")); !bytes.Equal(v, value) {
+		t.Errorf("read transaction value changed during compaction")
+	}
+	if err := rtx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("Compact: unexpected error: %v", err)
+	}
+	if copied != total || total == 0 {
+		t.Errorf("Compact progress: copied %d of %d keys", copied, total)
+	}
+	if sizeAfter := size(); sizeAfter >= sizeBefore {
+		t.Errorf("Compact did not shrink database: %d bytes, was %d",
+			sizeAfter, sizeBefore)
+	}
+
+	// Ensure the remaining values exist and the compacted database is
+	// writable.
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		b := tx.ReadWriteBucket(bucketKey)
+		if v := b.Get([]byte("key0000")); !bytes.Equal(v, value) {
+			return errors.Errorf("missing value after compaction")
+		}
+		if v := b.Get([]byte("key0001")); v != nil {
+			return errors.Errorf("deleted value exists after compaction")
+		}
+		if n := b.NestedReadBucket(nestedKey).KeyN(); n != 4096 {
+			return errors.Errorf("nested bucket has %d keys after "+
+				"compaction, want 4096", n)
+		}
+		return b.Put([]byte("key0001"), value)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestCopy ensures a copy of a database may be opened and records the values
+// of the database.
+func TestCopy(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := walletdb.Create(dbType, filepath.Join(dir, "copy.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	key := []byte("ns1")
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket(key)
+		if err != nil {
+			return err
+		}
+		return b.Put(key, key)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := db.Copy(&buf); err != nil {
+		t.Fatal(err)
+	}
+	copyPath := filepath.Join(dir, "copied.db")
+	if err := os.WriteFile(copyPath, buf.Bytes(), 0600); err != nil {
+		t.Fatal(err)
+	}
+	copied, err := walletdb.Open(dbType, copyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer copied.Close()
+	err = walletdb.View(ctx, copied, func(tx walletdb.ReadTx) error {
+		if v := tx.ReadBucket(key).Get(key); !bytes.Equal(v, key) {
+			return errors.Errorf("copy records value %q", v)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReadOnly ensures a database opened read-only may be read but not
+// written or compacted.
+func TestReadOnly(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "readonly.db")
+	db, err := walletdb.Create(dbType, dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := []byte("ns1")
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		_, err := tx.CreateTopLevelBucket(key)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	db, err = walletdb.Open(dbType, dbPath, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	err = walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+		if tx.ReadBucket(key) == nil {
+			return errors.Errorf("missing bucket")
+		}
+		if err := tx.ReadBucket(key).(walletdb.ReadWriteBucket).Put(key, key); !errors.Is(err, errors.Invalid) {
+			return errors.Errorf("Put in read transaction: unexpected error: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		return nil
+	})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("Update of read-only database: unexpected error: %v", err)
+	}
+	err = db.(walletdb.Compacter).Compact(nil)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("Compact of read-only database: unexpected error: %v", err)
+	}
+
+	if _, err := walletdb.Open(dbType, dbPath, "true"); err == nil {
+		t.Errorf("Open: expected error for invalid read-only argument")
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// CreateInvoice records an invoice requesting the payment of an amount of a
+// coin type, in atoms, to a new external address of an account.  Addresses
+// are derived ignoring the gap limit, so every invoice is paid to a distinct
+// address.  A zero expires time creates an invoice which does not expire.
+func (w *Wallet) CreateInvoice(ctx context.Context, account uint32, coinType cointype.CoinType,
+	amount *big.Int, label string, expires time.Time) (*udb.Invoice, error) {
+	const op errors.Op = "wallet.CreateInvoice"
+
+	now := time.Now()
+	switch {
+	case !coinType.IsValid():
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("invalid coin type %d", coinType))
+	case amount == nil || amount.Sign() <= 0:
+		return nil, errors.E(op, errors.Invalid, "invoice amount must be positive")
+	case len(label) > udb.MaxInvoiceLabelLen:
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("invoice label exceeds maximum length %d",
+				udb.MaxInvoiceLabelLen))
+	case !expires.IsZero() && !expires.After(now):
+		return nil, errors.E(op, errors.Invalid, "invoice expiry time has passed")
+	}
+
+	addr, err := w.NewExternalAddress(ctx, account, WithGapPolicyIgnore())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	inv := &udb.Invoice{
+		Address:  addr.String(),
+		CoinType: coinType,
+		Amount:   new(big.Int).Set(amount),
+		Label:    label,
+		Created:  time.Unix(now.Unix(), 0),
+	}
+	if !expires.IsZero() {
+		inv.Expires = time.Unix(expires.Unix(), 0)
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutInvoice(dbtx, inv)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return inv, nil
+}
+
+// Invoice returns the invoice with an ID.
+func (w *Wallet) Invoice(ctx context.Context, id uint32) (*udb.Invoice, error) {
+	const op errors.Op = "wallet.Invoice"
+
+	var inv *udb.Invoice
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		inv, err = udb.InvoiceByID(dbtx, id)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return inv, nil
+}
+
+// Invoices returns every invoice, ordered by ID.
+func (w *Wallet) Invoices(ctx context.Context) ([]*udb.Invoice, error) {
+	const op errors.Op = "wallet.Invoices"
+
+	var invoices []*udb.Invoice
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachInvoice(dbtx, func(inv *udb.Invoice) error {
+			invoices = append(invoices, inv)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return invoices, nil
+}
+
+// invoiceCredit is a wallet credit which may pay an invoice.
+type invoiceCredit struct {
+	address  string
+	coinType cointype.CoinType
+	payment  udb.InvoicePayment
+}
+
+// newInvoiceCredit describes the output of a credited transaction as a
+// possible invoice payment.
+func newInvoiceCredit(rec *udb.TxRecord, index uint32, tree int8, address string,
+	height int32) invoiceCredit {
+
+	output := rec.MsgTx.TxOut[index]
+	amount := big.NewInt(output.Value)
+	if output.CoinType.IsSKA() && output.SKAValue != nil {
+		amount = new(big.Int).Set(output.SKAValue)
+	}
+	return invoiceCredit{
+		address:  address,
+		coinType: output.CoinType,
+		payment: udb.InvoicePayment{
+			OutPoint: wire.OutPoint{Hash: rec.Hash, Index: index, Tree: tree},
+			Height:   height,
+			Amount:   amount,
+		},
+	}
+}
+
+// updateInvoices matches credits with the invoices of their address and coin
+// type, and expires open invoices which expired before t, notifying clients
+// of invoices which were paid or expired.  Credits are matched after expiring
+// invoices, so payments of an expired invoice are not counted, while payments
+// matched before the invoice expired are updated when they are mined.
+func (w *Wallet) updateInvoices(dbtx walletdb.ReadWriteTx, credits []invoiceCredit, t time.Time) error {
+	var modified []*udb.Invoice
+	var settled []*udb.Invoice
+	err := udb.ForEachInvoice(dbtx, func(inv *udb.Invoice) error {
+		status := inv.Status
+		changed := false
+		if inv.Status == udb.InvoiceOpen && inv.Expired(t) {
+			inv.Status = udb.InvoiceExpired
+			changed = true
+		}
+		for i := range credits {
+			c := &credits[i]
+			if c.address == inv.Address && c.coinType == inv.CoinType &&
+				c.payment.Amount.Sign() > 0 && inv.AddPayment(c.payment) {
+				changed = true
+			}
+		}
+		if changed {
+			modified = append(modified, inv)
+		}
+		if status == udb.InvoiceOpen && inv.Status != udb.InvoiceOpen {
+			settled = append(settled, inv)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, inv := range modified {
+		if err := udb.PutInvoice(dbtx, inv); err != nil {
+			return err
+		}
+	}
+	for _, inv := range settled {
+		log.Infof("Invoice %d %s", inv.ID, inv.Status)
+		w.NtfnServer.notifyInvoice(&InvoiceNotification{Invoice: inv})
+	}
+	return nil
+}
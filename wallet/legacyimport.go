@@ -0,0 +1,140 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// LegacyImport summarizes a legacy dcrwallet database imported by
+// ImportLegacyDB, before and after it was upgraded.
+type LegacyImport struct {
+	Legacy   udb.DBSummary
+	Imported udb.DBSummary
+}
+
+// ImportLegacyDB imports the upstream dcrwallet database at legacyPath, which
+// is opened read-only and never modified, to dbPath, which must not exist.
+// The copied database is upgraded to the current version, recording every
+// output of the legacy database as a VAR output, and is only written to
+// dbPath once its accounts, transactions and unspent outputs are verified to
+// match the legacy database.  The public passphrase is required by upgrades
+// of some database versions.
+func ImportLegacyDB(ctx context.Context, driver, legacyPath, dbPath string,
+	pubPass []byte, params *chaincfg.Params) (*LegacyImport, error) {
+
+	const op errors.Op = "wallet.ImportLegacyDB"
+	if _, err := os.Stat(dbPath); err == nil {
+		return nil, errors.E(op, errors.Exist, errors.Errorf("%q already exists", dbPath))
+	}
+	if _, err := os.Stat(legacyPath); err != nil {
+		return nil, errors.E(op, errors.NotExist, err)
+	}
+
+	dir := filepath.Dir(dbPath)
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
+	f, err := os.CreateTemp(dir, filepath.Base(dbPath)+".import")
+	if err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
+	tmpPath := f.Name()
+	defer os.Remove(tmpPath)
+	legacy, err := copyLegacyDB(ctx, driver, legacyPath, f)
+	if cerr := f.Close(); err == nil && cerr != nil {
+		err = errors.E(errors.IO, cerr)
+	}
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	imported, err := upgradeLegacyDB(ctx, driver, tmpPath, pubPass, params)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	err = verifyLegacyImport(legacy, imported)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	err = os.Rename(tmpPath, dbPath)
+	if err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
+	logCtx(ctx).Infof("Imported version %d dcrwallet database %s to %s with "+
+		"%d transactions and %v unspent", legacy.Version, legacyPath, dbPath,
+		legacy.Transactions, legacy.Unspent)
+	return &LegacyImport{Legacy: *legacy, Imported: *imported}, nil
+}
+
+// copyLegacyDB summarizes the legacy database at path and writes a copy of it
+// to f.
+func copyLegacyDB(ctx context.Context, driver, path string, f *os.File) (*udb.DBSummary, error) {
+	db, err := walletdb.Open(driver, path, true)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	s, err := udb.SummarizeLegacyDB(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	err = db.Copy(f)
+	if err == nil {
+		err = f.Sync()
+	}
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	return s, nil
+}
+
+// upgradeLegacyDB upgrades the copy of a legacy database at path and
+// summarizes the upgraded database.
+func upgradeLegacyDB(ctx context.Context, driver, path string, pubPass []byte,
+	params *chaincfg.Params) (*udb.DBSummary, error) {
+
+	db, err := walletdb.Open(driver, path)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	err = udb.Upgrade(ctx, db, pubPass, params)
+	if err != nil {
+		return nil, err
+	}
+	return udb.SummarizeImportedDB(ctx, db)
+}
+
+// verifyLegacyImport returns an error if an upgraded database does not record
+// the accounts, transactions and unspent outputs of the legacy database.
+func verifyLegacyImport(legacy, imported *udb.DBSummary) error {
+	switch {
+	case imported.LastAccount != legacy.LastAccount:
+		return errors.E(errors.Invalid, errors.Errorf("imported database "+
+			"has last account %d, legacy database has %d",
+			imported.LastAccount, legacy.LastAccount))
+	case imported.Transactions != legacy.Transactions:
+		return errors.E(errors.Invalid, errors.Errorf("imported database "+
+			"records %d transactions, legacy database records %d",
+			imported.Transactions, legacy.Transactions))
+	case imported.UnspentOutputs != legacy.UnspentOutputs ||
+		imported.Unspent != legacy.Unspent:
+		return errors.E(errors.Invalid, errors.Errorf("imported database "+
+			"records %d unspent outputs totaling %v, legacy database "+
+			"records %d totaling %v", imported.UnspentOutputs,
+			imported.Unspent, legacy.UnspentOutputs, legacy.Unspent))
+	}
+	return nil
+}
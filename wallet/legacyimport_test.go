@@ -0,0 +1,88 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestImportLegacyDB(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	dir := t.TempDir()
+	params := chaincfg.TestNet3Params()
+	pubPass := []byte("public")
+
+	// The v11 upgrade test database was created by upstream dcrwallet.
+	testFile, err := os.Open(filepath.Join("udb", "testdata", "v11.db.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer testFile.Close()
+	r, err := gzip.NewReader(testFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacyPath := filepath.Join(dir, "legacy.db")
+	fi, err := os.Create(legacyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = io.Copy(fi, r)
+	fi.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dbPath := filepath.Join(dir, "imported", "wallet.db")
+	imp, err := ImportLegacyDB(ctx, "bdb", legacyPath, dbPath, pubPass, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imp.Legacy.Version != 11 || imp.Imported.Version != udb.DBVersion {
+		t.Errorf("imported version %d database as version %d",
+			imp.Legacy.Version, imp.Imported.Version)
+	}
+	if imp.Legacy.Transactions == 0 || imp.Legacy.UnspentOutputs == 0 {
+		t.Errorf("legacy database summary records no transactions or outputs")
+	}
+
+	// The legacy database must not be modified.
+	legacy, err := walletdb.Open("bdb", legacyPath, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := udb.SummarizeLegacyDB(ctx, legacy)
+	legacy.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *s != imp.Legacy {
+		t.Errorf("legacy database was modified: %+v, want %+v", *s, imp.Legacy)
+	}
+
+	_, err = ImportLegacyDB(ctx, "bdb", legacyPath, dbPath, pubPass, params)
+	if !errors.Is(err, errors.Exist) {
+		t.Errorf("import over existing database: expected Exist error, got %v", err)
+	}
+	_, err = ImportLegacyDB(ctx, "bdb", dbPath, filepath.Join(dir, "again.db"),
+		pubPass, params)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("import of current database: expected Invalid error, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "again.db")); !os.IsNotExist(err) {
+		t.Errorf("failed import wrote a database")
+	}
+}
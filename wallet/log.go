@@ -5,7 +5,11 @@
 
 package wallet
 
-import "github.com/decred/slog"
+import (
+	"context"
+
+	"github.com/decred/slog"
+)
 
 // log is a logger that is initialized with no output filters.  This
 // means the package will not perform any logging by default until the caller
@@ -18,3 +22,18 @@ var log = slog.Disabled
 func UseLogger(logger slog.Logger) {
 	log = logger
 }
+
+// contextLogger is implemented by loggers which record the request ID carried
+// by a context with each record.
+type contextLogger interface {
+	WithContext(ctx context.Context) slog.Logger
+}
+
+// logCtx returns the package logger, recording the request ID of ctx if the
+// logger supports it.
+func logCtx(ctx context.Context) slog.Logger {
+	if l, ok := log.(contextLogger); ok {
+		return l.WithContext(ctx)
+	}
+	return log
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"slices"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/txrules"
+	"github.com/monetarium/monetarium-wallet/wallet/txsizes"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// MaxSpendableAmount describes the largest amount of a coin type which an
+// account can send in a single transaction.  Amount and Fee are in atoms of
+// the coin type, and Amount is zero when no transaction paying a non-dust
+// output can be created.  Inputs is the number of the Eligible outputs spent
+// by the transaction.
+type MaxSpendableAmount struct {
+	CoinType cointype.CoinType
+	Amount   *big.Int
+	Fee      dcrutil.Amount
+	Size     int
+	Inputs   int
+	Eligible int
+}
+
+// MaxSpendable computes the largest amount of a coin type which can be sent
+// from an account in a single transaction paying one P2PKH output without
+// change.  Eligible outputs with at least minconf confirmations are spent in
+// decreasing order of value while each pays for the increase in fee of its
+// input, and while the transaction remains within the maximum transaction
+// size.  Outputs too small to pay for their own input, such as dust fee
+// rewards, are not spent.  A zero feeRate selects the wallet's fee rate for
+// the coin type.
+func (w *Wallet) MaxSpendable(ctx context.Context, account uint32, coinType cointype.CoinType,
+	feeRate dcrutil.Amount, minconf int32) (*MaxSpendableAmount, error) {
+
+	const op errors.Op = "wallet.MaxSpendable"
+
+	if minconf < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative minconf")
+	}
+	if feeRate == 0 {
+		feeRate = w.RelayFeeForCoinType(ctx, coinType)
+	}
+
+	var eligible []Input
+	w.lockOutpoints(ctx)
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+		var err error
+		eligible, err = w.findEligibleOutputs(dbtx, account, minconf, tipHeight, coinType)
+		return err
+	})
+	w.lockedOutpointMu.Unlock()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	maximumTxSize := w.chainParams.MaxTxSize
+	if w.chainParams.Net == wire.MainNet {
+		maximumTxSize = maxStandardTxSize
+	}
+	return maxSpendable(eligible, coinType, feeRate, maximumTxSize), nil
+}
+
+// maxSpendable computes the largest amount spendable from the eligible outputs
+// by a transaction paying a single P2PKH output, as described by MaxSpendable.
+func maxSpendable(eligible []Input, coinType cointype.CoinType,
+	feeRate dcrutil.Amount, maxTxSize int) *MaxSpendableAmount {
+
+	values := make([]*big.Int, len(eligible))
+	for i := range eligible {
+		prevOut := &eligible[i].PrevOut
+		switch {
+		case !coinType.IsSKA():
+			values[i] = big.NewInt(prevOut.Value)
+		case prevOut.SKAValue != nil:
+			values[i] = prevOut.SKAValue
+		default:
+			values[i] = new(big.Int)
+		}
+	}
+	slices.SortFunc(values, func(a, b *big.Int) int { return b.Cmp(a) })
+
+	txOuts := []*wire.TxOut{{
+		PkScript: make([]byte, txsizes.P2PKHPkScriptSize),
+		CoinType: coinType,
+	}}
+	total := new(big.Int)
+	var n, size int
+	var fee dcrutil.Amount
+	for _, value := range values {
+		nextSize := estimateConsolidationSize(n+1, txOuts, coinType)
+		if nextSize > maxTxSize {
+			break
+		}
+		nextFee := txrules.FeeForSerializeSize(feeRate, nextSize)
+		if value.Cmp(big.NewInt(int64(nextFee-fee))) <= 0 {
+			break
+		}
+		total.Add(total, value)
+		n, size, fee = n+1, nextSize, nextFee
+	}
+
+	m := &MaxSpendableAmount{
+		CoinType: coinType,
+		Amount:   new(big.Int),
+		Eligible: len(eligible),
+	}
+	amount := total.Sub(total, big.NewInt(int64(fee)))
+	if n == 0 || amount.Sign() <= 0 {
+		return m
+	}
+	if !coinType.IsSKA() && txrules.IsDustAmount(dcrutil.Amount(amount.Int64()),
+		txsizes.P2PKHPkScriptSize, feeRate) {
+		return m
+	}
+	m.Amount = amount
+	m.Fee = fee
+	m.Size = size
+	m.Inputs = n
+	return m
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/wallet/txrules"
+	"github.com/monetarium/monetarium-wallet/wallet/txsizes"
+)
+
+func TestMaxSpendable(t *testing.T) {
+	const feeRate = dcrutil.Amount(1e4)
+
+	inputs := func(values ...int64) []Input {
+		in := make([]Input, len(values))
+		for i := range in {
+			in[i].PrevOut.Value = values[i]
+		}
+		return in
+	}
+	txSize := func(n int) int {
+		scriptSizes := make([]int, n)
+		for i := range scriptSizes {
+			scriptSizes[i] = txsizes.RedeemP2PKHSigScriptSize
+		}
+		txOuts := []*wire.TxOut{{PkScript: make([]byte, txsizes.P2PKHPkScriptSize)}}
+		return txsizes.EstimateSerializeSize(scriptSizes, txOuts, 0)
+	}
+	fee := func(n int) dcrutil.Amount {
+		return txrules.FeeForSerializeSize(feeRate, txSize(n))
+	}
+
+	tests := []struct {
+		name      string
+		eligible  []Input
+		maxTxSize int
+		amount    int64
+		inputs    int
+	}{{
+		name:      "all outputs",
+		eligible:  inputs(1e8, 2e8, 3e8),
+		maxTxSize: maxStandardTxSize,
+		amount:    6e8 - int64(fee(3)),
+		inputs:    3,
+	}, {
+		name:      "dust outputs excluded",
+		eligible:  inputs(1, 1e8, 2, 2e8, 3),
+		maxTxSize: maxStandardTxSize,
+		amount:    3e8 - int64(fee(2)),
+		inputs:    2,
+	}, {
+		name:      "limited by size",
+		eligible:  inputs(1e8, 4e8, 2e8, 3e8),
+		maxTxSize: txSize(2),
+		amount:    7e8 - int64(fee(2)),
+		inputs:    2,
+	}, {
+		name:      "all dust",
+		eligible:  inputs(1, 2, 3),
+		maxTxSize: maxStandardTxSize,
+		amount:    0,
+		inputs:    0,
+	}, {
+		name:      "no outputs",
+		maxTxSize: maxStandardTxSize,
+		amount:    0,
+		inputs:    0,
+	}}
+	for _, tc := range tests {
+		m := maxSpendable(tc.eligible, cointype.CoinTypeVAR, feeRate,
+			tc.maxTxSize)
+		if m.Eligible != len(tc.eligible) {
+			t.Errorf("%s: eligible %d, want %d", tc.name, m.Eligible,
+				len(tc.eligible))
+		}
+		if m.Amount.Cmp(big.NewInt(tc.amount)) != 0 {
+			t.Errorf("%s: amount %v, want %v", tc.name, m.Amount, tc.amount)
+		}
+		if m.Inputs != tc.inputs {
+			t.Errorf("%s: inputs %d, want %d", tc.name, m.Inputs, tc.inputs)
+		}
+		if tc.inputs != 0 && (m.Fee != fee(tc.inputs) ||
+			m.Size != txSize(tc.inputs)) {
+			t.Errorf("%s: fee %v size %d, want %v size %d", tc.name,
+				m.Fee, m.Size, fee(tc.inputs), txSize(tc.inputs))
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+)
+
+func TestSignVerifyMessage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+	err := w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr, err := w.NewExternalAddress(ctx, defaultAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkHash := addr.(stdaddr.Hash160er).Hash160()
+	ecdsaAddr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(pkHash[:], cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schnorrAddr, err := stdaddr.NewAddressPubKeyHashSchnorrSecp256k1V0(pkHash[:], cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := w.NewExternalAddress(ctx, defaultAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherHash := other.(stdaddr.Hash160er).Hash160()
+	otherSchnorr, err := stdaddr.NewAddressPubKeyHashSchnorrSecp256k1V0(otherHash[:], cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const msg = "proof of ownership"
+	for _, a := range []stdaddr.Address{ecdsaAddr, schnorrAddr} {
+		sig, err := w.SignMessage(ctx, msg, a)
+		if err != nil {
+			t.Fatalf("%v: %v", a, err)
+		}
+		valid, err := VerifyMessage(msg, a, sig, cfg.Params)
+		if err != nil || !valid {
+			t.Errorf("%v: signature not verified: %v", a, err)
+		}
+		valid, _ = VerifyMessage("other message", a, sig, cfg.Params)
+		if valid {
+			t.Errorf("%v: signature verified for another message", a)
+		}
+	}
+
+	// Schnorr signatures include the public key, which must match the
+	// address.
+	sig, err := w.SignMessage(ctx, msg, schnorrAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sig) != schnorrMessageSigLen {
+		t.Errorf("Schnorr signature length %d, want %d", len(sig), schnorrMessageSigLen)
+	}
+	valid, _ := VerifyMessage(msg, otherSchnorr, sig, cfg.Params)
+	if valid {
+		t.Errorf("signature verified for another address")
+	}
+	if _, err := VerifyMessage(msg, schnorrAddr, sig[:64], cfg.Params); err == nil {
+		t.Errorf("truncated signature: expected error")
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/monetarium/monetarium-wallet/internal/metrics"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	blocksProcessed = metrics.NewCounter("monetarium_wallet_blocks_processed_total",
+		"Blocks attached to the wallet's main chain.")
+	consolidationRuns = metrics.NewCounterVec("monetarium_wallet_consolidations_total",
+		"Successful consolidations of unspent outputs, by coin type.", "cointype")
+	ssfeeRewards = metrics.NewCounterVec("monetarium_wallet_ssfee_rewards_total",
+		"Mined SSFee transactions crediting the wallet, by coin type.", "cointype")
+	ssfeeRewardAtoms = metrics.NewCounterVec("monetarium_wallet_ssfee_reward_atoms_total",
+		"Atoms of SSFee rewards credited to the wallet, by coin type.", "cointype")
+
+	balanceAtoms = metrics.NewGaugeVec("monetarium_wallet_balance_atoms",
+		"Total balance of all accounts in atoms, by coin type.", "cointype")
+	unminedTxs = metrics.NewGauge("monetarium_wallet_unmined_transactions",
+		"Unmined transactions recorded by the wallet.")
+	rescanActiveGauge = metrics.NewGauge("monetarium_wallet_rescan_active",
+		"Whether a rescan is in progress.")
+	rescanHeightGauge = metrics.NewGauge("monetarium_wallet_rescan_height",
+		"Height of the last block scanned by the active or interrupted rescan.")
+	rescanPercentGauge = metrics.NewGauge("monetarium_wallet_rescan_percent",
+		"Percent of blocks scanned by the active or interrupted rescan.")
+)
+
+// atomsFloat returns the approximate float value of an amount in atoms.
+func atomsFloat(v *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(v).Float64()
+	return f
+}
+
+// CollectMetrics updates the gauges describing the state of the wallet,
+// which are read rather than maintained as the wallet changes.  It is called
+// before the metrics are served.
+func (w *Wallet) CollectMetrics(ctx context.Context) {
+	for _, ct := range w.getActiveCoinTypes() {
+		b, err := w.TotalBalanceByCoinType(ctx, ct, 1)
+		if err != nil {
+			logCtx(ctx).Debugf("Unable to collect %v balance metric: %v", ct, err)
+			continue
+		}
+		total := float64(b.Total)
+		if ct.IsSKA() {
+			total = atomsFloat(b.SKATotal.BigInt())
+		}
+		balanceAtoms.Set(ct.String(), total)
+	}
+
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		hashes, err := w.txStore.UnminedTxHashes(dbtx.ReadBucket(wtxmgrNamespaceKey))
+		if err != nil {
+			return err
+		}
+		unminedTxs.Set(float64(len(hashes)))
+		return nil
+	})
+	if err != nil {
+		logCtx(ctx).Debugf("Unable to collect unmined transaction metric: %v", err)
+	}
+
+	status, err := w.RescanStatus(ctx)
+	if err != nil {
+		logCtx(ctx).Debugf("Unable to collect rescan metrics: %v", err)
+		return
+	}
+	active := 0.0
+	if status.Active {
+		active = 1
+	}
+	rescanActiveGauge.Set(active)
+	rescanHeightGauge.Set(float64(status.ScannedThrough))
+	rescanPercentGauge.Set(status.Percent)
+}
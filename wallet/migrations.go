@@ -0,0 +1,117 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/chaincfg"
+)
+
+// backupBeforeUpgrade writes a copy of the database to dir when any database
+// upgrades are pending.  The backup is named after the database version it
+// records, and is written to a temporary file which is renamed after the copy
+// completes, so an interrupted backup never replaces an earlier one.
+func backupBeforeUpgrade(ctx context.Context, db walletdb.DB, dir string) error {
+	pending, err := udb.PendingMigrations(ctx, db)
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	name := fmt.Sprintf("wallet-v%d.db.bak", pending[0].Version-1)
+	path := filepath.Join(dir, name)
+	f, err := os.CreateTemp(dir, name+".tmp")
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	defer os.Remove(f.Name())
+	err = db.Copy(f)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	err = os.Rename(f.Name(), path)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	logCtx(ctx).Infof("Backed up database to %s before upgrading", path)
+	return nil
+}
+
+// PendingMigrations returns the database upgrades which will be performed when
+// the wallet is opened.
+func PendingMigrations(ctx context.Context, db DB) ([]udb.Migration, error) {
+	const op errors.Op = "wallet.PendingMigrations"
+	migrations, err := udb.PendingMigrations(ctx, db.internal())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return migrations, nil
+}
+
+// DryRunUpgrade performs every pending database upgrade without committing
+// any changes, returning the upgrades which will be performed when the wallet
+// is opened.  An error is returned if any upgrade would fail.
+func DryRunUpgrade(ctx context.Context, db DB, pubPass []byte, params *chaincfg.Params) ([]udb.Migration, error) {
+	const op errors.Op = "wallet.DryRunUpgrade"
+	needsMigration, err := udb.NeedsMigration(ctx, db.internal())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if needsMigration {
+		return nil, errors.E(op, errors.Invalid, "legacy database must be "+
+			"migrated to the unified database before a dry run")
+	}
+	migrations, err := udb.DryRunUpgrade(ctx, db.internal(), pubPass, params)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return migrations, nil
+}
+
+// MigrationHistory returns the database upgrades performed by the wallet,
+// ordered by version.
+func (w *Wallet) MigrationHistory(ctx context.Context) ([]udb.MigrationRecord, error) {
+	const op errors.Op = "wallet.MigrationHistory"
+	var history []udb.MigrationRecord
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		history, err = udb.MigrationHistory(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return history, nil
+}
+
+// SchemaVersion returns the schema version recorded by the wallet database.
+func (w *Wallet) SchemaVersion(ctx context.Context) (*udb.SchemaVersion, error) {
+	const op errors.Op = "wallet.SchemaVersion"
+	var v *udb.SchemaVersion
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		v, err = udb.ReadSchemaVersion(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return v, nil
+}
@@ -166,7 +166,9 @@ func (w *mixingWallet) SignInput(tx *wire.MsgTx, index int, prevScript []byte) e
 }
 
 // PublishTransaction adds the transaction to the wallet and publishes
-// it to the network.
+// it to the network.  Mix transactions pay the wallet's contribution to its
+// own mixed outputs, and are not checked against the send policy and daily
+// spend limits.
 func (w *mixingWallet) PublishTransaction(ctx context.Context, tx *wire.MsgTx) error {
 	wallet := (*Wallet)(w)
 
@@ -174,9 +176,9 @@ func (w *mixingWallet) PublishTransaction(ctx context.Context, tx *wire.MsgTx) e
 	n := wallet.networkBackend
 	wallet.networkBackendMu.Unlock()
 
-	_, err := wallet.PublishTransaction(ctx, tx, n)
+	_, err := wallet.publishTransaction(ctx, tx, n)
 	if err != nil {
-		log.Errorf("Failed to publish mix transaction: %v", err)
+		logCtx(ctx).Errorf("Failed to publish mix transaction: %v", err)
 	}
 	return err
 }
@@ -288,7 +290,7 @@ func (w *Wallet) MixOutput(ctx context.Context, output *wire.OutPoint, changeAcc
 		return errors.E(op, err)
 	}
 
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 	if _, exists := w.lockedOutpoints[outpoint{output.Hash, output.Index}]; exists {
 		w.lockedOutpointMu.Unlock()
 		err = errors.Errorf("output %v already locked", output)
@@ -318,7 +320,7 @@ func (w *Wallet) MixOutput(ctx context.Context, output *wire.OutPoint, changeAcc
 	w.lockedOutpointMu.Unlock()
 
 	defer func() {
-		w.lockedOutpointMu.Lock()
+		w.lockOutpoints(ctx)
 		delete(w.lockedOutpoints, outpoint{output.Hash, output.Index})
 		w.lockedOutpointMu.Unlock()
 	}()
@@ -417,7 +419,7 @@ SplitPoints:
 		}
 	}
 
-	log.Infof("Mixing output %v (%v)", output, amount)
+	logCtx(ctx).Infof("Mixing output %v (%v)", output, amount)
 
 	gen := w.makeGen(ctx, mixAccount, mixBranch)
 	expires := w.dicemixExpiry(ctx)
@@ -438,7 +440,7 @@ SplitPoints:
 
 	tx := cj.Tx()
 	cjHash := tx.TxHash()
-	log.Infof("Completed CoinShuffle++ mix of output %v in transaction %v", output, &cjHash)
+	logCtx(ctx).Infof("Completed CoinShuffle++ mix of output %v in transaction %v", output, &cjHash)
 	return nil
 }
 
@@ -458,7 +460,7 @@ func (w *Wallet) MixAccount(ctx context.Context, changeAccount, mixAccount,
 	}
 
 	_, tipHeight := w.MainChainTip(ctx)
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 	var credits []Input
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		var err error
@@ -488,11 +490,11 @@ func (w *Wallet) MixAccount(ctx context.Context, changeAccount, mixAccount,
 			}
 			switch {
 			case errors.Is(err, errNoSplitDenomination):
-				log.Debugf("Unable to mix output for account %q: %v",
+				logCtx(ctx).Debugf("Unable to mix output for account %q: %v",
 					changeAccount, err)
 				err = nil
 			case errors.Is(err, errThrottledMixRequest):
-				log.Debugf("Temporarily skipped output %v during account %q mix: %v",
+				logCtx(ctx).Debugf("Temporarily skipped output %v during account %q mix: %v",
 					op, changeAccount, err)
 				err = nil
 			}
@@ -500,7 +502,7 @@ func (w *Wallet) MixAccount(ctx context.Context, changeAccount, mixAccount,
 		})
 	}
 	err = g.Wait()
-	log.Debugf("Mixed %d of %d selected outputs of account %q", success.Load(),
+	logCtx(ctx).Debugf("Mixed %d of %d selected outputs of account %q", success.Load(),
 		len(credits), changeAccount)
 	if err != nil {
 		return errors.E(op, err)
@@ -5,14 +5,19 @@
 package wallet
 
 import (
+	"bytes"
 	"context"
+	"slices"
 
 	"github.com/monetarium/monetarium-wallet/errors"
 	"github.com/monetarium/monetarium-wallet/wallet/txrules"
 	"github.com/monetarium/monetarium-wallet/wallet/txsizes"
 	"github.com/monetarium/monetarium-wallet/wallet/udb"
 	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/hdkeychain"
+	"github.com/monetarium/monetarium-node/txscript"
 	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
 	"github.com/monetarium/monetarium-node/wire"
 )
 
@@ -99,3 +104,151 @@ func (w *Wallet) PrepareRedeemMultiSigOutTxOutput(msgTx *wire.MsgTx, p2shOutput
 	msgTx.AddTxOut(txOut)
 	return nil
 }
+
+// multisigParams describes the redeem scripts of a multisig account.
+type multisigParams struct {
+	nRequired uint32
+	cosigners []*hdkeychain.ExtendedKey
+}
+
+// redeemScript returns the redeem script of the multisig account address at a
+// branch and child index.  The script requires nRequired signatures from the
+// public keys of the account key and each cosigner key derived at the same
+// path.  Public keys are sorted so every cosigner derives the same script.
+func (m *multisigParams) redeemScript(xpub *hdkeychain.ExtendedKey, branch, child uint32) ([]byte, error) {
+	pubKeys := make([][]byte, 0, 1+len(m.cosigners))
+	for _, k := range append([]*hdkeychain.ExtendedKey{xpub}, m.cosigners...) {
+		branchKey, err := k.Child(branch)
+		if err != nil {
+			return nil, err
+		}
+		childKey, err := branchKey.Child(child)
+		if err != nil {
+			return nil, err
+		}
+		pubKeys = append(pubKeys, childKey.SerializedPubKey())
+	}
+	slices.SortFunc(pubKeys, bytes.Compare)
+	return stdscript.MultiSigScriptV0(int(m.nRequired), pubKeys...)
+}
+
+// multisigAddress is a P2SH address of a multisig account.
+type multisigAddress struct {
+	*stdaddr.AddressScriptHashV0
+	script      []byte
+	accountName string
+	account     uint32
+	branch      uint32
+	child       uint32
+}
+
+var _ P2SHAddress = (*multisigAddress)(nil)
+
+func (m *multisigAddress) ScriptLen() int                 { return txsizes.P2SHPkScriptSize }
+func (m *multisigAddress) AccountName() string            { return m.accountName }
+func (m *multisigAddress) AccountKind() AccountKind       { return AccountKindBIP0044 }
+func (m *multisigAddress) RedeemScript() (uint16, []byte) { return 0, m.script }
+
+// multisigAddress returns the P2SH address of a multisig account at a branch
+// and child index, and queues the address to be watched by the network
+// backend.  The redeem script is recorded when the child index is persisted.
+func (w *Wallet) multisigAddress(ad *bip0044AccountData, accountName string,
+	account, branch, child uint32) (*multisigAddress, error) {
+
+	script, err := ad.multisig.redeemScript(ad.xpub, branch, child)
+	if err != nil {
+		return nil, err
+	}
+	p2sh, err := stdaddr.NewAddressScriptHashV0(script, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := w.NetworkBackend(); err == nil {
+		w.queueTxFilterAddrs(n, []stdaddr.Address{p2sh})
+	}
+	return &multisigAddress{
+		AddressScriptHashV0: p2sh,
+		script:              script,
+		accountName:         accountName,
+		account:             account,
+		branch:              branch,
+		child:               child,
+	}, nil
+}
+
+// importMultisigScript records the redeem script of a multisig account address
+// at a branch and child index, so outputs paying the P2SH address are credited
+// to the account.  It does nothing for other accounts.
+func (w *Wallet) importMultisigScript(dbtx walletdb.ReadWriteTx, account, branch, child uint32) error {
+	ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+	nRequired, cosigners, err := w.manager.AccountMultisig(ns, account)
+	if errors.Is(err, errors.NotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	xpub, err := w.manager.AccountExtendedPubKey(dbtx, account)
+	if err != nil {
+		return err
+	}
+	m := &multisigParams{nRequired: nRequired, cosigners: cosigners}
+	script, err := m.redeemScript(xpub, branch, child)
+	if err != nil {
+		return err
+	}
+	a, err := w.manager.ImportScript(ns, script)
+	if errors.Is(err, errors.Exist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return w.attachImportedAddress(ns, a.Address(), account)
+}
+
+// CreateMultisigAccount creates an account which pays to P2SH multisig scripts
+// requiring nRequired signatures from the keys of the account and its
+// cosigners.  Each cosigner is identified by the extended public key of their
+// own account, and the redeem script of every address is created from the
+// account key and the cosigner keys derived at the same branch and child
+// index, so cosigners which create the account with the same keys derive the
+// same addresses.  Addresses are only watched after they are returned by this
+// wallet.
+//
+// Transactions spending from the account are signed by each cosigner, for
+// example using ProcessPSDT or SignTransaction, and the partial signatures are
+// combined before the transaction is published.
+func (w *Wallet) CreateMultisigAccount(ctx context.Context, name string, nRequired int,
+	cosigners []*hdkeychain.ExtendedKey) (uint32, error) {
+
+	const op errors.Op = "wallet.CreateMultisigAccount"
+	nKeys := 1 + len(cosigners)
+	if len(cosigners) == 0 || nKeys > txscript.MaxPubKeysPerMultiSig {
+		return 0, errors.E(op, errors.Invalid, errors.Errorf("multisig "+
+			"accounts require 1 to %d cosigners", txscript.MaxPubKeysPerMultiSig-1))
+	}
+	if nRequired < 1 || nRequired > nKeys {
+		return 0, errors.E(op, errors.Invalid, errors.Errorf("invalid "+
+			"%d-of-%d multisig", nRequired, nKeys))
+	}
+	seen := make(map[string]struct{}, len(cosigners))
+	for _, k := range cosigners {
+		if k.IsPrivate() {
+			return 0, errors.E(op, errors.Invalid, "cosigner keys must "+
+				"be extended public keys")
+		}
+		xpub := k.String()
+		if _, ok := seen[xpub]; ok {
+			return 0, errors.E(op, errors.Invalid, "duplicate cosigner key")
+		}
+		seen[xpub] = struct{}{}
+	}
+
+	msig := &multisigParams{nRequired: uint32(nRequired), cosigners: cosigners}
+	account, err := w.nextAccount(ctx, name, msig, nil)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return account, nil
+}
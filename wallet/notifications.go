@@ -8,6 +8,8 @@ package wallet
 import (
 	"bytes"
 	"context"
+	"math/big"
+	"sort"
 	"sync"
 
 	"github.com/monetarium/monetarium-wallet/errors"
@@ -38,6 +40,12 @@ import (
 // registered notification.  Clients are guaranteed to receive messages in the
 // order wallet created them, but there is no guaranteed synchronization between
 // different clients.
+//
+// Without a backlog limit, notifications are not queued and the server blocks
+// until every client receives them.  With a limit, each client's channel queues
+// up to the limit of undelivered notifications, and a client reaching the limit
+// is handled by the backlog policy.  Clients disconnected by the policy have
+// their channel closed.  Confirmation notification clients are not limited.
 type NotificationServer struct {
 	transactions []chan *TransactionNotifications
 	// Coalesce transaction notifications since wallet previously did not add
@@ -47,7 +55,17 @@ type NotificationServer struct {
 	tipChangedClients         []chan *MainTipChangedNotification
 	confClients               []*ConfirmationNotificationsClient
 	removedTransactionClients []chan *RemovedTransactionNotification
-	mu                        sync.Mutex // Only protects registered clients
+	coinTypeBalanceClients    []*coinTypeBalanceClient
+	ticketCompoundingClients  []chan *TicketCompoundingNotification
+	txConflictClients         []chan *TxConflictNotification
+	invoiceClients            []chan *InvoiceNotification
+	recurringPaymentClients   []chan *RecurringPaymentNotification
+	confThresholdClients      []*ConfirmationThresholdNotificationsClient
+	stakeEventClients         []chan *StakeEvent
+	backlogLimit              int
+	backlogPolicy             NotificationBacklogPolicy
+	backlogStats              backlogStats
+	mu                        sync.Mutex // Only protects registered clients and backlog limits
 	wallet                    *Wallet    // smells like hacks
 }
 
@@ -170,6 +188,11 @@ func makeTxSummary(dbtx walletdb.ReadTx, w *Wallet, details *udb.TxDetails) Tran
 		receiveTime = details.Block.Time
 	}
 
+	var traceID string
+	if trace, err := udb.TxTraceForHash(dbtx, &details.Hash); err == nil {
+		traceID = trace.ID.String()
+	}
+
 	return TransactionSummary{
 		Hash:        &details.Hash,
 		Transaction: serializedTx,
@@ -178,44 +201,43 @@ func makeTxSummary(dbtx walletdb.ReadTx, w *Wallet, details *udb.TxDetails) Tran
 		Fee:         fee,
 		Timestamp:   receiveTime.Unix(),
 		Type:        transactionType,
+		TraceID:     traceID,
 	}
 }
 
-func totalBalances(dbtx walletdb.ReadTx, w *Wallet, m map[uint32]dcrutil.Amount) error {
+// totalBalances adds the zero-confirmation balance of each coin type to each
+// account already present in m.
+func totalBalances(dbtx walletdb.ReadTx, w *Wallet, m map[uint32]map[cointype.CoinType]dcrutil.Amount) error {
 	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
-	// Get unspent outputs for active coin types
-	var unspent []*udb.Credit
+	addOutput := func(output *udb.Credit) error {
+		_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, output.PkScript, w.chainParams)
+		if len(addrs) == 0 {
+			return nil
+		}
+		outputAcct, err := w.manager.AddrAccount(addrmgrNs, addrs[0])
+		if err == nil {
+			bals, ok := m[outputAcct]
+			if ok {
+				bals[output.CoinType] += output.Amount
+			}
+		}
+		return nil
+	}
 
-	// Get VAR outputs (always active)
-	outputs, err := w.txStore.UnspentOutputs(dbtx, cointype.CoinTypeVAR)
+	// Sum VAR outputs (always active)
+	err := w.txStore.ForEachUnspentOutput(dbtx, cointype.CoinTypeVAR, addOutput)
 	if err != nil {
 		return err
 	}
-	unspent = append(unspent, outputs...)
 
-	// Get outputs for active SKA coin types only
+	// Sum outputs for active SKA coin types only
 	if w.chainParams != nil && w.chainParams.SKACoins != nil {
 		for coinType, config := range w.chainParams.SKACoins {
 			if config.Active {
-				outputs, err := w.txStore.UnspentOutputs(dbtx, coinType)
+				err := w.txStore.ForEachUnspentOutput(dbtx, coinType, addOutput)
 				if err != nil {
 					return err
 				}
-				unspent = append(unspent, outputs...)
-			}
-		}
-	}
-	for i := range unspent {
-		output := unspent[i]
-		_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, output.PkScript, w.chainParams)
-		if len(addrs) == 0 {
-			continue
-		}
-		outputAcct, err := w.manager.AddrAccount(addrmgrNs, addrs[0])
-		if err == nil {
-			_, ok := m[outputAcct]
-			if ok {
-				m[outputAcct] += output.Amount
 			}
 		}
 	}
@@ -261,13 +283,13 @@ func flattenMultiCoinBalanceMap(accountCoinBalances map[uint32]map[cointype.Coin
 	return s
 }
 
-func relevantAccounts(m map[uint32]dcrutil.Amount, txs []TransactionSummary) {
+func relevantAccounts(m map[uint32]map[cointype.CoinType]dcrutil.Amount, txs []TransactionSummary) {
 	for _, tx := range txs {
 		for _, d := range tx.MyInputs {
-			m[d.PreviousAccount] = 0
+			m[d.PreviousAccount] = make(map[cointype.CoinType]dcrutil.Amount)
 		}
 		for _, c := range tx.MyOutputs {
-			m[c.Account] = 0
+			m[c.Account] = make(map[cointype.CoinType]dcrutil.Amount)
 		}
 	}
 }
@@ -282,6 +304,8 @@ func (s *NotificationServer) notifyUnminedTransaction(dbtx walletdb.ReadTx, deta
 		log.Tracef("Notifying unmined tx notification while creating notification for blocks")
 	}
 
+	s.notifyCoinTypeBalances(dbtx)
+
 	clients := s.transactions
 	if len(clients) == 0 {
 		return
@@ -293,7 +317,7 @@ func (s *NotificationServer) notifyUnminedTransaction(dbtx walletdb.ReadTx, deta
 		log.Errorf("Cannot fetch unmined transaction hashes: %v", err)
 		return
 	}
-	bals := make(map[uint32]dcrutil.Amount)
+	bals := make(map[uint32]map[cointype.CoinType]dcrutil.Amount)
 	relevantAccounts(bals, unminedTxs)
 	err = totalBalances(dbtx, s.wallet, bals)
 	if err != nil {
@@ -303,11 +327,9 @@ func (s *NotificationServer) notifyUnminedTransaction(dbtx walletdb.ReadTx, deta
 	n := &TransactionNotifications{
 		UnminedTransactions:      unminedTxs,
 		UnminedTransactionHashes: unminedHashes,
-		NewBalances:              flattenBalanceMap(bals),
-	}
-	for _, c := range clients {
-		c <- n
+		NewBalances:              flattenMultiCoinBalanceMap(bals),
 	}
+	s.transactions = sendNotifications(s, clients, n, mergeTransactionNotifications)
 }
 
 func (s *NotificationServer) notifyDetachedBlock(header *wire.BlockHeader) {
@@ -356,7 +378,7 @@ func (s *NotificationServer) notifyAttachedBlock(block *wire.BlockHeader, blockH
 func (s *NotificationServer) sendAttachedBlockNotification(ctx context.Context) {
 	// Avoid work if possible
 	s.mu.Lock()
-	if len(s.transactions) == 0 {
+	if len(s.transactions) == 0 && len(s.coinTypeBalanceClients) == 0 {
 		s.currentTxNtfn = nil
 		s.mu.Unlock()
 		return
@@ -374,10 +396,20 @@ func (s *NotificationServer) sendAttachedBlockNotification(ctx context.Context)
 
 	var (
 		w             = s.wallet
-		bals          = make(map[uint32]dcrutil.Amount)
+		bals          = make(map[uint32]map[cointype.CoinType]dcrutil.Amount)
 		unminedHashes []*chainhash.Hash
 	)
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		// Spendable balances change as outputs gain confirmations, so
+		// coin type balance clients are notified for every attached
+		// block, even those without relevant transactions.
+		s.mu.Lock()
+		s.notifyCoinTypeBalances(dbtx)
+		s.mu.Unlock()
+
+		if currentTxNtfn == nil {
+			return nil
+		}
 		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 		var err error
 		unminedHashes, err = w.txStore.UnminedTxHashes(txmgrNs)
@@ -391,17 +423,19 @@ func (s *NotificationServer) sendAttachedBlockNotification(ctx context.Context)
 
 	})
 	if err != nil {
-		log.Errorf("Failed to construct attached blocks notification: %v", err)
+		logCtx(ctx).Errorf("Failed to construct attached blocks notification: %v", err)
+		return
+	}
+	if currentTxNtfn == nil {
 		return
 	}
 
 	currentTxNtfn.UnminedTransactionHashes = unminedHashes
-	currentTxNtfn.NewBalances = flattenBalanceMap(bals)
+	currentTxNtfn.NewBalances = flattenMultiCoinBalanceMap(bals)
 
 	s.mu.Lock()
-	for _, c := range s.transactions {
-		c <- currentTxNtfn
-	}
+	s.transactions = sendNotifications(s, s.transactions, currentTxNtfn,
+		mergeTransactionNotifications)
 	s.mu.Unlock()
 }
 
@@ -419,7 +453,7 @@ func (s *NotificationServer) sendAttachedBlockNotification(ctx context.Context)
 // transactions still unmined are included.
 //
 // If any transactions were involved, each affected account's new total balance
-// is included.
+// is included, broken down by coin type.
 //
 // TODO: Because this includes stuff about blocks and can be fired without any
 // changes to transactions, it needs a better name.
@@ -448,6 +482,7 @@ type TransactionSummary struct {
 	Fee         dcrutil.Amount
 	Timestamp   int64
 	Type        TransactionType
+	TraceID     string // Empty for transactions not originated by the wallet
 }
 
 // TransactionType describes the which type of transaction is has been observed to be.
@@ -531,7 +566,7 @@ type TransactionSummaryOutput struct {
 //
 // Fields:
 //   - Account: The account number this balance notification relates to
-//   - TotalBalance: Legacy VAR total balance (maintained for backward compatibility)
+//   - TotalBalance: VAR total balance (maintained for backward compatibility)
 //   - CoinTypeBalances: Map of coin type to total balance for that coin type
 //     Key 0 = VAR balance, Keys 1-255 = SKA variant balances
 //
@@ -562,14 +597,14 @@ type TransactionNotificationsClient struct {
 }
 
 // TransactionNotifications returns a client for receiving
-// TransactionNotifiations notifications over a channel.  The channel is
-// unbuffered.
+// TransactionNotifiations notifications over a channel.  The channel buffers
+// the server's backlog limit.
 //
 // When finished, the Done method should be called on the client to disassociate
 // it from the server.
 func (s *NotificationServer) TransactionNotifications() TransactionNotificationsClient {
-	c := make(chan *TransactionNotifications)
 	s.mu.Lock()
+	c := newClientChan[*TransactionNotifications](s)
 	s.transactions = append(s.transactions, c)
 	s.mu.Unlock()
 	return TransactionNotificationsClient{
@@ -616,11 +651,12 @@ type RemovedTransactionNotificationsClient struct {
 }
 
 // RemovedTransactionNotifications returns a client for receiving RemovedTransactionNotifications over
-// a channel.  The channel is unbuffered.  When finished, the client's Done
-// method should be called to disassociate the client from the server.
+// a channel.  The channel buffers the server's backlog limit.  When finished,
+// the client's Done method should be called to disassociate the client from the
+// server.
 func (s *NotificationServer) RemovedTransactionNotifications() RemovedTransactionNotificationsClient {
-	c := make(chan *RemovedTransactionNotification)
 	s.mu.Lock()
+	c := newClientChan[*RemovedTransactionNotification](s)
 	s.removedTransactionClients = append(s.removedTransactionClients, c)
 	s.mu.Unlock()
 	return RemovedTransactionNotificationsClient{
@@ -663,9 +699,7 @@ func (s *NotificationServer) notifyRemovedTransaction(hash chainhash.Hash) {
 	n := &RemovedTransactionNotification{
 		TxHash: hash,
 	}
-	for _, c := range clients {
-		c <- n
-	}
+	s.removedTransactionClients = sendNotifications(s, clients, n, nil)
 }
 
 // AccountNotification contains properties regarding an account, such as its
@@ -698,14 +732,13 @@ func (s *NotificationServer) notifyAccountProperties(props *udb.AccountPropertie
 	// addresses have also been generated and are being watched for transaction
 	// activity.
 	if props.AccountNumber <= udb.MaxAccountNum {
+		gapLimit := s.wallet.addressGapLimit(props)
 		n.ExternalKeyCount = min(hdkeychain.HardenedKeyStart,
-			props.LastUsedExternalIndex+s.wallet.gapLimit)
+			props.LastUsedExternalIndex+gapLimit)
 		n.InternalKeyCount = min(hdkeychain.HardenedKeyStart,
-			props.LastUsedInternalIndex+s.wallet.gapLimit)
-	}
-	for _, c := range clients {
-		c <- n
+			props.LastUsedInternalIndex+gapLimit)
 	}
+	s.accountClients = sendNotifications(s, clients, n, nil)
 }
 
 // AccountNotificationsClient receives AccountNotifications over the channel C.
@@ -715,11 +748,12 @@ type AccountNotificationsClient struct {
 }
 
 // AccountNotifications returns a client for receiving AccountNotifications over
-// a channel.  The channel is unbuffered.  When finished, the client's Done
-// method should be called to disassociate the client from the server.
+// a channel.  The channel buffers the server's backlog limit.  When finished,
+// the client's Done method should be called to disassociate the client from the
+// server.
 func (s *NotificationServer) AccountNotifications() AccountNotificationsClient {
-	c := make(chan *AccountNotification)
 	s.mu.Lock()
+	c := newClientChan[*AccountNotification](s)
 	s.accountClients = append(s.accountClients, c)
 	s.mu.Unlock()
 	return AccountNotificationsClient{
@@ -752,6 +786,255 @@ func (c *AccountNotificationsClient) Done() {
 	}()
 }
 
+// TicketCompoundingNotification describes a change to the ticket reward
+// compounding state of an account.  Matured is the value of SSFee VAR rewards
+// which matured and were accrued.  When tickets are purchased with accrued
+// rewards, TicketHashes lists the purchased tickets, TicketPrice is the stake
+// difficulty they were purchased at, and Spent is the value of accrued rewards
+// they consumed.  Accrued is the value of rewards remaining after the change.
+type TicketCompoundingNotification struct {
+	Account      uint32
+	Height       int32
+	Matured      dcrutil.Amount
+	TicketHashes []*chainhash.Hash
+	TicketPrice  dcrutil.Amount
+	Spent        dcrutil.Amount
+	Accrued      dcrutil.Amount
+}
+
+func (s *NotificationServer) notifyTicketCompounding(n *TicketCompoundingNotification) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	s.ticketCompoundingClients = sendNotifications(s, s.ticketCompoundingClients, n, nil)
+}
+
+// TicketCompoundingNotificationsClient receives TicketCompoundingNotifications
+// over the channel C.
+type TicketCompoundingNotificationsClient struct {
+	C      chan *TicketCompoundingNotification
+	server *NotificationServer
+}
+
+// TicketCompoundingNotifications returns a client for receiving
+// TicketCompoundingNotifications over a channel.  The channel buffers the
+// server's backlog limit.  When finished, the client's Done method should be
+// called to disassociate the client from the server.
+func (s *NotificationServer) TicketCompoundingNotifications() TicketCompoundingNotificationsClient {
+	s.mu.Lock()
+	c := newClientChan[*TicketCompoundingNotification](s)
+	s.ticketCompoundingClients = append(s.ticketCompoundingClients, c)
+	s.mu.Unlock()
+	return TicketCompoundingNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *TicketCompoundingNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.ticketCompoundingClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.ticketCompoundingClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// TxConflictNotification describes a transaction which double spends unmined
+// wallet transactions.  Conflicts lists the hashes of the unmined transactions
+// which double spend an input of the transaction, followed by every unmined
+// transaction spending their outputs.  When Mined is true, the transaction was
+// mined and the conflicting transactions were removed from the wallet.
+// Otherwise, the transaction is a competing unmined spend which was not
+// recorded, and the conflicting transactions remain unmined.
+type TxConflictNotification struct {
+	TxHash    chainhash.Hash
+	Conflicts []chainhash.Hash
+	Mined     bool
+}
+
+func (s *NotificationServer) notifyTxConflict(n *TxConflictNotification) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	s.txConflictClients = sendNotifications(s, s.txConflictClients, n, nil)
+}
+
+// TxConflictNotificationsClient receives TxConflictNotifications over the
+// channel C.
+type TxConflictNotificationsClient struct {
+	C      chan *TxConflictNotification
+	server *NotificationServer
+}
+
+// TxConflictNotifications returns a client for receiving
+// TxConflictNotifications over a channel.  The channel buffers the server's
+// backlog limit.  When finished, the client's Done method should be called to
+// disassociate the client from the server.
+func (s *NotificationServer) TxConflictNotifications() TxConflictNotificationsClient {
+	s.mu.Lock()
+	c := newClientChan[*TxConflictNotification](s)
+	s.txConflictClients = append(s.txConflictClients, c)
+	s.mu.Unlock()
+	return TxConflictNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *TxConflictNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.txConflictClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.txConflictClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// InvoiceNotification describes an invoice which was paid or expired.  The
+// invoice's status reports which.
+type InvoiceNotification struct {
+	Invoice *udb.Invoice
+}
+
+func (s *NotificationServer) notifyInvoice(n *InvoiceNotification) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	s.invoiceClients = sendNotifications(s, s.invoiceClients, n, nil)
+}
+
+// InvoiceNotificationsClient receives InvoiceNotifications over the channel C.
+type InvoiceNotificationsClient struct {
+	C      chan *InvoiceNotification
+	server *NotificationServer
+}
+
+// InvoiceNotifications returns a client for receiving InvoiceNotifications
+// over a channel.  The channel buffers the server's backlog limit.  When
+// finished, the client's Done method should be called to disassociate the
+// client from the server.
+func (s *NotificationServer) InvoiceNotifications() InvoiceNotificationsClient {
+	s.mu.Lock()
+	c := newClientChan[*InvoiceNotification](s)
+	s.invoiceClients = append(s.invoiceClients, c)
+	s.mu.Unlock()
+	return InvoiceNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *InvoiceNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.invoiceClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.invoiceClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// RecurringPaymentNotification describes an attempted payment of a recurring
+// payment.  The outcome is described by the transaction hash and error of the
+// recurring payment's last attempt.
+type RecurringPaymentNotification struct {
+	Payment *udb.RecurringPayment
+}
+
+func (s *NotificationServer) notifyRecurringPayment(n *RecurringPaymentNotification) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	s.recurringPaymentClients = sendNotifications(s, s.recurringPaymentClients, n, nil)
+}
+
+// RecurringPaymentNotificationsClient receives RecurringPaymentNotifications
+// over the channel C.
+type RecurringPaymentNotificationsClient struct {
+	C      chan *RecurringPaymentNotification
+	server *NotificationServer
+}
+
+// RecurringPaymentNotifications returns a client for receiving
+// RecurringPaymentNotifications over a channel.  The channel buffers the
+// server's backlog limit.  When finished, the client's Done method should be
+// called to disassociate the client from the server.
+func (s *NotificationServer) RecurringPaymentNotifications() RecurringPaymentNotificationsClient {
+	s.mu.Lock()
+	c := newClientChan[*RecurringPaymentNotification](s)
+	s.recurringPaymentClients = append(s.recurringPaymentClients, c)
+	s.mu.Unlock()
+	return RecurringPaymentNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *RecurringPaymentNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.recurringPaymentClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.recurringPaymentClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
 // MainTipChangedNotification describes processed changes to the main chain tip
 // block.  Attached and detached blocks are sorted by increasing heights.
 //
@@ -771,12 +1054,12 @@ type MainTipChangedNotificationsClient struct {
 }
 
 // MainTipChangedNotifications returns a client for receiving
-// MainTipChangedNotification over a channel.  The channel is unbuffered.  When
-// finished, the client's Done method should be called to disassociate the
-// client from the server.
+// MainTipChangedNotification over a channel.  The channel buffers the server's
+// backlog limit.  When finished, the client's Done method should be called to
+// disassociate the client from the server.
 func (s *NotificationServer) MainTipChangedNotifications() MainTipChangedNotificationsClient {
-	c := make(chan *MainTipChangedNotification)
 	s.mu.Lock()
+	c := newClientChan[*MainTipChangedNotification](s)
 	s.tipChangedClients = append(s.tipChangedClients, c)
 	s.mu.Unlock()
 	return MainTipChangedNotificationsClient{
@@ -812,9 +1095,8 @@ func (c *MainTipChangedNotificationsClient) Done() {
 func (s *NotificationServer) notifyMainChainTipChanged(n *MainTipChangedNotification) {
 	s.mu.Lock()
 
-	for _, c := range s.tipChangedClients {
-		c <- n
-	}
+	s.tipChangedClients = sendNotifications(s, s.tipChangedClients, n,
+		mergeMainTipChangedNotifications)
 
 	if len(s.confClients) > 0 {
 		var wg sync.WaitGroup
@@ -829,7 +1111,189 @@ func (s *NotificationServer) notifyMainChainTipChanged(n *MainTipChangedNotifica
 		wg.Wait()
 	}
 
+	connected := s.confThresholdClients[:0]
+	for _, c := range s.confThresholdClients {
+		if c.process(n) {
+			connected = append(connected, c)
+		}
+	}
+	clear(s.confThresholdClients[len(connected):])
+	s.confThresholdClients = connected
+
+	s.notifyStakeEvents(n)
+
+	s.mu.Unlock()
+}
+
+// CoinTypeBalanceNotification reports the new spendable balances of a single
+// coin type for each account whose spendable balance of that coin type
+// changed.
+type CoinTypeBalanceNotification struct {
+	CoinType cointype.CoinType
+	Balances []CoinTypeSpendableBalance
+}
+
+// CoinTypeSpendableBalance is the spendable balance of a coin type in an
+// account.  Spendable is exact for all coin types, including SKA balances
+// exceeding the range of dcrutil.Amount.
+type CoinTypeSpendableBalance struct {
+	Account   uint32
+	Spendable *big.Int
+}
+
+type coinTypeBalanceClient struct {
+	c        chan *CoinTypeBalanceNotification
+	coinType cointype.CoinType
+	minConf  int32
+	last     map[uint32]*big.Int
+}
+
+// CoinTypeBalanceNotificationsClient receives CoinTypeBalanceNotifications
+// over the channel C.
+type CoinTypeBalanceNotificationsClient struct {
+	C      <-chan *CoinTypeBalanceNotification
+	client *coinTypeBalanceClient
+	server *NotificationServer
+}
+
+// spendableBalances returns the spendable balance of a coin type, counting
+// outputs with at least minConf confirmations, for every account.  Unmined
+// outputs are recorded as unconfirmed rather than spendable by the
+// transaction store, and are added when minConf is zero.
+func spendableBalances(dbtx walletdb.ReadTx, w *Wallet, coinType cointype.CoinType,
+	minConf int32) (map[uint32]*big.Int, error) {
+
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	bals := make(map[uint32]*big.Int)
+	err := w.manager.ForEachAccount(addrmgrNs, func(acct uint32) error {
+		bal, err := w.txStore.AccountBalanceByCoinType(dbtx, minConf, acct, coinType)
+		if err != nil {
+			return err
+		}
+		if coinType.IsSKA() {
+			spendable := bal.SKASpendable
+			if minConf == 0 {
+				spendable = spendable.Add(bal.SKAUnconfirmed)
+			}
+			bals[acct] = spendable.BigInt()
+		} else {
+			spendable := bal.Spendable
+			if minConf == 0 {
+				spendable += bal.Unconfirmed
+			}
+			bals[acct] = big.NewInt(int64(spendable))
+		}
+		return nil
+	})
+	return bals, err
+}
+
+// CoinTypeBalanceNotifications returns a client for receiving notifications
+// when the spendable balance of coinType, counting outputs with at least
+// minConf confirmations, changes in any account.  Balances are rechecked
+// whenever a relevant unmined transaction is added or blocks are attached to
+// the main chain.  The channel buffers the server's backlog limit.
+//
+// When finished, the Done method should be called on the client to
+// disassociate it from the server.
+func (s *NotificationServer) CoinTypeBalanceNotifications(ctx context.Context,
+	coinType cointype.CoinType, minConf int32) (CoinTypeBalanceNotificationsClient, error) {
+
+	const op errors.Op = "wallet.CoinTypeBalanceNotifications"
+	if minConf < 0 {
+		return CoinTypeBalanceNotificationsClient{}, errors.E(op, errors.Invalid,
+			"negative minimum confirmations")
+	}
+
+	c := &coinTypeBalanceClient{
+		coinType: coinType,
+		minConf:  minConf,
+	}
+	err := walletdb.View(ctx, s.wallet.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		c.last, err = spendableBalances(dbtx, s.wallet, coinType, minConf)
+		return err
+	})
+	if err != nil {
+		return CoinTypeBalanceNotificationsClient{}, errors.E(op, err)
+	}
+
+	s.mu.Lock()
+	c.c = newClientChan[*CoinTypeBalanceNotification](s)
+	s.coinTypeBalanceClients = append(s.coinTypeBalanceClients, c)
 	s.mu.Unlock()
+	return CoinTypeBalanceNotificationsClient{
+		C:      c.c,
+		client: c,
+		server: s,
+	}, nil
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *CoinTypeBalanceNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.coinTypeBalanceClients
+		for i, cc := range clients {
+			if c.client == cc {
+				clients[i] = clients[len(clients)-1]
+				s.coinTypeBalanceClients = clients[:len(clients)-1]
+				close(cc.c)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// notifyCoinTypeBalances notifies each coin type balance client of accounts
+// whose spendable balance changed since the client was last notified.  The
+// server mutex must be held.
+func (s *NotificationServer) notifyCoinTypeBalances(dbtx walletdb.ReadTx) {
+	clients := s.coinTypeBalanceClients
+	connected := clients[:0]
+	defer func() {
+		clear(clients[len(connected):])
+		s.coinTypeBalanceClients = connected
+	}()
+	for _, c := range clients {
+		bals, err := spendableBalances(dbtx, s.wallet, c.coinType, c.minConf)
+		if err != nil {
+			log.Errorf("Cannot determine %v spendable balances: %v",
+				c.coinType, err)
+			connected = append(connected, c)
+			continue
+		}
+		n := &CoinTypeBalanceNotification{CoinType: c.coinType}
+		for acct, bal := range bals {
+			last, ok := c.last[acct]
+			if ok && last.Cmp(bal) == 0 {
+				continue
+			}
+			n.Balances = append(n.Balances, CoinTypeSpendableBalance{
+				Account:   acct,
+				Spendable: bal,
+			})
+		}
+		c.last = bals
+		if len(n.Balances) == 0 {
+			connected = append(connected, c)
+			continue
+		}
+		sort.Slice(n.Balances, func(i, j int) bool {
+			return n.Balances[i].Account < n.Balances[j].Account
+		})
+		if sendNotification(s, c.c, n, mergeCoinTypeBalanceNotifications) {
+			connected = append(connected, c)
+		}
+	}
 }
 
 // ConfirmationNotifications registers a client for confirmation notifications
@@ -0,0 +1,90 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+func TestCoinTypeBalanceNotifications(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	_, err := w.NtfnServer.CoinTypeBalanceNotifications(ctx, cointype.CoinTypeVAR, -1)
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("negative minconf: expected Invalid error, got %v", err)
+	}
+	n, err := w.NtfnServer.CoinTypeBalanceNotifications(ctx, cointype.CoinTypeVAR, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer n.Done()
+
+	addr, err := w.NewExternalAddress(ctx, defaultAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// add records an unmined transaction paying the wallet, returning the
+	// notification received while adding it, if any.
+	add := func(prev byte, coinType cointype.CoinType, value *big.Int) *CoinTypeBalanceNotification {
+		t.Helper()
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{prev}}, 0, nil))
+		tx.AddTxOut(payTo(addr, coinType, value))
+		errs := make(chan error, 1)
+		go func() { errs <- w.AddTransaction(ctx, tx, nil) }()
+
+		var ntfn *CoinTypeBalanceNotification
+		for {
+			select {
+			case ntfn = <-n.C:
+			case err := <-errs:
+				if err != nil {
+					t.Fatal(err)
+				}
+				// The notification is sent before AddTransaction
+				// returns, but may not have been received yet.
+				select {
+				case ntfn = <-n.C:
+				default:
+				}
+				return ntfn
+			case <-time.After(10 * time.Second):
+				t.Fatal("timed out adding transaction")
+			}
+		}
+	}
+
+	ntfn := add(1, cointype.CoinTypeVAR, big.NewInt(1e8))
+	if ntfn == nil {
+		t.Fatal("no notification for VAR balance change")
+	}
+	if ntfn.CoinType != cointype.CoinTypeVAR || len(ntfn.Balances) != 1 {
+		t.Fatalf("unexpected notification %+v", ntfn)
+	}
+	b := ntfn.Balances[0]
+	if b.Account != defaultAccount || b.Spendable.Cmp(big.NewInt(1e8)) != 0 {
+		t.Fatalf("notified account %d spendable %v, want account %d spendable %v",
+			b.Account, b.Spendable, defaultAccount, 1e8)
+	}
+
+	// Receiving another coin type leaves the VAR balance unchanged and must
+	// not notify the client.
+	if ntfn := add(2, 1, big.NewInt(5e8)); ntfn != nil {
+		t.Fatalf("notified of unchanged VAR balance: %+v", ntfn)
+	}
+}
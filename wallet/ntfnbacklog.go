@@ -0,0 +1,283 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"sort"
+	"sync/atomic"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+)
+
+// NotificationBacklogPolicy describes how the notification server handles a
+// client whose backlog of undelivered notifications reaches the backlog
+// limit.
+type NotificationBacklogPolicy int
+
+const (
+	// BacklogDisconnect disconnects the client by closing its notification
+	// channel.  No further notifications are sent to the client.
+	BacklogDisconnect NotificationBacklogPolicy = iota
+
+	// BacklogDropOldest discards the oldest undelivered notification to
+	// make room for the new notification.
+	BacklogDropOldest
+
+	// BacklogSummarize combines every undelivered notification and the new
+	// notification into a single notification describing all of them.
+	// Notifications which can not be combined (account, removed
+	// transaction, and ticket compounding notifications) drop the oldest
+	// notification instead.
+	BacklogSummarize
+)
+
+var backlogPolicyNames = [...]string{
+	BacklogDisconnect: "disconnect",
+	BacklogDropOldest: "dropoldest",
+	BacklogSummarize:  "summarize",
+}
+
+// String returns the name of the policy.
+func (p NotificationBacklogPolicy) String() string {
+	if p < 0 || int(p) >= len(backlogPolicyNames) {
+		return "unknown"
+	}
+	return backlogPolicyNames[p]
+}
+
+// ParseNotificationBacklogPolicy returns the policy with a name returned by
+// NotificationBacklogPolicy.String.
+func ParseNotificationBacklogPolicy(name string) (NotificationBacklogPolicy, error) {
+	for p, n := range backlogPolicyNames {
+		if n == name {
+			return NotificationBacklogPolicy(p), nil
+		}
+	}
+	return 0, errors.E(errors.Invalid, errors.Errorf("unknown notification "+
+		"backlog policy %q", name))
+}
+
+// NotificationBacklogStats counts the actions taken by the notification server
+// on clients which reached the backlog limit.
+type NotificationBacklogStats struct {
+	Dropped      uint64 // Notifications discarded by the drop policies
+	Summarized   uint64 // Notifications combined into summaries
+	Disconnected uint64 // Clients disconnected
+}
+
+type backlogStats struct {
+	dropped      atomic.Uint64
+	summarized   atomic.Uint64
+	disconnected atomic.Uint64
+}
+
+// SetBacklogLimit limits the number of undelivered notifications queued for
+// each client registered after the call, and sets the policy for clients
+// reaching the limit.  A zero limit queues nothing, blocking notifications
+// until they are received by every client.
+func (s *NotificationServer) SetBacklogLimit(limit int, policy NotificationBacklogPolicy) {
+	s.mu.Lock()
+	s.backlogLimit = max(limit, 0)
+	s.backlogPolicy = policy
+	s.mu.Unlock()
+}
+
+// BacklogStats returns the number of notifications and clients affected by
+// the backlog policy since the server was created.
+func (s *NotificationServer) BacklogStats() NotificationBacklogStats {
+	return NotificationBacklogStats{
+		Dropped:      s.backlogStats.dropped.Load(),
+		Summarized:   s.backlogStats.summarized.Load(),
+		Disconnected: s.backlogStats.disconnected.Load(),
+	}
+}
+
+// newClientChan returns the notification channel of a new client, buffering
+// the backlog limit.  The server mutex must be held.
+func newClientChan[T any](s *NotificationServer) chan T {
+	return make(chan T, s.backlogLimit)
+}
+
+// sendNotification sends n to a client's channel, applying the backlog policy
+// if the client's backlog is full.  Merge combines an older and newer
+// notification for the summarize policy, and is nil if notifications can not
+// be combined.  It returns false, after closing the channel, if the client was
+// disconnected and must be removed from the server.  The server mutex must be
+// held.
+func sendNotification[T any](s *NotificationServer, c chan T, n T, merge func(older, newer T) T) bool {
+	if cap(c) == 0 {
+		c <- n
+		return true
+	}
+	select {
+	case c <- n:
+		return true
+	default:
+	}
+
+	// The server is the only sender and holds the mutex, so after
+	// receiving from the full channel, the sends below can not block.
+	policy := s.backlogPolicy
+	if policy == BacklogSummarize && merge == nil {
+		policy = BacklogDropOldest
+	}
+	switch policy {
+	case BacklogDropOldest:
+		select {
+		case <-c:
+			s.backlogStats.dropped.Add(1)
+		default:
+		}
+		c <- n
+
+	case BacklogSummarize:
+		var summary T
+		var summarized uint64
+	drain:
+		for {
+			select {
+			case v := <-c:
+				if summarized == 0 {
+					summary = v
+				} else {
+					summary = merge(summary, v)
+				}
+				summarized++
+			default:
+				break drain
+			}
+		}
+		if summarized != 0 {
+			n = merge(summary, n)
+			s.backlogStats.summarized.Add(summarized + 1)
+		}
+		c <- n
+
+	default:
+		close(c)
+		s.backlogStats.disconnected.Add(1)
+		log.Warnf("Disconnected notification client with %d undelivered "+
+			"notifications", cap(c))
+		return false
+	}
+	return true
+}
+
+// sendNotifications sends n to every client and returns the clients which
+// remain connected.  The server mutex must be held.
+func sendNotifications[T any](s *NotificationServer, clients []chan T, n T, merge func(older, newer T) T) []chan T {
+	connected := clients[:0]
+	for _, c := range clients {
+		if sendNotification(s, c, n, merge) {
+			connected = append(connected, c)
+		}
+	}
+	clear(clients[len(connected):])
+	return connected
+}
+
+// mergeTransactionNotifications combines two transaction notifications.
+// Blocks attached by the older notification and detached by the newer are
+// omitted from both, and the newer notification's unmined transaction hashes
+// and balances replace the older.
+func mergeTransactionNotifications(older, newer *TransactionNotifications) *TransactionNotifications {
+	detached := make(map[chainhash.Hash]struct{}, len(newer.DetachedBlocks))
+	for _, h := range newer.DetachedBlocks {
+		detached[h.BlockHash()] = struct{}{}
+	}
+	merged := &TransactionNotifications{
+		UnminedTransactionHashes: newer.UnminedTransactionHashes,
+	}
+	for _, b := range older.AttachedBlocks {
+		hash := b.Header.BlockHash()
+		if _, ok := detached[hash]; ok {
+			delete(detached, hash)
+			continue
+		}
+		merged.AttachedBlocks = append(merged.AttachedBlocks, b)
+	}
+	merged.AttachedBlocks = append(merged.AttachedBlocks, newer.AttachedBlocks...)
+	// Detached blocks are sorted in the reverse order they were mined.  Any
+	// remaining block detached by the newer notification is lower than
+	// every block detached by the older, so is reported after them.
+	merged.DetachedBlocks = append(merged.DetachedBlocks, older.DetachedBlocks...)
+	for _, h := range newer.DetachedBlocks {
+		if _, ok := detached[h.BlockHash()]; ok {
+			merged.DetachedBlocks = append(merged.DetachedBlocks, h)
+		}
+	}
+	merged.UnminedTransactions = append(merged.UnminedTransactions,
+		older.UnminedTransactions...)
+	merged.UnminedTransactions = append(merged.UnminedTransactions,
+		newer.UnminedTransactions...)
+
+	balances := make(map[uint32]AccountBalance)
+	for _, b := range older.NewBalances {
+		balances[b.Account] = b
+	}
+	for _, b := range newer.NewBalances {
+		balances[b.Account] = b
+	}
+	for _, b := range balances {
+		merged.NewBalances = append(merged.NewBalances, b)
+	}
+	sort.Slice(merged.NewBalances, func(i, j int) bool {
+		return merged.NewBalances[i].Account < merged.NewBalances[j].Account
+	})
+	return merged
+}
+
+// mergeMainTipChangedNotifications combines two main chain tip notifications.
+// Blocks attached by the older notification and detached by the newer are
+// omitted from both.
+func mergeMainTipChangedNotifications(older, newer *MainTipChangedNotification) *MainTipChangedNotification {
+	detached := make(map[chainhash.Hash]struct{}, len(newer.DetachedBlocks))
+	for _, h := range newer.DetachedBlocks {
+		detached[*h] = struct{}{}
+	}
+	merged := &MainTipChangedNotification{NewHeight: newer.NewHeight}
+	for _, h := range older.AttachedBlocks {
+		if _, ok := detached[*h]; ok {
+			delete(detached, *h)
+			continue
+		}
+		merged.AttachedBlocks = append(merged.AttachedBlocks, h)
+	}
+	merged.AttachedBlocks = append(merged.AttachedBlocks, newer.AttachedBlocks...)
+	// Detached blocks are sorted by increasing height.  Any remaining block
+	// detached by the newer notification is lower than every block detached
+	// by the older, so is reported before them.
+	for _, h := range newer.DetachedBlocks {
+		if _, ok := detached[*h]; ok {
+			merged.DetachedBlocks = append(merged.DetachedBlocks, h)
+		}
+	}
+	merged.DetachedBlocks = append(merged.DetachedBlocks, older.DetachedBlocks...)
+	return merged
+}
+
+// mergeCoinTypeBalanceNotifications combines two spendable balance
+// notifications of a coin type, reporting the newer balance of each account.
+func mergeCoinTypeBalanceNotifications(older, newer *CoinTypeBalanceNotification) *CoinTypeBalanceNotification {
+	balances := make(map[uint32]CoinTypeSpendableBalance)
+	for _, b := range older.Balances {
+		balances[b.Account] = b
+	}
+	for _, b := range newer.Balances {
+		balances[b.Account] = b
+	}
+	merged := &CoinTypeBalanceNotification{
+		CoinType: newer.CoinType,
+		Balances: make([]CoinTypeSpendableBalance, 0, len(balances)),
+	}
+	for _, b := range balances {
+		merged.Balances = append(merged.Balances, b)
+	}
+	sort.Slice(merged.Balances, func(i, j int) bool {
+		return merged.Balances[i].Account < merged.Balances[j].Account
+	})
+	return merged
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+)
+
+func TestNotificationBacklogPolicies(t *testing.T) {
+	t.Parallel()
+
+	tip := func(height int32) *MainTipChangedNotification {
+		return &MainTipChangedNotification{
+			AttachedBlocks: []*chainhash.Hash{{byte(height)}},
+			NewHeight:      height,
+		}
+	}
+	// notify sends tip notifications for heights 1 through 4 to a client
+	// with a backlog limit of two, returning whether the client remains
+	// connected and the notifications it receives.
+	notify := func(policy NotificationBacklogPolicy) (*NotificationServer, bool, []*MainTipChangedNotification) {
+		s := new(NotificationServer)
+		s.SetBacklogLimit(2, policy)
+		c := s.MainTipChangedNotifications()
+		for h := int32(1); h <= 4; h++ {
+			s.notifyMainChainTipChanged(tip(h))
+		}
+		connected := len(s.tipChangedClients) == 1
+		var received []*MainTipChangedNotification
+		for len(c.C) > 0 {
+			received = append(received, <-c.C)
+		}
+		return s, connected, received
+	}
+
+	s, connected, received := notify(BacklogDisconnect)
+	if connected {
+		t.Errorf("disconnect: client remains connected")
+	}
+	if len(received) != 2 || received[0].NewHeight != 1 || received[1].NewHeight != 2 {
+		t.Errorf("disconnect: received %+v, want heights 1 and 2", received)
+	}
+	if got, want := s.BacklogStats(), (NotificationBacklogStats{Disconnected: 1}); got != want {
+		t.Errorf("disconnect: stats %+v, want %+v", got, want)
+	}
+
+	s, connected, received = notify(BacklogDropOldest)
+	if !connected {
+		t.Errorf("dropoldest: client was disconnected")
+	}
+	if len(received) != 2 || received[0].NewHeight != 3 || received[1].NewHeight != 4 {
+		t.Errorf("dropoldest: received %+v, want heights 3 and 4", received)
+	}
+	if got, want := s.BacklogStats(), (NotificationBacklogStats{Dropped: 2}); got != want {
+		t.Errorf("dropoldest: stats %+v, want %+v", got, want)
+	}
+
+	s, connected, received = notify(BacklogSummarize)
+	if !connected {
+		t.Errorf("summarize: client was disconnected")
+	}
+	// The first two notifications fill the backlog, the third is
+	// summarized with them, and the fourth is queued after the summary.
+	want := []*MainTipChangedNotification{{
+		AttachedBlocks: []*chainhash.Hash{{1}, {2}, {3}},
+		NewHeight:      3,
+	}, tip(4)}
+	if !reflect.DeepEqual(received, want) {
+		t.Errorf("summarize: received %+v, want %+v", received, want)
+	}
+	if got, want := s.BacklogStats(), (NotificationBacklogStats{Summarized: 3}); got != want {
+		t.Errorf("summarize: stats %+v, want %+v", got, want)
+	}
+}
+
+func TestMergeMainTipChangedNotifications(t *testing.T) {
+	t.Parallel()
+
+	// The older notification reorganizes blocks x and y of the original
+	// chain, attaching blocks b and c.
+	older := &MainTipChangedNotification{
+		AttachedBlocks: []*chainhash.Hash{{'b'}, {'c'}},
+		DetachedBlocks: []*chainhash.Hash{{'x'}, {'y'}},
+		NewHeight:      3,
+	}
+	tests := []struct {
+		name  string
+		newer *MainTipChangedNotification
+		want  *MainTipChangedNotification
+	}{{
+		name: "reorg of older attached block",
+		newer: &MainTipChangedNotification{
+			AttachedBlocks: []*chainhash.Hash{{'d'}, {'e'}},
+			DetachedBlocks: []*chainhash.Hash{{'c'}},
+			NewHeight:      4,
+		},
+		want: &MainTipChangedNotification{
+			AttachedBlocks: []*chainhash.Hash{{'b'}, {'d'}, {'e'}},
+			DetachedBlocks: []*chainhash.Hash{{'x'}, {'y'}},
+			NewHeight:      4,
+		},
+	}, {
+		// Block w of the original chain is lower than x.
+		name: "reorg below older fork",
+		newer: &MainTipChangedNotification{
+			AttachedBlocks: []*chainhash.Hash{{'d'}, {'e'}, {'f'}},
+			DetachedBlocks: []*chainhash.Hash{{'w'}, {'b'}, {'c'}},
+			NewHeight:      4,
+		},
+		want: &MainTipChangedNotification{
+			AttachedBlocks: []*chainhash.Hash{{'d'}, {'e'}, {'f'}},
+			DetachedBlocks: []*chainhash.Hash{{'w'}, {'x'}, {'y'}},
+			NewHeight:      4,
+		},
+	}}
+	for _, test := range tests {
+		got := mergeMainTipChangedNotifications(older, test.newer)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: merged %+v, want %+v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestParseNotificationBacklogPolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, p := range []NotificationBacklogPolicy{BacklogDisconnect,
+		BacklogDropOldest, BacklogSummarize} {
+		got, err := ParseNotificationBacklogPolicy(p.String())
+		if err != nil || got != p {
+			t.Errorf("parse %q: got %v, %v", p, got, err)
+		}
+	}
+	if _, err := ParseNotificationBacklogPolicy("block"); err == nil {
+		t.Errorf("parse of unknown policy did not error")
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"math/big"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+// MaxNullDataSize is the maximum number of bytes of data carried by a null
+// data output.
+const MaxNullDataSize = stdscript.MaxDataCarrierSizeV0
+
+// NullDataOutput returns an unspendable zero value output of a coin type with
+// an OP_RETURN script carrying data, which may be added to the outputs of a
+// send.  The output is sized by its script like any other output, so the fee
+// of the transaction pays for the data.  Errors with code errors.Invalid are
+// returned if data is empty or larger than MaxNullDataSize, or if the script
+// would be the OP_RETURN marker identifying SSFee transactions.
+func NullDataOutput(data []byte, coinType cointype.CoinType) (*wire.TxOut, error) {
+	const op errors.Op = "wallet.NullDataOutput"
+
+	switch {
+	case len(data) == 0:
+		return nil, errors.E(op, errors.Invalid, "null data output carries no data")
+	case len(data) > MaxNullDataSize:
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("null data "+
+			"size %d exceeds the maximum of %d bytes", len(data), MaxNullDataSize))
+	}
+	script, err := stdscript.ProvablyPruneableScriptV0(data)
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+	if stake.IsSSFeeMarkerScript(script) {
+		return nil, errors.E(op, errors.Invalid,
+			"null data collides with the SSFee marker format")
+	}
+	out := &wire.TxOut{
+		PkScript: script,
+		CoinType: coinType,
+	}
+	if coinType.IsSKA() {
+		out.SKAValue = new(big.Int)
+	}
+	return out, nil
+}
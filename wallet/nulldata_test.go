@@ -0,0 +1,69 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/txrules"
+)
+
+func TestNullDataOutput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		data     []byte
+		coinType cointype.CoinType
+		invalid  bool
+	}{
+		{"VAR", []byte("hello"), cointype.CoinTypeVAR, false},
+		{"SKA", []byte("hello"), 1, false},
+		{"max size", bytes.Repeat([]byte{1}, MaxNullDataSize), cointype.CoinTypeVAR, false},
+		{"single byte", []byte{1}, cointype.CoinTypeVAR, false},
+		{"SF prefix of other size", []byte("SF"), cointype.CoinTypeVAR, false},
+		{"empty", nil, cointype.CoinTypeVAR, true},
+		{"too large", bytes.Repeat([]byte{1}, MaxNullDataSize+1), cointype.CoinTypeVAR, true},
+		{"miner SSFee marker", []byte("MF\x00\x00\x00\x01"), cointype.CoinTypeVAR, true},
+		{"staker SSFee marker", []byte("SF\x00\x00\x00\x01\x00\x00"), cointype.CoinTypeVAR, true},
+	}
+	for _, test := range tests {
+		out, err := NullDataOutput(test.data, test.coinType)
+		if test.invalid {
+			if !errors.Is(err, errors.Invalid) {
+				t.Errorf("%s: expected Invalid error, got %v", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if !stdscript.IsNullDataScript(out.Version, out.PkScript) {
+			t.Errorf("%s: script %x is not null data", test.name, out.PkScript)
+		}
+		if out.CoinType != test.coinType || out.Value != 0 ||
+			test.coinType.IsSKA() != (out.SKAValue != nil) {
+			t.Errorf("%s: unexpected output %+v", test.name, out)
+		}
+		if err := txrules.CheckOutput(out, txrules.DefaultRelayFeePerKb); err != nil {
+			t.Errorf("%s: CheckOutput: %v", test.name, err)
+		}
+	}
+
+	// Outputs with SSFee markers are never sent by the wallet, even when
+	// created without NullDataOutput.
+	marker := &wire.TxOut{PkScript: stake.CreateMinerSSFeeMarker(100)}
+	err := txrules.CheckOutput(marker, txrules.DefaultRelayFeePerKb)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("SSFee marker output: expected Invalid error, got %v", err)
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/txauthor"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// unsignedTxFileVersion is the version of the unsigned transaction file
+// encoding written by CreateUnsignedTransactionFile.
+const unsignedTxFileVersion = 1
+
+// unsignedTxFile is the JSON encoding of an unsigned transaction and the
+// details of its inputs required to sign it without access to the previous
+// transactions.
+type unsignedTxFile struct {
+	Version       uint32                `json:"version"`
+	Network       string                `json:"network"`
+	Transaction   string                `json:"transaction"`
+	Inputs        []unsignedTxFileInput `json:"inputs"`
+	TotalInput    int64                 `json:"totalinput"`
+	SKATotalInput string                `json:"skatotalinput,omitempty"`
+	ChangeIndex   int                   `json:"changeindex"`
+	EstimatedSize int                   `json:"estimatedsignedsize"`
+}
+
+// unsignedTxFileInput describes the previous output spent by an input.  SKA
+// values are encoded as decimal atoms.  Path is the derivation of the key
+// paying to the previous output when it is a BIP0044 account address.
+type unsignedTxFileInput struct {
+	PrevScript string                 `json:"prevscript"`
+	CoinType   uint8                  `json:"cointype"`
+	Value      int64                  `json:"value"`
+	SKAValue   string                 `json:"skavalue,omitempty"`
+	Path       *unsignedTxFileKeyPath `json:"path,omitempty"`
+}
+
+type unsignedTxFileKeyPath struct {
+	Account uint32 `json:"account"`
+	Branch  uint32 `json:"branch"`
+	Child   uint32 `json:"child"`
+}
+
+// CreateUnsignedTransactionFile encodes an unsigned transaction authored by the
+// wallet, such as by NewUnsignedTransaction, so it may be signed by
+// SignTransactionFile of another wallet holding the private keys, which does
+// not have to be synced or connected to the network.  This allows transactions
+// to be authored by an online watching-only wallet and signed by an offline
+// wallet restored from the same seed.
+//
+// The encoding includes the previous output script, coin type, and value
+// (including SKAValueIn) of every input, and the derivation path of inputs
+// paying to BIP0044 account addresses.  Every input must spend an output of a
+// transaction known to the wallet.
+func (w *Wallet) CreateUnsignedTransactionFile(ctx context.Context, atx *txauthor.AuthoredTx) ([]byte, error) {
+	const op errors.Op = "wallet.CreateUnsignedTransactionFile"
+
+	tx := atx.Tx
+	if len(atx.PrevScripts) != len(tx.TxIn) {
+		return nil, errors.E(op, errors.Invalid, "previous script count "+
+			"does not match transaction inputs")
+	}
+
+	f := &unsignedTxFile{
+		Version:       unsignedTxFileVersion,
+		Network:       w.chainParams.Name,
+		Inputs:        make([]unsignedTxFileInput, len(tx.TxIn)),
+		TotalInput:    int64(atx.TotalInput),
+		ChangeIndex:   atx.ChangeIndex,
+		EstimatedSize: atx.EstimatedSignedSerializeSize,
+	}
+	if !atx.SKATotalInput.IsZero() {
+		f.SKATotalInput = atx.SKATotalInput.BigInt().String()
+	}
+	var buf bytes.Buffer
+	buf.Grow(tx.SerializeSize())
+	err := tx.Serialize(&buf)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	f.Transaction = hex.EncodeToString(buf.Bytes())
+
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for i, txIn := range tx.TxIn {
+			prevOut := &txIn.PreviousOutPoint
+			details, err := w.txStore.TxDetails(txmgrNs, &prevOut.Hash)
+			if err != nil {
+				return errors.E(errors.NotExist, errors.Errorf("input "+
+					"%d spends unknown output %v", i, prevOut))
+			}
+			if prevOut.Index >= uint32(len(details.MsgTx.TxOut)) {
+				return errors.E(errors.Invalid, errors.Errorf("input "+
+					"%d spends missing output %v", i, prevOut))
+			}
+			out := details.MsgTx.TxOut[prevOut.Index]
+
+			in := &f.Inputs[i]
+			in.PrevScript = hex.EncodeToString(atx.PrevScripts[i])
+			in.CoinType = uint8(out.CoinType)
+			in.Value = out.Value
+			if out.CoinType.IsSKA() && out.SKAValue != nil {
+				in.SKAValue = out.SKAValue.String()
+			}
+
+			_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed,
+				atx.PrevScripts[i], w.chainParams)
+			if len(addrs) != 1 {
+				continue
+			}
+			ma, err := w.manager.Address(addrmgrNs, addrs[0])
+			if err != nil {
+				continue
+			}
+			pka, ok := ma.(udb.ManagedPubKeyAddress)
+			if !ok || pka.Imported() || ma.Account() >= udb.ImportedAddrAccount {
+				continue
+			}
+			in.Path = &unsignedTxFileKeyPath{
+				Account: ma.Account(),
+				Child:   pka.Index(),
+			}
+			if ma.Internal() {
+				in.Path.Branch = udb.InternalBranch
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	return b, nil
+}
+
+// decodeUnsignedTxFile decodes and validates an unsigned transaction file.
+func (w *Wallet) decodeUnsignedTxFile(b []byte) (*unsignedTxFile, *wire.MsgTx, error) {
+	f := new(unsignedTxFile)
+	err := json.Unmarshal(b, f)
+	if err != nil {
+		return nil, nil, errors.E(errors.Encoding, err)
+	}
+	if f.Version != unsignedTxFileVersion {
+		return nil, nil, errors.E(errors.Encoding, errors.Errorf("unknown "+
+			"unsigned transaction file version %d", f.Version))
+	}
+	if f.Network != w.chainParams.Name {
+		return nil, nil, errors.E(errors.Invalid, errors.Errorf("transaction "+
+			"was created for network %q", f.Network))
+	}
+	rawTx, err := hex.DecodeString(f.Transaction)
+	if err != nil {
+		return nil, nil, errors.E(errors.Encoding, err)
+	}
+	tx := new(wire.MsgTx)
+	err = tx.Deserialize(bytes.NewReader(rawTx))
+	if err != nil {
+		return nil, nil, errors.E(errors.Encoding, err)
+	}
+	if len(f.Inputs) != len(tx.TxIn) {
+		return nil, nil, errors.E(errors.Invalid, "input count does not "+
+			"match transaction inputs")
+	}
+
+	// The input values recorded in the transaction must describe the
+	// previous outputs.
+	for i, in := range f.Inputs {
+		txIn := tx.TxIn[i]
+		coinType := cointype.CoinType(in.CoinType)
+		if !coinType.IsSKA() {
+			if txIn.ValueIn != in.Value {
+				return nil, nil, errors.E(errors.Invalid, errors.Errorf(
+					"input %d value %v does not match previous output "+
+						"value %v", i, dcrutil.Amount(txIn.ValueIn),
+					dcrutil.Amount(in.Value)))
+			}
+			continue
+		}
+		skaValue, ok := new(big.Int).SetString(in.SKAValue, 10)
+		if !ok {
+			return nil, nil, errors.E(errors.Encoding, errors.Errorf(
+				"input %d has invalid SKA value %q", i, in.SKAValue))
+		}
+		if txIn.SKAValueIn == nil || txIn.SKAValueIn.Cmp(skaValue) != 0 {
+			return nil, nil, errors.E(errors.Invalid, errors.Errorf(
+				"input %d SKA value does not match previous output "+
+					"value %v", i, skaValue))
+		}
+	}
+	return f, tx, nil
+}
+
+// SignTransactionFile signs every input of a transaction encoded by
+// CreateUnsignedTransactionFile which pays to keys of the wallet, returning the
+// signed transaction.  The keys of inputs paying to BIP0044 account addresses
+// are derived from their recorded paths, so the wallet does not need to have
+// discovered the addresses, nor know of the previous transactions.  The wallet
+// must be unlocked.  Inputs which could not be signed are described by the
+// returned signature errors.
+func (w *Wallet) SignTransactionFile(ctx context.Context, b []byte) (*wire.MsgTx, []SignatureError, error) {
+	const op errors.Op = "wallet.SignTransactionFile"
+
+	f, tx, err := w.decodeUnsignedTxFile(b)
+	if err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+
+	prevScripts := make(map[wire.OutPoint][]byte, len(tx.TxIn))
+	for i, in := range f.Inputs {
+		script, err := hex.DecodeString(in.PrevScript)
+		if err != nil {
+			return nil, nil, errors.E(op, errors.Encoding, err)
+		}
+		prevScripts[tx.TxIn[i].PreviousOutPoint] = script
+	}
+
+	// Derive the addresses of every input key path so the private keys
+	// can be looked up by address during signing.
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		for _, in := range f.Inputs {
+			p := in.Path
+			if p == nil {
+				continue
+			}
+			if p.Branch != udb.ExternalBranch && p.Branch != udb.InternalBranch {
+				return errors.E(errors.Invalid, errors.Errorf("invalid "+
+					"branch %d", p.Branch))
+			}
+			err := w.manager.SyncAccountToAddrIndex(ns, p.Account, p.Child, p.Branch)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+
+	signErrs, err := w.SignTransaction(ctx, tx, txscript.SigHashAll, prevScripts, nil, nil)
+	if err != nil {
+		return nil, signErrs, errors.E(op, err)
+	}
+	return tx, signErrs, nil
+}
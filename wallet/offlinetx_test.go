@@ -0,0 +1,111 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+func TestDecodeUnsignedTxFile(t *testing.T) {
+	t.Parallel()
+
+	params := chaincfg.SimNetParams()
+	w := &Wallet{chainParams: params}
+
+	skaValue, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 0}, 1e8, nil))
+	skaIn := wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil)
+	skaIn.SKAValueIn = skaValue
+	tx.AddTxIn(skaIn)
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	file := func(modify func(f *unsignedTxFile)) []byte {
+		f := &unsignedTxFile{
+			Version:     unsignedTxFileVersion,
+			Network:     params.Name,
+			Transaction: hex.EncodeToString(buf.Bytes()),
+			Inputs: []unsignedTxFileInput{{
+				PrevScript: "76a914000000000000000000000000000000000000000088ac",
+				Value:      1e8,
+				Path:       &unsignedTxFileKeyPath{Account: 1, Branch: 1, Child: 7},
+			}, {
+				PrevScript: "76a914000000000000000000000000000000000000000088ac",
+				CoinType:   1,
+				SKAValue:   skaValue.String(),
+			}},
+		}
+		if modify != nil {
+			modify(f)
+		}
+		b, err := json.Marshal(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	f, decodedTx, err := w.decodeUnsignedTxFile(file(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decodedTx.TxHash() != tx.TxHash() {
+		t.Errorf("decoded transaction %v, want %v", decodedTx.TxHash(), tx.TxHash())
+	}
+	if decodedTx.TxIn[1].SKAValueIn.Cmp(skaValue) != 0 {
+		t.Errorf("decoded SKA input value %v, want %v",
+			decodedTx.TxIn[1].SKAValueIn, skaValue)
+	}
+	if p := f.Inputs[0].Path; p == nil || *p != (unsignedTxFileKeyPath{1, 1, 7}) {
+		t.Errorf("decoded key path %+v", p)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(f *unsignedTxFile)
+		kind   errors.Kind
+	}{{
+		name:   "unknown version",
+		modify: func(f *unsignedTxFile) { f.Version++ },
+		kind:   errors.Encoding,
+	}, {
+		name:   "other network",
+		modify: func(f *unsignedTxFile) { f.Network = chaincfg.MainNetParams().Name },
+		kind:   errors.Invalid,
+	}, {
+		name:   "missing input",
+		modify: func(f *unsignedTxFile) { f.Inputs = f.Inputs[:1] },
+		kind:   errors.Invalid,
+	}, {
+		name:   "input value mismatch",
+		modify: func(f *unsignedTxFile) { f.Inputs[0].Value++ },
+		kind:   errors.Invalid,
+	}, {
+		name:   "SKA input value mismatch",
+		modify: func(f *unsignedTxFile) { f.Inputs[1].SKAValue = "1" },
+		kind:   errors.Invalid,
+	}, {
+		name:   "invalid SKA input value",
+		modify: func(f *unsignedTxFile) { f.Inputs[1].SKAValue = "" },
+		kind:   errors.Encoding,
+	}}
+	for _, test := range tests {
+		_, _, err := w.decodeUnsignedTxFile(file(test.modify))
+		if !errors.Is(err, test.kind) {
+			t.Errorf("%s: got error %v, want %v", test.name, err, test.kind)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/ownership"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// OwnershipProof creates a proof of ownership of the wallet's unspent P2PKH
+// outputs at outpoints, of any coin type, committing to the challenge.  Unmined
+// outputs may be proven.  The wallet must be unlocked to sign the proof.
+func (w *Wallet) OwnershipProof(ctx context.Context, challenge string,
+	outpoints []wire.OutPoint) (*ownership.Proof, error) {
+
+	const op errors.Op = "wallet.OwnershipProof"
+
+	var doneFuncs []func()
+	defer func() {
+		for _, f := range doneFuncs {
+			f()
+		}
+	}()
+
+	var p *ownership.Proof
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		prevOuts := make([]*wire.TxOut, len(outpoints))
+		for i := range outpoints {
+			c, err := w.txStore.UnspentOutput(txmgrNs, outpoints[i], true)
+			if errors.Is(err, errors.NotExist) {
+				return errors.E(errors.NotExist, errors.Errorf("%v is not "+
+					"an unspent wallet output", &outpoints[i]))
+			}
+			if err != nil {
+				return err
+			}
+			out := &wire.TxOut{
+				CoinType: c.CoinType,
+				Value:    int64(c.Amount),
+				Version:  scriptVersionAssumed,
+				PkScript: c.PkScript,
+			}
+			if c.CoinType.IsSKA() {
+				out.Value = 0
+				out.SKAValue = c.SKAAmount.BigInt()
+			}
+			prevOuts[i] = out
+		}
+
+		var err error
+		p, err = ownership.New(challenge, outpoints, prevOuts)
+		if err != nil {
+			return err
+		}
+		for i, out := range prevOuts {
+			_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, w.chainParams)
+			key, done, err := w.manager.PrivateKey(addrmgrNs, addrs[0])
+			if err != nil {
+				return err
+			}
+			doneFuncs = append(doneFuncs, done)
+			if err := p.Sign(i, key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if err := p.Verify(); err != nil {
+		return nil, errors.E(op, err)
+	}
+	return p, nil
+}
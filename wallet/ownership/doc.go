@@ -0,0 +1,31 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package ownership implements proofs of ownership of a set of unspent outputs,
+modeled after the proof of funds of BIP0322.
+
+A proof is a transaction which spends every proven output and can never be
+mined.  Its first input spends the nonexistent output at index 0xffffffff of
+the challenge hash, the BLAKE-256 hash of the challenge prefixed by
+"Monetarium Ownership Proof:\n", and has an empty signature script.  Each
+following input spends a proven output and is signed with SigHashAll, so the
+signatures commit to the challenge and to every other proven output, and may
+not be reused in any other transaction.  The transaction has no outputs, a
+zero lock time, and no expiry.
+
+Only P2PKH outputs of secp256k1 ECDSA keys, of any coin type, may be proven.
+Verify executes the script of each previous output, recorded with its coin
+type and value in the proof.  Signature hashes do not commit to the previous
+outputs, so a verifier must also confirm with the UTXO set that each proven
+output exists with the recorded script and value, and is unspent.
+
+The serialization begins with the magic bytes "ownp" 0xff, followed by the
+challenge and the serialized transaction, each encoded as variable length
+bytes, and the number of previous outputs as a variable length integer.  Each
+previous output is encoded as its coin type (1 byte), VAR value (8 bytes), SKA
+value as big-endian variable length bytes, script version (2 bytes), and script
+as variable length bytes.
+*/
+package ownership
@@ -0,0 +1,320 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ownership
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math/big"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/sign"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+// MaxChallengeLen is the maximum length of a challenge in bytes.
+const MaxChallengeLen = 4096
+
+// maxSKAValueLen is the maximum length of a serialized SKA value.
+const maxSKAValueLen = 64
+
+// verifyFlags are the script flags used to execute the proven output scripts.
+const verifyFlags = txscript.ScriptDiscourageUpgradableNops |
+	txscript.ScriptVerifyCleanStack
+
+var magic = [5]byte{'o', 'w', 'n', 'p', 0xff}
+
+// Proof is a proof of ownership of the previous outputs of the inputs of Tx
+// following the challenge commitment input.  PrevOuts[i] is the output spent
+// by Tx.TxIn[i+1].
+type Proof struct {
+	Challenge string
+	Tx        *wire.MsgTx
+	PrevOuts  []*wire.TxOut
+}
+
+// ChallengeHash returns the hash committed to by a proof of the challenge.
+func ChallengeHash(challenge string) chainhash.Hash {
+	b := make([]byte, 0, 28+len(challenge))
+	b = append(b, "Monetarium Ownership Proof:\n"...)
+	b = append(b, challenge...)
+	return chainhash.HashH(b)
+}
+
+// commitment returns the previous outpoint of the first input of a proof of
+// the challenge.
+func commitment(challenge string) wire.OutPoint {
+	return wire.OutPoint{
+		Hash:  ChallengeHash(challenge),
+		Index: wire.MaxPrevOutIndex,
+		Tree:  wire.TxTreeRegular,
+	}
+}
+
+// provable returns whether ownership of an output may be proven.
+func provable(out *wire.TxOut) bool {
+	return stdscript.DetermineScriptType(out.Version, out.PkScript) ==
+		stdscript.STPubKeyHashEcdsaSecp256k1
+}
+
+// value returns the value of an output in atoms of its coin type.
+func value(out *wire.TxOut) *big.Int {
+	if out.CoinType.IsSKA() && out.SKAValue != nil {
+		return new(big.Int).Set(out.SKAValue)
+	}
+	return big.NewInt(out.Value)
+}
+
+// New returns an unsigned proof of ownership of the outputs prevOuts at
+// outpoints, committing to the challenge.  Each input is signed with Sign.
+func New(challenge string, outpoints []wire.OutPoint, prevOuts []*wire.TxOut) (*Proof, error) {
+	const op errors.Op = "ownership.New"
+
+	switch {
+	case len(challenge) > MaxChallengeLen:
+		return nil, errors.E(op, errors.Invalid, "challenge is too long")
+	case len(outpoints) == 0:
+		return nil, errors.E(op, errors.Invalid, "no outputs to prove")
+	case len(outpoints) != len(prevOuts):
+		return nil, errors.E(op, errors.Invalid, "outpoint and previous output counts differ")
+	}
+
+	tx := wire.NewMsgTx()
+	c := commitment(challenge)
+	tx.AddTxIn(wire.NewTxIn(&c, 0, nil))
+	seen := make(map[wire.OutPoint]struct{}, len(outpoints))
+	for i := range outpoints {
+		outpoint, prevOut := &outpoints[i], prevOuts[i]
+		if _, ok := seen[*outpoint]; ok {
+			return nil, errors.E(op, errors.Invalid,
+				errors.Errorf("duplicate output %v", outpoint))
+		}
+		seen[*outpoint] = struct{}{}
+		if !provable(prevOut) {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("output %v "+
+				"is not a P2PKH output", outpoint))
+		}
+		in := wire.NewTxIn(outpoint, prevOut.Value, nil)
+		if prevOut.CoinType.IsSKA() {
+			in.ValueIn = 0
+			in.SKAValueIn = value(prevOut)
+		}
+		tx.AddTxIn(in)
+	}
+	return &Proof{
+		Challenge: challenge,
+		Tx:        tx,
+		PrevOuts:  prevOuts,
+	}, nil
+}
+
+// Sign signs the input spending PrevOuts[i] with the key of its P2PKH
+// script.
+func (p *Proof) Sign(i int, key *secp256k1.PrivateKey) error {
+	const op errors.Op = "ownership.Sign"
+
+	if i < 0 || i >= len(p.PrevOuts) {
+		return errors.E(op, errors.Invalid, "previous output index out of range")
+	}
+	script, err := sign.SignatureScript(p.Tx, i+1, p.PrevOuts[i].PkScript,
+		txscript.SigHashAll, key.Serialize(), dcrec.STEcdsaSecp256k1, true)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	p.Tx.TxIn[i+1].SignatureScript = script
+	return nil
+}
+
+// checkSigScript checks that a signature script only pushes a signature with
+// the SigHashAll hash type and a public key.
+func checkSigScript(script []byte) error {
+	var pushes [][]byte
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+	for tokenizer.Next() {
+		if tokenizer.Opcode() > txscript.OP_16 {
+			return errors.New("signature script is not push only")
+		}
+		pushes = append(pushes, tokenizer.Data())
+	}
+	if err := tokenizer.Err(); err != nil {
+		return err
+	}
+	if len(pushes) != 2 || len(pushes[0]) == 0 ||
+		txscript.SigHashType(pushes[0][len(pushes[0])-1]) != txscript.SigHashAll {
+		return errors.New("input is not signed with SigHashAll")
+	}
+	return nil
+}
+
+// Verify verifies that the proof commits to its challenge and that every
+// input is signed by the key of the script of its previous output.  Errors
+// of kind Invalid describe malformed proofs, and errors of kind
+// ScriptFailure invalid signatures.
+//
+// Verify does not check that the previous outputs exist and are unspent,
+// which must be confirmed with the UTXO set by the verifier.
+func (p *Proof) Verify() error {
+	const op errors.Op = "ownership.Verify"
+
+	tx := p.Tx
+	switch {
+	case len(p.PrevOuts) == 0 || len(tx.TxIn) != len(p.PrevOuts)+1:
+		return errors.E(op, errors.Invalid, "proof does not spend its previous outputs")
+	case len(tx.TxOut) != 0 || tx.LockTime != 0 || tx.Expiry != 0:
+		return errors.E(op, errors.Invalid, "proof transaction has outputs, "+
+			"a lock time, or an expiry")
+	case tx.TxIn[0].PreviousOutPoint != commitment(p.Challenge) ||
+		len(tx.TxIn[0].SignatureScript) != 0:
+		return errors.E(op, errors.Invalid, "proof does not commit to the challenge")
+	}
+	seen := make(map[wire.OutPoint]struct{}, len(p.PrevOuts))
+	for i, prevOut := range p.PrevOuts {
+		in := tx.TxIn[i+1]
+		if _, ok := seen[in.PreviousOutPoint]; ok {
+			return errors.E(op, errors.Invalid,
+				errors.Errorf("duplicate output %v", &in.PreviousOutPoint))
+		}
+		seen[in.PreviousOutPoint] = struct{}{}
+		if !provable(prevOut) {
+			return errors.E(op, errors.Invalid, errors.Errorf("output %v "+
+				"is not a P2PKH output", &in.PreviousOutPoint))
+		}
+		if err := checkSigScript(in.SignatureScript); err != nil {
+			return errors.E(op, errors.Invalid, errors.Errorf("output %v: %v",
+				&in.PreviousOutPoint, err))
+		}
+		vm, err := txscript.NewEngine(prevOut.PkScript, tx, i+1, verifyFlags,
+			prevOut.Version, nil)
+		if err == nil {
+			err = vm.Execute()
+		}
+		if err != nil {
+			return errors.E(op, errors.ScriptFailure, errors.Errorf("output %v: %v",
+				&in.PreviousOutPoint, err))
+		}
+	}
+	return nil
+}
+
+// Totals returns the total value of the proven outputs of each coin type.
+func (p *Proof) Totals() map[cointype.CoinType]*big.Int {
+	totals := make(map[cointype.CoinType]*big.Int)
+	for _, prevOut := range p.PrevOuts {
+		total, ok := totals[prevOut.CoinType]
+		if !ok {
+			total = new(big.Int)
+			totals[prevOut.CoinType] = total
+		}
+		total.Add(total, value(prevOut))
+	}
+	return totals
+}
+
+// Bytes returns the serialized proof.
+func (p *Proof) Bytes() ([]byte, error) {
+	const op errors.Op = "ownership.Bytes"
+
+	tx, err := p.Tx.Bytes()
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	var buf bytes.Buffer
+	buf.Write(magic[:])
+	// Writes to a bytes.Buffer do not error.
+	_ = wire.WriteVarString(&buf, 0, p.Challenge)
+	_ = wire.WriteVarBytes(&buf, 0, tx)
+	_ = wire.WriteVarInt(&buf, 0, uint64(len(p.PrevOuts)))
+	for _, out := range p.PrevOuts {
+		var ska []byte
+		if out.SKAValue != nil {
+			ska = out.SKAValue.Bytes()
+		}
+		buf.WriteByte(byte(out.CoinType))
+		_ = binary.Write(&buf, binary.LittleEndian, out.Value)
+		_ = wire.WriteVarBytes(&buf, 0, ska)
+		_ = binary.Write(&buf, binary.LittleEndian, out.Version)
+		_ = wire.WriteVarBytes(&buf, 0, out.PkScript)
+	}
+	return buf.Bytes(), nil
+}
+
+// Parse parses a serialized proof.  The proof is not verified.
+func Parse(b []byte) (*Proof, error) {
+	const op errors.Op = "ownership.Parse"
+
+	p, err := parse(bytes.NewReader(b))
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	return p, nil
+}
+
+func parse(r *bytes.Reader) (*Proof, error) {
+	var m [5]byte
+	if _, err := io.ReadFull(r, m[:]); err != nil || m != magic {
+		return nil, errors.New("missing ownership proof magic")
+	}
+	challenge, err := wire.ReadVarBytes(r, 0, MaxChallengeLen, "challenge")
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := wire.ReadVarBytes(r, 0, wire.MaxBlockPayload, "transaction")
+	if err != nil {
+		return nil, err
+	}
+	tx := new(wire.MsgTx)
+	if err := tx.FromBytes(txBytes); err != nil {
+		return nil, err
+	}
+	n, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	if n != uint64(len(tx.TxIn)-1) {
+		return nil, errors.New("previous output count does not match the transaction")
+	}
+	prevOuts := make([]*wire.TxOut, n)
+	for i := range prevOuts {
+		out := new(wire.TxOut)
+		coinType, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		out.CoinType = cointype.CoinType(coinType)
+		if err := binary.Read(r, binary.LittleEndian, &out.Value); err != nil {
+			return nil, err
+		}
+		ska, err := wire.ReadVarBytes(r, 0, maxSKAValueLen, "SKA value")
+		if err != nil {
+			return nil, err
+		}
+		if len(ska) != 0 {
+			out.SKAValue = new(big.Int).SetBytes(ska)
+		}
+		if err := binary.Read(r, binary.LittleEndian, &out.Version); err != nil {
+			return nil, err
+		}
+		out.PkScript, err = wire.ReadVarBytes(r, 0, txscript.MaxScriptSize, "script")
+		if err != nil {
+			return nil, err
+		}
+		prevOuts[i] = out
+	}
+	if r.Len() != 0 {
+		return nil, errors.New("trailing bytes after ownership proof")
+	}
+	return &Proof{
+		Challenge: string(challenge),
+		Tx:        tx,
+		PrevOuts:  prevOuts,
+	}, nil
+}
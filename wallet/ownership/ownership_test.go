@@ -0,0 +1,122 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ownership
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+func TestProof(t *testing.T) {
+	t.Parallel()
+
+	params := chaincfg.SimNetParams()
+	keys := []*secp256k1.PrivateKey{
+		secp256k1.PrivKeyFromBytes([]byte{1}),
+		secp256k1.PrivKeyFromBytes([]byte{2}),
+	}
+	p2pkh := func(key *secp256k1.PrivateKey) (uint16, []byte) {
+		pkh := stdaddr.Hash160(key.PubKey().SerializeCompressed())
+		addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(pkh, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addr.PaymentScript()
+	}
+	vers0, script0 := p2pkh(keys[0])
+	vers1, script1 := p2pkh(keys[1])
+	skaValue, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	outpoints := []wire.OutPoint{{Hash: chainhash.Hash{1}}, {Hash: chainhash.Hash{2}, Index: 3}}
+	prevOuts := []*wire.TxOut{
+		{Value: 5e8, Version: vers0, PkScript: script0},
+		{CoinType: 1, SKAValue: skaValue, Version: vers1, PkScript: script1},
+	}
+	const challenge = "audit 2025-06-30"
+
+	p2sh, err := stdaddr.NewAddressScriptHashV0([]byte{1}, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2shVers, p2shScript := p2sh.PaymentScript()
+	_, err = New(challenge, outpoints[:1], []*wire.TxOut{{Version: p2shVers, PkScript: p2shScript}})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("P2SH output: expected Invalid error, got %v", err)
+	}
+	_, err = New(challenge, []wire.OutPoint{outpoints[0], outpoints[0]}, prevOuts)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("duplicate output: expected Invalid error, got %v", err)
+	}
+
+	p, err := New(challenge, outpoints, prevOuts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Verify(); !errors.Is(err, errors.Invalid) {
+		t.Errorf("unsigned proof: expected Invalid error, got %v", err)
+	}
+	for i, key := range keys {
+		if err := p.Sign(i, key); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := p.Verify(); err != nil {
+		t.Fatalf("valid proof: %v", err)
+	}
+	totals := p.Totals()
+	if len(totals) != 2 || totals[cointype.CoinTypeVAR].Int64() != 5e8 ||
+		totals[1].Cmp(skaValue) != 0 {
+		t.Errorf("unexpected totals %v", totals)
+	}
+
+	b, err := p.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := parsed.Verify(); err != nil {
+		t.Fatalf("parsed proof: %v", err)
+	}
+	if b2, _ := parsed.Bytes(); !bytes.Equal(b, b2) {
+		t.Errorf("reserialized proof differs")
+	}
+	if _, err := Parse(b[:len(b)-1]); !errors.Is(err, errors.Encoding) {
+		t.Errorf("truncated proof: expected Encoding error, got %v", err)
+	}
+
+	// A proof does not verify for another challenge, or when its signatures
+	// are swapped between inputs.
+	parsed.Challenge = "another challenge"
+	if err := parsed.Verify(); !errors.Is(err, errors.Invalid) {
+		t.Errorf("other challenge: expected Invalid error, got %v", err)
+	}
+	parsed, _ = Parse(b)
+	in := parsed.Tx.TxIn
+	in[1].SignatureScript, in[2].SignatureScript = in[2].SignatureScript, in[1].SignatureScript
+	if err := parsed.Verify(); !errors.Is(err, errors.ScriptFailure) {
+		t.Errorf("swapped signatures: expected ScriptFailure error, got %v", err)
+	}
+
+	// Signatures must commit to the whole proof.
+	parsed, _ = Parse(b)
+	sig := parsed.Tx.TxIn[1].SignatureScript
+	pushLen := int(sig[0])
+	sig[pushLen] = byte(txscript.SigHashAll | txscript.SigHashAnyOneCanPay)
+	if err := parsed.Verify(); !errors.Is(err, errors.Invalid) {
+		t.Errorf("SigHashAnyOneCanPay signature: expected Invalid error, got %v", err)
+	}
+}
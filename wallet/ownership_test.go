@@ -0,0 +1,64 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+func TestOwnershipProof(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	var outpoints []wire.OutPoint
+	for i := 0; i < 2; i++ {
+		addr, err := w.NewExternalAddress(ctx, defaultAccount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{byte(i + 1)}}, 3e8, nil))
+		tx.AddTxOut(payTo(addr, cointype.CoinTypeVAR, big.NewInt(int64(i+1)*1e8)))
+		if err := w.AddTransaction(ctx, tx, nil); err != nil {
+			t.Fatal(err)
+		}
+		outpoints = append(outpoints, wire.OutPoint{Hash: tx.TxHash()})
+	}
+
+	const challenge = "proof of reserves"
+	if _, err := w.OwnershipProof(ctx, challenge, outpoints); !errors.Is(err, errors.Locked) {
+		t.Errorf("locked wallet: expected Locked error, got %v", err)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	unknown := append(outpoints[:1:1], wire.OutPoint{Hash: chainhash.Hash{9}})
+	if _, err := w.OwnershipProof(ctx, challenge, unknown); !errors.Is(err, errors.NotExist) {
+		t.Errorf("unknown output: expected NotExist error, got %v", err)
+	}
+
+	p, err := w.OwnershipProof(ctx, challenge, outpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Verify(); err != nil {
+		t.Fatalf("proof does not verify: %v", err)
+	}
+	if p.Challenge != challenge || len(p.PrevOuts) != 2 ||
+		p.Totals()[cointype.CoinTypeVAR].Int64() != 3e8 {
+		t.Errorf("unexpected proof %+v", p)
+	}
+}
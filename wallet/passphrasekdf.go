@@ -0,0 +1,64 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// SetArgon2idCost sets the time and memory (in KiB) cost of the Argon2id KDF
+// deriving the master private key from the private passphrase.  Zero values
+// keep the default cost for the network.  Master private keys derived with
+// scrypt, or with a lower Argon2id cost, are upgraded the next time the wallet
+// is unlocked.
+func (w *Wallet) SetArgon2idCost(time, memory uint32) {
+	opts := w.manager.Argon2idOptions()
+	if time != 0 {
+		opts.Time = time
+	}
+	if memory != 0 {
+		opts.Memory = memory
+	}
+	w.manager.SetArgon2idOptions(opts)
+}
+
+// KDFInfo describes the key derivation function deriving the master private
+// key from the private passphrase.
+func (w *Wallet) KDFInfo() (*udb.KDFInfo, error) {
+	const op errors.Op = "wallet.KDFInfo"
+
+	info, err := w.manager.KDFInfo()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return info, nil
+}
+
+// upgradePassphraseKDF reencrypts the master private key with a key derived
+// from the passphrase using the configured Argon2id cost when it was derived
+// with a weaker KDF.  The wallet must be unlocked with the passphrase.
+// Failing to upgrade the KDF does not prevent the wallet from unlocking, and
+// is retried at the next unlock.  Read-only wallets are never upgraded.
+func (w *Wallet) upgradePassphraseKDF(ctx context.Context, passphrase []byte) {
+	info, err := w.manager.KDFInfo()
+	if err != nil || !info.UpgradePending || w.readOnly {
+		return
+	}
+
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.manager.UpgradePassphraseKDF(ns, passphrase)
+	})
+	if err != nil {
+		logCtx(ctx).Warnf("Failed to upgrade the private passphrase KDF: %v", err)
+		return
+	}
+	logCtx(ctx).Infof("Upgraded the private passphrase KDF from %s to Argon2id",
+		info.Algorithm)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// PriceSource provides historical fiat prices of coin types.  Wallets do not
+// query any price source unless one is set with SetPriceSource.
+type PriceSource interface {
+	// Price returns the price of one whole coin of coinType in the fiat
+	// currency at time t.
+	Price(ctx context.Context, coinType cointype.CoinType, currency string,
+		t time.Time) (*big.Rat, error)
+}
+
+// SetPriceSource sets a source which is queried for the price, in currency,
+// of the coin type of every wallet transaction mined in a connected block, at
+// the time of the block.  The prices are recorded by the wallet and returned
+// by PriceAt.  Setting a nil source stops querying prices.
+func (w *Wallet) SetPriceSource(src PriceSource, currency string) {
+	w.priceSourceMu.Lock()
+	w.priceSource = src
+	w.priceCurrency = currency
+	w.priceSourceMu.Unlock()
+}
+
+// RecordPrice records the price of a coin type at a point in time, such as a
+// price provided by the user rather than a price source.  A price recorded
+// for the coin type at the same time, truncated to seconds, is replaced.
+func (w *Wallet) RecordPrice(ctx context.Context, s *udb.PriceSnapshot) error {
+	const op errors.Op = "wallet.RecordPrice"
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutPriceSnapshot(dbtx, s)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// PriceAt returns the latest price of a coin type recorded at or before t.
+// Errors with code errors.NotExist are returned if no earlier price is
+// recorded.
+func (w *Wallet) PriceAt(ctx context.Context, ct cointype.CoinType, t time.Time) (*udb.PriceSnapshot, error) {
+	const op errors.Op = "wallet.PriceAt"
+
+	var s *udb.PriceSnapshot
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		s, err = udb.PriceSnapshotAt(dbtx, ct, t)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return s, nil
+}
+
+// recordBlockPrices queries the price source, if any, for the price of the
+// coin type of each relevant transaction at the time of its block, and
+// records the prices in the background.  Failures to query prices are only
+// logged, since prices are informational and must not stall syncing.
+func (w *Wallet) recordBlockPrices(ctx context.Context, chain []*BlockNode,
+	relevantTxs map[chainhash.Hash][]*wire.MsgTx) {
+
+	w.priceSourceMu.Lock()
+	src, currency := w.priceSource, w.priceCurrency
+	w.priceSourceMu.Unlock()
+	if src == nil {
+		return
+	}
+
+	type snapshot struct {
+		coinType cointype.CoinType
+		time     time.Time
+	}
+	var snapshots []snapshot
+	seen := make(map[snapshot]struct{})
+	for _, n := range chain {
+		for _, tx := range relevantTxs[*n.Hash] {
+			s := snapshot{txCoinType(tx), n.Header.Timestamp}
+			if _, ok := seen[s]; ok {
+				continue
+			}
+			seen[s] = struct{}{}
+			snapshots = append(snapshots, s)
+		}
+	}
+	if len(snapshots) == 0 {
+		return
+	}
+
+	// The prices of all blocks of the chain are recorded with a single
+	// database update.
+	go func() {
+		prices := make([]*udb.PriceSnapshot, 0, len(snapshots))
+		for _, s := range snapshots {
+			price, err := src.Price(ctx, s.coinType, currency, s.time)
+			if err != nil {
+				logCtx(ctx).Warnf("Failed to query %v price at %v: %v",
+					s.coinType, s.time, err)
+				continue
+			}
+			prices = append(prices, &udb.PriceSnapshot{
+				CoinType: s.coinType,
+				Time:     s.time,
+				Currency: currency,
+				Price:    price,
+			})
+		}
+		if len(prices) == 0 {
+			return
+		}
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			for _, p := range prices {
+				err := udb.PutPriceSnapshot(dbtx, p)
+				if errors.Is(err, errors.Invalid) {
+					logCtx(ctx).Errorf("Failed to record %v price at "+
+						"%v: %v", p.CoinType, p.Time, err)
+					continue
+				}
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			logCtx(ctx).Errorf("Failed to record prices: %v", err)
+		}
+	}()
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/psdt"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/hdkeychain"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+)
+
+// psdtSigner describes a wallet key which can sign an input of a PSDT.
+type psdtSigner struct {
+	input     int
+	addr      stdaddr.Address
+	pubKey    []byte
+	sigScript []byte // previous output script, or P2SH redeem script
+}
+
+// bip32Path returns the BIP0032 derivation path of a wallet public key address,
+// or nil if the key was imported.  The path of BIP0044 account addresses begins
+// at the master key when the coin type is known, or at the account key
+// otherwise.
+func bip32Path(a udb.ManagedPubKeyAddress, coinType *uint32) []uint32 {
+	if a.Imported() || a.Account() >= udb.ImportedAddrAccount {
+		return nil
+	}
+	branch := udb.ExternalBranch
+	if a.Internal() {
+		branch = udb.InternalBranch
+	}
+	var path []uint32
+	if coinType != nil {
+		path = []uint32{
+			44 + hdkeychain.HardenedKeyStart,
+			*coinType + hdkeychain.HardenedKeyStart,
+			a.Account() + hdkeychain.HardenedKeyStart,
+		}
+	}
+	return append(path, branch, a.Index())
+}
+
+// psdtDerivation returns the BIP0032 derivation of a wallet public key
+// address.
+func psdtDerivation(a udb.ManagedPubKeyAddress, coinType *uint32) *psdt.Bip32Derivation {
+	return &psdt.Bip32Derivation{
+		PubKey: a.PubKey(),
+		Path:   bip32Path(a, coinType),
+	}
+}
+
+// ProcessPSDT adds the data the wallet knows about the inputs and outputs of a
+// PSDT: the previous output scripts, coin types, and SKA values of inputs
+// spending wallet transactions, the redeem scripts of wallet P2SH outputs, and
+// the BIP0032 derivations of wallet keys.  When sign is true, signatures are
+// added for every P2PKH and P2SH multisig input which can be signed by a wallet
+// key, which requires the wallet to be unlocked.
+//
+// Inputs which are already finalized are not modified.
+func (w *Wallet) ProcessPSDT(ctx context.Context, p *psdt.Packet, sign bool) error {
+	const op errors.Op = "wallet.ProcessPSDT"
+
+	tx := p.UnsignedTx
+	if len(p.Inputs) != len(tx.TxIn) || len(p.Outputs) != len(tx.TxOut) {
+		return errors.E(op, errors.Invalid, "PSDT inputs and outputs do not "+
+			"match the transaction")
+	}
+
+	var signers []psdtSigner
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		var coinType *uint32
+		if ct, err := w.manager.CoinType(dbtx); err == nil {
+			coinType = &ct
+		}
+		pubKeyAddr := func(a stdaddr.Address) (udb.ManagedPubKeyAddress, bool) {
+			ma, err := w.manager.Address(addrmgrNs, a)
+			if err != nil {
+				return nil, false
+			}
+			pka, ok := ma.(udb.ManagedPubKeyAddress)
+			return pka, ok
+		}
+
+		for i := range p.Inputs {
+			in := &p.Inputs[i]
+			if in.Finalized() {
+				continue
+			}
+
+			if in.PrevScript == nil {
+				prevOut := &tx.TxIn[i].PreviousOutPoint
+				details, err := w.txStore.TxDetails(txmgrNs, &prevOut.Hash)
+				if errors.Is(err, errors.NotExist) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				if prevOut.Index >= uint32(len(details.MsgTx.TxOut)) {
+					return errors.E(errors.Invalid, errors.Errorf("input "+
+						"%d spends missing output %v", i, prevOut))
+				}
+				out := details.MsgTx.TxOut[prevOut.Index]
+				in.PrevScriptVersion = out.Version
+				in.PrevScript = out.PkScript
+				in.CoinType = out.CoinType
+				if out.CoinType.IsSKA() && out.SKAValue != nil {
+					in.SKAValueIn = new(big.Int).Set(out.SKAValue)
+				}
+			}
+
+			scriptType, addrs := stdscript.ExtractAddrs(in.PrevScriptVersion,
+				in.PrevScript, w.chainParams)
+			if len(addrs) != 1 {
+				continue
+			}
+			switch addrs[0].(type) {
+			case *stdaddr.AddressPubKeyHashEcdsaSecp256k1V0:
+				pka, ok := pubKeyAddr(addrs[0])
+				if !ok {
+					continue
+				}
+				in.AddBip32Derivation(psdtDerivation(pka, coinType))
+				signers = append(signers, psdtSigner{
+					input:     i,
+					addr:      addrs[0],
+					pubKey:    pka.PubKey(),
+					sigScript: in.PrevScript,
+				})
+				continue
+			case *stdaddr.AddressScriptHashV0:
+				// Signed by the keys of a multisig redeem script.
+			default:
+				continue
+			}
+
+			if in.RedeemScript == nil {
+				script, err := w.manager.RedeemScript(addrmgrNs, addrs[0])
+				if errors.Is(err, errors.NotExist) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				in.RedeemScript = script
+			}
+			details := stdscript.ExtractMultiSigScriptDetailsV0(in.RedeemScript, true)
+			if !details.Valid {
+				logCtx(ctx).Debugf("PSDT input %d of type %v does not redeem a "+
+					"multisig script", i, scriptType)
+				continue
+			}
+			for _, pk := range details.PubKeys {
+				a, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+					dcrutil.Hash160(pk), w.chainParams)
+				if err != nil {
+					return err
+				}
+				pka, ok := pubKeyAddr(a)
+				if !ok {
+					continue
+				}
+				in.AddBip32Derivation(psdtDerivation(pka, coinType))
+				signers = append(signers, psdtSigner{
+					input:     i,
+					addr:      a,
+					pubKey:    pka.PubKey(),
+					sigScript: in.RedeemScript,
+				})
+			}
+		}
+
+		for i := range p.Outputs {
+			out := &p.Outputs[i]
+			txOut := tx.TxOut[i]
+			_, addrs := stdscript.ExtractAddrs(txOut.Version, txOut.PkScript,
+				w.chainParams)
+			if len(addrs) != 1 {
+				continue
+			}
+			if _, ok := addrs[0].(*stdaddr.AddressScriptHashV0); ok {
+				if out.RedeemScript != nil {
+					continue
+				}
+				script, err := w.manager.RedeemScript(addrmgrNs, addrs[0])
+				if err == nil {
+					out.RedeemScript = script
+				}
+				continue
+			}
+			if pka, ok := pubKeyAddr(addrs[0]); ok {
+				out.AddBip32Derivation(psdtDerivation(pka, coinType))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	if !sign {
+		return nil
+	}
+	for _, s := range signers {
+		in := &p.Inputs[s.input]
+		sig, _, err := w.CreateSignature(ctx, tx, uint32(s.input), s.addr,
+			in.SigHash(), s.sigScript)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		in.AddPartialSig(s.pubKey, sig)
+	}
+	return nil
+}
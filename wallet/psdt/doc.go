@@ -0,0 +1,24 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package psdt implements partially signed Decred-style transactions (PSDTs), a
+container for an unsigned transaction and the data required by each party to
+sign its inputs, modeled after the partially signed Bitcoin transactions of
+BIP0174.
+
+A PSDT carries, for every input, the previous output script, coin type and
+SKA value, any P2SH redeem script, BIP0032 derivations of the keys able to sign
+it, and the partial signatures created so far.  This allows transactions to
+be passed between wallets, hardware signers, and other tools, each adding the
+signatures they are able to create, before the signatures are combined,
+finalized into signature scripts, and the signed transaction is extracted.
+
+The serialization is a sequence of key-value maps, prefixed by the magic bytes
+"psdt" 0xff: one global map, followed by one map for each transaction input and
+output.  Each key and value is encoded as variable length bytes, and each map
+is terminated by an empty key.  The first byte of each key is its type, and
+keys of unknown types are preserved when a PSDT is parsed and serialized.
+*/
+package psdt
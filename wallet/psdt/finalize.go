@@ -0,0 +1,251 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package psdt
+
+import (
+	"bytes"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// AddPartialSig records a signature for the input created by the key of the
+// public key, replacing any previous signature by the same key.
+func (in *Input) AddPartialSig(pubKey, sig []byte) {
+	for _, s := range in.PartialSigs {
+		if bytes.Equal(s.PubKey, pubKey) {
+			s.Signature = sig
+			return
+		}
+	}
+	in.PartialSigs = append(in.PartialSigs, &PartialSig{
+		PubKey:    pubKey,
+		Signature: sig,
+	})
+}
+
+// partialSig returns the signature created by the key of the public key, or
+// nil if there is none.
+func (in *Input) partialSig(pubKey []byte) []byte {
+	for _, s := range in.PartialSigs {
+		if bytes.Equal(s.PubKey, pubKey) {
+			return s.Signature
+		}
+	}
+	return nil
+}
+
+// AddBip32Derivation records the derivation of a public key which can sign
+// the input, unless the derivation of the key is already known.
+func (in *Input) AddBip32Derivation(d *Bip32Derivation) {
+	in.Bip32Derivations = addDerivation(in.Bip32Derivations, d)
+}
+
+// AddBip32Derivation records the derivation of a public key paid by the output,
+// unless the derivation of the key is already known.
+func (out *Output) AddBip32Derivation(d *Bip32Derivation) {
+	out.Bip32Derivations = addDerivation(out.Bip32Derivations, d)
+}
+
+func addDerivation(ds []*Bip32Derivation, d *Bip32Derivation) []*Bip32Derivation {
+	for _, e := range ds {
+		if bytes.Equal(e.PubKey, d.PubKey) {
+			return ds
+		}
+	}
+	return append(ds, d)
+}
+
+func addUnknowns(us []*Unknown, add []*Unknown) []*Unknown {
+next:
+	for _, u := range add {
+		for _, e := range us {
+			if bytes.Equal(e.Key, u.Key) {
+				continue next
+			}
+		}
+		us = append(us, u)
+	}
+	return us
+}
+
+// Combine merges the data of PSDTs for the same unsigned transaction, such as
+// the signatures of different signers, into a new PSDT.  Data of the first
+// PSDT is preferred when PSDTs describe the same value differently.
+func Combine(packets ...*Packet) (*Packet, error) {
+	const op errors.Op = "psdt.Combine"
+	if len(packets) == 0 {
+		return nil, errors.E(op, errors.Invalid, "no PSDTs to combine")
+	}
+	txHash := packets[0].UnsignedTx.TxHash()
+	for _, p := range packets[1:] {
+		if p.UnsignedTx.TxHash() != txHash {
+			return nil, errors.E(op, errors.Invalid, "PSDTs describe "+
+				"different transactions")
+		}
+	}
+
+	tx := packets[0].UnsignedTx.Copy()
+	c, err := New(tx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	for _, p := range packets {
+		c.Unknowns = addUnknowns(c.Unknowns, p.Unknowns)
+		for i := range p.Inputs {
+			from, to := &p.Inputs[i], &c.Inputs[i]
+			if to.PrevScript == nil && from.PrevScript != nil {
+				to.PrevScriptVersion = from.PrevScriptVersion
+				to.PrevScript = from.PrevScript
+				to.CoinType = from.CoinType
+				to.SKAValueIn = from.SKAValueIn
+			}
+			if to.RedeemScript == nil {
+				to.RedeemScript = from.RedeemScript
+			}
+			if to.SigHashType == 0 {
+				to.SigHashType = from.SigHashType
+			}
+			if to.FinalScriptSig == nil {
+				to.FinalScriptSig = from.FinalScriptSig
+			}
+			for _, s := range from.PartialSigs {
+				if to.partialSig(s.PubKey) == nil {
+					to.AddPartialSig(s.PubKey, s.Signature)
+				}
+			}
+			for _, d := range from.Bip32Derivations {
+				to.AddBip32Derivation(d)
+			}
+			to.Unknowns = addUnknowns(to.Unknowns, from.Unknowns)
+		}
+		for i := range p.Outputs {
+			from, to := &p.Outputs[i], &c.Outputs[i]
+			if to.RedeemScript == nil {
+				to.RedeemScript = from.RedeemScript
+			}
+			for _, d := range from.Bip32Derivations {
+				to.AddBip32Derivation(d)
+			}
+			to.Unknowns = addUnknowns(to.Unknowns, from.Unknowns)
+		}
+	}
+	return c, nil
+}
+
+// finalScriptSig creates the signature script of an input from its partial
+// signatures.  Pay-to-pubkey-hash outputs and P2SH multisig outputs are
+// supported.  It returns a nil script if the input lacks signatures.
+func (in *Input) finalScriptSig() ([]byte, error) {
+	if in.PrevScript == nil {
+		return nil, nil
+	}
+
+	scriptType := stdscript.DetermineScriptType(in.PrevScriptVersion, in.PrevScript)
+	switch scriptType {
+	case stdscript.STPubKeyHashEcdsaSecp256k1,
+		stdscript.STStakeSubmissionPubKeyHash, stdscript.STStakeChangePubKeyHash,
+		stdscript.STStakeGenPubKeyHash, stdscript.STStakeRevocationPubKeyHash,
+		stdscript.STTreasuryGenPubKeyHash:
+
+		if len(in.PartialSigs) == 0 {
+			return nil, nil
+		}
+		s := in.PartialSigs[0]
+		return txscript.NewScriptBuilder().AddData(s.Signature).
+			AddData(s.PubKey).Script()
+
+	case stdscript.STScriptHash,
+		stdscript.STStakeSubmissionScriptHash, stdscript.STStakeChangeScriptHash,
+		stdscript.STStakeGenScriptHash, stdscript.STStakeRevocationScriptHash,
+		stdscript.STTreasuryGenScriptHash:
+
+		if in.RedeemScript == nil {
+			return nil, nil
+		}
+		details := stdscript.ExtractMultiSigScriptDetailsV0(in.RedeemScript, true)
+		if !details.Valid {
+			return nil, errors.E(errors.Invalid, "redeem script is not "+
+				"a standard multisig script")
+		}
+		// Signatures are ordered by the public keys of the redeem
+		// script.
+		b := txscript.NewScriptBuilder()
+		signed := 0
+		for _, pk := range details.PubKeys {
+			sig := in.partialSig(pk)
+			if sig == nil {
+				continue
+			}
+			b.AddData(sig)
+			signed++
+			if signed == int(details.RequiredSigs) {
+				break
+			}
+		}
+		if signed < int(details.RequiredSigs) {
+			return nil, nil
+		}
+		return b.AddData(in.RedeemScript).Script()
+
+	default:
+		return nil, errors.E(errors.Invalid, errors.Errorf("unsupported "+
+			"previous output script type %v", scriptType))
+	}
+}
+
+// Finalize creates the final signature script of every input with enough
+// partial signatures, and returns whether every input is finalized.  Finalized
+// inputs do not require their signing data, which is removed.
+func Finalize(p *Packet) (bool, error) {
+	const op errors.Op = "psdt.Finalize"
+	complete := true
+	for i := range p.Inputs {
+		in := &p.Inputs[i]
+		if in.Finalized() {
+			continue
+		}
+		script, err := in.finalScriptSig()
+		if err != nil {
+			return false, errors.E(op, errors.Invalid, errors.Errorf("input %d: %v", i, err))
+		}
+		if script == nil {
+			complete = false
+			continue
+		}
+		in.FinalScriptSig = script
+		in.PartialSigs = nil
+		in.Bip32Derivations = nil
+		in.RedeemScript = nil
+		in.SigHashType = 0
+	}
+	return complete, nil
+}
+
+// Complete returns whether every input of the PSDT is finalized, and the
+// signed transaction can be extracted.
+func (p *Packet) Complete() bool {
+	for i := range p.Inputs {
+		if !p.Inputs[i].Finalized() {
+			return false
+		}
+	}
+	return true
+}
+
+// Extract returns the signed transaction of a finalized PSDT.
+func Extract(p *Packet) (*wire.MsgTx, error) {
+	const op errors.Op = "psdt.Extract"
+	if !p.Complete() {
+		return nil, errors.E(op, errors.Invalid, "PSDT is not finalized")
+	}
+	tx := p.UnsignedTx.Copy()
+	for i := range tx.TxIn {
+		tx.TxIn[i].SignatureScript = p.Inputs[i].FinalScriptSig
+	}
+	return tx, nil
+}
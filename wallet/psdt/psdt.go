@@ -0,0 +1,525 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package psdt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"math/big"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// magic prefixes every serialized PSDT.
+var magic = [5]byte{'p', 's', 'd', 't', 0xff}
+
+// maxValueSize limits the size of each key and value read while parsing.
+const maxValueSize = wire.MaxMessagePayload
+
+// Global key types.
+const (
+	globalUnsignedTx = 0x00
+)
+
+// Input key types.
+const (
+	inPrevScript      = 0x00
+	inPartialSig      = 0x01
+	inSigHashType     = 0x02
+	inRedeemScript    = 0x03
+	inBip32Derivation = 0x04
+	inFinalScriptSig  = 0x05
+	inCoinType        = 0x06
+	inSKAValueIn      = 0x07
+)
+
+// Output key types.
+const (
+	outRedeemScript    = 0x00
+	outBip32Derivation = 0x01
+)
+
+// Unknown is a key-value pair of a type unknown to this package, which is
+// preserved when a PSDT is parsed and serialized.
+type Unknown struct {
+	Key   []byte
+	Value []byte
+}
+
+// PartialSig is a signature for an input created by the key of the public key.
+// The signature includes the trailing signature hash type byte.
+type PartialSig struct {
+	PubKey    []byte
+	Signature []byte
+}
+
+// Bip32Derivation describes the derivation of a public key from the master key
+// with the fingerprint.  A zero fingerprint describes an unknown master key.
+type Bip32Derivation struct {
+	PubKey               []byte
+	MasterKeyFingerprint uint32
+	Path                 []uint32
+}
+
+// Input describes the previous output spent by a transaction input, and the
+// signatures created for it.
+type Input struct {
+	PrevScriptVersion uint16
+	PrevScript        []byte
+	CoinType          cointype.CoinType
+	SKAValueIn        *big.Int
+	RedeemScript      []byte
+	SigHashType       txscript.SigHashType // Zero for SigHashAll
+	Bip32Derivations  []*Bip32Derivation
+	PartialSigs       []*PartialSig
+	FinalScriptSig    []byte
+	Unknowns          []*Unknown
+}
+
+// Output describes a transaction output which may pay to one of the signers.
+type Output struct {
+	RedeemScript     []byte
+	Bip32Derivations []*Bip32Derivation
+	Unknowns         []*Unknown
+}
+
+// Packet is a partially signed transaction.  Its inputs and outputs describe
+// the inputs and outputs of UnsignedTx with the same indexes.
+type Packet struct {
+	UnsignedTx *wire.MsgTx
+	Inputs     []Input
+	Outputs    []Output
+	Unknowns   []*Unknown
+}
+
+// New creates a PSDT for an unsigned transaction.  The transaction inputs must
+// not have signature scripts.
+func New(tx *wire.MsgTx) (*Packet, error) {
+	const op errors.Op = "psdt.New"
+	for i, in := range tx.TxIn {
+		if len(in.SignatureScript) != 0 {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf(
+				"input %d has a signature script", i))
+		}
+	}
+	return &Packet{
+		UnsignedTx: tx,
+		Inputs:     make([]Input, len(tx.TxIn)),
+		Outputs:    make([]Output, len(tx.TxOut)),
+	}, nil
+}
+
+// SigHash returns the signature hash type to sign the input with.
+func (in *Input) SigHash() txscript.SigHashType {
+	if in.SigHashType == 0 {
+		return txscript.SigHashAll
+	}
+	return in.SigHashType
+}
+
+// Finalized returns whether the input has a final signature script.
+func (in *Input) Finalized() bool {
+	return in.FinalScriptSig != nil
+}
+
+func writeKV(w io.Writer, key, value []byte) error {
+	err := wire.WriteVarBytes(w, 0, key)
+	if err != nil {
+		return err
+	}
+	return wire.WriteVarBytes(w, 0, value)
+}
+
+func writeUnknowns(w io.Writer, unknowns []*Unknown) error {
+	for _, u := range unknowns {
+		err := writeKV(w, u.Key, u.Value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeSeparator(w io.Writer) error {
+	return wire.WriteVarInt(w, 0, 0)
+}
+
+func encodeDerivation(d *Bip32Derivation) []byte {
+	b := make([]byte, 4+4*len(d.Path))
+	binary.LittleEndian.PutUint32(b, d.MasterKeyFingerprint)
+	for i, p := range d.Path {
+		binary.LittleEndian.PutUint32(b[4+4*i:], p)
+	}
+	return b
+}
+
+func decodeDerivation(pubKey, value []byte) (*Bip32Derivation, error) {
+	if len(value) < 4 || len(value)%4 != 0 {
+		return nil, errors.E(errors.Encoding, "invalid BIP0032 derivation")
+	}
+	d := &Bip32Derivation{
+		PubKey:               pubKey,
+		MasterKeyFingerprint: binary.LittleEndian.Uint32(value),
+		Path:                 make([]uint32, len(value)/4-1),
+	}
+	for i := range d.Path {
+		d.Path[i] = binary.LittleEndian.Uint32(value[4+4*i:])
+	}
+	return d, nil
+}
+
+// Serialize writes the serialized PSDT to w.
+func (p *Packet) Serialize(w io.Writer) error {
+	const op errors.Op = "psdt.Serialize"
+	if len(p.Inputs) != len(p.UnsignedTx.TxIn) || len(p.Outputs) != len(p.UnsignedTx.TxOut) {
+		return errors.E(op, errors.Invalid, "inputs and outputs do not "+
+			"match the transaction")
+	}
+	err := p.serialize(w)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+func (p *Packet) serialize(w io.Writer) error {
+	_, err := w.Write(magic[:])
+	if err != nil {
+		return err
+	}
+
+	var tx bytes.Buffer
+	tx.Grow(p.UnsignedTx.SerializeSize())
+	err = p.UnsignedTx.Serialize(&tx)
+	if err != nil {
+		return err
+	}
+	err = writeKV(w, []byte{globalUnsignedTx}, tx.Bytes())
+	if err != nil {
+		return err
+	}
+	err = writeUnknowns(w, p.Unknowns)
+	if err != nil {
+		return err
+	}
+	err = writeSeparator(w)
+	if err != nil {
+		return err
+	}
+
+	for i := range p.Inputs {
+		err := p.Inputs[i].serialize(w)
+		if err != nil {
+			return err
+		}
+	}
+	for i := range p.Outputs {
+		err := p.Outputs[i].serialize(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (in *Input) serialize(w io.Writer) error {
+	var err error
+	kv := func(key, value []byte) {
+		if err == nil {
+			err = writeKV(w, key, value)
+		}
+	}
+
+	if in.PrevScript != nil {
+		v := make([]byte, 2+len(in.PrevScript))
+		binary.LittleEndian.PutUint16(v, in.PrevScriptVersion)
+		copy(v[2:], in.PrevScript)
+		kv([]byte{inPrevScript}, v)
+	}
+	if in.CoinType != cointype.CoinTypeVAR {
+		kv([]byte{inCoinType}, []byte{uint8(in.CoinType)})
+	}
+	if in.SKAValueIn != nil {
+		kv([]byte{inSKAValueIn}, in.SKAValueIn.Bytes())
+	}
+	for _, s := range in.PartialSigs {
+		kv(append([]byte{inPartialSig}, s.PubKey...), s.Signature)
+	}
+	if in.SigHashType != 0 {
+		v := make([]byte, 4)
+		binary.LittleEndian.PutUint32(v, uint32(in.SigHashType))
+		kv([]byte{inSigHashType}, v)
+	}
+	if in.RedeemScript != nil {
+		kv([]byte{inRedeemScript}, in.RedeemScript)
+	}
+	for _, d := range in.Bip32Derivations {
+		kv(append([]byte{inBip32Derivation}, d.PubKey...), encodeDerivation(d))
+	}
+	if in.FinalScriptSig != nil {
+		kv([]byte{inFinalScriptSig}, in.FinalScriptSig)
+	}
+	if err != nil {
+		return err
+	}
+	err = writeUnknowns(w, in.Unknowns)
+	if err != nil {
+		return err
+	}
+	return writeSeparator(w)
+}
+
+func (out *Output) serialize(w io.Writer) error {
+	if out.RedeemScript != nil {
+		err := writeKV(w, []byte{outRedeemScript}, out.RedeemScript)
+		if err != nil {
+			return err
+		}
+	}
+	for _, d := range out.Bip32Derivations {
+		err := writeKV(w, append([]byte{outBip32Derivation}, d.PubKey...),
+			encodeDerivation(d))
+		if err != nil {
+			return err
+		}
+	}
+	err := writeUnknowns(w, out.Unknowns)
+	if err != nil {
+		return err
+	}
+	return writeSeparator(w)
+}
+
+// Bytes returns the serialized PSDT.
+func (p *Packet) Bytes() ([]byte, error) {
+	var b bytes.Buffer
+	err := p.Serialize(&b)
+	if err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// B64Encode returns the base64 encoding of the serialized PSDT, which is the
+// encoding used by RPCs.
+func (p *Packet) B64Encode() (string, error) {
+	b, err := p.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// readMap calls fn with each key-value pair of a map, until the separator.
+func readMap(r io.Reader, fn func(key, value []byte) error) error {
+	for {
+		key, err := wire.ReadVarBytes(r, 0, maxValueSize, "key")
+		if err != nil {
+			return err
+		}
+		if len(key) == 0 {
+			return nil
+		}
+		value, err := wire.ReadVarBytes(r, 0, maxValueSize, "value")
+		if err != nil {
+			return err
+		}
+		err = fn(key, value)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func errDuplicate(key []byte) error {
+	return errors.E(errors.Encoding, errors.Errorf("duplicate key %x", key))
+}
+
+func errInvalidKey(key []byte) error {
+	return errors.E(errors.Encoding, errors.Errorf("invalid key %x", key))
+}
+
+// Parse parses a serialized PSDT.
+func Parse(r io.Reader) (*Packet, error) {
+	const op errors.Op = "psdt.Parse"
+	p, err := parse(r)
+	if err != nil {
+		if !errors.Is(err, errors.Encoding) {
+			err = errors.E(errors.Encoding, err)
+		}
+		return nil, errors.E(op, err)
+	}
+	return p, nil
+}
+
+// ParseBase64 parses the base64 encoding of a serialized PSDT.
+func ParseBase64(s string) (*Packet, error) {
+	const op errors.Op = "psdt.ParseBase64"
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	return Parse(bytes.NewReader(b))
+}
+
+func parse(r io.Reader) (*Packet, error) {
+	var m [len(magic)]byte
+	_, err := io.ReadFull(r, m[:])
+	if err != nil {
+		return nil, err
+	}
+	if m != magic {
+		return nil, errors.E(errors.Encoding, "invalid magic")
+	}
+
+	p := new(Packet)
+	seen := make(map[string]struct{})
+	err = readMap(r, func(key, value []byte) error {
+		if _, ok := seen[string(key)]; ok {
+			return errDuplicate(key)
+		}
+		seen[string(key)] = struct{}{}
+		switch key[0] {
+		case globalUnsignedTx:
+			if len(key) != 1 {
+				return errInvalidKey(key)
+			}
+			tx := new(wire.MsgTx)
+			err := tx.Deserialize(bytes.NewReader(value))
+			if err != nil {
+				return err
+			}
+			p.UnsignedTx = tx
+		default:
+			p.Unknowns = append(p.Unknowns, &Unknown{key, value})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if p.UnsignedTx == nil {
+		return nil, errors.E(errors.Encoding, "missing unsigned transaction")
+	}
+	for i, in := range p.UnsignedTx.TxIn {
+		if len(in.SignatureScript) != 0 {
+			return nil, errors.E(errors.Encoding, errors.Errorf("input %d "+
+				"of unsigned transaction has a signature script", i))
+		}
+	}
+
+	p.Inputs = make([]Input, len(p.UnsignedTx.TxIn))
+	for i := range p.Inputs {
+		err := p.Inputs[i].parse(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+	p.Outputs = make([]Output, len(p.UnsignedTx.TxOut))
+	for i := range p.Outputs {
+		err := p.Outputs[i].parse(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func (in *Input) parse(r io.Reader) error {
+	seen := make(map[string]struct{})
+	return readMap(r, func(key, value []byte) error {
+		if _, ok := seen[string(key)]; ok {
+			return errDuplicate(key)
+		}
+		seen[string(key)] = struct{}{}
+		switch key[0] {
+		case inPrevScript:
+			if len(key) != 1 || len(value) < 2 {
+				return errInvalidKey(key)
+			}
+			in.PrevScriptVersion = binary.LittleEndian.Uint16(value)
+			in.PrevScript = value[2:]
+		case inCoinType:
+			if len(key) != 1 || len(value) != 1 {
+				return errInvalidKey(key)
+			}
+			in.CoinType = cointype.CoinType(value[0])
+		case inSKAValueIn:
+			if len(key) != 1 {
+				return errInvalidKey(key)
+			}
+			in.SKAValueIn = new(big.Int).SetBytes(value)
+		case inPartialSig:
+			if len(key) == 1 {
+				return errInvalidKey(key)
+			}
+			in.PartialSigs = append(in.PartialSigs, &PartialSig{
+				PubKey:    key[1:],
+				Signature: value,
+			})
+		case inSigHashType:
+			if len(key) != 1 || len(value) != 4 {
+				return errInvalidKey(key)
+			}
+			in.SigHashType = txscript.SigHashType(binary.LittleEndian.Uint32(value))
+		case inRedeemScript:
+			if len(key) != 1 {
+				return errInvalidKey(key)
+			}
+			in.RedeemScript = value
+		case inBip32Derivation:
+			if len(key) == 1 {
+				return errInvalidKey(key)
+			}
+			d, err := decodeDerivation(key[1:], value)
+			if err != nil {
+				return err
+			}
+			in.Bip32Derivations = append(in.Bip32Derivations, d)
+		case inFinalScriptSig:
+			if len(key) != 1 {
+				return errInvalidKey(key)
+			}
+			in.FinalScriptSig = value
+		default:
+			in.Unknowns = append(in.Unknowns, &Unknown{key, value})
+		}
+		return nil
+	})
+}
+
+func (out *Output) parse(r io.Reader) error {
+	seen := make(map[string]struct{})
+	return readMap(r, func(key, value []byte) error {
+		if _, ok := seen[string(key)]; ok {
+			return errDuplicate(key)
+		}
+		seen[string(key)] = struct{}{}
+		switch key[0] {
+		case outRedeemScript:
+			if len(key) != 1 {
+				return errInvalidKey(key)
+			}
+			out.RedeemScript = value
+		case outBip32Derivation:
+			if len(key) == 1 {
+				return errInvalidKey(key)
+			}
+			d, err := decodeDerivation(key[1:], value)
+			if err != nil {
+				return err
+			}
+			out.Bip32Derivations = append(out.Bip32Derivations, d)
+		default:
+			out.Unknowns = append(out.Unknowns, &Unknown{key, value})
+		}
+		return nil
+	})
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package psdt
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// p2pkhScript is a pay-to-pubkey-hash script of a zero hash.
+var p2pkhScript = []byte{
+	txscript.OP_DUP, txscript.OP_HASH160, txscript.OP_DATA_20,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	txscript.OP_EQUALVERIFY, txscript.OP_CHECKSIG,
+}
+
+func testTx() *wire.MsgTx {
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 0}, 1e8, nil))
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	tx.AddTxOut(wire.NewTxOut(1e8-1e4, p2pkhScript))
+	return tx
+}
+
+func TestSerializeParse(t *testing.T) {
+	t.Parallel()
+
+	skaValue, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	p, err := New(testTx())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Unknowns = []*Unknown{{Key: []byte{0xf0, 1}, Value: []byte{2}}}
+	p.Inputs[0] = Input{
+		PrevScript:  p2pkhScript,
+		SigHashType: txscript.SigHashAll | txscript.SigHashAnyOneCanPay,
+		Bip32Derivations: []*Bip32Derivation{{
+			PubKey:               bytes.Repeat([]byte{2}, 33),
+			MasterKeyFingerprint: 0xdeadbeef,
+			Path:                 []uint32{44 | 1<<31, 1 | 1<<31, 0 | 1<<31, 1, 7},
+		}},
+		PartialSigs: []*PartialSig{{
+			PubKey:    bytes.Repeat([]byte{2}, 33),
+			Signature: []byte{0x30, 1, 2, 3, byte(txscript.SigHashAll)},
+		}},
+	}
+	p.Inputs[1] = Input{
+		PrevScriptVersion: 1,
+		PrevScript:        p2pkhScript,
+		CoinType:          1,
+		SKAValueIn:        skaValue,
+		FinalScriptSig:    []byte{txscript.OP_TRUE},
+		Unknowns:          []*Unknown{{Key: []byte{0xf0}, Value: []byte{}}},
+	}
+	p.Outputs[0].RedeemScript = []byte{txscript.OP_TRUE}
+
+	b, err := p.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := Parse(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.UnsignedTx.TxHash() != p.UnsignedTx.TxHash() {
+		t.Fatal("parsed transaction differs")
+	}
+	parsed.UnsignedTx = p.UnsignedTx
+	if !reflect.DeepEqual(parsed, p) {
+		t.Fatalf("parsed PSDT differs:\nparsed %+v\nwant   %+v", parsed, p)
+	}
+
+	s, err := p.B64Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseBase64(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Duplicate keys and truncated PSDTs are rejected.
+	dup := append([]byte{}, b[:len(b)-1]...)
+	dup = append(dup, 1, outRedeemScript, 0, 0)
+	tests := map[string][]byte{
+		"bad magic":     append([]byte("psbt"), b[4:]...),
+		"truncated":     b[:len(b)-1],
+		"duplicate key": dup,
+	}
+	for name, b := range tests {
+		_, err := Parse(bytes.NewReader(b))
+		if !errors.Is(err, errors.Encoding) {
+			t.Errorf("%s: expected Encoding error, got %v", name, err)
+		}
+	}
+}
+
+func TestCombineFinalize(t *testing.T) {
+	t.Parallel()
+
+	tx := testTx()
+	pubKey1 := bytes.Repeat([]byte{2}, 33)
+	pubKey2 := bytes.Repeat([]byte{3}, 33)
+	sig1 := []byte{0x30, 1, byte(txscript.SigHashAll)}
+	sig2 := []byte{0x30, 2, byte(txscript.SigHashAll)}
+
+	p1, err := New(tx.Copy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p1.Inputs[0].PrevScript = p2pkhScript
+	p1.Inputs[0].AddPartialSig(pubKey1, sig1)
+	p2, err := New(tx.Copy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2.Inputs[1].PrevScript = p2pkhScript
+	p2.Inputs[1].AddPartialSig(pubKey2, sig2)
+
+	other := tx.Copy()
+	other.LockTime = 1
+	p3, err := New(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Combine(p1, p3); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("combining different transactions: expected Invalid "+
+			"error, got %v", err)
+	}
+
+	if complete, err := Finalize(p1); err != nil || complete {
+		t.Fatalf("finalizing partial PSDT: complete %v, err %v", complete, err)
+	}
+	if _, err := Extract(p1); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("extracting partial PSDT: expected Invalid error, got %v", err)
+	}
+
+	c, err := Combine(p1, p2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	complete, err := Finalize(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !complete {
+		t.Fatal("combined PSDT was not finalized")
+	}
+	signed, err := Extract(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, s := range [][2][]byte{{sig1, pubKey1}, {sig2, pubKey2}} {
+		want, err := txscript.NewScriptBuilder().AddData(s[0]).
+			AddData(s[1]).Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(signed.TxIn[i].SignatureScript, want) {
+			t.Errorf("input %d: signature script %x, want %x", i,
+				signed.TxIn[i].SignatureScript, want)
+		}
+	}
+	if signed.TxHash() != tx.TxHash() {
+		t.Error("extracted transaction differs from the unsigned transaction")
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+const (
+	// rebroadcastInterval is how often the rebroadcast queue is checked
+	// for transactions due to be republished.
+	rebroadcastInterval = time.Minute
+
+	// rebroadcastMinDelay and rebroadcastMaxDelay bound the exponential
+	// backoff between attempts to republish a transaction.
+	rebroadcastMinDelay = time.Minute
+	rebroadcastMaxDelay = 6 * time.Hour
+)
+
+// rebroadcastDelay returns the delay before the next attempt to republish a
+// transaction which has been republished attempts times.
+func rebroadcastDelay(attempts uint32) time.Duration {
+	delay := rebroadcastMinDelay
+	for i := uint32(0); i < attempts && delay < rebroadcastMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > rebroadcastMaxDelay {
+		delay = rebroadcastMaxDelay
+	}
+	return delay
+}
+
+// queueRebroadcast queues a published transaction to be republished until it
+// is mined.  Failing to queue the transaction is logged rather than returned,
+// as the transaction has already been published.
+func (w *Wallet) queueRebroadcast(ctx context.Context, txHash *chainhash.Hash) {
+	now := time.Unix(time.Now().Unix(), 0)
+	r := &udb.Rebroadcast{
+		Hash:        *txHash,
+		Queued:      now,
+		NextAttempt: now.Add(rebroadcastDelay(0)),
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutRebroadcast(dbtx, r)
+	})
+	if err != nil {
+		logCtx(ctx).Errorf("Failed to queue transaction %v for rebroadcast: %v",
+			txHash, err)
+	}
+}
+
+// PendingBroadcasts returns every published transaction queued to be
+// republished until it is mined, in increasing transaction hash order.
+func (w *Wallet) PendingBroadcasts(ctx context.Context) ([]udb.Rebroadcast, error) {
+	const op errors.Op = "wallet.PendingBroadcasts"
+
+	var queue []udb.Rebroadcast
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachRebroadcast(dbtx, func(r *udb.Rebroadcast) error {
+			queue = append(queue, *r)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return queue, nil
+}
+
+// rebroadcastDue republishes each queued transaction whose next attempt is
+// due at now, and removes transactions which were mined or are no longer
+// recorded by the wallet from the queue.
+func (w *Wallet) rebroadcastDue(ctx context.Context, n NetworkBackend, now time.Time) error {
+	const op errors.Op = "wallet.rebroadcastDue"
+
+	if !w.ops.TryBegin() {
+		return nil
+	}
+	defer w.ops.End()
+
+	var due []*udb.Rebroadcast
+	var dueTxs []*wire.MsgTx
+	var done []chainhash.Hash
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		return udb.ForEachRebroadcast(dbtx, func(r *udb.Rebroadcast) error {
+			_, unmined := w.txStore.ExistsTxMinedOrUnmined(txmgrNs, &r.Hash)
+			if !unmined {
+				done = append(done, r.Hash)
+				return nil
+			}
+			if r.NextAttempt.After(now) {
+				return nil
+			}
+			details, err := w.txStore.TxDetails(txmgrNs, &r.Hash)
+			if err != nil {
+				return err
+			}
+			due = append(due, r)
+			dueTxs = append(dueTxs, &details.MsgTx)
+			return nil
+		})
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	// Transactions are republished individually so that one rejected
+	// transaction does not prevent the others from being sent.  When
+	// cancelled, the attempts already made are still recorded.
+	now = time.Unix(now.Unix(), 0)
+	for i, r := range due {
+		err := n.PublishTransactions(ctx, dueTxs[i])
+		if ctx.Err() != nil {
+			due = due[:i]
+			ctx = context.WithoutCancel(ctx)
+			break
+		}
+		r.LastError = ""
+		if err != nil {
+			logCtx(ctx).Warnf("Failed to rebroadcast transaction %v: %v", &r.Hash, err)
+			r.LastError = err.Error()
+		} else {
+			logCtx(ctx).Debugf("Rebroadcast transaction %v", &r.Hash)
+		}
+		r.Attempts++
+		r.LastAttempt = now
+		r.NextAttempt = now.Add(rebroadcastDelay(r.Attempts))
+	}
+
+	if len(due) == 0 && len(done) == 0 {
+		return nil
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		for i := range done {
+			if err := udb.DeleteRebroadcast(dbtx, &done[i]); err != nil {
+				return err
+			}
+		}
+		for _, r := range due {
+			if err := udb.PutRebroadcast(dbtx, r); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// rebroadcastLoop periodically republishes queued transactions through the
+// wallet's network backend until the context is cancelled.  Checks are
+// skipped while no network backend is associated with the wallet.
+func (w *Wallet) rebroadcastLoop(ctx context.Context) error {
+	ticker := time.NewTicker(rebroadcastInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		n, err := w.NetworkBackend()
+		if err != nil {
+			continue
+		}
+		err = w.rebroadcastDue(ctx, n, time.Now())
+		if err != nil && ctx.Err() == nil {
+			logCtx(ctx).Errorf("Failed to rebroadcast queued transactions: %v", err)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+)
+
+func TestRebroadcastDelay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		attempts uint32
+		delay    time.Duration
+	}{
+		{0, time.Minute},
+		{1, 2 * time.Minute},
+		{5, 32 * time.Minute},
+		{9, rebroadcastMaxDelay},
+		{1 << 31, rebroadcastMaxDelay},
+	}
+	for _, test := range tests {
+		if d := rebroadcastDelay(test.attempts); d != test.delay {
+			t.Errorf("delay after %d attempts: got %v, want %v",
+				test.attempts, d, test.delay)
+		}
+	}
+}
+
+func TestRebroadcastQueue(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	hash := chainhash.Hash{1}
+	w.queueRebroadcast(ctx, &hash)
+	queue, err := w.PendingBroadcasts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue) != 1 || queue[0].Hash != hash || queue[0].Attempts != 0 ||
+		!queue[0].NextAttempt.Equal(queue[0].Queued.Add(time.Minute)) {
+		t.Fatalf("unexpected rebroadcast queue %+v", queue)
+	}
+
+	// Transactions no longer recorded by the wallet, such as abandoned
+	// transactions, leave the queue without being republished.
+	err = w.rebroadcastDue(ctx, mockNetwork{}, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	queue, err = w.PendingBroadcasts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue) != 0 {
+		t.Fatalf("unrecorded transaction remains queued: %+v", queue)
+	}
+}
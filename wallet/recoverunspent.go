@@ -0,0 +1,163 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sort"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	dcrdtypes "github.com/monetarium/monetarium-node/rpc/jsonrpc/types"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// TxOutQuerier defines the functions required of a (trusted) network backend
+// to look up unspent outputs in its UTXO set.
+type TxOutQuerier interface {
+	GetTxOut(ctx context.Context, txHash *chainhash.Hash, index uint32, tree int8,
+		includeMempool bool) (*dcrdtypes.GetTxOutResult, error)
+}
+
+// RecoveredOutput describes an unspent output paying a wallet address which
+// was missing from the wallet database and was reinserted by RecoverUnspent.
+// Mature reports whether the output has reached the maturity required of its
+// transaction type to be spent.  Verified reports whether the network backend
+// confirmed the output is unspent, and is always false for backends which do
+// not implement TxOutQuerier.
+type RecoveredOutput struct {
+	OutPoint     wire.OutPoint
+	Address      stdaddr.Address // nil for nonstandard scripts
+	Account      uint32
+	CoinType     cointype.CoinType
+	Amount       dcrutil.Amount
+	SKAAmount    cointype.SKAAmount
+	Height       int32
+	TxType       stake.TxType
+	FromCoinBase bool
+	Mature       bool
+	Verified     bool
+}
+
+// outputMatured returns whether the output c of a transaction of type txType
+// is mature in a main chain with tip height tipHeight.
+func outputMatured(params *chaincfg.Params, txType stake.TxType, c *udb.Credit, tipHeight int32) bool {
+	if c.FromCoinBase {
+		return coinbaseMatured(params, c.Height, tipHeight)
+	}
+	switch txType {
+	case stake.TxTypeSStx:
+		if c.Index == 0 {
+			return ticketMatured(params, c.Height, tipHeight)
+		}
+		return ticketChangeMatured(params, c.Height, tipHeight)
+	case stake.TxTypeSSGen, stake.TxTypeSSRtx, stake.TxTypeSSFee:
+		return coinbaseMatured(params, c.Height, tipHeight)
+	}
+	return true
+}
+
+// RecoverUnspent rescans the main chain from startHeight for outputs paying
+// wallet addresses which are missing from the wallet database, such as after
+// restoring a partial database backup, and reinserts them with the coin type
+// recorded by their transaction.  The recovered outputs are returned in block
+// order.  When the network backend implements TxOutQuerier, each recovered
+// output is additionally checked against the backend's UTXO set.
+func (w *Wallet) RecoverUnspent(ctx context.Context, n NetworkBackend, startHeight int32) ([]RecoveredOutput, error) {
+	const op errors.Op = "wallet.RecoverUnspent"
+
+	known := make(map[wire.OutPoint]struct{})
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return w.txStore.ForEachUnspentOutpoint(dbtx, nil, func(prev *wire.OutPoint) error {
+			known[*prev] = struct{}{}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	err = w.RescanFromHeight(ctx, n, startHeight)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	var recovered []RecoveredOutput
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+
+		for _, ct := range w.getActiveCoinTypes() {
+			unspent, err := w.txStore.UnspentOutputs(dbtx, ct)
+			if err != nil {
+				return err
+			}
+			for _, c := range unspent {
+				if _, ok := known[c.OutPoint]; ok || c.Height < 0 {
+					continue
+				}
+				details, err := w.txStore.TxDetails(txmgrNs, &c.Hash)
+				if err != nil {
+					return err
+				}
+				out := RecoveredOutput{
+					OutPoint:     c.OutPoint,
+					CoinType:     c.CoinType,
+					Amount:       c.Amount,
+					SKAAmount:    c.SKAAmount,
+					Height:       c.Height,
+					TxType:       details.TxType,
+					FromCoinBase: c.FromCoinBase,
+					Mature:       outputMatured(w.chainParams, details.TxType, c, tipHeight),
+				}
+				_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, c.PkScript, w.chainParams)
+				if len(addrs) > 0 {
+					out.Address = addrs[0]
+					acct, err := w.manager.AddrAccount(addrmgrNs, addrs[0])
+					if err == nil {
+						out.Account = acct
+					}
+				}
+				recovered = append(recovered, out)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sort.SliceStable(recovered, func(i, j int) bool {
+		return recovered[i].Height < recovered[j].Height
+	})
+
+	if rpc, ok := n.(TxOutQuerier); ok {
+		for i := range recovered {
+			out := &recovered[i]
+			txOut, err := rpc.GetTxOut(ctx, &out.OutPoint.Hash,
+				out.OutPoint.Index, out.OutPoint.Tree, true)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			out.Verified = txOut != nil
+			if !out.Verified {
+				logCtx(ctx).Warnf("Recovered output %v is not in the network "+
+					"backend's UTXO set", &out.OutPoint)
+			}
+		}
+	}
+
+	logCtx(ctx).Infof("Recovered %d unspent outputs missing from the wallet database",
+		len(recovered))
+	return recovered, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+)
+
+func TestOutputMatured(t *testing.T) {
+	t.Parallel()
+
+	params := chaincfg.SimNetParams()
+	coinbase := int32(params.CoinbaseMaturity)
+	ticket := int32(params.TicketMaturity)
+	ticketChange := int32(params.SStxChangeMaturity)
+
+	tests := []struct {
+		name     string
+		txType   stake.TxType
+		index    uint32
+		coinbase bool
+		tip      int32
+		mature   bool
+	}{
+		{"regular", stake.TxTypeRegular, 0, false, 100, true},
+		{"immature coinbase", stake.TxTypeRegular, 0, true, 100 + coinbase - 1, false},
+		{"mature coinbase", stake.TxTypeRegular, 0, true, 100 + coinbase, true},
+		{"immature vote", stake.TxTypeSSGen, 2, false, 100 + coinbase - 1, false},
+		{"mature vote", stake.TxTypeSSGen, 2, false, 100 + coinbase, true},
+		{"immature ticket", stake.TxTypeSStx, 0, false, 100 + ticket, false},
+		{"mature ticket", stake.TxTypeSStx, 0, false, 100 + ticket + 1, true},
+		{"immature ticket change", stake.TxTypeSStx, 2, false, 100 + ticketChange - 1, false},
+		{"mature ticket change", stake.TxTypeSStx, 2, false, 100 + ticketChange, true},
+	}
+	for _, test := range tests {
+		c := &udb.Credit{
+			OutPoint:     wire.OutPoint{Index: test.index},
+			BlockMeta:    udb.BlockMeta{Block: udb.Block{Height: 100}},
+			FromCoinBase: test.coinbase,
+		}
+		mature := outputMatured(params, test.txType, c, test.tip)
+		if mature != test.mature {
+			t.Errorf("%s: got mature %v, want %v", test.name, mature, test.mature)
+		}
+	}
+}
@@ -0,0 +1,340 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/txrules"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+const (
+	// minRecurringInterval is the shortest time interval of a recurring
+	// payment.
+	minRecurringInterval = time.Minute
+
+	// recurringRetryDelay is how long a failed payment of a recurring
+	// payment with the retry failure policy waits before it is retried.
+	recurringRetryDelay = 10 * time.Minute
+)
+
+// RecurringPaymentOptions specifies how often a recurring payment added with
+// AddRecurringPayment is sent.
+type RecurringPaymentOptions struct {
+	// IntervalBlocks is the number of blocks between payments.  Exactly one
+	// of IntervalBlocks and Interval must be set.
+	IntervalBlocks int32
+
+	// Interval is the time between payments, truncated to whole seconds.
+	Interval time.Duration
+
+	// StartHeight and StartTime, when not zero, are when the first payment
+	// of a block or time interval is due.  The first payment is otherwise
+	// due one interval after the payment is added.
+	StartHeight int32
+	StartTime   time.Time
+
+	// FailurePolicy describes how the recurring payment proceeds after a
+	// payment fails.
+	FailurePolicy udb.RecurringFailurePolicy
+
+	// Label is recorded for each payment transaction.
+	Label string
+}
+
+// AddRecurringPayment records a payment of output from account which is sent
+// every interval of blocks or time described by opts.  Each payment is
+// authored and signed when due, subject to the send policy and daily spend
+// limits like SendOutputsWithOptions, and waits while the wallet is locked.
+// Payments exceeding a spend limit which requires approval are recorded as
+// pending sends, and the recurring payment proceeds to the following payment.
+//
+// Due payments are sent by the same periodic checks as scheduled sends, and a
+// RecurringPaymentNotification is sent after each attempted payment.  Payments
+// missed while the wallet was not running are skipped.
+func (w *Wallet) AddRecurringPayment(ctx context.Context, output *wire.TxOut,
+	account uint32, minconf int32, opts *RecurringPaymentOptions) (*udb.RecurringPayment, error) {
+
+	const op errors.Op = "wallet.AddRecurringPayment"
+
+	interval := opts.Interval.Truncate(time.Second)
+	switch {
+	case (opts.IntervalBlocks > 0) == (interval > 0):
+		return nil, errors.E(op, errors.Invalid, "recurring payment requires "+
+			"one block or time interval")
+	case opts.IntervalBlocks < 0, interval < 0:
+		return nil, errors.E(op, errors.Invalid, "negative interval")
+	case interval > 0 && interval < minRecurringInterval:
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("time "+
+			"interval is shorter than %v", minRecurringInterval))
+	case opts.StartHeight < 0:
+		return nil, errors.E(op, errors.Invalid, "negative start height")
+	case opts.StartHeight > 0 && interval > 0, !opts.StartTime.IsZero() && opts.IntervalBlocks > 0:
+		return nil, errors.E(op, errors.Invalid, "start does not match "+
+			"the interval")
+	}
+
+	outputs := []*wire.TxOut{output}
+	coinType := txrules.GetCoinTypeFromOutputs(outputs)
+	err := txrules.CheckOutput(output, w.RelayFeeForCoinType(ctx, coinType))
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	now := time.Now()
+	_, tipHeight := w.MainChainTip(ctx)
+	p := &udb.RecurringPayment{
+		Send: udb.PendingSend{
+			Account:       account,
+			ChangeAccount: account,
+			MinConf:       minconf,
+			CoinType:      coinType,
+			Outputs:       outputs,
+			Amount:        sendAmount(outputs),
+			Label:         opts.Label,
+			Created:       time.Unix(now.Unix(), 0),
+		},
+		IntervalBlocks: opts.IntervalBlocks,
+		Interval:       interval,
+		FailurePolicy:  opts.FailurePolicy,
+	}
+	switch {
+	case opts.IntervalBlocks > 0 && opts.StartHeight > 0:
+		p.NextHeight = opts.StartHeight
+	case opts.IntervalBlocks > 0:
+		p.NextHeight = tipHeight + opts.IntervalBlocks
+	case !opts.StartTime.IsZero():
+		p.NextTime = time.Unix(opts.StartTime.Unix(), 0)
+	default:
+		p.NextTime = time.Unix(now.Add(interval).Unix(), 0)
+	}
+
+	// Payments to blocked addresses are refused now rather than failing
+	// every time they are due.
+	if err := w.checkSendPolicy(ctx, op, &p.Send); err != nil {
+		return nil, err
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := w.manager.AccountName(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+		return udb.PutRecurringPayment(dbtx, p)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	logCtx(ctx).Infof("Added recurring payment %d from account %d", p.ID, account)
+	return p, nil
+}
+
+// RecurringPayments returns every recurring payment, in increasing ID order.
+func (w *Wallet) RecurringPayments(ctx context.Context) ([]udb.RecurringPayment, error) {
+	const op errors.Op = "wallet.RecurringPayments"
+
+	var payments []udb.RecurringPayment
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachRecurringPayment(dbtx, func(p *udb.RecurringPayment) error {
+			payments = append(payments, *p)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return payments, nil
+}
+
+// PauseRecurringPayment pauses a recurring payment, which is not sent until it
+// is resumed.
+func (w *Wallet) PauseRecurringPayment(ctx context.Context, id uint32) error {
+	const op errors.Op = "wallet.PauseRecurringPayment"
+
+	err := w.updateRecurringPayment(ctx, id, func(p *udb.RecurringPayment) {
+		p.Paused = true
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	logCtx(ctx).Infof("Paused recurring payment %d", id)
+	return nil
+}
+
+// ResumeRecurringPayment resumes a paused recurring payment.  Payments which
+// became due while the recurring payment was paused are skipped.
+func (w *Wallet) ResumeRecurringPayment(ctx context.Context, id uint32) error {
+	const op errors.Op = "wallet.ResumeRecurringPayment"
+
+	now := time.Now()
+	_, tipHeight := w.MainChainTip(ctx)
+	err := w.updateRecurringPayment(ctx, id, func(p *udb.RecurringPayment) {
+		p.Paused = false
+		p.LastError = ""
+		skipMissedPayments(p, now, tipHeight)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	logCtx(ctx).Infof("Resumed recurring payment %d", id)
+	return nil
+}
+
+// RemoveRecurringPayment removes a recurring payment.
+func (w *Wallet) RemoveRecurringPayment(ctx context.Context, id uint32) error {
+	const op errors.Op = "wallet.RemoveRecurringPayment"
+
+	w.recurringPaymentMu.Lock()
+	defer w.recurringPaymentMu.Unlock()
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeleteRecurringPayment(dbtx, id)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	logCtx(ctx).Infof("Removed recurring payment %d", id)
+	return nil
+}
+
+// updateRecurringPayment modifies the recurring payment with an ID using f.
+func (w *Wallet) updateRecurringPayment(ctx context.Context, id uint32,
+	f func(*udb.RecurringPayment)) error {
+
+	w.recurringPaymentMu.Lock()
+	defer w.recurringPaymentMu.Unlock()
+
+	return walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		p, err := udb.RecurringPaymentByID(dbtx, id)
+		if err != nil {
+			return err
+		}
+		f(p)
+		return udb.PutRecurringPayment(dbtx, p)
+	})
+}
+
+// recurringPaymentDue returns whether the next payment of p is due at now and
+// tipHeight.
+func recurringPaymentDue(p *udb.RecurringPayment, now time.Time, tipHeight int32) bool {
+	if p.IntervalBlocks > 0 {
+		return tipHeight >= p.NextHeight
+	}
+	return !now.Before(p.NextTime)
+}
+
+// skipMissedPayments advances the next payment of p past every payment which
+// is due at now and tipHeight.
+func skipMissedPayments(p *udb.RecurringPayment, now time.Time, tipHeight int32) {
+	if !recurringPaymentDue(p, now, tipHeight) {
+		return
+	}
+	if p.IntervalBlocks > 0 {
+		missed := (tipHeight-p.NextHeight)/p.IntervalBlocks + 1
+		p.NextHeight += missed * p.IntervalBlocks
+	} else {
+		missed := now.Sub(p.NextTime)/p.Interval + 1
+		p.NextTime = p.NextTime.Add(missed * p.Interval)
+	}
+}
+
+// advanceRecurringPayment schedules the payment following the due payment of
+// p, skipping payments which were missed.
+func advanceRecurringPayment(p *udb.RecurringPayment, now time.Time, tipHeight int32) {
+	if p.IntervalBlocks > 0 {
+		p.NextHeight += p.IntervalBlocks
+	} else {
+		p.NextTime = p.NextTime.Add(p.Interval)
+	}
+	skipMissedPayments(p, now, tipHeight)
+}
+
+// sendRecurringDue sends the due payment of each recurring payment which is not
+// paused at now and tipHeight.  Payments wait while the wallet is locked, and
+// failed payments are handled by the failure policy of their recurring
+// payment.
+func (w *Wallet) sendRecurringDue(ctx context.Context, now time.Time, tipHeight int32) error {
+	const op errors.Op = "wallet.sendRecurringDue"
+
+	w.recurringPaymentMu.Lock()
+	defer w.recurringPaymentMu.Unlock()
+
+	if w.Locked() {
+		return nil
+	}
+	var due []*udb.RecurringPayment
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachRecurringPayment(dbtx, func(p *udb.RecurringPayment) error {
+			switch {
+			case p.Paused:
+			case !recurringPaymentDue(p, now, tipHeight):
+			case p.FailurePolicy == udb.RecurringRetry && p.LastError != "" &&
+				now.Sub(p.LastAttempt) < recurringRetryDelay:
+			default:
+				due = append(due, p)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	for _, p := range due {
+		send := p.Send
+		send.Created = time.Unix(now.Unix(), 0)
+		hash, err := w.sendWithinLimit(ctx, op, &send)
+		if errors.Is(err, errors.Locked) {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		p.LastAttempt = time.Unix(now.Unix(), 0)
+		p.LastTxHash = chainhash.Hash{}
+		p.LastError = ""
+		switch {
+		case err == nil:
+			logCtx(ctx).Infof("Sent payment %d of recurring payment %d as transaction %v",
+				p.Executions+1, p.ID, hash)
+			p.Executions++
+			p.LastTxHash = *hash
+			advanceRecurringPayment(p, now, tipHeight)
+		case send.ID != 0:
+			// The payment exceeded the spend limit and awaits
+			// approval as a pending send.
+			logCtx(ctx).Infof("Payment of recurring payment %d awaits approval as "+
+				"pending send %d", p.ID, send.ID)
+			p.LastError = err.Error()
+			advanceRecurringPayment(p, now, tipHeight)
+		default:
+			logCtx(ctx).Warnf("Failed to send payment of recurring payment %d: %v",
+				p.ID, err)
+			p.LastError = err.Error()
+			switch p.FailurePolicy {
+			case udb.RecurringSkip:
+				advanceRecurringPayment(p, now, tipHeight)
+			case udb.RecurringPause:
+				p.Paused = true
+			}
+		}
+		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			return udb.PutRecurringPayment(dbtx, p)
+		})
+		if err != nil {
+			return errors.E(op, err)
+		}
+		w.NtfnServer.notifyRecurringPayment(&RecurringPaymentNotification{
+			Payment: p,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+)
+
+func TestRecurringPayments(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vers, script := addr.PaymentScript()
+	output := &wire.TxOut{Value: 2e8, Version: vers, PkScript: script}
+	add := func(opts *RecurringPaymentOptions) (*udb.RecurringPayment, error) {
+		return w.AddRecurringPayment(ctx, output, defaultAccount, 1, opts)
+	}
+
+	invalid := []*RecurringPaymentOptions{
+		{},
+		{IntervalBlocks: 10, Interval: time.Hour},
+		{Interval: time.Second},
+		{IntervalBlocks: 10, StartTime: time.Now()},
+	}
+	for i, opts := range invalid {
+		if _, err := add(opts); !errors.Is(err, errors.Invalid) {
+			t.Errorf("invalid options %d: expected Invalid error, got %v", i, err)
+		}
+	}
+
+	now := time.Now()
+	byBlocks, err := add(&RecurringPaymentOptions{
+		IntervalBlocks: 10,
+		StartHeight:    5,
+		FailurePolicy:  udb.RecurringSkip,
+		Label:          "rent",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	byTime, err := add(&RecurringPaymentOptions{
+		Interval:      time.Hour,
+		FailurePolicy: udb.RecurringPause,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !byTime.NextTime.After(now) {
+		t.Errorf("first payment due at %v, before an interval elapsed", byTime.NextTime)
+	}
+	payment := func(id uint32) *udb.RecurringPayment {
+		t.Helper()
+		payments, err := w.RecurringPayments(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range payments {
+			if payments[i].ID == id {
+				return &payments[i]
+			}
+		}
+		t.Fatalf("recurring payment %d not found", id)
+		return nil
+	}
+
+	w.NtfnServer.SetBacklogLimit(10, BacklogDisconnect)
+	n := w.NtfnServer.RecurringPaymentNotifications()
+	defer n.Done()
+
+	// Payments wait while the wallet is locked.
+	if err := w.sendRecurringDue(ctx, now, 5); err != nil {
+		t.Fatal(err)
+	}
+	if p := payment(byBlocks.ID); p.NextHeight != 5 || !p.LastAttempt.IsZero() {
+		t.Fatalf("payment attempted while locked: %+v", p)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The unfunded wallet fails to send the due payment, which is skipped
+	// along with the payment missed at height 15.
+	if err := w.sendRecurringDue(ctx, now, 17); err != nil {
+		t.Fatal(err)
+	}
+	p := payment(byBlocks.ID)
+	if p.NextHeight != 25 || p.LastError == "" || p.Executions != 0 || p.Paused {
+		t.Errorf("unexpected skipped payment %+v", p)
+	}
+	select {
+	case ntfn := <-n.C:
+		if ntfn.Payment.ID != byBlocks.ID || ntfn.Payment.LastError == "" {
+			t.Errorf("unexpected notification %+v", ntfn.Payment)
+		}
+	default:
+		t.Errorf("no notification of the failed payment")
+	}
+
+	// A failed payment pauses a payment with the pause failure policy.
+	if err := w.sendRecurringDue(ctx, byTime.NextTime, 17); err != nil {
+		t.Fatal(err)
+	}
+	if p := payment(byTime.ID); !p.Paused || !p.NextTime.Equal(byTime.NextTime) {
+		t.Errorf("unexpected paused payment %+v", p)
+	}
+
+	if err := w.PauseRecurringPayment(ctx, byBlocks.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.sendRecurringDue(ctx, now, 30); err != nil {
+		t.Fatal(err)
+	}
+	if p := payment(byBlocks.ID); p.NextHeight != 25 || !p.Paused {
+		t.Errorf("paused payment was attempted: %+v", p)
+	}
+	if err := w.ResumeRecurringPayment(ctx, byBlocks.ID); err != nil {
+		t.Fatal(err)
+	}
+	if p := payment(byBlocks.ID); p.Paused || p.LastError != "" {
+		t.Errorf("unexpected resumed payment %+v", p)
+	}
+
+	if err := w.RemoveRecurringPayment(ctx, byTime.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.ResumeRecurringPayment(ctx, byTime.ID); !errors.Is(err, errors.NotExist) {
+		t.Errorf("resuming removed payment: expected NotExist error, got %v", err)
+	}
+}
+
+func TestSkipMissedPayments(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(1000, 0)
+	tests := []struct {
+		p          udb.RecurringPayment
+		now        time.Time
+		tipHeight  int32
+		nextHeight int32
+		nextTime   time.Time
+	}{
+		{udb.RecurringPayment{IntervalBlocks: 10, NextHeight: 20}, start, 19, 20, time.Time{}},
+		{udb.RecurringPayment{IntervalBlocks: 10, NextHeight: 20}, start, 20, 30, time.Time{}},
+		{udb.RecurringPayment{IntervalBlocks: 10, NextHeight: 20}, start, 45, 50, time.Time{}},
+		{udb.RecurringPayment{Interval: time.Hour, NextTime: start}, start.Add(-time.Second), 0, 0, start},
+		{udb.RecurringPayment{Interval: time.Hour, NextTime: start}, start.Add(150 * time.Minute), 0, 0, start.Add(3 * time.Hour)},
+	}
+	for i, test := range tests {
+		p := test.p
+		skipMissedPayments(&p, test.now, test.tipHeight)
+		if p.NextHeight != test.nextHeight || !p.NextTime.Equal(test.nextTime) {
+			t.Errorf("test %d: next payment %d/%v, want %d/%v", i, p.NextHeight,
+				p.NextTime, test.nextHeight, test.nextTime)
+		}
+	}
+}
@@ -7,6 +7,7 @@ package wallet
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/monetarium/monetarium-wallet/errors"
@@ -20,6 +21,11 @@ import (
 
 const maxBlocksPerRescan = 2000
 
+// maxRescanBatchTxs is the number of relevant transactions accumulated in
+// memory during a rescan before they are saved ahead of the end of the range
+// of rescanned blocks.
+const maxRescanBatchTxs = 4096
+
 // RescanFilter implements a precise filter intended to hold all watched wallet
 // data in memory such as addresses and unspent outputs.  The zero value is not
 // valid, and filters must be created using NewRescanFilter.  RescanFilter is
@@ -208,7 +214,9 @@ func (w *Wallet) saveRescanned(ctx context.Context, dbtx walletdb.ReadWriteTx,
 // rescan synchronously scans over all blocks on the main chain starting at
 // startHash and height up through the recorded main chain tip block.  The
 // progress channel, if non-nil, is sent non-error progress notifications with
-// the heights the rescan has completed through, starting with the start height.
+// the heights the rescan has completed through, starting with the start height,
+// and the percentage of the blocks through the main chain tip which have been
+// rescanned.
 func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 	startHash *chainhash.Hash, height int32, p chan<- RescanProgress) error {
 
@@ -217,6 +225,53 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 	w.logRescannedTransactions = true
 	w.logRescannedTransactionsMu.Unlock()
 
+	// Record a checkpoint of the rescan so it resumes from the last rescanned
+	// block if interrupted.  Rescans resuming an interrupted rescan keep the
+	// start height and time of the existing checkpoint.
+	var tipHeight int32
+	checkpoint := &udb.RescanCheckpoint{
+		StartHeight: height,
+		Height:      max(height-1, 0),
+		Started:     time.Now(),
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, tipHeight = w.txStore.MainChainTip(dbtx)
+		c := udb.LastRescanCheckpoint(dbtx)
+		if c != nil && c.StartHeight <= height && height <= c.Height+1 {
+			checkpoint.StartHeight = c.StartHeight
+			checkpoint.Started = c.Started
+		}
+		checkpoint.Hash = *startHash
+		if height != 0 {
+			header, err := w.txStore.GetBlockHeader(dbtx, startHash)
+			if err != nil {
+				return err
+			}
+			checkpoint.Hash = header.PrevBlock
+		}
+		return udb.SetRescanCheckpoint(dbtx, checkpoint)
+	})
+	if err != nil {
+		return err
+	}
+	startHeight := checkpoint.StartHeight
+
+	state := &rescanState{
+		checkpoint:    *checkpoint,
+		sessionHeight: checkpoint.Height,
+		sessionStart:  time.Now(),
+	}
+	w.rescanStateMu.Lock()
+	w.rescanState = state
+	w.rescanStateMu.Unlock()
+	defer func() {
+		w.rescanStateMu.Lock()
+		if w.rescanState == state {
+			w.rescanState = nil
+		}
+		w.rescanStateMu.Unlock()
+	}()
+
 	blockHashStorage := make([]chainhash.Hash, maxBlocksPerRescan)
 	rescanFrom := *startHash
 	inclusive := true
@@ -228,7 +283,7 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 		}
 
 		var rescanBlocks []chainhash.Hash
-		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 			txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 			var err error
 			rescanBlocks, err = w.txStore.GetMainChainBlockHashes(txmgrNs,
@@ -251,18 +306,22 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 				break
 			}
 		}
-		log.Infof("Rescanning block range [%v, %v]...", height, through)
+		logCtx(ctx).Infof("Rescanning block range [%v, %v]...", height, through)
+
+		// Helper func to save batches of matching transactions.  When f is
+		// non-nil, it is called in the same database transaction after the
+		// batch is saved.
+		saveRescanned := func(blocks []*chainhash.Hash, txs [][]*wire.MsgTx,
+			f func(dbtx walletdb.ReadWriteTx) error) error {
 
-		// Helper func to save batches of matching transactions.
-		saveRescanned := func(blocks []*chainhash.Hash, txs [][]*wire.MsgTx) error {
 			if len(blocks) != len(txs) {
 				return errors.E(errors.Bug, "len(blocks) must match len(txs)")
 			}
-			if len(blocks) == 0 {
+			if len(blocks) == 0 && f == nil {
 				return nil
 			}
 
-			w.lockedOutpointMu.Lock()
+			w.lockOutpoints(ctx)
 			defer w.lockedOutpointMu.Unlock()
 
 			return walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
@@ -276,6 +335,9 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 					}
 				}
 
+				if f != nil {
+					return f(dbtx)
+				}
 				return nil
 			})
 		}
@@ -284,6 +346,10 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 		// transactions to process from the rescan.  This allows
 		// grouping the updates instead of potentially performing a
 		// single db update for every block in the rescanned range.
+		// Transactions are accumulated in memory for the entire range
+		// unless there are too many of them, and the final batch is saved
+		// together with the rescan checkpoint, so most block ranges are
+		// committed with a single database write.
 		type rescannedBlock struct {
 			blockHash *chainhash.Hash
 			txs       []*wire.MsgTx
@@ -292,7 +358,7 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 		ch := make(chan rescannedBlock, 1)
 		blockHashes := make([]*chainhash.Hash, 0, maxBlocksPerRescan)
 		txs := make([][]*wire.MsgTx, 0, maxBlocksPerRescan)
-		lastBatchErr := make(chan error)
+		batched := make(chan struct{})
 		go func() {
 			numTxs := 0
 			for item := range ch {
@@ -302,8 +368,8 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 				txs = append(txs, item.txs)
 				numTxs += len(item.txs)
 
-				if numTxs >= 256 { // XXX: tune this
-					err := saveRescanned(blockHashes, txs)
+				if numTxs >= maxRescanBatchTxs {
+					err := saveRescanned(blockHashes, txs, nil)
 					if err != nil {
 						errc <- err
 						return
@@ -317,7 +383,7 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 				errc <- nil
 			}
 
-			lastBatchErr <- saveRescanned(blockHashes, txs)
+			close(batched)
 		}()
 
 		err = n.Rescan(ctx, rescanBlocks, func(blockHash *chainhash.Hash, txs []*wire.MsgTx) error {
@@ -333,25 +399,39 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 		if err != nil {
 			return err
 		}
-		err = <-lastBatchErr
-		if err != nil {
-			return err
-		}
-		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
-			return w.txStore.UpdateProcessedTxsBlockMarker(dbtx, &rescanBlocks[len(rescanBlocks)-1])
+		<-batched
+		checkpoint.Hash = rescanBlocks[len(rescanBlocks)-1]
+		checkpoint.Height = through
+		err = saveRescanned(blockHashes, txs, func(dbtx walletdb.ReadWriteTx) error {
+			err := w.txStore.UpdateProcessedTxsBlockMarker(dbtx, &checkpoint.Hash)
+			if err != nil {
+				return err
+			}
+			return udb.SetRescanCheckpoint(dbtx, checkpoint)
 		})
 		if err != nil {
 			return err
 		}
+		w.rescanStateMu.Lock()
+		state.checkpoint = *checkpoint
+		w.rescanStateMu.Unlock()
 		if p != nil {
-			p <- RescanProgress{ScannedThrough: through}
+			p <- RescanProgress{
+				ScannedThrough: through,
+				Percent:        rescanPercent(startHeight, through, tipHeight),
+			}
 		}
 		rescanFrom = rescanBlocks[len(rescanBlocks)-1]
 		height += int32(len(rescanBlocks))
 		inclusive = false
 	}
 
-	log.Infof("Rescan complete")
+	err = walletdb.Update(ctx, w.db, udb.DeleteRescanCheckpoint)
+	if err != nil {
+		return err
+	}
+
+	logCtx(ctx).Infof("Rescan complete")
 	return nil
 }
 
@@ -406,10 +486,114 @@ func (w *Wallet) RescanFromHeight(ctx context.Context, n NetworkBackend, startHe
 }
 
 // RescanProgress records the height the rescan has completed through and any
-// errors during processing of the rescan.
+// errors during processing of the rescan.  Percent is the percentage of blocks
+// from the start height through the main chain tip, as recorded when the rescan
+// began, which have been rescanned.
 type RescanProgress struct {
 	Err            error
 	ScannedThrough int32
+	Percent        float64
+}
+
+// rescanPercent returns the percentage of the blocks in the range [start, tip]
+// which have been rescanned when the rescan has completed through height.
+func rescanPercent(start, through, tip int32) float64 {
+	if tip <= start || through >= tip {
+		return 100
+	}
+	return float64(through-start+1) * 100 / float64(tip-start+1)
+}
+
+// rescanState records the progress of the rescan performed by this process.
+// sessionHeight and sessionStart are the height rescanned through and the time
+// when this process began the rescan, and are used to estimate the time
+// remaining.
+type rescanState struct {
+	checkpoint    udb.RescanCheckpoint
+	sessionHeight int32
+	sessionStart  time.Time
+}
+
+// RescanStatus describes the progress of the wallet's rescan.  Active is true
+// while a rescan is being performed.  When no rescan is active, a rescan
+// interrupted before completing is described by its last checkpoint, and is
+// resumed the next time the wallet syncs.
+type RescanStatus struct {
+	Active         bool
+	StartHeight    int32
+	ScannedThrough int32
+	TipHeight      int32
+	Percent        float64
+
+	// ETA is the estimated time remaining until an active rescan
+	// completes, or zero when unknown.
+	ETA time.Duration
+}
+
+// RescanStatus returns the progress of the active rescan, or of the last
+// interrupted rescan when no rescan is active.  If no rescan is incomplete,
+// the status describes the block through which all transactions have been
+// processed.
+func (w *Wallet) RescanStatus(ctx context.Context) (*RescanStatus, error) {
+	const op errors.Op = "wallet.RescanStatus"
+
+	w.rescanStateMu.Lock()
+	var state *rescanState
+	if w.rescanState != nil {
+		s := *w.rescanState
+		state = &s
+	}
+	w.rescanStateMu.Unlock()
+
+	status := new(RescanStatus)
+	var checkpoint *udb.RescanCheckpoint
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		_, status.TipHeight = w.txStore.MainChainTip(dbtx)
+		if state != nil {
+			checkpoint = &state.checkpoint
+			return nil
+		}
+		checkpoint = udb.LastRescanCheckpoint(dbtx)
+		if checkpoint != nil {
+			return nil
+		}
+		marker, err := w.mainChainAncestor(dbtx, w.txStore.ProcessedTxsBlockMarker(dbtx))
+		if err != nil {
+			return err
+		}
+		header, err := w.txStore.GetBlockHeader(dbtx, marker)
+		if err != nil {
+			return err
+		}
+		status.ScannedThrough = int32(header.Height)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	if checkpoint == nil {
+		status.StartHeight = status.ScannedThrough
+		if status.ScannedThrough >= status.TipHeight {
+			status.Percent = 100
+		}
+		return status, nil
+	}
+
+	status.Active = state != nil
+	status.StartHeight = checkpoint.StartHeight
+	status.ScannedThrough = checkpoint.Height
+	status.Percent = rescanPercent(checkpoint.StartHeight, checkpoint.Height,
+		status.TipHeight)
+	if state != nil {
+		scanned := checkpoint.Height - state.sessionHeight
+		remaining := status.TipHeight - checkpoint.Height
+		if scanned > 0 && remaining > 0 {
+			elapsed := time.Since(state.sessionStart)
+			status.ETA = elapsed * time.Duration(remaining) / time.Duration(scanned)
+		}
+	}
+	return status, nil
 }
 
 // RescanProgressFromHeight rescans for relevant transactions in all blocks in
@@ -480,15 +664,30 @@ func (w *Wallet) rescanPoint(dbtx walletdb.ReadTx) (*chainhash.Hash, error) {
 	if err != nil {
 		return nil, err
 	}
-	if tipHash, _ := w.txStore.MainChainTip(dbtx); *r == tipHash {
-		return nil, nil
-	}
-	// r is not the tip, so a child block must exist in the main chain.
 	h, err := w.txStore.GetBlockHeader(dbtx, r)
 	if err != nil {
 		log.Info(err)
 		return nil, err
 	}
+	// Resume an interrupted rescan from its last rescanned block when it
+	// is behind the processed transactions marker.
+	if c := udb.LastRescanCheckpoint(dbtx); c != nil {
+		cr, err := w.mainChainAncestor(dbtx, &c.Hash)
+		if err != nil {
+			return nil, err
+		}
+		ch, err := w.txStore.GetBlockHeader(dbtx, cr)
+		if err != nil {
+			return nil, err
+		}
+		if ch.Height < h.Height {
+			r, h = cr, ch
+		}
+	}
+	if tipHash, _ := w.txStore.MainChainTip(dbtx); *r == tipHash {
+		return nil, nil
+	}
+	// r is not the tip, so a child block must exist in the main chain.
 	rescanPoint, err := w.txStore.GetMainChainBlockHashForHeight(ns, int32(h.Height)+1)
 	if err != nil {
 		log.Info(err)
@@ -521,3 +720,123 @@ func (w *Wallet) BirthState(ctx context.Context) (bs *udb.BirthdayState, err err
 	}
 	return bs, nil
 }
+
+// SetBirthday sets the wallet birthday from a block height or time, as chosen
+// by the SetFromHeight or SetFromTime field of bs.  A birthday already passed
+// by the main chain is resolved to its block immediately, while later
+// birthdays are resolved as the main chain is extended.
+//
+// When the resolved birthday block precedes the last block for which all
+// transactions have been processed, such as after restoring a wallet from an
+// older seed, the wallet discovers addresses and rescans from the birthday
+// block the next time it syncs.
+func (w *Wallet) SetBirthday(ctx context.Context, bs *udb.BirthdayState) error {
+	const op errors.Op = "wallet.SetBirthday"
+	if bs.SetFromHeight == bs.SetFromTime {
+		return errors.E(op, errors.Invalid, "birthday must be set from either a block height or time")
+	}
+	birthState := &udb.BirthdayState{
+		Height:        bs.Height,
+		Time:          bs.Time,
+		SetFromHeight: bs.SetFromHeight,
+		SetFromTime:   bs.SetFromTime,
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+
+		// As when the birthday is resolved while extending the main
+		// chain, the birthday block must not be the tip block.
+		height := int32(-1)
+		switch {
+		case birthState.SetFromHeight:
+			if int64(birthState.Height) < int64(tipHeight) {
+				height = int32(birthState.Height)
+			}
+		case birthState.SetFromTime:
+			// Search for the first block after the birthday time.
+			// The birthday block is its parent.
+			var err error
+			first := sort.Search(int(tipHeight), func(i int) bool {
+				if err != nil {
+					return true
+				}
+				var hash chainhash.Hash
+				hash, err = w.txStore.GetMainChainBlockHashForHeight(ns, int32(i+1))
+				if err != nil {
+					return true
+				}
+				var header *wire.BlockHeader
+				header, err = w.txStore.GetBlockHeader(dbtx, &hash)
+				if err != nil {
+					return true
+				}
+				return header.Timestamp.After(birthState.Time)
+			})
+			if err != nil {
+				return err
+			}
+			if first < int(tipHeight) {
+				height = int32(first)
+			}
+		}
+		if height == -1 {
+			return udb.SetBirthState(dbtx, birthState)
+		}
+
+		hash, err := w.txStore.GetMainChainBlockHashForHeight(ns, height)
+		if err != nil {
+			return err
+		}
+		birthState.Hash = hash
+		birthState.Height = uint32(height)
+		birthState.SetFromHeight = false
+		birthState.SetFromTime = false
+		if err := udb.SetBirthState(dbtx, birthState); err != nil {
+			return err
+		}
+		return w.txStore.RewindProcessedTxsBlockMarker(dbtx, &hash)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if birthState.SetFromHeight || birthState.SetFromTime {
+		logCtx(ctx).Infof("Wallet birthday will be set once the main chain passes it")
+	} else {
+		logCtx(ctx).Infof("Set wallet birthday to block %d (%v).", birthState.Height,
+			&birthState.Hash)
+	}
+	return nil
+}
+
+// BirthdayBlock returns the main chain block recorded as the wallet birthday
+// and its height.  Rescans and address discovery need not search blocks before
+// the birthday.  A nil hash is returned when the wallet has no birthday block,
+// including when its birthday has not yet been reached by the main chain.
+func (w *Wallet) BirthdayBlock(ctx context.Context) (*chainhash.Hash, int32, error) {
+	const op errors.Op = "wallet.BirthdayBlock"
+	var hash *chainhash.Hash
+	var height int32
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		bs := udb.BirthState(dbtx)
+		if bs == nil || bs.SetFromHeight || bs.SetFromTime {
+			return nil
+		}
+		// A birthday block which was reorged out of the main chain is
+		// replaced by its main chain ancestor.
+		h, err := w.mainChainAncestor(dbtx, &bs.Hash)
+		if err != nil {
+			return err
+		}
+		header, err := w.txStore.GetBlockHeader(dbtx, h)
+		if err != nil {
+			return err
+		}
+		hash, height = h, int32(header.Height)
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.E(op, err)
+	}
+	return hash, height, nil
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import "testing"
+
+func TestRescanPercent(t *testing.T) {
+	tests := []struct {
+		start, through, tip int32
+		want                float64
+	}{
+		{start: 1, through: 1, tip: 100, want: 1},
+		{start: 1, through: 50, tip: 100, want: 50},
+		{start: 101, through: 150, tip: 200, want: 50},
+		{start: 1, through: 100, tip: 100, want: 100},
+		// Blocks attached after the rescan began.
+		{start: 1, through: 120, tip: 100, want: 100},
+		// Rescans of only the tip block.
+		{start: 100, through: 100, tip: 100, want: 100},
+	}
+	for _, tc := range tests {
+		got := rescanPercent(tc.start, tc.through, tc.tip)
+		if got != tc.want {
+			t.Errorf("rescanPercent(%d, %d, %d) = %v, want %v",
+				tc.start, tc.through, tc.tip, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/monetarium/monetarium-node/crypto/rand"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// CreateRPCCredential records a credential authenticating RPC clients with a
+// set of scopes, and returns its randomly generated password.  Only a salted
+// hash of the password is recorded, so the password can not be recovered
+// after it is returned.
+func (w *Wallet) CreateRPCCredential(ctx context.Context, username string, scopes udb.RPCScopes) (string, error) {
+	const op errors.Op = "wallet.CreateRPCCredential"
+
+	var secret [32]byte
+	rand.Read(secret[:])
+	password := hex.EncodeToString(secret[:])
+
+	c := udb.NewRPCCredential(username, []byte(password), scopes, time.Now())
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutRPCCredential(dbtx, c)
+	})
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+	return password, nil
+}
+
+// RPCCredentials returns every recorded RPC credential, in username order.
+func (w *Wallet) RPCCredentials(ctx context.Context) ([]*udb.RPCCredential, error) {
+	const op errors.Op = "wallet.RPCCredentials"
+
+	var creds []*udb.RPCCredential
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachRPCCredential(dbtx, func(c *udb.RPCCredential) error {
+			creds = append(creds, c)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return creds, nil
+}
+
+// RevokeRPCCredential removes the RPC credential with a username.  Clients
+// may no longer authenticate with the credential, but connections which
+// were already authenticated are not closed.
+func (w *Wallet) RevokeRPCCredential(ctx context.Context, username string) error {
+	const op errors.Op = "wallet.RevokeRPCCredential"
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeleteRPCCredential(dbtx, username)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// AuthenticateRPC returns the scopes of the RPC credential with a username
+// and password.  An error with kind Passphrase is returned if no credential
+// has the username, or if the password is incorrect.
+func (w *Wallet) AuthenticateRPC(ctx context.Context, username, password string) (udb.RPCScopes, error) {
+	const op errors.Op = "wallet.AuthenticateRPC"
+
+	var c *udb.RPCCredential
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		c, err = udb.RPCCredentialByUsername(dbtx, username)
+		return err
+	})
+	if errors.Is(err, errors.NotExist) {
+		return 0, errors.E(op, errors.Passphrase, "invalid RPC credentials")
+	}
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	if !c.Verify([]byte(password)) {
+		return 0, errors.E(op, errors.Passphrase, "invalid RPC credentials")
+	}
+	return c.Scopes, nil
+}
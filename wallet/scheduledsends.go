@@ -0,0 +1,387 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/txrules"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// scheduledSendInterval is how often scheduled sends are checked for sends
+// whose trigger has been reached.
+const scheduledSendInterval = 30 * time.Second
+
+// ScheduleOptions specifies when a send scheduled with ScheduleSend is
+// published.
+type ScheduleOptions struct {
+	// NotBefore, when not zero, is the earliest time the send is published.
+	NotBefore time.Time
+
+	// NotBeforeHeight, when not zero, is the main chain tip height which
+	// must be reached before the send is published.
+	NotBeforeHeight int32
+
+	// PreSign authors and signs the transaction when the send is scheduled,
+	// so it may be published while the wallet is locked.  Pre-signed sends
+	// must expire, and their inputs are locked until the send is published,
+	// fails or is cancelled.
+	PreSign bool
+}
+
+// ScheduleSend queues a send of outputs from account to be published once
+// both triggers of sched are reached.  Unless pre-signed, the transaction is
+// authored and signed when triggered, subject to the send policy and daily
+// spend limits like SendOutputsWithOptions, and the send waits while the
+// wallet is locked.  Pre-signed sends are checked against the send policy when
+// scheduled, and may not be sent from accounts with a daily spend limit.
+//
+// Sends are checked for reached triggers periodically while the wallet runs,
+// and their status is reported by ScheduledSends.
+func (w *Wallet) ScheduleSend(ctx context.Context, outputs []*wire.TxOut,
+	account, changeAccount uint32, minconf int32, opts *SendOptions,
+	sched *ScheduleOptions) (*udb.ScheduledSend, error) {
+
+	const op errors.Op = "wallet.ScheduleSend"
+
+	switch {
+	case sched.NotBefore.IsZero() && sched.NotBeforeHeight <= 0:
+		return nil, errors.E(op, errors.Invalid, "scheduled send requires "+
+			"a time or block height")
+	case sched.NotBeforeHeight < 0:
+		return nil, errors.E(op, errors.Invalid, "negative block height")
+	case sched.PreSign && (opts == nil || opts.LockTimes.Expiry == 0 &&
+		opts.LockTimes.ExpireAfter == 0):
+		return nil, errors.E(op, errors.Invalid, "pre-signed scheduled "+
+			"sends require an expiry")
+	}
+
+	coinType := txrules.GetCoinTypeFromOutputs(outputs)
+	txFeeRate := w.RelayFeeForCoinType(ctx, coinType)
+	if opts != nil && opts.FeeRate != 0 {
+		txFeeRate = opts.FeeRate
+	}
+	for _, output := range outputs {
+		err := txrules.CheckOutput(output, txFeeRate)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	s := &udb.ScheduledSend{
+		Send: udb.PendingSend{
+			Account:       account,
+			ChangeAccount: changeAccount,
+			MinConf:       minconf,
+			CoinType:      coinType,
+			Outputs:       outputs,
+			Amount:        sendAmount(outputs),
+			Created:       time.Unix(time.Now().Unix(), 0),
+		},
+		NotBefore:       sched.NotBefore,
+		NotBeforeHeight: sched.NotBeforeHeight,
+	}
+	if !s.NotBefore.IsZero() {
+		s.NotBefore = time.Unix(s.NotBefore.Unix(), 0)
+	}
+	if opts != nil {
+		p := &s.Send
+		p.SubtractFeeFrom = opts.SubtractFeeFrom
+		p.Expiry = opts.LockTimes.Expiry
+		p.ExpireAfter = opts.LockTimes.ExpireAfter
+		p.LockTime = opts.LockTimes.LockTime
+		p.Label = opts.Label
+		p.FeeRate = int64(opts.FeeRate)
+	}
+
+	if !sched.PreSign {
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+			_, err := w.manager.AccountName(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+			return udb.PutScheduledSend(dbtx, s)
+		})
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		logCtx(ctx).Infof("Scheduled send %d from account %d", s.ID, account)
+		return s, nil
+	}
+
+	if err := w.checkSendPolicy(ctx, op, &s.Send); err != nil {
+		return nil, err
+	}
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		_, err := udb.SpendLimitFor(dbtx, account, coinType)
+		return err
+	})
+	switch {
+	case err == nil:
+		return nil, errors.E(op, errors.Policy, errors.Errorf("sends "+
+			"from account %d are limited and may not be pre-signed", account))
+	case !errors.Is(err, errors.NotExist):
+		return nil, errors.E(op, err)
+	}
+
+	a := &authorTx{
+		outputs:            s.Send.Outputs,
+		account:            account,
+		changeAccount:      changeAccount,
+		minconf:            minconf,
+		txFee:              txFeeRate,
+		callerFeeRate:      opts.FeeRate != 0,
+		randomizeChangeIdx: true,
+		splitChange:        true,
+		subtractFeeFrom:    s.Send.SubtractFeeFrom,
+		label:              s.Send.Label,
+		lockTimes:          opts.LockTimes,
+	}
+	err = w.authorTx(ctx, op, a)
+	if err != nil {
+		return nil, err
+	}
+	tx := a.atx.Tx
+	if sched.NotBeforeHeight > 0 && tx.Expiry <= uint32(sched.NotBeforeHeight) {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("transaction "+
+			"expires at height %d before it may be published", tx.Expiry))
+	}
+	s.SignedTx = tx
+
+	// Inputs are locked before the send is recorded so that they are not
+	// selected by other transactions authored in the meantime.  The change
+	// address is recorded as returned with the send.
+	w.lockScheduledInputs(tx)
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		for _, up := range a.changeSourceUpdates {
+			if err := up(dbtx); err != nil {
+				return err
+			}
+		}
+		return udb.PutScheduledSend(dbtx, s)
+	})
+	if err != nil {
+		w.unlockScheduledInputs(tx)
+		return nil, errors.E(op, err)
+	}
+	logCtx(ctx).Infof("Scheduled pre-signed send %d of transaction %v from account %d",
+		s.ID, tx.TxHash(), account)
+	return s, nil
+}
+
+// ScheduledSends returns every send scheduled with ScheduleSend, in increasing
+// ID order.
+func (w *Wallet) ScheduledSends(ctx context.Context) ([]udb.ScheduledSend, error) {
+	const op errors.Op = "wallet.ScheduledSends"
+
+	var sends []udb.ScheduledSend
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachScheduledSend(dbtx, func(s *udb.ScheduledSend) error {
+			sends = append(sends, *s)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return sends, nil
+}
+
+// CancelScheduledSend cancels a scheduled send which has not been triggered,
+// unlocking the inputs of a pre-signed send.
+func (w *Wallet) CancelScheduledSend(ctx context.Context, id uint32) error {
+	const op errors.Op = "wallet.CancelScheduledSend"
+
+	w.scheduledSendMu.Lock()
+	defer w.scheduledSendMu.Unlock()
+
+	var s *udb.ScheduledSend
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		s, err = udb.ScheduledSendByID(dbtx, id)
+		if err != nil {
+			return err
+		}
+		if s.Status != udb.ScheduledSendWaiting {
+			return errors.E(errors.Invalid, errors.Errorf("scheduled "+
+				"send %d is %v", id, s.Status))
+		}
+		s.Status = udb.ScheduledSendCancelled
+		return udb.PutScheduledSend(dbtx, s)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if s.SignedTx != nil {
+		w.unlockScheduledInputs(s.SignedTx)
+	}
+	return nil
+}
+
+// lockScheduledInputs locks the inputs of a pre-signed scheduled send.
+func (w *Wallet) lockScheduledInputs(tx *wire.MsgTx) {
+	w.lockedOutpointMu.Lock()
+	for _, in := range tx.TxIn {
+		prev := &in.PreviousOutPoint
+		w.lockedOutpoints[outpoint{prev.Hash, prev.Index}] = struct{}{}
+	}
+	w.lockedOutpointMu.Unlock()
+}
+
+// unlockScheduledInputs unlocks the inputs of a pre-signed scheduled send.
+func (w *Wallet) unlockScheduledInputs(tx *wire.MsgTx) {
+	w.lockedOutpointMu.Lock()
+	for _, in := range tx.TxIn {
+		prev := &in.PreviousOutPoint
+		delete(w.lockedOutpoints, outpoint{prev.Hash, prev.Index})
+	}
+	w.lockedOutpointMu.Unlock()
+}
+
+// sendScheduledDue publishes each waiting scheduled send whose triggers are
+// reached at now and tipHeight.  Sends which are not pre-signed keep waiting
+// while the wallet is locked.  Sends which can not be published are marked
+// failed.
+func (w *Wallet) sendScheduledDue(ctx context.Context, n NetworkBackend,
+	now time.Time, tipHeight int32) error {
+
+	const op errors.Op = "wallet.sendScheduledDue"
+
+	w.scheduledSendMu.Lock()
+	defer w.scheduledSendMu.Unlock()
+
+	var due []*udb.ScheduledSend
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachScheduledSend(dbtx, func(s *udb.ScheduledSend) error {
+			switch {
+			case s.Status != udb.ScheduledSendWaiting:
+			case !s.NotBefore.IsZero() && now.Before(s.NotBefore):
+			case tipHeight < s.NotBeforeHeight:
+			case s.SignedTx == nil && w.Locked():
+			default:
+				due = append(due, s)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	for _, s := range due {
+		var sendErr error
+		if s.SignedTx != nil {
+			sendErr = w.publishScheduled(ctx, n, s, tipHeight)
+		} else {
+			hash, err := w.sendWithinLimit(ctx, op, &s.Send)
+			if errors.Is(err, errors.Locked) {
+				continue
+			}
+			if err == nil {
+				s.TxHash = *hash
+			}
+			sendErr = err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		s.Status = udb.ScheduledSendSent
+		s.LastError = ""
+		if sendErr != nil {
+			logCtx(ctx).Warnf("Failed to publish scheduled send %d: %v", s.ID, sendErr)
+			s.Status = udb.ScheduledSendFailed
+			s.LastError = sendErr.Error()
+		} else {
+			logCtx(ctx).Infof("Published scheduled send %d as transaction %v",
+				s.ID, &s.TxHash)
+		}
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			return udb.PutScheduledSend(dbtx, s)
+		})
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+	return nil
+}
+
+// publishScheduled publishes the transaction of a pre-signed scheduled send
+// unless it has expired, evaluating the send policy with the label of the
+// send.  The inputs of sends which fail are unlocked.
+func (w *Wallet) publishScheduled(ctx context.Context, n NetworkBackend,
+	s *udb.ScheduledSend, tipHeight int32) error {
+
+	tx := s.SignedTx
+	if tx.Expiry != wire.NoExpiryValue && uint32(tipHeight)+1 >= tx.Expiry {
+		w.unlockScheduledInputs(tx)
+		return errors.E(errors.Invalid, errors.Errorf("transaction "+
+			"expired at height %d", tx.Expiry))
+	}
+	hash, err := w.publishLimited(ctx, tx, n, s.Send.Label)
+	w.unlockScheduledInputs(tx)
+	if err != nil {
+		return err
+	}
+	s.TxHash = *hash
+	if s.Send.Label != "" {
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			return udb.PutTxLabel(dbtx, hash, s.Send.Label)
+		})
+		if err != nil {
+			logCtx(ctx).Errorf("Failed to label transaction %v: %v", hash, err)
+		}
+	}
+	w.queueRebroadcast(ctx, hash)
+	return nil
+}
+
+// scheduledSendLoop periodically publishes scheduled sends whose triggers are
+// reached, and the due payments of recurring payments, until the context is
+// cancelled.  The inputs of waiting pre-signed sends are locked when the loop
+// begins.  Checks are skipped while no network backend is associated with the
+// wallet.
+func (w *Wallet) scheduledSendLoop(ctx context.Context) error {
+	sends, err := w.ScheduledSends(ctx)
+	if err != nil {
+		logCtx(ctx).Errorf("Failed to load scheduled sends: %v", err)
+	}
+	for i := range sends {
+		s := &sends[i]
+		if s.Status == udb.ScheduledSendWaiting && s.SignedTx != nil {
+			w.lockScheduledInputs(s.SignedTx)
+		}
+	}
+
+	ticker := time.NewTicker(scheduledSendInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		n, err := w.NetworkBackend()
+		if err != nil {
+			continue
+		}
+		_, tipHeight := w.MainChainTip(ctx)
+		now := time.Now()
+		err = w.sendScheduledDue(ctx, n, now, tipHeight)
+		if err != nil && ctx.Err() == nil {
+			logCtx(ctx).Errorf("Failed to publish scheduled sends: %v", err)
+		}
+		err = w.sendRecurringDue(ctx, now, tipHeight)
+		if err != nil && ctx.Err() == nil {
+			logCtx(ctx).Errorf("Failed to send recurring payments: %v", err)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+)
+
+func TestScheduledSends(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vers, script := addr.PaymentScript()
+	outputs := []*wire.TxOut{{Value: 2e8, Version: vers, PkScript: script}}
+	schedule := func(opts *SendOptions, sched *ScheduleOptions) (*udb.ScheduledSend, error) {
+		return w.ScheduleSend(ctx, outputs, defaultAccount, defaultAccount, 1, opts, sched)
+	}
+
+	_, err = schedule(nil, &ScheduleOptions{})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("no trigger: expected Invalid error, got %v", err)
+	}
+	_, err = schedule(nil, &ScheduleOptions{NotBeforeHeight: 5, PreSign: true})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("pre-signed without expiry: expected Invalid error, got %v", err)
+	}
+	err = w.SetSpendLimit(ctx, defaultAccount, cointype.CoinTypeVAR, big.NewInt(1e9), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = schedule(&SendOptions{LockTimes: TxLockTimes{ExpireAfter: 20}},
+		&ScheduleOptions{NotBeforeHeight: 5, PreSign: true})
+	if !errors.Is(err, errors.Policy) {
+		t.Errorf("pre-signed from limited account: expected Policy error, got %v", err)
+	}
+
+	now := time.Now()
+	byHeight, err := schedule(&SendOptions{Label: "rent"}, &ScheduleOptions{NotBeforeHeight: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	byTime, err := schedule(nil, &ScheduleOptions{NotBefore: now.Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	status := func(id uint32) *udb.ScheduledSend {
+		t.Helper()
+		sends, err := w.ScheduledSends(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range sends {
+			if sends[i].ID == id {
+				return &sends[i]
+			}
+		}
+		t.Fatalf("scheduled send %d not found", id)
+		return nil
+	}
+
+	// Sends wait until their trigger is reached and the wallet is unlocked
+	// to author them.
+	if err := w.sendScheduledDue(ctx, mockNetwork{}, now, 5); err != nil {
+		t.Fatal(err)
+	}
+	if s := status(byHeight.ID); s.Status != udb.ScheduledSendWaiting {
+		t.Fatalf("send triggered while locked has status %v", s.Status)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.sendScheduledDue(ctx, mockNetwork{}, now, 4); err != nil {
+		t.Fatal(err)
+	}
+	if s := status(byHeight.ID); s.Status != udb.ScheduledSendWaiting {
+		t.Fatalf("send triggered before its height has status %v", s.Status)
+	}
+
+	// The unfunded wallet fails to author the triggered send.
+	if err := w.sendScheduledDue(ctx, mockNetwork{}, now, 5); err != nil {
+		t.Fatal(err)
+	}
+	s := status(byHeight.ID)
+	if s.Status != udb.ScheduledSendFailed || s.LastError == "" || s.Send.Label != "rent" {
+		t.Errorf("unexpected failed send %+v", s)
+	}
+	if s := status(byTime.ID); s.Status != udb.ScheduledSendWaiting {
+		t.Errorf("send triggered before its time has status %v", s.Status)
+	}
+
+	if err := w.CancelScheduledSend(ctx, byTime.ID); err != nil {
+		t.Fatal(err)
+	}
+	if s := status(byTime.ID); s.Status != udb.ScheduledSendCancelled {
+		t.Errorf("cancelled send has status %v", s.Status)
+	}
+	if err := w.CancelScheduledSend(ctx, byHeight.ID); !errors.Is(err, errors.Invalid) {
+		t.Errorf("cancelling failed send: expected Invalid error, got %v", err)
+	}
+	if err := w.CancelScheduledSend(ctx, 100); !errors.Is(err, errors.NotExist) {
+		t.Errorf("cancelling unknown send: expected NotExist error, got %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/walletseed"
+)
+
+// VerifySeedBackup checks that a mnemonic seed backup entered by the user
+// encodes the seed of the wallet.  The wallet does not record its seed, so the
+// backup is verified by deriving the account zero extended public key from it
+// and comparing it with the key of the wallet's first account.  Neither the
+// seed nor any private key is returned.
+//
+// When the words do not encode the wallet seed, the zero-based positions of
+// the words known to be incorrect are returned.  Invalid words are always
+// reported, and a single incorrect word is located by testing alternatives,
+// but positions may not be reported when several words are wrong.
+func (w *Wallet) VerifySeedBackup(ctx context.Context, words []string) (bool, []int, error) {
+	const op errors.Op = "wallet.VerifySeedBackup"
+
+	acctXpub, err := w.AccountXpub(ctx, 0)
+	if err != nil {
+		return false, nil, errors.E(op, err)
+	}
+	want := acctXpub.String()
+
+	match := func(seed []byte) bool {
+		ctLegacy, ctSLIP0044, acctLegacy, acctSLIP0044, err :=
+			udb.HDKeysFromSeed(seed, w.chainParams)
+		if err != nil {
+			return false
+		}
+		defer func() {
+			ctLegacy.Zero()
+			ctSLIP0044.Zero()
+			acctLegacy.Zero()
+			acctSLIP0044.Zero()
+		}()
+		return acctSLIP0044.Neuter().String() == want ||
+			acctLegacy.Neuter().String() == want
+	}
+
+	matches, incorrect, err := walletseed.VerifyMnemonic(words, match)
+	if err != nil {
+		return false, nil, errors.E(op, err)
+	}
+	return matches, incorrect, nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/monetarium/monetarium-wallet/pgpwordlist"
+	"github.com/monetarium/monetarium-wallet/walletseed"
+)
+
+func TestVerifySeedBackup(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	verify := func(words []string) (bool, []int) {
+		t.Helper()
+		matches, incorrect, err := w.VerifySeedBackup(ctx, words)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return matches, incorrect
+	}
+
+	words := walletseed.EncodeMnemonicSlice(seed)
+	if matches, incorrect := verify(words); !matches || len(incorrect) != 0 {
+		t.Errorf("correct backup: matches %v, incorrect words %v", matches, incorrect)
+	}
+
+	words[7] = pgpwordlist.ByteToMnemonic(seed[7]+1, 7)
+	if matches, incorrect := verify(words); matches || len(incorrect) != 1 || incorrect[0] != 7 {
+		t.Errorf("mistyped backup: matches %v, incorrect words %v", matches, incorrect)
+	}
+
+	other := make([]byte, len(seed))
+	words = walletseed.EncodeMnemonicSlice(other)
+	if matches, incorrect := verify(words); matches || len(incorrect) != 0 {
+		t.Errorf("other seed: matches %v, incorrect words %v", matches, incorrect)
+	}
+}
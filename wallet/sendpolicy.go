@@ -0,0 +1,151 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// BlockAddress adds an address to the send policy blocklist.  Sends paying
+// a blocked address are refused with an error of kind Policy which includes
+// the reason.
+func (w *Wallet) BlockAddress(ctx context.Context, addr stdaddr.Address, reason string) error {
+	const op errors.Op = "wallet.BlockAddress"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutBlockedAddress(dbtx, addr.String(), reason)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// UnblockAddress removes an address from the send policy blocklist.
+func (w *Wallet) UnblockAddress(ctx context.Context, addr stdaddr.Address) error {
+	const op errors.Op = "wallet.UnblockAddress"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeleteBlockedAddress(dbtx, addr.String())
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// SetLabelThreshold requires sends of at least threshold atoms of a coin type
+// to be labeled.  Unlabeled sends are refused with an error of kind Policy.
+// A nil or zero threshold removes the requirement.
+func (w *Wallet) SetLabelThreshold(ctx context.Context, coinType cointype.CoinType,
+	threshold *big.Int) error {
+
+	const op errors.Op = "wallet.SetLabelThreshold"
+	if !coinType.IsValid() {
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("invalid coin type %d", coinType))
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutLabelThreshold(dbtx, coinType, threshold)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// SetSKASendAccounts restricts sends of an SKA coin type to the accounts.
+// Sends from other accounts are refused with an error of kind Policy.  No
+// accounts allows every account to send the coin type.
+func (w *Wallet) SetSKASendAccounts(ctx context.Context, coinType cointype.CoinType,
+	accounts []uint32) error {
+
+	const op errors.Op = "wallet.SetSKASendAccounts"
+	if !coinType.IsSKA() {
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("coin type %d is not an SKA coin type", coinType))
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		for _, account := range accounts {
+			_, err := w.manager.AccountName(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+		}
+		return udb.PutSKASendAccounts(dbtx, coinType, accounts)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// SendPolicy returns the policy evaluated before the wallet publishes a send.
+func (w *Wallet) SendPolicy(ctx context.Context) (*udb.SendPolicy, error) {
+	const op errors.Op = "wallet.SendPolicy"
+	var policy *udb.SendPolicy
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		policy, err = udb.FetchSendPolicy(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return policy, nil
+}
+
+// checkSendPolicy returns an error with kind Policy if the send policy denies
+// the send described by p.
+func (w *Wallet) checkSendPolicy(ctx context.Context, op errors.Op, p *udb.PendingSend) error {
+	policy, err := w.SendPolicy(ctx)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	for _, output := range p.Outputs {
+		_, addrs := stdscript.ExtractAddrs(output.Version, output.PkScript,
+			w.chainParams)
+		for _, addr := range addrs {
+			reason, ok := policy.Blocklist[addr.String()]
+			if !ok {
+				continue
+			}
+			if reason == "" {
+				return errors.E(op, errors.Policy, errors.Errorf("send "+
+					"policy blocks sends to address %v", addr))
+			}
+			return errors.E(op, errors.Policy, errors.Errorf("send "+
+				"policy blocks sends to address %v: %s", addr, reason))
+		}
+	}
+
+	threshold := policy.LabelThresholds[p.CoinType]
+	if threshold != nil && p.Label == "" && p.Amount != nil &&
+		p.Amount.Cmp(threshold) >= 0 {
+		return errors.E(op, errors.Policy, errors.Errorf("send policy "+
+			"requires sends of at least %v atoms of coin type %d to be "+
+			"labeled", threshold, p.CoinType))
+	}
+
+	if accounts, ok := policy.SKASendAccounts[p.CoinType]; ok {
+		for _, account := range accounts {
+			if account == p.Account {
+				return nil
+			}
+		}
+		return errors.E(op, errors.Policy, errors.Errorf("send policy "+
+			"does not allow account %d to send coin type %d", p.Account,
+			p.CoinType))
+	}
+	return nil
+}
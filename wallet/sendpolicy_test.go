@@ -0,0 +1,101 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+func TestSendPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vers, script := addr.PaymentScript()
+	outputs := []*wire.TxOut{{Value: 2e8, Version: vers, PkScript: script}}
+
+	// Sends to blocked addresses are denied before any transaction is
+	// authored, even when labeled.
+	if err := w.BlockAddress(ctx, addr, "sanctioned"); err != nil {
+		t.Fatal(err)
+	}
+	opts := &SendOptions{Label: "invoice 7"}
+	_, err = w.SendOutputsWithOptions(ctx, outputs, defaultAccount, defaultAccount, 1, opts)
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("send to blocked address: expected Policy error, got %v", err)
+	}
+	if err := w.UnblockAddress(ctx, addr); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unlabeled sends of at least the label threshold are denied.
+	err = w.SetLabelThreshold(ctx, cointype.CoinTypeVAR, big.NewInt(1e8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.SendOutputs(ctx, outputs, defaultAccount, defaultAccount, 1)
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("unlabeled send: expected Policy error, got %v", err)
+	}
+
+	// Denied sends are not recorded as pending sends awaiting approval.
+	err = w.SetSpendLimit(ctx, defaultAccount, cointype.CoinTypeVAR, big.NewInt(1e8), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.SendOutputs(ctx, outputs, defaultAccount, defaultAccount, 1)
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("unlabeled send: expected Policy error, got %v", err)
+	}
+	pending, err := w.PendingSends(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("denied send recorded %d pending sends", len(pending))
+	}
+
+	// Labeled sends pass the policy, and the label is kept with the send
+	// awaiting approval.
+	_, err = w.SendOutputsWithOptions(ctx, outputs, defaultAccount, defaultAccount, 1, opts)
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("send awaiting approval: expected Policy error, got %v", err)
+	}
+	pending, err = w.PendingSends(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || pending[0].Label != opts.Label {
+		t.Fatalf("unexpected pending sends %+v", pending)
+	}
+
+	policy, err := w.SendPolicy(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(policy.Blocklist) != 0 || len(policy.LabelThresholds) != 1 ||
+		policy.LabelThresholds[cointype.CoinTypeVAR].Int64() != 1e8 {
+		t.Fatalf("unexpected send policy %+v", policy)
+	}
+
+	err = w.SetSKASendAccounts(ctx, cointype.CoinTypeVAR, []uint32{defaultAccount})
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("restricting VAR sends: expected Invalid error, got %v", err)
+	}
+}
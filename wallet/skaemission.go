@@ -0,0 +1,338 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1/ecdsa"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+// skaEmissionMarker prefixes the signature script of the null input of SKA
+// emission transactions.
+var skaEmissionMarker = []byte{0x01, 'S', 'K', 'A'}
+
+// skaEmissionAuthVersion is the version of emission authorization scripts
+// encoding big.Int amounts.
+const skaEmissionAuthVersion = 0x03
+
+// CreateSKAEmission creates the emission transaction of an SKA coin type,
+// signed by the emission key stored by the wallet as keyName.  The emission
+// pays the governance-approved addresses and amounts of the coin type, which
+// must add up to its maximum supply, and must be created at a main chain
+// height within the emission window of the coin type.  The transaction has a
+// null input carrying the authorization script and pays no fee.  Nonce must be
+// unique for each emission of the coin type.  The emission key is private key
+// data and the wallet must be unlocked.
+func (w *Wallet) CreateSKAEmission(ctx context.Context, coinType cointype.CoinType,
+	keyName string, nonce uint64) (*wire.MsgTx, error) {
+
+	const op errors.Op = "wallet.CreateSKAEmission"
+
+	params := w.chainParams
+	skaConfig := params.SKACoins[coinType]
+	switch {
+	case !coinType.IsSKA():
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("coin type "+
+			"%d is not an SKA coin type", coinType))
+	case skaConfig == nil:
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("coin type "+
+			"%d is not configured in governance settings", coinType))
+	case !skaConfig.Active:
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("coin type "+
+			"%d is not active according to governance settings", coinType))
+	case len(skaConfig.EmissionAddresses) == 0:
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("no emission "+
+			"addresses configured for coin type %d", coinType))
+	case len(skaConfig.EmissionAddresses) != len(skaConfig.EmissionAmounts):
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("emission "+
+			"addresses and amounts length mismatch for coin type %d", coinType))
+	}
+	total := new(big.Int)
+	for _, amount := range skaConfig.EmissionAmounts {
+		if amount == nil || amount.Sign() <= 0 {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("invalid "+
+				"emission amount %v for coin type %d", amount, coinType))
+		}
+		total.Add(total, amount)
+	}
+	if total.Cmp(skaConfig.MaxSupply) != 0 {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("total "+
+			"emission amount %v does not match the maximum supply %v of "+
+			"coin type %d", total, skaConfig.MaxSupply, coinType))
+	}
+
+	privKey, err := w.RetrieveEmissionKey(ctx, keyName)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer privKey.Zero()
+	authorizedKey := params.GetSKAEmissionKey(coinType)
+	if authorizedKey == nil || !privKey.PubKey().IsEqual(authorizedKey) {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("emission key "+
+			"%s is not the governance-approved key of coin type %d",
+			keyName, coinType))
+	}
+
+	_, tipHeight := w.MainChainTip(ctx)
+	auth := &chaincfg.SKAEmissionAuth{
+		EmissionKey: privKey.PubKey(),
+		Nonce:       nonce,
+		CoinType:    coinType,
+		Amount:      total,
+		Height:      int64(tipHeight),
+		Timestamp:   time.Now().Unix(),
+	}
+	start := int64(skaConfig.EmissionHeight)
+	end := start + int64(skaConfig.EmissionWindow)
+	if auth.Height < start || auth.Height > end {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("height %d "+
+			"is outside the emission window [%d, %d] of coin type %d",
+			auth.Height, start, end, coinType))
+	}
+
+	// The emission expires with the emission window, so it is removed from
+	// mempools if it is not mined in time.
+	tx := &wire.MsgTx{
+		SerType: wire.TxSerializeFull,
+		Version: wire.TxVersion,
+		Expiry:  uint32(end),
+	}
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{},
+			wire.MaxPrevOutIndex, wire.TxTreeRegular),
+		Sequence:    wire.MaxTxInSequenceNum,
+		ValueIn:     wire.NullValueIn,
+		BlockHeight: wire.NullBlockHeight,
+		BlockIndex:  wire.NullBlockIndex,
+	})
+	for i, s := range skaConfig.EmissionAddresses {
+		addr, err := stdaddr.DecodeAddress(s, params)
+		if err != nil {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("invalid "+
+				"emission address %q: %v", s, err))
+		}
+		vers, script := addr.PaymentScript()
+		tx.AddTxOut(&wire.TxOut{
+			SKAValue: new(big.Int).Set(skaConfig.EmissionAmounts[i]),
+			CoinType: coinType,
+			Version:  vers,
+			PkScript: script,
+		})
+	}
+
+	// The signature commits to the transaction prefix, which excludes the
+	// signature script, so the outputs can not be redirected.
+	hash, err := skaEmissionSigHash(tx, auth, params)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	auth.Signature = ecdsa.Sign(privKey, hash[:]).Serialize()
+	tx.TxIn[0].SignatureScript, err = skaEmissionAuthScript(auth)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	if err := CheckSKAEmission(tx, params); err != nil {
+		return nil, errors.E(op, errors.Bug, err)
+	}
+	return tx, nil
+}
+
+// ParseSKAEmissionAuth parses the authorization script of the null input of
+// an SKA emission transaction.  The script is encoded as
+//
+//	[marker:4][version:1][nonce:8][coin type:1][amount length:1][amount]
+//	[height:8][pubkey:33][signature length:1][signature]
+//
+// with little endian integers and a big endian amount.  The Timestamp of the
+// returned authorization is not encoded by the script and is always zero.
+func ParseSKAEmissionAuth(script []byte) (*chaincfg.SKAEmissionAuth, error) {
+	const op errors.Op = "wallet.ParseSKAEmissionAuth"
+
+	r := bytes.NewReader(script)
+	next := func(n int) ([]byte, bool) {
+		if r.Len() < n {
+			return nil, false
+		}
+		b := make([]byte, n)
+		r.Read(b)
+		return b, true
+	}
+	short := errors.E(op, errors.Encoding, "short emission authorization script")
+
+	marker, ok := next(len(skaEmissionMarker) + 1)
+	if !ok {
+		return nil, short
+	}
+	if !bytes.Equal(marker[:4], skaEmissionMarker) {
+		return nil, errors.E(op, errors.Encoding, "missing SKA emission marker")
+	}
+	if marker[4] != skaEmissionAuthVersion {
+		return nil, errors.E(op, errors.Encoding, errors.Errorf("unknown "+
+			"emission authorization version %d", marker[4]))
+	}
+	fixed, ok := next(8 + 1 + 1)
+	if !ok {
+		return nil, short
+	}
+	auth := &chaincfg.SKAEmissionAuth{
+		Nonce:    binary.LittleEndian.Uint64(fixed),
+		CoinType: cointype.CoinType(fixed[8]),
+	}
+	amount, ok := next(int(fixed[9]))
+	if !ok {
+		return nil, short
+	}
+	auth.Amount = new(big.Int).SetBytes(amount)
+	height, ok := next(8)
+	if !ok {
+		return nil, short
+	}
+	auth.Height = int64(binary.LittleEndian.Uint64(height))
+	pubKey, ok := next(secp256k1.PubKeyBytesLenCompressed)
+	if !ok {
+		return nil, short
+	}
+	var err error
+	auth.EmissionKey, err = secp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	sigLen, ok := next(1)
+	if !ok {
+		return nil, short
+	}
+	auth.Signature, ok = next(int(sigLen[0]))
+	if !ok {
+		return nil, short
+	}
+	if r.Len() != 0 {
+		return nil, errors.E(op, errors.Encoding,
+			"trailing data after emission authorization")
+	}
+	return auth, nil
+}
+
+// CheckSKAEmission checks that tx is an SKA emission transaction authorized by
+// the governance-approved emission key of its coin type.  The authorization
+// must be signed by the key, cover the total amount of the outputs, all of
+// which must be of the authorized coin type, and be for a height within the
+// emission window of the coin type.  Nonces are not checked, as they depend on
+// previous emissions recorded by the blockchain.  Errors with code
+// errors.Invalid are returned for transactions which are not authorized
+// emissions.
+func CheckSKAEmission(tx *wire.MsgTx, params *chaincfg.Params) error {
+	const op errors.Op = "wallet.CheckSKAEmission"
+
+	if !wire.IsSKAEmissionTransaction(tx) {
+		return errors.E(op, errors.Invalid, "not an SKA emission transaction")
+	}
+	auth, err := ParseSKAEmissionAuth(tx.TxIn[0].SignatureScript)
+	if err != nil {
+		return errors.E(op, errors.Invalid, err)
+	}
+
+	authorizedKey := params.GetSKAEmissionKey(auth.CoinType)
+	if authorizedKey == nil || !auth.EmissionKey.IsEqual(authorizedKey) {
+		return errors.E(op, errors.Invalid, errors.Errorf("emission key is "+
+			"not authorized for coin type %d", auth.CoinType))
+	}
+	skaConfig := params.SKACoins[auth.CoinType]
+	start := int64(skaConfig.EmissionHeight)
+	end := start + int64(skaConfig.EmissionWindow)
+	if auth.Height < start || auth.Height > end {
+		return errors.E(op, errors.Invalid, errors.Errorf("authorized height "+
+			"%d is outside the emission window [%d, %d]", auth.Height, start, end))
+	}
+	total := new(big.Int)
+	for i, out := range tx.TxOut {
+		if out.CoinType != auth.CoinType || out.SKAValue == nil ||
+			out.SKAValue.Sign() <= 0 {
+			return errors.E(op, errors.Invalid, errors.Errorf("output %d "+
+				"is not a positive amount of coin type %d", i, auth.CoinType))
+		}
+		total.Add(total, out.SKAValue)
+	}
+	if total.Cmp(auth.Amount) != 0 {
+		return errors.E(op, errors.Invalid, errors.Errorf("outputs pay %v "+
+			"but %v is authorized", total, auth.Amount))
+	}
+
+	sig, err := ecdsa.ParseDERSignature(auth.Signature)
+	if err != nil {
+		return errors.E(op, errors.Invalid, err)
+	}
+	hash, err := skaEmissionSigHash(tx, auth, params)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if !sig.Verify(hash[:], auth.EmissionKey) {
+		return errors.E(op, errors.Invalid, "invalid emission authorization signature")
+	}
+	return nil
+}
+
+// skaEmissionSigHash returns the hash signed by the emission key to authorize
+// an SKA emission transaction.  It binds the signature to the network, the
+// coin type, nonce and height of the authorization, and the transaction
+// prefix, which includes the outputs.
+func skaEmissionSigHash(tx *wire.MsgTx, auth *chaincfg.SKAEmissionAuth,
+	params *chaincfg.Params) ([32]byte, error) {
+
+	prefix, err := tx.BytesPrefix()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	prefixHash := sha256.Sum256(prefix)
+
+	// "SKA-EMIT-V2" || net || coin type || nonce || height || prefix hash
+	var buf bytes.Buffer
+	buf.WriteString("SKA-EMIT-V2")
+	binary.Write(&buf, binary.LittleEndian, uint32(params.Net))
+	buf.WriteByte(byte(auth.CoinType))
+	binary.Write(&buf, binary.LittleEndian, auth.Nonce)
+	binary.Write(&buf, binary.LittleEndian, uint64(auth.Height))
+	buf.Write(prefixHash[:])
+	return sha256.Sum256(buf.Bytes()), nil
+}
+
+// skaEmissionAuthScript returns the authorization script of the null input of
+// an SKA emission transaction, in the format parsed by ParseSKAEmissionAuth.
+func skaEmissionAuthScript(auth *chaincfg.SKAEmissionAuth) ([]byte, error) {
+	amount := auth.Amount.Bytes()
+	if len(amount) > 255 {
+		return nil, errors.E(errors.Invalid, errors.Errorf("emission amount "+
+			"of %d bytes is too large", len(amount)))
+	}
+	if len(auth.Signature) > 255 {
+		return nil, errors.E(errors.Invalid, "emission signature is too large")
+	}
+
+	var script bytes.Buffer
+	script.Write(skaEmissionMarker)
+	script.WriteByte(skaEmissionAuthVersion)
+	binary.Write(&script, binary.LittleEndian, auth.Nonce)
+	script.WriteByte(byte(auth.CoinType))
+	script.WriteByte(byte(len(amount)))
+	script.Write(amount)
+	binary.Write(&script, binary.LittleEndian, uint64(auth.Height))
+	script.Write(auth.EmissionKey.SerializeCompressed())
+	script.WriteByte(byte(len(auth.Signature)))
+	script.Write(auth.Signature)
+	return script.Bytes(), nil
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestCreateSKAEmission(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// Open the emission window of SKA-1 at the genesis block of the test
+	// wallet.
+	params := *chaincfg.SimNetParams()
+	params.SKACoins = make(map[cointype.CoinType]*chaincfg.SKACoinConfig)
+	for k, v := range chaincfg.SimNetParams().SKACoins {
+		c := *v
+		params.SKACoins[k] = &c
+	}
+	params.SKACoins[1].EmissionHeight = 0
+
+	cfg := basicWalletConfig
+	cfg.Params = &params
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The simnet SKA-1 emission key is the public key of private key 3.
+	err := w.StoreEmissionKey(ctx, "emitter", secp256k1.PrivKeyFromBytes([]byte{3}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.StoreEmissionKey(ctx, "other", secp256k1.PrivKeyFromBytes([]byte{4}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invalid := []struct {
+		name     string
+		coinType cointype.CoinType
+		key      string
+	}{
+		{"VAR", cointype.CoinTypeVAR, "emitter"},
+		{"unconfigured coin type", 3, "emitter"},
+		{"inactive coin type", 2, "emitter"},
+		{"unapproved key", 1, "other"},
+	}
+	for _, test := range invalid {
+		_, err := w.CreateSKAEmission(ctx, test.coinType, test.key, 1)
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("%s: expected Invalid error, got %v", test.name, err)
+		}
+	}
+	_, err = w.CreateSKAEmission(ctx, 1, "missing", 1)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("missing key: expected NotExist error, got %v", err)
+	}
+
+	tx, err := w.CreateSKAEmission(ctx, 1, "emitter", 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !wire.IsSKAEmissionTransaction(tx) {
+		t.Fatal("created transaction is not an SKA emission")
+	}
+	if err := CheckSKAEmission(tx, &params); err != nil {
+		t.Fatal(err)
+	}
+	auth, err := ParseSKAEmissionAuth(tx.TxIn[0].SignatureScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth.Nonce != 7 || auth.CoinType != 1 || auth.Height != 0 ||
+		auth.Amount.Cmp(params.SKACoins[1].MaxSupply) != 0 {
+		t.Errorf("unexpected authorization %+v", auth)
+	}
+
+	// Changing the outputs invalidates the authorization.
+	redirected := tx.Copy()
+	redirected.TxOut[0].PkScript[3] ^= 1
+	if err := CheckSKAEmission(redirected, &params); !errors.Is(err, errors.Invalid) {
+		t.Errorf("redirected emission: expected Invalid error, got %v", err)
+	}
+	inflated := tx.Copy()
+	inflated.TxOut[0].SKAValue = new(big.Int).Lsh(inflated.TxOut[0].SKAValue, 1)
+	if err := CheckSKAEmission(inflated, &params); !errors.Is(err, errors.Invalid) {
+		t.Errorf("inflated emission: expected Invalid error, got %v", err)
+	}
+
+	// Emissions are only valid within the emission window.
+	if err := CheckSKAEmission(tx, chaincfg.SimNetParams()); !errors.Is(err, errors.Invalid) {
+		t.Errorf("emission outside window: expected Invalid error, got %v", err)
+	}
+
+	truncated := tx.TxIn[0].SignatureScript[:40]
+	if _, err := ParseSKAEmissionAuth(truncated); !errors.Is(err, errors.Encoding) {
+		t.Errorf("truncated script: expected Encoding error, got %v", err)
+	}
+
+	// Mined emissions are recorded for supply tracking.
+	rec, err := udb.NewTxRecordFromMsgTx(tx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		meta := &udb.BlockMeta{Block: udb.Block{Height: 5}}
+		if err := w.recordSKAEmission(dbtx, rec, meta); err != nil {
+			return err
+		}
+		// Other transactions are ignored.
+		other := redirected.Copy()
+		other.TxIn[0].PreviousOutPoint.Index = 0
+		return w.recordSKAEmission(dbtx, &udb.TxRecord{MsgTx: *other}, meta)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	history, err := w.SKAEmissionHistory(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].Hash != tx.TxHash() || history[0].Height != 5 ||
+		!bytes.Equal(history[0].EmitterScript, tx.TxIn[0].SignatureScript) {
+		t.Fatalf("unexpected emission history %+v", history)
+	}
+	supply, err := w.SKASupply(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range supply {
+		switch s.CoinType {
+		case 1:
+			if s.Emitted.Cmp(params.SKACoins[1].MaxSupply) != 0 ||
+				s.Emissions != 1 || s.LastHeight != 5 {
+				t.Errorf("unexpected SKA-1 supply %+v", s)
+			}
+		default:
+			if s.Emitted.Sign() != 0 || s.Emissions != 0 || s.LastHeight != -1 {
+				t.Errorf("unexpected SKA-%d supply %+v", s.CoinType, s)
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"slices"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// recordSKAEmission records a mined SKA emission transaction.  The emission
+// has been validated by consensus, so the coin type and amount are read from
+// its outputs.  Only emissions relevant to the wallet, which pay or are
+// watched by the wallet, are observed during block processing.
+func (w *Wallet) recordSKAEmission(dbtx walletdb.ReadWriteTx, rec *udb.TxRecord,
+	blockMeta *udb.BlockMeta) error {
+
+	tx := &rec.MsgTx
+	if !wire.IsSKAEmissionTransaction(tx) {
+		return nil
+	}
+	ct := tx.TxOut[0].CoinType
+	amount := new(big.Int)
+	for _, out := range tx.TxOut {
+		if out.CoinType == ct && out.SKAValue != nil {
+			amount.Add(amount, out.SKAValue)
+		}
+	}
+	return udb.PutSKAEmission(dbtx, &udb.SKAEmission{
+		Height:        blockMeta.Height,
+		Hash:          rec.Hash,
+		CoinType:      ct,
+		Amount:        amount,
+		EmitterScript: tx.TxIn[0].SignatureScript,
+	})
+}
+
+// SKAEmissionHistory returns the SKA emissions observed by the wallet in main
+// chain blocks in increasing block height order.  When coinType is not nil,
+// only emissions of that coin type are returned.
+func (w *Wallet) SKAEmissionHistory(ctx context.Context,
+	coinType *cointype.CoinType) ([]udb.SKAEmission, error) {
+
+	const op errors.Op = "wallet.SKAEmissionHistory"
+
+	var res []udb.SKAEmission
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachSKAEmission(dbtx, func(e *udb.SKAEmission) error {
+			if coinType == nil || e.CoinType == *coinType {
+				res = append(res, *e)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return res, nil
+}
+
+// SKASupply describes the supply of an SKA coin type, in atoms of the coin
+// type.  MaxSupply is the maximum supply set by the network's governance
+// parameters, or nil for coin types the parameters do not configure.
+// Emitted totals the emissions observed by the wallet, and LastHeight is the
+// block height of the latest of them, or -1 when none were observed.
+type SKASupply struct {
+	CoinType   cointype.CoinType
+	MaxSupply  *big.Int
+	Emitted    *big.Int
+	Emissions  uint32
+	LastHeight int32
+}
+
+// SKASupply returns the supply of each SKA coin type configured by the
+// network parameters or with observed emissions, in increasing coin type
+// order.
+func (w *Wallet) SKASupply(ctx context.Context) ([]SKASupply, error) {
+	const op errors.Op = "wallet.SKASupply"
+
+	byCoinType := make(map[cointype.CoinType]*SKASupply)
+	supply := func(ct cointype.CoinType) *SKASupply {
+		s := byCoinType[ct]
+		if s == nil {
+			s = &SKASupply{CoinType: ct, Emitted: new(big.Int), LastHeight: -1}
+			if c := w.chainParams.SKACoins[ct]; c != nil && c.MaxSupply != nil {
+				s.MaxSupply = new(big.Int).Set(c.MaxSupply)
+			}
+			byCoinType[ct] = s
+		}
+		return s
+	}
+	for ct := range w.chainParams.SKACoins {
+		supply(ct)
+	}
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachSKAEmission(dbtx, func(e *udb.SKAEmission) error {
+			s := supply(e.CoinType)
+			s.Emitted.Add(s.Emitted, e.Amount)
+			s.Emissions++
+			s.LastHeight = e.Height
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	res := make([]SKASupply, 0, len(byCoinType))
+	for _, s := range byCoinType {
+		res = append(res, *s)
+	}
+	slices.SortFunc(res, func(a, b SKASupply) int {
+		return int(a.CoinType) - int(b.CoinType)
+	})
+	return res, nil
+}
@@ -0,0 +1,471 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// SetSpendLimit limits the amount of a coin type, in atoms, which an account
+// may send each day, with days beginning at midnight UTC.  Sends exceeding the
+// limit are refused with an error of kind Policy.  When requireApproval is
+// set, they are instead recorded as pending sends, which are authored and
+// published only once approved with ApprovePendingSend.  A nil or zero limit
+// removes the limit.  The amount already spent on the current day is kept
+// when a limit is replaced.
+func (w *Wallet) SetSpendLimit(ctx context.Context, account uint32, coinType cointype.CoinType,
+	limit *big.Int, requireApproval bool) error {
+
+	const op errors.Op = "wallet.SetSpendLimit"
+
+	switch {
+	case !coinType.IsValid():
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("invalid coin type %d", coinType))
+	case limit != nil && limit.Sign() < 0:
+		return errors.E(op, errors.Invalid, "spend limit may not be negative")
+	}
+
+	w.spendLimitMu.Lock()
+	defer w.spendLimitMu.Unlock()
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := w.manager.AccountName(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+		if limit == nil || limit.Sign() == 0 {
+			return udb.DeleteSpendLimit(dbtx, account, coinType)
+		}
+		l, err := udb.SpendLimitFor(dbtx, account, coinType)
+		switch {
+		case errors.Is(err, errors.NotExist):
+			l = &udb.SpendLimit{
+				Account:  account,
+				CoinType: coinType,
+			}
+		case err != nil:
+			return err
+		}
+		l.Limit = new(big.Int).Set(limit)
+		l.RequireApproval = requireApproval
+		return udb.PutSpendLimit(dbtx, l)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// SpendLimits returns every daily spend limit set with SetSpendLimit, in
+// increasing account and coin type order.
+func (w *Wallet) SpendLimits(ctx context.Context) ([]udb.SpendLimit, error) {
+	const op errors.Op = "wallet.SpendLimits"
+
+	var limits []udb.SpendLimit
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachSpendLimit(dbtx, func(l *udb.SpendLimit) error {
+			limits = append(limits, *l)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return limits, nil
+}
+
+// PendingSends returns every send awaiting approval after exceeding the daily
+// spend limit of its account, in increasing ID order.
+func (w *Wallet) PendingSends(ctx context.Context) ([]udb.PendingSend, error) {
+	const op errors.Op = "wallet.PendingSends"
+
+	var sends []udb.PendingSend
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachPendingSend(dbtx, func(p *udb.PendingSend) error {
+			sends = append(sends, *p)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return sends, nil
+}
+
+// ApprovePendingSend authors, signs and publishes a pending send, regardless
+// of the daily spend limit of its account.  The amount sent is still counted
+// against the limit.  The pending send is removed once it is published.
+func (w *Wallet) ApprovePendingSend(ctx context.Context, id uint32) (*chainhash.Hash, error) {
+	const op errors.Op = "wallet.ApprovePendingSend"
+
+	w.spendLimitMu.Lock()
+	defer w.spendLimitMu.Unlock()
+
+	var p *udb.PendingSend
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		p, err = udb.PendingSendByID(dbtx, id)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	hash, err := w.limitedSend(ctx, op, p, true)
+	if err != nil {
+		return nil, err
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeletePendingSend(dbtx, id)
+	})
+	if err != nil {
+		logCtx(ctx).Errorf("Failed to remove approved pending send %d: %v", id, err)
+	}
+	logCtx(ctx).Infof("Published approved pending send %d as transaction %v", id, hash)
+	return hash, nil
+}
+
+// RejectPendingSend removes a pending send without sending it.
+func (w *Wallet) RejectPendingSend(ctx context.Context, id uint32) error {
+	const op errors.Op = "wallet.RejectPendingSend"
+
+	w.spendLimitMu.Lock()
+	defer w.spendLimitMu.Unlock()
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := udb.PendingSendByID(dbtx, id)
+		if err != nil {
+			return err
+		}
+		return udb.DeletePendingSend(dbtx, id)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// outputAmount returns the value of an output in atoms of its coin type.
+func outputAmount(output *wire.TxOut) *big.Int {
+	if output.CoinType.IsSKA() && output.SKAValue != nil {
+		return new(big.Int).Set(output.SKAValue)
+	}
+	return big.NewInt(output.Value)
+}
+
+// sendAmount returns the total value of outputs, which is counted against the
+// daily spend limit of the sending account.
+func sendAmount(outputs []*wire.TxOut) *big.Int {
+	amount := new(big.Int)
+	for _, output := range outputs {
+		amount.Add(amount, outputAmount(output))
+	}
+	return amount
+}
+
+// sendWithinLimit authors, signs and publishes the send described by p if it
+// does not exceed the daily spend limit of its account.
+func (w *Wallet) sendWithinLimit(ctx context.Context, op errors.Op,
+	p *udb.PendingSend) (*chainhash.Hash, error) {
+
+	w.spendLimitMu.Lock()
+	defer w.spendLimitMu.Unlock()
+	return w.limitedSend(ctx, op, p, false)
+}
+
+// limitedSend authors, signs and publishes the send described by p if the send
+// policy allows it, counting the amount sent against the daily spend limit of
+// its account.  Unless the send was approved, a send exceeding the limit is
+// refused, or recorded as a pending send when the limit requires approval.
+// The spend limit mutex must be held.
+func (w *Wallet) limitedSend(ctx context.Context, op errors.Op, p *udb.PendingSend,
+	approved bool) (*chainhash.Hash, error) {
+
+	// The amount of a sweep is the spendable balance when it is sent.
+	if p.Sweep {
+		bal, err := w.AccountBalanceByCoinType(ctx, p.Account, p.CoinType, p.MinConf)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		p.Amount = big.NewInt(int64(bal.Spendable))
+		if p.CoinType.IsSKA() {
+			p.Amount = bal.SKASpendable.BigInt()
+		}
+	}
+
+	// The send policy is evaluated before the spend limit so that denied
+	// sends are never recorded as pending sends, and again when a pending
+	// send is approved.
+	if err := w.checkSendPolicy(ctx, op, p); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	limit, err := w.checkSpendLimit(ctx, op, p, now, approved)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := w.sendPending(ctx, op, p)
+	if err != nil {
+		return nil, err
+	}
+	if limit != nil {
+		w.countSpent(ctx, limit, now, p.Amount, hash)
+	}
+	return hash, nil
+}
+
+// checkSpendLimit returns the daily spend limit of the account and coin type of
+// p, or nil when the account may send the coin type without limit.  Unless the
+// send was approved, a send exceeding the limit is refused, or recorded as a
+// pending send when the limit requires approval.  The spend limit mutex must
+// be held.
+func (w *Wallet) checkSpendLimit(ctx context.Context, op errors.Op, p *udb.PendingSend,
+	now time.Time, approved bool) (*udb.SpendLimit, error) {
+
+	var limit *udb.SpendLimit
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		limit, err = udb.SpendLimitFor(dbtx, p.Account, p.CoinType)
+		return err
+	})
+	switch {
+	case errors.Is(err, errors.NotExist):
+		return nil, nil
+	case err != nil:
+		return nil, errors.E(op, err)
+	}
+
+	if approved || !limit.Exceeded(now, p.Amount) {
+		return limit, nil
+	}
+	if !limit.RequireApproval {
+		return nil, errors.E(op, errors.Policy, errors.Errorf("send "+
+			"exceeds the daily spend limit of account %d", p.Account))
+	}
+	p.Created = time.Unix(now.Unix(), 0)
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutPendingSend(dbtx, p)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	logCtx(ctx).Infof("Send of %v atoms from account %d exceeds its daily spend "+
+		"limit and awaits approval as pending send %d", p.Amount,
+		p.Account, p.ID)
+	return nil, errors.E(op, errors.Policy, errors.Errorf("send exceeds "+
+		"the daily spend limit of account %d and awaits approval as "+
+		"pending send %d", p.Account, p.ID))
+}
+
+// countSpent counts the amount sent by the published transaction hash against
+// a daily spend limit.
+func (w *Wallet) countSpent(ctx context.Context, limit *udb.SpendLimit, now time.Time,
+	amount *big.Int, hash *chainhash.Hash) {
+
+	limit.AddSpent(now, amount)
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutSpendLimit(dbtx, limit)
+	})
+	if err != nil {
+		logCtx(ctx).Errorf("Failed to count transaction %v against the daily spend "+
+			"limit of account %d: %v", hash, limit.Account, err)
+	}
+}
+
+// txSends describes the sends of a transaction spending outputs of wallet
+// accounts, one for each account it spends from.  The outputs of each send are
+// the transaction outputs not paying its account, and its amount is their
+// total value, and its label is the label recorded for the transaction, or
+// label when none is recorded.  Transactions already recorded by the wallet
+// were checked when they were first published, and have no sends.
+func (w *Wallet) txSends(dbtx walletdb.ReadTx, tx *wire.MsgTx, label string) ([]*udb.PendingSend, error) {
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+	txHash := tx.TxHash()
+	if w.txStore.ExistsTx(txmgrNs, &txHash) {
+		return nil, nil
+	}
+
+	accountOf := func(out *wire.TxOut) (uint32, bool) {
+		_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, w.chainParams)
+		for _, addr := range addrs {
+			account, err := w.manager.AddrAccount(addrmgrNs, addr)
+			if err == nil {
+				return account, true
+			}
+		}
+		return 0, false
+	}
+
+	var accounts []uint32
+	for _, in := range tx.TxIn {
+		prevOut := &in.PreviousOutPoint
+		if !w.txStore.ExistsUTXO(dbtx, prevOut) {
+			continue
+		}
+		prevTx, err := w.txStore.Tx(txmgrNs, &prevOut.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if int(prevOut.Index) >= len(prevTx.TxOut) {
+			continue
+		}
+		account, ok := accountOf(prevTx.TxOut[prevOut.Index])
+		if ok && !slices.Contains(accounts, account) {
+			accounts = append(accounts, account)
+		}
+	}
+
+	if l := udb.TxLabel(dbtx, &txHash); l != "" {
+		label = l
+	}
+	sends := make([]*udb.PendingSend, 0, len(accounts))
+	for _, account := range accounts {
+		p := &udb.PendingSend{
+			Account:       account,
+			ChangeAccount: account,
+			MinConf:       1,
+			CoinType:      txCoinType(tx),
+			Label:         label,
+		}
+		for _, out := range tx.TxOut {
+			if a, ok := accountOf(out); ok && a == account {
+				continue
+			}
+			p.Outputs = append(p.Outputs, out)
+		}
+		p.Amount = sendAmount(p.Outputs)
+		sends = append(sends, p)
+	}
+	return sends, nil
+}
+
+// publishLimited publishes a transaction which may spend outputs of wallet
+// accounts, such as one signed by signrawtransaction, if the send policy
+// allows its send from each account, counting the amount it sends from each
+// account against the daily spend limit of the account.  A transaction
+// exceeding a limit is refused, or recorded as a pending send of its outputs
+// when the limit requires approval.  Approving the pending send authors a new
+// transaction paying the same outputs.  The label is used by the send policy
+// when the transaction has no recorded label.
+func (w *Wallet) publishLimited(ctx context.Context, tx *wire.MsgTx,
+	n NetworkBackend, label string) (*chainhash.Hash, error) {
+
+	const opf = "wallet.PublishTransaction(%v)"
+	txHash := tx.TxHash()
+	op := errors.Opf(opf, &txHash)
+
+	w.spendLimitMu.Lock()
+	defer w.spendLimitMu.Unlock()
+
+	var sends []*udb.PendingSend
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		sends, err = w.txSends(dbtx, tx, label)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// As for sends authored by the wallet, the send policy is evaluated
+	// before any send is recorded as a pending send.
+	for _, p := range sends {
+		if err := w.checkSendPolicy(ctx, op, p); err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	limits := make([]*udb.SpendLimit, len(sends))
+	for i, p := range sends {
+		limits[i], err = w.checkSpendLimit(ctx, op, p, now, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hash, err := w.publishTransaction(ctx, tx, n)
+	if err != nil {
+		return nil, err
+	}
+	for i, limit := range limits {
+		if limit != nil {
+			w.countSpent(ctx, limit, now, sends[i].Amount, hash)
+		}
+	}
+	return hash, nil
+}
+
+// sendPending authors, signs and publishes the send described by p.  Sends
+// from vault accounts spend the vault outputs which have reached their relative
+// lock time.
+func (w *Wallet) sendPending(ctx context.Context, op errors.Op,
+	p *udb.PendingSend) (*chainhash.Hash, error) {
+
+	if w.isVaultAccount(p.Account) {
+		tx, err := w.sendFromVault(ctx, op, p.Account, p.CoinType, p.Outputs)
+		if err != nil {
+			return nil, err
+		}
+		hash := tx.TxHash()
+		return &hash, nil
+	}
+
+	a := &authorTx{
+		outputs:            p.Outputs,
+		account:            p.Account,
+		changeAccount:      p.ChangeAccount,
+		minconf:            p.MinConf,
+		randomizeChangeIdx: !p.Treasury && !p.Sweep,
+		splitChange:        !p.Treasury && !p.Sweep,
+		isTreasury:         p.Treasury,
+		sweep:              p.Sweep,
+		subtractFeeFrom:    p.SubtractFeeFrom,
+		label:              p.Label,
+		lockTimes: TxLockTimes{
+			Expiry:      p.Expiry,
+			ExpireAfter: p.ExpireAfter,
+			LockTime:    p.LockTime,
+		},
+	}
+	switch {
+	case p.FeeRate != 0:
+		a.txFee = dcrutil.Amount(p.FeeRate)
+		a.callerFeeRate = true
+	case p.Treasury:
+		a.txFee = w.RelayFee()
+	default:
+		a.txFee = w.RelayFeeForCoinType(ctx, p.CoinType)
+	}
+	err := w.authorTx(ctx, op, a)
+	if err != nil {
+		return nil, err
+	}
+	err = w.recordAuthoredTx(ctx, op, a)
+	if err != nil {
+		return nil, err
+	}
+	err = w.publishAndWatch(ctx, op, nil, a.atx.Tx, a.watch)
+	if err != nil {
+		return nil, err
+	}
+	hash := a.atx.Tx.TxHash()
+	return &hash, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+func TestSpendLimits(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vers, script := addr.PaymentScript()
+	outputs := []*wire.TxOut{{Value: 2e8, Version: vers, PkScript: script}}
+
+	// Sends exceeding a limit without approval are refused before any
+	// transaction is authored.
+	err = w.SetSpendLimit(ctx, defaultAccount, cointype.CoinTypeVAR, big.NewInt(1e8), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.SendOutputs(ctx, outputs, defaultAccount, defaultAccount, 1)
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("send exceeding limit: expected Policy error, got %v", err)
+	}
+	pending, err := w.PendingSends(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("refused send recorded %d pending sends", len(pending))
+	}
+
+	// Sends exceeding a limit requiring approval are recorded as pending.
+	err = w.SetSpendLimit(ctx, defaultAccount, cointype.CoinTypeVAR, big.NewInt(1e8), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.SendOutputs(ctx, outputs, defaultAccount, defaultAccount, 1)
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("send awaiting approval: expected Policy error, got %v", err)
+	}
+	pending, err = w.PendingSends(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || pending[0].Amount.Int64() != 2e8 ||
+		pending[0].Account != defaultAccount {
+		t.Fatalf("unexpected pending sends %+v", pending)
+	}
+
+	limits, err := w.SpendLimits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(limits) != 1 || !limits[0].RequireApproval ||
+		limits[0].Limit.Int64() != 1e8 {
+		t.Fatalf("unexpected spend limits %+v", limits)
+	}
+
+	if err := w.RejectPendingSend(ctx, pending[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	err = w.RejectPendingSend(ctx, pending[0].ID)
+	if !errors.Is(err, errors.NotExist) {
+		t.Fatalf("rejecting removed send: expected NotExist error, got %v", err)
+	}
+
+	// A zero limit removes the limit.
+	err = w.SetSpendLimit(ctx, defaultAccount, cointype.CoinTypeVAR, new(big.Int), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	limits, err = w.SpendLimits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(limits) != 0 {
+		t.Fatalf("limit was not removed: %+v", limits)
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// StakeEventType describes the stake event reported by a StakeEvent.
+type StakeEventType int8
+
+// Stake event types.
+const (
+	// StakeEventTicketPurchase reports a mined ticket purchased by the
+	// wallet.  The amount is the ticket price.
+	StakeEventTicketPurchase StakeEventType = iota
+
+	// StakeEventVote reports a mined vote of a wallet ticket.  The amount
+	// is the stakebase subsidy of the vote.
+	StakeEventVote
+
+	// StakeEventRevocation reports a mined revocation of a wallet ticket
+	// which missed its vote or expired.  Revocations have no amounts.
+	StakeEventRevocation
+
+	// StakeEventFeeRewardMatured reports the SSFee rewards credited to the
+	// wallet by a fee distribution reaching coinbase maturity and becoming
+	// spendable.  The amounts are the rewards of each coin type.
+	StakeEventFeeRewardMatured
+)
+
+var stakeEventTypeNames = [...]string{
+	StakeEventTicketPurchase:   "ticketpurchase",
+	StakeEventVote:             "vote",
+	StakeEventRevocation:       "revocation",
+	StakeEventFeeRewardMatured: "feerewardmatured",
+}
+
+// String returns the name of the stake event type.
+func (t StakeEventType) String() string {
+	if t < 0 || int(t) >= len(stakeEventTypeNames) {
+		return "unknown"
+	}
+	return stakeEventTypeNames[t]
+}
+
+// StakeEvent describes a stake transaction of the wallet.  Height is the
+// height of the block mining the transaction, and amounts are in atoms of each
+// coin type.
+type StakeEvent struct {
+	Type    StakeEventType
+	TxHash  chainhash.Hash
+	Height  int32
+	Expired bool // Only set for revocations of expired tickets
+	Amounts map[cointype.CoinType]*big.Int
+}
+
+// stakeEvents returns the stake events of blocks attached to the main chain
+// over the height range [forkHeight,tipHeight]: ticket purchases, votes and
+// revocations mined in the attached blocks, followed by fee distributions
+// which matured at the attached heights.
+func (w *Wallet) stakeEvents(ctx context.Context, forkHeight, tipHeight int32) ([]*StakeEvent, error) {
+	// Negative heights would include unmined transactions.
+	forkHeight = max(forkHeight, 0)
+
+	var events []*StakeEvent
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		ownTicket := func(rec *udb.TxRecord) bool {
+			return rec.TxType == stake.TxTypeSStx && w.txStore.OwnTicket(dbtx, &rec.Hash)
+		}
+		err := w.txStore.RangeFilteredTransactions(ctx, txmgrNs, forkHeight, tipHeight,
+			ownTicket, func(details []udb.TxDetails) (bool, error) {
+				for i := range details {
+					d := &details[i]
+					events = append(events, &StakeEvent{
+						Type:   StakeEventTicketPurchase,
+						TxHash: d.Hash,
+						Height: d.Height(),
+						Amounts: map[cointype.CoinType]*big.Int{
+							cointype.CoinTypeVAR: big.NewInt(d.MsgTx.TxOut[0].Value),
+						},
+					})
+				}
+				return false, nil
+			})
+		if err != nil {
+			return err
+		}
+
+		err = udb.ForEachStakeStat(dbtx, forkHeight, tipHeight, func(s *udb.StakeStat) error {
+			e := &StakeEvent{
+				TxHash:  s.Hash,
+				Height:  s.Height,
+				Amounts: s.Rewards,
+			}
+			switch s.Kind {
+			case udb.StakeStatVote:
+				e.Type = StakeEventVote
+			case udb.StakeStatMissed, udb.StakeStatExpired:
+				e.Type = StakeEventRevocation
+				e.Expired = s.Kind == udb.StakeStatExpired
+			default:
+				return nil
+			}
+			events = append(events, e)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// Fee distributions mature with the same maturity as coinbases.
+		maturity := int32(w.chainParams.CoinbaseMaturity)
+		return udb.ForEachStakeStat(dbtx, forkHeight-maturity, tipHeight-maturity,
+			func(s *udb.StakeStat) error {
+				if s.Kind != udb.StakeStatFeeReward {
+					return nil
+				}
+				events = append(events, &StakeEvent{
+					Type:    StakeEventFeeRewardMatured,
+					TxHash:  s.Hash,
+					Height:  s.Height,
+					Amounts: s.Rewards,
+				})
+				return nil
+			})
+	})
+	return events, err
+}
+
+// notifyStakeEvents sends the stake events of a main chain tip change to
+// stake event clients.  Events of blocks which are later detached are not
+// retracted, and the events of their replacements are sent when attached.  The
+// server mutex must be held.
+func (s *NotificationServer) notifyStakeEvents(n *MainTipChangedNotification) {
+	if len(s.stakeEventClients) == 0 || len(n.AttachedBlocks) == 0 {
+		return
+	}
+	forkHeight := n.NewHeight - int32(len(n.AttachedBlocks)) + 1
+	events, err := s.wallet.stakeEvents(context.Background(), forkHeight, n.NewHeight)
+	if err != nil {
+		log.Errorf("Cannot read stake events: %v", err)
+		return
+	}
+	for _, e := range events {
+		s.stakeEventClients = sendNotifications(s, s.stakeEventClients, e, nil)
+	}
+}
+
+// StakeEventNotificationsClient receives StakeEvents over the channel C.
+type StakeEventNotificationsClient struct {
+	C      chan *StakeEvent
+	server *NotificationServer
+}
+
+// StakeEventNotifications returns a client for receiving StakeEvents over a
+// channel as blocks are attached to the main chain.  The channel buffers the
+// server's backlog limit.  When finished, the client's Done method should be
+// called to disassociate the client from the server.
+func (s *NotificationServer) StakeEventNotifications() StakeEventNotificationsClient {
+	s.mu.Lock()
+	c := newClientChan[*StakeEvent](s)
+	s.stakeEventClients = append(s.stakeEventClients, c)
+	s.mu.Unlock()
+	return StakeEventNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *StakeEventNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.stakeEventClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.stakeEventClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+)
+
+// recordStakeStat records the outcome of a mined vote or revocation of a
+// wallet ticket, or the SSFee rewards credited by a mined fee distribution.
+// Other transactions are ignored.
+func (w *Wallet) recordStakeStat(dbtx walletdb.ReadWriteTx, rec *udb.TxRecord,
+	blockMeta *udb.BlockMeta) error {
+
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+	stat := &udb.StakeStat{
+		Height:  blockMeta.Height,
+		Hash:    rec.Hash,
+		Rewards: make(map[cointype.CoinType]*big.Int),
+	}
+	switch rec.TxType {
+	case stake.TxTypeSSGen:
+		ticketHash := &rec.MsgTx.TxIn[1].PreviousOutPoint.Hash
+		if !w.txStore.OwnTicket(dbtx, ticketHash) {
+			return nil
+		}
+		stat.Kind = udb.StakeStatVote
+		stat.Rewards[cointype.CoinTypeVAR] = big.NewInt(rec.MsgTx.TxIn[0].ValueIn)
+
+	case stake.TxTypeSSRtx:
+		ticketHash := &rec.MsgTx.TxIn[0].PreviousOutPoint.Hash
+		if !w.txStore.OwnTicket(dbtx, ticketHash) {
+			return nil
+		}
+		ticketHeight, err := w.txStore.TxBlockHeight(dbtx, ticketHash)
+		if err != nil {
+			return err
+		}
+		stat.Kind = udb.StakeStatMissed
+		if ticketExpired(w.chainParams, ticketHeight, blockMeta.Height) {
+			stat.Kind = udb.StakeStatExpired
+		}
+
+	case stake.TxTypeSSFee:
+		details, err := w.txStore.UniqueTxDetails(txmgrNs, &rec.Hash, &blockMeta.Block)
+		if err != nil || details == nil {
+			return err
+		}
+		// The value of any outputs spent by an augmented fee distribution
+		// to consolidate earlier rewards is subtracted, since those
+		// rewards were recorded when they were earned.
+		for _, c := range details.Credits {
+			reward := stat.Rewards[c.CoinType]
+			if reward == nil {
+				reward = new(big.Int)
+				stat.Rewards[c.CoinType] = reward
+			}
+			if c.CoinType.IsSKA() {
+				reward.Add(reward, c.SKAAmount.BigInt())
+			} else {
+				reward.Add(reward, big.NewInt(int64(c.Amount)))
+			}
+		}
+		for _, d := range details.Debits {
+			reward := stat.Rewards[d.CoinType]
+			if reward == nil {
+				continue
+			}
+			if d.CoinType.IsSKA() {
+				reward.Sub(reward, d.SKAAmount.BigInt())
+			} else {
+				reward.Sub(reward, big.NewInt(int64(d.Amount)))
+			}
+		}
+		for ct, reward := range stat.Rewards {
+			if reward.Sign() <= 0 {
+				delete(stat.Rewards, ct)
+			}
+		}
+		if len(stat.Rewards) == 0 {
+			return nil
+		}
+		stat.Kind = udb.StakeStatFeeReward
+		for ct, reward := range stat.Rewards {
+			ssfeeRewards.Inc(ct.String())
+			ssfeeRewardAtoms.Add(ct.String(), atomsFloat(reward))
+		}
+
+	default:
+		return nil
+	}
+
+	return udb.PutStakeStat(dbtx, stat)
+}
+
+// StakeStats describes the outcomes of the wallet's tickets and the stake
+// rewards earned by the wallet.  Votes, missed and expired tickets, and
+// rewards are counted from the stake transactions recorded as they were mined,
+// which include transactions mined after the wallet database was upgraded to
+// record them, or found by a later rescan.
+type StakeStats struct {
+	Height int32
+
+	// Counts of the wallet's current tickets, as reported by StakeInfo.
+	Live     uint32
+	Immature uint32
+	Revoked  uint32
+
+	// Counts of tickets which missed votes or expired, and the SSFee
+	// rewards earned by coin type, over the entire chain.
+	Missed     uint32
+	Expired    uint32
+	FeeRewards map[cointype.CoinType]*big.Int
+
+	// Votes, missed votes and the stakebase subsidy of votes mined in the
+	// last Window blocks.
+	Window        int32
+	WindowVotes   uint32
+	WindowMissed  uint32
+	WindowSubsidy dcrutil.Amount
+}
+
+// StakeStats returns statistics of the wallet's tickets and earned stake
+// rewards.  The vote statistics cover the last window blocks of the main
+// chain, or the entire chain if window is zero.
+func (w *Wallet) StakeStats(ctx context.Context, window int32) (*StakeStats, error) {
+	const op errors.Op = "wallet.StakeStats"
+
+	if window < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative window")
+	}
+
+	info, err := w.StakeInfo(ctx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	res := &StakeStats{
+		Height:     int32(info.BlockHeight),
+		Live:       info.Unspent - info.UnspentExpired,
+		Immature:   info.Immature,
+		Revoked:    info.Revoked,
+		FeeRewards: make(map[cointype.CoinType]*big.Int),
+		Window:     window,
+	}
+	windowStart := int32(0)
+	if window != 0 {
+		windowStart = res.Height - window + 1
+	}
+
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachStakeStat(dbtx, 0, res.Height, func(s *udb.StakeStat) error {
+			inWindow := s.Height >= windowStart
+			switch s.Kind {
+			case udb.StakeStatVote:
+				if inWindow {
+					res.WindowVotes++
+					subsidy := s.Rewards[cointype.CoinTypeVAR]
+					if subsidy != nil {
+						res.WindowSubsidy += dcrutil.Amount(subsidy.Int64())
+					}
+				}
+			case udb.StakeStatMissed:
+				res.Missed++
+				if inWindow {
+					res.WindowMissed++
+				}
+			case udb.StakeStatExpired:
+				res.Expired++
+			case udb.StakeStatFeeReward:
+				for ct, reward := range s.Rewards {
+					total := res.FeeRewards[ct]
+					if total == nil {
+						total = new(big.Int)
+						res.FeeRewards[ct] = total
+					}
+					total.Add(total, reward)
+				}
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return res, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// StakingLocked returns whether the staking key domain is locked.  Accounts
+// in the staking key domain may sign votes and revocations while the staking
+// key domain is unlocked, even when the wallet is locked.
+func (w *Wallet) StakingLocked() bool {
+	return w.manager.StakingLocked()
+}
+
+// UnlockStaking unlocks the staking key domain with the staking passphrase.
+func (w *Wallet) UnlockStaking(ctx context.Context, passphrase []byte) error {
+	const op errors.Op = "wallet.UnlockStaking"
+
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		return w.manager.UnlockStaking(ns, passphrase)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	logCtx(ctx).Info("The staking keys have been unlocked")
+	return nil
+}
+
+// LockStaking locks the staking key domain.  The staking key domain can not be
+// locked when no staking passphrase is set.
+func (w *Wallet) LockStaking(ctx context.Context) error {
+	const op errors.Op = "wallet.LockStaking"
+
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		return w.manager.LockStaking(ns)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	logCtx(ctx).Info("The staking keys have been locked")
+	return nil
+}
+
+// SetStakingPassphrase sets the passphrase protecting the staking key domain,
+// which must be unlocked.  An empty passphrase leaves the staking key domain
+// unlocked whenever the wallet is opened, allowing an unattended wallet to
+// vote without being able to spend funds outside of the domain.
+func (w *Wallet) SetStakingPassphrase(ctx context.Context, passphrase []byte) error {
+	const op errors.Op = "wallet.SetStakingPassphrase"
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.manager.SetStakingPassphrase(ns, passphrase)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// SetStakingAccount adds an account to, or removes an account from, the
+// staking key domain.  Tickets voting with addresses of an account in the
+// domain are voted and revoked while only the staking keys are unlocked.
+// Both the wallet and the staking key domain must be unlocked.
+func (w *Wallet) SetStakingAccount(ctx context.Context, account uint32, staking bool) error {
+	const op errors.Op = "wallet.SetStakingAccount"
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.manager.SetStakingAccount(ns, account, staking)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// SetTicketBuyerConfig records the ticket buyer configuration of an account.
+// A zero target removes the configuration, stopping the ticket buyer from
+// purchasing tickets to maintain the account's ticket count.
+func (w *Wallet) SetTicketBuyerConfig(ctx context.Context, cfg *udb.TicketBuyerConfig) error {
+	const op errors.Op = "wallet.SetTicketBuyerConfig"
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := w.manager.AccountName(addrmgrNs, cfg.Account)
+		if err != nil {
+			return err
+		}
+		if cfg.Target == 0 {
+			return udb.DeleteTicketBuyerConfig(dbtx, cfg.Account)
+		}
+		return udb.PutTicketBuyerConfig(dbtx, cfg)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// TicketBuyerConfigs returns the ticket buyer configuration of every account
+// maintaining a target number of tickets, in increasing account order.
+func (w *Wallet) TicketBuyerConfigs(ctx context.Context) ([]udb.TicketBuyerConfig, error) {
+	const op errors.Op = "wallet.TicketBuyerConfigs"
+
+	var configs []udb.TicketBuyerConfig
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachTicketBuyerConfig(dbtx, func(c *udb.TicketBuyerConfig) error {
+			configs = append(configs, *c)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return configs, nil
+}
+
+// AccountTicketCount returns the number of unspent and unexpired tickets,
+// including unmined and immature tickets, purchased by an account.  Tickets
+// are associated with accounts via their first commitment address.
+func (w *Wallet) AccountTicketCount(ctx context.Context, account uint32) (int, error) {
+	const op errors.Op = "wallet.AccountTicketCount"
+
+	var count int
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+
+		it := w.txStore.IterateTickets(dbtx)
+		defer it.Close()
+		for it.Next() {
+			if it.SpenderHash != (chainhash.Hash{}) {
+				continue
+			}
+			if ticketExpired(w.chainParams, it.Block.Height, tipHeight) {
+				continue
+			}
+
+			payKinds, hash160s, _, _, _, _ := stake.TxSStxStakeOutputInfo(&it.MsgTx)
+			if len(hash160s) == 0 {
+				continue
+			}
+			var addr stdaddr.Address
+			var err error
+			if payKinds[0] { // P2SH
+				addr, err = stdaddr.NewAddressScriptHashV0FromHash(hash160s[0], w.chainParams)
+			} else { // P2PKH
+				addr, err = stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(hash160s[0], w.chainParams)
+			}
+			if err != nil {
+				continue
+			}
+			addrAccount, err := w.manager.AddrAccount(addrmgrNs, addr)
+			if errors.Is(err, errors.NotExist) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if addrAccount == account {
+				count++
+			}
+		}
+		return it.Err()
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return count, nil
+}
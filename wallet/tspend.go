@@ -0,0 +1,63 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/txscript/sign"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+// SignTSpend signs the single input of a treasury spend transaction with the
+// private key of piKey, a sanctioned Politeia key of the network whose private
+// key has been imported to the wallet.  The transaction must otherwise be
+// complete, as the signature commits to the outputs, expiry and the value
+// spent from the treasury.  After signing, the wallet's vote policy for the
+// tspend hash is set to approve the spend; it may be changed afterwards with
+// SetTSpendPolicy.
+func (w *Wallet) SignTSpend(ctx context.Context, tx *wire.MsgTx, piKey []byte) error {
+	const op errors.Op = "wallet.SignTSpend"
+
+	if !w.chainParams.PiKeyExists(piKey) {
+		return errors.E(op, errors.Invalid, errors.Errorf("key %x is not "+
+			"a sanctioned Politeia key", piKey))
+	}
+	if tx.Version != wire.TxVersionTreasury || len(tx.TxIn) != 1 {
+		return errors.E(op, errors.Invalid, "transaction is not a treasury spend")
+	}
+	addr, err := stdaddr.NewAddressPubKeyEcdsaSecp256k1V0Raw(piKey, w.chainParams)
+	if err != nil {
+		return errors.E(op, errors.Invalid, err)
+	}
+	privKey, zero, err := w.LoadPrivateKey(ctx, addr)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	defer zero()
+
+	// The signature script is <signature> <compressed key> OP_TSPEND, and
+	// the signature omits the sighash type.
+	sigScript, err := sign.TSpendSignatureScript(tx, privKey.Serialize())
+	if err != nil {
+		return errors.E(op, err)
+	}
+	prevScript := tx.TxIn[0].SignatureScript
+	tx.TxIn[0].SignatureScript = sigScript
+	if _, _, err := stake.CheckTSpend(tx); err != nil {
+		tx.TxIn[0].SignatureScript = prevScript
+		return errors.E(op, errors.Invalid, err)
+	}
+
+	hash := tx.TxHash()
+	err = w.SetTSpendPolicy(ctx, &hash, stake.TreasuryVoteYes, nil)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
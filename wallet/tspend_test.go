@@ -0,0 +1,108 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+)
+
+func TestSignTSpend(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simnet Pi key pair published with the network parameters.
+	piKey, _ := hex.DecodeString("02a36b785d584555696b69d1b2bbeff4010332b301e3edd316d79438554cacb3e7")
+	wif, err := dcrutil.DecodeWIF("PsUUktzTqNKDRudiz3F4Chh5CKqqmp5W3ckRDhwECbwrSuWZ9m5fk",
+		w.chainParams.PrivateKeyID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newTSpend := func() *wire.MsgTx {
+		opReturn, err := txscript.NewScriptBuilder().AddOp(txscript.OP_RETURN).
+			AddData(make([]byte, 32)).Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+			make([]byte, 20), w.chainParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		vers, script := addr.PayFromTreasuryScript()
+		tx := wire.NewMsgTx()
+		tx.Version = wire.TxVersionTreasury
+		tx.AddTxOut(wire.NewTxOut(0, opReturn))
+		tx.AddTxOut(&wire.TxOut{Value: 1e8, Version: vers, PkScript: script})
+		tx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{},
+				wire.MaxPrevOutIndex, wire.TxTreeRegular),
+			Sequence:    wire.MaxTxInSequenceNum,
+			ValueIn:     1e8,
+			BlockHeight: wire.NullBlockHeight,
+			BlockIndex:  wire.NullBlockIndex,
+		})
+		return tx
+	}
+
+	// Signing requires the private key of the Pi key.
+	err = w.SignTSpend(ctx, newTSpend(), piKey)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("Pi key not imported: expected NotExist error, got %v", err)
+	}
+	if _, err := w.ImportPrivateKey(ctx, wif, udb.ImportedAddrAccount); err != nil {
+		t.Fatal(err)
+	}
+
+	notPiKey := bytes.Clone(piKey)
+	notPiKey[1] ^= 1
+	err = w.SignTSpend(ctx, newTSpend(), notPiKey)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("unsanctioned key: expected Invalid error, got %v", err)
+	}
+
+	notTSpend := newTSpend()
+	notTSpend.Version = 1
+	err = w.SignTSpend(ctx, notTSpend, piKey)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("non-treasury transaction: expected Invalid error, got %v", err)
+	}
+
+	tx := newTSpend()
+	if err := w.SignTSpend(ctx, tx, piKey); err != nil {
+		t.Fatal(err)
+	}
+	_, pubKey, err := stake.CheckTSpend(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(pubKey, piKey) {
+		t.Errorf("tspend signed by %x, want %x", pubKey, piKey)
+	}
+	hash := tx.TxHash()
+	if p := w.TSpendPolicy(&hash, nil); p != stake.TreasuryVoteYes {
+		t.Errorf("signed tspend has vote policy %v, want yes", p)
+	}
+}
@@ -7,15 +7,20 @@
 package txauthor
 
 import (
+	"math/big"
+	"slices"
+
 	"github.com/monetarium/monetarium-wallet/errors"
 	"github.com/monetarium/monetarium-wallet/wallet/txrules"
 	"github.com/monetarium/monetarium-wallet/wallet/txsizes"
 	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/crypto/rand"
+	"github.com/monetarium/monetarium-node/dcrec"
 	"github.com/monetarium/monetarium-node/dcrutil"
 	"github.com/monetarium/monetarium-node/txscript"
 	"github.com/monetarium/monetarium-node/txscript/sign"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
 	"github.com/monetarium/monetarium-node/wire"
 )
 
@@ -27,8 +32,26 @@ const (
 	// constant for the generated transaction version could allow creation
 	// of invalid transactions for the updated version.
 	generatedTxVersion = 1
+
+	// relativeLockTxVersion is the version of generated transactions with
+	// inputs whose sequence numbers encode a relative lock time.  Relative
+	// lock times, and therefore OP_CHECKSEQUENCEVERIFY, are only enforced
+	// for transactions of at least this version.
+	relativeLockTxVersion = 2
 )
 
+// txVersion returns the version of a generated transaction spending inputs.
+// Sequence numbers without the wire.SequenceLockTimeDisabled bit set encode
+// relative lock times, which require relativeLockTxVersion.
+func txVersion(inputs []*wire.TxIn) uint16 {
+	for _, in := range inputs {
+		if in.Sequence&wire.SequenceLockTimeDisabled == 0 {
+			return relativeLockTxVersion
+		}
+	}
+	return generatedTxVersion
+}
+
 // InputDetail provides a detailed summary of transaction inputs
 // referencing spendable outputs. This consists of the total spendable
 // amount, the generated inputs, the redeem scripts and the full redeem
@@ -216,7 +239,7 @@ func NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb dcrutil.Amount,
 
 		unsignedTransaction := &wire.MsgTx{
 			SerType:  wire.TxSerializeFull,
-			Version:  generatedTxVersion,
+			Version:  txVersion(inputDetail.Inputs),
 			TxIn:     inputDetail.Inputs,
 			TxOut:    outputs,
 			LockTime: 0,
@@ -295,6 +318,321 @@ func NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb dcrutil.Amount,
 	}
 }
 
+// NewUnsignedTransactionWithInputs creates an unsigned transaction like
+// NewUnsignedTransaction, except the transaction spends the pre-existing inputs
+// of existing in addition to any inputs chosen from fetchInputs.  The existing
+// inputs are placed first, and their value and redeem script sizes are counted
+// toward the outputs and fee, so additional inputs are only fetched for the
+// remaining value.  A nil or empty existing input detail is equivalent to
+// NewUnsignedTransaction.
+func NewUnsignedTransactionWithInputs(outputs []*wire.TxOut, existing *InputDetail,
+	relayFeePerKb dcrutil.Amount, fetchInputs InputSource, fetchChange ChangeSource,
+	maxTxSize int) (*AuthoredTx, error) {
+
+	const op errors.Op = "txauthor.NewUnsignedTransactionWithInputs"
+
+	if existing == nil || len(existing.Inputs) == 0 {
+		return NewUnsignedTransaction(outputs, relayFeePerKb, fetchInputs,
+			fetchChange, maxTxSize)
+	}
+	if len(existing.Scripts) != len(existing.Inputs) ||
+		len(existing.RedeemScriptSizes) != len(existing.Inputs) {
+		return nil, errors.E(op, errors.Invalid,
+			"existing inputs do not match their scripts and script sizes")
+	}
+
+	isSKA := len(outputs) > 0 && outputs[0].CoinType.IsSKA()
+	n := len(existing.Inputs)
+	inputs := func(target dcrutil.Amount) (*InputDetail, error) {
+		// SKA input sources are always asked for every available output,
+		// and VAR input sources only for the value the existing inputs
+		// do not provide.
+		detail := new(InputDetail)
+		if isSKA || target > existing.Amount {
+			var err error
+			detail, err = fetchInputs(max(target-existing.Amount, 0))
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &InputDetail{
+			Amount:            existing.Amount + detail.Amount,
+			SKAAmount:         existing.SKAAmount.Add(detail.SKAAmount),
+			Inputs:            append(existing.Inputs[:n:n], detail.Inputs...),
+			Scripts:           append(existing.Scripts[:n:n], detail.Scripts...),
+			RedeemScriptSizes: append(existing.RedeemScriptSizes[:n:n], detail.RedeemScriptSizes...),
+		}, nil
+	}
+	return NewUnsignedTransaction(outputs, relayFeePerKb, inputs, fetchChange,
+		maxTxSize)
+}
+
+// NewUnsignedTransactionSubtractFee creates an unsigned transaction paying to
+// one or more non-change outputs, like NewUnsignedTransaction, except the
+// transaction fee is subtracted from the values of the outputs at the indexes
+// in subtractFeeFrom rather than paid by additional input value.  The fee is
+// divided evenly between these outputs, and any remainder is subtracted from
+// the first of them.  Inputs are selected to pay the output values before the
+// fee is subtracted, so the value spent, excluding change, is exactly the sum
+// of the nominal output values.  Remaining input value which would be a dust
+// change output pays part of the fee instead.
+//
+// The outputs are copied and not modified.  Errors with code errors.Invalid
+// are returned if an output is too small to pay its part of the fee.
+func NewUnsignedTransactionSubtractFee(outputs []*wire.TxOut, subtractFeeFrom []int,
+	relayFeePerKb dcrutil.Amount, fetchInputs InputSource, fetchChange ChangeSource,
+	maxTxSize int) (*AuthoredTx, error) {
+
+	const op errors.Op = "txauthor.NewUnsignedTransactionSubtractFee"
+
+	if len(subtractFeeFrom) == 0 {
+		return nil, errors.E(op, errors.Invalid, "no outputs to subtract the fee from")
+	}
+	seen := make(map[int]struct{}, len(subtractFeeFrom))
+	for _, i := range subtractFeeFrom {
+		if i < 0 || i >= len(outputs) {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("output "+
+				"index %d out of range", i))
+		}
+		if _, ok := seen[i]; ok {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("duplicate "+
+				"output index %d", i))
+		}
+		seen[i] = struct{}{}
+	}
+
+	isSKA := outputs[0].CoinType.IsSKA()
+	targetAmount := sumOutputValues(outputs)
+	targetSKAAmount := cointype.Zero()
+	inputTarget := targetAmount
+	if isSKA {
+		targetSKAAmount = sumSKAOutputValues(outputs)
+		inputTarget = 0 // Get all available SKA UTXOs
+	}
+
+	inputDetail, err := fetchInputs(inputTarget)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if isSKA {
+		if inputDetail.SKAAmount.Cmp(targetSKAAmount) < 0 {
+			return nil, errors.E(op, errors.InsufficientBalance)
+		}
+	} else if inputDetail.Amount < targetAmount {
+		return nil, errors.E(op, errors.InsufficientBalance)
+	}
+
+	estimateSize := func(changeScriptSize int) int {
+		if isSKA {
+			return txsizes.EstimateSerializeSizeSKA(inputDetail.RedeemScriptSizes,
+				outputs, changeScriptSize)
+		}
+		return txsizes.EstimateSerializeSize(inputDetail.RedeemScriptSizes,
+			outputs, changeScriptSize)
+	}
+
+	// The input value remaining after paying the nominal output values is
+	// returned as change, or pays part of the fee if it would be dust.
+	changeScriptSize := fetchChange.ScriptSize()
+	changeAmount := inputDetail.Amount - targetAmount
+	var changeSKAAmount cointype.SKAAmount
+	var hasChange bool
+	if isSKA {
+		changeSKAAmount = inputDetail.SKAAmount.Sub(targetSKAAmount)
+		hasChange = !changeSKAAmount.IsZero()
+	} else {
+		hasChange = changeAmount != 0 &&
+			!txrules.IsDustAmount(changeAmount, changeScriptSize, relayFeePerKb)
+	}
+	var maxSignedSize int
+	var fee dcrutil.Amount
+	if hasChange {
+		maxSignedSize = estimateSize(changeScriptSize)
+		fee = txrules.FeeForSerializeSize(relayFeePerKb, maxSignedSize)
+	} else {
+		maxSignedSize = estimateSize(0)
+		fee = txrules.FeeForSerializeSize(relayFeePerKb, maxSignedSize)
+		if !isSKA {
+			fee -= changeAmount
+			if fee < 0 {
+				fee = 0
+			}
+		}
+	}
+	if maxSignedSize > maxTxSize {
+		return nil, errors.E(op, errors.Invalid, "signed tx size exceeds allowed maximum")
+	}
+
+	txOuts := make([]*wire.TxOut, len(outputs))
+	for i, out := range outputs {
+		txOut := *out
+		txOuts[i] = &txOut
+	}
+	n := int64(len(subtractFeeFrom))
+	if isSKA {
+		share, rem := new(big.Int).QuoRem(big.NewInt(int64(fee)), big.NewInt(n), new(big.Int))
+		for j, i := range subtractFeeFrom {
+			outFee := new(big.Int).Set(share)
+			if j == 0 {
+				outFee.Add(outFee, rem)
+			}
+			value := new(big.Int)
+			if txOuts[i].SKAValue != nil {
+				value.Sub(txOuts[i].SKAValue, outFee)
+			}
+			if value.Sign() <= 0 {
+				return nil, errors.E(op, errors.Invalid, errors.Errorf("output %d "+
+					"value is too small to pay the fee", i))
+			}
+			txOuts[i].SKAValue = value
+		}
+	} else {
+		share, rem := fee/dcrutil.Amount(n), fee%dcrutil.Amount(n)
+		for j, i := range subtractFeeFrom {
+			outFee := share
+			if j == 0 {
+				outFee += rem
+			}
+			value := dcrutil.Amount(txOuts[i].Value) - outFee
+			if value <= 0 || txrules.IsDustAmount(value, len(txOuts[i].PkScript), relayFeePerKb) {
+				return nil, errors.E(op, errors.Invalid, errors.Errorf("output %d "+
+					"value is too small to pay the fee", i))
+			}
+			txOuts[i].Value = int64(value)
+		}
+	}
+
+	unsignedTransaction := &wire.MsgTx{
+		SerType:  wire.TxSerializeFull,
+		Version:  txVersion(inputDetail.Inputs),
+		TxIn:     inputDetail.Inputs,
+		TxOut:    txOuts,
+		LockTime: 0,
+		Expiry:   0,
+	}
+	changeIndex := -1
+	if hasChange {
+		changeScript, changeScriptVersion, err := fetchChange.Script()
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if len(changeScript) > txscript.MaxScriptElementSize {
+			return nil, errors.E(op, errors.Invalid, "script size exceed maximum bytes "+
+				"pushable to the stack")
+		}
+		change := &wire.TxOut{
+			Version:  changeScriptVersion,
+			PkScript: changeScript,
+			CoinType: outputs[0].CoinType,
+		}
+		if isSKA {
+			change.SKAValue = changeSKAAmount.BigInt()
+		} else {
+			change.Value = int64(changeAmount)
+		}
+		changeIndex = len(txOuts)
+		unsignedTransaction.TxOut = append(txOuts, change)
+	}
+	return &AuthoredTx{
+		Tx:                           unsignedTransaction,
+		PrevScripts:                  inputDetail.Scripts,
+		TotalInput:                   inputDetail.Amount,
+		SKATotalInput:                inputDetail.SKAAmount,
+		ChangeIndex:                  changeIndex,
+		EstimatedSignedSerializeSize: maxSignedSize,
+	}, nil
+}
+
+// NewUnsignedSweepTransaction creates an unsigned transaction spending every
+// input returned by fetchInputs to a single output, without a change output.
+// The value of the output is set to the total input value minus the fee for
+// the estimated signed transaction size, rather than being fixed up-front.
+// The output's coin type selects whether the Value or SKAValue of the output
+// is set.
+//
+// fetchInputs is called once with a zero target amount, which input sources
+// interpret as a request for every spendable output.  Errors with code
+// errors.InsufficientBalance are returned if there are no inputs, or if the
+// inputs do not pay for the fee and a non-dust output.
+func NewUnsignedSweepTransaction(output *wire.TxOut, relayFeePerKb dcrutil.Amount,
+	fetchInputs InputSource, maxTxSize int) (*AuthoredTx, error) {
+
+	const op errors.Op = "txauthor.NewUnsignedSweepTransaction"
+
+	isSKA := output.CoinType.IsSKA()
+	inputDetail, err := fetchInputs(0)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(inputDetail.Inputs) == 0 {
+		return nil, errors.E(op, errors.InsufficientBalance, "no spendable outputs")
+	}
+
+	outputs := []*wire.TxOut{output}
+	var maxSignedSize int
+	if isSKA {
+		maxSignedSize = txsizes.EstimateSerializeSizeSKA(
+			inputDetail.RedeemScriptSizes, outputs, 0)
+	} else {
+		maxSignedSize = txsizes.EstimateSerializeSize(
+			inputDetail.RedeemScriptSizes, outputs, 0)
+	}
+	if maxSignedSize > maxTxSize {
+		return nil, errors.E(op, errors.Invalid, "signed tx size exceeds allowed maximum")
+	}
+	fee := txrules.FeeForSerializeSize(relayFeePerKb, maxSignedSize)
+
+	if isSKA {
+		value := inputDetail.SKAAmount.Sub(cointype.SKAAmountFromInt64(int64(fee)))
+		if value.IsZero() || value.IsNegative() {
+			return nil, errors.E(op, errors.InsufficientBalance)
+		}
+		output.Value = 0 // SKA uses SKAValue, not Value
+		output.SKAValue = value.BigInt()
+	} else {
+		value := inputDetail.Amount - fee
+		if value <= 0 || txrules.IsDustAmount(value, len(output.PkScript), relayFeePerKb) {
+			return nil, errors.E(op, errors.InsufficientBalance)
+		}
+		output.Value = int64(value)
+	}
+
+	unsignedTransaction := &wire.MsgTx{
+		SerType:  wire.TxSerializeFull,
+		Version:  txVersion(inputDetail.Inputs),
+		TxIn:     inputDetail.Inputs,
+		TxOut:    outputs,
+		LockTime: 0,
+		Expiry:   0,
+	}
+	return &AuthoredTx{
+		Tx:                           unsignedTransaction,
+		PrevScripts:                  inputDetail.Scripts,
+		TotalInput:                   inputDetail.Amount,
+		SKATotalInput:                inputDetail.SKAAmount,
+		ChangeIndex:                  -1,
+		EstimatedSignedSerializeSize: maxSignedSize,
+	}, nil
+}
+
+// SetLockTimes sets the expiry height and lock time of the unsigned
+// transaction.  Lock times are only enforced when an input is not final, so a
+// non-zero lock time also lowers the sequence number of every input below the
+// maximum.  Neither changes the serialize size of the transaction.  This must
+// be done before signing.
+func (tx *AuthoredTx) SetLockTimes(expiry, lockTime uint32) {
+	tx.Tx.Expiry = expiry
+	tx.Tx.LockTime = lockTime
+	if lockTime == 0 {
+		return
+	}
+	for _, in := range tx.Tx.TxIn {
+		if in.Sequence == wire.MaxTxInSequenceNum {
+			in.Sequence = wire.MaxTxInSequenceNum - 1
+		}
+	}
+}
+
 // RandomizeOutputPosition randomizes the position of a transaction's output by
 // swapping it with a random output.  The new index is returned.  This should be
 // done before signing.
@@ -310,6 +648,171 @@ func (tx *AuthoredTx) RandomizeChangePosition() {
 	tx.ChangeIndex = RandomizeOutputPosition(tx.Tx.TxOut, tx.ChangeIndex)
 }
 
+// ChangeDistribution describes how SplitChange divides change value between
+// the change outputs.
+type ChangeDistribution int
+
+const (
+	// ChangeEven divides change equally between the change outputs.
+	ChangeEven ChangeDistribution = iota
+
+	// ChangeRandom pays each change output at least half of an equal share
+	// and divides the remaining change at uniformly random points.
+	ChangeRandom
+)
+
+var changeDistributionNames = [...]string{
+	ChangeEven:   "even",
+	ChangeRandom: "random",
+}
+
+// String returns the name of the distribution.
+func (d ChangeDistribution) String() string {
+	if d < 0 || int(d) >= len(changeDistributionNames) {
+		return "unknown"
+	}
+	return changeDistributionNames[d]
+}
+
+// ParseChangeDistribution returns the distribution with a name returned by
+// ChangeDistribution.String.
+func ParseChangeDistribution(name string) (ChangeDistribution, error) {
+	for d, n := range changeDistributionNames {
+		if n == name {
+			return ChangeDistribution(d), nil
+		}
+	}
+	return 0, errors.E(errors.Invalid, errors.Errorf("unknown change "+
+		"distribution %q", name))
+}
+
+// SplitChange splits the change output of an authored transaction into count
+// outputs paying to scripts from fetchChange, so that the change value can
+// not be identified by its amount.  The fee for the additional outputs is
+// subtracted from the change.  Additional outputs are inserted at random
+// positions and the change index continues to reference one of the change
+// outputs.  VAR change is split into fewer outputs if an output would be dust
+// or the transaction would exceed maxTxSize, and is not split at all if no
+// split is possible.
+//
+// This must be done before signing, and changes the estimated serialize size
+// of the transaction.
+func (tx *AuthoredTx) SplitChange(count int, distribution ChangeDistribution,
+	relayFeePerKb dcrutil.Amount, fetchChange ChangeSource, maxTxSize int) error {
+
+	const op errors.Op = "txauthor.SplitChange"
+
+	if tx.ChangeIndex < 0 || count < 2 {
+		return nil
+	}
+	change := tx.Tx.TxOut[tx.ChangeIndex]
+	isSKA := change.CoinType.IsSKA()
+	changeValue := big.NewInt(change.Value)
+	if isSKA {
+		changeValue = new(big.Int)
+		if change.SKAValue != nil {
+			changeValue.Set(change.SKAValue)
+		}
+	}
+	scriptSize := fetchChange.ScriptSize()
+	outputSize := txsizes.EstimateOutputSize(scriptSize)
+	if isSKA {
+		outputSize = txsizes.EstimateOutputSizeSKA(scriptSize)
+	}
+	outputCount := len(tx.Tx.TxOut)
+	fee := txrules.FeeForSerializeSize(relayFeePerKb, tx.EstimatedSignedSerializeSize)
+
+	// Find the most outputs, up to count, which the change can be split
+	// into after paying the fee for the additional outputs.
+	var size int
+	var value, share *big.Int
+	for ; count >= 2; count-- {
+		size = tx.EstimatedSignedSerializeSize + (count-1)*outputSize +
+			wire.VarIntSerializeSize(uint64(outputCount+count-1)) -
+			wire.VarIntSerializeSize(uint64(outputCount))
+		if size > maxTxSize {
+			continue
+		}
+		extraFee := txrules.FeeForSerializeSize(relayFeePerKb, size) - fee
+		value = new(big.Int).Sub(changeValue, big.NewInt(int64(extraFee)))
+		share = new(big.Int).Quo(value, big.NewInt(int64(count)))
+		if distribution == ChangeRandom {
+			share.Rsh(share, 1)
+		}
+		if share.Sign() <= 0 {
+			continue
+		}
+		if !isSKA && txrules.IsDustAmount(dcrutil.Amount(share.Int64()),
+			scriptSize, relayFeePerKb) {
+			continue
+		}
+		break
+	}
+	if count < 2 {
+		return nil
+	}
+
+	// Every output is paid share, and the remaining value is added to the
+	// first output or divided between the outputs at random cut points.
+	rest := new(big.Int).Sub(value, new(big.Int).Mul(share, big.NewInt(int64(count))))
+	values := make([]*big.Int, count)
+	switch distribution {
+	case ChangeRandom:
+		cuts := make([]*big.Int, 0, count+1)
+		cuts = append(cuts, new(big.Int))
+		for i := 0; i < count-1; i++ {
+			cuts = append(cuts, rand.BigInt(new(big.Int).Add(rest, big.NewInt(1))))
+		}
+		cuts = append(cuts, rest)
+		slices.SortFunc(cuts, (*big.Int).Cmp)
+		for i := range values {
+			values[i] = new(big.Int).Sub(cuts[i+1], cuts[i])
+			values[i].Add(values[i], share)
+		}
+	default:
+		for i := range values {
+			values[i] = new(big.Int).Set(share)
+		}
+		values[0].Add(values[0], rest)
+	}
+
+	outputs := make([]*wire.TxOut, 0, count-1)
+	for i := 1; i < count; i++ {
+		script, version, err := fetchChange.Script()
+		if err != nil {
+			return errors.E(op, err)
+		}
+		if len(script) > txscript.MaxScriptElementSize {
+			return errors.E(op, errors.Invalid, "script size exceed maximum bytes "+
+				"pushable to the stack")
+		}
+		outputs = append(outputs, &wire.TxOut{
+			Version:  version,
+			PkScript: script,
+			CoinType: change.CoinType,
+		})
+	}
+	setValue := func(out *wire.TxOut, value *big.Int) {
+		if isSKA {
+			out.Value = 0 // SKA uses SKAValue, not Value
+			out.SKAValue = value
+		} else {
+			out.Value = value.Int64()
+		}
+	}
+	setValue(change, values[0])
+	for i, out := range outputs {
+		setValue(out, values[i+1])
+		r := int(rand.Int32N(int32(len(tx.Tx.TxOut) + 1)))
+		tx.Tx.TxOut = slices.Insert(tx.Tx.TxOut, r, out)
+		if r <= tx.ChangeIndex {
+			tx.ChangeIndex++
+		}
+	}
+	tx.EstimatedSignedSerializeSize = size
+	return nil
+}
+
 // SecretsSource provides private keys and redeem scripts necessary for
 // constructing transaction input signatures.  Secrets are looked up by the
 // corresponding Address for the previous output script.  Addresses for lookup
@@ -327,11 +830,26 @@ type SecretsSource interface {
 	ChainParams() *chaincfg.Params
 }
 
+// InputSigner is implemented by a SecretsSource which creates the signature
+// scripts of transaction inputs itself rather than revealing private keys, such
+// as a source backed by an external signing device.  AddAllInputScripts
+// delegates signing to SignInputs when the SecretsSource implements it.
+type InputSigner interface {
+	SecretsSource
+
+	// SignInputs sets the signature script of every transaction input.
+	// Previous output scripts redeemed by each input are passed in
+	// prevPkScripts, with a length matching the number of inputs.
+	SignInputs(tx *wire.MsgTx, prevPkScripts [][]byte) error
+}
+
 // AddAllInputScripts modifies transaction a transaction by adding inputs
 // scripts for each input.  Previous output scripts being redeemed by each input
 // are passed in prevPkScripts and the slice length must match the number of
 // inputs.  Private keys and redeem scripts are looked up using a SecretsSource
-// based on the previous output script.
+// based on the previous output script.  Inputs redeeming P2PK-Schnorr and
+// P2PKH-Schnorr outputs are signed with Schnorr signatures, even when the
+// SecretsSource describes the secp256k1 key as an ECDSA key.
 func AddAllInputScripts(tx *wire.MsgTx, prevPkScripts [][]byte, secrets SecretsSource) error {
 	inputs := tx.TxIn
 	chainParams := secrets.ChainParams()
@@ -341,11 +859,16 @@ func AddAllInputScripts(tx *wire.MsgTx, prevPkScripts [][]byte, secrets SecretsS
 			"have equal length")
 	}
 
+	if signer, ok := secrets.(InputSigner); ok {
+		return signer.SignInputs(tx, prevPkScripts)
+	}
+
+	keys := schnorrKeyDB{secrets}
 	for i := range inputs {
 		pkScript := prevPkScripts[i]
 		sigScript := inputs[i].SignatureScript
 		script, err := sign.SignTxOutput(chainParams, tx, i,
-			pkScript, txscript.SigHashAll, secrets, secrets,
+			pkScript, txscript.SigHashAll, keys, secrets,
 			sigScript, true) // Yes treasury
 		if err != nil {
 			return err
@@ -356,6 +879,30 @@ func AddAllInputScripts(tx *wire.MsgTx, prevPkScripts [][]byte, secrets SecretsS
 	return nil
 }
 
+// schnorrKeyDB is a sign.KeyDB which returns the secp256k1 keys of Schnorr
+// addresses with the Schnorr signature type.  Keys are looked up by the hash of
+// the public key, which is shared by the ECDSA and Schnorr addresses of a key,
+// so a KeyDB may not describe the key by the signature type of the address.
+type schnorrKeyDB struct {
+	sign.KeyDB
+}
+
+func (db schnorrKeyDB) GetKey(addr stdaddr.Address) ([]byte, dcrec.SignatureType, bool, error) {
+	key, sigType, compressed, err := db.KeyDB.GetKey(addr)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	switch addr.(type) {
+	case *stdaddr.AddressPubKeyHashSchnorrSecp256k1V0,
+		*stdaddr.AddressPubKeySchnorrSecp256k1V0:
+
+		if sigType == dcrec.STEcdsaSecp256k1 {
+			sigType = dcrec.STSchnorrSecp256k1
+		}
+	}
+	return key, sigType, compressed, nil
+}
+
 // AddAllInputScripts modifies an authored transaction by adding inputs scripts
 // for each input of an authored transaction.  Private keys and redeem scripts
 // are looked up using a SecretsSource based on the previous output script.
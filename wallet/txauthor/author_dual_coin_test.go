@@ -258,3 +258,94 @@ func TestEmptyOutputsHandling(t *testing.T) {
 		t.Log("Empty outputs allowed at txauthor level - validation handled elsewhere")
 	}
 }
+
+// TestSplitChange tests splitting VAR and SKA change into multiple outputs
+// with the fee for the additional outputs paid by the change.
+func TestSplitChange(t *testing.T) {
+	t.Parallel()
+
+	const relayFee = 1e4
+	const maxTxSize = 100000
+	const size = 300
+	scriptSize := txsizes.P2PKHPkScriptSize
+
+	tests := []struct {
+		name         string
+		coinType     cointype.CoinType
+		change       dcrutil.Amount
+		count        int
+		distribution txauthor.ChangeDistribution
+		wantOutputs  int
+	}{
+		{"even VAR", cointype.CoinTypeVAR, 3e8, 3, txauthor.ChangeEven, 3},
+		{"random VAR", cointype.CoinTypeVAR, 3e8, 4, txauthor.ChangeRandom, 4},
+		{"dust VAR", cointype.CoinTypeVAR, 2e4, 4, txauthor.ChangeEven, 3},
+		{"unsplittable VAR", cointype.CoinTypeVAR, 1e4, 4, txauthor.ChangeEven, 1},
+		{"even SKA", cointype.CoinType(1), 3e8, 3, txauthor.ChangeEven, 3},
+		{"random SKA", cointype.CoinType(1), 3e8, 4, txauthor.ChangeRandom, 4},
+	}
+	for _, tc := range tests {
+		outputs := p2pkhOutputsWithCoinType(tc.coinType, 1e8, tc.change)
+		outputs[1].PkScript = make([]byte, scriptSize)
+		tx := &txauthor.AuthoredTx{
+			Tx:                           &wire.MsgTx{TxOut: outputs},
+			ChangeIndex:                  1,
+			EstimatedSignedSerializeSize: size,
+		}
+		err := tx.SplitChange(tc.count, tc.distribution, relayFee,
+			AuthorTestChangeSource{}, maxTxSize)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if len(tx.Tx.TxOut) != tc.wantOutputs+1 {
+			t.Fatalf("%s: %d outputs, want %d", tc.name, len(tx.Tx.TxOut),
+				tc.wantOutputs+1)
+		}
+
+		outputSize := txsizes.EstimateOutputSize(scriptSize)
+		if tc.coinType.IsSKA() {
+			outputSize = txsizes.EstimateOutputSizeSKA(scriptSize)
+		}
+		wantSize := size + (tc.wantOutputs-1)*outputSize
+		if tx.EstimatedSignedSerializeSize != wantSize {
+			t.Errorf("%s: estimated size %d, want %d", tc.name,
+				tx.EstimatedSignedSerializeSize, wantSize)
+		}
+		extraFee := txrules.FeeForSerializeSize(relayFee, wantSize) -
+			txrules.FeeForSerializeSize(relayFee, size)
+
+		var payments int
+		changeTotal := new(big.Int)
+		for i, out := range tx.Tx.TxOut {
+			if out.CoinType != tc.coinType {
+				t.Errorf("%s: output %d has coin type %v", tc.name, i, out.CoinType)
+			}
+			value := big.NewInt(out.Value)
+			if tc.coinType.IsSKA() {
+				value = out.SKAValue
+				if out.Value != 0 {
+					t.Errorf("%s: SKA output %d sets Value", tc.name, i)
+				}
+			}
+			if len(out.PkScript) == txsizes.P2PKHOutputSize {
+				payments++
+				if i == tx.ChangeIndex {
+					t.Errorf("%s: change index %d references the payment",
+						tc.name, i)
+				}
+				continue
+			}
+			if value.Sign() <= 0 {
+				t.Errorf("%s: change output %d value %v", tc.name, i, value)
+			}
+			changeTotal.Add(changeTotal, value)
+		}
+		if payments != 1 {
+			t.Errorf("%s: %d payment outputs", tc.name, payments)
+		}
+		want := big.NewInt(int64(tc.change - extraFee))
+		if changeTotal.Cmp(want) != 0 {
+			t.Errorf("%s: total change %v, want %v", tc.name, changeTotal, want)
+		}
+	}
+}
@@ -6,6 +6,7 @@
 package txauthor_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/monetarium/monetarium-wallet/errors"
@@ -13,7 +14,11 @@ import (
 	"github.com/monetarium/monetarium-wallet/wallet/txrules"
 	"github.com/monetarium/monetarium-wallet/wallet/txsizes"
 	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/dcrec"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
 	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
 	"github.com/monetarium/monetarium-node/wire"
 )
 
@@ -237,3 +242,267 @@ func TestNewUnsignedTransaction(t *testing.T) {
 		}
 	}
 }
+
+// ecdsaSecretsSource is a SecretsSource which describes its only key as an
+// ECDSA key, as wallet SecretsSources do for every secp256k1 key.
+type ecdsaSecretsSource struct {
+	key *secp256k1.PrivateKey
+}
+
+func (s ecdsaSecretsSource) GetKey(stdaddr.Address) ([]byte, dcrec.SignatureType, bool, error) {
+	return s.key.Serialize(), dcrec.STEcdsaSecp256k1, true, nil
+}
+
+func (s ecdsaSecretsSource) GetScript(stdaddr.Address) ([]byte, error) {
+	return nil, errors.E(errors.NotExist, "no script")
+}
+
+func (s ecdsaSecretsSource) ChainParams() *chaincfg.Params {
+	return chaincfg.MainNetParams()
+}
+
+func TestAddAllInputScriptsSchnorr(t *testing.T) {
+	t.Parallel()
+
+	key := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{1}, 32))
+	pubKey := key.PubKey().SerializeCompressed()
+	params := chaincfg.MainNetParams()
+	p2pkh, err := stdaddr.NewAddressPubKeyHashSchnorrSecp256k1V0(
+		dcrutil.Hash160(pubKey), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2pk, err := stdaddr.NewAddressPubKeySchnorrSecp256k1V0Raw(pubKey, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		addr       stdaddr.Address
+		scriptSize int
+		sigSize    int
+	}{
+		{"p2pkh-schnorr", p2pkh, txsizes.P2PKHSchnorrPkScriptSize, txsizes.RedeemP2PKHSchnorrSigScriptSize},
+		{"p2pk-schnorr", p2pk, txsizes.P2PKSchnorrPkScriptSize, txsizes.RedeemP2PKSchnorrSigScriptSize},
+	}
+	for _, test := range tests {
+		_, prevScript := test.addr.PaymentScript()
+		if len(prevScript) != test.scriptSize {
+			t.Errorf("%s: output script size %d, want %d", test.name,
+				len(prevScript), test.scriptSize)
+		}
+
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 1e8, nil))
+		tx.AddTxOut(wire.NewTxOut(1e8-1e4, prevScript))
+		err := txauthor.AddAllInputScripts(tx, [][]byte{prevScript},
+			ecdsaSecretsSource{key})
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if n := len(tx.TxIn[0].SignatureScript); n != test.sigSize {
+			t.Errorf("%s: signature script size %d, want %d", test.name,
+				n, test.sigSize)
+		}
+		vm, err := txscript.NewEngine(prevScript, tx, 0, 0, 0, nil)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if err := vm.Execute(); err != nil {
+			t.Errorf("%s: signature script does not redeem the output: %v",
+				test.name, err)
+		}
+	}
+}
+
+func TestNewUnsignedSweepTransaction(t *testing.T) {
+	t.Parallel()
+
+	// sweepInputSource returns every unspent output regardless of the
+	// target amount.
+	sweepInputSource := func(unspents []*wire.TxOut) txauthor.InputSource {
+		return func(dcrutil.Amount) (*txauthor.InputDetail, error) {
+			detail := &txauthor.InputDetail{Scripts: make([][]byte, len(unspents))}
+			for _, u := range unspents {
+				detail.Amount += dcrutil.Amount(u.Value)
+				detail.Inputs = append(detail.Inputs, wire.NewTxIn(&wire.OutPoint{}, u.Value, nil))
+				detail.RedeemScriptSizes = append(detail.RedeemScriptSizes, txsizes.RedeemP2PKHSigScriptSize)
+			}
+			return detail, nil
+		}
+	}
+	const relayFee = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+
+	output := &wire.TxOut{PkScript: make([]byte, txsizes.P2PKHPkScriptSize)}
+	tx, err := txauthor.NewUnsignedSweepTransaction(output, relayFee,
+		sweepInputSource(p2pkhOutputs(1e8, 2e8, 3e8)), maxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.Tx.TxIn) != 3 || len(tx.Tx.TxOut) != 1 || tx.ChangeIndex != -1 {
+		t.Fatalf("sweep has %d inputs, %d outputs, change index %d",
+			len(tx.Tx.TxIn), len(tx.Tx.TxOut), tx.ChangeIndex)
+	}
+	fee := txrules.FeeForSerializeSize(relayFee, tx.EstimatedSignedSerializeSize)
+	if got, want := dcrutil.Amount(tx.Tx.TxOut[0].Value), tx.TotalInput-fee; got != want {
+		t.Errorf("sweep output value %v, want %v", got, want)
+	}
+
+	_, err = txauthor.NewUnsignedSweepTransaction(output, relayFee,
+		sweepInputSource(nil), maxTxSize)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("sweep without inputs: expected InsufficientBalance, got %v", err)
+	}
+	_, err = txauthor.NewUnsignedSweepTransaction(output, relayFee,
+		sweepInputSource(p2pkhOutputs(1e3)), maxTxSize)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("sweep of dust: expected InsufficientBalance, got %v", err)
+	}
+}
+
+func TestNewUnsignedTransactionSubtractFee(t *testing.T) {
+	t.Parallel()
+
+	const relayFee = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+
+	outputs := p2pkhOutputs(1e8, 2e8)
+	tx, err := txauthor.NewUnsignedTransactionSubtractFee(outputs, []int{0, 1},
+		relayFee, makeInputSource(p2pkhOutputs(2e8, 2e8)), AuthorTestChangeSource{},
+		maxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if outputs[0].Value != 1e8 || outputs[1].Value != 2e8 {
+		t.Fatal("outputs were modified")
+	}
+	if tx.ChangeIndex != 2 || len(tx.Tx.TxOut) != 3 {
+		t.Fatalf("change index %d with %d outputs", tx.ChangeIndex, len(tx.Tx.TxOut))
+	}
+	// The inputs pay exactly the nominal output values and the change.
+	if change := tx.Tx.TxOut[2].Value; change != 1e8 {
+		t.Errorf("change value %v, want %v", change, 1e8)
+	}
+	fee := txrules.FeeForSerializeSize(relayFee, tx.EstimatedSignedSerializeSize)
+	var paid dcrutil.Amount
+	for i, out := range tx.Tx.TxOut[:2] {
+		paid += dcrutil.Amount(outputs[i].Value - out.Value)
+	}
+	if paid != fee {
+		t.Errorf("outputs paid fee %v, want %v", paid, fee)
+	}
+	if d := tx.Tx.TxOut[0].Value - tx.Tx.TxOut[1].Value; d != -1e8 && d != -1e8-1 {
+		t.Errorf("fee was not divided evenly")
+	}
+
+	_, err = txauthor.NewUnsignedTransactionSubtractFee(p2pkhOutputs(1e3),
+		[]int{0}, relayFee, makeInputSource(p2pkhOutputs(1e8)),
+		AuthorTestChangeSource{}, maxTxSize)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("subtracting fee from dust: expected Invalid, got %v", err)
+	}
+	_, err = txauthor.NewUnsignedTransactionSubtractFee(p2pkhOutputs(1e8),
+		[]int{1}, relayFee, makeInputSource(p2pkhOutputs(1e8)),
+		AuthorTestChangeSource{}, maxTxSize)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("out of range index: expected Invalid, got %v", err)
+	}
+	_, err = txauthor.NewUnsignedTransactionSubtractFee(p2pkhOutputs(2e8),
+		[]int{0}, relayFee, makeInputSource(p2pkhOutputs(1e8)),
+		AuthorTestChangeSource{}, maxTxSize)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("insufficient inputs: expected InsufficientBalance, got %v", err)
+	}
+}
+
+func TestNewUnsignedTransactionWithInputs(t *testing.T) {
+	t.Parallel()
+
+	const relayFee = 1e4
+	maxTxSize := chaincfg.MainNetParams().MaxTxSize
+
+	existingInput := func(value int64) *txauthor.InputDetail {
+		op := &wire.OutPoint{Index: 7}
+		return &txauthor.InputDetail{
+			Amount:            dcrutil.Amount(value),
+			Inputs:            []*wire.TxIn{wire.NewTxIn(op, value, nil)},
+			Scripts:           make([][]byte, 1),
+			RedeemScriptSizes: []int{txsizes.RedeemP2PKHSigScriptSize},
+		}
+	}
+
+	// The existing input is spent first, and the input source provides
+	// the remaining value.
+	tx, err := txauthor.NewUnsignedTransactionWithInputs(p2pkhOutputs(2e8),
+		existingInput(1e8), relayFee, makeInputSource(p2pkhOutputs(3e8)),
+		AuthorTestChangeSource{}, maxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.Tx.TxIn) != 2 || tx.Tx.TxIn[0].PreviousOutPoint.Index != 7 {
+		t.Fatalf("transaction does not spend the existing input first")
+	}
+	if tx.TotalInput != 4e8 || tx.ChangeIndex != 1 {
+		t.Fatalf("total input %v, change index %d", tx.TotalInput, tx.ChangeIndex)
+	}
+	fee := txrules.FeeForSerializeSize(relayFee, tx.EstimatedSignedSerializeSize)
+	if change := dcrutil.Amount(tx.Tx.TxOut[1].Value); change != 2e8-fee {
+		t.Errorf("change value %v, want %v", change, 2e8-fee)
+	}
+
+	// No inputs are fetched when the existing input pays the outputs and
+	// fee.
+	tx, err = txauthor.NewUnsignedTransactionWithInputs(p2pkhOutputs(1e8),
+		existingInput(2e8), relayFee, makeInputSource(p2pkhOutputs(3e8)),
+		AuthorTestChangeSource{}, maxTxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.Tx.TxIn) != 1 || tx.TotalInput != 2e8 {
+		t.Fatalf("spent %d inputs with total %v", len(tx.Tx.TxIn), tx.TotalInput)
+	}
+
+	_, err = txauthor.NewUnsignedTransactionWithInputs(p2pkhOutputs(5e8),
+		existingInput(1e8), relayFee, makeInputSource(p2pkhOutputs(3e8)),
+		AuthorTestChangeSource{}, maxTxSize)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("insufficient inputs: expected InsufficientBalance, got %v", err)
+	}
+}
+
+func TestNewUnsignedTransactionRelativeLock(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		sequence uint32
+		version  uint16
+	}{
+		{"final", wire.MaxTxInSequenceNum, 1},
+		{"relative lock disabled", wire.SequenceLockTimeDisabled | 10, 1},
+		{"relative lock", 10, 2},
+	}
+	for _, test := range tests {
+		inputSource := func(target dcrutil.Amount) (*txauthor.InputDetail, error) {
+			in := wire.NewTxIn(&wire.OutPoint{}, 1e8, nil)
+			in.Sequence = test.sequence
+			return &txauthor.InputDetail{
+				Amount:            1e8,
+				Inputs:            []*wire.TxIn{in},
+				Scripts:           [][]byte{nil},
+				RedeemScriptSizes: []int{txsizes.RedeemVaultSigScriptSize},
+			}, nil
+		}
+		tx, err := txauthor.NewUnsignedTransaction(p2pkhOutputs(1e6), 1e4,
+			inputSource, AuthorTestChangeSource{}, 1e6)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if tx.Tx.Version != test.version {
+			t.Errorf("%s: transaction version %d, want %d", test.name,
+				tx.Tx.Version, test.version)
+		}
+	}
+}
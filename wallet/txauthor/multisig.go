@@ -0,0 +1,195 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"bytes"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1/ecdsa"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// multisigSigScript is the parsed signature script of an input redeeming a
+// P2SH multisig output.
+type multisigSigScript struct {
+	sigs         [][]byte
+	redeemScript []byte
+}
+
+// parseMultisigSigScript parses a signature script redeeming a P2SH multisig
+// output.  The empty pushes recorded for missing signatures by partial signing
+// are skipped.  A nil redeem script is returned for scripts of any other form.
+func parseMultisigSigScript(script []byte) (*multisigSigScript, error) {
+	var pushes [][]byte
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+	for tokenizer.Next() {
+		if tokenizer.Opcode() > txscript.OP_PUSHDATA4 {
+			return &multisigSigScript{}, nil
+		}
+		pushes = append(pushes, tokenizer.Data())
+	}
+	if err := tokenizer.Err(); err != nil {
+		return nil, err
+	}
+	if len(pushes) == 0 || !stdscript.IsMultiSigScriptV0(pushes[len(pushes)-1]) {
+		return &multisigSigScript{}, nil
+	}
+	s := &multisigSigScript{redeemScript: pushes[len(pushes)-1]}
+	for _, p := range pushes[:len(pushes)-1] {
+		if len(p) != 0 {
+			s.sigs = append(s.sigs, p)
+		}
+	}
+	return s, nil
+}
+
+// MergeMultisigScripts combines the signature scripts of cosigners who each
+// partially signed the input idx of tx, which redeems a P2SH multisig output.
+// Every signature is verified against the public keys of the redeem script,
+// and invalid signatures and signatures by unknown keys are discarded.  The
+// merged script orders signatures by the public keys of the redeem script, as
+// required by the script engine, and reports whether it includes enough
+// signatures to redeem the output.  Errors with code errors.Invalid are
+// returned if the scripts do not redeem the same multisig script.
+func MergeMultisigScripts(tx *wire.MsgTx, idx int, sigScripts ...[]byte) (script []byte, complete bool, err error) {
+	const op errors.Op = "txauthor.MergeMultisigScripts"
+	if idx < 0 || idx >= len(tx.TxIn) {
+		return nil, false, errors.E(op, errors.Invalid, "input index out of range")
+	}
+
+	var redeemScript []byte
+	var sigs [][]byte
+	for _, sigScript := range sigScripts {
+		if len(sigScript) == 0 {
+			continue
+		}
+		s, err := parseMultisigSigScript(sigScript)
+		if err != nil {
+			return nil, false, errors.E(op, errors.Invalid, err)
+		}
+		if s.redeemScript == nil {
+			return nil, false, errors.E(op, errors.Invalid, "signature "+
+				"script does not redeem a multisig script")
+		}
+		if redeemScript == nil {
+			redeemScript = s.redeemScript
+		} else if !bytes.Equal(redeemScript, s.redeemScript) {
+			return nil, false, errors.E(op, errors.Invalid, "signature "+
+				"scripts redeem different multisig scripts")
+		}
+		sigs = append(sigs, s.sigs...)
+	}
+	if redeemScript == nil {
+		return nil, false, nil
+	}
+
+	// Find a valid signature for each public key of the redeem script.
+	details := stdscript.ExtractMultiSigScriptDetailsV0(redeemScript, true)
+	pubKeySigs := make([][]byte, len(details.PubKeys))
+	for _, sig := range sigs {
+		if len(sig) < 2 {
+			continue
+		}
+		hashType := txscript.SigHashType(sig[len(sig)-1])
+		parsedSig, err := ecdsa.ParseDERSignature(sig[:len(sig)-1])
+		if err != nil {
+			continue
+		}
+		sigHash, err := txscript.CalcSignatureHash(redeemScript, hashType, tx, idx, nil)
+		if err != nil {
+			continue
+		}
+		for i, pk := range details.PubKeys {
+			if pubKeySigs[i] != nil {
+				continue
+			}
+			pubKey, err := secp256k1.ParsePubKey(pk)
+			if err != nil {
+				continue
+			}
+			if parsedSig.Verify(sigHash, pubKey) {
+				pubKeySigs[i] = sig
+				break
+			}
+		}
+	}
+
+	b := txscript.NewScriptBuilder()
+	signed := 0
+	for _, sig := range pubKeySigs {
+		if sig == nil {
+			continue
+		}
+		b.AddData(sig)
+		signed++
+		if signed == int(details.RequiredSigs) {
+			break
+		}
+	}
+	script, err = b.AddData(redeemScript).Script()
+	if err != nil {
+		return nil, false, errors.E(op, err)
+	}
+	return script, signed == int(details.RequiredSigs), nil
+}
+
+// MergeMultisigInputScripts merges the input signature scripts of partially
+// signed copies of tx, created by each cosigner of P2SH multisig inputs, into
+// tx.  Cosigners partially sign their copies with AddAllInputScripts, which
+// signs every multisig input with the keys known by the SecretsSource.  Inputs
+// which do not redeem multisig outputs keep the first signature script found
+// among tx and its copies.  It returns whether every input of tx is signed.
+func MergeMultisigInputScripts(tx *wire.MsgTx, partials ...*wire.MsgTx) (complete bool, err error) {
+	const op errors.Op = "txauthor.MergeMultisigInputScripts"
+	txHash := tx.TxHash()
+	for _, p := range partials {
+		if p.TxHash() != txHash || len(p.TxIn) != len(tx.TxIn) {
+			return false, errors.E(op, errors.Invalid, "partially signed "+
+				"transactions do not match")
+		}
+	}
+
+	complete = true
+	for i, in := range tx.TxIn {
+		sigScripts := make([][]byte, 0, 1+len(partials))
+		sigScripts = append(sigScripts, in.SignatureScript)
+		for _, p := range partials {
+			sigScripts = append(sigScripts, p.TxIn[i].SignatureScript)
+		}
+
+		var multisig bool
+		var first []byte
+		for _, s := range sigScripts {
+			if len(s) == 0 {
+				continue
+			}
+			if first == nil {
+				first = s
+			}
+			parsed, err := parseMultisigSigScript(s)
+			if err == nil && parsed.redeemScript != nil {
+				multisig = true
+				break
+			}
+		}
+		if !multisig {
+			in.SignatureScript = first
+			complete = complete && first != nil
+			continue
+		}
+
+		script, inputComplete, err := MergeMultisigScripts(tx, i, sigScripts...)
+		if err != nil {
+			return false, errors.E(op, errors.Invalid, errors.Errorf("input %d: %v", i, err))
+		}
+		in.SignatureScript = script
+		complete = complete && inputComplete
+	}
+	return complete, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/txauthor"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/dcrec"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/sign"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+func TestMergeMultisigScripts(t *testing.T) {
+	t.Parallel()
+
+	var privKeys []*secp256k1.PrivateKey
+	var pubKeys [][]byte
+	for i := byte(1); i <= 3; i++ {
+		k := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{i}, 32))
+		privKeys = append(privKeys, k)
+		pubKeys = append(pubKeys, k.PubKey().SerializeCompressed())
+	}
+	redeemScript, err := stdscript.MultiSigScriptV0(2, pubKeys...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 1e8, nil))
+	tx.AddTxOut(wire.NewTxOut(1e8-1e4, make([]byte, 25)))
+
+	// partial returns a copy of tx with a signature script including the
+	// signature of a single key, and an empty push for the other keys.
+	partial := func(k *secp256k1.PrivateKey) *wire.MsgTx {
+		t.Helper()
+		sig, err := sign.RawTxInSignature(tx, 0, redeemScript,
+			txscript.SigHashAll, k.Serialize(), dcrec.STEcdsaSecp256k1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		script, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+			AddData(sig).AddData(redeemScript).Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := tx.Copy()
+		p.TxIn[0].SignatureScript = script
+		return p
+	}
+
+	// A single signature does not complete the input.
+	merged := tx.Copy()
+	complete, err := txauthor.MergeMultisigInputScripts(merged, partial(privKeys[2]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if complete {
+		t.Fatal("1-of-2 signatures reported complete")
+	}
+
+	// Signatures of the second cosigner complete the input, ordered by the
+	// public keys of the redeem script.
+	complete, err = txauthor.MergeMultisigInputScripts(merged, partial(privKeys[0]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !complete {
+		t.Fatal("2-of-2 signatures reported incomplete")
+	}
+	p2sh, err := stdaddr.NewAddressScriptHashV0(redeemScript, chaincfg.MainNetParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, prevScript := p2sh.PaymentScript()
+	vm, err := txscript.NewEngine(prevScript, merged, 0, 0, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Fatalf("merged script does not redeem the output: %v", err)
+	}
+
+	// Scripts redeeming different multisig scripts can not be merged.
+	otherScript, err := stdscript.MultiSigScriptV0(1, pubKeys...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(otherScript).Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = txauthor.MergeMultisigScripts(tx, 0,
+		partial(privKeys[0]).TxIn[0].SignatureScript, other)
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("merging different redeem scripts: expected Invalid, got %v", err)
+	}
+}
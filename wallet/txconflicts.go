@@ -0,0 +1,48 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// TxConflict returns the record of an unmined transaction which was removed
+// from the wallet after a mined transaction double spent it, or double spent
+// an unmined transaction it depends on.  Errors with code errors.NotExist are
+// returned for transactions which were not removed by a conflict.
+func (w *Wallet) TxConflict(ctx context.Context, txHash *chainhash.Hash) (*udb.TxConflict, error) {
+	const op errors.Op = "wallet.TxConflict"
+	var c *udb.TxConflict
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		c, err = udb.TxConflictForHash(dbtx, txHash)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return c, nil
+}
+
+// TxConflicts returns the records of every unmined transaction removed from
+// the wallet by a conflicting mined transaction.
+func (w *Wallet) TxConflicts(ctx context.Context) ([]*udb.TxConflict, error) {
+	const op errors.Op = "wallet.TxConflicts"
+	var conflicts []*udb.TxConflict
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		conflicts, err = udb.TxConflicts(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return conflicts, nil
+}
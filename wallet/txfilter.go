@@ -0,0 +1,150 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+)
+
+const (
+	// txFilterBatchDelay is how long newly derived addresses are queued
+	// before being added to the transaction filter of the network backend.
+	// Each queued address restarts the delay, so addresses derived in quick
+	// succession are registered by a single LoadTxFilter call.
+	txFilterBatchDelay = 250 * time.Millisecond
+
+	// txFilterMaxBatchDelay limits how long the first queued address waits
+	// for registration while the delay is repeatedly restarted.
+	txFilterMaxBatchDelay = 2 * time.Second
+
+	// txFilterMaxBatch is the number of queued addresses which are
+	// registered immediately without waiting for the delay.
+	txFilterMaxBatch = 1024
+)
+
+// txFilterQueue queues addresses to be added to the transaction filter of a
+// network backend in batches.
+type txFilterQueue struct {
+	n      NetworkBackend
+	addrs  []stdaddr.Address
+	queued time.Time // when the first pending address was queued
+	timer  *time.Timer
+	mu     sync.Mutex
+}
+
+// take removes and returns the pending addresses and the backend they were
+// queued for.  The mutex must be held.
+func (q *txFilterQueue) take() (NetworkBackend, []stdaddr.Address) {
+	if q.timer != nil {
+		q.timer.Stop()
+		q.timer = nil
+	}
+	n, addrs := q.n, q.addrs
+	q.n, q.addrs = nil, nil
+	return n, addrs
+}
+
+// queueTxFilterAddrs queues addresses to be added to the transaction filter of
+// the network backend n after txFilterBatchDelay, together with any other
+// addresses queued before then.
+//
+// Addresses queued for a previous network backend are discarded, as every
+// active address is loaded when a backend is associated with the wallet.
+func (w *Wallet) queueTxFilterAddrs(n NetworkBackend, addrs []stdaddr.Address) {
+	if len(addrs) == 0 {
+		return
+	}
+
+	q := &w.txFilterQueue
+	q.mu.Lock()
+	if q.n != n {
+		q.take()
+		q.n = n
+	}
+	if len(q.addrs) == 0 {
+		q.queued = time.Now()
+	}
+	q.addrs = append(q.addrs, addrs...)
+	if len(q.addrs) >= txFilterMaxBatch {
+		n, addrs := q.take()
+		q.mu.Unlock()
+		w.loadQueuedTxFilter(context.Background(), n, addrs)
+		return
+	}
+	delay := min(txFilterBatchDelay, txFilterMaxBatchDelay-time.Since(q.queued))
+	if q.timer != nil {
+		q.timer.Stop()
+	}
+	q.timer = time.AfterFunc(max(delay, 0), func() {
+		w.FlushTxFilter(context.Background())
+	})
+	q.mu.Unlock()
+}
+
+// loadQueuedTxFilter adds queued addresses to the transaction filter of n if it
+// remains the wallet's network backend.
+func (w *Wallet) loadQueuedTxFilter(ctx context.Context, n NetworkBackend, addrs []stdaddr.Address) error {
+	if len(addrs) == 0 {
+		return nil
+	}
+	if cur, err := w.NetworkBackend(); err != nil || cur != n {
+		return nil
+	}
+	err := n.LoadTxFilter(ctx, false, addrs, nil)
+	if err != nil {
+		logCtx(ctx).Errorf("Failed to watch %d new address(es): %v", len(addrs), err)
+		return err
+	}
+	logCtx(ctx).Debugf("Registered for transaction notifications for %d new "+
+		"address(es)", len(addrs))
+	return nil
+}
+
+// FlushTxFilter immediately adds every address queued for registration with the
+// transaction filter of the network backend, rather than waiting for more
+// addresses to be derived.
+func (w *Wallet) FlushTxFilter(ctx context.Context) error {
+	const op errors.Op = "wallet.FlushTxFilter"
+
+	q := &w.txFilterQueue
+	q.mu.Lock()
+	n, addrs := q.take()
+	q.mu.Unlock()
+	err := w.loadQueuedTxFilter(ctx, n, addrs)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// ResyncTxFilter clears the transaction filter of the network backend and loads
+// it with every active address and unspent output of the wallet.  This
+// recovers from addresses or outputs which failed to be registered, at the
+// cost of resending the entire filter.
+func (w *Wallet) ResyncTxFilter(ctx context.Context) error {
+	const op errors.Op = "wallet.ResyncTxFilter"
+
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	// Queued addresses are included in the reloaded filter.
+	q := &w.txFilterQueue
+	q.mu.Lock()
+	q.take()
+	q.mu.Unlock()
+
+	err = w.LoadActiveDataFilters(ctx, n, true)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
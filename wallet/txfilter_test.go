@@ -0,0 +1,108 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// txFilterNetwork records the addresses of every LoadTxFilter call.
+type txFilterNetwork struct {
+	mockNetwork
+	loads chan []stdaddr.Address
+}
+
+func (n *txFilterNetwork) LoadTxFilter(ctx context.Context, reload bool, addrs []stdaddr.Address, outpoints []wire.OutPoint) error {
+	n.loads <- addrs
+	return nil
+}
+
+func TestTxFilterQueue(t *testing.T) {
+	t.Parallel()
+
+	params := chaincfg.SimNetParams()
+	addrs := func(count int) []stdaddr.Address {
+		t.Helper()
+		addrs := make([]stdaddr.Address, count)
+		for i := range addrs {
+			hash := make([]byte, 20)
+			hash[0], hash[1] = byte(i), byte(i>>8)
+			a, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(hash, params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			addrs[i] = a
+		}
+		return addrs
+	}
+	noLoads := func(n *txFilterNetwork, desc string) {
+		t.Helper()
+		select {
+		case a := <-n.loads:
+			t.Fatalf("%s: unexpected load of %d addresses", desc, len(a))
+		default:
+		}
+	}
+
+	w := &Wallet{chainParams: params}
+	n := &txFilterNetwork{loads: make(chan []stdaddr.Address, 4)}
+	w.SetNetworkBackend(n)
+
+	// Addresses queued in quick succession are loaded together after the
+	// batch delay.
+	for _, a := range addrs(3) {
+		w.queueTxFilterAddrs(n, []stdaddr.Address{a})
+	}
+	noLoads(n, "before delay")
+	select {
+	case a := <-n.loads:
+		if len(a) != 3 {
+			t.Fatalf("batch loaded %d addresses, want 3", len(a))
+		}
+	case <-time.After(10 * txFilterMaxBatchDelay):
+		t.Fatal("queued addresses were not loaded")
+	}
+
+	// Reaching the maximum batch size loads the addresses immediately.
+	w.queueTxFilterAddrs(n, addrs(txFilterMaxBatch))
+	select {
+	case a := <-n.loads:
+		if len(a) != txFilterMaxBatch {
+			t.Fatalf("full batch loaded %d addresses, want %d", len(a),
+				txFilterMaxBatch)
+		}
+	default:
+		t.Fatal("full batch was not loaded immediately")
+	}
+
+	// Flushing loads queued addresses without waiting for the delay.
+	w.queueTxFilterAddrs(n, addrs(2))
+	if err := w.FlushTxFilter(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case a := <-n.loads:
+		if len(a) != 2 {
+			t.Fatalf("flush loaded %d addresses, want 2", len(a))
+		}
+	default:
+		t.Fatal("flush did not load queued addresses")
+	}
+
+	// Addresses queued for a backend which is no longer associated with
+	// the wallet are discarded.
+	w.queueTxFilterAddrs(n, addrs(2))
+	w.SetNetworkBackend(nil)
+	if err := w.FlushTxFilter(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	noLoads(n, "disassociated backend")
+}
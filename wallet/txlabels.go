@@ -0,0 +1,45 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// SetTransactionLabel records a free-form label for a transaction.  Labels are
+// informational only and are not relayed to the network.  An empty label
+// removes any existing label.
+func (w *Wallet) SetTransactionLabel(ctx context.Context, txHash *chainhash.Hash, label string) error {
+	const op errors.Op = "wallet.SetTransactionLabel"
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutTxLabel(dbtx, txHash, label)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// TransactionLabel returns the label recorded for a transaction, or an empty
+// string if the transaction is unlabeled.
+func (w *Wallet) TransactionLabel(ctx context.Context, txHash *chainhash.Hash) (string, error) {
+	const op errors.Op = "wallet.TransactionLabel"
+
+	var label string
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		label = udb.TxLabel(dbtx, txHash)
+		return nil
+	})
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+	return label, nil
+}
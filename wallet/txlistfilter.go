@@ -0,0 +1,129 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/internal/compat"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+)
+
+// TxClass describes the kind of a wallet transaction for filtering listed
+// transactions.
+type TxClass int
+
+// Transaction classes.
+const (
+	TxClassRegular TxClass = iota
+	TxClassCoinbase
+	TxClassTicket
+	TxClassVote
+	TxClassRevocation
+	TxClassSSFee
+)
+
+var txClassNames = [...]string{
+	TxClassRegular:    "regular",
+	TxClassCoinbase:   "coinbase",
+	TxClassTicket:     "ticket",
+	TxClassVote:       "vote",
+	TxClassRevocation: "revocation",
+	TxClassSSFee:      "ssfee",
+}
+
+// String returns the name of the transaction class.
+func (c TxClass) String() string {
+	if c < 0 || int(c) >= len(txClassNames) {
+		return "unknown"
+	}
+	return txClassNames[c]
+}
+
+// ParseTxClass returns the transaction class with a name returned by
+// TxClass.String.
+func ParseTxClass(name string) (TxClass, error) {
+	for c, n := range txClassNames {
+		if n == name {
+			return TxClass(c), nil
+		}
+	}
+	return 0, errors.E(errors.Invalid, errors.Errorf("unknown transaction "+
+		"class %q", name))
+}
+
+// txClass returns the class of a transaction record.
+func txClass(rec *udb.TxRecord) TxClass {
+	switch rec.TxType {
+	case stake.TxTypeSStx:
+		return TxClassTicket
+	case stake.TxTypeSSGen:
+		return TxClassVote
+	case stake.TxTypeSSRtx:
+		return TxClassRevocation
+	case stake.TxTypeSSFee:
+		return TxClassSSFee
+	}
+	if compat.IsEitherCoinBaseTx(&rec.MsgTx) {
+		return TxClassCoinbase
+	}
+	return TxClassRegular
+}
+
+// txCoinType returns the coin type of the first SKA output of a transaction,
+// or VAR when it has no SKA outputs.
+func txCoinType(tx *wire.MsgTx) cointype.CoinType {
+	for _, out := range tx.TxOut {
+		if out.CoinType.IsSKA() {
+			return out.CoinType
+		}
+	}
+	return cointype.CoinTypeVAR
+}
+
+// TxListFilter limits the transactions returned by ListFilteredTransactions.
+// Nil fields do not filter transactions.
+type TxListFilter struct {
+	// CoinType selects transactions by coin type.  The coin type of a
+	// transaction is the coin type of its first SKA output, or VAR when it
+	// has no SKA outputs.
+	CoinType *cointype.CoinType
+
+	// Class selects transactions of a single class.
+	Class *TxClass
+
+	// StartHeight and EndHeight select transactions mined in blocks in the
+	// inclusive height range.  Unmined transactions are excluded when
+	// EndHeight is set.
+	StartHeight *int32
+	EndHeight   *int32
+}
+
+// heightRange returns the heights to range over, from newest to oldest,
+// with the special height -1 including unmined transactions.
+func (f *TxListFilter) heightRange() (begin, end int32) {
+	begin, end = -1, 0
+	if f.EndHeight != nil {
+		begin = *f.EndHeight
+	}
+	if f.StartHeight != nil {
+		end = *f.StartHeight
+	}
+	return begin, end
+}
+
+// match returns whether a transaction record matches the coin type and class
+// of the filter.
+func (f *TxListFilter) match(rec *udb.TxRecord) bool {
+	if f.CoinType != nil && txCoinType(&rec.MsgTx) != *f.CoinType {
+		return false
+	}
+	if f.Class != nil && txClass(rec) != *f.Class {
+		return false
+	}
+	return true
+}
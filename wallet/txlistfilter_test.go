@@ -0,0 +1,75 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+)
+
+func TestParseTxClass(t *testing.T) {
+	t.Parallel()
+
+	for c := TxClassRegular; c <= TxClassSSFee; c++ {
+		got, err := ParseTxClass(c.String())
+		if err != nil || got != c {
+			t.Errorf("ParseTxClass(%q) = %v, %v", c.String(), got, err)
+		}
+	}
+	_, err := ParseTxClass("unknown")
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("unknown class: expected Invalid error, got %v", err)
+	}
+}
+
+func TestTxListFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	record := func(txType stake.TxType, coinTypes ...cointype.CoinType) *udb.TxRecord {
+		rec := &udb.TxRecord{TxType: txType}
+		rec.MsgTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+		for _, ct := range coinTypes {
+			rec.MsgTx.AddTxOut(&wire.TxOut{CoinType: ct})
+		}
+		return rec
+	}
+	coinType := func(ct cointype.CoinType) *cointype.CoinType { return &ct }
+	class := func(c TxClass) *TxClass { return &c }
+
+	tests := []struct {
+		name   string
+		filter TxListFilter
+		rec    *udb.TxRecord
+		match  bool
+	}{
+		{"no filter", TxListFilter{}, record(stake.TxTypeRegular, 0), true},
+		{"VAR", TxListFilter{CoinType: coinType(0)},
+			record(stake.TxTypeRegular, 0), true},
+		{"VAR of SKA", TxListFilter{CoinType: coinType(0)},
+			record(stake.TxTypeRegular, 0, 1), false},
+		{"SKA", TxListFilter{CoinType: coinType(1)},
+			record(stake.TxTypeRegular, 0, 1), true},
+		{"other SKA", TxListFilter{CoinType: coinType(2)},
+			record(stake.TxTypeRegular, 1), false},
+		{"ticket", TxListFilter{Class: class(TxClassTicket)},
+			record(stake.TxTypeSStx, 0), true},
+		{"regular ticket", TxListFilter{Class: class(TxClassRegular)},
+			record(stake.TxTypeSStx, 0), false},
+		{"ssfee", TxListFilter{Class: class(TxClassSSFee), CoinType: coinType(1)},
+			record(stake.TxTypeSSFee, 1), true},
+		{"VAR ssfee", TxListFilter{Class: class(TxClassSSFee), CoinType: coinType(0)},
+			record(stake.TxTypeSSFee, 1), false},
+	}
+	for _, tc := range tests {
+		if got := tc.filter.match(tc.rec); got != tc.match {
+			t.Errorf("%s: match = %v, want %v", tc.name, got, tc.match)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// TransactionsPage is a page of transactions returned by GetTransactionsPage.
+type TransactionsPage struct {
+	// Blocks holds the transactions of the page, grouped by block, in the
+	// order described by GetTransactionsPage.  Unmined transactions are
+	// grouped in a Block with a nil header.
+	Blocks []Block
+
+	// NextCursor is the cursor of the next page, or the empty string if
+	// the page holds the last matching transaction.
+	NextCursor string
+}
+
+// txCursor identifies the position of a transaction in the order of
+// GetTransactionsPage.  It is encoded as the block height, which is -1 for
+// unmined transactions, and the transaction hash, separated by a colon.
+type txCursor struct {
+	height int32
+	hash   chainhash.Hash
+}
+
+func (c *txCursor) String() string {
+	return fmt.Sprintf("%d:%v", c.height, &c.hash)
+}
+
+func parseTxCursor(s string) (*txCursor, error) {
+	heightStr, hashStr, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, errors.E(errors.Encoding, errors.Errorf("malformed cursor %q", s))
+	}
+	height, err := strconv.ParseInt(heightStr, 10, 32)
+	if err != nil || height < -1 {
+		return nil, errors.E(errors.Encoding, errors.Errorf("malformed cursor %q", s))
+	}
+	hash, err := chainhash.NewHashFromStr(hashStr)
+	if err != nil {
+		return nil, errors.E(errors.Encoding, errors.Errorf("malformed cursor %q", s))
+	}
+	return &txCursor{height: int32(height), hash: *hash}, nil
+}
+
+// GetTransactionsPage returns up to limit transactions matching filter,
+// continuing after the transaction identified by cursor.  An empty cursor
+// returns the first page.  A nil filter matches every transaction.
+//
+// Unmined transactions are returned first, followed by mined transactions in
+// descending block height order.  Transactions at the same height are ordered
+// by hash, so the cursor remains valid as new transactions are recorded and
+// pages never repeat or skip transactions which remain at the same height.
+// Only the transactions of a single page are read from the database, making
+// this suitable for paging through very large transaction histories.
+//
+// The next cursor is returned whenever the page is full, so the final page
+// may be empty.
+func (w *Wallet) GetTransactionsPage(ctx context.Context, cursor string, limit int,
+	filter *TxListFilter) (*TransactionsPage, error) {
+
+	const op errors.Op = "wallet.GetTransactionsPage"
+
+	if limit <= 0 {
+		return nil, errors.E(op, errors.Invalid, "page limit must be positive")
+	}
+	var after *txCursor
+	if cursor != "" {
+		var err error
+		after, err = parseTxCursor(cursor)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	var begin, end int32 = -1, 0
+	var match func(*udb.TxRecord) bool
+	if filter != nil {
+		begin, end = filter.heightRange()
+		match = filter.match
+	}
+	if after != nil && after.height != -1 && (begin == -1 || after.height < begin) {
+		begin = after.height
+	}
+	page := new(TransactionsPage)
+	if begin != -1 && begin < end {
+		return page, nil
+	}
+
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		count := 0
+
+		rangeFn := func(details []udb.TxDetails) (bool, error) {
+			height := details[0].Block.Height
+			slices.SortFunc(details, func(a, b udb.TxDetails) int {
+				return bytes.Compare(a.Hash[:], b.Hash[:])
+			})
+
+			var txs []TransactionSummary
+			full := false
+			for i := range details {
+				d := &details[i]
+				if after != nil && height == after.height &&
+					bytes.Compare(d.Hash[:], after.hash[:]) <= 0 {
+					continue
+				}
+				txs = append(txs, makeTxSummary(dbtx, w, d))
+				count++
+				if count == limit {
+					next := txCursor{height: height, hash: d.Hash}
+					page.NextCursor = next.String()
+					full = true
+					break
+				}
+			}
+			if len(txs) == 0 {
+				return false, nil
+			}
+
+			block := Block{Transactions: txs}
+			if height != -1 {
+				serHeader, err := w.txStore.GetSerializedBlockHeader(txmgrNs,
+					&details[0].Block.Hash)
+				if err != nil {
+					return false, err
+				}
+				block.Header = new(wire.BlockHeader)
+				err = block.Header.Deserialize(bytes.NewReader(serHeader))
+				if err != nil {
+					return false, err
+				}
+			}
+			page.Blocks = append(page.Blocks, block)
+			return full, nil
+		}
+
+		return w.txStore.RangeFilteredTransactions(ctx, txmgrNs, begin, end,
+			match, rangeFn)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return page, nil
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-wallet/errors"
+)
+
+func TestTxCursor(t *testing.T) {
+	t.Parallel()
+
+	hash := chainhash.HashH([]byte("tx"))
+	for _, height := range []int32{-1, 0, 1000} {
+		c := &txCursor{height: height, hash: hash}
+		got, err := parseTxCursor(c.String())
+		if err != nil {
+			t.Fatalf("parse %q: %v", c, err)
+		}
+		if *got != *c {
+			t.Errorf("parse %q = %v", c, got)
+		}
+	}
+
+	malformed := []string{
+		"",
+		"100",
+		"x:" + hash.String(),
+		"-2:" + hash.String(),
+		"100:xyz",
+	}
+	for _, s := range malformed {
+		_, err := parseTxCursor(s)
+		if !errors.Is(err, errors.Encoding) {
+			t.Errorf("parse %q: expected Encoding error, got %v", s, err)
+		}
+	}
+}
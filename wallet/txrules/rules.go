@@ -7,6 +7,7 @@ package txrules
 
 import (
 	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-node/blockchain/stake"
 	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/dcrutil"
@@ -79,6 +80,12 @@ func IsDustOutput(output *wire.TxOut, relayFeePerKb dcrutil.Amount) bool {
 // output.  Returns with errors.Invalid if output violates consensus rules, and
 // errors.Policy if the output violates a non-consensus policy.
 func CheckOutput(output *wire.TxOut, relayFeePerKb dcrutil.Amount) error {
+	// Only SSFee transactions, which are never authored by the wallet, may
+	// include the OP_RETURN marker identifying them.
+	if stake.IsSSFeeMarkerScript(output.PkScript) {
+		return errors.E(errors.Invalid, "transaction output is an SSFee marker")
+	}
+
 	// For SKA outputs, use SKAValue for validation
 	if output.CoinType.IsSKA() {
 		// SKA uses big.Int (SKAValue), not int64 (Value)
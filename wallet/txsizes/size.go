@@ -5,7 +5,10 @@
 
 package txsizes
 
-import "github.com/monetarium/monetarium-node/wire"
+import (
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
 
 // Worst case script and input/output size estimates.
 const (
@@ -19,7 +22,9 @@ const (
 
 	// RedeemP2PKHSigScriptSize is the worst case (largest) serialize size
 	// of a transaction input script that redeems a compressed P2PKH output.
-	// It is calculated as:
+	// This includes stake-tagged P2PKH outputs such as ticket change, as
+	// the stake opcode is not repeated in the signature script.  It is
+	// calculated as:
 	//
 	//   - OP_DATA_73
 	//   - 72 bytes DER signature + 1 byte sighash
@@ -27,6 +32,25 @@ const (
 	//   - 33 bytes serialized compressed pubkey
 	RedeemP2PKHSigScriptSize = 1 + 73 + 1 + 33
 
+	// RedeemP2PKSchnorrSigScriptSize is the worst case (largest) serialize
+	// size of a transaction input script that redeems a P2PK-Schnorr
+	// output.  Schnorr signatures have a fixed length.  It is calculated
+	// as:
+	//
+	//   - OP_DATA_65
+	//   - 64 bytes Schnorr signature + 1 byte sighash
+	RedeemP2PKSchnorrSigScriptSize = 1 + 65
+
+	// RedeemP2PKHSchnorrSigScriptSize is the worst case (largest) serialize
+	// size of a transaction input script that redeems a P2PKH-Schnorr
+	// output.  It is calculated as:
+	//
+	//   - OP_DATA_65
+	//   - 64 bytes Schnorr signature + 1 byte sighash
+	//   - OP_DATA_33
+	//   - 33 bytes serialized compressed pubkey
+	RedeemP2PKHSchnorrSigScriptSize = 1 + 65 + 1 + 33
+
 	// RedeemP2SHSigScriptSize is the worst case (largest) serialize size
 	// of a transaction input script that redeems a P2SH output.
 	// It is calculated as:
@@ -39,6 +63,101 @@ const (
 	//  - OP_CHECKSIG
 	RedeemP2SHSigScriptSize = 1 + 73 + 1 + 1 + 33 + 1
 
+	// AtomicSwapContractSize is the worst case (largest) size of a
+	// hash-locked atomic swap contract redeem script with a 32 byte secret.
+	// It is calculated as:
+	//
+	//   - OP_IF
+	//   - OP_SIZE
+	//   - OP_DATA_1
+	//   - 1 byte secret size
+	//   - OP_EQUALVERIFY
+	//   - OP_SHA256
+	//   - OP_DATA_32
+	//   - 32 bytes secret hash
+	//   - OP_EQUALVERIFY
+	//   - OP_DUP
+	//   - OP_HASH160
+	//   - OP_DATA_20
+	//   - 20 bytes recipient pubkey hash
+	//   - OP_ELSE
+	//   - OP_DATA_5
+	//   - 5 bytes lock time
+	//   - OP_CHECKLOCKTIMEVERIFY
+	//   - OP_DROP
+	//   - OP_DUP
+	//   - OP_HASH160
+	//   - OP_DATA_20
+	//   - 20 bytes refund pubkey hash
+	//   - OP_ENDIF
+	//   - OP_EQUALVERIFY
+	//   - OP_CHECKSIG
+	AtomicSwapContractSize = 1 + 1 + 1 + 1 + 1 + 1 + 1 + 32 + 1 + 1 + 1 + 1 + 20 +
+		1 + 1 + 5 + 1 + 1 + 1 + 1 + 1 + 20 + 1 + 1 + 1
+
+	// RedeemAtomicSwapSigScriptSize is the worst case (largest) serialize
+	// size of a transaction input script that redeems a P2SH atomic swap
+	// contract with its secret.  It is calculated as:
+	//
+	//   - OP_DATA_73
+	//   - 72 bytes DER signature + 1 byte sighash
+	//   - OP_DATA_33
+	//   - 33 bytes serialized compressed pubkey
+	//   - OP_DATA_32
+	//   - 32 bytes secret
+	//   - OP_1
+	//   - OP_PUSHDATA1
+	//   - 1 byte contract length
+	//   - contract redeem script
+	RedeemAtomicSwapSigScriptSize = 1 + 73 + 1 + 33 + 1 + 32 + 1 + 1 + 1 +
+		AtomicSwapContractSize
+
+	// RefundAtomicSwapSigScriptSize is the worst case (largest) serialize
+	// size of a transaction input script that refunds a P2SH atomic swap
+	// contract after its lock time.  It is calculated as:
+	//
+	//   - OP_DATA_73
+	//   - 72 bytes DER signature + 1 byte sighash
+	//   - OP_DATA_33
+	//   - 33 bytes serialized compressed pubkey
+	//   - OP_0
+	//   - OP_PUSHDATA1
+	//   - 1 byte contract length
+	//   - contract redeem script
+	RefundAtomicSwapSigScriptSize = 1 + 73 + 1 + 33 + 1 + 1 + 1 +
+		AtomicSwapContractSize
+
+	// VaultScriptSize is the worst case (largest) size of a vault redeem
+	// script with a recovery key.  It is calculated as:
+	//
+	//   - OP_IF
+	//   - OP_DATA_3
+	//   - 3 bytes relative lock time
+	//   - OP_CHECKSEQUENCEVERIFY
+	//   - OP_DROP
+	//   - OP_DATA_33
+	//   - 33 bytes serialized compressed vault pubkey
+	//   - OP_ELSE
+	//   - OP_DATA_33
+	//   - 33 bytes serialized compressed recovery pubkey
+	//   - OP_ENDIF
+	//   - OP_CHECKSIG
+	VaultScriptSize = 1 + 1 + 3 + 1 + 1 + 1 + 33 + 1 + 1 + 33 + 1 + 1
+
+	// RedeemVaultSigScriptSize is the worst case (largest) serialize size
+	// of a transaction input script that redeems a P2SH vault output,
+	// either by the vault key after the relative lock time or by the
+	// recovery key.  Vaults without a recovery key have smaller scripts.
+	// It is calculated as:
+	//
+	//   - OP_DATA_73
+	//   - 72 bytes DER signature + 1 byte sighash
+	//   - OP_TRUE or OP_FALSE
+	//   - OP_PUSHDATA1
+	//   - 1 byte redeem script length
+	//   - vault redeem script
+	RedeemVaultSigScriptSize = 1 + 73 + 1 + 1 + 1 + VaultScriptSize
+
 	// RedeemP2PKHInputSize is the worst case (largest) serialize size of a
 	// transaction input redeeming a compressed P2PKH output.  It is
 	// calculated as:
@@ -54,6 +173,21 @@ const (
 	//   - 4 bytes sequence
 	RedeemP2PKHInputSize = 32 + 4 + 1 + 8 + 4 + 4 + 1 + RedeemP2PKHSigScriptSize + 4
 
+	// RedeemP2PKHSchnorrInputSize is the worst case (largest) serialize size
+	// of a transaction input redeeming a P2PKH-Schnorr output.  It is
+	// calculated as:
+	//
+	//   - 32 bytes previous tx
+	//   - 4 bytes output index
+	//   - 1 byte tree
+	//   - 8 bytes amount
+	//   - 4 bytes block height
+	//   - 4 bytes block index
+	//   - 1 byte compact int encoding value 100
+	//   - 100 bytes signature script
+	//   - 4 bytes sequence
+	RedeemP2PKHSchnorrInputSize = 32 + 4 + 1 + 8 + 4 + 4 + 1 + RedeemP2PKHSchnorrSigScriptSize + 4
+
 	// P2PKHPkScriptSize is the size of a transaction output script that
 	// pays to a compressed pubkey hash.  It is calculated as:
 	//
@@ -79,6 +213,29 @@ const (
 	//   - OP_CHECKSIG
 	P2PKHPkTreasruryScriptSize = 1 + 1 + 1 + 1 + 20 + 1 + 1
 
+	// P2PKSchnorrPkScriptSize is the size of a transaction output script
+	// that pays to a compressed pubkey with Schnorr signatures.  It is
+	// calculated as:
+	//
+	//   - OP_DATA_33
+	//   - 33 bytes serialized compressed pubkey
+	//   - OP_2 (Schnorr signature type)
+	//   - OP_CHECKSIGALT
+	P2PKSchnorrPkScriptSize = 1 + 33 + 1 + 1
+
+	// P2PKHSchnorrPkScriptSize is the size of a transaction output script
+	// that pays to a compressed pubkey hash with Schnorr signatures.  It is
+	// calculated as:
+	//
+	//   - OP_DUP
+	//   - OP_HASH160
+	//   - OP_DATA_20
+	//   - 20 bytes pubkey hash
+	//   - OP_EQUALVERIFY
+	//   - OP_2 (Schnorr signature type)
+	//   - OP_CHECKSIGALT
+	P2PKHSchnorrPkScriptSize = 1 + 1 + 1 + 20 + 1 + 1 + 1
+
 	// P2SHPkScriptSize is the size of a transaction output script that
 	// pays to a script hash.  It is calculated as:
 	//
@@ -118,6 +275,29 @@ const (
 	TSPENDInputSize = 1 + 73 + 1 + 33 + 1
 )
 
+// RedeemSigScriptSize returns the worst case serialize size of a transaction
+// input script that redeems an output script of the script type.  Stake-tagged
+// scripts must be passed as the script type they nest, as the stake opcode is
+// not repeated in the signature script.  P2SH scripts are assumed to be
+// redeemed as described by RedeemP2SHSigScriptSize.  Zero is returned for
+// script types without a known signature script size.
+func RedeemSigScriptSize(scriptType stdscript.ScriptType) int {
+	switch scriptType {
+	case stdscript.STPubKeyHashEcdsaSecp256k1:
+		return RedeemP2PKHSigScriptSize
+	case stdscript.STPubKeyEcdsaSecp256k1:
+		return RedeemP2PKSigScriptSize
+	case stdscript.STPubKeyHashSchnorrSecp256k1:
+		return RedeemP2PKHSchnorrSigScriptSize
+	case stdscript.STPubKeySchnorrSecp256k1:
+		return RedeemP2PKSchnorrSigScriptSize
+	case stdscript.STScriptHash:
+		return RedeemP2SHSigScriptSize
+	default:
+		return 0
+	}
+}
+
 func sumOutputSerializeSizes(outputs []*wire.TxOut) (serializeSize int) {
 	for _, txOut := range outputs {
 		serializeSize += txOut.SerializeSize()
@@ -0,0 +1,131 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/crypto/rand"
+)
+
+// newTxTrace returns an empty trace with a random ID.
+func newTxTrace() *udb.TxTrace {
+	t := new(udb.TxTrace)
+	rand.Read(t.ID[:])
+	return t
+}
+
+// txTraceEvent returns an event recording that a transaction reached a stage
+// now.
+func txTraceEvent(stage udb.TxTraceStage, height int32) udb.TxTraceEvent {
+	return udb.TxTraceEvent{Stage: stage, Time: time.Now(), Height: height}
+}
+
+// traceTx records that a traced transaction reached a lifecycle stage and logs
+// the event with the transaction's trace ID.  Untraced transactions are
+// ignored.
+func (w *Wallet) traceTx(dbtx walletdb.ReadWriteTx, txHash *chainhash.Hash,
+	stage udb.TxTraceStage, height int32) error {
+
+	t, added, err := udb.AddTxTraceEvent(dbtx, txHash, txTraceEvent(stage, height))
+	if err != nil {
+		return err
+	}
+	if !added {
+		return nil
+	}
+	if height >= 0 {
+		log.Infof("Transaction %v (trace %v) %v at height %d", txHash, t.ID,
+			stage, height)
+	} else {
+		log.Infof("Transaction %v (trace %v) %v", txHash, t.ID, stage)
+	}
+	return nil
+}
+
+// tracePublished records that a transaction was published to the network.
+// Failures are only logged since the transaction has already been published.
+func (w *Wallet) tracePublished(ctx context.Context, txHash *chainhash.Hash) {
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return w.traceTx(dbtx, txHash, udb.TxTracePublished, -1)
+	})
+	if err != nil {
+		logCtx(ctx).Errorf("Failed to trace published transaction %v: %v", txHash, err)
+	}
+}
+
+// txMaturityHeight returns the height at which the outputs of a transaction
+// of the given type mined at height may be spent.
+func txMaturityHeight(params *chaincfg.Params, txType stake.TxType, height int32) int32 {
+	switch txType {
+	case stake.TxTypeSStx:
+		// See ticketMatured for the off-by-one in ticket maturity.
+		return height + int32(params.TicketMaturity) + 1
+	case stake.TxTypeSSGen, stake.TxTypeSSRtx:
+		return height + int32(params.CoinbaseMaturity)
+	}
+	return height
+}
+
+// TxTrace returns the lifecycle timeline of a transaction originated by the
+// wallet, from its construction through signing, publishing, mempool
+// acceptance, confirmation, and maturity, or its removal after a conflicting
+// transaction is mined.  Maturity is determined from the current main chain,
+// and is only included once the outputs of a mined transaction may be spent.
+// Errors with code errors.NotExist are returned for untraced transactions.
+func (w *Wallet) TxTrace(ctx context.Context, txHash *chainhash.Hash) (*udb.TxTrace, error) {
+	const op errors.Op = "wallet.TxTrace"
+	var trace *udb.TxTrace
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		var err error
+		trace, err = udb.TxTraceForHash(dbtx, txHash)
+		if err != nil {
+			return err
+		}
+
+		details, err := w.txStore.TxDetails(txmgrNs, txHash)
+		if errors.Is(err, errors.NotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if details.Height() < 0 {
+			return nil
+		}
+		maturity := txMaturityHeight(w.chainParams, details.TxType, details.Height())
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+		if tipHeight < maturity {
+			return nil
+		}
+		blockHash, err := w.txStore.GetMainChainBlockHashForHeight(txmgrNs, maturity)
+		if err != nil {
+			return err
+		}
+		blockTime, err := w.txStore.GetBlockHeaderTime(dbtx, &blockHash)
+		if err != nil {
+			return err
+		}
+		trace.Events = append(trace.Events, udb.TxTraceEvent{
+			Stage:  udb.TxTraceMature,
+			Time:   time.Unix(blockTime, 0),
+			Height: maturity,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return trace, nil
+}
@@ -91,6 +91,9 @@ type dbBIP0044Account struct {
 	lastReturnedInternalIndex uint32
 	name                      string
 	uniqueKey                 *kdf.Argon2idParams
+	gapLimit                  uint32
+	archived                  bool
+	staking                   bool
 }
 
 func (a *dbBIP0044Account) accountType() accountType { return a.dbAccountRow.acctType }
@@ -263,6 +266,11 @@ var (
 	// Emission key storage names
 	emissionKeysBucketName = []byte("emissionkeys")
 
+	// importedKeysBucketName is the bucket attaching imported keys and
+	// scripts to accounts other than the reserved imported account.
+	// Key: addr hash (32 bytes) => Value: account (4 bytes)
+	importedKeysBucketName = []byte("importedkeys")
+
 	// Used addresses (used bucket).  This was removed by database version 2.
 	usedAddrBucketName = []byte("usedaddrs")
 )
@@ -830,6 +838,9 @@ func fetchDBAccount(ns walletdb.ReadBucket, account uint32, dbVersion uint32) (d
 		lastRetInt := r.getAccountUint32Var(varsBucket, acctVarLastReturnedInternal)
 		name := r.getAccountStringVar(varsBucket, acctVarName)
 		kdfParams := r.getAccountKDFVar(varsBucket, acctVarKDF)
+		gapLimit := r.getAccountOptionalUint32Var(varsBucket, acctVarGapLimit)
+		archived := r.getAccountOptionalUint32Var(varsBucket, acctVarArchived)
+		staking := r.getAccountOptionalUint32Var(varsBucket, acctVarStaking)
 		if r.err != nil {
 			return nil, errors.E(errors.IO, err)
 		}
@@ -846,6 +857,9 @@ func fetchDBAccount(ns walletdb.ReadBucket, account uint32, dbVersion uint32) (d
 		a.lastReturnedInternalIndex = lastRetInt
 		a.name = name
 		a.uniqueKey = kdfParams
+		a.gapLimit = gapLimit
+		a.archived = archived != 0
+		a.staking = staking != 0
 
 		return a, nil
 	}
@@ -1038,6 +1052,12 @@ var (
 	acctVarLastReturnedInternal = []byte("intret")
 	acctVarName                 = []byte("name")
 	acctVarKDF                  = []byte("kdf-params")
+	acctVarGapLimit             = []byte("gaplimit")
+	acctVarArchived             = []byte("archived")
+	acctVarMultisigRequired     = []byte("msig-nreq")
+	acctVarMultisigCosigners    = []byte("msig-cosigners")
+	acctVarVaultDelay           = []byte("vault-delay")
+	acctVarVaultRecovery        = []byte("vault-recovery")
 )
 
 func putAccountUint32Var(varsBucket walletdb.ReadWriteBucket, varName []byte, value uint32) error {
@@ -1087,6 +1107,15 @@ func (r *accountVarReader) getAccountUint32Var(varsBucket walletdb.ReadBucket, v
 	return binary.LittleEndian.Uint32(value)
 }
 
+// getAccountOptionalUint32Var reads a uint32 variable which is not recorded for
+// every account, returning zero when it is missing.
+func (r *accountVarReader) getAccountOptionalUint32Var(varsBucket walletdb.ReadBucket, varName []byte) uint32 {
+	if r.err != nil || varsBucket.Get(varName) == nil {
+		return 0
+	}
+	return r.getAccountUint32Var(varsBucket, varName)
+}
+
 func (r *accountVarReader) getAccountStringVar(varsBucket walletdb.ReadBucket, varName []byte) string {
 	if r.err != nil {
 		return ""
@@ -1418,6 +1447,31 @@ func fetchAddrAccount(ns walletdb.ReadBucket, addressID []byte) (uint32, error)
 	return binary.LittleEndian.Uint32(val), nil
 }
 
+// fetchImportedKeyAccount returns the account an imported address has been
+// attached to, and whether the address is attached to any account.
+func fetchImportedKeyAccount(ns walletdb.ReadBucket, addressID []byte) (uint32, bool) {
+	bucket := ns.NestedReadBucket(importedKeysBucketName)
+
+	addrHash := sha256.Sum256(addressID)
+	val := bucket.Get(addrHash[:])
+	if len(val) != 4 {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint32(val), true
+}
+
+// putImportedKeyAccount attaches an imported address to an account.
+func putImportedKeyAccount(ns walletdb.ReadWriteBucket, addressID []byte, account uint32) error {
+	bucket := ns.NestedReadWriteBucket(importedKeysBucketName)
+
+	addrHash := sha256.Sum256(addressID)
+	err := bucket.Put(addrHash[:], uint32ToBytes(account))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
 // forEachAccountAddress calls the given function with each address of
 // the given account stored in the manager, breaking early on error.
 func forEachAccountAddress(ns walletdb.ReadBucket, account uint32, fn func(rowInterface any) error) error {
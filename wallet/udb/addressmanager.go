@@ -151,6 +151,12 @@ type accountInfo struct {
 	uniqueKey        *kdf.Argon2idParams
 	uniquePassHasher hash.Hash // blake2b-256 keyed hash with random bytes
 	uniquePassHash   []byte
+
+	// staking is set for accounts in the staking key domain, whose
+	// acctKeyEncrypted is sealed by the staking crypto key.  Their
+	// acctKeyPriv is set whenever the staking key domain is unlocked,
+	// regardless of whether the manager is locked.
+	staking bool
 }
 
 func argon2idKey(password []byte, k *kdf.Argon2idParams) keyType {
@@ -209,7 +215,9 @@ func unseal(key keyType, ciphertext []byte) ([]byte, error) {
 // AccountProperties contains properties associated with each account, such as
 // the account name, number, and the nubmer of derived and imported keys.  If no
 // address usage has been recorded on any of the external or internal branches,
-// the child index is ^uint32(0).
+// the child index is ^uint32(0).  GapLimit is zero unless an address gap limit
+// has been set for the account.  Archived accounts are hidden from default
+// account listings but retain their keys and transaction history.
 type AccountProperties = struct {
 	AccountNumber             uint32
 	AccountName               string
@@ -218,6 +226,8 @@ type AccountProperties = struct {
 	LastUsedInternalIndex     uint32
 	LastReturnedExternalIndex uint32
 	LastReturnedInternalIndex uint32
+	GapLimit                  uint32
+	Archived                  bool
 	ImportedKeyCount          uint32
 	AccountEncrypted          bool
 	AccountUnlocked           bool
@@ -346,6 +356,51 @@ type Manager struct {
 	privPassphraseHasher   hash.Hash
 	privPassphraseHasherMu sync.Mutex // protects privPassphraseHasher
 	privPassphraseHash     []byte     // protected by m.mtx, not privPassphraseHasherMu
+
+	// unlockScope limits the private keys provided while the manager is
+	// unlocked, and relockAfterUse locks the manager once every returned
+	// private key has been released.  heldPrivKeys counts the returned
+	// private keys which have not been released.  The policy is kept when
+	// the manager is locked, so that it is restricted before a following
+	// unlock provides any keys.
+	unlockScope    UnlockScope
+	relockAfterUse bool
+	heldPrivKeys   int
+
+	// stakingKey is the staking crypto key protecting the extended private
+	// keys of the staking key domain.  It is nil while the staking key
+	// domain is locked, and is not cleared by locking the manager.
+	stakingKey keyType
+
+	// argon2idOpts are the Argon2id parameters used to derive new master
+	// private keys from the private passphrase.
+	argon2idOpts Argon2idOptions
+}
+
+// UnlockScope describes the private keys an unlocked address manager
+// provides.
+type UnlockScope uint8
+
+const (
+	// UnlockAll provides every private key.
+	UnlockAll UnlockScope = iota
+
+	// UnlockStaking only provides the private keys of imported voting
+	// accounts, which sign the votes and revocations of tickets delegated
+	// to them, and of accounts in the staking key domain.
+	UnlockStaking
+)
+
+// String returns the name of the unlock scope.
+func (s UnlockScope) String() string {
+	switch s {
+	case UnlockAll:
+		return "all"
+	case UnlockStaking:
+		return "staking"
+	default:
+		return "unknown"
+	}
 }
 
 func zero(b []byte) {
@@ -359,8 +414,12 @@ func zero(b []byte) {
 //
 // This function MUST be called with the manager lock held for writes.
 func (m *Manager) lock() {
-	// Clear all of the account private keys.
+	// Clear all of the account private keys, except for those of the
+	// independently locked staking key domain.
 	for _, acctInfo := range m.acctInfo {
+		if acctInfo.staking {
+			continue
+		}
 		if acctInfo.acctKeyPriv != nil {
 			acctInfo.acctKeyPriv.Zero()
 		}
@@ -380,6 +439,77 @@ func (m *Manager) lock() {
 	m.privPassphraseHash = nil
 }
 
+// SetUnlockPolicy limits the private keys provided by an unlocked address
+// manager to those of a scope.  If relockAfterUse is set, the manager locks
+// itself after the private keys returned by the next operation accessing any
+// are released, and extended private keys are not provided.  The policy
+// remains in effect across locking and unlocking the manager until it is
+// replaced.
+func (m *Manager) SetUnlockPolicy(scope UnlockScope, relockAfterUse bool) {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	m.unlockScope = scope
+	m.relockAfterUse = relockAfterUse
+}
+
+// UnlockPolicy returns the scope of the private keys provided by the
+// unlocked address manager, and whether it locks itself after the next
+// operation accessing private keys.
+func (m *Manager) UnlockPolicy() (scope UnlockScope, relockAfterUse bool) {
+	defer m.mtx.RUnlock()
+	m.mtx.RLock()
+
+	return m.unlockScope, m.relockAfterUse
+}
+
+// checkUnlockScope returns an error with code Locked if the unlock scope does
+// not provide the private keys of an account.  The scope does not apply to
+// uniquely-encrypted accounts accessed while the manager is locked.
+//
+// This function MUST be called with the manager lock held.
+func (m *Manager) checkUnlockScope(ns walletdb.ReadBucket, account uint32) error {
+	if m.locked || m.unlockScope == UnlockAll {
+		return nil
+	}
+	if account != ImportedAddrAccount {
+		acctInfo, err := m.loadAccountInfo(ns, account)
+		if err != nil {
+			return err
+		}
+		if acctInfo.acctType == importedVoting || acctInfo.staking {
+			return nil
+		}
+	}
+	return errors.E(errors.Locked, "only staking keys are unlocked")
+}
+
+// releasePrivKeyFunc returns the function which zeros and releases a
+// returned private key, locking the manager once every key is released if
+// the manager relocks after use.
+//
+// This function MUST be called with the manager lock held for writes.
+func (m *Manager) releasePrivKeyFunc(key *secp256k1.PrivateKey) func() {
+	m.heldPrivKeys++
+	var released bool
+	return func() {
+		key.Zero()
+
+		defer m.mtx.Unlock()
+		m.mtx.Lock()
+		if released {
+			return
+		}
+		released = true
+		m.heldPrivKeys--
+		if m.heldPrivKeys == 0 && m.relockAfterUse && !m.locked {
+			m.lock()
+			log.Info("The wallet has been locked after completing a " +
+				"single-operation unlock")
+		}
+	}
+}
+
 // zeroSensitivePublicData performs a best try effort to remove and zero all
 // sensitive public data associated with the address manager such as
 // hierarchical deterministic extended public keys and the crypto public keys.
@@ -411,6 +541,7 @@ func (m *Manager) Close() error {
 	if !m.watchingOnly && !m.locked {
 		m.lock()
 	}
+	m.lockStaking()
 
 	// Attempt to clear sensitive public key material from memory too.
 	m.zeroSensitivePublicData()
@@ -450,6 +581,10 @@ func deriveKey(acctInfo *accountInfo, branch, index uint32, private bool) (*hdke
 				return nil, errors.E(errors.Locked,
 					"account with unique passphrase is locked")
 			}
+			if acctInfo.staking {
+				return nil, errors.E(errors.Locked,
+					"staking keys are locked")
+			}
 			if len(acctInfo.acctKeyEncrypted) != 0 {
 				return nil, errors.E(errors.Locked,
 					"private key %s/%d/%d is locked",
@@ -511,6 +646,7 @@ func (m *Manager) loadAccountInfo(ns walletdb.ReadBucket, account uint32) (*acco
 		acctInfo.acctKeyEncrypted = row.privKeyEncrypted
 		acctInfo.acctKeyPub = acctKeyPub
 		acctInfo.uniqueKey = row.uniqueKey
+		acctInfo.staking = row.staking
 		if acctInfo.uniqueKey != nil { // a passphrase hasher is required
 			hashKey := make([]byte, 32)
 			rand.Read(hashKey)
@@ -524,7 +660,16 @@ func (m *Manager) loadAccountInfo(ns walletdb.ReadBucket, account uint32) (*acco
 		return nil, errors.Errorf("unknown account type %T", row)
 	}
 
-	if !m.locked && len(acctInfo.acctKeyEncrypted) != 0 && acctInfo.uniqueKey == nil {
+	if acctInfo.staking && m.stakingKey != nil && len(acctInfo.acctKeyEncrypted) != 0 {
+		// Use the staking crypto key to decrypt the account private
+		// extended keys of the staking key domain.
+		err := m.decryptStakingAccountKey(account, acctInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !m.locked && len(acctInfo.acctKeyEncrypted) != 0 && acctInfo.uniqueKey == nil &&
+		!acctInfo.staking {
 		// Use the crypto private key to decrypt the account private
 		// extended keys.
 		decrypted, err := m.cryptoKeyPriv.Decrypt(acctInfo.acctKeyEncrypted)
@@ -584,6 +729,8 @@ func (m *Manager) AccountProperties(ns walletdb.ReadBucket, account uint32) (*Ac
 			props.LastUsedInternalIndex = a.lastUsedInternalIndex
 			props.LastReturnedExternalIndex = a.lastReturnedExternalIndex
 			props.LastReturnedInternalIndex = a.lastReturnedInternalIndex
+			props.GapLimit = a.gapLimit
+			props.Archived = a.archived
 		default:
 			return nil, errors.Errorf("unknown account type %T", a)
 		}
@@ -647,6 +794,13 @@ func (m *Manager) AccountExtendedPrivKey(dbtx walletdb.ReadTx, account uint32) (
 	if acctInfo.acctKeyPriv == nil && account > ImportedAddrAccount {
 		return nil, errors.E(errors.Invalid, "imported xpub account has no extended privkey")
 	}
+	if m.relockAfterUse && !m.locked {
+		return nil, errors.E(errors.Locked, "single-operation unlocks "+
+			"do not provide extended private keys")
+	}
+	if err := m.checkUnlockScope(ns, account); err != nil {
+		return nil, err
+	}
 	if acctInfo.acctKeyPriv == nil {
 		return nil, errors.E(errors.Locked, "unable to access account extended privkey")
 	}
@@ -678,6 +832,10 @@ func (m *Manager) CoinTypePrivKey(dbtx walletdb.ReadTx) (*hdkeychain.ExtendedKey
 	if m.watchingOnly {
 		return nil, errors.E(errors.WatchingOnly)
 	}
+	if m.relockAfterUse || m.unlockScope != UnlockAll {
+		return nil, errors.E(errors.Locked, "the coin type private key "+
+			"requires an unrestricted unlock")
+	}
 
 	ns := dbtx.ReadBucket(waddrmgrBucketKey)
 
@@ -897,6 +1055,8 @@ func (m *Manager) chainAddressRowToManaged(ns walletdb.ReadBucket, row *dbChainA
 		private = false
 	} else if set, unlocked := m.accountHasPassphrase(ns, row.account); set {
 		private = unlocked
+	} else if staking, unlocked := m.stakingAccount(ns, row.account); staking {
+		private = unlocked
 	}
 	addressKey, err := m.deriveKeyFromPath(ns, row.account, row.branch,
 		row.index, private)
@@ -997,6 +1157,15 @@ func (m *Manager) loadAddress(ns walletdb.ReadBucket, address stdaddr.Address) (
 		return nil, err
 	}
 
+	// Imported addresses attached to another account are managed as
+	// addresses of that account.
+	switch row := rowInterface.(type) {
+	case *dbImportedAddressRow:
+		row.account = importedKeyAccount(ns, id, row.account)
+	case *dbScriptAddressRow:
+		row.account = importedKeyAccount(ns, id, row.account)
+	}
+
 	// Create a new managed address for the specific type of address based
 	// on type.
 	return m.rowInterfaceToManaged(ns, rowInterface)
@@ -1030,15 +1199,57 @@ func (m *Manager) AddrAccount(ns walletdb.ReadBucket, address stdaddr.Address) (
 		return 0, err
 	}
 
-	return acct, nil
+	return importedKeyAccount(ns, id, acct), nil
+}
+
+// importedKeyAccount returns the account an imported address with the given
+// id has been attached to.  The recorded account is returned for all other
+// addresses.
+func importedKeyAccount(ns walletdb.ReadBucket, id []byte, account uint32) uint32 {
+	if account != ImportedAddrAccount {
+		return account
+	}
+	if attached, ok := fetchImportedKeyAccount(ns, id); ok {
+		return attached
+	}
+	return account
+}
+
+// AttachImportedAddress attaches an address imported by ImportPrivateKey,
+// ImportPublicKey, or ImportScript to another account.  Outputs paying to the
+// address are then credited to, and spendable from, that account rather than
+// the reserved imported account.  Attaching the address to the imported
+// account reverts this.
+func (m *Manager) AttachImportedAddress(ns walletdb.ReadWriteBucket, address stdaddr.Address, account uint32) error {
+	const op errors.Op = "udb.AttachImportedAddress"
+	id, err := addressID(normalizeAddress(address))
+	if err != nil {
+		return errors.E(op, err)
+	}
+	acct, err := fetchAddrAccount(ns, id)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if acct != ImportedAddrAccount {
+		return errors.E(op, errors.Invalid, errors.Errorf("address %v is not imported", address))
+	}
+	if _, err := fetchAccountName(ns, account); err != nil {
+		return errors.E(op, err)
+	}
+	err = putImportedKeyAccount(ns, id, account)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
 }
 
 // ChangePassphrase changes either the public or private passphrase to the
 // provided value depending on the private flag.  In order to change the private
-// password, the address manager must not be watching-only.  The new passphrase
-// keys are derived using the scrypt parameters in the options, so changing the
-// passphrase may be used to bump the computational difficulty needed to brute
-// force the passphrase.
+// password, the address manager must not be watching-only.  The new public
+// passphrase key is derived using the scrypt parameters for the network, and
+// the new private passphrase key using the manager's Argon2id options, so
+// changing the passphrase may be used to bump the computational difficulty
+// needed to brute force the passphrase.
 func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, newPassphrase []byte, private bool) error {
 	defer m.mtx.Unlock()
 	m.mtx.Lock()
@@ -1065,7 +1276,13 @@ func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, n
 
 	// Generate a new master key from the passphrase which is used to secure
 	// the actual secret keys.
-	newMasterKey, err := newSecretKey(&newPassphrase, scryptOptionsForNet(m.chainParams.Net))
+	var newMasterKey *snacl.SecretKey
+	var err error
+	if private {
+		newMasterKey, err = m.newArgon2idMasterKey(newPassphrase)
+	} else {
+		newMasterKey, err = newSecretKey(&newPassphrase, scryptOptionsForNet(m.chainParams.Net))
+	}
 	if err != nil {
 		return err
 	}
@@ -1566,7 +1783,7 @@ func (m *Manager) Unlock(ns walletdb.ReadBucket, passphrase []byte) error {
 		if len(acctInfo.acctKeyEncrypted) == 0 {
 			continue
 		}
-		if acctInfo.uniqueKey != nil {
+		if acctInfo.uniqueKey != nil || acctInfo.staking {
 			// not encrypted by m.cryptoKeyPriv
 			continue
 		}
@@ -1713,6 +1930,10 @@ func (m *Manager) SetAccountPassphrase(dbtx walletdb.ReadWriteTx, account uint32
 	if err != nil {
 		return err
 	}
+	if acctInfo.staking {
+		return errors.E(errors.Invalid, "account is in the staking "+
+			"key domain")
+	}
 	var needUnlocked string
 	switch {
 	case acctInfo.acctKeyPriv == nil && acctInfo.uniqueKey == nil:
@@ -2338,8 +2559,9 @@ func (m *Manager) RecordDerivedAddress(dbtx walletdb.ReadWriteTx, account, branc
 }
 
 // RenameAccount renames an account stored in the manager based on the
-// given account number with the given name.  If an account with the same name
-// already exists, ErrDuplicateAccount will be returned.
+// given account number with the given name.  Renaming an account to its
+// current name does nothing.  If another account with the same name already
+// exists, an error with code errors.Exist is returned.
 func (m *Manager) RenameAccount(ns walletdb.ReadWriteBucket, account uint32, name string) error {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
@@ -2349,15 +2571,18 @@ func (m *Manager) RenameAccount(ns walletdb.ReadWriteBucket, account uint32, nam
 		return errors.E(errors.Invalid, "reserved account")
 	}
 
-	// Check that account with the new name does not exist
-	_, err := fetchAccountByName(ns, name)
-	if err == nil {
-		return errors.E(errors.Exist, errors.Errorf("account named %q already exists", name))
-	}
 	// Validate account name
 	if err := ValidateAccountName(name); err != nil {
 		return err
 	}
+	// Check that another account with the new name does not exist
+	existing, err := fetchAccountByName(ns, name)
+	if err == nil {
+		if existing == account {
+			return nil
+		}
+		return errors.E(errors.Exist, errors.Errorf("account named %q already exists", name))
+	}
 
 	dbAcct, err := fetchDBAccount(ns, account, DBVersion)
 	if err != nil {
@@ -2398,6 +2623,242 @@ func (m *Manager) RenameAccount(ns walletdb.ReadWriteBucket, account uint32, nam
 	return nil
 }
 
+// SetAccountGapLimit records the unused address gap limit of an account,
+// overriding the gap limit of the wallet.  A zero gap limit removes the
+// account's gap limit.
+func (m *Manager) SetAccountGapLimit(ns walletdb.ReadWriteBucket, account, gapLimit uint32) error {
+	if isReservedAccountNum(account) {
+		return errors.E(errors.Invalid, "reserved account")
+	}
+
+	dbAcct, err := fetchDBAccount(ns, account, DBVersion)
+	if err != nil {
+		return err
+	}
+	switch dbAcct.(type) {
+	case *dbBIP0044Account:
+		acctVars := accountVarsBucket(ns, account)
+		if gapLimit == 0 {
+			err = acctVars.Delete(acctVarGapLimit)
+			if err != nil {
+				return errors.E(errors.IO, err)
+			}
+			return nil
+		}
+		return putAccountUint32Var(acctVars, acctVarGapLimit, gapLimit)
+	default:
+		return errors.Errorf("unknown account type %T", dbAcct)
+	}
+}
+
+// SetAccountArchived archives or unarchives an account.  Archiving only
+// records the account's archived property; its keys, addresses, and
+// transaction history are retained.
+func (m *Manager) SetAccountArchived(ns walletdb.ReadWriteBucket, account uint32, archived bool) error {
+	if isReservedAccountNum(account) {
+		return errors.E(errors.Invalid, "reserved account")
+	}
+
+	dbAcct, err := fetchDBAccount(ns, account, DBVersion)
+	if err != nil {
+		return err
+	}
+	switch dbAcct.(type) {
+	case *dbBIP0044Account:
+		acctVars := accountVarsBucket(ns, account)
+		if !archived {
+			err = acctVars.Delete(acctVarArchived)
+			if err != nil {
+				return errors.E(errors.IO, err)
+			}
+			return nil
+		}
+		return putAccountUint32Var(acctVars, acctVarArchived, 1)
+	default:
+		return errors.Errorf("unknown account type %T", dbAcct)
+	}
+}
+
+// SetAccountMultisig records an account as a multisig account, which pays to
+// P2SH multisig scripts requiring nRequired signatures from the account key
+// and the cosigner extended public keys.  The multisig parameters of an account
+// may only be set once, and errors with code errors.Exist are returned if they
+// have already been recorded.
+func (m *Manager) SetAccountMultisig(ns walletdb.ReadWriteBucket, account, nRequired uint32,
+	cosigners []*hdkeychain.ExtendedKey) error {
+
+	if isReservedAccountNum(account) {
+		return errors.E(errors.Invalid, "reserved account")
+	}
+	if len(cosigners) == 0 || len(cosigners) > 0xff {
+		return errors.E(errors.Invalid, "invalid number of cosigners")
+	}
+	if nRequired == 0 || nRequired > uint32(len(cosigners))+1 {
+		return errors.E(errors.Invalid, "invalid number of required signatures")
+	}
+
+	dbAcct, err := fetchDBAccount(ns, account, DBVersion)
+	if err != nil {
+		return err
+	}
+	switch dbAcct.(type) {
+	case *dbBIP0044Account:
+		acctVars := accountVarsBucket(ns, account)
+		if acctVars.Get(acctVarMultisigRequired) != nil {
+			return errors.E(errors.Exist, errors.Errorf("account %d is "+
+				"already a multisig account", account))
+		}
+		if acctVars.Get(acctVarVaultDelay) != nil {
+			return errors.E(errors.Invalid, errors.Errorf("account %d is "+
+				"a vault account", account))
+		}
+		// Cosigner keys are serialized as a count followed by each
+		// length-prefixed extended public key string.
+		v := []byte{byte(len(cosigners))}
+		for _, k := range cosigners {
+			if k.IsPrivate() {
+				return errors.E(errors.Invalid, "cosigner key is private")
+			}
+			xpub := k.String()
+			v = append(v, byte(len(xpub)))
+			v = append(v, xpub...)
+		}
+		err = acctVars.Put(acctVarMultisigCosigners, v)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+		return putAccountUint32Var(acctVars, acctVarMultisigRequired, nRequired)
+	default:
+		return errors.Errorf("unknown account type %T", dbAcct)
+	}
+}
+
+// AccountMultisig returns the number of required signatures and the cosigner
+// extended public keys of a multisig account.  Errors with code
+// errors.NotExist are returned for accounts which are not multisig accounts.
+func (m *Manager) AccountMultisig(ns walletdb.ReadBucket, account uint32) (uint32,
+	[]*hdkeychain.ExtendedKey, error) {
+
+	if isReservedAccountNum(account) {
+		return 0, nil, errors.E(errors.NotExist, "reserved account")
+	}
+	acctVars := ns.NestedReadBucket(acctVarsBucketName).
+		NestedReadBucket(uint32ToBytes(account))
+	if acctVars == nil || acctVars.Get(acctVarMultisigRequired) == nil {
+		return 0, nil, errors.E(errors.NotExist, errors.Errorf("account "+
+			"%d is not a multisig account", account))
+	}
+	var r accountVarReader
+	nRequired := r.getAccountUint32Var(acctVars, acctVarMultisigRequired)
+	if r.err != nil {
+		return 0, nil, r.err
+	}
+
+	v := acctVars.Get(acctVarMultisigCosigners)
+	if len(v) == 0 {
+		return 0, nil, errors.E(errors.IO, "missing multisig cosigners")
+	}
+	n := int(v[0])
+	v = v[1:]
+	cosigners := make([]*hdkeychain.ExtendedKey, 0, n)
+	for i := 0; i < n; i++ {
+		if len(v) == 0 || len(v) < 1+int(v[0]) {
+			return 0, nil, errors.E(errors.IO, "short multisig cosigners")
+		}
+		l := int(v[0])
+		k, err := hdkeychain.NewKeyFromString(string(v[1:1+l]), m.chainParams)
+		if err != nil {
+			return 0, nil, errors.E(errors.IO, err)
+		}
+		cosigners = append(cosigners, k)
+		v = v[1+l:]
+	}
+	return nRequired, cosigners, nil
+}
+
+// MaxVaultDelay is the maximum relative lock time, in blocks, of vault
+// accounts.
+const MaxVaultDelay = wire.SequenceLockTimeMask
+
+// SetAccountVault records an account as a vault account, which pays to P2SH
+// scripts spendable by the account key once outputs have delay
+// confirmations, or immediately by the key derived at the same path from the
+// optional recovery extended public key.  The vault parameters of an account
+// may only be set once, and errors with code errors.Exist are returned if
+// they have already been recorded.
+func (m *Manager) SetAccountVault(ns walletdb.ReadWriteBucket, account, delay uint32,
+	recovery *hdkeychain.ExtendedKey) error {
+
+	if isReservedAccountNum(account) {
+		return errors.E(errors.Invalid, "reserved account")
+	}
+	if delay == 0 || delay > MaxVaultDelay {
+		return errors.E(errors.Invalid, errors.Errorf("vault delay must be "+
+			"1 to %d blocks", MaxVaultDelay))
+	}
+	if recovery != nil && recovery.IsPrivate() {
+		return errors.E(errors.Invalid, "recovery key is private")
+	}
+
+	dbAcct, err := fetchDBAccount(ns, account, DBVersion)
+	if err != nil {
+		return err
+	}
+	switch dbAcct.(type) {
+	case *dbBIP0044Account:
+		acctVars := accountVarsBucket(ns, account)
+		if acctVars.Get(acctVarVaultDelay) != nil {
+			return errors.E(errors.Exist, errors.Errorf("account %d is "+
+				"already a vault account", account))
+		}
+		if acctVars.Get(acctVarMultisigRequired) != nil {
+			return errors.E(errors.Invalid, errors.Errorf("account %d is "+
+				"a multisig account", account))
+		}
+		if recovery != nil {
+			err = acctVars.Put(acctVarVaultRecovery, []byte(recovery.String()))
+			if err != nil {
+				return errors.E(errors.IO, err)
+			}
+		}
+		return putAccountUint32Var(acctVars, acctVarVaultDelay, delay)
+	default:
+		return errors.Errorf("unknown account type %T", dbAcct)
+	}
+}
+
+// AccountVault returns the relative lock time, in blocks, and the recovery
+// extended public key of a vault account.  The recovery key is nil for vaults
+// without one.  Errors with code errors.NotExist are returned for accounts
+// which are not vault accounts.
+func (m *Manager) AccountVault(ns walletdb.ReadBucket, account uint32) (uint32,
+	*hdkeychain.ExtendedKey, error) {
+
+	if isReservedAccountNum(account) {
+		return 0, nil, errors.E(errors.NotExist, "reserved account")
+	}
+	acctVars := ns.NestedReadBucket(acctVarsBucketName).
+		NestedReadBucket(uint32ToBytes(account))
+	if acctVars == nil || acctVars.Get(acctVarVaultDelay) == nil {
+		return 0, nil, errors.E(errors.NotExist, errors.Errorf("account "+
+			"%d is not a vault account", account))
+	}
+	var r accountVarReader
+	delay := r.getAccountUint32Var(acctVars, acctVarVaultDelay)
+	if r.err != nil {
+		return 0, nil, r.err
+	}
+	v := acctVars.Get(acctVarVaultRecovery)
+	if v == nil {
+		return delay, nil, nil
+	}
+	recovery, err := hdkeychain.NewKeyFromString(string(v), m.chainParams)
+	if err != nil {
+		return 0, nil, errors.E(errors.IO, err)
+	}
+	return delay, recovery, nil
+}
+
 // AccountName returns the account name for the given account number
 // stored in the manager.
 func (m *Manager) AccountName(ns walletdb.ReadBucket, account uint32) (string, error) {
@@ -2473,7 +2934,9 @@ func (m *Manager) PrivateKey(ns walletdb.ReadBucket, addr stdaddr.Address) (key
 	defer m.mtx.Unlock()
 	m.mtx.Lock()
 
-	// NOTE: A watching only Manager may have imported private data.
+	// NOTE: A watching only Manager may have imported private data for
+	// imported voting accounts.  Keys of all other accounts are never
+	// recorded, and a WatchingOnly error is returned for their addresses.
 
 	// At this point, there are two types of addresses that must be handled:
 	// those that are derived from a BIP0044 account and addresses for imported
@@ -2491,6 +2954,19 @@ func (m *Manager) PrivateKey(ns walletdb.ReadBucket, addr stdaddr.Address) (key
 	}
 	switch a := addrInterface.(type) {
 	case *dbChainAddressRow:
+		if m.watchingOnly {
+			acctInfo, err := m.loadAccountInfo(ns, a.account)
+			if err != nil {
+				return nil, nil, err
+			}
+			if acctInfo.acctType != importedVoting {
+				return nil, nil, errors.E(errors.WatchingOnly,
+					"no private key for watching-only account")
+			}
+		}
+		if err := m.checkUnlockScope(ns, a.account); err != nil {
+			return nil, nil, err
+		}
 		xpriv, err := m.deriveKeyFromPath(ns, a.account, a.branch, a.index, true)
 		if err != nil {
 			return nil, nil, err
@@ -2503,6 +2979,13 @@ func (m *Manager) PrivateKey(ns walletdb.ReadBucket, addr stdaddr.Address) (key
 		zero(serializedPriv)
 
 	case *dbImportedAddressRow:
+		if m.watchingOnly {
+			return nil, nil, errors.E(errors.WatchingOnly,
+				"no private key for watching-only wallet")
+		}
+		if err := m.checkUnlockScope(ns, ImportedAddrAccount); err != nil {
+			return nil, nil, err
+		}
 		privKeyBytes, err := m.cryptoKeyPriv.Decrypt(a.encryptedPrivKey)
 		if err != nil {
 			return nil, nil, errors.E(errors.Crypto, errors.Errorf("decrypt imported privkey: %v", err))
@@ -2519,7 +3002,7 @@ func (m *Manager) PrivateKey(ns walletdb.ReadBucket, addr stdaddr.Address) (key
 		return nil, nil, errors.E(errors.Invalid, errors.Errorf("address row type %T", addrInterface))
 	}
 
-	return key, key.Zero, nil
+	return key, m.releasePrivKeyFunc(key), nil
 }
 
 // HavePrivateKey returns whether the private key for a P2PK or P2PKH address is
@@ -2655,6 +3138,7 @@ func newManager(chainParams *chaincfg.Params, masterKeyPub *snacl.SecretKey,
 		cryptoKeyPrivEncrypted: cryptoKeyPrivEncrypted,
 		cryptoKeyPriv:          &cryptoKey{},
 		privPassphraseHasher:   privPassphraseHasher,
+		argon2idOpts:           argon2idOptionsForNet(chainParams.Net),
 	}
 }
 
@@ -2795,6 +3279,16 @@ func loadManager(ns walletdb.ReadBucket, pubPassphrase []byte, chainParams *chai
 	mgr := newManager(chainParams, &masterKeyPub, &masterKeyPriv,
 		cryptoKeyPub, cryptoKeyPrivEnc, passHasher)
 	mgr.watchingOnly = watchingOnly
+
+	// The staking key domain starts off unlocked when no staking
+	// passphrase is set.
+	stakingKey, stakingKDF, err := fetchStakingKey(ns)
+	if err != nil {
+		return nil, err
+	}
+	if stakingKey != nil && stakingKDF == nil {
+		mgr.stakingKey = stakingKey
+	}
 	return mgr, nil
 }
 
@@ -3114,6 +3608,11 @@ func createWatchOnly(ns walletdb.ReadWriteBucket, hdPubKey string, pubPassphrase
 		return err
 	}
 
+	// Watching-only wallets never record private key material.
+	if acctKeyPub.IsPrivate() {
+		return errors.E(errors.Invalid, "extended key must be an xpub")
+	}
+
 	// Ensure the branch keys can be derived for the provided seed according
 	// to BIP0044.
 	if err := checkBranchKeys(acctKeyPub); err != nil {
@@ -15,6 +15,7 @@ import (
 	"testing"
 
 	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/internal/snacl"
 	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
 	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/dcrutil"
@@ -468,6 +469,330 @@ func TestManagerImports(t *testing.T) {
 	testImports(tc)
 }
 
+// TestAttachImportedAddress tests that imported addresses attached to an
+// account are managed as addresses of that account.
+func TestAttachImportedAddress(t *testing.T) {
+	ctx := context.Background()
+	db, mgr, _, teardown, err := cloneDB(ctx, "attach_imported.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Close()
+
+	wif, err := dcrutil.DecodeWIF("PtWUqkS3apLoZUevFtG3Bwt6uyX8LQfYttycGkt2XCzgxquPATQgG",
+		mgr.ChainParams().PrivateKeyID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrBucketKey)
+		if err := mgr.Unlock(ns, privPassphrase); err != nil {
+			return err
+		}
+		defer mgr.Lock()
+
+		maddr, err := mgr.ImportPrivateKey(ns, wif)
+		if err != nil {
+			return err
+		}
+		addr := maddr.Address()
+
+		checkAccount := func(want uint32) {
+			t.Helper()
+			account, err := mgr.AddrAccount(ns, addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if account != want {
+				t.Errorf("AddrAccount returned account %d, want %d", account, want)
+			}
+			ma, err := mgr.Address(ns, addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ma.Account() != want {
+				t.Errorf("managed address has account %d, want %d", ma.Account(), want)
+			}
+		}
+		checkAccount(ImportedAddrAccount)
+
+		err = mgr.AttachImportedAddress(ns, addr, 0)
+		if err != nil {
+			return err
+		}
+		checkAccount(0)
+
+		// Private keys of attached addresses remain accessible.
+		_, done, err := mgr.PrivateKey(ns, addr)
+		if err != nil {
+			return err
+		}
+		done()
+
+		err = mgr.AttachImportedAddress(ns, addr, 100)
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("attaching to missing account: expected NotExist, got %v", err)
+		}
+		checkAccount(0)
+
+		err = mgr.AttachImportedAddress(ns, addr, ImportedAddrAccount)
+		if err != nil {
+			return err
+		}
+		checkAccount(ImportedAddrAccount)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUnlockPolicy(t *testing.T) {
+	ctx := context.Background()
+	db, mgr, _, teardown, err := cloneDB(ctx, "unlock_policy.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Close()
+
+	wif, err := dcrutil.DecodeWIF("PtWUqkS3apLoZUevFtG3Bwt6uyX8LQfYttycGkt2XCzgxquPATQgG",
+		mgr.ChainParams().PrivateKeyID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrBucketKey)
+		if err := mgr.Unlock(ns, privPassphrase); err != nil {
+			return err
+		}
+		defer mgr.Lock()
+
+		maddr, err := mgr.ImportPrivateKey(ns, wif)
+		if err != nil {
+			return err
+		}
+		addr := maddr.Address()
+
+		// Staking unlocks do not provide keys of other accounts.
+		mgr.SetUnlockPolicy(UnlockStaking, false)
+		_, _, err = mgr.PrivateKey(ns, addr)
+		if !errors.Is(err, errors.Locked) {
+			t.Errorf("staking unlock provided imported key: %v", err)
+		}
+		_, err = mgr.AccountExtendedPrivKey(tx, 0)
+		if !errors.Is(err, errors.Locked) {
+			t.Errorf("staking unlock provided account xpriv: %v", err)
+		}
+		_, err = mgr.CoinTypePrivKey(tx)
+		if !errors.Is(err, errors.Locked) {
+			t.Errorf("staking unlock provided coin type xpriv: %v", err)
+		}
+
+		// Single-operation unlocks lock the manager after every key
+		// returned is released.
+		mgr.SetUnlockPolicy(UnlockAll, true)
+		_, err = mgr.AccountExtendedPrivKey(tx, 0)
+		if !errors.Is(err, errors.Locked) {
+			t.Errorf("single-operation unlock provided account xpriv: %v", err)
+		}
+		_, done1, err := mgr.PrivateKey(ns, addr)
+		if err != nil {
+			return err
+		}
+		_, done2, err := mgr.PrivateKey(ns, addr)
+		if err != nil {
+			return err
+		}
+		done1()
+		done1()
+		if mgr.IsLocked() {
+			t.Error("manager locked while a private key is held")
+		}
+		done2()
+		if !mgr.IsLocked() {
+			t.Error("manager unlocked after single operation")
+		}
+
+		// The policy is kept when locked and remains until replaced.
+		scope, relock := mgr.UnlockPolicy()
+		if scope != UnlockAll || !relock {
+			t.Errorf("policy after relocking is %v, %v", scope, relock)
+		}
+		mgr.SetUnlockPolicy(UnlockAll, false)
+		if err := mgr.Unlock(ns, privPassphrase); err != nil {
+			return err
+		}
+		_, done, err := mgr.PrivateKey(ns, addr)
+		if err != nil {
+			return err
+		}
+		done()
+		if mgr.IsLocked() {
+			t.Error("unrestricted unlock locked after use")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStakingKeyDomain(t *testing.T) {
+	ctx := context.Background()
+	db, mgr, _, teardown, err := cloneDB(ctx, "staking_key_domain.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Close()
+
+	stakingPass := []byte("staking")
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+		// Without a staking passphrase, the staking key domain is
+		// unlocked and can not be locked.
+		if mgr.StakingLocked() {
+			t.Error("staking keys without a passphrase are locked")
+		}
+		err := mgr.LockStaking(ns)
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("locked staking keys without a passphrase: %v", err)
+		}
+
+		if err := mgr.Unlock(ns, privPassphrase); err != nil {
+			return err
+		}
+		if err := mgr.SetStakingAccount(ns, 0, true); err != nil {
+			return err
+		}
+		if err := mgr.SetStakingPassphrase(ns, stakingPass); err != nil {
+			return err
+		}
+		mgr.Lock()
+
+		// Locking the wallet keeps the staking keys unlocked.
+		_, err = mgr.AccountExtendedPrivKey(tx, 0)
+		if err != nil {
+			t.Errorf("staking account locked with the wallet: %v", err)
+		}
+
+		if err := mgr.LockStaking(ns); err != nil {
+			return err
+		}
+		_, err = mgr.AccountExtendedPrivKey(tx, 0)
+		if !errors.Is(err, errors.Locked) {
+			t.Errorf("locked staking keys provided account xpriv: %v", err)
+		}
+
+		// The wallet passphrase does not unlock the staking keys.
+		if err := mgr.Unlock(ns, privPassphrase); err != nil {
+			return err
+		}
+		_, err = mgr.AccountExtendedPrivKey(tx, 0)
+		if !errors.Is(err, errors.Locked) {
+			t.Errorf("wallet passphrase unlocked staking keys: %v", err)
+		}
+		mgr.Lock()
+
+		err = mgr.UnlockStaking(ns, privPassphrase)
+		if !errors.Is(err, errors.Passphrase) {
+			t.Errorf("unlocked staking keys with wrong passphrase: %v", err)
+		}
+		if err := mgr.UnlockStaking(ns, stakingPass); err != nil {
+			return err
+		}
+		_, err = mgr.AccountExtendedPrivKey(tx, 0)
+		if err != nil {
+			t.Errorf("unlocked staking keys did not provide xpriv: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpgradePassphraseKDF(t *testing.T) {
+	ctx := context.Background()
+	db, mgr, _, teardown, err := cloneDB(ctx, "upgrade_passphrase_kdf.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Close()
+
+	opts := Argon2idOptions{Time: 1, Memory: 64, Threads: 1}
+	mgr.SetArgon2idOptions(opts)
+	info, err := mgr.KDFInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Algorithm != KDFScrypt || !info.UpgradePending {
+		t.Fatalf("new manager KDF is %s, upgrade pending %v",
+			info.Algorithm, info.UpgradePending)
+	}
+
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+		err := mgr.UpgradePassphraseKDF(ns, privPassphrase)
+		if !errors.Is(err, errors.Locked) {
+			t.Errorf("upgraded KDF of locked manager: %v", err)
+		}
+		if err := mgr.Unlock(ns, privPassphrase); err != nil {
+			return err
+		}
+		err = mgr.UpgradePassphraseKDF(ns, []byte("bogus"))
+		if !errors.Is(err, errors.Passphrase) {
+			t.Errorf("upgraded KDF with wrong passphrase: %v", err)
+		}
+		if err := mgr.UpgradePassphraseKDF(ns, privPassphrase); err != nil {
+			return err
+		}
+		return mgr.Lock()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err = mgr.KDFInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Algorithm != KDFArgon2id || info.UpgradePending ||
+		info.Time != opts.Time || info.Memory != opts.Memory {
+		t.Errorf("upgraded KDF info is %+v", info)
+	}
+
+	// The upgraded master key must be recorded and unlock the manager.
+	err = walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrBucketKey)
+		_, privParams, err := fetchMasterKeyParams(ns)
+		if err != nil {
+			return err
+		}
+		var masterKeyPriv snacl.SecretKey
+		if err := masterKeyPriv.Unmarshal(privParams); err != nil {
+			return err
+		}
+		if masterKeyPriv.Parameters.Argon2id == nil {
+			t.Error("recorded master key params do not describe Argon2id")
+		}
+		if err := mgr.Unlock(ns, privPassphrase); err != nil {
+			return err
+		}
+		return mgr.Lock()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 // TestImportVotingAccount tests that importing voting accounts works properly.
 //
 // This function expects the manager is already locked when called and returns
@@ -933,8 +1258,21 @@ func testRenameAccount(tc *testContext, wb walletdb.ReadWriteBucket) {
 		tc.t.Fatalf("RenameAccount account name mismatch -- got %s, want %s",
 			newName, testName)
 	}
-	// Test duplicate account name error
+	// Renaming an account to its current name does nothing
 	err = tc.manager.RenameAccount(wb, tc.account, testName)
+	if err != nil {
+		tc.t.Fatalf("RenameAccount: unexpected error: %v", err)
+	}
+	// Test duplicate account name error
+	otherAccount := uint32(0)
+	if tc.account == otherAccount {
+		otherAccount = 1
+	}
+	otherName, err := tc.manager.AccountName(rb, otherAccount)
+	if err != nil {
+		tc.t.Fatalf("AccountName: unexpected error: %v", err)
+	}
+	err = tc.manager.RenameAccount(wb, tc.account, otherName)
 	if !errors.Is(err, errors.Exist) {
 		tc.t.Fatalf("RenameAccount: unexpected error: %v", err)
 	}
@@ -1279,3 +1617,220 @@ func TestMain(m *testing.M) {
 	teardown()
 	os.Exit(exitCode)
 }
+
+func TestSetAccountGapLimit(t *testing.T) {
+	ctx := context.Background()
+	db, mgr, _, teardown, err := cloneDB(ctx, "account_gap_limit.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Close()
+
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+		checkGapLimit := func(want uint32) {
+			t.Helper()
+			props, err := mgr.AccountProperties(ns, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if props.GapLimit != want {
+				t.Fatalf("account gap limit is %d, want %d", props.GapLimit, want)
+			}
+		}
+
+		checkGapLimit(0)
+		if err := mgr.SetAccountGapLimit(ns, 0, 1000); err != nil {
+			return err
+		}
+		checkGapLimit(1000)
+		if err := mgr.SetAccountGapLimit(ns, 0, 0); err != nil {
+			return err
+		}
+		checkGapLimit(0)
+
+		err := mgr.SetAccountGapLimit(ns, ImportedAddrAccount, 1000)
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("setting imported account gap limit: expected Invalid, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetAccountArchived(t *testing.T) {
+	ctx := context.Background()
+	db, mgr, _, teardown, err := cloneDB(ctx, "account_archived.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Close()
+
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+		checkArchived := func(want bool) {
+			t.Helper()
+			props, err := mgr.AccountProperties(ns, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if props.Archived != want {
+				t.Fatalf("account archived is %v, want %v", props.Archived, want)
+			}
+		}
+
+		checkArchived(false)
+		if err := mgr.SetAccountArchived(ns, 0, true); err != nil {
+			return err
+		}
+		checkArchived(true)
+
+		// Renaming an archived account to its current name does
+		// nothing and leaves it archived.
+		name, err := mgr.AccountName(ns, 0)
+		if err != nil {
+			return err
+		}
+		if err := mgr.RenameAccount(ns, 0, name); err != nil {
+			return err
+		}
+		checkArchived(true)
+
+		if err := mgr.SetAccountArchived(ns, 0, false); err != nil {
+			return err
+		}
+		checkArchived(false)
+
+		err = mgr.SetAccountArchived(ns, ImportedAddrAccount, true)
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("archiving imported account: expected Invalid, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAccountMultisig(t *testing.T) {
+	ctx := context.Background()
+	db, mgr, _, teardown, err := cloneDB(ctx, "account_multisig.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Close()
+
+	params := chaincfg.TestNet3Params()
+	var cosigners []*hdkeychain.ExtendedKey
+	for i := byte(1); i <= 2; i++ {
+		master, err := hdkeychain.NewMaster(bytes.Repeat([]byte{i}, 32), params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cosigners = append(cosigners, master.Neuter())
+	}
+
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+		_, _, err := mgr.AccountMultisig(ns, 0)
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("non-multisig account: expected NotExist, got %v", err)
+		}
+		err = mgr.SetAccountMultisig(ns, 0, 4, cosigners)
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("4-of-3 multisig: expected Invalid, got %v", err)
+		}
+
+		if err := mgr.SetAccountMultisig(ns, 0, 2, cosigners); err != nil {
+			return err
+		}
+		nRequired, keys, err := mgr.AccountMultisig(ns, 0)
+		if err != nil {
+			return err
+		}
+		if nRequired != 2 || len(keys) != len(cosigners) {
+			t.Fatalf("account is %d-of-%d multisig, want 2-of-%d",
+				nRequired, len(keys)+1, len(cosigners)+1)
+		}
+		for i := range keys {
+			if keys[i].String() != cosigners[i].String() {
+				t.Errorf("cosigner %d is %v, want %v", i, keys[i], cosigners[i])
+			}
+		}
+
+		err = mgr.SetAccountMultisig(ns, 0, 1, cosigners)
+		if !errors.Is(err, errors.Exist) {
+			t.Errorf("resetting multisig: expected Exist, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAccountVault(t *testing.T) {
+	ctx := context.Background()
+	db, mgr, _, teardown, err := cloneDB(ctx, "account_vault.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Close()
+
+	params := chaincfg.TestNet3Params()
+	master, err := hdkeychain.NewMaster(bytes.Repeat([]byte{1}, 32), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recovery := master.Neuter()
+
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+		_, _, err := mgr.AccountVault(ns, 0)
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("non-vault account: expected NotExist, got %v", err)
+		}
+		err = mgr.SetAccountVault(ns, 0, MaxVaultDelay+1, nil)
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("excessive delay: expected Invalid, got %v", err)
+		}
+		err = mgr.SetAccountVault(ns, 0, 144, master)
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("private recovery key: expected Invalid, got %v", err)
+		}
+
+		if err := mgr.SetAccountVault(ns, 0, 144, recovery); err != nil {
+			return err
+		}
+		delay, key, err := mgr.AccountVault(ns, 0)
+		if err != nil {
+			return err
+		}
+		if delay != 144 || key == nil || key.String() != recovery.String() {
+			t.Fatalf("vault delay %d recovery %v, want 144 %v", delay, key, recovery)
+		}
+
+		err = mgr.SetAccountVault(ns, 0, 1, nil)
+		if !errors.Is(err, errors.Exist) {
+			t.Errorf("resetting vault: expected Exist, got %v", err)
+		}
+		err = mgr.SetAccountMultisig(ns, 0, 1, []*hdkeychain.ExtendedKey{recovery})
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("multisig vault: expected Invalid, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// changeAccountsBucketKey is the bucket key for storing the accounts
+	// which change of a coin type spent from a source account is redirected
+	// to.
+	// Key: source account (4 bytes) | coin type (1 byte) → Value: change
+	// account (4 bytes)
+	changeAccountsBucketKey = []byte("changeaccounts")
+)
+
+// ChangeAccount describes the account which receives all change of a coin type
+// from transactions spending the outputs of a source account.
+type ChangeAccount struct {
+	Account       uint32
+	CoinType      cointype.CoinType
+	ChangeAccount uint32
+}
+
+func keyChangeAccount(account uint32, ct cointype.CoinType) []byte {
+	k := make([]byte, 5)
+	byteOrder.PutUint32(k, account)
+	k[4] = byte(ct)
+	return k
+}
+
+func readChangeAccount(k, v []byte) (*ChangeAccount, error) {
+	if len(k) != 5 || len(v) != 4 {
+		return nil, errors.E(errors.IO, "bad change account record")
+	}
+	return &ChangeAccount{
+		Account:       byteOrder.Uint32(k),
+		CoinType:      cointype.CoinType(k[4]),
+		ChangeAccount: byteOrder.Uint32(v),
+	}, nil
+}
+
+// PutChangeAccount records the account which change of a coin type from the
+// source account is redirected to, replacing any previous redirection.
+func PutChangeAccount(dbtx walletdb.ReadWriteTx, c *ChangeAccount) error {
+	const op errors.Op = "udb.PutChangeAccount"
+
+	if c.Account == c.ChangeAccount {
+		return errors.E(op, errors.Invalid, "change account must differ from the source account")
+	}
+
+	b := dbtx.ReadWriteBucket(changeAccountsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing change accounts bucket")
+	}
+	v := make([]byte, 4)
+	byteOrder.PutUint32(v, c.ChangeAccount)
+	err := b.Put(keyChangeAccount(c.Account, c.CoinType), v)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteChangeAccount removes the redirection of change of a coin type from
+// the source account, returning its change to the source account.
+func DeleteChangeAccount(dbtx walletdb.ReadWriteTx, account uint32, ct cointype.CoinType) error {
+	const op errors.Op = "udb.DeleteChangeAccount"
+
+	b := dbtx.ReadWriteBucket(changeAccountsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing change accounts bucket")
+	}
+	err := b.Delete(keyChangeAccount(account, ct))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// ChangeAccountFor returns the account which change of a coin type from the
+// source account is redirected to.  An error with kind NotExist is returned if
+// the change is not redirected.
+func ChangeAccountFor(dbtx walletdb.ReadTx, account uint32, ct cointype.CoinType) (uint32, error) {
+	const op errors.Op = "udb.ChangeAccountFor"
+
+	var v []byte
+	k := keyChangeAccount(account, ct)
+	if b := dbtx.ReadBucket(changeAccountsBucketKey); b != nil {
+		v = b.Get(k)
+	}
+	if v == nil {
+		return 0, errors.E(op, errors.NotExist,
+			errors.Errorf("change of account %d is not redirected", account))
+	}
+	c, err := readChangeAccount(k, v)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return c.ChangeAccount, nil
+}
+
+// ForEachChangeAccount calls f with every change redirection, in increasing
+// source account and coin type order.  Iteration stops if f returns an error,
+// which is returned to the caller.
+func ForEachChangeAccount(dbtx walletdb.ReadTx, f func(*ChangeAccount) error) error {
+	const op errors.Op = "udb.ForEachChangeAccount"
+
+	b := dbtx.ReadBucket(changeAccountsBucketKey)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		c, err := readChangeAccount(k, v)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return f(c)
+	})
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestChangeAccounts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	put := func(c *ChangeAccount) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return PutChangeAccount(dbtx, c)
+		})
+	}
+	redirects := func() []ChangeAccount {
+		var redirects []ChangeAccount
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			return ForEachChangeAccount(dbtx, func(c *ChangeAccount) error {
+				got, err := ChangeAccountFor(dbtx, c.Account, c.CoinType)
+				if err != nil {
+					return err
+				}
+				if got != c.ChangeAccount {
+					t.Errorf("ChangeAccountFor(%d, %v) = %d, iterated %+v",
+						c.Account, c.CoinType, got, c)
+				}
+				redirects = append(redirects, *c)
+				return nil
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return redirects
+	}
+
+	if got := redirects(); len(got) != 0 {
+		t.Fatalf("new database has change redirections %+v", got)
+	}
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		_, err := ChangeAccountFor(dbtx, 0, 0)
+		return err
+	})
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("lookup of unredirected account: expected NotExist error, got %v", err)
+	}
+
+	if err := put(&ChangeAccount{Account: 2, CoinType: 1, ChangeAccount: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := put(&ChangeAccount{Account: 2, CoinType: 0, ChangeAccount: 4}); err != nil {
+		t.Fatal(err)
+	}
+	// Redirecting change of an account and coin type again replaces the
+	// previous redirection.
+	if err := put(&ChangeAccount{Account: 2, CoinType: 1, ChangeAccount: 5}); err != nil {
+		t.Fatal(err)
+	}
+	want := []ChangeAccount{
+		{Account: 2, CoinType: 0, ChangeAccount: 4},
+		{Account: 2, CoinType: 1, ChangeAccount: 5},
+	}
+	if got := redirects(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("redirections %+v, want %+v", got, want)
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return DeleteChangeAccount(dbtx, 2, 0)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = want[1:]
+	if got := redirects(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("redirections %+v, want %+v", got, want)
+	}
+
+	err = put(&ChangeAccount{Account: 1, ChangeAccount: 1})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("redirection to the source account: expected Invalid error, got %v", err)
+	}
+}
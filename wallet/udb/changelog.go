@@ -0,0 +1,592 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// changelogBucketKey is the bucket key of the changelog recording the
+	// mutations of the wallet database for incremental backups.  The bucket
+	// records the sequence number of the last recorded mutation and the
+	// sequence number through which the changelog has been pruned, and holds
+	// a nested bucket of the recorded mutations.
+	// Nested entries bucket: Key: sequence number (8 bytes) → Value:
+	// serialized ChangelogEntry
+	changelogBucketKey        = []byte("changelog")
+	changelogEntriesBucketKey = []byte("entries")
+	changelogSeqKey           = []byte("seq")
+	changelogPrunedKey        = []byte("pruned")
+)
+
+// ChangelogOp describes the mutation recorded by a changelog entry.
+type ChangelogOp uint8
+
+// Changelog operations.
+const (
+	ChangelogPut ChangelogOp = iota + 1
+	ChangelogDelete
+	ChangelogCreateBucket
+	ChangelogDeleteBucket
+)
+
+// ChangelogEntry is a recorded mutation of the wallet database.  Bucket is the
+// path of bucket keys, beginning with a top-level bucket, of the modified
+// bucket.  Key is the modified key or the name of the created or deleted
+// bucket, and Value is the value of put keys.  The Bucket path of created and
+// deleted top-level buckets is empty.
+type ChangelogEntry struct {
+	Seq    uint64
+	Op     ChangelogOp
+	Bucket [][]byte
+	Key    []byte
+	Value  []byte
+}
+
+func appendChangelogBytes(v, b []byte) []byte {
+	var l [4]byte
+	byteOrder.PutUint32(l[:], uint32(len(b)))
+	v = append(v, l[:]...)
+	return append(v, b...)
+}
+
+// serializeChangelogPath serializes a path of keys.  The serialization is
+// unique for each path and is used to identify the keys of entries.
+func serializeChangelogPath(v []byte, path [][]byte) []byte {
+	var n [4]byte
+	byteOrder.PutUint32(n[:], uint32(len(path)))
+	v = append(v, n[:]...)
+	for _, p := range path {
+		v = appendChangelogBytes(v, p)
+	}
+	return v
+}
+
+func (e *ChangelogEntry) serialize() []byte {
+	v := []byte{byte(e.Op)}
+	v = serializeChangelogPath(v, e.Bucket)
+	v = appendChangelogBytes(v, e.Key)
+	return appendChangelogBytes(v, e.Value)
+}
+
+func deserializeChangelogEntry(seq uint64, v []byte) (*ChangelogEntry, error) {
+	errShort := errors.E(errors.IO, errors.Errorf("short changelog entry %d", seq))
+	readBytes := func() ([]byte, bool) {
+		if len(v) < 4 {
+			return nil, false
+		}
+		l := byteOrder.Uint32(v)
+		if uint64(len(v)-4) < uint64(l) {
+			return nil, false
+		}
+		b := append([]byte(nil), v[4:4+l]...)
+		v = v[4+l:]
+		return b, true
+	}
+
+	if len(v) < 1+4 {
+		return nil, errShort
+	}
+	e := &ChangelogEntry{Seq: seq, Op: ChangelogOp(v[0])}
+	n := byteOrder.Uint32(v[1:])
+	v = v[1+4:]
+	if uint64(n) > uint64(len(v))/4 {
+		return nil, errShort
+	}
+	e.Bucket = make([][]byte, n)
+	for i := range e.Bucket {
+		var ok bool
+		if e.Bucket[i], ok = readBytes(); !ok {
+			return nil, errShort
+		}
+	}
+	var ok bool
+	if e.Key, ok = readBytes(); !ok {
+		return nil, errShort
+	}
+	if e.Value, ok = readBytes(); !ok {
+		return nil, errShort
+	}
+	return e, nil
+}
+
+// fullPath returns the path of the bucket or key modified by the entry.
+func (e *ChangelogEntry) fullPath() [][]byte {
+	path := make([][]byte, len(e.Bucket), len(e.Bucket)+1)
+	copy(path, e.Bucket)
+	return append(path, e.Key)
+}
+
+func hasChangelogPathPrefix(path, prefix [][]byte) bool {
+	if len(path) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if !bytes.Equal(path[i], prefix[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func changelogUint64(b walletdb.ReadBucket, key []byte) uint64 {
+	v := b.Get(key)
+	if len(v) != 8 {
+		return 0
+	}
+	return byteOrder.Uint64(v)
+}
+
+// ChangelogDB is a walletdb.DB which records every mutation made by its
+// read-write transactions in the changelog of the wallet database.  Mutations
+// of databases which have not been upgraded to include the changelog are not
+// recorded.
+type ChangelogDB struct {
+	walletdb.DB
+
+	mu      sync.Mutex
+	changed chan struct{}
+}
+
+// NewChangelogDB returns a ChangelogDB recording the mutations of db.
+func NewChangelogDB(db walletdb.DB) *ChangelogDB {
+	return &ChangelogDB{DB: db, changed: make(chan struct{})}
+}
+
+// Changed returns a channel which is closed when the next transaction which
+// records changes is committed.
+func (db *ChangelogDB) Changed() <-chan struct{} {
+	db.mu.Lock()
+	c := db.changed
+	db.mu.Unlock()
+	return c
+}
+
+func (db *ChangelogDB) notify() {
+	db.mu.Lock()
+	close(db.changed)
+	db.changed = make(chan struct{})
+	db.mu.Unlock()
+}
+
+// BeginReadWriteTx opens a database read+write transaction which records its
+// mutations in the changelog.
+func (db *ChangelogDB) BeginReadWriteTx() (walletdb.ReadWriteTx, error) {
+	tx, err := db.DB.BeginReadWriteTx()
+	if err != nil {
+		return nil, err
+	}
+	return &changelogTx{ReadWriteTx: tx, db: db}, nil
+}
+
+type changelogTx struct {
+	walletdb.ReadWriteTx
+	db       *ChangelogDB
+	seq      uint64
+	recorded bool
+}
+
+// record appends an entry to the changelog.  Nothing is recorded before the
+// changelog bucket is created.
+func (tx *changelogTx) record(op ChangelogOp, bucket [][]byte, key, value []byte) error {
+	b := tx.ReadWriteTx.ReadWriteBucket(changelogBucketKey)
+	if b == nil {
+		return nil
+	}
+	if !tx.recorded {
+		tx.seq = changelogUint64(b, changelogSeqKey)
+	}
+	tx.seq++
+	e := ChangelogEntry{Seq: tx.seq, Op: op, Bucket: bucket, Key: key, Value: value}
+	k := make([]byte, 8)
+	byteOrder.PutUint64(k, tx.seq)
+	err := b.NestedReadWriteBucket(changelogEntriesBucketKey).Put(k, e.serialize())
+	if err == nil {
+		err = b.Put(changelogSeqKey, k)
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	tx.recorded = true
+	return nil
+}
+
+func (tx *changelogTx) ReadWriteBucket(key []byte) walletdb.ReadWriteBucket {
+	b := tx.ReadWriteTx.ReadWriteBucket(key)
+	if b == nil || bytes.Equal(key, changelogBucketKey) {
+		return b
+	}
+	return &changelogBucket{ReadWriteBucket: b, tx: tx, path: [][]byte{bytes.Clone(key)}}
+}
+
+func (tx *changelogTx) CreateTopLevelBucket(key []byte) (walletdb.ReadWriteBucket, error) {
+	b, err := tx.ReadWriteTx.CreateTopLevelBucket(key)
+	if err != nil || bytes.Equal(key, changelogBucketKey) {
+		return b, err
+	}
+	err = tx.record(ChangelogCreateBucket, nil, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &changelogBucket{ReadWriteBucket: b, tx: tx, path: [][]byte{bytes.Clone(key)}}, nil
+}
+
+func (tx *changelogTx) DeleteTopLevelBucket(key []byte) error {
+	err := tx.ReadWriteTx.DeleteTopLevelBucket(key)
+	if err != nil || bytes.Equal(key, changelogBucketKey) {
+		return err
+	}
+	return tx.record(ChangelogDeleteBucket, nil, key, nil)
+}
+
+func (tx *changelogTx) Commit() error {
+	err := tx.ReadWriteTx.Commit()
+	if err == nil && tx.recorded {
+		tx.db.notify()
+	}
+	return err
+}
+
+type changelogBucket struct {
+	walletdb.ReadWriteBucket
+	tx   *changelogTx
+	path [][]byte
+}
+
+func (b *changelogBucket) nested(key []byte, nb walletdb.ReadWriteBucket) walletdb.ReadWriteBucket {
+	path := make([][]byte, len(b.path)+1)
+	copy(path, b.path)
+	path[len(b.path)] = bytes.Clone(key)
+	return &changelogBucket{ReadWriteBucket: nb, tx: b.tx, path: path}
+}
+
+func (b *changelogBucket) NestedReadWriteBucket(key []byte) walletdb.ReadWriteBucket {
+	nb := b.ReadWriteBucket.NestedReadWriteBucket(key)
+	if nb == nil {
+		return nil
+	}
+	return b.nested(key, nb)
+}
+
+func (b *changelogBucket) CreateBucket(key []byte) (walletdb.ReadWriteBucket, error) {
+	nb, err := b.ReadWriteBucket.CreateBucket(key)
+	if err != nil {
+		return nil, err
+	}
+	err = b.tx.record(ChangelogCreateBucket, b.path, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	return b.nested(key, nb), nil
+}
+
+func (b *changelogBucket) CreateBucketIfNotExists(key []byte) (walletdb.ReadWriteBucket, error) {
+	nb, err := b.ReadWriteBucket.CreateBucketIfNotExists(key)
+	if err != nil {
+		return nil, err
+	}
+	err = b.tx.record(ChangelogCreateBucket, b.path, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	return b.nested(key, nb), nil
+}
+
+func (b *changelogBucket) DeleteNestedBucket(key []byte) error {
+	err := b.ReadWriteBucket.DeleteNestedBucket(key)
+	if err != nil {
+		return err
+	}
+	return b.tx.record(ChangelogDeleteBucket, b.path, key, nil)
+}
+
+func (b *changelogBucket) Put(key, value []byte) error {
+	err := b.ReadWriteBucket.Put(key, value)
+	if err != nil {
+		return err
+	}
+	return b.tx.record(ChangelogPut, b.path, key, value)
+}
+
+func (b *changelogBucket) Delete(key []byte) error {
+	err := b.ReadWriteBucket.Delete(key)
+	if err != nil {
+		return err
+	}
+	return b.tx.record(ChangelogDelete, b.path, key, nil)
+}
+
+func (b *changelogBucket) ReadWriteCursor() walletdb.ReadWriteCursor {
+	return &changelogCursor{ReadWriteCursor: b.ReadWriteBucket.ReadWriteCursor(), bucket: b}
+}
+
+// changelogCursor records deletes of the key at the cursor.
+type changelogCursor struct {
+	walletdb.ReadWriteCursor
+	bucket *changelogBucket
+	key    []byte
+}
+
+func (c *changelogCursor) First() (key, value []byte) {
+	key, value = c.ReadWriteCursor.First()
+	c.key = key
+	return
+}
+
+func (c *changelogCursor) Last() (key, value []byte) {
+	key, value = c.ReadWriteCursor.Last()
+	c.key = key
+	return
+}
+
+func (c *changelogCursor) Next() (key, value []byte) {
+	key, value = c.ReadWriteCursor.Next()
+	c.key = key
+	return
+}
+
+func (c *changelogCursor) Prev() (key, value []byte) {
+	key, value = c.ReadWriteCursor.Prev()
+	c.key = key
+	return
+}
+
+func (c *changelogCursor) Seek(seek []byte) (key, value []byte) {
+	key, value = c.ReadWriteCursor.Seek(seek)
+	c.key = key
+	return
+}
+
+func (c *changelogCursor) Delete() error {
+	key := bytes.Clone(c.key)
+	err := c.ReadWriteCursor.Delete()
+	if err != nil {
+		return err
+	}
+	return c.bucket.tx.record(ChangelogDelete, c.bucket.path, key, nil)
+}
+
+// ChangelogSeq returns the sequence number of the last mutation recorded in
+// the changelog.  A copy of the database is current through the sequence
+// number recorded in the copy.
+func ChangelogSeq(dbtx walletdb.ReadTx) (uint64, error) {
+	const op errors.Op = "udb.ChangelogSeq"
+	b := dbtx.ReadBucket(changelogBucketKey)
+	if b == nil {
+		return 0, errors.E(op, errors.Bug, "missing changelog bucket")
+	}
+	return changelogUint64(b, changelogSeqKey), nil
+}
+
+// ChangelogEntries returns the changelog entries recorded after the sequence
+// number since.  Errors with code errors.NotExist are returned if entries
+// after since have been pruned, in which case a replica current through since
+// must be recreated from a full copy of the database.
+func ChangelogEntries(dbtx walletdb.ReadTx, since uint64) ([]ChangelogEntry, error) {
+	const op errors.Op = "udb.ChangelogEntries"
+	b := dbtx.ReadBucket(changelogBucketKey)
+	if b == nil {
+		return nil, errors.E(op, errors.Bug, "missing changelog bucket")
+	}
+	seq := changelogUint64(b, changelogSeqKey)
+	if since > seq {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("sequence number %d is after the last change %d", since, seq))
+	}
+	if pruned := changelogUint64(b, changelogPrunedKey); since < pruned {
+		return nil, errors.E(op, errors.NotExist,
+			errors.Errorf("changes through %d have been pruned", pruned))
+	}
+
+	var entries []ChangelogEntry
+	var start [8]byte
+	byteOrder.PutUint64(start[:], since+1)
+	c := b.NestedReadBucket(changelogEntriesBucketKey).ReadCursor()
+	defer c.Close()
+	for k, v := c.Seek(start[:]); k != nil; k, v = c.Next() {
+		e, err := deserializeChangelogEntry(byteOrder.Uint64(k), v)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		entries = append(entries, *e)
+	}
+	return entries, nil
+}
+
+// CompactChangelog removes the changelog entries which are superseded by
+// later entries, and prunes all entries through the sequence number
+// pruneThrough, which should be the lowest sequence number applied by every
+// replica.  An entry is superseded by a later put or delete of the same key,
+// or by a later delete of its bucket or any parent bucket.  Applying the
+// compacted entries after any sequence number results in the same database
+// as applying the original entries, but intermediate states may differ, so
+// replicas must apply all entries through the last recorded change.  The
+// number of removed entries is returned.
+func CompactChangelog(dbtx walletdb.ReadWriteTx, pruneThrough uint64) (int, error) {
+	const op errors.Op = "udb.CompactChangelog"
+	b := dbtx.ReadWriteBucket(changelogBucketKey)
+	if b == nil {
+		return 0, errors.E(op, errors.Bug, "missing changelog bucket")
+	}
+	if seq := changelogUint64(b, changelogSeqKey); pruneThrough > seq {
+		pruneThrough = seq
+	}
+	entries := b.NestedReadWriteBucket(changelogEntriesBucketKey)
+
+	var remove [][]byte
+	written := make(map[string]struct{})
+	var deletedBuckets [][][]byte
+	c := entries.ReadWriteCursor()
+	for k, v := c.Last(); k != nil; k, v = c.Prev() {
+		seq := byteOrder.Uint64(k)
+		if seq <= pruneThrough {
+			remove = append(remove, bytes.Clone(k))
+			continue
+		}
+		e, err := deserializeChangelogEntry(seq, v)
+		if err != nil {
+			c.Close()
+			return 0, errors.E(op, err)
+		}
+		path := e.fullPath()
+		superseded := false
+		for _, d := range deletedBuckets {
+			if hasChangelogPathPrefix(path, d) {
+				superseded = true
+				break
+			}
+		}
+		switch e.Op {
+		case ChangelogPut, ChangelogDelete:
+			id := string(serializeChangelogPath(nil, path))
+			if _, ok := written[id]; ok {
+				superseded = true
+			}
+			written[id] = struct{}{}
+		case ChangelogDeleteBucket:
+			if !superseded {
+				deletedBuckets = append(deletedBuckets, path)
+			}
+		}
+		if superseded {
+			remove = append(remove, bytes.Clone(k))
+		}
+	}
+	c.Close()
+
+	for _, k := range remove {
+		err := entries.Delete(k)
+		if err != nil {
+			return 0, errors.E(op, errors.IO, err)
+		}
+	}
+	if pruneThrough > changelogUint64(b, changelogPrunedKey) {
+		v := make([]byte, 8)
+		byteOrder.PutUint64(v, pruneThrough)
+		err := b.Put(changelogPrunedKey, v)
+		if err != nil {
+			return 0, errors.E(op, errors.IO, err)
+		}
+	}
+	return len(remove), nil
+}
+
+// ApplyChangelog applies changelog entries, in order, to a replica of a wallet
+// database.  Entries at or before the sequence number the replica is current
+// through are skipped, so entries may be reapplied after failures.  The
+// replica should be opened directly with walletdb and not with a ChangelogDB,
+// and must apply every entry through the last recorded change before it is
+// consistent.
+func ApplyChangelog(dbtx walletdb.ReadWriteTx, entries []ChangelogEntry) error {
+	const op errors.Op = "udb.ApplyChangelog"
+	clog := dbtx.ReadWriteBucket(changelogBucketKey)
+	if clog == nil {
+		return errors.E(op, errors.Bug, "missing changelog bucket")
+	}
+	seq := changelogUint64(clog, changelogSeqKey)
+	for i := range entries {
+		e := &entries[i]
+		if e.Seq <= seq {
+			continue
+		}
+		err := applyChangelogEntry(dbtx, e)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		seq = e.Seq
+	}
+	v := make([]byte, 8)
+	byteOrder.PutUint64(v, seq)
+	err := clog.Put(changelogSeqKey, v)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+func applyChangelogEntry(dbtx walletdb.ReadWriteTx, e *ChangelogEntry) error {
+	deleting := e.Op == ChangelogDelete || e.Op == ChangelogDeleteBucket
+	if len(e.Bucket) == 0 {
+		var err error
+		switch e.Op {
+		case ChangelogCreateBucket:
+			_, err = dbtx.CreateTopLevelBucket(e.Key)
+		case ChangelogDeleteBucket:
+			err = dbtx.DeleteTopLevelBucket(e.Key)
+			if errors.Is(err, errors.NotExist) {
+				err = nil
+			}
+		default:
+			return errors.E(errors.Invalid, errors.Errorf("changelog entry %d "+
+				"modifies a key outside of a bucket", e.Seq))
+		}
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+		return nil
+	}
+
+	b := dbtx.ReadWriteBucket(e.Bucket[0])
+	for _, key := range e.Bucket[1:] {
+		if b == nil {
+			break
+		}
+		b = b.NestedReadWriteBucket(key)
+	}
+	if b == nil {
+		if deleting {
+			return nil
+		}
+		return errors.E(errors.Invalid, errors.Errorf("changelog entry %d "+
+			"modifies a missing bucket", e.Seq))
+	}
+
+	var err error
+	switch e.Op {
+	case ChangelogPut:
+		err = b.Put(e.Key, e.Value)
+	case ChangelogDelete:
+		err = b.Delete(e.Key)
+	case ChangelogCreateBucket:
+		_, err = b.CreateBucketIfNotExists(e.Key)
+	case ChangelogDeleteBucket:
+		err = b.DeleteNestedBucket(e.Key)
+		if errors.Is(err, errors.NotExist) {
+			err = nil
+		}
+	default:
+		return errors.E(errors.Invalid, errors.Errorf("unknown changelog "+
+			"operation %d in entry %d", e.Op, e.Seq))
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
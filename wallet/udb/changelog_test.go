@@ -0,0 +1,236 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var changelogTestBucketKey = []byte("changelogtest")
+
+// dumpChangelogTestBucket returns every key and value of the test bucket,
+// keyed by their serialized paths.  Nested buckets have empty values.
+func dumpChangelogTestBucket(t *testing.T, db walletdb.DB) map[string]string {
+	t.Helper()
+	m := make(map[string]string)
+	var dump func(b walletdb.ReadBucket, path [][]byte) error
+	dump = func(b walletdb.ReadBucket, path [][]byte) error {
+		return b.ForEach(func(k, v []byte) error {
+			p := append(path[:len(path):len(path)], k)
+			m[string(serializeChangelogPath(nil, p))] = string(v)
+			if v == nil {
+				return dump(b.NestedReadBucket(k), p)
+			}
+			return nil
+		})
+	}
+	err := walletdb.View(context.Background(), db, func(dbtx walletdb.ReadTx) error {
+		b := dbtx.ReadBucket(changelogTestBucketKey)
+		if b == nil {
+			return nil
+		}
+		return dump(b, [][]byte{changelogTestBucketKey})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func copyChangelogTestDB(t *testing.T, db walletdb.DB, name string) (walletdb.DB, uint64) {
+	t.Helper()
+	var buf bytes.Buffer
+	err := db.Copy(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), name)
+	err = os.WriteFile(path, buf.Bytes(), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replica, err := walletdb.Open("bdb", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { replica.Close() })
+	var seq uint64
+	err = walletdb.View(context.Background(), replica, func(dbtx walletdb.ReadTx) error {
+		seq, err = ChangelogSeq(dbtx)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return replica, seq
+}
+
+func TestChangelog(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rawDB, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, rawDB, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := NewChangelogDB(rawDB)
+
+	update := func(f func(dbtx walletdb.ReadWriteTx) error) {
+		t.Helper()
+		err := walletdb.Update(ctx, db, f)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	entries := func(db walletdb.DB, since uint64) []ChangelogEntry {
+		t.Helper()
+		var entries []ChangelogEntry
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			entries, err = ChangelogEntries(dbtx, since)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return entries
+	}
+	apply := func(replica walletdb.DB, entries []ChangelogEntry) {
+		t.Helper()
+		err := walletdb.Update(ctx, replica, func(dbtx walletdb.ReadWriteTx) error {
+			return ApplyChangelog(dbtx, entries)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	assertReplica := func(replica walletdb.DB) {
+		t.Helper()
+		want := dumpChangelogTestBucket(t, db)
+		got := dumpChangelogTestBucket(t, replica)
+		if len(got) != len(want) {
+			t.Fatalf("replica has %d keys, want %d", len(got), len(want))
+		}
+		for k, v := range want {
+			if got[k] != v {
+				t.Fatalf("replica key %x has value %x, want %x", k, got[k], v)
+			}
+		}
+	}
+
+	replica, since := copyChangelogTestDB(t, db, "replica")
+	compacted, _ := copyChangelogTestDB(t, db, "compacted")
+
+	changed := db.Changed()
+	update(func(dbtx walletdb.ReadWriteTx) error {
+		b, err := dbtx.CreateTopLevelBucket(changelogTestBucketKey)
+		if err != nil {
+			return err
+		}
+		for _, k := range []string{"a", "b", "c", "d"} {
+			err = b.Put([]byte(k), []byte("1"))
+			if err != nil {
+				return err
+			}
+		}
+		nested, err := b.CreateBucket([]byte("nested"))
+		if err != nil {
+			return err
+		}
+		return nested.Put([]byte("a"), []byte("1"))
+	})
+	select {
+	case <-changed:
+	default:
+		t.Fatal("commit did not signal changes")
+	}
+	update(func(dbtx walletdb.ReadWriteTx) error {
+		b := dbtx.ReadWriteBucket(changelogTestBucketKey)
+		err := b.Put([]byte("a"), []byte("2"))
+		if err != nil {
+			return err
+		}
+		err = b.Delete([]byte("b"))
+		if err != nil {
+			return err
+		}
+		c := b.ReadWriteCursor()
+		defer c.Close()
+		for k, _ := c.Seek([]byte("c")); k != nil; k, _ = c.Next() {
+			if string(k) == "c" {
+				return c.Delete()
+			}
+		}
+		return nil
+	})
+	update(func(dbtx walletdb.ReadWriteTx) error {
+		b := dbtx.ReadWriteBucket(changelogTestBucketKey)
+		err := b.DeleteNestedBucket([]byte("nested"))
+		if err != nil {
+			return err
+		}
+		nested, err := b.CreateBucket([]byte("nested"))
+		if err != nil {
+			return err
+		}
+		return nested.Put([]byte("b"), []byte("2"))
+	})
+
+	all := entries(db, since)
+	if len(all) == 0 {
+		t.Fatal("no changes were recorded")
+	}
+	apply(replica, all)
+	assertReplica(replica)
+
+	// Reapplying changes leaves the replica unmodified.
+	apply(replica, all)
+	assertReplica(replica)
+
+	// Compacting removes superseded changes, and replicas applying the
+	// compacted changes reach the same state.
+	var removed int
+	update(func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		removed, err = CompactChangelog(dbtx, 0)
+		return err
+	})
+	if removed == 0 {
+		t.Fatal("compaction removed no changes")
+	}
+	if n := len(entries(db, since)); n != len(all)-removed {
+		t.Fatalf("%d changes after compaction, want %d", n, len(all)-removed)
+	}
+	apply(compacted, entries(db, since))
+	assertReplica(compacted)
+
+	// Pruned changes can not be streamed.
+	last := all[len(all)-1].Seq
+	update(func(dbtx walletdb.ReadWriteTx) error {
+		_, err := CompactChangelog(dbtx, last)
+		return err
+	})
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		_, err := ChangelogEntries(dbtx, since)
+		return err
+	})
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("streaming pruned changes: expected NotExist, got %v", err)
+	}
+	if n := len(entries(db, last)); n != 0 {
+		t.Errorf("%d changes after pruning", n)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// changeScriptTypesBucketKey is the bucket key for storing the script
+	// type of the change returned to accounts which do not use P2PKH change.
+	// Key: account (4 bytes) → Value: change script type (1 byte)
+	changeScriptTypesBucketKey = []byte("changescripttypes")
+)
+
+// ChangeScriptType describes the output script of change returned to an
+// account.
+type ChangeScriptType uint8
+
+// Change script types.
+const (
+	// ChangeScriptP2PKH pays change to the ECDSA P2PKH address of the next
+	// internal branch key.  This is the default for all accounts.
+	ChangeScriptP2PKH ChangeScriptType = iota
+
+	// ChangeScriptSchnorrP2PKH pays change to the Schnorr P2PKH address of
+	// the next internal branch key.
+	ChangeScriptSchnorrP2PKH
+
+	// ChangeScriptP2SH pays change to the P2SH address of a P2PK redeem
+	// script of the next internal branch key.
+	ChangeScriptP2SH
+)
+
+var changeScriptTypeNames = [...]string{
+	ChangeScriptP2PKH:        "p2pkh",
+	ChangeScriptSchnorrP2PKH: "schnorr-p2pkh",
+	ChangeScriptP2SH:         "p2sh",
+}
+
+// String returns the name of the change script type.
+func (t ChangeScriptType) String() string {
+	if int(t) < len(changeScriptTypeNames) {
+		return changeScriptTypeNames[t]
+	}
+	return "unknown"
+}
+
+// ParseChangeScriptType returns the change script type with a name returned by
+// ChangeScriptType.String.
+func ParseChangeScriptType(name string) (ChangeScriptType, error) {
+	for t, n := range changeScriptTypeNames {
+		if n == name {
+			return ChangeScriptType(t), nil
+		}
+	}
+	return 0, errors.E(errors.Invalid, errors.Errorf("unknown change script type %q", name))
+}
+
+// PutChangeScriptType records the script type of change returned to account.
+// Setting the P2PKH type removes the record.
+func PutChangeScriptType(dbtx walletdb.ReadWriteTx, account uint32, t ChangeScriptType) error {
+	const op errors.Op = "udb.PutChangeScriptType"
+
+	if int(t) >= len(changeScriptTypeNames) {
+		return errors.E(op, errors.Invalid, errors.Errorf("unknown change script type %d", t))
+	}
+
+	b := dbtx.ReadWriteBucket(changeScriptTypesBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing change script types bucket")
+	}
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, account)
+	var err error
+	if t == ChangeScriptP2PKH {
+		err = b.Delete(k)
+	} else {
+		err = b.Put(k, []byte{byte(t)})
+	}
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// ChangeScriptTypeFor returns the script type of change returned to account,
+// which is P2PKH unless another type has been recorded.
+func ChangeScriptTypeFor(dbtx walletdb.ReadTx, account uint32) (ChangeScriptType, error) {
+	const op errors.Op = "udb.ChangeScriptTypeFor"
+
+	b := dbtx.ReadBucket(changeScriptTypesBucketKey)
+	if b == nil {
+		return ChangeScriptP2PKH, nil
+	}
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, account)
+	v := b.Get(k)
+	switch {
+	case v == nil:
+		return ChangeScriptP2PKH, nil
+	case len(v) != 1:
+		return 0, errors.E(op, errors.IO, "bad change script type record")
+	}
+	return ChangeScriptType(v[0]), nil
+}
+
+// ForEachChangeScriptType calls f with every account which does not use P2PKH
+// change and the script type of its change, in increasing account order.
+// Iteration stops if f returns an error, which is returned to the caller.
+func ForEachChangeScriptType(dbtx walletdb.ReadTx, f func(account uint32, t ChangeScriptType) error) error {
+	const op errors.Op = "udb.ForEachChangeScriptType"
+
+	b := dbtx.ReadBucket(changeScriptTypesBucketKey)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		if len(k) != 4 || len(v) != 1 {
+			return errors.E(op, errors.IO, "bad change script type record")
+		}
+		return f(byteOrder.Uint32(k), ChangeScriptType(v[0]))
+	})
+}
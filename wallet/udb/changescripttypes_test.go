@@ -0,0 +1,114 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestChangeScriptTypes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	put := func(account uint32, typ ChangeScriptType) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return PutChangeScriptType(dbtx, account, typ)
+		})
+	}
+	types := func() map[uint32]ChangeScriptType {
+		types := make(map[uint32]ChangeScriptType)
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			return ForEachChangeScriptType(dbtx, func(account uint32, typ ChangeScriptType) error {
+				got, err := ChangeScriptTypeFor(dbtx, account)
+				if err != nil {
+					return err
+				}
+				if got != typ {
+					t.Errorf("ChangeScriptTypeFor(%d) = %v, iterated %v",
+						account, got, typ)
+				}
+				types[account] = typ
+				return nil
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return types
+	}
+
+	if got := types(); len(got) != 0 {
+		t.Fatalf("new database has change script types %v", got)
+	}
+	var typ ChangeScriptType
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		typ, err = ChangeScriptTypeFor(dbtx, 0)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if typ != ChangeScriptP2PKH {
+		t.Errorf("default change script type is %v, want %v", typ, ChangeScriptP2PKH)
+	}
+
+	if err := put(1, ChangeScriptSchnorrP2PKH); err != nil {
+		t.Fatal(err)
+	}
+	if err := put(2, ChangeScriptSchnorrP2PKH); err != nil {
+		t.Fatal(err)
+	}
+	// Setting the type of an account again replaces the previous type.
+	if err := put(2, ChangeScriptP2SH); err != nil {
+		t.Fatal(err)
+	}
+	want := map[uint32]ChangeScriptType{
+		1: ChangeScriptSchnorrP2PKH,
+		2: ChangeScriptP2SH,
+	}
+	if got := types(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("change script types %v, want %v", got, want)
+	}
+
+	// Returning to P2PKH change removes the record.
+	if err := put(1, ChangeScriptP2PKH); err != nil {
+		t.Fatal(err)
+	}
+	delete(want, 1)
+	if got := types(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("change script types %v, want %v", got, want)
+	}
+
+	err = put(3, ChangeScriptP2SH+1)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("unknown change script type: expected Invalid error, got %v", err)
+	}
+
+	for _, typ := range []ChangeScriptType{ChangeScriptP2PKH,
+		ChangeScriptSchnorrP2PKH, ChangeScriptP2SH} {
+		parsed, err := ParseChangeScriptType(typ.String())
+		if err != nil || parsed != typ {
+			t.Errorf("ParseChangeScriptType(%q) = %v, %v", typ.String(), parsed, err)
+		}
+	}
+	if _, err := ParseChangeScriptType("p2tr"); !errors.Is(err, errors.Invalid) {
+		t.Errorf("unknown change script type name: expected Invalid error, got %v", err)
+	}
+}
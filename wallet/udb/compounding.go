@@ -0,0 +1,130 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// ticketCompoundingBucketKey is the bucket key for storing the ticket
+	// reward compounding state of accounts which have opted in.
+	// Key: account (4 bytes) → Value: accrued atoms (8 bytes) | height (4 bytes)
+	ticketCompoundingBucketKey = []byte("ticketcompounding")
+)
+
+// TicketCompounding is the ticket reward compounding state of an account.
+// Accrued is the value of matured SSFee VAR rewards which have not yet been
+// spent purchasing tickets, and Height is the main chain height through which
+// matured rewards have been accrued.
+type TicketCompounding struct {
+	Account uint32
+	Accrued dcrutil.Amount
+	Height  int32
+}
+
+func keyTicketCompounding(account uint32) []byte {
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, account)
+	return k
+}
+
+func valueTicketCompounding(c *TicketCompounding) []byte {
+	v := make([]byte, 12)
+	byteOrder.PutUint64(v, uint64(c.Accrued))
+	byteOrder.PutUint32(v[8:], uint32(c.Height))
+	return v
+}
+
+func readTicketCompounding(k, v []byte) (*TicketCompounding, error) {
+	if len(k) != 4 || len(v) != 12 {
+		return nil, errors.E(errors.IO, "bad ticket compounding record")
+	}
+	return &TicketCompounding{
+		Account: byteOrder.Uint32(k),
+		Accrued: dcrutil.Amount(byteOrder.Uint64(v)),
+		Height:  int32(byteOrder.Uint32(v[8:])),
+	}, nil
+}
+
+// PutTicketCompounding records the ticket reward compounding state of an
+// account, opting the account in to compounding if it was not already.
+func PutTicketCompounding(dbtx walletdb.ReadWriteTx, c *TicketCompounding) error {
+	const op errors.Op = "udb.PutTicketCompounding"
+
+	if c.Accrued < 0 {
+		return errors.E(op, errors.Invalid, "accrued rewards cannot be negative")
+	}
+
+	b := dbtx.ReadWriteBucket(ticketCompoundingBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing ticket compounding bucket")
+	}
+	err := b.Put(keyTicketCompounding(c.Account), valueTicketCompounding(c))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteTicketCompounding removes the ticket reward compounding state of an
+// account, opting the account out of compounding.
+func DeleteTicketCompounding(dbtx walletdb.ReadWriteTx, account uint32) error {
+	const op errors.Op = "udb.DeleteTicketCompounding"
+
+	b := dbtx.ReadWriteBucket(ticketCompoundingBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing ticket compounding bucket")
+	}
+	err := b.Delete(keyTicketCompounding(account))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// TicketCompoundingForAccount returns the ticket reward compounding state of
+// an account.  An error with kind NotExist is returned if the account has not
+// opted in to compounding.
+func TicketCompoundingForAccount(dbtx walletdb.ReadTx, account uint32) (*TicketCompounding, error) {
+	const op errors.Op = "udb.TicketCompoundingForAccount"
+
+	var v []byte
+	k := keyTicketCompounding(account)
+	if b := dbtx.ReadBucket(ticketCompoundingBucketKey); b != nil {
+		v = b.Get(k)
+	}
+	if v == nil {
+		return nil, errors.E(op, errors.NotExist,
+			errors.Errorf("account %d is not compounding ticket rewards", account))
+	}
+	c, err := readTicketCompounding(k, v)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return c, nil
+}
+
+// ForEachTicketCompounding calls f with the ticket reward compounding state of
+// every account which has opted in to compounding, in increasing account
+// order.  Iteration stops if f returns an error, which is returned to the
+// caller.
+func ForEachTicketCompounding(dbtx walletdb.ReadTx, f func(*TicketCompounding) error) error {
+	const op errors.Op = "udb.ForEachTicketCompounding"
+
+	b := dbtx.ReadBucket(ticketCompoundingBucketKey)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		c, err := readTicketCompounding(k, v)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return f(c)
+	})
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestTicketCompounding(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	put := func(c *TicketCompounding) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return PutTicketCompounding(dbtx, c)
+		})
+	}
+	del := func(account uint32) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return DeleteTicketCompounding(dbtx, account)
+		})
+	}
+	states := func() []TicketCompounding {
+		var states []TicketCompounding
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			return ForEachTicketCompounding(dbtx, func(c *TicketCompounding) error {
+				got, err := TicketCompoundingForAccount(dbtx, c.Account)
+				if err != nil {
+					return err
+				}
+				if *got != *c {
+					t.Errorf("TicketCompoundingForAccount(%d) = %+v, iterated %+v",
+						c.Account, got, c)
+				}
+				states = append(states, *c)
+				return nil
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return states
+	}
+
+	if got := states(); len(got) != 0 {
+		t.Fatalf("new database has compounding states %+v", got)
+	}
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		_, err := TicketCompoundingForAccount(dbtx, 0)
+		return err
+	})
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("lookup of account not opted in: expected NotExist error, got %v", err)
+	}
+
+	if err := put(&TicketCompounding{Account: 7, Accrued: 3e8, Height: 100}); err != nil {
+		t.Fatal(err)
+	}
+	if err := put(&TicketCompounding{Account: 0, Height: 90}); err != nil {
+		t.Fatal(err)
+	}
+	// Putting the state of an account again replaces it.
+	if err := put(&TicketCompounding{Account: 7, Accrued: 5e8, Height: 120}); err != nil {
+		t.Fatal(err)
+	}
+	want := []TicketCompounding{
+		{Account: 0, Height: 90},
+		{Account: 7, Accrued: 5e8, Height: 120},
+	}
+	if got := states(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("states %+v, want %+v", got, want)
+	}
+
+	if err := del(0); err != nil {
+		t.Fatal(err)
+	}
+	// Opting out an account which is not opted in is not an error.
+	if err := del(3); err != nil {
+		t.Fatal(err)
+	}
+	want = want[1:]
+	if got := states(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("states %+v, want %+v", got, want)
+	}
+
+	err = put(&TicketCompounding{Account: 1, Accrued: -1})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("negative accrued rewards: expected Invalid error, got %v", err)
+	}
+}
+
+func TestTicketCompoundingUpgrade(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	params := chaincfg.TestNet3Params()
+	err := Initialize(ctx, db, params, seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Revert the database to version 33, before the ticket compounding
+	// bucket existed.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		err := dbtx.DeleteTopLevelBucket(ticketCompoundingBucketKey)
+		if err != nil {
+			return err
+		}
+		metadataBucket := dbtx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+		return unifiedDBMetadata{}.putVersion(metadataBucket, ticketCompoundingVersion-1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// No account compounds without the bucket, while writes report the
+	// missing bucket.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := TicketCompoundingForAccount(dbtx, 0)
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("lookup without bucket: expected NotExist error, got %v", err)
+		}
+		err = PutTicketCompounding(dbtx, &TicketCompounding{Account: 0})
+		if !errors.Is(err, errors.Bug) {
+			t.Errorf("put without bucket: expected Bug error, got %v", err)
+		}
+		err = DeleteTicketCompounding(dbtx, 0)
+		if !errors.Is(err, errors.Bug) {
+			t.Errorf("delete without bucket: expected Bug error, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return ticketCompoundingUpgrade(dbtx, pubPass, params)
+	})
+	if err != nil {
+		t.Fatalf("upgrade failed: %v", err)
+	}
+
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		metadataBucket := dbtx.ReadBucket(unifiedDBMetadata{}.rootBucketKey())
+		version, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+		if err != nil {
+			return err
+		}
+		if version != ticketCompoundingVersion {
+			t.Errorf("upgraded database version is %d, want %d",
+				version, ticketCompoundingVersion)
+		}
+		if dbtx.ReadBucket(ticketCompoundingBucketKey) == nil {
+			t.Errorf("ticket compounding bucket was not created")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The upgrade must refuse to run on any other version.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return ticketCompoundingUpgrade(dbtx, pubPass, params)
+	})
+	if err == nil {
+		t.Errorf("upgrade of version %d database did not error", ticketCompoundingVersion)
+	}
+}
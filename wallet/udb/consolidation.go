@@ -12,10 +12,18 @@ import (
 var (
 	// accountConsolidationBucketKey is the bucket key for storing per-account
 	// consolidation addresses for SSFee UTXO consolidation.
-	// Key: account name (string) → Value: addressHash160 (20 bytes)
+	// Key: account name (string) → Value: addressHash160 (20 bytes) and
+	// ownership flags (1 byte)
 	accountConsolidationBucketKey = []byte("accountconsolidation")
 )
 
+// Flags recording the ownership of a consolidation address.  Values written
+// before the flags were recorded are only the 20 byte hash160, and their
+// ownership was never verified.
+const (
+	consolidationAddrExternal byte = 1 << iota
+)
+
 // SetAccountConsolidationAddr sets the consolidation address (as hash160) for
 // a specific account. This address will be used in vote transactions to specify
 // where SSFee payments should be sent, enabling UTXO consolidation.
@@ -23,8 +31,12 @@ var (
 // The hash160 must be exactly 20 bytes. If the hash160 is nil or empty, this
 // function returns an error. To clear a consolidation address and revert to the
 // default, use ClearAccountConsolidationAddr instead.
+//
+// External records whether the address is not controlled by the account.  The
+// caller is responsible for verifying that addresses which are not external are
+// derived from the account.
 func SetAccountConsolidationAddr(dbtx walletdb.ReadWriteTx, accountName string,
-	hash160 []byte) error {
+	hash160 []byte, external bool) error {
 
 	const op errors.Op = "udb.SetAccountConsolidationAddr"
 
@@ -37,8 +49,14 @@ func SetAccountConsolidationAddr(dbtx walletdb.ReadWriteTx, accountName string,
 		return errors.E(op, errors.Invalid, "account name cannot be empty")
 	}
 
+	v := make([]byte, 21)
+	copy(v, hash160)
+	if external {
+		v[20] |= consolidationAddrExternal
+	}
+
 	b := dbtx.ReadWriteBucket(accountConsolidationBucketKey)
-	err := b.Put([]byte(accountName), hash160)
+	err := b.Put([]byte(accountName), v)
 	if err != nil {
 		return errors.E(op, errors.IO, err)
 	}
@@ -67,25 +85,53 @@ func GetAccountConsolidationAddr(dbtx walletdb.ReadTx, accountName string) ([]by
 		return nil, nil
 	}
 
-	hash160 := b.Get([]byte(accountName))
-	if hash160 == nil {
+	v := b.Get([]byte(accountName))
+	if v == nil {
 		// No custom consolidation address set for this account.
 		// Return nil to indicate default should be used.
 		return nil, nil
 	}
 
-	if len(hash160) != 20 {
+	if len(v) != 20 && len(v) != 21 {
 		return nil, errors.E(op, errors.IO,
 			errors.Errorf("invalid hash160 length %d for account %q",
-				len(hash160), accountName))
+				len(v), accountName))
 	}
 
 	// Return a copy to prevent modifications to database data
 	result := make([]byte, 20)
-	copy(result, hash160)
+	copy(result, v)
 	return result, nil
 }
 
+// IsAccountConsolidationAddrExternal returns whether the custom consolidation
+// address of an account was recorded as not controlled by the account.
+// Addresses set before ownership was recorded were never verified, and are
+// reported as external.  Errors with code errors.NotExist are returned when no
+// custom consolidation address is set for the account.
+func IsAccountConsolidationAddrExternal(dbtx walletdb.ReadTx, accountName string) (bool, error) {
+	const op errors.Op = "udb.IsAccountConsolidationAddrExternal"
+
+	b := dbtx.ReadBucket(accountConsolidationBucketKey)
+	var v []byte
+	if b != nil {
+		v = b.Get([]byte(accountName))
+	}
+	switch len(v) {
+	case 0:
+		return false, errors.E(op, errors.NotExist,
+			errors.Errorf("no consolidation address set for account %q", accountName))
+	case 20:
+		return true, nil
+	case 21:
+		return v[20]&consolidationAddrExternal != 0, nil
+	default:
+		return false, errors.E(op, errors.IO,
+			errors.Errorf("invalid consolidation address length %d for account %q",
+				len(v), accountName))
+	}
+}
+
 // ClearAccountConsolidationAddr removes the custom consolidation address for
 // a specific account, causing it to revert to the default behavior (using the
 // first external address of the account).
@@ -0,0 +1,77 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestAccountConsolidationAddrExternal(t *testing.T) {
+	ctx := context.Background()
+	db, _, _, teardown, err := cloneDB(ctx, "consolidation_addr.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash160 := bytes.Repeat([]byte{0x11}, 20)
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := IsAccountConsolidationAddrExternal(dbtx, "default")
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("unset address: expected NotExist, got %v", err)
+		}
+
+		for _, external := range []bool{false, true} {
+			err := SetAccountConsolidationAddr(dbtx, "default", hash160, external)
+			if err != nil {
+				return err
+			}
+			got, err := GetAccountConsolidationAddr(dbtx, "default")
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(got, hash160) {
+				t.Errorf("hash160 %x, want %x", got, hash160)
+			}
+			isExternal, err := IsAccountConsolidationAddrExternal(dbtx, "default")
+			if err != nil {
+				return err
+			}
+			if isExternal != external {
+				t.Errorf("external %v, want %v", isExternal, external)
+			}
+		}
+
+		// Addresses recorded without ownership flags were never verified.
+		b := dbtx.ReadWriteBucket(accountConsolidationBucketKey)
+		err = b.Put([]byte("legacy"), hash160)
+		if err != nil {
+			return err
+		}
+		got, err := GetAccountConsolidationAddr(dbtx, "legacy")
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(got, hash160) {
+			t.Errorf("legacy hash160 %x, want %x", got, hash160)
+		}
+		isExternal, err := IsAccountConsolidationAddrExternal(dbtx, "legacy")
+		if err != nil {
+			return err
+		}
+		if !isExternal {
+			t.Errorf("legacy address not reported as external")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
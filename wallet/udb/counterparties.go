@@ -0,0 +1,87 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// MaxCounterpartyNameLen is the maximum length in bytes of a counterparty
+// name.
+const MaxCounterpartyNameLen = 64
+
+var (
+	// counterpartiesBucketKey is the bucket key for storing the named
+	// counterparty that external addresses have been tagged with.
+	// Key: encoded address (string) → Value: counterparty name (string)
+	counterpartiesBucketKey = []byte("counterparties")
+)
+
+// PutCounterpartyAddr tags an encoded external address as belonging to the
+// named counterparty, replacing any previous tag of the address.
+func PutCounterpartyAddr(dbtx walletdb.ReadWriteTx, addr, name string) error {
+	const op errors.Op = "udb.PutCounterpartyAddr"
+
+	if addr == "" {
+		return errors.E(op, errors.Invalid, "address cannot be empty")
+	}
+	if name == "" {
+		return errors.E(op, errors.Invalid, "counterparty name cannot be empty")
+	}
+	if len(name) > MaxCounterpartyNameLen {
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("counterparty name exceeds maximum length %d",
+				MaxCounterpartyNameLen))
+	}
+
+	b := dbtx.ReadWriteBucket(counterpartiesBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing counterparties bucket")
+	}
+	err := b.Put([]byte(addr), []byte(name))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteCounterpartyAddr removes the counterparty tag of an encoded address.
+func DeleteCounterpartyAddr(dbtx walletdb.ReadWriteTx, addr string) error {
+	const op errors.Op = "udb.DeleteCounterpartyAddr"
+
+	b := dbtx.ReadWriteBucket(counterpartiesBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing counterparties bucket")
+	}
+	err := b.Delete([]byte(addr))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// CounterpartyForAddr returns the name of the counterparty an encoded address
+// is tagged with, or the empty string if the address is untagged.
+func CounterpartyForAddr(dbtx walletdb.ReadTx, addr string) string {
+	b := dbtx.ReadBucket(counterpartiesBucketKey)
+	if b == nil {
+		return ""
+	}
+	return string(b.Get([]byte(addr)))
+}
+
+// ForEachCounterpartyAddr calls f with every tagged address and the name of
+// its counterparty.  Iteration stops if f returns an error, which is returned
+// to the caller.
+func ForEachCounterpartyAddr(dbtx walletdb.ReadTx, f func(addr, name string) error) error {
+	b := dbtx.ReadBucket(counterpartiesBucketKey)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		return f(string(k), string(v))
+	})
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestCounterparties(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	put := func(addr, name string) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return PutCounterpartyAddr(dbtx, addr, name)
+		})
+	}
+	del := func(addr string) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return DeleteCounterpartyAddr(dbtx, addr)
+		})
+	}
+	tags := func() map[string]string {
+		tags := make(map[string]string)
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			return ForEachCounterpartyAddr(dbtx, func(addr, name string) error {
+				if cp := CounterpartyForAddr(dbtx, addr); cp != name {
+					t.Errorf("CounterpartyForAddr(%q) = %q, iterated %q",
+						addr, cp, name)
+				}
+				tags[addr] = name
+				return nil
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tags
+	}
+
+	if err := put("a1", "exchange"); err != nil {
+		t.Fatal(err)
+	}
+	if err := put("a2", "exchange"); err != nil {
+		t.Fatal(err)
+	}
+	if err := put("a3", "pool"); err != nil {
+		t.Fatal(err)
+	}
+	// Tagging an address again replaces its counterparty.
+	if err := put("a2", "pool"); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"a1": "exchange", "a2": "pool", "a3": "pool"}
+	if got := tags(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("tags %v, want %v", got, want)
+	}
+
+	if err := del("a1"); err != nil {
+		t.Fatal(err)
+	}
+	// Deleting an untagged address is not an error.
+	if err := del("a4"); err != nil {
+		t.Fatal(err)
+	}
+	delete(want, "a1")
+	if got := tags(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("tags %v, want %v", got, want)
+	}
+
+	invalid := []struct{ addr, name string }{
+		{"", "exchange"},
+		{"a5", ""},
+		{"a5", strings.Repeat("x", MaxCounterpartyNameLen+1)},
+	}
+	for _, test := range invalid {
+		err := put(test.addr, test.name)
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("put(%q, %q): expected Invalid error, got %v",
+				test.addr, test.name, err)
+		}
+	}
+	if err := put("a5", strings.Repeat("x", MaxCounterpartyNameLen)); err != nil {
+		t.Errorf("name of maximum length: %v", err)
+	}
+}
+
+func TestCounterpartiesUpgrade(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	params := chaincfg.TestNet3Params()
+	err := Initialize(ctx, db, params, seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Revert the database to version 32, before the counterparties bucket
+	// existed.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		err := dbtx.DeleteTopLevelBucket(counterpartiesBucketKey)
+		if err != nil {
+			return err
+		}
+		metadataBucket := dbtx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+		return unifiedDBMetadata{}.putVersion(metadataBucket, counterpartiesVersion-1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Lookups of a database without the bucket find no tags, while writes
+	// report the missing bucket.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		if cp := CounterpartyForAddr(dbtx, "a1"); cp != "" {
+			t.Errorf("found counterparty %q without bucket", cp)
+		}
+		err := PutCounterpartyAddr(dbtx, "a1", "exchange")
+		if !errors.Is(err, errors.Bug) {
+			t.Errorf("put without bucket: expected Bug error, got %v", err)
+		}
+		err = DeleteCounterpartyAddr(dbtx, "a1")
+		if !errors.Is(err, errors.Bug) {
+			t.Errorf("delete without bucket: expected Bug error, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return counterpartiesUpgrade(dbtx, pubPass, params)
+	})
+	if err != nil {
+		t.Fatalf("upgrade failed: %v", err)
+	}
+
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		metadataBucket := dbtx.ReadBucket(unifiedDBMetadata{}.rootBucketKey())
+		version, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+		if err != nil {
+			return err
+		}
+		if version != counterpartiesVersion {
+			t.Errorf("upgraded database version is %d, want %d",
+				version, counterpartiesVersion)
+		}
+		if dbtx.ReadBucket(counterpartiesBucketKey) == nil {
+			t.Errorf("counterparties bucket was not created")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The upgrade must refuse to run on any other version.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return counterpartiesUpgrade(dbtx, pubPass, params)
+	})
+	if err == nil {
+		t.Errorf("upgrade of version %d database did not error", counterpartiesVersion)
+	}
+}
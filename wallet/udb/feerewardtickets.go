@@ -0,0 +1,103 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// feeRewardTicketsBucketKey is the bucket key for recording tickets
+	// purchased with SSFee reward outputs.
+	// Key: ticket hash (32 bytes) → Value: account (4 bytes) | funding
+	// atoms (8 bytes)
+	feeRewardTicketsBucketKey = []byte("feerewardtickets")
+)
+
+// FeeRewardTicket records a ticket whose split transaction output was funded
+// only by SSFee reward outputs of Account.  Value is the value of the split
+// transaction output spent by the ticket, covering the ticket price and fee.
+type FeeRewardTicket struct {
+	Hash    chainhash.Hash
+	Account uint32
+	Value   dcrutil.Amount
+}
+
+func valueFeeRewardTicket(t *FeeRewardTicket) []byte {
+	v := make([]byte, 12)
+	byteOrder.PutUint32(v, t.Account)
+	byteOrder.PutUint64(v[4:], uint64(t.Value))
+	return v
+}
+
+func readFeeRewardTicket(k, v []byte) (*FeeRewardTicket, error) {
+	if len(k) != chainhash.HashSize || len(v) != 12 {
+		return nil, errors.E(errors.IO, "bad fee reward ticket record")
+	}
+	t := &FeeRewardTicket{
+		Account: byteOrder.Uint32(v),
+		Value:   dcrutil.Amount(byteOrder.Uint64(v[4:])),
+	}
+	copy(t.Hash[:], k)
+	return t, nil
+}
+
+// PutFeeRewardTicket records a ticket purchased with SSFee reward outputs.
+func PutFeeRewardTicket(dbtx walletdb.ReadWriteTx, t *FeeRewardTicket) error {
+	const op errors.Op = "udb.PutFeeRewardTicket"
+
+	b := dbtx.ReadWriteBucket(feeRewardTicketsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing fee reward tickets bucket")
+	}
+	err := b.Put(t.Hash[:], valueFeeRewardTicket(t))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// FeeRewardTicketForHash returns the record of a ticket purchased with SSFee
+// reward outputs.  An error with kind NotExist is returned for tickets which
+// were not funded by rewards.
+func FeeRewardTicketForHash(dbtx walletdb.ReadTx, ticketHash *chainhash.Hash) (*FeeRewardTicket, error) {
+	const op errors.Op = "udb.FeeRewardTicketForHash"
+
+	var v []byte
+	if b := dbtx.ReadBucket(feeRewardTicketsBucketKey); b != nil {
+		v = b.Get(ticketHash[:])
+	}
+	if v == nil {
+		return nil, errors.E(op, errors.NotExist,
+			errors.Errorf("ticket %v was not funded by fee rewards", ticketHash))
+	}
+	t, err := readFeeRewardTicket(ticketHash[:], v)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return t, nil
+}
+
+// ForEachFeeRewardTicket calls f with the record of every ticket purchased
+// with SSFee reward outputs.  Iteration stops if f returns an error, which is
+// returned to the caller.
+func ForEachFeeRewardTicket(dbtx walletdb.ReadTx, f func(*FeeRewardTicket) error) error {
+	const op errors.Op = "udb.ForEachFeeRewardTicket"
+
+	b := dbtx.ReadBucket(feeRewardTicketsBucketKey)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		t, err := readFeeRewardTicket(k, v)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return f(t)
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestFeeRewardTickets(t *testing.T) {
+	ctx := context.Background()
+	db, _, _, teardown, err := cloneDB(ctx, "fee_reward_tickets.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tickets := []FeeRewardTicket{
+		{Hash: chainhash.Hash{1}, Account: 0, Value: 1e8},
+		{Hash: chainhash.Hash{2}, Account: 3, Value: 2e8},
+	}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := FeeRewardTicketForHash(dbtx, &tickets[0].Hash)
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("unrecorded ticket: expected NotExist, got %v", err)
+		}
+
+		for i := range tickets {
+			err := PutFeeRewardTicket(dbtx, &tickets[i])
+			if err != nil {
+				return err
+			}
+		}
+		for i := range tickets {
+			got, err := FeeRewardTicketForHash(dbtx, &tickets[i].Hash)
+			if err != nil {
+				return err
+			}
+			if *got != tickets[i] {
+				t.Errorf("ticket %v: got %+v, want %+v", tickets[i].Hash,
+					*got, tickets[i])
+			}
+		}
+
+		var n int
+		err = ForEachFeeRewardTicket(dbtx, func(ft *FeeRewardTicket) error {
+			if *ft != tickets[n] {
+				t.Errorf("iterated ticket %d: got %+v, want %+v", n, *ft,
+					tickets[n])
+			}
+			n++
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if n != len(tickets) {
+			t.Errorf("iterated %d tickets, want %d", n, len(tickets))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
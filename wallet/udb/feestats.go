@@ -0,0 +1,168 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"encoding/binary"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// feeStatsBucketKey is the bucket key for storing the fees paid by
+	// mined transactions of the wallet, recorded as the transactions are
+	// mined.  Keys sort by block height so the fees of blocks removed by a
+	// reorg can be deleted, and fees are aggregated by day when read.
+	// Key: block height (4 bytes, big endian) | tx hash (32 bytes)
+	// Value: block time (8 bytes, Unix seconds) | coin type (1 byte) | fee
+	// length (1 byte) | big-endian unsigned fee in atoms
+	feeStatsBucketKey = []byte("feestats")
+)
+
+// FeeStat is the record of the fee paid by a mined transaction of the wallet,
+// in atoms of the transaction's coin type.
+type FeeStat struct {
+	Height   int32
+	Hash     chainhash.Hash
+	Time     time.Time
+	CoinType cointype.CoinType
+	Fee      *big.Int
+}
+
+// FeeDay aggregates the fees of a coin type paid by transactions mined in
+// blocks with timestamps on Day, counted in days since the Unix epoch.  Days
+// begin at midnight UTC.
+type FeeDay struct {
+	Day          int64
+	CoinType     cointype.CoinType
+	Transactions uint32
+	Fees         *big.Int
+}
+
+func keyFeeStat(height int32, hash *chainhash.Hash) []byte {
+	k := make([]byte, 4+chainhash.HashSize)
+	binary.BigEndian.PutUint32(k, uint32(height))
+	copy(k[4:], hash[:])
+	return k
+}
+
+func readFeeStat(k, v []byte) (*FeeStat, error) {
+	if len(k) != 4+chainhash.HashSize || len(v) < 10 || len(v) != 10+int(v[9]) {
+		return nil, errors.E(errors.IO, "bad fee stats record")
+	}
+	s := &FeeStat{
+		Height:   int32(binary.BigEndian.Uint32(k)),
+		Time:     time.Unix(int64(binary.BigEndian.Uint64(v)), 0),
+		CoinType: cointype.CoinType(v[8]),
+		Fee:      new(big.Int).SetBytes(v[10:]),
+	}
+	copy(s.Hash[:], k[4:])
+	return s, nil
+}
+
+// PutFeeStat records the fee paid by a mined transaction.  Recording the same
+// transaction again replaces the previous record.
+func PutFeeStat(dbtx walletdb.ReadWriteTx, s *FeeStat) error {
+	const op errors.Op = "udb.PutFeeStat"
+
+	if s.Fee == nil || s.Fee.Sign() < 0 || len(s.Fee.Bytes()) > 255 {
+		return errors.E(op, errors.Invalid, "fee out of range")
+	}
+	b := dbtx.ReadWriteBucket(feeStatsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing fee stats bucket")
+	}
+	fee := s.Fee.Bytes()
+	v := make([]byte, 10, 10+len(fee))
+	binary.BigEndian.PutUint64(v, uint64(s.Time.Unix()))
+	v[8] = byte(s.CoinType)
+	v[9] = byte(len(fee))
+	v = append(v, fee...)
+	err := b.Put(keyFeeStat(s.Height, &s.Hash), v)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// FeeDays returns the fees paid by the wallet's mined transactions on each
+// day from the day containing since, aggregated by day and coin type, in
+// increasing day and coin type order.  Days without fees of a coin type are
+// omitted.
+func FeeDays(dbtx walletdb.ReadTx, since time.Time) ([]*FeeDay, error) {
+	const op errors.Op = "udb.FeeDays"
+
+	b := dbtx.ReadBucket(feeStatsBucketKey)
+	if b == nil {
+		return nil, nil
+	}
+	type dayKey struct {
+		day int64
+		ct  cointype.CoinType
+	}
+	fromDay := spendDay(since)
+	days := make(map[dayKey]*FeeDay)
+	err := b.ForEach(func(k, v []byte) error {
+		s, err := readFeeStat(k, v)
+		if err != nil {
+			return err
+		}
+		key := dayKey{spendDay(s.Time), s.CoinType}
+		if key.day < fromDay {
+			return nil
+		}
+		d := days[key]
+		if d == nil {
+			d = &FeeDay{Day: key.day, CoinType: key.ct, Fees: new(big.Int)}
+			days[key] = d
+		}
+		d.Transactions++
+		d.Fees.Add(d.Fees, s.Fee)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	res := make([]*FeeDay, 0, len(days))
+	for _, d := range days {
+		res = append(res, d)
+	}
+	slices.SortFunc(res, func(a, b *FeeDay) int {
+		if a.Day != b.Day {
+			return int(a.Day - b.Day)
+		}
+		return int(a.CoinType) - int(b.CoinType)
+	})
+	return res, nil
+}
+
+// deleteFeeStatsFrom removes the fees recorded for blocks at height onwards.
+func deleteFeeStatsFrom(dbtx walletdb.ReadWriteTx, height int32) error {
+	b := dbtx.ReadWriteBucket(feeStatsBucketKey)
+	if b == nil {
+		return nil
+	}
+	var seek [4]byte
+	binary.BigEndian.PutUint32(seek[:], uint32(height))
+	var keys [][]byte
+	c := b.ReadCursor()
+	for k, _ := c.Seek(seek[:]); k != nil; k, _ = c.Next() {
+		keys = append(keys, append([]byte(nil), k...))
+	}
+	c.Close()
+	for _, k := range keys {
+		if err := b.Delete(k); err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	return nil
+}
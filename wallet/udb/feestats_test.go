@@ -0,0 +1,102 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestFeeStats(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	skaFee, _ := new(big.Int).SetString("123456789012345678901", 10)
+	stats := []FeeStat{
+		{Height: 10, Hash: chainhash.Hash{1}, Time: day.Add(time.Hour),
+			CoinType: cointype.CoinTypeVAR, Fee: big.NewInt(3000)},
+		{Height: 11, Hash: chainhash.Hash{2}, Time: day.Add(23 * time.Hour),
+			CoinType: cointype.CoinTypeVAR, Fee: big.NewInt(2000)},
+		{Height: 11, Hash: chainhash.Hash{3}, Time: day.Add(23 * time.Hour),
+			CoinType: 1, Fee: skaFee},
+		{Height: 20, Hash: chainhash.Hash{4}, Time: day.Add(25 * time.Hour),
+			CoinType: cointype.CoinTypeVAR, Fee: big.NewInt(1000)},
+	}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		for i := range stats {
+			if err := PutFeeStat(dbtx, &stats[i]); err != nil {
+				return err
+			}
+		}
+		// Recording a transaction again replaces its record.
+		return PutFeeStat(dbtx, &stats[0])
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feeDays := func(since time.Time) []*FeeDay {
+		var days []*FeeDay
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			days, err = FeeDays(dbtx, since)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return days
+	}
+	check := func(got []*FeeDay, want []FeeDay) {
+		t.Helper()
+		if len(got) != len(want) {
+			t.Fatalf("got %d days, want %d", len(got), len(want))
+		}
+		for i := range want {
+			g, w := got[i], &want[i]
+			if g.Day != w.Day || g.CoinType != w.CoinType ||
+				g.Transactions != w.Transactions || g.Fees.Cmp(w.Fees) != 0 {
+				t.Fatalf("day %d: got %+v, want %+v", i, g, w)
+			}
+		}
+	}
+
+	first := day.Unix() / 86400
+	check(feeDays(time.Time{}), []FeeDay{
+		{Day: first, CoinType: cointype.CoinTypeVAR, Transactions: 2, Fees: big.NewInt(5000)},
+		{Day: first, CoinType: 1, Transactions: 1, Fees: skaFee},
+		{Day: first + 1, CoinType: cointype.CoinTypeVAR, Transactions: 1, Fees: big.NewInt(1000)},
+	})
+	check(feeDays(day.Add(30*time.Hour)), []FeeDay{
+		{Day: first + 1, CoinType: cointype.CoinTypeVAR, Transactions: 1, Fees: big.NewInt(1000)},
+	})
+
+	// Removing blocks removes the fees of their transactions.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return deleteFeeStatsFrom(dbtx, 11)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(feeDays(time.Time{}), []FeeDay{
+		{Day: first, CoinType: cointype.CoinTypeVAR, Transactions: 1, Fees: big.NewInt(3000)},
+	})
+}
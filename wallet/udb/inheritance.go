@@ -0,0 +1,197 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// inheritanceBucketKey is the bucket key for storing the dead-man's
+	// switch of accounts.
+	// Key: account (4 bytes) → Value: delay seconds (8 bytes) | refreshed
+	// Unix time (8 bytes) | script version (2 bytes) | script length (2
+	// bytes) | recovery script | error length (2 bytes) | error |
+	// transaction count (1 byte) | [transaction length (4 bytes) |
+	// transaction]...
+	//
+	// A zero refreshed time records that the transactions were never
+	// created.
+	inheritanceBucketKey = []byte("inheritance")
+)
+
+// InheritanceSwitch describes the dead-man's switch of an account.  Txs are
+// pre-signed transactions, one for each coin type, sweeping the funds of the
+// account to the recovery script.  Their lock time is Delay after Refreshed,
+// the time they were last created.  LastError records why the transactions
+// could not be created when the account was last used, in which case Txs are
+// the transactions of the last successful refresh.
+type InheritanceSwitch struct {
+	Account       uint32
+	ScriptVersion uint16
+	PkScript      []byte
+	Delay         time.Duration
+	Txs           []*wire.MsgTx
+	Refreshed     time.Time
+	LastError     string
+}
+
+func keyInheritance(account uint32) []byte {
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, account)
+	return k
+}
+
+func valueInheritance(s *InheritanceSwitch) ([]byte, error) {
+	txs := make([][]byte, len(s.Txs))
+	size := 23 + len(s.PkScript) + len(s.LastError)
+	for i, tx := range s.Txs {
+		b, err := tx.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		txs[i] = b
+		size += 4 + len(b)
+	}
+	v := make([]byte, 18, size)
+	byteOrder.PutUint64(v[0:], uint64(s.Delay/time.Second))
+	byteOrder.PutUint64(v[8:], unixOrZero(s.Refreshed))
+	byteOrder.PutUint16(v[16:], s.ScriptVersion)
+	v = byteOrder.AppendUint16(v, uint16(len(s.PkScript)))
+	v = append(v, s.PkScript...)
+	v = byteOrder.AppendUint16(v, uint16(len(s.LastError)))
+	v = append(v, s.LastError...)
+	v = append(v, byte(len(txs)))
+	for _, tx := range txs {
+		v = byteOrder.AppendUint32(v, uint32(len(tx)))
+		v = append(v, tx...)
+	}
+	return v, nil
+}
+
+func readInheritance(k, v []byte) (*InheritanceSwitch, error) {
+	if len(k) != 4 {
+		return nil, errors.E(errors.IO, "bad inheritance record")
+	}
+	r := &invoiceReader{v: v}
+	s := &InheritanceSwitch{
+		Account: byteOrder.Uint32(k),
+		Delay:   time.Duration(byteOrder.Uint64(r.next(8))) * time.Second,
+	}
+	s.Refreshed = timeOrZero(byteOrder.Uint64(r.next(8)))
+	s.ScriptVersion = byteOrder.Uint16(r.next(2))
+	s.PkScript = append([]byte(nil), r.next(int(byteOrder.Uint16(r.next(2))))...)
+	s.LastError = string(r.next(int(byteOrder.Uint16(r.next(2)))))
+	n := int(r.next(1)[0])
+	for i := 0; i < n && !r.bad; i++ {
+		b := r.next(int(byteOrder.Uint32(r.next(4))))
+		if r.bad {
+			break
+		}
+		tx := new(wire.MsgTx)
+		if err := tx.FromBytes(b); err != nil {
+			return nil, errors.E(errors.IO, err)
+		}
+		s.Txs = append(s.Txs, tx)
+	}
+	if r.bad || len(r.v) != 0 {
+		return nil, errors.E(errors.IO, "bad inheritance record")
+	}
+	return s, nil
+}
+
+// PutInheritanceSwitch records the dead-man's switch of an account, replacing
+// any previous record of the account.
+func PutInheritanceSwitch(dbtx walletdb.ReadWriteTx, s *InheritanceSwitch) error {
+	const op errors.Op = "udb.PutInheritanceSwitch"
+
+	switch {
+	case len(s.PkScript) == 0 || len(s.PkScript) > 0xffff:
+		return errors.E(op, errors.Invalid, "recovery script length out of range")
+	case s.Delay < time.Second:
+		return errors.E(op, errors.Invalid, "delay out of range")
+	case len(s.Txs) > 255:
+		return errors.E(op, errors.Invalid, "too many inheritance transactions")
+	}
+	if len(s.LastError) > 0xffff {
+		s.LastError = s.LastError[:0xffff]
+	}
+
+	b := dbtx.ReadWriteBucket(inheritanceBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing inheritance bucket")
+	}
+	v, err := valueInheritance(s)
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	err = b.Put(keyInheritance(s.Account), v)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// InheritanceSwitchFor returns the dead-man's switch of an account.  An error
+// with kind NotExist is returned if the account has no dead-man's switch.
+func InheritanceSwitchFor(dbtx walletdb.ReadTx, account uint32) (*InheritanceSwitch, error) {
+	const op errors.Op = "udb.InheritanceSwitchFor"
+
+	var v []byte
+	k := keyInheritance(account)
+	if b := dbtx.ReadBucket(inheritanceBucketKey); b != nil {
+		v = b.Get(k)
+	}
+	if v == nil {
+		return nil, errors.E(op, errors.NotExist,
+			errors.Errorf("account %d has no dead-man's switch", account))
+	}
+	s, err := readInheritance(k, v)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return s, nil
+}
+
+// DeleteInheritanceSwitch removes the dead-man's switch of an account.  An
+// error with kind NotExist is returned if the account has no dead-man's
+// switch.
+func DeleteInheritanceSwitch(dbtx walletdb.ReadWriteTx, account uint32) error {
+	const op errors.Op = "udb.DeleteInheritanceSwitch"
+
+	b := dbtx.ReadWriteBucket(inheritanceBucketKey)
+	k := keyInheritance(account)
+	if b == nil || b.Get(k) == nil {
+		return errors.E(op, errors.NotExist,
+			errors.Errorf("account %d has no dead-man's switch", account))
+	}
+	if err := b.Delete(k); err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// ForEachInheritanceSwitch calls f with the dead-man's switch of every
+// account, in increasing account order.  Iteration stops if f returns an
+// error, which is returned to the caller.
+func ForEachInheritanceSwitch(dbtx walletdb.ReadTx, f func(*InheritanceSwitch) error) error {
+	const op errors.Op = "udb.ForEachInheritanceSwitch"
+
+	b := dbtx.ReadBucket(inheritanceBucketKey)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		s, err := readInheritance(k, v)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return f(s)
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestInheritanceSwitches(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	put := func(s *InheritanceSwitch) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return PutInheritanceSwitch(dbtx, s)
+		})
+	}
+	get := func(account uint32) (*InheritanceSwitch, error) {
+		var s *InheritanceSwitch
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			s, err = InheritanceSwitchFor(dbtx, account)
+			return err
+		})
+		return s, err
+	}
+
+	script := []byte{0x76, 0xa9, 0x14}
+	if err := put(&InheritanceSwitch{Account: 1, PkScript: script}); !errors.Is(err, errors.Invalid) {
+		t.Errorf("no delay: expected Invalid error, got %v", err)
+	}
+	if err := put(&InheritanceSwitch{Account: 1, Delay: time.Hour}); !errors.Is(err, errors.Invalid) {
+		t.Errorf("no script: expected Invalid error, got %v", err)
+	}
+
+	sweeps := make([]*wire.MsgTx, 2)
+	for i := range sweeps {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: uint32(i)}, 1e8, []byte{0x51}))
+		tx.AddTxOut(wire.NewTxOut(9e7, script))
+		tx.LockTime = 1800000000
+		sweeps[i] = tx
+	}
+	switches := []*InheritanceSwitch{{
+		Account:   0,
+		PkScript:  script,
+		Delay:     180 * 24 * time.Hour,
+		Txs:       sweeps,
+		Refreshed: time.Unix(1700000000, 0),
+	}, {
+		Account:       3,
+		ScriptVersion: 0,
+		PkScript:      script,
+		Delay:         30 * 24 * time.Hour,
+		LastError:     "wallet locked",
+	}}
+	for _, s := range switches {
+		if err := put(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []*InheritanceSwitch
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		return ForEachInheritanceSwitch(dbtx, func(s *InheritanceSwitch) error {
+			got = append(got, s)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(switches) {
+		t.Fatalf("iterated %d switches, want %d", len(got), len(switches))
+	}
+	for i, want := range switches {
+		g := got[i]
+		switch {
+		case g.Account != want.Account || g.Delay != want.Delay ||
+			!bytes.Equal(g.PkScript, want.PkScript):
+			t.Errorf("switch %d: got %+v, want %+v", i, g, want)
+		case !g.Refreshed.Equal(want.Refreshed) || g.LastError != want.LastError:
+			t.Errorf("switch %d: got refresh %v %q, want %v %q", i, g.Refreshed,
+				g.LastError, want.Refreshed, want.LastError)
+		case len(g.Txs) != len(want.Txs):
+			t.Errorf("switch %d: got %d transactions, want %d", i, len(g.Txs), len(want.Txs))
+		}
+		for j := 0; j < len(g.Txs) && j < len(want.Txs); j++ {
+			if g.Txs[j].TxHash() != want.Txs[j].TxHash() {
+				t.Errorf("switch %d: transaction %d is %v, want %v", i, j,
+					g.Txs[j].TxHash(), want.Txs[j].TxHash())
+			}
+		}
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return DeleteInheritanceSwitch(dbtx, 3)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := get(3); !errors.Is(err, errors.NotExist) {
+		t.Errorf("deleted switch: expected NotExist error, got %v", err)
+	}
+	if s, err := get(0); err != nil || len(s.Txs) != 2 {
+		t.Errorf("remaining switch %+v, %v", s, err)
+	}
+}
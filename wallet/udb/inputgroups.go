@@ -0,0 +1,78 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/wallet/txauthor"
+)
+
+// GroupInputsByAddress returns an input source which selects the inputs of
+// source, together with every other output of all which pays to the same
+// script as a selected input.  Spending all outputs of an address at once
+// avoids partial spends, which would otherwise link each later transaction
+// spending the remaining outputs to the same address.
+//
+// The outputs of all are only read the first time inputs are selected, and
+// all must be selected with a zero target to return every eligible output.
+func GroupInputsByAddress(source, all InputSource) InputSource {
+	var (
+		everything *txauthor.InputDetail
+		groups     map[string][]int // script → indexes of everything.Inputs
+	)
+	f := func(target dcrutil.Amount) (*txauthor.InputDetail, error) {
+		detail, err := source.SelectInputs(target)
+		if err != nil {
+			return nil, err
+		}
+		if everything == nil {
+			everything, err = all.SelectInputs(0)
+			if err != nil {
+				return nil, err
+			}
+			groups = make(map[string][]int)
+			for i, script := range everything.Scripts {
+				groups[string(script)] = append(groups[string(script)], i)
+			}
+		}
+
+		// Copy the selected inputs, since the slices of source are
+		// reused when it is called again for a higher target.
+		grouped := &txauthor.InputDetail{
+			Amount:            detail.Amount,
+			SKAAmount:         detail.SKAAmount,
+			Inputs:            append([]*wire.TxIn(nil), detail.Inputs...),
+			Scripts:           append([][]byte(nil), detail.Scripts...),
+			RedeemScriptSizes: append([]int(nil), detail.RedeemScriptSizes...),
+		}
+		selected := make(map[wire.OutPoint]struct{}, len(detail.Inputs))
+		for _, in := range detail.Inputs {
+			selected[in.PreviousOutPoint] = struct{}{}
+		}
+		for _, script := range detail.Scripts {
+			for _, i := range groups[string(script)] {
+				in := everything.Inputs[i]
+				if _, ok := selected[in.PreviousOutPoint]; ok {
+					continue
+				}
+				selected[in.PreviousOutPoint] = struct{}{}
+				grouped.Inputs = append(grouped.Inputs, in)
+				grouped.Scripts = append(grouped.Scripts, everything.Scripts[i])
+				grouped.RedeemScriptSizes = append(grouped.RedeemScriptSizes,
+					everything.RedeemScriptSizes[i])
+				if in.SKAValueIn != nil {
+					grouped.SKAAmount = grouped.SKAAmount.Add(
+						cointype.NewSKAAmount(in.SKAValueIn))
+				} else {
+					grouped.Amount += dcrutil.Amount(in.ValueIn)
+				}
+			}
+		}
+		return grouped, nil
+	}
+	return InputSource{source: f}
+}
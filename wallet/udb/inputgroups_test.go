@@ -0,0 +1,79 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/wallet/txauthor"
+)
+
+func TestGroupInputsByAddress(t *testing.T) {
+	t.Parallel()
+
+	scriptA, scriptB := []byte{0xa}, []byte{0xb}
+	type utxo struct {
+		index  uint32
+		value  int64
+		script []byte
+	}
+	utxos := []utxo{
+		{0, 1e8, scriptA},
+		{1, 2e8, scriptB},
+		{2, 3e8, scriptA},
+		{3, 4e8, scriptA},
+	}
+	// fixedSource selects the first n outputs of utxos.
+	fixedSource := func(n int) InputSource {
+		return InputSource{source: func(dcrutil.Amount) (*txauthor.InputDetail, error) {
+			detail := new(txauthor.InputDetail)
+			for _, u := range utxos[:n] {
+				op := wire.NewOutPoint(&chainhash.Hash{}, u.index, wire.TxTreeRegular)
+				detail.Inputs = append(detail.Inputs, wire.NewTxIn(op, u.value, nil))
+				detail.Scripts = append(detail.Scripts, u.script)
+				detail.RedeemScriptSizes = append(detail.RedeemScriptSizes, 1)
+				detail.Amount += dcrutil.Amount(u.value)
+			}
+			return detail, nil
+		}}
+	}
+
+	tests := []struct {
+		name     string
+		selected int
+		want     []uint32
+		amount   dcrutil.Amount
+	}{
+		{"group of first address", 1, []uint32{0, 2, 3}, 8e8},
+		{"groups of both addresses", 2, []uint32{0, 1, 2, 3}, 10e8},
+		{"no duplicate selection", 3, []uint32{0, 1, 2, 3}, 10e8},
+	}
+	for _, tc := range tests {
+		source := GroupInputsByAddress(fixedSource(tc.selected), fixedSource(len(utxos)))
+		detail, err := source.SelectInputs(1)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		var got []uint32
+		for _, in := range detail.Inputs {
+			got = append(got, in.PreviousOutPoint.Index)
+		}
+		if len(got) != len(tc.want) || len(detail.Scripts) != len(tc.want) ||
+			len(detail.RedeemScriptSizes) != len(tc.want) {
+			t.Fatalf("%s: selected outputs %v, want %v", tc.name, got, tc.want)
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Fatalf("%s: selected outputs %v, want %v", tc.name, got, tc.want)
+			}
+		}
+		if detail.Amount != tc.amount {
+			t.Errorf("%s: amount %v, want %v", tc.name, detail.Amount, tc.amount)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// MaxInvoiceLabelLen is the maximum length in bytes of an invoice label.
+const MaxInvoiceLabelLen = 128
+
+var (
+	// invoicesBucketKey is the bucket key for storing invoices awaiting or
+	// matched with payments to wallet addresses.
+	// Key: invoice ID (4 bytes) → Value: coin type (1 byte) | status
+	// (1 byte) | created Unix time (8 bytes) | expires Unix time (8 bytes)
+	// | address length (1 byte) | address | label length (1 byte) | label |
+	// amount length (1 byte) | amount | payment count (4 bytes) | payments
+	//
+	// Each payment is serialized as: outpoint hash (32 bytes) | outpoint
+	// index (4 bytes) | outpoint tree (1 byte) | height (4 bytes) | amount
+	// length (1 byte) | amount
+	//
+	// Amounts are big-endian unsigned integers of atoms.
+	invoicesBucketKey = []byte("invoices")
+)
+
+// InvoiceStatus describes whether an invoice is awaiting payment.
+type InvoiceStatus uint8
+
+// Invoice statuses.
+const (
+	// InvoiceOpen invoices have not been paid in full and have not
+	// expired.
+	InvoiceOpen InvoiceStatus = iota
+
+	// InvoicePaid invoices received payments totaling at least the
+	// invoiced amount before expiring.
+	InvoicePaid
+
+	// InvoiceExpired invoices expired before they were paid in full.
+	InvoiceExpired
+)
+
+var invoiceStatusNames = [...]string{
+	InvoiceOpen:    "open",
+	InvoicePaid:    "paid",
+	InvoiceExpired: "expired",
+}
+
+// String returns the name of the invoice status.
+func (s InvoiceStatus) String() string {
+	if int(s) >= len(invoiceStatusNames) {
+		return "unknown"
+	}
+	return invoiceStatusNames[s]
+}
+
+// ParseInvoiceStatus returns the invoice status with a name returned by
+// InvoiceStatus.String.
+func ParseInvoiceStatus(name string) (InvoiceStatus, error) {
+	for s, n := range invoiceStatusNames {
+		if n == name {
+			return InvoiceStatus(s), nil
+		}
+	}
+	return 0, errors.E(errors.Invalid, errors.Errorf("unknown invoice "+
+		"status %q", name))
+}
+
+// InvoicePayment is a wallet output paying an invoice.  Height is the height
+// of the block mining the output, or -1 if the output is unmined.
+type InvoicePayment struct {
+	OutPoint wire.OutPoint
+	Height   int32
+	Amount   *big.Int
+}
+
+// Invoice requests the payment of an amount of a coin type to a wallet
+// address.  Amount is in atoms of the coin type.  Expires is the time after
+// which payments are no longer matched with the invoice, or the zero time if
+// the invoice does not expire.
+type Invoice struct {
+	ID       uint32
+	Address  string
+	CoinType cointype.CoinType
+	Amount   *big.Int
+	Label    string
+	Created  time.Time
+	Expires  time.Time
+	Status   InvoiceStatus
+	Payments []InvoicePayment
+}
+
+// Received returns the total of the invoice's payments in atoms, including
+// unmined payments.
+func (inv *Invoice) Received() *big.Int {
+	received := new(big.Int)
+	for i := range inv.Payments {
+		received.Add(received, inv.Payments[i].Amount)
+	}
+	return received
+}
+
+// Expired returns whether the invoice expired before t.
+func (inv *Invoice) Expired(t time.Time) bool {
+	return !inv.Expires.IsZero() && t.After(inv.Expires)
+}
+
+// AddPayment matches a payment with an open invoice, marking the invoice paid
+// once its payments total at least the invoiced amount.  A payment of an
+// outpoint which was already matched updates the height of the recorded
+// payment, so an output is counted once whether it is first seen unmined or
+// mined.  Returns whether the invoice was modified.
+func (inv *Invoice) AddPayment(p InvoicePayment) bool {
+	for i := range inv.Payments {
+		if inv.Payments[i].OutPoint == p.OutPoint {
+			if inv.Payments[i].Height == p.Height {
+				return false
+			}
+			inv.Payments[i].Height = p.Height
+			return true
+		}
+	}
+	if inv.Status != InvoiceOpen {
+		return false
+	}
+	inv.Payments = append(inv.Payments, p)
+	if inv.Received().Cmp(inv.Amount) >= 0 {
+		inv.Status = InvoicePaid
+	}
+	return true
+}
+
+func keyInvoice(id uint32) []byte {
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, id)
+	return k
+}
+
+func valueInvoice(inv *Invoice) []byte {
+	amount := inv.Amount.Bytes()
+	var expires int64
+	if !inv.Expires.IsZero() {
+		expires = inv.Expires.Unix()
+	}
+	v := make([]byte, 18, 18+3+len(inv.Address)+len(inv.Label)+len(amount)+
+		4+len(inv.Payments)*(chainhash.HashSize+18))
+	v[0] = byte(inv.CoinType)
+	v[1] = byte(inv.Status)
+	byteOrder.PutUint64(v[2:], uint64(inv.Created.Unix()))
+	byteOrder.PutUint64(v[10:], uint64(expires))
+	v = append(v, byte(len(inv.Address)))
+	v = append(v, inv.Address...)
+	v = append(v, byte(len(inv.Label)))
+	v = append(v, inv.Label...)
+	v = append(v, byte(len(amount)))
+	v = append(v, amount...)
+	v = byteOrder.AppendUint32(v, uint32(len(inv.Payments)))
+	for i := range inv.Payments {
+		p := &inv.Payments[i]
+		amount := p.Amount.Bytes()
+		v = append(v, p.OutPoint.Hash[:]...)
+		v = byteOrder.AppendUint32(v, p.OutPoint.Index)
+		v = append(v, byte(p.OutPoint.Tree))
+		v = byteOrder.AppendUint32(v, uint32(p.Height))
+		v = append(v, byte(len(amount)))
+		v = append(v, amount...)
+	}
+	return v
+}
+
+// invoiceReader reads the fields of a serialized invoice, recording whether
+// the value was too short.
+type invoiceReader struct {
+	v   []byte
+	bad bool
+}
+
+func (r *invoiceReader) next(n int) []byte {
+	if r.bad || len(r.v) < n {
+		r.bad = true
+		return make([]byte, n)
+	}
+	b := r.v[:n]
+	r.v = r.v[n:]
+	return b
+}
+
+func (r *invoiceReader) nextVar() []byte {
+	return r.next(int(r.next(1)[0]))
+}
+
+func readInvoice(k, v []byte) (*Invoice, error) {
+	if len(k) != 4 {
+		return nil, errors.E(errors.IO, "bad invoice record")
+	}
+	r := &invoiceReader{v: v}
+	inv := &Invoice{
+		ID:       byteOrder.Uint32(k),
+		CoinType: cointype.CoinType(r.next(1)[0]),
+		Status:   InvoiceStatus(r.next(1)[0]),
+		Created:  time.Unix(int64(byteOrder.Uint64(r.next(8))), 0),
+	}
+	if expires := int64(byteOrder.Uint64(r.next(8))); expires != 0 {
+		inv.Expires = time.Unix(expires, 0)
+	}
+	inv.Address = string(r.nextVar())
+	inv.Label = string(r.nextVar())
+	inv.Amount = new(big.Int).SetBytes(r.nextVar())
+	n := byteOrder.Uint32(r.next(4))
+	for i := uint32(0); i < n && !r.bad; i++ {
+		var p InvoicePayment
+		copy(p.OutPoint.Hash[:], r.next(len(p.OutPoint.Hash)))
+		p.OutPoint.Index = byteOrder.Uint32(r.next(4))
+		p.OutPoint.Tree = int8(r.next(1)[0])
+		p.Height = int32(byteOrder.Uint32(r.next(4)))
+		p.Amount = new(big.Int).SetBytes(r.nextVar())
+		inv.Payments = append(inv.Payments, p)
+	}
+	if r.bad || len(r.v) != 0 || int(inv.Status) >= len(invoiceStatusNames) {
+		return nil, errors.E(errors.IO, "bad invoice record")
+	}
+	return inv, nil
+}
+
+// PutInvoice records an invoice.  An invoice with a zero ID is assigned the
+// next unused ID, while an invoice with a nonzero ID replaces the previously
+// recorded invoice with the ID.
+func PutInvoice(dbtx walletdb.ReadWriteTx, inv *Invoice) error {
+	const op errors.Op = "udb.PutInvoice"
+
+	switch {
+	case inv.Address == "" || len(inv.Address) > 255:
+		return errors.E(op, errors.Invalid, "invalid invoice address")
+	case inv.Amount == nil || inv.Amount.Sign() <= 0 || len(inv.Amount.Bytes()) > 255:
+		return errors.E(op, errors.Invalid, "invoice amount must be positive")
+	case len(inv.Label) > MaxInvoiceLabelLen:
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("invoice label exceeds maximum length %d",
+				MaxInvoiceLabelLen))
+	case inv.Created.Unix() < 0:
+		return errors.E(op, errors.Invalid,
+			"invoice creation time precedes the Unix epoch")
+	case !inv.Expires.IsZero() && inv.Expires.Unix() <= 0:
+		return errors.E(op, errors.Invalid,
+			"invoice expiry time must follow the Unix epoch")
+	case int(inv.Status) >= len(invoiceStatusNames):
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("invalid invoice status %d", inv.Status))
+	}
+	for i := range inv.Payments {
+		amount := inv.Payments[i].Amount
+		if amount == nil || amount.Sign() <= 0 || len(amount.Bytes()) > 255 {
+			return errors.E(op, errors.Invalid,
+				"invoice payment amounts must be positive")
+		}
+	}
+
+	b := dbtx.ReadWriteBucket(invoicesBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing invoices bucket")
+	}
+	if inv.ID == 0 {
+		c := b.ReadCursor()
+		k, _ := c.Last()
+		c.Close()
+		id := uint32(1)
+		if len(k) == 4 {
+			id = byteOrder.Uint32(k) + 1
+		}
+		if id == 0 {
+			return errors.E(op, errors.Invalid, "invoice IDs exhausted")
+		}
+		inv.ID = id
+	}
+	err := b.Put(keyInvoice(inv.ID), valueInvoice(inv))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// InvoiceByID returns the invoice with an ID.  An error with kind NotExist is
+// returned if no invoice has the ID.
+func InvoiceByID(dbtx walletdb.ReadTx, id uint32) (*Invoice, error) {
+	const op errors.Op = "udb.InvoiceByID"
+
+	var v []byte
+	k := keyInvoice(id)
+	if b := dbtx.ReadBucket(invoicesBucketKey); b != nil {
+		v = b.Get(k)
+	}
+	if v == nil {
+		return nil, errors.E(op, errors.NotExist,
+			errors.Errorf("no invoice with ID %d", id))
+	}
+	inv, err := readInvoice(k, v)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return inv, nil
+}
+
+// ForEachInvoice calls f with every invoice, in increasing ID order.
+// Iteration stops if f returns an error, which is returned to the caller.
+func ForEachInvoice(dbtx walletdb.ReadTx, f func(*Invoice) error) error {
+	const op errors.Op = "udb.ForEachInvoice"
+
+	b := dbtx.ReadBucket(invoicesBucketKey)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		inv, err := readInvoice(k, v)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return f(inv)
+	})
+}
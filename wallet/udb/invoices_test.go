@@ -0,0 +1,165 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestInvoices(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	put := func(inv *Invoice) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return PutInvoice(dbtx, inv)
+		})
+	}
+	byID := func(id uint32) (*Invoice, error) {
+		var inv *Invoice
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			inv, err = InvoiceByID(dbtx, id)
+			return err
+		})
+		return inv, err
+	}
+
+	invoices := []*Invoice{{
+		Address: "TsR28UZRprhgQQhzWns2M6cAwchrNVvbYq2",
+		Amount:  big.NewInt(1e8),
+		Label:   "order 1",
+		Created: time.Unix(1000, 0),
+	}, {
+		Address:  "TsR28UZRprhgQQhzWns2M6cAwchrNVvbYq2",
+		CoinType: 1,
+		Amount:   new(big.Int).Lsh(big.NewInt(1), 80),
+		Created:  time.Unix(2000, 0),
+		Expires:  time.Unix(3000, 0),
+	}}
+	for i, inv := range invoices {
+		if err := put(inv); err != nil {
+			t.Fatal(err)
+		}
+		if inv.ID != uint32(i+1) {
+			t.Errorf("invoice %d assigned ID %d", i, inv.ID)
+		}
+	}
+
+	// Payments are recorded with the invoice.
+	payment := InvoicePayment{
+		OutPoint: wire.OutPoint{Hash: chainhash.Hash{1}, Index: 2, Tree: 1},
+		Height:   -1,
+		Amount:   big.NewInt(4e7),
+	}
+	if !invoices[0].AddPayment(payment) {
+		t.Fatal("payment was not added")
+	}
+	if err := put(invoices[0]); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range invoices {
+		got, err := byID(want.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("invoice %d: got %+v, want %+v", want.ID, got, want)
+		}
+	}
+
+	var ids []uint32
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		return ForEachInvoice(dbtx, func(inv *Invoice) error {
+			ids = append(ids, inv.ID)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ids, []uint32{1, 2}) {
+		t.Errorf("iterated invoices %v, want [1 2]", ids)
+	}
+
+	if _, err := byID(3); !errors.Is(err, errors.NotExist) {
+		t.Errorf("missing invoice: expected NotExist error, got %v", err)
+	}
+	invalid := []*Invoice{
+		{Amount: big.NewInt(1)},
+		{Address: "Ts", Amount: big.NewInt(0)},
+		{Address: "Ts", Amount: big.NewInt(1), Label: string(make([]byte, MaxInvoiceLabelLen+1))},
+		{Address: "Ts", Amount: big.NewInt(1), Status: InvoiceExpired + 1},
+	}
+	for _, inv := range invalid {
+		if err := put(inv); !errors.Is(err, errors.Invalid) {
+			t.Errorf("%+v: expected Invalid error, got %v", inv, err)
+		}
+	}
+}
+
+func TestInvoiceAddPayment(t *testing.T) {
+	t.Parallel()
+
+	inv := &Invoice{Amount: big.NewInt(100)}
+	p := func(index uint32, height int32, amount int64) InvoicePayment {
+		return InvoicePayment{
+			OutPoint: wire.OutPoint{Index: index},
+			Height:   height,
+			Amount:   big.NewInt(amount),
+		}
+	}
+
+	if !inv.AddPayment(p(0, -1, 60)) || inv.Status != InvoiceOpen {
+		t.Fatalf("partial payment: status %v", inv.Status)
+	}
+	// Mining a matched output updates its height without counting it again.
+	if !inv.AddPayment(p(0, 10, 60)) || inv.AddPayment(p(0, 10, 60)) {
+		t.Fatal("unexpected modification of matched payment")
+	}
+	if inv.Received().Int64() != 60 || inv.Payments[0].Height != 10 {
+		t.Fatalf("received %v, height %d", inv.Received(), inv.Payments[0].Height)
+	}
+	if !inv.AddPayment(p(1, 11, 40)) || inv.Status != InvoicePaid {
+		t.Fatalf("full payment: status %v", inv.Status)
+	}
+	// Payments are not matched with paid invoices.
+	if inv.AddPayment(p(2, 12, 1)) || len(inv.Payments) != 2 {
+		t.Fatalf("payment matched with paid invoice")
+	}
+
+	inv.Expires = time.Unix(1000, 0)
+	if inv.Expired(time.Unix(1000, 0)) || !inv.Expired(time.Unix(1001, 0)) {
+		t.Errorf("invoice expiring at %v: unexpected expiry", inv.Expires)
+	}
+
+	for s := InvoiceOpen; s <= InvoiceExpired; s++ {
+		got, err := ParseInvoiceStatus(s.String())
+		if err != nil || got != s {
+			t.Errorf("ParseInvoiceStatus(%q) = %v, %v", s.String(), got, err)
+		}
+	}
+	if _, err := ParseInvoiceStatus("void"); !errors.Is(err, errors.Invalid) {
+		t.Errorf("unknown status: expected Invalid error, got %v", err)
+	}
+}
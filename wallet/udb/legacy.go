@@ -0,0 +1,146 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// LegacyDBVersion is the latest version of upstream dcrwallet databases which
+// may be imported.  Later versions of this database record coin types, and
+// upstream databases of later versions use an incompatible schema.
+const LegacyDBVersion = birthBlockVersion
+
+// DBSummary describes the contents of a wallet database which must be
+// preserved when a legacy dcrwallet database is imported.  Every output of a
+// legacy database is a VAR output, so the unspent outputs of an imported
+// database are those of the VAR coin type.
+type DBSummary struct {
+	Version        uint32
+	LastAccount    uint32
+	Transactions   int
+	UnspentOutputs int
+	Unspent        dcrutil.Amount
+}
+
+// SummarizeLegacyDB summarizes a legacy dcrwallet database before it is
+// imported.  Errors with code errors.Invalid are returned for databases which
+// are not legacy databases.
+func SummarizeLegacyDB(ctx context.Context, db walletdb.DB) (*DBSummary, error) {
+	const op errors.Op = "udb.SummarizeLegacyDB"
+	s, err := summarizeDB(ctx, db, bucketUnspent, bucketUnminedCredits)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if s.Version > LegacyDBVersion {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("database "+
+			"version %d is not a legacy dcrwallet database (versions "+
+			"through %d may be imported)", s.Version, LegacyDBVersion))
+	}
+	return s, nil
+}
+
+// SummarizeImportedDB summarizes an imported legacy database after it is
+// upgraded, for comparison with the summary of the legacy database.
+func SummarizeImportedDB(ctx context.Context, db walletdb.DB) (*DBSummary, error) {
+	const op errors.Op = "udb.SummarizeImportedDB"
+	s, err := summarizeDB(ctx, db,
+		bucketUnspentForCoinType(cointype.CoinTypeVAR),
+		bucketUnminedCreditsForCoinType(cointype.CoinTypeVAR))
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if s.Version != DBVersion {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("database "+
+			"version %d is not the current version %d", s.Version, DBVersion))
+	}
+	return s, nil
+}
+
+// summarizeDB summarizes a database using the unspent output and unmined
+// credit indexes named by unspentKey and unminedKey.  Only the record keys and
+// the amounts which begin each credit value are read, as these are encoded
+// identically by every database version.  Outputs spent by unmined
+// transactions are not counted.
+func summarizeDB(ctx context.Context, db walletdb.DB, unspentKey, unminedKey []byte) (*DBSummary, error) {
+	s := new(DBSummary)
+	err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		metadataBucket := dbtx.ReadBucket(unifiedDBMetadata{}.rootBucketKey())
+		if metadataBucket == nil {
+			return errors.E(errors.Invalid, "missing metadata bucket")
+		}
+		var err error
+		s.Version, err = unifiedDBMetadata{}.getVersion(metadataBucket)
+		if err != nil {
+			return err
+		}
+		s.LastAccount, err = fetchLastAccount(dbtx.ReadBucket(waddrmgrBucketKey))
+		if err != nil {
+			return err
+		}
+
+		ns := dbtx.ReadBucket(wtxmgrBucketKey)
+		for _, key := range [][]byte{bucketTxRecords, bucketUnmined} {
+			b := ns.NestedReadBucket(key)
+			if b == nil {
+				continue
+			}
+			err := b.ForEach(func(k, v []byte) error {
+				s.Transactions++
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		count := func(k, v []byte) error {
+			if existsRawUnminedInput(ns, k) != nil {
+				return nil
+			}
+			amount, err := fetchRawCreditAmount(v)
+			if err != nil {
+				return err
+			}
+			s.UnspentOutputs++
+			s.Unspent += amount
+			return nil
+		}
+		if b := ns.NestedReadBucket(unspentKey); b != nil {
+			err := b.ForEach(func(k, v []byte) error {
+				if len(k) != 36 || len(v) != 36 {
+					return errors.E(errors.IO, "bad unspent output record")
+				}
+				credKey := make([]byte, 72)
+				copy(credKey, k[:32])
+				copy(credKey[32:68], v)
+				copy(credKey[68:72], k[32:36])
+				cv := existsRawCredit(ns, credKey)
+				if cv == nil {
+					return errors.E(errors.IO, "missing credit for unspent output")
+				}
+				return count(k, cv)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if b := ns.NestedReadBucket(unminedKey); b != nil {
+			if err := b.ForEach(count); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -5,7 +5,12 @@
 
 package udb
 
-import "github.com/decred/slog"
+import (
+	"context"
+
+	"github.com/decred/slog"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
 
 // log is a logger that is initialized with no output filters.  This
 // means the package will not perform any logging by default until the caller
@@ -18,3 +23,18 @@ var log = slog.Disabled
 func UseLogger(logger slog.Logger) {
 	log = logger
 }
+
+// contextLogger is implemented by loggers which record the request ID carried
+// by a context with each record.
+type contextLogger interface {
+	WithContext(ctx context.Context) slog.Logger
+}
+
+// logTx returns the package logger, recording the request ID of the context
+// of the database transaction if the logger supports it.
+func logTx(tx walletdb.ReadTx) slog.Logger {
+	if l, ok := log.(contextLogger); ok {
+		return l.WithContext(walletdb.TxContext(tx))
+	}
+	return log
+}
@@ -35,3 +35,31 @@ func (unifiedDBMetadata) getVersion(bucket walletdb.ReadBucket) (uint32, error)
 	}
 	return byteOrder.Uint32(v), nil
 }
+
+// unifiedDBMetadataCompatVersionKey records the oldest database version
+// understood by software which may open the database.  It is recorded by
+// databases of schemaCompatVersion and later.
+const unifiedDBMetadataCompatVersionKey = "compatver"
+
+func (unifiedDBMetadata) putCompatVersion(bucket walletdb.ReadWriteBucket, version uint32) error {
+	buf := make([]byte, 4)
+	byteOrder.PutUint32(buf, version)
+	err := bucket.Put([]byte(unifiedDBMetadataCompatVersionKey), buf)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// getCompatVersion returns the recorded compatible version, and whether it
+// was recorded.
+func (unifiedDBMetadata) getCompatVersion(bucket walletdb.ReadBucket) (uint32, bool, error) {
+	v := bucket.Get([]byte(unifiedDBMetadataCompatVersionKey))
+	if v == nil {
+		return 0, false, nil
+	}
+	if len(v) != 4 {
+		return 0, false, errors.E(errors.IO, errors.Errorf("bad udb compatible version len %d", len(v)))
+	}
+	return byteOrder.Uint32(v), true, nil
+}
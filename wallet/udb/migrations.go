@@ -0,0 +1,279 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"time"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/chaincfg"
+)
+
+var (
+	// migrationHistoryBucketKey is the bucket key for recording when each
+	// database upgrade was performed.
+	// Key: version (4 bytes) → Value: unix time of the upgrade (8 bytes)
+	migrationHistoryBucketKey = []byte("migrationhistory")
+)
+
+// Migration describes the database upgrade to a version.
+type Migration struct {
+	Version     uint32
+	Description string
+}
+
+// MigrationRecord is a performed database upgrade recorded in the migration
+// history.
+type MigrationRecord struct {
+	Migration
+	Time time.Time
+}
+
+// migrationDescriptions summarizes the changes made by the upgrade to each
+// database version.
+var migrationDescriptions = [...]string{
+	lastUsedAddressIndexVersion:       "Index the last used address of BIP0044 accounts",
+	votingPreferencesVersion:          "Replace per-ticket vote bits with agenda voting preferences",
+	noEncryptedSeedVersion:            "Remove the encrypted seed",
+	lastReturnedAddressVersion:        "Index the last returned address of BIP0044 accounts",
+	ticketBucketVersion:               "Create the tickets bucket",
+	slip0044CoinTypeVersion:           "Allow SLIP0044 coin type keys",
+	hasExpiryVersion:                  "Record whether credits have an expiry",
+	hasExpiryFixedVersion:             "Rewrite credit expiry and stake flags",
+	cfVersion:                         "Create the compact filters bucket",
+	lastProcessedTxsBlockVersion:      "Record the last block processed for transactions",
+	ticketCommitmentsVersion:          "Index ticket commitment outputs",
+	importedXpubAccountVersion:        "Allow imported xpub accounts",
+	unencryptedRedeemScriptsVersion:   "Store redeem scripts unencrypted in the address manager",
+	blockcf2Version:                   "Recreate the compact filters bucket for version 2 filters",
+	perTicketVotingPreferencesVersion: "Create the per-ticket voting preferences bucket",
+	accountVariablesVersion:           "Create per-account variables buckets",
+	unpublishedTxsVersion:             "Create the unpublished transactions bucket",
+	tspendPolicyVersion:               "Create the treasury spend policy bucket",
+	vspBucketVersion:                  "Create the VSP tickets bucket",
+	vspStatusVersion:                  "Record the status of VSP tickets",
+	tspendHashPolicyVersion:           "Create the treasury spend hash policy bucket",
+	vspHostVersion:                    "Create the VSP host and public key buckets",
+	vspTreasuryPoliciesVersion:        "Create the VSP treasury policy buckets",
+	importVotingAccountVersion:        "Allow imported voting accounts",
+	birthBlockVersion:                 "Record the wallet birth block",
+	dualCoinVersion:                   "Record the coin type of credits and index balances by coin type",
+	coinTypeBucketsVersion:            "Create per-coin-type unspent output indexes",
+	consolidationAddressVersion:       "Create the account consolidation address bucket",
+	skaBucketsVersion:                 "Create the SKA credit amount and SSFee marker buckets",
+	wireFormatV13Version:              "Reserialize transactions using the V13 wire format",
+	txLabelsVersion:                   "Create the transaction labels bucket",
+	counterpartiesVersion:             "Create the counterparties bucket",
+	ticketCompoundingVersion:          "Create the ticket reward compounding bucket",
+	migrationHistoryVersion:           "Create the migration history bucket",
+	txTracesVersion:                   "Create the transaction traces bucket",
+	importedKeysVersion:               "Create the imported keys bucket",
+	changelogVersion:                  "Create the incremental backup changelog bucket",
+	txConflictsVersion:                "Create the transaction conflicts bucket",
+	feeRewardTicketsVersion:           "Create the fee reward funded tickets bucket",
+	ticketBuyerConfigVersion:          "Create the ticket buyer config bucket",
+	vspConfigVersion:                  "Create the selected VSP bucket",
+	stakeStatsVersion:                 "Create the stake statistics bucket",
+	changeAccountsVersion:             "Create the change account redirection bucket",
+	priceSnapshotsVersion:             "Create the fiat price snapshots bucket",
+	invoicesVersion:                   "Create the invoices bucket",
+	rpcCredentialsVersion:             "Create the RPC credentials bucket",
+	stakingKeyVersion:                 "Record a crypto key for the staking key domain",
+	argon2idMasterKeyVersion:          "Allow Argon2id master private key parameters",
+	prunedHistoryVersion:              "Create the pruned transaction history bucket",
+	spendLimitsVersion:                "Create the spend limits and pending sends buckets",
+	sendPolicyVersion:                 "Create the send policy bucket",
+	rebroadcastVersion:                "Create the rebroadcast queue bucket",
+	feeStatsVersion:                   "Create the fee statistics bucket",
+	changeScriptTypesVersion:          "Create the change script types bucket",
+	skaEmissionsVersion:               "Create the SKA emissions bucket",
+	scheduledSendsVersion:             "Create the scheduled sends bucket",
+	recurringPaymentsVersion:          "Create the recurring payments bucket",
+	inheritanceVersion:                "Create the inheritance bucket",
+	schemaCompatVersion:               "Record the oldest compatible database version",
+}
+
+// readCompatibleUpgrades lists the versions whose upgrades leave the database
+// usable by software of the previous version, such as upgrades which only
+// create buckets for optional records that the previous software ignores.
+// Upgrades to other versions record the upgraded version as the oldest
+// compatible version.  Upgrades which add indexes that every write must
+// maintain are never compatible.
+var readCompatibleUpgrades = map[uint32]bool{}
+
+// SchemaVersion describes the schema version recorded by a database.
+type SchemaVersion struct {
+	// Version is the version of the database.
+	Version uint32
+
+	// Compatible is the oldest database version understood by software
+	// which may open the database.  It equals Version for databases of
+	// versions before schemaCompatVersion, which did not record it.
+	Compatible uint32
+}
+
+// Supported returns whether the database may be opened by this software,
+// once any pending upgrades are performed.
+func (v *SchemaVersion) Supported() bool {
+	return v.Version <= DBVersion || v.Compatible <= DBVersion
+}
+
+func readSchemaVersion(metadataBucket walletdb.ReadBucket) (*SchemaVersion, error) {
+	version, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return nil, err
+	}
+	compat, ok, err := unifiedDBMetadata{}.getCompatVersion(metadataBucket)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		compat = version
+	}
+	return &SchemaVersion{Version: version, Compatible: compat}, nil
+}
+
+// ReadSchemaVersion returns the schema version recorded by the database.
+func ReadSchemaVersion(dbtx walletdb.ReadTx) (*SchemaVersion, error) {
+	const op errors.Op = "udb.ReadSchemaVersion"
+	metadataBucket := dbtx.ReadBucket(unifiedDBMetadata{}.rootBucketKey())
+	if metadataBucket == nil {
+		return nil, errors.E(op, errors.NotExist, "database has not been initialized")
+	}
+	v, err := readSchemaVersion(metadataBucket)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return v, nil
+}
+
+// The upgrade to DBVersion must be described.
+var _ [DBVersion + 1]string = migrationDescriptions
+
+// migrationsFrom returns the migrations upgrading a database from version to
+// DBVersion.
+func migrationsFrom(version uint32) []Migration {
+	var migrations []Migration
+	for v := version + 1; v <= DBVersion; v++ {
+		migrations = append(migrations, Migration{
+			Version:     v,
+			Description: migrationDescriptions[v],
+		})
+	}
+	return migrations
+}
+
+// PendingMigrations returns the upgrades which must be performed before the
+// database can be opened, in the order they will be performed.
+func PendingMigrations(ctx context.Context, db walletdb.DB) ([]Migration, error) {
+	const op errors.Op = "udb.PendingMigrations"
+	var v *SchemaVersion
+	err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		v, err = ReadSchemaVersion(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if !v.Supported() {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("database "+
+			"version %d is newer than the latest known version %d", v.Version,
+			DBVersion))
+	}
+	if v.Version >= DBVersion {
+		return nil, nil
+	}
+	return migrationsFrom(v.Version), nil
+}
+
+// errDryRun rolls back the transaction of a dry run upgrade.
+var errDryRun = errors.New("dry run")
+
+// DryRunUpgrade performs every pending upgrade of the database in a
+// transaction which is rolled back, leaving the database unmodified.  It
+// returns the migrations which Upgrade would perform, or the error of the
+// first migration which would fail.
+func DryRunUpgrade(ctx context.Context, db walletdb.DB, publicPassphrase []byte,
+	params *chaincfg.Params) ([]Migration, error) {
+
+	const op errors.Op = "udb.DryRunUpgrade"
+	migrations, err := PendingMigrations(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if len(migrations) == 0 {
+		return nil, nil
+	}
+
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		for _, m := range migrations {
+			err := performUpgrade(tx, m.Version-1, publicPassphrase, params)
+			if err != nil {
+				return err
+			}
+		}
+		return errDryRun
+	})
+	if !errors.Is(err, errDryRun) {
+		return nil, errors.E(op, err)
+	}
+	return migrations, nil
+}
+
+// putMigrationHistory records migrations as being performed at time t.
+func putMigrationHistory(dbtx walletdb.ReadWriteTx, migrations []Migration, t time.Time) error {
+	b := dbtx.ReadWriteBucket(migrationHistoryBucketKey)
+	if b == nil {
+		return errors.E(errors.Bug, "missing migration history bucket")
+	}
+	for _, m := range migrations {
+		k := make([]byte, 4)
+		byteOrder.PutUint32(k, m.Version)
+		v := make([]byte, 8)
+		byteOrder.PutUint64(v, uint64(t.Unix()))
+		err := b.Put(k, v)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	return nil
+}
+
+// MigrationHistory returns the database upgrades recorded since the migration
+// history was created, ordered by version.  Upgrades performed by earlier
+// software are not recorded.
+func MigrationHistory(dbtx walletdb.ReadTx) ([]MigrationRecord, error) {
+	const op errors.Op = "udb.MigrationHistory"
+	b := dbtx.ReadBucket(migrationHistoryBucketKey)
+	if b == nil {
+		return nil, nil
+	}
+	var history []MigrationRecord
+	err := b.ForEach(func(k, v []byte) error {
+		if len(k) != 4 || len(v) != 8 {
+			return errors.E(errors.IO, "bad migration history record")
+		}
+		version := byteOrder.Uint32(k)
+		var desc string
+		if version <= DBVersion {
+			desc = migrationDescriptions[version]
+		}
+		history = append(history, MigrationRecord{
+			Migration: Migration{
+				Version:     version,
+				Description: desc,
+			},
+			Time: time.Unix(int64(byteOrder.Uint64(v)), 0),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return history, nil
+}
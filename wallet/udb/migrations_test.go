@@ -0,0 +1,317 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestMigrationHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	params := chaincfg.TestNet3Params()
+	err := Initialize(ctx, db, params, seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Creating the wallet upgrades it from the initial version, recording
+	// every upgrade.
+	var history []MigrationRecord
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		history, err = MigrationHistory(dbtx)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != DBVersion-initialVersion {
+		t.Fatalf("recorded %d upgrades, want %d", len(history),
+			DBVersion-initialVersion)
+	}
+	for i, m := range history {
+		if m.Version != uint32(initialVersion+1+i) {
+			t.Errorf("history %d records version %d", i, m.Version)
+		}
+		if m.Description == "" {
+			t.Errorf("upgrade to version %d is not described", m.Version)
+		}
+	}
+
+	pending, err := PendingMigrations(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("upgraded database has %d pending migrations", len(pending))
+	}
+
+	// Revert the database to the version before the migration history
+	// existed.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		err := dbtx.DeleteTopLevelBucket(migrationHistoryBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(txTracesBucketKey)
+		if err != nil {
+			return err
+		}
+		addrmgrBucket := dbtx.ReadWriteBucket(waddrmgrBucketKey)
+		err = addrmgrBucket.DeleteNestedBucket(importedKeysBucketName)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(changelogBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(txConflictsBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(feeRewardTicketsBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(ticketBuyerConfigBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(vspConfigBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(stakeStatsBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(changeAccountsBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(priceSnapshotsBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(invoicesBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(rpcCredentialsBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(prunedHistoryBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(spendLimitsBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(pendingSendsBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(sendPolicyBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(rebroadcastBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(feeStatsBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(changeScriptTypesBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(skaEmissionsBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(scheduledSendsBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(recurringPaymentsBucketKey)
+		if err != nil {
+			return err
+		}
+		err = dbtx.DeleteTopLevelBucket(inheritanceBucketKey)
+		if err != nil {
+			return err
+		}
+		err = addrmgrBucket.NestedReadWriteBucket(mainBucketName).Delete(stakingKeyName)
+		if err != nil {
+			return err
+		}
+		metadataBucket := dbtx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+		return unifiedDBMetadata{}.putVersion(metadataBucket, migrationHistoryVersion-1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A dry run reports the pending upgrades without modifying the database.
+	migrations, err := DryRunUpgrade(ctx, db, pubPass, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) != DBVersion-migrationHistoryVersion+1 ||
+		migrations[0].Version != migrationHistoryVersion {
+		t.Fatalf("dry run reported migrations %+v", migrations)
+	}
+	version, err := dbVersion(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != migrationHistoryVersion-1 {
+		t.Errorf("dry run changed the database version to %d", version)
+	}
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		if dbtx.ReadBucket(migrationHistoryBucketKey) != nil {
+			t.Errorf("dry run created the migration history bucket")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Upgrade(ctx, db, pubPass, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		history, err = MigrationHistory(dbtx)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != len(migrations) || history[0].Version != migrationHistoryVersion {
+		t.Errorf("upgrade recorded history %+v", history)
+	}
+}
+
+func TestSchemaVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	params := chaincfg.TestNet3Params()
+	err := Initialize(ctx, db, params, seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	readVersion := func() *SchemaVersion {
+		t.Helper()
+		var v *SchemaVersion
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			v, err = ReadSchemaVersion(dbtx)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	setVersion := func(version, compat uint32) {
+		t.Helper()
+		err := walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			metadataBucket := dbtx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+			err := unifiedDBMetadata{}.putVersion(metadataBucket, version)
+			if err != nil {
+				return err
+			}
+			if compat == 0 {
+				return metadataBucket.Delete([]byte(unifiedDBMetadataCompatVersionKey))
+			}
+			return unifiedDBMetadata{}.putCompatVersion(metadataBucket, compat)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if v := readVersion(); v.Version != DBVersion || v.Compatible != DBVersion {
+		t.Fatalf("created database records schema version %+v", *v)
+	}
+
+	// An interrupted upgrade resumes from the last committed version and
+	// records the compatible version.
+	setVersion(schemaCompatVersion-1, 0)
+	if v := readVersion(); v.Compatible != schemaCompatVersion-1 {
+		t.Errorf("database without a compatible version reports %d", v.Compatible)
+	}
+	err = Upgrade(ctx, db, pubPass, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := readVersion(); v.Version != DBVersion || v.Compatible != schemaCompatVersion {
+		t.Errorf("resumed upgrade recorded schema version %+v", *v)
+	}
+	var history []MigrationRecord
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		history, err = MigrationHistory(dbtx)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last := history[len(history)-1]; last.Version != DBVersion {
+		t.Errorf("resumed upgrade recorded history %+v", last)
+	}
+
+	// Newer databases remaining compatible with this version may be opened
+	// without upgrades.
+	setVersion(DBVersion+1, DBVersion)
+	if _, _, err := Open(ctx, db, params, pubPass); err != nil {
+		t.Errorf("open compatible newer database: %v", err)
+	}
+	pending, err := PendingMigrations(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("compatible newer database has %d pending migrations", len(pending))
+	}
+	if err := Upgrade(ctx, db, pubPass, params); err != nil {
+		t.Fatal(err)
+	}
+	if v := readVersion(); v.Version != DBVersion+1 {
+		t.Errorf("upgrade changed newer database version to %d", v.Version)
+	}
+
+	setVersion(DBVersion+1, DBVersion+1)
+	if _, _, err := Open(ctx, db, params, pubPass); !errors.Is(err, errors.Invalid) {
+		t.Errorf("open incompatible newer database: expected Invalid error, got %v", err)
+	}
+	if _, err := PendingMigrations(ctx, db); !errors.Is(err, errors.Invalid) {
+		t.Errorf("pending migrations of incompatible newer database: "+
+			"expected Invalid error, got %v", err)
+	}
+}
@@ -16,8 +16,9 @@ import (
 // to access and modify data in the database.
 //
 // A NotExist error will be returned if the database has not been initialized.
-// The recorded database version must match exactly with DBVersion.  If the
-// version does not match, an Invalid error is returned.
+// The recorded database version must match exactly with DBVersion, or be a
+// newer version which records that it remains compatible with DBVersion.
+// Otherwise, an Invalid error is returned.
 func Open(ctx context.Context, db walletdb.DB, params *chaincfg.Params, pubPass []byte) (addrMgr *Manager, txStore *Store, err error) {
 	err = walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
 		// Verify the database exists and the recorded version is supported by
@@ -26,14 +27,14 @@ func Open(ctx context.Context, db walletdb.DB, params *chaincfg.Params, pubPass
 		if metadataBucket == nil {
 			return errors.E(errors.NotExist, "database has not been initialized")
 		}
-		dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+		v, err := readSchemaVersion(metadataBucket)
 		if err != nil {
 			return err
 		}
-		if dbVersion < DBVersion {
+		if v.Version < DBVersion {
 			return errors.E(errors.Invalid, "database upgrade required")
 		}
-		if dbVersion > DBVersion {
+		if !v.Supported() {
 			return errors.E(errors.Invalid, "database has been upgraded to an unknown newer version")
 		}
 
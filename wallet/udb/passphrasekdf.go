@@ -0,0 +1,199 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"crypto/subtle"
+	"runtime"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/kdf"
+	"github.com/monetarium/monetarium-wallet/wallet/internal/snacl"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/crypto/rand"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// Argon2idOptions describes the difficulty and parallelism of the Argon2id KDF
+// deriving master private keys from the private passphrase.  Memory is
+// measured in KiB.
+type Argon2idOptions struct {
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+}
+
+// argon2idOptionsForNet returns the default Argon2id options for a given
+// network.
+func argon2idOptionsForNet(net wire.CurrencyNet) Argon2idOptions {
+	if net == wire.SimNet {
+		return Argon2idOptions{Time: 1, Memory: 64, Threads: 1}
+	}
+
+	return Argon2idOptions{
+		Time:    1,
+		Memory:  256 * 1024, // 256 MiB
+		Threads: uint8(min(runtime.NumCPU(), 255)),
+	}
+}
+
+// KDF algorithms describing how master private keys are derived from the
+// private passphrase.
+const (
+	KDFScrypt   = "scrypt"
+	KDFArgon2id = "argon2id"
+)
+
+// KDFInfo describes the key derivation function deriving the master private
+// key from the private passphrase.
+type KDFInfo struct {
+	Algorithm string
+
+	// N, R, and P are the scrypt parameters, and are only set when the
+	// algorithm is scrypt.
+	N, R, P int
+
+	// Time, Memory, and Threads are the Argon2id parameters, and are only
+	// set when the algorithm is Argon2id.
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+
+	// Options are the Argon2id options used to derive new master private
+	// keys.
+	Options Argon2idOptions
+
+	// UpgradePending is set when the master private key will be derived
+	// with the Argon2id options after the manager is next unlocked.
+	UpgradePending bool
+}
+
+// Argon2idOptions returns the Argon2id options used to derive new master
+// private keys.
+func (m *Manager) Argon2idOptions() Argon2idOptions {
+	defer m.mtx.RUnlock()
+	m.mtx.RLock()
+
+	return m.argon2idOpts
+}
+
+// SetArgon2idOptions sets the Argon2id options used to derive new master
+// private keys.  Master private keys which were derived using scrypt, or
+// using Argon2id with a lower time or memory cost, are upgraded the next time
+// the manager is unlocked by UpgradePassphraseKDF.
+func (m *Manager) SetArgon2idOptions(opts Argon2idOptions) {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	m.argon2idOpts = opts
+}
+
+// kdfOutdated returns whether the master private key was derived with a
+// weaker KDF than described by the Argon2id options.
+//
+// This function MUST be called with the manager lock held for reads.
+func (m *Manager) kdfOutdated() bool {
+	params := m.masterKeyPriv.Parameters.Argon2id
+	if params == nil {
+		return true
+	}
+	return params.Time < m.argon2idOpts.Time ||
+		params.Memory < m.argon2idOpts.Memory
+}
+
+// KDFInfo describes the key derivation function deriving the master private
+// key from the private passphrase.
+func (m *Manager) KDFInfo() (*KDFInfo, error) {
+	defer m.mtx.RUnlock()
+	m.mtx.RLock()
+
+	if m.watchingOnly {
+		return nil, errors.E(errors.WatchingOnly)
+	}
+
+	info := &KDFInfo{
+		Options:        m.argon2idOpts,
+		UpgradePending: m.kdfOutdated(),
+	}
+	params := &m.masterKeyPriv.Parameters
+	if params.Argon2id != nil {
+		info.Algorithm = KDFArgon2id
+		info.Time = params.Argon2id.Time
+		info.Memory = params.Argon2id.Memory
+		info.Threads = params.Argon2id.Threads
+	} else {
+		info.Algorithm = KDFScrypt
+		info.N, info.R, info.P = params.N, params.R, params.P
+	}
+	return info, nil
+}
+
+// newArgon2idMasterKey derives a new master key from the passphrase with the
+// manager's Argon2id options and a random salt.
+//
+// This function MUST be called with the manager lock held for reads.
+func (m *Manager) newArgon2idMasterKey(passphrase []byte) (*snacl.SecretKey, error) {
+	params := &kdf.Argon2idParams{
+		Time:    m.argon2idOpts.Time,
+		Memory:  m.argon2idOpts.Memory,
+		Threads: m.argon2idOpts.Threads,
+	}
+	rand.Read(params.Salt[:])
+	return snacl.NewArgon2idSecretKey(&passphrase, params)
+}
+
+// UpgradePassphraseKDF reencrypts the crypto private key with a new master
+// private key derived from the passphrase using the manager's Argon2id
+// options, if the current master private key was derived with a weaker KDF.
+// The manager must be unlocked with the same passphrase.
+func (m *Manager) UpgradePassphraseKDF(ns walletdb.ReadWriteBucket, passphrase []byte) error {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.watchingOnly {
+		return errors.E(errors.WatchingOnly)
+	}
+	if m.locked {
+		return errors.E(errors.Locked, "manager must be unlocked to "+
+			"upgrade the passphrase KDF")
+	}
+	if !m.kdfOutdated() {
+		return nil
+	}
+
+	m.privPassphraseHasherMu.Lock()
+	m.privPassphraseHasher.Reset()
+	m.privPassphraseHasher.Write(passphrase)
+	passHash := m.privPassphraseHasher.Sum(nil)
+	m.privPassphraseHasherMu.Unlock()
+	if subtle.ConstantTimeCompare(passHash, m.privPassphraseHash) != 1 {
+		return errors.E(errors.Passphrase)
+	}
+
+	newMasterKey, err := m.newArgon2idMasterKey(passphrase)
+	if err != nil {
+		return err
+	}
+	encPriv, err := newMasterKey.Encrypt(m.cryptoKeyPriv.Bytes())
+	if err != nil {
+		newMasterKey.Zero()
+		return errors.E(errors.Crypto, errors.Errorf("encrypt crypto privkey: %v", err))
+	}
+	err = putCryptoKeys(ns, nil, encPriv)
+	if err != nil {
+		newMasterKey.Zero()
+		return err
+	}
+	err = putMasterKeyParams(ns, nil, newMasterKey.Marshal())
+	if err != nil {
+		newMasterKey.Zero()
+		return err
+	}
+
+	m.cryptoKeyPrivEncrypted = encPriv
+	m.masterKeyPriv.Zero()
+	m.masterKeyPriv = newMasterKey
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"math/big"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// MaxPriceCurrencyLen is the maximum length in bytes of the currency code of
+// a price snapshot.
+const MaxPriceCurrencyLen = 16
+
+var (
+	// priceSnapshotsBucketKey is the bucket key for storing the fiat
+	// exchange rates of coin types recorded for the transaction history.
+	// Key: coin type (1 byte) | Unix time (8 bytes) → Value: currency
+	// length (1 byte) | currency | price of one coin as a rational number
+	priceSnapshotsBucketKey = []byte("pricesnapshots")
+)
+
+// PriceSnapshot records the price of one whole coin of a coin type in a fiat
+// currency at a point in time.
+type PriceSnapshot struct {
+	CoinType cointype.CoinType
+	Time     time.Time
+	Currency string
+	Price    *big.Rat
+}
+
+func keyPriceSnapshot(ct cointype.CoinType, unix int64) []byte {
+	k := make([]byte, 9)
+	k[0] = byte(ct)
+	byteOrder.PutUint64(k[1:], uint64(unix))
+	return k
+}
+
+func readPriceSnapshot(k, v []byte) (*PriceSnapshot, error) {
+	if len(k) != 9 || len(v) < 1 || len(v) < 1+int(v[0]) {
+		return nil, errors.E(errors.IO, "bad price snapshot record")
+	}
+	price, ok := new(big.Rat).SetString(string(v[1+v[0]:]))
+	if !ok {
+		return nil, errors.E(errors.IO, "bad price snapshot record")
+	}
+	return &PriceSnapshot{
+		CoinType: cointype.CoinType(k[0]),
+		Time:     time.Unix(int64(byteOrder.Uint64(k[1:])), 0),
+		Currency: string(v[1 : 1+v[0]]),
+		Price:    price,
+	}, nil
+}
+
+// PutPriceSnapshot records the price of a coin type at the time of the
+// snapshot, truncated to seconds, replacing any price previously recorded
+// for the coin type at the same time.
+func PutPriceSnapshot(dbtx walletdb.ReadWriteTx, s *PriceSnapshot) error {
+	const op errors.Op = "udb.PutPriceSnapshot"
+
+	switch {
+	case s.Price == nil || s.Price.Sign() <= 0:
+		return errors.E(op, errors.Invalid, "price must be positive")
+	case s.Currency == "" || len(s.Currency) > MaxPriceCurrencyLen:
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("currency must be 1 to %d bytes", MaxPriceCurrencyLen))
+	case s.Time.Unix() < 0:
+		return errors.E(op, errors.Invalid, "price time precedes the Unix epoch")
+	}
+
+	b := dbtx.ReadWriteBucket(priceSnapshotsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing price snapshots bucket")
+	}
+	price := s.Price.String()
+	v := make([]byte, 0, 1+len(s.Currency)+len(price))
+	v = append(v, byte(len(s.Currency)))
+	v = append(v, s.Currency...)
+	v = append(v, price...)
+	err := b.Put(keyPriceSnapshot(s.CoinType, s.Time.Unix()), v)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// PriceSnapshotAt returns the latest price of a coin type recorded at or
+// before t.  An error with kind NotExist is returned if no earlier price is
+// recorded.
+func PriceSnapshotAt(dbtx walletdb.ReadTx, ct cointype.CoinType, t time.Time) (*PriceSnapshot, error) {
+	const op errors.Op = "udb.PriceSnapshotAt"
+
+	notExist := errors.E(op, errors.NotExist,
+		errors.Errorf("no %v price recorded at or before %v", ct, t))
+	b := dbtx.ReadBucket(priceSnapshotsBucketKey)
+	if b == nil || t.Unix() < 0 {
+		return nil, notExist
+	}
+
+	// Seek past every snapshot of the coin type at or before t, and step
+	// back to the latest of them.
+	c := b.ReadCursor()
+	defer c.Close()
+	k, v := c.Seek(keyPriceSnapshot(ct, t.Unix()+1))
+	if k == nil {
+		k, v = c.Last()
+	} else {
+		k, v = c.Prev()
+	}
+	if k == nil || !bytes.HasPrefix(k, []byte{byte(ct)}) {
+		return nil, notExist
+	}
+	s, err := readPriceSnapshot(k, v)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return s, nil
+}
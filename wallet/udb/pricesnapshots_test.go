@@ -0,0 +1,130 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestPriceSnapshots(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	put := func(ct cointype.CoinType, unix int64, currency, price string) error {
+		p, ok := new(big.Rat).SetString(price)
+		if !ok {
+			t.Fatalf("bad price %q", price)
+		}
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return PutPriceSnapshot(dbtx, &PriceSnapshot{
+				CoinType: ct,
+				Time:     time.Unix(unix, 0),
+				Currency: currency,
+				Price:    p,
+			})
+		})
+	}
+	at := func(ct cointype.CoinType, unix int64) (*PriceSnapshot, error) {
+		var s *PriceSnapshot
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			s, err = PriceSnapshotAt(dbtx, ct, time.Unix(unix, 0))
+			return err
+		})
+		return s, err
+	}
+
+	for _, p := range []struct {
+		ct       cointype.CoinType
+		unix     int64
+		currency string
+		price    string
+	}{
+		{0, 1000, "USD", "1.5"},
+		{0, 2000, "USD", "2.25"},
+		{1, 1500, "EUR", "0.001"},
+		{2, 3000, "USD", "7"},
+	} {
+		if err := put(p.ct, p.unix, p.currency, p.price); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		ct       cointype.CoinType
+		unix     int64
+		wantTime int64
+		currency string
+		price    string
+	}{
+		{0, 1000, 1000, "USD", "1.5"},
+		{0, 1999, 1000, "USD", "1.5"},
+		{0, 2000, 2000, "USD", "2.25"},
+		{0, 9999, 2000, "USD", "2.25"},
+		{1, 1500, 1500, "EUR", "0.001"},
+		{1, 2500, 1500, "EUR", "0.001"},
+		{2, 3001, 3000, "USD", "7"},
+	}
+	for _, tc := range tests {
+		s, err := at(tc.ct, tc.unix)
+		if err != nil {
+			t.Fatalf("price of %v at %d: %v", tc.ct, tc.unix, err)
+		}
+		want, _ := new(big.Rat).SetString(tc.price)
+		if s.CoinType != tc.ct || s.Time.Unix() != tc.wantTime ||
+			s.Currency != tc.currency || s.Price.Cmp(want) != 0 {
+			t.Errorf("price of %v at %d: got %v %d %s %v", tc.ct, tc.unix,
+				s.CoinType, s.Time.Unix(), s.Currency, s.Price)
+		}
+	}
+
+	// Prices recorded after the time, or only for other coin types, are
+	// never returned.
+	for _, lookup := range []struct {
+		ct   cointype.CoinType
+		unix int64
+	}{{0, 999}, {1, 1499}, {2, 2999}, {3, 5000}} {
+		_, err := at(lookup.ct, lookup.unix)
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("price of %v at %d: expected NotExist error, got %v",
+				lookup.ct, lookup.unix, err)
+		}
+	}
+
+	// Recording a price at the same time replaces the previous price.
+	if err := put(0, 2000, "USD", "3"); err != nil {
+		t.Fatal(err)
+	}
+	s, err := at(0, 2000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Price.Cmp(big.NewRat(3, 1)) != 0 {
+		t.Errorf("replaced price is %v, want 3", s.Price)
+	}
+
+	if err := put(0, 4000, "USD", "0"); !errors.Is(err, errors.Invalid) {
+		t.Errorf("zero price: expected Invalid error, got %v", err)
+	}
+	if err := put(0, 4000, "", "1"); !errors.Is(err, errors.Invalid) {
+		t.Errorf("empty currency: expected Invalid error, got %v", err)
+	}
+}
@@ -0,0 +1,505 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+var (
+	// prunedHistoryBucketKey is the bucket key for storing the aggregate
+	// history of the mined transactions removed from the transaction store
+	// by PruneTransactions, by the coin types they credited or debited.
+	// Key: coin type (1 byte)
+	// Value: transactions (4 bytes) | first height (4 bytes) | last height
+	// (4 bytes) | received length (1 byte) | signed big-endian received |
+	// sent length (1 byte) | signed big-endian sent
+	prunedHistoryBucketKey = []byte("prunedhistory")
+)
+
+// MinPruneDepth is the minimum number of blocks a transaction and all of its
+// spenders must be buried by before the transaction may be pruned.  It is far
+// deeper than any reorganization the wallet can process.
+const MinPruneDepth = 4096
+
+// PrunedHistory is the aggregate history of the pruned transactions which
+// credited or debited a coin type.  Received and Sent total the pruned
+// credits and debits of the coin type, and the heights describe the blocks
+// mining the oldest and newest pruned transactions.
+type PrunedHistory struct {
+	CoinType     cointype.CoinType
+	Transactions uint32
+	FirstHeight  int32
+	LastHeight   int32
+	Received     *big.Int
+	Sent         *big.Int
+}
+
+func valuePrunedHistory(h *PrunedHistory) ([]byte, error) {
+	received := cointype.NewSKAAmount(h.Received).SignedBytes()
+	sent := cointype.NewSKAAmount(h.Sent).SignedBytes()
+	if len(received) > 255 || len(sent) > 255 {
+		return nil, errors.E(errors.Invalid, "pruned history amount too large")
+	}
+	v := make([]byte, 12, 14+len(received)+len(sent))
+	binary.BigEndian.PutUint32(v, h.Transactions)
+	binary.BigEndian.PutUint32(v[4:], uint32(h.FirstHeight))
+	binary.BigEndian.PutUint32(v[8:], uint32(h.LastHeight))
+	v = append(v, byte(len(received)))
+	v = append(v, received...)
+	v = append(v, byte(len(sent)))
+	v = append(v, sent...)
+	return v, nil
+}
+
+func readPrunedHistory(k, v []byte) (*PrunedHistory, error) {
+	bad := errors.E(errors.IO, "bad pruned history record")
+	if len(k) != 1 || len(v) < 13 || len(v) < 14+int(v[12]) {
+		return nil, bad
+	}
+	h := &PrunedHistory{
+		CoinType:     cointype.CoinType(k[0]),
+		Transactions: binary.BigEndian.Uint32(v),
+		FirstHeight:  int32(binary.BigEndian.Uint32(v[4:])),
+		LastHeight:   int32(binary.BigEndian.Uint32(v[8:])),
+	}
+	l := int(v[12])
+	h.Received = cointype.SKAAmountFromSignedBytes(v[13 : 13+l]).BigInt()
+	v = v[13+l:]
+	if len(v) != 1+int(v[0]) {
+		return nil, bad
+	}
+	h.Sent = cointype.SKAAmountFromSignedBytes(v[1:]).BigInt()
+	return h, nil
+}
+
+// ForEachPrunedHistory calls f with the aggregate history of pruned
+// transactions of each coin type, in increasing coin type order.  Iteration
+// stops if f returns an error, which is returned to the caller.
+func ForEachPrunedHistory(dbtx walletdb.ReadTx, f func(*PrunedHistory) error) error {
+	const op errors.Op = "udb.ForEachPrunedHistory"
+
+	b := dbtx.ReadBucket(prunedHistoryBucketKey)
+	if b == nil {
+		return nil
+	}
+	c := b.ReadCursor()
+	defer c.Close()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		h, err := readPrunedHistory(k, v)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		if err := f(h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneCandidate describes a mined transaction which may be pruned.
+type pruneCandidate struct {
+	txKey      []byte
+	hash       chainhash.Hash
+	height     int32
+	time       time.Time
+	creditKeys [][]byte
+	debitKeys  [][]byte
+
+	// debitTxKeys are the transaction record keys of the transactions
+	// with credits spent by the candidate which remain recorded, and
+	// skaSpenderKeys are the transaction record keys of the spenders of
+	// the candidate's SKA credits.  The candidate may only be pruned
+	// along with these transactions.
+	debitTxKeys    [][]byte
+	skaSpenderKeys [][]byte
+}
+
+// pruneCandidates returns the mined transactions of blocks through maxHeight
+// which may be pruned, keyed by their transaction record keys.  Only regular
+// transactions of stake validated blocks whose credits are all spent by
+// transactions mined through maxHeight, and which record no multisig outputs,
+// are candidates.  Candidates are then excluded until every recorded credit
+// spent by a candidate is also a candidate's, and every SKA credit of a
+// candidate is spent by another candidate, so that the debits of remaining
+// transactions still describe the coin type and amount they spent.
+func (s *Store) pruneCandidates(ns walletdb.ReadBucket, maxHeight int32) (map[string]*pruneCandidate, error) {
+	candidates := make(map[string]*pruneCandidate)
+	var order []*pruneCandidate
+
+	it := makeReadBlockIterator(ns, 0)
+	defer it.close()
+	for it.next() {
+		block := &it.elem
+		if block.Height > maxHeight {
+			break
+		}
+		if extractRawBlockRecordStakeInvalid(it.cv) {
+			continue
+		}
+	txs:
+		for i := range block.transactions {
+			c := &pruneCandidate{
+				hash:   block.transactions[i],
+				height: block.Height,
+				time:   block.Time,
+			}
+			c.txKey = keyTxRecord(&c.hash, &block.Block)
+			v := existsRawTxRecord(ns, c.txKey)
+			if v == nil {
+				continue
+			}
+
+			spent := true
+			cur := ns.NestedReadBucket(bucketCredits).ReadCursor()
+			for k, v := cur.Seek(c.txKey); bytes.HasPrefix(k, c.txKey); k, v = cur.Next() {
+				if !extractRawCreditIsSpent(v) {
+					spent = false
+					break
+				}
+				debKey := extractRawCreditSpenderDebitKey(v)
+				if int32(byteOrder.Uint32(debKey[32:36])) > maxHeight {
+					spent = false
+					break
+				}
+				c.creditKeys = append(c.creditKeys, append([]byte(nil), k...))
+				if fetchRawCreditCoinType(v).IsSKA() {
+					spenderKey := append([]byte(nil), extractRawDebitTxRecordKey(debKey)...)
+					c.skaSpenderKeys = append(c.skaSpenderKeys, spenderKey)
+				}
+			}
+			cur.Close()
+			if !spent {
+				continue
+			}
+			for _, k := range c.creditKeys {
+				idx := extractRawCreditIndex(k)
+				if existsMultisigOut(ns, canonicalOutPoint(&c.hash, idx)) != nil {
+					continue txs
+				}
+			}
+
+			var msgTx wire.MsgTx
+			err := readRawTxRecordMsgTx(v, &msgTx)
+			if err != nil {
+				return nil, err
+			}
+			if stake.DetermineTxType(&msgTx) != stake.TxTypeRegular {
+				continue
+			}
+
+			cur = ns.NestedReadBucket(bucketDebits).ReadCursor()
+			for k, v := cur.Seek(c.txKey); bytes.HasPrefix(k, c.txKey); k, v = cur.Next() {
+				c.debitKeys = append(c.debitKeys, append([]byte(nil), k...))
+				credKey := extractRawDebitCreditKey(v)
+				if existsRawCredit(ns, credKey) != nil {
+					txKey := append([]byte(nil), credKey[:68]...)
+					c.debitTxKeys = append(c.debitTxKeys, txKey)
+				}
+			}
+			cur.Close()
+
+			candidates[string(c.txKey)] = c
+			order = append(order, c)
+		}
+	}
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	// Exclude candidates depending on transactions which are not pruned
+	// until no further candidates are excluded.
+	for excluded := true; excluded; {
+		excluded = false
+		for _, c := range order {
+			if candidates[string(c.txKey)] == nil {
+				continue
+			}
+			if !allCandidates(candidates, c.debitTxKeys) ||
+				!allCandidates(candidates, c.skaSpenderKeys) {
+				delete(candidates, string(c.txKey))
+				excluded = true
+			}
+		}
+	}
+	return candidates, nil
+}
+
+// allCandidates returns whether every transaction record key is a candidate's.
+func allCandidates(candidates map[string]*pruneCandidate, txKeys [][]byte) bool {
+	for _, k := range txKeys {
+		if candidates[string(k)] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// PruneTransactions removes the records of fully spent regular transactions
+// mined, along with all of their spenders, at least depth blocks below the
+// main chain tip, adding them to the aggregate pruned history of each coin
+// type they credited or debited.  Pruned transactions are no longer reported
+// by transaction queries, but the wallet balance is unchanged.  Progress, if
+// non-nil, is called with the number of transactions pruned and the number of
+// transactions to prune.  The labels and traces of pruned transactions are
+// removed, as are the price snapshots which no longer value any recorded
+// transaction.  The number of pruned transactions is returned.
+func (s *Store) PruneTransactions(dbtx walletdb.ReadWriteTx, depth int32, progress func(pruned, total int)) (int, error) {
+	const op errors.Op = "udb.PruneTransactions"
+
+	if depth < MinPruneDepth {
+		return 0, errors.E(op, errors.Invalid, errors.Errorf("prune depth "+
+			"must be at least %d blocks", MinPruneDepth))
+	}
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	historyBucket := dbtx.ReadWriteBucket(prunedHistoryBucketKey)
+	if historyBucket == nil {
+		return 0, errors.E(op, errors.Bug, "missing pruned history bucket")
+	}
+	labelsBucket := dbtx.ReadWriteBucket(txLabelsBucketKey)
+	if labelsBucket == nil {
+		return 0, errors.E(op, errors.Bug, "missing transaction labels bucket")
+	}
+	tracesBucket := dbtx.ReadWriteBucket(txTracesBucketKey)
+	if tracesBucket == nil {
+		return 0, errors.E(op, errors.Bug, "missing transaction traces bucket")
+	}
+	_, tipHeight := s.MainChainTip(dbtx)
+	maxHeight := tipHeight - depth
+	if maxHeight < 0 {
+		return 0, nil
+	}
+
+	candidates, err := s.pruneCandidates(ns, maxHeight)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	if progress != nil {
+		progress(0, len(candidates))
+	}
+
+	// Record the credits and debits of each candidate in the aggregate
+	// history before removing any records, as debits are described by the
+	// credits they spend.
+	history := make(map[cointype.CoinType]*PrunedHistory)
+	err = historyBucket.ForEach(func(k, v []byte) error {
+		h, err := readPrunedHistory(k, v)
+		if err != nil {
+			return err
+		}
+		history[h.CoinType] = h
+		return nil
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	addHistory := func(ct cointype.CoinType, height int32) *PrunedHistory {
+		h := history[ct]
+		if h == nil {
+			h = &PrunedHistory{
+				CoinType:    ct,
+				FirstHeight: height,
+				LastHeight:  height,
+				Received:    new(big.Int),
+				Sent:        new(big.Int),
+			}
+			history[ct] = h
+		}
+		h.FirstHeight = min(h.FirstHeight, height)
+		h.LastHeight = max(h.LastHeight, height)
+		return h
+	}
+	amount := func(credKey, credVal []byte) (cointype.CoinType, *big.Int, error) {
+		ct := fetchRawCreditCoinType(credVal)
+		if ct.IsSKA() {
+			return ct, fetchSKACreditAmount(ns, credKey).BigInt(), nil
+		}
+		amt, err := fetchRawCreditAmount(credVal)
+		return ct, big.NewInt(int64(amt)), err
+	}
+	prices := make(map[string]struct{})
+	for _, c := range candidates {
+		touched := make(map[cointype.CoinType]*PrunedHistory)
+		for _, k := range c.creditKeys {
+			ct, amt, err := amount(k, existsRawCredit(ns, k))
+			if err != nil {
+				return 0, errors.E(op, err)
+			}
+			h := addHistory(ct, c.height)
+			h.Received.Add(h.Received, amt)
+			touched[ct] = h
+		}
+		for _, k := range c.debitKeys {
+			debVal := ns.NestedReadBucket(bucketDebits).Get(k)
+			credKey := extractRawDebitCreditKey(debVal)
+			ct, amt := cointype.CoinTypeVAR, big.NewInt(int64(extractRawDebitAmount(debVal)))
+			if credVal := existsRawCredit(ns, credKey); credVal != nil {
+				ct, amt, err = amount(credKey, credVal)
+				if err != nil {
+					return 0, errors.E(op, err)
+				}
+			}
+			h := addHistory(ct, c.height)
+			h.Sent.Add(h.Sent, amt)
+			touched[ct] = h
+		}
+		for ct, h := range touched {
+			h.Transactions++
+			prices[string(keyPriceSnapshot(ct, c.time.Unix()))] = struct{}{}
+		}
+	}
+	for ct, h := range history {
+		v, err := valuePrunedHistory(h)
+		if err != nil {
+			return 0, errors.E(op, err)
+		}
+		err = historyBucket.Put([]byte{byte(ct)}, v)
+		if err != nil {
+			return 0, errors.E(op, errors.IO, err)
+		}
+	}
+
+	// Remove the records of each candidate, and the transaction hashes from
+	// the block records.
+	blockTxs := make(map[int32]map[chainhash.Hash]struct{})
+	pruned := 0
+	for _, c := range candidates {
+		for _, k := range c.creditKeys {
+			if err := deleteSKACreditAmount(ns, k); err != nil {
+				return 0, errors.E(op, errors.IO, err)
+			}
+			if err := deleteRawCredit(ns, k); err != nil {
+				return 0, errors.E(op, err)
+			}
+		}
+		for _, k := range c.debitKeys {
+			if err := deleteRawDebit(ns, k); err != nil {
+				return 0, errors.E(op, err)
+			}
+		}
+		if err := deleteSSFeeMarker(ns, c.txKey); err != nil {
+			return 0, errors.E(op, err)
+		}
+		if err := labelsBucket.Delete(c.hash[:]); err != nil {
+			return 0, errors.E(op, errors.IO, err)
+		}
+		if err := tracesBucket.Delete(c.hash[:]); err != nil {
+			return 0, errors.E(op, errors.IO, err)
+		}
+		err := ns.NestedReadWriteBucket(bucketTxRecords).Delete(c.txKey)
+		if err != nil {
+			return 0, errors.E(op, errors.IO, err)
+		}
+		if blockTxs[c.height] == nil {
+			blockTxs[c.height] = make(map[chainhash.Hash]struct{})
+		}
+		blockTxs[c.height][c.hash] = struct{}{}
+
+		pruned++
+		if progress != nil && pruned%1000 == 0 {
+			progress(pruned, len(candidates))
+		}
+	}
+	for height, txs := range blockTxs {
+		k, v := existsBlockRecord(ns, height)
+		if v == nil {
+			return 0, errors.E(op, errors.IO, errors.Errorf("missing block "+
+				"record for height %d", height))
+		}
+		newv := make([]byte, 47, len(v))
+		copy(newv, v[:47])
+		n := uint32(0)
+		for off := 47; off+chainhash.HashSize <= len(v); off += chainhash.HashSize {
+			var hash chainhash.Hash
+			copy(hash[:], v[off:])
+			if _, ok := txs[hash]; ok {
+				continue
+			}
+			newv = append(newv, hash[:]...)
+			n++
+		}
+		byteOrder.PutUint32(newv[43:47], n)
+		err := putRawBlockRecord(ns, k, newv)
+		if err != nil {
+			return 0, errors.E(op, err)
+		}
+	}
+	if err := prunePriceSnapshots(dbtx, ns, prices); err != nil {
+		return 0, errors.E(op, err)
+	}
+	if progress != nil {
+		progress(pruned, len(candidates))
+	}
+	return pruned, nil
+}
+
+// prunePriceSnapshots removes the price snapshots with the keys of the coin
+// types and block times of pruned transactions.  Transactions are valued by
+// the latest snapshot of their coin type at or before the time of their block,
+// so a snapshot is only removed when no block still recording transactions
+// was mined between its time and the time of the next snapshot of the coin
+// type.
+func prunePriceSnapshots(dbtx walletdb.ReadWriteTx, ns walletdb.ReadBucket, keys map[string]struct{}) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	b := dbtx.ReadWriteBucket(priceSnapshotsBucketKey)
+	if b == nil {
+		return errors.E(errors.Bug, "missing price snapshots bucket")
+	}
+
+	// Record the times of the blocks which still record transactions, in
+	// increasing order.
+	var times []int64
+	it := makeReadBlockIterator(ns, 0)
+	for it.next() {
+		if len(it.elem.transactions) != 0 {
+			times = append(times, it.elem.Time.Unix())
+		}
+	}
+	it.close()
+	if it.err != nil {
+		return it.err
+	}
+	slices.Sort(times)
+
+	var remove [][]byte
+	c := b.ReadCursor()
+	for k := range keys {
+		key := []byte(k)
+		if b.Get(key) == nil {
+			continue
+		}
+		t := int64(byteOrder.Uint64(key[1:]))
+		end := int64(math.MaxInt64)
+		next, _ := c.Seek(keyPriceSnapshot(cointype.CoinType(key[0]), t+1))
+		if next != nil && next[0] == key[0] {
+			end = int64(byteOrder.Uint64(next[1:]))
+		}
+		i, _ := slices.BinarySearch(times, t)
+		if i == len(times) || times[i] >= end {
+			remove = append(remove, key)
+		}
+	}
+	c.Close()
+	for _, k := range remove {
+		if err := b.Delete(k); err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	return nil
+}
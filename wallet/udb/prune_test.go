@@ -0,0 +1,238 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	_ "github.com/monetarium/monetarium-wallet/wallet/internal/bdb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+func TestPruneTransactions(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, teardown, err := cloneDB(ctx, "prune_transactions.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := makeBlockGenerator()
+	headers := make([]*wire.BlockHeader, 0, MinPruneDepth+2)
+	for i := 0; i < MinPruneDepth+2; i++ {
+		h := g.generate(dcrutil.BlockValid)
+		h.Timestamp = time.Unix(1700000000+int64(i)*300, 0)
+		g.lastHash = h.BlockHash()
+		headers = append(headers, h)
+	}
+
+	block1Tx := wire.MsgTx{
+		TxOut: []*wire.TxOut{{Value: 2e8, CoinType: 0}},
+	}
+	block2Tx := wire.MsgTx{
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Hash: block1Tx.TxHash()},
+		}},
+		TxOut: []*wire.TxOut{{Value: 1e8, CoinType: 0}},
+	}
+	block1TxRec, err := NewTxRecordFromMsgTx(&block1Tx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	block2TxRec, err := NewTxRecordFromMsgTx(&block2Tx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		for _, rec := range []*TxRecord{block1TxRec, block2TxRec} {
+			err := s.InsertMemPoolTx(dbtx, rec)
+			if err != nil {
+				return err
+			}
+			err = s.AddCredit(dbtx, rec, nil, 0, false, 0)
+			if err != nil {
+				return err
+			}
+		}
+		headerData := makeHeaderDataSlice(headers[:2]...)
+		err := insertMainChainHeaders(s, dbtx, headerData, emptyFilters(2))
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(dbtx, block1TxRec, &headerData[0].BlockHash)
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(dbtx, block2TxRec, &headerData[1].BlockHash)
+		if err != nil {
+			return err
+		}
+
+		// Label and trace both transactions, and record the prices of
+		// VAR and an SKA coin type at the time of each block.
+		for _, rec := range []*TxRecord{block1TxRec, block2TxRec} {
+			err := PutTxLabel(dbtx, &rec.Hash, "label")
+			if err != nil {
+				return err
+			}
+			err = PutTxTrace(dbtx, &rec.Hash, &TxTrace{ID: TxTraceID{1}})
+			if err != nil {
+				return err
+			}
+		}
+		for _, h := range headers[:2] {
+			for _, ct := range []cointype.CoinType{cointype.CoinTypeVAR, 1} {
+				err := PutPriceSnapshot(dbtx, &PriceSnapshot{
+					CoinType: ct,
+					Time:     h.Timestamp,
+					Currency: "USD",
+					Price:    big.NewRat(1, 1),
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prune := func() int {
+		t.Helper()
+		var pruned int
+		err := walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			var err error
+			pruned, err = s.PruneTransactions(dbtx, MinPruneDepth, nil)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pruned
+	}
+
+	// Neither transaction is buried deeply enough to be pruned.
+	if n := prune(); n != 0 {
+		t.Fatalf("pruned %d shallow transactions", n)
+	}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := s.PruneTransactions(dbtx, MinPruneDepth-1, nil)
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("pruning with a shallow depth: %v", err)
+		}
+		return insertMainChainHeaders(s, dbtx,
+			makeHeaderDataSlice(headers[2:]...), emptyFilters(len(headers)-2))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the fully spent transaction of block 1 is pruned.
+	if n := prune(); n != 1 {
+		t.Fatalf("pruned %d transactions, want 1", n)
+	}
+	if n := prune(); n != 0 {
+		t.Fatalf("pruned %d transactions again", n)
+	}
+
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrBucketKey)
+		block1Hash := block1Tx.TxHash()
+		_, err := s.TxDetails(ns, &block1Hash)
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("pruned transaction details: %v", err)
+		}
+		block2Hash := block2Tx.TxHash()
+		details, err := s.TxDetails(ns, &block2Hash)
+		if err != nil {
+			return err
+		}
+		if len(details.Debits) != 1 || details.Debits[0].Amount != 2e8 ||
+			details.Debits[0].CoinType != cointype.CoinTypeVAR {
+			t.Errorf("spender debits %+v", details.Debits)
+		}
+		block, err := fetchBlockRecord(ns, 1)
+		if err != nil {
+			return err
+		}
+		if len(block.transactions) != 0 {
+			t.Errorf("block record lists %d pruned transactions",
+				len(block.transactions))
+		}
+
+		bal, err := s.AccountBalance(dbtx, 1, 0)
+		if err != nil {
+			return err
+		}
+		if bal.Total != 1e8 {
+			t.Errorf("balance after pruning is %v, want 1 VAR", bal.Total)
+		}
+
+		// The label and trace of the pruned transaction are removed, as is
+		// the VAR price which no longer values any transaction.  Prices of
+		// other coin types are unchanged.
+		for _, tx := range []*wire.MsgTx{&block1Tx, &block2Tx} {
+			hash := tx.TxHash()
+			pruned := tx == &block1Tx
+			if l := TxLabel(dbtx, &hash); (l == "") != pruned {
+				t.Errorf("transaction %v label %q after pruning", &hash, l)
+			}
+			_, err := TxTraceForHash(dbtx, &hash)
+			if errors.Is(err, errors.NotExist) != pruned {
+				t.Errorf("transaction %v trace after pruning: %v", &hash, err)
+			}
+		}
+		prices := []struct {
+			ct   cointype.CoinType
+			h    *wire.BlockHeader
+			want bool
+		}{
+			{cointype.CoinTypeVAR, headers[0], false},
+			{cointype.CoinTypeVAR, headers[1], true},
+			{1, headers[0], true},
+			{1, headers[1], true},
+		}
+		for _, p := range prices {
+			price, err := PriceSnapshotAt(dbtx, p.ct, p.h.Timestamp)
+			recorded := err == nil && price.Time.Equal(p.h.Timestamp)
+			if recorded != p.want {
+				t.Errorf("%v price at height %d recorded %v after "+
+					"pruning, want %v", p.ct, p.h.Height, recorded, p.want)
+			}
+		}
+
+		var history []*PrunedHistory
+		err = ForEachPrunedHistory(dbtx, func(h *PrunedHistory) error {
+			history = append(history, h)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(history) != 1 {
+			t.Fatalf("pruned history records %d coin types", len(history))
+		}
+		h := history[0]
+		if h.CoinType != cointype.CoinTypeVAR || h.Transactions != 1 ||
+			h.FirstHeight != 1 || h.LastHeight != 1 ||
+			h.Received.Int64() != 2e8 || h.Sent.Sign() != 0 {
+			t.Errorf("pruned history %+v", h)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// rebroadcastBucketKey is the bucket key for storing the queue of
+	// published wallet-authored transactions which are periodically
+	// republished until mined.
+	// Key: transaction hash (32 bytes) → Value: queued Unix time (8
+	// bytes) | attempts (4 bytes) | last attempt Unix time (8 bytes) | next
+	// attempt Unix time (8 bytes) | last error
+	//
+	// A zero last attempt time records that the transaction has not been
+	// republished.
+	rebroadcastBucketKey = []byte("rebroadcast")
+)
+
+// Rebroadcast describes a published transaction queued to be republished
+// until it is mined.  Attempts counts the times it has been republished, and
+// LastError records the error of the last attempt, if it failed.
+type Rebroadcast struct {
+	Hash        chainhash.Hash
+	Queued      time.Time
+	Attempts    uint32
+	LastAttempt time.Time
+	NextAttempt time.Time
+	LastError   string
+}
+
+func unixOrZero(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.Unix())
+}
+
+func timeOrZero(unix uint64) time.Time {
+	if unix == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(unix), 0)
+}
+
+func valueRebroadcast(r *Rebroadcast) []byte {
+	v := make([]byte, 28, 28+len(r.LastError))
+	byteOrder.PutUint64(v[0:], uint64(r.Queued.Unix()))
+	byteOrder.PutUint32(v[8:], r.Attempts)
+	byteOrder.PutUint64(v[12:], unixOrZero(r.LastAttempt))
+	byteOrder.PutUint64(v[20:], uint64(r.NextAttempt.Unix()))
+	return append(v, r.LastError...)
+}
+
+func readRebroadcast(k, v []byte) (*Rebroadcast, error) {
+	if len(k) != chainhash.HashSize || len(v) < 28 {
+		return nil, errors.E(errors.IO, "bad rebroadcast record")
+	}
+	r := &Rebroadcast{
+		Queued:      time.Unix(int64(byteOrder.Uint64(v[0:])), 0),
+		Attempts:    byteOrder.Uint32(v[8:]),
+		LastAttempt: timeOrZero(byteOrder.Uint64(v[12:])),
+		NextAttempt: time.Unix(int64(byteOrder.Uint64(v[20:])), 0),
+		LastError:   string(v[28:]),
+	}
+	copy(r.Hash[:], k)
+	return r, nil
+}
+
+// PutRebroadcast queues a transaction to be republished, replacing any
+// previous record for the transaction.
+func PutRebroadcast(dbtx walletdb.ReadWriteTx, r *Rebroadcast) error {
+	const op errors.Op = "udb.PutRebroadcast"
+
+	if r.Queued.Unix() < 0 || r.NextAttempt.Unix() < 0 {
+		return errors.E(op, errors.Invalid,
+			"rebroadcast time precedes the Unix epoch")
+	}
+
+	b := dbtx.ReadWriteBucket(rebroadcastBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing rebroadcast bucket")
+	}
+	err := b.Put(r.Hash[:], valueRebroadcast(r))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteRebroadcast removes a transaction from the rebroadcast queue.
+func DeleteRebroadcast(dbtx walletdb.ReadWriteTx, txHash *chainhash.Hash) error {
+	const op errors.Op = "udb.DeleteRebroadcast"
+
+	b := dbtx.ReadWriteBucket(rebroadcastBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing rebroadcast bucket")
+	}
+	if err := b.Delete(txHash[:]); err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// ForEachRebroadcast calls f with every queued transaction, in increasing
+// transaction hash order.  Iteration stops if f returns an error, which is
+// returned to the caller.
+func ForEachRebroadcast(dbtx walletdb.ReadTx, f func(*Rebroadcast) error) error {
+	const op errors.Op = "udb.ForEachRebroadcast"
+
+	b := dbtx.ReadBucket(rebroadcastBucketKey)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		r, err := readRebroadcast(k, v)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return f(r)
+	})
+}
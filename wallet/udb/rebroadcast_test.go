@@ -0,0 +1,80 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestRebroadcastQueue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	queue := []*Rebroadcast{{
+		Hash:        [32]byte{1},
+		Queued:      time.Unix(1000, 0),
+		NextAttempt: time.Unix(1060, 0),
+	}, {
+		Hash:        [32]byte{2},
+		Queued:      time.Unix(2000, 0),
+		Attempts:    3,
+		LastAttempt: time.Unix(2400, 0),
+		NextAttempt: time.Unix(2880, 0),
+		LastError:   "connection refused",
+	}}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		for _, r := range queue {
+			if err := PutRebroadcast(dbtx, r); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list := func() []*Rebroadcast {
+		t.Helper()
+		var got []*Rebroadcast
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			return ForEachRebroadcast(dbtx, func(r *Rebroadcast) error {
+				got = append(got, r)
+				return nil
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+	if got := list(); !reflect.DeepEqual(got, queue) {
+		t.Fatalf("got queue %+v, want %+v", got, queue)
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return DeleteRebroadcast(dbtx, &queue[0].Hash)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := list(); !reflect.DeepEqual(got, queue[1:]) {
+		t.Fatalf("got queue %+v after removal, want %+v", got, queue[1:])
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// recurringPaymentsBucketKey is the bucket key for storing payments
+	// repeated every interval of blocks or time.
+	// Key: recurring payment ID (4 bytes) → Value: flags (1 byte) | failure
+	// policy (1 byte) | interval blocks (4 bytes) | interval seconds (8
+	// bytes) | next height (4 bytes) | next Unix time (8 bytes) |
+	// executions (4 bytes) | last attempt Unix time (8 bytes) | last
+	// transaction hash (32 bytes) | error length (2 bytes) | error |
+	// pending send record
+	//
+	// Only one of the block and time intervals, and the matching next
+	// height or time, is non-zero.  A zero last attempt time records that no
+	// payment was attempted.  The payment is encoded as a pending send
+	// record without ID.
+	recurringPaymentsBucketKey = []byte("recurringpayments")
+)
+
+// Recurring payment flags.
+const (
+	recurringPaymentPaused = 1 << iota
+)
+
+// RecurringFailurePolicy describes how a recurring payment proceeds after a
+// payment fails.
+type RecurringFailurePolicy uint8
+
+// Recurring payment failure policies.
+const (
+	// RecurringRetry payments are retried until they succeed, and the
+	// following payment is not due until an interval after the retried
+	// payment was due.
+	RecurringRetry RecurringFailurePolicy = iota
+
+	// RecurringSkip payments are skipped when they fail, and the following
+	// payment is due an interval later.
+	RecurringSkip
+
+	// RecurringPause payments pause the recurring payment when they fail.
+	RecurringPause
+)
+
+var recurringFailurePolicyNames = [...]string{
+	RecurringRetry: "retry",
+	RecurringSkip:  "skip",
+	RecurringPause: "pause",
+}
+
+// String returns the name of the failure policy.
+func (p RecurringFailurePolicy) String() string {
+	if int(p) >= len(recurringFailurePolicyNames) {
+		return "unknown"
+	}
+	return recurringFailurePolicyNames[p]
+}
+
+// ParseRecurringFailurePolicy returns the failure policy with a name returned
+// by RecurringFailurePolicy.String.
+func ParseRecurringFailurePolicy(name string) (RecurringFailurePolicy, error) {
+	for p, n := range recurringFailurePolicyNames {
+		if n == name {
+			return RecurringFailurePolicy(p), nil
+		}
+	}
+	return 0, errors.E(errors.Invalid, errors.Errorf("unknown failure "+
+		"policy %q", name))
+}
+
+// RecurringPayment describes a send repeated every IntervalBlocks blocks or
+// every Interval of time.  The next payment is due once the main chain tip
+// reaches NextHeight for block intervals, or once the time reaches NextTime
+// for time intervals.  Executions counts the payments which were sent, and
+// LastTxHash and LastError describe the outcome of the last attempted
+// payment.  Paused payments are not sent until resumed.
+type RecurringPayment struct {
+	ID             uint32
+	Send           PendingSend
+	IntervalBlocks int32
+	Interval       time.Duration
+	NextHeight     int32
+	NextTime       time.Time
+	FailurePolicy  RecurringFailurePolicy
+	Paused         bool
+	Executions     uint32
+	LastAttempt    time.Time
+	LastTxHash     chainhash.Hash
+	LastError      string
+}
+
+func keyRecurringPayment(id uint32) []byte {
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, id)
+	return k
+}
+
+func valueRecurringPayment(r *RecurringPayment) ([]byte, error) {
+	send, err := valuePendingSend(&r.Send)
+	if err != nil {
+		return nil, err
+	}
+	v := make([]byte, 70, 72+len(r.LastError)+len(send))
+	if r.Paused {
+		v[0] |= recurringPaymentPaused
+	}
+	v[1] = byte(r.FailurePolicy)
+	byteOrder.PutUint32(v[2:], uint32(r.IntervalBlocks))
+	byteOrder.PutUint64(v[6:], uint64(r.Interval/time.Second))
+	byteOrder.PutUint32(v[14:], uint32(r.NextHeight))
+	byteOrder.PutUint64(v[18:], unixOrZero(r.NextTime))
+	byteOrder.PutUint32(v[26:], r.Executions)
+	byteOrder.PutUint64(v[30:], unixOrZero(r.LastAttempt))
+	copy(v[38:], r.LastTxHash[:])
+	v = byteOrder.AppendUint16(v, uint16(len(r.LastError)))
+	v = append(v, r.LastError...)
+	return append(v, send...), nil
+}
+
+func readRecurringPayment(k, v []byte) (*RecurringPayment, error) {
+	if len(k) != 4 {
+		return nil, errors.E(errors.IO, "bad recurring payment record")
+	}
+	r := &invoiceReader{v: v}
+	p := &RecurringPayment{ID: byteOrder.Uint32(k)}
+	flags := r.next(1)[0]
+	p.Paused = flags&recurringPaymentPaused != 0
+	p.FailurePolicy = RecurringFailurePolicy(r.next(1)[0])
+	p.IntervalBlocks = int32(byteOrder.Uint32(r.next(4)))
+	p.Interval = time.Duration(byteOrder.Uint64(r.next(8))) * time.Second
+	p.NextHeight = int32(byteOrder.Uint32(r.next(4)))
+	p.NextTime = timeOrZero(byteOrder.Uint64(r.next(8)))
+	p.Executions = byteOrder.Uint32(r.next(4))
+	p.LastAttempt = timeOrZero(byteOrder.Uint64(r.next(8)))
+	copy(p.LastTxHash[:], r.next(chainhash.HashSize))
+	p.LastError = string(r.next(int(byteOrder.Uint16(r.next(2)))))
+	if r.bad {
+		return nil, errors.E(errors.IO, "bad recurring payment record")
+	}
+	send, err := readPendingSend(keyPendingSend(0), r.v)
+	if err != nil {
+		return nil, errors.E(errors.IO, "bad recurring payment record")
+	}
+	p.Send = *send
+	return p, nil
+}
+
+// PutRecurringPayment records a recurring payment.  Payments with a zero ID
+// are assigned the next unused ID, while others replace the previous record
+// of the payment.
+func PutRecurringPayment(dbtx walletdb.ReadWriteTx, p *RecurringPayment) error {
+	const op errors.Op = "udb.PutRecurringPayment"
+
+	switch {
+	case (p.IntervalBlocks > 0) == (p.Interval > 0):
+		return errors.E(op, errors.Invalid,
+			"recurring payment requires one block or time interval")
+	case p.IntervalBlocks < 0, p.Interval < 0, p.NextHeight < 0,
+		p.Interval > 0 && p.Interval < time.Second:
+		return errors.E(op, errors.Invalid, "recurring payment interval out of range")
+	case p.Interval > 0 && p.NextTime.Unix() <= 0:
+		return errors.E(op, errors.Invalid, "recurring payment requires a next time")
+	case int(p.FailurePolicy) >= len(recurringFailurePolicyNames):
+		return errors.E(op, errors.Invalid, "unknown failure policy")
+	case len(p.Send.Outputs) == 0:
+		return errors.E(op, errors.Invalid, "recurring payment has no outputs")
+	case p.Send.Amount == nil || p.Send.Amount.Sign() < 0 || len(p.Send.Amount.Bytes()) > 255:
+		return errors.E(op, errors.Invalid, "recurring payment amount out of range")
+	case len(p.Send.Label) > MaxTxLabelLen:
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("label exceeds maximum length %d", MaxTxLabelLen))
+	}
+	if len(p.LastError) > 0xffff {
+		p.LastError = p.LastError[:0xffff]
+	}
+
+	b := dbtx.ReadWriteBucket(recurringPaymentsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing recurring payments bucket")
+	}
+	id := p.ID
+	if id == 0 {
+		c := b.ReadCursor()
+		k, _ := c.Last()
+		c.Close()
+		id = 1
+		if len(k) == 4 {
+			id = byteOrder.Uint32(k) + 1
+		}
+		if id == 0 {
+			return errors.E(op, errors.Invalid, "recurring payment IDs exhausted")
+		}
+	}
+	v, err := valueRecurringPayment(p)
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	err = b.Put(keyRecurringPayment(id), v)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	p.ID = id
+	return nil
+}
+
+// RecurringPaymentByID returns the recurring payment with an ID.  An error
+// with kind NotExist is returned if no recurring payment has the ID.
+func RecurringPaymentByID(dbtx walletdb.ReadTx, id uint32) (*RecurringPayment, error) {
+	const op errors.Op = "udb.RecurringPaymentByID"
+
+	var v []byte
+	k := keyRecurringPayment(id)
+	if b := dbtx.ReadBucket(recurringPaymentsBucketKey); b != nil {
+		v = b.Get(k)
+	}
+	if v == nil {
+		return nil, errors.E(op, errors.NotExist,
+			errors.Errorf("no recurring payment with ID %d", id))
+	}
+	p, err := readRecurringPayment(k, v)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return p, nil
+}
+
+// DeleteRecurringPayment removes the recurring payment with an ID.  An error
+// with kind NotExist is returned if no recurring payment has the ID.
+func DeleteRecurringPayment(dbtx walletdb.ReadWriteTx, id uint32) error {
+	const op errors.Op = "udb.DeleteRecurringPayment"
+
+	b := dbtx.ReadWriteBucket(recurringPaymentsBucketKey)
+	k := keyRecurringPayment(id)
+	if b == nil || b.Get(k) == nil {
+		return errors.E(op, errors.NotExist,
+			errors.Errorf("no recurring payment with ID %d", id))
+	}
+	if err := b.Delete(k); err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// ForEachRecurringPayment calls f with every recurring payment, in increasing
+// ID order.  Iteration stops if f returns an error, which is returned to the
+// caller.
+func ForEachRecurringPayment(dbtx walletdb.ReadTx, f func(*RecurringPayment) error) error {
+	const op errors.Op = "udb.ForEachRecurringPayment"
+
+	b := dbtx.ReadBucket(recurringPaymentsBucketKey)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		p, err := readRecurringPayment(k, v)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return f(p)
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestRecurringPayments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	put := func(p *RecurringPayment) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return PutRecurringPayment(dbtx, p)
+		})
+	}
+	byID := func(id uint32) (*RecurringPayment, error) {
+		var p *RecurringPayment
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			p, err = RecurringPaymentByID(dbtx, id)
+			return err
+		})
+		return p, err
+	}
+
+	send := PendingSend{
+		Account:  1,
+		MinConf:  1,
+		CoinType: 0,
+		Outputs:  []*wire.TxOut{{Value: 5e8, PkScript: []byte{0x51}}},
+		Amount:   big.NewInt(5e8),
+		Label:    "rent",
+		Created:  time.Unix(1000, 0),
+	}
+	invalid := []*RecurringPayment{
+		{Send: send},
+		{Send: send, IntervalBlocks: 10, Interval: time.Hour, NextHeight: 20},
+		{Send: send, Interval: time.Hour},
+		{Send: send, IntervalBlocks: 10, FailurePolicy: 3},
+	}
+	for i, p := range invalid {
+		if err := put(p); !errors.Is(err, errors.Invalid) {
+			t.Errorf("invalid payment %d: expected Invalid error, got %v", i, err)
+		}
+	}
+
+	payments := []*RecurringPayment{{
+		Send:           send,
+		IntervalBlocks: 144,
+		NextHeight:     300,
+		FailurePolicy:  RecurringSkip,
+	}, {
+		Send:          send,
+		Interval:      24 * time.Hour,
+		NextTime:      time.Unix(5000, 0),
+		FailurePolicy: RecurringPause,
+		Paused:        true,
+	}}
+	for _, p := range payments {
+		if err := put(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []*RecurringPayment
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		return ForEachRecurringPayment(dbtx, func(p *RecurringPayment) error {
+			got = append(got, p)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(payments) {
+		t.Fatalf("iterated %d recurring payments, want %d", len(got), len(payments))
+	}
+	for i, want := range payments {
+		g := got[i]
+		switch {
+		case g.ID != uint32(i+1) || want.ID != g.ID:
+			t.Errorf("recurring payment %d assigned ID %d", i, g.ID)
+		case g.IntervalBlocks != want.IntervalBlocks || g.Interval != want.Interval:
+			t.Errorf("recurring payment %d: got interval %d/%v, want %d/%v", g.ID,
+				g.IntervalBlocks, g.Interval, want.IntervalBlocks, want.Interval)
+		case g.NextHeight != want.NextHeight || !g.NextTime.Equal(want.NextTime):
+			t.Errorf("recurring payment %d: got next %d/%v, want %d/%v", g.ID,
+				g.NextHeight, g.NextTime, want.NextHeight, want.NextTime)
+		case g.FailurePolicy != want.FailurePolicy || g.Paused != want.Paused:
+			t.Errorf("recurring payment %d: got policy %v paused %v", g.ID,
+				g.FailurePolicy, g.Paused)
+		case g.Send.Label != send.Label || g.Send.Amount.Cmp(send.Amount) != 0 ||
+			len(g.Send.Outputs) != 1 || g.Send.Outputs[0].Value != send.Outputs[0].Value:
+			t.Errorf("recurring payment %d: got send %+v, want %+v", g.ID, g.Send, send)
+		case !g.LastAttempt.IsZero() || g.Executions != 0:
+			t.Errorf("recurring payment %d: unexpected execution record", g.ID)
+		}
+	}
+
+	// Updating a payment replaces its record.
+	p := got[0]
+	p.Executions = 2
+	p.NextHeight += p.IntervalBlocks
+	p.LastAttempt = time.Unix(6000, 0)
+	p.LastTxHash = chainhash.Hash{1}
+	p.LastError = "insufficient balance"
+	if err := put(p); err != nil {
+		t.Fatal(err)
+	}
+	p, err = byID(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Executions != 2 || p.NextHeight != 444 || p.LastTxHash != (chainhash.Hash{1}) ||
+		p.LastAttempt.Unix() != 6000 || p.LastError != "insufficient balance" {
+		t.Errorf("updated recurring payment %+v", p)
+	}
+	if pol, err := ParseRecurringFailurePolicy(p.FailurePolicy.String()); err != nil || pol != RecurringSkip {
+		t.Errorf("parsed failure policy %v, %v", pol, err)
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return DeleteRecurringPayment(dbtx, 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := byID(1); !errors.Is(err, errors.NotExist) {
+		t.Errorf("deleted payment: expected NotExist error, got %v", err)
+	}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return DeleteRecurringPayment(dbtx, 1)
+	})
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("deleting unknown payment: expected NotExist error, got %v", err)
+	}
+}
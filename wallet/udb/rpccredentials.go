@@ -0,0 +1,254 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/monetarium/monetarium-node/crypto/rand"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// MaxRPCUsernameLen is the maximum length in bytes of the username of an RPC
+// credential.
+const MaxRPCUsernameLen = 64
+
+// rpcCredentialSaltLen is the length of the random salt hashed with the
+// password of an RPC credential.
+const rpcCredentialSaltLen = 16
+
+var (
+	// rpcCredentialsBucketKey is the bucket key for storing the RPC
+	// credentials which authenticate clients with a limited set of scopes.
+	// Key: username → Value: scopes (1 byte) | created Unix time (8 bytes) |
+	// salt (16 bytes) | SHA256(salt | password) (32 bytes)
+	rpcCredentialsBucketKey = []byte("rpccredentials")
+)
+
+// RPCScopes is a set of the scopes of RPC methods an RPC credential may
+// invoke.
+type RPCScopes uint8
+
+// RPC scopes.
+const (
+	// RPCScopeRead permits methods which only report wallet and network
+	// state.
+	RPCScopeRead RPCScopes = 1 << iota
+
+	// RPCScopeSend permits methods which derive receiving addresses and
+	// create, sign, and publish transactions.
+	RPCScopeSend
+
+	// RPCScopeStaking permits methods which purchase tickets and manage
+	// voting preferences.
+	RPCScopeStaking
+
+	// RPCScopeAdmin permits every method, including those which manage
+	// keys, passphrases, accounts, and RPC credentials.
+	RPCScopeAdmin
+)
+
+var rpcScopeNames = [...]string{
+	"read",
+	"send",
+	"staking",
+	"admin",
+}
+
+// ParseRPCScope returns the scope named by a name returned by
+// RPCScopes.Names.
+func ParseRPCScope(name string) (RPCScopes, error) {
+	for i, n := range rpcScopeNames {
+		if n == name {
+			return 1 << i, nil
+		}
+	}
+	return 0, errors.E(errors.Invalid, errors.Errorf("unknown RPC scope %q", name))
+}
+
+// Names returns the names of each scope in the set.
+func (s RPCScopes) Names() []string {
+	var names []string
+	for i, n := range rpcScopeNames {
+		if s&(1<<i) != 0 {
+			names = append(names, n)
+		}
+	}
+	return names
+}
+
+// String returns the comma-separated names of each scope in the set.
+func (s RPCScopes) String() string {
+	return strings.Join(s.Names(), ",")
+}
+
+// Allows returns whether the set permits invoking methods of a required
+// scope.  The admin scope permits methods of every scope.
+func (s RPCScopes) Allows(required RPCScopes) bool {
+	return s&RPCScopeAdmin != 0 || s&required == required
+}
+
+// RPCCredential is a username and hashed password which authenticates RPC
+// clients with a limited set of scopes.  The password itself is never
+// recorded.
+type RPCCredential struct {
+	Username string
+	Scopes   RPCScopes
+	Created  time.Time
+	salt     [rpcCredentialSaltLen]byte
+	hash     [sha256.Size]byte
+}
+
+// NewRPCCredential returns a credential authenticating a username and
+// password with a set of scopes.  The password is hashed with a random salt.
+func NewRPCCredential(username string, password []byte, scopes RPCScopes, created time.Time) *RPCCredential {
+	c := &RPCCredential{
+		Username: username,
+		Scopes:   scopes,
+		Created:  time.Unix(created.Unix(), 0),
+	}
+	rand.Read(c.salt[:])
+	c.hash = c.hashPassword(password)
+	return c
+}
+
+func (c *RPCCredential) hashPassword(password []byte) [sha256.Size]byte {
+	h := sha256.New()
+	h.Write(c.salt[:])
+	h.Write(password)
+	var hash [sha256.Size]byte
+	h.Sum(hash[:0])
+	return hash
+}
+
+// Verify returns whether a password matches the credential's password.  The
+// comparison is time constant.
+func (c *RPCCredential) Verify(password []byte) bool {
+	hash := c.hashPassword(password)
+	return subtle.ConstantTimeCompare(hash[:], c.hash[:]) == 1
+}
+
+func valueRPCCredential(c *RPCCredential) []byte {
+	v := make([]byte, 9, 9+len(c.salt)+len(c.hash))
+	v[0] = byte(c.Scopes)
+	byteOrder.PutUint64(v[1:], uint64(c.Created.Unix()))
+	v = append(v, c.salt[:]...)
+	v = append(v, c.hash[:]...)
+	return v
+}
+
+func readRPCCredential(k, v []byte) (*RPCCredential, error) {
+	if len(v) != 9+rpcCredentialSaltLen+sha256.Size {
+		return nil, errors.E(errors.IO, "bad RPC credential record")
+	}
+	c := &RPCCredential{
+		Username: string(k),
+		Scopes:   RPCScopes(v[0]),
+		Created:  time.Unix(int64(byteOrder.Uint64(v[1:])), 0),
+	}
+	copy(c.salt[:], v[9:])
+	copy(c.hash[:], v[9+rpcCredentialSaltLen:])
+	return c, nil
+}
+
+// PutRPCCredential records a new RPC credential.  An error with kind Exist is
+// returned if a credential with the same username is already recorded.
+func PutRPCCredential(dbtx walletdb.ReadWriteTx, c *RPCCredential) error {
+	const op errors.Op = "udb.PutRPCCredential"
+
+	switch {
+	case c.Username == "" || len(c.Username) > MaxRPCUsernameLen:
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("RPC username must be between 1 and %d bytes",
+				MaxRPCUsernameLen))
+	case strings.ContainsRune(c.Username, ':'):
+		return errors.E(op, errors.Invalid, "RPC username may not contain ':'")
+	case c.Scopes == 0 || c.Scopes>>len(rpcScopeNames) != 0:
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("invalid RPC scopes %#x", uint8(c.Scopes)))
+	case c.Created.Unix() < 0:
+		return errors.E(op, errors.Invalid,
+			"RPC credential creation time precedes the Unix epoch")
+	}
+
+	b := dbtx.ReadWriteBucket(rpcCredentialsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing RPC credentials bucket")
+	}
+	k := []byte(c.Username)
+	if b.Get(k) != nil {
+		return errors.E(op, errors.Exist,
+			errors.Errorf("RPC credential %q already exists", c.Username))
+	}
+	err := b.Put(k, valueRPCCredential(c))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// RPCCredentialByUsername returns the RPC credential with a username.  An
+// error with kind NotExist is returned if no credential has the username.
+func RPCCredentialByUsername(dbtx walletdb.ReadTx, username string) (*RPCCredential, error) {
+	const op errors.Op = "udb.RPCCredentialByUsername"
+
+	var v []byte
+	k := []byte(username)
+	if b := dbtx.ReadBucket(rpcCredentialsBucketKey); b != nil && len(k) != 0 {
+		v = b.Get(k)
+	}
+	if v == nil {
+		return nil, errors.E(op, errors.NotExist,
+			errors.Errorf("no RPC credential %q", username))
+	}
+	c, err := readRPCCredential(k, v)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return c, nil
+}
+
+// DeleteRPCCredential removes the RPC credential with a username.  An error
+// with kind NotExist is returned if no credential has the username.
+func DeleteRPCCredential(dbtx walletdb.ReadWriteTx, username string) error {
+	const op errors.Op = "udb.DeleteRPCCredential"
+
+	b := dbtx.ReadWriteBucket(rpcCredentialsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing RPC credentials bucket")
+	}
+	k := []byte(username)
+	if len(k) == 0 || b.Get(k) == nil {
+		return errors.E(op, errors.NotExist,
+			errors.Errorf("no RPC credential %q", username))
+	}
+	err := b.Delete(k)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// ForEachRPCCredential calls f with every RPC credential, in username order.
+// Iteration stops if f returns an error, which is returned to the caller.
+func ForEachRPCCredential(dbtx walletdb.ReadTx, f func(*RPCCredential) error) error {
+	const op errors.Op = "udb.ForEachRPCCredential"
+
+	b := dbtx.ReadBucket(rpcCredentialsBucketKey)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		c, err := readRPCCredential(k, v)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return f(c)
+	})
+}
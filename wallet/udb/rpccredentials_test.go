@@ -0,0 +1,142 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestRPCScopes(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"read", "send", "staking", "admin"} {
+		s, err := ParseRPCScope(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.String() != name {
+			t.Errorf("scope %q round tripped as %q", name, s)
+		}
+	}
+	if _, err := ParseRPCScope("write"); !errors.Is(err, errors.Invalid) {
+		t.Errorf("parsing unknown scope returned %v", err)
+	}
+
+	readSend := RPCScopeRead | RPCScopeSend
+	if readSend.String() != "read,send" {
+		t.Errorf("scopes formatted as %q", readSend)
+	}
+	switch {
+	case !readSend.Allows(RPCScopeRead):
+		t.Error("read,send does not allow read")
+	case readSend.Allows(RPCScopeStaking):
+		t.Error("read,send allows staking")
+	case readSend.Allows(RPCScopeAdmin):
+		t.Error("read,send allows admin")
+	case !RPCScopeAdmin.Allows(RPCScopeStaking):
+		t.Error("admin does not allow staking")
+	}
+}
+
+func TestRPCCredentials(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	put := func(c *RPCCredential) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return PutRPCCredential(dbtx, c)
+		})
+	}
+	byUsername := func(username string) (*RPCCredential, error) {
+		var c *RPCCredential
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			c, err = RPCCredentialByUsername(dbtx, username)
+			return err
+		})
+		return c, err
+	}
+
+	created := time.Unix(1000, 0)
+	dashboard := NewRPCCredential("dashboard", []byte("secret"), RPCScopeRead, created)
+	if err := put(dashboard); err != nil {
+		t.Fatal(err)
+	}
+	err = put(NewRPCCredential("dashboard", []byte("other"), RPCScopeAdmin, created))
+	if !errors.Is(err, errors.Exist) {
+		t.Errorf("replacing credential returned %v", err)
+	}
+	for _, c := range []*RPCCredential{
+		NewRPCCredential("", []byte("secret"), RPCScopeRead, created),
+		NewRPCCredential("a:b", []byte("secret"), RPCScopeRead, created),
+		NewRPCCredential("noscopes", []byte("secret"), 0, created),
+	} {
+		if err := put(c); !errors.Is(err, errors.Invalid) {
+			t.Errorf("putting invalid credential %q returned %v", c.Username, err)
+		}
+	}
+
+	c, err := byUsername("dashboard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Scopes != RPCScopeRead || !c.Created.Equal(created) {
+		t.Errorf("read credential %+v", c)
+	}
+	if !c.Verify([]byte("secret")) {
+		t.Error("correct password did not verify")
+	}
+	if c.Verify([]byte("Secret")) {
+		t.Error("incorrect password verified")
+	}
+
+	err = put(NewRPCCredential("buyer", []byte("tickets"), RPCScopeRead|RPCScopeStaking, created))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var usernames []string
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		return ForEachRPCCredential(dbtx, func(c *RPCCredential) error {
+			usernames = append(usernames, c.Username)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(usernames) != 2 || usernames[0] != "buyer" || usernames[1] != "dashboard" {
+		t.Errorf("iterated credentials %v", usernames)
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return DeleteRPCCredential(dbtx, "dashboard")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := byUsername("dashboard"); !errors.Is(err, errors.NotExist) {
+		t.Errorf("reading deleted credential returned %v", err)
+	}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return DeleteRPCCredential(dbtx, "dashboard")
+	})
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("deleting missing credential returned %v", err)
+	}
+}
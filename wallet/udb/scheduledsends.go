@@ -0,0 +1,247 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// scheduledSendsBucketKey is the bucket key for storing sends queued to
+	// be published once a time or block height is reached.
+	// Key: scheduled send ID (4 bytes) → Value: status (1 byte) | flags (1
+	// byte) | not before Unix time (8 bytes) | not before height (4 bytes)
+	// | transaction hash (32 bytes) | error length (2 bytes) | error |
+	// [signed transaction length (4 bytes) | signed transaction] | pending
+	// send record
+	//
+	// A zero not before time records that the send is not delayed until a
+	// time.  The signed transaction is only present when the signed flag is
+	// set.  The send is encoded as a pending send record without ID.
+	scheduledSendsBucketKey = []byte("scheduledsends")
+)
+
+// Scheduled send flags.
+const (
+	scheduledSendSigned = 1 << iota
+)
+
+// ScheduledSendStatus describes whether a scheduled send awaits its trigger.
+type ScheduledSendStatus uint8
+
+// Scheduled send statuses.
+const (
+	// ScheduledSendWaiting sends have not yet been triggered.
+	ScheduledSendWaiting ScheduledSendStatus = iota
+
+	// ScheduledSendSent sends were published once triggered.
+	ScheduledSendSent
+
+	// ScheduledSendFailed sends could not be published once triggered.
+	ScheduledSendFailed
+
+	// ScheduledSendCancelled sends were cancelled before being triggered.
+	ScheduledSendCancelled
+)
+
+var scheduledSendStatusNames = [...]string{
+	ScheduledSendWaiting:   "waiting",
+	ScheduledSendSent:      "sent",
+	ScheduledSendFailed:    "failed",
+	ScheduledSendCancelled: "cancelled",
+}
+
+// String returns the name of the scheduled send status.
+func (s ScheduledSendStatus) String() string {
+	if int(s) >= len(scheduledSendStatusNames) {
+		return "unknown"
+	}
+	return scheduledSendStatusNames[s]
+}
+
+// ScheduledSend describes a send queued to be published once the time is at
+// least NotBefore and the main chain tip is at least NotBeforeHeight.  Either
+// trigger is ignored when zero.  Sends without SignedTx are authored and
+// signed when triggered, while SignedTx holds a transaction signed when the
+// send was scheduled.  TxHash is the hash of the published transaction of a
+// sent send, and LastError records why a failed send could not be published.
+type ScheduledSend struct {
+	ID              uint32
+	Send            PendingSend
+	NotBefore       time.Time
+	NotBeforeHeight int32
+	SignedTx        *wire.MsgTx
+	Status          ScheduledSendStatus
+	TxHash          chainhash.Hash
+	LastError       string
+}
+
+func keyScheduledSend(id uint32) []byte {
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, id)
+	return k
+}
+
+func valueScheduledSend(s *ScheduledSend) ([]byte, error) {
+	send, err := valuePendingSend(&s.Send)
+	if err != nil {
+		return nil, err
+	}
+	var signed []byte
+	if s.SignedTx != nil {
+		signed, err = s.SignedTx.Bytes()
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := make([]byte, 46, 52+len(s.LastError)+len(signed)+len(send))
+	v[0] = byte(s.Status)
+	if s.SignedTx != nil {
+		v[1] |= scheduledSendSigned
+	}
+	byteOrder.PutUint64(v[2:], unixOrZero(s.NotBefore))
+	byteOrder.PutUint32(v[10:], uint32(s.NotBeforeHeight))
+	copy(v[14:], s.TxHash[:])
+	v = byteOrder.AppendUint16(v, uint16(len(s.LastError)))
+	v = append(v, s.LastError...)
+	if s.SignedTx != nil {
+		v = byteOrder.AppendUint32(v, uint32(len(signed)))
+		v = append(v, signed...)
+	}
+	return append(v, send...), nil
+}
+
+func readScheduledSend(k, v []byte) (*ScheduledSend, error) {
+	if len(k) != 4 {
+		return nil, errors.E(errors.IO, "bad scheduled send record")
+	}
+	r := &invoiceReader{v: v}
+	s := &ScheduledSend{
+		ID:     byteOrder.Uint32(k),
+		Status: ScheduledSendStatus(r.next(1)[0]),
+	}
+	flags := r.next(1)[0]
+	s.NotBefore = timeOrZero(byteOrder.Uint64(r.next(8)))
+	s.NotBeforeHeight = int32(byteOrder.Uint32(r.next(4)))
+	copy(s.TxHash[:], r.next(chainhash.HashSize))
+	s.LastError = string(r.next(int(byteOrder.Uint16(r.next(2)))))
+	var signed []byte
+	if flags&scheduledSendSigned != 0 {
+		signed = r.next(int(byteOrder.Uint32(r.next(4))))
+	}
+	if r.bad {
+		return nil, errors.E(errors.IO, "bad scheduled send record")
+	}
+	if signed != nil {
+		s.SignedTx = new(wire.MsgTx)
+		if err := s.SignedTx.FromBytes(signed); err != nil {
+			return nil, errors.E(errors.IO, err)
+		}
+	}
+	send, err := readPendingSend(keyPendingSend(0), r.v)
+	if err != nil {
+		return nil, errors.E(errors.IO, "bad scheduled send record")
+	}
+	s.Send = *send
+	return s, nil
+}
+
+// PutScheduledSend records a scheduled send.  Sends with a zero ID are
+// assigned the next unused ID, while others replace the previous record of
+// the send.
+func PutScheduledSend(dbtx walletdb.ReadWriteTx, s *ScheduledSend) error {
+	const op errors.Op = "udb.PutScheduledSend"
+
+	switch {
+	case s.NotBefore.IsZero() && s.NotBeforeHeight <= 0:
+		return errors.E(op, errors.Invalid,
+			"scheduled send requires a time or block height")
+	case !s.NotBefore.IsZero() && s.NotBefore.Unix() < 0, s.NotBeforeHeight < 0:
+		return errors.E(op, errors.Invalid, "scheduled send trigger out of range")
+	case len(s.Send.Outputs) == 0:
+		return errors.E(op, errors.Invalid, "scheduled send has no outputs")
+	case s.Send.Amount == nil || s.Send.Amount.Sign() < 0 || len(s.Send.Amount.Bytes()) > 255:
+		return errors.E(op, errors.Invalid, "scheduled send amount out of range")
+	case len(s.Send.Label) > MaxTxLabelLen:
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("label exceeds maximum length %d", MaxTxLabelLen))
+	}
+	if len(s.LastError) > 0xffff {
+		s.LastError = s.LastError[:0xffff]
+	}
+
+	b := dbtx.ReadWriteBucket(scheduledSendsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing scheduled sends bucket")
+	}
+	id := s.ID
+	if id == 0 {
+		c := b.ReadCursor()
+		k, _ := c.Last()
+		c.Close()
+		id = 1
+		if len(k) == 4 {
+			id = byteOrder.Uint32(k) + 1
+		}
+		if id == 0 {
+			return errors.E(op, errors.Invalid, "scheduled send IDs exhausted")
+		}
+	}
+	v, err := valueScheduledSend(s)
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	err = b.Put(keyScheduledSend(id), v)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	s.ID = id
+	return nil
+}
+
+// ScheduledSendByID returns the scheduled send with an ID.  An error with kind
+// NotExist is returned if no send is scheduled with the ID.
+func ScheduledSendByID(dbtx walletdb.ReadTx, id uint32) (*ScheduledSend, error) {
+	const op errors.Op = "udb.ScheduledSendByID"
+
+	var v []byte
+	k := keyScheduledSend(id)
+	if b := dbtx.ReadBucket(scheduledSendsBucketKey); b != nil {
+		v = b.Get(k)
+	}
+	if v == nil {
+		return nil, errors.E(op, errors.NotExist,
+			errors.Errorf("no scheduled send with ID %d", id))
+	}
+	s, err := readScheduledSend(k, v)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return s, nil
+}
+
+// ForEachScheduledSend calls f with every scheduled send, in increasing ID
+// order.  Iteration stops if f returns an error, which is returned to the
+// caller.
+func ForEachScheduledSend(dbtx walletdb.ReadTx, f func(*ScheduledSend) error) error {
+	const op errors.Op = "udb.ForEachScheduledSend"
+
+	b := dbtx.ReadBucket(scheduledSendsBucketKey)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		s, err := readScheduledSend(k, v)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return f(s)
+	})
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestScheduledSends(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	put := func(s *ScheduledSend) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return PutScheduledSend(dbtx, s)
+		})
+	}
+	byID := func(id uint32) (*ScheduledSend, error) {
+		var s *ScheduledSend
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			s, err = ScheduledSendByID(dbtx, id)
+			return err
+		})
+		return s, err
+	}
+
+	send := PendingSend{
+		Account:     1,
+		MinConf:     1,
+		CoinType:    2,
+		Outputs:     []*wire.TxOut{{CoinType: 2, SKAValue: big.NewInt(5e8), PkScript: []byte{0x51}}},
+		ExpireAfter: 6,
+		Amount:      big.NewInt(5e8),
+		Label:       "payroll",
+		Created:     time.Unix(1000, 0),
+	}
+	if err := put(&ScheduledSend{Send: send}); !errors.Is(err, errors.Invalid) {
+		t.Errorf("no trigger: expected Invalid error, got %v", err)
+	}
+
+	signed := wire.NewMsgTx()
+	signed.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 3}, 1e8, []byte{0x51}))
+	signed.AddTxOut(wire.NewTxOut(9e7, []byte{0x51}))
+	signed.Expiry = 500
+	scheduled := []*ScheduledSend{{
+		Send:      send,
+		NotBefore: time.Unix(2000, 0),
+	}, {
+		Send:            send,
+		NotBeforeHeight: 400,
+		SignedTx:        signed,
+	}}
+	for _, s := range scheduled {
+		if err := put(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []*ScheduledSend
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		return ForEachScheduledSend(dbtx, func(s *ScheduledSend) error {
+			got = append(got, s)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(scheduled) {
+		t.Fatalf("iterated %d scheduled sends, want %d", len(got), len(scheduled))
+	}
+	for i, want := range scheduled {
+		g := got[i]
+		switch {
+		case g.ID != uint32(i+1) || want.ID != g.ID:
+			t.Errorf("scheduled send %d assigned ID %d", i, g.ID)
+		case !g.NotBefore.Equal(want.NotBefore) || g.NotBeforeHeight != want.NotBeforeHeight:
+			t.Errorf("scheduled send %d: got trigger %v/%d, want %v/%d", g.ID,
+				g.NotBefore, g.NotBeforeHeight, want.NotBefore, want.NotBeforeHeight)
+		case g.Status != ScheduledSendWaiting:
+			t.Errorf("scheduled send %d: status %v", g.ID, g.Status)
+		case g.Send.Label != send.Label || g.Send.ExpireAfter != send.ExpireAfter ||
+			g.Send.Amount.Cmp(send.Amount) != 0 || len(g.Send.Outputs) != 1 ||
+			g.Send.Outputs[0].SKAValue.Cmp(send.Outputs[0].SKAValue) != 0:
+			t.Errorf("scheduled send %d: got send %+v, want %+v", g.ID, g.Send, send)
+		case (g.SignedTx == nil) != (want.SignedTx == nil):
+			t.Errorf("scheduled send %d: signed transaction %v", g.ID, g.SignedTx)
+		case g.SignedTx != nil && g.SignedTx.TxHash() != signed.TxHash():
+			t.Errorf("scheduled send %d: signed transaction %v, want %v", g.ID,
+				g.SignedTx.TxHash(), signed.TxHash())
+		}
+	}
+
+	// Updating a send replaces its record.
+	s := got[1]
+	s.Status = ScheduledSendSent
+	s.TxHash = signed.TxHash()
+	s.LastError = "previous attempt failed"
+	if err := put(s); err != nil {
+		t.Fatal(err)
+	}
+	s, err = byID(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Status != ScheduledSendSent || s.TxHash != signed.TxHash() ||
+		s.LastError != "previous attempt failed" {
+		t.Errorf("updated scheduled send %+v", s)
+	}
+	if s.Status.String() != "sent" {
+		t.Errorf("status name %q", s.Status)
+	}
+	if _, err := byID(3); !errors.Is(err, errors.NotExist) {
+		t.Errorf("unknown ID: expected NotExist error, got %v", err)
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// sendPolicyBucketKey is the bucket key for storing the send policy
+	// evaluated before the wallet publishes a send.  Records are keyed by a
+	// one byte prefix:
+	// Key: 'b' | address → Value: reason the address is blocked
+	// Key: 'l' | coin type (1 byte) → Value: label threshold (big-endian
+	// atoms)
+	// Key: 's' | coin type (1 byte) | account (4 bytes) → Value: empty
+	sendPolicyBucketKey = []byte("sendpolicy")
+)
+
+// Send policy record key prefixes.
+const (
+	sendPolicyBlocked    = 'b'
+	sendPolicyLabel      = 'l'
+	sendPolicySKAAccount = 's'
+)
+
+// maxBlockedAddrReasonLen is the maximum length in bytes of the reason an
+// address is blocked.
+const maxBlockedAddrReasonLen = 1024
+
+// SendPolicy describes the sends which the wallet refuses to publish.
+// Blocklist maps encoded addresses which may not be paid to the reason they
+// are blocked.  LabelThresholds maps coin types to the amount in atoms at and
+// above which a send must be labeled.  SKASendAccounts maps SKA coin types to
+// the only accounts, in increasing order, which may send them.  Coin types
+// without a record may be sent from any account.
+type SendPolicy struct {
+	Blocklist       map[string]string
+	LabelThresholds map[cointype.CoinType]*big.Int
+	SKASendAccounts map[cointype.CoinType][]uint32
+}
+
+func keyBlockedAddress(addr string) []byte {
+	k := make([]byte, 1+len(addr))
+	k[0] = sendPolicyBlocked
+	copy(k[1:], addr)
+	return k
+}
+
+func keySKASendAccount(ct cointype.CoinType, account uint32) []byte {
+	k := make([]byte, 6)
+	k[0] = sendPolicySKAAccount
+	k[1] = byte(ct)
+	byteOrder.PutUint32(k[2:], account)
+	return k
+}
+
+// PutBlockedAddress adds an encoded address to the send policy blocklist,
+// replacing the reason recorded for an address which is already blocked.
+func PutBlockedAddress(dbtx walletdb.ReadWriteTx, addr, reason string) error {
+	const op errors.Op = "udb.PutBlockedAddress"
+
+	switch {
+	case addr == "":
+		return errors.E(op, errors.Invalid, "empty address")
+	case len(reason) > maxBlockedAddrReasonLen:
+		return errors.E(op, errors.Invalid, errors.Errorf("reason "+
+			"exceeds maximum length %d", maxBlockedAddrReasonLen))
+	}
+
+	b := dbtx.ReadWriteBucket(sendPolicyBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing send policy bucket")
+	}
+	err := b.Put(keyBlockedAddress(addr), []byte(reason))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteBlockedAddress removes an encoded address from the send policy
+// blocklist.  An error with kind NotExist is returned if the address is not
+// blocked.
+func DeleteBlockedAddress(dbtx walletdb.ReadWriteTx, addr string) error {
+	const op errors.Op = "udb.DeleteBlockedAddress"
+
+	b := dbtx.ReadWriteBucket(sendPolicyBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing send policy bucket")
+	}
+	k := keyBlockedAddress(addr)
+	if b.Get(k) == nil {
+		return errors.E(op, errors.NotExist,
+			errors.Errorf("address %s is not blocked", addr))
+	}
+	if err := b.Delete(k); err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// PutLabelThreshold sets the amount of a coin type, in atoms, at and above
+// which sends must be labeled.  A nil or zero threshold removes the
+// requirement.
+func PutLabelThreshold(dbtx walletdb.ReadWriteTx, ct cointype.CoinType, threshold *big.Int) error {
+	const op errors.Op = "udb.PutLabelThreshold"
+
+	if threshold != nil && threshold.Sign() < 0 {
+		return errors.E(op, errors.Invalid, "label threshold may not be negative")
+	}
+
+	b := dbtx.ReadWriteBucket(sendPolicyBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing send policy bucket")
+	}
+	k := []byte{sendPolicyLabel, byte(ct)}
+	var err error
+	if threshold == nil || threshold.Sign() == 0 {
+		err = b.Delete(k)
+	} else {
+		err = b.Put(k, threshold.Bytes())
+	}
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// PutSKASendAccounts restricts sends of an SKA coin type to the accounts,
+// replacing any previous restriction.  No accounts removes the restriction.
+func PutSKASendAccounts(dbtx walletdb.ReadWriteTx, ct cointype.CoinType, accounts []uint32) error {
+	const op errors.Op = "udb.PutSKASendAccounts"
+
+	if !ct.IsSKA() {
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("coin type %d is not an SKA coin type", ct))
+	}
+
+	b := dbtx.ReadWriteBucket(sendPolicyBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing send policy bucket")
+	}
+	var stale [][]byte
+	prefix := []byte{sendPolicySKAAccount, byte(ct)}
+	c := b.ReadCursor()
+	for k, _ := c.Seek(prefix); bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+		stale = append(stale, append([]byte(nil), k...))
+	}
+	c.Close()
+	for _, k := range stale {
+		if err := b.Delete(k); err != nil {
+			return errors.E(op, errors.IO, err)
+		}
+	}
+	for _, account := range accounts {
+		err := b.Put(keySKASendAccount(ct, account), []byte{})
+		if err != nil {
+			return errors.E(op, errors.IO, err)
+		}
+	}
+	return nil
+}
+
+// FetchSendPolicy returns the send policy recorded by PutBlockedAddress,
+// PutLabelThreshold and PutSKASendAccounts.
+func FetchSendPolicy(dbtx walletdb.ReadTx) (*SendPolicy, error) {
+	const op errors.Op = "udb.FetchSendPolicy"
+
+	p := &SendPolicy{
+		Blocklist:       make(map[string]string),
+		LabelThresholds: make(map[cointype.CoinType]*big.Int),
+		SKASendAccounts: make(map[cointype.CoinType][]uint32),
+	}
+	b := dbtx.ReadBucket(sendPolicyBucketKey)
+	if b == nil {
+		return p, nil
+	}
+	err := b.ForEach(func(k, v []byte) error {
+		switch {
+		case len(k) > 1 && k[0] == sendPolicyBlocked:
+			p.Blocklist[string(k[1:])] = string(v)
+		case len(k) == 2 && k[0] == sendPolicyLabel:
+			p.LabelThresholds[cointype.CoinType(k[1])] = new(big.Int).SetBytes(v)
+		case len(k) == 6 && k[0] == sendPolicySKAAccount:
+			ct := cointype.CoinType(k[1])
+			p.SKASendAccounts[ct] = append(p.SKASendAccounts[ct],
+				byteOrder.Uint32(k[2:]))
+		default:
+			return errors.E(errors.IO, "bad send policy record")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return p, nil
+}
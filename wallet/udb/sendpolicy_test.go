@@ -0,0 +1,115 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestSendPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fetch := func() *SendPolicy {
+		t.Helper()
+		var p *SendPolicy
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			p, err = FetchSendPolicy(dbtx)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		if err := PutBlockedAddress(dbtx, "TsAddr1", "sanctioned"); err != nil {
+			return err
+		}
+		if err := PutBlockedAddress(dbtx, "TsAddr2", ""); err != nil {
+			return err
+		}
+		err := PutLabelThreshold(dbtx, cointype.CoinTypeVAR, big.NewInt(1e9))
+		if err != nil {
+			return err
+		}
+		err = PutLabelThreshold(dbtx, 1, new(big.Int).Lsh(big.NewInt(1), 70))
+		if err != nil {
+			return err
+		}
+		if err := PutSKASendAccounts(dbtx, 1, []uint32{5, 0, 2}); err != nil {
+			return err
+		}
+		return PutSKASendAccounts(dbtx, 2, []uint32{3})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &SendPolicy{
+		Blocklist: map[string]string{"TsAddr1": "sanctioned", "TsAddr2": ""},
+		LabelThresholds: map[cointype.CoinType]*big.Int{
+			cointype.CoinTypeVAR: big.NewInt(1e9),
+			1:                    new(big.Int).Lsh(big.NewInt(1), 70),
+		},
+		SKASendAccounts: map[cointype.CoinType][]uint32{
+			1: {0, 2, 5},
+			2: {3},
+		},
+	}
+	if got := fetch(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got send policy %+v, want %+v", got, want)
+	}
+
+	// Removing records leaves the rest of the policy in place.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		if err := DeleteBlockedAddress(dbtx, "TsAddr2"); err != nil {
+			return err
+		}
+		if err := PutLabelThreshold(dbtx, cointype.CoinTypeVAR, nil); err != nil {
+			return err
+		}
+		return PutSKASendAccounts(dbtx, 1, nil)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	delete(want.Blocklist, "TsAddr2")
+	delete(want.LabelThresholds, cointype.CoinTypeVAR)
+	delete(want.SKASendAccounts, 1)
+	if got := fetch(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got send policy %+v, want %+v", got, want)
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return DeleteBlockedAddress(dbtx, "TsAddr2")
+	})
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("unblocking address twice: expected NotExist error, got %v", err)
+	}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return PutSKASendAccounts(dbtx, cointype.CoinTypeVAR, []uint32{0})
+	})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("restricting VAR sends: expected Invalid error, got %v", err)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// skaEmissionsBucketKey is the bucket key for storing the SKA emission
+	// transactions observed in main chain blocks.  Keys sort by block
+	// height so the emissions of blocks removed by a reorg can be deleted.
+	// Key: block height (4 bytes, big endian) | tx hash (32 bytes)
+	// Value: coin type (1 byte) | amount length (1 byte) | big-endian
+	// unsigned amount in atoms | emitter script
+	skaEmissionsBucketKey = []byte("skaemissions")
+)
+
+// SKAEmission is the record of an SKA emission transaction mined in a main
+// chain block.  Amount is the total emitted in atoms of the coin type, and
+// EmitterScript is the authorization script of the emission's null input,
+// which commits to the emission key.
+type SKAEmission struct {
+	Height        int32
+	Hash          chainhash.Hash
+	CoinType      cointype.CoinType
+	Amount        *big.Int
+	EmitterScript []byte
+}
+
+func keySKAEmission(height int32, hash *chainhash.Hash) []byte {
+	k := make([]byte, 4+chainhash.HashSize)
+	binary.BigEndian.PutUint32(k, uint32(height))
+	copy(k[4:], hash[:])
+	return k
+}
+
+func readSKAEmission(k, v []byte) (*SKAEmission, error) {
+	if len(k) != 4+chainhash.HashSize || len(v) < 2 || len(v) < 2+int(v[1]) {
+		return nil, errors.E(errors.IO, "bad SKA emission record")
+	}
+	e := &SKAEmission{
+		Height:        int32(binary.BigEndian.Uint32(k)),
+		CoinType:      cointype.CoinType(v[0]),
+		Amount:        new(big.Int).SetBytes(v[2 : 2+v[1]]),
+		EmitterScript: append([]byte(nil), v[2+v[1]:]...),
+	}
+	copy(e.Hash[:], k[4:])
+	return e, nil
+}
+
+// PutSKAEmission records an SKA emission mined in a main chain block.
+// Recording the same transaction again replaces the previous record.
+func PutSKAEmission(dbtx walletdb.ReadWriteTx, e *SKAEmission) error {
+	const op errors.Op = "udb.PutSKAEmission"
+
+	if !e.CoinType.IsSKA() {
+		return errors.E(op, errors.Invalid, "not an SKA coin type")
+	}
+	if e.Amount == nil || e.Amount.Sign() < 0 || len(e.Amount.Bytes()) > 255 {
+		return errors.E(op, errors.Invalid, "amount out of range")
+	}
+	b := dbtx.ReadWriteBucket(skaEmissionsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing SKA emissions bucket")
+	}
+	amount := e.Amount.Bytes()
+	v := make([]byte, 2, 2+len(amount)+len(e.EmitterScript))
+	v[0] = byte(e.CoinType)
+	v[1] = byte(len(amount))
+	v = append(v, amount...)
+	v = append(v, e.EmitterScript...)
+	err := b.Put(keySKAEmission(e.Height, &e.Hash), v)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// ForEachSKAEmission calls f with each recorded SKA emission in increasing
+// block height order.  Iteration stops early when f returns an error, which
+// is returned to the caller.
+func ForEachSKAEmission(dbtx walletdb.ReadTx, f func(*SKAEmission) error) error {
+	const op errors.Op = "udb.ForEachSKAEmission"
+
+	b := dbtx.ReadBucket(skaEmissionsBucketKey)
+	if b == nil {
+		return nil
+	}
+	err := b.ForEach(func(k, v []byte) error {
+		e, err := readSKAEmission(k, v)
+		if err != nil {
+			return err
+		}
+		return f(e)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// deleteSKAEmissionsFrom removes the emissions recorded for blocks at height
+// onwards.
+func deleteSKAEmissionsFrom(dbtx walletdb.ReadWriteTx, height int32) error {
+	b := dbtx.ReadWriteBucket(skaEmissionsBucketKey)
+	if b == nil {
+		return nil
+	}
+	var seek [4]byte
+	binary.BigEndian.PutUint32(seek[:], uint32(height))
+	var keys [][]byte
+	c := b.ReadCursor()
+	for k, _ := c.Seek(seek[:]); k != nil; k, _ = c.Next() {
+		keys = append(keys, append([]byte(nil), k...))
+	}
+	c.Close()
+	for _, k := range keys {
+		if err := b.Delete(k); err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	return nil
+}
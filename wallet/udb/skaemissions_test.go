@@ -0,0 +1,95 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestSKAEmissions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	supply, _ := new(big.Int).SetString("900000000000000000000000", 10)
+	emissions := []SKAEmission{
+		{Height: 20, Hash: chainhash.Hash{2}, CoinType: 2,
+			Amount: big.NewInt(5000), EmitterScript: []byte{0x01, 'S', 'K', 'A', 2}},
+		{Height: 10, Hash: chainhash.Hash{1}, CoinType: 1,
+			Amount: supply, EmitterScript: []byte{0x01, 'S', 'K', 'A', 1}},
+		{Height: 30, Hash: chainhash.Hash{3}, CoinType: 1,
+			Amount: big.NewInt(1), EmitterScript: nil},
+	}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		for i := range emissions {
+			if err := PutSKAEmission(dbtx, &emissions[i]); err != nil {
+				return err
+			}
+		}
+		err := PutSKAEmission(dbtx, &SKAEmission{CoinType: cointype.CoinTypeVAR,
+			Amount: big.NewInt(1)})
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("VAR emission: expected Invalid error, got %v", err)
+		}
+		// Recording a transaction again replaces its record.
+		return PutSKAEmission(dbtx, &emissions[0])
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorded := func() []*SKAEmission {
+		var res []*SKAEmission
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			return ForEachSKAEmission(dbtx, func(e *SKAEmission) error {
+				res = append(res, e)
+				return nil
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	check := func(got []*SKAEmission, want ...*SKAEmission) {
+		t.Helper()
+		if len(got) != len(want) {
+			t.Fatalf("got %d emissions, want %d", len(got), len(want))
+		}
+		for i := range want {
+			g, w := got[i], want[i]
+			if g.Height != w.Height || g.Hash != w.Hash || g.CoinType != w.CoinType ||
+				g.Amount.Cmp(w.Amount) != 0 || !bytes.Equal(g.EmitterScript, w.EmitterScript) {
+				t.Fatalf("emission %d: got %+v, want %+v", i, g, w)
+			}
+		}
+	}
+	check(recorded(), &emissions[1], &emissions[0], &emissions[2])
+
+	// Removing blocks removes their emissions.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return deleteSKAEmissionsFrom(dbtx, 20)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(recorded(), &emissions[1])
+}
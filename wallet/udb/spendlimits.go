@@ -0,0 +1,431 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// spendLimitsBucketKey is the bucket key for storing the daily spend
+	// limits of accounts and the amounts spent on the current day.
+	// Key: account (4 bytes) | coin type (1 byte) → Value: flags (1 byte)
+	// | day (8 bytes) | limit length (1 byte) | limit | spent length (1
+	// byte) | spent
+	//
+	// Amounts are big-endian unsigned integers of atoms.
+	spendLimitsBucketKey = []byte("spendlimits")
+
+	// pendingSendsBucketKey is the bucket key for storing sends exceeding a
+	// daily spend limit which await approval.
+	// Key: pending send ID (4 bytes) → Value: account (4 bytes) | change
+	// account (4 bytes) | minconf (4 bytes) | coin type (1 byte) | flags (1
+	// byte) | created Unix time (8 bytes) | fee rate (8 bytes) | expiry (4
+	// bytes) | expire after (4 bytes) | lock time (4 bytes) | subtract fee
+	// count (1 byte) | subtract fee output indexes (4 bytes each) | amount
+	// length (1 byte) | amount | [label length (2 bytes) | label] |
+	// serialized transaction holding the outputs
+	//
+	// The label is only present when the label flag is set.
+	pendingSendsBucketKey = []byte("pendingsends")
+)
+
+// Spend limit flags.
+const (
+	spendLimitRequireApproval = 1 << iota
+)
+
+// Pending send flags.
+const (
+	pendingSendSweep = 1 << iota
+	pendingSendTreasury
+	pendingSendLabel
+)
+
+// SpendLimit describes the maximum amount of a coin type which an account may
+// spend each day.  Days begin at midnight UTC.  Sends exceeding the limit are
+// refused, or when RequireApproval is set, recorded as pending sends awaiting
+// approval.  Spent is the amount spent on Day, counted in days since the Unix
+// epoch.
+type SpendLimit struct {
+	Account         uint32
+	CoinType        cointype.CoinType
+	Limit           *big.Int
+	RequireApproval bool
+	Day             int64
+	Spent           *big.Int
+}
+
+// spendDay returns the day containing t, counted in days since the Unix epoch.
+func spendDay(t time.Time) int64 {
+	return t.Unix() / int64(24*time.Hour/time.Second)
+}
+
+// SpentOn returns the amount spent on the day containing t.
+func (l *SpendLimit) SpentOn(t time.Time) *big.Int {
+	if l.Spent == nil || l.Day != spendDay(t) {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(l.Spent)
+}
+
+// AddSpent adds amount to the amount spent on the day containing t, resetting
+// the amount spent on any earlier day.
+func (l *SpendLimit) AddSpent(t time.Time, amount *big.Int) {
+	spent := l.SpentOn(t)
+	l.Spent = spent.Add(spent, amount)
+	l.Day = spendDay(t)
+}
+
+// Exceeded returns whether spending amount on the day containing t would
+// exceed the limit.
+func (l *SpendLimit) Exceeded(t time.Time, amount *big.Int) bool {
+	spent := l.SpentOn(t)
+	return spent.Add(spent, amount).Cmp(l.Limit) > 0
+}
+
+func keySpendLimit(account uint32, ct cointype.CoinType) []byte {
+	k := make([]byte, 5)
+	byteOrder.PutUint32(k, account)
+	k[4] = byte(ct)
+	return k
+}
+
+func valueSpendLimit(l *SpendLimit) []byte {
+	limit := l.Limit.Bytes()
+	var spent []byte
+	if l.Spent != nil {
+		spent = l.Spent.Bytes()
+	}
+	v := make([]byte, 9, 11+len(limit)+len(spent))
+	if l.RequireApproval {
+		v[0] |= spendLimitRequireApproval
+	}
+	byteOrder.PutUint64(v[1:], uint64(l.Day))
+	v = append(v, byte(len(limit)))
+	v = append(v, limit...)
+	v = append(v, byte(len(spent)))
+	v = append(v, spent...)
+	return v
+}
+
+func readSpendLimit(k, v []byte) (*SpendLimit, error) {
+	if len(k) != 5 {
+		return nil, errors.E(errors.IO, "bad spend limit record")
+	}
+	r := &invoiceReader{v: v}
+	flags := r.next(1)[0]
+	l := &SpendLimit{
+		Account:         byteOrder.Uint32(k),
+		CoinType:        cointype.CoinType(k[4]),
+		RequireApproval: flags&spendLimitRequireApproval != 0,
+		Day:             int64(byteOrder.Uint64(r.next(8))),
+	}
+	l.Limit = new(big.Int).SetBytes(r.nextVar())
+	l.Spent = new(big.Int).SetBytes(r.nextVar())
+	if r.bad || len(r.v) != 0 {
+		return nil, errors.E(errors.IO, "bad spend limit record")
+	}
+	return l, nil
+}
+
+// PutSpendLimit records the daily spend limit of an account and coin type,
+// replacing any previous limit.
+func PutSpendLimit(dbtx walletdb.ReadWriteTx, l *SpendLimit) error {
+	const op errors.Op = "udb.PutSpendLimit"
+
+	switch {
+	case l.Limit == nil || l.Limit.Sign() <= 0 || len(l.Limit.Bytes()) > 255:
+		return errors.E(op, errors.Invalid, "spend limit must be positive")
+	case l.Spent != nil && (l.Spent.Sign() < 0 || len(l.Spent.Bytes()) > 255):
+		return errors.E(op, errors.Invalid, "spent amount out of range")
+	}
+
+	b := dbtx.ReadWriteBucket(spendLimitsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing spend limits bucket")
+	}
+	err := b.Put(keySpendLimit(l.Account, l.CoinType), valueSpendLimit(l))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteSpendLimit removes the daily spend limit of an account and coin type.
+func DeleteSpendLimit(dbtx walletdb.ReadWriteTx, account uint32, ct cointype.CoinType) error {
+	const op errors.Op = "udb.DeleteSpendLimit"
+
+	b := dbtx.ReadWriteBucket(spendLimitsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing spend limits bucket")
+	}
+	err := b.Delete(keySpendLimit(account, ct))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// SpendLimitFor returns the daily spend limit of an account and coin type.  An
+// error with kind NotExist is returned if the spending of the account is not
+// limited.
+func SpendLimitFor(dbtx walletdb.ReadTx, account uint32, ct cointype.CoinType) (*SpendLimit, error) {
+	const op errors.Op = "udb.SpendLimitFor"
+
+	var v []byte
+	k := keySpendLimit(account, ct)
+	if b := dbtx.ReadBucket(spendLimitsBucketKey); b != nil {
+		v = b.Get(k)
+	}
+	if v == nil {
+		return nil, errors.E(op, errors.NotExist,
+			errors.Errorf("spending of account %d is not limited", account))
+	}
+	l, err := readSpendLimit(k, v)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return l, nil
+}
+
+// ForEachSpendLimit calls f with every daily spend limit, in increasing
+// account and coin type order.  Iteration stops if f returns an error, which
+// is returned to the caller.
+func ForEachSpendLimit(dbtx walletdb.ReadTx, f func(*SpendLimit) error) error {
+	const op errors.Op = "udb.ForEachSpendLimit"
+
+	b := dbtx.ReadBucket(spendLimitsBucketKey)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		l, err := readSpendLimit(k, v)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return f(l)
+	})
+}
+
+// PendingSend describes a send which exceeded the daily spend limit of its
+// account and awaits approval before it is authored and published.  Sends
+// pay FeeRate atoms/kB, or the wallet's fee rate when zero.  Sweep sends spend
+// every eligible output of the account to the only output.  Treasury sends add
+// the outputs to the treasury.  Amount is the amount counted against the
+// daily spend limit.  Label is recorded as the transaction label once the
+// send is published.
+type PendingSend struct {
+	ID              uint32
+	Account         uint32
+	ChangeAccount   uint32
+	MinConf         int32
+	CoinType        cointype.CoinType
+	Outputs         []*wire.TxOut
+	SubtractFeeFrom []int
+	Expiry          uint32
+	ExpireAfter     uint32
+	LockTime        uint32
+	FeeRate         int64
+	Sweep           bool
+	Treasury        bool
+	Amount          *big.Int
+	Label           string
+	Created         time.Time
+}
+
+func keyPendingSend(id uint32) []byte {
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, id)
+	return k
+}
+
+func valuePendingSend(p *PendingSend) ([]byte, error) {
+	tx := wire.NewMsgTx()
+	tx.TxOut = p.Outputs
+	txBytes, err := tx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	amount := p.Amount.Bytes()
+	v := make([]byte, 42, 42+1+4*len(p.SubtractFeeFrom)+1+len(amount)+
+		2+len(p.Label)+len(txBytes))
+	byteOrder.PutUint32(v[0:], p.Account)
+	byteOrder.PutUint32(v[4:], p.ChangeAccount)
+	byteOrder.PutUint32(v[8:], uint32(p.MinConf))
+	v[12] = byte(p.CoinType)
+	if p.Sweep {
+		v[13] |= pendingSendSweep
+	}
+	if p.Treasury {
+		v[13] |= pendingSendTreasury
+	}
+	if p.Label != "" {
+		v[13] |= pendingSendLabel
+	}
+	byteOrder.PutUint64(v[14:], uint64(p.Created.Unix()))
+	byteOrder.PutUint64(v[22:], uint64(p.FeeRate))
+	byteOrder.PutUint32(v[30:], p.Expiry)
+	byteOrder.PutUint32(v[34:], p.ExpireAfter)
+	byteOrder.PutUint32(v[38:], p.LockTime)
+	v = append(v, byte(len(p.SubtractFeeFrom)))
+	for _, idx := range p.SubtractFeeFrom {
+		v = byteOrder.AppendUint32(v, uint32(idx))
+	}
+	v = append(v, byte(len(amount)))
+	v = append(v, amount...)
+	if p.Label != "" {
+		v = byteOrder.AppendUint16(v, uint16(len(p.Label)))
+		v = append(v, p.Label...)
+	}
+	v = append(v, txBytes...)
+	return v, nil
+}
+
+func readPendingSend(k, v []byte) (*PendingSend, error) {
+	if len(k) != 4 {
+		return nil, errors.E(errors.IO, "bad pending send record")
+	}
+	r := &invoiceReader{v: v}
+	p := &PendingSend{
+		ID:            byteOrder.Uint32(k),
+		Account:       byteOrder.Uint32(r.next(4)),
+		ChangeAccount: byteOrder.Uint32(r.next(4)),
+		MinConf:       int32(byteOrder.Uint32(r.next(4))),
+		CoinType:      cointype.CoinType(r.next(1)[0]),
+	}
+	flags := r.next(1)[0]
+	p.Sweep = flags&pendingSendSweep != 0
+	p.Treasury = flags&pendingSendTreasury != 0
+	p.Created = time.Unix(int64(byteOrder.Uint64(r.next(8))), 0)
+	p.FeeRate = int64(byteOrder.Uint64(r.next(8)))
+	p.Expiry = byteOrder.Uint32(r.next(4))
+	p.ExpireAfter = byteOrder.Uint32(r.next(4))
+	p.LockTime = byteOrder.Uint32(r.next(4))
+	n := int(r.next(1)[0])
+	for i := 0; i < n && !r.bad; i++ {
+		p.SubtractFeeFrom = append(p.SubtractFeeFrom,
+			int(byteOrder.Uint32(r.next(4))))
+	}
+	p.Amount = new(big.Int).SetBytes(r.nextVar())
+	if flags&pendingSendLabel != 0 {
+		p.Label = string(r.next(int(byteOrder.Uint16(r.next(2)))))
+	}
+	if r.bad {
+		return nil, errors.E(errors.IO, "bad pending send record")
+	}
+	var tx wire.MsgTx
+	if err := tx.FromBytes(r.v); err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	p.Outputs = tx.TxOut
+	return p, nil
+}
+
+// PutPendingSend records a send awaiting approval, assigning it the next
+// unused ID.
+func PutPendingSend(dbtx walletdb.ReadWriteTx, p *PendingSend) error {
+	const op errors.Op = "udb.PutPendingSend"
+
+	switch {
+	case len(p.Outputs) == 0:
+		return errors.E(op, errors.Invalid, "pending send has no outputs")
+	case len(p.SubtractFeeFrom) > 255:
+		return errors.E(op, errors.Invalid, "too many outputs subtracting the fee")
+	case p.Amount == nil || p.Amount.Sign() < 0 || len(p.Amount.Bytes()) > 255:
+		return errors.E(op, errors.Invalid, "pending send amount out of range")
+	case len(p.Label) > MaxTxLabelLen:
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("label exceeds maximum length %d", MaxTxLabelLen))
+	case p.Created.Unix() < 0:
+		return errors.E(op, errors.Invalid,
+			"pending send creation time precedes the Unix epoch")
+	}
+
+	b := dbtx.ReadWriteBucket(pendingSendsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing pending sends bucket")
+	}
+	c := b.ReadCursor()
+	k, _ := c.Last()
+	c.Close()
+	id := uint32(1)
+	if len(k) == 4 {
+		id = byteOrder.Uint32(k) + 1
+	}
+	if id == 0 {
+		return errors.E(op, errors.Invalid, "pending send IDs exhausted")
+	}
+	v, err := valuePendingSend(p)
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	err = b.Put(keyPendingSend(id), v)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	p.ID = id
+	return nil
+}
+
+// PendingSendByID returns the pending send with an ID.  An error with kind
+// NotExist is returned if no send awaiting approval has the ID.
+func PendingSendByID(dbtx walletdb.ReadTx, id uint32) (*PendingSend, error) {
+	const op errors.Op = "udb.PendingSendByID"
+
+	var v []byte
+	k := keyPendingSend(id)
+	if b := dbtx.ReadBucket(pendingSendsBucketKey); b != nil {
+		v = b.Get(k)
+	}
+	if v == nil {
+		return nil, errors.E(op, errors.NotExist,
+			errors.Errorf("no pending send with ID %d", id))
+	}
+	p, err := readPendingSend(k, v)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return p, nil
+}
+
+// DeletePendingSend removes the pending send with an ID.
+func DeletePendingSend(dbtx walletdb.ReadWriteTx, id uint32) error {
+	const op errors.Op = "udb.DeletePendingSend"
+
+	b := dbtx.ReadWriteBucket(pendingSendsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing pending sends bucket")
+	}
+	err := b.Delete(keyPendingSend(id))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// ForEachPendingSend calls f with every pending send, in increasing ID order.
+// Iteration stops if f returns an error, which is returned to the caller.
+func ForEachPendingSend(dbtx walletdb.ReadTx, f func(*PendingSend) error) error {
+	const op errors.Op = "udb.ForEachPendingSend"
+
+	b := dbtx.ReadBucket(pendingSendsBucketKey)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		p, err := readPendingSend(k, v)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return f(p)
+	})
+}
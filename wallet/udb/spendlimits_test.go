@@ -0,0 +1,216 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestSpendLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	put := func(l *SpendLimit) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return PutSpendLimit(dbtx, l)
+		})
+	}
+	limitFor := func(account uint32, ct cointype.CoinType) (*SpendLimit, error) {
+		var l *SpendLimit
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			l, err = SpendLimitFor(dbtx, account, ct)
+			return err
+		})
+		return l, err
+	}
+
+	now := time.Unix(1700000000, 0)
+	limits := []*SpendLimit{{
+		Account: 0,
+		Limit:   big.NewInt(1e8),
+		Spent:   new(big.Int),
+	}, {
+		Account:         0,
+		CoinType:        1,
+		Limit:           new(big.Int).Lsh(big.NewInt(1), 80),
+		RequireApproval: true,
+		Spent:           new(big.Int),
+	}}
+	limits[0].AddSpent(now, big.NewInt(4e7))
+	for _, l := range limits {
+		if err := put(l); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, want := range limits {
+		got, err := limitFor(want.Account, want.CoinType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("limit %d/%d: got %+v, want %+v", want.Account,
+				want.CoinType, got, want)
+		}
+	}
+
+	// The amount spent resets at the start of each day.
+	l := limits[0]
+	if l.Exceeded(now, big.NewInt(6e7)) || !l.Exceeded(now, big.NewInt(6e7+1)) {
+		t.Errorf("spent %v of %v: unexpected limit check", l.Spent, l.Limit)
+	}
+	tomorrow := now.Add(24 * time.Hour)
+	if l.SpentOn(tomorrow).Sign() != 0 || l.Exceeded(tomorrow, big.NewInt(1e8)) {
+		t.Errorf("amount spent was not reset on the next day")
+	}
+	l.AddSpent(tomorrow, big.NewInt(1))
+	if l.SpentOn(tomorrow).Int64() != 1 || l.SpentOn(now).Sign() != 0 {
+		t.Errorf("spent %v on day %d", l.Spent, l.Day)
+	}
+
+	var n int
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		return ForEachSpendLimit(dbtx, func(*SpendLimit) error {
+			n++
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len(limits) {
+		t.Errorf("iterated %d limits, want %d", n, len(limits))
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return DeleteSpendLimit(dbtx, 0, 0)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := limitFor(0, 0); !errors.Is(err, errors.NotExist) {
+		t.Errorf("deleted limit: expected NotExist error, got %v", err)
+	}
+	if err := put(&SpendLimit{Limit: big.NewInt(0)}); !errors.Is(err, errors.Invalid) {
+		t.Errorf("zero limit: expected Invalid error, got %v", err)
+	}
+}
+
+func TestPendingSends(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sends := []*PendingSend{{
+		Account:       1,
+		ChangeAccount: 2,
+		MinConf:       1,
+		Outputs: []*wire.TxOut{
+			{Value: 5e7, PkScript: []byte{0x76, 0xa9}},
+			{Value: 6e7, PkScript: []byte{0x51}},
+		},
+		SubtractFeeFrom: []int{1},
+		ExpireAfter:     10,
+		Amount:          big.NewInt(11e7),
+		Label:           "rent",
+		Created:         time.Unix(1000, 0),
+	}, {
+		Account:  3,
+		MinConf:  1,
+		Outputs:  []*wire.TxOut{{PkScript: []byte{0x51}}},
+		FeeRate:  1e4,
+		Sweep:    true,
+		Amount:   big.NewInt(2e8),
+		Created:  time.Unix(2000, 0),
+		LockTime: 100,
+	}}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		for _, p := range sends {
+			if err := PutPendingSend(dbtx, p); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []*PendingSend
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		return ForEachPendingSend(dbtx, func(p *PendingSend) error {
+			got = append(got, p)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(sends) {
+		t.Fatalf("iterated %d pending sends, want %d", len(got), len(sends))
+	}
+	for i, want := range sends {
+		if want.ID != uint32(i+1) {
+			t.Errorf("pending send %d assigned ID %d", i, want.ID)
+		}
+		g := *got[i]
+		w := *want
+		if len(g.Outputs) != len(w.Outputs) {
+			t.Fatalf("pending send %d: got %d outputs, want %d", want.ID,
+				len(g.Outputs), len(w.Outputs))
+		}
+		for j := range w.Outputs {
+			if g.Outputs[j].Value != w.Outputs[j].Value ||
+				!bytes.Equal(g.Outputs[j].PkScript, w.Outputs[j].PkScript) {
+				t.Errorf("pending send %d: output %d: got %+v, want %+v",
+					want.ID, j, g.Outputs[j], w.Outputs[j])
+			}
+		}
+		g.Outputs, w.Outputs = nil, nil
+		if !reflect.DeepEqual(g, w) {
+			t.Errorf("pending send %d: got %+v, want %+v", want.ID, g, w)
+		}
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return DeletePendingSend(dbtx, 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		_, err := PendingSendByID(dbtx, 1)
+		return err
+	})
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("deleted pending send: expected NotExist error, got %v", err)
+	}
+}
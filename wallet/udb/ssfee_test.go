@@ -5,12 +5,17 @@
 package udb
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
 	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
 	"github.com/monetarium/monetarium-node/txscript"
 	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
 )
 
 // createMockSSFeeTx creates a mock SSFee transaction for testing.
@@ -400,3 +405,168 @@ func TestAugmentedSSFeeValueCalculation(t *testing.T) {
 		})
 	}
 }
+
+// TestCoinbaseMaturedBoundaries tests the maturity of coinbase-class outputs,
+// including SSFee miner fees, at the heights surrounding coinbase maturity.
+func TestCoinbaseMaturedBoundaries(t *testing.T) {
+	params := chaincfg.TestNet3Params()
+	maturity := int32(params.CoinbaseMaturity)
+
+	tests := []struct {
+		name      string
+		txHeight  int32
+		curHeight int32
+		matured   bool
+	}{
+		{"unmined", -1, 100, false},
+		{"same block", 10, 10, false},
+		{"one block before maturity", 10, 10 + maturity - 1, false},
+		{"maturity", 10, 10 + maturity, true},
+		{"after maturity", 10, 10 + maturity + 1, true},
+		{"tip rolled back below tx", 10, 9, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := coinbaseMatured(params, tt.txHeight, tt.curHeight)
+			if got != tt.matured {
+				t.Errorf("coinbaseMatured(%d, %d) = %v, want %v",
+					tt.txHeight, tt.curHeight, got, tt.matured)
+			}
+		})
+	}
+}
+
+// TestSSFeeRollback tests that rolling back blocks mining SSFee transactions
+// removes their credits instead of moving them to the unmined set, restores
+// the outputs spent by augmented SSFee transactions, removes unmined spends of
+// the removed outputs, and recalculates maturity from the new tip.
+func TestSSFeeRollback(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, teardown, err := cloneDB(ctx, "ssfee_rollback.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const account = 0
+	maturity := int(s.chainParams.CoinbaseMaturity)
+	g := makeBlockGenerator()
+	headers := make([]*wire.BlockHeader, maturity+1)
+	for i := range headers {
+		headers[i] = g.generate(dcrutil.BlockValid)
+	}
+	headerData := makeHeaderDataSlice(headers...)
+	filters := emptyFilters(len(headers))
+
+	// The miner fee of the first block matures in the last block, which
+	// mines an augmented miner fee spending it.
+	b1H := headers[0]
+	b1Hash := b1H.BlockHash()
+	bMatureH := headers[maturity]
+	bMatureHash := bMatureH.BlockHash()
+
+	newRec := func(tx *wire.MsgTx) *TxRecord {
+		t.Helper()
+		rec, err := NewTxRecordFromMsgTx(tx, time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rec
+	}
+	fee1 := newRec(createMockSSFeeTx(cointype.CoinTypeVAR, 1, 1e8, "MF"))
+	fee2 := newRec(createMockSSFeeTxEx(cointype.CoinTypeVAR, 1, 1.2e8, "MF",
+		&wire.OutPoint{Hash: fee1.Hash}, 1e8))
+	spend := newRec(spendOutput(&fee2.Hash, 0, 0, 1.1e8))
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+
+		checkBalance := func(immature, spendable dcrutil.Amount) {
+			t.Helper()
+			bal, err := s.AccountBalance(dbtx, 0, account)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if bal.ImmatureCoinbaseRewards != immature {
+				t.Errorf("immature coinbase rewards %v, want %v",
+					bal.ImmatureCoinbaseRewards, immature)
+			}
+			if bal.Spendable != spendable {
+				t.Errorf("spendable balance %v, want %v", bal.Spendable, spendable)
+			}
+		}
+		checkNotUnmined := func(recs ...*TxRecord) {
+			t.Helper()
+			for _, rec := range recs {
+				if existsRawUnmined(ns, rec.Hash[:]) != nil {
+					t.Errorf("transaction %v was moved to the unmined set", &rec.Hash)
+				}
+			}
+		}
+
+		err := insertMainChainHeaders(s, dbtx, headerData[:1], filters[:1])
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(dbtx, fee1, &b1Hash)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(dbtx, fee1, makeBlockMeta(b1H), 0, false, account)
+		if err != nil {
+			return err
+		}
+
+		// The miner fee is immature until the block before maturity.
+		err = insertMainChainHeaders(s, dbtx, headerData[1:maturity], filters[1:maturity])
+		if err != nil {
+			return err
+		}
+		checkBalance(1e8, 0)
+
+		err = insertMainChainHeaders(s, dbtx, headerData[maturity:], filters[maturity:])
+		if err != nil {
+			return err
+		}
+		checkBalance(0, 1e8)
+
+		err = s.InsertMinedTx(dbtx, fee2, &bMatureHash)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(dbtx, fee2, makeBlockMeta(bMatureH), 0, false, account)
+		if err != nil {
+			return err
+		}
+		checkBalance(1.2e8, 0)
+		err = s.InsertMemPoolTx(dbtx, spend)
+		if err != nil {
+			return err
+		}
+		checkBalance(0, 0)
+
+		// Rolling back the maturing block removes the augmented miner fee
+		// and its unmined spend, and the restored miner fee of the first
+		// block is immature again.
+		err = s.Rollback(dbtx, int32(bMatureH.Height))
+		if err != nil {
+			return err
+		}
+		checkNotUnmined(fee2, spend)
+		if existsRawUnspent(ns, canonicalOutPoint(&fee1.Hash, 0), s.chainParams) == nil {
+			t.Errorf("output spent by rolled back augmented SSFee is not unspent")
+		}
+		checkBalance(1e8, 0)
+
+		err = s.Rollback(dbtx, int32(b1H.Height))
+		if err != nil {
+			return err
+		}
+		checkNotUnmined(fee1)
+		checkBalance(0, 0)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
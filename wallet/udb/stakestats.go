@@ -0,0 +1,181 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+var (
+	// stakeStatsBucketKey is the bucket key for storing the outcomes of the
+	// wallet's tickets and the SSFee rewards it earned, recorded as the
+	// votes, revocations and fee distributions are mined.  Keys sort by
+	// block height so statistics over a window of blocks can be aggregated.
+	// Key: block height (4 bytes, big endian) | tx hash (32 bytes)
+	// Value: kind (1 byte) | reward count (1 byte) | [coin type (1 byte) |
+	// reward length (1 byte) | signed big-endian reward]...
+	stakeStatsBucketKey = []byte("stakestats")
+)
+
+// StakeStatKind describes the stake transaction a StakeStat was recorded for.
+type StakeStatKind uint8
+
+// StakeStatKind values.
+const (
+	// StakeStatVote records a vote of a wallet ticket.
+	StakeStatVote StakeStatKind = iota
+
+	// StakeStatMissed records the revocation of a wallet ticket which was
+	// called to vote but missed.
+	StakeStatMissed
+
+	// StakeStatExpired records the revocation of an expired wallet ticket.
+	StakeStatExpired
+
+	// StakeStatFeeReward records a fee distribution crediting SSFee rewards
+	// to the wallet.
+	StakeStatFeeReward
+)
+
+// StakeStat is the record of a mined stake transaction of the wallet.  Rewards
+// is the stakebase subsidy of a vote, or the SSFee rewards credited by a fee
+// distribution, by coin type.  Rewards is empty for revocations.
+type StakeStat struct {
+	Height  int32
+	Hash    chainhash.Hash
+	Kind    StakeStatKind
+	Rewards map[cointype.CoinType]*big.Int
+}
+
+func keyStakeStat(height int32, hash *chainhash.Hash) []byte {
+	k := make([]byte, 4+chainhash.HashSize)
+	binary.BigEndian.PutUint32(k, uint32(height))
+	copy(k[4:], hash[:])
+	return k
+}
+
+func valueStakeStat(s *StakeStat) ([]byte, error) {
+	if len(s.Rewards) > 255 {
+		return nil, errors.E(errors.Invalid, "too many reward coin types")
+	}
+	v := []byte{byte(s.Kind), byte(len(s.Rewards))}
+	for ct, reward := range s.Rewards {
+		b := cointype.NewSKAAmount(reward).SignedBytes()
+		if len(b) > 255 {
+			return nil, errors.E(errors.Invalid, "reward too large")
+		}
+		v = append(v, byte(ct), byte(len(b)))
+		v = append(v, b...)
+	}
+	return v, nil
+}
+
+func readStakeStat(k, v []byte) (*StakeStat, error) {
+	bad := errors.E(errors.IO, "bad stake stats record")
+	if len(k) != 4+chainhash.HashSize || len(v) < 2 {
+		return nil, bad
+	}
+	s := &StakeStat{
+		Height:  int32(binary.BigEndian.Uint32(k)),
+		Kind:    StakeStatKind(v[0]),
+		Rewards: make(map[cointype.CoinType]*big.Int, v[1]),
+	}
+	copy(s.Hash[:], k[4:])
+	n := int(v[1])
+	v = v[2:]
+	for i := 0; i < n; i++ {
+		if len(v) < 2 || len(v) < 2+int(v[1]) {
+			return nil, bad
+		}
+		ct, l := cointype.CoinType(v[0]), int(v[1])
+		s.Rewards[ct] = cointype.SKAAmountFromSignedBytes(v[2 : 2+l]).BigInt()
+		v = v[2+l:]
+	}
+	if len(v) != 0 {
+		return nil, bad
+	}
+	return s, nil
+}
+
+// PutStakeStat records the outcome of a mined stake transaction.  Recording
+// the same transaction again replaces the previous record.
+func PutStakeStat(dbtx walletdb.ReadWriteTx, s *StakeStat) error {
+	const op errors.Op = "udb.PutStakeStat"
+
+	b := dbtx.ReadWriteBucket(stakeStatsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing stake stats bucket")
+	}
+	v, err := valueStakeStat(s)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	err = b.Put(keyStakeStat(s.Height, &s.Hash), v)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// ForEachStakeStat calls f with each stake statistic recorded for blocks from
+// height from through to, inclusive, in increasing height order.  Iteration
+// stops if f returns an error, which is returned to the caller.
+func ForEachStakeStat(dbtx walletdb.ReadTx, from, to int32, f func(*StakeStat) error) error {
+	const op errors.Op = "udb.ForEachStakeStat"
+
+	b := dbtx.ReadBucket(stakeStatsBucketKey)
+	if b == nil || to < from {
+		return nil
+	}
+	if from < 0 {
+		from = 0
+	}
+	var seek [4]byte
+	binary.BigEndian.PutUint32(seek[:], uint32(from))
+	c := b.ReadCursor()
+	defer c.Close()
+	for k, v := c.Seek(seek[:]); k != nil; k, v = c.Next() {
+		s, err := readStakeStat(k, v)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		if s.Height > to {
+			break
+		}
+		if err := f(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteStakeStatsFrom removes the stake statistics recorded for blocks at
+// height onwards.
+func deleteStakeStatsFrom(dbtx walletdb.ReadWriteTx, height int32) error {
+	b := dbtx.ReadWriteBucket(stakeStatsBucketKey)
+	if b == nil {
+		return nil
+	}
+	var seek [4]byte
+	binary.BigEndian.PutUint32(seek[:], uint32(height))
+	var keys [][]byte
+	c := b.ReadCursor()
+	for k, _ := c.Seek(seek[:]); k != nil; k, _ = c.Next() {
+		keys = append(keys, append([]byte(nil), k...))
+	}
+	c.Close()
+	for _, k := range keys {
+		if err := b.Delete(k); err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	return nil
+}
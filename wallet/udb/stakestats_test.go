@@ -0,0 +1,98 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+func TestStakeStats(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	skaReward, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	stats := []StakeStat{
+		{Height: 10, Hash: chainhash.Hash{1}, Kind: StakeStatVote,
+			Rewards: map[cointype.CoinType]*big.Int{0: big.NewInt(1e8)}},
+		{Height: 12, Hash: chainhash.Hash{2}, Kind: StakeStatFeeReward,
+			Rewards: map[cointype.CoinType]*big.Int{0: big.NewInt(5e5), 1: skaReward}},
+		{Height: 12, Hash: chainhash.Hash{3}, Kind: StakeStatMissed,
+			Rewards: map[cointype.CoinType]*big.Int{}},
+		{Height: 300, Hash: chainhash.Hash{4}, Kind: StakeStatExpired,
+			Rewards: map[cointype.CoinType]*big.Int{}},
+	}
+	statsIn := func(from, to int32) []*StakeStat {
+		var got []*StakeStat
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			return ForEachStakeStat(dbtx, from, to, func(s *StakeStat) error {
+				got = append(got, s)
+				return nil
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+	check := func(got []*StakeStat, want []StakeStat) {
+		t.Helper()
+		if len(got) != len(want) {
+			t.Fatalf("got %d stats, want %d", len(got), len(want))
+		}
+		for i := range want {
+			g, w := got[i], &want[i]
+			if g.Height != w.Height || g.Hash != w.Hash || g.Kind != w.Kind ||
+				len(g.Rewards) != len(w.Rewards) {
+				t.Fatalf("stat %d: got %+v, want %+v", i, g, w)
+			}
+			for ct, r := range w.Rewards {
+				if g.Rewards[ct] == nil || g.Rewards[ct].Cmp(r) != 0 {
+					t.Fatalf("stat %d: coin type %v reward %v, want %v",
+						i, ct, g.Rewards[ct], r)
+				}
+			}
+		}
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		for i := range stats {
+			if err := PutStakeStat(dbtx, &stats[i]); err != nil {
+				return err
+			}
+		}
+		// Recording a transaction again replaces its record.
+		return PutStakeStat(dbtx, &stats[0])
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check(statsIn(0, 1000), stats)
+	check(statsIn(11, 299), stats[1:3])
+	check(statsIn(13, 299), nil)
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return deleteStakeStatsFrom(dbtx, 12)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(statsIn(0, 1000), stats[:1])
+}
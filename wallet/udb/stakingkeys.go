@@ -0,0 +1,313 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/kdf"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/crypto/rand"
+	"github.com/monetarium/monetarium-node/hdkeychain"
+)
+
+// The staking key domain is a set of accounts whose extended private keys are
+// encrypted by a staking crypto key rather than the crypto private key
+// protected by the wallet passphrase.  Accounts in the domain, such as those
+// which vote and revoke tickets, may be unlocked with a separate staking
+// passphrase, or remain unlocked when no staking passphrase is set, while
+// every other account remains locked.  Locking the wallet does not lock the
+// staking key domain.
+
+var (
+	// stakingKeyName is the main bucket key of the staking crypto key.  It
+	// is recorded in plaintext when no staking passphrase is set, and
+	// otherwise sealed with a key derived from the staking passphrase
+	// using the Argon2id parameters recorded by stakingKDFName.
+	stakingKeyName = []byte("stakingkey")
+	stakingKDFName = []byte("stakingkdf")
+
+	// acctVarStaking records that an account is in the staking key domain.
+	acctVarStaking = []byte("staking")
+)
+
+// stakingKeySize is the size of the XChaCha20-Poly1305 staking crypto key.
+const stakingKeySize = 32
+
+// newStakingKey returns a new random staking crypto key.
+func newStakingKey() []byte {
+	key := make([]byte, stakingKeySize)
+	rand.Read(key)
+	return key
+}
+
+// fetchStakingKey returns the recorded staking crypto key, which is sealed
+// when Argon2id parameters are also returned.  A nil key is returned for
+// databases which have not been upgraded to record one.
+func fetchStakingKey(ns walletdb.ReadBucket) ([]byte, *kdf.Argon2idParams, error) {
+	bucket := ns.NestedReadBucket(mainBucketName)
+
+	val := bucket.Get(stakingKeyName)
+	if val == nil {
+		return nil, nil, nil
+	}
+	key := make([]byte, len(val))
+	copy(key, val)
+
+	var kdfp *kdf.Argon2idParams
+	if val := bucket.Get(stakingKDFName); val != nil {
+		kdfp = new(kdf.Argon2idParams)
+		err := kdfp.UnmarshalBinary(val)
+		if err != nil {
+			return nil, nil, errors.E(errors.IO, err)
+		}
+	}
+	return key, kdfp, nil
+}
+
+// putStakingKey records the staking crypto key.  The key must be sealed with
+// a key derived from the Argon2id parameters if they are non-nil, and is
+// otherwise recorded in plaintext.
+func putStakingKey(ns walletdb.ReadWriteBucket, key []byte, kdfp *kdf.Argon2idParams) error {
+	bucket := ns.NestedReadWriteBucket(mainBucketName)
+
+	err := bucket.Put(stakingKeyName, key)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	if kdfp == nil {
+		err = bucket.Delete(stakingKDFName)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+		return nil
+	}
+	marshaled, err := kdfp.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	err = bucket.Put(stakingKDFName, marshaled)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// decryptStakingAccountKey decrypts the extended private key of an account in
+// the staking key domain using the unlocked staking crypto key.
+//
+// This function MUST be called with the manager lock held for writes.
+func (m *Manager) decryptStakingAccountKey(account uint32, acctInfo *accountInfo) error {
+	plaintext, err := unseal(m.stakingKey, acctInfo.acctKeyEncrypted)
+	if err != nil {
+		err := errors.Errorf("decrypt account %d privkey: %v", account, err)
+		return errors.E(errors.Crypto, err)
+	}
+	acctKeyPriv, err := hdkeychain.NewKeyFromString(string(plaintext), m.chainParams)
+	zero(plaintext)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	acctInfo.acctKeyPriv = acctKeyPriv
+	return nil
+}
+
+// lockStaking removes the staking crypto key and the extended private keys of
+// the staking key domain from memory.
+//
+// This function MUST be called with the manager lock held for writes.
+func (m *Manager) lockStaking() {
+	for _, acctInfo := range m.acctInfo {
+		if !acctInfo.staking || acctInfo.acctKeyPriv == nil {
+			continue
+		}
+		acctInfo.acctKeyPriv.Zero()
+		acctInfo.acctKeyPriv = nil
+	}
+	zero(m.stakingKey)
+	m.stakingKey = nil
+}
+
+// StakingLocked returns whether the staking key domain is locked.
+func (m *Manager) StakingLocked() bool {
+	defer m.mtx.RUnlock()
+	m.mtx.RLock()
+
+	return m.stakingKey == nil
+}
+
+// UnlockStaking unlocks the staking key domain, providing the extended
+// private keys of its accounts regardless of whether the manager is locked.
+// The passphrase is ignored when no staking passphrase is set.  An error with
+// code Passphrase is returned if the passphrase is incorrect.
+func (m *Manager) UnlockStaking(ns walletdb.ReadBucket, passphrase []byte) error {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	stored, kdfp, err := fetchStakingKey(ns)
+	if err != nil {
+		return err
+	}
+	if stored == nil {
+		return errors.E(errors.NotExist, "no staking key is recorded")
+	}
+	key := keyType(stored)
+	if kdfp != nil {
+		derived := argon2idKey(passphrase, kdfp)
+		key, err = unseal(derived, stored)
+		zero(derived)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.lockStaking()
+	m.stakingKey = key
+	for account, acctInfo := range m.acctInfo {
+		if !acctInfo.staking || len(acctInfo.acctKeyEncrypted) == 0 {
+			continue
+		}
+		err := m.decryptStakingAccountKey(account, acctInfo)
+		if err != nil {
+			m.lockStaking()
+			return err
+		}
+	}
+	return nil
+}
+
+// LockStaking locks the staking key domain.  An error with code Invalid is
+// returned if no staking passphrase is set, as the staking key domain would
+// be unlocked again when the manager is next opened.
+func (m *Manager) LockStaking(ns walletdb.ReadBucket) error {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	_, kdfp, err := fetchStakingKey(ns)
+	if err != nil {
+		return err
+	}
+	if kdfp == nil {
+		return errors.E(errors.Invalid, "staking keys without a "+
+			"passphrase can not be locked")
+	}
+	m.lockStaking()
+	return nil
+}
+
+// SetStakingPassphrase changes the passphrase protecting the staking key
+// domain.  An empty passphrase leaves the staking key domain unlocked each
+// time the manager is opened.  The staking key domain must be unlocked.
+func (m *Manager) SetStakingPassphrase(ns walletdb.ReadWriteBucket, passphrase []byte) error {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.stakingKey == nil {
+		return errors.E(errors.Locked, "staking keys must be unlocked "+
+			"to set the staking passphrase")
+	}
+	if len(passphrase) == 0 {
+		return putStakingKey(ns, m.stakingKey, nil)
+	}
+
+	kdfp, err := kdf.NewArgon2idParams(rand.Reader())
+	if err != nil {
+		return err
+	}
+	derived := argon2idKey(passphrase, kdfp)
+	sealed, err := seal(derived, m.stakingKey)
+	zero(derived)
+	if err != nil {
+		return err
+	}
+	return putStakingKey(ns, sealed, kdfp)
+}
+
+// SetStakingAccount adds an account to, or removes an account from, the
+// staking key domain by reencrypting its extended private key.  Both the
+// manager and the staking key domain must be unlocked.  Accounts with a
+// unique passphrase may not be added to the staking key domain.
+func (m *Manager) SetStakingAccount(ns walletdb.ReadWriteBucket, account uint32, staking bool) error {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.watchingOnly {
+		return errors.E(errors.WatchingOnly)
+	}
+	if isReservedAccountNum(account) {
+		return errors.E(errors.Invalid, "reserved account")
+	}
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	if err != nil {
+		return err
+	}
+	if acctInfo.staking == staking {
+		return nil
+	}
+	if acctInfo.uniqueKey != nil {
+		return errors.E(errors.Invalid, "account is encrypted with "+
+			"a unique passphrase")
+	}
+	if m.locked || m.stakingKey == nil || acctInfo.acctKeyPriv == nil {
+		return errors.E(errors.Locked, "wallet and staking keys must "+
+			"be unlocked to change the staking key domain")
+	}
+
+	plaintext := []byte(acctInfo.acctKeyPriv.String())
+	var ciphertext []byte
+	if staking {
+		ciphertext, err = seal(m.stakingKey, plaintext)
+	} else {
+		ciphertext, err = m.cryptoKeyPriv.Encrypt(plaintext)
+	}
+	zero(plaintext)
+	if err != nil {
+		err := errors.Errorf("encrypt account %d privkey: %v", account, err)
+		return errors.E(errors.Crypto, err)
+	}
+
+	dbAcct, err := fetchDBAccount(ns, account, DBVersion)
+	if err != nil {
+		return err
+	}
+	switch a := dbAcct.(type) {
+	case *dbBIP0044Account:
+		a.privKeyEncrypted = ciphertext
+		a.rawData = a.serializeRow()
+		err := putAccountRow(ns, account, &a.dbAccountRow)
+		if err != nil {
+			return err
+		}
+	default:
+		return errors.Errorf("unknown account type %T", a)
+	}
+	acctVars := accountVarsBucket(ns, account)
+	if staking {
+		err = putAccountUint32Var(acctVars, acctVarStaking, 1)
+	} else {
+		err = acctVars.Delete(acctVarStaking)
+		if err != nil {
+			err = errors.E(errors.IO, err)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	acctInfo.acctKeyEncrypted = ciphertext
+	acctInfo.staking = staking
+	return nil
+}
+
+// stakingAccount returns whether an account is in the staking key domain, and
+// if so, whether its private keys are unlocked.
+//
+// This function MUST be called with the manager lock held for writes.
+func (m *Manager) stakingAccount(ns walletdb.ReadBucket, account uint32) (staking, unlocked bool) {
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	if err != nil {
+		return
+	}
+	return acctInfo.staking, acctInfo.staking && acctInfo.acctKeyPriv != nil
+}
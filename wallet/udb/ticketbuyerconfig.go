@@ -0,0 +1,117 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// ticketBuyerConfigBucketKey is the bucket key for storing the ticket
+	// buyer configuration of accounts maintaining a target number of live
+	// tickets.
+	// Key: account (4 bytes) → Value: target (4 bytes) | max price atoms
+	// (8 bytes) | max fee atoms (8 bytes) | reserve atoms (8 bytes)
+	ticketBuyerConfigBucketKey = []byte("ticketbuyerconfig")
+)
+
+// TicketBuyerConfig is the ticket buyer configuration of an account.  The
+// ticket buyer purchases tickets with the account's outputs until Target
+// tickets are unspent and unexpired.  Tickets are not purchased while the
+// ticket price exceeds MaxPrice or the relay fee per kB exceeds MaxFee, and
+// purchases never reduce the account's spendable balance below Reserve.  A
+// zero MaxPrice or MaxFee does not limit purchases.
+type TicketBuyerConfig struct {
+	Account  uint32
+	Target   uint32
+	MaxPrice dcrutil.Amount
+	MaxFee   dcrutil.Amount
+	Reserve  dcrutil.Amount
+}
+
+func keyTicketBuyerConfig(account uint32) []byte {
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, account)
+	return k
+}
+
+func valueTicketBuyerConfig(c *TicketBuyerConfig) []byte {
+	v := make([]byte, 28)
+	byteOrder.PutUint32(v, c.Target)
+	byteOrder.PutUint64(v[4:], uint64(c.MaxPrice))
+	byteOrder.PutUint64(v[12:], uint64(c.MaxFee))
+	byteOrder.PutUint64(v[20:], uint64(c.Reserve))
+	return v
+}
+
+func readTicketBuyerConfig(k, v []byte) (*TicketBuyerConfig, error) {
+	if len(k) != 4 || len(v) != 28 {
+		return nil, errors.E(errors.IO, "bad ticket buyer config record")
+	}
+	return &TicketBuyerConfig{
+		Account:  byteOrder.Uint32(k),
+		Target:   byteOrder.Uint32(v),
+		MaxPrice: dcrutil.Amount(byteOrder.Uint64(v[4:])),
+		MaxFee:   dcrutil.Amount(byteOrder.Uint64(v[12:])),
+		Reserve:  dcrutil.Amount(byteOrder.Uint64(v[20:])),
+	}, nil
+}
+
+// PutTicketBuyerConfig records the ticket buyer configuration of an account,
+// replacing any previous configuration.
+func PutTicketBuyerConfig(dbtx walletdb.ReadWriteTx, c *TicketBuyerConfig) error {
+	const op errors.Op = "udb.PutTicketBuyerConfig"
+
+	if c.MaxPrice < 0 || c.MaxFee < 0 || c.Reserve < 0 {
+		return errors.E(op, errors.Invalid, "ticket buyer limits cannot be negative")
+	}
+
+	b := dbtx.ReadWriteBucket(ticketBuyerConfigBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing ticket buyer config bucket")
+	}
+	err := b.Put(keyTicketBuyerConfig(c.Account), valueTicketBuyerConfig(c))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteTicketBuyerConfig removes the ticket buyer configuration of an
+// account, stopping the ticket buyer from maintaining its tickets.
+func DeleteTicketBuyerConfig(dbtx walletdb.ReadWriteTx, account uint32) error {
+	const op errors.Op = "udb.DeleteTicketBuyerConfig"
+
+	b := dbtx.ReadWriteBucket(ticketBuyerConfigBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing ticket buyer config bucket")
+	}
+	err := b.Delete(keyTicketBuyerConfig(account))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// ForEachTicketBuyerConfig calls f with the ticket buyer configuration of
+// every configured account, in increasing account order.  Iteration stops if f
+// returns an error, which is returned to the caller.
+func ForEachTicketBuyerConfig(dbtx walletdb.ReadTx, f func(*TicketBuyerConfig) error) error {
+	const op errors.Op = "udb.ForEachTicketBuyerConfig"
+
+	b := dbtx.ReadBucket(ticketBuyerConfigBucketKey)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		c, err := readTicketBuyerConfig(k, v)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return f(c)
+	})
+}
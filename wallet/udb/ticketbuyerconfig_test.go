@@ -0,0 +1,91 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestTicketBuyerConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	put := func(c *TicketBuyerConfig) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return PutTicketBuyerConfig(dbtx, c)
+		})
+	}
+	del := func(account uint32) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return DeleteTicketBuyerConfig(dbtx, account)
+		})
+	}
+	configs := func() []TicketBuyerConfig {
+		var configs []TicketBuyerConfig
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			return ForEachTicketBuyerConfig(dbtx, func(c *TicketBuyerConfig) error {
+				configs = append(configs, *c)
+				return nil
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return configs
+	}
+
+	if got := configs(); len(got) != 0 {
+		t.Fatalf("new database has ticket buyer configs %+v", got)
+	}
+
+	if err := put(&TicketBuyerConfig{Account: 4, Target: 10, MaxPrice: 2e10}); err != nil {
+		t.Fatal(err)
+	}
+	if err := put(&TicketBuyerConfig{Account: 0, Target: 1, Reserve: 1e8}); err != nil {
+		t.Fatal(err)
+	}
+	// Putting the config of an account again replaces it.
+	if err := put(&TicketBuyerConfig{Account: 4, Target: 20, MaxFee: 1e5}); err != nil {
+		t.Fatal(err)
+	}
+	want := []TicketBuyerConfig{
+		{Account: 0, Target: 1, Reserve: 1e8},
+		{Account: 4, Target: 20, MaxFee: 1e5},
+	}
+	if got := configs(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("configs %+v, want %+v", got, want)
+	}
+
+	if err := del(0); err != nil {
+		t.Fatal(err)
+	}
+	// Deleting the config of an unconfigured account is not an error.
+	if err := del(3); err != nil {
+		t.Fatal(err)
+	}
+	want = want[1:]
+	if got := configs(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("configs %+v, want %+v", got, want)
+	}
+
+	err = put(&TicketBuyerConfig{Account: 1, Target: 1, Reserve: -1})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("negative reserve: expected Invalid error, got %v", err)
+	}
+}
@@ -7,14 +7,19 @@ package udb
 
 import (
 	"context"
+	"math/big"
 	"testing"
 	"time"
 
 	_ "github.com/monetarium/monetarium-wallet/wallet/drivers/bdb"
+	"github.com/monetarium/monetarium-wallet/wallet/txauthor"
+	"github.com/monetarium/monetarium-wallet/wallet/txsizes"
 	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
 	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
 	"github.com/monetarium/monetarium-node/wire"
 )
 
@@ -705,3 +710,259 @@ func TestUnconfirmedBalanceTicketPurchase(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestForEachUnspentOutputFrom(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, teardown, err := cloneDB(ctx, "foreach_unspent.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := makeBlockGenerator()
+	b1H := g.generate(dcrutil.BlockValid)
+	b1Hash := b1H.BlockHash()
+	b1Meta := makeBlockMeta(b1H)
+	headerData := makeHeaderDataSlice(b1H)
+	filters := emptyFilters(1)
+
+	mined := wire.MsgTx{TxOut: []*wire.TxOut{{Value: 1e8}, {Value: 2e8}, {Value: 3e8}}}
+	minedRec, err := NewTxRecordFromMsgTx(&mined, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unmined := wire.MsgTx{TxOut: []*wire.TxOut{{Value: 4e8}, {Value: 5e8}}}
+	unminedRec, err := NewTxRecordFromMsgTx(&unmined, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := make(map[wire.OutPoint]struct{})
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		err := insertMainChainHeaders(s, dbtx, headerData, filters)
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(dbtx, minedRec, &b1Hash)
+		if err != nil {
+			return err
+		}
+		for i := range mined.TxOut {
+			err = s.AddCredit(dbtx, minedRec, b1Meta, uint32(i), false, 0)
+			if err != nil {
+				return err
+			}
+			want[wire.OutPoint{Hash: minedRec.Hash, Index: uint32(i)}] = struct{}{}
+		}
+		err = s.InsertMemPoolTx(dbtx, unminedRec)
+		if err != nil {
+			return err
+		}
+		for i := range unmined.TxOut {
+			err = s.AddCredit(dbtx, unminedRec, nil, uint32(i), false, 0)
+			if err != nil {
+				return err
+			}
+			want[wire.OutPoint{Hash: unminedRec.Hash, Index: uint32(i)}] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Visit two outputs per database transaction until the cursor reports
+	// that every output has been visited.
+	var cursor UnspentOutputCursor
+	seen := make(map[wire.OutPoint]struct{})
+	for calls := 0; !cursor.Done(); calls++ {
+		if calls > len(want) {
+			t.Fatal("iteration did not complete")
+		}
+		n := 0
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			return s.ForEachUnspentOutputFrom(dbtx, cointype.CoinTypeVAR,
+				&cursor, 2, func(c *Credit) error {
+					if _, ok := seen[c.OutPoint]; ok {
+						t.Errorf("output %v visited twice", &c.OutPoint)
+					}
+					seen[c.OutPoint] = struct{}{}
+					n++
+					return nil
+				})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n > 2 {
+			t.Errorf("visited %d outputs with limit 2", n)
+		}
+	}
+	for op := range want {
+		if _, ok := seen[op]; !ok {
+			t.Errorf("output %v was not visited", &op)
+		}
+	}
+	if len(seen) != len(want) {
+		t.Errorf("visited %d outputs, want %d", len(seen), len(want))
+	}
+}
+
+func TestSelectStakeChangeInputs(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, teardown, err := cloneDB(ctx, "stake_change_inputs.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := chaincfg.TestNet3Params()
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20),
+		params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, changeScript := addr.StakeChangeScript()
+
+	g := makeBlockGenerator()
+	b1H := g.generate(dcrutil.BlockValid)
+	b1Hash := b1H.BlockHash()
+	b1Meta := makeBlockMeta(b1H)
+	headerData := makeHeaderDataSlice(b1H)
+	filters := emptyFilters(1)
+
+	tx := wire.MsgTx{
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}},
+		}},
+		TxOut: []*wire.TxOut{{Value: 1e8, PkScript: changeScript}},
+	}
+	rec, err := NewTxRecordFromMsgTx(&tx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		err := insertMainChainHeaders(s, dbtx, headerData, filters)
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(dbtx, rec, &b1Hash)
+		if err != nil {
+			return err
+		}
+		return s.AddCredit(dbtx, rec, b1Meta, 0, false, 0)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	selectAll := func(syncHeight int32) *txauthor.InputDetail {
+		var detail *txauthor.InputDetail
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			source := s.MakeInputSourceWithCoinType(dbtx, 0, 1, syncHeight,
+				nil, cointype.CoinTypeVAR)
+			var err error
+			detail, err = source.SelectInputs(0)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return detail
+	}
+
+	// Stake change can not be spent before it reaches maturity.
+	maturity := int32(params.SStxChangeMaturity)
+	if detail := selectAll(maturity); len(detail.Inputs) != 0 {
+		t.Fatalf("selected %d immature stake change inputs",
+			len(detail.Inputs))
+	}
+
+	detail := selectAll(maturity + 1)
+	if len(detail.Inputs) != 1 {
+		t.Fatalf("selected %d inputs, want 1 mature stake change input",
+			len(detail.Inputs))
+	}
+	if tree := detail.Inputs[0].PreviousOutPoint.Tree; tree != wire.TxTreeStake {
+		t.Errorf("stake change input references tree %d", tree)
+	}
+	if detail.Amount != 1e8 {
+		t.Errorf("selected amount %v, want 1e8 atoms", detail.Amount)
+	}
+	if detail.RedeemScriptSizes[0] != txsizes.RedeemP2PKHSigScriptSize {
+		t.Errorf("redeem script size %d, want %d",
+			detail.RedeemScriptSizes[0], txsizes.RedeemP2PKHSigScriptSize)
+	}
+}
+
+func TestUnminedCreditsByCoinType(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, teardown, err := cloneDB(ctx, "unmined_credits_by_coin_type.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An unmined transaction paying VAR and SKA-1 outputs to the wallet
+	// records each credit in the unmined credits bucket of its coin type.
+	tx := wire.MsgTx{
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}},
+		}},
+		TxOut: []*wire.TxOut{
+			{Value: 1e8, CoinType: cointype.CoinTypeVAR},
+			{SKAValue: big.NewInt(5e8), CoinType: 1},
+			{Value: 2e8, CoinType: cointype.CoinTypeVAR},
+		},
+	}
+	rec, err := NewTxRecordFromMsgTx(&tx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		err := s.InsertMemPoolTx(dbtx, rec)
+		if err != nil {
+			return err
+		}
+		for i := range tx.TxOut {
+			err := s.AddCredit(dbtx, rec, nil, uint32(i), false, 0)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrBucketKey)
+		for _, ct := range []cointype.CoinType{cointype.CoinTypeVAR, 1} {
+			b := ns.NestedReadBucket(bucketUnminedCreditsForCoinType(ct))
+			if b == nil || walletdb.BucketIsEmpty(b) {
+				t.Errorf("no unmined credits recorded for coin type %d", ct)
+			}
+		}
+
+		details, err := s.TxDetails(ns, &rec.Hash)
+		if err != nil {
+			return err
+		}
+		want := []cointype.CoinType{cointype.CoinTypeVAR, 1, cointype.CoinTypeVAR}
+		if len(details.Credits) != len(want) {
+			t.Fatalf("read %d unmined credits, want %d",
+				len(details.Credits), len(want))
+		}
+		for i, c := range details.Credits {
+			if c.Index != uint32(i) || c.CoinType != want[i] {
+				t.Errorf("credit %d: index %d coin type %d, want index "+
+					"%d coin type %d", i, c.Index, c.CoinType, i, want[i])
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
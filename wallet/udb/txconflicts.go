@@ -0,0 +1,208 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// txConflictsBucketKey is the bucket key for recording unmined
+	// transactions removed from the wallet after losing a double spend.
+	// Key: conflicted transaction hash (32 bytes) → Value: hash of the
+	// conflicting transaction (32 bytes), unix time of the conflict (8
+	// bytes), and the serialized conflicted transaction
+	txConflictsBucketKey = []byte("txconflicts")
+)
+
+// TxConflict describes an unmined transaction which was removed from the
+// wallet because a mined transaction double spent it or one of the unmined
+// transactions it spends.
+type TxConflict struct {
+	Hash         chainhash.Hash
+	ConflictedBy chainhash.Hash
+	Time         time.Time
+	Tx           wire.MsgTx
+}
+
+func (c *TxConflict) serialize() ([]byte, error) {
+	v := make([]byte, 40, 40+c.Tx.SerializeSize())
+	copy(v, c.ConflictedBy[:])
+	byteOrder.PutUint64(v[32:40], uint64(c.Time.Unix()))
+	buf := bytes.NewBuffer(v)
+	err := c.Tx.Serialize(buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func deserializeTxConflict(k, v []byte) (*TxConflict, error) {
+	if len(k) != chainhash.HashSize || len(v) < 40 {
+		return nil, errors.E(errors.IO, errors.Errorf("bad transaction conflict length %d", len(v)))
+	}
+	c := new(TxConflict)
+	copy(c.Hash[:], k)
+	copy(c.ConflictedBy[:], v)
+	c.Time = time.Unix(int64(byteOrder.Uint64(v[32:40])), 0)
+	err := c.Tx.Deserialize(bytes.NewReader(v[40:]))
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	return c, nil
+}
+
+func putTxConflict(dbtx walletdb.ReadWriteTx, c *TxConflict) error {
+	b := dbtx.ReadWriteBucket(txConflictsBucketKey)
+	if b == nil {
+		return errors.E(errors.Bug, "missing transaction conflicts bucket")
+	}
+	v, err := c.serialize()
+	if err != nil {
+		return errors.E(errors.Encoding, err)
+	}
+	err = b.Put(c.Hash[:], v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// deleteTxConflict removes the conflict record of a transaction which is added
+// back to the wallet, e.g. after a reorg removes the conflicting transaction.
+func deleteTxConflict(dbtx walletdb.ReadWriteTx, txHash *chainhash.Hash) error {
+	b := dbtx.ReadWriteBucket(txConflictsBucketKey)
+	if b == nil {
+		return errors.E(errors.Bug, "missing transaction conflicts bucket")
+	}
+	if b.Get(txHash[:]) == nil {
+		return nil
+	}
+	err := b.Delete(txHash[:])
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// TxConflictForHash returns the conflict record of the transaction with the
+// given hash.  Errors with code errors.NotExist are returned for transactions
+// which were not removed by a conflict.
+func TxConflictForHash(dbtx walletdb.ReadTx, txHash *chainhash.Hash) (*TxConflict, error) {
+	const op errors.Op = "udb.TxConflictForHash"
+	b := dbtx.ReadBucket(txConflictsBucketKey)
+	if b == nil {
+		return nil, errors.E(op, errors.Bug, "missing transaction conflicts bucket")
+	}
+	v := b.Get(txHash[:])
+	if v == nil {
+		return nil, errors.E(op, errors.NotExist, errors.Errorf("no conflict for transaction %v", txHash))
+	}
+	c, err := deserializeTxConflict(txHash[:], v)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return c, nil
+}
+
+// TxConflicts returns the conflict records of every transaction removed from
+// the wallet by a conflict.
+func TxConflicts(dbtx walletdb.ReadTx) ([]*TxConflict, error) {
+	const op errors.Op = "udb.TxConflicts"
+	b := dbtx.ReadBucket(txConflictsBucketKey)
+	if b == nil {
+		return nil, errors.E(op, errors.Bug, "missing transaction conflicts bucket")
+	}
+	var conflicts []*TxConflict
+	err := b.ForEach(func(k, v []byte) error {
+		c, err := deserializeTxConflict(k, v)
+		if err != nil {
+			return err
+		}
+		conflicts = append(conflicts, c)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return conflicts, nil
+}
+
+// unminedConflicts returns the unmined transactions which double spend an
+// input of tx, followed by every unmined transaction spending their outputs.
+// These are the transactions removed from the store when tx is mined.
+func unminedConflicts(ns walletdb.ReadBucket, tx *wire.MsgTx, txHash *chainhash.Hash) ([]*TxRecord, error) {
+	var conflicts []*TxRecord
+	seen := make(map[chainhash.Hash]struct{})
+	add := func(spenderVal []byte) error {
+		var spenderHash chainhash.Hash
+		readRawUnminedInputSpenderHash(spenderVal, &spenderHash)
+		if spenderHash == *txHash {
+			return nil
+		}
+		if _, ok := seen[spenderHash]; ok {
+			return nil
+		}
+		seen[spenderHash] = struct{}{}
+		rec := &TxRecord{Hash: spenderHash}
+		err := readRawTxRecord(&rec.Hash, existsRawUnmined(ns, spenderHash[:]), rec)
+		if err != nil {
+			return err
+		}
+		conflicts = append(conflicts, rec)
+		return nil
+	}
+
+	for _, input := range tx.TxIn {
+		prevOut := &input.PreviousOutPoint
+		k := canonicalOutPoint(&prevOut.Hash, prevOut.Index)
+		if v := existsRawUnminedInput(ns, k); v != nil {
+			err := add(v)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	// Conflicts are appended while iterating to include the spenders of
+	// every conflicting transaction.
+	for i := 0; i < len(conflicts); i++ {
+		c := conflicts[i]
+		for j := range c.MsgTx.TxOut {
+			k := canonicalOutPoint(&c.Hash, uint32(j))
+			if v := existsRawUnminedInput(ns, k); v != nil {
+				err := add(v)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return conflicts, nil
+}
+
+// UnminedConflicts returns the hashes of unmined wallet transactions which
+// conflict with tx, either by double spending one of its inputs or by spending
+// an output of a conflicting transaction.  The conflicting transactions are
+// removed from the store if tx is mined.
+func (s *Store) UnminedConflicts(dbtx walletdb.ReadTx, tx *wire.MsgTx) ([]chainhash.Hash, error) {
+	const op errors.Op = "udb.UnminedConflicts"
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+	txHash := tx.TxHash()
+	conflicts, err := unminedConflicts(ns, tx, &txHash)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	hashes := make([]chainhash.Hash, len(conflicts))
+	for i, c := range conflicts {
+		hashes[i] = c.Hash
+	}
+	return hashes, nil
+}
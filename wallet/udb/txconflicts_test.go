@@ -0,0 +1,164 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestTxConflicts(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, teardown, err := cloneDB(ctx, "tx_conflicts.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := makeBlockGenerator()
+	b1H := g.generate(dcrutil.BlockValid)
+	b1Hash := b1H.BlockHash()
+	b2H := g.generate(dcrutil.BlockValid)
+	b2Hash := b2H.BlockHash()
+
+	newRec := func(tx *wire.MsgTx) *TxRecord {
+		t.Helper()
+		rec, err := NewTxRecordFromMsgTx(tx, time.Unix(1e9, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rec
+	}
+	spend := func(prev *TxRecord, value int64) *TxRecord {
+		return newRec(&wire.MsgTx{
+			TxIn: []*wire.TxIn{{
+				PreviousOutPoint: wire.OutPoint{Hash: prev.Hash},
+				ValueIn:          prev.MsgTx.TxOut[0].Value,
+			}},
+			TxOut: []*wire.TxOut{{Value: value}},
+		})
+	}
+
+	// The unmined transaction a and its unmined child c lose a double spend
+	// of the funding transaction's output to b.
+	fund := newRec(&wire.MsgTx{TxOut: []*wire.TxOut{{Value: 2e8}}})
+	a := spend(fund, 2e8-1e4)
+	c := spend(a, 2e8-2e4)
+	b := spend(fund, 2e8-3e4)
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		err := insertMainChainHeaders(s, dbtx, makeHeaderDataSlice(b1H, b2H), emptyFilters(2))
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(dbtx, fund, &b1Hash)
+		if err != nil {
+			return err
+		}
+		err = s.InsertMemPoolTx(dbtx, a)
+		if err != nil {
+			return err
+		}
+		return s.InsertMemPoolTx(dbtx, c)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	update := func(f func(dbtx walletdb.ReadWriteTx) error) error {
+		return walletdb.Update(ctx, db, f)
+	}
+	conflictRecord := func(hash *chainhash.Hash) (*TxConflict, error) {
+		var tc *TxConflict
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			tc, err = TxConflictForHash(dbtx, hash)
+			return err
+		})
+		return tc, err
+	}
+
+	// Competing unmined spends are not inserted.
+	err = update(func(dbtx walletdb.ReadWriteTx) error {
+		return s.InsertMemPoolTx(dbtx, b)
+	})
+	if !errors.Is(err, errors.DoubleSpend) {
+		t.Fatalf("inserting unmined double spend: expected DoubleSpend, got %v", err)
+	}
+	var conflicts []chainhash.Hash
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		conflicts, err = s.UnminedConflicts(dbtx, &b.MsgTx)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []chainhash.Hash{a.Hash, c.Hash}
+	if !reflect.DeepEqual(conflicts, want) {
+		t.Fatalf("unmined conflicts %v, want %v", conflicts, want)
+	}
+	_, err = conflictRecord(&a.Hash)
+	if !errors.Is(err, errors.NotExist) {
+		t.Fatalf("conflict recorded for unmined double spend: %v", err)
+	}
+
+	// Mining the double spend removes and records the conflicts.
+	err = update(func(dbtx walletdb.ReadWriteTx) error {
+		return s.InsertMinedTx(dbtx, b, &b2Hash)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range []*TxRecord{a, c} {
+		got, err := conflictRecord(&rec.Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.ConflictedBy != b.Hash || !got.Time.Equal(b.Received) ||
+			got.Tx.TxHash() != rec.Hash {
+			t.Errorf("conflict record of %v: %+v", &rec.Hash, got)
+		}
+	}
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrBucketKey)
+		for _, rec := range []*TxRecord{a, c} {
+			if existsRawUnmined(ns, rec.Hash[:]) != nil {
+				t.Errorf("conflicted transaction %v remains unmined", &rec.Hash)
+			}
+		}
+		all, err := TxConflicts(dbtx)
+		if err != nil {
+			return err
+		}
+		if len(all) != 2 {
+			t.Errorf("recorded %d conflicts, want 2", len(all))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Inserting a conflicted transaction again removes its conflict record.
+	err = update(func(dbtx walletdb.ReadWriteTx) error {
+		return s.InsertMemPoolTx(dbtx, a)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = conflictRecord(&a.Hash)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("conflict record remains after reinsertion: %v", err)
+	}
+}
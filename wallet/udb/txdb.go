@@ -210,6 +210,9 @@ var (
 	rootLastTxsBlock = []byte("lasttxsblock")
 	rootVSPHostIndex = []byte("vsphostindex")
 	rootBirthState   = []byte("birthstate")
+
+	rootRescanCheckpoint    = []byte("rescancheckpoint")
+	rootDiscoveryCheckpoint = []byte("discoverycheckpoint")
 )
 
 // The root bucket's mined balance k/v pair records the total balance for all
@@ -1056,6 +1059,20 @@ func putSSFeeMarker(ns walletdb.ReadWriteBucket, txRecKey []byte, markerType sta
 	return bucket.Put(txRecKey, []byte{byte(markerType)})
 }
 
+// deleteSSFeeMarker removes the cached SSFee marker type of a transaction
+// record.
+func deleteSSFeeMarker(ns walletdb.ReadWriteBucket, txRecKey []byte) error {
+	bucket := ns.NestedReadWriteBucket(bucketSSFeeMarkers)
+	if bucket == nil {
+		return nil
+	}
+	err := bucket.Delete(txRecKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
 // fetchSSFeeMarker retrieves the cached SSFee marker type for a transaction.
 // Returns SSFeeMarkerNone and false if not cached.
 func fetchSSFeeMarker(ns walletdb.ReadBucket, txRecKey []byte) (stake.SSFeeMarkerType, bool) {
@@ -1987,8 +2004,16 @@ func (r readCursor) Delete() error {
 	return errors.E(errors.IO, "delete called from read-only cursor")
 }
 
-func makeReadUnminedCreditIterator(ns walletdb.ReadBucket, txHash *chainhash.Hash, dbVersion uint32) unminedCreditIterator {
-	c := ns.NestedReadBucket(bucketUnminedCredits).ReadCursor()
+// makeReadUnminedCreditIterator returns an iterator over the unmined credits
+// of a coin type recorded for a transaction.
+func makeReadUnminedCreditIterator(ns walletdb.ReadBucket, txHash *chainhash.Hash,
+	coinType cointype.CoinType, dbVersion uint32) unminedCreditIterator {
+
+	bucket := ns.NestedReadBucket(bucketUnminedCreditsForCoinType(coinType))
+	if bucket == nil {
+		return unminedCreditIterator{}
+	}
+	c := bucket.ReadCursor()
 	return unminedCreditIterator{c: readCursor{c}, ns: ns, prefix: txHash[:], dbVersion: dbVersion}
 }
 
@@ -2883,6 +2908,12 @@ func getSSFeeType(tx *wire.MsgTx) string {
 	return ""
 }
 
+// SSFeeType returns "MF" for SSFee miner fee transactions, "SF" for SSFee
+// staker fee transactions, or the empty string for all other transactions.
+func SSFeeType(tx *wire.MsgTx) string {
+	return getSSFeeType(tx)
+}
+
 // isSSFeeMinerTx checks if a transaction is an SSFee Miner Fee transaction.
 // These transactions should be treated like coinbase for maturity purposes.
 func isSSFeeMinerTx(tx *wire.MsgTx) bool {
@@ -0,0 +1,58 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// MaxTxLabelLen is the maximum length in bytes of a transaction label.  It is
+// large enough to record the per-recipient fiat amounts of a sendmany.
+const MaxTxLabelLen = 4096
+
+var (
+	// txLabelsBucketKey is the bucket key for storing free-form labels
+	// attached to wallet transactions.
+	// Key: transaction hash (32 bytes) → Value: label (UTF-8 string)
+	txLabelsBucketKey = []byte("txlabels")
+)
+
+// PutTxLabel records a label for the transaction with the given hash,
+// replacing any previous label.  An empty label removes the record.
+func PutTxLabel(dbtx walletdb.ReadWriteTx, txHash *chainhash.Hash, label string) error {
+	const op errors.Op = "udb.PutTxLabel"
+
+	if len(label) > MaxTxLabelLen {
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("label exceeds maximum length %d", MaxTxLabelLen))
+	}
+
+	b := dbtx.ReadWriteBucket(txLabelsBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing transaction labels bucket")
+	}
+	var err error
+	if label == "" {
+		err = b.Delete(txHash[:])
+	} else {
+		err = b.Put(txHash[:], []byte(label))
+	}
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// TxLabel returns the label recorded for a transaction.  An empty string is
+// returned when the transaction has no label.
+func TxLabel(dbtx walletdb.ReadTx, txHash *chainhash.Hash) string {
+	b := dbtx.ReadBucket(txLabelsBucketKey)
+	if b == nil {
+		return ""
+	}
+	return string(b.Get(txHash[:]))
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestTxLabels(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h1 := chainhash.Hash{1}
+	h2 := chainhash.Hash{2}
+	label := func(h *chainhash.Hash) string {
+		var l string
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			l = TxLabel(dbtx, h)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return l
+	}
+	put := func(h *chainhash.Hash, l string) error {
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			return PutTxLabel(dbtx, h, l)
+		})
+	}
+
+	if err := put(&h1, "first"); err != nil {
+		t.Fatal(err)
+	}
+	if err := put(&h2, "second"); err != nil {
+		t.Fatal(err)
+	}
+	if l := label(&h1); l != "first" {
+		t.Errorf("label of h1 is %q, want %q", l, "first")
+	}
+
+	// Putting a new label replaces the previous one.
+	if err := put(&h1, "replaced"); err != nil {
+		t.Fatal(err)
+	}
+	if l := label(&h1); l != "replaced" {
+		t.Errorf("label of h1 is %q, want %q", l, "replaced")
+	}
+
+	// An empty label deletes the record without affecting others.
+	if err := put(&h1, ""); err != nil {
+		t.Fatal(err)
+	}
+	if l := label(&h1); l != "" {
+		t.Errorf("label of h1 is %q after deletion", l)
+	}
+	if l := label(&h2); l != "second" {
+		t.Errorf("label of h2 is %q, want %q", l, "second")
+	}
+
+	// Labels are limited to MaxTxLabelLen bytes.
+	if err := put(&h2, strings.Repeat("x", MaxTxLabelLen)); err != nil {
+		t.Errorf("label of maximum length: %v", err)
+	}
+	err = put(&h2, strings.Repeat("x", MaxTxLabelLen+1))
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("label exceeding maximum length: expected Invalid error, got %v", err)
+	}
+	if l := label(&h2); len(l) != MaxTxLabelLen {
+		t.Errorf("rejected label modified the recorded label")
+	}
+}
+
+func TestTxLabelsUpgrade(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	params := chaincfg.TestNet3Params()
+	err := Initialize(ctx, db, params, seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Revert the database to version 31, before the labels bucket existed.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		err := dbtx.DeleteTopLevelBucket(txLabelsBucketKey)
+		if err != nil {
+			return err
+		}
+		metadataBucket := dbtx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+		return unifiedDBMetadata{}.putVersion(metadataBucket, txLabelsVersion-1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return txLabelsUpgrade(dbtx, pubPass, params)
+	})
+	if err != nil {
+		t.Fatalf("upgrade failed: %v", err)
+	}
+
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		metadataBucket := dbtx.ReadBucket(unifiedDBMetadata{}.rootBucketKey())
+		version, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+		if err != nil {
+			return err
+		}
+		if version != txLabelsVersion {
+			t.Errorf("upgraded database version is %d, want %d",
+				version, txLabelsVersion)
+		}
+		if dbtx.ReadBucket(txLabelsBucketKey) == nil {
+			t.Errorf("labels bucket was not created")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The upgrade must refuse to run on any other version.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return txLabelsUpgrade(dbtx, pubPass, params)
+	})
+	if err == nil {
+		t.Errorf("upgrade of version %d database did not error", txLabelsVersion)
+	}
+}
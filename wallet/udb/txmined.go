@@ -390,6 +390,46 @@ func (s *Store) UpdateProcessedTxsBlockMarker(dbtx walletdb.ReadWriteTx, hash *c
 	return nil
 }
 
+// RewindProcessedTxsBlockMarker moves the marker of the final block for which
+// all transactions have been processed back to hash, causing transactions of
+// all later blocks to be processed again by the next rescan.  Hash must
+// describe a main chain block.  This does not modify the database if hash has
+// a greater block height than the main chain fork point of the existing
+// marker.
+func (s *Store) RewindProcessedTxsBlockMarker(dbtx walletdb.ReadWriteTx, hash *chainhash.Hash) error {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	prev := s.ProcessedTxsBlockMarker(dbtx)
+	for {
+		mainChain, _ := s.BlockInMainChain(dbtx, prev)
+		if mainChain {
+			break
+		}
+		h, err := s.GetBlockHeader(dbtx, prev)
+		if err != nil {
+			return err
+		}
+		prev = &h.PrevBlock
+	}
+	prevHeader, err := s.GetBlockHeader(dbtx, prev)
+	if err != nil {
+		return err
+	}
+	if mainChain, _ := s.BlockInMainChain(dbtx, hash); !mainChain {
+		return errors.E(errors.Invalid, errors.Errorf("%v is not a main chain block", hash))
+	}
+	header, err := s.GetBlockHeader(dbtx, hash)
+	if err != nil {
+		return err
+	}
+	if header.Height < prevHeader.Height {
+		err := ns.Put(rootLastTxsBlock, hash[:])
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	return nil
+}
+
 // BirthdayState holds fields for setting and reading the birthday block.
 // SetFromHeight and SetFromTime indicate that the birthday block should be set
 // from those respective fields. Upon setting the hash and height are filled in
@@ -461,6 +501,125 @@ func BirthState(dbtx walletdb.ReadTx) *BirthdayState {
 	}
 }
 
+// RescanCheckpoint records the progress of a rescan which has not completed.
+// StartHeight is the height of the first block of the rescan, and Hash and
+// Height describe the last block for which all transactions were rescanned.
+// Started is the time the rescan began.
+type RescanCheckpoint struct {
+	StartHeight int32
+	Hash        chainhash.Hash
+	Height      int32
+	Started     time.Time
+}
+
+// SetRescanCheckpoint records the progress of an incomplete rescan, replacing
+// any existing checkpoint.
+func SetRescanCheckpoint(dbtx walletdb.ReadWriteTx, c *RescanCheckpoint) error {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	v := make([]byte, 4+chainhash.HashSize+4+8)
+	byteOrder.PutUint32(v, uint32(c.StartHeight))
+	copy(v[4:], c.Hash[:])
+	byteOrder.PutUint32(v[4+chainhash.HashSize:], uint32(c.Height))
+	byteOrder.PutUint64(v[4+chainhash.HashSize+4:], uint64(c.Started.Unix()))
+	err := ns.Put(rootRescanCheckpoint, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// LastRescanCheckpoint returns the progress of an incomplete rescan, or nil if
+// no rescan was interrupted before completing.
+func LastRescanCheckpoint(dbtx walletdb.ReadTx) *RescanCheckpoint {
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+	v := ns.Get(rootRescanCheckpoint)
+	if len(v) != 4+chainhash.HashSize+4+8 {
+		return nil
+	}
+	c := &RescanCheckpoint{
+		StartHeight: int32(byteOrder.Uint32(v)),
+		Height:      int32(byteOrder.Uint32(v[4+chainhash.HashSize:])),
+		Started:     time.Unix(int64(byteOrder.Uint64(v[4+chainhash.HashSize+4:])), 0),
+	}
+	copy(c.Hash[:], v[4:])
+	return c
+}
+
+// DeleteRescanCheckpoint removes the checkpoint of a completed rescan.
+func DeleteRescanCheckpoint(dbtx walletdb.ReadWriteTx) error {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	if ns.Get(rootRescanCheckpoint) == nil {
+		return nil
+	}
+	err := ns.Delete(rootRescanCheckpoint)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// DiscoveryCheckpoint records the progress of an address discovery which has
+// not completed.  StartBlock is the first block searched for address usage.
+// AccountsDiscovered is true once used accounts have been discovered and
+// created, and Accounts lists the accounts for which all discovered addresses
+// have been recorded.
+type DiscoveryCheckpoint struct {
+	StartBlock         chainhash.Hash
+	AccountsDiscovered bool
+	Accounts           []uint32
+}
+
+// SetDiscoveryCheckpoint records the progress of an incomplete address
+// discovery, replacing any existing checkpoint.
+func SetDiscoveryCheckpoint(dbtx walletdb.ReadWriteTx, c *DiscoveryCheckpoint) error {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	v := make([]byte, chainhash.HashSize+1+4*len(c.Accounts))
+	copy(v, c.StartBlock[:])
+	if c.AccountsDiscovered {
+		v[chainhash.HashSize] = 1
+	}
+	for i, acct := range c.Accounts {
+		byteOrder.PutUint32(v[chainhash.HashSize+1+4*i:], acct)
+	}
+	err := ns.Put(rootDiscoveryCheckpoint, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// LastDiscoveryCheckpoint returns the progress of an incomplete address
+// discovery, or nil if no discovery was interrupted before completing.
+func LastDiscoveryCheckpoint(dbtx walletdb.ReadTx) *DiscoveryCheckpoint {
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+	v := ns.Get(rootDiscoveryCheckpoint)
+	if len(v) < chainhash.HashSize+1 || (len(v)-chainhash.HashSize-1)%4 != 0 {
+		return nil
+	}
+	c := &DiscoveryCheckpoint{
+		AccountsDiscovered: v[chainhash.HashSize] == 1,
+	}
+	copy(c.StartBlock[:], v)
+	for off := chainhash.HashSize + 1; off < len(v); off += 4 {
+		c.Accounts = append(c.Accounts, byteOrder.Uint32(v[off:]))
+	}
+	return c
+}
+
+// DeleteDiscoveryCheckpoint removes the checkpoint of a completed address
+// discovery.
+func DeleteDiscoveryCheckpoint(dbtx walletdb.ReadWriteTx) error {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	if ns.Get(rootDiscoveryCheckpoint) == nil {
+		return nil
+	}
+	err := ns.Delete(rootDiscoveryCheckpoint)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
 // IsMissingMainChainCFilters returns whether all compact filters for main chain
 // blocks have been recorded to the database after the upgrade which began to
 // require them to extend the main chain.  If compact filters are missing, they
@@ -1345,6 +1504,13 @@ func (s *Store) InsertMinedTx(dbtx walletdb.ReadWriteTx, rec *TxRecord, blockHas
 		return errors.E(errors.Invalid, "mined transactions must be added to main chain blocks")
 	}
 
+	// A mined transaction is no longer conflicted, e.g. when a reorg
+	// removed the transaction which double spent it.
+	err := deleteTxConflict(dbtx, &rec.Hash)
+	if err != nil {
+		return err
+	}
+
 	// Fetch the mined balance in case we need to update it.
 	minedBalance, err := fetchMinedBalance(ns)
 	if err != nil {
@@ -1481,7 +1647,7 @@ func (s *Store) InsertMinedTx(dbtx walletdb.ReadWriteTx, rec *TxRecord, blockHas
 	// from the unconfirmed set.  This also handles removing unconfirmed
 	// transaction spend chains if any other unconfirmed transactions spend
 	// outputs of the removed double spend.
-	err = s.removeDoubleSpends(ns, rec)
+	err = s.removeDoubleSpends(dbtx, ns, rec)
 	if err != nil {
 		return err
 	}
@@ -2107,7 +2273,7 @@ func (s *Store) Rollback(dbtx walletdb.ReadWriteTx, height int32) error {
 		return err
 	}
 
-	// Keep track of all credits that were removed from coinbase
+	// Keep track of all credits that were removed from coinbase-class
 	// transactions.  After detaching all blocks, if any transaction record
 	// exists in unmined that spends these outputs, remove them and their
 	// spend chains.
@@ -2128,7 +2294,7 @@ func (s *Store) Rollback(dbtx walletdb.ReadWriteTx, height int32) error {
 
 		heightsToRemove = append(heightsToRemove, it.elem.Height)
 
-		log.Debugf("Rolling back transactions from block %v height %d",
+		logTx(dbtx).Debugf("Rolling back transactions from block %v height %d",
 			b.Hash, b.Height)
 
 		// cache the values of removed credits so they can be inspected even
@@ -2151,55 +2317,16 @@ func (s *Store) Rollback(dbtx walletdb.ReadWriteTx, height int32) error {
 				return err
 			}
 
-			// Handle coinbase transactions specially since they are
-			// not moved to the unconfirmed store.  A coinbase cannot
-			// contain any debits, but all credits should be removed
-			// and the mined balance decremented.
-			if compat.IsEitherCoinBaseTx(&rec.MsgTx) {
-				for i, output := range rec.MsgTx.TxOut {
-					k, v := existsCredit(ns, &rec.Hash,
-						uint32(i), &b.Block)
-					if v == nil {
-						continue
-					}
-
-					coinBaseCredits = append(coinBaseCredits, wire.OutPoint{
-						Hash:  rec.Hash,
-						Index: uint32(i),
-						Tree:  wire.TxTreeRegular,
-					})
-
-					outPointKey := canonicalOutPoint(&rec.Hash, uint32(i))
-					credKey := existsRawUnspent(ns, outPointKey, s.chainParams)
-					if credKey != nil {
-						minedBalance -= dcrutil.Amount(output.Value)
-						// Get the coinType from the credit value (v)
-						coinType := fetchRawCreditCoinType(v)
-						err = deleteRawUnspent(ns, outPointKey, coinType)
-						if err != nil {
-							return err
-						}
-					}
-					removedCredits[string(k)] = v
-					err = deleteRawCredit(ns, k)
-					if err != nil {
-						return err
-					}
-
-					// Check if this output is a multisignature
-					// P2SH output. If it is, access the value
-					// for the key and mark it unmined.
-					msKey := keyMultisigOut(*txHash, uint32(i))
-					msVal := existsMultisigOutCopy(ns, msKey)
-					if msVal != nil {
-						setMultisigOutUnmined(msVal)
-						err := putMultisigOutRawValues(ns, msKey, msVal)
-						if err != nil {
-							return err
-						}
-					}
+			// Coinbase-class transactions are bound to the block which
+			// mined them and are not moved to the unconfirmed store.
+			if isCoinbaseClassTx(&rec.MsgTx) {
+				credits, delta, err := s.rollbackCoinbaseClassTx(ns, &rec,
+					&b.Block, removedCredits)
+				if err != nil {
+					return err
 				}
-
+				coinBaseCredits = append(coinBaseCredits, credits...)
+				minedBalance += delta
 				continue
 			}
 
@@ -2232,86 +2359,12 @@ func (s *Store) Rollback(dbtx walletdb.ReadWriteTx, height int32) error {
 				// If this input is a debit, remove the debit
 				// record and mark the credit that it spent as
 				// unspent, incrementing the mined balance.
-				debKey, credKey, err := existsDebit(ns,
-					&rec.Hash, uint32(i), &b.Block)
+				amt, err := s.rollbackDebit(ns, &rec, uint32(i),
+					&b.Block, removedCredits)
 				if err != nil {
 					return err
 				}
-				if debKey == nil {
-					continue
-				}
-
-				// Store the credit OP code for later use.  Since the credit may
-				// already have been removed if it also appeared in this block,
-				// a cache of removed credits is also checked.
-				credVal := existsRawCredit(ns, credKey)
-				if credVal == nil {
-					credVal = removedCredits[string(credKey)]
-				}
-				if credVal == nil {
-					return errors.E(errors.IO, errors.Errorf("missing credit "+
-						"%v, key %x, spent by %v", prevOut, credKey, &rec.Hash))
-				}
-				creditOpCode := fetchRawCreditTagOpCode(credVal)
-
-				// unspendRawCredit does not error in case the no credit exists
-				// for this key, but this behavior is correct.  Since
-				// transactions are removed in an unspecified order
-				// (transactions in the blo	ck record are not sorted by
-				// appearance in the block), this credit may have already been
-				// removed.
-				var amt dcrutil.Amount
-				amt, err = unspendRawCredit(ns, credKey)
-				if err != nil {
-					return err
-				}
-
-				err = deleteRawDebit(ns, debKey)
-				if err != nil {
-					return err
-				}
-
-				// If the credit was previously removed in the
-				// rollback, the credit amount is zero.  Only
-				// mark the previously spent credit as unspent
-				// if it still exists.
-				if amt == 0 {
-					continue
-				}
-				unspentVal, err := fetchRawCreditUnspentValue(credKey)
-				if err != nil {
-					return err
-				}
-
-				// Get coin type from the credit
-				coinType := fetchRawCreditCoinType(credVal)
-
-				// Ticket output spends are never decremented, so no need
-				// to add them back.
-				if !(creditOpCode == txscript.OP_SSTX) {
-					minedBalance += amt
-				}
-
-				err = putRawUnspent(ns, prevOutKey, unspentVal, coinType)
-				if err != nil {
-					return err
-				}
-
-				// Check if this input uses a multisignature P2SH
-				// output. If it did, mark the output unspent
-				// and create an entry in the unspent bucket.
-				msVal := existsMultisigOutCopy(ns, prevOutKey)
-				if msVal != nil {
-					setMultisigOutUnSpent(msVal)
-					err := putMultisigOutRawValues(ns, prevOutKey, msVal)
-					if err != nil {
-						return err
-					}
-					err = putMultisigOutUS(ns, prevOutKey)
-					if err != nil {
-						return err
-					}
-				}
+				minedBalance += amt
 			}
 
 			// For each detached non-coinbase credit, move the
@@ -2435,7 +2488,7 @@ func (s *Store) Rollback(dbtx walletdb.ReadWriteTx, height int32) error {
 				return err
 			}
 
-			log.Debugf("Transaction %v spends a removed coinbase "+
+			logTx(dbtx).Debugf("Transaction %v spends a removed coinbase-class "+
 				"output -- removing as well", unminedRec.Hash)
 			err = s.RemoveUnconfirmed(ns, &unminedRec.MsgTx, &unminedRec.Hash)
 			if err != nil {
@@ -2449,6 +2502,21 @@ func (s *Store) Rollback(dbtx walletdb.ReadWriteTx, height int32) error {
 		return err
 	}
 
+	// Stake and fee statistics and SKA emissions of removed blocks are
+	// recorded again as the transactions are mined in the new main chain.
+	err = deleteStakeStatsFrom(dbtx, height)
+	if err != nil {
+		return err
+	}
+	err = deleteFeeStatsFrom(dbtx, height)
+	if err != nil {
+		return err
+	}
+	err = deleteSKAEmissionsFrom(dbtx, height)
+	if err != nil {
+		return err
+	}
+
 	// Mark block hash for height-1 as the new main chain tip.
 	_, newTipBlockRecord := existsBlockRecord(ns, height-1)
 	newTipHash := extractRawBlockRecordHash(newTipBlockRecord)
@@ -2460,6 +2528,187 @@ func (s *Store) Rollback(dbtx walletdb.ReadWriteTx, height int32) error {
 	return nil
 }
 
+// isCoinbaseClassTx returns whether tx creates outputs from the block which
+// mines it, and can not be mined in any other block.  These are coinbases and
+// SSFee transactions distributing the block's fees, including augmented SSFee
+// transactions which also spend a previous SSFee output.
+func isCoinbaseClassTx(tx *wire.MsgTx) bool {
+	if compat.IsEitherCoinBaseTx(tx) || isSSFeeTx(tx) {
+		return true
+	}
+	return len(tx.TxOut) != 0 &&
+		stake.HasSSFeeMarker(tx.TxOut[0].PkScript) != stake.SSFeeMarkerNone
+}
+
+// rollbackCoinbaseClassTx is the reorg hook for coinbase-class transactions
+// removed from block by a rollback.  Since these transactions can not be
+// mined in another block, they are not moved to the unconfirmed store.  The
+// debits of augmented SSFee transactions are removed and the credits they spent
+// are marked unspent, and every credit of the transaction is removed.  The
+// outpoints of the removed credits are returned so unmined transactions
+// spending them can be removed after the rollback, along with the change to the
+// mined balance.
+func (s *Store) rollbackCoinbaseClassTx(ns walletdb.ReadWriteBucket, rec *TxRecord,
+	block *Block, removedCredits map[string][]byte) ([]wire.OutPoint, dcrutil.Amount, error) {
+
+	var credits []wire.OutPoint
+	var delta dcrutil.Amount
+
+	for i := range rec.MsgTx.TxIn {
+		amt, err := s.rollbackDebit(ns, rec, uint32(i), block, removedCredits)
+		if err != nil {
+			return nil, 0, err
+		}
+		delta += amt
+	}
+
+	for i, output := range rec.MsgTx.TxOut {
+		k, v := existsCredit(ns, &rec.Hash, uint32(i), block)
+		if v == nil {
+			continue
+		}
+		vcopy := make([]byte, len(v))
+		copy(vcopy, v)
+		removedCredits[string(k)] = vcopy
+
+		credits = append(credits, wire.OutPoint{
+			Hash:  rec.Hash,
+			Index: uint32(i),
+			Tree:  wire.TxTreeRegular,
+		})
+
+		outPointKey := canonicalOutPoint(&rec.Hash, uint32(i))
+		credKey := existsRawUnspent(ns, outPointKey, s.chainParams)
+		if credKey != nil {
+			delta -= dcrutil.Amount(output.Value)
+			// Get the coinType from the credit value
+			coinType := fetchRawCreditCoinType(vcopy)
+			err := deleteRawUnspent(ns, outPointKey, coinType)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+		err := deleteRawCredit(ns, k)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// Check if this output is a multisignature P2SH output. If it
+		// is, access the value for the key and mark it unmined.
+		msKey := keyMultisigOut(rec.Hash, uint32(i))
+		msVal := existsMultisigOutCopy(ns, msKey)
+		if msVal != nil {
+			setMultisigOutUnmined(msVal)
+			err := putMultisigOutRawValues(ns, msKey, msVal)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+
+	// The cached SSFee marker is keyed by the removed transaction record.
+	err := deleteSSFeeMarker(ns, keyTxRecord(&rec.Hash, block))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(credits) != 0 {
+		log.Debugf("Removed %d credits of coinbase-class transaction %v "+
+			"from rolled back block %v", len(credits), &rec.Hash, &block.Hash)
+	}
+	return credits, delta, nil
+}
+
+// rollbackDebit removes the debit recorded for the input index of a
+// transaction removed from block by a rollback, and marks the credit it spent
+// as unspent.  The amount to add back to the mined balance is returned.
+// Inputs which are not debits are ignored.
+func (s *Store) rollbackDebit(ns walletdb.ReadWriteBucket, rec *TxRecord, index uint32,
+	block *Block, removedCredits map[string][]byte) (dcrutil.Amount, error) {
+
+	prevOut := &rec.MsgTx.TxIn[index].PreviousOutPoint
+	prevOutKey := canonicalOutPoint(&prevOut.Hash, prevOut.Index)
+	debKey, credKey, err := existsDebit(ns, &rec.Hash, index, block)
+	if err != nil {
+		return 0, err
+	}
+	if debKey == nil {
+		return 0, nil
+	}
+
+	// Store the credit OP code for later use.  Since the credit may
+	// already have been removed if it also appeared in this block,
+	// a cache of removed credits is also checked.
+	credVal := existsRawCredit(ns, credKey)
+	if credVal == nil {
+		credVal = removedCredits[string(credKey)]
+	}
+	if credVal == nil {
+		return 0, errors.E(errors.IO, errors.Errorf("missing credit "+
+			"%v, key %x, spent by %v", prevOut, credKey, &rec.Hash))
+	}
+	creditOpCode := fetchRawCreditTagOpCode(credVal)
+
+	// unspendRawCredit does not error in case the no credit exists
+	// for this key, but this behavior is correct.  Since
+	// transactions are removed in an unspecified order
+	// (transactions in the block record are not sorted by
+	// appearance in the block), this credit may have already been
+	// removed.
+	amt, err := unspendRawCredit(ns, credKey)
+	if err != nil {
+		return 0, err
+	}
+
+	err = deleteRawDebit(ns, debKey)
+	if err != nil {
+		return 0, err
+	}
+
+	// If the credit was previously removed in the
+	// rollback, the credit amount is zero.  Only
+	// mark the previously spent credit as unspent
+	// if it still exists.
+	if amt == 0 {
+		return 0, nil
+	}
+	unspentVal, err := fetchRawCreditUnspentValue(credKey)
+	if err != nil {
+		return 0, err
+	}
+
+	// Get coin type from the credit
+	coinType := fetchRawCreditCoinType(credVal)
+
+	err = putRawUnspent(ns, prevOutKey, unspentVal, coinType)
+	if err != nil {
+		return 0, err
+	}
+
+	// Check if this input uses a multisignature P2SH
+	// output. If it did, mark the output unspent
+	// and create an entry in the unspent bucket.
+	msVal := existsMultisigOutCopy(ns, prevOutKey)
+	if msVal != nil {
+		setMultisigOutUnSpent(msVal)
+		err := putMultisigOutRawValues(ns, prevOutKey, msVal)
+		if err != nil {
+			return 0, err
+		}
+		err = putMultisigOutUS(ns, prevOutKey)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// Ticket output spends are never decremented, so no need
+	// to add them back.
+	if creditOpCode == txscript.OP_SSTX {
+		return 0, nil
+	}
+	return amt, nil
+}
+
 // outputCreditInfo fetches information about a credit from the database,
 // fills out a credit struct, and returns it.
 func (s *Store) outputCreditInfo(ns walletdb.ReadBucket, op wire.OutPoint, block *Block) (*Credit, error) {
@@ -2756,95 +3005,182 @@ func (s *Store) RandomUTXO(dbtx walletdb.ReadTx, minConf, syncHeight int32, coin
 
 // UnspentOutputs returns all unspent received transaction outputs for the specified coin type.
 // The order is undefined.
+//
+// Callers which do not require every output at once should use
+// ForEachUnspentOutput instead, which does not hold all outputs in memory.
 func (s *Store) UnspentOutputs(dbtx walletdb.ReadTx, coinType cointype.CoinType) ([]*Credit, error) {
-	ns := dbtx.ReadBucket(wtxmgrBucketKey)
 	var unspent []*Credit
+	err := s.ForEachUnspentOutput(dbtx, coinType, func(c *Credit) error {
+		unspent = append(unspent, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	var op wire.OutPoint
-	var block Block
+	logTx(dbtx).Tracef("%v many utxos found in database", len(unspent))
 
-	// Use specific coin type buckets only
-	buckets := [][]byte{bucketUnspentForCoinType(coinType)}
-	unminedBuckets := [][]byte{bucketUnminedCreditsForCoinType(coinType)}
+	return unspent, nil
+}
 
-	// Iterate through mined unspent outputs
-	for _, bucketName := range buckets {
-		bucket := ns.NestedReadBucket(bucketName)
-		if bucket == nil {
-			continue
-		}
+// UnspentOutputCursor records the position of an iteration over unspent
+// outputs, allowing it to be resumed in a later database transaction.  The
+// zero value begins with the first output.
+type UnspentOutputCursor struct {
+	unmined bool
+	key     []byte // last visited key, nil before the first
+	done    bool
+}
+
+// Done returns whether the iteration has visited every unspent output.
+func (c *UnspentOutputCursor) Done() bool {
+	return c.done
+}
+
+// ForEachUnspentOutput calls f with each unspent received transaction output
+// of the specified coin type.  Mined outputs are visited before unmined
+// outputs.  Iteration stops with the first error returned by f, which is
+// returned to the caller.
+func (s *Store) ForEachUnspentOutput(dbtx walletdb.ReadTx, coinType cointype.CoinType, f func(*Credit) error) error {
+	var cursor UnspentOutputCursor
+	return s.ForEachUnspentOutputFrom(dbtx, coinType, &cursor, 0, f)
+}
 
+// ForEachUnspentOutputFrom continues an iteration over unspent outputs of the
+// specified coin type from the position recorded by cursor, calling f with at
+// most limit outputs, or all remaining outputs if limit is not positive.  The
+// cursor is advanced past every output visited, so a later call in a new
+// database transaction resumes where this one stopped.  Outputs added or
+// spent between calls may or may not be visited.
+func (s *Store) ForEachUnspentOutputFrom(dbtx walletdb.ReadTx, coinType cointype.CoinType,
+	cursor *UnspentOutputCursor, limit int, f func(*Credit) error) error {
+
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+	n := 0
+	var op wire.OutPoint
+	var block Block
+
+	// visit iterates over the keys of a bucket following the cursor's
+	// position, calling fn with each.  It returns false when the limit is
+	// reached before the end of the bucket.
+	visit := func(bucket walletdb.ReadBucket, fn func(k, v []byte) error) (bool, error) {
 		c := bucket.ReadCursor()
-		for k, v := c.First(); k != nil; k, v = c.Next() {
-			err := readCanonicalOutPoint(k, &op)
-			if err != nil {
-				c.Close()
-				return nil, err
+		defer c.Close()
+		var k, v []byte
+		if cursor.key == nil {
+			k, v = c.First()
+		} else {
+			k, v = c.Seek(cursor.key)
+			if bytes.Equal(k, cursor.key) {
+				k, v = c.Next()
 			}
+		}
+		for ; k != nil; k, v = c.Next() {
+			if limit > 0 && n == limit {
+				return false, nil
+			}
+			cursor.key = append(cursor.key[:0], k...)
 			if existsRawUnminedInput(ns, k) != nil {
 				// Output is spent by an unmined transaction.
-				// Skip this k/v pair.
 				continue
 			}
-
-			err = readUnspentBlock(v, &block)
-			if err != nil {
-				c.Close()
-				return nil, err
+			if err := fn(k, v); err != nil {
+				return false, err
 			}
+		}
+		return true, nil
+	}
 
-			cred, err := s.outputCreditInfo(ns, op, &block)
-			if err != nil {
-				c.Close()
-				return nil, err
+	if !cursor.unmined {
+		// Iterate through mined unspent outputs
+		bucket := ns.NestedReadBucket(bucketUnspentForCoinType(coinType))
+		if bucket != nil {
+			complete, err := visit(bucket, func(k, v []byte) error {
+				err := readCanonicalOutPoint(k, &op)
+				if err != nil {
+					return err
+				}
+				err = readUnspentBlock(v, &block)
+				if err != nil {
+					return err
+				}
+				cred, err := s.outputCreditInfo(ns, op, &block)
+				if err != nil {
+					return err
+				}
+				n++
+				return f(cred)
+			})
+			if err != nil || !complete {
+				return err
 			}
-
-			unspent = append(unspent, cred)
 		}
-		c.Close()
+		cursor.unmined = true
+		cursor.key = nil
 	}
 
 	// Iterate through unmined credits
-	for _, bucketName := range unminedBuckets {
-		bucket := ns.NestedReadBucket(bucketName)
-		if bucket == nil {
-			continue
-		}
-
-		c := bucket.ReadCursor()
-		for k, _ := c.First(); k != nil; k, _ = c.Next() {
-			if existsRawUnminedInput(ns, k) != nil {
-				// Output is spent by an unmined transaction.
-				// Skip to next unmined credit.
-				continue
-			}
-
+	bucket := ns.NestedReadBucket(bucketUnminedCreditsForCoinType(coinType))
+	if bucket != nil {
+		complete, err := visit(bucket, func(k, v []byte) error {
 			// Skip outputs from unpublished transactions.
 			txHash := k[:32]
 			if existsUnpublished(ns, txHash) {
-				continue
+				return nil
 			}
-
 			err := readCanonicalOutPoint(k, &op)
 			if err != nil {
-				c.Close()
-				return nil, err
+				return err
 			}
-
 			cred, err := s.outputCreditInfo(ns, op, nil)
 			if err != nil {
-				c.Close()
-				return nil, err
+				return err
 			}
-
-			unspent = append(unspent, cred)
+			n++
+			return f(cred)
+		})
+		if err != nil || !complete {
+			return err
 		}
-		c.Close()
 	}
+	cursor.done = true
+	return nil
+}
 
-	log.Tracef("%v many utxos found in database", len(unspent))
+// UnspentSSFeeOutPoints returns the outpoints of all mined unspent outputs of
+// a coin type which were created by SSFee transactions.  Maturity is not
+// checked; input sources already skip immature stake outputs.
+func (s *Store) UnspentSSFeeOutPoints(dbtx walletdb.ReadTx, coinType cointype.CoinType) ([]wire.OutPoint, error) {
+	const op errors.Op = "udb.UnspentSSFeeOutPoints"
 
-	return unspent, nil
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+	b := ns.NestedReadBucket(bucketUnspentForCoinType(coinType))
+	if b == nil {
+		return nil, nil
+	}
+	var outpoints []wire.OutPoint
+	err := b.ForEach(func(k, v []byte) error {
+		cKey := make([]byte, 72)
+		copy(cKey[0:32], k[0:32])   // Tx hash
+		copy(cKey[32:36], v[0:4])   // Block height
+		copy(cKey[36:68], v[4:36])  // Block hash
+		copy(cKey[68:72], k[32:36]) // Output index
+		if getSSFeeMarkerType(ns, cKey) == stake.SSFeeMarkerNone {
+			return nil
+		}
+		var outpoint wire.OutPoint
+		err := readCanonicalOutPoint(k, &outpoint)
+		if err != nil {
+			return err
+		}
+		outpoint.Tree = wire.TxTreeStake
+		outpoints = append(outpoints, outpoint)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return outpoints, nil
 }
 
 // UnspentOutput returns details for an unspent received transaction output.
@@ -2997,6 +3333,126 @@ func (s *Store) ForEachUnspentOutpoint(dbtx walletdb.ReadTx, coinType *cointype.
 	return nil
 }
 
+// ReceivedOutput describes an output received by the wallet, whether spent or
+// unspent.
+type ReceivedOutput struct {
+	PkScript  []byte
+	Height    int32 // -1 if unmined
+	CoinType  cointype.CoinType
+	Amount    dcrutil.Amount
+	SKAAmount cointype.SKAAmount // Set for SKA coin types
+	Spent     bool
+}
+
+// ForEachReceivedOutput calls f with every output received by the wallet from
+// the credits indexes, including spent outputs, so address usage can be
+// reported without rescanning the chain.  Mined outputs are visited before
+// unmined outputs, and outputs spent by unmined transactions are reported as
+// spent.  Outputs of unpublished transactions are skipped.  Iteration stops
+// with the first error returned by f, which is returned to the caller.
+func (s *Store) ForEachReceivedOutput(dbtx walletdb.ReadTx, f func(*ReceivedOutput) error) error {
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+
+	err := func() error {
+		c := ns.NestedReadBucket(bucketCredits).ReadCursor()
+		defer c.Close()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if len(k) < creditKeySize {
+				return errors.E(errors.IO, errors.Errorf("credit key len %d", len(k)))
+			}
+			amount, spent, err := fetchRawCreditAmountSpent(v)
+			if err != nil {
+				return err
+			}
+			recK := extractRawCreditTxRecordKey(k)
+			recV := existsRawTxRecord(ns, recK)
+			if recV == nil {
+				return errors.E(errors.IO, errors.Errorf("missing "+
+					"transaction record for credit %x", k))
+			}
+			index := extractRawCreditIndex(k)
+			pkScript, err := fetchRawTxRecordPkScript(recK, recV, index,
+				fetchRawCreditScriptOffset(v), fetchRawCreditScriptLength(v))
+			if err != nil {
+				return err
+			}
+			txHash := extractRawCreditTxHash(k)
+			if !spent && existsRawUnminedInput(ns, canonicalOutPoint(&txHash, index)) != nil {
+				spent = true
+			}
+			out := &ReceivedOutput{
+				PkScript:  pkScript,
+				Height:    extractRawCreditHeight(k),
+				CoinType:  fetchRawCreditCoinType(v),
+				Amount:    amount,
+				SKAAmount: cointype.Zero(),
+				Spent:     spent,
+			}
+			if out.CoinType.IsSKA() {
+				out.SKAAmount = fetchSKACreditAmount(ns, k)
+			}
+			if err := f(out); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		return err
+	}
+
+	for _, ct := range s.getAllActiveCoinTypes() {
+		bucket := ns.NestedReadBucket(bucketUnminedCreditsForCoinType(ct))
+		if bucket == nil {
+			continue
+		}
+		err := bucket.ForEach(func(k, v []byte) error {
+			var op wire.OutPoint
+			err := readCanonicalOutPoint(k, &op)
+			if err != nil {
+				return err
+			}
+			if existsUnpublished(ns, op.Hash[:]) {
+				return nil
+			}
+			amount, err := fetchRawUnminedCreditAmount(v)
+			if err != nil {
+				return err
+			}
+			recV := existsRawUnmined(ns, op.Hash[:])
+			if recV == nil {
+				return errors.E(errors.IO, errors.Errorf("missing "+
+					"unmined transaction %v", &op.Hash))
+			}
+			var tx wire.MsgTx
+			err = tx.Deserialize(bytes.NewReader(extractRawUnminedTx(recV)))
+			if err != nil {
+				return errors.E(errors.IO, err)
+			}
+			if op.Index >= uint32(len(tx.TxOut)) {
+				return errors.E(errors.IO, errors.Errorf("no output %d "+
+					"for tx %v", op.Index, &op.Hash))
+			}
+			out := &ReceivedOutput{
+				PkScript:  tx.TxOut[op.Index].PkScript,
+				Height:    -1,
+				CoinType:  fetchRawUnminedCreditCoinType(v),
+				Amount:    amount,
+				SKAAmount: cointype.Zero(),
+				Spent:     existsRawUnminedInput(ns, k) != nil,
+			}
+			if out.CoinType.IsSKA() {
+				out.SKAAmount = fetchSKAUnminedCreditAmount(ns, k)
+			}
+			return f(out)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // IsUnspentOutpoint returns whether the outpoint is recorded as a wallet UTXO.
 func (s *Store) IsUnspentOutpoint(dbtx walletdb.ReadTx, op *wire.OutPoint) bool {
 	ns := dbtx.ReadBucket(wtxmgrBucketKey)
@@ -3445,7 +3901,7 @@ func (s *Store) MakeInputSourceWithCoinType(dbtx walletdb.ReadTx, account uint32
 	}
 
 	if minConf != 0 {
-		log.Debugf("Unspent bucket k/v count for coin type %d: %v", coinType, numUnspent)
+		logTx(dbtx).Debugf("Unspent bucket k/v count for coin type %d: %v", coinType, numUnspent)
 	}
 
 	skip := func(k, v []byte) bool {
@@ -3478,7 +3934,7 @@ func (s *Store) MakeInputSourceWithCoinType(dbtx walletdb.ReadTx, account uint32
 				}
 			} else if remainingKeys == nil {
 				if randTries > 0 {
-					log.Debugf("Abandoned random UTXO selection "+
+					logTx(dbtx).Debugf("Abandoned random UTXO selection "+
 						"attempts after %v tries", randTries)
 				}
 				// All remaining keys not discovered by the
@@ -3732,20 +4188,15 @@ func (s *Store) MakeInputSourceWithCoinType(dbtx walletdb.ReadTx, account uint32
 			}
 
 			// Unspent credits are currently expected to be either P2PKH or
-			// P2PK, P2PKH/P2SH nested in a revocation/stakechange/vote output.
-			// Ignore stake P2SH since it can pay to any script, which the
-			// wallet may not recognize.
-			var scriptSize int
+			// P2PK with ECDSA or Schnorr signatures, or P2PKH/P2SH nested
+			// in a revocation/stakechange/vote output.  Stake-tagged
+			// outputs are redeemed by the same signature scripts as the
+			// untagged scripts they nest, so they are sized by their sub
+			// script type.  Any other script type is ignored.
 			scriptClass := stdscript.DetermineScriptType(scriptVersionAssumed, pkScript)
 			scriptSubClass, _ := txrules.StakeSubScriptType(scriptClass)
-			switch scriptSubClass {
-			case stdscript.STPubKeyHashEcdsaSecp256k1:
-				scriptSize = txsizes.RedeemP2PKHSigScriptSize
-			case stdscript.STPubKeyEcdsaSecp256k1:
-				scriptSize = txsizes.RedeemP2PKSigScriptSize
-			case stdscript.STScriptHash:
-				scriptSize = txsizes.RedeemP2SHSigScriptSize
-			default:
+			scriptSize := txsizes.RedeemSigScriptSize(scriptSubClass)
+			if scriptSize == 0 {
 				continue
 			}
 
@@ -3935,7 +4386,7 @@ func (s *Store) balanceFullScan(dbtx walletdb.ReadTx, minConf int32, syncHeight
 					ab.Total += utxoAmt
 				}
 			default:
-				log.Warnf("Unhandled opcode: %v", opcode)
+				logTx(dbtx).Warnf("Unhandled opcode: %v", opcode)
 			}
 
 			// Store updated coin balance back to map
@@ -4056,7 +4507,7 @@ func (s *Store) balanceFullScan(dbtx walletdb.ReadTx, minConf int32, syncHeight
 				coinBalance.SKATotal = coinBalance.SKATotal.Add(skaAmt)
 			default:
 				// Skip VAR-specific opcodes for SKA coins (staking, coinbase, etc.)
-				log.Warnf("Unexpected opcode %v for SKA coin type %v", opcode, coinType)
+				logTx(dbtx).Warnf("Unexpected opcode %v for SKA coin type %v", opcode, coinType)
 			}
 
 			// Store updated coin balance back to map
@@ -4158,7 +4609,7 @@ func (s *Store) balanceFullScan(dbtx walletdb.ReadTx, minConf int32, syncHeight
 			case txscript.OP_TGEN:
 				// Only consider mined tspends for simpler balance accounting.
 			default:
-				log.Warnf("Unhandled unconfirmed opcode %v: %v", opcode, v)
+				logTx(dbtx).Warnf("Unhandled unconfirmed opcode %v: %v", opcode, v)
 			}
 
 			ab.CoinTypeBalances[coinType] = coinBalance
@@ -4243,7 +4694,7 @@ func (s *Store) balanceFullScan(dbtx walletdb.ReadTx, minConf int32, syncHeight
 				coinBalance.Total += utxoAmt
 				coinBalance.SKATotal = coinBalance.SKATotal.Add(skaAmt)
 			default:
-				log.Warnf("Unhandled unmined SKA opcode %v for coin type %v", opcode, coinType)
+				logTx(dbtx).Warnf("Unhandled unmined SKA opcode %v for coin type %v", opcode, coinType)
 				coinBalance.Total += utxoAmt
 				coinBalance.SKATotal = coinBalance.SKATotal.Add(skaAmt)
 			}
@@ -4502,7 +4953,7 @@ func (s *Store) AccountBalanceByCoinType(dbtx walletdb.ReadTx, minConf int32, ac
 				}
 
 			default:
-				log.Warnf("Unhandled opcode: %v", opcode)
+				logTx(dbtx).Warnf("Unhandled opcode: %v", opcode)
 			}
 		}
 		c.Close()
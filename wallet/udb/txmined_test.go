@@ -6,6 +6,7 @@ package udb
 
 import (
 	"context"
+	"slices"
 	"testing"
 	"time"
 
@@ -152,3 +153,103 @@ func TestSetBirthState(t *testing.T) {
 		})
 	}
 }
+
+func TestRescanCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	db, _, _, teardown, err := cloneDB(ctx, "mgr_watching_only.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkpoint := func() *RescanCheckpoint {
+		var c *RescanCheckpoint
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			c = LastRescanCheckpoint(dbtx)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	if c := checkpoint(); c != nil {
+		t.Fatalf("unexpected checkpoint %+v", c)
+	}
+
+	want := &RescanCheckpoint{
+		StartHeight: 100,
+		Hash:        randomHash(),
+		Height:      2099,
+		Started:     time.Unix(rand.Int64N(100000000), 0),
+	}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return SetRescanCheckpoint(dbtx, want)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := checkpoint(); got == nil || *got != *want {
+		t.Fatalf("want checkpoint %+v, got %+v", want, got)
+	}
+
+	err = walletdb.Update(ctx, db, DeleteRescanCheckpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := checkpoint(); c != nil {
+		t.Fatalf("checkpoint %+v remains after deletion", c)
+	}
+}
+
+func TestDiscoveryCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	db, _, _, teardown, err := cloneDB(ctx, "mgr_watching_only.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkpoint := func() *DiscoveryCheckpoint {
+		var c *DiscoveryCheckpoint
+		err := walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+			c = LastDiscoveryCheckpoint(dbtx)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	if c := checkpoint(); c != nil {
+		t.Fatalf("unexpected checkpoint %+v", c)
+	}
+
+	want := &DiscoveryCheckpoint{
+		StartBlock:         randomHash(),
+		AccountsDiscovered: true,
+		Accounts:           []uint32{0, 2, 1},
+	}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return SetDiscoveryCheckpoint(dbtx, want)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := checkpoint()
+	if got == nil || got.StartBlock != want.StartBlock ||
+		got.AccountsDiscovered != want.AccountsDiscovered ||
+		!slices.Equal(got.Accounts, want.Accounts) {
+		t.Fatalf("want checkpoint %+v, got %+v", want, got)
+	}
+
+	err = walletdb.Update(ctx, db, DeleteDiscoveryCheckpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := checkpoint(); c != nil {
+		t.Fatalf("checkpoint %+v remains after deletion", c)
+	}
+}
@@ -7,7 +7,9 @@ package udb
 
 import (
 	"bytes"
+	"cmp"
 	"context"
+	"slices"
 
 	"github.com/monetarium/monetarium-wallet/errors"
 	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
@@ -121,21 +123,46 @@ func (s *Store) unminedTxDetails(ns walletdb.ReadBucket, txHash *chainhash.Hash,
 	if err != nil {
 		return nil, err
 	}
+	err = s.readUnminedCreditsDebits(ns, &details)
+	if err != nil {
+		return nil, err
+	}
+	return &details, nil
+}
 
-	it := makeReadUnminedCreditIterator(ns, txHash, DBVersion)
-	defer it.close()
-	for it.next() {
-		if int(it.elem.Index) >= len(details.MsgTx.TxOut) {
-			return nil, errors.E(errors.IO, errors.Errorf("credit output index %d does not exist for tx %v", it.elem.Index, txHash))
-		}
+// readUnminedCreditsDebits appends the credits and debits of the unmined
+// transaction record of details to details.
+func (s *Store) readUnminedCreditsDebits(ns walletdb.ReadBucket, details *TxDetails) error {
+	txHash := &details.Hash
+
+	// Unmined credits are recorded in the bucket of their coin type, so
+	// the bucket of each coin type paid by the transaction is read.
+	var coinTypes []cointype.CoinType
+	for _, out := range details.MsgTx.TxOut {
+		if !slices.Contains(coinTypes, out.CoinType) {
+			coinTypes = append(coinTypes, out.CoinType)
+		}
+	}
+	for _, ct := range coinTypes {
+		it := makeReadUnminedCreditIterator(ns, txHash, ct, DBVersion)
+		for it.next() {
+			if int(it.elem.Index) >= len(details.MsgTx.TxOut) {
+				it.close()
+				return errors.E(errors.IO, errors.Errorf("credit output index %d does not exist for tx %v", it.elem.Index, txHash))
+			}
 
-		// Set the Spent field since this is not done by the iterator.
-		it.elem.Spent = existsRawUnminedInput(ns, it.ck) != nil
-		details.Credits = append(details.Credits, it.elem)
-	}
-	if it.err != nil {
-		return nil, it.err
+			// Set the Spent field since this is not done by the iterator.
+			it.elem.Spent = existsRawUnminedInput(ns, it.ck) != nil
+			details.Credits = append(details.Credits, it.elem)
+		}
+		it.close()
+		if it.err != nil {
+			return it.err
+		}
 	}
+	slices.SortFunc(details.Credits, func(a, b CreditRecord) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
 
 	// Debit records are not saved for unmined transactions.  Instead, they
 	// must be looked up for each transaction input manually.  There are two
@@ -151,7 +178,7 @@ func (s *Store) unminedTxDetails(ns walletdb.ReadBucket, txHash *chainhash.Hash,
 			v := existsRawCredit(ns, credKey)
 			amount, err := fetchRawCreditAmount(v)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			ct := fetchRawCreditCoinType(v)
 			var skaAmt cointype.SKAAmount
@@ -175,7 +202,7 @@ func (s *Store) unminedTxDetails(ns walletdb.ReadBucket, txHash *chainhash.Hash,
 
 		amount, err := fetchRawCreditAmount(v)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		ct := fetchRawUnminedCreditCoinType(v)
 		var skaAmt cointype.SKAAmount
@@ -191,7 +218,7 @@ func (s *Store) unminedTxDetails(ns walletdb.ReadBucket, txHash *chainhash.Hash,
 		})
 	}
 
-	return &details, nil
+	return nil
 }
 
 // TxDetails looks up all recorded details regarding a transaction with some
@@ -370,7 +397,9 @@ func (s *Store) TxBlockHeight(dbtx walletdb.ReadTx, txHash *chainhash.Hash) (int
 // Error returns from f (if any) are propigated to the caller.  Returns true
 // (signaling breaking out of a RangeTransactions) iff f executes and returns
 // true.
-func (s *Store) rangeUnminedTransactions(ctx context.Context, ns walletdb.ReadBucket, f func([]TxDetails) (bool, error)) (bool, error) {
+func (s *Store) rangeUnminedTransactions(ctx context.Context, ns walletdb.ReadBucket,
+	filter func(*TxRecord) bool, f func([]TxDetails) (bool, error)) (bool, error) {
+
 	var details []TxDetails
 	err := ns.NestedReadBucket(bucketUnmined).ForEach(func(k, v []byte) error {
 		if ctx.Err() != nil {
@@ -382,15 +411,21 @@ func (s *Store) rangeUnminedTransactions(ctx context.Context, ns walletdb.ReadBu
 
 		var txHash chainhash.Hash
 		copy(txHash[:], k)
-		detail, err := s.unminedTxDetails(ns, &txHash, v)
+		detail := TxDetails{
+			Block: BlockMeta{Block: Block{Height: -1}},
+		}
+		err := readRawTxRecord(&txHash, v, &detail.TxRecord)
 		if err != nil {
 			return err
 		}
-
-		// Because the key was created while foreach-ing over the
-		// bucket, it should be impossible for unminedTxDetails to ever
-		// successfully return a nil details struct.
-		details = append(details, *detail)
+		if filter != nil && !filter(&detail.TxRecord) {
+			return nil
+		}
+		err = s.readUnminedCreditsDebits(ns, &detail)
+		if err != nil {
+			return err
+		}
+		details = append(details, detail)
 		return nil
 	})
 	if err == nil && len(details) > 0 {
@@ -404,7 +439,7 @@ func (s *Store) rangeUnminedTransactions(ctx context.Context, ns walletdb.ReadBu
 // returns true, or the transactions from block is processed.  Returns true iff
 // f executes and returns true.
 func (s *Store) rangeBlockTransactions(ctx context.Context, ns walletdb.ReadBucket, begin, end int32,
-	f func([]TxDetails) (bool, error)) (bool, error) {
+	filter func(*TxRecord) bool, f func([]TxDetails) (bool, error)) (bool, error) {
 
 	// Mempool height is considered a high bound.
 	if begin < 0 {
@@ -468,6 +503,9 @@ func (s *Store) rangeBlockTransactions(ctx context.Context, ns walletdb.ReadBuck
 			if err != nil {
 				return false, err
 			}
+			if filter != nil && !filter(&detail.TxRecord) {
+				continue
+			}
 
 			credIter := makeReadCreditIterator(ns, k, DBVersion)
 			for credIter.next() {
@@ -539,18 +577,33 @@ func (s *Store) rangeBlockTransactions(ctx context.Context, ns walletdb.ReadBuck
 func (s *Store) RangeTransactions(ctx context.Context, ns walletdb.ReadBucket, begin, end int32,
 	f func([]TxDetails) (bool, error)) error {
 
+	return s.RangeFilteredTransactions(ctx, ns, begin, end, nil, f)
+}
+
+// RangeFilteredTransactions runs the function f on the details of every
+// transaction between blocks over the height range [begin,end], like
+// RangeTransactions, for which filter returns true.  The filter is called with
+// the transaction record before the credits and debits of the transaction are
+// read, so transactions which are filtered out are not fully loaded.  A nil
+// filter includes every transaction.
+//
+// Blocks without any transactions matching the filter are skipped, so f is
+// still only called with a non-empty slice.
+func (s *Store) RangeFilteredTransactions(ctx context.Context, ns walletdb.ReadBucket,
+	begin, end int32, filter func(*TxRecord) bool, f func([]TxDetails) (bool, error)) error {
+
 	var addedUnmined bool
 	if begin < 0 {
-		brk, err := s.rangeUnminedTransactions(ctx, ns, f)
+		brk, err := s.rangeUnminedTransactions(ctx, ns, filter, f)
 		if err != nil || brk {
 			return err
 		}
 		addedUnmined = true
 	}
 
-	brk, err := s.rangeBlockTransactions(ctx, ns, begin, end, f)
+	brk, err := s.rangeBlockTransactions(ctx, ns, begin, end, filter, f)
 	if err == nil && !brk && !addedUnmined && end < 0 {
-		_, err = s.rangeUnminedTransactions(ctx, ns, f)
+		_, err = s.rangeUnminedTransactions(ctx, ns, filter, f)
 	}
 	return err
 }
@@ -0,0 +1,169 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// txTracesBucketKey is the bucket key for recording the lifecycle
+	// timelines of transactions originated by the wallet.
+	// Key: transaction hash (32 bytes) → Value: trace ID (8 bytes) followed
+	// by events of stage (1 byte), unix time (8 bytes), and height (4 bytes)
+	txTracesBucketKey = []byte("txtraces")
+)
+
+const txTraceEventSize = 1 + 8 + 4
+
+// TxTraceID identifies the trace of a transaction in logs and notifications.
+type TxTraceID [8]byte
+
+// String returns the hex encoding of the trace ID.
+func (id TxTraceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// TxTraceStage describes a stage in the lifecycle of a traced transaction.
+type TxTraceStage uint8
+
+// Transaction lifecycle stages.
+const (
+	TxTraceConstructed TxTraceStage = iota + 1
+	TxTraceSigned
+	TxTracePublished
+	TxTraceMempool
+	TxTraceConfirmed
+	TxTraceMature
+	TxTraceConflicted
+)
+
+var txTraceStageStrings = [...]string{
+	TxTraceConstructed: "constructed",
+	TxTraceSigned:      "signed",
+	TxTracePublished:   "published",
+	TxTraceMempool:     "mempool",
+	TxTraceConfirmed:   "confirmed",
+	TxTraceMature:      "mature",
+	TxTraceConflicted:  "conflicted",
+}
+
+// String returns the name of the stage.
+func (s TxTraceStage) String() string {
+	if int(s) < len(txTraceStageStrings) && txTraceStageStrings[s] != "" {
+		return txTraceStageStrings[s]
+	}
+	return "unknown"
+}
+
+// TxTraceEvent records when a traced transaction reached a stage.  Height is
+// the block height of confirmation, maturity, and conflict events, and -1 for
+// all other stages.
+type TxTraceEvent struct {
+	Stage  TxTraceStage
+	Time   time.Time
+	Height int32
+}
+
+// TxTrace is the lifecycle timeline of a transaction originated by the wallet.
+type TxTrace struct {
+	ID     TxTraceID
+	Events []TxTraceEvent
+}
+
+func (t *TxTrace) serialize() []byte {
+	v := make([]byte, len(t.ID), len(t.ID)+len(t.Events)*txTraceEventSize)
+	copy(v, t.ID[:])
+	for _, e := range t.Events {
+		var ev [txTraceEventSize]byte
+		ev[0] = byte(e.Stage)
+		byteOrder.PutUint64(ev[1:9], uint64(e.Time.Unix()))
+		byteOrder.PutUint32(ev[9:13], uint32(e.Height))
+		v = append(v, ev[:]...)
+	}
+	return v
+}
+
+func deserializeTxTrace(v []byte) (*TxTrace, error) {
+	if len(v) < len(TxTraceID{}) || (len(v)-len(TxTraceID{}))%txTraceEventSize != 0 {
+		return nil, errors.E(errors.IO, errors.Errorf("bad transaction trace length %d", len(v)))
+	}
+	t := new(TxTrace)
+	copy(t.ID[:], v)
+	for v = v[len(t.ID):]; len(v) != 0; v = v[txTraceEventSize:] {
+		t.Events = append(t.Events, TxTraceEvent{
+			Stage:  TxTraceStage(v[0]),
+			Time:   time.Unix(int64(byteOrder.Uint64(v[1:9])), 0),
+			Height: int32(byteOrder.Uint32(v[9:13])),
+		})
+	}
+	return t, nil
+}
+
+// PutTxTrace records the trace of the transaction with the given hash,
+// replacing any previous trace.
+func PutTxTrace(dbtx walletdb.ReadWriteTx, txHash *chainhash.Hash, trace *TxTrace) error {
+	const op errors.Op = "udb.PutTxTrace"
+	b := dbtx.ReadWriteBucket(txTracesBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing transaction traces bucket")
+	}
+	err := b.Put(txHash[:], trace.serialize())
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// TxTraceForHash returns the trace of the transaction with the given hash.
+// Errors with code errors.NotExist are returned for untraced transactions.
+func TxTraceForHash(dbtx walletdb.ReadTx, txHash *chainhash.Hash) (*TxTrace, error) {
+	const op errors.Op = "udb.TxTraceForHash"
+	b := dbtx.ReadBucket(txTracesBucketKey)
+	if b == nil {
+		return nil, errors.E(op, errors.Bug, "missing transaction traces bucket")
+	}
+	v := b.Get(txHash[:])
+	if v == nil {
+		return nil, errors.E(op, errors.NotExist, errors.Errorf("no trace for transaction %v", txHash))
+	}
+	t, err := deserializeTxTrace(v)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return t, nil
+}
+
+// AddTxTraceEvent appends an event to the trace of the transaction with the
+// given hash.  Events are only recorded for traced transactions, and an event
+// repeating the stage and height of a recorded event is ignored.  The trace is
+// returned with whether the event was added, or nil if the transaction is not
+// traced.
+func AddTxTraceEvent(dbtx walletdb.ReadWriteTx, txHash *chainhash.Hash, event TxTraceEvent) (*TxTrace, bool, error) {
+	const op errors.Op = "udb.AddTxTraceEvent"
+	t, err := TxTraceForHash(dbtx, txHash)
+	if errors.Is(err, errors.NotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, errors.E(op, err)
+	}
+	for _, e := range t.Events {
+		if e.Stage == event.Stage && e.Height == event.Height {
+			return t, false, nil
+		}
+	}
+	t.Events = append(t.Events, event)
+	err = PutTxTrace(dbtx, txHash, t)
+	if err != nil {
+		return nil, false, errors.E(op, err)
+	}
+	return t, true, nil
+}
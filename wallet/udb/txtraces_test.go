@@ -0,0 +1,120 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestTxTraces(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	traced := chainhash.Hash{1}
+	untraced := chainhash.Hash{2}
+	now := time.Unix(time.Now().Unix(), 0)
+	trace := &TxTrace{
+		ID: TxTraceID{1, 2, 3, 4, 5, 6, 7, 8},
+		Events: []TxTraceEvent{
+			{Stage: TxTraceConstructed, Time: now, Height: -1},
+			{Stage: TxTraceSigned, Time: now, Height: -1},
+		},
+	}
+	add := func(h *chainhash.Hash, stage TxTraceStage, height int32) (*TxTrace, bool) {
+		var tr *TxTrace
+		var added bool
+		err := walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			var err error
+			tr, added, err = AddTxTraceEvent(dbtx, h, TxTraceEvent{
+				Stage:  stage,
+				Time:   now,
+				Height: height,
+			})
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tr, added
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return PutTxTrace(dbtx, &traced, trace)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Events are not recorded for untraced transactions.
+	if tr, added := add(&untraced, TxTracePublished, -1); tr != nil || added {
+		t.Errorf("untraced transaction recorded event")
+	}
+
+	if _, added := add(&traced, TxTracePublished, -1); !added {
+		t.Errorf("published event was not added")
+	}
+	if _, added := add(&traced, TxTracePublished, -1); added {
+		t.Errorf("repeated published event was added")
+	}
+	if _, added := add(&traced, TxTraceConfirmed, 100); !added {
+		t.Errorf("confirmed event was not added")
+	}
+	// Confirmations in other blocks after reorgs are recorded.
+	if _, added := add(&traced, TxTraceConfirmed, 101); !added {
+		t.Errorf("reorged confirmed event was not added")
+	}
+
+	var got *TxTrace
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		got, err = TxTraceForHash(dbtx, &traced)
+		if err != nil {
+			return err
+		}
+		_, err = TxTraceForHash(dbtx, &untraced)
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("expected NotExist error for untraced transaction, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != trace.ID {
+		t.Errorf("trace ID %v, want %v", got.ID, trace.ID)
+	}
+	want := []TxTraceEvent{
+		{TxTraceConstructed, now, -1},
+		{TxTraceSigned, now, -1},
+		{TxTracePublished, now, -1},
+		{TxTraceConfirmed, now, 100},
+		{TxTraceConfirmed, now, 101},
+	}
+	if len(got.Events) != len(want) {
+		t.Fatalf("recorded %d events, want %d", len(got.Events), len(want))
+	}
+	for i := range want {
+		e := got.Events[i]
+		if e.Stage != want[i].Stage || !e.Time.Equal(want[i].Time) || e.Height != want[i].Height {
+			t.Errorf("event %d is %v at %v height %d, want %v at %v height %d",
+				i, e.Stage, e.Time, e.Height, want[i].Stage, want[i].Time, want[i].Height)
+		}
+	}
+}
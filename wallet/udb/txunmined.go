@@ -81,8 +81,12 @@ func (s *Store) InsertMemPoolTx(dbtx walletdb.ReadWriteTx, rec *TxRecord) error
 		}
 	}
 
-	log.Infof("Inserting unconfirmed transaction %v", &rec.Hash)
-	v, err := valueTxRecord(rec)
+	logTx(dbtx).Infof("Inserting unconfirmed transaction %v", &rec.Hash)
+	err := deleteTxConflict(dbtx, &rec.Hash)
+	if err != nil {
+		return err
+	}
+	v, err = valueTxRecord(rec)
 	if err != nil {
 		return err
 	}
@@ -144,8 +148,24 @@ func (s *Store) SetPublished(dbtx walletdb.ReadWriteTx, txHash *chainhash.Hash,
 // removeDoubleSpends checks for any unmined transactions which would introduce
 // a double spend if tx was added to the store (either as a confirmed or unmined
 // transaction).  Each conflicting transaction and all transactions which spend
-// it are recursively removed.
-func (s *Store) removeDoubleSpends(ns walletdb.ReadWriteBucket, rec *TxRecord) error {
+// it are recursively removed, and recorded as conflicted by tx.
+func (s *Store) removeDoubleSpends(dbtx walletdb.ReadWriteTx, ns walletdb.ReadWriteBucket, rec *TxRecord) error {
+	conflicts, err := unminedConflicts(ns, &rec.MsgTx, &rec.Hash)
+	if err != nil {
+		return err
+	}
+	for _, c := range conflicts {
+		err := putTxConflict(dbtx, &TxConflict{
+			Hash:         c.Hash,
+			ConflictedBy: rec.Hash,
+			Time:         rec.Received,
+			Tx:           c.MsgTx,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, input := range rec.MsgTx.TxIn {
 		prevOut := &input.PreviousOutPoint
 		prevOutKey := canonicalOutPoint(&prevOut.Hash, prevOut.Index)
@@ -160,7 +180,7 @@ func (s *Store) removeDoubleSpends(ns walletdb.ReadWriteBucket, rec *TxRecord) e
 				return err
 			}
 
-			log.Debugf("Removing double spending transaction %v",
+			logTx(dbtx).Debugf("Removing double spending transaction %v",
 				doubleSpend.Hash)
 			err = s.RemoveUnconfirmed(ns, &doubleSpend.MsgTx, &doubleSpend.Hash)
 			if err != nil {
@@ -367,6 +387,11 @@ func (s *Store) PruneUnmined(dbtx walletdb.ReadWriteTx, stakeDiff int64) ([]*cha
 		switch {
 		case tx.Expiry != wire.NoExpiryValue && tx.Expiry <= uint32(tipHeight):
 			reason = "expired"
+		case isCoinbaseClassTx(&tx):
+			// Coinbase-class transactions can only be mined in their
+			// original block, and were previously moved to the
+			// unmined set when it was rolled back.
+			reason = "detached coinbase-class"
 		case stake.IsSStx(&tx):
 			if tx.TxOut[0].Value == stakeDiff {
 				continue
@@ -400,7 +425,7 @@ func (s *Store) PruneUnmined(dbtx walletdb.ReadWriteTx, stakeDiff int64) ([]*cha
 			return nil, errors.E(errors.IO, err)
 		}
 
-		log.Infof("Removing %s unmined transaction %v", reason, txHash)
+		logTx(dbtx).Infof("Removing %s unmined transaction %v", reason, txHash)
 
 		toRemove = append(toRemove, &removeTx{tx, txHash, reason})
 	}
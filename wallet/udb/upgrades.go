@@ -7,6 +7,7 @@ package udb
 import (
 	"context"
 	"crypto/sha256"
+	"time"
 
 	"github.com/monetarium/monetarium-wallet/errors"
 	"github.com/monetarium/monetarium-wallet/internal/compat"
@@ -236,10 +237,142 @@ const (
 	// This change was necessary to support variable-length SKA amounts (big.Int).
 	wireFormatV13Version = 31
 
+	// txLabelsVersion is the 32nd version of the database. It creates a
+	// bucket for storing labels attached to wallet transactions.
+	txLabelsVersion = 32
+
+	// counterpartiesVersion is the 33rd version of the database. It creates
+	// a bucket for tagging external addresses with named counterparties.
+	counterpartiesVersion = 33
+
+	// ticketCompoundingVersion is the 34th version of the database. It
+	// creates a bucket for the ticket reward compounding state of accounts.
+	ticketCompoundingVersion = 34
+
+	// migrationHistoryVersion is the 35th version of the database. It
+	// creates a bucket recording when each database upgrade was performed.
+	migrationHistoryVersion = 35
+
+	// txTracesVersion is the 36th version of the database. It creates a
+	// bucket recording the lifecycle timelines of wallet transactions.
+	txTracesVersion = 36
+
+	// importedKeysVersion is the 37th version of the database. It creates a
+	// bucket attaching imported keys and scripts to accounts.
+	importedKeysVersion = 37
+
+	// changelogVersion is the 38th version of the database. It creates a
+	// bucket recording database mutations for incremental backups.
+	changelogVersion = 38
+
+	// txConflictsVersion is the 39th version of the database. It creates a
+	// bucket recording unmined transactions removed by double spends.
+	txConflictsVersion = 39
+
+	// feeRewardTicketsVersion is the 40th version of the database. It
+	// creates a bucket recording tickets purchased with SSFee rewards.
+	feeRewardTicketsVersion = 40
+
+	// ticketBuyerConfigVersion is the 41st version of the database. It
+	// creates a bucket for the ticket buyer configuration of accounts.
+	ticketBuyerConfigVersion = 41
+
+	// vspConfigVersion is the 42nd version of the database. It creates a
+	// bucket recording the VSP selected to register tickets with.
+	vspConfigVersion = 42
+
+	// stakeStatsVersion is the 43rd version of the database. It creates a
+	// bucket recording the outcomes of tickets and SSFee rewards earned.
+	stakeStatsVersion = 43
+
+	// changeAccountsVersion is the 44th version of the database. It creates
+	// a bucket recording the accounts which change of source accounts is
+	// redirected to.
+	changeAccountsVersion = 44
+
+	// priceSnapshotsVersion is the 45th version of the database. It creates
+	// a bucket recording fiat exchange rates of coin types for the
+	// transaction history.
+	priceSnapshotsVersion = 45
+
+	// invoicesVersion is the 46th version of the database. It creates a
+	// bucket recording invoices and the wallet outputs paying them.
+	invoicesVersion = 46
+
+	// rpcCredentialsVersion is the 47th version of the database. It creates
+	// a bucket recording hashed RPC credentials and their scopes.
+	rpcCredentialsVersion = 47
+
+	// stakingKeyVersion is the 48th version of the database. It records a
+	// staking crypto key, without a staking passphrase, which protects the
+	// extended private keys of accounts in the staking key domain.
+	stakingKeyVersion = 48
+
+	// argon2idMasterKeyVersion is the 49th version of the database. It
+	// allows the master private key parameters to describe the Argon2id
+	// KDF.  Master private keys are reencrypted when the wallet is next
+	// unlocked rather than during the upgrade, as this requires the private
+	// passphrase.
+	argon2idMasterKeyVersion = 49
+
+	// prunedHistoryVersion is the 50th version of the database. It creates
+	// a bucket recording the aggregate history of pruned transactions.
+	prunedHistoryVersion = 50
+
+	// spendLimitsVersion is the 51st version of the database. It creates
+	// buckets recording the daily spend limits of accounts and the sends
+	// awaiting approval after exceeding them.
+	spendLimitsVersion = 51
+
+	// sendPolicyVersion is the 52nd version of the database. It creates a
+	// bucket recording the policy evaluated before sends are published.
+	sendPolicyVersion = 52
+
+	// rebroadcastVersion is the 53rd version of the database. It creates a
+	// bucket queueing published transactions to be republished until mined.
+	rebroadcastVersion = 53
+
+	// feeStatsVersion is the 54th version of the database. It creates a
+	// bucket recording the fees paid by mined transactions of the wallet.
+	feeStatsVersion = 54
+
+	// changeScriptTypesVersion is the 55th version of the database. It
+	// creates a bucket recording the script type of each account's change.
+	changeScriptTypesVersion = 55
+
+	// skaEmissionsVersion is the 56th version of the database. It creates a
+	// bucket recording the SKA emission transactions observed in mined
+	// blocks.
+	skaEmissionsVersion = 56
+
+	// scheduledSendsVersion is the 57th version of the database. It creates
+	// a bucket recording sends queued to be published at a time or block
+	// height.
+	scheduledSendsVersion = 57
+
+	// recurringPaymentsVersion is the 58th version of the database. It
+	// creates a bucket recording payments repeated every interval of blocks
+	// or time.
+	recurringPaymentsVersion = 58
+
+	// inheritanceVersion is the 59th version of the database. It creates a
+	// bucket recording the dead-man's switch of accounts whose funds are
+	// swept to a recovery address by pre-signed, time-locked transactions.
+	inheritanceVersion = 59
+
+	// schemaCompatVersion is the 60th version of the database. It records
+	// the oldest database version understood by software which may open
+	// the database, allowing later upgrades which older software can
+	// safely ignore to be made without preventing the use of the older
+	// software.  Each upgrade is committed in its own transaction from this
+	// version.
+	schemaCompatVersion = 60
+
 	// DBVersion is the latest version of the database that is understood by the
 	// program.  Databases with recorded versions higher than this will fail to
-	// open (meaning any upgrades prevent reverting to older software).
-	DBVersion = wireFormatV13Version
+	// open (meaning any upgrades prevent reverting to older software), unless
+	// the database records that it remains compatible with this version.
+	DBVersion = schemaCompatVersion
 )
 
 // upgrades maps between old database versions and the upgrade function to
@@ -276,6 +409,35 @@ var upgrades = [...]func(walletdb.ReadWriteTx, []byte, *chaincfg.Params) error{
 	consolidationAddressVersion - 1:       consolidationAddressUpgrade,
 	skaBucketsVersion - 1:                 skaBucketsUpgrade,
 	wireFormatV13Version - 1:              wireFormatV13Upgrade,
+	txLabelsVersion - 1:                   txLabelsUpgrade,
+	counterpartiesVersion - 1:             counterpartiesUpgrade,
+	ticketCompoundingVersion - 1:          ticketCompoundingUpgrade,
+	migrationHistoryVersion - 1:           migrationHistoryUpgrade,
+	txTracesVersion - 1:                   txTracesUpgrade,
+	importedKeysVersion - 1:               importedKeysUpgrade,
+	changelogVersion - 1:                  changelogUpgrade,
+	txConflictsVersion - 1:                txConflictsUpgrade,
+	feeRewardTicketsVersion - 1:           feeRewardTicketsUpgrade,
+	ticketBuyerConfigVersion - 1:          ticketBuyerConfigUpgrade,
+	vspConfigVersion - 1:                  vspConfigUpgrade,
+	stakeStatsVersion - 1:                 stakeStatsUpgrade,
+	changeAccountsVersion - 1:             changeAccountsUpgrade,
+	priceSnapshotsVersion - 1:             priceSnapshotsUpgrade,
+	invoicesVersion - 1:                   invoicesUpgrade,
+	rpcCredentialsVersion - 1:             rpcCredentialsUpgrade,
+	stakingKeyVersion - 1:                 stakingKeyUpgrade,
+	argon2idMasterKeyVersion - 1:          argon2idMasterKeyUpgrade,
+	prunedHistoryVersion - 1:              prunedHistoryUpgrade,
+	spendLimitsVersion - 1:                spendLimitsUpgrade,
+	sendPolicyVersion - 1:                 sendPolicyUpgrade,
+	rebroadcastVersion - 1:                rebroadcastUpgrade,
+	feeStatsVersion - 1:                   feeStatsUpgrade,
+	changeScriptTypesVersion - 1:          changeScriptTypesUpgrade,
+	skaEmissionsVersion - 1:               skaEmissionsUpgrade,
+	scheduledSendsVersion - 1:             scheduledSendsUpgrade,
+	recurringPaymentsVersion - 1:          recurringPaymentsUpgrade,
+	inheritanceVersion - 1:                inheritanceUpgrade,
+	schemaCompatVersion - 1:               schemaCompatUpgrade,
 }
 
 func lastUsedAddressIndexUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
@@ -1011,7 +1173,7 @@ func ticketCommitmentsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte,
 					return errors.E(errors.IO, err)
 				}
 
-				log.Debugf("Adding ticket commitment %s:%d (%s) for account %d",
+				logTx(tx).Debugf("Adding ticket commitment %s:%d (%s) for account %d",
 					txrec.Hash, i, amount, acct)
 
 				// Store both the ticket commitment info and an entry in the
@@ -1063,7 +1225,7 @@ func ticketCommitmentsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte,
 				if unmined {
 					// An unmined vote/revocation only marks the ticket
 					// commitment as unminedSpent.
-					log.Debugf("Marking ticket commitment %s:%d unmined spent",
+					logTx(tx).Debugf("Marking ticket commitment %s:%d unmined spent",
 						ticketHash, txoIdx)
 
 					v = valueUnspentTicketCommitment(true)
@@ -1071,7 +1233,7 @@ func ticketCommitmentsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte,
 				} else {
 					// A mined vote/revocation removes the entry from the
 					// unspent ticket commitment index.
-					log.Debugf("Removing unspent ticket commitment %s:%d",
+					logTx(tx).Debugf("Removing unspent ticket commitment %s:%d",
 						ticketHash, txoIdx)
 
 					err = deleteRawUnspentTicketCommitment(txmgrBucket, k)
@@ -1125,7 +1287,7 @@ func ticketCommitmentsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte,
 		return errors.E(errors.IO, err)
 	}
 
-	log.Debug("Ticket commitments db upgrade done")
+	logTx(tx).Debug("Ticket commitments db upgrade done")
 
 	// Write the new database version.
 	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
@@ -1921,9 +2083,8 @@ func coinTypeBucketsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, pa
 	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
 }
 
-// Upgrade checks whether the any upgrades are necessary before the database is
-// ready for application usage.  If any are, they are performed.
-func Upgrade(ctx context.Context, db walletdb.DB, publicPassphrase []byte, params *chaincfg.Params) error {
+// dbVersion reads the current version of the database.
+func dbVersion(ctx context.Context, db walletdb.DB) (uint32, error) {
 	var version uint32
 	err := walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
 		var err error
@@ -1936,6 +2097,14 @@ func Upgrade(ctx context.Context, db walletdb.DB, publicPassphrase []byte, param
 		version, err = unifiedDBMetadata{}.getVersion(metadataBucket)
 		return err
 	})
+	return version, err
+}
+
+// Upgrade checks whether the any upgrades are necessary before the database is
+// ready for application usage.  If any are, they are performed and recorded in
+// the migration history.
+func Upgrade(ctx context.Context, db walletdb.DB, publicPassphrase []byte, params *chaincfg.Params) error {
+	version, err := dbVersion(ctx, db)
 	if err != nil {
 		return err
 	}
@@ -1947,16 +2116,92 @@ func Upgrade(ctx context.Context, db walletdb.DB, publicPassphrase []byte, param
 
 	log.Infof("Upgrading database from version %d to %d", version, DBVersion)
 
-	return walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
-		// Execute all necessary upgrades in order.
-		for _, upgrade := range upgrades[version:] {
-			err := upgrade(tx, publicPassphrase, params)
+	// Each upgrade is committed together with the version it upgrades to,
+	// so an interrupted upgrade resumes after the last committed upgrade.
+	// Upgrades performed before the migration history bucket is created
+	// are recorded once it exists.
+	var unrecorded []Migration
+	for {
+		var done, recorded bool
+		err := walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+			metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+			version, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+			if err != nil {
+				return err
+			}
+			if version >= DBVersion {
+				done = true
+				return nil
+			}
+			err = performUpgrade(tx, version, publicPassphrase, params)
 			if err != nil {
 				return err
 			}
+			migrations := append(unrecorded, migrationsFrom(version)[0])
+			if tx.ReadBucket(migrationHistoryBucketKey) == nil {
+				unrecorded = migrations
+				return nil
+			}
+			recorded = true
+			return putMigrationHistory(tx, migrations, time.Now())
+		})
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if recorded {
+			unrecorded = nil
 		}
+	}
+}
+
+// performUpgrade upgrades a database from version to the next version, and
+// records the oldest version understood by software which may open the
+// upgraded database.
+func performUpgrade(tx walletdb.ReadWriteTx, version uint32, publicPassphrase []byte,
+	params *chaincfg.Params) error {
+
+	newVersion := version + 1
+	err := upgrades[version](tx, publicPassphrase, params)
+	if err != nil {
+		return errors.Errorf("upgrade to version %d (%s): %w", newVersion,
+			migrationDescriptions[newVersion], err)
+	}
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	recorded, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if recorded != newVersion {
+		return errors.E(errors.Bug, errors.Errorf("upgrade to version %d "+
+			"recorded version %d", newVersion, recorded))
+	}
+	if newVersion < schemaCompatVersion || readCompatibleUpgrades[newVersion] {
 		return nil
-	})
+	}
+	return unifiedDBMetadata{}.putCompatVersion(metadataBucket, newVersion)
+}
+
+// schemaCompatUpgrade performs an upgrade from version 59 to 60.  The oldest
+// compatible version is recorded by performUpgrade.
+func schemaCompatUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 59
+	const newVersion = 60
+
+	// Assert that this function is only called on version 59 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("schemaCompatUpgrade inappropriately called"))
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
 }
 
 // consolidationAddressUpgrade creates the account consolidation bucket for
@@ -2144,3 +2389,643 @@ func wireFormatV13Upgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, para
 	// Update the database version.
 	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
 }
+
+// txLabelsUpgrade performs an upgrade from version 31 to 32. This upgrade
+// creates the transaction labels bucket.
+func txLabelsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 31
+	const newVersion = 32
+
+	// Assert that this function is only called on version 31 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("txLabelsUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(txLabelsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+// counterpartiesUpgrade performs an upgrade from version 32 to 33. This
+// upgrade creates the counterparty address tags bucket.
+func counterpartiesUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 32
+	const newVersion = 33
+
+	// Assert that this function is only called on version 32 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("counterpartiesUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(counterpartiesBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+// ticketCompoundingUpgrade performs an upgrade from version 33 to 34. This
+// upgrade creates the ticket reward compounding bucket.
+func ticketCompoundingUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 33
+	const newVersion = 34
+
+	// Assert that this function is only called on version 33 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("ticketCompoundingUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(ticketCompoundingBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+// migrationHistoryUpgrade performs an upgrade from version 34 to 35. This
+// upgrade creates the migration history bucket.
+func migrationHistoryUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 34
+	const newVersion = 35
+
+	// Assert that this function is only called on version 34 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("migrationHistoryUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(migrationHistoryBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+// txTracesUpgrade performs an upgrade from version 35 to 36. This upgrade
+// creates the transaction traces bucket.
+func txTracesUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 35
+	const newVersion = 36
+
+	// Assert that this function is only called on version 35 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("txTracesUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(txTracesBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+// importedKeysUpgrade performs an upgrade from version 36 to 37. This upgrade
+// creates the imported keys bucket in the address manager namespace.
+func importedKeysUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 36
+	const newVersion = 37
+
+	// Assert that this function is only called on version 36 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("importedKeysUpgrade inappropriately called"))
+	}
+
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+	_, err = addrmgrBucket.CreateBucket(importedKeysBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+// changelogUpgrade performs an upgrade from version 37 to 38. This upgrade
+// creates the changelog bucket and its nested entries bucket.
+func changelogUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 37
+	const newVersion = 38
+
+	// Assert that this function is only called on version 37 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("changelogUpgrade inappropriately called"))
+	}
+
+	changelogBucket, err := tx.CreateTopLevelBucket(changelogBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	_, err = changelogBucket.CreateBucket(changelogEntriesBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func txConflictsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 38
+	const newVersion = 39
+
+	// Assert that this function is only called on version 38 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("txConflictsUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(txConflictsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func feeRewardTicketsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 39
+	const newVersion = 40
+
+	// Assert that this function is only called on version 39 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("feeRewardTicketsUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(feeRewardTicketsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func ticketBuyerConfigUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 40
+	const newVersion = 41
+
+	// Assert that this function is only called on version 40 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("ticketBuyerConfigUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(ticketBuyerConfigBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func vspConfigUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 41
+	const newVersion = 42
+
+	// Assert that this function is only called on version 41 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("vspConfigUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(vspConfigBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func stakeStatsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 42
+	const newVersion = 43
+
+	// Assert that this function is only called on version 42 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("stakeStatsUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(stakeStatsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func changeAccountsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 43
+	const newVersion = 44
+
+	// Assert that this function is only called on version 43 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("changeAccountsUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(changeAccountsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func priceSnapshotsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 44
+	const newVersion = 45
+
+	// Assert that this function is only called on version 44 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("priceSnapshotsUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(priceSnapshotsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func invoicesUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 45
+	const newVersion = 46
+
+	// Assert that this function is only called on version 45 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("invoicesUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(invoicesBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func rpcCredentialsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 46
+	const newVersion = 47
+
+	// Assert that this function is only called on version 46 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("rpcCredentialsUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(rpcCredentialsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func stakingKeyUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 47
+	const newVersion = 48
+
+	// Assert that this function is only called on version 47 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("stakingKeyUpgrade inappropriately called"))
+	}
+
+	// No accounts are in the staking key domain yet, so the new key is
+	// recorded without a passphrase.
+	ns := tx.ReadWriteBucket(waddrmgrBucketKey)
+	err = putStakingKey(ns, newStakingKey(), nil)
+	if err != nil {
+		return err
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func argon2idMasterKeyUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 48
+	const newVersion = 49
+
+	// Assert that this function is only called on version 48 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("argon2idMasterKeyUpgrade inappropriately called"))
+	}
+
+	// The version is bumped so that older software, which can not
+	// unmarshal Argon2id master key parameters, refuses to open the
+	// database.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func prunedHistoryUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 49
+	const newVersion = 50
+
+	// Assert that this function is only called on version 49 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("prunedHistoryUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(prunedHistoryBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func spendLimitsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 50
+	const newVersion = 51
+
+	// Assert that this function is only called on version 50 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("spendLimitsUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(spendLimitsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	_, err = tx.CreateTopLevelBucket(pendingSendsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func sendPolicyUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 51
+	const newVersion = 52
+
+	// Assert that this function is only called on version 51 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("sendPolicyUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(sendPolicyBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func rebroadcastUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 52
+	const newVersion = 53
+
+	// Assert that this function is only called on version 52 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("rebroadcastUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(rebroadcastBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func feeStatsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 53
+	const newVersion = 54
+
+	// Assert that this function is only called on version 53 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("feeStatsUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(feeStatsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func changeScriptTypesUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 54
+	const newVersion = 55
+
+	// Assert that this function is only called on version 54 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("changeScriptTypesUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(changeScriptTypesBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func skaEmissionsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 55
+	const newVersion = 56
+
+	// Assert that this function is only called on version 55 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("skaEmissionsUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(skaEmissionsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func scheduledSendsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 56
+	const newVersion = 57
+
+	// Assert that this function is only called on version 56 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("scheduledSendsUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(scheduledSendsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func recurringPaymentsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 57
+	const newVersion = 58
+
+	// Assert that this function is only called on version 57 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("recurringPaymentsUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(recurringPaymentsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func inheritanceUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 58
+	const newVersion = 59
+
+	// Assert that this function is only called on version 58 databases.
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, errors.Errorf("inheritanceUpgrade inappropriately called"))
+	}
+
+	_, err = tx.CreateTopLevelBucket(inheritanceBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
@@ -35,6 +35,22 @@ const (
 	VSPFeeProcessConfirmed
 )
 
+// String returns the name of the fee status.
+func (s FeeStatus) String() string {
+	switch s {
+	case VSPFeeProcessStarted:
+		return "started"
+	case VSPFeeProcessPaid:
+		return "paid"
+	case VSPFeeProcessErrored:
+		return "errored"
+	case VSPFeeProcessConfirmed:
+		return "confirmed"
+	default:
+		return "unknown"
+	}
+}
+
 type VSPTicket struct {
 	FeeHash     chainhash.Hash
 	FeeTxStatus uint32
@@ -0,0 +1,118 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+var (
+	// vspConfigBucketKey is the bucket key for storing the VSP selected to
+	// register purchased tickets with.
+	// Key: "selected" → Value: host length (4 bytes) | host | pubkey length
+	// (4 bytes) | pubkey | fee account (4 bytes)
+	vspConfigBucketKey = []byte("vspconfig")
+
+	vspConfigSelectedKey = []byte("selected")
+)
+
+// VSPConfig describes the VSP selected to register purchased tickets with.
+// PubKey is the VSP's base64 encoded public key, and VSP fees are paid from
+// FeeAccount.
+type VSPConfig struct {
+	Host       string
+	PubKey     string
+	FeeAccount uint32
+}
+
+func valueVSPConfig(c *VSPConfig) []byte {
+	v := make([]byte, 4+len(c.Host)+4+len(c.PubKey)+4)
+	off := 0
+	byteOrder.PutUint32(v[off:], uint32(len(c.Host)))
+	off += 4
+	off += copy(v[off:], c.Host)
+	byteOrder.PutUint32(v[off:], uint32(len(c.PubKey)))
+	off += 4
+	off += copy(v[off:], c.PubKey)
+	byteOrder.PutUint32(v[off:], c.FeeAccount)
+	return v
+}
+
+func readVSPConfig(v []byte) (*VSPConfig, error) {
+	bad := errors.E(errors.IO, "bad VSP config record")
+	if len(v) < 4 {
+		return nil, bad
+	}
+	hostLen := int(byteOrder.Uint32(v))
+	v = v[4:]
+	if len(v) < hostLen+4 {
+		return nil, bad
+	}
+	c := &VSPConfig{Host: string(v[:hostLen])}
+	v = v[hostLen:]
+	pubKeyLen := int(byteOrder.Uint32(v))
+	v = v[4:]
+	if len(v) != pubKeyLen+4 {
+		return nil, bad
+	}
+	c.PubKey = string(v[:pubKeyLen])
+	c.FeeAccount = byteOrder.Uint32(v[pubKeyLen:])
+	return c, nil
+}
+
+// PutVSPConfig records the VSP selected to register purchased tickets with,
+// replacing any previous selection.
+func PutVSPConfig(dbtx walletdb.ReadWriteTx, c *VSPConfig) error {
+	const op errors.Op = "udb.PutVSPConfig"
+
+	if c.Host == "" {
+		return errors.E(op, errors.Invalid, "empty VSP host")
+	}
+
+	b := dbtx.ReadWriteBucket(vspConfigBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing VSP config bucket")
+	}
+	err := b.Put(vspConfigSelectedKey, valueVSPConfig(c))
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteVSPConfig removes the selected VSP.
+func DeleteVSPConfig(dbtx walletdb.ReadWriteTx) error {
+	const op errors.Op = "udb.DeleteVSPConfig"
+
+	b := dbtx.ReadWriteBucket(vspConfigBucketKey)
+	if b == nil {
+		return errors.E(op, errors.Bug, "missing VSP config bucket")
+	}
+	err := b.Delete(vspConfigSelectedKey)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// SelectedVSPConfig returns the VSP selected to register purchased tickets
+// with.  An error with kind NotExist is returned if no VSP is selected.
+func SelectedVSPConfig(dbtx walletdb.ReadTx) (*VSPConfig, error) {
+	const op errors.Op = "udb.SelectedVSPConfig"
+
+	var v []byte
+	if b := dbtx.ReadBucket(vspConfigBucketKey); b != nil {
+		v = b.Get(vspConfigSelectedKey)
+	}
+	if v == nil {
+		return nil, errors.E(op, errors.NotExist, "no VSP is selected")
+	}
+	c, err := readVSPConfig(v)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return c, nil
+}
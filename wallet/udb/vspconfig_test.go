@@ -0,0 +1,69 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestVSPConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	err := Initialize(ctx, db, chaincfg.TestNet3Params(), seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := SelectedVSPConfig(dbtx)
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("no selected VSP: expected NotExist error, got %v", err)
+		}
+
+		for _, c := range []VSPConfig{
+			{Host: "https://vsp.example.com", PubKey: "cHVia2V5", FeeAccount: 2},
+			{Host: "https://other.example.com", FeeAccount: 0},
+		} {
+			err := PutVSPConfig(dbtx, &c)
+			if err != nil {
+				return err
+			}
+			got, err := SelectedVSPConfig(dbtx)
+			if err != nil {
+				return err
+			}
+			if *got != c {
+				t.Errorf("selected VSP %+v, want %+v", *got, c)
+			}
+		}
+
+		err = PutVSPConfig(dbtx, &VSPConfig{})
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("empty host: expected Invalid error, got %v", err)
+		}
+
+		err = DeleteVSPConfig(dbtx)
+		if err != nil {
+			return err
+		}
+		_, err = SelectedVSPConfig(dbtx)
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("deleted VSP: expected NotExist error, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
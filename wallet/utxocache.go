@@ -0,0 +1,108 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+)
+
+// utxoCacheKey identifies the cached unspent outputs of an account and coin
+// type.
+type utxoCacheKey struct {
+	account  uint32
+	coinType cointype.CoinType
+}
+
+// cachedOutput is an unspent output of an account which may be selected as a
+// transaction input once it is confirmed and matured.
+type cachedOutput struct {
+	input        Input
+	class        stdscript.ScriptType
+	height       int32
+	fromCoinBase bool
+}
+
+// utxoCache caches the unspent outputs of each account and coin type which may
+// be selected as transaction inputs.  Outputs are cached before they are
+// filtered by confirmations, maturity, and output locks, as these depend on
+// the main chain tip and change more frequently than the outputs themselves.
+//
+// The cache is invalidated whenever transactions are recorded or removed and
+// whenever blocks are connected or disconnected.  To avoid caching outputs
+// read by a database transaction which began before an invalidating update was
+// committed, the cache is only filled with w.lockedOutpointMu held, and
+// updates which invalidate the cache must either hold w.lockedOutpointMu until
+// they are committed, or invalidate the cache again with the mutex held after
+// committing.
+type utxoCache struct {
+	mu      sync.Mutex
+	outputs map[utxoCacheKey][]cachedOutput
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// load returns the cached outputs of an account and coin type, reading and
+// caching them with fetch on a cache miss.
+func (c *utxoCache) load(key utxoCacheKey, fetch func() ([]cachedOutput, error)) ([]cachedOutput, error) {
+	c.mu.Lock()
+	outputs, ok := c.outputs[key]
+	c.mu.Unlock()
+	if ok {
+		c.hits.Add(1)
+		return outputs, nil
+	}
+	c.misses.Add(1)
+
+	outputs, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if c.outputs == nil {
+		c.outputs = make(map[utxoCacheKey][]cachedOutput)
+	}
+	c.outputs[key] = outputs
+	c.mu.Unlock()
+	return outputs, nil
+}
+
+// invalidate removes all cached outputs.
+func (c *utxoCache) invalidate() {
+	c.mu.Lock()
+	clear(c.outputs)
+	c.mu.Unlock()
+}
+
+// UTXOCacheStats describes the effectiveness of the cache of unspent outputs
+// which may be selected as transaction inputs.
+type UTXOCacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// HitRate returns the fraction of input selections served by the cache.
+func (s UTXOCacheStats) HitRate() float64 {
+	lookups := s.Hits + s.Misses
+	if lookups == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(lookups)
+}
+
+// UTXOCacheStats returns the number of input selections which were served by
+// the cache of eligible unspent outputs, and which read unspent outputs from
+// the database.
+func (w *Wallet) UTXOCacheStats() UTXOCacheStats {
+	return UTXOCacheStats{
+		Hits:   w.utxoCache.hits.Load(),
+		Misses: w.utxoCache.misses.Load(),
+	}
+}
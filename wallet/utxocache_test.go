@@ -0,0 +1,55 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+func TestUTXOCache(t *testing.T) {
+	t.Parallel()
+
+	var c utxoCache
+	fetches := 0
+	fetch := func() ([]cachedOutput, error) {
+		fetches++
+		return []cachedOutput{{height: int32(fetches)}}, nil
+	}
+	load := func(key utxoCacheKey) int32 {
+		t.Helper()
+		outputs, err := c.load(key, fetch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return outputs[0].height
+	}
+
+	varKey := utxoCacheKey{account: 0, coinType: cointype.CoinTypeVAR}
+	skaKey := utxoCacheKey{account: 0, coinType: 1}
+	if h := load(varKey); h != 1 {
+		t.Fatalf("first load read fetch %d", h)
+	}
+	if h := load(varKey); h != 1 {
+		t.Fatalf("cached load read fetch %d", h)
+	}
+	if h := load(skaKey); h != 2 {
+		t.Fatalf("load of other coin type read fetch %d", h)
+	}
+
+	c.invalidate()
+	if h := load(varKey); h != 3 {
+		t.Fatalf("load after invalidation read fetch %d", h)
+	}
+
+	stats := UTXOCacheStats{Hits: c.hits.Load(), Misses: c.misses.Load()}
+	if stats.Hits != 1 || stats.Misses != 3 {
+		t.Errorf("%d hits and %d misses, want 1 and 3", stats.Hits, stats.Misses)
+	}
+	if r := stats.HitRate(); r != 0.25 {
+		t.Errorf("hit rate %v, want 0.25", r)
+	}
+}
@@ -37,7 +37,7 @@ func (w *Wallet) UnspentOutputs(ctx context.Context, policy OutputSelectionPolic
 	const op errors.Op = "wallet.UnspentOutputs"
 
 	defer w.lockedOutpointMu.Unlock()
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 
 	var outputResults []*TransactionOutput
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
@@ -45,18 +45,11 @@ func (w *Wallet) UnspentOutputs(ctx context.Context, policy OutputSelectionPolic
 
 		_, tipHeight := w.txStore.MainChainTip(dbtx)
 
-		// TODO: actually stream outputs from the db instead of fetching
-		// all of them at once.
-		outputs, err := w.txStore.UnspentOutputs(dbtx, policy.CoinType)
-		if err != nil {
-			return err
-		}
-
-		for _, output := range outputs {
+		return w.txStore.ForEachUnspentOutput(dbtx, policy.CoinType, func(output *udb.Credit) error {
 			// Ignore outputs that haven't reached the required
 			// number of confirmations.
 			if !policy.meetsRequiredConfs(output.Height, tipHeight) {
-				continue
+				return nil
 			}
 
 			// Ignore outputs that are not controlled by the account.
@@ -66,19 +59,19 @@ func (w *Wallet) UnspentOutputs(ctx context.Context, policy OutputSelectionPolic
 				// to without a valid address.  TODO: Fix this
 				// by saving outputs per account, or accounts
 				// per output.
-				continue
+				return nil
 			}
 			outputAcct, err := w.manager.AddrAccount(addrmgrNs, addrs[0])
 			if err != nil {
 				return err
 			}
 			if outputAcct != policy.Account {
-				continue
+				return nil
 			}
 
 			// Filter by coin type - must match policy's coin type
 			if output.CoinType != policy.CoinType {
-				continue
+				return nil
 			}
 
 			// Stakebase isn't exposed by wtxmgr so those will be
@@ -103,9 +96,8 @@ func (w *Wallet) UnspentOutputs(ctx context.Context, policy OutputSelectionPolic
 				ReceiveTime:     output.Received,
 			}
 			outputResults = append(outputResults, result)
-		}
-
-		return nil
+			return nil
+		})
 	})
 	if err != nil {
 		return nil, errors.E(op, err)
@@ -113,13 +105,53 @@ func (w *Wallet) UnspentOutputs(ctx context.Context, policy OutputSelectionPolic
 	return outputResults, nil
 }
 
+// unspentOutputBatchSize is the number of unspent outputs read in each
+// database transaction by ForEachUnspentOutput.
+const unspentOutputBatchSize = 1000
+
+// ForEachUnspentOutput calls f with every unspent output of coinType.  Outputs
+// are read in batches, each in a separate short read transaction, and f is
+// called outside of any database transaction.  This bounds both the memory
+// used and the time the database is held open for wallets with very many
+// outputs, at the cost of not observing a consistent snapshot: outputs added
+// or spent during the iteration may or may not be visited.  Iteration stops
+// with the first error returned by f, which is returned to the caller.
+func (w *Wallet) ForEachUnspentOutput(ctx context.Context, coinType cointype.CoinType, f func(*udb.Credit) error) error {
+	const op errors.Op = "wallet.ForEachUnspentOutput"
+
+	var cursor udb.UnspentOutputCursor
+	batch := make([]*udb.Credit, 0, unspentOutputBatchSize)
+	for !cursor.Done() {
+		batch = batch[:0]
+		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			return w.txStore.ForEachUnspentOutputFrom(dbtx, coinType, &cursor,
+				unspentOutputBatchSize, func(c *udb.Credit) error {
+					batch = append(batch, c)
+					return nil
+				})
+		})
+		if err != nil {
+			return errors.E(op, err)
+		}
+		for _, c := range batch {
+			if err := f(c); err != nil {
+				return err
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return errors.E(op, err)
+		}
+	}
+	return nil
+}
+
 // SelectInputs selects transaction inputs to redeem unspent outputs stored in
 // the wallet.  It returns an input detail summary.
 func (w *Wallet) SelectInputs(ctx context.Context, targetAmount dcrutil.Amount, policy OutputSelectionPolicy) (inputDetail *txauthor.InputDetail, err error) {
 	const op errors.Op = "wallet.SelectInputs"
 
 	defer w.lockedOutpointMu.Unlock()
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 
 	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
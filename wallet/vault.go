@@ -0,0 +1,481 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/hdkeychain"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/sign"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/txauthor"
+	"github.com/monetarium/monetarium-wallet/wallet/txsizes"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// vaultParams describes the redeem scripts of a vault account.
+type vaultParams struct {
+	delay    uint32
+	recovery *hdkeychain.ExtendedKey // nil unless the vault has a recovery key
+}
+
+// childPubKey returns the serialized public key of an extended key derived at
+// a branch and child index.
+func childPubKey(k *hdkeychain.ExtendedKey, branch, child uint32) ([]byte, error) {
+	branchKey, err := k.Child(branch)
+	if err != nil {
+		return nil, err
+	}
+	childKey, err := branchKey.Child(child)
+	if err != nil {
+		return nil, err
+	}
+	return childKey.SerializedPubKey(), nil
+}
+
+// redeemScript returns the redeem script of the vault account address at a
+// branch and child index.  The script pays the account key once the output
+// has delay confirmations:
+//
+//	<delay> CHECKSEQUENCEVERIFY DROP <pubkey> CHECKSIG
+//
+// or, with a recovery key, also pays the recovery key derived at the same path
+// without delay:
+//
+//	IF <delay> CHECKSEQUENCEVERIFY DROP <pubkey> ELSE <recovery pubkey> ENDIF CHECKSIG
+func (v *vaultParams) redeemScript(xpub *hdkeychain.ExtendedKey, branch, child uint32) ([]byte, error) {
+	pubKey, err := childPubKey(xpub, branch, child)
+	if err != nil {
+		return nil, err
+	}
+	b := txscript.NewScriptBuilder()
+	if v.recovery == nil {
+		b.AddInt64(int64(v.delay))
+		b.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+		b.AddOp(txscript.OP_DROP)
+		b.AddData(pubKey)
+		b.AddOp(txscript.OP_CHECKSIG)
+		return b.Script()
+	}
+	recoveryKey, err := childPubKey(v.recovery, branch, child)
+	if err != nil {
+		return nil, err
+	}
+	b.AddOp(txscript.OP_IF)
+	b.AddInt64(int64(v.delay))
+	b.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+	b.AddOp(txscript.OP_DROP)
+	b.AddData(pubKey)
+	b.AddOp(txscript.OP_ELSE)
+	b.AddData(recoveryKey)
+	b.AddOp(txscript.OP_ENDIF)
+	b.AddOp(txscript.OP_CHECKSIG)
+	return b.Script()
+}
+
+// vaultScript is a parsed vault redeem script.
+type vaultScript struct {
+	delay       uint32
+	pubKey      []byte
+	recoveryKey []byte // nil without a recovery key
+}
+
+// parseVaultScript parses a redeem script created by vaultParams.redeemScript.
+// It returns nil for scripts of any other form.
+func parseVaultScript(script []byte) *vaultScript {
+	var ops []byte
+	var pushes [][]byte
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+	for tokenizer.Next() {
+		ops = append(ops, tokenizer.Opcode())
+		pushes = append(pushes, tokenizer.Data())
+	}
+	if tokenizer.Err() != nil {
+		return nil
+	}
+
+	// The script of vaults with a recovery key wraps the delayed spend in
+	// an IF branch.
+	var recoveryKey []byte
+	if len(ops) == 9 && ops[0] == txscript.OP_IF && ops[5] == txscript.OP_ELSE &&
+		ops[7] == txscript.OP_ENDIF && ops[8] == txscript.OP_CHECKSIG &&
+		ops[6] == txscript.OP_DATA_33 {
+
+		recoveryKey = pushes[6]
+		ops = append(ops[1:5], ops[8])
+		pushes = append(pushes[1:5], pushes[8])
+	}
+	if len(ops) != 5 || ops[1] != txscript.OP_CHECKSEQUENCEVERIFY ||
+		ops[2] != txscript.OP_DROP || ops[3] != txscript.OP_DATA_33 ||
+		ops[4] != txscript.OP_CHECKSIG {
+		return nil
+	}
+	delay, err := txscript.MakeScriptNum(pushes[0], 3)
+	if ops[0] >= txscript.OP_1 && ops[0] <= txscript.OP_16 {
+		delay, err = txscript.ScriptNum(ops[0]-txscript.OP_1+1), nil
+	}
+	if err != nil || delay <= 0 || delay > udb.MaxVaultDelay {
+		return nil
+	}
+	return &vaultScript{
+		delay:       uint32(delay),
+		pubKey:      pushes[3],
+		recoveryKey: recoveryKey,
+	}
+}
+
+// vaultSigScript returns the signature script spending a vault output with a
+// signature of the account key after the relative lock time.
+func vaultSigScript(redeemScript, sig []byte, v *vaultScript) ([]byte, error) {
+	b := txscript.NewScriptBuilder()
+	b.AddData(sig)
+	if v.recoveryKey != nil {
+		b.AddOp(txscript.OP_TRUE)
+	}
+	b.AddData(redeemScript)
+	return b.Script()
+}
+
+// vaultAddress is a P2SH address of a vault account.
+type vaultAddress struct {
+	*stdaddr.AddressScriptHashV0
+	script      []byte
+	accountName string
+	account     uint32
+	branch      uint32
+	child       uint32
+}
+
+var _ P2SHAddress = (*vaultAddress)(nil)
+
+func (v *vaultAddress) ScriptLen() int                 { return txsizes.P2SHPkScriptSize }
+func (v *vaultAddress) AccountName() string            { return v.accountName }
+func (v *vaultAddress) AccountKind() AccountKind       { return AccountKindBIP0044 }
+func (v *vaultAddress) RedeemScript() (uint16, []byte) { return 0, v.script }
+
+// vaultAddress returns the P2SH address of a vault account at a branch and
+// child index, and queues the address to be watched by the network backend.
+// The redeem script is recorded when the child index is persisted.
+func (w *Wallet) vaultAddress(ad *bip0044AccountData, accountName string,
+	account, branch, child uint32) (*vaultAddress, error) {
+
+	script, err := ad.vault.redeemScript(ad.xpub, branch, child)
+	if err != nil {
+		return nil, err
+	}
+	p2sh, err := stdaddr.NewAddressScriptHashV0(script, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := w.NetworkBackend(); err == nil {
+		w.queueTxFilterAddrs(n, []stdaddr.Address{p2sh})
+	}
+	return &vaultAddress{
+		AddressScriptHashV0: p2sh,
+		script:              script,
+		accountName:         accountName,
+		account:             account,
+		branch:              branch,
+		child:               child,
+	}, nil
+}
+
+// importVaultScript records the redeem script of a vault account address at a
+// branch and child index, so outputs paying the P2SH address are credited to
+// the account.  It does nothing for other accounts.
+func (w *Wallet) importVaultScript(dbtx walletdb.ReadWriteTx, account, branch, child uint32) error {
+	ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+	delay, recovery, err := w.manager.AccountVault(ns, account)
+	if errors.Is(err, errors.NotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	xpub, err := w.manager.AccountExtendedPubKey(dbtx, account)
+	if err != nil {
+		return err
+	}
+	v := &vaultParams{delay: delay, recovery: recovery}
+	script, err := v.redeemScript(xpub, branch, child)
+	if err != nil {
+		return err
+	}
+	a, err := w.manager.ImportScript(ns, script)
+	if errors.Is(err, errors.Exist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return w.attachImportedAddress(ns, a.Address(), account)
+}
+
+// CreateVaultAccount creates an account which pays to P2SH vault scripts
+// spendable by the keys of the account only once outputs have delay
+// confirmations, limiting how quickly a compromised wallet can be emptied.
+// When recovery is non-nil, the key derived from the recovery extended public
+// key at the same branch and child index as each address may spend its
+// outputs without delay, for example to move coins to safety from a
+// compromised vault.  The recovery key holder signs such spends externally.
+//
+// Outputs of vault accounts are not selected by regular sends, and are spent
+// with SendFromVault.
+func (w *Wallet) CreateVaultAccount(ctx context.Context, name string, delay uint32,
+	recovery *hdkeychain.ExtendedKey) (uint32, error) {
+
+	const op errors.Op = "wallet.CreateVaultAccount"
+
+	if delay == 0 || delay > udb.MaxVaultDelay {
+		return 0, errors.E(op, errors.Invalid, errors.Errorf("vault delay "+
+			"must be 1 to %d blocks", udb.MaxVaultDelay))
+	}
+	if recovery != nil && recovery.IsPrivate() {
+		return 0, errors.E(op, errors.Invalid, "recovery key must be an "+
+			"extended public key")
+	}
+	vault := &vaultParams{delay: delay, recovery: recovery}
+	account, err := w.nextAccount(ctx, name, nil, vault)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return account, nil
+}
+
+// vaultInput is a vault output which may be spent by the account key.
+type vaultInput struct {
+	credit       *udb.Credit
+	redeemScript []byte
+	script       *vaultScript
+}
+
+// SendFromVault spends the vault outputs of account which have reached the
+// relative lock time of the vault to pay outputs of a single coin type.
+// Change is returned to a new internal address of the vault, and so is locked
+// again.  Input sequence numbers encode the relative lock time, which the
+// transaction author enforces with a transaction version supporting
+// OP_CHECKSEQUENCEVERIFY.  Sends are evaluated by the send policy and counted
+// against the daily spend limit of the account, and a send exceeding the limit
+// is refused, or recorded as a pending send when the limit requires approval.
+// The published transaction is returned.
+func (w *Wallet) SendFromVault(ctx context.Context, account uint32,
+	outputs []*wire.TxOut) (*wire.MsgTx, error) {
+
+	const op errors.Op = "wallet.SendFromVault"
+
+	if len(outputs) == 0 {
+		return nil, errors.E(op, errors.Invalid, "no outputs")
+	}
+	coinType := outputs[0].CoinType
+	for _, out := range outputs[1:] {
+		if out.CoinType != coinType {
+			return nil, errors.E(op, errors.Invalid, "outputs must pay a single coin type")
+		}
+	}
+	if !w.isVaultAccount(account) {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("account %d "+
+			"is not a vault account", account))
+	}
+
+	p := &udb.PendingSend{
+		Account:       account,
+		ChangeAccount: account,
+		MinConf:       1,
+		CoinType:      coinType,
+		Outputs:       outputs,
+		Amount:        sendAmount(outputs),
+	}
+	w.spendLimitMu.Lock()
+	defer w.spendLimitMu.Unlock()
+	if err := w.checkSendPolicy(ctx, op, p); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	limit, err := w.checkSpendLimit(ctx, op, p, now, false)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := w.sendFromVault(ctx, op, account, coinType, outputs)
+	if err != nil {
+		return nil, err
+	}
+	if limit != nil {
+		hash := tx.TxHash()
+		w.countSpent(ctx, limit, now, p.Amount, &hash)
+	}
+	return tx, nil
+}
+
+// isVaultAccount returns whether account is a vault account.
+func (w *Wallet) isVaultAccount(account uint32) bool {
+	w.addressBuffersMu.Lock()
+	defer w.addressBuffersMu.Unlock()
+	ad, ok := w.addressBuffers[account]
+	return ok && ad.vault != nil
+}
+
+// sendFromVault authors, signs and publishes a transaction spending the vault
+// outputs of account to pay outputs of the coin type, without checking it
+// against the send policy and daily spend limits.
+func (w *Wallet) sendFromVault(ctx context.Context, op errors.Op, account uint32,
+	coinType cointype.CoinType, outputs []*wire.TxOut) (*wire.MsgTx, error) {
+
+	var inputs []vaultInput
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		inputs, err = w.vaultInputs(dbtx, account, coinType)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	inputSource := func(target dcrutil.Amount) (*txauthor.InputDetail, error) {
+		detail := new(txauthor.InputDetail)
+		for _, in := range inputs {
+			if !coinType.IsSKA() && target != 0 && detail.Amount >= target {
+				break
+			}
+			c := in.credit
+			txIn := wire.NewTxIn(&c.OutPoint, int64(c.Amount), nil)
+			txIn.Sequence = in.script.delay
+			if coinType.IsSKA() {
+				txIn.ValueIn = 0
+				txIn.SKAValueIn = c.SKAAmount.BigInt()
+				detail.SKAAmount = detail.SKAAmount.Add(c.SKAAmount)
+			} else {
+				detail.Amount += c.Amount
+			}
+			detail.Inputs = append(detail.Inputs, txIn)
+			detail.Scripts = append(detail.Scripts, c.PkScript)
+			detail.RedeemScriptSizes = append(detail.RedeemScriptSizes,
+				txsizes.RedeemVaultSigScriptSize)
+		}
+		return detail, nil
+	}
+	changeSource := &p2PKHChangeSource{
+		persist:    w.persistReturnedChild(ctx, nil),
+		account:    account,
+		wallet:     w,
+		ctx:        ctx,
+		gapPolicy:  gapPolicyWrap,
+		scriptType: udb.ChangeScriptP2PKH,
+	}
+	atx, err := txauthor.NewUnsignedTransaction(outputs, w.RelayFeeForCoinType(ctx, coinType),
+		inputSource, changeSource, w.chainParams.MaxTxSize)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if atx.ChangeIndex >= 0 {
+		atx.RandomizeChangePosition()
+	}
+
+	tx := atx.Tx
+	byOutPoint := make(map[wire.OutPoint]*vaultInput, len(inputs))
+	for i := range inputs {
+		byOutPoint[inputs[i].credit.OutPoint] = &inputs[i]
+	}
+	for i, txIn := range tx.TxIn {
+		in := byOutPoint[txIn.PreviousOutPoint]
+		if err := w.signVaultInput(ctx, tx, i, in); err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	if err := validateMsgTx(op, tx, atx.PrevScripts); err != nil {
+		return nil, err
+	}
+
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if _, err := w.publishTransaction(ctx, tx, n); err != nil {
+		return nil, errors.E(op, err)
+	}
+	return tx, nil
+}
+
+// vaultInputs returns the unspent, unlocked vault outputs of account and coin
+// type which have reached the relative lock time of their redeem scripts.
+func (w *Wallet) vaultInputs(dbtx walletdb.ReadTx, account uint32,
+	coinType cointype.CoinType) ([]vaultInput, error) {
+
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	unspent, err := w.txStore.UnspentOutputs(dbtx, coinType)
+	if err != nil {
+		return nil, err
+	}
+	_, tipHeight := w.txStore.MainChainTip(dbtx)
+
+	w.lockedOutpointMu.Lock()
+	defer w.lockedOutpointMu.Unlock()
+
+	var inputs []vaultInput
+	for _, c := range unspent {
+		if c.CoinType != coinType {
+			continue
+		}
+		if _, locked := w.lockedOutpoints[outpoint{c.Hash, c.Index}]; locked {
+			continue
+		}
+		class, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, c.PkScript, w.chainParams)
+		if class != stdscript.STScriptHash || len(addrs) != 1 {
+			continue
+		}
+		addrAcct, err := w.manager.AddrAccount(addrmgrNs, addrs[0])
+		if err != nil || addrAcct != account {
+			continue
+		}
+		redeemScript, err := w.manager.RedeemScript(addrmgrNs, addrs[0])
+		if err != nil {
+			continue
+		}
+		v := parseVaultScript(redeemScript)
+		if v == nil || !confirmed(int32(v.delay), c.Height, tipHeight) {
+			continue
+		}
+		inputs = append(inputs, vaultInput{
+			credit:       c,
+			redeemScript: redeemScript,
+			script:       v,
+		})
+	}
+	return inputs, nil
+}
+
+// signVaultInput signs input idx of tx, which spends the vault output in, with
+// the account key of the vault script.
+func (w *Wallet) signVaultInput(ctx context.Context, tx *wire.MsgTx, idx int, in *vaultInput) error {
+	if in == nil {
+		return errors.E(errors.Bug, errors.Errorf("input %d does not spend "+
+			"a vault output", idx))
+	}
+	keyAddr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		stdaddr.Hash160(in.script.pubKey), w.chainParams)
+	if err != nil {
+		return err
+	}
+	key, zero, err := w.LoadPrivateKey(ctx, keyAddr)
+	if err != nil {
+		return err
+	}
+	defer zero()
+	sig, err := sign.RawTxInSignature(tx, idx, in.redeemScript, txscript.SigHashAll,
+		key.Serialize(), dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		return err
+	}
+	tx.TxIn[idx].SignatureScript, err = vaultSigScript(in.redeemScript, sig, in.script)
+	return err
+}
@@ -0,0 +1,278 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/gcs/blockcf2"
+	"github.com/monetarium/monetarium-node/hdkeychain"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/txsizes"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+func TestVaultAccount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 10
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	recoveryPriv, err := hdkeychain.NewMaster(bytes.Repeat([]byte{7}, 32), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recovery := recoveryPriv.Neuter()
+
+	const delay = 10
+	if _, err := w.CreateVaultAccount(ctx, "vault", 0, recovery); !errors.Is(err, errors.Invalid) {
+		t.Errorf("zero delay: expected Invalid error, got %v", err)
+	}
+	if _, err := w.CreateVaultAccount(ctx, "vault", udb.MaxVaultDelay+1, recovery); !errors.Is(err, errors.Invalid) {
+		t.Errorf("excessive delay: expected Invalid error, got %v", err)
+	}
+	if _, err := w.CreateVaultAccount(ctx, "vault", delay, recoveryPriv); !errors.Is(err, errors.Invalid) {
+		t.Errorf("private recovery key: expected Invalid error, got %v", err)
+	}
+	account, err := w.CreateVaultAccount(ctx, "vault", delay, recovery)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.SetChangeScriptType(ctx, account, udb.ChangeScriptP2SH); !errors.Is(err, errors.Invalid) {
+		t.Errorf("vault change script type: expected Invalid error, got %v", err)
+	}
+
+	addr, err := w.NewExternalAddress(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	va, ok := addr.(*vaultAddress)
+	if !ok {
+		t.Fatalf("vault account returned %T address", addr)
+	}
+	_, redeemScript := va.RedeemScript()
+	if len(redeemScript) > txsizes.VaultScriptSize {
+		t.Errorf("redeem script size %d exceeds the estimate", len(redeemScript))
+	}
+	v := parseVaultScript(redeemScript)
+	if v == nil || v.delay != delay || v.recoveryKey == nil {
+		t.Fatalf("unexpected vault script %x", redeemScript)
+	}
+
+	// The redeem script must be recorded and the address credited to the
+	// vault account.
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		acct, err := w.manager.AddrAccount(addrmgrNs, addr)
+		if err != nil {
+			return err
+		}
+		if acct != account {
+			t.Errorf("vault address credits account %d", acct)
+		}
+		script, err := w.manager.RedeemScript(addrmgrNs, addr)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(script, redeemScript) {
+			t.Errorf("recorded redeem script %x, want %x", script, redeemScript)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The account key may only spend the output with an input sequence
+	// encoding the vault delay.
+	_, pkScript := addr.PaymentScript()
+	spend := func(sequence uint32) error {
+		tx := &wire.MsgTx{Version: 2}
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 0}, 1e8, nil))
+		tx.TxIn[0].Sequence = sequence
+		tx.AddTxOut(wire.NewTxOut(1e8-1e5, []byte{txscript.OP_TRUE}))
+		in := &vaultInput{redeemScript: redeemScript, script: v}
+		if err := w.signVaultInput(ctx, tx, 0, in); err != nil {
+			return err
+		}
+		if n := len(tx.TxIn[0].SignatureScript); n > txsizes.RedeemVaultSigScriptSize {
+			t.Errorf("signature script size %d exceeds the estimate", n)
+		}
+		vm, err := txscript.NewEngine(pkScript, tx, 0, sanityVerifyFlags, 0, nil)
+		if err != nil {
+			return err
+		}
+		return vm.Execute()
+	}
+	if err := spend(delay); err != nil {
+		t.Errorf("spend after delay: %v", err)
+	}
+	if err := spend(delay - 1); err == nil {
+		t.Errorf("spend before delay succeeded")
+	}
+}
+
+// publishingNetwork is a mock network backend recording published
+// transactions.
+type publishingNetwork struct {
+	mockNetwork
+	published []*wire.MsgTx
+}
+
+func (n *publishingNetwork) PublishTransactions(ctx context.Context, txs ...*wire.MsgTx) error {
+	n.published = append(n.published, txs...)
+	return nil
+}
+
+func TestSendFromVaultPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 10
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	recoveryPriv, err := hdkeychain.NewMaster(bytes.Repeat([]byte{7}, 32), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const delay = 1
+	account, err := w.CreateVaultAccount(ctx, "vault", delay, recoveryPriv.Neuter())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Mine a transaction paying the vault two outputs of ten coins, which
+	// reach the vault delay in the block mining them.
+	addr, err := w.NewExternalAddress(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vers, script := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, 20e8, nil))
+	for i := 0; i < 2; i++ {
+		out := wire.NewTxOut(10e8, script)
+		out.Version = vers
+		fund.AddTxOut(out)
+	}
+	tipHash, _ := w.MainChainTip(ctx)
+	h := &wire.BlockHeader{
+		PrevBlock: tipHash,
+		VoteBits:  dcrutil.BlockValid,
+		Height:    1,
+		Timestamp: time.Unix(1700000000, 0),
+	}
+	block := &wire.MsgBlock{Header: *h}
+	block.AddTransaction(fund)
+	filter, err := blockcf2.Regular(block, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := h.BlockHash()
+	var forest SidechainForest
+	_, err = w.ChainSwitch(ctx, &forest, []*BlockNode{NewBlockNode(h, &hash, filter)},
+		map[chainhash.Hash][]*wire.MsgTx{hash: {fund}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := new(publishingNetwork)
+	w.SetNetworkBackend(n)
+
+	dest, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	destVers, destScript := dest.PaymentScript()
+	pay := func(atoms int64) []*wire.TxOut {
+		out := wire.NewTxOut(atoms, destScript)
+		out.Version = destVers
+		return []*wire.TxOut{out}
+	}
+
+	// Vault sends to blocked addresses are refused.
+	if err := w.BlockAddress(ctx, dest, "sanctioned"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.SendFromVault(ctx, account, pay(2e8)); !errors.Is(err, errors.Policy) {
+		t.Fatalf("vault send to blocked address: expected Policy error, got %v", err)
+	}
+	if err := w.UnblockAddress(ctx, dest); err != nil {
+		t.Fatal(err)
+	}
+
+	// Vault sends exceeding the daily spend limit are refused, and sends
+	// within it are counted.
+	err = w.SetSpendLimit(ctx, account, cointype.CoinTypeVAR, big.NewInt(3e8), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.SendFromVault(ctx, account, pay(4e8)); !errors.Is(err, errors.Policy) {
+		t.Fatalf("vault send exceeding limit: expected Policy error, got %v", err)
+	}
+	if len(n.published) != 0 {
+		t.Fatalf("refused vault sends were published")
+	}
+	if _, err := w.SendFromVault(ctx, account, pay(2e8)); err != nil {
+		t.Fatal(err)
+	}
+	limits, err := w.SpendLimits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(n.published) != 1 || limits[0].SpentOn(time.Now()).Int64() != 2e8 {
+		t.Fatalf("published %d transactions, counted %v atoms, want 1 and 2e8",
+			len(n.published), limits[0].SpentOn(time.Now()))
+	}
+
+	// Vault sends exceeding a limit requiring approval are recorded as
+	// pending sends, and approving them spends the remaining vault output.
+	err = w.SetSpendLimit(ctx, account, cointype.CoinTypeVAR, big.NewInt(3e8), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.SendFromVault(ctx, account, pay(2e8)); !errors.Is(err, errors.Policy) {
+		t.Fatalf("vault send awaiting approval: expected Policy error, got %v", err)
+	}
+	pending, err := w.PendingSends(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || pending[0].Account != account || len(n.published) != 1 {
+		t.Fatalf("unexpected pending sends %+v", pending)
+	}
+	if _, err := w.ApprovePendingSend(ctx, pending[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if len(n.published) != 2 {
+		t.Fatalf("approved vault send was not published")
+	}
+	for _, in := range n.published[1].TxIn {
+		if in.PreviousOutPoint.Hash != fund.TxHash() || in.Sequence != delay {
+			t.Errorf("approved vault send input %v with sequence %d does "+
+				"not spend a vault output", &in.PreviousOutPoint, in.Sequence)
+		}
+	}
+}
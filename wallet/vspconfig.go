@@ -0,0 +1,135 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+)
+
+// vspClientConfig returns the client configuration of a selected VSP, paying
+// fees from, and deriving fee change addresses of, its fee account.
+func (w *Wallet) vspClientConfig(cfg *udb.VSPConfig) VSPClientConfig {
+	return VSPClientConfig{
+		URL:    cfg.Host,
+		PubKey: cfg.PubKey,
+		Policy: &VSPPolicy{
+			MaxFee:     w.VSPMaxFee(),
+			FeeAcct:    cfg.FeeAccount,
+			ChangeAcct: cfg.FeeAccount,
+		},
+	}
+}
+
+// SetVSP selects the VSP to register purchased tickets with, and returns its
+// client.  The selection is saved in the wallet database.  An empty host
+// removes the selection and returns a nil client.
+func (w *Wallet) SetVSP(ctx context.Context, cfg *udb.VSPConfig) (*VSPClient, error) {
+	const op errors.Op = "wallet.SetVSP"
+
+	if cfg.Host == "" {
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			return udb.DeleteVSPConfig(dbtx)
+		})
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		return nil, nil
+	}
+
+	if _, err := w.AccountName(ctx, cfg.FeeAccount); err != nil {
+		return nil, errors.E(op, err)
+	}
+	client, err := w.VSP(w.vspClientConfig(cfg))
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutVSPConfig(dbtx, cfg)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return client, nil
+}
+
+// SelectedVSP returns the client of the VSP selected with SetVSP.  An error
+// with kind NotExist is returned if no VSP is selected.
+func (w *Wallet) SelectedVSP(ctx context.Context) (*VSPClient, *udb.VSPConfig, error) {
+	const op errors.Op = "wallet.SelectedVSP"
+
+	var cfg *udb.VSPConfig
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		cfg, err = udb.SelectedVSPConfig(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+	client, err := w.VSP(w.vspClientConfig(cfg))
+	if err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+	return client, cfg, nil
+}
+
+// RetryVSPFeePayments retries the VSP fee payment of each unspent and
+// unexpired ticket whose registration with a VSP failed.  Tickets are only
+// retried with the VSP they failed to register with, and only if a client for
+// that VSP has been created.  Retries of all tickets are attempted, and any
+// errors are returned together.
+func (w *Wallet) RetryVSPFeePayments(ctx context.Context) error {
+	const op errors.Op = "wallet.RetryVSPFeePayments"
+
+	var errored map[chainhash.Hash]*udb.VSPTicket
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		errored, err = udb.GetVSPTicketsByFeeStatus(dbtx,
+			int(udb.VSPFeeProcessErrored))
+		return err
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if len(errored) == 0 {
+		return nil
+	}
+
+	var errs []error
+	err = w.ForUnspentUnexpiredTickets(ctx, func(hash *chainhash.Hash) error {
+		data, ok := errored[*hash]
+		if !ok {
+			return nil
+		}
+		client, err := w.LookupVSP(data.Host)
+		if err != nil {
+			return nil
+		}
+		ticket, err := w.NewVSPTicket(ctx, hash)
+		if err != nil {
+			errs = append(errs, err)
+			return nil
+		}
+		logCtx(ctx).Infof("Retrying VSP fee payment for ticket %v with %s", hash,
+			data.Host)
+		err = client.Process(ctx, ticket, nil)
+		if err != nil {
+			errs = append(errs, errors.Errorf("ticket %v: %w", hash, err))
+		}
+		return nil
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) != 0 {
+		return errors.E(op, errors.Join(errs...))
+	}
+	return nil
+}
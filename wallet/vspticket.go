@@ -113,7 +113,7 @@ func (v *VSPTicket) calcHeights(ctx context.Context) {
 	if err != nil {
 		// This is not expected to ever error, as the ticket has already been
 		// fetched from the wallet at least one before this point is reached.
-		log.Errorf("Failed to query block which mines ticket: %v", err)
+		logCtx(ctx).Errorf("Failed to query block which mines ticket: %v", err)
 		return
 	}
 
@@ -11,6 +11,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"maps"
 	"math/big"
 	"runtime"
 	"sort"
@@ -21,7 +22,9 @@ import (
 	"github.com/monetarium/monetarium-wallet/deployments"
 	"github.com/monetarium/monetarium-wallet/errors"
 	"github.com/monetarium/monetarium-wallet/internal/compat"
+	"github.com/monetarium/monetarium-wallet/internal/inflight"
 	"github.com/monetarium/monetarium-wallet/internal/loggers"
+	"github.com/monetarium/monetarium-wallet/internal/rpctrace"
 	"github.com/monetarium/monetarium-wallet/rpc/client/dcrd"
 	"github.com/monetarium/monetarium-wallet/rpc/jsonrpc/types"
 	"github.com/monetarium/monetarium-wallet/validate"
@@ -40,6 +43,7 @@ import (
 	"github.com/monetarium/monetarium-node/dcrec"
 	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
 	"github.com/monetarium/monetarium-node/dcrec/secp256k1/ecdsa"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1/schnorr"
 	"github.com/monetarium/monetarium-node/dcrutil"
 	gcs2 "github.com/monetarium/monetarium-node/gcs"
 	"github.com/monetarium/monetarium-node/hdkeychain"
@@ -105,10 +109,22 @@ type Wallet struct {
 	// disapprove on simnet or testnet.
 	disapprovePercent atomic.Uint32
 
+	// avoidAddressReuse and avoidPartialSpends are atomics.  They set the
+	// address reuse policy described by SetAvoidAddressReuse and
+	// SetAvoidPartialSpends.
+	avoidAddressReuse  atomic.Bool
+	avoidPartialSpends atomic.Bool
+
+	// changeSplitCount and changeSplitDistribution are atomics.  They set
+	// the splitting of change described by SetChangeSplit.
+	changeSplitCount        atomic.Uint32
+	changeSplitDistribution atomic.Int32
+
 	// Data stores
-	db      walletdb.DB
-	manager *udb.Manager
-	txStore *udb.Store
+	db        walletdb.DB
+	changelog *udb.ChangelogDB
+	manager   *udb.Manager
+	txStore   *udb.Store
 
 	// Handlers for stake system.
 	stakeSettingsLock  sync.Mutex
@@ -135,9 +151,48 @@ type Wallet struct {
 	networkBackend   NetworkBackend
 	networkBackendMu sync.Mutex
 
+	// Addresses pending registration with the network backend's
+	// transaction filter.
+	txFilterQueue txFilterQueue
+
+	// externalSigner, when set, signs transactions authored by the wallet
+	// instead of the wallet's private keys.
+	externalSigner   txauthor.SecretsSource
+	externalSignerMu sync.Mutex
+
+	// priceSource, when set, is queried for the prices of coin types of
+	// wallet transactions mined in connected blocks.
+	priceSource   PriceSource
+	priceCurrency string
+	priceSourceMu sync.Mutex
+
+	// readOnly is set when the wallet database was opened read-only.
+	readOnly bool
+
+	// ops counts the transaction authoring and publishing in progress,
+	// which is waited on by Drain before the wallet is closed.
+	ops inflight.Tracker
+
 	lockedOutpoints  map[outpoint]struct{}
 	lockedOutpointMu sync.Mutex
 
+	// spendLimitMu serializes sends checked against daily spend limits.
+	spendLimitMu sync.Mutex
+
+	// scheduledSendMu serializes changes to the status of scheduled sends.
+	scheduledSendMu sync.Mutex
+
+	// recurringPaymentMu serializes changes to recurring payments.
+	recurringPaymentMu sync.Mutex
+
+	// inheritanceMu serializes changes to dead-man's switches, whose
+	// transactions are refreshed when activity is signaled.
+	inheritanceMu       sync.Mutex
+	inheritanceActivity chan struct{}
+
+	// Unspent outputs which may be selected as transaction inputs.
+	utxoCache utxoCache
+
 	relayFee      dcrutil.Amount
 	relayFeeMu    sync.Mutex
 	skaRelayFee   dcrutil.Amount
@@ -154,6 +209,10 @@ type Wallet struct {
 	recentlyPublishedMu        sync.Mutex
 	logRescannedTransactions   bool
 	logRescannedTransactionsMu sync.Mutex
+	rescanState                *rescanState
+	rescanStateMu              sync.Mutex
+	compactStatus              *CompactStatus
+	compactStatusMu            sync.Mutex
 
 	// Internal address handling.
 	addressBuffers   map[uint32]*bip0044AccountData
@@ -163,6 +222,7 @@ type Wallet struct {
 	passphraseUsedMu        sync.RWMutex
 	passphraseTimeoutMu     sync.Mutex
 	passphraseTimeoutCancel chan struct{}
+	passphraseDeadline      time.Time // protected by passphraseTimeoutMu
 
 	// Mixing
 	mixingEnabled bool
@@ -209,9 +269,23 @@ type Config struct {
 	VSPMaxFee     dcrutil.Amount
 	Params        *chaincfg.Params
 
+	// MigrationBackupDir, if set, is the directory a copy of the database
+	// is written to before performing any database upgrades.
+	MigrationBackupDir string
+
+	// ReadOnly opens a database which was opened read-only.  The database
+	// is not migrated, upgraded, or otherwise written while opening the
+	// wallet, and opening errors if any upgrade is pending.
+	ReadOnly bool
+
 	Dialer DialFunc
 }
 
+// ReadOnly returns whether the wallet was opened with a read-only database.
+func (w *Wallet) ReadOnly() bool {
+	return w.readOnly
+}
+
 // DisapprovePercent returns the wallet's block disapproval percentage.
 func (w *Wallet) DisapprovePercent() uint32 {
 	return w.disapprovePercent.Load()
@@ -223,6 +297,53 @@ func (w *Wallet) SetDisapprovePercent(percent uint32) {
 	w.disapprovePercent.Store(percent)
 }
 
+// AvoidAddressReuse returns whether the wallet refuses to return previously
+// returned addresses.
+func (w *Wallet) AvoidAddressReuse() bool {
+	return w.avoidAddressReuse.Load()
+}
+
+// SetAvoidAddressReuse sets whether the wallet refuses to return previously
+// returned addresses.  When set, requests for new or change addresses which
+// would wrap around to a previously returned address instead ignore the gap
+// limit and return a new address.
+func (w *Wallet) SetAvoidAddressReuse(avoid bool) {
+	w.avoidAddressReuse.Store(avoid)
+}
+
+// AvoidPartialSpends returns whether transactions created by the wallet spend
+// every output paying to the address of any selected input.
+func (w *Wallet) AvoidPartialSpends() bool {
+	return w.avoidPartialSpends.Load()
+}
+
+// SetAvoidPartialSpends sets whether transactions created by the wallet spend
+// every output paying to the address of any selected input, so that outputs
+// of an address, such as the SSFee rewards paid to a reward address, are
+// never split across transactions which would link them.
+func (w *Wallet) SetAvoidPartialSpends(avoid bool) {
+	w.avoidPartialSpends.Store(avoid)
+}
+
+// ChangeSplit returns the number of outputs which change of transactions
+// created by the wallet is split into, and the distribution of the change
+// value between them.
+func (w *Wallet) ChangeSplit() (uint32, txauthor.ChangeDistribution) {
+	return w.changeSplitCount.Load(),
+		txauthor.ChangeDistribution(w.changeSplitDistribution.Load())
+}
+
+// SetChangeSplit sets the number of outputs which change of transactions
+// created by the wallet is split into, and the distribution of the change
+// value between them.  Splitting change into outputs of varying value breaks
+// heuristics identifying the change of a transaction by its amount.  Change
+// is not split when count is less than two, and is split into fewer outputs
+// when an output would otherwise be dust.
+func (w *Wallet) SetChangeSplit(count uint32, distribution txauthor.ChangeDistribution) {
+	w.changeSplitCount.Store(count)
+	w.changeSplitDistribution.Store(int32(distribution))
+}
+
 // FetchOutput fetches the associated transaction output given an outpoint.
 // It cannot be used to fetch multi-signature outputs.
 func (w *Wallet) FetchOutput(ctx context.Context, outPoint *wire.OutPoint) (*wire.TxOut, error) {
@@ -773,8 +894,12 @@ func (w *Wallet) SetTSpendPolicy(ctx context.Context, tspendHash *chainhash.Hash
 //
 // The accountNameOrNumber parameter can be either an account name (string) or
 // account number (string representation of uint32).
+//
+// Unless external is true, the address must be derived from the account, so
+// that SSFee payments are not directed to an address the account does not
+// control.  Whether the address is external is recorded with the address.
 func (w *Wallet) SetVoteFeeConsolidationAddress(ctx context.Context,
-	accountNameOrNumber string, address stdaddr.Address) error {
+	accountNameOrNumber string, address stdaddr.Address, external bool) error {
 
 	const op errors.Op = "wallet.SetVoteFeeConsolidationAddress"
 
@@ -797,7 +922,14 @@ func (w *Wallet) SetVoteFeeConsolidationAddress(ctx context.Context,
 
 	// Store the consolidation address in the database
 	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
-		return udb.SetAccountConsolidationAddr(dbtx, accountName, (*hash160)[:])
+		if !external {
+			err := w.checkConsolidationAddrOwner(dbtx, accountName, address)
+			if err != nil {
+				return err
+			}
+		}
+		return udb.SetAccountConsolidationAddr(dbtx, accountName,
+			(*hash160)[:], external)
 	})
 	if err != nil {
 		return errors.E(op, err)
@@ -908,6 +1040,62 @@ func (w *Wallet) HasCustomConsolidationAddress(ctx context.Context,
 	return hasCustom, nil
 }
 
+// IsExternalConsolidationAddress returns whether the custom consolidation
+// address of an account is not controlled by the account.  Custom addresses set
+// before their ownership was recorded are reported as external.  The default
+// consolidation address is never external.
+func (w *Wallet) IsExternalConsolidationAddress(ctx context.Context,
+	accountNameOrNumber string) (bool, error) {
+
+	const op errors.Op = "wallet.IsExternalConsolidationAddress"
+
+	// Resolve account name from name or number
+	accountName, err := w.resolveAccountName(ctx, accountNameOrNumber)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+
+	var external bool
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		external, err = udb.IsAccountConsolidationAddrExternal(dbtx, accountName)
+		if errors.Is(err, errors.NotExist) {
+			// Using the default address.
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+
+	return external, nil
+}
+
+// checkConsolidationAddrOwner errors with code errors.Invalid unless the
+// address is derived from the account.
+func (w *Wallet) checkConsolidationAddrOwner(dbtx walletdb.ReadTx,
+	accountName string, address stdaddr.Address) error {
+
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+
+	accountNumber, err := w.manager.LookupAccount(addrmgrNs, accountName)
+	if err != nil {
+		return err
+	}
+	ma, err := w.manager.Address(addrmgrNs, address)
+	if err != nil && !errors.Is(err, errors.NotExist) {
+		return err
+	}
+	if err != nil || ma.Account() != accountNumber {
+		return errors.E(errors.Invalid, errors.Errorf("address %v is not "+
+			"derived from account %q; mark the address as external to "+
+			"direct vote fees to an address not controlled by the "+
+			"account", address, accountName))
+	}
+	return nil
+}
+
 // resolveAccountName converts an account name or number string to an account name.
 // If the input is a number, it looks up the corresponding account name.
 // If the input is already a name, it validates that the account exists.
@@ -1126,6 +1314,51 @@ func (w *Wallet) queryDynamicFee(ctx context.Context, ct cointype.CoinType) (dcr
 	return dcrutil.NewAmount(estimates.NormalFee)
 }
 
+// Fee preferences select one of the fee rates estimated by the network backend.
+const (
+	FeePreferenceSlow   = "slow"
+	FeePreferenceNormal = "normal"
+	FeePreferenceFast   = "fast"
+)
+
+// FeeRateForPreference returns the fee rate per kB estimated by the network
+// backend for transactions of a coin type at the fee preference pref.  Rates
+// below the backend's minimum relay fee are raised to the minimum.  Manual fee
+// overrides do not apply, as the caller chose an estimate explicitly.
+func (w *Wallet) FeeRateForPreference(ctx context.Context, ct cointype.CoinType,
+	pref string) (dcrutil.Amount, error) {
+
+	const op errors.Op = "wallet.FeeRateForPreference"
+
+	switch pref {
+	case FeePreferenceSlow, FeePreferenceNormal, FeePreferenceFast:
+	default:
+		return 0, errors.E(op, errors.Invalid,
+			errors.Errorf("unknown fee preference %q", pref))
+	}
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	estimates, err := n.GetFeeEstimatesByCoinType(ctx, uint8(ct))
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	fee := estimates.NormalFee
+	switch pref {
+	case FeePreferenceSlow:
+		fee = estimates.SlowFee
+	case FeePreferenceFast:
+		fee = estimates.FastFee
+	}
+	fee = max(fee, estimates.MinRelayFee)
+	rate, err := dcrutil.NewAmount(fee)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return rate, nil
+}
+
 // GetEffectiveFee returns the fee that will actually be used for transactions.
 // Priority: manual override > RPC dynamic fee > static config fee
 // Returns the fee amount, source ("manual", "rpc", or "static"), and any error.
@@ -1158,7 +1391,7 @@ func (w *Wallet) GetEffectiveFee(ctx context.Context, ct cointype.CoinType) (dcr
 func (w *Wallet) RelayFeeForCoinType(ctx context.Context, ct cointype.CoinType) dcrutil.Amount {
 	fee, _, err := w.GetEffectiveFee(ctx, ct)
 	if err != nil {
-		log.Warnf("Failed to get effective fee for coin type %d: %v", ct, err)
+		logCtx(ctx).Warnf("Failed to get effective fee for coin type %d: %v", ct, err)
 		w.feesMu.RLock()
 		static := w.staticFees[ct]
 		w.feesMu.RUnlock()
@@ -1186,6 +1419,52 @@ func (w *Wallet) MainChainTip(ctx context.Context) (hash chainhash.Hash, height
 	return
 }
 
+// Snapshot returns a context with which all wallet database reads observe the
+// same consistent state of the database, unaffected by concurrent block
+// processing and transaction creation.  The wallet may not be modified with
+// the returned context, and modifications by other callers may block until
+// the release function is called.  The release function must be called once
+// the snapshot is no longer used.
+//
+// Wallet mutexes held across database transactions, such as the locked
+// outpoints mutex held while connecting blocks and creating transactions,
+// are ordered before the snapshot.  Methods called with the returned context
+// must only read the database and must not wait on these mutexes.  The
+// locked outpoints are instead recorded when the snapshot is opened.
+func (w *Wallet) Snapshot(ctx context.Context) (context.Context, func(), error) {
+	const op errors.Op = "wallet.Snapshot"
+
+	if _, ok := ctx.Value(snapshotLockedOutpointsKey{}).(map[outpoint]struct{}); ok {
+		return ctx, func() {}, nil
+	}
+	w.lockedOutpointMu.Lock()
+	locked := maps.Clone(w.lockedOutpoints)
+	w.lockedOutpointMu.Unlock()
+
+	ctx, release, err := walletdb.Snapshot(ctx, w.db)
+	if err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+	ctx = context.WithValue(ctx, snapshotLockedOutpointsKey{}, locked)
+	return ctx, release, nil
+}
+
+// snapshotLockedOutpointsKey is the context key of the locked outpoints
+// recorded when a snapshot is opened.
+type snapshotLockedOutpointsKey struct{}
+
+// lockedOutpointsCopy returns a copy of the locked outpoints, or the locked
+// outpoints recorded by a snapshot of ctx.  It must not be called with
+// w.lockedOutpointMu held.
+func (w *Wallet) lockedOutpointsCopy(ctx context.Context) map[outpoint]struct{} {
+	if locked, ok := ctx.Value(snapshotLockedOutpointsKey{}).(map[outpoint]struct{}); ok {
+		return locked
+	}
+	w.lockedOutpointMu.Lock()
+	defer w.lockedOutpointMu.Unlock()
+	return maps.Clone(w.lockedOutpoints)
+}
+
 // BlockInMainChain returns whether hash is a block hash of any block in the
 // wallet's main chain.  If the block is in the main chain, invalidated reports
 // whether a child block in the main chain stake invalidates the queried block.
@@ -1292,6 +1571,7 @@ func (w *Wallet) watchHDAddrs(ctx context.Context, firstWatch bool, n NetworkBac
 		lastWatchedExternal, lastWatchedInternal   uint32
 		lastReturnedExternal, lastReturnedInternal uint32
 		lastUsedExternal, lastUsedInternal         uint32
+		gapLimit                                   uint32
 	}
 	hdAccounts := make(map[uint32]hdAccount)
 	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
@@ -1312,13 +1592,15 @@ func (w *Wallet) watchHDAddrs(ctx context.Context, firstWatch bool, n NetworkBac
 			if err != nil {
 				return err
 			}
+			gapLimit := w.addressGapLimit(props)
 			hdAccounts[acct] = hdAccount{
-				externalCount:        min(props.LastReturnedExternalIndex+w.gapLimit, hdkeychain.HardenedKeyStart-1),
-				internalCount:        min(props.LastReturnedInternalIndex+w.gapLimit, hdkeychain.HardenedKeyStart-1),
+				externalCount:        min(props.LastReturnedExternalIndex+gapLimit, hdkeychain.HardenedKeyStart-1),
+				internalCount:        min(props.LastReturnedInternalIndex+gapLimit, hdkeychain.HardenedKeyStart-1),
 				lastReturnedExternal: props.LastReturnedExternalIndex,
 				lastReturnedInternal: props.LastReturnedInternalIndex,
 				lastUsedExternal:     props.LastUsedExternalIndex,
 				lastUsedInternal:     props.LastUsedInternalIndex,
+				gapLimit:             gapLimit,
 			}
 			return nil
 		}
@@ -1391,6 +1673,12 @@ func (w *Wallet) watchHDAddrs(ctx context.Context, firstWatch bool, n NetworkBac
 	go func() {
 		for addrs := range watchAddrs {
 			count += uint64(len(addrs))
+			if !firstWatch {
+				// Addresses watched after newly used addresses
+				// are registered in batches.
+				w.queueTxFilterAddrs(n, addrs)
+				continue
+			}
 			err := n.LoadTxFilter(ctx, false, addrs, nil)
 			if err != nil {
 				watchError <- err
@@ -1401,9 +1689,9 @@ func (w *Wallet) watchHDAddrs(ctx context.Context, firstWatch bool, n NetworkBac
 		watchError <- nil
 	}()
 	var deriveError error
-	loadBranchAddrs := func(branchKey *hdkeychain.ExtendedKey, start, end uint32) {
-		if start == 0 && w.watchLast != 0 && end-w.gapLimit > w.watchLast {
-			start = end - w.gapLimit - w.watchLast
+	loadBranchAddrs := func(branchKey *hdkeychain.ExtendedKey, start, end, gapLimit uint32) {
+		if start == 0 && w.watchLast != 0 && end-gapLimit > w.watchLast {
+			start = end - gapLimit - w.watchLast
 		}
 		const step = 256
 		for ; start <= end; start += step {
@@ -1428,8 +1716,8 @@ func (w *Wallet) watchHDAddrs(ctx context.Context, firstWatch bool, n NetworkBac
 		}
 	}
 	for _, hd := range hdAccounts {
-		loadBranchAddrs(hd.externalKey, hd.lastWatchedExternal, hd.externalCount)
-		loadBranchAddrs(hd.internalKey, hd.lastWatchedInternal, hd.internalCount)
+		loadBranchAddrs(hd.externalKey, hd.lastWatchedExternal, hd.externalCount, hd.gapLimit)
+		loadBranchAddrs(hd.internalKey, hd.lastWatchedInternal, hd.internalCount, hd.gapLimit)
 		if ctx.Err() != nil || deriveError != nil {
 			break
 		}
@@ -1494,7 +1782,7 @@ func (w *Wallet) CoinTypePrivKey(ctx context.Context) (*hdkeychain.ExtendedKey,
 // outpoints for this wallet.
 func (w *Wallet) LoadActiveDataFilters(ctx context.Context, n NetworkBackend, reload bool) (err error) {
 	const op errors.Op = "wallet.LoadActiveDataFilters"
-	log.Infof("Loading active addresses and unspent outputs...")
+	logCtx(ctx).Infof("Loading active addresses and unspent outputs...")
 
 	if reload {
 		err := n.LoadTxFilter(ctx, true, nil, nil)
@@ -1525,7 +1813,7 @@ func (w *Wallet) LoadActiveDataFilters(ctx context.Context, n NetworkBackend, re
 	if err != nil {
 		return err
 	}
-	log.Infof("Registered for transaction notifications for %v HD address(es)", hdAddrCount)
+	logCtx(ctx).Infof("Registered for transaction notifications for %v HD address(es)", hdAddrCount)
 
 	// Watch individually-imported addresses (which must each be read out of
 	// the DB).
@@ -1557,11 +1845,11 @@ func (w *Wallet) LoadActiveDataFilters(ctx context.Context, n NetworkBackend, re
 		}
 	}
 	if importedAddrCount > 0 {
-		log.Infof("Registered for transaction notifications for %v imported address(es)", importedAddrCount)
+		logCtx(ctx).Infof("Registered for transaction notifications for %v imported address(es)", importedAddrCount)
 	}
 
 	defer w.lockedOutpointMu.Unlock()
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		err := w.txStore.ForEachUnspentOutpoint(dbtx, nil, watchOutPoint) // nil = all coin types
 		if err != nil {
@@ -1589,7 +1877,7 @@ func (w *Wallet) LoadActiveDataFilters(ctx context.Context, n NetworkBackend, re
 	if err != nil {
 		return errors.E(op, err)
 	}
-	log.Infof("Registered for transaction notifications for all relevant outputs")
+	logCtx(ctx).Infof("Registered for transaction notifications for all relevant outputs")
 
 	return nil
 }
@@ -1608,7 +1896,7 @@ func (w *Wallet) CommittedTickets(ctx context.Context, tickets []*chainhash.Hash
 			// Make sure ticket exists
 			tx, err := w.txStore.Tx(txmgrNs, v)
 			if err != nil {
-				log.Debugf("%v", err)
+				logCtx(ctx).Debugf("%v", err)
 				continue
 			}
 			if !stake.IsSStx(tx) {
@@ -1625,18 +1913,18 @@ func (w *Wallet) CommittedTickets(ctx context.Context, tickets []*chainhash.Hash
 				addr, err := stake.AddrFromSStxPkScrCommitment(scr,
 					w.chainParams)
 				if err != nil {
-					log.Debugf("%v", err)
+					logCtx(ctx).Debugf("%v", err)
 					break
 				}
 				if _, ok := addr.(*stdaddr.AddressPubKeyHashEcdsaSecp256k1V0); !ok {
-					log.Tracef("Skipping commitment at "+
+					logCtx(ctx).Tracef("Skipping commitment at "+
 						"index %v: address is not "+
 						"P2PKH", i)
 					continue
 				}
 				amt, err := stake.AmountFromSStxPkScrCommitment(scr)
 				if err != nil {
-					log.Debugf("%v", err)
+					logCtx(ctx).Debugf("%v", err)
 					break
 				}
 				if amt > bestAmount {
@@ -1646,7 +1934,7 @@ func (w *Wallet) CommittedTickets(ctx context.Context, tickets []*chainhash.Hash
 			}
 
 			if bestAddr == nil {
-				log.Debugf("no best address")
+				logCtx(ctx).Debugf("no best address")
 				continue
 			}
 
@@ -1658,11 +1946,11 @@ func (w *Wallet) CommittedTickets(ctx context.Context, tickets []*chainhash.Hash
 			}
 			if hash160 == nil || !w.manager.ExistsHash160(
 				addrmgrNs, hash160) {
-				log.Debugf("not our address: hash160=%x", hash160)
+				logCtx(ctx).Debugf("not our address: hash160=%x", hash160)
 				continue
 			}
 			ticketHash := tx.TxHash()
-			log.Tracef("Ticket purchase %v: best commitment"+
+			logCtx(ctx).Tracef("Ticket purchase %v: best commitment"+
 				" address %v amount %v", &ticketHash, bestAddr,
 				bestAmount)
 
@@ -1804,7 +2092,7 @@ func (w *Wallet) fetchMissingCFilters(ctx context.Context, n NetworkBackend, pro
 		if progress != nil {
 			progress <- MissingCFilterProgress{BlockHeightStart: height, BlockHeightEnd: height + span - 1}
 		}
-		log.Infof("Fetched cfilters for blocks %v-%v", height, height+span-1)
+		logCtx(ctx).Infof("Fetched cfilters for blocks %v-%v", height, height+span-1)
 	}
 }
 
@@ -1930,16 +2218,22 @@ func (w *Wallet) blockLocators(dbtx walletdb.ReadTx, sidechain []*BlockNode) ([]
 
 // Consolidate consolidates as many UTXOs as are passed in the inputs argument.
 // If that many UTXOs can not be found, it will use the maximum it finds. This
-// will only compress UTXOs in the default account
-func (w *Wallet) Consolidate(ctx context.Context, inputs int, account uint32, address stdaddr.Address) (*chainhash.Hash, error) {
+// will only compress UTXOs in the default account.  Consolidations exceeding
+// the maximum transaction size are split into multiple chained transactions,
+// and the hashes of every transaction are returned in the order they are
+// spent, with only the final transaction paying address.
+func (w *Wallet) Consolidate(ctx context.Context, inputs int, account uint32, address stdaddr.Address) ([]*chainhash.Hash, error) {
 	// Default to VAR for consolidation
 	return w.compressWallet(ctx, "wallet.Consolidate", inputs, account, address, cointype.CoinTypeVAR)
 }
 
 // ConsolidateWithCoinType consolidates as many UTXOs as are passed in the inputs argument
 // for a specific coin type. If that many UTXOs can not be found, it will use the maximum
-// it finds. This will only compress UTXOs in the specified account.
-func (w *Wallet) ConsolidateWithCoinType(ctx context.Context, inputs int, account uint32, address stdaddr.Address, ct cointype.CoinType) (*chainhash.Hash, error) {
+// it finds. This will only compress UTXOs in the specified account.  As with
+// Consolidate, consolidations exceeding the maximum transaction size are split
+// into multiple chained transactions, and the hashes of every transaction are
+// returned.
+func (w *Wallet) ConsolidateWithCoinType(ctx context.Context, inputs int, account uint32, address stdaddr.Address, ct cointype.CoinType) ([]*chainhash.Hash, error) {
 	return w.compressWallet(ctx, "wallet.ConsolidateWithCoinType", inputs, account, address, ct)
 }
 
@@ -1968,6 +2262,12 @@ type PurchaseTicketsRequest struct {
 
 	VSPClient *VSPClient
 
+	// FeeRewardFunding restricts the split transaction inputs to mature
+	// SSFee reward outputs, leaving other outputs of the source account
+	// untouched, and records the purchased tickets as funded by fee
+	// rewards.  VSP fees may still be paid from any output.
+	FeeRewardFunding bool
+
 	// extraSplitOutput is an additional transaction output created during
 	// UTXO contention, to be used as the input to pay a VSP fee
 	// transaction, in order that both VSP fees and a single ticket purchase
@@ -2110,11 +2410,65 @@ func (w *Wallet) PurchaseTickets(ctx context.Context, n NetworkBackend,
 // locked in the background after reading from the channel.
 // If the wallet is already unlocked with a previous timeout, the new timeout
 // replaces the prior.
+//
+// Unlocking provides every private key and replaces any policy set by
+// UnlockWithPolicy.  If the wallet was unlocked with a restricted policy, it
+// is treated as locked and the new timeout always applies.
 func (w *Wallet) Unlock(ctx context.Context, passphrase []byte, timeout <-chan time.Time) error {
 	const op errors.Op = "wallet.Unlock"
+	return w.unlock(ctx, op, passphrase, timeout, time.Time{}, udb.UnlockAll, false)
+}
+
+// UnlockPolicy describes when an unlocked wallet is locked again, and which
+// private keys it provides while unlocked.
+type UnlockPolicy struct {
+	// Timeout locks the wallet after it elapses.  A zero timeout leaves
+	// the wallet unlocked until it is locked by other means.
+	Timeout time.Duration
+
+	// SingleOperation locks the wallet after the first operation using
+	// private keys has released them.
+	SingleOperation bool
+
+	// StakingOnly limits the private keys to those of imported voting
+	// accounts, which vote and revoke tickets.
+	StakingOnly bool
+}
+
+// UnlockWithPolicy unlocks the wallet like Unlock, but only for the duration
+// and the private keys allowed by a policy.  The restrictions are enforced
+// by the address manager before the passphrase is checked, so operations
+// running concurrently with the unlock never observe an unrestricted wallet.
+func (w *Wallet) UnlockWithPolicy(ctx context.Context, passphrase []byte, policy *UnlockPolicy) error {
+	const op errors.Op = "wallet.UnlockWithPolicy"
+
+	var timeout <-chan time.Time
+	var deadline time.Time
+	if policy.Timeout != 0 {
+		timeout = time.After(policy.Timeout)
+		deadline = time.Now().Add(policy.Timeout)
+	}
+	scope := udb.UnlockAll
+	if policy.StakingOnly {
+		scope = udb.UnlockStaking
+	}
+	return w.unlock(ctx, op, passphrase, timeout, deadline, scope,
+		policy.SingleOperation)
+}
+
+func (w *Wallet) unlock(ctx context.Context, op errors.Op, passphrase []byte,
+	timeout <-chan time.Time, deadline time.Time, scope udb.UnlockScope,
+	relockAfterUse bool) error {
+
+	restricted := scope != udb.UnlockAll || relockAfterUse
 
 	w.passphraseUsedMu.RLock()
 	wasLocked := w.manager.IsLocked()
+	prevScope, prevRelockAfterUse := w.manager.UnlockPolicy()
+	wasRestricted := prevScope != udb.UnlockAll || prevRelockAfterUse
+	if restricted {
+		w.manager.SetUnlockPolicy(scope, relockAfterUse)
+	}
 	err := w.manager.UnlockedWithPassphrase(passphrase)
 	w.passphraseUsedMu.RUnlock()
 	switch {
@@ -2123,7 +2477,7 @@ func (w *Wallet) Unlock(ctx context.Context, passphrase []byte, timeout <-chan t
 	case errors.Is(err, errors.Passphrase):
 		w.Lock()
 		if !wasLocked {
-			log.Info("The wallet has been locked due to an incorrect passphrase.")
+			logCtx(ctx).Info("The wallet has been locked due to an incorrect passphrase.")
 		}
 		return errors.E(op, err)
 	default:
@@ -2138,9 +2492,17 @@ func (w *Wallet) Unlock(ctx context.Context, passphrase []byte, timeout <-chan t
 		if err != nil {
 			return errors.E(op, errors.Passphrase, err)
 		}
+		w.upgradePassphraseKDF(ctx, passphrase)
 	case err == nil:
 	}
-	w.replacePassphraseTimeout(wasLocked, timeout)
+	if !restricted {
+		w.manager.SetUnlockPolicy(udb.UnlockAll, false)
+	}
+	// Timeouts always apply to restricted unlocks, and to unlocks
+	// which lift a restricted policy.
+	w.replacePassphraseTimeout(wasLocked || wasRestricted || restricted,
+		timeout, deadline)
+	w.noteActivity()
 	return nil
 }
 
@@ -2202,7 +2564,7 @@ func (w *Wallet) AccountHasPassphrase(ctx context.Context, account uint32) (bool
 	return encrypted, nil
 }
 
-func (w *Wallet) replacePassphraseTimeout(wasLocked bool, newTimeout <-chan time.Time) {
+func (w *Wallet) replacePassphraseTimeout(wasLocked bool, newTimeout <-chan time.Time, deadline time.Time) {
 	defer w.passphraseTimeoutMu.Unlock()
 	w.passphraseTimeoutMu.Lock()
 	hadTimeout := w.passphraseTimeoutCancel != nil
@@ -2215,6 +2577,7 @@ func (w *Wallet) replacePassphraseTimeout(wasLocked bool, newTimeout <-chan time
 			newCancel = make(chan struct{}, 1)
 		}
 		w.passphraseTimeoutCancel = newCancel
+		w.passphraseDeadline = deadline
 
 		if oldCancel != nil {
 			oldCancel <- struct{}{}
@@ -2245,10 +2608,43 @@ func (w *Wallet) Lock() {
 	w.passphraseTimeoutMu.Lock()
 	_ = w.manager.Lock()
 	w.passphraseTimeoutCancel = nil
+	w.passphraseDeadline = time.Time{}
 	w.passphraseTimeoutMu.Unlock()
 	w.passphraseUsedMu.Unlock()
 }
 
+// LockState describes whether a wallet is locked, and the policy of an
+// unlocked wallet.
+type LockState struct {
+	Locked bool
+
+	// Expires is the time the wallet will be locked due to the unlock
+	// timeout.  It is zero if the wallet is locked, unlocked without a
+	// time limit, or unlocked with a timeout of unknown duration.
+	Expires time.Time
+
+	SingleOperation bool
+	StakingOnly     bool
+}
+
+// LockState returns whether the wallet is locked, and when and how an
+// unlocked wallet will be locked.
+func (w *Wallet) LockState() *LockState {
+	w.passphraseTimeoutMu.Lock()
+	deadline := w.passphraseDeadline
+	w.passphraseTimeoutMu.Unlock()
+
+	if w.manager.IsLocked() {
+		return &LockState{Locked: true}
+	}
+	scope, relockAfterUse := w.manager.UnlockPolicy()
+	return &LockState{
+		Expires:         deadline,
+		SingleOperation: relockAfterUse,
+		StakingOnly:     scope == udb.UnlockStaking,
+	}
+}
+
 // Locked returns whether the account manager for a wallet is locked.
 func (w *Wallet) Locked() bool {
 	return w.manager.IsLocked()
@@ -2486,7 +2882,7 @@ func (w *Wallet) ListCoinTypes(ctx context.Context, confirms int32) ([]cointype.
 
 		// Check VAR bucket first (always present)
 		varBucket := ns.NestedReadBucket([]byte("u:0")) // bucketUnspentForCoinType(cointype.CoinTypeVAR)
-		if varBucket != nil && varBucket.KeyN() > 0 {
+		if varBucket != nil && !walletdb.BucketIsEmpty(varBucket) {
 			coinTypes = append(coinTypes, cointype.CoinTypeVAR)
 		}
 
@@ -2500,7 +2896,7 @@ func (w *Wallet) ListCoinTypes(ctx context.Context, confirms int32) ([]cointype.
 				// Check if this coin type has any unspent outputs or unmined credits
 				bucketName := fmt.Sprintf("u:%d", coinType)
 				bucket := ns.NestedReadBucket([]byte(bucketName))
-				if bucket != nil && bucket.KeyN() > 0 {
+				if bucket != nil && !walletdb.BucketIsEmpty(bucket) {
 					coinTypes = append(coinTypes, coinType)
 					continue
 				}
@@ -2508,7 +2904,7 @@ func (w *Wallet) ListCoinTypes(ctx context.Context, confirms int32) ([]cointype.
 				// Also check unmined credits
 				unminedBucketName := fmt.Sprintf("mc:%d", coinType)
 				unminedBucket := ns.NestedReadBucket([]byte(unminedBucketName))
-				if unminedBucket != nil && unminedBucket.KeyN() > 0 {
+				if unminedBucket != nil && !walletdb.BucketIsEmpty(unminedBucket) {
 					coinTypes = append(coinTypes, coinType)
 				}
 			}
@@ -2640,14 +3036,41 @@ func (w *Wallet) SignHashes(ctx context.Context, hashes [][]byte, addr stdaddr.A
 	return signatures, privKey.PubKey().SerializeCompressed(), nil
 }
 
-// SignMessage returns the signature of a signed message using an address'
-// associated private key.
-func (w *Wallet) SignMessage(ctx context.Context, msg string, addr stdaddr.Address) (sig []byte, err error) {
-	const op errors.Op = "wallet.SignMessage"
+// signedMessageHash returns the hash of a message which is signed by
+// SignMessage.
+func signedMessageHash(msg string) []byte {
 	var buf bytes.Buffer
 	_ = wire.WriteVarString(&buf, 0, "Monetarium Signed Message:\n")
 	_ = wire.WriteVarString(&buf, 0, msg)
-	messageHash := chainhash.HashB(buf.Bytes())
+	return chainhash.HashB(buf.Bytes())
+}
+
+// schnorrMessageSigLen is the length of a message signature of a Schnorr
+// address: the Schnorr signature followed by the compressed public key.
+const schnorrMessageSigLen = 64 + secp256k1.PubKeyBytesLenCompressed
+
+// schnorrMessageAddress returns the P2PKH address of a P2PK or P2PKH address
+// with the Schnorr signature type, and whether the address has the Schnorr
+// signature type.
+func schnorrMessageAddress(addr stdaddr.Address) (*stdaddr.AddressPubKeyHashSchnorrSecp256k1V0, bool) {
+	switch a := addr.(type) {
+	case *stdaddr.AddressPubKeyHashSchnorrSecp256k1V0:
+		return a, true
+	case *stdaddr.AddressPubKeySchnorrSecp256k1V0:
+		pkh, ok := a.AddressPubKeyHash().(*stdaddr.AddressPubKeyHashSchnorrSecp256k1V0)
+		return pkh, ok
+	}
+	return nil, false
+}
+
+// SignMessage returns the signature of a signed message using an address'
+// associated private key.  Messages are signed with compact ECDSA signatures,
+// except for addresses with the Schnorr signature type, which are signed with
+// a Schnorr signature followed by the compressed public key, since the public
+// key can not be recovered from a Schnorr signature.
+func (w *Wallet) SignMessage(ctx context.Context, msg string, addr stdaddr.Address) (sig []byte, err error) {
+	const op errors.Op = "wallet.SignMessage"
+	messageHash := signedMessageHash(msg)
 	var privKey *secp256k1.PrivateKey
 	var done func()
 	defer func() {
@@ -2664,20 +3087,45 @@ func (w *Wallet) SignMessage(ctx context.Context, msg string, addr stdaddr.Addre
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+	if _, ok := schnorrMessageAddress(addr); ok {
+		s, err := schnorr.Sign(privKey, messageHash)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		sig = append(s.Serialize(), privKey.PubKey().SerializeCompressed()...)
+		return sig, nil
+	}
 	sig = ecdsa.SignCompact(privKey, messageHash, true)
 	return sig, nil
 }
 
 // VerifyMessage verifies that sig is a valid signature of msg and was created
-// using the secp256k1 private key for addr.
+// using the secp256k1 private key for addr.  Signatures of addresses with the
+// Schnorr signature type are verified as created by SignMessage.
 func VerifyMessage(msg string, addr stdaddr.Address, sig []byte, params stdaddr.AddressParams) (bool, error) {
 	const op errors.Op = "wallet.VerifyMessage"
+	expectedMessageHash := signedMessageHash(msg)
+
+	if pkhAddr, ok := schnorrMessageAddress(addr); ok {
+		if len(sig) != schnorrMessageSigLen {
+			return false, errors.E(op, errors.Encoding,
+				"invalid Schnorr message signature length")
+		}
+		s, err := schnorr.ParseSignature(sig[:64])
+		if err != nil {
+			return false, errors.E(op, errors.Encoding, err)
+		}
+		pk, err := schnorr.ParsePubKey(sig[64:])
+		if err != nil {
+			return false, errors.E(op, errors.Encoding, err)
+		}
+		pkHash := stdaddr.Hash160(pk.SerializeCompressed())
+		return bytes.Equal(pkHash, pkhAddr.Hash160()[:]) &&
+			s.Verify(expectedMessageHash, pk), nil
+	}
+
 	// Validate the signature - this just shows that it was valid for any pubkey
 	// at all. Whether the pubkey matches is checked below.
-	var buf bytes.Buffer
-	_ = wire.WriteVarString(&buf, 0, "Monetarium Signed Message:\n")
-	_ = wire.WriteVarString(&buf, 0, msg)
-	expectedMessageHash := chainhash.HashB(buf.Bytes())
 	pk, wasCompressed, err := ecdsa.RecoverCompact(sig, expectedMessageHash)
 	if err != nil {
 		return false, errors.E(op, err)
@@ -2748,7 +3196,27 @@ func (w *Wallet) AccountName(ctx context.Context, accountNumber uint32) (string,
 	return accountName, nil
 }
 
-// RenameAccount sets the name for an account number to newName.
+// AccountArchived returns whether an account has been archived.
+func (w *Wallet) AccountArchived(ctx context.Context, account uint32) (bool, error) {
+	const op errors.Op = "wallet.AccountArchived"
+	var archived bool
+	err := walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		props, err := w.manager.AccountProperties(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+		archived = props.Archived
+		return nil
+	})
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return archived, nil
+}
+
+// RenameAccount sets the name for an account number to newName.  Renaming an
+// account to its current name does nothing.
 func (w *Wallet) RenameAccount(ctx context.Context, account uint32, newName string) error {
 	const op errors.Op = "wallet.RenameAccount"
 	var props *udb.AccountProperties
@@ -2768,6 +3236,47 @@ func (w *Wallet) RenameAccount(ctx context.Context, account uint32, newName stri
 	return nil
 }
 
+// ArchiveAccount archives an account, hiding it from default balance and
+// account listings.  The account's keys, addresses, and transaction history
+// are retained, and it may still be used by name or number.
+func (w *Wallet) ArchiveAccount(ctx context.Context, account uint32) error {
+	const op errors.Op = "wallet.ArchiveAccount"
+	err := w.setAccountArchived(ctx, account, true)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// UnarchiveAccount reverses ArchiveAccount, restoring the account to default
+// balance and account listings.
+func (w *Wallet) UnarchiveAccount(ctx context.Context, account uint32) error {
+	const op errors.Op = "wallet.UnarchiveAccount"
+	err := w.setAccountArchived(ctx, account, false)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+func (w *Wallet) setAccountArchived(ctx context.Context, account uint32, archived bool) error {
+	var props *udb.AccountProperties
+	err := walletdb.Update(ctx, w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		err := w.manager.SetAccountArchived(addrmgrNs, account, archived)
+		if err != nil {
+			return err
+		}
+		props, err = w.manager.AccountProperties(addrmgrNs, account)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	w.NtfnServer.notifyAccountProperties(props)
+	return nil
+}
+
 // NextAccount creates the next account and returns its account number.  The
 // name must be unique to the account.  In order to support automatic seed
 // restoring, new accounts may not be created when all of the previous 100
@@ -2775,6 +3284,18 @@ func (w *Wallet) RenameAccount(ctx context.Context, account uint32, newName stri
 // spec, which allows no unused account gaps).
 func (w *Wallet) NextAccount(ctx context.Context, name string) (uint32, error) {
 	const op errors.Op = "wallet.NextAccount"
+	account, err := w.nextAccount(ctx, name, nil, nil)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return account, nil
+}
+
+// nextAccount creates the next account.  If msig is non-nil, the account is
+// created as a multisig account with the multisig parameters, and if vault is
+// non-nil, as a vault account with the vault parameters.
+func (w *Wallet) nextAccount(ctx context.Context, name string, msig *multisigParams,
+	vault *vaultParams) (uint32, error) {
 	maxEmptyAccounts := uint32(w.accountGapLimit)
 	var account uint32
 	var props *udb.AccountProperties
@@ -2814,6 +3335,20 @@ func (w *Wallet) NextAccount(ctx context.Context, name string) (uint32, error) {
 		if err != nil {
 			return err
 		}
+		if msig != nil {
+			err = w.manager.SetAccountMultisig(addrmgrNs, account,
+				msig.nRequired, msig.cosigners)
+			if err != nil {
+				return err
+			}
+		}
+		if vault != nil {
+			err = w.manager.SetAccountVault(addrmgrNs, account,
+				vault.delay, vault.recovery)
+			if err != nil {
+				return err
+			}
+		}
 
 		props, err = w.manager.AccountProperties(addrmgrNs, account)
 		if err != nil {
@@ -2834,18 +3369,21 @@ func (w *Wallet) NextAccount(ctx context.Context, name string) (uint32, error) {
 			w.gapLimit, udb.InternalBranch)
 	})
 	if err != nil {
-		return 0, errors.E(op, err)
+		return 0, err
 	}
 
 	extKey, intKey, err := deriveBranches(xpub)
 	if err != nil {
-		return 0, errors.E(op, err)
+		return 0, err
 	}
 	w.addressBuffersMu.Lock()
 	w.addressBuffers[account] = &bip0044AccountData{
 		xpub:        xpub,
 		albExternal: addressBuffer{branchXpub: extKey, lastUsed: ^uint32(0)},
 		albInternal: addressBuffer{branchXpub: intKey, lastUsed: ^uint32(0)},
+		gapLimit:    w.gapLimit,
+		multisig:    msig,
+		vault:       vault,
 	}
 	w.addressBuffersMu.Unlock()
 
@@ -2866,7 +3404,7 @@ func (w *Wallet) NextAccount(ctx context.Context, name string) (uint32, error) {
 		for i := 0; i < cap(errs); i++ {
 			err := <-errs
 			if err != nil {
-				return 0, errors.E(op, err)
+				return 0, err
 			}
 		}
 	}
@@ -3051,6 +3589,23 @@ func listTransactions(tx walletdb.ReadTx, details *udb.TxDetails, addrMgr *udb.M
 
 	send := len(details.Debits) != 0
 
+	// Received outputs are attributed to the counterparty of the first
+	// tagged input address, if any.  Transactions spending wallet outputs
+	// are not attributed to a counterparty, even if they also spend tagged
+	// inputs.
+	var funder string
+	if !send {
+		for _, in := range details.MsgTx.TxIn {
+			addr := inputAddress(in, net)
+			if addr == nil {
+				continue
+			}
+			if funder = udb.CounterpartyForAddr(tx, addr.String()); funder != "" {
+				break
+			}
+		}
+	}
+
 	txTypeStr := types.LTTTRegular
 	switch details.TxType {
 	case stake.TxTypeSStx:
@@ -3199,6 +3754,9 @@ outputs:
 			result.Category = "send"
 			result.Amount = negAmountValue
 			result.Fee = feeValue
+			if address != "" {
+				result.Counterparty = udb.CounterpartyForAddr(tx, address)
+			}
 			sends = append(sends, result)
 		}
 		if isCredit {
@@ -3206,6 +3764,7 @@ outputs:
 			result.Category = recvCat
 			result.Amount = amountValue
 			result.Fee = nil
+			result.Counterparty = funder
 			receives = append(receives, result)
 		}
 	}
@@ -3244,6 +3803,29 @@ func (w *Wallet) ListSinceBlock(ctx context.Context, start, end, syncHeight int3
 // replies.
 func (w *Wallet) ListTransactions(ctx context.Context, from, count int) ([]types.ListTransactionsResult, error) {
 	const op errors.Op = "wallet.ListTransactions"
+	txList, err := w.ListFilteredTransactions(ctx, from, count, nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return txList, nil
+}
+
+// ListFilteredTransactions returns a slice of objects with details about the
+// recorded transactions matching a filter, like ListTransactions.  Skipped and
+// counted transactions only include transactions matching the filter.
+// Transactions are filtered while reading the transaction store, so those not
+// matching the filter are never fully loaded.  A nil filter matches every
+// transaction.
+func (w *Wallet) ListFilteredTransactions(ctx context.Context, from, count int,
+	filter *TxListFilter) ([]types.ListTransactionsResult, error) {
+
+	const op errors.Op = "wallet.ListFilteredTransactions"
+	var begin, end int32 = -1, 0
+	var match func(*udb.TxRecord) bool
+	if filter != nil {
+		begin, end = filter.heightRange()
+		match = filter.match
+	}
 	txList := []types.ListTransactionsResult{}
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
@@ -3287,7 +3869,8 @@ func (w *Wallet) ListTransactions(ctx context.Context, from, count int) ([]types
 
 		// Return newer results first by starting at mempool height and working
 		// down to the genesis block.
-		return w.txStore.RangeTransactions(ctx, txmgrNs, -1, 0, rangeFn)
+		return w.txStore.RangeFilteredTransactions(ctx, txmgrNs, begin, end,
+			match, rangeFn)
 	})
 	if err != nil {
 		return nil, errors.E(op, err)
@@ -4029,7 +4612,9 @@ func (w *Wallet) UnspentOutput(ctx context.Context, op wire.OutPoint, includeMem
 // AccountProperties contains properties associated with each account, such as
 // the account name, number, and the nubmer of derived and imported keys.  If no
 // address usage has been recorded on any of the external or internal branches,
-// the child index is ^uint32(0).
+// the child index is ^uint32(0).  GapLimit is zero unless an address gap limit
+// has been set for the account.  Archived accounts are hidden from default
+// account listings but retain their keys and transaction history.
 type AccountProperties struct {
 	AccountNumber             uint32
 	AccountName               string
@@ -4038,6 +4623,8 @@ type AccountProperties struct {
 	LastUsedInternalIndex     uint32
 	LastReturnedExternalIndex uint32
 	LastReturnedInternalIndex uint32
+	GapLimit                  uint32
+	Archived                  bool
 	ImportedKeyCount          uint32
 	AccountEncrypted          bool
 	AccountUnlocked           bool
@@ -4090,21 +4677,12 @@ func (w *Wallet) Accounts(ctx context.Context) (*AccountsResult, error) {
 	)
 
 	defer w.lockedOutpointMu.Unlock()
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 
 		tipHash, tipHeight = w.txStore.MainChainTip(dbtx)
-		// Get unspent outputs for active coin types only
-		var unspent []*udb.Credit
-		for _, ct := range w.getActiveCoinTypes() {
-			outputs, err := w.txStore.UnspentOutputs(dbtx, ct)
-			if err != nil {
-				return err
-			}
-			unspent = append(unspent, outputs...)
-		}
 		err := w.manager.ForEachAccount(addrmgrNs, func(acct uint32) error {
 			props, err := w.manager.AccountProperties(addrmgrNs, acct)
 			if err != nil {
@@ -4124,18 +4702,24 @@ func (w *Wallet) Accounts(ctx context.Context) (*AccountsResult, error) {
 			a := &accounts[i]
 			m[a.AccountNumber] = &a.TotalBalance
 		}
-		for i := range unspent {
-			output := unspent[i]
-			_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, output.PkScript, w.chainParams)
-			if len(addrs) == 0 {
-				continue
-			}
-			outputAcct, err := w.manager.AddrAccount(addrmgrNs, addrs[0])
-			if err == nil {
-				amt, ok := m[outputAcct]
-				if ok {
-					*amt += output.Amount
+		// Sum unspent outputs of active coin types only
+		for _, ct := range w.getActiveCoinTypes() {
+			err := w.txStore.ForEachUnspentOutput(dbtx, ct, func(output *udb.Credit) error {
+				_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, output.PkScript, w.chainParams)
+				if len(addrs) == 0 {
+					return nil
 				}
+				outputAcct, err := w.manager.AddrAccount(addrmgrNs, addrs[0])
+				if err == nil {
+					amt, ok := m[outputAcct]
+					if ok {
+						*amt += output.Amount
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return err
 			}
 		}
 		return nil
@@ -4194,6 +4778,11 @@ func (s creditSlice) Swap(i, j int) {
 // transaction an empty array will be returned.
 func (w *Wallet) ListUnspent(ctx context.Context, minconf, maxconf int32, addresses map[string]struct{}, accountName string) ([]*types.ListUnspentResult, error) {
 	const op errors.Op = "wallet.ListUnspent"
+
+	// Copy the locked outpoints before the database is read, as the mutex
+	// is held by writers waiting to begin database transactions.
+	locked := w.lockedOutpointsCopy(ctx)
+
 	var results []*types.ListUnspentResult
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
@@ -4276,7 +4865,7 @@ func (w *Wallet) ListUnspent(ctx context.Context, minconf, maxconf int32, addres
 			}
 
 			// Exclude locked outputs from the result set.
-			if w.LockedOutpoint(&output.OutPoint.Hash, output.OutPoint.Index) {
+			if _, ok := locked[outpoint{output.OutPoint.Hash, output.OutPoint.Index}]; ok {
 				continue
 			}
 
@@ -4446,9 +5035,21 @@ func (w *Wallet) DumpWIFPrivateKey(ctx context.Context, addr stdaddr.Address) (s
 	return wif.String(), nil
 }
 
+// attachImportedAddress attaches an imported address to account, unless the
+// account is the reserved imported account.
+func (w *Wallet) attachImportedAddress(addrmgrNs walletdb.ReadWriteBucket,
+	addr stdaddr.Address, account uint32) error {
+
+	if account == udb.ImportedAddrAccount {
+		return nil
+	}
+	return w.manager.AttachImportedAddress(addrmgrNs, addr, account)
+}
+
 // ImportPrivateKey imports a private key to the wallet and writes the new
-// wallet to disk.
-func (w *Wallet) ImportPrivateKey(ctx context.Context, wif *dcrutil.WIF) (string, error) {
+// wallet to disk.  Outputs paying to the key are credited to account, which
+// may be the reserved imported account or any other account of the wallet.
+func (w *Wallet) ImportPrivateKey(ctx context.Context, wif *dcrutil.WIF, account uint32) (string, error) {
 	const op errors.Op = "wallet.ImportPrivateKey"
 	// Attempt to import private key into wallet.
 	var addr stdaddr.Address
@@ -4456,11 +5057,16 @@ func (w *Wallet) ImportPrivateKey(ctx context.Context, wif *dcrutil.WIF) (string
 	err := walletdb.Update(ctx, w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
 		maddr, err := w.manager.ImportPrivateKey(addrmgrNs, wif)
-		if err == nil {
-			addr = maddr.Address()
-			props, err = w.manager.AccountProperties(
-				addrmgrNs, udb.ImportedAddrAccount)
+		if err != nil {
+			return err
+		}
+		addr = maddr.Address()
+		err = w.attachImportedAddress(addrmgrNs, addr, account)
+		if err != nil {
+			return err
 		}
+		props, err = w.manager.AccountProperties(
+			addrmgrNs, udb.ImportedAddrAccount)
 		return err
 	})
 	if err != nil {
@@ -4475,7 +5081,7 @@ func (w *Wallet) ImportPrivateKey(ctx context.Context, wif *dcrutil.WIF) (string
 	}
 
 	addrStr := addr.String()
-	log.Infof("Imported payment address %s", addrStr)
+	logCtx(ctx).Infof("Imported payment address %s", addrStr)
 
 	w.NtfnServer.notifyAccountProperties(props)
 
@@ -4484,8 +5090,8 @@ func (w *Wallet) ImportPrivateKey(ctx context.Context, wif *dcrutil.WIF) (string
 }
 
 // ImportPublicKey imports a compressed secp256k1 public key and its derived
-// P2PKH address.
-func (w *Wallet) ImportPublicKey(ctx context.Context, pubkey []byte) (string, error) {
+// P2PKH address.  Outputs paying to the address are credited to account.
+func (w *Wallet) ImportPublicKey(ctx context.Context, pubkey []byte, account uint32) (string, error) {
 	const op errors.Op = "wallet.ImportPublicKey"
 	// Attempt to import private key into wallet.
 	var addr stdaddr.Address
@@ -4493,11 +5099,16 @@ func (w *Wallet) ImportPublicKey(ctx context.Context, pubkey []byte) (string, er
 	err := walletdb.Update(ctx, w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
 		maddr, err := w.manager.ImportPublicKey(addrmgrNs, pubkey)
-		if err == nil {
-			addr = maddr.Address()
-			props, err = w.manager.AccountProperties(
-				addrmgrNs, udb.ImportedAddrAccount)
+		if err != nil {
+			return err
 		}
+		addr = maddr.Address()
+		err = w.attachImportedAddress(addrmgrNs, addr, account)
+		if err != nil {
+			return err
+		}
+		props, err = w.manager.AccountProperties(
+			addrmgrNs, udb.ImportedAddrAccount)
 		return err
 	})
 	if err != nil {
@@ -4512,7 +5123,7 @@ func (w *Wallet) ImportPublicKey(ctx context.Context, pubkey []byte) (string, er
 	}
 
 	addrStr := addr.String()
-	log.Infof("Imported payment address %s", addrStr)
+	logCtx(ctx).Infof("Imported payment address %s", addrStr)
 
 	w.NtfnServer.notifyAccountProperties(props)
 
@@ -4522,8 +5133,9 @@ func (w *Wallet) ImportPublicKey(ctx context.Context, pubkey []byte) (string, er
 
 // ImportScript imports a redeemscript to the wallet. If it also allows the
 // user to specify whether or not they want the redeemscript to be rescanned,
-// and how far back they wish to rescan.
-func (w *Wallet) ImportScript(ctx context.Context, rs []byte) error {
+// and how far back they wish to rescan.  Outputs paying to the P2SH address of
+// the script are credited to account.
+func (w *Wallet) ImportScript(ctx context.Context, rs []byte, account uint32) error {
 	const op errors.Op = "wallet.ImportScript"
 	err := walletdb.Update(ctx, w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
@@ -4533,6 +5145,10 @@ func (w *Wallet) ImportScript(ctx context.Context, rs []byte) error {
 		}
 
 		addr := mscriptaddr.Address()
+		err = w.attachImportedAddress(addrmgrNs, addr, account)
+		if err != nil {
+			return err
+		}
 		if n, err := w.NetworkBackend(); err == nil {
 			addrs := []stdaddr.Address{addr}
 			err := n.LoadTxFilter(ctx, false, addrs, nil)
@@ -4541,7 +5157,7 @@ func (w *Wallet) ImportScript(ctx context.Context, rs []byte) error {
 			}
 		}
 
-		log.Infof("Imported script with P2SH address %v", addr)
+		logCtx(ctx).Infof("Imported script with P2SH address %v", addr)
 		return nil
 	})
 	if err != nil {
@@ -4631,6 +5247,7 @@ func (w *Wallet) ImportVotingAccount(ctx context.Context, xpriv *hdkeychain.Exte
 		xpub:        xpub,
 		albExternal: albExternal,
 		albInternal: albInternal,
+		gapLimit:    w.gapLimit,
 	}
 
 	return accountN, nil
@@ -4691,6 +5308,7 @@ func (w *Wallet) ImportXpubAccount(ctx context.Context, name string, xpub *hdkey
 		xpub:        xpub,
 		albExternal: albExternal,
 		albInternal: albInternal,
+		gapLimit:    w.gapLimit,
 	}
 
 	return nil
@@ -4986,6 +5604,19 @@ func (w *Wallet) StakeInfoPrecise(ctx context.Context, rpc *dcrd.RPC) (*StakeInf
 	return res, nil
 }
 
+// lockOutpoints acquires lockedOutpointMu, adding the time waited to the RPC
+// trace of ctx, if any.
+func (w *Wallet) lockOutpoints(ctx context.Context) {
+	t := rpctrace.FromContext(ctx)
+	if t == nil {
+		w.lockedOutpointMu.Lock()
+		return
+	}
+	start := time.Now()
+	w.lockedOutpointMu.Lock()
+	t.AddLockWait(time.Since(start))
+}
+
 // LockedOutpoint returns whether an outpoint has been marked as locked and
 // should not be used as an input for created transactions.
 func (w *Wallet) LockedOutpoint(txHash *chainhash.Hash, index uint32) bool {
@@ -5026,7 +5657,7 @@ func (w *Wallet) ResetLockedOutpoints() {
 // intended to be used by marshaling the result as a JSON array for
 // listlockunspent RPC results.
 func (w *Wallet) LockedOutpoints(ctx context.Context, accountName string) ([]dcrdtypes.TransactionInput, error) {
-	w.lockedOutpointMu.Lock()
+	w.lockOutpoints(ctx)
 	allLocked := make([]outpoint, len(w.lockedOutpoints))
 	i := 0
 	for op := range w.lockedOutpoints {
@@ -5318,7 +5949,8 @@ func (w *Wallet) TotalReceivedForAddr(ctx context.Context, addr stdaddr.Address,
 }
 
 // SendOutputs creates and sends payment transactions. It returns the
-// transaction hash upon success
+// transaction hash upon success.  Sends exceeding the daily spend limit of the
+// account are refused or await approval as described by SetSpendLimit.
 func (w *Wallet) SendOutputs(ctx context.Context, outputs []*wire.TxOut, account, changeAccount uint32, minconf int32) (*chainhash.Hash, error) {
 	const op errors.Op = "wallet.SendOutputs"
 
@@ -5336,33 +5968,164 @@ func (w *Wallet) SendOutputs(ctx context.Context, outputs []*wire.TxOut, account
 		}
 	}
 
+	p := &udb.PendingSend{
+		Account:       account,
+		ChangeAccount: changeAccount,
+		MinConf:       minconf,
+		CoinType:      coinType,
+		Outputs:       outputs,
+		Amount:        sendAmount(outputs),
+	}
+	return w.sendWithinLimit(ctx, op, p)
+}
+
+// SendOutputsSubtractFee creates and sends payment transactions like
+// SendOutputs, except the transaction fee is subtracted from the outputs at the
+// indexes in subtractFeeFrom, so the recipients of these outputs bear the fee.
+// The wallet balance decreases by exactly the sum of the output values.
+func (w *Wallet) SendOutputsSubtractFee(ctx context.Context, outputs []*wire.TxOut,
+	subtractFeeFrom []int, account, changeAccount uint32, minconf int32) (*chainhash.Hash, error) {
+
+	opts := &SendOptions{SubtractFeeFrom: subtractFeeFrom}
+	return w.SendOutputsWithOptions(ctx, outputs, account, changeAccount, minconf, opts)
+}
+
+// SendOptions specifies optional behavior of SendOutputsWithOptions.
+type SendOptions struct {
+	// SubtractFeeFrom lists the indexes of outputs which pay the
+	// transaction fee, as described by SendOutputsSubtractFee.
+	SubtractFeeFrom []int
+
+	// LockTimes sets the expiry and lock time of the transaction.
+	LockTimes TxLockTimes
+
+	// Label is recorded as the label of the transaction.  Sends of at
+	// least the label threshold of the send policy require a label.
+	Label string
+
+	// FeeRate, when nonzero, is the fee rate per kB paid by the
+	// transaction instead of the wallet's fee rate for its coin type.
+	FeeRate dcrutil.Amount
+}
+
+// SendOutputsWithOptions creates and sends payment transactions like
+// SendOutputs, with the optional behavior described by opts.  Errors with code
+// errors.Invalid are returned if the lock times are invalid at the current tip
+// height.
+func (w *Wallet) SendOutputsWithOptions(ctx context.Context, outputs []*wire.TxOut,
+	account, changeAccount uint32, minconf int32, opts *SendOptions) (*chainhash.Hash, error) {
+
+	const op errors.Op = "wallet.SendOutputsWithOptions"
+
+	coinType := txrules.GetCoinTypeFromOutputs(outputs)
+	txFeeRate := w.RelayFeeForCoinType(ctx, coinType)
+	if opts != nil && opts.FeeRate != 0 {
+		txFeeRate = opts.FeeRate
+	}
+	for _, output := range outputs {
+		err := txrules.CheckOutput(output, txFeeRate)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	p := &udb.PendingSend{
+		Account:       account,
+		ChangeAccount: changeAccount,
+		MinConf:       minconf,
+		CoinType:      coinType,
+		Outputs:       outputs,
+		Amount:        sendAmount(outputs),
+	}
+	if opts != nil {
+		p.SubtractFeeFrom = opts.SubtractFeeFrom
+		p.Expiry = opts.LockTimes.Expiry
+		p.ExpireAfter = opts.LockTimes.ExpireAfter
+		p.LockTime = opts.LockTimes.LockTime
+		p.Label = opts.Label
+		p.FeeRate = int64(opts.FeeRate)
+	}
+	return w.sendWithinLimit(ctx, op, p)
+}
+
+// sweepAccount authors a transaction spending every eligible output of the
+// coin type from account to destAddr.  A zero feeRate selects the wallet's fee
+// rate for the coin type.
+func (w *Wallet) sweepAccount(ctx context.Context, op errors.Op, account uint32,
+	coinType cointype.CoinType, destAddr stdaddr.Address, feeRate dcrutil.Amount,
+	minConf int32, dontSignTx bool) (*authorTx, error) {
+
+	if feeRate == 0 {
+		feeRate = w.RelayFeeForCoinType(ctx, coinType)
+	}
+	vers, script := destAddr.PaymentScript()
 	a := &authorTx{
-		outputs:            outputs,
-		account:            account,
-		changeAccount:      changeAccount,
-		minconf:            minconf,
-		randomizeChangeIdx: true,
-		txFee:              txFeeRate,
-		dontSignTx:         false,
-		isTreasury:         false,
+		outputs: []*wire.TxOut{{
+			Version:  vers,
+			PkScript: script,
+			CoinType: coinType,
+		}},
+		account:       account,
+		changeAccount: account,
+		minconf:       minConf,
+		txFee:         feeRate,
+		dontSignTx:    dontSignTx,
+		sweep:         true,
 	}
 	err := w.authorTx(ctx, op, a)
 	if err != nil {
 		return nil, err
 	}
-	err = w.recordAuthoredTx(ctx, op, a)
-	if err != nil {
-		return nil, err
-	}
-	err = w.publishAndWatch(ctx, op, nil, a.atx.Tx, a.watch)
+	return a, nil
+}
+
+// SweepAccount creates and sends a transaction spending every eligible
+// unspent output of the coin type from an account to a single destination
+// address.  No change output is created; the fee is subtracted from the value
+// paid to destAddr.  Outputs with at least one confirmation are spent.  A zero
+// feeRate selects the wallet's fee rate for the coin type.  It returns the
+// transaction hash upon success.
+func (w *Wallet) SweepAccount(ctx context.Context, account uint32, coinType cointype.CoinType,
+	destAddr stdaddr.Address, feeRate dcrutil.Amount) (*chainhash.Hash, error) {
+
+	const op errors.Op = "wallet.SweepAccount"
+	vers, script := destAddr.PaymentScript()
+	p := &udb.PendingSend{
+		Account:       account,
+		ChangeAccount: account,
+		MinConf:       1,
+		CoinType:      coinType,
+		Outputs: []*wire.TxOut{{
+			Version:  vers,
+			PkScript: script,
+			CoinType: coinType,
+		}},
+		FeeRate: int64(feeRate),
+		Sweep:   true,
+		Amount:  new(big.Int),
+	}
+	return w.sendWithinLimit(ctx, op, p)
+}
+
+// NewUnsignedSweepTransaction creates an unsigned transaction spending every
+// unspent output of the coin type from an account with at least minConf
+// confirmations to a single destination address, without a change output.
+// The fee is subtracted from the value paid to destAddr.  A zero feeRate
+// selects the wallet's fee rate for the coin type.
+func (w *Wallet) NewUnsignedSweepTransaction(ctx context.Context, account uint32,
+	coinType cointype.CoinType, destAddr stdaddr.Address, feeRate dcrutil.Amount,
+	minConf int32) (*txauthor.AuthoredTx, error) {
+
+	const op errors.Op = "wallet.NewUnsignedSweepTransaction"
+	a, err := w.sweepAccount(ctx, op, account, coinType, destAddr, feeRate, minConf, true)
 	if err != nil {
 		return nil, err
 	}
-	hash := a.atx.Tx.TxHash()
-	return &hash, nil
+	return a.atx, nil
 }
 
-// transaction hash upon success
+// SendOutputsToTreasury creates and sends a transaction adding the outputs to
+// the treasury.  It returns the transaction hash upon success.
 func (w *Wallet) SendOutputsToTreasury(ctx context.Context, outputs []*wire.TxOut, account, changeAccount uint32, minconf int32) (*chainhash.Hash, error) {
 	const op errors.Op = "wallet.SendOutputsToTreasury"
 	relayFee := w.RelayFee()
@@ -5373,30 +6136,42 @@ func (w *Wallet) SendOutputsToTreasury(ctx context.Context, outputs []*wire.TxOu
 		}
 	}
 
-	a := &authorTx{
-		outputs:            outputs,
-		account:            account,
-		changeAccount:      changeAccount,
-		minconf:            minconf,
-		randomizeChangeIdx: false,
-		txFee:              relayFee,
-		dontSignTx:         false,
-		isTreasury:         true,
+	p := &udb.PendingSend{
+		Account:       account,
+		ChangeAccount: changeAccount,
+		MinConf:       minconf,
+		CoinType:      txrules.GetCoinTypeFromOutputs(outputs),
+		Outputs:       outputs,
+		Treasury:      true,
+		Amount:        sendAmount(outputs),
 	}
-	err := w.authorTx(ctx, op, a)
-	if err != nil {
-		return nil, err
+	return w.sendWithinLimit(ctx, op, p)
+}
+
+// CreateTreasuryAdd creates, signs and publishes a treasury add transaction
+// contributing amount from account to the treasury.  The OP_TADD output is
+// funded by confirmed outputs of the account, and any change is returned to
+// changeAccount by an OP_SSTXCHANGE tagged P2PKH output, as required of stake
+// change.  It returns the transaction hash upon success.
+func (w *Wallet) CreateTreasuryAdd(ctx context.Context, account uint32,
+	amount dcrutil.Amount, changeAccount uint32) (*chainhash.Hash, error) {
+
+	const op errors.Op = "wallet.CreateTreasuryAdd"
+	const minconf = 1
+
+	if amount <= 0 {
+		return nil, errors.E(op, errors.Invalid, "treasury add amount must be positive")
 	}
-	err = w.recordAuthoredTx(ctx, op, a)
+	outputs := []*wire.TxOut{{
+		Value:    int64(amount),
+		Version:  wire.DefaultPkScriptVersion,
+		PkScript: []byte{txscript.OP_TADD},
+	}}
+	hash, err := w.SendOutputsToTreasury(ctx, outputs, account, changeAccount, minconf)
 	if err != nil {
-		return nil, err
-	}
-	err = w.publishAndWatch(ctx, op, nil, a.atx.Tx, a.watch)
-	if err != nil {
-		return nil, err
+		return nil, errors.E(op, err)
 	}
-	hash := a.atx.Tx.TxHash()
-	return &hash, nil
+	return hash, nil
 }
 
 // SignatureError records the underlying error when validating a transaction
@@ -5459,7 +6234,7 @@ func (w *Wallet) CreateVspPayment(ctx context.Context, tx *wire.MsgTx, fee dcrut
 
 	addr, err := w.NewChangeAddress(ctx, changeAcct)
 	if err != nil {
-		log.Warnf("failed to get new change address: %v", err)
+		logCtx(ctx).Warnf("failed to get new change address: %v", err)
 		return err
 	}
 	var changeOut *wire.TxOut
@@ -5495,10 +6270,10 @@ func (w *Wallet) CreateVspPayment(ctx context.Context, tx *wire.MsgTx, fee dcrut
 
 	sigErrs, err := w.SignTransaction(ctx, tx, txscript.SigHashAll, nil, nil, nil)
 	if err != nil || len(sigErrs) > 0 {
-		log.Errorf("failed to sign transaction: %v", err)
+		logCtx(ctx).Errorf("failed to sign transaction: %v", err)
 		sigErrStr := ""
 		for _, sigErr := range sigErrs {
-			log.Errorf("\t%v", sigErr)
+			logCtx(ctx).Errorf("\t%v", sigErr)
 			sigErrStr = fmt.Sprintf("\t%v", sigErr) + " "
 		}
 		if err != nil {
@@ -5521,6 +6296,11 @@ func (w *Wallet) CreateVspPayment(ctx context.Context, tx *wire.MsgTx, fee dcrut
 
 // SignTransaction uses secrets of the wallet, as well as additional secrets
 // passed in by the caller, to create and add input signatures to a transaction.
+// Additional keys and P2SH redeem scripts take precedence over the wallet's,
+// which are used for any address without an additional secret, so inputs
+// spending outputs of other parties may be signed along with the wallet's own
+// inputs.  Previous output scripts of inputs which do not spend wallet
+// outputs must be passed in additionalPrevScripts.
 //
 // Transaction input script validation is used to confirm that all signatures
 // are valid.  For any invalid input, a SignatureError is added to the returns.
@@ -5571,15 +6351,8 @@ func (w *Wallet) SignTransaction(ctx context.Context, tx *wire.MsgTx, hashType t
 			// look up the appropriate keys and scripts by address.
 			var source sigDataSource
 			source.key = func(addr stdaddr.Address) ([]byte, dcrec.SignatureType, bool, error) {
-				if len(additionalKeysByAddress) != 0 {
-					addrStr := addr.String()
-					wif, ok := additionalKeysByAddress[addrStr]
-					if !ok {
-						return nil, 0, false,
-							errors.Errorf("no key for address (needed: %v, have %v)",
-								addr, additionalKeysByAddress)
-					}
-					return wif.PrivKey(), dcrec.STEcdsaSecp256k1, true, nil
+				if wif, ok := additionalKeysByAddress[addr.String()]; ok {
+					return wif.PrivKey(), wif.DSA(), true, nil
 				}
 
 				key, done, err := w.manager.PrivateKey(addrmgrNs, addr)
@@ -5590,15 +6363,7 @@ func (w *Wallet) SignTransaction(ctx context.Context, tx *wire.MsgTx, hashType t
 				return key.Serialize(), dcrec.STEcdsaSecp256k1, true, nil
 			}
 			source.script = func(addr stdaddr.Address) ([]byte, error) {
-				// If keys were provided then we can only use the
-				// redeem scripts provided with our inputs, too.
-				if len(additionalKeysByAddress) != 0 {
-					addrStr := addr.String()
-					script, ok := p2shRedeemScriptsByAddress[addrStr]
-					if !ok {
-						return nil, errors.New("no script for " +
-							"address")
-					}
+				if script, ok := p2shRedeemScriptsByAddress[addr.String()]; ok {
 					return script, nil
 				}
 
@@ -5830,6 +6595,7 @@ func (w *Wallet) appendRelevantOutpoints(relevant []wire.OutPoint, dbtx walletdb
 // replace other transactions authored by the wallet.
 func (w *Wallet) AbandonTransaction(ctx context.Context, hash *chainhash.Hash) error {
 	const opf = "wallet.AbandonTransaction(%v)"
+	w.lockOutpoints(ctx)
 	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
 		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
 		details, err := w.txStore.TxDetails(ns, hash)
@@ -5839,8 +6605,10 @@ func (w *Wallet) AbandonTransaction(ctx context.Context, hash *chainhash.Hash) e
 		if details.Block.Height != -1 {
 			return errors.E(errors.Invalid, errors.Errorf("transaction %v is mined in main chain", hash))
 		}
+		w.utxoCache.invalidate()
 		return w.txStore.RemoveUnconfirmed(ns, &details.MsgTx, hash)
 	})
+	w.lockedOutpointMu.Unlock()
 	if err != nil {
 		op := errors.Opf(opf, hash)
 		return errors.E(op, err)
@@ -5863,10 +6631,25 @@ func (w *Wallet) AllowsHighFees() bool {
 // the caller's responsibility to check this using either the current wallet
 // policy or other configuration parameters.  See txrules.TxPaysHighFees for a
 // check for insanely high transaction fees.
+//
+// The sends of a transaction from wallet accounts are evaluated by the send
+// policy, and the amount sent is counted against the daily spend limits of the
+// accounts.  Transactions denied by the policy or exceeding a limit are not
+// published.
 func (w *Wallet) PublishTransaction(ctx context.Context, tx *wire.MsgTx, n NetworkBackend) (*chainhash.Hash, error) {
+	return w.publishLimited(ctx, tx, n, "")
+}
+
+// publishTransaction saves (if relevant) and publishes the transaction without
+// checking it against the send policy and daily spend limits.
+func (w *Wallet) publishTransaction(ctx context.Context, tx *wire.MsgTx, n NetworkBackend) (*chainhash.Hash, error) {
 	const opf = "wallet.PublishTransaction(%v)"
 
 	txHash := tx.TxHash()
+	if err := w.beginOp(errors.Opf(opf, &txHash)); err != nil {
+		return nil, err
+	}
+	defer w.ops.End()
 
 	var relevant bool
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
@@ -5887,12 +6670,23 @@ func (w *Wallet) PublishTransaction(ctx context.Context, tx *wire.MsgTx, n Netwo
 			return nil, errors.E(op, err)
 		}
 
-		w.lockedOutpointMu.Lock()
+		w.lockOutpoints(ctx)
 		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
 			rec, err := udb.NewTxRecord(txBuf.Bytes(), time.Now())
 			if err != nil {
 				return err
 			}
+
+			// Begin tracing transactions which were not authored by
+			// the wallet, such as those signed externally.
+			_, err = udb.TxTraceForHash(dbtx, &rec.Hash)
+			if errors.Is(err, errors.NotExist) {
+				err = udb.PutTxTrace(dbtx, &rec.Hash, newTxTrace())
+			}
+			if err != nil {
+				return err
+			}
+
 			watchOutPoints, err = w.processTransactionRecord(ctx, dbtx, rec, nil, nil)
 			return err
 		})
@@ -5907,17 +6701,20 @@ func (w *Wallet) PublishTransaction(ctx context.Context, tx *wire.MsgTx, n Netwo
 	if err != nil {
 		if relevant {
 			if err := w.AbandonTransaction(ctx, &txHash); err != nil {
-				log.Warnf("Failed to abandon unmined transaction: %v", err)
+				logCtx(ctx).Warnf("Failed to abandon unmined transaction: %v", err)
 			}
 		}
 		op := errors.Opf(opf, &txHash)
 		return nil, errors.E(op, err)
 	}
+	if relevant {
+		w.tracePublished(ctx, &txHash)
+	}
 
 	if len(watchOutPoints) > 0 {
 		err := n.LoadTxFilter(ctx, false, nil, watchOutPoints)
 		if err != nil {
-			log.Errorf("Failed to watch outpoints: %v", err)
+			logCtx(ctx).Errorf("Failed to watch outpoints: %v", err)
 		}
 	}
 
@@ -6070,16 +6867,6 @@ func Create(ctx context.Context, db DB, pubPass, privPass, seed []byte, params *
 	return nil
 }
 
-// CreateWatchOnly creates a watchonly wallet on the provided db.
-func CreateWatchOnly(ctx context.Context, db DB, extendedPubKey string, pubPass []byte, params *chaincfg.Params) error {
-	const op errors.Op = "wallet.CreateWatchOnly"
-	err := udb.InitializeWatchOnly(ctx, db.internal(), params, extendedPubKey, pubPass)
-	if err != nil {
-		return errors.E(op, err)
-	}
-	return nil
-}
-
 // GapLimit returns the currently used gap limit.
 func (w *Wallet) GapLimit() uint32 {
 	return w.gapLimit
@@ -6103,6 +6890,21 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+	if cfg.ReadOnly {
+		// Read-only databases are only opened once they are
+		// current, as no upgrade may be performed.
+		var migrations []udb.Migration
+		if !needsMigration {
+			migrations, err = udb.PendingMigrations(ctx, db)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+		}
+		if needsMigration || len(migrations) != 0 {
+			return nil, errors.E(op, errors.Invalid, "database must be "+
+				"upgraded before it can be opened read-only")
+		}
+	}
 	if needsMigration {
 		err := udb.Migrate(ctx, db, cfg.Params)
 		if err != nil {
@@ -6110,6 +6912,19 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 		}
 	}
 
+	// Back up the database before performing any upgrades.
+	if cfg.MigrationBackupDir != "" && !cfg.ReadOnly {
+		err := backupBeforeUpgrade(ctx, db, cfg.MigrationBackupDir)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	// Record all further database changes, including upgrades, in the
+	// changelog for incremental backups.
+	changelog := udb.NewChangelogDB(db)
+	db = changelog
+
 	// Perform upgrades as necessary.
 	err = udb.Upgrade(ctx, db, cfg.PubPassphrase, cfg.Params)
 	if err != nil {
@@ -6142,7 +6957,8 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 	}
 
 	w := &Wallet{
-		db: db,
+		db:        db,
+		changelog: changelog,
 
 		// StakeOptions
 		votingEnabled:      cfg.VotingEnabled,
@@ -6159,6 +6975,7 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 		accountGapLimit:         cfg.AccountGapLimit,
 		disableCoinTypeUpgrades: cfg.DisableCoinTypeUpgrades,
 		manualTickets:           cfg.ManualTickets,
+		readOnly:                cfg.ReadOnly,
 
 		// Chain params
 		subsidyCache:       blockchain.NewSubsidyCache(params),
@@ -6169,6 +6986,8 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 
 		lockedOutpoints: make(map[outpoint]struct{}),
 
+		inheritanceActivity: make(chan struct{}, 1),
+
 		recentlyPublished: make(map[chainhash.Hash]struct{}),
 
 		addressBuffers: make(map[uint32]*bip0044AccountData),
@@ -6187,13 +7006,20 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
-	log.Infof("Opened wallet") // TODO: log balance? last sync height?
+	logCtx(ctx).Infof("Opened wallet") // TODO: log balance? last sync height?
 
-	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
-		return w.rollbackInvalidCheckpoints(dbtx)
-	})
-	if err != nil {
-		return nil, errors.E(op, err)
+	if !cfg.ReadOnly {
+		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			return w.rollbackInvalidCheckpoints(dbtx)
+		})
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+
+		err = w.CompactBackupChangelog(ctx, 0)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
 	}
 
 	var vb stake.VoteBits
@@ -6236,6 +7062,27 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 					lastUsed:   props.LastUsedInternalIndex,
 					cursor:     props.LastReturnedInternalIndex - props.LastUsedInternalIndex,
 				},
+				gapLimit: w.addressGapLimit(props),
+			}
+			nRequired, cosigners, err := w.manager.AccountMultisig(ns, acct)
+			switch {
+			case err == nil:
+				w.addressBuffers[acct].multisig = &multisigParams{
+					nRequired: nRequired,
+					cosigners: cosigners,
+				}
+			case !errors.Is(err, errors.NotExist):
+				return err
+			}
+			delay, recovery, err := w.manager.AccountVault(ns, acct)
+			switch {
+			case err == nil:
+				w.addressBuffers[acct].vault = &vaultParams{
+					delay:    delay,
+					recovery: recovery,
+				}
+			case !errors.Is(err, errors.NotExist):
+				return err
 			}
 			return nil
 		}
@@ -6321,10 +7168,17 @@ func (w *Wallet) MixingEnabled() bool {
 
 // Run executes any necessary background goroutines for the wallet.
 func (w *Wallet) Run(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+	if !w.readOnly {
+		// These loops record transactions they publish.
+		g.Go(func() error { return w.rebroadcastLoop(ctx) })
+		g.Go(func() error { return w.scheduledSendLoop(ctx) })
+		g.Go(func() error { return w.inheritanceLoop(ctx) })
+	}
 	if w.mixingEnabled {
-		return w.mixClient.Run(ctx)
+		g.Go(func() error { return w.mixClient.Run(ctx) })
 	}
-	return nil
+	return g.Wait()
 }
 
 // getCoinjoinTxsSumbByAcct returns a map with key representing the account and
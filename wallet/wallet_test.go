@@ -5,12 +5,15 @@
 package wallet
 
 import (
+	"context"
 	"encoding/hex"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/monetarium/monetarium-wallet/errors"
 	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/dcrutil"
 )
 
 func TestCoinbaseMatured(t *testing.T) {
@@ -94,6 +97,46 @@ func TestTicketExpired(t *testing.T) {
 	}
 }
 
+func TestTxLockTimesResolve(t *testing.T) {
+	t.Parallel()
+	const tipHeight = 1000
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		locks            TxLockTimes
+		expiry, lockTime uint32
+		invalid          bool
+	}{
+		{TxLockTimes{}, 0, 0, false},
+		{TxLockTimes{Expiry: tipHeight + 2}, tipHeight + 2, 0, false},
+		{TxLockTimes{Expiry: tipHeight + 1}, 0, 0, true},
+		{TxLockTimes{ExpireAfter: 10}, tipHeight + 11, 0, false},
+		{TxLockTimes{ExpireAfter: math.MaxUint32}, 0, 0, true},
+		{TxLockTimes{Expiry: tipHeight + 2, ExpireAfter: 10}, 0, 0, true},
+		{TxLockTimes{LockTime: tipHeight}, 0, tipHeight, false},
+		{TxLockTimes{LockTime: tipHeight + 1}, 0, 0, true},
+		{TxLockTimes{LockTime: 1699999999}, 0, 1699999999, false},
+		{TxLockTimes{LockTime: 1700000001}, 0, 0, true},
+	}
+
+	for i, test := range tests {
+		expiry, lockTime, err := test.locks.resolve(tipHeight, now)
+		if test.invalid {
+			if !errors.Is(err, errors.Invalid) {
+				t.Errorf("test %d: expected Invalid, got %v", i, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %v", i, err)
+			continue
+		}
+		if expiry != test.expiry || lockTime != test.lockTime {
+			t.Errorf("test %d: expiry %d lock time %d, want %d %d", i,
+				expiry, lockTime, test.expiry, test.lockTime)
+		}
+	}
+}
+
 // TestVotingXprivFromSeed tests that creating voting xprivs works properly.
 func TestVotingXprivFromSeed(t *testing.T) {
 	seed, err := hex.DecodeString("0000000000000000000000000000000000000" +
@@ -138,3 +181,23 @@ func TestVotingXprivFromSeed(t *testing.T) {
 		}
 	}
 }
+
+func TestCreateTreasuryAdd(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+
+	for _, amount := range []dcrutil.Amount{0, -1} {
+		_, err := w.CreateTreasuryAdd(ctx, 0, amount, 0)
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("amount %v: expected Invalid error, got %v", amount, err)
+		}
+	}
+	_, err := w.CreateTreasuryAdd(ctx, 0, 1e8, 0)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("unfunded account: expected InsufficientBalance error, got %v", err)
+	}
+}
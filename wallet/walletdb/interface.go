@@ -12,8 +12,10 @@ import (
 	"context"
 	"io"
 	"runtime/trace"
+	"sync"
 
 	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/internal/rpctrace"
 )
 
 // ReadTx represents a database transaction that can only be used for reads.  If
@@ -81,6 +83,10 @@ type ReadBucket interface {
 	Get(key []byte) []byte
 
 	// KeyN returns the number of keys and value pairs inside a bucket.
+	// Whether the keys of nested buckets are counted is driver-specific,
+	// and counting may visit every key, so KeyN should only be used with
+	// buckets without nested buckets, and BucketIsEmpty should be preferred
+	// to test for any keys.
 	KeyN() int
 
 	ReadCursor() ReadCursor
@@ -196,17 +202,93 @@ type DB interface {
 	Close() error
 }
 
+// Compacter is implemented by databases which can reclaim the space of deleted
+// records while the database remains open.
+type Compacter interface {
+	// Compact rewrites the database without unused space.  Progress, if
+	// non-nil, is called with the number of keys copied and the total
+	// number of keys.
+	Compact(progress func(copied, total int)) error
+}
+
+// txContexts maps each open transaction begun by View, Update or Snapshot to
+// the context of its caller.
+var txContexts sync.Map
+
+// TxContext returns the context passed to the View, Update or Snapshot which
+// began the transaction, or the background context if the transaction was not
+// begun by one of these functions.  It allows values of the context, such as
+// the request ID recorded in logs, to be used by code which is only passed the
+// transaction.
+func TxContext(tx ReadTx) context.Context {
+	if ctx, ok := txContexts.Load(tx); ok {
+		return ctx.(context.Context)
+	}
+	return context.Background()
+}
+
+// snapshotKey is the context key of a snapshot read transaction of a
+// database.
+type snapshotKey struct {
+	db DB
+}
+
+// Snapshot opens a database read transaction which is used by every View of db
+// performed with the returned context, or a context derived from it, instead
+// of opening a new read transaction.  All such reads observe the same
+// consistent state of the database, even while it is concurrently modified.
+// Updates of db may not be performed with the returned context, and updates by
+// other callers may block until the snapshot is released.
+//
+// The release function ends the read transaction and must be called once the
+// snapshot is no longer used.  It may be called more than once.
+//
+// A snapshot is a read transaction held open for the lifetime of the context,
+// and the lock order of database transactions applies: locks which are held
+// by callers beginning database transactions must be acquired before the
+// snapshot is opened, and must not be acquired while it is held.  A writer
+// holding such a lock may be waiting for the snapshot to be released.
+func Snapshot(ctx context.Context, db DB) (context.Context, func(), error) {
+	if _, ok := ctx.Value(snapshotKey{db}).(ReadTx); ok {
+		return ctx, func() {}, nil
+	}
+
+	tx, err := db.BeginReadTx()
+	if err != nil {
+		return nil, nil, err
+	}
+	txContexts.Store(tx, ctx)
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			txContexts.Delete(tx)
+			_ = tx.Rollback()
+		})
+	}
+	return context.WithValue(ctx, snapshotKey{db}, tx), release, nil
+}
+
 // View opens a database read transaction and executes the function f with the
 // transaction passed as a parameter.  After f exits or panics, the transaction
 // is rolled back.  If f errors, its error is returned, not a rollback error (if
 // any occurred).
+//
+// If the context carries a snapshot of db, f is executed with the snapshot
+// read transaction instead.
 func View(ctx context.Context, db DB, f func(tx ReadTx) error) error {
 	defer trace.StartRegion(ctx, "db.View").End()
+	rpctrace.FromContext(ctx).AddDBView()
+
+	if tx, ok := ctx.Value(snapshotKey{db}).(ReadTx); ok {
+		return f(tx)
+	}
 
 	tx, err := db.BeginReadTx()
 	if err != nil {
 		return err
 	}
+	txContexts.Store(tx, ctx)
+	defer txContexts.Delete(tx)
 
 	defer trace.StartRegion(ctx, "db.ReadTx").End()
 
@@ -229,11 +311,20 @@ func View(ctx context.Context, db DB, f func(tx ReadTx) error) error {
 // by f is still returned.  If the commit fails, the commit error is returned.
 func Update(ctx context.Context, db DB, f func(tx ReadWriteTx) error) (err error) {
 	defer trace.StartRegion(ctx, "db.Update").End()
+	rpctrace.FromContext(ctx).AddDBUpdate()
+
+	// Waiting on a writer while holding a snapshot read transaction may
+	// deadlock the database.
+	if _, ok := ctx.Value(snapshotKey{db}).(ReadTx); ok {
+		return errors.E(errors.Invalid, "update of database with an open snapshot")
+	}
 
 	tx, err := db.BeginReadWriteTx()
 	if err != nil {
 		return err
 	}
+	txContexts.Store(tx, ctx)
+	defer txContexts.Delete(tx)
 
 	defer trace.StartRegion(ctx, "db.ReadWriteTx").End()
 
@@ -14,6 +14,7 @@
 package walletdb_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"reflect"
@@ -665,6 +666,73 @@ func testAdditionalErrors(ctx context.Context, tc *testContext) bool {
 	return true
 }
 
+// testSnapshot ensures that views performed with a snapshot context observe
+// the database as it was when the snapshot was opened, and that updates are
+// refused with a snapshot context.
+func testSnapshot(ctx context.Context, tc *testContext) bool {
+	nsKey := []byte("snapshot")
+	key, value := []byte("key"), []byte("value")
+
+	err := walletdb.Update(ctx, tc.db, func(tx walletdb.ReadWriteTx) error {
+		_, err := tx.CreateTopLevelBucket(nsKey)
+		return err
+	})
+	if err != nil {
+		tc.t.Errorf("CreateTopLevelBucket: unexpected error: %v", err)
+		return false
+	}
+
+	snapCtx, release, err := walletdb.Snapshot(ctx, tc.db)
+	if err != nil {
+		tc.t.Errorf("Snapshot: unexpected error: %v", err)
+		return false
+	}
+	defer release()
+
+	// The update may need to wait for the snapshot to be released.
+	updated := make(chan error, 1)
+	go func() {
+		updated <- walletdb.Update(ctx, tc.db, func(tx walletdb.ReadWriteTx) error {
+			return tx.ReadWriteBucket(nsKey).Put(key, value)
+		})
+	}()
+
+	get := func(ctx context.Context) (v []byte) {
+		err := walletdb.View(ctx, tc.db, func(tx walletdb.ReadTx) error {
+			v = tx.ReadBucket(nsKey).Get(key)
+			return nil
+		})
+		if err != nil {
+			tc.t.Errorf("View: unexpected error: %v", err)
+		}
+		return v
+	}
+	if v := get(snapCtx); v != nil {
+		tc.t.Errorf("snapshot view observed later update: %q", v)
+		return false
+	}
+
+	err = walletdb.Update(snapCtx, tc.db, func(tx walletdb.ReadWriteTx) error {
+		return nil
+	})
+	if !errors.Is(err, errors.Invalid) {
+		tc.t.Errorf("Update with snapshot: unexpected error %v", err)
+		return false
+	}
+
+	release()
+	if err := <-updated; err != nil {
+		tc.t.Errorf("Put: unexpected error: %v", err)
+		return false
+	}
+	if v := get(ctx); !bytes.Equal(v, value) {
+		tc.t.Errorf("view without snapshot got %q, want %q", v, value)
+		return false
+	}
+
+	return true
+}
+
 // testInterface tests performs tests for the various interfaces of walletdb
 // which require state in the database for the given database type.
 func testInterface(ctx context.Context, t *testing.T, db walletdb.DB) {
@@ -685,4 +753,9 @@ func testInterface(ctx context.Context, t *testing.T, db walletdb.DB) {
 	if !testAdditionalErrors(ctx, &tcontext) {
 		return
 	}
+
+	// Ensure views of a snapshot do not observe later updates.
+	if !testSnapshot(ctx, &tcontext) {
+		return
+	}
 }
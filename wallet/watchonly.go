@@ -0,0 +1,99 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/udb"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/hdkeychain"
+)
+
+// ErrWatchOnly describes attempts to sign with private keys which a
+// watching-only wallet does not record.  It matches, using errors.Is, every
+// error with code errors.WatchingOnly.
+var ErrWatchOnly = errors.E(errors.WatchingOnly)
+
+type watchOnlyOptions struct {
+	xpubAccounts []xpubAccount
+}
+
+type xpubAccount struct {
+	name string
+	xpub string
+}
+
+// WatchOnlyOption defines an option for creating a watching-only wallet with
+// CreateWatchOnly.
+type WatchOnlyOption func(*watchOnlyOptions)
+
+// WithXpubAccount imports an additional account extended public key into the
+// created watching-only wallet as an account with the provided name.  The
+// option may be repeated to watch several accounts.
+func WithXpubAccount(name, xpub string) WatchOnlyOption {
+	return func(o *watchOnlyOptions) {
+		o.xpubAccounts = append(o.xpubAccounts, xpubAccount{name: name, xpub: xpub})
+	}
+}
+
+// CreateWatchOnly creates a watchonly wallet on the provided db.  The default
+// account watches extendedPubKey, and additional accounts may be watched
+// using the WithXpubAccount option.  Private keys are never recorded, and
+// attempts to sign with the wallet's keys error with ErrWatchOnly.
+func CreateWatchOnly(ctx context.Context, db DB, extendedPubKey string, pubPass []byte,
+	params *chaincfg.Params, opts ...WatchOnlyOption) error {
+
+	const op errors.Op = "wallet.CreateWatchOnly"
+
+	var o watchOnlyOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	// Parse every additional key before creating the database so that
+	// invalid keys do not leave a partially created wallet.
+	xpubs := make([]*hdkeychain.ExtendedKey, len(o.xpubAccounts))
+	for i, a := range o.xpubAccounts {
+		xpub, err := hdkeychain.NewKeyFromString(a.xpub, params)
+		if err != nil {
+			return errors.E(op, errors.Invalid, err)
+		}
+		if xpub.IsPrivate() {
+			return errors.E(op, errors.Invalid, "extended key must be an xpub")
+		}
+		xpubs[i] = xpub
+	}
+
+	err := udb.InitializeWatchOnly(ctx, db.internal(), params, extendedPubKey, pubPass)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if len(xpubs) == 0 {
+		return nil
+	}
+
+	mgr, _, err := udb.Open(ctx, db.internal(), params, pubPass)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	defer mgr.Close()
+	err = walletdb.Update(ctx, db.internal(), func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		for i, a := range o.xpubAccounts {
+			err := mgr.ImportXpubAccount(ns, a.name, xpubs[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
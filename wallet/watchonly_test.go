@@ -0,0 +1,119 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/wallet/walletdb"
+	"github.com/monetarium/monetarium-node/hdkeychain"
+)
+
+func TestCreateWatchOnly(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 10
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+	err := w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account, err := w.NextAccount(ctx, "second")
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub0, err := w.AccountXpub(ctx, defaultAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub1, err := w.AccountXpub(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xprv, err := hdkeychain.NewMaster(seed, cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newDB := func() DB {
+		f, err := os.CreateTemp(t.TempDir(), "watchonly.testdb")
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+		db, err := walletdb.Create("bdb", f.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close() })
+		return opaqueDB{db}
+	}
+	pubPass := []byte(InsecurePubPassphrase)
+
+	// Private extended keys are never recorded.
+	err = CreateWatchOnly(ctx, newDB(), xprv.String(), pubPass, cfg.Params)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("xprv default account: expected Invalid error, got %v", err)
+	}
+	err = CreateWatchOnly(ctx, newDB(), xpub0.String(), pubPass, cfg.Params,
+		WithXpubAccount("xprv", xprv.String()))
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("xprv account: expected Invalid error, got %v", err)
+	}
+
+	db := newDB()
+	err = CreateWatchOnly(ctx, db, xpub0.String(), pubPass, cfg.Params,
+		WithXpubAccount("xpub1", xpub1.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	woCfg := basicWalletConfig
+	woCfg.DB = db
+	wo, err := Open(ctx, &woCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !wo.WatchingOnly() {
+		t.Fatal("wallet is not watching-only")
+	}
+
+	// Both accounts derive the addresses of the seeded wallet.
+	for _, name := range []string{defaultAccountName, "xpub1"} {
+		woAccount, err := wo.AccountNumber(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		xpub, err := wo.AccountXpub(ctx, woAccount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := xpub0
+		if name == "xpub1" {
+			want = xpub1
+		}
+		if xpub.String() != want.String() {
+			t.Errorf("account %q watches %v, want %v", name, xpub, want)
+		}
+	}
+
+	addr, err := wo.NewExternalAddress(ctx, defaultAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = wo.SignMessage(ctx, "message", addr)
+	if !errors.Is(err, ErrWatchOnly) {
+		t.Errorf("SignMessage: expected ErrWatchOnly, got %v", err)
+	}
+	_, err = wo.DumpWIFPrivateKey(ctx, addr)
+	if !errors.Is(err, ErrWatchOnly) {
+		t.Errorf("DumpWIFPrivateKey: expected ErrWatchOnly, got %v", err)
+	}
+}
@@ -100,3 +100,66 @@ func DecodeUserInput(input string) ([]byte, error) {
 	}
 	return seed, nil
 }
+
+// VerifyMnemonic checks that a mnemonic word list encodes the seed accepted by
+// match, without revealing that seed.  When the words do not encode the seed,
+// the zero-based positions of the words known to be incorrect are returned.
+// Words which are not valid at their position are always reported.  Otherwise,
+// a single incorrect word is located by testing each alternative word against
+// the checksum and match.  Incorrect words may not be reported when several
+// words are wrong.
+func VerifyMnemonic(words []string, match func(seed []byte) bool) (bool, []int, error) {
+	const op errors.Op = "walletseed.VerifyMnemonic"
+
+	trimmed := make([]string, 0, len(words))
+	for _, w := range words {
+		trimmed = append(trimmed, strings.Fields(w)...)
+	}
+	words = trimmed
+	seedLen := len(words) - 1 // Extra word for checksumByte
+	if seedLen < hdkeychain.MinSeedBytes || seedLen > hdkeychain.MaxSeedBytes {
+		return false, nil, errors.E(op, errors.Invalid,
+			errors.Errorf("mnemonic has invalid word count %d", len(words)))
+	}
+
+	decoded := make([]byte, len(words))
+	defer func() {
+		for i := range decoded {
+			decoded[i] = 0
+		}
+	}()
+	var invalid []int
+	for i, w := range words {
+		b, err := pgpwordlist.MnemonicToByte(w, i)
+		if err != nil {
+			invalid = append(invalid, i)
+			continue
+		}
+		decoded[i] = b
+	}
+	if len(invalid) != 0 {
+		return false, invalid, nil
+	}
+
+	seed, checksum := decoded[:seedLen], decoded[seedLen]
+	if checksumByte(seed) == checksum {
+		return match(seed), nil, nil
+	}
+	if match(seed) {
+		return false, []int{seedLen}, nil
+	}
+	for i := range seed {
+		orig := seed[i]
+		for b := 0; b <= 0xff; b++ {
+			if byte(b) == orig {
+				continue
+			}
+			seed[i] = byte(b)
+			if checksumByte(seed) == checksum && match(seed) {
+				return false, []int{i}, nil
+			}
+		}
+		seed[i] = orig
+	}
+	return false, nil, nil
+}
@@ -9,6 +9,9 @@ import (
 	"encoding/hex"
 	"strings"
 	"testing"
+
+	"github.com/monetarium/monetarium-wallet/errors"
+	"github.com/monetarium/monetarium-wallet/pgpwordlist"
 )
 
 var mnemonicTests = []struct {
@@ -86,3 +89,54 @@ func TestDecodeHex(t *testing.T) {
 		}
 	}
 }
+
+func TestVerifyMnemonic(t *testing.T) {
+	test := mnemonicTests[2]
+	match := func(seed []byte) bool { return bytes.Equal(seed, test.data) }
+	replace := func(i int, word string) []string {
+		words := strings.Fields(test.mnemonics)
+		words[i] = word
+		return words
+	}
+	last := len(test.data)
+
+	tests := []struct {
+		name      string
+		words     []string
+		matches   bool
+		incorrect []int
+	}{
+		{"correct", strings.Fields(test.mnemonics), true, nil},
+		{"split words", []string{test.mnemonics}, true, nil},
+		{"unknown word", replace(3, "notaword"), false, []int{3}},
+		{"wrong parity", replace(4, pgpwordlist.ByteToMnemonic(test.data[4], 5)), false, []int{4}},
+		{"wrong word", replace(5, pgpwordlist.ByteToMnemonic(test.data[5]^1, 5)), false, []int{5}},
+		{"wrong checksum", replace(last, pgpwordlist.ByteToMnemonic(0, last)), false, []int{last}},
+	}
+	for _, tc := range tests {
+		matches, incorrect, err := VerifyMnemonic(tc.words, match)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if matches != tc.matches {
+			t.Errorf("%s: matches %v, want %v", tc.name, matches, tc.matches)
+		}
+		if len(incorrect) != len(tc.incorrect) ||
+			(len(incorrect) != 0 && incorrect[0] != tc.incorrect[0]) {
+			t.Errorf("%s: incorrect words %v, want %v", tc.name, incorrect, tc.incorrect)
+		}
+	}
+
+	// Seeds of other wallets do not match.
+	other := func(seed []byte) bool { return false }
+	matches, incorrect, err := VerifyMnemonic(strings.Fields(test.mnemonics), other)
+	if err != nil || matches || len(incorrect) != 0 {
+		t.Errorf("other seed: got %v %v %v", matches, incorrect, err)
+	}
+
+	_, _, err = VerifyMnemonic([]string{"aardvark", "adroitness"}, match)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("short mnemonic: expected Invalid error, got %v", err)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/monetarium/monetarium-wallet/internal/prompt"
 	"github.com/monetarium/monetarium-wallet/wallet"
 	_ "github.com/monetarium/monetarium-wallet/wallet/drivers/bdb"
+	_ "github.com/monetarium/monetarium-wallet/wallet/drivers/sqlite"
 	"github.com/monetarium/monetarium-wallet/wallet/udb"
 	"github.com/monetarium/monetarium-wallet/walletseed"
 	"github.com/monetarium/monetarium-node/chaincfg"
@@ -116,7 +117,7 @@ func createWallet(ctx context.Context, cfg *config) error {
 		cfg.GapLimit, cfg.WatchLast, cfg.AllowHighFees, cfg.RelayFee.Amount,
 		cfg.VSPOpts.MaxFee.Amount, cfg.AccountGapLimit,
 		cfg.DisableCoinTypeUpgrades, cfg.MixingEnabled, cfg.ManualTickets,
-		cfg.MixSplitLimit, cfg.dial)
+		cfg.MixSplitLimit, false, cfg.DBDriver, cfg.dial)
 
 	var privPass, pubPass, seed []byte
 	var imported bool
@@ -294,8 +295,8 @@ func createSimulationWallet(ctx context.Context, cfg *config) error {
 	dbPath := filepath.Join(netDir, walletDbName)
 	fmt.Println("Creating the wallet...")
 
-	// Create the wallet database backed by bolt db.
-	db, err := wallet.CreateDB("bdb", dbPath)
+	// Create the wallet database with the configured driver.
+	db, err := wallet.CreateDB(cfg.DBDriver, dbPath)
 	if err != nil {
 		return err
 	}
@@ -346,8 +347,8 @@ func createWatchingOnlyWallet(ctx context.Context, cfg *config) error {
 	dbPath := filepath.Join(netDir, walletDbName)
 	fmt.Println("Creating the wallet...")
 
-	// Create the wallet database backed by bolt db.
-	db, err := wallet.CreateDB("bdb", dbPath)
+	// Create the wallet database with the configured driver.
+	db, err := wallet.CreateDB(cfg.DBDriver, dbPath)
 	if err != nil {
 		return err
 	}